@@ -0,0 +1,306 @@
+// Command schedula-backfill loads appointments and weekly series from a
+// legacy system, read from a CSV file or a query against its database. It
+// writes either straight to the database through the service or through a
+// running server's API, at a bounded rate. Progress is checkpointed after
+// every batch, so an interrupted run resumes where it stopped; records it
+// replays are recognized by their external id and reported as existing.
+package main
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"log/slog"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"syscall"
+	"time"
+
+	_ "github.com/jackc/pgx/v5/stdlib"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+
+	"schedula/backend/internal/config"
+	"schedula/backend/internal/conflicts"
+	schedulev1 "schedula/backend/internal/gen/proto/schedula/v1"
+	"schedula/backend/internal/service/appointments"
+	"schedula/backend/internal/store/postgres"
+)
+
+// checkpoint records how far a run got through its source. Source guards
+// against resuming one source's run with another's checkpoint.
+type checkpoint struct {
+	Source    string    `json:"source"`
+	Processed int       `json:"processed"`
+	Written   int       `json:"written"`
+	Existing  int       `json:"existing"`
+	Rejected  int       `json:"rejected"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+func loadCheckpoint(path, source string) (checkpoint, error) {
+	cp := checkpoint{Source: source}
+	if path == "" {
+		return cp, nil
+	}
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return cp, nil
+	}
+	if err != nil {
+		return checkpoint{}, err
+	}
+	if err := json.Unmarshal(data, &cp); err != nil {
+		return checkpoint{}, fmt.Errorf("read checkpoint %s: %w", path, err)
+	}
+	if cp.Source != source {
+		return checkpoint{}, fmt.Errorf("checkpoint %s belongs to source %q; remove it to start over", path, cp.Source)
+	}
+	return cp, nil
+}
+
+// saveCheckpoint replaces the file atomically, so a crash mid-write leaves
+// the previous checkpoint.
+func saveCheckpoint(path string, cp checkpoint) error {
+	if path == "" {
+		return nil
+	}
+	data, err := json.MarshalIndent(cp, "", "  ")
+	if err != nil {
+		return err
+	}
+	tmp, err := os.CreateTemp(filepath.Dir(path), filepath.Base(path)+".*")
+	if err != nil {
+		return err
+	}
+	if _, err := tmp.Write(data); err != nil {
+		_ = tmp.Close()
+		_ = os.Remove(tmp.Name())
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		_ = os.Remove(tmp.Name())
+		return err
+	}
+	return os.Rename(tmp.Name(), path)
+}
+
+// runner moves records from a source to a sink in batches. The checkpoint
+// is saved after each batch; a record is only counted once written.
+type runner struct {
+	src            recordSource
+	sink           sink
+	batchSize      int
+	interval       time.Duration
+	checkpointPath string
+	log            *slog.Logger
+}
+
+func (r *runner) run(ctx context.Context, cp checkpoint) (checkpoint, error) {
+	for skipped := 0; skipped < cp.Processed; skipped++ {
+		if _, err := r.src.Next(); err != nil && !errors.Is(err, errInvalidRecord) {
+			if errors.Is(err, io.EOF) {
+				return cp, fmt.Errorf("source ended after %d records but the checkpoint is at %d", skipped, cp.Processed)
+			}
+			return cp, err
+		}
+	}
+	if cp.Processed > 0 {
+		r.log.Info("resuming from checkpoint", slog.Int("processed", cp.Processed))
+	}
+
+	var tick <-chan time.Time
+	if r.interval > 0 {
+		ticker := time.NewTicker(r.interval)
+		defer ticker.Stop()
+		tick = ticker.C
+	}
+	started := time.Now()
+	startedAt := cp.Processed
+	inBatch := 0
+	flush := func() error {
+		cp.UpdatedAt = time.Now().UTC()
+		if err := saveCheckpoint(r.checkpointPath, cp); err != nil {
+			return fmt.Errorf("save checkpoint: %w", err)
+		}
+		rate := float64(cp.Processed-startedAt) / max(time.Since(started).Seconds(), 1e-9)
+		r.log.Info("batch done",
+			slog.Int("processed", cp.Processed),
+			slog.Int("written", cp.Written),
+			slog.Int("existing", cp.Existing),
+			slog.Int("rejected", cp.Rejected),
+			slog.Float64("records_per_second", rate),
+		)
+		inBatch = 0
+		return nil
+	}
+	// interrupted keeps what was written before the context ended.
+	interrupted := func() (checkpoint, error) {
+		return cp, errors.Join(ctx.Err(), flush())
+	}
+
+	for {
+		rec, err := r.src.Next()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil && !errors.Is(err, errInvalidRecord) {
+			return cp, err
+		}
+		if err == nil {
+			if tick != nil {
+				select {
+				case <-ctx.Done():
+					return interrupted()
+				case <-tick:
+				}
+			}
+			err = r.sink.Write(ctx, rec)
+		}
+		switch {
+		case err == nil:
+			cp.Written++
+		case errors.Is(err, errExists):
+			cp.Existing++
+		case errors.Is(err, errInvalidRecord), errors.Is(err, errRejected):
+			cp.Rejected++
+			r.log.Warn("record rejected", slog.Any("err", err), slog.Int("position", rec.Position), slog.String("external_id", rec.ExternalID))
+		default:
+			return cp, fmt.Errorf("record %d: %w", cp.Processed+1, err)
+		}
+		cp.Processed++
+		inBatch++
+		if inBatch == r.batchSize {
+			if err := flush(); err != nil {
+				return cp, err
+			}
+		}
+		if ctx.Err() != nil {
+			return interrupted()
+		}
+	}
+	return cp, flush()
+}
+
+func main() {
+	log := slog.New(slog.NewTextHandler(os.Stderr, nil)).With(slog.String("service", "schedula-backfill"))
+
+	csvPath := flag.String("csv", "", "CSV file to load; its first row names the columns")
+	sourceDB := flag.String("source-db", "", "legacy Postgres database URL to read from instead of -csv")
+	sourceQuery := flag.String("source-query", "", "ordered query against -source-db returning the backfill columns")
+	target := flag.String("target", "store", `where to write: "store" for the database, "api" for a running server`)
+	apiAddr := flag.String("api-addr", "localhost:50051", "server address for -target=api")
+	system := flag.String("system", "legacy", "external system recorded on backfilled appointments")
+	rps := flag.Float64("rate", 50, "maximum records per second; 0 for no limit")
+	batchSize := flag.Int("batch-size", 500, "records between checkpoints")
+	checkpointPath := flag.String("checkpoint", "", "file to record progress in and resume from")
+	inclusiveEnd := flag.Duration("inclusive-end", 0, "resolution of the source's inclusive end times, such as 1s, 1m, or 24h for all-day dates; 0 when ends are already exclusive")
+	flag.Parse()
+
+	if (*csvPath == "") == (*sourceDB == "") {
+		log.Error("set exactly one of -csv and -source-db")
+		os.Exit(2)
+	}
+	if *sourceDB != "" && *sourceQuery == "" {
+		log.Error("-source-db needs -source-query")
+		os.Exit(2)
+	}
+	if *batchSize < 1 || *rps < 0 {
+		log.Error("-batch-size must be at least 1 and -rate must not be negative")
+		os.Exit(2)
+	}
+	if *inclusiveEnd < 0 || *inclusiveEnd > 24*time.Hour {
+		log.Error("-inclusive-end must be between 0 and 24h")
+		os.Exit(2)
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	var (
+		src      recordSource
+		sourceID string
+		err      error
+	)
+	if *csvPath != "" {
+		sourceID = "csv:" + *csvPath
+		var f *os.File
+		if f, err = os.Open(*csvPath); err == nil {
+			src, err = newCSVSource(f)
+		}
+	} else {
+		sourceID = "query:" + *sourceQuery
+		var db *sql.DB
+		if db, err = sql.Open("pgx", *sourceDB); err == nil {
+			if src, err = newSQLSource(ctx, db, *sourceQuery); err != nil {
+				_ = db.Close()
+			}
+		}
+	}
+	if err != nil {
+		log.Error("opening source failed", slog.Any("err", err))
+		os.Exit(1)
+	}
+	defer func() { _ = src.Close() }()
+	if *inclusiveEnd > 0 {
+		src = inclusiveEndSource{recordSource: src, resolution: *inclusiveEnd}
+	}
+
+	cp, err := loadCheckpoint(*checkpointPath, sourceID)
+	if err != nil {
+		log.Error("loading checkpoint failed", slog.Any("err", err))
+		os.Exit(1)
+	}
+
+	var out sink
+	switch *target {
+	case "store":
+		cfg, err := config.Load()
+		if err != nil {
+			log.Error("config load failed", slog.Any("err", err))
+			os.Exit(1)
+		}
+		db, err := postgres.Open(cfg.DatabaseURL, postgres.PoolConfig{MaxOpenConns: 4, MaxIdleConns: 4})
+		if err != nil {
+			log.Error("database connection failed", slog.Any("err", err))
+			os.Exit(1)
+		}
+		defer func() { _ = postgres.Close(db) }()
+		conflictPolicy, _ := conflicts.Lookup(cfg.ConflictPolicy)
+		repo := postgres.NewAppointmentRepoWithOptions(db, postgres.RepoOptions{ConflictPolicy: conflictPolicy})
+		out = &storeSink{svc: appointments.NewServiceWithLimits(repo, cfg.Limits), system: *system}
+	case "api":
+		conn, err := grpc.NewClient(*apiAddr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+		if err != nil {
+			log.Error("api connection failed", slog.Any("err", err))
+			os.Exit(1)
+		}
+		defer func() { _ = conn.Close() }()
+		out = &apiSink{client: schedulev1.NewAppointmentsServiceClient(conn), system: *system}
+	default:
+		log.Error(`-target must be "store" or "api"`)
+		os.Exit(2)
+	}
+
+	var interval time.Duration
+	if *rps > 0 {
+		interval = time.Duration(float64(time.Second) / *rps)
+	}
+	r := &runner{src: src, sink: out, batchSize: *batchSize, interval: interval, checkpointPath: *checkpointPath, log: log}
+	cp, err = r.run(ctx, cp)
+	if err != nil {
+		log.Error("backfill stopped", slog.Any("err", err), slog.Int("processed", cp.Processed))
+		os.Exit(1)
+	}
+	log.Info("backfill complete",
+		slog.Int("processed", cp.Processed),
+		slog.Int("written", cp.Written),
+		slog.Int("existing", cp.Existing),
+		slog.Int("rejected", cp.Rejected),
+	)
+}
@@ -0,0 +1,109 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+const testCSV = `external_id,kind,user_id,title,start,end,time_zone,weekdays,count
+a1,appointment,u1,Intake,2019-03-04T09:00:00Z,2019-03-04T10:00:00Z,,,
+a2,appointment,u1,Follow-up,not a time,2019-03-05T10:00:00Z,,,
+s1,series,u1,Group,2019-03-04T14:00:00Z,2019-03-04T15:00:00Z,Europe/London,1 3,6
+a3,appointment,u2,Review,2019-03-06T09:00:00Z,2019-03-06T09:30:00Z,,,
+`
+
+type recordingSink struct {
+	written []string
+	failAt  string
+}
+
+func (s *recordingSink) Write(ctx context.Context, rec legacyRecord) error {
+	if rec.ExternalID == s.failAt {
+		return errors.New("connection reset")
+	}
+	s.written = append(s.written, rec.ExternalID)
+	if rec.Kind == recordKindSeries && (len(rec.Weekdays) != 2 || rec.Count != 6 || rec.TimeZone != "Europe/London") {
+		return fmt.Errorf("%w: series parsed as %+v", errRejected, rec)
+	}
+	return nil
+}
+
+func TestRunner_ResumesFromCheckpoint(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "backfill.json")
+	open := func() recordSource {
+		src, err := newCSVSource(io.NopCloser(strings.NewReader(testCSV)))
+		if err != nil {
+			t.Fatalf("newCSVSource error: %v", err)
+		}
+		return src
+	}
+
+	// The first run writes a1, rejects a2, checkpoints, then fails on s1.
+	sink := &recordingSink{failAt: "s1"}
+	r := &runner{src: open(), sink: sink, batchSize: 2, checkpointPath: path, log: slog.Default()}
+	if _, err := r.run(context.Background(), checkpoint{Source: "test"}); err == nil {
+		t.Fatal("first run error = nil, want the sink failure")
+	}
+	cp, err := loadCheckpoint(path, "test")
+	if err != nil {
+		t.Fatalf("loadCheckpoint error: %v", err)
+	}
+	if cp.Processed != 2 || cp.Written != 1 || cp.Rejected != 1 {
+		t.Fatalf("checkpoint = %+v, want 2 processed, 1 written, 1 rejected", cp)
+	}
+
+	sink.failAt = ""
+	r.src = open()
+	cp, err = r.run(context.Background(), cp)
+	if err != nil {
+		t.Fatalf("resumed run error: %v", err)
+	}
+	if got := strings.Join(sink.written, ","); got != "a1,s1,a3" {
+		t.Fatalf("written = %s, want a1,s1,a3", got)
+	}
+	if cp.Processed != 4 || cp.Written != 3 || cp.Rejected != 1 {
+		t.Fatalf("final checkpoint = %+v", cp)
+	}
+
+	if _, err := loadCheckpoint(path, "other"); err == nil {
+		t.Fatal("loading another source's checkpoint succeeded")
+	}
+}
+
+func TestInclusiveEndSource_MakesEndsExclusive(t *testing.T) {
+	const inclusiveCSV = `external_id,kind,user_id,title,start,end
+a1,appointment,u1,Intake,2019-03-04T09:00:00Z,2019-03-04T09:59:59Z
+a2,appointment,u1,Follow-up,2019-03-04T10:00:00Z,2019-03-04T10:29:59Z
+`
+	csvSrc, err := newCSVSource(io.NopCloser(strings.NewReader(inclusiveCSV)))
+	if err != nil {
+		t.Fatalf("newCSVSource error: %v", err)
+	}
+	src := inclusiveEndSource{recordSource: csvSrc, resolution: time.Second}
+
+	first, err := src.Next()
+	if err != nil {
+		t.Fatalf("Next error: %v", err)
+	}
+	second, err := src.Next()
+	if err != nil {
+		t.Fatalf("Next error: %v", err)
+	}
+	// The two are back to back once converted, not a second apart.
+	if !first.End.Equal(second.Start) {
+		t.Fatalf("first end = %v, want the second's start %v", first.End, second.Start)
+	}
+	if want := time.Date(2019, 3, 4, 10, 30, 0, 0, time.UTC); !second.End.Equal(want) {
+		t.Fatalf("second end = %v, want %v", second.End, want)
+	}
+	if _, err := src.Next(); !errors.Is(err, io.EOF) {
+		t.Fatalf("Next after the last record error = %v, want io.EOF", err)
+	}
+}
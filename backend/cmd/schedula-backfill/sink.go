@@ -0,0 +1,140 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"schedula/backend/internal/domain"
+	schedulev1 "schedula/backend/internal/gen/proto/schedula/v1"
+	"schedula/backend/internal/service/appointments"
+	"schedula/backend/internal/store"
+)
+
+// errExists marks a record that an earlier run already wrote.
+var errExists = errors.New("already exists")
+
+// errRejected marks a record the target refused, such as one that overlaps
+// an existing booking. The run counts it and moves on.
+var errRejected = errors.New("rejected")
+
+// sink writes records to Schedula. Write returns an error wrapping errExists
+// or errRejected for records that should not stop the run; any other error
+// stops it.
+type sink interface {
+	Write(ctx context.Context, rec legacyRecord) error
+}
+
+// storeSink writes through the service straight to the database, so the
+// usual validation and conflict checks apply but the past start policy does
+// not: legacy data is mostly in the past.
+type storeSink struct {
+	svc    *appointments.Service
+	system string
+}
+
+func (s *storeSink) Write(ctx context.Context, rec legacyRecord) error {
+	var err error
+	switch rec.Kind {
+	case recordKindAppointment:
+		_, err = s.svc.Create(ctx, appointments.CreateInput{
+			UserID:         rec.UserID,
+			Title:          rec.Title,
+			Notes:          rec.Notes,
+			StartTime:      rec.Start,
+			EndTime:        rec.End,
+			TimeZone:       rec.TimeZone,
+			ExternalRef:    &appointments.ExternalRef{System: s.system, ID: rec.ExternalID},
+			AllowPastStart: true,
+		})
+	case recordKindSeries:
+		_, err = s.svc.CreateRecurringSeries(ctx, seriesInput(rec))
+	}
+	var vErr *appointments.ValidationError
+	switch {
+	case err == nil:
+		return nil
+	case errors.Is(err, store.ErrDuplicate):
+		return fmt.Errorf("%w: %v", errExists, err)
+	case errors.As(err, &vErr), errors.Is(err, store.ErrConflict), errors.Is(err, appointments.ErrBlackout),
+		errors.Is(err, appointments.ErrTimeOff), errors.Is(err, appointments.ErrNotAuthorized),
+		errors.Is(err, appointments.ErrSeriesLimit):
+		return fmt.Errorf("%w: %v", errRejected, err)
+	default:
+		return err
+	}
+}
+
+func seriesInput(rec legacyRecord) appointments.CreateRecurringSeriesInput {
+	in := appointments.CreateRecurringSeriesInput{
+		UserID:    rec.UserID,
+		Title:     rec.Title,
+		Notes:     rec.Notes,
+		StartTime: rec.Start,
+		EndTime:   rec.End,
+		Rule: appointments.RecurrenceRuleInput{
+			Frequency: domain.RecurrenceFrequencyWeekly,
+			Interval:  rec.Interval,
+			ByWeekday: rec.Weekdays,
+			Until:     rec.Until,
+			TimeZone:  rec.TimeZone,
+		},
+	}
+	if rec.Count > 0 {
+		in.Rule.Count = &rec.Count
+	}
+	return in
+}
+
+// apiSink writes through a running server's gRPC API, so every server-side
+// policy applies, including the past start policy.
+type apiSink struct {
+	client schedulev1.AppointmentsServiceClient
+	system string
+}
+
+func (s *apiSink) Write(ctx context.Context, rec legacyRecord) error {
+	var err error
+	switch rec.Kind {
+	case recordKindAppointment:
+		_, err = s.client.CreateAppointment(ctx, &schedulev1.CreateAppointmentRequest{
+			UserId:      rec.UserID,
+			Title:       rec.Title,
+			Notes:       rec.Notes,
+			StartTime:   timestamppb.New(rec.Start),
+			EndTime:     timestamppb.New(rec.End),
+			TimeZone:    rec.TimeZone,
+			ExternalRef: &schedulev1.ExternalRef{System: s.system, Id: rec.ExternalID},
+		})
+	case recordKindSeries:
+		weekly := &schedulev1.WeeklyRecurrence{Interval: uint32(rec.Interval), Count: uint32(rec.Count), TimeZone: rec.TimeZone}
+		for _, wd := range rec.Weekdays {
+			weekly.Weekdays = append(weekly.Weekdays, schedulev1.Weekday(wd))
+		}
+		if rec.Until != nil {
+			weekly.Until = timestamppb.New(*rec.Until)
+		}
+		_, err = s.client.CreateRecurringSeries(ctx, &schedulev1.CreateRecurringSeriesRequest{
+			UserId:    rec.UserID,
+			Title:     rec.Title,
+			Notes:     rec.Notes,
+			StartTime: timestamppb.New(rec.Start),
+			EndTime:   timestamppb.New(rec.End),
+			Weekly:    weekly,
+		})
+	}
+	switch status.Code(err) {
+	case codes.OK:
+		return nil
+	case codes.AlreadyExists:
+		return fmt.Errorf("%w: %v", errExists, err)
+	case codes.InvalidArgument, codes.FailedPrecondition, codes.PermissionDenied, codes.ResourceExhausted:
+		return fmt.Errorf("%w: %v", errRejected, err)
+	default:
+		return err
+	}
+}
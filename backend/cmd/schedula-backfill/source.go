@@ -0,0 +1,248 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"schedula/backend/internal/domain"
+)
+
+const (
+	recordKindAppointment = "appointment"
+	recordKindSeries      = "series"
+)
+
+// recordColumns are the columns a source provides, by name and in any order.
+// kind, user_id, external_id, title, start and end are required; the rest
+// may be missing or empty.
+var recordColumns = []string{"kind", "user_id", "external_id", "title", "notes", "start", "end", "time_zone", "weekdays", "interval", "count", "until"}
+
+// legacyRecord is one appointment or weekly series to load. Series fields
+// are zero for appointments.
+type legacyRecord struct {
+	Position   int
+	Kind       string
+	UserID     string
+	ExternalID string
+	Title      string
+	Notes      string
+	Start      time.Time
+	End        time.Time
+	TimeZone   string
+	Weekdays   []int16
+	Interval   int
+	Count      int
+	Until      *time.Time
+}
+
+// recordSource yields records in a stable order, returning io.EOF after the
+// last one. Resuming from a checkpoint relies on the order.
+type recordSource interface {
+	Next() (legacyRecord, error)
+	Close() error
+}
+
+// errInvalidRecord marks a record the source could not parse. The run
+// counts it as rejected and moves on.
+var errInvalidRecord = errors.New("invalid record")
+
+// columnIndex maps recordColumns to their positions in a header, or -1.
+func columnIndex(header []string) (map[string]int, error) {
+	idx := make(map[string]int, len(recordColumns))
+	for _, name := range recordColumns {
+		idx[name] = -1
+	}
+	for i, name := range header {
+		name = strings.ToLower(strings.TrimSpace(name))
+		if _, ok := idx[name]; ok {
+			idx[name] = i
+		}
+	}
+	for _, name := range []string{"kind", "user_id", "external_id", "title", "start", "end"} {
+		if idx[name] < 0 {
+			return nil, fmt.Errorf("source has no %s column", name)
+		}
+	}
+	return idx, nil
+}
+
+func parseRecord(position int, idx map[string]int, values []string) (legacyRecord, error) {
+	get := func(name string) string {
+		if i := idx[name]; i >= 0 && i < len(values) {
+			return strings.TrimSpace(values[i])
+		}
+		return ""
+	}
+	invalid := func(msg string) (legacyRecord, error) {
+		return legacyRecord{}, fmt.Errorf("%w at %d: %s", errInvalidRecord, position, msg)
+	}
+
+	rec := legacyRecord{
+		Position:   position,
+		Kind:       strings.ToLower(get("kind")),
+		UserID:     get("user_id"),
+		ExternalID: get("external_id"),
+		Title:      get("title"),
+		Notes:      get("notes"),
+		TimeZone:   get("time_zone"),
+	}
+	if rec.Kind != recordKindAppointment && rec.Kind != recordKindSeries {
+		return invalid("kind must be appointment or series")
+	}
+	if rec.UserID == "" || rec.ExternalID == "" {
+		return invalid("user_id and external_id are required")
+	}
+	var err error
+	if rec.Start, err = time.Parse(time.RFC3339, get("start")); err != nil {
+		return invalid("start must be an RFC 3339 time")
+	}
+	if rec.End, err = time.Parse(time.RFC3339, get("end")); err != nil {
+		return invalid("end must be an RFC 3339 time")
+	}
+	if rec.Kind == recordKindAppointment {
+		return rec, nil
+	}
+
+	for _, f := range strings.Fields(get("weekdays")) {
+		wd, err := strconv.Atoi(f)
+		if err != nil || wd < 1 || wd > 7 {
+			return invalid("weekdays must be numbers from 1 (Monday) to 7 (Sunday)")
+		}
+		rec.Weekdays = append(rec.Weekdays, int16(wd))
+	}
+	if rec.Interval, err = optionalInt(get("interval")); err != nil {
+		return invalid("interval must be a number")
+	}
+	if rec.Count, err = optionalInt(get("count")); err != nil {
+		return invalid("count must be a number")
+	}
+	if raw := get("until"); raw != "" {
+		until, err := time.Parse(time.RFC3339, raw)
+		if err != nil {
+			return invalid("until must be an RFC 3339 time")
+		}
+		rec.Until = &until
+	}
+	return rec, nil
+}
+
+func optionalInt(raw string) (int, error) {
+	if raw == "" {
+		return 0, nil
+	}
+	return strconv.Atoi(raw)
+}
+
+// csvSource reads records from a CSV file whose first row names the columns.
+type csvSource struct {
+	r        *csv.Reader
+	closer   io.Closer
+	idx      map[string]int
+	position int
+}
+
+func newCSVSource(rc io.ReadCloser) (*csvSource, error) {
+	r := csv.NewReader(rc)
+	r.FieldsPerRecord = -1
+	header, err := r.Read()
+	if err != nil {
+		_ = rc.Close()
+		return nil, fmt.Errorf("read csv header: %w", err)
+	}
+	idx, err := columnIndex(header)
+	if err != nil {
+		_ = rc.Close()
+		return nil, err
+	}
+	return &csvSource{r: r, closer: rc, idx: idx}, nil
+}
+
+func (s *csvSource) Next() (legacyRecord, error) {
+	values, err := s.r.Read()
+	if err != nil {
+		return legacyRecord{}, err
+	}
+	s.position++
+	return parseRecord(s.position, s.idx, values)
+}
+
+func (s *csvSource) Close() error { return s.closer.Close() }
+
+// sqlSource reads records from a query against the legacy database. The
+// query must name its columns after recordColumns and order its rows, so a
+// resumed run sees them in the same order.
+type sqlSource struct {
+	db       *sql.DB
+	rows     *sql.Rows
+	idx      map[string]int
+	values   []sql.NullString
+	position int
+}
+
+func newSQLSource(ctx context.Context, db *sql.DB, query string) (*sqlSource, error) {
+	rows, err := db.QueryContext(ctx, query)
+	if err != nil {
+		return nil, fmt.Errorf("source query: %w", err)
+	}
+	cols, err := rows.Columns()
+	if err != nil {
+		_ = rows.Close()
+		return nil, err
+	}
+	idx, err := columnIndex(cols)
+	if err != nil {
+		_ = rows.Close()
+		return nil, err
+	}
+	return &sqlSource{db: db, rows: rows, idx: idx, values: make([]sql.NullString, len(cols))}, nil
+}
+
+func (s *sqlSource) Next() (legacyRecord, error) {
+	if !s.rows.Next() {
+		if err := s.rows.Err(); err != nil {
+			return legacyRecord{}, err
+		}
+		return legacyRecord{}, io.EOF
+	}
+	dest := make([]any, len(s.values))
+	for i := range s.values {
+		dest[i] = &s.values[i]
+	}
+	s.position++
+	if err := s.rows.Scan(dest...); err != nil {
+		return legacyRecord{}, fmt.Errorf("%w at %d: %v", errInvalidRecord, s.position, err)
+	}
+	values := make([]string, len(s.values))
+	for i, v := range s.values {
+		values[i] = v.String
+	}
+	return parseRecord(s.position, s.idx, values)
+}
+
+func (s *sqlSource) Close() error {
+	return errors.Join(s.rows.Close(), s.db.Close())
+}
+
+// inclusiveEndSource converts the ends of a source that records the last
+// instant an appointment covers, such as 10:59:59 for a meeting that ends at
+// 11:00, to the exclusive ends the server stores. Without this, back-to-back
+// legacy bookings would load with a one-second gap between them.
+type inclusiveEndSource struct {
+	recordSource
+	resolution time.Duration
+}
+
+func (s inclusiveEndSource) Next() (legacyRecord, error) {
+	rec, err := s.recordSource.Next()
+	if err == nil {
+		rec.End = domain.ExclusiveEnd(rec.End, s.resolution)
+	}
+	return rec, err
+}
@@ -0,0 +1,85 @@
+// Command schedula-conformance plays the golden scenarios in
+// internal/conformance against a running server and reports each step.
+// Every scenario runs under a fresh user id, so it can point at a shared
+// deployment without touching anyone's calendar; the appointments it
+// creates are left behind in the far future under that id.
+package main
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"flag"
+	"fmt"
+	"log/slog"
+	"os"
+	"os/signal"
+	"strings"
+	"syscall"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+
+	"schedula/backend/internal/conformance"
+)
+
+func main() {
+	log := slog.New(slog.NewTextHandler(os.Stderr, nil)).With(slog.String("service", "schedula-conformance"))
+
+	addr := flag.String("addr", "localhost:50051", "server address")
+	run := flag.String("run", "", "only play scenarios whose name contains this")
+	timeout := flag.Duration("timeout", time.Minute, "limit on the whole run")
+	flag.Parse()
+
+	scenarios, err := conformance.Load()
+	if err != nil {
+		log.Error("loading goldens failed", slog.Any("err", err))
+		os.Exit(1)
+	}
+	if *run != "" {
+		var kept []conformance.Scenario
+		for _, sc := range scenarios {
+			if strings.Contains(sc.Name, *run) {
+				kept = append(kept, sc)
+			}
+		}
+		scenarios = kept
+	}
+	if len(scenarios) == 0 {
+		log.Error("no scenarios match -run", slog.String("run", *run))
+		os.Exit(2)
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+	ctx, cancel := context.WithTimeout(ctx, *timeout)
+	defer cancel()
+
+	conn, err := grpc.NewClient(*addr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		log.Error("connection failed", slog.Any("err", err))
+		os.Exit(1)
+	}
+	defer func() { _ = conn.Close() }()
+
+	failed := 0
+	for _, r := range conformance.Run(ctx, conn, scenarios, newUserID) {
+		if r.Err != nil {
+			failed++
+			fmt.Printf("FAIL %s: %s: %v\n", r.Scenario, r.Step, r.Err)
+			continue
+		}
+		fmt.Printf("ok   %s: %s\n", r.Scenario, r.Step)
+	}
+	if failed > 0 {
+		log.Error("conformance failed", slog.Int("failed_steps", failed))
+		os.Exit(1)
+	}
+}
+
+func newUserID() string {
+	b := make([]byte, 8)
+	_, _ = rand.Read(b)
+	return "conformance-" + hex.EncodeToString(b)
+}
@@ -0,0 +1,83 @@
+// Command schedula-daysummaries rebuilds the stored day summaries that month
+// views read. With -user it rebuilds one user's; with -all it drops every
+// stored summary and rebuilds each user with a calendar, for recovery after
+// the table was lost or a bug stored wrong counts.
+package main
+
+import (
+	"context"
+	"flag"
+	"log/slog"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"schedula/backend/internal/config"
+	"schedula/backend/internal/service/appointments"
+	"schedula/backend/internal/store/postgres"
+)
+
+func main() {
+	log := slog.New(slog.NewTextHandler(os.Stderr, nil)).With(slog.String("service", "schedula-daysummaries"))
+
+	userID := flag.String("user", "", "user whose summaries to rebuild")
+	all := flag.Bool("all", false, "drop and rebuild every user's summaries")
+	batchSize := flag.Int("batch-size", 100, "users rebuilt per batch with -all")
+	flag.Parse()
+
+	if (*userID == "") == !*all {
+		log.Error("set exactly one of -user and -all")
+		os.Exit(2)
+	}
+	if *batchSize < 1 {
+		log.Error("-batch-size must be at least 1")
+		os.Exit(2)
+	}
+
+	cfg, err := config.Load()
+	if err != nil {
+		log.Error("config load failed", slog.Any("err", err))
+		os.Exit(1)
+	}
+	db, err := postgres.Open(cfg.DatabaseURL, postgres.PoolConfig{MaxOpenConns: 2, MaxIdleConns: 2})
+	if err != nil {
+		log.Error("database connection failed", slog.Any("err", err))
+		os.Exit(1)
+	}
+	defer func() { _ = postgres.Close(db) }()
+	svc := appointments.NewService(postgres.NewAppointmentRepo(db))
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	if *userID != "" {
+		days, err := svc.RebuildDaySummaries(ctx, *userID)
+		if err != nil {
+			log.Error("rebuild failed", slog.Any("err", err), slog.String("user_id", *userID))
+			os.Exit(1)
+		}
+		log.Info("day summaries rebuilt", slog.String("user_id", *userID), slog.Int("days", days))
+		return
+	}
+
+	// Once cleared, every user with a calendar is stale, and each rebuild
+	// takes that user off the stale list, so the loop ends when all are done.
+	if err := svc.ClearDaySummaries(ctx); err != nil {
+		log.Error("clearing day summaries failed", slog.Any("err", err))
+		os.Exit(1)
+	}
+	total := 0
+	for {
+		n, err := svc.RefreshDaySummaries(ctx, *batchSize)
+		total += n
+		if err != nil {
+			log.Error("rebuild failed", slog.Any("err", err), slog.Int("users", total))
+			os.Exit(1)
+		}
+		if n == 0 {
+			break
+		}
+		log.Info("rebuilding day summaries", slog.Int("users", total))
+	}
+	log.Info("day summaries rebuilt", slog.Int("users", total))
+}
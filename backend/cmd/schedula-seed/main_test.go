@@ -0,0 +1,36 @@
+package main
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestBuildPlan_Deterministic(t *testing.T) {
+	start := time.Date(2026, 3, 4, 0, 0, 0, 0, time.UTC)
+	a, err := buildPlan(7, 3, 14, start)
+	if err != nil {
+		t.Fatalf("buildPlan error: %v", err)
+	}
+	b, err := buildPlan(7, 3, 14, start)
+	if err != nil {
+		t.Fatalf("buildPlan error: %v", err)
+	}
+	if !reflect.DeepEqual(a, b) {
+		t.Fatal("same seed produced different plans")
+	}
+	if len(a.Series) != 6 || len(a.Appointments) == 0 {
+		t.Fatalf("plan has %d series and %d appointments", len(a.Series), len(a.Appointments))
+	}
+	if got := a.Series[0].StartTime; got.Before(time.Date(2026, 3, 2, 0, 0, 0, 0, time.UTC)) || got.After(time.Date(2026, 3, 3, 0, 0, 0, 0, time.UTC)) {
+		t.Fatalf("standup starts %s, want Monday 2 March", got)
+	}
+
+	c, err := buildPlan(8, 3, 14, start)
+	if err != nil {
+		t.Fatalf("buildPlan error: %v", err)
+	}
+	if reflect.DeepEqual(a, c) {
+		t.Fatal("different seeds produced the same plan")
+	}
+}
@@ -0,0 +1,257 @@
+package main
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"log/slog"
+	"net"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"schedula/backend/internal/config"
+	"schedula/backend/internal/conformance"
+	schedulev1 "schedula/backend/internal/gen/proto/schedula/v1"
+	schedulev2 "schedula/backend/internal/gen/proto/schedula/v2"
+	"schedula/backend/internal/limits"
+	"schedula/backend/internal/logscope"
+	"schedula/backend/internal/service/appointments"
+	"schedula/backend/internal/sli"
+	"schedula/backend/internal/store/postgres"
+	grpcTransport "schedula/backend/internal/transport/grpc"
+	"schedula/backend/internal/usage"
+	"schedula/backend/migrations"
+)
+
+// e2eClients are connected to a server built by newGRPCServer and listening
+// on a real socket, so every call crosses the wire and the interceptors.
+type e2eClients struct {
+	conn *grpc.ClientConn
+	v1   schedulev1.AppointmentsServiceClient
+	v2   schedulev2.AppointmentsServiceClient
+}
+
+// startE2EServer boots the server against SCHEDULA_E2E_DATABASE_URL, which
+// must point at a database migrated to the embedded migrations (make
+// db-migrate). Tests isolate themselves with a fresh user id.
+func startE2EServer(t *testing.T) e2eClients {
+	t.Helper()
+	databaseURL := strings.TrimSpace(os.Getenv("SCHEDULA_E2E_DATABASE_URL"))
+	if databaseURL == "" {
+		t.Skip("SCHEDULA_E2E_DATABASE_URL not set")
+	}
+
+	db, err := postgres.Open(databaseURL, postgres.PoolConfig{MaxOpenConns: 4})
+	if err != nil {
+		t.Fatalf("Open error: %v", err)
+	}
+	t.Cleanup(func() { _ = postgres.Close(db) })
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	migrationStatus, err := postgres.CheckMigrations(ctx, db, migrations.FS)
+	if err != nil {
+		t.Fatalf("CheckMigrations error: %v", err)
+	}
+	if !migrationStatus.UpToDate() {
+		t.Fatalf("database at version %d, want %d; run make db-migrate", migrationStatus.Current, migrationStatus.Latest)
+	}
+
+	cfg := config.Config{
+		GRPCRequestTimeout: 10 * time.Second,
+		UsageWindow:        time.Hour,
+		Limits:             limits.Default(),
+		GRPCStrictConvert:  true,
+	}
+	log := slog.New(slog.NewTextHandler(os.Stderr, &slog.HandlerOptions{Level: slog.LevelWarn}))
+	svc := appointments.NewServiceWithLimits(postgres.NewAppointmentRepo(db), cfg.Limits)
+	server, err := newGRPCServer(cfg, svc, db, usage.New(cfg.UsageWindow, 0, 0), sli.New(cfg.SLIWindow, 0), grpcTransport.NewConversionErrors(), logscope.NewTargets(), log)
+	if err != nil {
+		t.Fatalf("newGRPCServer error: %v", err)
+	}
+
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("Listen error: %v", err)
+	}
+	go func() { _ = server.Serve(lis) }()
+	t.Cleanup(server.Stop)
+
+	conn, err := grpc.NewClient(lis.Addr().String(), grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		t.Fatalf("NewClient error: %v", err)
+	}
+	t.Cleanup(func() { _ = conn.Close() })
+
+	return e2eClients{
+		conn: conn,
+		v1:   schedulev1.NewAppointmentsServiceClient(conn),
+		v2:   schedulev2.NewAppointmentsServiceClient(conn),
+	}
+}
+
+func e2eUserID(t *testing.T) string {
+	t.Helper()
+	b := make([]byte, 6)
+	if _, err := rand.Read(b); err != nil {
+		t.Fatalf("rand.Read error: %v", err)
+	}
+	return "e2e-" + hex.EncodeToString(b)
+}
+
+func TestE2E_AppointmentAndSeriesFlow(t *testing.T) {
+	clients := startE2EServer(t)
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	userID := e2eUserID(t)
+	// A Monday well in the future, so no rule that looks at the clock
+	// changes the outcome.
+	day := time.Date(2031, 3, 3, 0, 0, 0, 0, time.UTC)
+	windowStart := timestamppb.New(day.AddDate(0, 0, -2))
+	windowEnd := timestamppb.New(day.AddDate(0, 0, 28))
+
+	// Create, and check the write headers set by the interceptor chain.
+	var header metadata.MD
+	created, err := clients.v1.CreateAppointment(ctx, &schedulev1.CreateAppointmentRequest{
+		UserId:    userID,
+		Title:     "Intro call",
+		StartTime: timestamppb.New(day.Add(9 * time.Hour)),
+		EndTime:   timestamppb.New(day.Add(10 * time.Hour)),
+	}, grpc.Header(&header))
+	if err != nil {
+		t.Fatalf("CreateAppointment error: %v", err)
+	}
+	if got := header.Get(grpcTransport.CalendarVersionHeader); len(got) != 1 {
+		t.Fatalf("calendar version header = %v, want one value", got)
+	}
+	if got := header.Get(grpcTransport.APIVersionHeader); len(got) != 1 || got[0] != "v1" {
+		t.Fatalf("api version header = %v, want v1", got)
+	}
+	apptID := created.Appointment.Id
+
+	_, err = clients.v1.CreateAppointment(ctx, &schedulev1.CreateAppointmentRequest{
+		UserId:    userID,
+		Title:     "Overlap",
+		StartTime: timestamppb.New(day.Add(9*time.Hour + 30*time.Minute)),
+		EndTime:   timestamppb.New(day.Add(10*time.Hour + 30*time.Minute)),
+	})
+	if status.Code(err) != codes.FailedPrecondition {
+		t.Fatalf("overlapping create code = %s, want %s", status.Code(err), codes.FailedPrecondition)
+	}
+
+	listed, err := clients.v1.ListAppointments(ctx, &schedulev1.ListAppointmentsRequest{UserId: userID, WindowStart: windowStart, WindowEnd: windowEnd})
+	if err != nil {
+		t.Fatalf("ListAppointments error: %v", err)
+	}
+	if len(listed.Appointments) != 1 || listed.Appointments[0].Id != apptID || listed.Appointments[0].Source != "manual" {
+		t.Fatalf("listed = %v, want the created manual appointment", listed.Appointments)
+	}
+
+	// A weekly series on Mondays and Wednesdays, four occurrences.
+	series, err := clients.v1.CreateRecurringSeries(ctx, &schedulev1.CreateRecurringSeriesRequest{
+		UserId:    userID,
+		Title:     "Standup",
+		StartTime: timestamppb.New(day.Add(14 * time.Hour)),
+		EndTime:   timestamppb.New(day.Add(14*time.Hour + 30*time.Minute)),
+		Weekly: &schedulev1.WeeklyRecurrence{
+			Interval: 1,
+			Weekdays: []schedulev1.Weekday{schedulev1.Weekday_MONDAY, schedulev1.Weekday_WEDNESDAY},
+			Count:    4,
+			TimeZone: "UTC",
+		},
+	})
+	if err != nil {
+		t.Fatalf("CreateRecurringSeries error: %v", err)
+	}
+	seriesID := series.Series.Id
+
+	occ, err := clients.v1.ListOccurrences(ctx, &schedulev1.ListOccurrencesRequest{UserId: userID, WindowStart: windowStart, WindowEnd: windowEnd})
+	if err != nil {
+		t.Fatalf("ListOccurrences error: %v", err)
+	}
+	wantStarts := []time.Time{
+		day.Add(14 * time.Hour),
+		day.AddDate(0, 0, 2).Add(14 * time.Hour),
+		day.AddDate(0, 0, 7).Add(14 * time.Hour),
+		day.AddDate(0, 0, 9).Add(14 * time.Hour),
+	}
+	if len(occ.Occurrences) != len(wantStarts) {
+		t.Fatalf("occurrences = %d, want %d", len(occ.Occurrences), len(wantStarts))
+	}
+	for i, want := range wantStarts {
+		if got := occ.Occurrences[i].StartTime.AsTime(); !got.Equal(want) || occ.Occurrences[i].SeriesId != seriesID {
+			t.Fatalf("occurrence %d = %s of %s, want %s of %s", i, got, occ.Occurrences[i].SeriesId, want, seriesID)
+		}
+	}
+
+	// Per-occurrence state on the second occurrence.
+	if _, err := clients.v1.MarkAttendance(ctx, &schedulev1.MarkAttendanceRequest{
+		UserId:        userID,
+		SeriesId:      seriesID,
+		OccurrenceId:  occ.Occurrences[1].OccurrenceId,
+		ParticipantId: "p1",
+		Status:        schedulev1.AttendanceStatus_ATTENDANCE_STATUS_ATTENDED,
+	}); err != nil {
+		t.Fatalf("MarkAttendance error: %v", err)
+	}
+	stats, err := clients.v1.GetAttendanceStats(ctx, &schedulev1.GetAttendanceStatsRequest{UserId: userID, SeriesId: seriesID})
+	if err != nil {
+		t.Fatalf("GetAttendanceStats error: %v", err)
+	}
+	if stats.OccurrencesTracked != 1 || len(stats.Participants) != 1 || stats.Participants[0].Attended != 1 {
+		t.Fatalf("stats = %v, want one attended occurrence", stats)
+	}
+
+	// Delete through v1, which runs on the v2 handler, then confirm both
+	// versions see it gone.
+	if _, err := clients.v1.DeleteAppointment(ctx, &schedulev1.DeleteAppointmentRequest{UserId: userID, AppointmentId: apptID}); err != nil {
+		t.Fatalf("DeleteAppointment error: %v", err)
+	}
+	_, err = clients.v1.DeleteAppointment(ctx, &schedulev1.DeleteAppointmentRequest{UserId: userID, AppointmentId: apptID})
+	if st := status.Convert(err); st.Code() != codes.NotFound || len(st.Details()) != 0 {
+		t.Fatalf("second v1 delete = %s with %d details, want NotFound without details", st.Code(), len(st.Details()))
+	}
+	_, err = clients.v2.DeleteAppointment(ctx, &schedulev2.DeleteAppointmentRequest{UserId: userID, AppointmentId: apptID})
+	st := status.Convert(err)
+	if st.Code() != codes.NotFound || len(st.Details()) != 1 {
+		t.Fatalf("v2 delete = %s with %d details, want NotFound with ErrorInfo", st.Code(), len(st.Details()))
+	}
+	if info, ok := st.Details()[0].(*errdetails.ErrorInfo); !ok || info.Reason != grpcTransport.ReasonAppointmentNotFound {
+		t.Fatalf("v2 delete detail = %v, want %s", st.Details()[0], grpcTransport.ReasonAppointmentNotFound)
+	}
+
+	v2List, err := clients.v2.ListAppointments(ctx, &schedulev2.ListAppointmentsRequest{UserId: userID, WindowStart: windowStart, WindowEnd: windowEnd})
+	if err != nil {
+		t.Fatalf("v2 ListAppointments error: %v", err)
+	}
+	if len(v2List.Appointments) != 0 {
+		t.Fatalf("v2 listed %d appointments after delete, want 0", len(v2List.Appointments))
+	}
+}
+
+func TestE2E_Conformance(t *testing.T) {
+	clients := startE2EServer(t)
+
+	scenarios, err := conformance.Load()
+	if err != nil {
+		t.Fatalf("Load error: %v", err)
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+	for _, r := range conformance.Run(ctx, clients.conn, scenarios, func() string { return e2eUserID(t) }) {
+		if r.Err != nil {
+			t.Errorf("%s: %s: %v", r.Scenario, r.Step, r.Err)
+		}
+	}
+}
@@ -2,24 +2,44 @@ package main
 
 import (
 	"context"
+	"database/sql/driver"
 	"errors"
+	"fmt"
 	"log/slog"
 	"net"
+	"net/http"
 	"net/url"
 	"os"
 	"os/signal"
+	"path"
 	"strconv"
 	"strings"
 	"syscall"
 	"time"
 
+	"github.com/uptrace/bun"
 	"google.golang.org/grpc"
+	"google.golang.org/protobuf/reflect/protoreflect"
 
 	"schedula/backend/internal/config"
+	"schedula/backend/internal/conflicts"
+	"schedula/backend/internal/domain"
+	"schedula/backend/internal/faults"
 	schedulev1 "schedula/backend/internal/gen/proto/schedula/v1"
+	schedulev2 "schedula/backend/internal/gen/proto/schedula/v2"
+	"schedula/backend/internal/identity"
+	"schedula/backend/internal/lifecycle"
+	"schedula/backend/internal/lockwait"
+	"schedula/backend/internal/logscope"
 	"schedula/backend/internal/service/appointments"
+	"schedula/backend/internal/sli"
 	"schedula/backend/internal/store/postgres"
+	"schedula/backend/internal/timepolicy"
 	grpcTransport "schedula/backend/internal/transport/grpc"
+	"schedula/backend/internal/transport/grpc/middleware"
+	"schedula/backend/internal/transport/httpapi"
+	"schedula/backend/internal/usage"
+	"schedula/backend/migrations"
 )
 
 func main() {
@@ -34,7 +54,14 @@ func main() {
 		os.Exit(1)
 	}
 
-	log = slog.New(slog.NewJSONHandler(os.Stdout, &slog.HandlerOptions{Level: parseLogLevel(cfg.LogLevel)})).With(
+	// The JSON handler takes every level; the scope handler applies the
+	// configured one, except for users and requests under a log target.
+	logTargets := logscope.NewTargets()
+	log = slog.New(logscope.NewHandler(
+		slog.NewJSONHandler(os.Stdout, &slog.HandlerOptions{Level: slog.LevelDebug}),
+		parseLogLevel(cfg.LogLevel),
+		logTargets,
+	)).With(
 		slog.String("service", "schedula-server"),
 	)
 	slog.SetDefault(log)
@@ -45,14 +72,50 @@ func main() {
 		slog.String("grpc_host", cfg.GRPCHost),
 		slog.Int("grpc_port", cfg.GRPCPort),
 		slog.String("log_level", cfg.LogLevel),
+		slog.String("region", cfg.Region),
+		slog.Bool("read_only", cfg.ReadOnly),
 	)
 
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	var wrapConnector func(driver.Connector) driver.Connector
+	if cfg.Faults.Active() {
+		if faults.Enabled {
+			injector := faults.NewInjector(cfg.Faults)
+			wrapConnector = func(c driver.Connector) driver.Connector { return faults.Wrap(c, injector) }
+			log.Warn("database fault injection enabled",
+				slog.Float64("delay_rate", cfg.Faults.DelayRate),
+				slog.Duration("max_delay", cfg.Faults.MaxDelay),
+				slog.Float64("serialization_rate", cfg.Faults.SerializationRate),
+				slog.Float64("drop_rate", cfg.Faults.DropRate),
+			)
+		} else {
+			log.Warn("fault injection configured but this binary was built without the faults tag; ignoring")
+		}
+	}
+
 	log.Info("connecting to database", databaseLogArgs(cfg.DatabaseURL)...)
-	db, err := postgres.Open(cfg.DatabaseURL, postgres.PoolConfig{
+	var connectRetries int64
+	db, err := postgres.OpenWithRetry(ctx, cfg.DatabaseURL, postgres.PoolConfig{
 		MaxOpenConns:    cfg.DBMaxOpenConns,
 		MaxIdleConns:    cfg.DBMaxIdleConns,
 		ConnMaxLifetime: cfg.DBConnMaxLifetime,
 		ConnMaxIdleTime: cfg.DBConnMaxIdleTime,
+		ReadOnly:        cfg.ReadOnly,
+		WrapConnector:   wrapConnector,
+	}, postgres.RetryConfig{
+		MaxWait:        cfg.DBConnectMaxWait,
+		InitialBackoff: cfg.DBConnectBackoff,
+		MaxBackoff:     cfg.DBConnectMaxDelay,
+		OnRetry: func(attempt int, wait time.Duration, err error) {
+			connectRetries++
+			log.Warn("database not reachable; retrying",
+				slog.Any("err", err),
+				slog.Int("attempt", attempt),
+				slog.Duration("retry_in", wait),
+			)
+		},
 	})
 	if err != nil {
 		args := append([]any{slog.Any("err", err)}, databaseLogArgs(cfg.DatabaseURL)...)
@@ -65,13 +128,85 @@ func main() {
 		}
 	}()
 
-	repo := postgres.NewAppointmentRepo(db)
-	svc := appointments.NewService(repo)
+	if err := checkMigrations(log, db, cfg.MigrationCheck); err != nil {
+		log.Error("database schema check failed", slog.Any("err", err))
+		os.Exit(1)
+	}
 
-	grpcServer := grpc.NewServer(
-		grpc.UnaryInterceptor(defaultRequestTimeoutInterceptor(cfg.GRPCRequestTimeout)),
-	)
-	schedulev1.RegisterAppointmentsServiceServer(grpcServer, grpcTransport.NewAppointmentsServer(svc, log))
+	group := lifecycle.NewGroup(log)
+	group.AddJob("pool-stats", func(ctx context.Context) error {
+		logPoolStats(ctx, log, db, cfg.DBStatsInterval)
+		return nil
+	})
+
+	if cfg.DBPlanCheck {
+		log.Warn("query plan checks enabled; list queries will run EXPLAIN first")
+	}
+	locks := lockwait.New(0, 0, 0, 0)
+	pool := postgres.NewPoolMetrics(db.Stats, connectRetries)
+	domain.SetExpansionLimits(domain.ExpansionLimits{MaxWeeks: cfg.ExpansionMaxWeeks, MaxOccurrences: cfg.ExpansionMaxOccs})
+	domain.SetLegacyOccurrenceIDCutoff(cfg.LegacyIDsUntil)
+	// Load has already checked the name.
+	conflictPolicy, _ := conflicts.Lookup(cfg.ConflictPolicy)
+	repo := postgres.NewAppointmentRepoWithOptions(db, postgres.RepoOptions{
+		CheckQueryPlans: cfg.DBPlanCheck,
+		ObserveLockWait: observeLockWait(log, locks, cfg.DBSlowLockWait),
+		ConflictPolicy:  conflictPolicy,
+	})
+	svc := appointments.NewServiceWithLimits(repo, cfg.Limits)
+
+	// Replicas cannot delete, so expired holds, retention purges and
+	// exception compaction are left to the primary.
+	if !cfg.ReadOnly {
+		group.AddJob("hold-sweeper", func(ctx context.Context) error {
+			sweepExpiredHolds(ctx, log, svc, cfg.HoldSweepInterval)
+			return nil
+		})
+		group.AddJob("retention-purge", func(ctx context.Context) error {
+			purgeExpiredAppointments(ctx, log, svc, cfg.RetentionInterval, cfg.RetentionDryRun)
+			return nil
+		})
+		group.AddJob("exception-compaction", func(ctx context.Context) error {
+			compactSeriesExceptions(ctx, log, svc, cfg.CompactionInterval, cfg.CompactionAge)
+			return nil
+		})
+		group.AddJob("day-summary-refresh", func(ctx context.Context) error {
+			refreshDaySummaries(ctx, log, svc, cfg.SummaryInterval)
+			return nil
+		})
+	}
+
+	if cfg.OccurrenceCacheTTL > 0 {
+		svc.EnableOccurrenceCache(cfg.OccurrenceCacheTTL)
+		group.AddJob("occurrence-cache-refresh", func(ctx context.Context) error {
+			refreshOccurrenceCache(ctx, log, svc, cfg.OccurrenceCacheTTL/2)
+			return nil
+		})
+	}
+	svc.EnableRequestPolicyCache(cfg.PolicyCacheTTL)
+	svc.EnableEmbedTokens([]byte(cfg.EmbedSecret))
+	svc.SetTimePolicy(timepolicy.Policy{Skew: cfg.ClockSkew, MinNotice: cfg.BookingMinNotice})
+	svc.SetPastStartPolicy(domain.PastStartPolicy(cfg.PastStartPolicy))
+	svc.SetRetentionDays(cfg.RetentionDays)
+	svc.SetSnapshotBlobs(postgres.NewBlobRepo(db))
+	// Load has already checked the mode.
+	directory, _ := identity.New(cfg.IdentityMode, repo)
+	svc.SetIdentity(directory)
+	svc.SetExpiryTTLs(appointments.ExpiryTTLs{
+		HoldDefault:     cfg.HoldTTL,
+		HoldMax:         cfg.HoldMaxTTL,
+		ProposalDefault: cfg.ProposalTTL,
+		ProposalMax:     cfg.ProposalMaxTTL,
+	})
+
+	tracker := usage.New(cfg.UsageWindow, 0, 0)
+	slis := sli.New(cfg.SLIWindow, 0)
+	conversions := grpcTransport.NewConversionErrors()
+	grpcServer, err := newGRPCServer(cfg, svc, db, tracker, slis, conversions, logTargets, log)
+	if err != nil {
+		log.Error("grpc interceptor chain invalid", slog.Any("err", err))
+		os.Exit(1)
+	}
 
 	lis, err := net.Listen("tcp", grpcAddr)
 	if err != nil {
@@ -79,29 +214,347 @@ func main() {
 		os.Exit(1)
 	}
 
-	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
-	defer stop()
+	group.AddServer("grpc", func() error { return grpcServer.Serve(lis) }, stopGRPC(grpcServer))
+	log.Info("grpc server started", slog.String("grpc_addr", grpcAddr))
 
-	errCh := make(chan error, 1)
-	go func() {
-		errCh <- grpcServer.Serve(lis)
-	}()
+	// The HTTP listener is optional; it carries the public embed feed and,
+	// when enabled, the metrics scrape endpoint and the API docs.
+	if cfg.HTTPPort > 0 {
+		httpAddr := net.JoinHostPort(cfg.HTTPHost, strconv.Itoa(cfg.HTTPPort))
+		mux := http.NewServeMux()
+		mux.Handle("/", httpapi.NewEmbedHandler(svc, cfg.EmbedCacheMaxAge, log))
+		if cfg.MetricsEnabled {
+			mux.Handle(httpapi.MetricsPath, httpapi.NewMetricsHandler(httpapi.MetricsWriters{tracker, slis, locks, pool, conversions, httpapi.ExpansionLimitMetrics{}, httpapi.LegacyOccurrenceIDMetrics{}}, log))
+		}
+		if cfg.APIDocsEnabled {
+			// Only the public services are documented; AdminService is for
+			// operators.
+			docs, err := httpapi.NewAPIDocsHandler([]protoreflect.FileDescriptor{
+				schedulev1.File_proto_schedula_v1_appointments_proto,
+				schedulev2.File_proto_schedula_v2_appointments_proto,
+			}, cfg.APIExplorer, log)
+			if err != nil {
+				log.Error("api docs failed", slog.Any("err", err))
+				os.Exit(1)
+			}
+			mux.Handle("/api/", docs)
+		}
+		httpServer := &http.Server{
+			Addr:              httpAddr,
+			Handler:           httpapi.Middleware(httpapi.MiddlewareConfig{CORSOrigins: cfg.HTTPCORSOrigins, RequestTimeout: cfg.HTTPRequestTimeout}, log)(mux),
+			ReadHeaderTimeout: 5 * time.Second,
+			WriteTimeout:      cfg.HTTPRequestTimeout + 5*time.Second,
+		}
+		if cfg.EmbedSecret == "" {
+			log.Warn("embed secret not set; embed endpoints will return not found")
+		}
+		group.AddServer("http", httpServer.ListenAndServe, httpServer.Shutdown)
+		log.Info("http server started", slog.String("http_addr", httpAddr))
+	} else {
+		if cfg.MetricsEnabled {
+			log.Warn("metrics enabled but SCHEDULA_HTTP_PORT is not set; metrics will not be served")
+		}
+		if cfg.APIDocsEnabled {
+			log.Warn("api docs enabled but SCHEDULA_HTTP_PORT is not set; docs will not be served")
+		}
+	}
 
-	log.Info("grpc server started", slog.String("grpc_addr", grpcAddr))
+	if err := group.Run(ctx, cfg.ShutdownTimeout); err != nil {
+		log.Error("server stopped with error", slog.Any("err", err))
+		os.Exit(1)
+	}
+}
 
-	select {
-	case <-ctx.Done():
-		log.Info("shutdown signal received")
-		shutdown(log, grpcServer, cfg.ShutdownTimeout)
-	case err := <-errCh:
-		if err != nil && !errors.Is(err, grpc.ErrServerStopped) {
-			log.Error("grpc server stopped with error", slog.Any("err", err))
-			os.Exit(1)
+// newGRPCServer builds the gRPC server with every service registered and
+// the interceptor chain main runs in production. The end-to-end tests boot
+// the same server.
+func newGRPCServer(cfg config.Config, svc *appointments.Service, db *bun.DB, tracker *usage.Tracker, slis *sli.Tracker, conversions *grpcTransport.ConversionErrors, logTargets *logscope.Targets, log *slog.Logger) (*grpc.Server, error) {
+	readMethods := cfg.ReadMethods
+	if len(readMethods) == 0 {
+		readMethods = grpcTransport.DefaultReadMethods
+	}
+	batchMethods := cfg.BatchMethods
+	if len(batchMethods) == 0 {
+		batchMethods = grpcTransport.DefaultBatchMethods
+	}
+	// The replica guard, consistency tokens and calendar versions run last,
+	// next to the handler. Replicas wait on tokens; primaries issue them.
+	readOnly := middleware.Interceptor{Name: "read_only", Required: true}
+	consistency := middleware.Interceptor{Name: "consistency"}
+	conversion := middleware.Interceptor{Name: "conversion"}
+	grpcTransport.SetStrictConversion(cfg.GRPCStrictConvert)
+	if cfg.GRPCStrictConvert {
+		conversion.Unary = grpcTransport.ConversionInterceptor(conversions.Record, log)
+		conversion.Stream = grpcTransport.ConversionStreamInterceptor(conversions.Record, log)
+	}
+	calendarVersion := middleware.Interceptor{Name: "calendar_version"}
+	wal := postgres.NewWALReader(db)
+	if cfg.ReadOnly {
+		readOnly.Unary = grpcTransport.ReadOnlyInterceptor(cfg.Region, cfg.PrimaryRegion, readMethods)
+		consistency.Unary = grpcTransport.ConsistencyWaitInterceptor(wal.Replayed, cfg.Region, cfg.PrimaryRegion, cfg.ConsistencyWait)
+	} else {
+		calendarVersion.Unary = grpcTransport.CalendarVersionInterceptor(svc.CalendarVersion, readMethods, log)
+		if cfg.ConsistencyTokens {
+			consistency.Unary = grpcTransport.ConsistencyTokenInterceptor(wal.Position, readMethods, log)
 		}
 	}
+	// Usage and SLI come first so they count rejections by the rest.
+	available := []middleware.Interceptor{
+		{Name: "usage", Unary: grpcTransport.UsageInterceptor(tracker.Record)},
+		{Name: "sli", Unary: grpcTransport.SLIInterceptor(slis.Record)},
+		{Name: "log_scope", Unary: grpcTransport.LogScopeInterceptor(log)},
+		{Name: "api_version", Unary: grpcTransport.APIVersionInterceptor(grpcTransport.DeprecatedMethods)},
+		{Name: "request_policy", Unary: grpcTransport.RequestPolicyInterceptor(svc.RequestPolicy, log)},
+		{Name: "timeout", Unary: defaultRequestTimeoutInterceptor(cfg.GRPCRequestTimeout, cfg.GRPCMethodTimeouts)},
+		{Name: "lanes", Unary: grpcTransport.LaneInterceptor(batchMethods, grpcTransport.LaneLimits{Interactive: cfg.LaneInteractive, Batch: cfg.LaneBatch}, log)},
+		{Name: "compression", Unary: grpcTransport.CompressionInterceptor(cfg.GRPCCompression, cfg.GRPCCompressMin)},
+		conversion,
+		{Name: "debug_timing", Unary: grpcTransport.DebugTimingInterceptor(cfg.DebugTimingToken)},
+		readOnly,
+		consistency,
+		calendarVersion,
+	}
+	chain, err := middleware.Chain(available, middleware.Config{Order: cfg.GRPCChainOrder, Disabled: cfg.GRPCChainDisabled})
+	if err != nil {
+		return nil, err
+	}
+	log.Info("grpc interceptors", slog.Any("chain", middleware.Names(chain)))
+
+	serverOpts := []grpc.ServerOption{
+		grpc.ChainUnaryInterceptor(middleware.Unary(chain)...),
+		grpc.ChainStreamInterceptor(middleware.Stream(chain)...),
+	}
+	if cfg.Limits.MaxMessageBytes > 0 {
+		serverOpts = append(serverOpts, grpc.MaxRecvMsgSize(cfg.Limits.MaxMessageBytes))
+	}
+	grpcServer := grpc.NewServer(serverOpts...)
+	schedulev1.RegisterAppointmentsServiceServer(grpcServer, grpcTransport.NewAppointmentsServer(svc, log))
+	schedulev2.RegisterAppointmentsServiceServer(grpcServer, grpcTransport.NewAppointmentsV2Server(svc, log))
+	schedulev1.RegisterAdminServiceServer(grpcServer, grpcTransport.NewAdminServer(postgres.NewDiagnosticsReader(db), svc, tracker, svc, svc, slis, logTargets, svc, svc, log))
+	return grpcServer, nil
 }
 
-func defaultRequestTimeoutInterceptor(timeout time.Duration) grpc.UnaryServerInterceptor {
+func checkMigrations(log *slog.Logger, db *bun.DB, mode string) error {
+	if mode == "off" {
+		return nil
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	status, err := postgres.CheckMigrations(ctx, db, migrations.FS)
+	if err != nil {
+		return err
+	}
+
+	args := []any{
+		slog.Int64("schema_version", status.Current),
+		slog.Int64("expected_version", status.Latest),
+		slog.Any("pending", status.Pending),
+		slog.Any("unknown", status.Unknown),
+	}
+	if status.UpToDate() {
+		log.Info("database schema up to date", args...)
+		return nil
+	}
+	if mode == "strict" {
+		return fmt.Errorf("database schema does not match embedded migrations: pending %v, unknown %v", status.Pending, status.Unknown)
+	}
+	log.Warn("database schema does not match embedded migrations", args...)
+	return nil
+}
+
+func logPoolStats(ctx context.Context, log *slog.Logger, db *bun.DB, interval time.Duration) {
+	if interval <= 0 {
+		return
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			stats := db.Stats()
+			log.Debug("database pool stats",
+				slog.Int("open_conns", stats.OpenConnections),
+				slog.Int("in_use", stats.InUse),
+				slog.Int("idle", stats.Idle),
+				slog.Int64("wait_count", stats.WaitCount),
+				slog.Duration("wait_duration", stats.WaitDuration),
+				slog.Int64("max_idle_closed", stats.MaxIdleClosed),
+				slog.Int64("max_lifetime_closed", stats.MaxLifetimeClosed),
+			)
+		}
+	}
+}
+
+// observeLockWait records each calendar lock wait and warns about waits of
+// at least slow, so a hot calendar shows up before its writes time out. A
+// zero slow turns the warning off.
+func observeLockWait(log *slog.Logger, locks *lockwait.Tracker, slow time.Duration) func(ctx context.Context, userID string, wait time.Duration) {
+	return func(ctx context.Context, userID string, wait time.Duration) {
+		locks.Record(userID, wait)
+		if slow > 0 && wait >= slow {
+			log.WarnContext(ctx, "slow calendar lock",
+				slog.String("user_id", userID),
+				slog.Duration("wait", wait),
+			)
+		}
+	}
+}
+
+// sweepExpiredHolds also closes expired proposals, whose holds expire with
+// them.
+func sweepExpiredHolds(ctx context.Context, log *slog.Logger, svc *appointments.Service, interval time.Duration) {
+	if interval <= 0 {
+		return
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if expired, err := svc.ExpireProposals(ctx); err != nil {
+				log.Warn("expired proposal sweep failed", slog.Any("err", err))
+			} else if expired > 0 {
+				log.Debug("proposals expired", slog.Int("count", expired))
+			}
+			deleted, err := svc.DeleteExpiredHolds(ctx)
+			if err != nil {
+				log.Warn("expired hold sweep failed", slog.Any("err", err))
+				continue
+			}
+			if deleted > 0 {
+				log.Debug("expired holds deleted", slog.Int("count", deleted))
+			}
+		}
+	}
+}
+
+// purgeExpiredAppointments deletes appointments past their owner's retention,
+// or with dryRun only logs what it would delete.
+func purgeExpiredAppointments(ctx context.Context, log *slog.Logger, svc *appointments.Service, interval time.Duration, dryRun bool) {
+	if interval <= 0 {
+		return
+	}
+	log = log.With(slog.String("job", "retention-purge"))
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			purged, err := svc.PurgeExpiredAppointments(ctx, dryRun)
+			grpcTransport.LogRetentionPurges(log, purged, dryRun)
+			if err != nil {
+				log.Warn("retention purge failed", slog.Any("err", err))
+			}
+		}
+	}
+}
+
+// compactSeriesExceptions compacts the exceptions of series that ended more
+// than age ago. An age of 0 turns it off.
+func compactSeriesExceptions(ctx context.Context, log *slog.Logger, svc *appointments.Service, interval, age time.Duration) {
+	if interval <= 0 || age <= 0 {
+		return
+	}
+	log = log.With(slog.String("job", "exception-compaction"))
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			out, err := svc.CompactSeriesExceptions(ctx, age)
+			if out.SeriesCompacted > 0 {
+				log.Info("series exceptions compacted",
+					slog.Int("series", out.SeriesCompacted),
+					slog.Int("exceptions_removed", out.ExceptionsRemoved),
+					slog.Int("series_folded", out.SeriesFolded),
+					slog.Int("archived", out.Archived),
+				)
+			}
+			if err != nil {
+				log.Warn("exception compaction failed", slog.Any("err", err))
+			}
+		}
+	}
+}
+
+// daySummaryRefreshBatch caps how many users one refresh tick rebuilds, so a
+// burst of imports is worked off over several ticks.
+const daySummaryRefreshBatch = 100
+
+// refreshDaySummaries rebuilds the stored day summaries of users whose
+// calendar moved on since their last build, a batch at a time, so month
+// views rarely have to rebuild on read.
+func refreshDaySummaries(ctx context.Context, log *slog.Logger, svc *appointments.Service, interval time.Duration) {
+	if interval <= 0 {
+		return
+	}
+	log = log.With(slog.String("job", "day-summary-refresh"))
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			refreshed, err := svc.RefreshDaySummaries(ctx, daySummaryRefreshBatch)
+			if err != nil {
+				log.Warn("day summary refresh failed", slog.Any("err", err))
+			}
+			if refreshed > 0 {
+				log.Debug("day summaries rebuilt", slog.Int("users", refreshed))
+			}
+		}
+	}
+}
+
+// refreshOccurrenceCache reloads active users' cached week at half the cache
+// TTL, so reads from those users never find an expired entry.
+func refreshOccurrenceCache(ctx context.Context, log *slog.Logger, svc *appointments.Service, interval time.Duration) {
+	if interval <= 0 {
+		return
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			refreshed, err := svc.RefreshOccurrenceCache(ctx)
+			if err != nil {
+				log.Warn("occurrence cache refresh failed", slog.Any("err", err))
+				continue
+			}
+			if refreshed > 0 {
+				log.Debug("occurrence cache refreshed", slog.Int("users", refreshed))
+			}
+		}
+	}
+}
+
+func defaultRequestTimeoutInterceptor(timeout time.Duration, methodTimeouts map[string]time.Duration) grpc.UnaryServerInterceptor {
 	if timeout <= 0 {
 		timeout = 10 * time.Second
 	}
@@ -110,31 +563,44 @@ func defaultRequestTimeoutInterceptor(timeout time.Duration) grpc.UnaryServerInt
 		if _, ok := ctx.Deadline(); ok {
 			return handler(ctx, req)
 		}
-		ctx, cancel := context.WithTimeout(ctx, timeout)
+		// A user's request policy can lengthen the timeout, never shorten it.
+		d := max(methodTimeout(info.FullMethod, timeout, methodTimeouts), grpcTransport.RequestPolicyTimeout(ctx))
+		ctx, cancel := context.WithTimeout(ctx, d)
 		defer cancel()
 
 		return handler(ctx, req)
 	}
 }
 
-func shutdown(log *slog.Logger, s *grpc.Server, timeout time.Duration) {
-	log.Info("shutting down grpc server", slog.Duration("timeout", timeout))
-
-	done := make(chan struct{})
-	go func() {
-		s.GracefulStop()
-		close(done)
-	}()
-
-	timer := time.NewTimer(timeout)
-	defer timer.Stop()
+// methodTimeout resolves the timeout for a full method name such as
+// "/schedula.v1.AppointmentsService/ListOccurrences", preferring an exact
+// override, then one keyed by the bare method name.
+func methodTimeout(fullMethod string, fallback time.Duration, overrides map[string]time.Duration) time.Duration {
+	if d, ok := overrides[fullMethod]; ok {
+		return d
+	}
+	if d, ok := overrides[path.Base(fullMethod)]; ok {
+		return d
+	}
+	return fallback
+}
 
-	select {
-	case <-done:
-		log.Info("grpc server stopped")
-	case <-timer.C:
-		log.Warn("grpc graceful shutdown timed out; forcing stop")
-		s.Stop()
+// stopGRPC drains in-flight RPCs, forcing the server closed if they outlast
+// the context.
+func stopGRPC(s *grpc.Server) func(ctx context.Context) error {
+	return func(ctx context.Context) error {
+		done := make(chan struct{})
+		go func() {
+			s.GracefulStop()
+			close(done)
+		}()
+		select {
+		case <-done:
+			return nil
+		case <-ctx.Done():
+			s.Stop()
+			return errors.New("graceful stop timed out; forced stop")
+		}
 	}
 }
 
@@ -0,0 +1,42 @@
+package config
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParseMethodTimeouts(t *testing.T) {
+	got, err := parseMethodTimeouts(" ListOccurrences=30s, /schedula.v1.AppointmentsService/GetRecurringSeries=2s ,")
+	if err != nil {
+		t.Fatalf("parseMethodTimeouts error: %v", err)
+	}
+	want := map[string]time.Duration{
+		"ListOccurrences": 30 * time.Second,
+		"/schedula.v1.AppointmentsService/GetRecurringSeries": 2 * time.Second,
+	}
+	if len(got) != len(want) {
+		t.Fatalf("got %v, want %v", got, want)
+	}
+	for k, v := range want {
+		if got[k] != v {
+			t.Fatalf("got[%q] = %v, want %v", k, got[k], v)
+		}
+	}
+
+	for _, raw := range []string{"ListOccurrences", "=5s", "ListOccurrences=soon", "ListOccurrences=0s"} {
+		if _, err := parseMethodTimeouts(raw); err == nil {
+			t.Fatalf("parseMethodTimeouts(%q) expected error", raw)
+		}
+	}
+}
+
+func TestParseList(t *testing.T) {
+	got := parseList(" ListOccurrences, ,/schedula.v1.AdminService/GetDatabaseDiagnostics ")
+	want := []string{"ListOccurrences", "/schedula.v1.AdminService/GetDatabaseDiagnostics"}
+	if len(got) != len(want) || got[0] != want[0] || got[1] != want[1] {
+		t.Fatalf("got %v, want %v", got, want)
+	}
+	if got := parseList(""); got != nil {
+		t.Fatalf("got %v, want nil", got)
+	}
+}
@@ -0,0 +1,145 @@
+// Package conflicts decides what happens when a booking overlaps something
+// already on the calendar. The store finds the overlaps, under the calendar
+// lock; a Policy only judges them, so deployments can change the rules
+// without touching how calendars are read or locked.
+package conflicts
+
+import (
+	"fmt"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ResourceKey is the metadata key naming the resource, such as a room or a
+// desk, that an appointment or series occupies.
+const ResourceKey = "resource"
+
+// EntryKind says what on the calendar an Entry stands for.
+type EntryKind string
+
+const (
+	EntryAppointment EntryKind = "appointment"
+	EntryOccurrence  EntryKind = "occurrence"
+)
+
+// Entry is a booking, or something already on the calendar, as a policy
+// sees it. ID is the appointment's id, or the series' for an occurrence.
+type Entry struct {
+	Kind     EntryKind
+	ID       uuid.UUID
+	Title    string
+	Start    time.Time
+	End      time.Time
+	Resource string
+}
+
+// Verdict is a policy's answer about one booking.
+type Verdict string
+
+const (
+	// Reject fails the write with a conflict.
+	Reject Verdict = "reject"
+	// Warn stores the booking and reports each overlap as a warning.
+	Warn Verdict = "warn"
+	// Allow stores the booking and says nothing.
+	Allow Verdict = "allow"
+)
+
+// Policy judges a booking that overlaps at least one entry. Decide is
+// called with the booking and every entry it overlaps, never with none, and
+// must not block: it runs while the user's calendar is locked.
+type Policy interface {
+	Name() string
+	Decide(booking Entry, overlapping []Entry) Verdict
+}
+
+// Strict rejects every overlap. It is the default, and what the overlap
+// constraint alone enforced before policies existed.
+type Strict struct{}
+
+func (Strict) Name() string { return "strict" }
+
+func (Strict) Decide(Entry, []Entry) Verdict { return Reject }
+
+// Advisory stores overlapping bookings and warns about each overlap, for
+// deployments where double booking is a choice rather than a mistake.
+type Advisory struct{}
+
+func (Advisory) Name() string { return "advisory" }
+
+func (Advisory) Decide(Entry, []Entry) Verdict { return Warn }
+
+// ResourceAware lets bookings overlap when they occupy different resources,
+// such as two desks in one coworking space. A booking without a resource
+// holds the whole calendar, so it conflicts with everything, as everything
+// conflicts with it.
+type ResourceAware struct{}
+
+func (ResourceAware) Name() string { return "resource" }
+
+func (ResourceAware) Decide(booking Entry, overlapping []Entry) Verdict {
+	for _, e := range overlapping {
+		if booking.Resource == "" || e.Resource == "" || e.Resource == booking.Resource {
+			return Reject
+		}
+	}
+	return Allow
+}
+
+var (
+	mu       sync.RWMutex
+	policies = map[string]Policy{}
+)
+
+func init() {
+	for _, p := range []Policy{Strict{}, Advisory{}, ResourceAware{}} {
+		Register(p)
+	}
+}
+
+// Register makes p selectable by its name. A deployment with rules of its
+// own registers its policy from an init function in a file built into the
+// server, then names it in config. Registering a name twice panics.
+func Register(p Policy) {
+	mu.Lock()
+	defer mu.Unlock()
+	if _, ok := policies[p.Name()]; ok {
+		panic(fmt.Sprintf("conflicts: policy %q registered twice", p.Name()))
+	}
+	policies[p.Name()] = p
+}
+
+// Lookup returns the policy registered under name.
+func Lookup(name string) (Policy, bool) {
+	mu.RLock()
+	defer mu.RUnlock()
+	p, ok := policies[name]
+	return p, ok
+}
+
+// Names lists the registered policies, sorted.
+func Names() []string {
+	mu.RLock()
+	defer mu.RUnlock()
+	out := make([]string, 0, len(policies))
+	for name := range policies {
+		out = append(out, name)
+	}
+	slices.Sort(out)
+	return out
+}
+
+// Overlaps reports whether a and b share time. Spans are '[)', the bounds of
+// the appointments_no_overlap constraint: spans that touch do not overlap,
+// and an empty span overlaps nothing.
+func Overlaps(a, b Entry) bool {
+	return a.Start.Before(b.End) && b.Start.Before(a.End) && a.Start.Before(a.End) && b.Start.Before(b.End)
+}
+
+// Resource returns the resource named in metadata, if any.
+func Resource(metadata map[string]string) string {
+	return metadata[ResourceKey]
+}
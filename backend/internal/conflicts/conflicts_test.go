@@ -0,0 +1,66 @@
+package conflicts
+
+import (
+	"testing"
+	"time"
+)
+
+func TestResourceAware_Decide(t *testing.T) {
+	at := func(h int) time.Time { return time.Date(2026, 1, 5, h, 0, 0, 0, time.UTC) }
+	entry := func(resource string) Entry { return Entry{Start: at(9), End: at(10), Resource: resource} }
+
+	cases := []struct {
+		name     string
+		booking  string
+		existing []string
+		want     Verdict
+	}{
+		{"different desks", "desk-1", []string{"desk-2", "desk-3"}, Allow},
+		{"same desk", "desk-1", []string{"desk-2", "desk-1"}, Reject},
+		{"booking holds the calendar", "", []string{"desk-2"}, Reject},
+		{"existing holds the calendar", "desk-1", []string{""}, Reject},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			var overlapping []Entry
+			for _, r := range c.existing {
+				overlapping = append(overlapping, entry(r))
+			}
+			if got := (ResourceAware{}).Decide(entry(c.booking), overlapping); got != c.want {
+				t.Fatalf("Decide = %q, want %q", got, c.want)
+			}
+		})
+	}
+}
+
+func TestRegistry(t *testing.T) {
+	for _, name := range []string{"strict", "advisory", "resource"} {
+		if p, ok := Lookup(name); !ok || p.Name() != name {
+			t.Fatalf("Lookup(%q) = %v, %v", name, p, ok)
+		}
+	}
+	if _, ok := Lookup("clinic"); ok {
+		t.Fatalf("Lookup found an unregistered policy")
+	}
+	defer func() {
+		if recover() == nil {
+			t.Fatalf("registering strict twice did not panic")
+		}
+	}()
+	Register(Strict{})
+}
+
+func TestOverlaps(t *testing.T) {
+	at := func(h int) time.Time { return time.Date(2026, 1, 5, h, 0, 0, 0, time.UTC) }
+	span := func(start, end int) Entry { return Entry{Start: at(start), End: at(end)} }
+
+	if Overlaps(span(9, 10), span(10, 11)) {
+		t.Fatalf("back-to-back spans overlap")
+	}
+	if !Overlaps(span(9, 11), span(10, 12)) {
+		t.Fatalf("crossing spans do not overlap")
+	}
+	if Overlaps(span(9, 12), span(10, 10)) {
+		t.Fatalf("an empty span overlaps")
+	}
+}
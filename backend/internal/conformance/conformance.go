@@ -0,0 +1,440 @@
+// Package conformance holds golden request and response pairs for the
+// public RPCs and plays them against a running server. The goldens are
+// plain JSON in golden/, in the proto3 JSON mapping with proto field
+// names, so client implementers in any language can read them. Playing
+// them against a deployment checks that it still answers the way the
+// goldens say.
+//
+// A golden file is a scenario: steps run in order under a user id of their
+// own. In a request, "{{user}}" is that id and "{{name}}" is a value an
+// earlier step captured. In a response, "{{*}}" matches any value that is
+// present and "{{name=*}}" also captures it as name. A response matches
+// when every field it lists matches; fields it leaves out, including ones
+// added to the API later, are not compared. A step that expects an error
+// gives its code, and for v2 methods the ErrorInfo reason, instead of a
+// response.
+package conformance
+
+import (
+	"context"
+	"embed"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"path"
+	"reflect"
+	"regexp"
+	"slices"
+	"strings"
+	"unicode"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+
+	_ "schedula/backend/internal/gen/proto/schedula/v1"
+	_ "schedula/backend/internal/gen/proto/schedula/v2"
+)
+
+//go:embed golden/*.json
+var goldenFS embed.FS
+
+// Scenario is one golden file.
+type Scenario struct {
+	// Name is the file name without its extension.
+	Name  string `json:"-"`
+	Steps []Step `json:"steps"`
+}
+
+// Step is one call and the answer it expects: Response or Error, not both.
+type Step struct {
+	Name string `json:"name"`
+	// Method is the full method name without its leading slash, e.g.
+	// "schedula.v1.AppointmentsService/CreateAppointment".
+	Method   string          `json:"method"`
+	Request  json.RawMessage `json:"request"`
+	Response json.RawMessage `json:"response,omitempty"`
+	Error    *ExpectedError  `json:"error,omitempty"`
+
+	method protoreflect.MethodDescriptor
+}
+
+// ExpectedError is a failed call. Code is the canonical code name, such as
+// "INVALID_ARGUMENT".
+type ExpectedError struct {
+	Code   string `json:"code"`
+	Reason string `json:"reason,omitempty"`
+}
+
+// Result is the outcome of one step. Err is nil when the step passed.
+type Result struct {
+	Scenario string
+	Step     string
+	Err      error
+}
+
+var placeholder = regexp.MustCompile(`^\{\{([a-z_]+)(=\*)?\}\}$|^\{\{\*\}\}$`)
+
+// Load reads the embedded goldens, sorted by name, and checks that every
+// step names a unary method this build knows, that its request parses as
+// that method's input, and that its response only names fields of the
+// output.
+func Load() ([]Scenario, error) {
+	return load(goldenFS, "golden")
+}
+
+func load(fsys fs.FS, dir string) ([]Scenario, error) {
+	entries, err := fs.ReadDir(fsys, dir)
+	if err != nil {
+		return nil, err
+	}
+	var out []Scenario
+	for _, e := range entries {
+		if e.IsDir() || path.Ext(e.Name()) != ".json" {
+			continue
+		}
+		data, err := fs.ReadFile(fsys, path.Join(dir, e.Name()))
+		if err != nil {
+			return nil, err
+		}
+		sc := Scenario{Name: strings.TrimSuffix(e.Name(), ".json")}
+		dec := json.NewDecoder(strings.NewReader(string(data)))
+		dec.DisallowUnknownFields()
+		if err := dec.Decode(&sc); err != nil {
+			return nil, fmt.Errorf("%s: %w", e.Name(), err)
+		}
+		if len(sc.Steps) == 0 {
+			return nil, fmt.Errorf("%s: no steps", e.Name())
+		}
+		for i := range sc.Steps {
+			if err := sc.Steps[i].check(); err != nil {
+				return nil, fmt.Errorf("%s: step %q: %w", e.Name(), sc.Steps[i].Name, err)
+			}
+		}
+		out = append(out, sc)
+	}
+	slices.SortFunc(out, func(a, b Scenario) int { return strings.Compare(a.Name, b.Name) })
+	return out, nil
+}
+
+func (s *Step) check() error {
+	service, method, ok := strings.Cut(s.Method, "/")
+	if !ok {
+		return fmt.Errorf("method %q is not service/method", s.Method)
+	}
+	desc, err := protoregistry.GlobalFiles.FindDescriptorByName(protoreflect.FullName(service))
+	if err != nil {
+		return fmt.Errorf("unknown service %q", service)
+	}
+	sd, ok := desc.(protoreflect.ServiceDescriptor)
+	if !ok {
+		return fmt.Errorf("%q is not a service", service)
+	}
+	md := sd.Methods().ByName(protoreflect.Name(method))
+	if md == nil {
+		return fmt.Errorf("unknown method %q", s.Method)
+	}
+	if md.IsStreamingClient() || md.IsStreamingServer() {
+		return fmt.Errorf("%q is a streaming method", s.Method)
+	}
+	s.method = md
+
+	if (s.Response == nil) == (s.Error == nil) {
+		return errors.New("give exactly one of response and error")
+	}
+	if s.Error != nil && !knownCode(s.Error.Code) {
+		return fmt.Errorf("unknown code %q", s.Error.Code)
+	}
+	// Placeholders are filled with a UUID, since that is what every
+	// captured value so far is.
+	var req any
+	if err := json.Unmarshal(s.Request, &req); err != nil {
+		return fmt.Errorf("request: %w", err)
+	}
+	filled, err := json.Marshal(fill(req))
+	if err != nil {
+		return err
+	}
+	if err := protojson.Unmarshal(filled, newMessage(md.Input())); err != nil {
+		return fmt.Errorf("request: %w", err)
+	}
+	if s.Response != nil {
+		var resp any
+		if err := json.Unmarshal(s.Response, &resp); err != nil {
+			return fmt.Errorf("response: %w", err)
+		}
+		if err := checkFields(resp, md.Output(), "response"); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func fill(v any) any {
+	switch v := v.(type) {
+	case string:
+		if placeholder.MatchString(v) {
+			return "00000000-0000-0000-0000-000000000000"
+		}
+	case map[string]any:
+		for k, e := range v {
+			v[k] = fill(e)
+		}
+	case []any:
+		for i, e := range v {
+			v[i] = fill(e)
+		}
+	}
+	return v
+}
+
+// checkFields reports a response key that is not a field of md, which is
+// most likely a typo that would otherwise never match.
+func checkFields(v any, md protoreflect.MessageDescriptor, at string) error {
+	obj, ok := v.(map[string]any)
+	if !ok || isWellKnown(md) {
+		return nil
+	}
+	for k, e := range obj {
+		fd := md.Fields().ByName(protoreflect.Name(k))
+		if fd == nil {
+			return fmt.Errorf("%s.%s is not a field of %s", at, k, md.FullName())
+		}
+		if fd.Message() == nil || fd.IsMap() {
+			continue
+		}
+		if list, ok := e.([]any); ok && fd.IsList() {
+			for i, item := range list {
+				if err := checkFields(item, fd.Message(), fmt.Sprintf("%s.%s[%d]", at, k, i)); err != nil {
+					return err
+				}
+			}
+			continue
+		}
+		if err := checkFields(e, fd.Message(), at+"."+k); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func isWellKnown(md protoreflect.MessageDescriptor) bool {
+	return md.ParentFile().Package() == "google.protobuf"
+}
+
+func newMessage(md protoreflect.MessageDescriptor) proto.Message {
+	mt, err := protoregistry.GlobalTypes.FindMessageByName(md.FullName())
+	if err != nil {
+		// Every message of a registered file is registered too.
+		panic(err)
+	}
+	return mt.New().Interface()
+}
+
+// Run plays each scenario against conn under a user id from newUserID and
+// returns one result per step. A scenario stops at its first failed step,
+// since later steps usually depend on it.
+func Run(ctx context.Context, conn grpc.ClientConnInterface, scenarios []Scenario, newUserID func() string) []Result {
+	var out []Result
+	for _, sc := range scenarios {
+		vars := map[string]string{"user": newUserID()}
+		for _, step := range sc.Steps {
+			err := step.run(ctx, conn, vars)
+			out = append(out, Result{Scenario: sc.Name, Step: step.Name, Err: err})
+			if err != nil {
+				break
+			}
+		}
+	}
+	return out
+}
+
+func (s Step) run(ctx context.Context, conn grpc.ClientConnInterface, vars map[string]string) error {
+	var req any
+	if err := json.Unmarshal(s.Request, &req); err != nil {
+		return err
+	}
+	req, err := substitute(req, vars)
+	if err != nil {
+		return fmt.Errorf("request: %w", err)
+	}
+	body, err := json.Marshal(req)
+	if err != nil {
+		return err
+	}
+	in := newMessage(s.method.Input())
+	if err := protojson.Unmarshal(body, in); err != nil {
+		return fmt.Errorf("request: %w", err)
+	}
+	out := newMessage(s.method.Output())
+
+	callErr := conn.Invoke(ctx, "/"+s.Method, in, out)
+	if s.Error != nil {
+		return matchError(s.Error, callErr)
+	}
+	if callErr != nil {
+		return fmt.Errorf("call failed: %w", callErr)
+	}
+	got, err := protojson.MarshalOptions{UseProtoNames: true}.Marshal(out)
+	if err != nil {
+		return err
+	}
+	var actual, expected any
+	if err := json.Unmarshal(got, &actual); err != nil {
+		return err
+	}
+	if err := json.Unmarshal(s.Response, &expected); err != nil {
+		return err
+	}
+	if err := match(expected, actual, "response", vars); err != nil {
+		return fmt.Errorf("%w; got %s", err, got)
+	}
+	return nil
+}
+
+func matchError(want *ExpectedError, err error) error {
+	if err == nil {
+		return fmt.Errorf("call succeeded, want %s", want.Code)
+	}
+	st := status.Convert(err)
+	if got := codeName(st.Code()); got != want.Code {
+		return fmt.Errorf("code %s (%s), want %s", got, st.Message(), want.Code)
+	}
+	if want.Reason == "" {
+		return nil
+	}
+	for _, d := range st.Details() {
+		if info, ok := d.(*errdetails.ErrorInfo); ok {
+			if info.Reason != want.Reason {
+				return fmt.Errorf("reason %s, want %s", info.Reason, want.Reason)
+			}
+			return nil
+		}
+	}
+	return fmt.Errorf("no ErrorInfo, want reason %s", want.Reason)
+}
+
+// substitute replaces "{{name}}" strings with their variables.
+func substitute(v any, vars map[string]string) (any, error) {
+	switch v := v.(type) {
+	case string:
+		m := placeholder.FindStringSubmatch(v)
+		if m == nil {
+			return v, nil
+		}
+		if m[1] == "" || m[2] != "" {
+			return nil, fmt.Errorf("%s is only allowed in responses", v)
+		}
+		val, ok := vars[m[1]]
+		if !ok {
+			return nil, fmt.Errorf("nothing captured as %s", m[1])
+		}
+		return val, nil
+	case map[string]any:
+		for k, e := range v {
+			s, err := substitute(e, vars)
+			if err != nil {
+				return nil, err
+			}
+			v[k] = s
+		}
+	case []any:
+		for i, e := range v {
+			s, err := substitute(e, vars)
+			if err != nil {
+				return nil, err
+			}
+			v[i] = s
+		}
+	}
+	return v, nil
+}
+
+// match checks actual against the expected golden value, capturing into
+// vars as it goes.
+func match(expected, actual any, at string, vars map[string]string) error {
+	if s, ok := expected.(string); ok {
+		if m := placeholder.FindStringSubmatch(s); m != nil {
+			if actual == nil {
+				return fmt.Errorf("%s is missing", at)
+			}
+			if m[1] == "" || m[2] != "" {
+				if m[1] != "" {
+					got, ok := actual.(string)
+					if !ok {
+						return fmt.Errorf("%s is %v, not a string to capture", at, actual)
+					}
+					vars[m[1]] = got
+				}
+				return nil
+			}
+			val, ok := vars[m[1]]
+			if !ok {
+				return fmt.Errorf("%s: nothing captured as %s", at, m[1])
+			}
+			expected = val
+		}
+	}
+	switch want := expected.(type) {
+	case map[string]any:
+		got, ok := actual.(map[string]any)
+		if !ok {
+			return fmt.Errorf("%s is %v, want an object", at, actual)
+		}
+		keys := make([]string, 0, len(want))
+		for k := range want {
+			keys = append(keys, k)
+		}
+		slices.Sort(keys)
+		for _, k := range keys {
+			if err := match(want[k], got[k], at+"."+k, vars); err != nil {
+				return err
+			}
+		}
+		return nil
+	case []any:
+		got, ok := actual.([]any)
+		if !ok || len(got) != len(want) {
+			return fmt.Errorf("%s is %v, want %d items", at, actual, len(want))
+		}
+		for i := range want {
+			if err := match(want[i], got[i], fmt.Sprintf("%s[%d]", at, i), vars); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	if !reflect.DeepEqual(expected, actual) {
+		return fmt.Errorf("%s is %v, want %v", at, actual, expected)
+	}
+	return nil
+}
+
+// codeName returns the canonical name of c, e.g. "INVALID_ARGUMENT".
+func codeName(c codes.Code) string {
+	var b strings.Builder
+	prev := rune(0)
+	for _, r := range c.String() {
+		if unicode.IsUpper(r) && unicode.IsLower(prev) {
+			b.WriteByte('_')
+		}
+		b.WriteRune(unicode.ToUpper(r))
+		prev = r
+	}
+	return b.String()
+}
+
+func knownCode(name string) bool {
+	for c := codes.OK; c <= codes.Unauthenticated; c++ {
+		if codeName(c) == name {
+			return true
+		}
+	}
+	return false
+}
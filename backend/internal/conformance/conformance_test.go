@@ -0,0 +1,119 @@
+package conformance
+
+import (
+	"strings"
+	"testing"
+	"testing/fstest"
+
+	"google.golang.org/grpc/codes"
+)
+
+func TestLoad_Goldens(t *testing.T) {
+	scenarios, err := Load()
+	if err != nil {
+		t.Fatalf("Load error: %v", err)
+	}
+	if len(scenarios) == 0 {
+		t.Fatalf("no scenarios")
+	}
+	for _, sc := range scenarios {
+		for _, step := range sc.Steps {
+			if step.method == nil {
+				t.Fatalf("%s: %s: method not resolved", sc.Name, step.Name)
+			}
+		}
+	}
+}
+
+func TestLoad_RejectsBadGoldens(t *testing.T) {
+	tests := []struct {
+		name   string
+		golden string
+		want   string
+	}{
+		{"unknown method", `{"steps": [{"name": "s", "method": "schedula.v1.AppointmentsService/Nope", "request": {}, "response": {}}]}`, "unknown method"},
+		{"both outcomes", `{"steps": [{"name": "s", "method": "schedula.v1.AppointmentsService/GetLimits", "request": {}, "response": {}, "error": {"code": "NOT_FOUND"}}]}`, "exactly one"},
+		{"unknown code", `{"steps": [{"name": "s", "method": "schedula.v1.AppointmentsService/GetLimits", "request": {}, "error": {"code": "NOPE"}}]}`, "unknown code"},
+		{"bad request field", `{"steps": [{"name": "s", "method": "schedula.v1.AppointmentsService/GetLimits", "request": {"nope": 1}, "response": {}}]}`, "request"},
+		{"bad response field", `{"steps": [{"name": "s", "method": "schedula.v1.AppointmentsService/CreateAppointment", "request": {}, "response": {"appointment": {"nope": "{{*}}"}}}]}`, "response.appointment.nope"},
+		{"unknown key", `{"steps": [], "extra": true}`, "unknown field"},
+		{"no steps", `{"steps": []}`, "no steps"},
+	}
+	for _, tt := range tests {
+		fsys := fstest.MapFS{"golden/bad.json": {Data: []byte(tt.golden)}}
+		_, err := load(fsys, "golden")
+		if err == nil || !strings.Contains(err.Error(), tt.want) {
+			t.Fatalf("%s: error = %v, want it to mention %q", tt.name, err, tt.want)
+		}
+	}
+}
+
+func TestMatch_PlaceholdersAndSubsets(t *testing.T) {
+	vars := map[string]string{"user": "u1"}
+	expected := map[string]any{
+		"id":      "{{appt=*}}",
+		"user_id": "{{user}}",
+		"created": "{{*}}",
+		"tags":    []any{"a", "b"},
+	}
+	actual := map[string]any{
+		"id":      "abc",
+		"user_id": "u1",
+		"created": "2031-03-03T09:00:00Z",
+		"tags":    []any{"a", "b"},
+		"extra":   "ignored",
+	}
+	if err := match(expected, actual, "response", vars); err != nil {
+		t.Fatalf("match error: %v", err)
+	}
+	if vars["appt"] != "abc" {
+		t.Fatalf("captured appt = %q, want abc", vars["appt"])
+	}
+
+	tests := []struct {
+		name     string
+		expected any
+		actual   any
+	}{
+		{"missing wildcard", map[string]any{"id": "{{*}}"}, map[string]any{}},
+		{"wrong variable", map[string]any{"user_id": "{{user}}"}, map[string]any{"user_id": "u2"}},
+		{"extra item", []any{"a"}, []any{"a", "b"}},
+		{"wrong value", map[string]any{"title": "x"}, map[string]any{"title": "y"}},
+	}
+	for _, tt := range tests {
+		if err := match(tt.expected, tt.actual, "response", vars); err == nil {
+			t.Fatalf("%s: match succeeded", tt.name)
+		}
+	}
+}
+
+func TestSubstitute(t *testing.T) {
+	vars := map[string]string{"user": "u1"}
+	got, err := substitute(map[string]any{"user_id": "{{user}}", "ids": []any{"{{user}}", "plain"}}, vars)
+	if err != nil {
+		t.Fatalf("substitute error: %v", err)
+	}
+	m := got.(map[string]any)
+	if m["user_id"] != "u1" || m["ids"].([]any)[0] != "u1" || m["ids"].([]any)[1] != "plain" {
+		t.Fatalf("substitute = %v", got)
+	}
+	if _, err := substitute("{{missing}}", vars); err == nil {
+		t.Fatalf("substitute of an uncaptured variable succeeded")
+	}
+	if _, err := substitute("{{*}}", vars); err == nil {
+		t.Fatalf("substitute of a wildcard succeeded")
+	}
+}
+
+func TestCodeName(t *testing.T) {
+	for c, want := range map[codes.Code]string{
+		codes.OK:                 "OK",
+		codes.InvalidArgument:    "INVALID_ARGUMENT",
+		codes.FailedPrecondition: "FAILED_PRECONDITION",
+		codes.Unauthenticated:    "UNAUTHENTICATED",
+	} {
+		if got := codeName(c); got != want {
+			t.Fatalf("codeName(%v) = %q, want %q", c, got, want)
+		}
+	}
+}
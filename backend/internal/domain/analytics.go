@@ -0,0 +1,27 @@
+package domain
+
+import "time"
+
+// UserAnalytics summarizes a user's calendar over a reporting period.
+type UserAnalytics struct {
+	AppointmentCount int
+	AverageDuration  time.Duration
+	Attended         int
+	Missed           int
+
+	// Sessions counts appointments both checked in and out. PlannedTime and
+	// ActualTime sum their booked and checked-in lengths.
+	Sessions    int
+	PlannedTime time.Duration
+	ActualTime  time.Duration
+}
+
+// NoShowRate is the share of tracked attendance marked missed, or zero when
+// nothing was tracked.
+func (a UserAnalytics) NoShowRate() float64 {
+	total := a.Attended + a.Missed
+	if total == 0 {
+		return 0
+	}
+	return float64(a.Missed) / float64(total)
+}
@@ -19,6 +19,103 @@ type Appointment struct {
 	EndTime   time.Time `bun:"end_time,notnull"`
 	CreatedAt time.Time `bun:"created_at,notnull"`
 	UpdatedAt time.Time `bun:"updated_at,notnull"`
+
+	Metadata       map[string]string `bun:"metadata,type:jsonb,nullzero"`
+	ExternalSystem string            `bun:"external_system,nullzero"`
+	ExternalID     string            `bun:"external_id,nullzero"`
+
+	// PrivateNotes are for the calendar's owner alone; see VisibleTo.
+	PrivateNotes string `bun:"private_notes"`
+
+	// Timezone is the IANA zone the appointment was booked in, if the client
+	// sent one. Times are still stored and compared in UTC.
+	Timezone string `bun:"timezone,nullzero"`
+
+	// CreatedBy is the delegate who created the appointment on UserID's
+	// behalf, or empty when the user created it.
+	CreatedBy string `bun:"created_by,nullzero"`
+
+	// CheckedInAt and CheckedOutAt are when the session actually started and
+	// ended, or nil until recorded.
+	CheckedInAt  *time.Time `bun:"checked_in_at"`
+	CheckedOutAt *time.Time `bun:"checked_out_at"`
+
+	// ContactID links the appointment to one of the user's contacts.
+	ContactID *uuid.UUID `bun:"contact_id,type:uuid"`
+
+	// ProgramID puts the appointment in one of the user's programs.
+	ProgramID *uuid.UUID `bun:"program_id,type:uuid"`
+
+	// Source records which path created the appointment; see the Source
+	// constants. It is empty for appointments that predate it.
+	Source string `bun:"source,nullzero"`
+
+	// Kind is AppointmentKindEvent or AppointmentKindMilestone. Rows
+	// inserted without one take the column default, event.
+	Kind AppointmentKind `bun:"kind,nullzero"`
+
+	// OverlapAllowed marks a row the conflict policy let overlap another,
+	// which the overlap constraint then leaves out. Only the store sets it.
+	OverlapAllowed bool `bun:"overlap_allowed"`
+
+	// BlackoutWarnings lists warn-mode blackouts the appointment overlaps.
+	// It is only set on the result of a create.
+	BlackoutWarnings []Blackout `bun:"-"`
+	// PastStartWarning reports that the appointment started before it was
+	// created under a warn policy. It is only set on the result of a create.
+	PastStartWarning bool `bun:"-"`
+	// Warnings collects every advisory about the write, the two above
+	// included. It is only set on the result of a create.
+	Warnings []Warning `bun:"-"`
+	// SourceDefault is the owner's default that decorated the title or
+	// notes. It is only set on the result of a create.
+	SourceDefault *SourceDefault `bun:"-"`
+}
+
+// Appointment sources. A sync source is SourceSyncPrefix followed by the
+// external system, as in "sync:google".
+const (
+	SourceManual     = "manual"
+	SourceBooking    = "booking"
+	SourceOffline    = "offline"
+	SourceImport     = "import"
+	SourceProposal   = "proposal"
+	SourceArchive    = "archive"
+	SourceSyncPrefix = "sync:"
+)
+
+func SyncSource(system string) string {
+	return SourceSyncPrefix + system
+}
+
+// AppointmentKind separates bookings that occupy time from milestones,
+// zero-duration markers such as deadlines and reminders.
+type AppointmentKind string
+
+const (
+	AppointmentKindEvent     AppointmentKind = "event"
+	AppointmentKindMilestone AppointmentKind = "milestone"
+)
+
+func (k AppointmentKind) Valid() bool {
+	return k == AppointmentKindEvent || k == AppointmentKindMilestone
+}
+
+// Milestone reports whether the appointment is a milestone. A milestone
+// starts and ends at the same instant, so it never conflicts with or blocks
+// other bookings.
+func (a Appointment) Milestone() bool {
+	return a.Kind == AppointmentKindMilestone
+}
+
+// VisibleTo returns the appointment as viewerID may see it. Anyone but the
+// owner, such as a delegate, gets it without its private notes. An empty
+// viewer is the owner, as an empty actor is everywhere else.
+func (a Appointment) VisibleTo(viewerID string) Appointment {
+	if viewerID != "" && viewerID != a.UserID {
+		a.PrivateNotes = ""
+	}
+	return a
 }
 
 func (a *Appointment) BeforeAppendModel(ctx context.Context, query bun.Query) error {
@@ -43,3 +140,12 @@ func (a *Appointment) BeforeAppendModel(ctx context.Context, query bun.Query) er
 	}
 	return nil
 }
+
+// ActualDuration is the checked-in session length, reported only once the
+// appointment has been checked out.
+func (a Appointment) ActualDuration() (time.Duration, bool) {
+	if a.CheckedInAt == nil || a.CheckedOutAt == nil {
+		return 0, false
+	}
+	return a.CheckedOutAt.Sub(*a.CheckedInAt), true
+}
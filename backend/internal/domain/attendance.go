@@ -0,0 +1,100 @@
+package domain
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+)
+
+type AttendanceStatus string
+
+const (
+	AttendanceStatusAttended AttendanceStatus = "attended"
+	AttendanceStatusMissed   AttendanceStatus = "missed"
+)
+
+// OccurrenceAttendance records whether a participant attended one occurrence
+// of a recurring series. Occurrences are keyed the same way as exceptions, by
+// series and original occurrence start.
+type OccurrenceAttendance struct {
+	bun.BaseModel `bun:"table:occurrence_attendance"`
+
+	ID              uuid.UUID        `bun:"id,pk,type:uuid"`
+	SeriesID        uuid.UUID        `bun:"series_id,notnull,type:uuid"`
+	OccurrenceStart time.Time        `bun:"occurrence_start,notnull"`
+	ParticipantID   string           `bun:"participant_id,notnull"`
+	Status          AttendanceStatus `bun:"status,notnull"`
+	CreatedAt       time.Time        `bun:"created_at,notnull"`
+	UpdatedAt       time.Time        `bun:"updated_at,notnull"`
+}
+
+func (a *OccurrenceAttendance) BeforeAppendModel(ctx context.Context, query bun.Query) error {
+	now := time.Now().UTC()
+	switch query.(type) {
+	case *bun.InsertQuery:
+		if a.ID == uuid.Nil {
+			id, err := uuid.NewV7()
+			if err != nil {
+				return err
+			}
+			a.ID = id
+		}
+		if a.CreatedAt.IsZero() {
+			a.CreatedAt = now
+		}
+		if a.UpdatedAt.IsZero() {
+			a.UpdatedAt = now
+		}
+	case *bun.UpdateQuery:
+		a.UpdatedAt = now
+	}
+	return nil
+}
+
+type ParticipantAttendance struct {
+	ParticipantID string
+	Attended      int
+	Missed        int
+}
+
+type AttendanceStats struct {
+	Participants       []ParticipantAttendance
+	OccurrencesTracked int
+}
+
+// SummarizeAttendance tallies attendance records per participant, ordered by
+// participant id.
+func SummarizeAttendance(records []OccurrenceAttendance) AttendanceStats {
+	byParticipant := make(map[string]*ParticipantAttendance)
+	occurrences := make(map[int64]struct{})
+	for _, r := range records {
+		occurrences[r.OccurrenceStart.UTC().UnixNano()] = struct{}{}
+
+		p, ok := byParticipant[r.ParticipantID]
+		if !ok {
+			p = &ParticipantAttendance{ParticipantID: r.ParticipantID}
+			byParticipant[r.ParticipantID] = p
+		}
+		switch r.Status {
+		case AttendanceStatusAttended:
+			p.Attended++
+		case AttendanceStatusMissed:
+			p.Missed++
+		}
+	}
+
+	out := AttendanceStats{
+		Participants:       make([]ParticipantAttendance, 0, len(byParticipant)),
+		OccurrencesTracked: len(occurrences),
+	}
+	for _, p := range byParticipant {
+		out.Participants = append(out.Participants, *p)
+	}
+	sort.Slice(out.Participants, func(i, j int) bool {
+		return out.Participants[i].ParticipantID < out.Participants[j].ParticipantID
+	})
+	return out
+}
@@ -0,0 +1,34 @@
+package domain
+
+import (
+	"testing"
+	"time"
+)
+
+func TestSummarizeAttendance(t *testing.T) {
+	first := time.Date(2026, 1, 5, 9, 0, 0, 0, time.UTC)
+	second := first.Add(7 * 24 * time.Hour)
+
+	stats := SummarizeAttendance([]OccurrenceAttendance{
+		{OccurrenceStart: first, ParticipantID: "p2", Status: AttendanceStatusAttended},
+		{OccurrenceStart: first, ParticipantID: "p1", Status: AttendanceStatusMissed},
+		{OccurrenceStart: second, ParticipantID: "p1", Status: AttendanceStatusAttended},
+		{OccurrenceStart: second, ParticipantID: "p2", Status: AttendanceStatusAttended},
+	})
+
+	if stats.OccurrencesTracked != 2 {
+		t.Fatalf("occurrences tracked = %d, want 2", stats.OccurrencesTracked)
+	}
+	want := []ParticipantAttendance{
+		{ParticipantID: "p1", Attended: 1, Missed: 1},
+		{ParticipantID: "p2", Attended: 2, Missed: 0},
+	}
+	if len(stats.Participants) != len(want) {
+		t.Fatalf("len(participants) = %d, want %d", len(stats.Participants), len(want))
+	}
+	for i := range want {
+		if stats.Participants[i] != want[i] {
+			t.Fatalf("participants[%d] = %+v, want %+v", i, stats.Participants[i], want[i])
+		}
+	}
+}
@@ -0,0 +1,57 @@
+package domain
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+)
+
+type BlackoutMode string
+
+const (
+	// BlackoutModeBlock rejects bookings that overlap the window.
+	BlackoutModeBlock BlackoutMode = "block"
+	// BlackoutModeWarn allows them but reports the overlap to the caller.
+	BlackoutModeWarn BlackoutMode = "warn"
+)
+
+func (m BlackoutMode) Valid() bool {
+	return m == BlackoutModeBlock || m == BlackoutModeWarn
+}
+
+// Blackout is an admin-managed window, such as a company holiday or a
+// maintenance period, that applies to every calendar.
+type Blackout struct {
+	bun.BaseModel `bun:"table:blackouts"`
+
+	ID        uuid.UUID    `bun:"id,pk,type:uuid"`
+	Title     string       `bun:"title,notnull"`
+	StartTime time.Time    `bun:"start_time,notnull"`
+	EndTime   time.Time    `bun:"end_time,notnull"`
+	Mode      BlackoutMode `bun:"mode,notnull"`
+	CreatedAt time.Time    `bun:"created_at,notnull"`
+}
+
+func (b *Blackout) BeforeAppendModel(ctx context.Context, query bun.Query) error {
+	if _, ok := query.(*bun.InsertQuery); !ok {
+		return nil
+	}
+	if b.ID == uuid.Nil {
+		id, err := uuid.NewV7()
+		if err != nil {
+			return err
+		}
+		b.ID = id
+	}
+	if b.CreatedAt.IsZero() {
+		b.CreatedAt = time.Now().UTC()
+	}
+	return nil
+}
+
+// Overlaps reports whether [start, end) intersects the blackout.
+func (b Blackout) Overlaps(start, end time.Time) bool {
+	return Overlaps(start, end, b.StartTime, b.EndTime)
+}
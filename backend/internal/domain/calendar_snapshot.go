@@ -0,0 +1,30 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+)
+
+// CalendarSnapshotInfo describes a saved copy of a user's whole calendar. The
+// copy itself is a calendar bundle kept in blob storage under BlobKey; this
+// row is what listing snapshots reads, so it carries the bundle's counts.
+type CalendarSnapshotInfo struct {
+	bun.BaseModel `bun:"table:calendar_snapshots"`
+
+	ID     uuid.UUID `bun:"id,pk,type:uuid"`
+	UserID string    `bun:"user_id,notnull"`
+	// Label is the caller's note, such as "before term import".
+	Label string `bun:"label,nullzero"`
+	// FormatVersion is the bundle version the blob was written in.
+	FormatVersion int       `bun:"format_version,notnull"`
+	BlobKey       string    `bun:"blob_key,notnull"`
+	Checksum      string    `bun:"checksum,notnull"`
+	SizeBytes     int       `bun:"size_bytes,notnull"`
+	Contacts      int       `bun:"contacts,notnull"`
+	Appointments  int       `bun:"appointments,notnull"`
+	Series        int       `bun:"series,notnull"`
+	Exceptions    int       `bun:"exceptions,notnull"`
+	CreatedAt     time.Time `bun:"created_at,notnull"`
+}
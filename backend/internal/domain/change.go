@@ -0,0 +1,56 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+)
+
+type ChangeEntity string
+
+const (
+	ChangeEntityAppointment ChangeEntity = "appointment"
+	// ChangeEntitySeries covers the series row and its exceptions, since both
+	// change the series' occurrences.
+	ChangeEntitySeries ChangeEntity = "series"
+	// ChangeEntityHold and ChangeEntityProposal are only logged when they
+	// expire.
+	ChangeEntityHold     ChangeEntity = "hold"
+	ChangeEntityProposal ChangeEntity = "proposal"
+)
+
+type ChangeOp string
+
+const (
+	ChangeOpCreated ChangeOp = "created"
+	ChangeOpUpdated ChangeOp = "updated"
+	ChangeOpDeleted ChangeOp = "deleted"
+	ChangeOpExpired ChangeOp = "expired"
+)
+
+// CalendarVersion counts a user's committed calendar writes. It advances by
+// exactly one per transaction that records changes, so a client that held
+// version N before its own write knows nobody else wrote if it gets N+1 back.
+type CalendarVersion struct {
+	bun.BaseModel `bun:"table:calendar_versions"`
+
+	UserID    string    `bun:"user_id,pk"`
+	Version   int64     `bun:"version,notnull"`
+	XactID    int64     `bun:"xact_id,notnull"`
+	UpdatedAt time.Time `bun:"updated_at,notnull"`
+}
+
+// CalendarChange is one entry in a user's change log. Seq increases in
+// commit order for each user, because every calendar write holds the user's
+// calendar lock.
+type CalendarChange struct {
+	bun.BaseModel `bun:"table:calendar_changes"`
+
+	Seq        int64        `bun:"seq,pk,autoincrement"`
+	UserID     string       `bun:"user_id,notnull"`
+	EntityType ChangeEntity `bun:"entity_type,notnull"`
+	EntityID   uuid.UUID    `bun:"entity_id,notnull,type:uuid"`
+	Op         ChangeOp     `bun:"op,notnull"`
+	ChangedAt  time.Time    `bun:"changed_at,notnull,default:current_timestamp"`
+}
@@ -0,0 +1,46 @@
+package domain
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+)
+
+// Contact is a client or other person a user books appointments with.
+// Email and Phone are optional.
+type Contact struct {
+	bun.BaseModel `bun:"table:contacts"`
+
+	ID        uuid.UUID `bun:"id,pk,type:uuid"`
+	UserID    string    `bun:"user_id,notnull"`
+	Name      string    `bun:"name,notnull"`
+	Email     string    `bun:"email,nullzero"`
+	Phone     string    `bun:"phone,nullzero"`
+	CreatedAt time.Time `bun:"created_at,notnull"`
+	UpdatedAt time.Time `bun:"updated_at,notnull"`
+}
+
+func (c *Contact) BeforeAppendModel(ctx context.Context, query bun.Query) error {
+	now := time.Now().UTC()
+	switch query.(type) {
+	case *bun.InsertQuery:
+		if c.ID == uuid.Nil {
+			id, err := uuid.NewV7()
+			if err != nil {
+				return err
+			}
+			c.ID = id
+		}
+		if c.CreatedAt.IsZero() {
+			c.CreatedAt = now
+		}
+		if c.UpdatedAt.IsZero() {
+			c.UpdatedAt = now
+		}
+	case *bun.UpdateQuery:
+		c.UpdatedAt = now
+	}
+	return nil
+}
@@ -0,0 +1,93 @@
+package domain
+
+import (
+	"sort"
+	"time"
+
+	"github.com/uptrace/bun"
+)
+
+// DaySummary is how full one local day of a user's calendar is, as a month
+// view draws it. Day is the local date at midnight UTC. An item that crosses
+// midnight counts on every day it touches; milestones count on the day they
+// fall on and take no busy time.
+type DaySummary struct {
+	bun.BaseModel `bun:"table:day_summaries"`
+
+	UserID       string    `bun:"user_id,pk"`
+	Day          time.Time `bun:"day,pk,type:date"`
+	Appointments int       `bun:"appointments,notnull"`
+	Occurrences  int       `bun:"occurrences,notnull"`
+	BusySeconds  int       `bun:"busy_seconds,notnull"`
+}
+
+// DaySummaryBuild records what a user's stored day summaries were built
+// from: the days in [WindowStart, WindowEnd) of TimeZone, at
+// CalendarVersion. Summaries are current while the calendar version and the
+// user's time zone still match.
+type DaySummaryBuild struct {
+	bun.BaseModel `bun:"table:day_summary_builds"`
+
+	UserID          string    `bun:"user_id,pk"`
+	TimeZone        string    `bun:"time_zone,notnull"`
+	CalendarVersion int64     `bun:"calendar_version,notnull"`
+	WindowStart     time.Time `bun:"window_start,type:date,notnull"`
+	WindowEnd       time.Time `bun:"window_end,type:date,notnull"`
+	BuiltAt         time.Time `bun:"built_at,notnull"`
+}
+
+// Covers reports whether the build holds every day in [from, to), both
+// dates at midnight UTC.
+func (b DaySummaryBuild) Covers(from, to time.Time) bool {
+	return !from.Before(b.WindowStart) && !to.After(b.WindowEnd)
+}
+
+// SummarizeDays returns a summary for each day of [from, to) in loc that
+// appts or occs touch, oldest first. from and to are local midnights; days
+// with nothing on them are left out.
+func SummarizeDays(userID string, loc *time.Location, from, to time.Time, appts []Appointment, occs []RecurringOccurrence) []DaySummary {
+	days := map[time.Time]*DaySummary{}
+	busy := map[time.Time][]BusyInterval{}
+	add := func(start, end time.Time, occurrence, milestone bool) {
+		spans := SplitByLocalDay(start, end, loc)
+		if milestone {
+			local := start.In(loc)
+			spans = []DaySpan{{Date: time.Date(local.Year(), local.Month(), local.Day(), 0, 0, 0, 0, loc)}}
+		}
+		for _, span := range spans {
+			if span.Date.Before(from) || !span.Date.Before(to) {
+				continue
+			}
+			key := time.Date(span.Date.Year(), span.Date.Month(), span.Date.Day(), 0, 0, 0, 0, time.UTC)
+			d := days[key]
+			if d == nil {
+				d = &DaySummary{UserID: userID, Day: key}
+				days[key] = d
+			}
+			if occurrence {
+				d.Occurrences++
+			} else {
+				d.Appointments++
+			}
+			if !milestone {
+				busy[key] = append(busy[key], BusyInterval{Start: span.Start, End: span.End})
+			}
+		}
+	}
+	for _, a := range appts {
+		add(a.StartTime, a.EndTime, false, a.Milestone())
+	}
+	for _, o := range occs {
+		add(o.StartTime, o.EndTime, true, false)
+	}
+
+	out := make([]DaySummary, 0, len(days))
+	for key, d := range days {
+		for _, iv := range MergeBusy(busy[key]) {
+			d.BusySeconds += int(iv.End.Sub(iv.Start) / time.Second)
+		}
+		out = append(out, *d)
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].Day.Before(out[j].Day) })
+	return out
+}
@@ -0,0 +1,39 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+func TestSummarizeDays(t *testing.T) {
+	ny, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Fatalf("LoadLocation error: %v", err)
+	}
+	at := func(day, hour int) time.Time { return time.Date(2026, 1, day, hour, 0, 0, 0, ny) }
+	appts := []Appointment{
+		{ID: uuid.New(), StartTime: at(5, 9), EndTime: at(5, 11)},
+		{ID: uuid.New(), StartTime: at(5, 10), EndTime: at(5, 12)},
+		{ID: uuid.New(), StartTime: at(5, 22), EndTime: at(6, 1)},
+		{ID: uuid.New(), StartTime: at(7, 12), EndTime: at(7, 12), Kind: AppointmentKindMilestone},
+		{ID: uuid.New(), StartTime: at(9, 9), EndTime: at(9, 10)},
+	}
+	occs := []RecurringOccurrence{{SeriesID: uuid.New(), StartTime: at(6, 9), EndTime: at(6, 10)}}
+
+	got := SummarizeDays("u1", ny, at(5, 0), at(9, 0), appts, occs)
+	want := []DaySummary{
+		{UserID: "u1", Day: time.Date(2026, 1, 5, 0, 0, 0, 0, time.UTC), Appointments: 3, BusySeconds: 5 * 3600},
+		{UserID: "u1", Day: time.Date(2026, 1, 6, 0, 0, 0, 0, time.UTC), Appointments: 1, Occurrences: 1, BusySeconds: 2 * 3600},
+		{UserID: "u1", Day: time.Date(2026, 1, 7, 0, 0, 0, 0, time.UTC), Appointments: 1},
+	}
+	if len(got) != len(want) {
+		t.Fatalf("summaries = %+v, want %+v", got, want)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Fatalf("summary %d = %+v, want %+v", i, got[i], want[i])
+		}
+	}
+}
@@ -0,0 +1,34 @@
+package domain
+
+import "time"
+
+// DaySpan is the part of a time range that falls on one local calendar day.
+// Date is that day's local midnight.
+type DaySpan struct {
+	Date  time.Time
+	Start time.Time
+	End   time.Time
+}
+
+// SplitByLocalDay cuts [start, end) at each local midnight in loc. A range
+// that stays within one local day yields a single span.
+func SplitByLocalDay(start, end time.Time, loc *time.Location) []DaySpan {
+	if !end.After(start) {
+		return nil
+	}
+
+	var out []DaySpan
+	cur := start.In(loc)
+	localEnd := end.In(loc)
+	for cur.Before(localEnd) {
+		day := time.Date(cur.Year(), cur.Month(), cur.Day(), 0, 0, 0, 0, loc)
+		next := day.AddDate(0, 0, 1)
+		segEnd := localEnd
+		if next.Before(segEnd) {
+			segEnd = next
+		}
+		out = append(out, DaySpan{Date: day, Start: cur, End: segEnd})
+		cur = segEnd
+	}
+	return out
+}
@@ -0,0 +1,113 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+func TestSplitByLocalDay(t *testing.T) {
+	ny, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Fatalf("LoadLocation error: %v", err)
+	}
+
+	tests := []struct {
+		name      string
+		start     time.Time
+		end       time.Time
+		wantDates []string
+		wantHours []float64
+	}{
+		{
+			name:      "same day",
+			start:     time.Date(2026, 1, 5, 9, 0, 0, 0, ny),
+			end:       time.Date(2026, 1, 5, 10, 0, 0, 0, ny),
+			wantDates: []string{"2026-01-05"},
+			wantHours: []float64{1},
+		},
+		{
+			name:      "crosses local midnight",
+			start:     time.Date(2026, 1, 5, 22, 0, 0, 0, ny),
+			end:       time.Date(2026, 1, 6, 1, 30, 0, 0, ny),
+			wantDates: []string{"2026-01-05", "2026-01-06"},
+			wantHours: []float64{2, 1.5},
+		},
+		{
+			name:      "ends exactly at midnight",
+			start:     time.Date(2026, 1, 5, 22, 0, 0, 0, ny),
+			end:       time.Date(2026, 1, 6, 0, 0, 0, 0, ny),
+			wantDates: []string{"2026-01-05"},
+			wantHours: []float64{2},
+		},
+		{
+			name:      "full day across spring forward",
+			start:     time.Date(2026, 3, 7, 12, 0, 0, 0, ny),
+			end:       time.Date(2026, 3, 8, 12, 0, 0, 0, ny),
+			wantDates: []string{"2026-03-07", "2026-03-08"},
+			wantHours: []float64{12, 11},
+		},
+		{
+			name:      "utc midnight is not a split point",
+			start:     time.Date(2026, 1, 5, 23, 0, 0, 0, time.UTC),
+			end:       time.Date(2026, 1, 6, 1, 0, 0, 0, time.UTC),
+			wantDates: []string{"2026-01-05"},
+			wantHours: []float64{2},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := SplitByLocalDay(tt.start, tt.end, ny)
+			if len(got) != len(tt.wantDates) {
+				t.Fatalf("len(spans) = %d, want %d: %+v", len(got), len(tt.wantDates), got)
+			}
+			for i, s := range got {
+				if d := s.Date.Format("2006-01-02"); d != tt.wantDates[i] {
+					t.Fatalf("spans[%d].Date = %s, want %s", i, d, tt.wantDates[i])
+				}
+				if h := s.End.Sub(s.Start).Hours(); h != tt.wantHours[i] {
+					t.Fatalf("spans[%d] hours = %v, want %v", i, h, tt.wantHours[i])
+				}
+			}
+		})
+	}
+}
+
+func TestGenerateWeeklyOccurrences_CrossMidnightOccurrenceOverlapsNextDayWindow(t *testing.T) {
+	ny, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Fatalf("LoadLocation error: %v", err)
+	}
+	count := 2
+	series := RecurringSeries{
+		ID:              uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+		UserID:          "u1",
+		Title:           "night shift",
+		Timezone:        "America/New_York",
+		DTStart:         time.Date(2026, 1, 5, 23, 0, 0, 0, ny),
+		DurationSeconds: 3 * 3600,
+		Frequency:       RecurrenceFrequencyWeekly,
+		Interval:        1,
+		ByWeekday:       []int16{1},
+		Count:           &count,
+	}
+
+	// The window is Tuesday only; the Monday 23:00 occurrence runs into it.
+	windowStart := time.Date(2026, 1, 13, 0, 0, 0, 0, ny)
+	occs, err := GenerateWeeklyOccurrences(series, windowStart, windowStart.AddDate(0, 0, 1))
+	if err != nil {
+		t.Fatalf("GenerateWeeklyOccurrences error: %v", err)
+	}
+	if len(occs) != 1 {
+		t.Fatalf("len(occs) = %d, want 1", len(occs))
+	}
+	want := time.Date(2026, 1, 12, 23, 0, 0, 0, ny)
+	if !occs[0].StartTime.Equal(want) {
+		t.Fatalf("start = %v, want %v", occs[0].StartTime, want)
+	}
+	if spans := SplitByLocalDay(occs[0].StartTime, occs[0].EndTime, ny); len(spans) != 2 {
+		t.Fatalf("len(spans) = %d, want 2", len(spans))
+	}
+}
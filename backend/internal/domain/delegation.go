@@ -0,0 +1,37 @@
+package domain
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+)
+
+// DelegationGrant lets DelegateID create and delete appointments on
+// PrincipalID's calendar.
+type DelegationGrant struct {
+	bun.BaseModel `bun:"table:delegation_grants"`
+
+	ID          uuid.UUID `bun:"id,pk,type:uuid"`
+	PrincipalID string    `bun:"principal_id,notnull"`
+	DelegateID  string    `bun:"delegate_id,notnull"`
+	CreatedAt   time.Time `bun:"created_at,notnull"`
+}
+
+func (g *DelegationGrant) BeforeAppendModel(ctx context.Context, query bun.Query) error {
+	if _, ok := query.(*bun.InsertQuery); !ok {
+		return nil
+	}
+	if g.ID == uuid.Nil {
+		id, err := uuid.NewV7()
+		if err != nil {
+			return err
+		}
+		g.ID = id
+	}
+	if g.CreatedAt.IsZero() {
+		g.CreatedAt = time.Now().UTC()
+	}
+	return nil
+}
@@ -0,0 +1,70 @@
+package domain
+
+import "time"
+
+// DSTGapPolicy decides what happens to an occurrence whose local start time
+// does not exist because clocks spring forward over it.
+type DSTGapPolicy string
+
+const (
+	// DSTGapShiftForward reads the wall time with the offset in effect before
+	// the gap, so 02:30 in a 02:00-03:00 gap becomes 03:30 (RFC 5545).
+	DSTGapShiftForward DSTGapPolicy = "shift_forward"
+	// DSTGapSkip drops the occurrence. It still counts towards COUNT.
+	DSTGapSkip DSTGapPolicy = "skip"
+)
+
+// DSTAmbiguousPolicy picks between the two instants of a local start time that
+// occurs twice because clocks fall back over it.
+type DSTAmbiguousPolicy string
+
+const (
+	DSTAmbiguousEarlier DSTAmbiguousPolicy = "earlier"
+	DSTAmbiguousLater   DSTAmbiguousPolicy = "later"
+)
+
+func (p DSTGapPolicy) Valid() bool {
+	return p == DSTGapShiftForward || p == DSTGapSkip
+}
+
+func (p DSTAmbiguousPolicy) Valid() bool {
+	return p == DSTAmbiguousEarlier || p == DSTAmbiguousLater
+}
+
+// resolveLocalStart returns the instant at which the wall clock in loc reads
+// date's calendar day at clock's time of day. inGap reports that no such
+// instant exists; the returned time is then the shift-forward reading.
+func resolveLocalStart(date, clock time.Time, loc *time.Location, ambiguous DSTAmbiguousPolicy) (t time.Time, inGap bool) {
+	wall := time.Date(date.Year(), date.Month(), date.Day(), clock.Hour(), clock.Minute(), clock.Second(), clock.Nanosecond(), time.UTC)
+
+	// Offsets a day either side bracket any single transition near wall.
+	_, offBefore := wall.Add(-24 * time.Hour).In(loc).Zone()
+	_, offAfter := wall.Add(24 * time.Hour).In(loc).Zone()
+	before := wall.Add(-time.Duration(offBefore) * time.Second)
+	after := wall.Add(-time.Duration(offAfter) * time.Second)
+	beforeOK := sameWallClock(before.In(loc), wall)
+	afterOK := sameWallClock(after.In(loc), wall)
+
+	switch {
+	case beforeOK && afterOK:
+		first, second := before, after
+		if second.Before(first) {
+			first, second = second, first
+		}
+		if ambiguous == DSTAmbiguousLater {
+			return second, false
+		}
+		return first, false
+	case beforeOK:
+		return before, false
+	case afterOK:
+		return after, false
+	}
+	return before, true
+}
+
+func sameWallClock(t, wall time.Time) bool {
+	return t.Year() == wall.Year() && t.Month() == wall.Month() && t.Day() == wall.Day() &&
+		t.Hour() == wall.Hour() && t.Minute() == wall.Minute() && t.Second() == wall.Second() &&
+		t.Nanosecond() == wall.Nanosecond()
+}
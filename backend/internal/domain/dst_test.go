@@ -0,0 +1,165 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+func TestGenerateWeeklyOccurrences_DSTPolicies(t *testing.T) {
+	tests := []struct {
+		name      string
+		zone      string
+		dtstart   [5]int // year, month, day, hour, minute of the first occurrence
+		gap       DSTGapPolicy
+		ambiguous DSTAmbiguousPolicy
+		// The second weekly occurrence, which lands on the transition day.
+		wantCount int
+		wantUTC   time.Time
+	}{
+		{
+			name:      "new york gap shifts forward",
+			zone:      "America/New_York",
+			dtstart:   [5]int{2026, 3, 1, 2, 30},
+			gap:       DSTGapShiftForward,
+			wantCount: 2,
+			wantUTC:   time.Date(2026, 3, 8, 7, 30, 0, 0, time.UTC), // 03:30 EDT
+		},
+		{
+			name:      "new york gap defaults to shift forward",
+			zone:      "America/New_York",
+			dtstart:   [5]int{2026, 3, 1, 2, 30},
+			wantCount: 2,
+			wantUTC:   time.Date(2026, 3, 8, 7, 30, 0, 0, time.UTC),
+		},
+		{
+			name:      "new york gap skipped",
+			zone:      "America/New_York",
+			dtstart:   [5]int{2026, 3, 1, 2, 30},
+			gap:       DSTGapSkip,
+			wantCount: 1,
+		},
+		{
+			name:      "new york ambiguous earlier",
+			zone:      "America/New_York",
+			dtstart:   [5]int{2026, 10, 25, 1, 30},
+			ambiguous: DSTAmbiguousEarlier,
+			wantCount: 2,
+			wantUTC:   time.Date(2026, 11, 1, 5, 30, 0, 0, time.UTC), // 01:30 EDT
+		},
+		{
+			name:      "new york ambiguous later",
+			zone:      "America/New_York",
+			dtstart:   [5]int{2026, 10, 25, 1, 30},
+			ambiguous: DSTAmbiguousLater,
+			wantCount: 2,
+			wantUTC:   time.Date(2026, 11, 1, 6, 30, 0, 0, time.UTC), // 01:30 EST
+		},
+		{
+			name:      "london gap shifts forward",
+			zone:      "Europe/London",
+			dtstart:   [5]int{2026, 3, 22, 1, 15},
+			wantCount: 2,
+			wantUTC:   time.Date(2026, 3, 29, 1, 15, 0, 0, time.UTC), // 02:15 BST
+		},
+		{
+			name:      "london ambiguous later",
+			zone:      "Europe/London",
+			dtstart:   [5]int{2026, 10, 18, 1, 15},
+			ambiguous: DSTAmbiguousLater,
+			wantCount: 2,
+			wantUTC:   time.Date(2026, 10, 25, 1, 15, 0, 0, time.UTC), // 01:15 GMT
+		},
+		{
+			name:      "lord howe half-hour gap shifts forward",
+			zone:      "Australia/Lord_Howe",
+			dtstart:   [5]int{2026, 9, 27, 2, 15},
+			wantCount: 2,
+			wantUTC:   time.Date(2026, 10, 3, 15, 45, 0, 0, time.UTC), // 02:45 +11
+		},
+		{
+			name:      "lord howe half-hour ambiguous later",
+			zone:      "Australia/Lord_Howe",
+			dtstart:   [5]int{2026, 3, 29, 1, 45},
+			ambiguous: DSTAmbiguousLater,
+			wantCount: 2,
+			wantUTC:   time.Date(2026, 4, 4, 15, 15, 0, 0, time.UTC), // 01:45 +1030
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			loc, err := time.LoadLocation(tt.zone)
+			if err != nil {
+				t.Fatalf("LoadLocation error: %v", err)
+			}
+			dtstart := time.Date(tt.dtstart[0], time.Month(tt.dtstart[1]), tt.dtstart[2], tt.dtstart[3], tt.dtstart[4], 0, 0, loc)
+			weekday := int16(dtstart.Weekday())
+			if weekday == 0 {
+				weekday = 7
+			}
+			count := 2
+			series := RecurringSeries{
+				ID:              uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+				UserID:          "u1",
+				Timezone:        tt.zone,
+				DTStart:         dtstart,
+				DurationSeconds: 1800,
+				Frequency:       RecurrenceFrequencyWeekly,
+				Interval:        1,
+				ByWeekday:       []int16{weekday},
+				Count:           &count,
+				DSTGap:          tt.gap,
+				DSTAmbiguous:    tt.ambiguous,
+			}
+
+			occs, err := GenerateWeeklyOccurrences(series, dtstart.Add(-time.Hour), dtstart.AddDate(0, 0, 30))
+			if err != nil {
+				t.Fatalf("GenerateWeeklyOccurrences error: %v", err)
+			}
+			if len(occs) != tt.wantCount {
+				t.Fatalf("len(occs) = %d, want %d: %+v", len(occs), tt.wantCount, occs)
+			}
+			if !occs[0].StartTime.Equal(dtstart) {
+				t.Fatalf("first start = %v, want %v", occs[0].StartTime, dtstart)
+			}
+			if tt.wantCount == 2 && !occs[1].StartTime.Equal(tt.wantUTC) {
+				t.Fatalf("second start = %v, want %v", occs[1].StartTime, tt.wantUTC)
+			}
+		})
+	}
+}
+
+func TestGenerateWeeklyOccurrences_LeapDay(t *testing.T) {
+	count := 3
+	series := RecurringSeries{
+		ID:              uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+		UserID:          "u1",
+		Timezone:        "UTC",
+		DTStart:         time.Date(2028, 2, 22, 9, 0, 0, 0, time.UTC),
+		DurationSeconds: 3600,
+		Frequency:       RecurrenceFrequencyWeekly,
+		Interval:        1,
+		ByWeekday:       []int16{2},
+		Count:           &count,
+	}
+
+	occs, err := GenerateWeeklyOccurrences(series, series.DTStart, series.DTStart.AddDate(0, 1, 0))
+	if err != nil {
+		t.Fatalf("GenerateWeeklyOccurrences error: %v", err)
+	}
+	want := []time.Time{
+		time.Date(2028, 2, 22, 9, 0, 0, 0, time.UTC),
+		time.Date(2028, 2, 29, 9, 0, 0, 0, time.UTC),
+		time.Date(2028, 3, 7, 9, 0, 0, 0, time.UTC),
+	}
+	if len(occs) != len(want) {
+		t.Fatalf("len(occs) = %d, want %d", len(occs), len(want))
+	}
+	for i := range want {
+		if !occs[i].StartTime.Equal(want[i]) {
+			t.Fatalf("occs[%d] = %v, want %v", i, occs[i].StartTime, want[i])
+		}
+	}
+}
@@ -0,0 +1,71 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"sync/atomic"
+
+	"github.com/google/uuid"
+)
+
+// ExpansionLimits cap the work one GenerateWeeklyOccurrences call may do, so
+// a series with a corrupt start, interval or window cannot spin the CPU.
+// Zero turns a limit off.
+type ExpansionLimits struct {
+	// MaxWeeks is the most weeks the generator steps through.
+	MaxWeeks int
+	// MaxOccurrences is the most occurrences one call returns.
+	MaxOccurrences int
+}
+
+// DefaultExpansionLimits are far beyond any real series: almost two
+// centuries of weeks, or a daily series for over a century.
+var DefaultExpansionLimits = ExpansionLimits{MaxWeeks: 10000, MaxOccurrences: 50000}
+
+// ErrExpansionLimit matches every *ExpansionLimitError.
+var ErrExpansionLimit = errors.New("recurring series expansion limit reached")
+
+// ExpansionLimitError reports the series and the limit an expansion hit.
+type ExpansionLimitError struct {
+	SeriesID uuid.UUID
+	// Limit is "weeks" or "occurrences".
+	Limit string
+	Max   int
+}
+
+func (e *ExpansionLimitError) Error() string {
+	return fmt.Sprintf("recurring series %s: expansion passed %d %s", e.SeriesID, e.Max, e.Limit)
+}
+
+func (e *ExpansionLimitError) Unwrap() error {
+	return ErrExpansionLimit
+}
+
+var (
+	expansionLimits   atomic.Pointer[ExpansionLimits]
+	expansionLimitHit atomic.Int64
+)
+
+// SetExpansionLimits replaces the process-wide expansion limits. main sets
+// them once from config; until then DefaultExpansionLimits apply.
+func SetExpansionLimits(l ExpansionLimits) {
+	expansionLimits.Store(&l)
+}
+
+func currentExpansionLimits() ExpansionLimits {
+	if l := expansionLimits.Load(); l != nil {
+		return *l
+	}
+	return DefaultExpansionLimits
+}
+
+// ExpansionLimitHits counts the expansions stopped by a limit since the
+// process started.
+func ExpansionLimitHits() int64 {
+	return expansionLimitHit.Load()
+}
+
+func expansionLimitError(seriesID uuid.UUID, limit string, max int) error {
+	expansionLimitHit.Add(1)
+	return &ExpansionLimitError{SeriesID: seriesID, Limit: limit, Max: max}
+}
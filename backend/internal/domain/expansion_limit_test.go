@@ -0,0 +1,62 @@
+package domain
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+func TestGenerateWeeklyOccurrences_ExpansionLimits(t *testing.T) {
+	t.Cleanup(func() { SetExpansionLimits(DefaultExpansionLimits) })
+
+	series := RecurringSeries{
+		ID:              uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+		UserID:          "u1",
+		Title:           "title",
+		Timezone:        "UTC",
+		DTStart:         time.Date(2026, 1, 5, 9, 0, 0, 0, time.UTC),
+		DurationSeconds: 3600,
+		Frequency:       RecurrenceFrequencyWeekly,
+		Interval:        1,
+		ByWeekday:       []int16{1, 3, 5},
+	}
+	windowStart := series.DTStart
+	windowEnd := windowStart.AddDate(0, 0, 10*7)
+
+	SetExpansionLimits(ExpansionLimits{MaxWeeks: 20, MaxOccurrences: 30})
+	occs, err := GenerateWeeklyOccurrences(series, windowStart, windowEnd)
+	if err != nil {
+		t.Fatalf("within limits error: %v", err)
+	}
+	if len(occs) != 30 {
+		t.Fatalf("got %d occurrences, want 30", len(occs))
+	}
+
+	hits := ExpansionLimitHits()
+	SetExpansionLimits(ExpansionLimits{MaxWeeks: 5})
+	_, err = GenerateWeeklyOccurrences(series, windowStart, windowEnd)
+	var limitErr *ExpansionLimitError
+	if !errors.As(err, &limitErr) || !errors.Is(err, ErrExpansionLimit) {
+		t.Fatalf("error = %v, want *ExpansionLimitError", err)
+	}
+	if limitErr.SeriesID != series.ID || limitErr.Limit != "weeks" || limitErr.Max != 5 {
+		t.Fatalf("limit error = %+v, want weeks at 5", limitErr)
+	}
+
+	SetExpansionLimits(ExpansionLimits{MaxOccurrences: 29})
+	if _, err := GenerateWeeklyOccurrences(series, windowStart, windowEnd); !errors.As(err, &limitErr) || limitErr.Limit != "occurrences" {
+		t.Fatalf("error = %v, want the occurrences limit", err)
+	}
+	if got := ExpansionLimitHits() - hits; got != 2 {
+		t.Fatalf("limit hits = %d, want 2", got)
+	}
+
+	// A window starting long after the series does not count the weeks
+	// before it.
+	SetExpansionLimits(ExpansionLimits{MaxWeeks: 12})
+	if _, err := GenerateWeeklyOccurrences(series, windowStart.AddDate(10, 0, 0), windowEnd.AddDate(10, 0, 0)); err != nil {
+		t.Fatalf("late window error: %v", err)
+	}
+}
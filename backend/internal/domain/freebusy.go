@@ -0,0 +1,36 @@
+package domain
+
+import (
+	"sort"
+	"time"
+)
+
+// BusyInterval is a span during which a user is booked.
+type BusyInterval struct {
+	Start time.Time
+	End   time.Time
+}
+
+// MergeBusy sorts intervals and joins any that overlap or touch, so callers
+// get the minimal set of disjoint busy spans. Empty intervals are dropped.
+func MergeBusy(intervals []BusyInterval) []BusyInterval {
+	sorted := make([]BusyInterval, 0, len(intervals))
+	for _, iv := range intervals {
+		if iv.End.After(iv.Start) {
+			sorted = append(sorted, iv)
+		}
+	}
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].Start.Before(sorted[j].Start) })
+
+	out := make([]BusyInterval, 0, len(sorted))
+	for _, iv := range sorted {
+		if n := len(out); n > 0 && !iv.Start.After(out[n-1].End) {
+			if iv.End.After(out[n-1].End) {
+				out[n-1].End = iv.End
+			}
+			continue
+		}
+		out = append(out, iv)
+	}
+	return out
+}
@@ -0,0 +1,32 @@
+package domain
+
+import (
+	"testing"
+	"time"
+)
+
+func TestMergeBusy(t *testing.T) {
+	at := func(h int) time.Time { return time.Date(2026, 1, 5, h, 0, 0, 0, time.UTC) }
+
+	got := MergeBusy([]BusyInterval{
+		{Start: at(13), End: at(14)},
+		{Start: at(9), End: at(11)},
+		{Start: at(10), End: at(12)},
+		{Start: at(12), End: at(13)},
+		{Start: at(16), End: at(17)},
+		{Start: at(15), End: at(15)},
+	})
+
+	want := []BusyInterval{
+		{Start: at(9), End: at(14)},
+		{Start: at(16), End: at(17)},
+	}
+	if len(got) != len(want) {
+		t.Fatalf("len(got) = %d, want %d: %+v", len(got), len(want), got)
+	}
+	for i := range want {
+		if !got[i].Start.Equal(want[i].Start) || !got[i].End.Equal(want[i].End) {
+			t.Fatalf("got[%d] = %+v, want %+v", i, got[i], want[i])
+		}
+	}
+}
@@ -0,0 +1,39 @@
+package domain
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+)
+
+// SlotHold reserves a time range on a user's calendar until ExpiresAt, so a
+// multi-step booking flow can confirm it without racing other bookings.
+type SlotHold struct {
+	bun.BaseModel `bun:"table:slot_holds"`
+
+	ID        uuid.UUID `bun:"id,pk,type:uuid"`
+	UserID    string    `bun:"user_id,notnull"`
+	StartTime time.Time `bun:"start_time,notnull"`
+	EndTime   time.Time `bun:"end_time,notnull"`
+	ExpiresAt time.Time `bun:"expires_at,notnull"`
+	CreatedAt time.Time `bun:"created_at,notnull"`
+}
+
+func (h *SlotHold) BeforeAppendModel(ctx context.Context, query bun.Query) error {
+	if _, ok := query.(*bun.InsertQuery); !ok {
+		return nil
+	}
+	if h.ID == uuid.Nil {
+		id, err := uuid.NewV7()
+		if err != nil {
+			return err
+		}
+		h.ID = id
+	}
+	if h.CreatedAt.IsZero() {
+		h.CreatedAt = time.Now().UTC()
+	}
+	return nil
+}
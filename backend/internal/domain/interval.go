@@ -0,0 +1,32 @@
+package domain
+
+import "time"
+
+// Every span the server stores or compares, whether an appointment, an
+// occurrence, a hold, a blackout or time off, ends exclusively: 10:00 to
+// 11:00 covers [10:00, 11:00). A booking that starts at 11:00 is back to
+// back with it, not in conflict, which is also what the database's '[)'
+// exclusion constraint enforces.
+
+// Overlaps reports whether [aStart, aEnd) and [bStart, bEnd) share an
+// instant. Spans that only touch do not overlap.
+func Overlaps(aStart, aEnd, bStart, bEnd time.Time) bool {
+	return aStart.Before(bEnd) && bStart.Before(aEnd)
+}
+
+// ExclusiveEnd converts an inclusive end, the last instant a span covers at
+// the given resolution, to the exclusive end the server stores. A source
+// that ends a 10:00 meeting at 10:59:59 has a resolution of a second; one
+// that ends an all-day event on its own date has a resolution of a day,
+// which is counted in the end's location so DST days keep their length. A
+// zero resolution means the end is already exclusive.
+func ExclusiveEnd(end time.Time, resolution time.Duration) time.Time {
+	switch {
+	case resolution <= 0:
+		return end
+	case resolution == 24*time.Hour:
+		return end.AddDate(0, 0, 1)
+	default:
+		return end.Add(resolution)
+	}
+}
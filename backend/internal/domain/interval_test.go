@@ -0,0 +1,77 @@
+package domain
+
+import (
+	"testing"
+	"time"
+)
+
+func TestOverlaps_ExclusiveEnds(t *testing.T) {
+	at := func(h, m int) time.Time { return time.Date(2026, 1, 5, h, m, 0, 0, time.UTC) }
+	// Every case is checked against [10:00, 11:00) in both argument orders,
+	// since overlap is symmetric.
+	start, end := at(10, 0), at(11, 0)
+
+	tests := []struct {
+		name       string
+		start, end time.Time
+		want       bool
+	}{
+		{"back to back before", at(9, 0), at(10, 0), false},
+		{"back to back after", at(11, 0), at(12, 0), false},
+		{"well before", at(8, 0), at(9, 0), false},
+		{"well after", at(12, 0), at(13, 0), false},
+		{"one nanosecond into the start", at(9, 0), at(10, 0).Add(time.Nanosecond), true},
+		{"one nanosecond before the end", at(11, 0).Add(-time.Nanosecond), at(12, 0), true},
+		{"same span", at(10, 0), at(11, 0), true},
+		{"inside", at(10, 15), at(10, 45), true},
+		{"around", at(9, 0), at(12, 0), true},
+		{"shares the start", at(10, 0), at(10, 30), true},
+		{"shares the end", at(10, 30), at(11, 0), true},
+		// What an inclusive source's 10:00 to 10:59:59 meeting becomes.
+		{"converted inclusive end before", at(9, 0), ExclusiveEnd(at(9, 59).Add(59*time.Second), time.Second), false},
+	}
+	for _, tt := range tests {
+		if got := Overlaps(start, end, tt.start, tt.end); got != tt.want {
+			t.Fatalf("%s: Overlaps = %v, want %v", tt.name, got, tt.want)
+		}
+		if got := Overlaps(tt.start, tt.end, start, end); got != tt.want {
+			t.Fatalf("%s reversed: Overlaps = %v, want %v", tt.name, got, tt.want)
+		}
+		if got := (Blackout{StartTime: start, EndTime: end}).Overlaps(tt.start, tt.end); got != tt.want {
+			t.Fatalf("%s: Blackout.Overlaps = %v, want %v", tt.name, got, tt.want)
+		}
+	}
+
+	// Back-to-back busy time still merges into one span for display.
+	merged := MergeBusy([]BusyInterval{{Start: at(11, 0), End: at(12, 0)}, {Start: start, End: end}})
+	if len(merged) != 1 || !merged[0].Start.Equal(start) || !merged[0].End.Equal(at(12, 0)) {
+		t.Fatalf("MergeBusy = %v, want one span from 10:00 to 12:00", merged)
+	}
+}
+
+func TestExclusiveEnd(t *testing.T) {
+	ny, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Fatalf("LoadLocation error: %v", err)
+	}
+
+	tests := []struct {
+		name       string
+		end        time.Time
+		resolution time.Duration
+		want       time.Time
+	}{
+		{"already exclusive", time.Date(2026, 1, 5, 11, 0, 0, 0, time.UTC), 0, time.Date(2026, 1, 5, 11, 0, 0, 0, time.UTC)},
+		{"seconds", time.Date(2026, 1, 5, 10, 59, 59, 0, time.UTC), time.Second, time.Date(2026, 1, 5, 11, 0, 0, 0, time.UTC)},
+		{"minutes", time.Date(2026, 1, 5, 10, 59, 0, 0, time.UTC), time.Minute, time.Date(2026, 1, 5, 11, 0, 0, 0, time.UTC)},
+		{"all-day date", time.Date(2026, 1, 5, 0, 0, 0, 0, ny), 24 * time.Hour, time.Date(2026, 1, 6, 0, 0, 0, 0, ny)},
+		// The spring-forward day is 23 hours long; the end is still the next
+		// local midnight.
+		{"all-day date across DST", time.Date(2026, 3, 8, 0, 0, 0, 0, ny), 24 * time.Hour, time.Date(2026, 3, 9, 0, 0, 0, 0, ny)},
+	}
+	for _, tt := range tests {
+		if got := ExclusiveEnd(tt.end, tt.resolution); !got.Equal(tt.want) {
+			t.Fatalf("%s: ExclusiveEnd = %v, want %v", tt.name, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,52 @@
+package domain
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+)
+
+type AppointmentLinkKind string
+
+const (
+	AppointmentLinkKindFollowUpOf AppointmentLinkKind = "follow_up_of"
+	AppointmentLinkKindPrepFor    AppointmentLinkKind = "prep_for"
+)
+
+// AppointmentLink records that AppointmentID relates to RelatedID by Kind,
+// e.g. AppointmentID is a follow-up of RelatedID.
+type AppointmentLink struct {
+	bun.BaseModel `bun:"table:appointment_links"`
+
+	ID            uuid.UUID           `bun:"id,pk,type:uuid"`
+	UserID        string              `bun:"user_id,notnull"`
+	AppointmentID uuid.UUID           `bun:"appointment_id,notnull,type:uuid"`
+	RelatedID     uuid.UUID           `bun:"related_id,notnull,type:uuid"`
+	Kind          AppointmentLinkKind `bun:"kind,notnull"`
+	CreatedAt     time.Time           `bun:"created_at,notnull"`
+}
+
+func (l *AppointmentLink) BeforeAppendModel(ctx context.Context, query bun.Query) error {
+	if _, ok := query.(*bun.InsertQuery); !ok {
+		return nil
+	}
+	if l.ID == uuid.Nil {
+		id, err := uuid.NewV7()
+		if err != nil {
+			return err
+		}
+		l.ID = id
+	}
+	if l.CreatedAt.IsZero() {
+		l.CreatedAt = time.Now().UTC()
+	}
+	return nil
+}
+
+// RelatedAppointment pairs a link with the appointment at its other end.
+type RelatedAppointment struct {
+	Link        AppointmentLink
+	Appointment Appointment
+}
@@ -0,0 +1,87 @@
+package domain
+
+import (
+	"encoding/base64"
+	"errors"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+var ErrInvalidOccurrenceID = errors.New("invalid occurrence id")
+
+// ErrLegacyOccurrenceIDRetired is returned for a legacy occurrence id once
+// the deprecation window set by SetLegacyOccurrenceIDCutoff has closed.
+var ErrLegacyOccurrenceIDRetired = errors.New("legacy occurrence id format retired")
+
+// EncodeOccurrenceID returns the opaque id of the occurrence of seriesID that
+// the rule generates at start: the series id and the RFC 3339 start in UTC,
+// joined by "/" and base64url-encoded. Clients should treat it as opaque.
+func EncodeOccurrenceID(seriesID uuid.UUID, start time.Time) string {
+	raw := seriesID.String() + "/" + start.UTC().Format(time.RFC3339Nano)
+	return base64.RawURLEncoding.EncodeToString([]byte(raw))
+}
+
+// DecodeOccurrenceID reverses EncodeOccurrenceID.
+func DecodeOccurrenceID(id string) (uuid.UUID, time.Time, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(strings.TrimSpace(id))
+	if err != nil {
+		return uuid.Nil, time.Time{}, ErrInvalidOccurrenceID
+	}
+	seriesPart, startPart, ok := strings.Cut(string(raw), "/")
+	if !ok {
+		return uuid.Nil, time.Time{}, ErrInvalidOccurrenceID
+	}
+	seriesID, err := uuid.Parse(seriesPart)
+	if err != nil {
+		return uuid.Nil, time.Time{}, ErrInvalidOccurrenceID
+	}
+	start, err := time.Parse(time.RFC3339Nano, startPart)
+	if err != nil {
+		return uuid.Nil, time.Time{}, ErrInvalidOccurrenceID
+	}
+	return seriesID, start.UTC(), nil
+}
+
+var (
+	legacyOccurrenceIDCutoff   atomic.Pointer[time.Time]
+	legacyOccurrenceIDAccepted atomic.Int64
+	legacyOccurrenceIDRejected atomic.Int64
+)
+
+// SetLegacyOccurrenceIDCutoff ends the deprecation window for legacy
+// occurrence ids at cutoff. main sets it once from config; the zero time,
+// the default, leaves the window open.
+func SetLegacyOccurrenceIDCutoff(cutoff time.Time) {
+	legacyOccurrenceIDCutoff.Store(&cutoff)
+}
+
+// LegacyOccurrenceIDCounts returns how many legacy occurrence ids were
+// accepted and rejected since the process started.
+func LegacyOccurrenceIDCounts() (accepted, rejected int64) {
+	return legacyOccurrenceIDAccepted.Load(), legacyOccurrenceIDRejected.Load()
+}
+
+// ResolveOccurrenceID decodes an occurrence id a client sent for seriesID.
+// Besides EncodeOccurrenceID's format it accepts, until the cutoff, the
+// legacy format from before Decision 39: the start's UnixNano in decimal,
+// which names no series, so seriesID is assumed.
+func ResolveOccurrenceID(id string, seriesID uuid.UUID, at time.Time) (uuid.UUID, time.Time, error) {
+	occurrenceSeriesID, start, err := DecodeOccurrenceID(id)
+	if err == nil {
+		return occurrenceSeriesID, start, nil
+	}
+	nanos, parseErr := strconv.ParseInt(strings.TrimSpace(id), 10, 64)
+	if parseErr != nil || nanos <= 0 {
+		return uuid.Nil, time.Time{}, ErrInvalidOccurrenceID
+	}
+	if cutoff := legacyOccurrenceIDCutoff.Load(); cutoff != nil && !cutoff.IsZero() && !at.Before(*cutoff) {
+		legacyOccurrenceIDRejected.Add(1)
+		return uuid.Nil, time.Time{}, ErrLegacyOccurrenceIDRetired
+	}
+	legacyOccurrenceIDAccepted.Add(1)
+	return seriesID, time.Unix(0, nanos).UTC(), nil
+}
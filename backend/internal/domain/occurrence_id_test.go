@@ -0,0 +1,80 @@
+package domain
+
+import (
+	"encoding/base64"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+func TestOccurrenceID_RoundTrip(t *testing.T) {
+	seriesID := uuid.MustParse("00000000-0000-0000-0000-000000000001")
+	start := time.Date(2026, 3, 29, 9, 30, 0, 500, time.FixedZone("CEST", 2*60*60))
+
+	id := EncodeOccurrenceID(seriesID, start)
+	gotSeries, gotStart, err := DecodeOccurrenceID(id)
+	if err != nil {
+		t.Fatalf("DecodeOccurrenceID error: %v", err)
+	}
+	if gotSeries != seriesID || !gotStart.Equal(start) || gotStart.Location() != time.UTC {
+		t.Fatalf("decoded = %s %v, want %s %v in UTC", gotSeries, gotStart, seriesID, start.UTC())
+	}
+}
+
+func TestDecodeOccurrenceID_RejectsMalformed(t *testing.T) {
+	enc := func(s string) string { return base64.RawURLEncoding.EncodeToString([]byte(s)) }
+
+	for _, id := range []string{
+		"",
+		"1767603600000000000",
+		"not base64!",
+		enc("00000000-0000-0000-0000-000000000001"),
+		enc("not-a-uuid/2026-01-05T09:00:00Z"),
+		enc("00000000-0000-0000-0000-000000000001/yesterday"),
+	} {
+		if _, _, err := DecodeOccurrenceID(id); err != ErrInvalidOccurrenceID {
+			t.Fatalf("DecodeOccurrenceID(%q) error = %v, want %v", id, err, ErrInvalidOccurrenceID)
+		}
+	}
+}
+
+func TestResolveOccurrenceID_AcceptsLegacyUntilCutoff(t *testing.T) {
+	t.Cleanup(func() { SetLegacyOccurrenceIDCutoff(time.Time{}) })
+	seriesID := uuid.MustParse("00000000-0000-0000-0000-000000000001")
+	start := time.Date(2026, 1, 5, 9, 0, 0, 0, time.UTC)
+	legacy := "1767603600000000000"
+	now := time.Date(2026, 6, 1, 0, 0, 0, 0, time.UTC)
+
+	gotSeries, gotStart, err := ResolveOccurrenceID(EncodeOccurrenceID(seriesID, start), uuid.Nil, now)
+	if err != nil || gotSeries != seriesID || !gotStart.Equal(start) {
+		t.Fatalf("encoded id = %s %v %v, want %s %v", gotSeries, gotStart, err, seriesID, start)
+	}
+
+	accepted, rejected := LegacyOccurrenceIDCounts()
+	gotSeries, gotStart, err = ResolveOccurrenceID(legacy, seriesID, now)
+	if err != nil || gotSeries != seriesID || !gotStart.Equal(start) {
+		t.Fatalf("legacy id = %s %v %v, want %s %v", gotSeries, gotStart, err, seriesID, start)
+	}
+	if a, r := LegacyOccurrenceIDCounts(); a != accepted+1 || r != rejected {
+		t.Fatalf("counts = %d/%d, want %d/%d", a, r, accepted+1, rejected)
+	}
+
+	SetLegacyOccurrenceIDCutoff(now.Add(time.Hour))
+	if _, _, err := ResolveOccurrenceID(legacy, seriesID, now); err != nil {
+		t.Fatalf("legacy id before cutoff error = %v", err)
+	}
+	SetLegacyOccurrenceIDCutoff(now)
+	if _, _, err := ResolveOccurrenceID(legacy, seriesID, now); err != ErrLegacyOccurrenceIDRetired {
+		t.Fatalf("legacy id at cutoff error = %v, want %v", err, ErrLegacyOccurrenceIDRetired)
+	}
+	if a, r := LegacyOccurrenceIDCounts(); a != accepted+2 || r != rejected+1 {
+		t.Fatalf("counts = %d/%d, want %d/%d", a, r, accepted+2, rejected+1)
+	}
+
+	for _, id := range []string{"", "-5", "0", "12abc"} {
+		if _, _, err := ResolveOccurrenceID(id, seriesID, now); err != ErrInvalidOccurrenceID {
+			t.Fatalf("ResolveOccurrenceID(%q) error = %v, want %v", id, err, ErrInvalidOccurrenceID)
+		}
+	}
+}
@@ -0,0 +1,73 @@
+package domain
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+)
+
+// Program groups a user's series and one-off appointments that belong
+// together, such as the weekly classes and final exam of a 12-week course.
+// Members point at their program with ProgramID; a program holds nothing
+// itself. CancelledAt is set once the program is cancelled, after which it
+// takes no new members.
+type Program struct {
+	bun.BaseModel `bun:"table:programs"`
+
+	ID          uuid.UUID  `bun:"id,pk,type:uuid"`
+	UserID      string     `bun:"user_id,notnull"`
+	Title       string     `bun:"title,notnull"`
+	Notes       string     `bun:"notes"`
+	CancelledAt *time.Time `bun:"cancelled_at"`
+	CreatedAt   time.Time  `bun:"created_at,notnull"`
+	UpdatedAt   time.Time  `bun:"updated_at,notnull"`
+}
+
+func (p *Program) BeforeAppendModel(ctx context.Context, query bun.Query) error {
+	now := time.Now().UTC()
+	switch query.(type) {
+	case *bun.InsertQuery:
+		if p.ID == uuid.Nil {
+			id, err := uuid.NewV7()
+			if err != nil {
+				return err
+			}
+			p.ID = id
+		}
+		if p.CreatedAt.IsZero() {
+			p.CreatedAt = now
+		}
+		if p.UpdatedAt.IsZero() {
+			p.UpdatedAt = now
+		}
+	case *bun.UpdateQuery:
+		p.UpdatedAt = now
+	}
+	return nil
+}
+
+// ProgramProgress counts a program's sessions, its one-offs plus every
+// occurrence of its series, as of a moment. A session is completed once it
+// has ended.
+type ProgramProgress struct {
+	Total       int
+	Completed   int
+	Remaining   int
+	NextSession *time.Time
+}
+
+// Add counts one session running from start to end.
+func (p *ProgramProgress) Add(start, end, now time.Time) {
+	p.Total++
+	if !end.After(now) {
+		p.Completed++
+		return
+	}
+	p.Remaining++
+	if start.After(now) && (p.NextSession == nil || start.Before(*p.NextSession)) {
+		next := start.UTC()
+		p.NextSession = &next
+	}
+}
@@ -0,0 +1,66 @@
+package domain
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+)
+
+type ProposalStatus string
+
+const (
+	ProposalStatusPending  ProposalStatus = "pending"
+	ProposalStatusAccepted ProposalStatus = "accepted"
+	ProposalStatusDeclined ProposalStatus = "declined"
+	ProposalStatusExpired  ProposalStatus = "expired"
+)
+
+// AppointmentProposal offers a time from one user to another. While it is
+// pending, a slot hold keeps the time free on the proposer's calendar.
+// Accepting it books the appointment on both calendars at once; the
+// appointment ids are set then.
+type AppointmentProposal struct {
+	bun.BaseModel `bun:"table:appointment_proposals"`
+
+	ID          uuid.UUID      `bun:"id,pk,type:uuid"`
+	ProposerID  string         `bun:"proposer_id,notnull"`
+	RecipientID string         `bun:"recipient_id,notnull"`
+	Title       string         `bun:"title,notnull"`
+	Notes       string         `bun:"notes,notnull"`
+	StartTime   time.Time      `bun:"start_time,notnull"`
+	EndTime     time.Time      `bun:"end_time,notnull"`
+	HoldID      uuid.UUID      `bun:"hold_id,type:uuid,notnull"`
+	Status      ProposalStatus `bun:"status,notnull"`
+	ExpiresAt   time.Time      `bun:"expires_at,notnull"`
+	CreatedAt   time.Time      `bun:"created_at,notnull"`
+	RespondedAt *time.Time     `bun:"responded_at"`
+
+	ProposerAppointmentID  *uuid.UUID `bun:"proposer_appointment_id,type:uuid"`
+	RecipientAppointmentID *uuid.UUID `bun:"recipient_appointment_id,type:uuid"`
+}
+
+// Open reports whether the proposal can still be accepted or declined at
+// now. A pending proposal past its expiry is closed even before the sweep
+// marks it expired.
+func (p AppointmentProposal) Open(now time.Time) bool {
+	return p.Status == ProposalStatusPending && now.Before(p.ExpiresAt)
+}
+
+func (p *AppointmentProposal) BeforeAppendModel(ctx context.Context, query bun.Query) error {
+	if _, ok := query.(*bun.InsertQuery); !ok {
+		return nil
+	}
+	if p.ID == uuid.Nil {
+		id, err := uuid.NewV7()
+		if err != nil {
+			return err
+		}
+		p.ID = id
+	}
+	if p.CreatedAt.IsZero() {
+		p.CreatedAt = time.Now().UTC()
+	}
+	return nil
+}
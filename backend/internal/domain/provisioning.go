@@ -0,0 +1,73 @@
+package domain
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+)
+
+// ProvisionedUser is a user an identity system manages through the
+// provisioning API. UserID is the id the rest of the server knows them by.
+// Users who were never provisioned have no row and are treated as active,
+// so calendars that predate provisioning keep working.
+type ProvisionedUser struct {
+	bun.BaseModel `bun:"table:provisioned_users"`
+
+	UserID      string `bun:"user_id,pk"`
+	DisplayName string `bun:"display_name,notnull"`
+	Email       string `bun:"email,nullzero"`
+	// ExternalID is the identity system's own id for the user.
+	ExternalID string `bun:"external_id,nullzero"`
+	Active     bool   `bun:"active,notnull"`
+	// DeactivatedAt is when the user was last deactivated, or nil while
+	// active.
+	DeactivatedAt *time.Time `bun:"deactivated_at"`
+	CreatedAt     time.Time  `bun:"created_at,notnull"`
+	UpdatedAt     time.Time  `bun:"updated_at,notnull"`
+}
+
+// UserGroup is a named set of provisioned users, such as a department.
+// Members is filled in on reads.
+type UserGroup struct {
+	bun.BaseModel `bun:"table:user_groups"`
+
+	ID         uuid.UUID `bun:"id,pk,type:uuid"`
+	Name       string    `bun:"name,notnull"`
+	ExternalID string    `bun:"external_id,nullzero"`
+	CreatedAt  time.Time `bun:"created_at,notnull"`
+	UpdatedAt  time.Time `bun:"updated_at,notnull"`
+
+	Members []string `bun:"-"`
+}
+
+type UserGroupMember struct {
+	bun.BaseModel `bun:"table:user_group_members"`
+
+	GroupID uuid.UUID `bun:"group_id,pk,type:uuid"`
+	UserID  string    `bun:"user_id,pk"`
+}
+
+func (g *UserGroup) BeforeAppendModel(ctx context.Context, query bun.Query) error {
+	now := time.Now().UTC()
+	switch query.(type) {
+	case *bun.InsertQuery:
+		if g.ID == uuid.Nil {
+			id, err := uuid.NewV7()
+			if err != nil {
+				return err
+			}
+			g.ID = id
+		}
+		if g.CreatedAt.IsZero() {
+			g.CreatedAt = now
+		}
+		if g.UpdatedAt.IsZero() {
+			g.UpdatedAt = now
+		}
+	case *bun.UpdateQuery:
+		g.UpdatedAt = now
+	}
+	return nil
+}
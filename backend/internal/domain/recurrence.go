@@ -4,7 +4,6 @@ import (
 	"context"
 	"errors"
 	"sort"
-	"strconv"
 	"time"
 
 	"github.com/google/uuid"
@@ -30,10 +29,69 @@ type RecurringSeries struct {
 	Frequency       RecurrenceFrequency `bun:"frequency,notnull"`
 	Interval        int                 `bun:"interval,notnull"`
 	ByWeekday       []int16             `bun:"byweekday,array,notnull"`
+	ByWeekdayMask   WeekdayMask         `bun:"by_weekday_mask,notnull"`
 	Until           *time.Time          `bun:"until"`
 	Count           *int                `bun:"count"`
+	WeekStart       int16               `bun:"wkst,nullzero"`
+	DSTGap          DSTGapPolicy        `bun:"dst_gap_policy,nullzero"`
+	DSTAmbiguous    DSTAmbiguousPolicy  `bun:"dst_ambiguous_policy,nullzero"`
+	WeekdayTimes    []WeekdayTime       `bun:"weekday_times,type:jsonb,nullzero"`
 	CreatedAt       time.Time           `bun:"created_at,notnull"`
 	UpdatedAt       time.Time           `bun:"updated_at,notnull"`
+
+	Metadata map[string]string `bun:"metadata,type:jsonb,nullzero"`
+
+	// CreatedBy is the delegate who created the series on UserID's behalf,
+	// or empty when the user created it.
+	CreatedBy string `bun:"created_by,nullzero"`
+
+	// ProgramID puts the series in one of the user's programs.
+	ProgramID *uuid.UUID `bun:"program_id,type:uuid"`
+
+	OccurrencesRemaining int         `bun:"-"`
+	NextOccurrence       *time.Time  `bun:"-"`
+	SkippedOccurrences   []time.Time `bun:"-"`
+	BlackoutWarnings     []Blackout  `bun:"-"`
+	Warnings             []Warning   `bun:"-"`
+}
+
+// WeekdayTime gives one weekday of a series its own local start time, in
+// minutes after midnight, as in "Mondays at 9:00 and Wednesdays at 14:00".
+// Weekdays without one start at DTStart's local time.
+type WeekdayTime struct {
+	Weekday     int16 `json:"weekday"`
+	StartMinute int   `json:"start_minute"`
+}
+
+// MinutesPerDay bounds WeekdayTime.StartMinute.
+const MinutesPerDay = 24 * 60
+
+// SeriesHorizonEnd returns the latest instant an occurrence of series can end,
+// given the lookahead that bounds open-ended rules.
+func SeriesHorizonEnd(series RecurringSeries, lookahead time.Duration) time.Time {
+	end := series.DTStart.UTC().Add(lookahead)
+	if series.Until != nil && series.Until.UTC().Before(end) {
+		end = series.Until.UTC()
+	}
+	return end.Add(time.Duration(series.DurationSeconds) * time.Second)
+}
+
+// WithProgress sets the remaining-occurrence fields on series from its
+// expanded occurrences, counting those that start at or after now.
+func (s RecurringSeries) WithProgress(occs []RecurringOccurrence, now time.Time) RecurringSeries {
+	s.OccurrencesRemaining = 0
+	s.NextOccurrence = nil
+	for _, o := range occs {
+		if o.StartTime.Before(now) {
+			continue
+		}
+		s.OccurrencesRemaining++
+		if s.NextOccurrence == nil || o.StartTime.Before(*s.NextOccurrence) {
+			next := o.StartTime.UTC()
+			s.NextOccurrence = &next
+		}
+	}
+	return s
 }
 
 func (s *RecurringSeries) BeforeAppendModel(ctx context.Context, query bun.Query) error {
@@ -112,8 +170,16 @@ type RecurringOccurrence struct {
 	Notes     string
 	StartTime time.Time
 	EndTime   time.Time
+	Metadata  map[string]string
+
+	// Timezone is the series' IANA zone, which clients use to render the
+	// occurrence in the wall-clock time it was scheduled in.
+	Timezone string
 }
 
+// GenerateWeeklyOccurrences expands series over the window. It stops with an
+// *ExpansionLimitError when the expansion needs more weeks or occurrences
+// than the process's ExpansionLimits allow.
 func GenerateWeeklyOccurrences(series RecurringSeries, windowStart, windowEnd time.Time) ([]RecurringOccurrence, error) {
 	if series.Frequency != RecurrenceFrequencyWeekly {
 		return nil, errors.New("unsupported recurrence frequency")
@@ -146,7 +212,38 @@ func GenerateWeeklyOccurrences(series RecurringSeries, windowStart, windowEnd ti
 	if len(weekdays) == 0 {
 		return nil, errors.New("at least one weekday is required")
 	}
-	sort.Slice(weekdays, func(i, j int) bool { return weekdays[i] < weekdays[j] })
+	wkst := series.WeekStart
+	if wkst == 0 {
+		wkst = 1
+	}
+	if wkst < 1 || wkst > 7 {
+		return nil, errors.New("invalid week start")
+	}
+	// clock is the local time of day a weekday's occurrences start at.
+	clocks := make(map[int16]time.Time, len(series.WeekdayTimes))
+	for _, wt := range series.WeekdayTimes {
+		if _, ok := seen[wt.Weekday]; !ok {
+			return nil, errors.New("weekday time for a weekday the series does not repeat on")
+		}
+		if _, ok := clocks[wt.Weekday]; ok {
+			return nil, errors.New("repeated weekday time")
+		}
+		if wt.StartMinute < 0 || wt.StartMinute >= MinutesPerDay {
+			return nil, errors.New("invalid weekday start time")
+		}
+		clocks[wt.Weekday] = time.Date(0, 1, 1, wt.StartMinute/60, wt.StartMinute%60, 0, 0, time.UTC)
+	}
+	clock := func(wd int16) time.Time {
+		if c, ok := clocks[wd]; ok {
+			return c
+		}
+		return dtstartLocal
+	}
+
+	// Order weekdays as they fall within a week beginning on wkst.
+	sort.Slice(weekdays, func(i, j int) bool {
+		return weekdayOffset(weekdays[i], wkst) < weekdayOffset(weekdays[j], wkst)
+	})
 
 	interval := series.Interval
 	if interval < 1 {
@@ -155,13 +252,13 @@ func GenerateWeeklyOccurrences(series RecurringSeries, windowStart, windowEnd ti
 
 	windowStartLocal := windowStart.In(loc)
 	windowEndLocal := windowEnd.In(loc)
-	startWeekMondayUTC := mondayDateUTC(dtstartLocal)
-	windowStartWeekMondayUTC := mondayDateUTC(windowStartLocal)
-	windowEndWeekBoundaryUTC := mondayDateUTC(windowEndLocal).AddDate(0, 0, 7)
+	startWeekUTC := weekStartDateUTC(dtstartLocal, wkst)
+	windowStartWeekUTC := weekStartDateUTC(windowStartLocal, wkst)
+	windowEndWeekBoundaryUTC := weekStartDateUTC(windowEndLocal, wkst).AddDate(0, 0, 7)
 
 	startWeekIndex := 0
-	if windowStartWeekMondayUTC.After(startWeekMondayUTC) {
-		daysDiff := int(windowStartWeekMondayUTC.Sub(startWeekMondayUTC) / (24 * time.Hour))
+	if windowStartWeekUTC.After(startWeekUTC) {
+		daysDiff := int(windowStartWeekUTC.Sub(startWeekUTC) / (24 * time.Hour))
 		startWeekIndex = daysDiff / (7 * interval)
 		if startWeekIndex < 0 {
 			startWeekIndex = 0
@@ -176,42 +273,28 @@ func GenerateWeeklyOccurrences(series RecurringSeries, windowStart, windowEnd ti
 	occPerWeek := len(weekdays)
 	skippedInFirstWeek := 0
 	for _, wd := range weekdays {
-		occDateUTC := startWeekMondayUTC.AddDate(0, 0, weekdayOffsetFromMonday(wd))
-		startLocal := time.Date(
-			occDateUTC.Year(),
-			occDateUTC.Month(),
-			occDateUTC.Day(),
-			dtstartLocal.Hour(),
-			dtstartLocal.Minute(),
-			dtstartLocal.Second(),
-			dtstartLocal.Nanosecond(),
-			loc,
-		)
+		occDateUTC := startWeekUTC.AddDate(0, 0, weekdayOffset(wd, wkst))
+		startLocal, _ := resolveLocalStart(occDateUTC, clock(wd), loc, series.DSTAmbiguous)
 		if startLocal.UTC().Before(dtstartUTC) {
 			skippedInFirstWeek++
 		}
 	}
 
 	out := make([]RecurringOccurrence, 0, 16)
+	limits := currentExpansionLimits()
 
 	for weekIndex := startWeekIndex; ; weekIndex++ {
-		weekStartMondayUTC := startWeekMondayUTC.AddDate(0, 0, weekIndex*interval*7)
-		if !weekStartMondayUTC.Before(windowEndWeekBoundaryUTC) {
+		weekStartUTC := startWeekUTC.AddDate(0, 0, weekIndex*interval*7)
+		if !weekStartUTC.Before(windowEndWeekBoundaryUTC) {
 			break
 		}
+		if limits.MaxWeeks > 0 && weekIndex-startWeekIndex >= limits.MaxWeeks {
+			return nil, expansionLimitError(series.ID, "weeks", limits.MaxWeeks)
+		}
 
 		for weekdayIndex, wd := range weekdays {
-			occDateUTC := weekStartMondayUTC.AddDate(0, 0, weekdayOffsetFromMonday(wd))
-			startLocal := time.Date(
-				occDateUTC.Year(),
-				occDateUTC.Month(),
-				occDateUTC.Day(),
-				dtstartLocal.Hour(),
-				dtstartLocal.Minute(),
-				dtstartLocal.Second(),
-				dtstartLocal.Nanosecond(),
-				loc,
-			)
+			occDateUTC := weekStartUTC.AddDate(0, 0, weekdayOffset(wd, wkst))
+			startLocal, inGap := resolveLocalStart(occDateUTC, clock(wd), loc, series.DSTAmbiguous)
 			startUTC := startLocal.UTC()
 			if startUTC.Before(dtstartUTC) {
 				continue
@@ -228,17 +311,25 @@ func GenerateWeeklyOccurrences(series RecurringSeries, windowStart, windowEnd ti
 				}
 			}
 
+			if inGap && series.DSTGap == DSTGapSkip {
+				continue
+			}
+
 			endUTC := startUTC.Add(duration)
 			if startUTC.Before(windowEnd) && endUTC.After(windowStart) {
-				occurrenceID := strconv.FormatInt(startUTC.UnixNano(), 10)
+				if limits.MaxOccurrences > 0 && len(out) >= limits.MaxOccurrences {
+					return nil, expansionLimitError(series.ID, "occurrences", limits.MaxOccurrences)
+				}
 				out = append(out, RecurringOccurrence{
-					ID:        occurrenceID,
+					ID:        EncodeOccurrenceID(series.ID, startUTC),
 					SeriesID:  series.ID,
 					UserID:    series.UserID,
 					Title:     series.Title,
 					Notes:     series.Notes,
 					StartTime: startUTC,
 					EndTime:   endUTC,
+					Metadata:  series.Metadata,
+					Timezone:  series.Timezone,
 				})
 			}
 		}
@@ -247,21 +338,18 @@ func GenerateWeeklyOccurrences(series RecurringSeries, windowStart, windowEnd ti
 	return out, nil
 }
 
-func mondayDateUTC(t time.Time) time.Time {
-	wd := t.Weekday()
-	offset := 0
-	if wd == time.Sunday {
-		offset = 6
-	} else {
-		offset = int(wd) - 1
+// weekStartDateUTC returns the calendar date, as UTC midnight, of the first
+// day of t's week when weeks begin on wkst (1 = Monday ... 7 = Sunday).
+func weekStartDateUTC(t time.Time, wkst int16) time.Time {
+	wd := int16(t.Weekday())
+	if wd == 0 {
+		wd = 7
 	}
 	d := time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
-	return d.AddDate(0, 0, -offset)
+	return d.AddDate(0, 0, -weekdayOffset(wd, wkst))
 }
 
-func weekdayOffsetFromMonday(weekday int16) int {
-	if weekday == 7 {
-		return 6
-	}
-	return int(weekday) - 1
+// weekdayOffset is how many days weekday falls after wkst within a week.
+func weekdayOffset(weekday, wkst int16) int {
+	return int((weekday - wkst + 7) % 7)
 }
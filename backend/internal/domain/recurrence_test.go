@@ -73,6 +73,15 @@ func TestGenerateWeeklyOccurrences_Validation(t *testing.T) {
 			}(),
 			wantErr: "at least one weekday is required",
 		},
+		{
+			name: "invalid week start",
+			series: func() RecurringSeries {
+				s := base
+				s.WeekStart = 8
+				return s
+			}(),
+			wantErr: "invalid week start",
+		},
 	}
 
 	for _, tt := range tests {
@@ -220,3 +229,50 @@ func TestGenerateWeeklyOccurrences_DSTMaintainsLocalHour(t *testing.T) {
 	}
 }
 
+func TestGenerateWeeklyOccurrences_WeekStartShiftsIntervalWeeks(t *testing.T) {
+	// RFC 5545 section 3.3.10: FREQ=WEEKLY;INTERVAL=2;COUNT=4;BYDAY=TU,SU
+	// starting Tuesday 1997-08-05 depends on WKST.
+	count := 4
+	base := RecurringSeries{
+		ID:              uuid.MustParse("00000000-0000-0000-0000-000000000003"),
+		UserID:          "u1",
+		Title:           "title",
+		Timezone:        "UTC",
+		DTStart:         time.Date(1997, 8, 5, 9, 0, 0, 0, time.UTC),
+		DurationSeconds: 3600,
+		Frequency:       RecurrenceFrequencyWeekly,
+		Interval:        2,
+		ByWeekday:       []int16{2, 7},
+		Count:           &count,
+	}
+
+	tests := []struct {
+		name     string
+		wkst     int16
+		wantDays []int
+	}{
+		{name: "default is monday", wkst: 0, wantDays: []int{5, 10, 19, 24}},
+		{name: "monday", wkst: 1, wantDays: []int{5, 10, 19, 24}},
+		{name: "sunday", wkst: 7, wantDays: []int{5, 17, 19, 31}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			series := base
+			series.WeekStart = tt.wkst
+			occs, err := GenerateWeeklyOccurrences(series, base.DTStart, base.DTStart.AddDate(0, 2, 0))
+			if err != nil {
+				t.Fatalf("GenerateWeeklyOccurrences error: %v", err)
+			}
+			if len(occs) != len(tt.wantDays) {
+				t.Fatalf("len(occs) = %d, want %d", len(occs), len(tt.wantDays))
+			}
+			for i, day := range tt.wantDays {
+				want := time.Date(1997, 8, day, 9, 0, 0, 0, time.UTC)
+				if !occs[i].StartTime.Equal(want) {
+					t.Fatalf("occs[%d] = %v, want %v", i, occs[i].StartTime, want)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,92 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type SeriesFindingKind string
+
+const (
+	// SeriesFindingInvalidTimeZone means the series zone no longer loads, for
+	// example after a tzdata update removed it. It cannot be repaired
+	// automatically.
+	SeriesFindingInvalidTimeZone SeriesFindingKind = "invalid_time_zone"
+	// SeriesFindingInvalidRule means the stored rule no longer expands, for
+	// example an out-of-range weekday written before validation tightened.
+	SeriesFindingInvalidRule SeriesFindingKind = "invalid_rule"
+	// SeriesFindingExceptionOutsideSeries is an exception dated before the
+	// first or after the last occurrence.
+	SeriesFindingExceptionOutsideSeries SeriesFindingKind = "exception_outside_series"
+	// SeriesFindingExceptionOffPattern is an exception inside the series
+	// bounds that matches no occurrence start.
+	SeriesFindingExceptionOffPattern SeriesFindingKind = "exception_off_pattern"
+	// SeriesFindingInvalidOverride is an override whose effective end is not
+	// after its effective start.
+	SeriesFindingInvalidOverride SeriesFindingKind = "invalid_override"
+)
+
+// SeriesFinding is one problem AuditRecurringSeries found. ExceptionID is nil
+// for series-level findings.
+type SeriesFinding struct {
+	Kind            SeriesFindingKind
+	ExceptionID     uuid.UUID
+	OccurrenceStart time.Time
+	Repaired        bool
+}
+
+// Repairable reports whether deleting the finding's exception fixes it.
+func (f SeriesFinding) Repairable() bool {
+	return f.ExceptionID != uuid.Nil
+}
+
+// AuditRecurringSeries checks exceptions against the occurrences series
+// actually produces within lookahead. Every exception finding is fixed by
+// deleting that exception; series-level findings need a person.
+func AuditRecurringSeries(series RecurringSeries, exceptions []RecurringException, lookahead time.Duration) []SeriesFinding {
+	if _, err := time.LoadLocation(series.Timezone); err != nil {
+		return []SeriesFinding{{Kind: SeriesFindingInvalidTimeZone}}
+	}
+	occs, err := GenerateWeeklyOccurrences(series, series.DTStart, SeriesHorizonEnd(series, lookahead))
+	if err != nil {
+		return []SeriesFinding{{Kind: SeriesFindingInvalidRule}}
+	}
+
+	byStart := make(map[int64]RecurringOccurrence, len(occs))
+	for _, o := range occs {
+		byStart[o.StartTime.UTC().UnixNano()] = o
+	}
+
+	var out []SeriesFinding
+	for _, ex := range exceptions {
+		finding := SeriesFinding{ExceptionID: ex.ID, OccurrenceStart: ex.OccurrenceStart.UTC()}
+		o, ok := byStart[finding.OccurrenceStart.UnixNano()]
+		switch {
+		case !ok && (len(occs) == 0 || finding.OccurrenceStart.Before(occs[0].StartTime) || finding.OccurrenceStart.After(occs[len(occs)-1].StartTime)):
+			finding.Kind = SeriesFindingExceptionOutsideSeries
+		case !ok:
+			finding.Kind = SeriesFindingExceptionOffPattern
+		case ex.Kind == RecurringExceptionKindOverride && !overrideEnd(ex, o).After(overrideStart(ex, o)):
+			finding.Kind = SeriesFindingInvalidOverride
+		default:
+			continue
+		}
+		out = append(out, finding)
+	}
+	return out
+}
+
+func overrideStart(ex RecurringException, o RecurringOccurrence) time.Time {
+	if ex.OverrideStart != nil {
+		return ex.OverrideStart.UTC()
+	}
+	return o.StartTime
+}
+
+func overrideEnd(ex RecurringException, o RecurringOccurrence) time.Time {
+	if ex.OverrideEnd != nil {
+		return ex.OverrideEnd.UTC()
+	}
+	return o.EndTime
+}
@@ -0,0 +1,60 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+func TestAuditRecurringSeries(t *testing.T) {
+	count := 3
+	series := RecurringSeries{
+		ID:              uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+		UserID:          "u1",
+		Timezone:        "UTC",
+		DTStart:         time.Date(2026, 1, 5, 9, 0, 0, 0, time.UTC),
+		DurationSeconds: 3600,
+		Frequency:       RecurrenceFrequencyWeekly,
+		Interval:        1,
+		ByWeekday:       []int16{1},
+		Count:           &count,
+	}
+	week := 7 * 24 * time.Hour
+	ptr := func(t time.Time) *time.Time { return &t }
+
+	valid := RecurringException{ID: uuid.New(), OccurrenceStart: series.DTStart.Add(week), Kind: RecurringExceptionKindSkip}
+	offPattern := RecurringException{ID: uuid.New(), OccurrenceStart: series.DTStart.Add(week + time.Hour), Kind: RecurringExceptionKindSkip}
+	outside := RecurringException{ID: uuid.New(), OccurrenceStart: series.DTStart.Add(3 * week), Kind: RecurringExceptionKindSkip}
+	badOverride := RecurringException{
+		ID:              uuid.New(),
+		OccurrenceStart: series.DTStart.Add(2 * week),
+		Kind:            RecurringExceptionKindOverride,
+		OverrideStart:   ptr(series.DTStart.Add(2*week + 2*time.Hour)),
+	}
+
+	findings := AuditRecurringSeries(series, []RecurringException{valid, offPattern, outside, badOverride}, 180*24*time.Hour)
+
+	want := map[uuid.UUID]SeriesFindingKind{
+		offPattern.ID:  SeriesFindingExceptionOffPattern,
+		outside.ID:     SeriesFindingExceptionOutsideSeries,
+		badOverride.ID: SeriesFindingInvalidOverride,
+	}
+	if len(findings) != len(want) {
+		t.Fatalf("len(findings) = %d, want %d: %+v", len(findings), len(want), findings)
+	}
+	for _, f := range findings {
+		if want[f.ExceptionID] != f.Kind {
+			t.Fatalf("finding %v kind = %q, want %q", f.ExceptionID, f.Kind, want[f.ExceptionID])
+		}
+		if !f.Repairable() {
+			t.Fatalf("finding %v not repairable", f.ExceptionID)
+		}
+	}
+
+	series.Timezone = "Gone/Away"
+	findings = AuditRecurringSeries(series, []RecurringException{offPattern}, 180*24*time.Hour)
+	if len(findings) != 1 || findings[0].Kind != SeriesFindingInvalidTimeZone || findings[0].Repairable() {
+		t.Fatalf("findings = %+v, want a single unrepairable invalid_time_zone", findings)
+	}
+}
@@ -0,0 +1,160 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// SeriesCompaction is what compacting an ended series removes without
+// changing what it expands to.
+type SeriesCompaction struct {
+	SeriesID uuid.UUID
+	UserID   string
+	// Dead are exceptions that match no occurrence of the series, so they
+	// change nothing.
+	Dead []uuid.UUID
+	// Until, when set, pulls the series end back to its last occurrence that
+	// is not skipped. TrailingSkips counts the skips after it, which the
+	// shorter series no longer needs.
+	Until         *time.Time
+	TrailingSkips int
+	// Fold reports that every occurrence is skipped or overridden. The
+	// series is then replaced by Archived, one appointment per overridden
+	// occurrence as it was shown, and deleted with all its exceptions.
+	// Dead and Until are moot when it folds.
+	Fold     bool
+	Archived []Appointment
+	// Removed is how many exceptions the compaction deletes.
+	Removed int
+}
+
+// Empty reports whether the compaction changes nothing.
+func (c SeriesCompaction) Empty() bool {
+	return len(c.Dead) == 0 && c.Until == nil && !c.Fold
+}
+
+// WithoutFold returns the compaction with the series kept, for when it
+// cannot be replaced by its archived appointments.
+func (c SeriesCompaction) WithoutFold() SeriesCompaction {
+	c.Fold = false
+	c.Archived = nil
+	c.Removed = len(c.Dead) + c.TrailingSkips
+	return c
+}
+
+// PlanSeriesCompaction works out the compaction of series given all its
+// exceptions. ok is false unless the series has an until or a count and its
+// last occurrence ended before cutoff; series still running are left alone.
+// Archived appointments take at as their creation time and an id derived
+// from the series and the occurrence, so planning twice gives the same rows.
+func PlanSeriesCompaction(series RecurringSeries, exceptions []RecurringException, cutoff, at time.Time) (SeriesCompaction, bool, error) {
+	occs, ok, err := boundedOccurrences(series)
+	if err != nil || !ok || len(occs) == 0 || occs[len(occs)-1].EndTime.After(cutoff) {
+		return SeriesCompaction{}, false, err
+	}
+
+	out := SeriesCompaction{SeriesID: series.ID, UserID: series.UserID}
+	byStart := make(map[int64]RecurringException, len(exceptions))
+	for _, ex := range exceptions {
+		byStart[ex.OccurrenceStart.UTC().UnixNano()] = ex
+	}
+	matched := make(map[uuid.UUID]bool, len(exceptions))
+	standing := 0
+	lastKept := -1
+	for i, o := range occs {
+		ex, ok := byStart[o.StartTime.UTC().UnixNano()]
+		if !ok {
+			standing++
+			lastKept = i
+			continue
+		}
+		matched[ex.ID] = true
+		if ex.Kind != RecurringExceptionKindSkip {
+			lastKept = i
+		}
+	}
+	for _, ex := range exceptions {
+		if !matched[ex.ID] {
+			out.Dead = append(out.Dead, ex.ID)
+		}
+	}
+
+	if trailing := len(occs) - 1 - lastKept; lastKept >= 0 && trailing > 0 {
+		until := occs[lastKept].StartTime.UTC()
+		out.Until = &until
+		out.TrailingSkips = trailing
+	}
+	out.Removed = len(out.Dead) + out.TrailingSkips
+	if standing > 0 {
+		return out, true, nil
+	}
+
+	out.Fold = true
+	out.Removed = len(exceptions)
+	for _, o := range occs {
+		ex := byStart[o.StartTime.UTC().UnixNano()]
+		if ex.Kind != RecurringExceptionKindOverride {
+			continue
+		}
+		start, end := overrideStart(ex, o), overrideEnd(ex, o)
+		if !end.After(start) {
+			// Never shown, so there is nothing to keep.
+			continue
+		}
+		out.Archived = append(out.Archived, archivedOccurrence(series, ex, o, start, end, at))
+	}
+	return out, true, nil
+}
+
+func archivedOccurrence(series RecurringSeries, ex RecurringException, o RecurringOccurrence, start, end, at time.Time) Appointment {
+	title, notes := o.Title, o.Notes
+	if ex.OverrideTitle != nil {
+		title = *ex.OverrideTitle
+	}
+	if ex.OverrideNotes != nil {
+		notes = *ex.OverrideNotes
+	}
+	return Appointment{
+		ID:        uuid.NewSHA1(series.ID, []byte(o.StartTime.UTC().Format(time.RFC3339Nano))),
+		UserID:    series.UserID,
+		Title:     title,
+		Notes:     notes,
+		StartTime: start,
+		EndTime:   end,
+		CreatedAt: at,
+		UpdatedAt: at,
+		Metadata:  series.Metadata,
+		Timezone:  series.Timezone,
+		CreatedBy: series.CreatedBy,
+		ProgramID: series.ProgramID,
+		Source:    SourceArchive,
+		Kind:      AppointmentKindEvent,
+	}
+}
+
+// boundedOccurrences expands every occurrence of a series that has an until
+// or a count. ok is false for a series without either.
+func boundedOccurrences(series RecurringSeries) ([]RecurringOccurrence, bool, error) {
+	var end time.Time
+	if series.Until != nil {
+		end = series.Until.UTC()
+	}
+	if series.Count != nil {
+		// Every active week holds at least one occurrence, so count weeks of
+		// interval, plus the partial week of DTStart, hold them all.
+		interval := max(series.Interval, 1)
+		byCount := series.DTStart.UTC().AddDate(0, 0, (*series.Count*interval+1)*7)
+		if end.IsZero() || byCount.Before(end) {
+			end = byCount
+		}
+	}
+	if end.IsZero() {
+		return nil, false, nil
+	}
+	occs, err := GenerateWeeklyOccurrences(series, series.DTStart, end.Add(time.Duration(series.DurationSeconds)*time.Second+time.Nanosecond))
+	if err != nil {
+		return nil, false, err
+	}
+	return occs, true, nil
+}
@@ -0,0 +1,73 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+func TestPlanSeriesCompaction(t *testing.T) {
+	count := 4
+	series := RecurringSeries{
+		ID:              uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+		UserID:          "u1",
+		Title:           "Standup",
+		Timezone:        "UTC",
+		DTStart:         time.Date(2026, 1, 5, 9, 0, 0, 0, time.UTC),
+		DurationSeconds: 3600,
+		Frequency:       RecurrenceFrequencyWeekly,
+		Interval:        1,
+		ByWeekday:       []int16{1},
+		Count:           &count,
+	}
+	week := 7 * 24 * time.Hour
+	at := time.Date(2026, 6, 1, 0, 0, 0, 0, time.UTC)
+	cutoff := at.Add(-30 * 24 * time.Hour)
+	skip := func(n int) RecurringException {
+		return RecurringException{ID: uuid.New(), OccurrenceStart: series.DTStart.Add(time.Duration(n) * week), Kind: RecurringExceptionKindSkip}
+	}
+
+	// A series still running when the cutoff falls is left alone.
+	if _, ok, err := PlanSeriesCompaction(series, []RecurringException{skip(1)}, series.DTStart.Add(2*week), at); ok || err != nil {
+		t.Fatalf("running series: ok = %v, err = %v", ok, err)
+	}
+	unbounded := series
+	unbounded.Count = nil
+	if _, ok, _ := PlanSeriesCompaction(unbounded, []RecurringException{skip(1)}, cutoff, at); ok {
+		t.Fatalf("unbounded series planned")
+	}
+
+	// Skips after the last kept occurrence go with a shorter series; a skip
+	// in the middle stays, and one off the pattern is dead.
+	dead := RecurringException{ID: uuid.New(), OccurrenceStart: series.DTStart.Add(time.Hour), Kind: RecurringExceptionKindSkip}
+	plan, ok, err := PlanSeriesCompaction(series, []RecurringException{skip(1), skip(3), dead}, cutoff, at)
+	if err != nil || !ok {
+		t.Fatalf("plan: ok = %v, err = %v", ok, err)
+	}
+	if plan.Fold || plan.TrailingSkips != 1 || plan.Until == nil || !plan.Until.Equal(series.DTStart.Add(2*week)) || len(plan.Dead) != 1 || plan.Dead[0] != dead.ID {
+		t.Fatalf("plan = %+v, want the end pulled back to the third occurrence and one dead skip", plan)
+	}
+	if plan.Removed != 2 {
+		t.Fatalf("removed = %d, want 2", plan.Removed)
+	}
+
+	// Every occurrence skipped or overridden: the series folds into the
+	// overrides as they were shown.
+	title := "Moved standup"
+	moved := series.DTStart.Add(2*week - time.Hour)
+	override := RecurringException{ID: uuid.New(), OccurrenceStart: series.DTStart.Add(2 * week), Kind: RecurringExceptionKindOverride, OverrideStart: &moved, OverrideTitle: &title}
+	plan, ok, err = PlanSeriesCompaction(series, []RecurringException{skip(0), skip(1), override, skip(3)}, cutoff, at)
+	if err != nil || !ok || !plan.Fold || len(plan.Archived) != 1 || plan.Removed != 4 {
+		t.Fatalf("fold plan = %+v, ok = %v, err = %v", plan, ok, err)
+	}
+	a := plan.Archived[0]
+	// The override moved only the start, so the occurrence's end stays.
+	if a.Title != title || !a.StartTime.Equal(moved) || !a.EndTime.Equal(series.DTStart.Add(2*week+time.Hour)) || a.Source != SourceArchive {
+		t.Fatalf("archived = %+v", a)
+	}
+	again, _, _ := PlanSeriesCompaction(series, []RecurringException{skip(0), skip(1), override, skip(3)}, cutoff, at)
+	if again.Archived[0].ID != a.ID {
+		t.Fatalf("archived id changed between plans")
+	}
+}
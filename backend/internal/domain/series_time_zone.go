@@ -0,0 +1,173 @@
+package domain
+
+import (
+	"errors"
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// TimeZoneKeep says what a series holds on to when it moves to another time
+// zone.
+type TimeZoneKeep string
+
+const (
+	// TimeZoneKeepWallTime keeps the local time of day, so a 9:00 meeting is
+	// at 9:00 in the new zone. The first occurrence, until and override
+	// times move by the difference between the zones.
+	TimeZoneKeepWallTime TimeZoneKeep = "wall_time"
+	// TimeZoneKeepInstant keeps the first week's occurrences at the same
+	// instants and rewrites the weekdays and times of day to match. After a
+	// DST change in only one of the zones, occurrences follow the new zone.
+	TimeZoneKeepInstant TimeZoneKeep = "instant"
+)
+
+func (k TimeZoneKeep) Valid() bool {
+	return k == TimeZoneKeepWallTime || k == TimeZoneKeepInstant
+}
+
+// MoveSeriesTimeZone returns series moved to tz and its exceptions moved
+// with their occurrences. Exceptions are matched to occurrences by position
+// in the series rather than by start, so a skip or override stays with its
+// occurrence when the start changes. The ids of exceptions that match no
+// occurrence of the moved series are returned in dropped.
+func MoveSeriesTimeZone(series RecurringSeries, exceptions []RecurringException, tz string, keep TimeZoneKeep, lookahead time.Duration) (moved RecurringSeries, kept []RecurringException, dropped []uuid.UUID, err error) {
+	from, err := time.LoadLocation(series.Timezone)
+	if err != nil {
+		return RecurringSeries{}, nil, nil, errors.New("the series time zone does not load")
+	}
+	to, err := time.LoadLocation(tz)
+	if err != nil || tz == "" {
+		return RecurringSeries{}, nil, nil, errors.New("invalid time_zone")
+	}
+
+	moved = series
+	moved.Timezone = tz
+	switch keep {
+	case TimeZoneKeepWallTime:
+		moved.DTStart = wallTimeIn(series.DTStart, from, to, series.DSTAmbiguous)
+		if series.Until != nil {
+			u := wallTimeIn(*series.Until, from, to, series.DSTAmbiguous)
+			moved.Until = &u
+		}
+	case TimeZoneKeepInstant:
+		moved.ByWeekday, moved.WeekdayTimes, err = weekdaysAtInstants(series, from, to)
+		if err != nil {
+			return RecurringSeries{}, nil, nil, err
+		}
+	default:
+		return RecurringSeries{}, nil, nil, errors.New("invalid keep")
+	}
+
+	before, err := occurrenceSlots(series, lookahead)
+	if err != nil {
+		return RecurringSeries{}, nil, nil, err
+	}
+	after, err := occurrenceSlots(moved, lookahead)
+	if err != nil {
+		return RecurringSeries{}, nil, nil, err
+	}
+	if len(after) == 0 {
+		return RecurringSeries{}, nil, nil, errors.New("the series has no occurrences in time_zone")
+	}
+
+	position := make(map[int64]int, len(before))
+	for i, o := range before {
+		position[o.StartTime.UTC().UnixNano()] = i
+	}
+	for _, ex := range exceptions {
+		i, ok := position[ex.OccurrenceStart.UTC().UnixNano()]
+		if !ok || i >= len(after) {
+			dropped = append(dropped, ex.ID)
+			continue
+		}
+		ex.OccurrenceStart = after[i].StartTime.UTC()
+		if keep == TimeZoneKeepWallTime {
+			if ex.OverrideStart != nil {
+				t := wallTimeIn(*ex.OverrideStart, from, to, series.DSTAmbiguous)
+				ex.OverrideStart = &t
+			}
+			if ex.OverrideEnd != nil {
+				t := wallTimeIn(*ex.OverrideEnd, from, to, series.DSTAmbiguous)
+				ex.OverrideEnd = &t
+			}
+		}
+		kept = append(kept, ex)
+	}
+	return moved, kept, dropped, nil
+}
+
+// occurrenceSlots expands every occurrence the rule counts, including those
+// a skip gap policy would drop, so positions line up across zones.
+func occurrenceSlots(series RecurringSeries, lookahead time.Duration) ([]RecurringOccurrence, error) {
+	series.DSTGap = DSTGapShiftForward
+	return GenerateWeeklyOccurrences(series, series.DTStart, SeriesHorizonEnd(series, lookahead))
+}
+
+// wallTimeIn returns the instant at which the clock in to reads what the
+// clock in from read at t.
+func wallTimeIn(t time.Time, from, to *time.Location, ambiguous DSTAmbiguousPolicy) time.Time {
+	local := t.In(from)
+	at, _ := resolveLocalStart(local, local, to, ambiguous)
+	return at.UTC()
+}
+
+// weekdaysAtInstants rewrites series' weekdays and per-weekday times so that
+// each of its first week's occurrences starts at the same instant in to.
+// A series repeating every few weeks cannot have a weekday cross the start
+// of its week, since that would move the occurrence into another week.
+func weekdaysAtInstants(series RecurringSeries, from, to *time.Location) ([]int16, []WeekdayTime, error) {
+	wkst := series.WeekStart
+	if wkst == 0 {
+		wkst = 1
+	}
+	dtstartLocal := series.DTStart.In(from)
+	week := weekStartDateUTC(dtstartLocal, wkst)
+	clocks := make(map[int16]time.Time, len(series.WeekdayTimes))
+	for _, wt := range series.WeekdayTimes {
+		clocks[wt.Weekday] = time.Date(0, 1, 1, wt.StartMinute/60, wt.StartMinute%60, 0, 0, time.UTC)
+	}
+	startLocal := series.DTStart.In(to)
+	startMinute := startLocal.Hour()*60 + startLocal.Minute()
+
+	seen := make(map[int16]struct{}, len(series.ByWeekday))
+	weekdays := make([]int16, 0, len(series.ByWeekday))
+	var times []WeekdayTime
+	for _, wd := range series.ByWeekday {
+		clock, ok := clocks[wd]
+		if !ok {
+			clock = dtstartLocal
+		}
+		date := week.AddDate(0, 0, weekdayOffset(wd, wkst))
+		at, _ := resolveLocalStart(date, clock, from, series.DSTAmbiguous)
+		local := at.In(to)
+
+		moved := isoWeekday(local.Weekday())
+		if _, ok := seen[moved]; ok {
+			return nil, nil, errors.New("two weekdays of the series fall on the same day in time_zone")
+		}
+		seen[moved] = struct{}{}
+		if series.Interval > 1 {
+			days := int(time.Date(local.Year(), local.Month(), local.Day(), 0, 0, 0, 0, time.UTC).Sub(date) / (24 * time.Hour))
+			if weekdayOffset(moved, wkst) != weekdayOffset(wd, wkst)+days {
+				return nil, nil, errors.New("a weekday of the series would cross the start of its week in time_zone")
+			}
+		}
+		weekdays = append(weekdays, moved)
+		if minute := local.Hour()*60 + local.Minute(); minute != startMinute {
+			times = append(times, WeekdayTime{Weekday: moved, StartMinute: minute})
+		}
+	}
+	sort.Slice(weekdays, func(i, j int) bool { return weekdays[i] < weekdays[j] })
+	sort.Slice(times, func(i, j int) bool { return times[i].Weekday < times[j].Weekday })
+	return weekdays, times, nil
+}
+
+// isoWeekday numbers wd from 1 for Monday to 7 for Sunday, as ByWeekday does.
+func isoWeekday(wd time.Weekday) int16 {
+	if wd == time.Sunday {
+		return 7
+	}
+	return int16(wd)
+}
@@ -0,0 +1,131 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+func TestMoveSeriesTimeZone_KeepWallTime(t *testing.T) {
+	ny, london := mustLoad(t, "America/New_York"), mustLoad(t, "Europe/London")
+	count := 4
+	series := RecurringSeries{
+		Timezone:        "America/New_York",
+		DTStart:         time.Date(2026, 1, 5, 9, 0, 0, 0, ny).UTC(),
+		DurationSeconds: 3600,
+		Frequency:       RecurrenceFrequencyWeekly,
+		Interval:        1,
+		ByWeekday:       []int16{1},
+		Count:           &count,
+	}
+	overrideStart := time.Date(2026, 1, 19, 10, 0, 0, 0, ny).UTC()
+	skip := RecurringException{ID: uuid.New(), OccurrenceStart: time.Date(2026, 1, 12, 9, 0, 0, 0, ny).UTC(), Kind: RecurringExceptionKindSkip}
+	override := RecurringException{ID: uuid.New(), OccurrenceStart: time.Date(2026, 1, 19, 9, 0, 0, 0, ny).UTC(), Kind: RecurringExceptionKindOverride, OverrideStart: &overrideStart}
+	stray := RecurringException{ID: uuid.New(), OccurrenceStart: time.Date(2026, 1, 20, 9, 0, 0, 0, ny).UTC(), Kind: RecurringExceptionKindSkip}
+
+	moved, kept, dropped, err := MoveSeriesTimeZone(series, []RecurringException{skip, override, stray}, "Europe/London", TimeZoneKeepWallTime, 180*24*time.Hour)
+	if err != nil {
+		t.Fatalf("MoveSeriesTimeZone error: %v", err)
+	}
+	if moved.Timezone != "Europe/London" || !moved.DTStart.Equal(time.Date(2026, 1, 5, 9, 0, 0, 0, london)) {
+		t.Fatalf("moved = %s at %v, want Europe/London at 9:00 local", moved.Timezone, moved.DTStart)
+	}
+	if len(kept) != 2 || len(dropped) != 1 || dropped[0] != stray.ID {
+		t.Fatalf("kept %d, dropped %v; want 2 kept and the stray dropped", len(kept), dropped)
+	}
+	if !kept[0].OccurrenceStart.Equal(time.Date(2026, 1, 12, 9, 0, 0, 0, london)) {
+		t.Fatalf("skip at %v, want the second Monday at 9:00 in London", kept[0].OccurrenceStart)
+	}
+	if !kept[1].OccurrenceStart.Equal(time.Date(2026, 1, 19, 9, 0, 0, 0, london)) || !kept[1].OverrideStart.Equal(time.Date(2026, 1, 19, 10, 0, 0, 0, london)) {
+		t.Fatalf("override at %v moved to %v, want 9:00 moved to 10:00 in London", kept[1].OccurrenceStart, kept[1].OverrideStart)
+	}
+}
+
+func TestMoveSeriesTimeZone_KeepInstant(t *testing.T) {
+	ny := mustLoad(t, "America/New_York")
+	count := 4
+	// Mondays at 21:00 and Wednesdays at 8:00 in New York: Tuesdays at 2:00
+	// and Wednesdays at 13:00 in London.
+	series := RecurringSeries{
+		Timezone:        "America/New_York",
+		DTStart:         time.Date(2026, 1, 5, 21, 0, 0, 0, ny).UTC(),
+		DurationSeconds: 3600,
+		Frequency:       RecurrenceFrequencyWeekly,
+		Interval:        1,
+		ByWeekday:       []int16{1, 3},
+		WeekdayTimes:    []WeekdayTime{{Weekday: 3, StartMinute: 8 * 60}},
+		Count:           &count,
+	}
+	skip := RecurringException{ID: uuid.New(), OccurrenceStart: time.Date(2026, 1, 14, 8, 0, 0, 0, ny).UTC(), Kind: RecurringExceptionKindSkip}
+
+	moved, kept, dropped, err := MoveSeriesTimeZone(series, []RecurringException{skip}, "Europe/London", TimeZoneKeepInstant, 180*24*time.Hour)
+	if err != nil {
+		t.Fatalf("MoveSeriesTimeZone error: %v", err)
+	}
+	if !moved.DTStart.Equal(series.DTStart) {
+		t.Fatalf("dtstart = %v, want it unchanged", moved.DTStart)
+	}
+	if len(moved.ByWeekday) != 2 || moved.ByWeekday[0] != 2 || moved.ByWeekday[1] != 3 {
+		t.Fatalf("weekdays = %v, want [2 3]", moved.ByWeekday)
+	}
+	if len(moved.WeekdayTimes) != 1 || moved.WeekdayTimes[0] != (WeekdayTime{Weekday: 3, StartMinute: 13 * 60}) {
+		t.Fatalf("weekday times = %v, want Wednesday at 13:00", moved.WeekdayTimes)
+	}
+	before, _ := GenerateWeeklyOccurrences(series, series.DTStart, series.DTStart.AddDate(0, 1, 0))
+	after, _ := GenerateWeeklyOccurrences(moved, moved.DTStart, moved.DTStart.AddDate(0, 1, 0))
+	if len(before) != len(after) {
+		t.Fatalf("got %d occurrences, want %d", len(after), len(before))
+	}
+	for i := range before {
+		if !before[i].StartTime.Equal(after[i].StartTime) {
+			t.Fatalf("occurrence %d at %v, want %v", i, after[i].StartTime, before[i].StartTime)
+		}
+	}
+	if len(kept) != 1 || len(dropped) != 0 || !kept[0].OccurrenceStart.Equal(skip.OccurrenceStart) {
+		t.Fatalf("kept %v, dropped %v; want the skip at the same instant", kept, dropped)
+	}
+}
+
+func TestMoveSeriesTimeZone_RejectsUnkeepableInstants(t *testing.T) {
+	ny := mustLoad(t, "America/New_York")
+	count := 4
+	base := RecurringSeries{
+		Timezone:        "America/New_York",
+		DTStart:         time.Date(2026, 1, 5, 21, 0, 0, 0, ny).UTC(),
+		DurationSeconds: 3600,
+		Frequency:       RecurrenceFrequencyWeekly,
+		Interval:        1,
+		Count:           &count,
+	}
+
+	// Monday at 21:00 and Tuesday at 1:00 both land on Tuesday in London.
+	collide := base
+	collide.ByWeekday = []int16{1, 2}
+	collide.WeekdayTimes = []WeekdayTime{{Weekday: 2, StartMinute: 60}}
+	if _, _, _, err := MoveSeriesTimeZone(collide, nil, "Europe/London", TimeZoneKeepInstant, 180*24*time.Hour); err == nil {
+		t.Fatalf("expected an error for weekdays landing on the same day")
+	}
+
+	// Every other Sunday at 21:00 becomes Monday, in the following week.
+	fortnightly := base
+	fortnightly.DTStart = time.Date(2026, 1, 4, 21, 0, 0, 0, ny).UTC()
+	fortnightly.Interval = 2
+	fortnightly.ByWeekday = []int16{7}
+	if _, _, _, err := MoveSeriesTimeZone(fortnightly, nil, "Europe/London", TimeZoneKeepInstant, 180*24*time.Hour); err == nil {
+		t.Fatalf("expected an error for a weekday crossing the week start")
+	}
+	// Keeping the wall time never moves a weekday.
+	if _, _, _, err := MoveSeriesTimeZone(fortnightly, nil, "Europe/London", TimeZoneKeepWallTime, 180*24*time.Hour); err != nil {
+		t.Fatalf("keep wall time error: %v", err)
+	}
+}
+
+func mustLoad(t *testing.T, name string) *time.Location {
+	t.Helper()
+	loc, err := time.LoadLocation(name)
+	if err != nil {
+		t.Fatalf("LoadLocation error: %v", err)
+	}
+	return loc
+}
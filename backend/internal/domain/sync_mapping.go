@@ -0,0 +1,30 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+)
+
+// SyncMapping pairs an event in an external calendar with the appointment a
+// sync connector made of it. A mapping outlives its appointment: deleting
+// the appointment clears AppointmentID, which tells the connector to delete
+// the remote event, and a mapping whose LastSyncedAt is older than a full
+// sync run names an event the provider no longer has.
+type SyncMapping struct {
+	bun.BaseModel `bun:"table:sync_mappings"`
+
+	UserID string `bun:"user_id,pk"`
+	// Provider names the external calendar, e.g. "google" or "outlook".
+	Provider   string `bun:"provider,pk"`
+	ExternalID string `bun:"external_id,pk"`
+	// AppointmentID is nil once the appointment has been deleted here.
+	AppointmentID *uuid.UUID `bun:"appointment_id,type:uuid"`
+	// ETag is the provider's version of the event as last synced, so an
+	// unchanged event can be skipped.
+	ETag         string    `bun:"etag,nullzero"`
+	LastSyncedAt time.Time `bun:"last_synced_at,notnull"`
+	CreatedAt    time.Time `bun:"created_at,notnull"`
+	UpdatedAt    time.Time `bun:"updated_at,notnull"`
+}
@@ -0,0 +1,102 @@
+package domain
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+)
+
+// TimeOff is a span, such as a vacation or a standing afternoon off, in
+// which a user takes no bookings. It shows as busy to others but is not an
+// appointment, so it never conflicts with the user's own bookings.
+//
+// A record with ByWeekday set repeats weekly: every Interval weeks on each
+// listed weekday at StartTime's wall-clock time in Timezone, until Until.
+type TimeOff struct {
+	bun.BaseModel `bun:"table:time_off"`
+
+	ID        uuid.UUID  `bun:"id,pk,type:uuid"`
+	UserID    string     `bun:"user_id,notnull"`
+	Title     string     `bun:"title,notnull"`
+	StartTime time.Time  `bun:"start_time,notnull"`
+	EndTime   time.Time  `bun:"end_time,notnull"`
+	Timezone  string     `bun:"timezone,nullzero"`
+	Interval  int        `bun:"interval,notnull"`
+	ByWeekday []int16    `bun:"byweekday,array"`
+	Until     *time.Time `bun:"until"`
+	CreatedAt time.Time  `bun:"created_at,notnull"`
+	UpdatedAt time.Time  `bun:"updated_at,notnull"`
+}
+
+func (t *TimeOff) BeforeAppendModel(ctx context.Context, query bun.Query) error {
+	now := time.Now().UTC()
+	switch query.(type) {
+	case *bun.InsertQuery:
+		if t.ID == uuid.Nil {
+			id, err := uuid.NewV7()
+			if err != nil {
+				return err
+			}
+			t.ID = id
+		}
+		if t.CreatedAt.IsZero() {
+			t.CreatedAt = now
+		}
+		if t.UpdatedAt.IsZero() {
+			t.UpdatedAt = now
+		}
+	case *bun.UpdateQuery:
+		t.UpdatedAt = now
+	}
+	return nil
+}
+
+func (t TimeOff) Recurring() bool {
+	return len(t.ByWeekday) > 0
+}
+
+// Spans returns the parts of t that fall within [windowStart, windowEnd),
+// expanding a recurring record into its occurrences.
+func (t TimeOff) Spans(windowStart, windowEnd time.Time) ([]BusyInterval, error) {
+	clip := func(start, end time.Time) BusyInterval {
+		iv := BusyInterval{Start: start.UTC(), End: end.UTC()}
+		if iv.Start.Before(windowStart) {
+			iv.Start = windowStart
+		}
+		if iv.End.After(windowEnd) {
+			iv.End = windowEnd
+		}
+		return iv
+	}
+	if !t.Recurring() {
+		if !t.StartTime.Before(windowEnd) || !t.EndTime.After(windowStart) {
+			return nil, nil
+		}
+		return []BusyInterval{clip(t.StartTime, t.EndTime)}, nil
+	}
+
+	duration := t.EndTime.Sub(t.StartTime)
+	occs, err := GenerateWeeklyOccurrences(RecurringSeries{
+		ID:              t.ID,
+		UserID:          t.UserID,
+		Timezone:        t.Timezone,
+		DTStart:         t.StartTime,
+		DurationSeconds: int(duration / time.Second),
+		Frequency:       RecurrenceFrequencyWeekly,
+		Interval:        t.Interval,
+		ByWeekday:       t.ByWeekday,
+		Until:           t.Until,
+	}, windowStart.Add(-duration), windowEnd)
+	if err != nil {
+		return nil, err
+	}
+	out := make([]BusyInterval, 0, len(occs))
+	for _, o := range occs {
+		if o.StartTime.Before(windowEnd) && o.EndTime.After(windowStart) {
+			out = append(out, clip(o.StartTime, o.EndTime))
+		}
+	}
+	return out, nil
+}
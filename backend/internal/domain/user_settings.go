@@ -0,0 +1,136 @@
+package domain
+
+import (
+	"strings"
+	"time"
+
+	"github.com/uptrace/bun"
+)
+
+// UserSettings holds a user's per-calendar preferences. A user without a row
+// has the zero value, which imposes no rules.
+type UserSettings struct {
+	bun.BaseModel `bun:"table:user_settings"`
+
+	UserID string `bun:"user_id,pk"`
+	// SlotAlignmentMinutes requires bookings to start on a multiple of this
+	// many minutes past the hour in SlotTimeZone; 0 means any minute.
+	SlotAlignmentMinutes int `bun:"slot_alignment_minutes,notnull"`
+	// SlotTimeZone is the IANA zone alignment and breaks are measured in;
+	// empty is UTC.
+	SlotTimeZone string `bun:"slot_time_zone,notnull"`
+	// DailyBreaks are taken out of the user's availability every day.
+	DailyBreaks []DailyBreak `bun:"daily_breaks,type:jsonb,nullzero"`
+	// PastStartPolicy overrides the server's policy for appointments
+	// created with a start already in the past; empty uses the server's.
+	PastStartPolicy PastStartPolicy `bun:"past_start_policy,notnull"`
+	// RetentionDays overrides the server's retention: appointments that
+	// ended more than this many days ago are purged, and 0 keeps them
+	// forever. Nil uses the server's.
+	RetentionDays *int `bun:"retention_days"`
+	// RequestTimeoutSeconds lengthens the server's timeout for the user's
+	// requests; nil uses the server's.
+	RequestTimeoutSeconds *int `bun:"request_timeout_seconds"`
+	// LimitsMultiplier scales the server's field limits for the user's
+	// requests; nil uses them as they are.
+	LimitsMultiplier *int `bun:"limits_multiplier"`
+	// SourceDefaults decorate appointments created through the sources
+	// they name.
+	SourceDefaults []SourceDefault `bun:"source_defaults,type:jsonb,nullzero"`
+	UpdatedAt      time.Time       `bun:"updated_at,notnull"`
+}
+
+// PastStartPolicy decides what happens to an appointment created with a
+// start time that has already passed.
+type PastStartPolicy string
+
+const (
+	PastStartAllow  PastStartPolicy = "allow"
+	PastStartWarn   PastStartPolicy = "warn"
+	PastStartReject PastStartPolicy = "reject"
+)
+
+func (p PastStartPolicy) Valid() bool {
+	switch p {
+	case PastStartAllow, PastStartWarn, PastStartReject:
+		return true
+	}
+	return false
+}
+
+// DailyBreak is a recurring gap, such as lunch, in local minutes since
+// midnight.
+type DailyBreak struct {
+	Label       string `json:"label,omitempty"`
+	StartMinute int    `json:"start_minute"`
+	EndMinute   int    `json:"end_minute"`
+}
+
+// DailyBreakSpans returns the occurrences of breaks in loc that overlap
+// [start, end), clipped to it. Minutes are wall-clock, so a break keeps its
+// local time across DST changes.
+func DailyBreakSpans(breaks []DailyBreak, loc *time.Location, start, end time.Time) []BusyInterval {
+	if len(breaks) == 0 || !end.After(start) {
+		return nil
+	}
+	var out []BusyInterval
+	first := start.In(loc).AddDate(0, 0, -1)
+	for day := time.Date(first.Year(), first.Month(), first.Day(), 0, 0, 0, 0, loc); day.Before(end); day = day.AddDate(0, 0, 1) {
+		for _, b := range breaks {
+			from := time.Date(day.Year(), day.Month(), day.Day(), 0, b.StartMinute, 0, 0, loc).UTC()
+			to := time.Date(day.Year(), day.Month(), day.Day(), 0, b.EndMinute, 0, 0, loc).UTC()
+			if !from.Before(end) || !to.After(start) {
+				continue
+			}
+			if from.Before(start) {
+				from = start
+			}
+			if to.After(end) {
+				to = end
+			}
+			out = append(out, BusyInterval{Start: from, End: to})
+		}
+	}
+	return out
+}
+
+// SourceDefault is a title prefix and notes footer added to appointments
+// created through Source: an exact source such as "booking", or a prefix
+// ending in ":" such as "sync:" for every sync.
+type SourceDefault struct {
+	Source      string `json:"source"`
+	TitlePrefix string `json:"title_prefix,omitempty"`
+	NotesFooter string `json:"notes_footer,omitempty"`
+}
+
+// MatchSourceDefault returns the default for source: an exact match if
+// there is one, else the longest matching prefix.
+func MatchSourceDefault(defaults []SourceDefault, source string) (SourceDefault, bool) {
+	var best SourceDefault
+	found := false
+	for _, d := range defaults {
+		if d.Source == source {
+			return d, true
+		}
+		if strings.HasSuffix(d.Source, ":") && strings.HasPrefix(source, d.Source) && len(d.Source) > len(best.Source) {
+			best, found = d, true
+		}
+	}
+	return best, found
+}
+
+// Apply returns title and notes with the prefix and footer added. Text that
+// already carries them is left alone, so a replayed create does not add
+// them twice.
+func (d SourceDefault) Apply(title, notes string) (string, string) {
+	if d.TitlePrefix != "" && !strings.HasPrefix(title, d.TitlePrefix) {
+		title = d.TitlePrefix + title
+	}
+	if d.NotesFooter != "" && !strings.HasSuffix(notes, d.NotesFooter) {
+		if notes != "" {
+			notes += "\n\n"
+		}
+		notes += d.NotesFooter
+	}
+	return title, notes
+}
@@ -0,0 +1,30 @@
+package domain
+
+import (
+	"testing"
+	"time"
+)
+
+func TestDailyBreakSpans_KeepsLocalTimeAcrossDST(t *testing.T) {
+	loc, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Fatalf("load location: %v", err)
+	}
+	lunch := []DailyBreak{{Label: "Lunch", StartMinute: 12 * 60, EndMinute: 13 * 60}}
+	start := time.Date(2030, 3, 9, 0, 0, 0, 0, time.UTC)
+	end := time.Date(2030, 3, 10, 16, 30, 0, 0, time.UTC)
+
+	got := DailyBreakSpans(lunch, loc, start, end)
+	want := []BusyInterval{
+		{Start: time.Date(2030, 3, 9, 17, 0, 0, 0, time.UTC), End: time.Date(2030, 3, 9, 18, 0, 0, 0, time.UTC)},
+		{Start: time.Date(2030, 3, 10, 16, 0, 0, 0, time.UTC), End: end},
+	}
+	if len(got) != len(want) {
+		t.Fatalf("spans = %+v, want %+v", got, want)
+	}
+	for i := range want {
+		if !got[i].Start.Equal(want[i].Start) || !got[i].End.Equal(want[i].End) {
+			t.Fatalf("spans[%d] = %+v, want %+v", i, got[i], want[i])
+		}
+	}
+}
@@ -0,0 +1,20 @@
+package domain
+
+// WarningCode names the advisory check behind a Warning, so clients can
+// choose how to show it without parsing the message.
+type WarningCode string
+
+const (
+	WarningCodeBlackout   WarningCode = "blackout"
+	WarningCodePastStart  WarningCode = "past_start"
+	WarningCodeBackToBack WarningCode = "back_to_back"
+	WarningCodeDailyBreak WarningCode = "daily_break"
+	WarningCodeOverlap    WarningCode = "overlap"
+)
+
+// Warning is advice about a write that went through. Warnings never block a
+// write; they tell the caller about something they may want to change.
+type Warning struct {
+	Code    WarningCode
+	Message string
+}
@@ -0,0 +1,39 @@
+package domain
+
+// WeekdayMask holds a set of ByWeekday days as bits, 1 << 0 for Monday to
+// 1 << 6 for Sunday. Series store it next to ByWeekday so SQL can filter on
+// weekdays with a bitwise AND instead of unnesting the array.
+type WeekdayMask int16
+
+// AllWeekdays has every day of the week set.
+const AllWeekdays WeekdayMask = 1<<7 - 1
+
+// WeekdayMaskOf sets the bit of each weekday in weekdays, numbered as
+// ByWeekday is. Days outside 1..7 are ignored.
+func WeekdayMaskOf(weekdays []int16) WeekdayMask {
+	var m WeekdayMask
+	for _, wd := range weekdays {
+		if wd >= 1 && wd <= 7 {
+			m |= 1 << (wd - 1)
+		}
+	}
+	return m
+}
+
+// Has reports whether wd, numbered as ByWeekday is, is set.
+func (m WeekdayMask) Has(wd int16) bool {
+	return wd >= 1 && wd <= 7 && m&(1<<(wd-1)) != 0
+}
+
+// Widen adds the days up to days either side of each set day, wrapping from
+// Sunday to Monday, so a mask of start days covers the days an occurrence
+// starting on one of them can reach.
+func (m WeekdayMask) Widen(days int) WeekdayMask {
+	m &= AllWeekdays
+	out := m
+	for i := 1; i <= days && out != AllWeekdays; i++ {
+		out |= (m<<i | m>>(7-i)) & AllWeekdays
+		out |= (m>>i | m<<(7-i)) & AllWeekdays
+	}
+	return out
+}
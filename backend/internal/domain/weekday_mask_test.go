@@ -0,0 +1,38 @@
+package domain
+
+import "testing"
+
+func TestWeekdayMaskOf(t *testing.T) {
+	m := WeekdayMaskOf([]int16{1, 3, 7, 3, 0, 8})
+	if m != 0b1000101 {
+		t.Fatalf("mask = %07b, want 1000101", m)
+	}
+	for wd, want := range map[int16]bool{1: true, 2: false, 3: true, 6: false, 7: true, 0: false, 8: false} {
+		if m.Has(wd) != want {
+			t.Errorf("Has(%d) = %v, want %v", wd, !want, want)
+		}
+	}
+}
+
+func TestWeekdayMaskWiden(t *testing.T) {
+	cases := []struct {
+		name string
+		in   WeekdayMask
+		days int
+		want WeekdayMask
+	}{
+		{name: "none", in: WeekdayMaskOf([]int16{3}), days: 0, want: WeekdayMaskOf([]int16{3})},
+		{name: "midweek", in: WeekdayMaskOf([]int16{3}), days: 1, want: WeekdayMaskOf([]int16{2, 3, 4})},
+		{name: "wraps past sunday", in: WeekdayMaskOf([]int16{7}), days: 1, want: WeekdayMaskOf([]int16{6, 7, 1})},
+		{name: "wraps before monday", in: WeekdayMaskOf([]int16{1}), days: 2, want: WeekdayMaskOf([]int16{6, 7, 1, 2, 3})},
+		{name: "saturates", in: WeekdayMaskOf([]int16{1, 5}), days: 3, want: AllWeekdays},
+		{name: "empty", in: 0, days: 2, want: 0},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := tc.in.Widen(tc.days); got != tc.want {
+				t.Fatalf("Widen(%d) = %07b, want %07b", tc.days, got, tc.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,51 @@
+package domain
+
+import (
+	"testing"
+	"time"
+)
+
+func TestGenerateWeeklyOccurrences_WeekdayTimes(t *testing.T) {
+	ny, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Fatalf("LoadLocation error: %v", err)
+	}
+	// Mondays at 9:00 and Wednesdays at 14:00, starting the Monday before
+	// spring forward so the local times hold across it.
+	dtstart := time.Date(2026, 3, 2, 9, 0, 0, 0, ny)
+	count := 4
+	series := RecurringSeries{
+		Timezone:        "America/New_York",
+		DTStart:         dtstart.UTC(),
+		DurationSeconds: 3600,
+		Frequency:       RecurrenceFrequencyWeekly,
+		Interval:        1,
+		ByWeekday:       []int16{1, 3},
+		Count:           &count,
+		WeekdayTimes:    []WeekdayTime{{Weekday: 3, StartMinute: 14 * 60}},
+	}
+
+	occs, err := GenerateWeeklyOccurrences(series, dtstart, dtstart.AddDate(0, 1, 0))
+	if err != nil {
+		t.Fatalf("GenerateWeeklyOccurrences error: %v", err)
+	}
+	want := []time.Time{
+		time.Date(2026, 3, 2, 9, 0, 0, 0, ny),
+		time.Date(2026, 3, 4, 14, 0, 0, 0, ny),
+		time.Date(2026, 3, 9, 9, 0, 0, 0, ny),
+		time.Date(2026, 3, 11, 14, 0, 0, 0, ny),
+	}
+	if len(occs) != len(want) {
+		t.Fatalf("got %d occurrences, want %d", len(occs), len(want))
+	}
+	for i, o := range occs {
+		if !o.StartTime.Equal(want[i]) || !o.EndTime.Equal(want[i].Add(time.Hour)) {
+			t.Fatalf("occurrence %d = %v-%v, want start %v", i, o.StartTime, o.EndTime, want[i])
+		}
+	}
+
+	series.WeekdayTimes = []WeekdayTime{{Weekday: 5, StartMinute: 600}}
+	if _, err := GenerateWeeklyOccurrences(series, dtstart, dtstart.AddDate(0, 1, 0)); err == nil {
+		t.Fatalf("expected error for a time on a weekday the series skips")
+	}
+}
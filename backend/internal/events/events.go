@@ -0,0 +1,138 @@
+// Package events is an in-process bus for domain events. Writers publish
+// after their change commits; subsystems that react to changes, such as
+// caches and watchers, subscribe to the events they need instead of being
+// called from every write path.
+package events
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Event is a domain event. Name is fixed per type, such as
+// "appointment.created", so it must work on the zero value.
+type Event interface {
+	Name() string
+}
+
+// AppointmentCreated is published after an appointment is created, however
+// it was created.
+type AppointmentCreated struct {
+	UserID        string
+	AppointmentID uuid.UUID
+}
+
+func (AppointmentCreated) Name() string { return "appointment.created" }
+
+// AppointmentUpdated is published after an appointment's fields change.
+type AppointmentUpdated struct {
+	UserID        string
+	AppointmentID uuid.UUID
+}
+
+func (AppointmentUpdated) Name() string { return "appointment.updated" }
+
+// AppointmentDeleted is published after an appointment is deleted.
+type AppointmentDeleted struct {
+	UserID        string
+	AppointmentID uuid.UUID
+}
+
+func (AppointmentDeleted) Name() string { return "appointment.deleted" }
+
+// SeriesCreated is published after a recurring series is created.
+type SeriesCreated struct {
+	UserID   string
+	SeriesID uuid.UUID
+}
+
+func (SeriesCreated) Name() string { return "series.created" }
+
+// SeriesUpdated is published after a series' rule or exceptions change in a
+// way no narrower event describes, including when it is ended or deleted.
+// Subscribers reload the series.
+type SeriesUpdated struct {
+	UserID   string
+	SeriesID uuid.UUID
+}
+
+func (SeriesUpdated) Name() string { return "series.updated" }
+
+// ExceptionUpserted is published after an exception is added to or changed
+// on one occurrence of a series, keyed by the occurrence's generated start.
+type ExceptionUpserted struct {
+	UserID          string
+	SeriesID        uuid.UUID
+	OccurrenceStart time.Time
+}
+
+func (ExceptionUpserted) Name() string { return "exception.upserted" }
+
+type handler struct {
+	id uint64
+	fn func(context.Context, Event)
+}
+
+// Bus delivers each published event to the handlers subscribed to its name.
+// Delivery is synchronous, on the publisher's goroutine, in subscription
+// order, so a handler sees events in the order they were published. Handlers
+// must be quick; one that does I/O should hand the event to its own
+// goroutine. The zero value is ready to use.
+type Bus struct {
+	mu     sync.RWMutex
+	nextID uint64
+	subs   map[string][]handler
+}
+
+// NewBus returns an empty bus.
+func NewBus() *Bus {
+	return &Bus{}
+}
+
+// Subscribe calls fn with every E published on b until the returned function
+// is called.
+func Subscribe[E Event](b *Bus, fn func(ctx context.Context, e E)) (unsubscribe func()) {
+	var zero E
+	name := zero.Name()
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.subs == nil {
+		b.subs = make(map[string][]handler)
+	}
+	b.nextID++
+	id := b.nextID
+	b.subs[name] = append(b.subs[name], handler{id: id, fn: func(ctx context.Context, e Event) {
+		if typed, ok := e.(E); ok {
+			fn(ctx, typed)
+		}
+	}})
+
+	return func() {
+		b.mu.Lock()
+		defer b.mu.Unlock()
+		hs := b.subs[name]
+		for i, h := range hs {
+			if h.id == id {
+				b.subs[name] = append(hs[:i:i], hs[i+1:]...)
+				break
+			}
+		}
+	}
+}
+
+// Publish delivers e to its subscribers. A nil bus drops it.
+func (b *Bus) Publish(ctx context.Context, e Event) {
+	if b == nil {
+		return
+	}
+	b.mu.RLock()
+	hs := b.subs[e.Name()]
+	b.mu.RUnlock()
+	for _, h := range hs {
+		h.fn(ctx, e)
+	}
+}
@@ -0,0 +1,55 @@
+package events
+
+import (
+	"context"
+	"slices"
+	"testing"
+
+	"github.com/google/uuid"
+)
+
+func TestBus_DeliversByTypeInOrder(t *testing.T) {
+	b := NewBus()
+	var got []string
+	Subscribe(b, func(ctx context.Context, e AppointmentCreated) { got = append(got, "first "+e.UserID) })
+	Subscribe(b, func(ctx context.Context, e AppointmentCreated) { got = append(got, "second "+e.UserID) })
+	Subscribe(b, func(ctx context.Context, e SeriesUpdated) { got = append(got, "series "+e.SeriesID.String()) })
+
+	b.Publish(context.Background(), AppointmentCreated{UserID: "u1"})
+	b.Publish(context.Background(), AppointmentDeleted{UserID: "u1"})
+	b.Publish(context.Background(), SeriesUpdated{UserID: "u1", SeriesID: uuid.Nil})
+
+	want := []string{"first u1", "second u1", "series " + uuid.Nil.String()}
+	if !slices.Equal(got, want) {
+		t.Fatalf("delivered = %q, want %q", got, want)
+	}
+}
+
+func TestBus_Unsubscribe(t *testing.T) {
+	b := NewBus()
+	var first, second int
+	stop := Subscribe(b, func(ctx context.Context, e SeriesCreated) { first++ })
+	Subscribe(b, func(ctx context.Context, e SeriesCreated) { second++ })
+
+	b.Publish(context.Background(), SeriesCreated{})
+	stop()
+	stop()
+	b.Publish(context.Background(), SeriesCreated{})
+
+	if first != 1 || second != 2 {
+		t.Fatalf("deliveries = %d, %d; want 1, 2", first, second)
+	}
+}
+
+func TestBus_NilAndZeroValue(t *testing.T) {
+	var nilBus *Bus
+	nilBus.Publish(context.Background(), ExceptionUpserted{})
+
+	var b Bus
+	got := 0
+	Subscribe(&b, func(ctx context.Context, e ExceptionUpserted) { got++ })
+	b.Publish(context.Background(), ExceptionUpserted{})
+	if got != 1 {
+		t.Fatalf("deliveries = %d, want 1", got)
+	}
+}
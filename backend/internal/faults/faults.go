@@ -0,0 +1,111 @@
+// Package faults injects delays, serialization failures and dropped
+// connections into database calls so retry and timeout handling can be
+// exercised end to end in staging. The driver hook is compiled in only with
+// the "faults" build tag; other builds ignore the configuration.
+package faults
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/rand"
+	"net"
+	"sync"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgconn"
+
+	"schedula/backend/internal/store/pgerrors"
+)
+
+// Config sets how often each fault fires. Rates are per statement, from 0
+// (never) to 1 (always).
+type Config struct {
+	// DelayRate is the share of statements delayed by up to MaxDelay.
+	DelayRate float64
+	MaxDelay  time.Duration
+	// SerializationRate is the share of statements that fail with
+	// SQLSTATE 40001 instead of running.
+	SerializationRate float64
+	// DropRate is the share of statements whose connection is closed
+	// instead of running; the pool then discards it.
+	DropRate float64
+	// Seed makes a run repeatable; zero seeds from the clock.
+	Seed int64
+}
+
+// Active reports whether any fault can fire.
+func (c Config) Active() bool {
+	return (c.DelayRate > 0 && c.MaxDelay > 0) || c.SerializationRate > 0 || c.DropRate > 0
+}
+
+func (c Config) Validate() error {
+	rates := []struct {
+		name string
+		rate float64
+	}{{"delay_rate", c.DelayRate}, {"serialization_rate", c.SerializationRate}, {"drop_rate", c.DropRate}}
+	for _, r := range rates {
+		if r.rate < 0 || r.rate > 1 {
+			return fmt.Errorf("invalid faults.%s %v (want 0 to 1)", r.name, r.rate)
+		}
+	}
+	if c.MaxDelay < 0 {
+		return fmt.Errorf("invalid faults.max_delay %s (want 0 or more)", c.MaxDelay)
+	}
+	return nil
+}
+
+// errConnDropped stands in for the network error a severed connection
+// returns, so it is classified as store.ErrUnavailable like a real one.
+var errConnDropped = &net.OpError{Op: "read", Net: "tcp", Err: errors.New("fault injection: connection dropped")}
+
+// Injector decides which fault, if any, hits each statement.
+type Injector struct {
+	cfg Config
+
+	mu  sync.Mutex
+	rng *rand.Rand
+}
+
+func NewInjector(cfg Config) *Injector {
+	seed := cfg.Seed
+	if seed == 0 {
+		seed = time.Now().UnixNano()
+	}
+	return &Injector{cfg: cfg, rng: rand.New(rand.NewSource(seed))}
+}
+
+// Before runs ahead of a statement. It may sleep, and returns the error the
+// statement should fail with instead of running. dropped reports that the
+// connection must be treated as closed.
+func (i *Injector) Before(ctx context.Context) (dropped bool, err error) {
+	i.mu.Lock()
+	delay := time.Duration(0)
+	if i.cfg.MaxDelay > 0 && i.rng.Float64() < i.cfg.DelayRate {
+		delay = time.Duration(i.rng.Int63n(int64(i.cfg.MaxDelay)) + 1)
+	}
+	serialization := i.rng.Float64() < i.cfg.SerializationRate
+	drop := i.rng.Float64() < i.cfg.DropRate
+	i.mu.Unlock()
+
+	if delay > 0 {
+		timer := time.NewTimer(delay)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return false, ctx.Err()
+		case <-timer.C:
+		}
+	}
+	switch {
+	case drop:
+		return true, errConnDropped
+	case serialization:
+		return false, &pgconn.PgError{
+			Severity: "ERROR",
+			Code:     pgerrors.CodeSerializationFailure,
+			Message:  "fault injection: could not serialize access due to concurrent update",
+		}
+	}
+	return false, nil
+}
@@ -0,0 +1,13 @@
+//go:build !faults
+
+package faults
+
+import "database/sql/driver"
+
+// Enabled reports whether this binary was built with the "faults" tag.
+const Enabled = false
+
+// Wrap returns c unchanged; fault injection is not compiled in.
+func Wrap(c driver.Connector, inj *Injector) driver.Connector {
+	return c
+}
@@ -0,0 +1,108 @@
+//go:build faults
+
+package faults
+
+import (
+	"context"
+	"database/sql/driver"
+)
+
+// Enabled reports whether this binary was built with the "faults" tag.
+const Enabled = true
+
+// Wrap returns a connector whose connections consult inj before every
+// statement and transaction. A nil inj leaves c unchanged.
+func Wrap(c driver.Connector, inj *Injector) driver.Connector {
+	if inj == nil {
+		return c
+	}
+	return &connector{Connector: c, inj: inj}
+}
+
+type connector struct {
+	driver.Connector
+	inj *Injector
+}
+
+func (c *connector) Connect(ctx context.Context) (driver.Conn, error) {
+	inner, err := c.Connector.Connect(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &conn{Conn: inner, inj: c.inj}, nil
+}
+
+// conn forwards to the driver's connection. The optional interfaces are
+// forwarded explicitly because database/sql checks for them on the value
+// it holds, not on the embedded one.
+type conn struct {
+	driver.Conn
+	inj     *Injector
+	dropped bool
+}
+
+func (c *conn) before(ctx context.Context) error {
+	if c.dropped {
+		return driver.ErrBadConn
+	}
+	dropped, err := c.inj.Before(ctx)
+	if dropped {
+		c.dropped = true
+		_ = c.Conn.Close()
+	}
+	return err
+}
+
+func (c *conn) ExecContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	if err := c.before(ctx); err != nil {
+		return nil, err
+	}
+	return c.Conn.(driver.ExecerContext).ExecContext(ctx, query, args)
+}
+
+func (c *conn) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	if err := c.before(ctx); err != nil {
+		return nil, err
+	}
+	return c.Conn.(driver.QueryerContext).QueryContext(ctx, query, args)
+}
+
+func (c *conn) BeginTx(ctx context.Context, opts driver.TxOptions) (driver.Tx, error) {
+	if err := c.before(ctx); err != nil {
+		return nil, err
+	}
+	return c.Conn.(driver.ConnBeginTx).BeginTx(ctx, opts)
+}
+
+func (c *conn) PrepareContext(ctx context.Context, query string) (driver.Stmt, error) {
+	return c.Conn.(driver.ConnPrepareContext).PrepareContext(ctx, query)
+}
+
+func (c *conn) Ping(ctx context.Context) error {
+	if c.dropped {
+		return driver.ErrBadConn
+	}
+	return c.Conn.(driver.Pinger).Ping(ctx)
+}
+
+func (c *conn) CheckNamedValue(v *driver.NamedValue) error {
+	return c.Conn.(driver.NamedValueChecker).CheckNamedValue(v)
+}
+
+func (c *conn) ResetSession(ctx context.Context) error {
+	if c.dropped {
+		return driver.ErrBadConn
+	}
+	return c.Conn.(driver.SessionResetter).ResetSession(ctx)
+}
+
+func (c *conn) IsValid() bool {
+	return !c.dropped && c.Conn.(driver.Validator).IsValid()
+}
+
+func (c *conn) Close() error {
+	if c.dropped {
+		return nil
+	}
+	return c.Conn.Close()
+}
@@ -0,0 +1,58 @@
+package faults
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"schedula/backend/internal/store"
+	"schedula/backend/internal/store/pgerrors"
+)
+
+func TestInjector_FaultsClassifyAsRetryable(t *testing.T) {
+	inj := NewInjector(Config{SerializationRate: 1, Seed: 1})
+	dropped, err := inj.Before(context.Background())
+	if dropped || !errors.Is(pgerrors.Classify(err), store.ErrSerialization) {
+		t.Fatalf("serialization fault = %v (dropped %v), want ErrSerialization", err, dropped)
+	}
+
+	inj = NewInjector(Config{DropRate: 1, Seed: 1})
+	dropped, err = inj.Before(context.Background())
+	if !dropped || !errors.Is(pgerrors.Classify(err), store.ErrUnavailable) {
+		t.Fatalf("drop fault = %v (dropped %v), want ErrUnavailable", err, dropped)
+	}
+
+	inj = NewInjector(Config{Seed: 1})
+	if dropped, err := inj.Before(context.Background()); dropped || err != nil {
+		t.Fatalf("inactive injector = %v (dropped %v), want nothing", err, dropped)
+	}
+}
+
+func TestInjector_DelayHonoursDeadline(t *testing.T) {
+	inj := NewInjector(Config{DelayRate: 1, MaxDelay: time.Hour, Seed: 1})
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	start := time.Now()
+	if _, err := inj.Before(ctx); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("err = %v, want deadline exceeded", err)
+	}
+	if elapsed := time.Since(start); elapsed > time.Second {
+		t.Fatalf("delay ran %s past the deadline", elapsed)
+	}
+}
+
+func TestConfigValidate(t *testing.T) {
+	if err := (Config{DelayRate: 0.5, MaxDelay: time.Second, DropRate: 0.01}).Validate(); err != nil {
+		t.Fatalf("Validate error: %v", err)
+	}
+	for _, cfg := range []Config{{DelayRate: 1.5}, {SerializationRate: -0.1}, {MaxDelay: -time.Second}} {
+		if err := cfg.Validate(); err == nil {
+			t.Fatalf("Validate(%+v) expected error", cfg)
+		}
+	}
+	if (Config{DelayRate: 1}).Active() {
+		t.Fatalf("delay rate without max delay should be inactive")
+	}
+}
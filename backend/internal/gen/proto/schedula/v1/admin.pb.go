@@ -0,0 +1,3939 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: proto/schedula/v1/admin.proto
+
+package schedulev1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type BlackoutMode int32
+
+const (
+	BlackoutMode_BLACKOUT_MODE_UNSPECIFIED BlackoutMode = 0
+	BlackoutMode_BLACKOUT_MODE_BLOCK       BlackoutMode = 1
+	BlackoutMode_BLACKOUT_MODE_WARN        BlackoutMode = 2
+)
+
+// Enum value maps for BlackoutMode.
+var (
+	BlackoutMode_name = map[int32]string{
+		0: "BLACKOUT_MODE_UNSPECIFIED",
+		1: "BLACKOUT_MODE_BLOCK",
+		2: "BLACKOUT_MODE_WARN",
+	}
+	BlackoutMode_value = map[string]int32{
+		"BLACKOUT_MODE_UNSPECIFIED": 0,
+		"BLACKOUT_MODE_BLOCK":       1,
+		"BLACKOUT_MODE_WARN":        2,
+	}
+)
+
+func (x BlackoutMode) Enum() *BlackoutMode {
+	p := new(BlackoutMode)
+	*p = x
+	return p
+}
+
+func (x BlackoutMode) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (BlackoutMode) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_schedula_v1_admin_proto_enumTypes[0].Descriptor()
+}
+
+func (BlackoutMode) Type() protoreflect.EnumType {
+	return &file_proto_schedula_v1_admin_proto_enumTypes[0]
+}
+
+func (x BlackoutMode) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use BlackoutMode.Descriptor instead.
+func (BlackoutMode) EnumDescriptor() ([]byte, []int) {
+	return file_proto_schedula_v1_admin_proto_rawDescGZIP(), []int{0}
+}
+
+type LogTargetKind int32
+
+const (
+	LogTargetKind_LOG_TARGET_KIND_UNSPECIFIED LogTargetKind = 0
+	LogTargetKind_LOG_TARGET_KIND_USER        LogTargetKind = 1
+	LogTargetKind_LOG_TARGET_KIND_REQUEST     LogTargetKind = 2
+)
+
+// Enum value maps for LogTargetKind.
+var (
+	LogTargetKind_name = map[int32]string{
+		0: "LOG_TARGET_KIND_UNSPECIFIED",
+		1: "LOG_TARGET_KIND_USER",
+		2: "LOG_TARGET_KIND_REQUEST",
+	}
+	LogTargetKind_value = map[string]int32{
+		"LOG_TARGET_KIND_UNSPECIFIED": 0,
+		"LOG_TARGET_KIND_USER":        1,
+		"LOG_TARGET_KIND_REQUEST":     2,
+	}
+)
+
+func (x LogTargetKind) Enum() *LogTargetKind {
+	p := new(LogTargetKind)
+	*p = x
+	return p
+}
+
+func (x LogTargetKind) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (LogTargetKind) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_schedula_v1_admin_proto_enumTypes[1].Descriptor()
+}
+
+func (LogTargetKind) Type() protoreflect.EnumType {
+	return &file_proto_schedula_v1_admin_proto_enumTypes[1]
+}
+
+func (x LogTargetKind) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use LogTargetKind.Descriptor instead.
+func (LogTargetKind) EnumDescriptor() ([]byte, []int) {
+	return file_proto_schedula_v1_admin_proto_rawDescGZIP(), []int{1}
+}
+
+type PastStartPolicy int32
+
+const (
+	PastStartPolicy_PAST_START_POLICY_UNSPECIFIED PastStartPolicy = 0
+	PastStartPolicy_PAST_START_POLICY_ALLOW       PastStartPolicy = 1
+	PastStartPolicy_PAST_START_POLICY_WARN        PastStartPolicy = 2
+	PastStartPolicy_PAST_START_POLICY_REJECT      PastStartPolicy = 3
+)
+
+// Enum value maps for PastStartPolicy.
+var (
+	PastStartPolicy_name = map[int32]string{
+		0: "PAST_START_POLICY_UNSPECIFIED",
+		1: "PAST_START_POLICY_ALLOW",
+		2: "PAST_START_POLICY_WARN",
+		3: "PAST_START_POLICY_REJECT",
+	}
+	PastStartPolicy_value = map[string]int32{
+		"PAST_START_POLICY_UNSPECIFIED": 0,
+		"PAST_START_POLICY_ALLOW":       1,
+		"PAST_START_POLICY_WARN":        2,
+		"PAST_START_POLICY_REJECT":      3,
+	}
+)
+
+func (x PastStartPolicy) Enum() *PastStartPolicy {
+	p := new(PastStartPolicy)
+	*p = x
+	return p
+}
+
+func (x PastStartPolicy) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PastStartPolicy) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_schedula_v1_admin_proto_enumTypes[2].Descriptor()
+}
+
+func (PastStartPolicy) Type() protoreflect.EnumType {
+	return &file_proto_schedula_v1_admin_proto_enumTypes[2]
+}
+
+func (x PastStartPolicy) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PastStartPolicy.Descriptor instead.
+func (PastStartPolicy) EnumDescriptor() ([]byte, []int) {
+	return file_proto_schedula_v1_admin_proto_rawDescGZIP(), []int{2}
+}
+
+type TableStats struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Name           string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	TotalBytes     int64                  `protobuf:"varint,2,opt,name=total_bytes,json=totalBytes,proto3" json:"total_bytes,omitempty"`
+	TableBytes     int64                  `protobuf:"varint,3,opt,name=table_bytes,json=tableBytes,proto3" json:"table_bytes,omitempty"`
+	IndexBytes     int64                  `protobuf:"varint,4,opt,name=index_bytes,json=indexBytes,proto3" json:"index_bytes,omitempty"`
+	LiveTuples     int64                  `protobuf:"varint,5,opt,name=live_tuples,json=liveTuples,proto3" json:"live_tuples,omitempty"`
+	DeadTuples     int64                  `protobuf:"varint,6,opt,name=dead_tuples,json=deadTuples,proto3" json:"dead_tuples,omitempty"`
+	DeadTupleRatio float64                `protobuf:"fixed64,7,opt,name=dead_tuple_ratio,json=deadTupleRatio,proto3" json:"dead_tuple_ratio,omitempty"`
+	LastVacuum     *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=last_vacuum,json=lastVacuum,proto3" json:"last_vacuum,omitempty"`
+	LastAutovacuum *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=last_autovacuum,json=lastAutovacuum,proto3" json:"last_autovacuum,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *TableStats) Reset() {
+	*x = TableStats{}
+	mi := &file_proto_schedula_v1_admin_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TableStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TableStats) ProtoMessage() {}
+
+func (x *TableStats) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_schedula_v1_admin_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TableStats.ProtoReflect.Descriptor instead.
+func (*TableStats) Descriptor() ([]byte, []int) {
+	return file_proto_schedula_v1_admin_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *TableStats) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *TableStats) GetTotalBytes() int64 {
+	if x != nil {
+		return x.TotalBytes
+	}
+	return 0
+}
+
+func (x *TableStats) GetTableBytes() int64 {
+	if x != nil {
+		return x.TableBytes
+	}
+	return 0
+}
+
+func (x *TableStats) GetIndexBytes() int64 {
+	if x != nil {
+		return x.IndexBytes
+	}
+	return 0
+}
+
+func (x *TableStats) GetLiveTuples() int64 {
+	if x != nil {
+		return x.LiveTuples
+	}
+	return 0
+}
+
+func (x *TableStats) GetDeadTuples() int64 {
+	if x != nil {
+		return x.DeadTuples
+	}
+	return 0
+}
+
+func (x *TableStats) GetDeadTupleRatio() float64 {
+	if x != nil {
+		return x.DeadTupleRatio
+	}
+	return 0
+}
+
+func (x *TableStats) GetLastVacuum() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastVacuum
+	}
+	return nil
+}
+
+func (x *TableStats) GetLastAutovacuum() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastAutovacuum
+	}
+	return nil
+}
+
+type IndexStats struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	Name                string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Table               string                 `protobuf:"bytes,2,opt,name=table,proto3" json:"table,omitempty"`
+	Bytes               int64                  `protobuf:"varint,3,opt,name=bytes,proto3" json:"bytes,omitempty"`
+	BytesPerTuple       float64                `protobuf:"fixed64,4,opt,name=bytes_per_tuple,json=bytesPerTuple,proto3" json:"bytes_per_tuple,omitempty"`
+	Scans               int64                  `protobuf:"varint,5,opt,name=scans,proto3" json:"scans,omitempty"`
+	Valid               bool                   `protobuf:"varint,6,opt,name=valid,proto3" json:"valid,omitempty"`
+	Ready               bool                   `protobuf:"varint,7,opt,name=ready,proto3" json:"ready,omitempty"`
+	ExclusionConstraint bool                   `protobuf:"varint,8,opt,name=exclusion_constraint,json=exclusionConstraint,proto3" json:"exclusion_constraint,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *IndexStats) Reset() {
+	*x = IndexStats{}
+	mi := &file_proto_schedula_v1_admin_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *IndexStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IndexStats) ProtoMessage() {}
+
+func (x *IndexStats) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_schedula_v1_admin_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IndexStats.ProtoReflect.Descriptor instead.
+func (*IndexStats) Descriptor() ([]byte, []int) {
+	return file_proto_schedula_v1_admin_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *IndexStats) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *IndexStats) GetTable() string {
+	if x != nil {
+		return x.Table
+	}
+	return ""
+}
+
+func (x *IndexStats) GetBytes() int64 {
+	if x != nil {
+		return x.Bytes
+	}
+	return 0
+}
+
+func (x *IndexStats) GetBytesPerTuple() float64 {
+	if x != nil {
+		return x.BytesPerTuple
+	}
+	return 0
+}
+
+func (x *IndexStats) GetScans() int64 {
+	if x != nil {
+		return x.Scans
+	}
+	return 0
+}
+
+func (x *IndexStats) GetValid() bool {
+	if x != nil {
+		return x.Valid
+	}
+	return false
+}
+
+func (x *IndexStats) GetReady() bool {
+	if x != nil {
+		return x.Ready
+	}
+	return false
+}
+
+func (x *IndexStats) GetExclusionConstraint() bool {
+	if x != nil {
+		return x.ExclusionConstraint
+	}
+	return false
+}
+
+type PoolStats struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MaxOpen       uint32                 `protobuf:"varint,1,opt,name=max_open,json=maxOpen,proto3" json:"max_open,omitempty"`
+	Open          uint32                 `protobuf:"varint,2,opt,name=open,proto3" json:"open,omitempty"`
+	InUse         uint32                 `protobuf:"varint,3,opt,name=in_use,json=inUse,proto3" json:"in_use,omitempty"`
+	Idle          uint32                 `protobuf:"varint,4,opt,name=idle,proto3" json:"idle,omitempty"`
+	WaitCount     int64                  `protobuf:"varint,5,opt,name=wait_count,json=waitCount,proto3" json:"wait_count,omitempty"`
+	WaitDuration  *durationpb.Duration   `protobuf:"bytes,6,opt,name=wait_duration,json=waitDuration,proto3" json:"wait_duration,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PoolStats) Reset() {
+	*x = PoolStats{}
+	mi := &file_proto_schedula_v1_admin_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PoolStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PoolStats) ProtoMessage() {}
+
+func (x *PoolStats) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_schedula_v1_admin_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PoolStats.ProtoReflect.Descriptor instead.
+func (*PoolStats) Descriptor() ([]byte, []int) {
+	return file_proto_schedula_v1_admin_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *PoolStats) GetMaxOpen() uint32 {
+	if x != nil {
+		return x.MaxOpen
+	}
+	return 0
+}
+
+func (x *PoolStats) GetOpen() uint32 {
+	if x != nil {
+		return x.Open
+	}
+	return 0
+}
+
+func (x *PoolStats) GetInUse() uint32 {
+	if x != nil {
+		return x.InUse
+	}
+	return 0
+}
+
+func (x *PoolStats) GetIdle() uint32 {
+	if x != nil {
+		return x.Idle
+	}
+	return 0
+}
+
+func (x *PoolStats) GetWaitCount() int64 {
+	if x != nil {
+		return x.WaitCount
+	}
+	return 0
+}
+
+func (x *PoolStats) GetWaitDuration() *durationpb.Duration {
+	if x != nil {
+		return x.WaitDuration
+	}
+	return nil
+}
+
+type GetDatabaseDiagnosticsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetDatabaseDiagnosticsRequest) Reset() {
+	*x = GetDatabaseDiagnosticsRequest{}
+	mi := &file_proto_schedula_v1_admin_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDatabaseDiagnosticsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDatabaseDiagnosticsRequest) ProtoMessage() {}
+
+func (x *GetDatabaseDiagnosticsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_schedula_v1_admin_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDatabaseDiagnosticsRequest.ProtoReflect.Descriptor instead.
+func (*GetDatabaseDiagnosticsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_schedula_v1_admin_proto_rawDescGZIP(), []int{3}
+}
+
+type GetDatabaseDiagnosticsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Tables        []*TableStats          `protobuf:"bytes,1,rep,name=tables,proto3" json:"tables,omitempty"`
+	Indexes       []*IndexStats          `protobuf:"bytes,2,rep,name=indexes,proto3" json:"indexes,omitempty"`
+	Pool          *PoolStats             `protobuf:"bytes,3,opt,name=pool,proto3" json:"pool,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetDatabaseDiagnosticsResponse) Reset() {
+	*x = GetDatabaseDiagnosticsResponse{}
+	mi := &file_proto_schedula_v1_admin_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDatabaseDiagnosticsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDatabaseDiagnosticsResponse) ProtoMessage() {}
+
+func (x *GetDatabaseDiagnosticsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_schedula_v1_admin_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDatabaseDiagnosticsResponse.ProtoReflect.Descriptor instead.
+func (*GetDatabaseDiagnosticsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_schedula_v1_admin_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *GetDatabaseDiagnosticsResponse) GetTables() []*TableStats {
+	if x != nil {
+		return x.Tables
+	}
+	return nil
+}
+
+func (x *GetDatabaseDiagnosticsResponse) GetIndexes() []*IndexStats {
+	if x != nil {
+		return x.Indexes
+	}
+	return nil
+}
+
+func (x *GetDatabaseDiagnosticsResponse) GetPool() *PoolStats {
+	if x != nil {
+		return x.Pool
+	}
+	return nil
+}
+
+type Blackout struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Title         string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	StartTime     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	EndTime       *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	Mode          BlackoutMode           `protobuf:"varint,5,opt,name=mode,proto3,enum=schedula.v1.BlackoutMode" json:"mode,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Blackout) Reset() {
+	*x = Blackout{}
+	mi := &file_proto_schedula_v1_admin_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Blackout) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Blackout) ProtoMessage() {}
+
+func (x *Blackout) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_schedula_v1_admin_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Blackout.ProtoReflect.Descriptor instead.
+func (*Blackout) Descriptor() ([]byte, []int) {
+	return file_proto_schedula_v1_admin_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *Blackout) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Blackout) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *Blackout) GetStartTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartTime
+	}
+	return nil
+}
+
+func (x *Blackout) GetEndTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndTime
+	}
+	return nil
+}
+
+func (x *Blackout) GetMode() BlackoutMode {
+	if x != nil {
+		return x.Mode
+	}
+	return BlackoutMode_BLACKOUT_MODE_UNSPECIFIED
+}
+
+func (x *Blackout) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type CreateBlackoutRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Title         string                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	StartTime     *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	EndTime       *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	Mode          BlackoutMode           `protobuf:"varint,4,opt,name=mode,proto3,enum=schedula.v1.BlackoutMode" json:"mode,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateBlackoutRequest) Reset() {
+	*x = CreateBlackoutRequest{}
+	mi := &file_proto_schedula_v1_admin_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateBlackoutRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateBlackoutRequest) ProtoMessage() {}
+
+func (x *CreateBlackoutRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_schedula_v1_admin_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateBlackoutRequest.ProtoReflect.Descriptor instead.
+func (*CreateBlackoutRequest) Descriptor() ([]byte, []int) {
+	return file_proto_schedula_v1_admin_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *CreateBlackoutRequest) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *CreateBlackoutRequest) GetStartTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartTime
+	}
+	return nil
+}
+
+func (x *CreateBlackoutRequest) GetEndTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndTime
+	}
+	return nil
+}
+
+func (x *CreateBlackoutRequest) GetMode() BlackoutMode {
+	if x != nil {
+		return x.Mode
+	}
+	return BlackoutMode_BLACKOUT_MODE_UNSPECIFIED
+}
+
+type CreateBlackoutResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Blackout      *Blackout              `protobuf:"bytes,1,opt,name=blackout,proto3" json:"blackout,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateBlackoutResponse) Reset() {
+	*x = CreateBlackoutResponse{}
+	mi := &file_proto_schedula_v1_admin_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateBlackoutResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateBlackoutResponse) ProtoMessage() {}
+
+func (x *CreateBlackoutResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_schedula_v1_admin_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateBlackoutResponse.ProtoReflect.Descriptor instead.
+func (*CreateBlackoutResponse) Descriptor() ([]byte, []int) {
+	return file_proto_schedula_v1_admin_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *CreateBlackoutResponse) GetBlackout() *Blackout {
+	if x != nil {
+		return x.Blackout
+	}
+	return nil
+}
+
+type DeleteBlackoutRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BlackoutId    string                 `protobuf:"bytes,1,opt,name=blackout_id,json=blackoutId,proto3" json:"blackout_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteBlackoutRequest) Reset() {
+	*x = DeleteBlackoutRequest{}
+	mi := &file_proto_schedula_v1_admin_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteBlackoutRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteBlackoutRequest) ProtoMessage() {}
+
+func (x *DeleteBlackoutRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_schedula_v1_admin_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteBlackoutRequest.ProtoReflect.Descriptor instead.
+func (*DeleteBlackoutRequest) Descriptor() ([]byte, []int) {
+	return file_proto_schedula_v1_admin_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *DeleteBlackoutRequest) GetBlackoutId() string {
+	if x != nil {
+		return x.BlackoutId
+	}
+	return ""
+}
+
+type DeleteBlackoutResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteBlackoutResponse) Reset() {
+	*x = DeleteBlackoutResponse{}
+	mi := &file_proto_schedula_v1_admin_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteBlackoutResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteBlackoutResponse) ProtoMessage() {}
+
+func (x *DeleteBlackoutResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_schedula_v1_admin_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteBlackoutResponse.ProtoReflect.Descriptor instead.
+func (*DeleteBlackoutResponse) Descriptor() ([]byte, []int) {
+	return file_proto_schedula_v1_admin_proto_rawDescGZIP(), []int{9}
+}
+
+type ListBlackoutsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	WindowStart   *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=window_start,json=windowStart,proto3" json:"window_start,omitempty"`
+	WindowEnd     *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=window_end,json=windowEnd,proto3" json:"window_end,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListBlackoutsRequest) Reset() {
+	*x = ListBlackoutsRequest{}
+	mi := &file_proto_schedula_v1_admin_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListBlackoutsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListBlackoutsRequest) ProtoMessage() {}
+
+func (x *ListBlackoutsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_schedula_v1_admin_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListBlackoutsRequest.ProtoReflect.Descriptor instead.
+func (*ListBlackoutsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_schedula_v1_admin_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *ListBlackoutsRequest) GetWindowStart() *timestamppb.Timestamp {
+	if x != nil {
+		return x.WindowStart
+	}
+	return nil
+}
+
+func (x *ListBlackoutsRequest) GetWindowEnd() *timestamppb.Timestamp {
+	if x != nil {
+		return x.WindowEnd
+	}
+	return nil
+}
+
+type ListBlackoutsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Blackouts     []*Blackout            `protobuf:"bytes,1,rep,name=blackouts,proto3" json:"blackouts,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListBlackoutsResponse) Reset() {
+	*x = ListBlackoutsResponse{}
+	mi := &file_proto_schedula_v1_admin_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListBlackoutsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListBlackoutsResponse) ProtoMessage() {}
+
+func (x *ListBlackoutsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_schedula_v1_admin_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListBlackoutsResponse.ProtoReflect.Descriptor instead.
+func (*ListBlackoutsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_schedula_v1_admin_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *ListBlackoutsResponse) GetBlackouts() []*Blackout {
+	if x != nil {
+		return x.Blackouts
+	}
+	return nil
+}
+
+type MethodUsage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Method        string                 `protobuf:"bytes,1,opt,name=method,proto3" json:"method,omitempty"`
+	Requests      int64                  `protobuf:"varint,2,opt,name=requests,proto3" json:"requests,omitempty"`
+	Errors        int64                  `protobuf:"varint,3,opt,name=errors,proto3" json:"errors,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MethodUsage) Reset() {
+	*x = MethodUsage{}
+	mi := &file_proto_schedula_v1_admin_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MethodUsage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MethodUsage) ProtoMessage() {}
+
+func (x *MethodUsage) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_schedula_v1_admin_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MethodUsage.ProtoReflect.Descriptor instead.
+func (*MethodUsage) Descriptor() ([]byte, []int) {
+	return file_proto_schedula_v1_admin_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *MethodUsage) GetMethod() string {
+	if x != nil {
+		return x.Method
+	}
+	return ""
+}
+
+func (x *MethodUsage) GetRequests() int64 {
+	if x != nil {
+		return x.Requests
+	}
+	return 0
+}
+
+func (x *MethodUsage) GetErrors() int64 {
+	if x != nil {
+		return x.Errors
+	}
+	return 0
+}
+
+type UserUsage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Requests      int64                  `protobuf:"varint,2,opt,name=requests,proto3" json:"requests,omitempty"`
+	Errors        int64                  `protobuf:"varint,3,opt,name=errors,proto3" json:"errors,omitempty"`
+	Methods       []*MethodUsage         `protobuf:"bytes,4,rep,name=methods,proto3" json:"methods,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UserUsage) Reset() {
+	*x = UserUsage{}
+	mi := &file_proto_schedula_v1_admin_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserUsage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserUsage) ProtoMessage() {}
+
+func (x *UserUsage) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_schedula_v1_admin_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserUsage.ProtoReflect.Descriptor instead.
+func (*UserUsage) Descriptor() ([]byte, []int) {
+	return file_proto_schedula_v1_admin_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *UserUsage) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *UserUsage) GetRequests() int64 {
+	if x != nil {
+		return x.Requests
+	}
+	return 0
+}
+
+func (x *UserUsage) GetErrors() int64 {
+	if x != nil {
+		return x.Errors
+	}
+	return 0
+}
+
+func (x *UserUsage) GetMethods() []*MethodUsage {
+	if x != nil {
+		return x.Methods
+	}
+	return nil
+}
+
+type GetAPIUsageRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Limit         uint32                 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAPIUsageRequest) Reset() {
+	*x = GetAPIUsageRequest{}
+	mi := &file_proto_schedula_v1_admin_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAPIUsageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAPIUsageRequest) ProtoMessage() {}
+
+func (x *GetAPIUsageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_schedula_v1_admin_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAPIUsageRequest.ProtoReflect.Descriptor instead.
+func (*GetAPIUsageRequest) Descriptor() ([]byte, []int) {
+	return file_proto_schedula_v1_admin_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *GetAPIUsageRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *GetAPIUsageRequest) GetLimit() uint32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type GetAPIUsageResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Window        *durationpb.Duration   `protobuf:"bytes,1,opt,name=window,proto3" json:"window,omitempty"`
+	Users         []*UserUsage           `protobuf:"bytes,2,rep,name=users,proto3" json:"users,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAPIUsageResponse) Reset() {
+	*x = GetAPIUsageResponse{}
+	mi := &file_proto_schedula_v1_admin_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAPIUsageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAPIUsageResponse) ProtoMessage() {}
+
+func (x *GetAPIUsageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_schedula_v1_admin_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAPIUsageResponse.ProtoReflect.Descriptor instead.
+func (*GetAPIUsageResponse) Descriptor() ([]byte, []int) {
+	return file_proto_schedula_v1_admin_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *GetAPIUsageResponse) GetWindow() *durationpb.Duration {
+	if x != nil {
+		return x.Window
+	}
+	return nil
+}
+
+func (x *GetAPIUsageResponse) GetUsers() []*UserUsage {
+	if x != nil {
+		return x.Users
+	}
+	return nil
+}
+
+type MethodHealth struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Method        string                 `protobuf:"bytes,1,opt,name=method,proto3" json:"method,omitempty"`
+	Requests      int64                  `protobuf:"varint,2,opt,name=requests,proto3" json:"requests,omitempty"`
+	ServerErrors  int64                  `protobuf:"varint,3,opt,name=server_errors,json=serverErrors,proto3" json:"server_errors,omitempty"`
+	Availability  float64                `protobuf:"fixed64,4,opt,name=availability,proto3" json:"availability,omitempty"`
+	Conflicts     int64                  `protobuf:"varint,5,opt,name=conflicts,proto3" json:"conflicts,omitempty"`
+	ConflictRate  float64                `protobuf:"fixed64,6,opt,name=conflict_rate,json=conflictRate,proto3" json:"conflict_rate,omitempty"`
+	P99Latency    *durationpb.Duration   `protobuf:"bytes,7,opt,name=p99_latency,json=p99Latency,proto3" json:"p99_latency,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MethodHealth) Reset() {
+	*x = MethodHealth{}
+	mi := &file_proto_schedula_v1_admin_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MethodHealth) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MethodHealth) ProtoMessage() {}
+
+func (x *MethodHealth) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_schedula_v1_admin_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MethodHealth.ProtoReflect.Descriptor instead.
+func (*MethodHealth) Descriptor() ([]byte, []int) {
+	return file_proto_schedula_v1_admin_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *MethodHealth) GetMethod() string {
+	if x != nil {
+		return x.Method
+	}
+	return ""
+}
+
+func (x *MethodHealth) GetRequests() int64 {
+	if x != nil {
+		return x.Requests
+	}
+	return 0
+}
+
+func (x *MethodHealth) GetServerErrors() int64 {
+	if x != nil {
+		return x.ServerErrors
+	}
+	return 0
+}
+
+func (x *MethodHealth) GetAvailability() float64 {
+	if x != nil {
+		return x.Availability
+	}
+	return 0
+}
+
+func (x *MethodHealth) GetConflicts() int64 {
+	if x != nil {
+		return x.Conflicts
+	}
+	return 0
+}
+
+func (x *MethodHealth) GetConflictRate() float64 {
+	if x != nil {
+		return x.ConflictRate
+	}
+	return 0
+}
+
+func (x *MethodHealth) GetP99Latency() *durationpb.Duration {
+	if x != nil {
+		return x.P99Latency
+	}
+	return nil
+}
+
+type HealthWindow struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Window        *durationpb.Duration   `protobuf:"bytes,1,opt,name=window,proto3" json:"window,omitempty"`
+	Total         *MethodHealth          `protobuf:"bytes,2,opt,name=total,proto3" json:"total,omitempty"`
+	Methods       []*MethodHealth        `protobuf:"bytes,3,rep,name=methods,proto3" json:"methods,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HealthWindow) Reset() {
+	*x = HealthWindow{}
+	mi := &file_proto_schedula_v1_admin_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HealthWindow) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HealthWindow) ProtoMessage() {}
+
+func (x *HealthWindow) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_schedula_v1_admin_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HealthWindow.ProtoReflect.Descriptor instead.
+func (*HealthWindow) Descriptor() ([]byte, []int) {
+	return file_proto_schedula_v1_admin_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *HealthWindow) GetWindow() *durationpb.Duration {
+	if x != nil {
+		return x.Window
+	}
+	return nil
+}
+
+func (x *HealthWindow) GetTotal() *MethodHealth {
+	if x != nil {
+		return x.Total
+	}
+	return nil
+}
+
+func (x *HealthWindow) GetMethods() []*MethodHealth {
+	if x != nil {
+		return x.Methods
+	}
+	return nil
+}
+
+type GetServiceHealthSummaryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Windows       []*durationpb.Duration `protobuf:"bytes,1,rep,name=windows,proto3" json:"windows,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetServiceHealthSummaryRequest) Reset() {
+	*x = GetServiceHealthSummaryRequest{}
+	mi := &file_proto_schedula_v1_admin_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetServiceHealthSummaryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetServiceHealthSummaryRequest) ProtoMessage() {}
+
+func (x *GetServiceHealthSummaryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_schedula_v1_admin_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetServiceHealthSummaryRequest.ProtoReflect.Descriptor instead.
+func (*GetServiceHealthSummaryRequest) Descriptor() ([]byte, []int) {
+	return file_proto_schedula_v1_admin_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *GetServiceHealthSummaryRequest) GetWindows() []*durationpb.Duration {
+	if x != nil {
+		return x.Windows
+	}
+	return nil
+}
+
+type GetServiceHealthSummaryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Windows       []*HealthWindow        `protobuf:"bytes,1,rep,name=windows,proto3" json:"windows,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetServiceHealthSummaryResponse) Reset() {
+	*x = GetServiceHealthSummaryResponse{}
+	mi := &file_proto_schedula_v1_admin_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetServiceHealthSummaryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetServiceHealthSummaryResponse) ProtoMessage() {}
+
+func (x *GetServiceHealthSummaryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_schedula_v1_admin_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetServiceHealthSummaryResponse.ProtoReflect.Descriptor instead.
+func (*GetServiceHealthSummaryResponse) Descriptor() ([]byte, []int) {
+	return file_proto_schedula_v1_admin_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *GetServiceHealthSummaryResponse) GetWindows() []*HealthWindow {
+	if x != nil {
+		return x.Windows
+	}
+	return nil
+}
+
+type LogTarget struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Kind          LogTargetKind          `protobuf:"varint,1,opt,name=kind,proto3,enum=schedula.v1.LogTargetKind" json:"kind,omitempty"`
+	Id            string                 `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	ExpiresAt     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LogTarget) Reset() {
+	*x = LogTarget{}
+	mi := &file_proto_schedula_v1_admin_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LogTarget) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LogTarget) ProtoMessage() {}
+
+func (x *LogTarget) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_schedula_v1_admin_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LogTarget.ProtoReflect.Descriptor instead.
+func (*LogTarget) Descriptor() ([]byte, []int) {
+	return file_proto_schedula_v1_admin_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *LogTarget) GetKind() LogTargetKind {
+	if x != nil {
+		return x.Kind
+	}
+	return LogTargetKind_LOG_TARGET_KIND_UNSPECIFIED
+}
+
+func (x *LogTarget) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *LogTarget) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+type SetLogTargetRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Kind          LogTargetKind          `protobuf:"varint,1,opt,name=kind,proto3,enum=schedula.v1.LogTargetKind" json:"kind,omitempty"`
+	Id            string                 `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	Ttl           *durationpb.Duration   `protobuf:"bytes,3,opt,name=ttl,proto3" json:"ttl,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetLogTargetRequest) Reset() {
+	*x = SetLogTargetRequest{}
+	mi := &file_proto_schedula_v1_admin_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetLogTargetRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetLogTargetRequest) ProtoMessage() {}
+
+func (x *SetLogTargetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_schedula_v1_admin_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetLogTargetRequest.ProtoReflect.Descriptor instead.
+func (*SetLogTargetRequest) Descriptor() ([]byte, []int) {
+	return file_proto_schedula_v1_admin_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *SetLogTargetRequest) GetKind() LogTargetKind {
+	if x != nil {
+		return x.Kind
+	}
+	return LogTargetKind_LOG_TARGET_KIND_UNSPECIFIED
+}
+
+func (x *SetLogTargetRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *SetLogTargetRequest) GetTtl() *durationpb.Duration {
+	if x != nil {
+		return x.Ttl
+	}
+	return nil
+}
+
+type SetLogTargetResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Target        *LogTarget             `protobuf:"bytes,1,opt,name=target,proto3" json:"target,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetLogTargetResponse) Reset() {
+	*x = SetLogTargetResponse{}
+	mi := &file_proto_schedula_v1_admin_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetLogTargetResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetLogTargetResponse) ProtoMessage() {}
+
+func (x *SetLogTargetResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_schedula_v1_admin_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetLogTargetResponse.ProtoReflect.Descriptor instead.
+func (*SetLogTargetResponse) Descriptor() ([]byte, []int) {
+	return file_proto_schedula_v1_admin_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *SetLogTargetResponse) GetTarget() *LogTarget {
+	if x != nil {
+		return x.Target
+	}
+	return nil
+}
+
+type ClearLogTargetRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Kind          LogTargetKind          `protobuf:"varint,1,opt,name=kind,proto3,enum=schedula.v1.LogTargetKind" json:"kind,omitempty"`
+	Id            string                 `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ClearLogTargetRequest) Reset() {
+	*x = ClearLogTargetRequest{}
+	mi := &file_proto_schedula_v1_admin_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ClearLogTargetRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClearLogTargetRequest) ProtoMessage() {}
+
+func (x *ClearLogTargetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_schedula_v1_admin_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClearLogTargetRequest.ProtoReflect.Descriptor instead.
+func (*ClearLogTargetRequest) Descriptor() ([]byte, []int) {
+	return file_proto_schedula_v1_admin_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *ClearLogTargetRequest) GetKind() LogTargetKind {
+	if x != nil {
+		return x.Kind
+	}
+	return LogTargetKind_LOG_TARGET_KIND_UNSPECIFIED
+}
+
+func (x *ClearLogTargetRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type ClearLogTargetResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ClearLogTargetResponse) Reset() {
+	*x = ClearLogTargetResponse{}
+	mi := &file_proto_schedula_v1_admin_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ClearLogTargetResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClearLogTargetResponse) ProtoMessage() {}
+
+func (x *ClearLogTargetResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_schedula_v1_admin_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClearLogTargetResponse.ProtoReflect.Descriptor instead.
+func (*ClearLogTargetResponse) Descriptor() ([]byte, []int) {
+	return file_proto_schedula_v1_admin_proto_rawDescGZIP(), []int{24}
+}
+
+type ListLogTargetsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListLogTargetsRequest) Reset() {
+	*x = ListLogTargetsRequest{}
+	mi := &file_proto_schedula_v1_admin_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListLogTargetsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListLogTargetsRequest) ProtoMessage() {}
+
+func (x *ListLogTargetsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_schedula_v1_admin_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListLogTargetsRequest.ProtoReflect.Descriptor instead.
+func (*ListLogTargetsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_schedula_v1_admin_proto_rawDescGZIP(), []int{25}
+}
+
+type ListLogTargetsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Targets       []*LogTarget           `protobuf:"bytes,1,rep,name=targets,proto3" json:"targets,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListLogTargetsResponse) Reset() {
+	*x = ListLogTargetsResponse{}
+	mi := &file_proto_schedula_v1_admin_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListLogTargetsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListLogTargetsResponse) ProtoMessage() {}
+
+func (x *ListLogTargetsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_schedula_v1_admin_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListLogTargetsResponse.ProtoReflect.Descriptor instead.
+func (*ListLogTargetsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_schedula_v1_admin_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *ListLogTargetsResponse) GetTargets() []*LogTarget {
+	if x != nil {
+		return x.Targets
+	}
+	return nil
+}
+
+type UpdateRequestPolicyRequest struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	UserId           string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	RequestTimeout   *durationpb.Duration   `protobuf:"bytes,2,opt,name=request_timeout,json=requestTimeout,proto3" json:"request_timeout,omitempty"`
+	LimitsMultiplier uint32                 `protobuf:"varint,3,opt,name=limits_multiplier,json=limitsMultiplier,proto3" json:"limits_multiplier,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *UpdateRequestPolicyRequest) Reset() {
+	*x = UpdateRequestPolicyRequest{}
+	mi := &file_proto_schedula_v1_admin_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateRequestPolicyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateRequestPolicyRequest) ProtoMessage() {}
+
+func (x *UpdateRequestPolicyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_schedula_v1_admin_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateRequestPolicyRequest.ProtoReflect.Descriptor instead.
+func (*UpdateRequestPolicyRequest) Descriptor() ([]byte, []int) {
+	return file_proto_schedula_v1_admin_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *UpdateRequestPolicyRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *UpdateRequestPolicyRequest) GetRequestTimeout() *durationpb.Duration {
+	if x != nil {
+		return x.RequestTimeout
+	}
+	return nil
+}
+
+func (x *UpdateRequestPolicyRequest) GetLimitsMultiplier() uint32 {
+	if x != nil {
+		return x.LimitsMultiplier
+	}
+	return 0
+}
+
+type UpdateRequestPolicyResponse struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	UserId             string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	RequestTimeout     *durationpb.Duration   `protobuf:"bytes,2,opt,name=request_timeout,json=requestTimeout,proto3" json:"request_timeout,omitempty"`
+	LimitsMultiplier   uint32                 `protobuf:"varint,3,opt,name=limits_multiplier,json=limitsMultiplier,proto3" json:"limits_multiplier,omitempty"`
+	MaxTitleLength     uint32                 `protobuf:"varint,4,opt,name=max_title_length,json=maxTitleLength,proto3" json:"max_title_length,omitempty"`
+	MaxNotesLength     uint32                 `protobuf:"varint,5,opt,name=max_notes_length,json=maxNotesLength,proto3" json:"max_notes_length,omitempty"`
+	MaxMetadataEntries uint32                 `protobuf:"varint,6,opt,name=max_metadata_entries,json=maxMetadataEntries,proto3" json:"max_metadata_entries,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *UpdateRequestPolicyResponse) Reset() {
+	*x = UpdateRequestPolicyResponse{}
+	mi := &file_proto_schedula_v1_admin_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateRequestPolicyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateRequestPolicyResponse) ProtoMessage() {}
+
+func (x *UpdateRequestPolicyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_schedula_v1_admin_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateRequestPolicyResponse.ProtoReflect.Descriptor instead.
+func (*UpdateRequestPolicyResponse) Descriptor() ([]byte, []int) {
+	return file_proto_schedula_v1_admin_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *UpdateRequestPolicyResponse) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *UpdateRequestPolicyResponse) GetRequestTimeout() *durationpb.Duration {
+	if x != nil {
+		return x.RequestTimeout
+	}
+	return nil
+}
+
+func (x *UpdateRequestPolicyResponse) GetLimitsMultiplier() uint32 {
+	if x != nil {
+		return x.LimitsMultiplier
+	}
+	return 0
+}
+
+func (x *UpdateRequestPolicyResponse) GetMaxTitleLength() uint32 {
+	if x != nil {
+		return x.MaxTitleLength
+	}
+	return 0
+}
+
+func (x *UpdateRequestPolicyResponse) GetMaxNotesLength() uint32 {
+	if x != nil {
+		return x.MaxNotesLength
+	}
+	return 0
+}
+
+func (x *UpdateRequestPolicyResponse) GetMaxMetadataEntries() uint32 {
+	if x != nil {
+		return x.MaxMetadataEntries
+	}
+	return 0
+}
+
+type ProvisionedUser struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	DisplayName   string                 `protobuf:"bytes,2,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty"`
+	Email         string                 `protobuf:"bytes,3,opt,name=email,proto3" json:"email,omitempty"`
+	ExternalId    string                 `protobuf:"bytes,4,opt,name=external_id,json=externalId,proto3" json:"external_id,omitempty"`
+	Active        bool                   `protobuf:"varint,5,opt,name=active,proto3" json:"active,omitempty"`
+	DeactivatedAt *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=deactivated_at,json=deactivatedAt,proto3" json:"deactivated_at,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProvisionedUser) Reset() {
+	*x = ProvisionedUser{}
+	mi := &file_proto_schedula_v1_admin_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProvisionedUser) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProvisionedUser) ProtoMessage() {}
+
+func (x *ProvisionedUser) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_schedula_v1_admin_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProvisionedUser.ProtoReflect.Descriptor instead.
+func (*ProvisionedUser) Descriptor() ([]byte, []int) {
+	return file_proto_schedula_v1_admin_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *ProvisionedUser) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ProvisionedUser) GetDisplayName() string {
+	if x != nil {
+		return x.DisplayName
+	}
+	return ""
+}
+
+func (x *ProvisionedUser) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *ProvisionedUser) GetExternalId() string {
+	if x != nil {
+		return x.ExternalId
+	}
+	return ""
+}
+
+func (x *ProvisionedUser) GetActive() bool {
+	if x != nil {
+		return x.Active
+	}
+	return false
+}
+
+func (x *ProvisionedUser) GetDeactivatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DeactivatedAt
+	}
+	return nil
+}
+
+func (x *ProvisionedUser) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *ProvisionedUser) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type ProvisionUserRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	DisplayName   string                 `protobuf:"bytes,2,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty"`
+	Email         string                 `protobuf:"bytes,3,opt,name=email,proto3" json:"email,omitempty"`
+	ExternalId    string                 `protobuf:"bytes,4,opt,name=external_id,json=externalId,proto3" json:"external_id,omitempty"`
+	Deactivated   bool                   `protobuf:"varint,5,opt,name=deactivated,proto3" json:"deactivated,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProvisionUserRequest) Reset() {
+	*x = ProvisionUserRequest{}
+	mi := &file_proto_schedula_v1_admin_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProvisionUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProvisionUserRequest) ProtoMessage() {}
+
+func (x *ProvisionUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_schedula_v1_admin_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProvisionUserRequest.ProtoReflect.Descriptor instead.
+func (*ProvisionUserRequest) Descriptor() ([]byte, []int) {
+	return file_proto_schedula_v1_admin_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *ProvisionUserRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ProvisionUserRequest) GetDisplayName() string {
+	if x != nil {
+		return x.DisplayName
+	}
+	return ""
+}
+
+func (x *ProvisionUserRequest) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *ProvisionUserRequest) GetExternalId() string {
+	if x != nil {
+		return x.ExternalId
+	}
+	return ""
+}
+
+func (x *ProvisionUserRequest) GetDeactivated() bool {
+	if x != nil {
+		return x.Deactivated
+	}
+	return false
+}
+
+type ProvisionUserResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	User          *ProvisionedUser       `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProvisionUserResponse) Reset() {
+	*x = ProvisionUserResponse{}
+	mi := &file_proto_schedula_v1_admin_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProvisionUserResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProvisionUserResponse) ProtoMessage() {}
+
+func (x *ProvisionUserResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_schedula_v1_admin_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProvisionUserResponse.ProtoReflect.Descriptor instead.
+func (*ProvisionUserResponse) Descriptor() ([]byte, []int) {
+	return file_proto_schedula_v1_admin_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *ProvisionUserResponse) GetUser() *ProvisionedUser {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+type DeactivateUserRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeactivateUserRequest) Reset() {
+	*x = DeactivateUserRequest{}
+	mi := &file_proto_schedula_v1_admin_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeactivateUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeactivateUserRequest) ProtoMessage() {}
+
+func (x *DeactivateUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_schedula_v1_admin_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeactivateUserRequest.ProtoReflect.Descriptor instead.
+func (*DeactivateUserRequest) Descriptor() ([]byte, []int) {
+	return file_proto_schedula_v1_admin_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *DeactivateUserRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type DeactivateUserResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	User          *ProvisionedUser       `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeactivateUserResponse) Reset() {
+	*x = DeactivateUserResponse{}
+	mi := &file_proto_schedula_v1_admin_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeactivateUserResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeactivateUserResponse) ProtoMessage() {}
+
+func (x *DeactivateUserResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_schedula_v1_admin_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeactivateUserResponse.ProtoReflect.Descriptor instead.
+func (*DeactivateUserResponse) Descriptor() ([]byte, []int) {
+	return file_proto_schedula_v1_admin_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *DeactivateUserResponse) GetUser() *ProvisionedUser {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+type ReactivateUserRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReactivateUserRequest) Reset() {
+	*x = ReactivateUserRequest{}
+	mi := &file_proto_schedula_v1_admin_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReactivateUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReactivateUserRequest) ProtoMessage() {}
+
+func (x *ReactivateUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_schedula_v1_admin_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReactivateUserRequest.ProtoReflect.Descriptor instead.
+func (*ReactivateUserRequest) Descriptor() ([]byte, []int) {
+	return file_proto_schedula_v1_admin_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *ReactivateUserRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type ReactivateUserResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	User          *ProvisionedUser       `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReactivateUserResponse) Reset() {
+	*x = ReactivateUserResponse{}
+	mi := &file_proto_schedula_v1_admin_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReactivateUserResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReactivateUserResponse) ProtoMessage() {}
+
+func (x *ReactivateUserResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_schedula_v1_admin_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReactivateUserResponse.ProtoReflect.Descriptor instead.
+func (*ReactivateUserResponse) Descriptor() ([]byte, []int) {
+	return file_proto_schedula_v1_admin_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *ReactivateUserResponse) GetUser() *ProvisionedUser {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+type ListProvisionedUsersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListProvisionedUsersRequest) Reset() {
+	*x = ListProvisionedUsersRequest{}
+	mi := &file_proto_schedula_v1_admin_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListProvisionedUsersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListProvisionedUsersRequest) ProtoMessage() {}
+
+func (x *ListProvisionedUsersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_schedula_v1_admin_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListProvisionedUsersRequest.ProtoReflect.Descriptor instead.
+func (*ListProvisionedUsersRequest) Descriptor() ([]byte, []int) {
+	return file_proto_schedula_v1_admin_proto_rawDescGZIP(), []int{36}
+}
+
+type ListProvisionedUsersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Users         []*ProvisionedUser     `protobuf:"bytes,1,rep,name=users,proto3" json:"users,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListProvisionedUsersResponse) Reset() {
+	*x = ListProvisionedUsersResponse{}
+	mi := &file_proto_schedula_v1_admin_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListProvisionedUsersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListProvisionedUsersResponse) ProtoMessage() {}
+
+func (x *ListProvisionedUsersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_schedula_v1_admin_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListProvisionedUsersResponse.ProtoReflect.Descriptor instead.
+func (*ListProvisionedUsersResponse) Descriptor() ([]byte, []int) {
+	return file_proto_schedula_v1_admin_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *ListProvisionedUsersResponse) GetUsers() []*ProvisionedUser {
+	if x != nil {
+		return x.Users
+	}
+	return nil
+}
+
+type UserGroup struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	ExternalId    string                 `protobuf:"bytes,3,opt,name=external_id,json=externalId,proto3" json:"external_id,omitempty"`
+	MemberIds     []string               `protobuf:"bytes,4,rep,name=member_ids,json=memberIds,proto3" json:"member_ids,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UserGroup) Reset() {
+	*x = UserGroup{}
+	mi := &file_proto_schedula_v1_admin_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserGroup) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserGroup) ProtoMessage() {}
+
+func (x *UserGroup) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_schedula_v1_admin_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserGroup.ProtoReflect.Descriptor instead.
+func (*UserGroup) Descriptor() ([]byte, []int) {
+	return file_proto_schedula_v1_admin_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *UserGroup) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UserGroup) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UserGroup) GetExternalId() string {
+	if x != nil {
+		return x.ExternalId
+	}
+	return ""
+}
+
+func (x *UserGroup) GetMemberIds() []string {
+	if x != nil {
+		return x.MemberIds
+	}
+	return nil
+}
+
+func (x *UserGroup) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *UserGroup) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type CreateUserGroupRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	ExternalId    string                 `protobuf:"bytes,2,opt,name=external_id,json=externalId,proto3" json:"external_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateUserGroupRequest) Reset() {
+	*x = CreateUserGroupRequest{}
+	mi := &file_proto_schedula_v1_admin_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateUserGroupRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateUserGroupRequest) ProtoMessage() {}
+
+func (x *CreateUserGroupRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_schedula_v1_admin_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateUserGroupRequest.ProtoReflect.Descriptor instead.
+func (*CreateUserGroupRequest) Descriptor() ([]byte, []int) {
+	return file_proto_schedula_v1_admin_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *CreateUserGroupRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateUserGroupRequest) GetExternalId() string {
+	if x != nil {
+		return x.ExternalId
+	}
+	return ""
+}
+
+type CreateUserGroupResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Group         *UserGroup             `protobuf:"bytes,1,opt,name=group,proto3" json:"group,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateUserGroupResponse) Reset() {
+	*x = CreateUserGroupResponse{}
+	mi := &file_proto_schedula_v1_admin_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateUserGroupResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateUserGroupResponse) ProtoMessage() {}
+
+func (x *CreateUserGroupResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_schedula_v1_admin_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateUserGroupResponse.ProtoReflect.Descriptor instead.
+func (*CreateUserGroupResponse) Descriptor() ([]byte, []int) {
+	return file_proto_schedula_v1_admin_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *CreateUserGroupResponse) GetGroup() *UserGroup {
+	if x != nil {
+		return x.Group
+	}
+	return nil
+}
+
+type SetUserGroupMembersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GroupId       string                 `protobuf:"bytes,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	MemberIds     []string               `protobuf:"bytes,2,rep,name=member_ids,json=memberIds,proto3" json:"member_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetUserGroupMembersRequest) Reset() {
+	*x = SetUserGroupMembersRequest{}
+	mi := &file_proto_schedula_v1_admin_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetUserGroupMembersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetUserGroupMembersRequest) ProtoMessage() {}
+
+func (x *SetUserGroupMembersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_schedula_v1_admin_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetUserGroupMembersRequest.ProtoReflect.Descriptor instead.
+func (*SetUserGroupMembersRequest) Descriptor() ([]byte, []int) {
+	return file_proto_schedula_v1_admin_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *SetUserGroupMembersRequest) GetGroupId() string {
+	if x != nil {
+		return x.GroupId
+	}
+	return ""
+}
+
+func (x *SetUserGroupMembersRequest) GetMemberIds() []string {
+	if x != nil {
+		return x.MemberIds
+	}
+	return nil
+}
+
+type SetUserGroupMembersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Group         *UserGroup             `protobuf:"bytes,1,opt,name=group,proto3" json:"group,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetUserGroupMembersResponse) Reset() {
+	*x = SetUserGroupMembersResponse{}
+	mi := &file_proto_schedula_v1_admin_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetUserGroupMembersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetUserGroupMembersResponse) ProtoMessage() {}
+
+func (x *SetUserGroupMembersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_schedula_v1_admin_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetUserGroupMembersResponse.ProtoReflect.Descriptor instead.
+func (*SetUserGroupMembersResponse) Descriptor() ([]byte, []int) {
+	return file_proto_schedula_v1_admin_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *SetUserGroupMembersResponse) GetGroup() *UserGroup {
+	if x != nil {
+		return x.Group
+	}
+	return nil
+}
+
+type ListUserGroupsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListUserGroupsRequest) Reset() {
+	*x = ListUserGroupsRequest{}
+	mi := &file_proto_schedula_v1_admin_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListUserGroupsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListUserGroupsRequest) ProtoMessage() {}
+
+func (x *ListUserGroupsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_schedula_v1_admin_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListUserGroupsRequest.ProtoReflect.Descriptor instead.
+func (*ListUserGroupsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_schedula_v1_admin_proto_rawDescGZIP(), []int{43}
+}
+
+type ListUserGroupsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Groups        []*UserGroup           `protobuf:"bytes,1,rep,name=groups,proto3" json:"groups,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListUserGroupsResponse) Reset() {
+	*x = ListUserGroupsResponse{}
+	mi := &file_proto_schedula_v1_admin_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListUserGroupsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListUserGroupsResponse) ProtoMessage() {}
+
+func (x *ListUserGroupsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_schedula_v1_admin_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListUserGroupsResponse.ProtoReflect.Descriptor instead.
+func (*ListUserGroupsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_schedula_v1_admin_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *ListUserGroupsResponse) GetGroups() []*UserGroup {
+	if x != nil {
+		return x.Groups
+	}
+	return nil
+}
+
+type UpdatePastStartPolicyRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Policy        PastStartPolicy        `protobuf:"varint,2,opt,name=policy,proto3,enum=schedula.v1.PastStartPolicy" json:"policy,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdatePastStartPolicyRequest) Reset() {
+	*x = UpdatePastStartPolicyRequest{}
+	mi := &file_proto_schedula_v1_admin_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdatePastStartPolicyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdatePastStartPolicyRequest) ProtoMessage() {}
+
+func (x *UpdatePastStartPolicyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_schedula_v1_admin_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdatePastStartPolicyRequest.ProtoReflect.Descriptor instead.
+func (*UpdatePastStartPolicyRequest) Descriptor() ([]byte, []int) {
+	return file_proto_schedula_v1_admin_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *UpdatePastStartPolicyRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *UpdatePastStartPolicyRequest) GetPolicy() PastStartPolicy {
+	if x != nil {
+		return x.Policy
+	}
+	return PastStartPolicy_PAST_START_POLICY_UNSPECIFIED
+}
+
+type UpdatePastStartPolicyResponse struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	UserId          string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Policy          PastStartPolicy        `protobuf:"varint,2,opt,name=policy,proto3,enum=schedula.v1.PastStartPolicy" json:"policy,omitempty"`
+	EffectivePolicy PastStartPolicy        `protobuf:"varint,3,opt,name=effective_policy,json=effectivePolicy,proto3,enum=schedula.v1.PastStartPolicy" json:"effective_policy,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *UpdatePastStartPolicyResponse) Reset() {
+	*x = UpdatePastStartPolicyResponse{}
+	mi := &file_proto_schedula_v1_admin_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdatePastStartPolicyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdatePastStartPolicyResponse) ProtoMessage() {}
+
+func (x *UpdatePastStartPolicyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_schedula_v1_admin_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdatePastStartPolicyResponse.ProtoReflect.Descriptor instead.
+func (*UpdatePastStartPolicyResponse) Descriptor() ([]byte, []int) {
+	return file_proto_schedula_v1_admin_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *UpdatePastStartPolicyResponse) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *UpdatePastStartPolicyResponse) GetPolicy() PastStartPolicy {
+	if x != nil {
+		return x.Policy
+	}
+	return PastStartPolicy_PAST_START_POLICY_UNSPECIFIED
+}
+
+func (x *UpdatePastStartPolicyResponse) GetEffectivePolicy() PastStartPolicy {
+	if x != nil {
+		return x.EffectivePolicy
+	}
+	return PastStartPolicy_PAST_START_POLICY_UNSPECIFIED
+}
+
+type UpdateRetentionPolicyRequest struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	UserId           string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	RetentionDays    uint32                 `protobuf:"varint,2,opt,name=retention_days,json=retentionDays,proto3" json:"retention_days,omitempty"`
+	UseServerDefault bool                   `protobuf:"varint,3,opt,name=use_server_default,json=useServerDefault,proto3" json:"use_server_default,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *UpdateRetentionPolicyRequest) Reset() {
+	*x = UpdateRetentionPolicyRequest{}
+	mi := &file_proto_schedula_v1_admin_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateRetentionPolicyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateRetentionPolicyRequest) ProtoMessage() {}
+
+func (x *UpdateRetentionPolicyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_schedula_v1_admin_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateRetentionPolicyRequest.ProtoReflect.Descriptor instead.
+func (*UpdateRetentionPolicyRequest) Descriptor() ([]byte, []int) {
+	return file_proto_schedula_v1_admin_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *UpdateRetentionPolicyRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *UpdateRetentionPolicyRequest) GetRetentionDays() uint32 {
+	if x != nil {
+		return x.RetentionDays
+	}
+	return 0
+}
+
+func (x *UpdateRetentionPolicyRequest) GetUseServerDefault() bool {
+	if x != nil {
+		return x.UseServerDefault
+	}
+	return false
+}
+
+type UpdateRetentionPolicyResponse struct {
+	state                  protoimpl.MessageState `protogen:"open.v1"`
+	UserId                 string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	RetentionDays          uint32                 `protobuf:"varint,2,opt,name=retention_days,json=retentionDays,proto3" json:"retention_days,omitempty"`
+	UsesServerDefault      bool                   `protobuf:"varint,3,opt,name=uses_server_default,json=usesServerDefault,proto3" json:"uses_server_default,omitempty"`
+	EffectiveRetentionDays uint32                 `protobuf:"varint,4,opt,name=effective_retention_days,json=effectiveRetentionDays,proto3" json:"effective_retention_days,omitempty"`
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *UpdateRetentionPolicyResponse) Reset() {
+	*x = UpdateRetentionPolicyResponse{}
+	mi := &file_proto_schedula_v1_admin_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateRetentionPolicyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateRetentionPolicyResponse) ProtoMessage() {}
+
+func (x *UpdateRetentionPolicyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_schedula_v1_admin_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateRetentionPolicyResponse.ProtoReflect.Descriptor instead.
+func (*UpdateRetentionPolicyResponse) Descriptor() ([]byte, []int) {
+	return file_proto_schedula_v1_admin_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *UpdateRetentionPolicyResponse) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *UpdateRetentionPolicyResponse) GetRetentionDays() uint32 {
+	if x != nil {
+		return x.RetentionDays
+	}
+	return 0
+}
+
+func (x *UpdateRetentionPolicyResponse) GetUsesServerDefault() bool {
+	if x != nil {
+		return x.UsesServerDefault
+	}
+	return false
+}
+
+func (x *UpdateRetentionPolicyResponse) GetEffectiveRetentionDays() uint32 {
+	if x != nil {
+		return x.EffectiveRetentionDays
+	}
+	return 0
+}
+
+type PurgeExpiredAppointmentsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DryRun        bool                   `protobuf:"varint,1,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PurgeExpiredAppointmentsRequest) Reset() {
+	*x = PurgeExpiredAppointmentsRequest{}
+	mi := &file_proto_schedula_v1_admin_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PurgeExpiredAppointmentsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PurgeExpiredAppointmentsRequest) ProtoMessage() {}
+
+func (x *PurgeExpiredAppointmentsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_schedula_v1_admin_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PurgeExpiredAppointmentsRequest.ProtoReflect.Descriptor instead.
+func (*PurgeExpiredAppointmentsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_schedula_v1_admin_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *PurgeExpiredAppointmentsRequest) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+type RetentionPurge struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Cutoff        *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=cutoff,proto3" json:"cutoff,omitempty"`
+	Count         int64                  `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RetentionPurge) Reset() {
+	*x = RetentionPurge{}
+	mi := &file_proto_schedula_v1_admin_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RetentionPurge) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RetentionPurge) ProtoMessage() {}
+
+func (x *RetentionPurge) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_schedula_v1_admin_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RetentionPurge.ProtoReflect.Descriptor instead.
+func (*RetentionPurge) Descriptor() ([]byte, []int) {
+	return file_proto_schedula_v1_admin_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *RetentionPurge) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *RetentionPurge) GetCutoff() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Cutoff
+	}
+	return nil
+}
+
+func (x *RetentionPurge) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type PurgeExpiredAppointmentsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DryRun        bool                   `protobuf:"varint,1,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	Users         []*RetentionPurge      `protobuf:"bytes,2,rep,name=users,proto3" json:"users,omitempty"`
+	Total         int64                  `protobuf:"varint,3,opt,name=total,proto3" json:"total,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PurgeExpiredAppointmentsResponse) Reset() {
+	*x = PurgeExpiredAppointmentsResponse{}
+	mi := &file_proto_schedula_v1_admin_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PurgeExpiredAppointmentsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PurgeExpiredAppointmentsResponse) ProtoMessage() {}
+
+func (x *PurgeExpiredAppointmentsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_schedula_v1_admin_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PurgeExpiredAppointmentsResponse.ProtoReflect.Descriptor instead.
+func (*PurgeExpiredAppointmentsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_schedula_v1_admin_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *PurgeExpiredAppointmentsResponse) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+func (x *PurgeExpiredAppointmentsResponse) GetUsers() []*RetentionPurge {
+	if x != nil {
+		return x.Users
+	}
+	return nil
+}
+
+func (x *PurgeExpiredAppointmentsResponse) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+type CreateBackdatedAppointmentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Title         string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	Notes         string                 `protobuf:"bytes,3,opt,name=notes,proto3" json:"notes,omitempty"`
+	StartTime     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	EndTime       *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	TimeZone      string                 `protobuf:"bytes,6,opt,name=time_zone,json=timeZone,proto3" json:"time_zone,omitempty"`
+	Metadata      map[string]string      `protobuf:"bytes,7,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Reason        string                 `protobuf:"bytes,8,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateBackdatedAppointmentRequest) Reset() {
+	*x = CreateBackdatedAppointmentRequest{}
+	mi := &file_proto_schedula_v1_admin_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateBackdatedAppointmentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateBackdatedAppointmentRequest) ProtoMessage() {}
+
+func (x *CreateBackdatedAppointmentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_schedula_v1_admin_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateBackdatedAppointmentRequest.ProtoReflect.Descriptor instead.
+func (*CreateBackdatedAppointmentRequest) Descriptor() ([]byte, []int) {
+	return file_proto_schedula_v1_admin_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *CreateBackdatedAppointmentRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *CreateBackdatedAppointmentRequest) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *CreateBackdatedAppointmentRequest) GetNotes() string {
+	if x != nil {
+		return x.Notes
+	}
+	return ""
+}
+
+func (x *CreateBackdatedAppointmentRequest) GetStartTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartTime
+	}
+	return nil
+}
+
+func (x *CreateBackdatedAppointmentRequest) GetEndTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndTime
+	}
+	return nil
+}
+
+func (x *CreateBackdatedAppointmentRequest) GetTimeZone() string {
+	if x != nil {
+		return x.TimeZone
+	}
+	return ""
+}
+
+func (x *CreateBackdatedAppointmentRequest) GetMetadata() map[string]string {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+func (x *CreateBackdatedAppointmentRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type CreateBackdatedAppointmentResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AppointmentId string                 `protobuf:"bytes,1,opt,name=appointment_id,json=appointmentId,proto3" json:"appointment_id,omitempty"`
+	StartTime     *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	EndTime       *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateBackdatedAppointmentResponse) Reset() {
+	*x = CreateBackdatedAppointmentResponse{}
+	mi := &file_proto_schedula_v1_admin_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateBackdatedAppointmentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateBackdatedAppointmentResponse) ProtoMessage() {}
+
+func (x *CreateBackdatedAppointmentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_schedula_v1_admin_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateBackdatedAppointmentResponse.ProtoReflect.Descriptor instead.
+func (*CreateBackdatedAppointmentResponse) Descriptor() ([]byte, []int) {
+	return file_proto_schedula_v1_admin_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *CreateBackdatedAppointmentResponse) GetAppointmentId() string {
+	if x != nil {
+		return x.AppointmentId
+	}
+	return ""
+}
+
+func (x *CreateBackdatedAppointmentResponse) GetStartTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartTime
+	}
+	return nil
+}
+
+func (x *CreateBackdatedAppointmentResponse) GetEndTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndTime
+	}
+	return nil
+}
+
+type SourceDefault struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Source        string                 `protobuf:"bytes,1,opt,name=source,proto3" json:"source,omitempty"`
+	TitlePrefix   string                 `protobuf:"bytes,2,opt,name=title_prefix,json=titlePrefix,proto3" json:"title_prefix,omitempty"`
+	NotesFooter   string                 `protobuf:"bytes,3,opt,name=notes_footer,json=notesFooter,proto3" json:"notes_footer,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SourceDefault) Reset() {
+	*x = SourceDefault{}
+	mi := &file_proto_schedula_v1_admin_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SourceDefault) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SourceDefault) ProtoMessage() {}
+
+func (x *SourceDefault) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_schedula_v1_admin_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SourceDefault.ProtoReflect.Descriptor instead.
+func (*SourceDefault) Descriptor() ([]byte, []int) {
+	return file_proto_schedula_v1_admin_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *SourceDefault) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+func (x *SourceDefault) GetTitlePrefix() string {
+	if x != nil {
+		return x.TitlePrefix
+	}
+	return ""
+}
+
+func (x *SourceDefault) GetNotesFooter() string {
+	if x != nil {
+		return x.NotesFooter
+	}
+	return ""
+}
+
+type UpdateSourceDefaultsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Defaults      []*SourceDefault       `protobuf:"bytes,2,rep,name=defaults,proto3" json:"defaults,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateSourceDefaultsRequest) Reset() {
+	*x = UpdateSourceDefaultsRequest{}
+	mi := &file_proto_schedula_v1_admin_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateSourceDefaultsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateSourceDefaultsRequest) ProtoMessage() {}
+
+func (x *UpdateSourceDefaultsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_schedula_v1_admin_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateSourceDefaultsRequest.ProtoReflect.Descriptor instead.
+func (*UpdateSourceDefaultsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_schedula_v1_admin_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *UpdateSourceDefaultsRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *UpdateSourceDefaultsRequest) GetDefaults() []*SourceDefault {
+	if x != nil {
+		return x.Defaults
+	}
+	return nil
+}
+
+type UpdateSourceDefaultsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Defaults      []*SourceDefault       `protobuf:"bytes,2,rep,name=defaults,proto3" json:"defaults,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateSourceDefaultsResponse) Reset() {
+	*x = UpdateSourceDefaultsResponse{}
+	mi := &file_proto_schedula_v1_admin_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateSourceDefaultsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateSourceDefaultsResponse) ProtoMessage() {}
+
+func (x *UpdateSourceDefaultsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_schedula_v1_admin_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateSourceDefaultsResponse.ProtoReflect.Descriptor instead.
+func (*UpdateSourceDefaultsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_schedula_v1_admin_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *UpdateSourceDefaultsResponse) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *UpdateSourceDefaultsResponse) GetDefaults() []*SourceDefault {
+	if x != nil {
+		return x.Defaults
+	}
+	return nil
+}
+
+var File_proto_schedula_v1_admin_proto protoreflect.FileDescriptor
+
+const file_proto_schedula_v1_admin_proto_rawDesc = "" +
+	"\n" +
+	"\x1dproto/schedula/v1/admin.proto\x12\vschedula.v1\x1a\x1egoogle/protobuf/duration.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xf1\x02\n" +
+	"\n" +
+	"TableStats\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x1f\n" +
+	"\vtotal_bytes\x18\x02 \x01(\x03R\n" +
+	"totalBytes\x12\x1f\n" +
+	"\vtable_bytes\x18\x03 \x01(\x03R\n" +
+	"tableBytes\x12\x1f\n" +
+	"\vindex_bytes\x18\x04 \x01(\x03R\n" +
+	"indexBytes\x12\x1f\n" +
+	"\vlive_tuples\x18\x05 \x01(\x03R\n" +
+	"liveTuples\x12\x1f\n" +
+	"\vdead_tuples\x18\x06 \x01(\x03R\n" +
+	"deadTuples\x12(\n" +
+	"\x10dead_tuple_ratio\x18\a \x01(\x01R\x0edeadTupleRatio\x12;\n" +
+	"\vlast_vacuum\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"lastVacuum\x12C\n" +
+	"\x0flast_autovacuum\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\x0elastAutovacuum\"\xe9\x01\n" +
+	"\n" +
+	"IndexStats\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x14\n" +
+	"\x05table\x18\x02 \x01(\tR\x05table\x12\x14\n" +
+	"\x05bytes\x18\x03 \x01(\x03R\x05bytes\x12&\n" +
+	"\x0fbytes_per_tuple\x18\x04 \x01(\x01R\rbytesPerTuple\x12\x14\n" +
+	"\x05scans\x18\x05 \x01(\x03R\x05scans\x12\x14\n" +
+	"\x05valid\x18\x06 \x01(\bR\x05valid\x12\x14\n" +
+	"\x05ready\x18\a \x01(\bR\x05ready\x121\n" +
+	"\x14exclusion_constraint\x18\b \x01(\bR\x13exclusionConstraint\"\xc4\x01\n" +
+	"\tPoolStats\x12\x19\n" +
+	"\bmax_open\x18\x01 \x01(\rR\amaxOpen\x12\x12\n" +
+	"\x04open\x18\x02 \x01(\rR\x04open\x12\x15\n" +
+	"\x06in_use\x18\x03 \x01(\rR\x05inUse\x12\x12\n" +
+	"\x04idle\x18\x04 \x01(\rR\x04idle\x12\x1d\n" +
+	"\n" +
+	"wait_count\x18\x05 \x01(\x03R\twaitCount\x12>\n" +
+	"\rwait_duration\x18\x06 \x01(\v2\x19.google.protobuf.DurationR\fwaitDuration\"\x1f\n" +
+	"\x1dGetDatabaseDiagnosticsRequest\"\xb0\x01\n" +
+	"\x1eGetDatabaseDiagnosticsResponse\x12/\n" +
+	"\x06tables\x18\x01 \x03(\v2\x17.schedula.v1.TableStatsR\x06tables\x121\n" +
+	"\aindexes\x18\x02 \x03(\v2\x17.schedula.v1.IndexStatsR\aindexes\x12*\n" +
+	"\x04pool\x18\x03 \x01(\v2\x16.schedula.v1.PoolStatsR\x04pool\"\x8c\x02\n" +
+	"\bBlackout\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x14\n" +
+	"\x05title\x18\x02 \x01(\tR\x05title\x129\n" +
+	"\n" +
+	"start_time\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\tstartTime\x125\n" +
+	"\bend_time\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\aendTime\x12-\n" +
+	"\x04mode\x18\x05 \x01(\x0e2\x19.schedula.v1.BlackoutModeR\x04mode\x129\n" +
+	"\n" +
+	"created_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"\xce\x01\n" +
+	"\x15CreateBlackoutRequest\x12\x14\n" +
+	"\x05title\x18\x01 \x01(\tR\x05title\x129\n" +
+	"\n" +
+	"start_time\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\tstartTime\x125\n" +
+	"\bend_time\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\aendTime\x12-\n" +
+	"\x04mode\x18\x04 \x01(\x0e2\x19.schedula.v1.BlackoutModeR\x04mode\"K\n" +
+	"\x16CreateBlackoutResponse\x121\n" +
+	"\bblackout\x18\x01 \x01(\v2\x15.schedula.v1.BlackoutR\bblackout\"8\n" +
+	"\x15DeleteBlackoutRequest\x12\x1f\n" +
+	"\vblackout_id\x18\x01 \x01(\tR\n" +
+	"blackoutId\"\x18\n" +
+	"\x16DeleteBlackoutResponse\"\x90\x01\n" +
+	"\x14ListBlackoutsRequest\x12=\n" +
+	"\fwindow_start\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\vwindowStart\x129\n" +
+	"\n" +
+	"window_end\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\twindowEnd\"L\n" +
+	"\x15ListBlackoutsResponse\x123\n" +
+	"\tblackouts\x18\x01 \x03(\v2\x15.schedula.v1.BlackoutR\tblackouts\"Y\n" +
+	"\vMethodUsage\x12\x16\n" +
+	"\x06method\x18\x01 \x01(\tR\x06method\x12\x1a\n" +
+	"\brequests\x18\x02 \x01(\x03R\brequests\x12\x16\n" +
+	"\x06errors\x18\x03 \x01(\x03R\x06errors\"\x8c\x01\n" +
+	"\tUserUsage\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1a\n" +
+	"\brequests\x18\x02 \x01(\x03R\brequests\x12\x16\n" +
+	"\x06errors\x18\x03 \x01(\x03R\x06errors\x122\n" +
+	"\amethods\x18\x04 \x03(\v2\x18.schedula.v1.MethodUsageR\amethods\"C\n" +
+	"\x12GetAPIUsageRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\rR\x05limit\"v\n" +
+	"\x13GetAPIUsageResponse\x121\n" +
+	"\x06window\x18\x01 \x01(\v2\x19.google.protobuf.DurationR\x06window\x12,\n" +
+	"\x05users\x18\x02 \x03(\v2\x16.schedula.v1.UserUsageR\x05users\"\x8a\x02\n" +
+	"\fMethodHealth\x12\x16\n" +
+	"\x06method\x18\x01 \x01(\tR\x06method\x12\x1a\n" +
+	"\brequests\x18\x02 \x01(\x03R\brequests\x12#\n" +
+	"\rserver_errors\x18\x03 \x01(\x03R\fserverErrors\x12\"\n" +
+	"\favailability\x18\x04 \x01(\x01R\favailability\x12\x1c\n" +
+	"\tconflicts\x18\x05 \x01(\x03R\tconflicts\x12#\n" +
+	"\rconflict_rate\x18\x06 \x01(\x01R\fconflictRate\x12:\n" +
+	"\vp99_latency\x18\a \x01(\v2\x19.google.protobuf.DurationR\n" +
+	"p99Latency\"\xa7\x01\n" +
+	"\fHealthWindow\x121\n" +
+	"\x06window\x18\x01 \x01(\v2\x19.google.protobuf.DurationR\x06window\x12/\n" +
+	"\x05total\x18\x02 \x01(\v2\x19.schedula.v1.MethodHealthR\x05total\x123\n" +
+	"\amethods\x18\x03 \x03(\v2\x19.schedula.v1.MethodHealthR\amethods\"U\n" +
+	"\x1eGetServiceHealthSummaryRequest\x123\n" +
+	"\awindows\x18\x01 \x03(\v2\x19.google.protobuf.DurationR\awindows\"V\n" +
+	"\x1fGetServiceHealthSummaryResponse\x123\n" +
+	"\awindows\x18\x01 \x03(\v2\x19.schedula.v1.HealthWindowR\awindows\"\x86\x01\n" +
+	"\tLogTarget\x12.\n" +
+	"\x04kind\x18\x01 \x01(\x0e2\x1a.schedula.v1.LogTargetKindR\x04kind\x12\x0e\n" +
+	"\x02id\x18\x02 \x01(\tR\x02id\x129\n" +
+	"\n" +
+	"expires_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\"\x82\x01\n" +
+	"\x13SetLogTargetRequest\x12.\n" +
+	"\x04kind\x18\x01 \x01(\x0e2\x1a.schedula.v1.LogTargetKindR\x04kind\x12\x0e\n" +
+	"\x02id\x18\x02 \x01(\tR\x02id\x12+\n" +
+	"\x03ttl\x18\x03 \x01(\v2\x19.google.protobuf.DurationR\x03ttl\"F\n" +
+	"\x14SetLogTargetResponse\x12.\n" +
+	"\x06target\x18\x01 \x01(\v2\x16.schedula.v1.LogTargetR\x06target\"W\n" +
+	"\x15ClearLogTargetRequest\x12.\n" +
+	"\x04kind\x18\x01 \x01(\x0e2\x1a.schedula.v1.LogTargetKindR\x04kind\x12\x0e\n" +
+	"\x02id\x18\x02 \x01(\tR\x02id\"\x18\n" +
+	"\x16ClearLogTargetResponse\"\x17\n" +
+	"\x15ListLogTargetsRequest\"J\n" +
+	"\x16ListLogTargetsResponse\x120\n" +
+	"\atargets\x18\x01 \x03(\v2\x16.schedula.v1.LogTargetR\atargets\"\xa6\x01\n" +
+	"\x1aUpdateRequestPolicyRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12B\n" +
+	"\x0frequest_timeout\x18\x02 \x01(\v2\x19.google.protobuf.DurationR\x0erequestTimeout\x12+\n" +
+	"\x11limits_multiplier\x18\x03 \x01(\rR\x10limitsMultiplier\"\xad\x02\n" +
+	"\x1bUpdateRequestPolicyResponse\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12B\n" +
+	"\x0frequest_timeout\x18\x02 \x01(\v2\x19.google.protobuf.DurationR\x0erequestTimeout\x12+\n" +
+	"\x11limits_multiplier\x18\x03 \x01(\rR\x10limitsMultiplier\x12(\n" +
+	"\x10max_title_length\x18\x04 \x01(\rR\x0emaxTitleLength\x12(\n" +
+	"\x10max_notes_length\x18\x05 \x01(\rR\x0emaxNotesLength\x120\n" +
+	"\x14max_metadata_entries\x18\x06 \x01(\rR\x12maxMetadataEntries\"\xd5\x02\n" +
+	"\x0fProvisionedUser\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12!\n" +
+	"\fdisplay_name\x18\x02 \x01(\tR\vdisplayName\x12\x14\n" +
+	"\x05email\x18\x03 \x01(\tR\x05email\x12\x1f\n" +
+	"\vexternal_id\x18\x04 \x01(\tR\n" +
+	"externalId\x12\x16\n" +
+	"\x06active\x18\x05 \x01(\bR\x06active\x12A\n" +
+	"\x0edeactivated_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\rdeactivatedAt\x129\n" +
+	"\n" +
+	"created_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\xab\x01\n" +
+	"\x14ProvisionUserRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12!\n" +
+	"\fdisplay_name\x18\x02 \x01(\tR\vdisplayName\x12\x14\n" +
+	"\x05email\x18\x03 \x01(\tR\x05email\x12\x1f\n" +
+	"\vexternal_id\x18\x04 \x01(\tR\n" +
+	"externalId\x12 \n" +
+	"\vdeactivated\x18\x05 \x01(\bR\vdeactivated\"I\n" +
+	"\x15ProvisionUserResponse\x120\n" +
+	"\x04user\x18\x01 \x01(\v2\x1c.schedula.v1.ProvisionedUserR\x04user\"0\n" +
+	"\x15DeactivateUserRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\"J\n" +
+	"\x16DeactivateUserResponse\x120\n" +
+	"\x04user\x18\x01 \x01(\v2\x1c.schedula.v1.ProvisionedUserR\x04user\"0\n" +
+	"\x15ReactivateUserRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\"J\n" +
+	"\x16ReactivateUserResponse\x120\n" +
+	"\x04user\x18\x01 \x01(\v2\x1c.schedula.v1.ProvisionedUserR\x04user\"\x1d\n" +
+	"\x1bListProvisionedUsersRequest\"R\n" +
+	"\x1cListProvisionedUsersResponse\x122\n" +
+	"\x05users\x18\x01 \x03(\v2\x1c.schedula.v1.ProvisionedUserR\x05users\"\xe5\x01\n" +
+	"\tUserGroup\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1f\n" +
+	"\vexternal_id\x18\x03 \x01(\tR\n" +
+	"externalId\x12\x1d\n" +
+	"\n" +
+	"member_ids\x18\x04 \x03(\tR\tmemberIds\x129\n" +
+	"\n" +
+	"created_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"M\n" +
+	"\x16CreateUserGroupRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x1f\n" +
+	"\vexternal_id\x18\x02 \x01(\tR\n" +
+	"externalId\"G\n" +
+	"\x17CreateUserGroupResponse\x12,\n" +
+	"\x05group\x18\x01 \x01(\v2\x16.schedula.v1.UserGroupR\x05group\"V\n" +
+	"\x1aSetUserGroupMembersRequest\x12\x19\n" +
+	"\bgroup_id\x18\x01 \x01(\tR\agroupId\x12\x1d\n" +
+	"\n" +
+	"member_ids\x18\x02 \x03(\tR\tmemberIds\"K\n" +
+	"\x1bSetUserGroupMembersResponse\x12,\n" +
+	"\x05group\x18\x01 \x01(\v2\x16.schedula.v1.UserGroupR\x05group\"\x17\n" +
+	"\x15ListUserGroupsRequest\"H\n" +
+	"\x16ListUserGroupsResponse\x12.\n" +
+	"\x06groups\x18\x01 \x03(\v2\x16.schedula.v1.UserGroupR\x06groups\"m\n" +
+	"\x1cUpdatePastStartPolicyRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x124\n" +
+	"\x06policy\x18\x02 \x01(\x0e2\x1c.schedula.v1.PastStartPolicyR\x06policy\"\xb7\x01\n" +
+	"\x1dUpdatePastStartPolicyResponse\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x124\n" +
+	"\x06policy\x18\x02 \x01(\x0e2\x1c.schedula.v1.PastStartPolicyR\x06policy\x12G\n" +
+	"\x10effective_policy\x18\x03 \x01(\x0e2\x1c.schedula.v1.PastStartPolicyR\x0feffectivePolicy\"\x8c\x01\n" +
+	"\x1cUpdateRetentionPolicyRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12%\n" +
+	"\x0eretention_days\x18\x02 \x01(\rR\rretentionDays\x12,\n" +
+	"\x12use_server_default\x18\x03 \x01(\bR\x10useServerDefault\"\xc9\x01\n" +
+	"\x1dUpdateRetentionPolicyResponse\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12%\n" +
+	"\x0eretention_days\x18\x02 \x01(\rR\rretentionDays\x12.\n" +
+	"\x13uses_server_default\x18\x03 \x01(\bR\x11usesServerDefault\x128\n" +
+	"\x18effective_retention_days\x18\x04 \x01(\rR\x16effectiveRetentionDays\":\n" +
+	"\x1fPurgeExpiredAppointmentsRequest\x12\x17\n" +
+	"\adry_run\x18\x01 \x01(\bR\x06dryRun\"s\n" +
+	"\x0eRetentionPurge\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x122\n" +
+	"\x06cutoff\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\x06cutoff\x12\x14\n" +
+	"\x05count\x18\x03 \x01(\x03R\x05count\"\x84\x01\n" +
+	" PurgeExpiredAppointmentsResponse\x12\x17\n" +
+	"\adry_run\x18\x01 \x01(\bR\x06dryRun\x121\n" +
+	"\x05users\x18\x02 \x03(\v2\x1b.schedula.v1.RetentionPurgeR\x05users\x12\x14\n" +
+	"\x05total\x18\x03 \x01(\x03R\x05total\"\xa6\x03\n" +
+	"!CreateBackdatedAppointmentRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x14\n" +
+	"\x05title\x18\x02 \x01(\tR\x05title\x12\x14\n" +
+	"\x05notes\x18\x03 \x01(\tR\x05notes\x129\n" +
+	"\n" +
+	"start_time\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tstartTime\x125\n" +
+	"\bend_time\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\aendTime\x12\x1b\n" +
+	"\ttime_zone\x18\x06 \x01(\tR\btimeZone\x12X\n" +
+	"\bmetadata\x18\a \x03(\v2<.schedula.v1.CreateBackdatedAppointmentRequest.MetadataEntryR\bmetadata\x12\x16\n" +
+	"\x06reason\x18\b \x01(\tR\x06reason\x1a;\n" +
+	"\rMetadataEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xbd\x01\n" +
+	"\"CreateBackdatedAppointmentResponse\x12%\n" +
+	"\x0eappointment_id\x18\x01 \x01(\tR\rappointmentId\x129\n" +
+	"\n" +
+	"start_time\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\tstartTime\x125\n" +
+	"\bend_time\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\aendTime\"m\n" +
+	"\rSourceDefault\x12\x16\n" +
+	"\x06source\x18\x01 \x01(\tR\x06source\x12!\n" +
+	"\ftitle_prefix\x18\x02 \x01(\tR\vtitlePrefix\x12!\n" +
+	"\fnotes_footer\x18\x03 \x01(\tR\vnotesFooter\"n\n" +
+	"\x1bUpdateSourceDefaultsRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x126\n" +
+	"\bdefaults\x18\x02 \x03(\v2\x1a.schedula.v1.SourceDefaultR\bdefaults\"o\n" +
+	"\x1cUpdateSourceDefaultsResponse\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x126\n" +
+	"\bdefaults\x18\x02 \x03(\v2\x1a.schedula.v1.SourceDefaultR\bdefaults*^\n" +
+	"\fBlackoutMode\x12\x1d\n" +
+	"\x19BLACKOUT_MODE_UNSPECIFIED\x10\x00\x12\x17\n" +
+	"\x13BLACKOUT_MODE_BLOCK\x10\x01\x12\x16\n" +
+	"\x12BLACKOUT_MODE_WARN\x10\x02*g\n" +
+	"\rLogTargetKind\x12\x1f\n" +
+	"\x1bLOG_TARGET_KIND_UNSPECIFIED\x10\x00\x12\x18\n" +
+	"\x14LOG_TARGET_KIND_USER\x10\x01\x12\x1b\n" +
+	"\x17LOG_TARGET_KIND_REQUEST\x10\x02*\x8b\x01\n" +
+	"\x0fPastStartPolicy\x12!\n" +
+	"\x1dPAST_START_POLICY_UNSPECIFIED\x10\x00\x12\x1b\n" +
+	"\x17PAST_START_POLICY_ALLOW\x10\x01\x12\x1a\n" +
+	"\x16PAST_START_POLICY_WARN\x10\x02\x12\x1c\n" +
+	"\x18PAST_START_POLICY_REJECT\x10\x032\xaf\x11\n" +
+	"\fAdminService\x12q\n" +
+	"\x16GetDatabaseDiagnostics\x12*.schedula.v1.GetDatabaseDiagnosticsRequest\x1a+.schedula.v1.GetDatabaseDiagnosticsResponse\x12Y\n" +
+	"\x0eCreateBlackout\x12\".schedula.v1.CreateBlackoutRequest\x1a#.schedula.v1.CreateBlackoutResponse\x12Y\n" +
+	"\x0eDeleteBlackout\x12\".schedula.v1.DeleteBlackoutRequest\x1a#.schedula.v1.DeleteBlackoutResponse\x12V\n" +
+	"\rListBlackouts\x12!.schedula.v1.ListBlackoutsRequest\x1a\".schedula.v1.ListBlackoutsResponse\x12P\n" +
+	"\vGetAPIUsage\x12\x1f.schedula.v1.GetAPIUsageRequest\x1a .schedula.v1.GetAPIUsageResponse\x12n\n" +
+	"\x15UpdatePastStartPolicy\x12).schedula.v1.UpdatePastStartPolicyRequest\x1a*.schedula.v1.UpdatePastStartPolicyResponse\x12}\n" +
+	"\x1aCreateBackdatedAppointment\x12..schedula.v1.CreateBackdatedAppointmentRequest\x1a/.schedula.v1.CreateBackdatedAppointmentResponse\x12n\n" +
+	"\x15UpdateRetentionPolicy\x12).schedula.v1.UpdateRetentionPolicyRequest\x1a*.schedula.v1.UpdateRetentionPolicyResponse\x12w\n" +
+	"\x18PurgeExpiredAppointments\x12,.schedula.v1.PurgeExpiredAppointmentsRequest\x1a-.schedula.v1.PurgeExpiredAppointmentsResponse\x12t\n" +
+	"\x17GetServiceHealthSummary\x12+.schedula.v1.GetServiceHealthSummaryRequest\x1a,.schedula.v1.GetServiceHealthSummaryResponse\x12S\n" +
+	"\fSetLogTarget\x12 .schedula.v1.SetLogTargetRequest\x1a!.schedula.v1.SetLogTargetResponse\x12Y\n" +
+	"\x0eClearLogTarget\x12\".schedula.v1.ClearLogTargetRequest\x1a#.schedula.v1.ClearLogTargetResponse\x12Y\n" +
+	"\x0eListLogTargets\x12\".schedula.v1.ListLogTargetsRequest\x1a#.schedula.v1.ListLogTargetsResponse\x12h\n" +
+	"\x13UpdateRequestPolicy\x12'.schedula.v1.UpdateRequestPolicyRequest\x1a(.schedula.v1.UpdateRequestPolicyResponse\x12V\n" +
+	"\rProvisionUser\x12!.schedula.v1.ProvisionUserRequest\x1a\".schedula.v1.ProvisionUserResponse\x12Y\n" +
+	"\x0eDeactivateUser\x12\".schedula.v1.DeactivateUserRequest\x1a#.schedula.v1.DeactivateUserResponse\x12Y\n" +
+	"\x0eReactivateUser\x12\".schedula.v1.ReactivateUserRequest\x1a#.schedula.v1.ReactivateUserResponse\x12k\n" +
+	"\x14ListProvisionedUsers\x12(.schedula.v1.ListProvisionedUsersRequest\x1a).schedula.v1.ListProvisionedUsersResponse\x12\\\n" +
+	"\x0fCreateUserGroup\x12#.schedula.v1.CreateUserGroupRequest\x1a$.schedula.v1.CreateUserGroupResponse\x12h\n" +
+	"\x13SetUserGroupMembers\x12'.schedula.v1.SetUserGroupMembersRequest\x1a(.schedula.v1.SetUserGroupMembersResponse\x12Y\n" +
+	"\x0eListUserGroups\x12\".schedula.v1.ListUserGroupsRequest\x1a#.schedula.v1.ListUserGroupsResponse\x12k\n" +
+	"\x14UpdateSourceDefaults\x12(.schedula.v1.UpdateSourceDefaultsRequest\x1a).schedula.v1.UpdateSourceDefaultsResponseB<Z:schedula/backend/internal/gen/proto/schedula/v1;schedulev1b\x06proto3"
+
+var (
+	file_proto_schedula_v1_admin_proto_rawDescOnce sync.Once
+	file_proto_schedula_v1_admin_proto_rawDescData []byte
+)
+
+func file_proto_schedula_v1_admin_proto_rawDescGZIP() []byte {
+	file_proto_schedula_v1_admin_proto_rawDescOnce.Do(func() {
+		file_proto_schedula_v1_admin_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_proto_schedula_v1_admin_proto_rawDesc), len(file_proto_schedula_v1_admin_proto_rawDesc)))
+	})
+	return file_proto_schedula_v1_admin_proto_rawDescData
+}
+
+var file_proto_schedula_v1_admin_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
+var file_proto_schedula_v1_admin_proto_msgTypes = make([]protoimpl.MessageInfo, 58)
+var file_proto_schedula_v1_admin_proto_goTypes = []any{
+	(BlackoutMode)(0),                          // 0: schedula.v1.BlackoutMode
+	(LogTargetKind)(0),                         // 1: schedula.v1.LogTargetKind
+	(PastStartPolicy)(0),                       // 2: schedula.v1.PastStartPolicy
+	(*TableStats)(nil),                         // 3: schedula.v1.TableStats
+	(*IndexStats)(nil),                         // 4: schedula.v1.IndexStats
+	(*PoolStats)(nil),                          // 5: schedula.v1.PoolStats
+	(*GetDatabaseDiagnosticsRequest)(nil),      // 6: schedula.v1.GetDatabaseDiagnosticsRequest
+	(*GetDatabaseDiagnosticsResponse)(nil),     // 7: schedula.v1.GetDatabaseDiagnosticsResponse
+	(*Blackout)(nil),                           // 8: schedula.v1.Blackout
+	(*CreateBlackoutRequest)(nil),              // 9: schedula.v1.CreateBlackoutRequest
+	(*CreateBlackoutResponse)(nil),             // 10: schedula.v1.CreateBlackoutResponse
+	(*DeleteBlackoutRequest)(nil),              // 11: schedula.v1.DeleteBlackoutRequest
+	(*DeleteBlackoutResponse)(nil),             // 12: schedula.v1.DeleteBlackoutResponse
+	(*ListBlackoutsRequest)(nil),               // 13: schedula.v1.ListBlackoutsRequest
+	(*ListBlackoutsResponse)(nil),              // 14: schedula.v1.ListBlackoutsResponse
+	(*MethodUsage)(nil),                        // 15: schedula.v1.MethodUsage
+	(*UserUsage)(nil),                          // 16: schedula.v1.UserUsage
+	(*GetAPIUsageRequest)(nil),                 // 17: schedula.v1.GetAPIUsageRequest
+	(*GetAPIUsageResponse)(nil),                // 18: schedula.v1.GetAPIUsageResponse
+	(*MethodHealth)(nil),                       // 19: schedula.v1.MethodHealth
+	(*HealthWindow)(nil),                       // 20: schedula.v1.HealthWindow
+	(*GetServiceHealthSummaryRequest)(nil),     // 21: schedula.v1.GetServiceHealthSummaryRequest
+	(*GetServiceHealthSummaryResponse)(nil),    // 22: schedula.v1.GetServiceHealthSummaryResponse
+	(*LogTarget)(nil),                          // 23: schedula.v1.LogTarget
+	(*SetLogTargetRequest)(nil),                // 24: schedula.v1.SetLogTargetRequest
+	(*SetLogTargetResponse)(nil),               // 25: schedula.v1.SetLogTargetResponse
+	(*ClearLogTargetRequest)(nil),              // 26: schedula.v1.ClearLogTargetRequest
+	(*ClearLogTargetResponse)(nil),             // 27: schedula.v1.ClearLogTargetResponse
+	(*ListLogTargetsRequest)(nil),              // 28: schedula.v1.ListLogTargetsRequest
+	(*ListLogTargetsResponse)(nil),             // 29: schedula.v1.ListLogTargetsResponse
+	(*UpdateRequestPolicyRequest)(nil),         // 30: schedula.v1.UpdateRequestPolicyRequest
+	(*UpdateRequestPolicyResponse)(nil),        // 31: schedula.v1.UpdateRequestPolicyResponse
+	(*ProvisionedUser)(nil),                    // 32: schedula.v1.ProvisionedUser
+	(*ProvisionUserRequest)(nil),               // 33: schedula.v1.ProvisionUserRequest
+	(*ProvisionUserResponse)(nil),              // 34: schedula.v1.ProvisionUserResponse
+	(*DeactivateUserRequest)(nil),              // 35: schedula.v1.DeactivateUserRequest
+	(*DeactivateUserResponse)(nil),             // 36: schedula.v1.DeactivateUserResponse
+	(*ReactivateUserRequest)(nil),              // 37: schedula.v1.ReactivateUserRequest
+	(*ReactivateUserResponse)(nil),             // 38: schedula.v1.ReactivateUserResponse
+	(*ListProvisionedUsersRequest)(nil),        // 39: schedula.v1.ListProvisionedUsersRequest
+	(*ListProvisionedUsersResponse)(nil),       // 40: schedula.v1.ListProvisionedUsersResponse
+	(*UserGroup)(nil),                          // 41: schedula.v1.UserGroup
+	(*CreateUserGroupRequest)(nil),             // 42: schedula.v1.CreateUserGroupRequest
+	(*CreateUserGroupResponse)(nil),            // 43: schedula.v1.CreateUserGroupResponse
+	(*SetUserGroupMembersRequest)(nil),         // 44: schedula.v1.SetUserGroupMembersRequest
+	(*SetUserGroupMembersResponse)(nil),        // 45: schedula.v1.SetUserGroupMembersResponse
+	(*ListUserGroupsRequest)(nil),              // 46: schedula.v1.ListUserGroupsRequest
+	(*ListUserGroupsResponse)(nil),             // 47: schedula.v1.ListUserGroupsResponse
+	(*UpdatePastStartPolicyRequest)(nil),       // 48: schedula.v1.UpdatePastStartPolicyRequest
+	(*UpdatePastStartPolicyResponse)(nil),      // 49: schedula.v1.UpdatePastStartPolicyResponse
+	(*UpdateRetentionPolicyRequest)(nil),       // 50: schedula.v1.UpdateRetentionPolicyRequest
+	(*UpdateRetentionPolicyResponse)(nil),      // 51: schedula.v1.UpdateRetentionPolicyResponse
+	(*PurgeExpiredAppointmentsRequest)(nil),    // 52: schedula.v1.PurgeExpiredAppointmentsRequest
+	(*RetentionPurge)(nil),                     // 53: schedula.v1.RetentionPurge
+	(*PurgeExpiredAppointmentsResponse)(nil),   // 54: schedula.v1.PurgeExpiredAppointmentsResponse
+	(*CreateBackdatedAppointmentRequest)(nil),  // 55: schedula.v1.CreateBackdatedAppointmentRequest
+	(*CreateBackdatedAppointmentResponse)(nil), // 56: schedula.v1.CreateBackdatedAppointmentResponse
+	(*SourceDefault)(nil),                      // 57: schedula.v1.SourceDefault
+	(*UpdateSourceDefaultsRequest)(nil),        // 58: schedula.v1.UpdateSourceDefaultsRequest
+	(*UpdateSourceDefaultsResponse)(nil),       // 59: schedula.v1.UpdateSourceDefaultsResponse
+	nil,                                        // 60: schedula.v1.CreateBackdatedAppointmentRequest.MetadataEntry
+	(*timestamppb.Timestamp)(nil),              // 61: google.protobuf.Timestamp
+	(*durationpb.Duration)(nil),                // 62: google.protobuf.Duration
+}
+var file_proto_schedula_v1_admin_proto_depIdxs = []int32{
+	61, // 0: schedula.v1.TableStats.last_vacuum:type_name -> google.protobuf.Timestamp
+	61, // 1: schedula.v1.TableStats.last_autovacuum:type_name -> google.protobuf.Timestamp
+	62, // 2: schedula.v1.PoolStats.wait_duration:type_name -> google.protobuf.Duration
+	3,  // 3: schedula.v1.GetDatabaseDiagnosticsResponse.tables:type_name -> schedula.v1.TableStats
+	4,  // 4: schedula.v1.GetDatabaseDiagnosticsResponse.indexes:type_name -> schedula.v1.IndexStats
+	5,  // 5: schedula.v1.GetDatabaseDiagnosticsResponse.pool:type_name -> schedula.v1.PoolStats
+	61, // 6: schedula.v1.Blackout.start_time:type_name -> google.protobuf.Timestamp
+	61, // 7: schedula.v1.Blackout.end_time:type_name -> google.protobuf.Timestamp
+	0,  // 8: schedula.v1.Blackout.mode:type_name -> schedula.v1.BlackoutMode
+	61, // 9: schedula.v1.Blackout.created_at:type_name -> google.protobuf.Timestamp
+	61, // 10: schedula.v1.CreateBlackoutRequest.start_time:type_name -> google.protobuf.Timestamp
+	61, // 11: schedula.v1.CreateBlackoutRequest.end_time:type_name -> google.protobuf.Timestamp
+	0,  // 12: schedula.v1.CreateBlackoutRequest.mode:type_name -> schedula.v1.BlackoutMode
+	8,  // 13: schedula.v1.CreateBlackoutResponse.blackout:type_name -> schedula.v1.Blackout
+	61, // 14: schedula.v1.ListBlackoutsRequest.window_start:type_name -> google.protobuf.Timestamp
+	61, // 15: schedula.v1.ListBlackoutsRequest.window_end:type_name -> google.protobuf.Timestamp
+	8,  // 16: schedula.v1.ListBlackoutsResponse.blackouts:type_name -> schedula.v1.Blackout
+	15, // 17: schedula.v1.UserUsage.methods:type_name -> schedula.v1.MethodUsage
+	62, // 18: schedula.v1.GetAPIUsageResponse.window:type_name -> google.protobuf.Duration
+	16, // 19: schedula.v1.GetAPIUsageResponse.users:type_name -> schedula.v1.UserUsage
+	62, // 20: schedula.v1.MethodHealth.p99_latency:type_name -> google.protobuf.Duration
+	62, // 21: schedula.v1.HealthWindow.window:type_name -> google.protobuf.Duration
+	19, // 22: schedula.v1.HealthWindow.total:type_name -> schedula.v1.MethodHealth
+	19, // 23: schedula.v1.HealthWindow.methods:type_name -> schedula.v1.MethodHealth
+	62, // 24: schedula.v1.GetServiceHealthSummaryRequest.windows:type_name -> google.protobuf.Duration
+	20, // 25: schedula.v1.GetServiceHealthSummaryResponse.windows:type_name -> schedula.v1.HealthWindow
+	1,  // 26: schedula.v1.LogTarget.kind:type_name -> schedula.v1.LogTargetKind
+	61, // 27: schedula.v1.LogTarget.expires_at:type_name -> google.protobuf.Timestamp
+	1,  // 28: schedula.v1.SetLogTargetRequest.kind:type_name -> schedula.v1.LogTargetKind
+	62, // 29: schedula.v1.SetLogTargetRequest.ttl:type_name -> google.protobuf.Duration
+	23, // 30: schedula.v1.SetLogTargetResponse.target:type_name -> schedula.v1.LogTarget
+	1,  // 31: schedula.v1.ClearLogTargetRequest.kind:type_name -> schedula.v1.LogTargetKind
+	23, // 32: schedula.v1.ListLogTargetsResponse.targets:type_name -> schedula.v1.LogTarget
+	62, // 33: schedula.v1.UpdateRequestPolicyRequest.request_timeout:type_name -> google.protobuf.Duration
+	62, // 34: schedula.v1.UpdateRequestPolicyResponse.request_timeout:type_name -> google.protobuf.Duration
+	61, // 35: schedula.v1.ProvisionedUser.deactivated_at:type_name -> google.protobuf.Timestamp
+	61, // 36: schedula.v1.ProvisionedUser.created_at:type_name -> google.protobuf.Timestamp
+	61, // 37: schedula.v1.ProvisionedUser.updated_at:type_name -> google.protobuf.Timestamp
+	32, // 38: schedula.v1.ProvisionUserResponse.user:type_name -> schedula.v1.ProvisionedUser
+	32, // 39: schedula.v1.DeactivateUserResponse.user:type_name -> schedula.v1.ProvisionedUser
+	32, // 40: schedula.v1.ReactivateUserResponse.user:type_name -> schedula.v1.ProvisionedUser
+	32, // 41: schedula.v1.ListProvisionedUsersResponse.users:type_name -> schedula.v1.ProvisionedUser
+	61, // 42: schedula.v1.UserGroup.created_at:type_name -> google.protobuf.Timestamp
+	61, // 43: schedula.v1.UserGroup.updated_at:type_name -> google.protobuf.Timestamp
+	41, // 44: schedula.v1.CreateUserGroupResponse.group:type_name -> schedula.v1.UserGroup
+	41, // 45: schedula.v1.SetUserGroupMembersResponse.group:type_name -> schedula.v1.UserGroup
+	41, // 46: schedula.v1.ListUserGroupsResponse.groups:type_name -> schedula.v1.UserGroup
+	2,  // 47: schedula.v1.UpdatePastStartPolicyRequest.policy:type_name -> schedula.v1.PastStartPolicy
+	2,  // 48: schedula.v1.UpdatePastStartPolicyResponse.policy:type_name -> schedula.v1.PastStartPolicy
+	2,  // 49: schedula.v1.UpdatePastStartPolicyResponse.effective_policy:type_name -> schedula.v1.PastStartPolicy
+	61, // 50: schedula.v1.RetentionPurge.cutoff:type_name -> google.protobuf.Timestamp
+	53, // 51: schedula.v1.PurgeExpiredAppointmentsResponse.users:type_name -> schedula.v1.RetentionPurge
+	61, // 52: schedula.v1.CreateBackdatedAppointmentRequest.start_time:type_name -> google.protobuf.Timestamp
+	61, // 53: schedula.v1.CreateBackdatedAppointmentRequest.end_time:type_name -> google.protobuf.Timestamp
+	60, // 54: schedula.v1.CreateBackdatedAppointmentRequest.metadata:type_name -> schedula.v1.CreateBackdatedAppointmentRequest.MetadataEntry
+	61, // 55: schedula.v1.CreateBackdatedAppointmentResponse.start_time:type_name -> google.protobuf.Timestamp
+	61, // 56: schedula.v1.CreateBackdatedAppointmentResponse.end_time:type_name -> google.protobuf.Timestamp
+	57, // 57: schedula.v1.UpdateSourceDefaultsRequest.defaults:type_name -> schedula.v1.SourceDefault
+	57, // 58: schedula.v1.UpdateSourceDefaultsResponse.defaults:type_name -> schedula.v1.SourceDefault
+	6,  // 59: schedula.v1.AdminService.GetDatabaseDiagnostics:input_type -> schedula.v1.GetDatabaseDiagnosticsRequest
+	9,  // 60: schedula.v1.AdminService.CreateBlackout:input_type -> schedula.v1.CreateBlackoutRequest
+	11, // 61: schedula.v1.AdminService.DeleteBlackout:input_type -> schedula.v1.DeleteBlackoutRequest
+	13, // 62: schedula.v1.AdminService.ListBlackouts:input_type -> schedula.v1.ListBlackoutsRequest
+	17, // 63: schedula.v1.AdminService.GetAPIUsage:input_type -> schedula.v1.GetAPIUsageRequest
+	48, // 64: schedula.v1.AdminService.UpdatePastStartPolicy:input_type -> schedula.v1.UpdatePastStartPolicyRequest
+	55, // 65: schedula.v1.AdminService.CreateBackdatedAppointment:input_type -> schedula.v1.CreateBackdatedAppointmentRequest
+	50, // 66: schedula.v1.AdminService.UpdateRetentionPolicy:input_type -> schedula.v1.UpdateRetentionPolicyRequest
+	52, // 67: schedula.v1.AdminService.PurgeExpiredAppointments:input_type -> schedula.v1.PurgeExpiredAppointmentsRequest
+	21, // 68: schedula.v1.AdminService.GetServiceHealthSummary:input_type -> schedula.v1.GetServiceHealthSummaryRequest
+	24, // 69: schedula.v1.AdminService.SetLogTarget:input_type -> schedula.v1.SetLogTargetRequest
+	26, // 70: schedula.v1.AdminService.ClearLogTarget:input_type -> schedula.v1.ClearLogTargetRequest
+	28, // 71: schedula.v1.AdminService.ListLogTargets:input_type -> schedula.v1.ListLogTargetsRequest
+	30, // 72: schedula.v1.AdminService.UpdateRequestPolicy:input_type -> schedula.v1.UpdateRequestPolicyRequest
+	33, // 73: schedula.v1.AdminService.ProvisionUser:input_type -> schedula.v1.ProvisionUserRequest
+	35, // 74: schedula.v1.AdminService.DeactivateUser:input_type -> schedula.v1.DeactivateUserRequest
+	37, // 75: schedula.v1.AdminService.ReactivateUser:input_type -> schedula.v1.ReactivateUserRequest
+	39, // 76: schedula.v1.AdminService.ListProvisionedUsers:input_type -> schedula.v1.ListProvisionedUsersRequest
+	42, // 77: schedula.v1.AdminService.CreateUserGroup:input_type -> schedula.v1.CreateUserGroupRequest
+	44, // 78: schedula.v1.AdminService.SetUserGroupMembers:input_type -> schedula.v1.SetUserGroupMembersRequest
+	46, // 79: schedula.v1.AdminService.ListUserGroups:input_type -> schedula.v1.ListUserGroupsRequest
+	58, // 80: schedula.v1.AdminService.UpdateSourceDefaults:input_type -> schedula.v1.UpdateSourceDefaultsRequest
+	7,  // 81: schedula.v1.AdminService.GetDatabaseDiagnostics:output_type -> schedula.v1.GetDatabaseDiagnosticsResponse
+	10, // 82: schedula.v1.AdminService.CreateBlackout:output_type -> schedula.v1.CreateBlackoutResponse
+	12, // 83: schedula.v1.AdminService.DeleteBlackout:output_type -> schedula.v1.DeleteBlackoutResponse
+	14, // 84: schedula.v1.AdminService.ListBlackouts:output_type -> schedula.v1.ListBlackoutsResponse
+	18, // 85: schedula.v1.AdminService.GetAPIUsage:output_type -> schedula.v1.GetAPIUsageResponse
+	49, // 86: schedula.v1.AdminService.UpdatePastStartPolicy:output_type -> schedula.v1.UpdatePastStartPolicyResponse
+	56, // 87: schedula.v1.AdminService.CreateBackdatedAppointment:output_type -> schedula.v1.CreateBackdatedAppointmentResponse
+	51, // 88: schedula.v1.AdminService.UpdateRetentionPolicy:output_type -> schedula.v1.UpdateRetentionPolicyResponse
+	54, // 89: schedula.v1.AdminService.PurgeExpiredAppointments:output_type -> schedula.v1.PurgeExpiredAppointmentsResponse
+	22, // 90: schedula.v1.AdminService.GetServiceHealthSummary:output_type -> schedula.v1.GetServiceHealthSummaryResponse
+	25, // 91: schedula.v1.AdminService.SetLogTarget:output_type -> schedula.v1.SetLogTargetResponse
+	27, // 92: schedula.v1.AdminService.ClearLogTarget:output_type -> schedula.v1.ClearLogTargetResponse
+	29, // 93: schedula.v1.AdminService.ListLogTargets:output_type -> schedula.v1.ListLogTargetsResponse
+	31, // 94: schedula.v1.AdminService.UpdateRequestPolicy:output_type -> schedula.v1.UpdateRequestPolicyResponse
+	34, // 95: schedula.v1.AdminService.ProvisionUser:output_type -> schedula.v1.ProvisionUserResponse
+	36, // 96: schedula.v1.AdminService.DeactivateUser:output_type -> schedula.v1.DeactivateUserResponse
+	38, // 97: schedula.v1.AdminService.ReactivateUser:output_type -> schedula.v1.ReactivateUserResponse
+	40, // 98: schedula.v1.AdminService.ListProvisionedUsers:output_type -> schedula.v1.ListProvisionedUsersResponse
+	43, // 99: schedula.v1.AdminService.CreateUserGroup:output_type -> schedula.v1.CreateUserGroupResponse
+	45, // 100: schedula.v1.AdminService.SetUserGroupMembers:output_type -> schedula.v1.SetUserGroupMembersResponse
+	47, // 101: schedula.v1.AdminService.ListUserGroups:output_type -> schedula.v1.ListUserGroupsResponse
+	59, // 102: schedula.v1.AdminService.UpdateSourceDefaults:output_type -> schedula.v1.UpdateSourceDefaultsResponse
+	81, // [81:103] is the sub-list for method output_type
+	59, // [59:81] is the sub-list for method input_type
+	59, // [59:59] is the sub-list for extension type_name
+	59, // [59:59] is the sub-list for extension extendee
+	0,  // [0:59] is the sub-list for field type_name
+}
+
+func init() { file_proto_schedula_v1_admin_proto_init() }
+func file_proto_schedula_v1_admin_proto_init() {
+	if File_proto_schedula_v1_admin_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_proto_schedula_v1_admin_proto_rawDesc), len(file_proto_schedula_v1_admin_proto_rawDesc)),
+			NumEnums:      3,
+			NumMessages:   58,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_proto_schedula_v1_admin_proto_goTypes,
+		DependencyIndexes: file_proto_schedula_v1_admin_proto_depIdxs,
+		EnumInfos:         file_proto_schedula_v1_admin_proto_enumTypes,
+		MessageInfos:      file_proto_schedula_v1_admin_proto_msgTypes,
+	}.Build()
+	File_proto_schedula_v1_admin_proto = out.File
+	file_proto_schedula_v1_admin_proto_goTypes = nil
+	file_proto_schedula_v1_admin_proto_depIdxs = nil
+}
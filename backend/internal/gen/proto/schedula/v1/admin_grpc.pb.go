@@ -0,0 +1,919 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.6.0
+// - protoc             (unknown)
+// source: proto/schedula/v1/admin.proto
+
+package schedulev1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	AdminService_GetDatabaseDiagnostics_FullMethodName     = "/schedula.v1.AdminService/GetDatabaseDiagnostics"
+	AdminService_CreateBlackout_FullMethodName             = "/schedula.v1.AdminService/CreateBlackout"
+	AdminService_DeleteBlackout_FullMethodName             = "/schedula.v1.AdminService/DeleteBlackout"
+	AdminService_ListBlackouts_FullMethodName              = "/schedula.v1.AdminService/ListBlackouts"
+	AdminService_GetAPIUsage_FullMethodName                = "/schedula.v1.AdminService/GetAPIUsage"
+	AdminService_UpdatePastStartPolicy_FullMethodName      = "/schedula.v1.AdminService/UpdatePastStartPolicy"
+	AdminService_CreateBackdatedAppointment_FullMethodName = "/schedula.v1.AdminService/CreateBackdatedAppointment"
+	AdminService_UpdateRetentionPolicy_FullMethodName      = "/schedula.v1.AdminService/UpdateRetentionPolicy"
+	AdminService_PurgeExpiredAppointments_FullMethodName   = "/schedula.v1.AdminService/PurgeExpiredAppointments"
+	AdminService_GetServiceHealthSummary_FullMethodName    = "/schedula.v1.AdminService/GetServiceHealthSummary"
+	AdminService_SetLogTarget_FullMethodName               = "/schedula.v1.AdminService/SetLogTarget"
+	AdminService_ClearLogTarget_FullMethodName             = "/schedula.v1.AdminService/ClearLogTarget"
+	AdminService_ListLogTargets_FullMethodName             = "/schedula.v1.AdminService/ListLogTargets"
+	AdminService_UpdateRequestPolicy_FullMethodName        = "/schedula.v1.AdminService/UpdateRequestPolicy"
+	AdminService_ProvisionUser_FullMethodName              = "/schedula.v1.AdminService/ProvisionUser"
+	AdminService_DeactivateUser_FullMethodName             = "/schedula.v1.AdminService/DeactivateUser"
+	AdminService_ReactivateUser_FullMethodName             = "/schedula.v1.AdminService/ReactivateUser"
+	AdminService_ListProvisionedUsers_FullMethodName       = "/schedula.v1.AdminService/ListProvisionedUsers"
+	AdminService_CreateUserGroup_FullMethodName            = "/schedula.v1.AdminService/CreateUserGroup"
+	AdminService_SetUserGroupMembers_FullMethodName        = "/schedula.v1.AdminService/SetUserGroupMembers"
+	AdminService_ListUserGroups_FullMethodName             = "/schedula.v1.AdminService/ListUserGroups"
+	AdminService_UpdateSourceDefaults_FullMethodName       = "/schedula.v1.AdminService/UpdateSourceDefaults"
+)
+
+// AdminServiceClient is the client API for AdminService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type AdminServiceClient interface {
+	GetDatabaseDiagnostics(ctx context.Context, in *GetDatabaseDiagnosticsRequest, opts ...grpc.CallOption) (*GetDatabaseDiagnosticsResponse, error)
+	CreateBlackout(ctx context.Context, in *CreateBlackoutRequest, opts ...grpc.CallOption) (*CreateBlackoutResponse, error)
+	DeleteBlackout(ctx context.Context, in *DeleteBlackoutRequest, opts ...grpc.CallOption) (*DeleteBlackoutResponse, error)
+	ListBlackouts(ctx context.Context, in *ListBlackoutsRequest, opts ...grpc.CallOption) (*ListBlackoutsResponse, error)
+	GetAPIUsage(ctx context.Context, in *GetAPIUsageRequest, opts ...grpc.CallOption) (*GetAPIUsageResponse, error)
+	UpdatePastStartPolicy(ctx context.Context, in *UpdatePastStartPolicyRequest, opts ...grpc.CallOption) (*UpdatePastStartPolicyResponse, error)
+	CreateBackdatedAppointment(ctx context.Context, in *CreateBackdatedAppointmentRequest, opts ...grpc.CallOption) (*CreateBackdatedAppointmentResponse, error)
+	UpdateRetentionPolicy(ctx context.Context, in *UpdateRetentionPolicyRequest, opts ...grpc.CallOption) (*UpdateRetentionPolicyResponse, error)
+	PurgeExpiredAppointments(ctx context.Context, in *PurgeExpiredAppointmentsRequest, opts ...grpc.CallOption) (*PurgeExpiredAppointmentsResponse, error)
+	GetServiceHealthSummary(ctx context.Context, in *GetServiceHealthSummaryRequest, opts ...grpc.CallOption) (*GetServiceHealthSummaryResponse, error)
+	SetLogTarget(ctx context.Context, in *SetLogTargetRequest, opts ...grpc.CallOption) (*SetLogTargetResponse, error)
+	ClearLogTarget(ctx context.Context, in *ClearLogTargetRequest, opts ...grpc.CallOption) (*ClearLogTargetResponse, error)
+	ListLogTargets(ctx context.Context, in *ListLogTargetsRequest, opts ...grpc.CallOption) (*ListLogTargetsResponse, error)
+	UpdateRequestPolicy(ctx context.Context, in *UpdateRequestPolicyRequest, opts ...grpc.CallOption) (*UpdateRequestPolicyResponse, error)
+	ProvisionUser(ctx context.Context, in *ProvisionUserRequest, opts ...grpc.CallOption) (*ProvisionUserResponse, error)
+	DeactivateUser(ctx context.Context, in *DeactivateUserRequest, opts ...grpc.CallOption) (*DeactivateUserResponse, error)
+	ReactivateUser(ctx context.Context, in *ReactivateUserRequest, opts ...grpc.CallOption) (*ReactivateUserResponse, error)
+	ListProvisionedUsers(ctx context.Context, in *ListProvisionedUsersRequest, opts ...grpc.CallOption) (*ListProvisionedUsersResponse, error)
+	CreateUserGroup(ctx context.Context, in *CreateUserGroupRequest, opts ...grpc.CallOption) (*CreateUserGroupResponse, error)
+	SetUserGroupMembers(ctx context.Context, in *SetUserGroupMembersRequest, opts ...grpc.CallOption) (*SetUserGroupMembersResponse, error)
+	ListUserGroups(ctx context.Context, in *ListUserGroupsRequest, opts ...grpc.CallOption) (*ListUserGroupsResponse, error)
+	UpdateSourceDefaults(ctx context.Context, in *UpdateSourceDefaultsRequest, opts ...grpc.CallOption) (*UpdateSourceDefaultsResponse, error)
+}
+
+type adminServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewAdminServiceClient(cc grpc.ClientConnInterface) AdminServiceClient {
+	return &adminServiceClient{cc}
+}
+
+func (c *adminServiceClient) GetDatabaseDiagnostics(ctx context.Context, in *GetDatabaseDiagnosticsRequest, opts ...grpc.CallOption) (*GetDatabaseDiagnosticsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetDatabaseDiagnosticsResponse)
+	err := c.cc.Invoke(ctx, AdminService_GetDatabaseDiagnostics_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) CreateBlackout(ctx context.Context, in *CreateBlackoutRequest, opts ...grpc.CallOption) (*CreateBlackoutResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateBlackoutResponse)
+	err := c.cc.Invoke(ctx, AdminService_CreateBlackout_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) DeleteBlackout(ctx context.Context, in *DeleteBlackoutRequest, opts ...grpc.CallOption) (*DeleteBlackoutResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteBlackoutResponse)
+	err := c.cc.Invoke(ctx, AdminService_DeleteBlackout_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) ListBlackouts(ctx context.Context, in *ListBlackoutsRequest, opts ...grpc.CallOption) (*ListBlackoutsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListBlackoutsResponse)
+	err := c.cc.Invoke(ctx, AdminService_ListBlackouts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) GetAPIUsage(ctx context.Context, in *GetAPIUsageRequest, opts ...grpc.CallOption) (*GetAPIUsageResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetAPIUsageResponse)
+	err := c.cc.Invoke(ctx, AdminService_GetAPIUsage_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) UpdatePastStartPolicy(ctx context.Context, in *UpdatePastStartPolicyRequest, opts ...grpc.CallOption) (*UpdatePastStartPolicyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdatePastStartPolicyResponse)
+	err := c.cc.Invoke(ctx, AdminService_UpdatePastStartPolicy_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) CreateBackdatedAppointment(ctx context.Context, in *CreateBackdatedAppointmentRequest, opts ...grpc.CallOption) (*CreateBackdatedAppointmentResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateBackdatedAppointmentResponse)
+	err := c.cc.Invoke(ctx, AdminService_CreateBackdatedAppointment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) UpdateRetentionPolicy(ctx context.Context, in *UpdateRetentionPolicyRequest, opts ...grpc.CallOption) (*UpdateRetentionPolicyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateRetentionPolicyResponse)
+	err := c.cc.Invoke(ctx, AdminService_UpdateRetentionPolicy_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) PurgeExpiredAppointments(ctx context.Context, in *PurgeExpiredAppointmentsRequest, opts ...grpc.CallOption) (*PurgeExpiredAppointmentsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PurgeExpiredAppointmentsResponse)
+	err := c.cc.Invoke(ctx, AdminService_PurgeExpiredAppointments_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) GetServiceHealthSummary(ctx context.Context, in *GetServiceHealthSummaryRequest, opts ...grpc.CallOption) (*GetServiceHealthSummaryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetServiceHealthSummaryResponse)
+	err := c.cc.Invoke(ctx, AdminService_GetServiceHealthSummary_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) SetLogTarget(ctx context.Context, in *SetLogTargetRequest, opts ...grpc.CallOption) (*SetLogTargetResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetLogTargetResponse)
+	err := c.cc.Invoke(ctx, AdminService_SetLogTarget_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) ClearLogTarget(ctx context.Context, in *ClearLogTargetRequest, opts ...grpc.CallOption) (*ClearLogTargetResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ClearLogTargetResponse)
+	err := c.cc.Invoke(ctx, AdminService_ClearLogTarget_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) ListLogTargets(ctx context.Context, in *ListLogTargetsRequest, opts ...grpc.CallOption) (*ListLogTargetsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListLogTargetsResponse)
+	err := c.cc.Invoke(ctx, AdminService_ListLogTargets_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) UpdateRequestPolicy(ctx context.Context, in *UpdateRequestPolicyRequest, opts ...grpc.CallOption) (*UpdateRequestPolicyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateRequestPolicyResponse)
+	err := c.cc.Invoke(ctx, AdminService_UpdateRequestPolicy_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) ProvisionUser(ctx context.Context, in *ProvisionUserRequest, opts ...grpc.CallOption) (*ProvisionUserResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ProvisionUserResponse)
+	err := c.cc.Invoke(ctx, AdminService_ProvisionUser_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) DeactivateUser(ctx context.Context, in *DeactivateUserRequest, opts ...grpc.CallOption) (*DeactivateUserResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeactivateUserResponse)
+	err := c.cc.Invoke(ctx, AdminService_DeactivateUser_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) ReactivateUser(ctx context.Context, in *ReactivateUserRequest, opts ...grpc.CallOption) (*ReactivateUserResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReactivateUserResponse)
+	err := c.cc.Invoke(ctx, AdminService_ReactivateUser_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) ListProvisionedUsers(ctx context.Context, in *ListProvisionedUsersRequest, opts ...grpc.CallOption) (*ListProvisionedUsersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListProvisionedUsersResponse)
+	err := c.cc.Invoke(ctx, AdminService_ListProvisionedUsers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) CreateUserGroup(ctx context.Context, in *CreateUserGroupRequest, opts ...grpc.CallOption) (*CreateUserGroupResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateUserGroupResponse)
+	err := c.cc.Invoke(ctx, AdminService_CreateUserGroup_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) SetUserGroupMembers(ctx context.Context, in *SetUserGroupMembersRequest, opts ...grpc.CallOption) (*SetUserGroupMembersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetUserGroupMembersResponse)
+	err := c.cc.Invoke(ctx, AdminService_SetUserGroupMembers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) ListUserGroups(ctx context.Context, in *ListUserGroupsRequest, opts ...grpc.CallOption) (*ListUserGroupsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListUserGroupsResponse)
+	err := c.cc.Invoke(ctx, AdminService_ListUserGroups_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) UpdateSourceDefaults(ctx context.Context, in *UpdateSourceDefaultsRequest, opts ...grpc.CallOption) (*UpdateSourceDefaultsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateSourceDefaultsResponse)
+	err := c.cc.Invoke(ctx, AdminService_UpdateSourceDefaults_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// AdminServiceServer is the server API for AdminService service.
+// All implementations must embed UnimplementedAdminServiceServer
+// for forward compatibility.
+type AdminServiceServer interface {
+	GetDatabaseDiagnostics(context.Context, *GetDatabaseDiagnosticsRequest) (*GetDatabaseDiagnosticsResponse, error)
+	CreateBlackout(context.Context, *CreateBlackoutRequest) (*CreateBlackoutResponse, error)
+	DeleteBlackout(context.Context, *DeleteBlackoutRequest) (*DeleteBlackoutResponse, error)
+	ListBlackouts(context.Context, *ListBlackoutsRequest) (*ListBlackoutsResponse, error)
+	GetAPIUsage(context.Context, *GetAPIUsageRequest) (*GetAPIUsageResponse, error)
+	UpdatePastStartPolicy(context.Context, *UpdatePastStartPolicyRequest) (*UpdatePastStartPolicyResponse, error)
+	CreateBackdatedAppointment(context.Context, *CreateBackdatedAppointmentRequest) (*CreateBackdatedAppointmentResponse, error)
+	UpdateRetentionPolicy(context.Context, *UpdateRetentionPolicyRequest) (*UpdateRetentionPolicyResponse, error)
+	PurgeExpiredAppointments(context.Context, *PurgeExpiredAppointmentsRequest) (*PurgeExpiredAppointmentsResponse, error)
+	GetServiceHealthSummary(context.Context, *GetServiceHealthSummaryRequest) (*GetServiceHealthSummaryResponse, error)
+	SetLogTarget(context.Context, *SetLogTargetRequest) (*SetLogTargetResponse, error)
+	ClearLogTarget(context.Context, *ClearLogTargetRequest) (*ClearLogTargetResponse, error)
+	ListLogTargets(context.Context, *ListLogTargetsRequest) (*ListLogTargetsResponse, error)
+	UpdateRequestPolicy(context.Context, *UpdateRequestPolicyRequest) (*UpdateRequestPolicyResponse, error)
+	ProvisionUser(context.Context, *ProvisionUserRequest) (*ProvisionUserResponse, error)
+	DeactivateUser(context.Context, *DeactivateUserRequest) (*DeactivateUserResponse, error)
+	ReactivateUser(context.Context, *ReactivateUserRequest) (*ReactivateUserResponse, error)
+	ListProvisionedUsers(context.Context, *ListProvisionedUsersRequest) (*ListProvisionedUsersResponse, error)
+	CreateUserGroup(context.Context, *CreateUserGroupRequest) (*CreateUserGroupResponse, error)
+	SetUserGroupMembers(context.Context, *SetUserGroupMembersRequest) (*SetUserGroupMembersResponse, error)
+	ListUserGroups(context.Context, *ListUserGroupsRequest) (*ListUserGroupsResponse, error)
+	UpdateSourceDefaults(context.Context, *UpdateSourceDefaultsRequest) (*UpdateSourceDefaultsResponse, error)
+	mustEmbedUnimplementedAdminServiceServer()
+}
+
+// UnimplementedAdminServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedAdminServiceServer struct{}
+
+func (UnimplementedAdminServiceServer) GetDatabaseDiagnostics(context.Context, *GetDatabaseDiagnosticsRequest) (*GetDatabaseDiagnosticsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetDatabaseDiagnostics not implemented")
+}
+func (UnimplementedAdminServiceServer) CreateBlackout(context.Context, *CreateBlackoutRequest) (*CreateBlackoutResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateBlackout not implemented")
+}
+func (UnimplementedAdminServiceServer) DeleteBlackout(context.Context, *DeleteBlackoutRequest) (*DeleteBlackoutResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteBlackout not implemented")
+}
+func (UnimplementedAdminServiceServer) ListBlackouts(context.Context, *ListBlackoutsRequest) (*ListBlackoutsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListBlackouts not implemented")
+}
+func (UnimplementedAdminServiceServer) GetAPIUsage(context.Context, *GetAPIUsageRequest) (*GetAPIUsageResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetAPIUsage not implemented")
+}
+func (UnimplementedAdminServiceServer) UpdatePastStartPolicy(context.Context, *UpdatePastStartPolicyRequest) (*UpdatePastStartPolicyResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdatePastStartPolicy not implemented")
+}
+func (UnimplementedAdminServiceServer) CreateBackdatedAppointment(context.Context, *CreateBackdatedAppointmentRequest) (*CreateBackdatedAppointmentResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateBackdatedAppointment not implemented")
+}
+func (UnimplementedAdminServiceServer) UpdateRetentionPolicy(context.Context, *UpdateRetentionPolicyRequest) (*UpdateRetentionPolicyResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateRetentionPolicy not implemented")
+}
+func (UnimplementedAdminServiceServer) PurgeExpiredAppointments(context.Context, *PurgeExpiredAppointmentsRequest) (*PurgeExpiredAppointmentsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method PurgeExpiredAppointments not implemented")
+}
+func (UnimplementedAdminServiceServer) GetServiceHealthSummary(context.Context, *GetServiceHealthSummaryRequest) (*GetServiceHealthSummaryResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetServiceHealthSummary not implemented")
+}
+func (UnimplementedAdminServiceServer) SetLogTarget(context.Context, *SetLogTargetRequest) (*SetLogTargetResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetLogTarget not implemented")
+}
+func (UnimplementedAdminServiceServer) ClearLogTarget(context.Context, *ClearLogTargetRequest) (*ClearLogTargetResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ClearLogTarget not implemented")
+}
+func (UnimplementedAdminServiceServer) ListLogTargets(context.Context, *ListLogTargetsRequest) (*ListLogTargetsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListLogTargets not implemented")
+}
+func (UnimplementedAdminServiceServer) UpdateRequestPolicy(context.Context, *UpdateRequestPolicyRequest) (*UpdateRequestPolicyResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateRequestPolicy not implemented")
+}
+func (UnimplementedAdminServiceServer) ProvisionUser(context.Context, *ProvisionUserRequest) (*ProvisionUserResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ProvisionUser not implemented")
+}
+func (UnimplementedAdminServiceServer) DeactivateUser(context.Context, *DeactivateUserRequest) (*DeactivateUserResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeactivateUser not implemented")
+}
+func (UnimplementedAdminServiceServer) ReactivateUser(context.Context, *ReactivateUserRequest) (*ReactivateUserResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ReactivateUser not implemented")
+}
+func (UnimplementedAdminServiceServer) ListProvisionedUsers(context.Context, *ListProvisionedUsersRequest) (*ListProvisionedUsersResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListProvisionedUsers not implemented")
+}
+func (UnimplementedAdminServiceServer) CreateUserGroup(context.Context, *CreateUserGroupRequest) (*CreateUserGroupResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateUserGroup not implemented")
+}
+func (UnimplementedAdminServiceServer) SetUserGroupMembers(context.Context, *SetUserGroupMembersRequest) (*SetUserGroupMembersResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetUserGroupMembers not implemented")
+}
+func (UnimplementedAdminServiceServer) ListUserGroups(context.Context, *ListUserGroupsRequest) (*ListUserGroupsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListUserGroups not implemented")
+}
+func (UnimplementedAdminServiceServer) UpdateSourceDefaults(context.Context, *UpdateSourceDefaultsRequest) (*UpdateSourceDefaultsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateSourceDefaults not implemented")
+}
+func (UnimplementedAdminServiceServer) mustEmbedUnimplementedAdminServiceServer() {}
+func (UnimplementedAdminServiceServer) testEmbeddedByValue()                      {}
+
+// UnsafeAdminServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to AdminServiceServer will
+// result in compilation errors.
+type UnsafeAdminServiceServer interface {
+	mustEmbedUnimplementedAdminServiceServer()
+}
+
+func RegisterAdminServiceServer(s grpc.ServiceRegistrar, srv AdminServiceServer) {
+	// If the following call panics, it indicates UnimplementedAdminServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&AdminService_ServiceDesc, srv)
+}
+
+func _AdminService_GetDatabaseDiagnostics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDatabaseDiagnosticsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).GetDatabaseDiagnostics(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_GetDatabaseDiagnostics_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).GetDatabaseDiagnostics(ctx, req.(*GetDatabaseDiagnosticsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_CreateBlackout_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateBlackoutRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).CreateBlackout(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_CreateBlackout_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).CreateBlackout(ctx, req.(*CreateBlackoutRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_DeleteBlackout_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteBlackoutRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).DeleteBlackout(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_DeleteBlackout_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).DeleteBlackout(ctx, req.(*DeleteBlackoutRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_ListBlackouts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListBlackoutsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).ListBlackouts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_ListBlackouts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).ListBlackouts(ctx, req.(*ListBlackoutsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_GetAPIUsage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAPIUsageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).GetAPIUsage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_GetAPIUsage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).GetAPIUsage(ctx, req.(*GetAPIUsageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_UpdatePastStartPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdatePastStartPolicyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).UpdatePastStartPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_UpdatePastStartPolicy_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).UpdatePastStartPolicy(ctx, req.(*UpdatePastStartPolicyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_CreateBackdatedAppointment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateBackdatedAppointmentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).CreateBackdatedAppointment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_CreateBackdatedAppointment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).CreateBackdatedAppointment(ctx, req.(*CreateBackdatedAppointmentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_UpdateRetentionPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateRetentionPolicyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).UpdateRetentionPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_UpdateRetentionPolicy_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).UpdateRetentionPolicy(ctx, req.(*UpdateRetentionPolicyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_PurgeExpiredAppointments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PurgeExpiredAppointmentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).PurgeExpiredAppointments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_PurgeExpiredAppointments_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).PurgeExpiredAppointments(ctx, req.(*PurgeExpiredAppointmentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_GetServiceHealthSummary_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetServiceHealthSummaryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).GetServiceHealthSummary(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_GetServiceHealthSummary_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).GetServiceHealthSummary(ctx, req.(*GetServiceHealthSummaryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_SetLogTarget_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetLogTargetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).SetLogTarget(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_SetLogTarget_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).SetLogTarget(ctx, req.(*SetLogTargetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_ClearLogTarget_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ClearLogTargetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).ClearLogTarget(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_ClearLogTarget_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).ClearLogTarget(ctx, req.(*ClearLogTargetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_ListLogTargets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListLogTargetsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).ListLogTargets(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_ListLogTargets_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).ListLogTargets(ctx, req.(*ListLogTargetsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_UpdateRequestPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateRequestPolicyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).UpdateRequestPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_UpdateRequestPolicy_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).UpdateRequestPolicy(ctx, req.(*UpdateRequestPolicyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_ProvisionUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProvisionUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).ProvisionUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_ProvisionUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).ProvisionUser(ctx, req.(*ProvisionUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_DeactivateUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeactivateUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).DeactivateUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_DeactivateUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).DeactivateUser(ctx, req.(*DeactivateUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_ReactivateUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReactivateUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).ReactivateUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_ReactivateUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).ReactivateUser(ctx, req.(*ReactivateUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_ListProvisionedUsers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListProvisionedUsersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).ListProvisionedUsers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_ListProvisionedUsers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).ListProvisionedUsers(ctx, req.(*ListProvisionedUsersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_CreateUserGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateUserGroupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).CreateUserGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_CreateUserGroup_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).CreateUserGroup(ctx, req.(*CreateUserGroupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_SetUserGroupMembers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetUserGroupMembersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).SetUserGroupMembers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_SetUserGroupMembers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).SetUserGroupMembers(ctx, req.(*SetUserGroupMembersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_ListUserGroups_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListUserGroupsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).ListUserGroups(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_ListUserGroups_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).ListUserGroups(ctx, req.(*ListUserGroupsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_UpdateSourceDefaults_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateSourceDefaultsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).UpdateSourceDefaults(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_UpdateSourceDefaults_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).UpdateSourceDefaults(ctx, req.(*UpdateSourceDefaultsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// AdminService_ServiceDesc is the grpc.ServiceDesc for AdminService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var AdminService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "schedula.v1.AdminService",
+	HandlerType: (*AdminServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetDatabaseDiagnostics",
+			Handler:    _AdminService_GetDatabaseDiagnostics_Handler,
+		},
+		{
+			MethodName: "CreateBlackout",
+			Handler:    _AdminService_CreateBlackout_Handler,
+		},
+		{
+			MethodName: "DeleteBlackout",
+			Handler:    _AdminService_DeleteBlackout_Handler,
+		},
+		{
+			MethodName: "ListBlackouts",
+			Handler:    _AdminService_ListBlackouts_Handler,
+		},
+		{
+			MethodName: "GetAPIUsage",
+			Handler:    _AdminService_GetAPIUsage_Handler,
+		},
+		{
+			MethodName: "UpdatePastStartPolicy",
+			Handler:    _AdminService_UpdatePastStartPolicy_Handler,
+		},
+		{
+			MethodName: "CreateBackdatedAppointment",
+			Handler:    _AdminService_CreateBackdatedAppointment_Handler,
+		},
+		{
+			MethodName: "UpdateRetentionPolicy",
+			Handler:    _AdminService_UpdateRetentionPolicy_Handler,
+		},
+		{
+			MethodName: "PurgeExpiredAppointments",
+			Handler:    _AdminService_PurgeExpiredAppointments_Handler,
+		},
+		{
+			MethodName: "GetServiceHealthSummary",
+			Handler:    _AdminService_GetServiceHealthSummary_Handler,
+		},
+		{
+			MethodName: "SetLogTarget",
+			Handler:    _AdminService_SetLogTarget_Handler,
+		},
+		{
+			MethodName: "ClearLogTarget",
+			Handler:    _AdminService_ClearLogTarget_Handler,
+		},
+		{
+			MethodName: "ListLogTargets",
+			Handler:    _AdminService_ListLogTargets_Handler,
+		},
+		{
+			MethodName: "UpdateRequestPolicy",
+			Handler:    _AdminService_UpdateRequestPolicy_Handler,
+		},
+		{
+			MethodName: "ProvisionUser",
+			Handler:    _AdminService_ProvisionUser_Handler,
+		},
+		{
+			MethodName: "DeactivateUser",
+			Handler:    _AdminService_DeactivateUser_Handler,
+		},
+		{
+			MethodName: "ReactivateUser",
+			Handler:    _AdminService_ReactivateUser_Handler,
+		},
+		{
+			MethodName: "ListProvisionedUsers",
+			Handler:    _AdminService_ListProvisionedUsers_Handler,
+		},
+		{
+			MethodName: "CreateUserGroup",
+			Handler:    _AdminService_CreateUserGroup_Handler,
+		},
+		{
+			MethodName: "SetUserGroupMembers",
+			Handler:    _AdminService_SetUserGroupMembers_Handler,
+		},
+		{
+			MethodName: "ListUserGroups",
+			Handler:    _AdminService_ListUserGroups_Handler,
+		},
+		{
+			MethodName: "UpdateSourceDefaults",
+			Handler:    _AdminService_UpdateSourceDefaults_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "proto/schedula/v1/admin.proto",
+}
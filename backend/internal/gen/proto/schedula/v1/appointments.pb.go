@@ -9,6 +9,7 @@ package schedulev1
 import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
 	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
@@ -86,32 +87,10973 @@ func (Weekday) EnumDescriptor() ([]byte, []int) {
 	return file_proto_schedula_v1_appointments_proto_rawDescGZIP(), []int{0}
 }
 
+type AttendanceStatus int32
+
+const (
+	AttendanceStatus_ATTENDANCE_STATUS_UNSPECIFIED AttendanceStatus = 0
+	AttendanceStatus_ATTENDANCE_STATUS_ATTENDED    AttendanceStatus = 1
+	AttendanceStatus_ATTENDANCE_STATUS_MISSED      AttendanceStatus = 2
+)
+
+// Enum value maps for AttendanceStatus.
+var (
+	AttendanceStatus_name = map[int32]string{
+		0: "ATTENDANCE_STATUS_UNSPECIFIED",
+		1: "ATTENDANCE_STATUS_ATTENDED",
+		2: "ATTENDANCE_STATUS_MISSED",
+	}
+	AttendanceStatus_value = map[string]int32{
+		"ATTENDANCE_STATUS_UNSPECIFIED": 0,
+		"ATTENDANCE_STATUS_ATTENDED":    1,
+		"ATTENDANCE_STATUS_MISSED":      2,
+	}
+)
+
+func (x AttendanceStatus) Enum() *AttendanceStatus {
+	p := new(AttendanceStatus)
+	*p = x
+	return p
+}
+
+func (x AttendanceStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (AttendanceStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_schedula_v1_appointments_proto_enumTypes[1].Descriptor()
+}
+
+func (AttendanceStatus) Type() protoreflect.EnumType {
+	return &file_proto_schedula_v1_appointments_proto_enumTypes[1]
+}
+
+func (x AttendanceStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use AttendanceStatus.Descriptor instead.
+func (AttendanceStatus) EnumDescriptor() ([]byte, []int) {
+	return file_proto_schedula_v1_appointments_proto_rawDescGZIP(), []int{1}
+}
+
+type AppointmentLinkKind int32
+
+const (
+	AppointmentLinkKind_APPOINTMENT_LINK_KIND_UNSPECIFIED  AppointmentLinkKind = 0
+	AppointmentLinkKind_APPOINTMENT_LINK_KIND_FOLLOW_UP_OF AppointmentLinkKind = 1
+	AppointmentLinkKind_APPOINTMENT_LINK_KIND_PREP_FOR     AppointmentLinkKind = 2
+)
+
+// Enum value maps for AppointmentLinkKind.
+var (
+	AppointmentLinkKind_name = map[int32]string{
+		0: "APPOINTMENT_LINK_KIND_UNSPECIFIED",
+		1: "APPOINTMENT_LINK_KIND_FOLLOW_UP_OF",
+		2: "APPOINTMENT_LINK_KIND_PREP_FOR",
+	}
+	AppointmentLinkKind_value = map[string]int32{
+		"APPOINTMENT_LINK_KIND_UNSPECIFIED":  0,
+		"APPOINTMENT_LINK_KIND_FOLLOW_UP_OF": 1,
+		"APPOINTMENT_LINK_KIND_PREP_FOR":     2,
+	}
+)
+
+func (x AppointmentLinkKind) Enum() *AppointmentLinkKind {
+	p := new(AppointmentLinkKind)
+	*p = x
+	return p
+}
+
+func (x AppointmentLinkKind) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (AppointmentLinkKind) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_schedula_v1_appointments_proto_enumTypes[2].Descriptor()
+}
+
+func (AppointmentLinkKind) Type() protoreflect.EnumType {
+	return &file_proto_schedula_v1_appointments_proto_enumTypes[2]
+}
+
+func (x AppointmentLinkKind) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use AppointmentLinkKind.Descriptor instead.
+func (AppointmentLinkKind) EnumDescriptor() ([]byte, []int) {
+	return file_proto_schedula_v1_appointments_proto_rawDescGZIP(), []int{2}
+}
+
+type DstGapPolicy int32
+
+const (
+	DstGapPolicy_DST_GAP_POLICY_UNSPECIFIED   DstGapPolicy = 0
+	DstGapPolicy_DST_GAP_POLICY_SHIFT_FORWARD DstGapPolicy = 1
+	DstGapPolicy_DST_GAP_POLICY_SKIP          DstGapPolicy = 2
+)
+
+// Enum value maps for DstGapPolicy.
+var (
+	DstGapPolicy_name = map[int32]string{
+		0: "DST_GAP_POLICY_UNSPECIFIED",
+		1: "DST_GAP_POLICY_SHIFT_FORWARD",
+		2: "DST_GAP_POLICY_SKIP",
+	}
+	DstGapPolicy_value = map[string]int32{
+		"DST_GAP_POLICY_UNSPECIFIED":   0,
+		"DST_GAP_POLICY_SHIFT_FORWARD": 1,
+		"DST_GAP_POLICY_SKIP":          2,
+	}
+)
+
+func (x DstGapPolicy) Enum() *DstGapPolicy {
+	p := new(DstGapPolicy)
+	*p = x
+	return p
+}
+
+func (x DstGapPolicy) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (DstGapPolicy) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_schedula_v1_appointments_proto_enumTypes[3].Descriptor()
+}
+
+func (DstGapPolicy) Type() protoreflect.EnumType {
+	return &file_proto_schedula_v1_appointments_proto_enumTypes[3]
+}
+
+func (x DstGapPolicy) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use DstGapPolicy.Descriptor instead.
+func (DstGapPolicy) EnumDescriptor() ([]byte, []int) {
+	return file_proto_schedula_v1_appointments_proto_rawDescGZIP(), []int{3}
+}
+
+type DstAmbiguousPolicy int32
+
+const (
+	DstAmbiguousPolicy_DST_AMBIGUOUS_POLICY_UNSPECIFIED DstAmbiguousPolicy = 0
+	DstAmbiguousPolicy_DST_AMBIGUOUS_POLICY_EARLIER     DstAmbiguousPolicy = 1
+	DstAmbiguousPolicy_DST_AMBIGUOUS_POLICY_LATER       DstAmbiguousPolicy = 2
+)
+
+// Enum value maps for DstAmbiguousPolicy.
+var (
+	DstAmbiguousPolicy_name = map[int32]string{
+		0: "DST_AMBIGUOUS_POLICY_UNSPECIFIED",
+		1: "DST_AMBIGUOUS_POLICY_EARLIER",
+		2: "DST_AMBIGUOUS_POLICY_LATER",
+	}
+	DstAmbiguousPolicy_value = map[string]int32{
+		"DST_AMBIGUOUS_POLICY_UNSPECIFIED": 0,
+		"DST_AMBIGUOUS_POLICY_EARLIER":     1,
+		"DST_AMBIGUOUS_POLICY_LATER":       2,
+	}
+)
+
+func (x DstAmbiguousPolicy) Enum() *DstAmbiguousPolicy {
+	p := new(DstAmbiguousPolicy)
+	*p = x
+	return p
+}
+
+func (x DstAmbiguousPolicy) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (DstAmbiguousPolicy) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_schedula_v1_appointments_proto_enumTypes[4].Descriptor()
+}
+
+func (DstAmbiguousPolicy) Type() protoreflect.EnumType {
+	return &file_proto_schedula_v1_appointments_proto_enumTypes[4]
+}
+
+func (x DstAmbiguousPolicy) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use DstAmbiguousPolicy.Descriptor instead.
+func (DstAmbiguousPolicy) EnumDescriptor() ([]byte, []int) {
+	return file_proto_schedula_v1_appointments_proto_rawDescGZIP(), []int{4}
+}
+
+type AppointmentKind int32
+
+const (
+	AppointmentKind_APPOINTMENT_KIND_UNSPECIFIED AppointmentKind = 0
+	AppointmentKind_APPOINTMENT_KIND_EVENT       AppointmentKind = 1
+	AppointmentKind_APPOINTMENT_KIND_MILESTONE   AppointmentKind = 2
+)
+
+// Enum value maps for AppointmentKind.
+var (
+	AppointmentKind_name = map[int32]string{
+		0: "APPOINTMENT_KIND_UNSPECIFIED",
+		1: "APPOINTMENT_KIND_EVENT",
+		2: "APPOINTMENT_KIND_MILESTONE",
+	}
+	AppointmentKind_value = map[string]int32{
+		"APPOINTMENT_KIND_UNSPECIFIED": 0,
+		"APPOINTMENT_KIND_EVENT":       1,
+		"APPOINTMENT_KIND_MILESTONE":   2,
+	}
+)
+
+func (x AppointmentKind) Enum() *AppointmentKind {
+	p := new(AppointmentKind)
+	*p = x
+	return p
+}
+
+func (x AppointmentKind) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (AppointmentKind) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_schedula_v1_appointments_proto_enumTypes[5].Descriptor()
+}
+
+func (AppointmentKind) Type() protoreflect.EnumType {
+	return &file_proto_schedula_v1_appointments_proto_enumTypes[5]
+}
+
+func (x AppointmentKind) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use AppointmentKind.Descriptor instead.
+func (AppointmentKind) EnumDescriptor() ([]byte, []int) {
+	return file_proto_schedula_v1_appointments_proto_rawDescGZIP(), []int{5}
+}
+
+type TimeZoneKeep int32
+
+const (
+	TimeZoneKeep_TIME_ZONE_KEEP_UNSPECIFIED TimeZoneKeep = 0
+	TimeZoneKeep_TIME_ZONE_KEEP_WALL_TIME   TimeZoneKeep = 1
+	TimeZoneKeep_TIME_ZONE_KEEP_INSTANT     TimeZoneKeep = 2
+)
+
+// Enum value maps for TimeZoneKeep.
+var (
+	TimeZoneKeep_name = map[int32]string{
+		0: "TIME_ZONE_KEEP_UNSPECIFIED",
+		1: "TIME_ZONE_KEEP_WALL_TIME",
+		2: "TIME_ZONE_KEEP_INSTANT",
+	}
+	TimeZoneKeep_value = map[string]int32{
+		"TIME_ZONE_KEEP_UNSPECIFIED": 0,
+		"TIME_ZONE_KEEP_WALL_TIME":   1,
+		"TIME_ZONE_KEEP_INSTANT":     2,
+	}
+)
+
+func (x TimeZoneKeep) Enum() *TimeZoneKeep {
+	p := new(TimeZoneKeep)
+	*p = x
+	return p
+}
+
+func (x TimeZoneKeep) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TimeZoneKeep) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_schedula_v1_appointments_proto_enumTypes[6].Descriptor()
+}
+
+func (TimeZoneKeep) Type() protoreflect.EnumType {
+	return &file_proto_schedula_v1_appointments_proto_enumTypes[6]
+}
+
+func (x TimeZoneKeep) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TimeZoneKeep.Descriptor instead.
+func (TimeZoneKeep) EnumDescriptor() ([]byte, []int) {
+	return file_proto_schedula_v1_appointments_proto_rawDescGZIP(), []int{6}
+}
+
+type IntervalBounds int32
+
+const (
+	IntervalBounds_INTERVAL_BOUNDS_UNSPECIFIED                   IntervalBounds = 0
+	IntervalBounds_INTERVAL_BOUNDS_START_INCLUSIVE_END_EXCLUSIVE IntervalBounds = 1
+)
+
+// Enum value maps for IntervalBounds.
+var (
+	IntervalBounds_name = map[int32]string{
+		0: "INTERVAL_BOUNDS_UNSPECIFIED",
+		1: "INTERVAL_BOUNDS_START_INCLUSIVE_END_EXCLUSIVE",
+	}
+	IntervalBounds_value = map[string]int32{
+		"INTERVAL_BOUNDS_UNSPECIFIED":                   0,
+		"INTERVAL_BOUNDS_START_INCLUSIVE_END_EXCLUSIVE": 1,
+	}
+)
+
+func (x IntervalBounds) Enum() *IntervalBounds {
+	p := new(IntervalBounds)
+	*p = x
+	return p
+}
+
+func (x IntervalBounds) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (IntervalBounds) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_schedula_v1_appointments_proto_enumTypes[7].Descriptor()
+}
+
+func (IntervalBounds) Type() protoreflect.EnumType {
+	return &file_proto_schedula_v1_appointments_proto_enumTypes[7]
+}
+
+func (x IntervalBounds) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use IntervalBounds.Descriptor instead.
+func (IntervalBounds) EnumDescriptor() ([]byte, []int) {
+	return file_proto_schedula_v1_appointments_proto_rawDescGZIP(), []int{7}
+}
+
+type ConflictCause int32
+
+const (
+	ConflictCause_CONFLICT_CAUSE_UNSPECIFIED ConflictCause = 0
+	ConflictCause_CONFLICT_CAUSE_OVERLAP     ConflictCause = 1
+	ConflictCause_CONFLICT_CAUSE_EXTERNAL    ConflictCause = 2
+	ConflictCause_CONFLICT_CAUSE_OVERRIDE    ConflictCause = 3
+)
+
+// Enum value maps for ConflictCause.
+var (
+	ConflictCause_name = map[int32]string{
+		0: "CONFLICT_CAUSE_UNSPECIFIED",
+		1: "CONFLICT_CAUSE_OVERLAP",
+		2: "CONFLICT_CAUSE_EXTERNAL",
+		3: "CONFLICT_CAUSE_OVERRIDE",
+	}
+	ConflictCause_value = map[string]int32{
+		"CONFLICT_CAUSE_UNSPECIFIED": 0,
+		"CONFLICT_CAUSE_OVERLAP":     1,
+		"CONFLICT_CAUSE_EXTERNAL":    2,
+		"CONFLICT_CAUSE_OVERRIDE":    3,
+	}
+)
+
+func (x ConflictCause) Enum() *ConflictCause {
+	p := new(ConflictCause)
+	*p = x
+	return p
+}
+
+func (x ConflictCause) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ConflictCause) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_schedula_v1_appointments_proto_enumTypes[8].Descriptor()
+}
+
+func (ConflictCause) Type() protoreflect.EnumType {
+	return &file_proto_schedula_v1_appointments_proto_enumTypes[8]
+}
+
+func (x ConflictCause) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ConflictCause.Descriptor instead.
+func (ConflictCause) EnumDescriptor() ([]byte, []int) {
+	return file_proto_schedula_v1_appointments_proto_rawDescGZIP(), []int{8}
+}
+
+type ProposalStatus int32
+
+const (
+	ProposalStatus_PROPOSAL_STATUS_UNSPECIFIED ProposalStatus = 0
+	ProposalStatus_PROPOSAL_STATUS_PENDING     ProposalStatus = 1
+	ProposalStatus_PROPOSAL_STATUS_ACCEPTED    ProposalStatus = 2
+	ProposalStatus_PROPOSAL_STATUS_DECLINED    ProposalStatus = 3
+	ProposalStatus_PROPOSAL_STATUS_EXPIRED     ProposalStatus = 4
+)
+
+// Enum value maps for ProposalStatus.
+var (
+	ProposalStatus_name = map[int32]string{
+		0: "PROPOSAL_STATUS_UNSPECIFIED",
+		1: "PROPOSAL_STATUS_PENDING",
+		2: "PROPOSAL_STATUS_ACCEPTED",
+		3: "PROPOSAL_STATUS_DECLINED",
+		4: "PROPOSAL_STATUS_EXPIRED",
+	}
+	ProposalStatus_value = map[string]int32{
+		"PROPOSAL_STATUS_UNSPECIFIED": 0,
+		"PROPOSAL_STATUS_PENDING":     1,
+		"PROPOSAL_STATUS_ACCEPTED":    2,
+		"PROPOSAL_STATUS_DECLINED":    3,
+		"PROPOSAL_STATUS_EXPIRED":     4,
+	}
+)
+
+func (x ProposalStatus) Enum() *ProposalStatus {
+	p := new(ProposalStatus)
+	*p = x
+	return p
+}
+
+func (x ProposalStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ProposalStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_schedula_v1_appointments_proto_enumTypes[9].Descriptor()
+}
+
+func (ProposalStatus) Type() protoreflect.EnumType {
+	return &file_proto_schedula_v1_appointments_proto_enumTypes[9]
+}
+
+func (x ProposalStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ProposalStatus.Descriptor instead.
+func (ProposalStatus) EnumDescriptor() ([]byte, []int) {
+	return file_proto_schedula_v1_appointments_proto_rawDescGZIP(), []int{9}
+}
+
+type SeriesFindingKind int32
+
+const (
+	SeriesFindingKind_SERIES_FINDING_KIND_UNSPECIFIED              SeriesFindingKind = 0
+	SeriesFindingKind_SERIES_FINDING_KIND_INVALID_TIME_ZONE        SeriesFindingKind = 1
+	SeriesFindingKind_SERIES_FINDING_KIND_INVALID_RULE             SeriesFindingKind = 2
+	SeriesFindingKind_SERIES_FINDING_KIND_EXCEPTION_OUTSIDE_SERIES SeriesFindingKind = 3
+	SeriesFindingKind_SERIES_FINDING_KIND_EXCEPTION_OFF_PATTERN    SeriesFindingKind = 4
+	SeriesFindingKind_SERIES_FINDING_KIND_INVALID_OVERRIDE         SeriesFindingKind = 5
+)
+
+// Enum value maps for SeriesFindingKind.
+var (
+	SeriesFindingKind_name = map[int32]string{
+		0: "SERIES_FINDING_KIND_UNSPECIFIED",
+		1: "SERIES_FINDING_KIND_INVALID_TIME_ZONE",
+		2: "SERIES_FINDING_KIND_INVALID_RULE",
+		3: "SERIES_FINDING_KIND_EXCEPTION_OUTSIDE_SERIES",
+		4: "SERIES_FINDING_KIND_EXCEPTION_OFF_PATTERN",
+		5: "SERIES_FINDING_KIND_INVALID_OVERRIDE",
+	}
+	SeriesFindingKind_value = map[string]int32{
+		"SERIES_FINDING_KIND_UNSPECIFIED":              0,
+		"SERIES_FINDING_KIND_INVALID_TIME_ZONE":        1,
+		"SERIES_FINDING_KIND_INVALID_RULE":             2,
+		"SERIES_FINDING_KIND_EXCEPTION_OUTSIDE_SERIES": 3,
+		"SERIES_FINDING_KIND_EXCEPTION_OFF_PATTERN":    4,
+		"SERIES_FINDING_KIND_INVALID_OVERRIDE":         5,
+	}
+)
+
+func (x SeriesFindingKind) Enum() *SeriesFindingKind {
+	p := new(SeriesFindingKind)
+	*p = x
+	return p
+}
+
+func (x SeriesFindingKind) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SeriesFindingKind) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_schedula_v1_appointments_proto_enumTypes[10].Descriptor()
+}
+
+func (SeriesFindingKind) Type() protoreflect.EnumType {
+	return &file_proto_schedula_v1_appointments_proto_enumTypes[10]
+}
+
+func (x SeriesFindingKind) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SeriesFindingKind.Descriptor instead.
+func (SeriesFindingKind) EnumDescriptor() ([]byte, []int) {
+	return file_proto_schedula_v1_appointments_proto_rawDescGZIP(), []int{10}
+}
+
+type ChangeEntity int32
+
+const (
+	ChangeEntity_CHANGE_ENTITY_UNSPECIFIED ChangeEntity = 0
+	ChangeEntity_CHANGE_ENTITY_APPOINTMENT ChangeEntity = 1
+	ChangeEntity_CHANGE_ENTITY_SERIES      ChangeEntity = 2
+	// Holds and proposals are only logged when they expire.
+	ChangeEntity_CHANGE_ENTITY_HOLD     ChangeEntity = 3
+	ChangeEntity_CHANGE_ENTITY_PROPOSAL ChangeEntity = 4
+)
+
+// Enum value maps for ChangeEntity.
+var (
+	ChangeEntity_name = map[int32]string{
+		0: "CHANGE_ENTITY_UNSPECIFIED",
+		1: "CHANGE_ENTITY_APPOINTMENT",
+		2: "CHANGE_ENTITY_SERIES",
+		3: "CHANGE_ENTITY_HOLD",
+		4: "CHANGE_ENTITY_PROPOSAL",
+	}
+	ChangeEntity_value = map[string]int32{
+		"CHANGE_ENTITY_UNSPECIFIED": 0,
+		"CHANGE_ENTITY_APPOINTMENT": 1,
+		"CHANGE_ENTITY_SERIES":      2,
+		"CHANGE_ENTITY_HOLD":        3,
+		"CHANGE_ENTITY_PROPOSAL":    4,
+	}
+)
+
+func (x ChangeEntity) Enum() *ChangeEntity {
+	p := new(ChangeEntity)
+	*p = x
+	return p
+}
+
+func (x ChangeEntity) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ChangeEntity) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_schedula_v1_appointments_proto_enumTypes[11].Descriptor()
+}
+
+func (ChangeEntity) Type() protoreflect.EnumType {
+	return &file_proto_schedula_v1_appointments_proto_enumTypes[11]
+}
+
+func (x ChangeEntity) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ChangeEntity.Descriptor instead.
+func (ChangeEntity) EnumDescriptor() ([]byte, []int) {
+	return file_proto_schedula_v1_appointments_proto_rawDescGZIP(), []int{11}
+}
+
+type ChangeOp int32
+
+const (
+	ChangeOp_CHANGE_OP_UNSPECIFIED ChangeOp = 0
+	ChangeOp_CHANGE_OP_CREATED     ChangeOp = 1
+	ChangeOp_CHANGE_OP_UPDATED     ChangeOp = 2
+	ChangeOp_CHANGE_OP_DELETED     ChangeOp = 3
+	ChangeOp_CHANGE_OP_EXPIRED     ChangeOp = 4
+)
+
+// Enum value maps for ChangeOp.
+var (
+	ChangeOp_name = map[int32]string{
+		0: "CHANGE_OP_UNSPECIFIED",
+		1: "CHANGE_OP_CREATED",
+		2: "CHANGE_OP_UPDATED",
+		3: "CHANGE_OP_DELETED",
+		4: "CHANGE_OP_EXPIRED",
+	}
+	ChangeOp_value = map[string]int32{
+		"CHANGE_OP_UNSPECIFIED": 0,
+		"CHANGE_OP_CREATED":     1,
+		"CHANGE_OP_UPDATED":     2,
+		"CHANGE_OP_DELETED":     3,
+		"CHANGE_OP_EXPIRED":     4,
+	}
+)
+
+func (x ChangeOp) Enum() *ChangeOp {
+	p := new(ChangeOp)
+	*p = x
+	return p
+}
+
+func (x ChangeOp) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ChangeOp) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_schedula_v1_appointments_proto_enumTypes[12].Descriptor()
+}
+
+func (ChangeOp) Type() protoreflect.EnumType {
+	return &file_proto_schedula_v1_appointments_proto_enumTypes[12]
+}
+
+func (x ChangeOp) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ChangeOp.Descriptor instead.
+func (ChangeOp) EnumDescriptor() ([]byte, []int) {
+	return file_proto_schedula_v1_appointments_proto_rawDescGZIP(), []int{12}
+}
+
+type BillablePeriod int32
+
+const (
+	BillablePeriod_BILLABLE_PERIOD_UNSPECIFIED BillablePeriod = 0
+	BillablePeriod_BILLABLE_PERIOD_WEEK        BillablePeriod = 1
+	BillablePeriod_BILLABLE_PERIOD_MONTH       BillablePeriod = 2
+)
+
+// Enum value maps for BillablePeriod.
+var (
+	BillablePeriod_name = map[int32]string{
+		0: "BILLABLE_PERIOD_UNSPECIFIED",
+		1: "BILLABLE_PERIOD_WEEK",
+		2: "BILLABLE_PERIOD_MONTH",
+	}
+	BillablePeriod_value = map[string]int32{
+		"BILLABLE_PERIOD_UNSPECIFIED": 0,
+		"BILLABLE_PERIOD_WEEK":        1,
+		"BILLABLE_PERIOD_MONTH":       2,
+	}
+)
+
+func (x BillablePeriod) Enum() *BillablePeriod {
+	p := new(BillablePeriod)
+	*p = x
+	return p
+}
+
+func (x BillablePeriod) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (BillablePeriod) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_schedula_v1_appointments_proto_enumTypes[13].Descriptor()
+}
+
+func (BillablePeriod) Type() protoreflect.EnumType {
+	return &file_proto_schedula_v1_appointments_proto_enumTypes[13]
+}
+
+func (x BillablePeriod) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use BillablePeriod.Descriptor instead.
+func (BillablePeriod) EnumDescriptor() ([]byte, []int) {
+	return file_proto_schedula_v1_appointments_proto_rawDescGZIP(), []int{13}
+}
+
+type BillableFormat int32
+
+const (
+	BillableFormat_BILLABLE_FORMAT_UNSPECIFIED BillableFormat = 0
+	BillableFormat_BILLABLE_FORMAT_CSV         BillableFormat = 1
+	BillableFormat_BILLABLE_FORMAT_JSON        BillableFormat = 2
+)
+
+// Enum value maps for BillableFormat.
+var (
+	BillableFormat_name = map[int32]string{
+		0: "BILLABLE_FORMAT_UNSPECIFIED",
+		1: "BILLABLE_FORMAT_CSV",
+		2: "BILLABLE_FORMAT_JSON",
+	}
+	BillableFormat_value = map[string]int32{
+		"BILLABLE_FORMAT_UNSPECIFIED": 0,
+		"BILLABLE_FORMAT_CSV":         1,
+		"BILLABLE_FORMAT_JSON":        2,
+	}
+)
+
+func (x BillableFormat) Enum() *BillableFormat {
+	p := new(BillableFormat)
+	*p = x
+	return p
+}
+
+func (x BillableFormat) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (BillableFormat) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_schedula_v1_appointments_proto_enumTypes[14].Descriptor()
+}
+
+func (BillableFormat) Type() protoreflect.EnumType {
+	return &file_proto_schedula_v1_appointments_proto_enumTypes[14]
+}
+
+func (x BillableFormat) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use BillableFormat.Descriptor instead.
+func (BillableFormat) EnumDescriptor() ([]byte, []int) {
+	return file_proto_schedula_v1_appointments_proto_rawDescGZIP(), []int{14}
+}
+
+type MutationKind int32
+
+const (
+	MutationKind_MUTATION_KIND_UNSPECIFIED MutationKind = 0
+	MutationKind_MUTATION_KIND_CREATE      MutationKind = 1
+	MutationKind_MUTATION_KIND_UPDATE      MutationKind = 2
+	MutationKind_MUTATION_KIND_DELETE      MutationKind = 3
+)
+
+// Enum value maps for MutationKind.
+var (
+	MutationKind_name = map[int32]string{
+		0: "MUTATION_KIND_UNSPECIFIED",
+		1: "MUTATION_KIND_CREATE",
+		2: "MUTATION_KIND_UPDATE",
+		3: "MUTATION_KIND_DELETE",
+	}
+	MutationKind_value = map[string]int32{
+		"MUTATION_KIND_UNSPECIFIED": 0,
+		"MUTATION_KIND_CREATE":      1,
+		"MUTATION_KIND_UPDATE":      2,
+		"MUTATION_KIND_DELETE":      3,
+	}
+)
+
+func (x MutationKind) Enum() *MutationKind {
+	p := new(MutationKind)
+	*p = x
+	return p
+}
+
+func (x MutationKind) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (MutationKind) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_schedula_v1_appointments_proto_enumTypes[15].Descriptor()
+}
+
+func (MutationKind) Type() protoreflect.EnumType {
+	return &file_proto_schedula_v1_appointments_proto_enumTypes[15]
+}
+
+func (x MutationKind) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use MutationKind.Descriptor instead.
+func (MutationKind) EnumDescriptor() ([]byte, []int) {
+	return file_proto_schedula_v1_appointments_proto_rawDescGZIP(), []int{15}
+}
+
+type MutationStatus int32
+
+const (
+	MutationStatus_MUTATION_STATUS_UNSPECIFIED MutationStatus = 0
+	MutationStatus_MUTATION_STATUS_ACCEPTED    MutationStatus = 1
+	MutationStatus_MUTATION_STATUS_CONFLICTED  MutationStatus = 2
+	MutationStatus_MUTATION_STATUS_REJECTED    MutationStatus = 3
+)
+
+// Enum value maps for MutationStatus.
+var (
+	MutationStatus_name = map[int32]string{
+		0: "MUTATION_STATUS_UNSPECIFIED",
+		1: "MUTATION_STATUS_ACCEPTED",
+		2: "MUTATION_STATUS_CONFLICTED",
+		3: "MUTATION_STATUS_REJECTED",
+	}
+	MutationStatus_value = map[string]int32{
+		"MUTATION_STATUS_UNSPECIFIED": 0,
+		"MUTATION_STATUS_ACCEPTED":    1,
+		"MUTATION_STATUS_CONFLICTED":  2,
+		"MUTATION_STATUS_REJECTED":    3,
+	}
+)
+
+func (x MutationStatus) Enum() *MutationStatus {
+	p := new(MutationStatus)
+	*p = x
+	return p
+}
+
+func (x MutationStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (MutationStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_schedula_v1_appointments_proto_enumTypes[16].Descriptor()
+}
+
+func (MutationStatus) Type() protoreflect.EnumType {
+	return &file_proto_schedula_v1_appointments_proto_enumTypes[16]
+}
+
+func (x MutationStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use MutationStatus.Descriptor instead.
+func (MutationStatus) EnumDescriptor() ([]byte, []int) {
+	return file_proto_schedula_v1_appointments_proto_rawDescGZIP(), []int{16}
+}
+
+type MutationConflict int32
+
+const (
+	MutationConflict_MUTATION_CONFLICT_UNSPECIFIED MutationConflict = 0
+	MutationConflict_MUTATION_CONFLICT_VERSION     MutationConflict = 1
+	MutationConflict_MUTATION_CONFLICT_DELETED     MutationConflict = 2
+	MutationConflict_MUTATION_CONFLICT_EXISTS      MutationConflict = 3
+	MutationConflict_MUTATION_CONFLICT_OVERLAP     MutationConflict = 4
+)
+
+// Enum value maps for MutationConflict.
+var (
+	MutationConflict_name = map[int32]string{
+		0: "MUTATION_CONFLICT_UNSPECIFIED",
+		1: "MUTATION_CONFLICT_VERSION",
+		2: "MUTATION_CONFLICT_DELETED",
+		3: "MUTATION_CONFLICT_EXISTS",
+		4: "MUTATION_CONFLICT_OVERLAP",
+	}
+	MutationConflict_value = map[string]int32{
+		"MUTATION_CONFLICT_UNSPECIFIED": 0,
+		"MUTATION_CONFLICT_VERSION":     1,
+		"MUTATION_CONFLICT_DELETED":     2,
+		"MUTATION_CONFLICT_EXISTS":      3,
+		"MUTATION_CONFLICT_OVERLAP":     4,
+	}
+)
+
+func (x MutationConflict) Enum() *MutationConflict {
+	p := new(MutationConflict)
+	*p = x
+	return p
+}
+
+func (x MutationConflict) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (MutationConflict) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_schedula_v1_appointments_proto_enumTypes[17].Descriptor()
+}
+
+func (MutationConflict) Type() protoreflect.EnumType {
+	return &file_proto_schedula_v1_appointments_proto_enumTypes[17]
+}
+
+func (x MutationConflict) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use MutationConflict.Descriptor instead.
+func (MutationConflict) EnumDescriptor() ([]byte, []int) {
+	return file_proto_schedula_v1_appointments_proto_rawDescGZIP(), []int{17}
+}
+
 type WeeklyRecurrence struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	Interval           uint32                 `protobuf:"varint,1,opt,name=interval,proto3" json:"interval,omitempty"`
+	Weekdays           []Weekday              `protobuf:"varint,2,rep,packed,name=weekdays,proto3,enum=schedula.v1.Weekday" json:"weekdays,omitempty"`
+	Until              *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=until,proto3" json:"until,omitempty"`
+	Count              uint32                 `protobuf:"varint,4,opt,name=count,proto3" json:"count,omitempty"`
+	TimeZone           string                 `protobuf:"bytes,5,opt,name=time_zone,json=timeZone,proto3" json:"time_zone,omitempty"`
+	DstGapPolicy       DstGapPolicy           `protobuf:"varint,6,opt,name=dst_gap_policy,json=dstGapPolicy,proto3,enum=schedula.v1.DstGapPolicy" json:"dst_gap_policy,omitempty"`
+	DstAmbiguousPolicy DstAmbiguousPolicy     `protobuf:"varint,7,opt,name=dst_ambiguous_policy,json=dstAmbiguousPolicy,proto3,enum=schedula.v1.DstAmbiguousPolicy" json:"dst_ambiguous_policy,omitempty"`
+	WeekStart          Weekday                `protobuf:"varint,8,opt,name=week_start,json=weekStart,proto3,enum=schedula.v1.Weekday" json:"week_start,omitempty"`
+	WeekdayTimes       []*WeekdayTime         `protobuf:"bytes,9,rep,name=weekday_times,json=weekdayTimes,proto3" json:"weekday_times,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *WeeklyRecurrence) Reset() {
+	*x = WeeklyRecurrence{}
+	mi := &file_proto_schedula_v1_appointments_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WeeklyRecurrence) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WeeklyRecurrence) ProtoMessage() {}
+
+func (x *WeeklyRecurrence) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_schedula_v1_appointments_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WeeklyRecurrence.ProtoReflect.Descriptor instead.
+func (*WeeklyRecurrence) Descriptor() ([]byte, []int) {
+	return file_proto_schedula_v1_appointments_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *WeeklyRecurrence) GetInterval() uint32 {
+	if x != nil {
+		return x.Interval
+	}
+	return 0
+}
+
+func (x *WeeklyRecurrence) GetWeekdays() []Weekday {
+	if x != nil {
+		return x.Weekdays
+	}
+	return nil
+}
+
+func (x *WeeklyRecurrence) GetUntil() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Until
+	}
+	return nil
+}
+
+func (x *WeeklyRecurrence) GetCount() uint32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *WeeklyRecurrence) GetTimeZone() string {
+	if x != nil {
+		return x.TimeZone
+	}
+	return ""
+}
+
+func (x *WeeklyRecurrence) GetDstGapPolicy() DstGapPolicy {
+	if x != nil {
+		return x.DstGapPolicy
+	}
+	return DstGapPolicy_DST_GAP_POLICY_UNSPECIFIED
+}
+
+func (x *WeeklyRecurrence) GetDstAmbiguousPolicy() DstAmbiguousPolicy {
+	if x != nil {
+		return x.DstAmbiguousPolicy
+	}
+	return DstAmbiguousPolicy_DST_AMBIGUOUS_POLICY_UNSPECIFIED
+}
+
+func (x *WeeklyRecurrence) GetWeekStart() Weekday {
+	if x != nil {
+		return x.WeekStart
+	}
+	return Weekday_WEEKDAY_UNSPECIFIED
+}
+
+func (x *WeeklyRecurrence) GetWeekdayTimes() []*WeekdayTime {
+	if x != nil {
+		return x.WeekdayTimes
+	}
+	return nil
+}
+
+type WeekdayTime struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Weekday       Weekday                `protobuf:"varint,1,opt,name=weekday,proto3,enum=schedula.v1.Weekday" json:"weekday,omitempty"`
+	StartMinute   uint32                 `protobuf:"varint,2,opt,name=start_minute,json=startMinute,proto3" json:"start_minute,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WeekdayTime) Reset() {
+	*x = WeekdayTime{}
+	mi := &file_proto_schedula_v1_appointments_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WeekdayTime) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WeekdayTime) ProtoMessage() {}
+
+func (x *WeekdayTime) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_schedula_v1_appointments_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WeekdayTime.ProtoReflect.Descriptor instead.
+func (*WeekdayTime) Descriptor() ([]byte, []int) {
+	return file_proto_schedula_v1_appointments_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *WeekdayTime) GetWeekday() Weekday {
+	if x != nil {
+		return x.Weekday
+	}
+	return Weekday_WEEKDAY_UNSPECIFIED
+}
+
+func (x *WeekdayTime) GetStartMinute() uint32 {
+	if x != nil {
+		return x.StartMinute
+	}
+	return 0
+}
+
+type ExternalRef struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	System        string                 `protobuf:"bytes,1,opt,name=system,proto3" json:"system,omitempty"`
+	Id            string                 `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExternalRef) Reset() {
+	*x = ExternalRef{}
+	mi := &file_proto_schedula_v1_appointments_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExternalRef) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExternalRef) ProtoMessage() {}
+
+func (x *ExternalRef) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_schedula_v1_appointments_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExternalRef.ProtoReflect.Descriptor instead.
+func (*ExternalRef) Descriptor() ([]byte, []int) {
+	return file_proto_schedula_v1_appointments_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ExternalRef) GetSystem() string {
+	if x != nil {
+		return x.System
+	}
+	return ""
+}
+
+func (x *ExternalRef) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type Appointment struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId         string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Title          string                 `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
+	Notes          string                 `protobuf:"bytes,4,opt,name=notes,proto3" json:"notes,omitempty"`
+	StartTime      *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	EndTime        *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	CreatedAt      *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt      *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	Metadata       map[string]string      `protobuf:"bytes,9,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	ExternalRef    *ExternalRef           `protobuf:"bytes,10,opt,name=external_ref,json=externalRef,proto3" json:"external_ref,omitempty"`
+	TimeZone       string                 `protobuf:"bytes,11,opt,name=time_zone,json=timeZone,proto3" json:"time_zone,omitempty"`
+	LocalStartTime string                 `protobuf:"bytes,12,opt,name=local_start_time,json=localStartTime,proto3" json:"local_start_time,omitempty"`
+	LocalEndTime   string                 `protobuf:"bytes,13,opt,name=local_end_time,json=localEndTime,proto3" json:"local_end_time,omitempty"`
+	CreatedBy      string                 `protobuf:"bytes,14,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
+	CheckedInAt    *timestamppb.Timestamp `protobuf:"bytes,15,opt,name=checked_in_at,json=checkedInAt,proto3" json:"checked_in_at,omitempty"`
+	CheckedOutAt   *timestamppb.Timestamp `protobuf:"bytes,16,opt,name=checked_out_at,json=checkedOutAt,proto3" json:"checked_out_at,omitempty"`
+	ContactId      string                 `protobuf:"bytes,17,opt,name=contact_id,json=contactId,proto3" json:"contact_id,omitempty"`
+	Source         string                 `protobuf:"bytes,18,opt,name=source,proto3" json:"source,omitempty"`
+	Kind           AppointmentKind        `protobuf:"varint,19,opt,name=kind,proto3,enum=schedula.v1.AppointmentKind" json:"kind,omitempty"`
+	PrivateNotes   string                 `protobuf:"bytes,20,opt,name=private_notes,json=privateNotes,proto3" json:"private_notes,omitempty"`
+	ProgramId      string                 `protobuf:"bytes,21,opt,name=program_id,json=programId,proto3" json:"program_id,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *Appointment) Reset() {
+	*x = Appointment{}
+	mi := &file_proto_schedula_v1_appointments_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Appointment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Appointment) ProtoMessage() {}
+
+func (x *Appointment) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_schedula_v1_appointments_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Appointment.ProtoReflect.Descriptor instead.
+func (*Appointment) Descriptor() ([]byte, []int) {
+	return file_proto_schedula_v1_appointments_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *Appointment) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Appointment) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *Appointment) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *Appointment) GetNotes() string {
+	if x != nil {
+		return x.Notes
+	}
+	return ""
+}
+
+func (x *Appointment) GetStartTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartTime
+	}
+	return nil
+}
+
+func (x *Appointment) GetEndTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndTime
+	}
+	return nil
+}
+
+func (x *Appointment) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Appointment) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *Appointment) GetMetadata() map[string]string {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+func (x *Appointment) GetExternalRef() *ExternalRef {
+	if x != nil {
+		return x.ExternalRef
+	}
+	return nil
+}
+
+func (x *Appointment) GetTimeZone() string {
+	if x != nil {
+		return x.TimeZone
+	}
+	return ""
+}
+
+func (x *Appointment) GetLocalStartTime() string {
+	if x != nil {
+		return x.LocalStartTime
+	}
+	return ""
+}
+
+func (x *Appointment) GetLocalEndTime() string {
+	if x != nil {
+		return x.LocalEndTime
+	}
+	return ""
+}
+
+func (x *Appointment) GetCreatedBy() string {
+	if x != nil {
+		return x.CreatedBy
+	}
+	return ""
+}
+
+func (x *Appointment) GetCheckedInAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CheckedInAt
+	}
+	return nil
+}
+
+func (x *Appointment) GetCheckedOutAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CheckedOutAt
+	}
+	return nil
+}
+
+func (x *Appointment) GetContactId() string {
+	if x != nil {
+		return x.ContactId
+	}
+	return ""
+}
+
+func (x *Appointment) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+func (x *Appointment) GetKind() AppointmentKind {
+	if x != nil {
+		return x.Kind
+	}
+	return AppointmentKind_APPOINTMENT_KIND_UNSPECIFIED
+}
+
+func (x *Appointment) GetPrivateNotes() string {
+	if x != nil {
+		return x.PrivateNotes
+	}
+	return ""
+}
+
+func (x *Appointment) GetProgramId() string {
+	if x != nil {
+		return x.ProgramId
+	}
+	return ""
+}
+
+type CreateAppointmentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Title         string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	Notes         string                 `protobuf:"bytes,3,opt,name=notes,proto3" json:"notes,omitempty"`
+	StartTime     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	EndTime       *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	Metadata      map[string]string      `protobuf:"bytes,6,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	ExternalRef   *ExternalRef           `protobuf:"bytes,7,opt,name=external_ref,json=externalRef,proto3" json:"external_ref,omitempty"`
+	TimeZone      string                 `protobuf:"bytes,8,opt,name=time_zone,json=timeZone,proto3" json:"time_zone,omitempty"`
+	ActorId       string                 `protobuf:"bytes,9,opt,name=actor_id,json=actorId,proto3" json:"actor_id,omitempty"`
+	ContactId     string                 `protobuf:"bytes,10,opt,name=contact_id,json=contactId,proto3" json:"contact_id,omitempty"`
+	Kind          AppointmentKind        `protobuf:"varint,11,opt,name=kind,proto3,enum=schedula.v1.AppointmentKind" json:"kind,omitempty"`
+	PrivateNotes  string                 `protobuf:"bytes,12,opt,name=private_notes,json=privateNotes,proto3" json:"private_notes,omitempty"`
+	ProgramId     string                 `protobuf:"bytes,13,opt,name=program_id,json=programId,proto3" json:"program_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateAppointmentRequest) Reset() {
+	*x = CreateAppointmentRequest{}
+	mi := &file_proto_schedula_v1_appointments_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateAppointmentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateAppointmentRequest) ProtoMessage() {}
+
+func (x *CreateAppointmentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_schedula_v1_appointments_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateAppointmentRequest.ProtoReflect.Descriptor instead.
+func (*CreateAppointmentRequest) Descriptor() ([]byte, []int) {
+	return file_proto_schedula_v1_appointments_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *CreateAppointmentRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *CreateAppointmentRequest) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *CreateAppointmentRequest) GetNotes() string {
+	if x != nil {
+		return x.Notes
+	}
+	return ""
+}
+
+func (x *CreateAppointmentRequest) GetStartTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartTime
+	}
+	return nil
+}
+
+func (x *CreateAppointmentRequest) GetEndTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndTime
+	}
+	return nil
+}
+
+func (x *CreateAppointmentRequest) GetMetadata() map[string]string {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+func (x *CreateAppointmentRequest) GetExternalRef() *ExternalRef {
+	if x != nil {
+		return x.ExternalRef
+	}
+	return nil
+}
+
+func (x *CreateAppointmentRequest) GetTimeZone() string {
+	if x != nil {
+		return x.TimeZone
+	}
+	return ""
+}
+
+func (x *CreateAppointmentRequest) GetActorId() string {
+	if x != nil {
+		return x.ActorId
+	}
+	return ""
+}
+
+func (x *CreateAppointmentRequest) GetContactId() string {
+	if x != nil {
+		return x.ContactId
+	}
+	return ""
+}
+
+func (x *CreateAppointmentRequest) GetKind() AppointmentKind {
+	if x != nil {
+		return x.Kind
+	}
+	return AppointmentKind_APPOINTMENT_KIND_UNSPECIFIED
+}
+
+func (x *CreateAppointmentRequest) GetPrivateNotes() string {
+	if x != nil {
+		return x.PrivateNotes
+	}
+	return ""
+}
+
+func (x *CreateAppointmentRequest) GetProgramId() string {
+	if x != nil {
+		return x.ProgramId
+	}
+	return ""
+}
+
+type BlackoutWarning struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Title         string                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	StartTime     *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	EndTime       *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BlackoutWarning) Reset() {
+	*x = BlackoutWarning{}
+	mi := &file_proto_schedula_v1_appointments_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BlackoutWarning) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BlackoutWarning) ProtoMessage() {}
+
+func (x *BlackoutWarning) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_schedula_v1_appointments_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BlackoutWarning.ProtoReflect.Descriptor instead.
+func (*BlackoutWarning) Descriptor() ([]byte, []int) {
+	return file_proto_schedula_v1_appointments_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *BlackoutWarning) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *BlackoutWarning) GetStartTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartTime
+	}
+	return nil
+}
+
+func (x *BlackoutWarning) GetEndTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndTime
+	}
+	return nil
+}
+
+type Warning struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Warning) Reset() {
+	*x = Warning{}
+	mi := &file_proto_schedula_v1_appointments_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Warning) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Warning) ProtoMessage() {}
+
+func (x *Warning) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_schedula_v1_appointments_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Warning.ProtoReflect.Descriptor instead.
+func (*Warning) Descriptor() ([]byte, []int) {
+	return file_proto_schedula_v1_appointments_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *Warning) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *Warning) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type CreateAppointmentResponse struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Appointment      *Appointment           `protobuf:"bytes,1,opt,name=appointment,proto3" json:"appointment,omitempty"`
+	BlackoutWarnings []*BlackoutWarning     `protobuf:"bytes,2,rep,name=blackout_warnings,json=blackoutWarnings,proto3" json:"blackout_warnings,omitempty"`
+	PastStartWarning bool                   `protobuf:"varint,3,opt,name=past_start_warning,json=pastStartWarning,proto3" json:"past_start_warning,omitempty"`
+	Warnings         []*Warning             `protobuf:"bytes,4,rep,name=warnings,proto3" json:"warnings,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *CreateAppointmentResponse) Reset() {
+	*x = CreateAppointmentResponse{}
+	mi := &file_proto_schedula_v1_appointments_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateAppointmentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateAppointmentResponse) ProtoMessage() {}
+
+func (x *CreateAppointmentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_schedula_v1_appointments_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateAppointmentResponse.ProtoReflect.Descriptor instead.
+func (*CreateAppointmentResponse) Descriptor() ([]byte, []int) {
+	return file_proto_schedula_v1_appointments_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *CreateAppointmentResponse) GetAppointment() *Appointment {
+	if x != nil {
+		return x.Appointment
+	}
+	return nil
+}
+
+func (x *CreateAppointmentResponse) GetBlackoutWarnings() []*BlackoutWarning {
+	if x != nil {
+		return x.BlackoutWarnings
+	}
+	return nil
+}
+
+func (x *CreateAppointmentResponse) GetPastStartWarning() bool {
+	if x != nil {
+		return x.PastStartWarning
+	}
+	return false
+}
+
+func (x *CreateAppointmentResponse) GetWarnings() []*Warning {
+	if x != nil {
+		return x.Warnings
+	}
+	return nil
+}
+
+type ListAppointmentsRequest struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	UserId            string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	WindowStart       *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=window_start,json=windowStart,proto3" json:"window_start,omitempty"`
+	WindowEnd         *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=window_end,json=windowEnd,proto3" json:"window_end,omitempty"`
+	MetadataFilter    map[string]string      `protobuf:"bytes,4,rep,name=metadata_filter,json=metadataFilter,proto3" json:"metadata_filter,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	SplitTimeZone     string                 `protobuf:"bytes,5,opt,name=split_time_zone,json=splitTimeZone,proto3" json:"split_time_zone,omitempty"`
+	IncludeLocalTimes bool                   `protobuf:"varint,6,opt,name=include_local_times,json=includeLocalTimes,proto3" json:"include_local_times,omitempty"`
+	StartSync         bool                   `protobuf:"varint,7,opt,name=start_sync,json=startSync,proto3" json:"start_sync,omitempty"`
+	SyncToken         string                 `protobuf:"bytes,8,opt,name=sync_token,json=syncToken,proto3" json:"sync_token,omitempty"`
+	ContactId         string                 `protobuf:"bytes,9,opt,name=contact_id,json=contactId,proto3" json:"contact_id,omitempty"`
+	Source            string                 `protobuf:"bytes,10,opt,name=source,proto3" json:"source,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *ListAppointmentsRequest) Reset() {
+	*x = ListAppointmentsRequest{}
+	mi := &file_proto_schedula_v1_appointments_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAppointmentsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAppointmentsRequest) ProtoMessage() {}
+
+func (x *ListAppointmentsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_schedula_v1_appointments_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAppointmentsRequest.ProtoReflect.Descriptor instead.
+func (*ListAppointmentsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_schedula_v1_appointments_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ListAppointmentsRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ListAppointmentsRequest) GetWindowStart() *timestamppb.Timestamp {
+	if x != nil {
+		return x.WindowStart
+	}
+	return nil
+}
+
+func (x *ListAppointmentsRequest) GetWindowEnd() *timestamppb.Timestamp {
+	if x != nil {
+		return x.WindowEnd
+	}
+	return nil
+}
+
+func (x *ListAppointmentsRequest) GetMetadataFilter() map[string]string {
+	if x != nil {
+		return x.MetadataFilter
+	}
+	return nil
+}
+
+func (x *ListAppointmentsRequest) GetSplitTimeZone() string {
+	if x != nil {
+		return x.SplitTimeZone
+	}
+	return ""
+}
+
+func (x *ListAppointmentsRequest) GetIncludeLocalTimes() bool {
+	if x != nil {
+		return x.IncludeLocalTimes
+	}
+	return false
+}
+
+func (x *ListAppointmentsRequest) GetStartSync() bool {
+	if x != nil {
+		return x.StartSync
+	}
+	return false
+}
+
+func (x *ListAppointmentsRequest) GetSyncToken() string {
+	if x != nil {
+		return x.SyncToken
+	}
+	return ""
+}
+
+func (x *ListAppointmentsRequest) GetContactId() string {
+	if x != nil {
+		return x.ContactId
+	}
+	return ""
+}
+
+func (x *ListAppointmentsRequest) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+type DaySegment struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	LocalDate     string                 `protobuf:"bytes,2,opt,name=local_date,json=localDate,proto3" json:"local_date,omitempty"`
+	StartTime     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	EndTime       *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DaySegment) Reset() {
+	*x = DaySegment{}
+	mi := &file_proto_schedula_v1_appointments_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DaySegment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DaySegment) ProtoMessage() {}
+
+func (x *DaySegment) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_schedula_v1_appointments_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DaySegment.ProtoReflect.Descriptor instead.
+func (*DaySegment) Descriptor() ([]byte, []int) {
+	return file_proto_schedula_v1_appointments_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *DaySegment) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *DaySegment) GetLocalDate() string {
+	if x != nil {
+		return x.LocalDate
+	}
+	return ""
+}
+
+func (x *DaySegment) GetStartTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartTime
+	}
+	return nil
+}
+
+func (x *DaySegment) GetEndTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndTime
+	}
+	return nil
+}
+
+type ListAppointmentsResponse struct {
+	state                 protoimpl.MessageState `protogen:"open.v1"`
+	Appointments          []*Appointment         `protobuf:"bytes,1,rep,name=appointments,proto3" json:"appointments,omitempty"`
+	DaySegments           []*DaySegment          `protobuf:"bytes,2,rep,name=day_segments,json=daySegments,proto3" json:"day_segments,omitempty"`
+	NextSyncToken         string                 `protobuf:"bytes,3,opt,name=next_sync_token,json=nextSyncToken,proto3" json:"next_sync_token,omitempty"`
+	FullSync              bool                   `protobuf:"varint,4,opt,name=full_sync,json=fullSync,proto3" json:"full_sync,omitempty"`
+	DeletedAppointmentIds []string               `protobuf:"bytes,5,rep,name=deleted_appointment_ids,json=deletedAppointmentIds,proto3" json:"deleted_appointment_ids,omitempty"`
+	CalendarVersion       int64                  `protobuf:"varint,6,opt,name=calendar_version,json=calendarVersion,proto3" json:"calendar_version,omitempty"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *ListAppointmentsResponse) Reset() {
+	*x = ListAppointmentsResponse{}
+	mi := &file_proto_schedula_v1_appointments_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAppointmentsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAppointmentsResponse) ProtoMessage() {}
+
+func (x *ListAppointmentsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_schedula_v1_appointments_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAppointmentsResponse.ProtoReflect.Descriptor instead.
+func (*ListAppointmentsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_schedula_v1_appointments_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *ListAppointmentsResponse) GetAppointments() []*Appointment {
+	if x != nil {
+		return x.Appointments
+	}
+	return nil
+}
+
+func (x *ListAppointmentsResponse) GetDaySegments() []*DaySegment {
+	if x != nil {
+		return x.DaySegments
+	}
+	return nil
+}
+
+func (x *ListAppointmentsResponse) GetNextSyncToken() string {
+	if x != nil {
+		return x.NextSyncToken
+	}
+	return ""
+}
+
+func (x *ListAppointmentsResponse) GetFullSync() bool {
+	if x != nil {
+		return x.FullSync
+	}
+	return false
+}
+
+func (x *ListAppointmentsResponse) GetDeletedAppointmentIds() []string {
+	if x != nil {
+		return x.DeletedAppointmentIds
+	}
+	return nil
+}
+
+func (x *ListAppointmentsResponse) GetCalendarVersion() int64 {
+	if x != nil {
+		return x.CalendarVersion
+	}
+	return 0
+}
+
+type GetAppointmentByExternalRefRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	ExternalRef   *ExternalRef           `protobuf:"bytes,2,opt,name=external_ref,json=externalRef,proto3" json:"external_ref,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAppointmentByExternalRefRequest) Reset() {
+	*x = GetAppointmentByExternalRefRequest{}
+	mi := &file_proto_schedula_v1_appointments_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAppointmentByExternalRefRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAppointmentByExternalRefRequest) ProtoMessage() {}
+
+func (x *GetAppointmentByExternalRefRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_schedula_v1_appointments_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAppointmentByExternalRefRequest.ProtoReflect.Descriptor instead.
+func (*GetAppointmentByExternalRefRequest) Descriptor() ([]byte, []int) {
+	return file_proto_schedula_v1_appointments_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *GetAppointmentByExternalRefRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *GetAppointmentByExternalRefRequest) GetExternalRef() *ExternalRef {
+	if x != nil {
+		return x.ExternalRef
+	}
+	return nil
+}
+
+type GetAppointmentByExternalRefResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Appointment   *Appointment           `protobuf:"bytes,1,opt,name=appointment,proto3" json:"appointment,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAppointmentByExternalRefResponse) Reset() {
+	*x = GetAppointmentByExternalRefResponse{}
+	mi := &file_proto_schedula_v1_appointments_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAppointmentByExternalRefResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAppointmentByExternalRefResponse) ProtoMessage() {}
+
+func (x *GetAppointmentByExternalRefResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_schedula_v1_appointments_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAppointmentByExternalRefResponse.ProtoReflect.Descriptor instead.
+func (*GetAppointmentByExternalRefResponse) Descriptor() ([]byte, []int) {
+	return file_proto_schedula_v1_appointments_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *GetAppointmentByExternalRefResponse) GetAppointment() *Appointment {
+	if x != nil {
+		return x.Appointment
+	}
+	return nil
+}
+
+type DeleteAppointmentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	AppointmentId string                 `protobuf:"bytes,2,opt,name=appointment_id,json=appointmentId,proto3" json:"appointment_id,omitempty"`
+	ActorId       string                 `protobuf:"bytes,3,opt,name=actor_id,json=actorId,proto3" json:"actor_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteAppointmentRequest) Reset() {
+	*x = DeleteAppointmentRequest{}
+	mi := &file_proto_schedula_v1_appointments_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteAppointmentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteAppointmentRequest) ProtoMessage() {}
+
+func (x *DeleteAppointmentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_schedula_v1_appointments_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteAppointmentRequest.ProtoReflect.Descriptor instead.
+func (*DeleteAppointmentRequest) Descriptor() ([]byte, []int) {
+	return file_proto_schedula_v1_appointments_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *DeleteAppointmentRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *DeleteAppointmentRequest) GetAppointmentId() string {
+	if x != nil {
+		return x.AppointmentId
+	}
+	return ""
+}
+
+func (x *DeleteAppointmentRequest) GetActorId() string {
+	if x != nil {
+		return x.ActorId
+	}
+	return ""
+}
+
+type DeleteAppointmentResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteAppointmentResponse) Reset() {
+	*x = DeleteAppointmentResponse{}
+	mi := &file_proto_schedula_v1_appointments_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteAppointmentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteAppointmentResponse) ProtoMessage() {}
+
+func (x *DeleteAppointmentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_schedula_v1_appointments_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteAppointmentResponse.ProtoReflect.Descriptor instead.
+func (*DeleteAppointmentResponse) Descriptor() ([]byte, []int) {
+	return file_proto_schedula_v1_appointments_proto_rawDescGZIP(), []int{14}
+}
+
+type RecurringSeries struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Id        string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId    string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Title     string                 `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
+	Notes     string                 `protobuf:"bytes,4,opt,name=notes,proto3" json:"notes,omitempty"`
+	StartTime *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	// Deprecated: Marked as deprecated in proto/schedula/v1/appointments.proto.
+	EndTime              *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	Weekly               *WeeklyRecurrence      `protobuf:"bytes,7,opt,name=weekly,proto3" json:"weekly,omitempty"`
+	CreatedAt            *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt            *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	OccurrencesRemaining uint32                 `protobuf:"varint,10,opt,name=occurrences_remaining,json=occurrencesRemaining,proto3" json:"occurrences_remaining,omitempty"`
+	NextOccurrence       *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=next_occurrence,json=nextOccurrence,proto3" json:"next_occurrence,omitempty"`
+	Metadata             map[string]string      `protobuf:"bytes,12,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	CreatedBy            string                 `protobuf:"bytes,13,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
+	ProgramId            string                 `protobuf:"bytes,14,opt,name=program_id,json=programId,proto3" json:"program_id,omitempty"`
+	Duration             *durationpb.Duration   `protobuf:"bytes,15,opt,name=duration,proto3" json:"duration,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *RecurringSeries) Reset() {
+	*x = RecurringSeries{}
+	mi := &file_proto_schedula_v1_appointments_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RecurringSeries) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecurringSeries) ProtoMessage() {}
+
+func (x *RecurringSeries) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_schedula_v1_appointments_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecurringSeries.ProtoReflect.Descriptor instead.
+func (*RecurringSeries) Descriptor() ([]byte, []int) {
+	return file_proto_schedula_v1_appointments_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *RecurringSeries) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *RecurringSeries) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *RecurringSeries) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *RecurringSeries) GetNotes() string {
+	if x != nil {
+		return x.Notes
+	}
+	return ""
+}
+
+func (x *RecurringSeries) GetStartTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartTime
+	}
+	return nil
+}
+
+// Deprecated: Marked as deprecated in proto/schedula/v1/appointments.proto.
+func (x *RecurringSeries) GetEndTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndTime
+	}
+	return nil
+}
+
+func (x *RecurringSeries) GetWeekly() *WeeklyRecurrence {
+	if x != nil {
+		return x.Weekly
+	}
+	return nil
+}
+
+func (x *RecurringSeries) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *RecurringSeries) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *RecurringSeries) GetOccurrencesRemaining() uint32 {
+	if x != nil {
+		return x.OccurrencesRemaining
+	}
+	return 0
+}
+
+func (x *RecurringSeries) GetNextOccurrence() *timestamppb.Timestamp {
+	if x != nil {
+		return x.NextOccurrence
+	}
+	return nil
+}
+
+func (x *RecurringSeries) GetMetadata() map[string]string {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+func (x *RecurringSeries) GetCreatedBy() string {
+	if x != nil {
+		return x.CreatedBy
+	}
+	return ""
+}
+
+func (x *RecurringSeries) GetProgramId() string {
+	if x != nil {
+		return x.ProgramId
+	}
+	return ""
+}
+
+func (x *RecurringSeries) GetDuration() *durationpb.Duration {
+	if x != nil {
+		return x.Duration
+	}
+	return nil
+}
+
+type CreateRecurringSeriesRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	UserId    string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Title     string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	Notes     string                 `protobuf:"bytes,3,opt,name=notes,proto3" json:"notes,omitempty"`
+	StartTime *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	// Deprecated: Marked as deprecated in proto/schedula/v1/appointments.proto.
+	EndTime       *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	Weekly        *WeeklyRecurrence      `protobuf:"bytes,6,opt,name=weekly,proto3" json:"weekly,omitempty"`
+	Metadata      map[string]string      `protobuf:"bytes,7,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	SkipConflicts bool                   `protobuf:"varint,8,opt,name=skip_conflicts,json=skipConflicts,proto3" json:"skip_conflicts,omitempty"`
+	ActorId       string                 `protobuf:"bytes,9,opt,name=actor_id,json=actorId,proto3" json:"actor_id,omitempty"`
+	ProgramId     string                 `protobuf:"bytes,10,opt,name=program_id,json=programId,proto3" json:"program_id,omitempty"`
+	Duration      *durationpb.Duration   `protobuf:"bytes,11,opt,name=duration,proto3" json:"duration,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateRecurringSeriesRequest) Reset() {
+	*x = CreateRecurringSeriesRequest{}
+	mi := &file_proto_schedula_v1_appointments_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateRecurringSeriesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateRecurringSeriesRequest) ProtoMessage() {}
+
+func (x *CreateRecurringSeriesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_schedula_v1_appointments_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateRecurringSeriesRequest.ProtoReflect.Descriptor instead.
+func (*CreateRecurringSeriesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_schedula_v1_appointments_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *CreateRecurringSeriesRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *CreateRecurringSeriesRequest) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *CreateRecurringSeriesRequest) GetNotes() string {
+	if x != nil {
+		return x.Notes
+	}
+	return ""
+}
+
+func (x *CreateRecurringSeriesRequest) GetStartTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartTime
+	}
+	return nil
+}
+
+// Deprecated: Marked as deprecated in proto/schedula/v1/appointments.proto.
+func (x *CreateRecurringSeriesRequest) GetEndTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndTime
+	}
+	return nil
+}
+
+func (x *CreateRecurringSeriesRequest) GetWeekly() *WeeklyRecurrence {
+	if x != nil {
+		return x.Weekly
+	}
+	return nil
+}
+
+func (x *CreateRecurringSeriesRequest) GetMetadata() map[string]string {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+func (x *CreateRecurringSeriesRequest) GetSkipConflicts() bool {
+	if x != nil {
+		return x.SkipConflicts
+	}
+	return false
+}
+
+func (x *CreateRecurringSeriesRequest) GetActorId() string {
+	if x != nil {
+		return x.ActorId
+	}
+	return ""
+}
+
+func (x *CreateRecurringSeriesRequest) GetProgramId() string {
+	if x != nil {
+		return x.ProgramId
+	}
+	return ""
+}
+
+func (x *CreateRecurringSeriesRequest) GetDuration() *durationpb.Duration {
+	if x != nil {
+		return x.Duration
+	}
+	return nil
+}
+
+type CreateRecurringSeriesResponse struct {
+	state              protoimpl.MessageState   `protogen:"open.v1"`
+	Series             *RecurringSeries         `protobuf:"bytes,1,opt,name=series,proto3" json:"series,omitempty"`
+	SkippedOccurrences []*timestamppb.Timestamp `protobuf:"bytes,2,rep,name=skipped_occurrences,json=skippedOccurrences,proto3" json:"skipped_occurrences,omitempty"`
+	BlackoutWarnings   []*BlackoutWarning       `protobuf:"bytes,3,rep,name=blackout_warnings,json=blackoutWarnings,proto3" json:"blackout_warnings,omitempty"`
+	Warnings           []*Warning               `protobuf:"bytes,4,rep,name=warnings,proto3" json:"warnings,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *CreateRecurringSeriesResponse) Reset() {
+	*x = CreateRecurringSeriesResponse{}
+	mi := &file_proto_schedula_v1_appointments_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateRecurringSeriesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateRecurringSeriesResponse) ProtoMessage() {}
+
+func (x *CreateRecurringSeriesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_schedula_v1_appointments_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateRecurringSeriesResponse.ProtoReflect.Descriptor instead.
+func (*CreateRecurringSeriesResponse) Descriptor() ([]byte, []int) {
+	return file_proto_schedula_v1_appointments_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *CreateRecurringSeriesResponse) GetSeries() *RecurringSeries {
+	if x != nil {
+		return x.Series
+	}
+	return nil
+}
+
+func (x *CreateRecurringSeriesResponse) GetSkippedOccurrences() []*timestamppb.Timestamp {
+	if x != nil {
+		return x.SkippedOccurrences
+	}
+	return nil
+}
+
+func (x *CreateRecurringSeriesResponse) GetBlackoutWarnings() []*BlackoutWarning {
+	if x != nil {
+		return x.BlackoutWarnings
+	}
+	return nil
+}
+
+func (x *CreateRecurringSeriesResponse) GetWarnings() []*Warning {
+	if x != nil {
+		return x.Warnings
+	}
+	return nil
+}
+
+type GetRecurringSeriesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	SeriesId      string                 `protobuf:"bytes,2,opt,name=series_id,json=seriesId,proto3" json:"series_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRecurringSeriesRequest) Reset() {
+	*x = GetRecurringSeriesRequest{}
+	mi := &file_proto_schedula_v1_appointments_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRecurringSeriesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRecurringSeriesRequest) ProtoMessage() {}
+
+func (x *GetRecurringSeriesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_schedula_v1_appointments_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRecurringSeriesRequest.ProtoReflect.Descriptor instead.
+func (*GetRecurringSeriesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_schedula_v1_appointments_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *GetRecurringSeriesRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *GetRecurringSeriesRequest) GetSeriesId() string {
+	if x != nil {
+		return x.SeriesId
+	}
+	return ""
+}
+
+type GetRecurringSeriesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Series        *RecurringSeries       `protobuf:"bytes,1,opt,name=series,proto3" json:"series,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRecurringSeriesResponse) Reset() {
+	*x = GetRecurringSeriesResponse{}
+	mi := &file_proto_schedula_v1_appointments_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRecurringSeriesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRecurringSeriesResponse) ProtoMessage() {}
+
+func (x *GetRecurringSeriesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_schedula_v1_appointments_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRecurringSeriesResponse.ProtoReflect.Descriptor instead.
+func (*GetRecurringSeriesResponse) Descriptor() ([]byte, []int) {
+	return file_proto_schedula_v1_appointments_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *GetRecurringSeriesResponse) GetSeries() *RecurringSeries {
+	if x != nil {
+		return x.Series
+	}
+	return nil
+}
+
+// ListRecurringSeriesRequest lists the user's series that repeat on any of
+// weekdays, or all of them when weekdays is empty. Ended series are listed
+// too.
+type ListRecurringSeriesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Weekdays      []Weekday              `protobuf:"varint,2,rep,packed,name=weekdays,proto3,enum=schedula.v1.Weekday" json:"weekdays,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListRecurringSeriesRequest) Reset() {
+	*x = ListRecurringSeriesRequest{}
+	mi := &file_proto_schedula_v1_appointments_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListRecurringSeriesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRecurringSeriesRequest) ProtoMessage() {}
+
+func (x *ListRecurringSeriesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_schedula_v1_appointments_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRecurringSeriesRequest.ProtoReflect.Descriptor instead.
+func (*ListRecurringSeriesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_schedula_v1_appointments_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *ListRecurringSeriesRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ListRecurringSeriesRequest) GetWeekdays() []Weekday {
+	if x != nil {
+		return x.Weekdays
+	}
+	return nil
+}
+
+type ListRecurringSeriesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Series        []*RecurringSeries     `protobuf:"bytes,1,rep,name=series,proto3" json:"series,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListRecurringSeriesResponse) Reset() {
+	*x = ListRecurringSeriesResponse{}
+	mi := &file_proto_schedula_v1_appointments_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListRecurringSeriesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRecurringSeriesResponse) ProtoMessage() {}
+
+func (x *ListRecurringSeriesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_schedula_v1_appointments_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRecurringSeriesResponse.ProtoReflect.Descriptor instead.
+func (*ListRecurringSeriesResponse) Descriptor() ([]byte, []int) {
+	return file_proto_schedula_v1_appointments_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *ListRecurringSeriesResponse) GetSeries() []*RecurringSeries {
+	if x != nil {
+		return x.Series
+	}
+	return nil
+}
+
+type Occurrence struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	SeriesId       string                 `protobuf:"bytes,1,opt,name=series_id,json=seriesId,proto3" json:"series_id,omitempty"`
+	OccurrenceId   string                 `protobuf:"bytes,2,opt,name=occurrence_id,json=occurrenceId,proto3" json:"occurrence_id,omitempty"`
+	UserId         string                 `protobuf:"bytes,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Title          string                 `protobuf:"bytes,4,opt,name=title,proto3" json:"title,omitempty"`
+	Notes          string                 `protobuf:"bytes,5,opt,name=notes,proto3" json:"notes,omitempty"`
+	StartTime      *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	EndTime        *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	Metadata       map[string]string      `protobuf:"bytes,8,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	TimeZone       string                 `protobuf:"bytes,9,opt,name=time_zone,json=timeZone,proto3" json:"time_zone,omitempty"`
+	LocalStartTime string                 `protobuf:"bytes,10,opt,name=local_start_time,json=localStartTime,proto3" json:"local_start_time,omitempty"`
+	LocalEndTime   string                 `protobuf:"bytes,11,opt,name=local_end_time,json=localEndTime,proto3" json:"local_end_time,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *Occurrence) Reset() {
+	*x = Occurrence{}
+	mi := &file_proto_schedula_v1_appointments_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Occurrence) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Occurrence) ProtoMessage() {}
+
+func (x *Occurrence) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_schedula_v1_appointments_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Occurrence.ProtoReflect.Descriptor instead.
+func (*Occurrence) Descriptor() ([]byte, []int) {
+	return file_proto_schedula_v1_appointments_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *Occurrence) GetSeriesId() string {
+	if x != nil {
+		return x.SeriesId
+	}
+	return ""
+}
+
+func (x *Occurrence) GetOccurrenceId() string {
+	if x != nil {
+		return x.OccurrenceId
+	}
+	return ""
+}
+
+func (x *Occurrence) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *Occurrence) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *Occurrence) GetNotes() string {
+	if x != nil {
+		return x.Notes
+	}
+	return ""
+}
+
+func (x *Occurrence) GetStartTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartTime
+	}
+	return nil
+}
+
+func (x *Occurrence) GetEndTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndTime
+	}
+	return nil
+}
+
+func (x *Occurrence) GetMetadata() map[string]string {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+func (x *Occurrence) GetTimeZone() string {
+	if x != nil {
+		return x.TimeZone
+	}
+	return ""
+}
+
+func (x *Occurrence) GetLocalStartTime() string {
+	if x != nil {
+		return x.LocalStartTime
+	}
+	return ""
+}
+
+func (x *Occurrence) GetLocalEndTime() string {
+	if x != nil {
+		return x.LocalEndTime
+	}
+	return ""
+}
+
+type ListOccurrencesRequest struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	UserId            string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	WindowStart       *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=window_start,json=windowStart,proto3" json:"window_start,omitempty"`
+	WindowEnd         *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=window_end,json=windowEnd,proto3" json:"window_end,omitempty"`
+	SplitTimeZone     string                 `protobuf:"bytes,4,opt,name=split_time_zone,json=splitTimeZone,proto3" json:"split_time_zone,omitempty"`
+	IncludeLocalTimes bool                   `protobuf:"varint,5,opt,name=include_local_times,json=includeLocalTimes,proto3" json:"include_local_times,omitempty"`
+	StartSync         bool                   `protobuf:"varint,6,opt,name=start_sync,json=startSync,proto3" json:"start_sync,omitempty"`
+	SyncToken         string                 `protobuf:"bytes,7,opt,name=sync_token,json=syncToken,proto3" json:"sync_token,omitempty"`
+	MaxHorizon        *durationpb.Duration   `protobuf:"bytes,8,opt,name=max_horizon,json=maxHorizon,proto3" json:"max_horizon,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *ListOccurrencesRequest) Reset() {
+	*x = ListOccurrencesRequest{}
+	mi := &file_proto_schedula_v1_appointments_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListOccurrencesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListOccurrencesRequest) ProtoMessage() {}
+
+func (x *ListOccurrencesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_schedula_v1_appointments_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListOccurrencesRequest.ProtoReflect.Descriptor instead.
+func (*ListOccurrencesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_schedula_v1_appointments_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *ListOccurrencesRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ListOccurrencesRequest) GetWindowStart() *timestamppb.Timestamp {
+	if x != nil {
+		return x.WindowStart
+	}
+	return nil
+}
+
+func (x *ListOccurrencesRequest) GetWindowEnd() *timestamppb.Timestamp {
+	if x != nil {
+		return x.WindowEnd
+	}
+	return nil
+}
+
+func (x *ListOccurrencesRequest) GetSplitTimeZone() string {
+	if x != nil {
+		return x.SplitTimeZone
+	}
+	return ""
+}
+
+func (x *ListOccurrencesRequest) GetIncludeLocalTimes() bool {
+	if x != nil {
+		return x.IncludeLocalTimes
+	}
+	return false
+}
+
+func (x *ListOccurrencesRequest) GetStartSync() bool {
+	if x != nil {
+		return x.StartSync
+	}
+	return false
+}
+
+func (x *ListOccurrencesRequest) GetSyncToken() string {
+	if x != nil {
+		return x.SyncToken
+	}
+	return ""
+}
+
+func (x *ListOccurrencesRequest) GetMaxHorizon() *durationpb.Duration {
+	if x != nil {
+		return x.MaxHorizon
+	}
+	return nil
+}
+
+type ListOccurrencesResponse struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Occurrences      []*Occurrence          `protobuf:"bytes,1,rep,name=occurrences,proto3" json:"occurrences,omitempty"`
+	DaySegments      []*DaySegment          `protobuf:"bytes,2,rep,name=day_segments,json=daySegments,proto3" json:"day_segments,omitempty"`
+	NextSyncToken    string                 `protobuf:"bytes,3,opt,name=next_sync_token,json=nextSyncToken,proto3" json:"next_sync_token,omitempty"`
+	FullSync         bool                   `protobuf:"varint,4,opt,name=full_sync,json=fullSync,proto3" json:"full_sync,omitempty"`
+	ChangedSeriesIds []string               `protobuf:"bytes,5,rep,name=changed_series_ids,json=changedSeriesIds,proto3" json:"changed_series_ids,omitempty"`
+	CalendarVersion  int64                  `protobuf:"varint,6,opt,name=calendar_version,json=calendarVersion,proto3" json:"calendar_version,omitempty"`
+	Truncated        bool                   `protobuf:"varint,7,opt,name=truncated,proto3" json:"truncated,omitempty"`
+	ExpandedUntil    *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=expanded_until,json=expandedUntil,proto3" json:"expanded_until,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *ListOccurrencesResponse) Reset() {
+	*x = ListOccurrencesResponse{}
+	mi := &file_proto_schedula_v1_appointments_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListOccurrencesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListOccurrencesResponse) ProtoMessage() {}
+
+func (x *ListOccurrencesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_schedula_v1_appointments_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListOccurrencesResponse.ProtoReflect.Descriptor instead.
+func (*ListOccurrencesResponse) Descriptor() ([]byte, []int) {
+	return file_proto_schedula_v1_appointments_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *ListOccurrencesResponse) GetOccurrences() []*Occurrence {
+	if x != nil {
+		return x.Occurrences
+	}
+	return nil
+}
+
+func (x *ListOccurrencesResponse) GetDaySegments() []*DaySegment {
+	if x != nil {
+		return x.DaySegments
+	}
+	return nil
+}
+
+func (x *ListOccurrencesResponse) GetNextSyncToken() string {
+	if x != nil {
+		return x.NextSyncToken
+	}
+	return ""
+}
+
+func (x *ListOccurrencesResponse) GetFullSync() bool {
+	if x != nil {
+		return x.FullSync
+	}
+	return false
+}
+
+func (x *ListOccurrencesResponse) GetChangedSeriesIds() []string {
+	if x != nil {
+		return x.ChangedSeriesIds
+	}
+	return nil
+}
+
+func (x *ListOccurrencesResponse) GetCalendarVersion() int64 {
+	if x != nil {
+		return x.CalendarVersion
+	}
+	return 0
+}
+
+func (x *ListOccurrencesResponse) GetTruncated() bool {
+	if x != nil {
+		return x.Truncated
+	}
+	return false
+}
+
+func (x *ListOccurrencesResponse) GetExpandedUntil() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpandedUntil
+	}
+	return nil
+}
+
+type OccurrenceAttendance struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	SeriesId        string                 `protobuf:"bytes,1,opt,name=series_id,json=seriesId,proto3" json:"series_id,omitempty"`
+	OccurrenceId    string                 `protobuf:"bytes,2,opt,name=occurrence_id,json=occurrenceId,proto3" json:"occurrence_id,omitempty"`
+	ParticipantId   string                 `protobuf:"bytes,3,opt,name=participant_id,json=participantId,proto3" json:"participant_id,omitempty"`
+	Status          AttendanceStatus       `protobuf:"varint,4,opt,name=status,proto3,enum=schedula.v1.AttendanceStatus" json:"status,omitempty"`
+	OccurrenceStart *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=occurrence_start,json=occurrenceStart,proto3" json:"occurrence_start,omitempty"`
+	UpdatedAt       *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *OccurrenceAttendance) Reset() {
+	*x = OccurrenceAttendance{}
+	mi := &file_proto_schedula_v1_appointments_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OccurrenceAttendance) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OccurrenceAttendance) ProtoMessage() {}
+
+func (x *OccurrenceAttendance) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_schedula_v1_appointments_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OccurrenceAttendance.ProtoReflect.Descriptor instead.
+func (*OccurrenceAttendance) Descriptor() ([]byte, []int) {
+	return file_proto_schedula_v1_appointments_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *OccurrenceAttendance) GetSeriesId() string {
+	if x != nil {
+		return x.SeriesId
+	}
+	return ""
+}
+
+func (x *OccurrenceAttendance) GetOccurrenceId() string {
+	if x != nil {
+		return x.OccurrenceId
+	}
+	return ""
+}
+
+func (x *OccurrenceAttendance) GetParticipantId() string {
+	if x != nil {
+		return x.ParticipantId
+	}
+	return ""
+}
+
+func (x *OccurrenceAttendance) GetStatus() AttendanceStatus {
+	if x != nil {
+		return x.Status
+	}
+	return AttendanceStatus_ATTENDANCE_STATUS_UNSPECIFIED
+}
+
+func (x *OccurrenceAttendance) GetOccurrenceStart() *timestamppb.Timestamp {
+	if x != nil {
+		return x.OccurrenceStart
+	}
+	return nil
+}
+
+func (x *OccurrenceAttendance) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type MarkAttendanceRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	SeriesId      string                 `protobuf:"bytes,2,opt,name=series_id,json=seriesId,proto3" json:"series_id,omitempty"`
+	OccurrenceId  string                 `protobuf:"bytes,3,opt,name=occurrence_id,json=occurrenceId,proto3" json:"occurrence_id,omitempty"`
+	ParticipantId string                 `protobuf:"bytes,4,opt,name=participant_id,json=participantId,proto3" json:"participant_id,omitempty"`
+	Status        AttendanceStatus       `protobuf:"varint,5,opt,name=status,proto3,enum=schedula.v1.AttendanceStatus" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MarkAttendanceRequest) Reset() {
+	*x = MarkAttendanceRequest{}
+	mi := &file_proto_schedula_v1_appointments_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MarkAttendanceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MarkAttendanceRequest) ProtoMessage() {}
+
+func (x *MarkAttendanceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_schedula_v1_appointments_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MarkAttendanceRequest.ProtoReflect.Descriptor instead.
+func (*MarkAttendanceRequest) Descriptor() ([]byte, []int) {
+	return file_proto_schedula_v1_appointments_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *MarkAttendanceRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *MarkAttendanceRequest) GetSeriesId() string {
+	if x != nil {
+		return x.SeriesId
+	}
+	return ""
+}
+
+func (x *MarkAttendanceRequest) GetOccurrenceId() string {
+	if x != nil {
+		return x.OccurrenceId
+	}
+	return ""
+}
+
+func (x *MarkAttendanceRequest) GetParticipantId() string {
+	if x != nil {
+		return x.ParticipantId
+	}
+	return ""
+}
+
+func (x *MarkAttendanceRequest) GetStatus() AttendanceStatus {
+	if x != nil {
+		return x.Status
+	}
+	return AttendanceStatus_ATTENDANCE_STATUS_UNSPECIFIED
+}
+
+type MarkAttendanceResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Attendance    *OccurrenceAttendance  `protobuf:"bytes,1,opt,name=attendance,proto3" json:"attendance,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MarkAttendanceResponse) Reset() {
+	*x = MarkAttendanceResponse{}
+	mi := &file_proto_schedula_v1_appointments_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MarkAttendanceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MarkAttendanceResponse) ProtoMessage() {}
+
+func (x *MarkAttendanceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_schedula_v1_appointments_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MarkAttendanceResponse.ProtoReflect.Descriptor instead.
+func (*MarkAttendanceResponse) Descriptor() ([]byte, []int) {
+	return file_proto_schedula_v1_appointments_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *MarkAttendanceResponse) GetAttendance() *OccurrenceAttendance {
+	if x != nil {
+		return x.Attendance
+	}
+	return nil
+}
+
+type ParticipantAttendanceStats struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ParticipantId string                 `protobuf:"bytes,1,opt,name=participant_id,json=participantId,proto3" json:"participant_id,omitempty"`
+	Attended      uint32                 `protobuf:"varint,2,opt,name=attended,proto3" json:"attended,omitempty"`
+	Missed        uint32                 `protobuf:"varint,3,opt,name=missed,proto3" json:"missed,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ParticipantAttendanceStats) Reset() {
+	*x = ParticipantAttendanceStats{}
+	mi := &file_proto_schedula_v1_appointments_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ParticipantAttendanceStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ParticipantAttendanceStats) ProtoMessage() {}
+
+func (x *ParticipantAttendanceStats) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_schedula_v1_appointments_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ParticipantAttendanceStats.ProtoReflect.Descriptor instead.
+func (*ParticipantAttendanceStats) Descriptor() ([]byte, []int) {
+	return file_proto_schedula_v1_appointments_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *ParticipantAttendanceStats) GetParticipantId() string {
+	if x != nil {
+		return x.ParticipantId
+	}
+	return ""
+}
+
+func (x *ParticipantAttendanceStats) GetAttended() uint32 {
+	if x != nil {
+		return x.Attended
+	}
+	return 0
+}
+
+func (x *ParticipantAttendanceStats) GetMissed() uint32 {
+	if x != nil {
+		return x.Missed
+	}
+	return 0
+}
+
+type GetAttendanceStatsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	SeriesId      string                 `protobuf:"bytes,2,opt,name=series_id,json=seriesId,proto3" json:"series_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAttendanceStatsRequest) Reset() {
+	*x = GetAttendanceStatsRequest{}
+	mi := &file_proto_schedula_v1_appointments_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAttendanceStatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAttendanceStatsRequest) ProtoMessage() {}
+
+func (x *GetAttendanceStatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_schedula_v1_appointments_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAttendanceStatsRequest.ProtoReflect.Descriptor instead.
+func (*GetAttendanceStatsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_schedula_v1_appointments_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *GetAttendanceStatsRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *GetAttendanceStatsRequest) GetSeriesId() string {
+	if x != nil {
+		return x.SeriesId
+	}
+	return ""
+}
+
+type GetAttendanceStatsResponse struct {
+	state              protoimpl.MessageState        `protogen:"open.v1"`
+	Participants       []*ParticipantAttendanceStats `protobuf:"bytes,1,rep,name=participants,proto3" json:"participants,omitempty"`
+	OccurrencesTracked uint32                        `protobuf:"varint,2,opt,name=occurrences_tracked,json=occurrencesTracked,proto3" json:"occurrences_tracked,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *GetAttendanceStatsResponse) Reset() {
+	*x = GetAttendanceStatsResponse{}
+	mi := &file_proto_schedula_v1_appointments_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAttendanceStatsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAttendanceStatsResponse) ProtoMessage() {}
+
+func (x *GetAttendanceStatsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_schedula_v1_appointments_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAttendanceStatsResponse.ProtoReflect.Descriptor instead.
+func (*GetAttendanceStatsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_schedula_v1_appointments_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *GetAttendanceStatsResponse) GetParticipants() []*ParticipantAttendanceStats {
+	if x != nil {
+		return x.Participants
+	}
+	return nil
+}
+
+func (x *GetAttendanceStatsResponse) GetOccurrencesTracked() uint32 {
+	if x != nil {
+		return x.OccurrencesTracked
+	}
+	return 0
+}
+
+type GetLimitsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetLimitsRequest) Reset() {
+	*x = GetLimitsRequest{}
+	mi := &file_proto_schedula_v1_appointments_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetLimitsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLimitsRequest) ProtoMessage() {}
+
+func (x *GetLimitsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_schedula_v1_appointments_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetLimitsRequest.ProtoReflect.Descriptor instead.
+func (*GetLimitsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_schedula_v1_appointments_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *GetLimitsRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type GetLimitsResponse struct {
+	state                  protoimpl.MessageState `protogen:"open.v1"`
+	MaxAppointmentDuration *durationpb.Duration   `protobuf:"bytes,1,opt,name=max_appointment_duration,json=maxAppointmentDuration,proto3" json:"max_appointment_duration,omitempty"`
+	RecurringLookahead     *durationpb.Duration   `protobuf:"bytes,2,opt,name=recurring_lookahead,json=recurringLookahead,proto3" json:"recurring_lookahead,omitempty"`
+	MaxTitleLength         uint32                 `protobuf:"varint,3,opt,name=max_title_length,json=maxTitleLength,proto3" json:"max_title_length,omitempty"`
+	MaxNotesLength         uint32                 `protobuf:"varint,4,opt,name=max_notes_length,json=maxNotesLength,proto3" json:"max_notes_length,omitempty"`
+	MaxWeekdays            uint32                 `protobuf:"varint,5,opt,name=max_weekdays,json=maxWeekdays,proto3" json:"max_weekdays,omitempty"`
+	MaxParticipantIdLength uint32                 `protobuf:"varint,6,opt,name=max_participant_id_length,json=maxParticipantIdLength,proto3" json:"max_participant_id_length,omitempty"`
+	MaxMessageBytes        uint32                 `protobuf:"varint,7,opt,name=max_message_bytes,json=maxMessageBytes,proto3" json:"max_message_bytes,omitempty"`
+	MaxMetadataEntries     uint32                 `protobuf:"varint,8,opt,name=max_metadata_entries,json=maxMetadataEntries,proto3" json:"max_metadata_entries,omitempty"`
+	MaxMetadataKeyLength   uint32                 `protobuf:"varint,9,opt,name=max_metadata_key_length,json=maxMetadataKeyLength,proto3" json:"max_metadata_key_length,omitempty"`
+	MaxMetadataValueLength uint32                 `protobuf:"varint,10,opt,name=max_metadata_value_length,json=maxMetadataValueLength,proto3" json:"max_metadata_value_length,omitempty"`
+	IntervalBounds         IntervalBounds         `protobuf:"varint,11,opt,name=interval_bounds,json=intervalBounds,proto3,enum=schedula.v1.IntervalBounds" json:"interval_bounds,omitempty"`
+	MaxActiveSeries        uint32                 `protobuf:"varint,12,opt,name=max_active_series,json=maxActiveSeries,proto3" json:"max_active_series,omitempty"`
+	MaxFutureOccurrences   uint32                 `protobuf:"varint,13,opt,name=max_future_occurrences,json=maxFutureOccurrences,proto3" json:"max_future_occurrences,omitempty"`
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *GetLimitsResponse) Reset() {
+	*x = GetLimitsResponse{}
+	mi := &file_proto_schedula_v1_appointments_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetLimitsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLimitsResponse) ProtoMessage() {}
+
+func (x *GetLimitsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_schedula_v1_appointments_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetLimitsResponse.ProtoReflect.Descriptor instead.
+func (*GetLimitsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_schedula_v1_appointments_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *GetLimitsResponse) GetMaxAppointmentDuration() *durationpb.Duration {
+	if x != nil {
+		return x.MaxAppointmentDuration
+	}
+	return nil
+}
+
+func (x *GetLimitsResponse) GetRecurringLookahead() *durationpb.Duration {
+	if x != nil {
+		return x.RecurringLookahead
+	}
+	return nil
+}
+
+func (x *GetLimitsResponse) GetMaxTitleLength() uint32 {
+	if x != nil {
+		return x.MaxTitleLength
+	}
+	return 0
+}
+
+func (x *GetLimitsResponse) GetMaxNotesLength() uint32 {
+	if x != nil {
+		return x.MaxNotesLength
+	}
+	return 0
+}
+
+func (x *GetLimitsResponse) GetMaxWeekdays() uint32 {
+	if x != nil {
+		return x.MaxWeekdays
+	}
+	return 0
+}
+
+func (x *GetLimitsResponse) GetMaxParticipantIdLength() uint32 {
+	if x != nil {
+		return x.MaxParticipantIdLength
+	}
+	return 0
+}
+
+func (x *GetLimitsResponse) GetMaxMessageBytes() uint32 {
+	if x != nil {
+		return x.MaxMessageBytes
+	}
+	return 0
+}
+
+func (x *GetLimitsResponse) GetMaxMetadataEntries() uint32 {
+	if x != nil {
+		return x.MaxMetadataEntries
+	}
+	return 0
+}
+
+func (x *GetLimitsResponse) GetMaxMetadataKeyLength() uint32 {
+	if x != nil {
+		return x.MaxMetadataKeyLength
+	}
+	return 0
+}
+
+func (x *GetLimitsResponse) GetMaxMetadataValueLength() uint32 {
+	if x != nil {
+		return x.MaxMetadataValueLength
+	}
+	return 0
+}
+
+func (x *GetLimitsResponse) GetIntervalBounds() IntervalBounds {
+	if x != nil {
+		return x.IntervalBounds
+	}
+	return IntervalBounds_INTERVAL_BOUNDS_UNSPECIFIED
+}
+
+func (x *GetLimitsResponse) GetMaxActiveSeries() uint32 {
+	if x != nil {
+		return x.MaxActiveSeries
+	}
+	return 0
+}
+
+func (x *GetLimitsResponse) GetMaxFutureOccurrences() uint32 {
+	if x != nil {
+		return x.MaxFutureOccurrences
+	}
+	return 0
+}
+
+type GetAnalyticsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	WindowStart   *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=window_start,json=windowStart,proto3" json:"window_start,omitempty"`
+	WindowEnd     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=window_end,json=windowEnd,proto3" json:"window_end,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAnalyticsRequest) Reset() {
+	*x = GetAnalyticsRequest{}
+	mi := &file_proto_schedula_v1_appointments_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAnalyticsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAnalyticsRequest) ProtoMessage() {}
+
+func (x *GetAnalyticsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_schedula_v1_appointments_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAnalyticsRequest.ProtoReflect.Descriptor instead.
+func (*GetAnalyticsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_schedula_v1_appointments_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *GetAnalyticsRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *GetAnalyticsRequest) GetWindowStart() *timestamppb.Timestamp {
+	if x != nil {
+		return x.WindowStart
+	}
+	return nil
+}
+
+func (x *GetAnalyticsRequest) GetWindowEnd() *timestamppb.Timestamp {
+	if x != nil {
+		return x.WindowEnd
+	}
+	return nil
+}
+
+type GetAnalyticsResponse struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	AppointmentCount   uint32                 `protobuf:"varint,1,opt,name=appointment_count,json=appointmentCount,proto3" json:"appointment_count,omitempty"`
+	AverageDuration    *durationpb.Duration   `protobuf:"bytes,2,opt,name=average_duration,json=averageDuration,proto3" json:"average_duration,omitempty"`
+	Attended           uint32                 `protobuf:"varint,3,opt,name=attended,proto3" json:"attended,omitempty"`
+	Missed             uint32                 `protobuf:"varint,4,opt,name=missed,proto3" json:"missed,omitempty"`
+	NoShowRate         float64                `protobuf:"fixed64,5,opt,name=no_show_rate,json=noShowRate,proto3" json:"no_show_rate,omitempty"`
+	Sessions           uint32                 `protobuf:"varint,6,opt,name=sessions,proto3" json:"sessions,omitempty"`
+	PlannedSessionTime *durationpb.Duration   `protobuf:"bytes,7,opt,name=planned_session_time,json=plannedSessionTime,proto3" json:"planned_session_time,omitempty"`
+	ActualSessionTime  *durationpb.Duration   `protobuf:"bytes,8,opt,name=actual_session_time,json=actualSessionTime,proto3" json:"actual_session_time,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *GetAnalyticsResponse) Reset() {
+	*x = GetAnalyticsResponse{}
+	mi := &file_proto_schedula_v1_appointments_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAnalyticsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAnalyticsResponse) ProtoMessage() {}
+
+func (x *GetAnalyticsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_schedula_v1_appointments_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAnalyticsResponse.ProtoReflect.Descriptor instead.
+func (*GetAnalyticsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_schedula_v1_appointments_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *GetAnalyticsResponse) GetAppointmentCount() uint32 {
+	if x != nil {
+		return x.AppointmentCount
+	}
+	return 0
+}
+
+func (x *GetAnalyticsResponse) GetAverageDuration() *durationpb.Duration {
+	if x != nil {
+		return x.AverageDuration
+	}
+	return nil
+}
+
+func (x *GetAnalyticsResponse) GetAttended() uint32 {
+	if x != nil {
+		return x.Attended
+	}
+	return 0
+}
+
+func (x *GetAnalyticsResponse) GetMissed() uint32 {
+	if x != nil {
+		return x.Missed
+	}
+	return 0
+}
+
+func (x *GetAnalyticsResponse) GetNoShowRate() float64 {
+	if x != nil {
+		return x.NoShowRate
+	}
+	return 0
+}
+
+func (x *GetAnalyticsResponse) GetSessions() uint32 {
+	if x != nil {
+		return x.Sessions
+	}
+	return 0
+}
+
+func (x *GetAnalyticsResponse) GetPlannedSessionTime() *durationpb.Duration {
+	if x != nil {
+		return x.PlannedSessionTime
+	}
+	return nil
+}
+
+func (x *GetAnalyticsResponse) GetActualSessionTime() *durationpb.Duration {
+	if x != nil {
+		return x.ActualSessionTime
+	}
+	return nil
+}
+
+type SuggestEndTimeRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	UserId          string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	StartTime       *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	DesiredDuration *durationpb.Duration   `protobuf:"bytes,3,opt,name=desired_duration,json=desiredDuration,proto3" json:"desired_duration,omitempty"`
+	// When set, the suggested end never runs past the close of working hours.
+	WorkingHours  *WorkingHours `protobuf:"bytes,4,opt,name=working_hours,json=workingHours,proto3" json:"working_hours,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SuggestEndTimeRequest) Reset() {
+	*x = SuggestEndTimeRequest{}
+	mi := &file_proto_schedula_v1_appointments_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SuggestEndTimeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SuggestEndTimeRequest) ProtoMessage() {}
+
+func (x *SuggestEndTimeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_schedula_v1_appointments_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SuggestEndTimeRequest.ProtoReflect.Descriptor instead.
+func (*SuggestEndTimeRequest) Descriptor() ([]byte, []int) {
+	return file_proto_schedula_v1_appointments_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *SuggestEndTimeRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *SuggestEndTimeRequest) GetStartTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartTime
+	}
+	return nil
+}
+
+func (x *SuggestEndTimeRequest) GetDesiredDuration() *durationpb.Duration {
+	if x != nil {
+		return x.DesiredDuration
+	}
+	return nil
+}
+
+func (x *SuggestEndTimeRequest) GetWorkingHours() *WorkingHours {
+	if x != nil {
+		return x.WorkingHours
+	}
+	return nil
+}
+
+type SuggestEndTimeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EndTime       *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	Duration      *durationpb.Duration   `protobuf:"bytes,2,opt,name=duration,proto3" json:"duration,omitempty"`
+	Shortened     bool                   `protobuf:"varint,3,opt,name=shortened,proto3" json:"shortened,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SuggestEndTimeResponse) Reset() {
+	*x = SuggestEndTimeResponse{}
+	mi := &file_proto_schedula_v1_appointments_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SuggestEndTimeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SuggestEndTimeResponse) ProtoMessage() {}
+
+func (x *SuggestEndTimeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_schedula_v1_appointments_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SuggestEndTimeResponse.ProtoReflect.Descriptor instead.
+func (*SuggestEndTimeResponse) Descriptor() ([]byte, []int) {
+	return file_proto_schedula_v1_appointments_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *SuggestEndTimeResponse) GetEndTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndTime
+	}
+	return nil
+}
+
+func (x *SuggestEndTimeResponse) GetDuration() *durationpb.Duration {
+	if x != nil {
+		return x.Duration
+	}
+	return nil
+}
+
+func (x *SuggestEndTimeResponse) GetShortened() bool {
+	if x != nil {
+		return x.Shortened
+	}
+	return false
+}
+
+type SlotHold struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	StartTime     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	EndTime       *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	ExpiresAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SlotHold) Reset() {
+	*x = SlotHold{}
+	mi := &file_proto_schedula_v1_appointments_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SlotHold) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SlotHold) ProtoMessage() {}
+
+func (x *SlotHold) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_schedula_v1_appointments_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SlotHold.ProtoReflect.Descriptor instead.
+func (*SlotHold) Descriptor() ([]byte, []int) {
+	return file_proto_schedula_v1_appointments_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *SlotHold) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *SlotHold) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *SlotHold) GetStartTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartTime
+	}
+	return nil
+}
+
+func (x *SlotHold) GetEndTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndTime
+	}
+	return nil
+}
+
+func (x *SlotHold) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+type ReserveSlotRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	StartTime     *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	EndTime       *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	Ttl           *durationpb.Duration   `protobuf:"bytes,4,opt,name=ttl,proto3" json:"ttl,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReserveSlotRequest) Reset() {
+	*x = ReserveSlotRequest{}
+	mi := &file_proto_schedula_v1_appointments_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReserveSlotRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReserveSlotRequest) ProtoMessage() {}
+
+func (x *ReserveSlotRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_schedula_v1_appointments_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReserveSlotRequest.ProtoReflect.Descriptor instead.
+func (*ReserveSlotRequest) Descriptor() ([]byte, []int) {
+	return file_proto_schedula_v1_appointments_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *ReserveSlotRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ReserveSlotRequest) GetStartTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartTime
+	}
+	return nil
+}
+
+func (x *ReserveSlotRequest) GetEndTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndTime
+	}
+	return nil
+}
+
+func (x *ReserveSlotRequest) GetTtl() *durationpb.Duration {
+	if x != nil {
+		return x.Ttl
+	}
+	return nil
+}
+
+type ReserveSlotResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Hold          *SlotHold              `protobuf:"bytes,1,opt,name=hold,proto3" json:"hold,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReserveSlotResponse) Reset() {
+	*x = ReserveSlotResponse{}
+	mi := &file_proto_schedula_v1_appointments_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReserveSlotResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReserveSlotResponse) ProtoMessage() {}
+
+func (x *ReserveSlotResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_schedula_v1_appointments_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReserveSlotResponse.ProtoReflect.Descriptor instead.
+func (*ReserveSlotResponse) Descriptor() ([]byte, []int) {
+	return file_proto_schedula_v1_appointments_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *ReserveSlotResponse) GetHold() *SlotHold {
+	if x != nil {
+		return x.Hold
+	}
+	return nil
+}
+
+type ConfirmHoldRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	HoldId        string                 `protobuf:"bytes,2,opt,name=hold_id,json=holdId,proto3" json:"hold_id,omitempty"`
+	Title         string                 `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
+	Notes         string                 `protobuf:"bytes,4,opt,name=notes,proto3" json:"notes,omitempty"`
+	Metadata      map[string]string      `protobuf:"bytes,5,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConfirmHoldRequest) Reset() {
+	*x = ConfirmHoldRequest{}
+	mi := &file_proto_schedula_v1_appointments_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConfirmHoldRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfirmHoldRequest) ProtoMessage() {}
+
+func (x *ConfirmHoldRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_schedula_v1_appointments_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfirmHoldRequest.ProtoReflect.Descriptor instead.
+func (*ConfirmHoldRequest) Descriptor() ([]byte, []int) {
+	return file_proto_schedula_v1_appointments_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *ConfirmHoldRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ConfirmHoldRequest) GetHoldId() string {
+	if x != nil {
+		return x.HoldId
+	}
+	return ""
+}
+
+func (x *ConfirmHoldRequest) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *ConfirmHoldRequest) GetNotes() string {
+	if x != nil {
+		return x.Notes
+	}
+	return ""
+}
+
+func (x *ConfirmHoldRequest) GetMetadata() map[string]string {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+type ConfirmHoldResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Appointment   *Appointment           `protobuf:"bytes,1,opt,name=appointment,proto3" json:"appointment,omitempty"`
+	Warnings      []*Warning             `protobuf:"bytes,2,rep,name=warnings,proto3" json:"warnings,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConfirmHoldResponse) Reset() {
+	*x = ConfirmHoldResponse{}
+	mi := &file_proto_schedula_v1_appointments_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConfirmHoldResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfirmHoldResponse) ProtoMessage() {}
+
+func (x *ConfirmHoldResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_schedula_v1_appointments_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfirmHoldResponse.ProtoReflect.Descriptor instead.
+func (*ConfirmHoldResponse) Descriptor() ([]byte, []int) {
+	return file_proto_schedula_v1_appointments_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *ConfirmHoldResponse) GetAppointment() *Appointment {
+	if x != nil {
+		return x.Appointment
+	}
+	return nil
+}
+
+func (x *ConfirmHoldResponse) GetWarnings() []*Warning {
+	if x != nil {
+		return x.Warnings
+	}
+	return nil
+}
+
+type ReleaseHoldRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	HoldId        string                 `protobuf:"bytes,2,opt,name=hold_id,json=holdId,proto3" json:"hold_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReleaseHoldRequest) Reset() {
+	*x = ReleaseHoldRequest{}
+	mi := &file_proto_schedula_v1_appointments_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReleaseHoldRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReleaseHoldRequest) ProtoMessage() {}
+
+func (x *ReleaseHoldRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_schedula_v1_appointments_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReleaseHoldRequest.ProtoReflect.Descriptor instead.
+func (*ReleaseHoldRequest) Descriptor() ([]byte, []int) {
+	return file_proto_schedula_v1_appointments_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *ReleaseHoldRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ReleaseHoldRequest) GetHoldId() string {
+	if x != nil {
+		return x.HoldId
+	}
+	return ""
+}
+
+type ReleaseHoldResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReleaseHoldResponse) Reset() {
+	*x = ReleaseHoldResponse{}
+	mi := &file_proto_schedula_v1_appointments_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReleaseHoldResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReleaseHoldResponse) ProtoMessage() {}
+
+func (x *ReleaseHoldResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_schedula_v1_appointments_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReleaseHoldResponse.ProtoReflect.Descriptor instead.
+func (*ReleaseHoldResponse) Descriptor() ([]byte, []int) {
+	return file_proto_schedula_v1_appointments_proto_rawDescGZIP(), []int{43}
+}
+
+type AppointmentProposal struct {
+	state                  protoimpl.MessageState `protogen:"open.v1"`
+	Id                     string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ProposerId             string                 `protobuf:"bytes,2,opt,name=proposer_id,json=proposerId,proto3" json:"proposer_id,omitempty"`
+	RecipientId            string                 `protobuf:"bytes,3,opt,name=recipient_id,json=recipientId,proto3" json:"recipient_id,omitempty"`
+	Title                  string                 `protobuf:"bytes,4,opt,name=title,proto3" json:"title,omitempty"`
+	Notes                  string                 `protobuf:"bytes,5,opt,name=notes,proto3" json:"notes,omitempty"`
+	StartTime              *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	EndTime                *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	Status                 ProposalStatus         `protobuf:"varint,8,opt,name=status,proto3,enum=schedula.v1.ProposalStatus" json:"status,omitempty"`
+	ExpiresAt              *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	CreatedAt              *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	RespondedAt            *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=responded_at,json=respondedAt,proto3" json:"responded_at,omitempty"`
+	ProposerAppointmentId  string                 `protobuf:"bytes,12,opt,name=proposer_appointment_id,json=proposerAppointmentId,proto3" json:"proposer_appointment_id,omitempty"`
+	RecipientAppointmentId string                 `protobuf:"bytes,13,opt,name=recipient_appointment_id,json=recipientAppointmentId,proto3" json:"recipient_appointment_id,omitempty"`
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *AppointmentProposal) Reset() {
+	*x = AppointmentProposal{}
+	mi := &file_proto_schedula_v1_appointments_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AppointmentProposal) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AppointmentProposal) ProtoMessage() {}
+
+func (x *AppointmentProposal) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_schedula_v1_appointments_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AppointmentProposal.ProtoReflect.Descriptor instead.
+func (*AppointmentProposal) Descriptor() ([]byte, []int) {
+	return file_proto_schedula_v1_appointments_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *AppointmentProposal) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *AppointmentProposal) GetProposerId() string {
+	if x != nil {
+		return x.ProposerId
+	}
+	return ""
+}
+
+func (x *AppointmentProposal) GetRecipientId() string {
+	if x != nil {
+		return x.RecipientId
+	}
+	return ""
+}
+
+func (x *AppointmentProposal) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *AppointmentProposal) GetNotes() string {
+	if x != nil {
+		return x.Notes
+	}
+	return ""
+}
+
+func (x *AppointmentProposal) GetStartTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartTime
+	}
+	return nil
+}
+
+func (x *AppointmentProposal) GetEndTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndTime
+	}
+	return nil
+}
+
+func (x *AppointmentProposal) GetStatus() ProposalStatus {
+	if x != nil {
+		return x.Status
+	}
+	return ProposalStatus_PROPOSAL_STATUS_UNSPECIFIED
+}
+
+func (x *AppointmentProposal) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+func (x *AppointmentProposal) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *AppointmentProposal) GetRespondedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.RespondedAt
+	}
+	return nil
+}
+
+func (x *AppointmentProposal) GetProposerAppointmentId() string {
+	if x != nil {
+		return x.ProposerAppointmentId
+	}
+	return ""
+}
+
+func (x *AppointmentProposal) GetRecipientAppointmentId() string {
+	if x != nil {
+		return x.RecipientAppointmentId
+	}
+	return ""
+}
+
+type ProposeAppointmentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProposerId    string                 `protobuf:"bytes,1,opt,name=proposer_id,json=proposerId,proto3" json:"proposer_id,omitempty"`
+	RecipientId   string                 `protobuf:"bytes,2,opt,name=recipient_id,json=recipientId,proto3" json:"recipient_id,omitempty"`
+	Title         string                 `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
+	Notes         string                 `protobuf:"bytes,4,opt,name=notes,proto3" json:"notes,omitempty"`
+	StartTime     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	EndTime       *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	Ttl           *durationpb.Duration   `protobuf:"bytes,7,opt,name=ttl,proto3" json:"ttl,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProposeAppointmentRequest) Reset() {
+	*x = ProposeAppointmentRequest{}
+	mi := &file_proto_schedula_v1_appointments_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProposeAppointmentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProposeAppointmentRequest) ProtoMessage() {}
+
+func (x *ProposeAppointmentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_schedula_v1_appointments_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProposeAppointmentRequest.ProtoReflect.Descriptor instead.
+func (*ProposeAppointmentRequest) Descriptor() ([]byte, []int) {
+	return file_proto_schedula_v1_appointments_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *ProposeAppointmentRequest) GetProposerId() string {
+	if x != nil {
+		return x.ProposerId
+	}
+	return ""
+}
+
+func (x *ProposeAppointmentRequest) GetRecipientId() string {
+	if x != nil {
+		return x.RecipientId
+	}
+	return ""
+}
+
+func (x *ProposeAppointmentRequest) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *ProposeAppointmentRequest) GetNotes() string {
+	if x != nil {
+		return x.Notes
+	}
+	return ""
+}
+
+func (x *ProposeAppointmentRequest) GetStartTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartTime
+	}
+	return nil
+}
+
+func (x *ProposeAppointmentRequest) GetEndTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndTime
+	}
+	return nil
+}
+
+func (x *ProposeAppointmentRequest) GetTtl() *durationpb.Duration {
+	if x != nil {
+		return x.Ttl
+	}
+	return nil
+}
+
+type ProposeAppointmentResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Proposal      *AppointmentProposal   `protobuf:"bytes,1,opt,name=proposal,proto3" json:"proposal,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProposeAppointmentResponse) Reset() {
+	*x = ProposeAppointmentResponse{}
+	mi := &file_proto_schedula_v1_appointments_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProposeAppointmentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProposeAppointmentResponse) ProtoMessage() {}
+
+func (x *ProposeAppointmentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_schedula_v1_appointments_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProposeAppointmentResponse.ProtoReflect.Descriptor instead.
+func (*ProposeAppointmentResponse) Descriptor() ([]byte, []int) {
+	return file_proto_schedula_v1_appointments_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *ProposeAppointmentResponse) GetProposal() *AppointmentProposal {
+	if x != nil {
+		return x.Proposal
+	}
+	return nil
+}
+
+type ListProposalsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListProposalsRequest) Reset() {
+	*x = ListProposalsRequest{}
+	mi := &file_proto_schedula_v1_appointments_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListProposalsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListProposalsRequest) ProtoMessage() {}
+
+func (x *ListProposalsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_schedula_v1_appointments_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListProposalsRequest.ProtoReflect.Descriptor instead.
+func (*ListProposalsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_schedula_v1_appointments_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *ListProposalsRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type ListProposalsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Proposals     []*AppointmentProposal `protobuf:"bytes,1,rep,name=proposals,proto3" json:"proposals,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListProposalsResponse) Reset() {
+	*x = ListProposalsResponse{}
+	mi := &file_proto_schedula_v1_appointments_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListProposalsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListProposalsResponse) ProtoMessage() {}
+
+func (x *ListProposalsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_schedula_v1_appointments_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListProposalsResponse.ProtoReflect.Descriptor instead.
+func (*ListProposalsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_schedula_v1_appointments_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *ListProposalsResponse) GetProposals() []*AppointmentProposal {
+	if x != nil {
+		return x.Proposals
+	}
+	return nil
+}
+
+type AcceptProposalRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	ProposalId    string                 `protobuf:"bytes,2,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AcceptProposalRequest) Reset() {
+	*x = AcceptProposalRequest{}
+	mi := &file_proto_schedula_v1_appointments_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AcceptProposalRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AcceptProposalRequest) ProtoMessage() {}
+
+func (x *AcceptProposalRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_schedula_v1_appointments_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AcceptProposalRequest.ProtoReflect.Descriptor instead.
+func (*AcceptProposalRequest) Descriptor() ([]byte, []int) {
+	return file_proto_schedula_v1_appointments_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *AcceptProposalRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *AcceptProposalRequest) GetProposalId() string {
+	if x != nil {
+		return x.ProposalId
+	}
+	return ""
+}
+
+type AcceptProposalResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Proposal      *AppointmentProposal   `protobuf:"bytes,1,opt,name=proposal,proto3" json:"proposal,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AcceptProposalResponse) Reset() {
+	*x = AcceptProposalResponse{}
+	mi := &file_proto_schedula_v1_appointments_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AcceptProposalResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AcceptProposalResponse) ProtoMessage() {}
+
+func (x *AcceptProposalResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_schedula_v1_appointments_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AcceptProposalResponse.ProtoReflect.Descriptor instead.
+func (*AcceptProposalResponse) Descriptor() ([]byte, []int) {
+	return file_proto_schedula_v1_appointments_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *AcceptProposalResponse) GetProposal() *AppointmentProposal {
+	if x != nil {
+		return x.Proposal
+	}
+	return nil
+}
+
+type DeclineProposalRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	ProposalId    string                 `protobuf:"bytes,2,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeclineProposalRequest) Reset() {
+	*x = DeclineProposalRequest{}
+	mi := &file_proto_schedula_v1_appointments_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeclineProposalRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeclineProposalRequest) ProtoMessage() {}
+
+func (x *DeclineProposalRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_schedula_v1_appointments_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeclineProposalRequest.ProtoReflect.Descriptor instead.
+func (*DeclineProposalRequest) Descriptor() ([]byte, []int) {
+	return file_proto_schedula_v1_appointments_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *DeclineProposalRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *DeclineProposalRequest) GetProposalId() string {
+	if x != nil {
+		return x.ProposalId
+	}
+	return ""
+}
+
+type DeclineProposalResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Proposal      *AppointmentProposal   `protobuf:"bytes,1,opt,name=proposal,proto3" json:"proposal,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeclineProposalResponse) Reset() {
+	*x = DeclineProposalResponse{}
+	mi := &file_proto_schedula_v1_appointments_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeclineProposalResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeclineProposalResponse) ProtoMessage() {}
+
+func (x *DeclineProposalResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_schedula_v1_appointments_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeclineProposalResponse.ProtoReflect.Descriptor instead.
+func (*DeclineProposalResponse) Descriptor() ([]byte, []int) {
+	return file_proto_schedula_v1_appointments_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *DeclineProposalResponse) GetProposal() *AppointmentProposal {
+	if x != nil {
+		return x.Proposal
+	}
+	return nil
+}
+
+type AppointmentLink struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	AppointmentId        string                 `protobuf:"bytes,1,opt,name=appointment_id,json=appointmentId,proto3" json:"appointment_id,omitempty"`
+	RelatedAppointmentId string                 `protobuf:"bytes,2,opt,name=related_appointment_id,json=relatedAppointmentId,proto3" json:"related_appointment_id,omitempty"`
+	Kind                 AppointmentLinkKind    `protobuf:"varint,3,opt,name=kind,proto3,enum=schedula.v1.AppointmentLinkKind" json:"kind,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *AppointmentLink) Reset() {
+	*x = AppointmentLink{}
+	mi := &file_proto_schedula_v1_appointments_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AppointmentLink) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AppointmentLink) ProtoMessage() {}
+
+func (x *AppointmentLink) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_schedula_v1_appointments_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AppointmentLink.ProtoReflect.Descriptor instead.
+func (*AppointmentLink) Descriptor() ([]byte, []int) {
+	return file_proto_schedula_v1_appointments_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *AppointmentLink) GetAppointmentId() string {
+	if x != nil {
+		return x.AppointmentId
+	}
+	return ""
+}
+
+func (x *AppointmentLink) GetRelatedAppointmentId() string {
+	if x != nil {
+		return x.RelatedAppointmentId
+	}
+	return ""
+}
+
+func (x *AppointmentLink) GetKind() AppointmentLinkKind {
+	if x != nil {
+		return x.Kind
+	}
+	return AppointmentLinkKind_APPOINTMENT_LINK_KIND_UNSPECIFIED
+}
+
+type LinkAppointmentsRequest struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	UserId               string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	AppointmentId        string                 `protobuf:"bytes,2,opt,name=appointment_id,json=appointmentId,proto3" json:"appointment_id,omitempty"`
+	RelatedAppointmentId string                 `protobuf:"bytes,3,opt,name=related_appointment_id,json=relatedAppointmentId,proto3" json:"related_appointment_id,omitempty"`
+	Kind                 AppointmentLinkKind    `protobuf:"varint,4,opt,name=kind,proto3,enum=schedula.v1.AppointmentLinkKind" json:"kind,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *LinkAppointmentsRequest) Reset() {
+	*x = LinkAppointmentsRequest{}
+	mi := &file_proto_schedula_v1_appointments_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LinkAppointmentsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LinkAppointmentsRequest) ProtoMessage() {}
+
+func (x *LinkAppointmentsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_schedula_v1_appointments_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LinkAppointmentsRequest.ProtoReflect.Descriptor instead.
+func (*LinkAppointmentsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_schedula_v1_appointments_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *LinkAppointmentsRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *LinkAppointmentsRequest) GetAppointmentId() string {
+	if x != nil {
+		return x.AppointmentId
+	}
+	return ""
+}
+
+func (x *LinkAppointmentsRequest) GetRelatedAppointmentId() string {
+	if x != nil {
+		return x.RelatedAppointmentId
+	}
+	return ""
+}
+
+func (x *LinkAppointmentsRequest) GetKind() AppointmentLinkKind {
+	if x != nil {
+		return x.Kind
+	}
+	return AppointmentLinkKind_APPOINTMENT_LINK_KIND_UNSPECIFIED
+}
+
+type LinkAppointmentsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Link          *AppointmentLink       `protobuf:"bytes,1,opt,name=link,proto3" json:"link,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LinkAppointmentsResponse) Reset() {
+	*x = LinkAppointmentsResponse{}
+	mi := &file_proto_schedula_v1_appointments_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LinkAppointmentsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LinkAppointmentsResponse) ProtoMessage() {}
+
+func (x *LinkAppointmentsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_schedula_v1_appointments_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LinkAppointmentsResponse.ProtoReflect.Descriptor instead.
+func (*LinkAppointmentsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_schedula_v1_appointments_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *LinkAppointmentsResponse) GetLink() *AppointmentLink {
+	if x != nil {
+		return x.Link
+	}
+	return nil
+}
+
+type UnlinkAppointmentsRequest struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	UserId               string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	AppointmentId        string                 `protobuf:"bytes,2,opt,name=appointment_id,json=appointmentId,proto3" json:"appointment_id,omitempty"`
+	RelatedAppointmentId string                 `protobuf:"bytes,3,opt,name=related_appointment_id,json=relatedAppointmentId,proto3" json:"related_appointment_id,omitempty"`
+	Kind                 AppointmentLinkKind    `protobuf:"varint,4,opt,name=kind,proto3,enum=schedula.v1.AppointmentLinkKind" json:"kind,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *UnlinkAppointmentsRequest) Reset() {
+	*x = UnlinkAppointmentsRequest{}
+	mi := &file_proto_schedula_v1_appointments_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnlinkAppointmentsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnlinkAppointmentsRequest) ProtoMessage() {}
+
+func (x *UnlinkAppointmentsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_schedula_v1_appointments_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnlinkAppointmentsRequest.ProtoReflect.Descriptor instead.
+func (*UnlinkAppointmentsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_schedula_v1_appointments_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *UnlinkAppointmentsRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *UnlinkAppointmentsRequest) GetAppointmentId() string {
+	if x != nil {
+		return x.AppointmentId
+	}
+	return ""
+}
+
+func (x *UnlinkAppointmentsRequest) GetRelatedAppointmentId() string {
+	if x != nil {
+		return x.RelatedAppointmentId
+	}
+	return ""
+}
+
+func (x *UnlinkAppointmentsRequest) GetKind() AppointmentLinkKind {
+	if x != nil {
+		return x.Kind
+	}
+	return AppointmentLinkKind_APPOINTMENT_LINK_KIND_UNSPECIFIED
+}
+
+type UnlinkAppointmentsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnlinkAppointmentsResponse) Reset() {
+	*x = UnlinkAppointmentsResponse{}
+	mi := &file_proto_schedula_v1_appointments_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnlinkAppointmentsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnlinkAppointmentsResponse) ProtoMessage() {}
+
+func (x *UnlinkAppointmentsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_schedula_v1_appointments_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnlinkAppointmentsResponse.ProtoReflect.Descriptor instead.
+func (*UnlinkAppointmentsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_schedula_v1_appointments_proto_rawDescGZIP(), []int{57}
+}
+
+type RelatedAppointment struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Link          *AppointmentLink       `protobuf:"bytes,1,opt,name=link,proto3" json:"link,omitempty"`
+	Appointment   *Appointment           `protobuf:"bytes,2,opt,name=appointment,proto3" json:"appointment,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RelatedAppointment) Reset() {
+	*x = RelatedAppointment{}
+	mi := &file_proto_schedula_v1_appointments_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RelatedAppointment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RelatedAppointment) ProtoMessage() {}
+
+func (x *RelatedAppointment) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_schedula_v1_appointments_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RelatedAppointment.ProtoReflect.Descriptor instead.
+func (*RelatedAppointment) Descriptor() ([]byte, []int) {
+	return file_proto_schedula_v1_appointments_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *RelatedAppointment) GetLink() *AppointmentLink {
+	if x != nil {
+		return x.Link
+	}
+	return nil
+}
+
+func (x *RelatedAppointment) GetAppointment() *Appointment {
+	if x != nil {
+		return x.Appointment
+	}
+	return nil
+}
+
+type ListRelatedRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	AppointmentId string                 `protobuf:"bytes,2,opt,name=appointment_id,json=appointmentId,proto3" json:"appointment_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListRelatedRequest) Reset() {
+	*x = ListRelatedRequest{}
+	mi := &file_proto_schedula_v1_appointments_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListRelatedRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRelatedRequest) ProtoMessage() {}
+
+func (x *ListRelatedRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_schedula_v1_appointments_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRelatedRequest.ProtoReflect.Descriptor instead.
+func (*ListRelatedRequest) Descriptor() ([]byte, []int) {
+	return file_proto_schedula_v1_appointments_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *ListRelatedRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ListRelatedRequest) GetAppointmentId() string {
+	if x != nil {
+		return x.AppointmentId
+	}
+	return ""
+}
+
+type ListRelatedResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Related       []*RelatedAppointment  `protobuf:"bytes,1,rep,name=related,proto3" json:"related,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListRelatedResponse) Reset() {
+	*x = ListRelatedResponse{}
+	mi := &file_proto_schedula_v1_appointments_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListRelatedResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRelatedResponse) ProtoMessage() {}
+
+func (x *ListRelatedResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_schedula_v1_appointments_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRelatedResponse.ProtoReflect.Descriptor instead.
+func (*ListRelatedResponse) Descriptor() ([]byte, []int) {
+	return file_proto_schedula_v1_appointments_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *ListRelatedResponse) GetRelated() []*RelatedAppointment {
+	if x != nil {
+		return x.Related
+	}
+	return nil
+}
+
+type MergeAppointmentsRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	UserId          string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	PrimaryId       string                 `protobuf:"bytes,2,opt,name=primary_id,json=primaryId,proto3" json:"primary_id,omitempty"`
+	DuplicateIds    []string               `protobuf:"bytes,3,rep,name=duplicate_ids,json=duplicateIds,proto3" json:"duplicate_ids,omitempty"`
+	CoverDuplicates bool                   `protobuf:"varint,4,opt,name=cover_duplicates,json=coverDuplicates,proto3" json:"cover_duplicates,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *MergeAppointmentsRequest) Reset() {
+	*x = MergeAppointmentsRequest{}
+	mi := &file_proto_schedula_v1_appointments_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MergeAppointmentsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MergeAppointmentsRequest) ProtoMessage() {}
+
+func (x *MergeAppointmentsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_schedula_v1_appointments_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MergeAppointmentsRequest.ProtoReflect.Descriptor instead.
+func (*MergeAppointmentsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_schedula_v1_appointments_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *MergeAppointmentsRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *MergeAppointmentsRequest) GetPrimaryId() string {
+	if x != nil {
+		return x.PrimaryId
+	}
+	return ""
+}
+
+func (x *MergeAppointmentsRequest) GetDuplicateIds() []string {
+	if x != nil {
+		return x.DuplicateIds
+	}
+	return nil
+}
+
+func (x *MergeAppointmentsRequest) GetCoverDuplicates() bool {
+	if x != nil {
+		return x.CoverDuplicates
+	}
+	return false
+}
+
+type MergeAppointmentsResponse struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Appointment       *Appointment           `protobuf:"bytes,1,opt,name=appointment,proto3" json:"appointment,omitempty"`
+	DeletedIds        []string               `protobuf:"bytes,2,rep,name=deleted_ids,json=deletedIds,proto3" json:"deleted_ids,omitempty"`
+	LinksMoved        uint32                 `protobuf:"varint,3,opt,name=links_moved,json=linksMoved,proto3" json:"links_moved,omitempty"`
+	SyncMappingsMoved uint32                 `protobuf:"varint,4,opt,name=sync_mappings_moved,json=syncMappingsMoved,proto3" json:"sync_mappings_moved,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *MergeAppointmentsResponse) Reset() {
+	*x = MergeAppointmentsResponse{}
+	mi := &file_proto_schedula_v1_appointments_proto_msgTypes[62]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MergeAppointmentsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MergeAppointmentsResponse) ProtoMessage() {}
+
+func (x *MergeAppointmentsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_schedula_v1_appointments_proto_msgTypes[62]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MergeAppointmentsResponse.ProtoReflect.Descriptor instead.
+func (*MergeAppointmentsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_schedula_v1_appointments_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *MergeAppointmentsResponse) GetAppointment() *Appointment {
+	if x != nil {
+		return x.Appointment
+	}
+	return nil
+}
+
+func (x *MergeAppointmentsResponse) GetDeletedIds() []string {
+	if x != nil {
+		return x.DeletedIds
+	}
+	return nil
+}
+
+func (x *MergeAppointmentsResponse) GetLinksMoved() uint32 {
+	if x != nil {
+		return x.LinksMoved
+	}
+	return 0
+}
+
+func (x *MergeAppointmentsResponse) GetSyncMappingsMoved() uint32 {
+	if x != nil {
+		return x.SyncMappingsMoved
+	}
+	return 0
+}
+
+type BusyInterval struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	StartTime     *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	EndTime       *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BusyInterval) Reset() {
+	*x = BusyInterval{}
+	mi := &file_proto_schedula_v1_appointments_proto_msgTypes[63]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BusyInterval) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BusyInterval) ProtoMessage() {}
+
+func (x *BusyInterval) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_schedula_v1_appointments_proto_msgTypes[63]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BusyInterval.ProtoReflect.Descriptor instead.
+func (*BusyInterval) Descriptor() ([]byte, []int) {
+	return file_proto_schedula_v1_appointments_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *BusyInterval) GetStartTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartTime
+	}
+	return nil
+}
+
+func (x *BusyInterval) GetEndTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndTime
+	}
+	return nil
+}
+
+type UserFreeBusy struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Busy          []*BusyInterval        `protobuf:"bytes,2,rep,name=busy,proto3" json:"busy,omitempty"`
+	ErrorCode     string                 `protobuf:"bytes,3,opt,name=error_code,json=errorCode,proto3" json:"error_code,omitempty"`
+	ErrorMessage  string                 `protobuf:"bytes,4,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UserFreeBusy) Reset() {
+	*x = UserFreeBusy{}
+	mi := &file_proto_schedula_v1_appointments_proto_msgTypes[64]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserFreeBusy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserFreeBusy) ProtoMessage() {}
+
+func (x *UserFreeBusy) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_schedula_v1_appointments_proto_msgTypes[64]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserFreeBusy.ProtoReflect.Descriptor instead.
+func (*UserFreeBusy) Descriptor() ([]byte, []int) {
+	return file_proto_schedula_v1_appointments_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *UserFreeBusy) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *UserFreeBusy) GetBusy() []*BusyInterval {
+	if x != nil {
+		return x.Busy
+	}
+	return nil
+}
+
+func (x *UserFreeBusy) GetErrorCode() string {
+	if x != nil {
+		return x.ErrorCode
+	}
+	return ""
+}
+
+func (x *UserFreeBusy) GetErrorMessage() string {
+	if x != nil {
+		return x.ErrorMessage
+	}
+	return ""
+}
+
+type BatchGetFreeBusyRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserIds       []string               `protobuf:"bytes,1,rep,name=user_ids,json=userIds,proto3" json:"user_ids,omitempty"`
+	WindowStart   *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=window_start,json=windowStart,proto3" json:"window_start,omitempty"`
+	WindowEnd     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=window_end,json=windowEnd,proto3" json:"window_end,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchGetFreeBusyRequest) Reset() {
+	*x = BatchGetFreeBusyRequest{}
+	mi := &file_proto_schedula_v1_appointments_proto_msgTypes[65]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchGetFreeBusyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchGetFreeBusyRequest) ProtoMessage() {}
+
+func (x *BatchGetFreeBusyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_schedula_v1_appointments_proto_msgTypes[65]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchGetFreeBusyRequest.ProtoReflect.Descriptor instead.
+func (*BatchGetFreeBusyRequest) Descriptor() ([]byte, []int) {
+	return file_proto_schedula_v1_appointments_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *BatchGetFreeBusyRequest) GetUserIds() []string {
+	if x != nil {
+		return x.UserIds
+	}
+	return nil
+}
+
+func (x *BatchGetFreeBusyRequest) GetWindowStart() *timestamppb.Timestamp {
+	if x != nil {
+		return x.WindowStart
+	}
+	return nil
+}
+
+func (x *BatchGetFreeBusyRequest) GetWindowEnd() *timestamppb.Timestamp {
+	if x != nil {
+		return x.WindowEnd
+	}
+	return nil
+}
+
+type BatchGetFreeBusyResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Results       []*UserFreeBusy        `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchGetFreeBusyResponse) Reset() {
+	*x = BatchGetFreeBusyResponse{}
+	mi := &file_proto_schedula_v1_appointments_proto_msgTypes[66]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchGetFreeBusyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchGetFreeBusyResponse) ProtoMessage() {}
+
+func (x *BatchGetFreeBusyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_schedula_v1_appointments_proto_msgTypes[66]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchGetFreeBusyResponse.ProtoReflect.Descriptor instead.
+func (*BatchGetFreeBusyResponse) Descriptor() ([]byte, []int) {
+	return file_proto_schedula_v1_appointments_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *BatchGetFreeBusyResponse) GetResults() []*UserFreeBusy {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+type TimeRange struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	StartTime     *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	EndTime       *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TimeRange) Reset() {
+	*x = TimeRange{}
+	mi := &file_proto_schedula_v1_appointments_proto_msgTypes[67]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TimeRange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TimeRange) ProtoMessage() {}
+
+func (x *TimeRange) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_schedula_v1_appointments_proto_msgTypes[67]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TimeRange.ProtoReflect.Descriptor instead.
+func (*TimeRange) Descriptor() ([]byte, []int) {
+	return file_proto_schedula_v1_appointments_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *TimeRange) GetStartTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartTime
+	}
+	return nil
+}
+
+func (x *TimeRange) GetEndTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndTime
+	}
+	return nil
+}
+
+type WorkingHours struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TimeZone      string                 `protobuf:"bytes,1,opt,name=time_zone,json=timeZone,proto3" json:"time_zone,omitempty"`
+	StartMinute   uint32                 `protobuf:"varint,2,opt,name=start_minute,json=startMinute,proto3" json:"start_minute,omitempty"`
+	EndMinute     uint32                 `protobuf:"varint,3,opt,name=end_minute,json=endMinute,proto3" json:"end_minute,omitempty"`
+	Weekdays      []Weekday              `protobuf:"varint,4,rep,packed,name=weekdays,proto3,enum=schedula.v1.Weekday" json:"weekdays,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WorkingHours) Reset() {
+	*x = WorkingHours{}
+	mi := &file_proto_schedula_v1_appointments_proto_msgTypes[68]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WorkingHours) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WorkingHours) ProtoMessage() {}
+
+func (x *WorkingHours) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_schedula_v1_appointments_proto_msgTypes[68]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WorkingHours.ProtoReflect.Descriptor instead.
+func (*WorkingHours) Descriptor() ([]byte, []int) {
+	return file_proto_schedula_v1_appointments_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *WorkingHours) GetTimeZone() string {
+	if x != nil {
+		return x.TimeZone
+	}
+	return ""
+}
+
+func (x *WorkingHours) GetStartMinute() uint32 {
+	if x != nil {
+		return x.StartMinute
+	}
+	return 0
+}
+
+func (x *WorkingHours) GetEndMinute() uint32 {
+	if x != nil {
+		return x.EndMinute
+	}
+	return 0
+}
+
+func (x *WorkingHours) GetWeekdays() []Weekday {
+	if x != nil {
+		return x.Weekdays
+	}
+	return nil
+}
+
+type MeetingAttendee struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	WorkingHours  *WorkingHours          `protobuf:"bytes,2,opt,name=working_hours,json=workingHours,proto3" json:"working_hours,omitempty"`
+	Preferred     []*TimeRange           `protobuf:"bytes,3,rep,name=preferred,proto3" json:"preferred,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MeetingAttendee) Reset() {
+	*x = MeetingAttendee{}
+	mi := &file_proto_schedula_v1_appointments_proto_msgTypes[69]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MeetingAttendee) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MeetingAttendee) ProtoMessage() {}
+
+func (x *MeetingAttendee) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_schedula_v1_appointments_proto_msgTypes[69]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MeetingAttendee.ProtoReflect.Descriptor instead.
+func (*MeetingAttendee) Descriptor() ([]byte, []int) {
+	return file_proto_schedula_v1_appointments_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *MeetingAttendee) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *MeetingAttendee) GetWorkingHours() *WorkingHours {
+	if x != nil {
+		return x.WorkingHours
+	}
+	return nil
+}
+
+func (x *MeetingAttendee) GetPreferred() []*TimeRange {
+	if x != nil {
+		return x.Preferred
+	}
+	return nil
+}
+
+type SuggestMeetingTimesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Attendees     []*MeetingAttendee     `protobuf:"bytes,1,rep,name=attendees,proto3" json:"attendees,omitempty"`
+	Duration      *durationpb.Duration   `protobuf:"bytes,2,opt,name=duration,proto3" json:"duration,omitempty"`
+	WindowStart   *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=window_start,json=windowStart,proto3" json:"window_start,omitempty"`
+	WindowEnd     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=window_end,json=windowEnd,proto3" json:"window_end,omitempty"`
+	Step          *durationpb.Duration   `protobuf:"bytes,5,opt,name=step,proto3" json:"step,omitempty"`
+	MaxResults    uint32                 `protobuf:"varint,6,opt,name=max_results,json=maxResults,proto3" json:"max_results,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SuggestMeetingTimesRequest) Reset() {
+	*x = SuggestMeetingTimesRequest{}
+	mi := &file_proto_schedula_v1_appointments_proto_msgTypes[70]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SuggestMeetingTimesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SuggestMeetingTimesRequest) ProtoMessage() {}
+
+func (x *SuggestMeetingTimesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_schedula_v1_appointments_proto_msgTypes[70]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SuggestMeetingTimesRequest.ProtoReflect.Descriptor instead.
+func (*SuggestMeetingTimesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_schedula_v1_appointments_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *SuggestMeetingTimesRequest) GetAttendees() []*MeetingAttendee {
+	if x != nil {
+		return x.Attendees
+	}
+	return nil
+}
+
+func (x *SuggestMeetingTimesRequest) GetDuration() *durationpb.Duration {
+	if x != nil {
+		return x.Duration
+	}
+	return nil
+}
+
+func (x *SuggestMeetingTimesRequest) GetWindowStart() *timestamppb.Timestamp {
+	if x != nil {
+		return x.WindowStart
+	}
+	return nil
+}
+
+func (x *SuggestMeetingTimesRequest) GetWindowEnd() *timestamppb.Timestamp {
+	if x != nil {
+		return x.WindowEnd
+	}
+	return nil
+}
+
+func (x *SuggestMeetingTimesRequest) GetStep() *durationpb.Duration {
+	if x != nil {
+		return x.Step
+	}
+	return nil
+}
+
+func (x *SuggestMeetingTimesRequest) GetMaxResults() uint32 {
+	if x != nil {
+		return x.MaxResults
+	}
+	return 0
+}
+
+type MeetingSuggestion struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	StartTime           *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	EndTime             *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	Score               float64                `protobuf:"fixed64,3,opt,name=score,proto3" json:"score,omitempty"`
+	OutsideWorkingHours []string               `protobuf:"bytes,4,rep,name=outside_working_hours,json=outsideWorkingHours,proto3" json:"outside_working_hours,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *MeetingSuggestion) Reset() {
+	*x = MeetingSuggestion{}
+	mi := &file_proto_schedula_v1_appointments_proto_msgTypes[71]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MeetingSuggestion) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MeetingSuggestion) ProtoMessage() {}
+
+func (x *MeetingSuggestion) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_schedula_v1_appointments_proto_msgTypes[71]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MeetingSuggestion.ProtoReflect.Descriptor instead.
+func (*MeetingSuggestion) Descriptor() ([]byte, []int) {
+	return file_proto_schedula_v1_appointments_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *MeetingSuggestion) GetStartTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartTime
+	}
+	return nil
+}
+
+func (x *MeetingSuggestion) GetEndTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndTime
+	}
+	return nil
+}
+
+func (x *MeetingSuggestion) GetScore() float64 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+func (x *MeetingSuggestion) GetOutsideWorkingHours() []string {
+	if x != nil {
+		return x.OutsideWorkingHours
+	}
+	return nil
+}
+
+type SuggestMeetingTimesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Suggestions   []*MeetingSuggestion   `protobuf:"bytes,1,rep,name=suggestions,proto3" json:"suggestions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SuggestMeetingTimesResponse) Reset() {
+	*x = SuggestMeetingTimesResponse{}
+	mi := &file_proto_schedula_v1_appointments_proto_msgTypes[72]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SuggestMeetingTimesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SuggestMeetingTimesResponse) ProtoMessage() {}
+
+func (x *SuggestMeetingTimesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_schedula_v1_appointments_proto_msgTypes[72]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SuggestMeetingTimesResponse.ProtoReflect.Descriptor instead.
+func (*SuggestMeetingTimesResponse) Descriptor() ([]byte, []int) {
+	return file_proto_schedula_v1_appointments_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *SuggestMeetingTimesResponse) GetSuggestions() []*MeetingSuggestion {
+	if x != nil {
+		return x.Suggestions
+	}
+	return nil
+}
+
+type SimulatedStaff struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Label         string                 `protobuf:"bytes,1,opt,name=label,proto3" json:"label,omitempty"`
+	WorkingHours  *WorkingHours          `protobuf:"bytes,2,opt,name=working_hours,json=workingHours,proto3" json:"working_hours,omitempty"`
+	Breaks        []*DailyBreak          `protobuf:"bytes,3,rep,name=breaks,proto3" json:"breaks,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SimulatedStaff) Reset() {
+	*x = SimulatedStaff{}
+	mi := &file_proto_schedula_v1_appointments_proto_msgTypes[73]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SimulatedStaff) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SimulatedStaff) ProtoMessage() {}
+
+func (x *SimulatedStaff) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_schedula_v1_appointments_proto_msgTypes[73]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SimulatedStaff.ProtoReflect.Descriptor instead.
+func (*SimulatedStaff) Descriptor() ([]byte, []int) {
+	return file_proto_schedula_v1_appointments_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *SimulatedStaff) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+func (x *SimulatedStaff) GetWorkingHours() *WorkingHours {
+	if x != nil {
+		return x.WorkingHours
+	}
+	return nil
+}
+
+func (x *SimulatedStaff) GetBreaks() []*DailyBreak {
+	if x != nil {
+		return x.Breaks
+	}
+	return nil
+}
+
+type BookingPattern struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Label          string                 `protobuf:"bytes,1,opt,name=label,proto3" json:"label,omitempty"`
+	Duration       *durationpb.Duration   `protobuf:"bytes,2,opt,name=duration,proto3" json:"duration,omitempty"`
+	BookingsPerDay uint32                 `protobuf:"varint,3,opt,name=bookings_per_day,json=bookingsPerDay,proto3" json:"bookings_per_day,omitempty"`
+	Weekdays       []Weekday              `protobuf:"varint,4,rep,packed,name=weekdays,proto3,enum=schedula.v1.Weekday" json:"weekdays,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *BookingPattern) Reset() {
+	*x = BookingPattern{}
+	mi := &file_proto_schedula_v1_appointments_proto_msgTypes[74]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BookingPattern) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BookingPattern) ProtoMessage() {}
+
+func (x *BookingPattern) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_schedula_v1_appointments_proto_msgTypes[74]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BookingPattern.ProtoReflect.Descriptor instead.
+func (*BookingPattern) Descriptor() ([]byte, []int) {
+	return file_proto_schedula_v1_appointments_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *BookingPattern) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+func (x *BookingPattern) GetDuration() *durationpb.Duration {
+	if x != nil {
+		return x.Duration
+	}
+	return nil
+}
+
+func (x *BookingPattern) GetBookingsPerDay() uint32 {
+	if x != nil {
+		return x.BookingsPerDay
+	}
+	return 0
+}
+
+func (x *BookingPattern) GetWeekdays() []Weekday {
+	if x != nil {
+		return x.Weekdays
+	}
+	return nil
+}
+
+type SimulateScheduleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Staff         []*SimulatedStaff      `protobuf:"bytes,1,rep,name=staff,proto3" json:"staff,omitempty"`
+	Patterns      []*BookingPattern      `protobuf:"bytes,2,rep,name=patterns,proto3" json:"patterns,omitempty"`
+	WindowStart   *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=window_start,json=windowStart,proto3" json:"window_start,omitempty"`
+	WindowEnd     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=window_end,json=windowEnd,proto3" json:"window_end,omitempty"`
+	TimeZone      string                 `protobuf:"bytes,5,opt,name=time_zone,json=timeZone,proto3" json:"time_zone,omitempty"`
+	Step          *durationpb.Duration   `protobuf:"bytes,6,opt,name=step,proto3" json:"step,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SimulateScheduleRequest) Reset() {
+	*x = SimulateScheduleRequest{}
+	mi := &file_proto_schedula_v1_appointments_proto_msgTypes[75]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SimulateScheduleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SimulateScheduleRequest) ProtoMessage() {}
+
+func (x *SimulateScheduleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_schedula_v1_appointments_proto_msgTypes[75]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SimulateScheduleRequest.ProtoReflect.Descriptor instead.
+func (*SimulateScheduleRequest) Descriptor() ([]byte, []int) {
+	return file_proto_schedula_v1_appointments_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *SimulateScheduleRequest) GetStaff() []*SimulatedStaff {
+	if x != nil {
+		return x.Staff
+	}
+	return nil
+}
+
+func (x *SimulateScheduleRequest) GetPatterns() []*BookingPattern {
+	if x != nil {
+		return x.Patterns
+	}
+	return nil
+}
+
+func (x *SimulateScheduleRequest) GetWindowStart() *timestamppb.Timestamp {
+	if x != nil {
+		return x.WindowStart
+	}
+	return nil
+}
+
+func (x *SimulateScheduleRequest) GetWindowEnd() *timestamppb.Timestamp {
+	if x != nil {
+		return x.WindowEnd
+	}
+	return nil
+}
+
+func (x *SimulateScheduleRequest) GetTimeZone() string {
+	if x != nil {
+		return x.TimeZone
+	}
+	return ""
+}
+
+func (x *SimulateScheduleRequest) GetStep() *durationpb.Duration {
+	if x != nil {
+		return x.Step
+	}
+	return nil
+}
+
+type ScheduleUtilization struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Capacity      *durationpb.Duration   `protobuf:"bytes,1,opt,name=capacity,proto3" json:"capacity,omitempty"`
+	Booked        *durationpb.Duration   `protobuf:"bytes,2,opt,name=booked,proto3" json:"booked,omitempty"`
+	Utilization   float64                `protobuf:"fixed64,3,opt,name=utilization,proto3" json:"utilization,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ScheduleUtilization) Reset() {
+	*x = ScheduleUtilization{}
+	mi := &file_proto_schedula_v1_appointments_proto_msgTypes[76]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ScheduleUtilization) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScheduleUtilization) ProtoMessage() {}
+
+func (x *ScheduleUtilization) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_schedula_v1_appointments_proto_msgTypes[76]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScheduleUtilization.ProtoReflect.Descriptor instead.
+func (*ScheduleUtilization) Descriptor() ([]byte, []int) {
+	return file_proto_schedula_v1_appointments_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *ScheduleUtilization) GetCapacity() *durationpb.Duration {
+	if x != nil {
+		return x.Capacity
+	}
+	return nil
+}
+
+func (x *ScheduleUtilization) GetBooked() *durationpb.Duration {
+	if x != nil {
+		return x.Booked
+	}
+	return nil
+}
+
+func (x *ScheduleUtilization) GetUtilization() float64 {
+	if x != nil {
+		return x.Utilization
+	}
+	return 0
+}
+
+type SimulatedDay struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Date          string                 `protobuf:"bytes,1,opt,name=date,proto3" json:"date,omitempty"`
+	Utilization   *ScheduleUtilization   `protobuf:"bytes,2,opt,name=utilization,proto3" json:"utilization,omitempty"`
+	Requested     uint32                 `protobuf:"varint,3,opt,name=requested,proto3" json:"requested,omitempty"`
+	Unbooked      uint32                 `protobuf:"varint,4,opt,name=unbooked,proto3" json:"unbooked,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SimulatedDay) Reset() {
+	*x = SimulatedDay{}
+	mi := &file_proto_schedula_v1_appointments_proto_msgTypes[77]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SimulatedDay) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SimulatedDay) ProtoMessage() {}
+
+func (x *SimulatedDay) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_schedula_v1_appointments_proto_msgTypes[77]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SimulatedDay.ProtoReflect.Descriptor instead.
+func (*SimulatedDay) Descriptor() ([]byte, []int) {
+	return file_proto_schedula_v1_appointments_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *SimulatedDay) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+func (x *SimulatedDay) GetUtilization() *ScheduleUtilization {
+	if x != nil {
+		return x.Utilization
+	}
+	return nil
+}
+
+func (x *SimulatedDay) GetRequested() uint32 {
+	if x != nil {
+		return x.Requested
+	}
+	return 0
+}
+
+func (x *SimulatedDay) GetUnbooked() uint32 {
+	if x != nil {
+		return x.Unbooked
+	}
+	return 0
+}
+
+type SimulatedStaffUtilization struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Label         string                 `protobuf:"bytes,1,opt,name=label,proto3" json:"label,omitempty"`
+	Utilization   *ScheduleUtilization   `protobuf:"bytes,2,opt,name=utilization,proto3" json:"utilization,omitempty"`
+	Bookings      uint32                 `protobuf:"varint,3,opt,name=bookings,proto3" json:"bookings,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SimulatedStaffUtilization) Reset() {
+	*x = SimulatedStaffUtilization{}
+	mi := &file_proto_schedula_v1_appointments_proto_msgTypes[78]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SimulatedStaffUtilization) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SimulatedStaffUtilization) ProtoMessage() {}
+
+func (x *SimulatedStaffUtilization) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_schedula_v1_appointments_proto_msgTypes[78]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SimulatedStaffUtilization.ProtoReflect.Descriptor instead.
+func (*SimulatedStaffUtilization) Descriptor() ([]byte, []int) {
+	return file_proto_schedula_v1_appointments_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *SimulatedStaffUtilization) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+func (x *SimulatedStaffUtilization) GetUtilization() *ScheduleUtilization {
+	if x != nil {
+		return x.Utilization
+	}
+	return nil
+}
+
+func (x *SimulatedStaffUtilization) GetBookings() uint32 {
+	if x != nil {
+		return x.Bookings
+	}
+	return 0
+}
+
+type BookingPatternOutcome struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Label         string                 `protobuf:"bytes,1,opt,name=label,proto3" json:"label,omitempty"`
+	Requested     uint32                 `protobuf:"varint,2,opt,name=requested,proto3" json:"requested,omitempty"`
+	Unbooked      uint32                 `protobuf:"varint,3,opt,name=unbooked,proto3" json:"unbooked,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BookingPatternOutcome) Reset() {
+	*x = BookingPatternOutcome{}
+	mi := &file_proto_schedula_v1_appointments_proto_msgTypes[79]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BookingPatternOutcome) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BookingPatternOutcome) ProtoMessage() {}
+
+func (x *BookingPatternOutcome) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_schedula_v1_appointments_proto_msgTypes[79]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BookingPatternOutcome.ProtoReflect.Descriptor instead.
+func (*BookingPatternOutcome) Descriptor() ([]byte, []int) {
+	return file_proto_schedula_v1_appointments_proto_rawDescGZIP(), []int{79}
+}
+
+func (x *BookingPatternOutcome) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+func (x *BookingPatternOutcome) GetRequested() uint32 {
+	if x != nil {
+		return x.Requested
+	}
+	return 0
+}
+
+func (x *BookingPatternOutcome) GetUnbooked() uint32 {
+	if x != nil {
+		return x.Unbooked
+	}
+	return 0
+}
+
+type SimulateScheduleResponse struct {
+	state         protoimpl.MessageState       `protogen:"open.v1"`
+	Utilization   *ScheduleUtilization         `protobuf:"bytes,1,opt,name=utilization,proto3" json:"utilization,omitempty"`
+	Requested     uint32                       `protobuf:"varint,2,opt,name=requested,proto3" json:"requested,omitempty"`
+	Unbooked      uint32                       `protobuf:"varint,3,opt,name=unbooked,proto3" json:"unbooked,omitempty"`
+	Days          []*SimulatedDay              `protobuf:"bytes,4,rep,name=days,proto3" json:"days,omitempty"`
+	Staff         []*SimulatedStaffUtilization `protobuf:"bytes,5,rep,name=staff,proto3" json:"staff,omitempty"`
+	Patterns      []*BookingPatternOutcome     `protobuf:"bytes,6,rep,name=patterns,proto3" json:"patterns,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SimulateScheduleResponse) Reset() {
+	*x = SimulateScheduleResponse{}
+	mi := &file_proto_schedula_v1_appointments_proto_msgTypes[80]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SimulateScheduleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SimulateScheduleResponse) ProtoMessage() {}
+
+func (x *SimulateScheduleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_schedula_v1_appointments_proto_msgTypes[80]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SimulateScheduleResponse.ProtoReflect.Descriptor instead.
+func (*SimulateScheduleResponse) Descriptor() ([]byte, []int) {
+	return file_proto_schedula_v1_appointments_proto_rawDescGZIP(), []int{80}
+}
+
+func (x *SimulateScheduleResponse) GetUtilization() *ScheduleUtilization {
+	if x != nil {
+		return x.Utilization
+	}
+	return nil
+}
+
+func (x *SimulateScheduleResponse) GetRequested() uint32 {
+	if x != nil {
+		return x.Requested
+	}
+	return 0
+}
+
+func (x *SimulateScheduleResponse) GetUnbooked() uint32 {
+	if x != nil {
+		return x.Unbooked
+	}
+	return 0
+}
+
+func (x *SimulateScheduleResponse) GetDays() []*SimulatedDay {
+	if x != nil {
+		return x.Days
+	}
+	return nil
+}
+
+func (x *SimulateScheduleResponse) GetStaff() []*SimulatedStaffUtilization {
+	if x != nil {
+		return x.Staff
+	}
+	return nil
+}
+
+func (x *SimulateScheduleResponse) GetPatterns() []*BookingPatternOutcome {
+	if x != nil {
+		return x.Patterns
+	}
+	return nil
+}
+
+type SeriesFinding struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Kind            SeriesFindingKind      `protobuf:"varint,1,opt,name=kind,proto3,enum=schedula.v1.SeriesFindingKind" json:"kind,omitempty"`
+	ExceptionId     string                 `protobuf:"bytes,2,opt,name=exception_id,json=exceptionId,proto3" json:"exception_id,omitempty"`
+	OccurrenceStart *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=occurrence_start,json=occurrenceStart,proto3" json:"occurrence_start,omitempty"`
+	Repaired        bool                   `protobuf:"varint,4,opt,name=repaired,proto3" json:"repaired,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *SeriesFinding) Reset() {
+	*x = SeriesFinding{}
+	mi := &file_proto_schedula_v1_appointments_proto_msgTypes[81]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SeriesFinding) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SeriesFinding) ProtoMessage() {}
+
+func (x *SeriesFinding) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_schedula_v1_appointments_proto_msgTypes[81]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SeriesFinding.ProtoReflect.Descriptor instead.
+func (*SeriesFinding) Descriptor() ([]byte, []int) {
+	return file_proto_schedula_v1_appointments_proto_rawDescGZIP(), []int{81}
+}
+
+func (x *SeriesFinding) GetKind() SeriesFindingKind {
+	if x != nil {
+		return x.Kind
+	}
+	return SeriesFindingKind_SERIES_FINDING_KIND_UNSPECIFIED
+}
+
+func (x *SeriesFinding) GetExceptionId() string {
+	if x != nil {
+		return x.ExceptionId
+	}
+	return ""
+}
+
+func (x *SeriesFinding) GetOccurrenceStart() *timestamppb.Timestamp {
+	if x != nil {
+		return x.OccurrenceStart
+	}
+	return nil
+}
+
+func (x *SeriesFinding) GetRepaired() bool {
+	if x != nil {
+		return x.Repaired
+	}
+	return false
+}
+
+type RepairRecurringSeriesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	SeriesId      string                 `protobuf:"bytes,2,opt,name=series_id,json=seriesId,proto3" json:"series_id,omitempty"`
+	Apply         bool                   `protobuf:"varint,3,opt,name=apply,proto3" json:"apply,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RepairRecurringSeriesRequest) Reset() {
+	*x = RepairRecurringSeriesRequest{}
+	mi := &file_proto_schedula_v1_appointments_proto_msgTypes[82]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RepairRecurringSeriesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RepairRecurringSeriesRequest) ProtoMessage() {}
+
+func (x *RepairRecurringSeriesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_schedula_v1_appointments_proto_msgTypes[82]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RepairRecurringSeriesRequest.ProtoReflect.Descriptor instead.
+func (*RepairRecurringSeriesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_schedula_v1_appointments_proto_rawDescGZIP(), []int{82}
+}
+
+func (x *RepairRecurringSeriesRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *RepairRecurringSeriesRequest) GetSeriesId() string {
+	if x != nil {
+		return x.SeriesId
+	}
+	return ""
+}
+
+func (x *RepairRecurringSeriesRequest) GetApply() bool {
+	if x != nil {
+		return x.Apply
+	}
+	return false
+}
+
+type RepairRecurringSeriesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Findings      []*SeriesFinding       `protobuf:"bytes,1,rep,name=findings,proto3" json:"findings,omitempty"`
+	Repaired      uint32                 `protobuf:"varint,2,opt,name=repaired,proto3" json:"repaired,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RepairRecurringSeriesResponse) Reset() {
+	*x = RepairRecurringSeriesResponse{}
+	mi := &file_proto_schedula_v1_appointments_proto_msgTypes[83]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RepairRecurringSeriesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RepairRecurringSeriesResponse) ProtoMessage() {}
+
+func (x *RepairRecurringSeriesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_schedula_v1_appointments_proto_msgTypes[83]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RepairRecurringSeriesResponse.ProtoReflect.Descriptor instead.
+func (*RepairRecurringSeriesResponse) Descriptor() ([]byte, []int) {
+	return file_proto_schedula_v1_appointments_proto_rawDescGZIP(), []int{83}
+}
+
+func (x *RepairRecurringSeriesResponse) GetFindings() []*SeriesFinding {
+	if x != nil {
+		return x.Findings
+	}
+	return nil
+}
+
+func (x *RepairRecurringSeriesResponse) GetRepaired() uint32 {
+	if x != nil {
+		return x.Repaired
+	}
+	return 0
+}
+
+type CalendarEntry struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AppointmentId string                 `protobuf:"bytes,1,opt,name=appointment_id,json=appointmentId,proto3" json:"appointment_id,omitempty"`
+	SeriesId      string                 `protobuf:"bytes,2,opt,name=series_id,json=seriesId,proto3" json:"series_id,omitempty"`
+	OccurrenceId  string                 `protobuf:"bytes,3,opt,name=occurrence_id,json=occurrenceId,proto3" json:"occurrence_id,omitempty"`
+	Title         string                 `protobuf:"bytes,4,opt,name=title,proto3" json:"title,omitempty"`
+	Source        string                 `protobuf:"bytes,5,opt,name=source,proto3" json:"source,omitempty"`
+	StartTime     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	EndTime       *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	Overridden    bool                   `protobuf:"varint,8,opt,name=overridden,proto3" json:"overridden,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CalendarEntry) Reset() {
+	*x = CalendarEntry{}
+	mi := &file_proto_schedula_v1_appointments_proto_msgTypes[84]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CalendarEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CalendarEntry) ProtoMessage() {}
+
+func (x *CalendarEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_schedula_v1_appointments_proto_msgTypes[84]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CalendarEntry.ProtoReflect.Descriptor instead.
+func (*CalendarEntry) Descriptor() ([]byte, []int) {
+	return file_proto_schedula_v1_appointments_proto_rawDescGZIP(), []int{84}
+}
+
+func (x *CalendarEntry) GetAppointmentId() string {
+	if x != nil {
+		return x.AppointmentId
+	}
+	return ""
+}
+
+func (x *CalendarEntry) GetSeriesId() string {
+	if x != nil {
+		return x.SeriesId
+	}
+	return ""
+}
+
+func (x *CalendarEntry) GetOccurrenceId() string {
+	if x != nil {
+		return x.OccurrenceId
+	}
+	return ""
+}
+
+func (x *CalendarEntry) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *CalendarEntry) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+func (x *CalendarEntry) GetStartTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartTime
+	}
+	return nil
+}
+
+func (x *CalendarEntry) GetEndTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndTime
+	}
+	return nil
+}
+
+func (x *CalendarEntry) GetOverridden() bool {
+	if x != nil {
+		return x.Overridden
+	}
+	return false
+}
+
+type CalendarConflict struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	First         *CalendarEntry         `protobuf:"bytes,1,opt,name=first,proto3" json:"first,omitempty"`
+	Second        *CalendarEntry         `protobuf:"bytes,2,opt,name=second,proto3" json:"second,omitempty"`
+	OverlapStart  *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=overlap_start,json=overlapStart,proto3" json:"overlap_start,omitempty"`
+	OverlapEnd    *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=overlap_end,json=overlapEnd,proto3" json:"overlap_end,omitempty"`
+	Cause         ConflictCause          `protobuf:"varint,5,opt,name=cause,proto3,enum=schedula.v1.ConflictCause" json:"cause,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CalendarConflict) Reset() {
+	*x = CalendarConflict{}
+	mi := &file_proto_schedula_v1_appointments_proto_msgTypes[85]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CalendarConflict) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CalendarConflict) ProtoMessage() {}
+
+func (x *CalendarConflict) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_schedula_v1_appointments_proto_msgTypes[85]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CalendarConflict.ProtoReflect.Descriptor instead.
+func (*CalendarConflict) Descriptor() ([]byte, []int) {
+	return file_proto_schedula_v1_appointments_proto_rawDescGZIP(), []int{85}
+}
+
+func (x *CalendarConflict) GetFirst() *CalendarEntry {
+	if x != nil {
+		return x.First
+	}
+	return nil
+}
+
+func (x *CalendarConflict) GetSecond() *CalendarEntry {
+	if x != nil {
+		return x.Second
+	}
+	return nil
+}
+
+func (x *CalendarConflict) GetOverlapStart() *timestamppb.Timestamp {
+	if x != nil {
+		return x.OverlapStart
+	}
+	return nil
+}
+
+func (x *CalendarConflict) GetOverlapEnd() *timestamppb.Timestamp {
+	if x != nil {
+		return x.OverlapEnd
+	}
+	return nil
+}
+
+func (x *CalendarConflict) GetCause() ConflictCause {
+	if x != nil {
+		return x.Cause
+	}
+	return ConflictCause_CONFLICT_CAUSE_UNSPECIFIED
+}
+
+type AuditCalendarRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	WindowStart   *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=window_start,json=windowStart,proto3" json:"window_start,omitempty"`
+	WindowEnd     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=window_end,json=windowEnd,proto3" json:"window_end,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AuditCalendarRequest) Reset() {
+	*x = AuditCalendarRequest{}
+	mi := &file_proto_schedula_v1_appointments_proto_msgTypes[86]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AuditCalendarRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuditCalendarRequest) ProtoMessage() {}
+
+func (x *AuditCalendarRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_schedula_v1_appointments_proto_msgTypes[86]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuditCalendarRequest.ProtoReflect.Descriptor instead.
+func (*AuditCalendarRequest) Descriptor() ([]byte, []int) {
+	return file_proto_schedula_v1_appointments_proto_rawDescGZIP(), []int{86}
+}
+
+func (x *AuditCalendarRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *AuditCalendarRequest) GetWindowStart() *timestamppb.Timestamp {
+	if x != nil {
+		return x.WindowStart
+	}
+	return nil
+}
+
+func (x *AuditCalendarRequest) GetWindowEnd() *timestamppb.Timestamp {
+	if x != nil {
+		return x.WindowEnd
+	}
+	return nil
+}
+
+type AuditCalendarResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Conflicts      []*CalendarConflict    `protobuf:"bytes,1,rep,name=conflicts,proto3" json:"conflicts,omitempty"`
+	EntriesScanned uint32                 `protobuf:"varint,2,opt,name=entries_scanned,json=entriesScanned,proto3" json:"entries_scanned,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *AuditCalendarResponse) Reset() {
+	*x = AuditCalendarResponse{}
+	mi := &file_proto_schedula_v1_appointments_proto_msgTypes[87]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AuditCalendarResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuditCalendarResponse) ProtoMessage() {}
+
+func (x *AuditCalendarResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_schedula_v1_appointments_proto_msgTypes[87]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuditCalendarResponse.ProtoReflect.Descriptor instead.
+func (*AuditCalendarResponse) Descriptor() ([]byte, []int) {
+	return file_proto_schedula_v1_appointments_proto_rawDescGZIP(), []int{87}
+}
+
+func (x *AuditCalendarResponse) GetConflicts() []*CalendarConflict {
+	if x != nil {
+		return x.Conflicts
+	}
+	return nil
+}
+
+func (x *AuditCalendarResponse) GetEntriesScanned() uint32 {
+	if x != nil {
+		return x.EntriesScanned
+	}
+	return 0
+}
+
+type GetDailyAgendaRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Date          string                 `protobuf:"bytes,2,opt,name=date,proto3" json:"date,omitempty"`
+	TimeZone      string                 `protobuf:"bytes,3,opt,name=time_zone,json=timeZone,proto3" json:"time_zone,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetDailyAgendaRequest) Reset() {
+	*x = GetDailyAgendaRequest{}
+	mi := &file_proto_schedula_v1_appointments_proto_msgTypes[88]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDailyAgendaRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDailyAgendaRequest) ProtoMessage() {}
+
+func (x *GetDailyAgendaRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_schedula_v1_appointments_proto_msgTypes[88]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDailyAgendaRequest.ProtoReflect.Descriptor instead.
+func (*GetDailyAgendaRequest) Descriptor() ([]byte, []int) {
+	return file_proto_schedula_v1_appointments_proto_rawDescGZIP(), []int{88}
+}
+
+func (x *GetDailyAgendaRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *GetDailyAgendaRequest) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+func (x *GetDailyAgendaRequest) GetTimeZone() string {
+	if x != nil {
+		return x.TimeZone
+	}
+	return ""
+}
+
+type AgendaItem struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Entry         *CalendarEntry         `protobuf:"bytes,1,opt,name=entry,proto3" json:"entry,omitempty"`
+	Milestone     bool                   `protobuf:"varint,2,opt,name=milestone,proto3" json:"milestone,omitempty"`
+	GapBefore     *durationpb.Duration   `protobuf:"bytes,3,opt,name=gap_before,json=gapBefore,proto3" json:"gap_before,omitempty"`
+	BackToBack    bool                   `protobuf:"varint,4,opt,name=back_to_back,json=backToBack,proto3" json:"back_to_back,omitempty"`
+	Overlaps      bool                   `protobuf:"varint,5,opt,name=overlaps,proto3" json:"overlaps,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AgendaItem) Reset() {
+	*x = AgendaItem{}
+	mi := &file_proto_schedula_v1_appointments_proto_msgTypes[89]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AgendaItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AgendaItem) ProtoMessage() {}
+
+func (x *AgendaItem) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_schedula_v1_appointments_proto_msgTypes[89]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AgendaItem.ProtoReflect.Descriptor instead.
+func (*AgendaItem) Descriptor() ([]byte, []int) {
+	return file_proto_schedula_v1_appointments_proto_rawDescGZIP(), []int{89}
+}
+
+func (x *AgendaItem) GetEntry() *CalendarEntry {
+	if x != nil {
+		return x.Entry
+	}
+	return nil
+}
+
+func (x *AgendaItem) GetMilestone() bool {
+	if x != nil {
+		return x.Milestone
+	}
+	return false
+}
+
+func (x *AgendaItem) GetGapBefore() *durationpb.Duration {
+	if x != nil {
+		return x.GapBefore
+	}
+	return nil
+}
+
+func (x *AgendaItem) GetBackToBack() bool {
+	if x != nil {
+		return x.BackToBack
+	}
+	return false
+}
+
+func (x *AgendaItem) GetOverlaps() bool {
+	if x != nil {
+		return x.Overlaps
+	}
+	return false
+}
+
+type GetDailyAgendaResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Date          string                 `protobuf:"bytes,1,opt,name=date,proto3" json:"date,omitempty"`
+	TimeZone      string                 `protobuf:"bytes,2,opt,name=time_zone,json=timeZone,proto3" json:"time_zone,omitempty"`
+	Items         []*AgendaItem          `protobuf:"bytes,3,rep,name=items,proto3" json:"items,omitempty"`
+	BusyTime      *durationpb.Duration   `protobuf:"bytes,4,opt,name=busy_time,json=busyTime,proto3" json:"busy_time,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetDailyAgendaResponse) Reset() {
+	*x = GetDailyAgendaResponse{}
+	mi := &file_proto_schedula_v1_appointments_proto_msgTypes[90]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDailyAgendaResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDailyAgendaResponse) ProtoMessage() {}
+
+func (x *GetDailyAgendaResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_schedula_v1_appointments_proto_msgTypes[90]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDailyAgendaResponse.ProtoReflect.Descriptor instead.
+func (*GetDailyAgendaResponse) Descriptor() ([]byte, []int) {
+	return file_proto_schedula_v1_appointments_proto_rawDescGZIP(), []int{90}
+}
+
+func (x *GetDailyAgendaResponse) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+func (x *GetDailyAgendaResponse) GetTimeZone() string {
+	if x != nil {
+		return x.TimeZone
+	}
+	return ""
+}
+
+func (x *GetDailyAgendaResponse) GetItems() []*AgendaItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *GetDailyAgendaResponse) GetBusyTime() *durationpb.Duration {
+	if x != nil {
+		return x.BusyTime
+	}
+	return nil
+}
+
+type GetDaySummariesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	FromDate      string                 `protobuf:"bytes,2,opt,name=from_date,json=fromDate,proto3" json:"from_date,omitempty"`
+	ToDate        string                 `protobuf:"bytes,3,opt,name=to_date,json=toDate,proto3" json:"to_date,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetDaySummariesRequest) Reset() {
+	*x = GetDaySummariesRequest{}
+	mi := &file_proto_schedula_v1_appointments_proto_msgTypes[91]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDaySummariesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDaySummariesRequest) ProtoMessage() {}
+
+func (x *GetDaySummariesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_schedula_v1_appointments_proto_msgTypes[91]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDaySummariesRequest.ProtoReflect.Descriptor instead.
+func (*GetDaySummariesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_schedula_v1_appointments_proto_rawDescGZIP(), []int{91}
+}
+
+func (x *GetDaySummariesRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *GetDaySummariesRequest) GetFromDate() string {
+	if x != nil {
+		return x.FromDate
+	}
+	return ""
+}
+
+func (x *GetDaySummariesRequest) GetToDate() string {
+	if x != nil {
+		return x.ToDate
+	}
+	return ""
+}
+
+type DaySummary struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Date          string                 `protobuf:"bytes,1,opt,name=date,proto3" json:"date,omitempty"`
+	Appointments  uint32                 `protobuf:"varint,2,opt,name=appointments,proto3" json:"appointments,omitempty"`
+	Occurrences   uint32                 `protobuf:"varint,3,opt,name=occurrences,proto3" json:"occurrences,omitempty"`
+	BusyTime      *durationpb.Duration   `protobuf:"bytes,4,opt,name=busy_time,json=busyTime,proto3" json:"busy_time,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DaySummary) Reset() {
+	*x = DaySummary{}
+	mi := &file_proto_schedula_v1_appointments_proto_msgTypes[92]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DaySummary) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DaySummary) ProtoMessage() {}
+
+func (x *DaySummary) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_schedula_v1_appointments_proto_msgTypes[92]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DaySummary.ProtoReflect.Descriptor instead.
+func (*DaySummary) Descriptor() ([]byte, []int) {
+	return file_proto_schedula_v1_appointments_proto_rawDescGZIP(), []int{92}
+}
+
+func (x *DaySummary) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+func (x *DaySummary) GetAppointments() uint32 {
+	if x != nil {
+		return x.Appointments
+	}
+	return 0
+}
+
+func (x *DaySummary) GetOccurrences() uint32 {
+	if x != nil {
+		return x.Occurrences
+	}
+	return 0
+}
+
+func (x *DaySummary) GetBusyTime() *durationpb.Duration {
+	if x != nil {
+		return x.BusyTime
+	}
+	return nil
+}
+
+type GetDaySummariesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TimeZone      string                 `protobuf:"bytes,1,opt,name=time_zone,json=timeZone,proto3" json:"time_zone,omitempty"`
+	Days          []*DaySummary          `protobuf:"bytes,2,rep,name=days,proto3" json:"days,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetDaySummariesResponse) Reset() {
+	*x = GetDaySummariesResponse{}
+	mi := &file_proto_schedula_v1_appointments_proto_msgTypes[93]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDaySummariesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDaySummariesResponse) ProtoMessage() {}
+
+func (x *GetDaySummariesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_schedula_v1_appointments_proto_msgTypes[93]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDaySummariesResponse.ProtoReflect.Descriptor instead.
+func (*GetDaySummariesResponse) Descriptor() ([]byte, []int) {
+	return file_proto_schedula_v1_appointments_proto_rawDescGZIP(), []int{93}
+}
+
+func (x *GetDaySummariesResponse) GetTimeZone() string {
+	if x != nil {
+		return x.TimeZone
+	}
+	return ""
+}
+
+func (x *GetDaySummariesResponse) GetDays() []*DaySummary {
+	if x != nil {
+		return x.Days
+	}
+	return nil
+}
+
+type UpdateSeriesEndRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	SeriesId      string                 `protobuf:"bytes,2,opt,name=series_id,json=seriesId,proto3" json:"series_id,omitempty"`
+	Until         *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=until,proto3" json:"until,omitempty"`
+	Count         uint32                 `protobuf:"varint,4,opt,name=count,proto3" json:"count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateSeriesEndRequest) Reset() {
+	*x = UpdateSeriesEndRequest{}
+	mi := &file_proto_schedula_v1_appointments_proto_msgTypes[94]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateSeriesEndRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateSeriesEndRequest) ProtoMessage() {}
+
+func (x *UpdateSeriesEndRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_schedula_v1_appointments_proto_msgTypes[94]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateSeriesEndRequest.ProtoReflect.Descriptor instead.
+func (*UpdateSeriesEndRequest) Descriptor() ([]byte, []int) {
+	return file_proto_schedula_v1_appointments_proto_rawDescGZIP(), []int{94}
+}
+
+func (x *UpdateSeriesEndRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *UpdateSeriesEndRequest) GetSeriesId() string {
+	if x != nil {
+		return x.SeriesId
+	}
+	return ""
+}
+
+func (x *UpdateSeriesEndRequest) GetUntil() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Until
+	}
+	return nil
+}
+
+func (x *UpdateSeriesEndRequest) GetCount() uint32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type UpdateSeriesEndResponse struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Series            *RecurringSeries       `protobuf:"bytes,1,opt,name=series,proto3" json:"series,omitempty"`
+	RemovedExceptions uint32                 `protobuf:"varint,2,opt,name=removed_exceptions,json=removedExceptions,proto3" json:"removed_exceptions,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *UpdateSeriesEndResponse) Reset() {
+	*x = UpdateSeriesEndResponse{}
+	mi := &file_proto_schedula_v1_appointments_proto_msgTypes[95]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateSeriesEndResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateSeriesEndResponse) ProtoMessage() {}
+
+func (x *UpdateSeriesEndResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_schedula_v1_appointments_proto_msgTypes[95]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateSeriesEndResponse.ProtoReflect.Descriptor instead.
+func (*UpdateSeriesEndResponse) Descriptor() ([]byte, []int) {
+	return file_proto_schedula_v1_appointments_proto_rawDescGZIP(), []int{95}
+}
+
+func (x *UpdateSeriesEndResponse) GetSeries() *RecurringSeries {
+	if x != nil {
+		return x.Series
+	}
+	return nil
+}
+
+func (x *UpdateSeriesEndResponse) GetRemovedExceptions() uint32 {
+	if x != nil {
+		return x.RemovedExceptions
+	}
+	return 0
+}
+
+type ChangeSeriesTimeZoneRequest struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	UserId            string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	SeriesIds         []string               `protobuf:"bytes,2,rep,name=series_ids,json=seriesIds,proto3" json:"series_ids,omitempty"`
+	TimeZone          string                 `protobuf:"bytes,3,opt,name=time_zone,json=timeZone,proto3" json:"time_zone,omitempty"`
+	Keep              TimeZoneKeep           `protobuf:"varint,4,opt,name=keep,proto3,enum=schedula.v1.TimeZoneKeep" json:"keep,omitempty"`
+	Preview           bool                   `protobuf:"varint,5,opt,name=preview,proto3" json:"preview,omitempty"`
+	ConfirmationToken string                 `protobuf:"bytes,6,opt,name=confirmation_token,json=confirmationToken,proto3" json:"confirmation_token,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *ChangeSeriesTimeZoneRequest) Reset() {
+	*x = ChangeSeriesTimeZoneRequest{}
+	mi := &file_proto_schedula_v1_appointments_proto_msgTypes[96]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChangeSeriesTimeZoneRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChangeSeriesTimeZoneRequest) ProtoMessage() {}
+
+func (x *ChangeSeriesTimeZoneRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_schedula_v1_appointments_proto_msgTypes[96]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChangeSeriesTimeZoneRequest.ProtoReflect.Descriptor instead.
+func (*ChangeSeriesTimeZoneRequest) Descriptor() ([]byte, []int) {
+	return file_proto_schedula_v1_appointments_proto_rawDescGZIP(), []int{96}
+}
+
+func (x *ChangeSeriesTimeZoneRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ChangeSeriesTimeZoneRequest) GetSeriesIds() []string {
+	if x != nil {
+		return x.SeriesIds
+	}
+	return nil
+}
+
+func (x *ChangeSeriesTimeZoneRequest) GetTimeZone() string {
+	if x != nil {
+		return x.TimeZone
+	}
+	return ""
+}
+
+func (x *ChangeSeriesTimeZoneRequest) GetKeep() TimeZoneKeep {
+	if x != nil {
+		return x.Keep
+	}
+	return TimeZoneKeep_TIME_ZONE_KEEP_UNSPECIFIED
+}
+
+func (x *ChangeSeriesTimeZoneRequest) GetPreview() bool {
+	if x != nil {
+		return x.Preview
+	}
+	return false
+}
+
+func (x *ChangeSeriesTimeZoneRequest) GetConfirmationToken() string {
+	if x != nil {
+		return x.ConfirmationToken
+	}
+	return ""
+}
+
+type ChangeSeriesTimeZoneResponse struct {
+	state                 protoimpl.MessageState `protogen:"open.v1"`
+	Series                []*RecurringSeries     `protobuf:"bytes,1,rep,name=series,proto3" json:"series,omitempty"`
+	RemovedExceptions     uint32                 `protobuf:"varint,2,opt,name=removed_exceptions,json=removedExceptions,proto3" json:"removed_exceptions,omitempty"`
+	ConfirmationToken     string                 `protobuf:"bytes,3,opt,name=confirmation_token,json=confirmationToken,proto3" json:"confirmation_token,omitempty"`
+	ConfirmationExpiresAt *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=confirmation_expires_at,json=confirmationExpiresAt,proto3" json:"confirmation_expires_at,omitempty"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *ChangeSeriesTimeZoneResponse) Reset() {
+	*x = ChangeSeriesTimeZoneResponse{}
+	mi := &file_proto_schedula_v1_appointments_proto_msgTypes[97]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChangeSeriesTimeZoneResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChangeSeriesTimeZoneResponse) ProtoMessage() {}
+
+func (x *ChangeSeriesTimeZoneResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_schedula_v1_appointments_proto_msgTypes[97]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChangeSeriesTimeZoneResponse.ProtoReflect.Descriptor instead.
+func (*ChangeSeriesTimeZoneResponse) Descriptor() ([]byte, []int) {
+	return file_proto_schedula_v1_appointments_proto_rawDescGZIP(), []int{97}
+}
+
+func (x *ChangeSeriesTimeZoneResponse) GetSeries() []*RecurringSeries {
+	if x != nil {
+		return x.Series
+	}
+	return nil
+}
+
+func (x *ChangeSeriesTimeZoneResponse) GetRemovedExceptions() uint32 {
+	if x != nil {
+		return x.RemovedExceptions
+	}
+	return 0
+}
+
+func (x *ChangeSeriesTimeZoneResponse) GetConfirmationToken() string {
+	if x != nil {
+		return x.ConfirmationToken
+	}
+	return ""
+}
+
+func (x *ChangeSeriesTimeZoneResponse) GetConfirmationExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ConfirmationExpiresAt
+	}
+	return nil
+}
+
+type SkipOccurrencesRequest struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	UserId            string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	SeriesId          string                 `protobuf:"bytes,2,opt,name=series_id,json=seriesId,proto3" json:"series_id,omitempty"`
+	WindowStart       *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=window_start,json=windowStart,proto3" json:"window_start,omitempty"`
+	WindowEnd         *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=window_end,json=windowEnd,proto3" json:"window_end,omitempty"`
+	Weekdays          []Weekday              `protobuf:"varint,5,rep,packed,name=weekdays,proto3,enum=schedula.v1.Weekday" json:"weekdays,omitempty"`
+	Apply             bool                   `protobuf:"varint,6,opt,name=apply,proto3" json:"apply,omitempty"`
+	ConfirmationToken string                 `protobuf:"bytes,7,opt,name=confirmation_token,json=confirmationToken,proto3" json:"confirmation_token,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *SkipOccurrencesRequest) Reset() {
+	*x = SkipOccurrencesRequest{}
+	mi := &file_proto_schedula_v1_appointments_proto_msgTypes[98]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SkipOccurrencesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SkipOccurrencesRequest) ProtoMessage() {}
+
+func (x *SkipOccurrencesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_schedula_v1_appointments_proto_msgTypes[98]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SkipOccurrencesRequest.ProtoReflect.Descriptor instead.
+func (*SkipOccurrencesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_schedula_v1_appointments_proto_rawDescGZIP(), []int{98}
+}
+
+func (x *SkipOccurrencesRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *SkipOccurrencesRequest) GetSeriesId() string {
+	if x != nil {
+		return x.SeriesId
+	}
+	return ""
+}
+
+func (x *SkipOccurrencesRequest) GetWindowStart() *timestamppb.Timestamp {
+	if x != nil {
+		return x.WindowStart
+	}
+	return nil
+}
+
+func (x *SkipOccurrencesRequest) GetWindowEnd() *timestamppb.Timestamp {
+	if x != nil {
+		return x.WindowEnd
+	}
+	return nil
+}
+
+func (x *SkipOccurrencesRequest) GetWeekdays() []Weekday {
+	if x != nil {
+		return x.Weekdays
+	}
+	return nil
+}
+
+func (x *SkipOccurrencesRequest) GetApply() bool {
+	if x != nil {
+		return x.Apply
+	}
+	return false
+}
+
+func (x *SkipOccurrencesRequest) GetConfirmationToken() string {
+	if x != nil {
+		return x.ConfirmationToken
+	}
+	return ""
+}
+
+type SkipOccurrencesResponse struct {
+	state                 protoimpl.MessageState   `protogen:"open.v1"`
+	OccurrenceStarts      []*timestamppb.Timestamp `protobuf:"bytes,1,rep,name=occurrence_starts,json=occurrenceStarts,proto3" json:"occurrence_starts,omitempty"`
+	Skipped               uint32                   `protobuf:"varint,2,opt,name=skipped,proto3" json:"skipped,omitempty"`
+	ConfirmationToken     string                   `protobuf:"bytes,3,opt,name=confirmation_token,json=confirmationToken,proto3" json:"confirmation_token,omitempty"`
+	ConfirmationExpiresAt *timestamppb.Timestamp   `protobuf:"bytes,4,opt,name=confirmation_expires_at,json=confirmationExpiresAt,proto3" json:"confirmation_expires_at,omitempty"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *SkipOccurrencesResponse) Reset() {
+	*x = SkipOccurrencesResponse{}
+	mi := &file_proto_schedula_v1_appointments_proto_msgTypes[99]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SkipOccurrencesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SkipOccurrencesResponse) ProtoMessage() {}
+
+func (x *SkipOccurrencesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_schedula_v1_appointments_proto_msgTypes[99]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SkipOccurrencesResponse.ProtoReflect.Descriptor instead.
+func (*SkipOccurrencesResponse) Descriptor() ([]byte, []int) {
+	return file_proto_schedula_v1_appointments_proto_rawDescGZIP(), []int{99}
+}
+
+func (x *SkipOccurrencesResponse) GetOccurrenceStarts() []*timestamppb.Timestamp {
+	if x != nil {
+		return x.OccurrenceStarts
+	}
+	return nil
+}
+
+func (x *SkipOccurrencesResponse) GetSkipped() uint32 {
+	if x != nil {
+		return x.Skipped
+	}
+	return 0
+}
+
+func (x *SkipOccurrencesResponse) GetConfirmationToken() string {
+	if x != nil {
+		return x.ConfirmationToken
+	}
+	return ""
+}
+
+func (x *SkipOccurrencesResponse) GetConfirmationExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ConfirmationExpiresAt
+	}
+	return nil
+}
+
+type DelegationGrant struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PrincipalId   string                 `protobuf:"bytes,1,opt,name=principal_id,json=principalId,proto3" json:"principal_id,omitempty"`
+	DelegateId    string                 `protobuf:"bytes,2,opt,name=delegate_id,json=delegateId,proto3" json:"delegate_id,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DelegationGrant) Reset() {
+	*x = DelegationGrant{}
+	mi := &file_proto_schedula_v1_appointments_proto_msgTypes[100]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DelegationGrant) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DelegationGrant) ProtoMessage() {}
+
+func (x *DelegationGrant) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_schedula_v1_appointments_proto_msgTypes[100]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DelegationGrant.ProtoReflect.Descriptor instead.
+func (*DelegationGrant) Descriptor() ([]byte, []int) {
+	return file_proto_schedula_v1_appointments_proto_rawDescGZIP(), []int{100}
+}
+
+func (x *DelegationGrant) GetPrincipalId() string {
+	if x != nil {
+		return x.PrincipalId
+	}
+	return ""
+}
+
+func (x *DelegationGrant) GetDelegateId() string {
+	if x != nil {
+		return x.DelegateId
+	}
+	return ""
+}
+
+func (x *DelegationGrant) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type GrantDelegationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PrincipalId   string                 `protobuf:"bytes,1,opt,name=principal_id,json=principalId,proto3" json:"principal_id,omitempty"`
+	DelegateId    string                 `protobuf:"bytes,2,opt,name=delegate_id,json=delegateId,proto3" json:"delegate_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GrantDelegationRequest) Reset() {
+	*x = GrantDelegationRequest{}
+	mi := &file_proto_schedula_v1_appointments_proto_msgTypes[101]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GrantDelegationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GrantDelegationRequest) ProtoMessage() {}
+
+func (x *GrantDelegationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_schedula_v1_appointments_proto_msgTypes[101]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GrantDelegationRequest.ProtoReflect.Descriptor instead.
+func (*GrantDelegationRequest) Descriptor() ([]byte, []int) {
+	return file_proto_schedula_v1_appointments_proto_rawDescGZIP(), []int{101}
+}
+
+func (x *GrantDelegationRequest) GetPrincipalId() string {
+	if x != nil {
+		return x.PrincipalId
+	}
+	return ""
+}
+
+func (x *GrantDelegationRequest) GetDelegateId() string {
+	if x != nil {
+		return x.DelegateId
+	}
+	return ""
+}
+
+type GrantDelegationResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Grant         *DelegationGrant       `protobuf:"bytes,1,opt,name=grant,proto3" json:"grant,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GrantDelegationResponse) Reset() {
+	*x = GrantDelegationResponse{}
+	mi := &file_proto_schedula_v1_appointments_proto_msgTypes[102]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GrantDelegationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GrantDelegationResponse) ProtoMessage() {}
+
+func (x *GrantDelegationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_schedula_v1_appointments_proto_msgTypes[102]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GrantDelegationResponse.ProtoReflect.Descriptor instead.
+func (*GrantDelegationResponse) Descriptor() ([]byte, []int) {
+	return file_proto_schedula_v1_appointments_proto_rawDescGZIP(), []int{102}
+}
+
+func (x *GrantDelegationResponse) GetGrant() *DelegationGrant {
+	if x != nil {
+		return x.Grant
+	}
+	return nil
+}
+
+type RevokeDelegationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PrincipalId   string                 `protobuf:"bytes,1,opt,name=principal_id,json=principalId,proto3" json:"principal_id,omitempty"`
+	DelegateId    string                 `protobuf:"bytes,2,opt,name=delegate_id,json=delegateId,proto3" json:"delegate_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeDelegationRequest) Reset() {
+	*x = RevokeDelegationRequest{}
+	mi := &file_proto_schedula_v1_appointments_proto_msgTypes[103]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeDelegationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeDelegationRequest) ProtoMessage() {}
+
+func (x *RevokeDelegationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_schedula_v1_appointments_proto_msgTypes[103]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeDelegationRequest.ProtoReflect.Descriptor instead.
+func (*RevokeDelegationRequest) Descriptor() ([]byte, []int) {
+	return file_proto_schedula_v1_appointments_proto_rawDescGZIP(), []int{103}
+}
+
+func (x *RevokeDelegationRequest) GetPrincipalId() string {
+	if x != nil {
+		return x.PrincipalId
+	}
+	return ""
+}
+
+func (x *RevokeDelegationRequest) GetDelegateId() string {
+	if x != nil {
+		return x.DelegateId
+	}
+	return ""
+}
+
+type RevokeDelegationResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeDelegationResponse) Reset() {
+	*x = RevokeDelegationResponse{}
+	mi := &file_proto_schedula_v1_appointments_proto_msgTypes[104]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeDelegationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeDelegationResponse) ProtoMessage() {}
+
+func (x *RevokeDelegationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_schedula_v1_appointments_proto_msgTypes[104]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeDelegationResponse.ProtoReflect.Descriptor instead.
+func (*RevokeDelegationResponse) Descriptor() ([]byte, []int) {
+	return file_proto_schedula_v1_appointments_proto_rawDescGZIP(), []int{104}
+}
+
+type ListDelegationsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PrincipalId   string                 `protobuf:"bytes,1,opt,name=principal_id,json=principalId,proto3" json:"principal_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListDelegationsRequest) Reset() {
+	*x = ListDelegationsRequest{}
+	mi := &file_proto_schedula_v1_appointments_proto_msgTypes[105]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDelegationsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDelegationsRequest) ProtoMessage() {}
+
+func (x *ListDelegationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_schedula_v1_appointments_proto_msgTypes[105]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDelegationsRequest.ProtoReflect.Descriptor instead.
+func (*ListDelegationsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_schedula_v1_appointments_proto_rawDescGZIP(), []int{105}
+}
+
+func (x *ListDelegationsRequest) GetPrincipalId() string {
+	if x != nil {
+		return x.PrincipalId
+	}
+	return ""
+}
+
+type ListDelegationsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Grants        []*DelegationGrant     `protobuf:"bytes,1,rep,name=grants,proto3" json:"grants,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListDelegationsResponse) Reset() {
+	*x = ListDelegationsResponse{}
+	mi := &file_proto_schedula_v1_appointments_proto_msgTypes[106]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDelegationsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDelegationsResponse) ProtoMessage() {}
+
+func (x *ListDelegationsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_schedula_v1_appointments_proto_msgTypes[106]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDelegationsResponse.ProtoReflect.Descriptor instead.
+func (*ListDelegationsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_schedula_v1_appointments_proto_rawDescGZIP(), []int{106}
+}
+
+func (x *ListDelegationsResponse) GetGrants() []*DelegationGrant {
+	if x != nil {
+		return x.Grants
+	}
+	return nil
+}
+
+type WatchOccurrencesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	SeriesId      string                 `protobuf:"bytes,2,opt,name=series_id,json=seriesId,proto3" json:"series_id,omitempty"`
+	WindowStart   *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=window_start,json=windowStart,proto3" json:"window_start,omitempty"`
+	WindowEnd     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=window_end,json=windowEnd,proto3" json:"window_end,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WatchOccurrencesRequest) Reset() {
+	*x = WatchOccurrencesRequest{}
+	mi := &file_proto_schedula_v1_appointments_proto_msgTypes[107]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchOccurrencesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchOccurrencesRequest) ProtoMessage() {}
+
+func (x *WatchOccurrencesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_schedula_v1_appointments_proto_msgTypes[107]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchOccurrencesRequest.ProtoReflect.Descriptor instead.
+func (*WatchOccurrencesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_schedula_v1_appointments_proto_rawDescGZIP(), []int{107}
+}
+
+func (x *WatchOccurrencesRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *WatchOccurrencesRequest) GetSeriesId() string {
+	if x != nil {
+		return x.SeriesId
+	}
+	return ""
+}
+
+func (x *WatchOccurrencesRequest) GetWindowStart() *timestamppb.Timestamp {
+	if x != nil {
+		return x.WindowStart
+	}
+	return nil
+}
+
+func (x *WatchOccurrencesRequest) GetWindowEnd() *timestamppb.Timestamp {
+	if x != nil {
+		return x.WindowEnd
+	}
+	return nil
+}
+
+type WatchOccurrencesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Series        *RecurringSeries       `protobuf:"bytes,1,opt,name=series,proto3" json:"series,omitempty"`
+	Occurrences   []*Occurrence          `protobuf:"bytes,2,rep,name=occurrences,proto3" json:"occurrences,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WatchOccurrencesResponse) Reset() {
+	*x = WatchOccurrencesResponse{}
+	mi := &file_proto_schedula_v1_appointments_proto_msgTypes[108]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchOccurrencesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchOccurrencesResponse) ProtoMessage() {}
+
+func (x *WatchOccurrencesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_schedula_v1_appointments_proto_msgTypes[108]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchOccurrencesResponse.ProtoReflect.Descriptor instead.
+func (*WatchOccurrencesResponse) Descriptor() ([]byte, []int) {
+	return file_proto_schedula_v1_appointments_proto_rawDescGZIP(), []int{108}
+}
+
+func (x *WatchOccurrencesResponse) GetSeries() *RecurringSeries {
+	if x != nil {
+		return x.Series
+	}
+	return nil
+}
+
+func (x *WatchOccurrencesResponse) GetOccurrences() []*Occurrence {
+	if x != nil {
+		return x.Occurrences
+	}
+	return nil
+}
+
+type CalendarChange struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EntityType    ChangeEntity           `protobuf:"varint,1,opt,name=entity_type,json=entityType,proto3,enum=schedula.v1.ChangeEntity" json:"entity_type,omitempty"`
+	EntityId      string                 `protobuf:"bytes,2,opt,name=entity_id,json=entityId,proto3" json:"entity_id,omitempty"`
+	Op            ChangeOp               `protobuf:"varint,3,opt,name=op,proto3,enum=schedula.v1.ChangeOp" json:"op,omitempty"`
+	ChangedAt     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=changed_at,json=changedAt,proto3" json:"changed_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CalendarChange) Reset() {
+	*x = CalendarChange{}
+	mi := &file_proto_schedula_v1_appointments_proto_msgTypes[109]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CalendarChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CalendarChange) ProtoMessage() {}
+
+func (x *CalendarChange) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_schedula_v1_appointments_proto_msgTypes[109]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CalendarChange.ProtoReflect.Descriptor instead.
+func (*CalendarChange) Descriptor() ([]byte, []int) {
+	return file_proto_schedula_v1_appointments_proto_rawDescGZIP(), []int{109}
+}
+
+func (x *CalendarChange) GetEntityType() ChangeEntity {
+	if x != nil {
+		return x.EntityType
+	}
+	return ChangeEntity_CHANGE_ENTITY_UNSPECIFIED
+}
+
+func (x *CalendarChange) GetEntityId() string {
+	if x != nil {
+		return x.EntityId
+	}
+	return ""
+}
+
+func (x *CalendarChange) GetOp() ChangeOp {
+	if x != nil {
+		return x.Op
+	}
+	return ChangeOp_CHANGE_OP_UNSPECIFIED
+}
+
+func (x *CalendarChange) GetChangedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ChangedAt
+	}
+	return nil
+}
+
+type ListChangesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	SinceCursor   string                 `protobuf:"bytes,2,opt,name=since_cursor,json=sinceCursor,proto3" json:"since_cursor,omitempty"`
+	PageSize      int32                  `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	Wait          *durationpb.Duration   `protobuf:"bytes,4,opt,name=wait,proto3" json:"wait,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListChangesRequest) Reset() {
+	*x = ListChangesRequest{}
+	mi := &file_proto_schedula_v1_appointments_proto_msgTypes[110]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListChangesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListChangesRequest) ProtoMessage() {}
+
+func (x *ListChangesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_schedula_v1_appointments_proto_msgTypes[110]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListChangesRequest.ProtoReflect.Descriptor instead.
+func (*ListChangesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_schedula_v1_appointments_proto_rawDescGZIP(), []int{110}
+}
+
+func (x *ListChangesRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ListChangesRequest) GetSinceCursor() string {
+	if x != nil {
+		return x.SinceCursor
+	}
+	return ""
+}
+
+func (x *ListChangesRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListChangesRequest) GetWait() *durationpb.Duration {
+	if x != nil {
+		return x.Wait
+	}
+	return nil
+}
+
+type ListChangesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Changes       []*CalendarChange      `protobuf:"bytes,1,rep,name=changes,proto3" json:"changes,omitempty"`
+	NextCursor    string                 `protobuf:"bytes,2,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"`
+	HasMore       bool                   `protobuf:"varint,3,opt,name=has_more,json=hasMore,proto3" json:"has_more,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListChangesResponse) Reset() {
+	*x = ListChangesResponse{}
+	mi := &file_proto_schedula_v1_appointments_proto_msgTypes[111]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListChangesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListChangesResponse) ProtoMessage() {}
+
+func (x *ListChangesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_schedula_v1_appointments_proto_msgTypes[111]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListChangesResponse.ProtoReflect.Descriptor instead.
+func (*ListChangesResponse) Descriptor() ([]byte, []int) {
+	return file_proto_schedula_v1_appointments_proto_rawDescGZIP(), []int{111}
+}
+
+func (x *ListChangesResponse) GetChanges() []*CalendarChange {
+	if x != nil {
+		return x.Changes
+	}
+	return nil
+}
+
+func (x *ListChangesResponse) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
+func (x *ListChangesResponse) GetHasMore() bool {
+	if x != nil {
+		return x.HasMore
+	}
+	return false
+}
+
+type ExportCalendarRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportCalendarRequest) Reset() {
+	*x = ExportCalendarRequest{}
+	mi := &file_proto_schedula_v1_appointments_proto_msgTypes[112]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportCalendarRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportCalendarRequest) ProtoMessage() {}
+
+func (x *ExportCalendarRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_schedula_v1_appointments_proto_msgTypes[112]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportCalendarRequest.ProtoReflect.Descriptor instead.
+func (*ExportCalendarRequest) Descriptor() ([]byte, []int) {
+	return file_proto_schedula_v1_appointments_proto_rawDescGZIP(), []int{112}
+}
+
+func (x *ExportCalendarRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type ExportCalendarResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Bundle        []byte                 `protobuf:"bytes,1,opt,name=bundle,proto3" json:"bundle,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportCalendarResponse) Reset() {
+	*x = ExportCalendarResponse{}
+	mi := &file_proto_schedula_v1_appointments_proto_msgTypes[113]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportCalendarResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportCalendarResponse) ProtoMessage() {}
+
+func (x *ExportCalendarResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_schedula_v1_appointments_proto_msgTypes[113]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportCalendarResponse.ProtoReflect.Descriptor instead.
+func (*ExportCalendarResponse) Descriptor() ([]byte, []int) {
+	return file_proto_schedula_v1_appointments_proto_rawDescGZIP(), []int{113}
+}
+
+func (x *ExportCalendarResponse) GetBundle() []byte {
+	if x != nil {
+		return x.Bundle
+	}
+	return nil
+}
+
+type ImportCalendarRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Bundle        []byte                 `protobuf:"bytes,2,opt,name=bundle,proto3" json:"bundle,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportCalendarRequest) Reset() {
+	*x = ImportCalendarRequest{}
+	mi := &file_proto_schedula_v1_appointments_proto_msgTypes[114]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportCalendarRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportCalendarRequest) ProtoMessage() {}
+
+func (x *ImportCalendarRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_schedula_v1_appointments_proto_msgTypes[114]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportCalendarRequest.ProtoReflect.Descriptor instead.
+func (*ImportCalendarRequest) Descriptor() ([]byte, []int) {
+	return file_proto_schedula_v1_appointments_proto_rawDescGZIP(), []int{114}
+}
+
+func (x *ImportCalendarRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ImportCalendarRequest) GetBundle() []byte {
+	if x != nil {
+		return x.Bundle
+	}
+	return nil
+}
+
+type ImportCalendarResponse struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	AppointmentsImported int32                  `protobuf:"varint,1,opt,name=appointments_imported,json=appointmentsImported,proto3" json:"appointments_imported,omitempty"`
+	SeriesImported       int32                  `protobuf:"varint,2,opt,name=series_imported,json=seriesImported,proto3" json:"series_imported,omitempty"`
+	ExceptionsImported   int32                  `protobuf:"varint,3,opt,name=exceptions_imported,json=exceptionsImported,proto3" json:"exceptions_imported,omitempty"`
+	ContactsImported     int32                  `protobuf:"varint,4,opt,name=contacts_imported,json=contactsImported,proto3" json:"contacts_imported,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *ImportCalendarResponse) Reset() {
+	*x = ImportCalendarResponse{}
+	mi := &file_proto_schedula_v1_appointments_proto_msgTypes[115]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportCalendarResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportCalendarResponse) ProtoMessage() {}
+
+func (x *ImportCalendarResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_schedula_v1_appointments_proto_msgTypes[115]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportCalendarResponse.ProtoReflect.Descriptor instead.
+func (*ImportCalendarResponse) Descriptor() ([]byte, []int) {
+	return file_proto_schedula_v1_appointments_proto_rawDescGZIP(), []int{115}
+}
+
+func (x *ImportCalendarResponse) GetAppointmentsImported() int32 {
+	if x != nil {
+		return x.AppointmentsImported
+	}
+	return 0
+}
+
+func (x *ImportCalendarResponse) GetSeriesImported() int32 {
+	if x != nil {
+		return x.SeriesImported
+	}
+	return 0
+}
+
+func (x *ImportCalendarResponse) GetExceptionsImported() int32 {
+	if x != nil {
+		return x.ExceptionsImported
+	}
+	return 0
+}
+
+func (x *ImportCalendarResponse) GetContactsImported() int32 {
+	if x != nil {
+		return x.ContactsImported
+	}
+	return 0
+}
+
+// CalendarSnapshot is a saved copy of a user's whole calendar, stored as an
+// ExportCalendar bundle of format_version.
+type CalendarSnapshot struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Label         string                 `protobuf:"bytes,3,opt,name=label,proto3" json:"label,omitempty"`
+	FormatVersion int32                  `protobuf:"varint,4,opt,name=format_version,json=formatVersion,proto3" json:"format_version,omitempty"`
+	Checksum      string                 `protobuf:"bytes,5,opt,name=checksum,proto3" json:"checksum,omitempty"`
+	SizeBytes     int32                  `protobuf:"varint,6,opt,name=size_bytes,json=sizeBytes,proto3" json:"size_bytes,omitempty"`
+	Contacts      int32                  `protobuf:"varint,7,opt,name=contacts,proto3" json:"contacts,omitempty"`
+	Appointments  int32                  `protobuf:"varint,8,opt,name=appointments,proto3" json:"appointments,omitempty"`
+	Series        int32                  `protobuf:"varint,9,opt,name=series,proto3" json:"series,omitempty"`
+	Exceptions    int32                  `protobuf:"varint,10,opt,name=exceptions,proto3" json:"exceptions,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CalendarSnapshot) Reset() {
+	*x = CalendarSnapshot{}
+	mi := &file_proto_schedula_v1_appointments_proto_msgTypes[116]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CalendarSnapshot) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CalendarSnapshot) ProtoMessage() {}
+
+func (x *CalendarSnapshot) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_schedula_v1_appointments_proto_msgTypes[116]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CalendarSnapshot.ProtoReflect.Descriptor instead.
+func (*CalendarSnapshot) Descriptor() ([]byte, []int) {
+	return file_proto_schedula_v1_appointments_proto_rawDescGZIP(), []int{116}
+}
+
+func (x *CalendarSnapshot) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *CalendarSnapshot) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *CalendarSnapshot) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+func (x *CalendarSnapshot) GetFormatVersion() int32 {
+	if x != nil {
+		return x.FormatVersion
+	}
+	return 0
+}
+
+func (x *CalendarSnapshot) GetChecksum() string {
+	if x != nil {
+		return x.Checksum
+	}
+	return ""
+}
+
+func (x *CalendarSnapshot) GetSizeBytes() int32 {
+	if x != nil {
+		return x.SizeBytes
+	}
+	return 0
+}
+
+func (x *CalendarSnapshot) GetContacts() int32 {
+	if x != nil {
+		return x.Contacts
+	}
+	return 0
+}
+
+func (x *CalendarSnapshot) GetAppointments() int32 {
+	if x != nil {
+		return x.Appointments
+	}
+	return 0
+}
+
+func (x *CalendarSnapshot) GetSeries() int32 {
+	if x != nil {
+		return x.Series
+	}
+	return 0
+}
+
+func (x *CalendarSnapshot) GetExceptions() int32 {
+	if x != nil {
+		return x.Exceptions
+	}
+	return 0
+}
+
+func (x *CalendarSnapshot) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type CreateCalendarSnapshotRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Label         string                 `protobuf:"bytes,2,opt,name=label,proto3" json:"label,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateCalendarSnapshotRequest) Reset() {
+	*x = CreateCalendarSnapshotRequest{}
+	mi := &file_proto_schedula_v1_appointments_proto_msgTypes[117]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateCalendarSnapshotRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateCalendarSnapshotRequest) ProtoMessage() {}
+
+func (x *CreateCalendarSnapshotRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_schedula_v1_appointments_proto_msgTypes[117]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateCalendarSnapshotRequest.ProtoReflect.Descriptor instead.
+func (*CreateCalendarSnapshotRequest) Descriptor() ([]byte, []int) {
+	return file_proto_schedula_v1_appointments_proto_rawDescGZIP(), []int{117}
+}
+
+func (x *CreateCalendarSnapshotRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *CreateCalendarSnapshotRequest) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+type CreateCalendarSnapshotResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Snapshot      *CalendarSnapshot      `protobuf:"bytes,1,opt,name=snapshot,proto3" json:"snapshot,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateCalendarSnapshotResponse) Reset() {
+	*x = CreateCalendarSnapshotResponse{}
+	mi := &file_proto_schedula_v1_appointments_proto_msgTypes[118]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateCalendarSnapshotResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateCalendarSnapshotResponse) ProtoMessage() {}
+
+func (x *CreateCalendarSnapshotResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_schedula_v1_appointments_proto_msgTypes[118]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateCalendarSnapshotResponse.ProtoReflect.Descriptor instead.
+func (*CreateCalendarSnapshotResponse) Descriptor() ([]byte, []int) {
+	return file_proto_schedula_v1_appointments_proto_rawDescGZIP(), []int{118}
+}
+
+func (x *CreateCalendarSnapshotResponse) GetSnapshot() *CalendarSnapshot {
+	if x != nil {
+		return x.Snapshot
+	}
+	return nil
+}
+
+type ListCalendarSnapshotsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCalendarSnapshotsRequest) Reset() {
+	*x = ListCalendarSnapshotsRequest{}
+	mi := &file_proto_schedula_v1_appointments_proto_msgTypes[119]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCalendarSnapshotsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCalendarSnapshotsRequest) ProtoMessage() {}
+
+func (x *ListCalendarSnapshotsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_schedula_v1_appointments_proto_msgTypes[119]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCalendarSnapshotsRequest.ProtoReflect.Descriptor instead.
+func (*ListCalendarSnapshotsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_schedula_v1_appointments_proto_rawDescGZIP(), []int{119}
+}
+
+func (x *ListCalendarSnapshotsRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type ListCalendarSnapshotsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Newest first.
+	Snapshots     []*CalendarSnapshot `protobuf:"bytes,1,rep,name=snapshots,proto3" json:"snapshots,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCalendarSnapshotsResponse) Reset() {
+	*x = ListCalendarSnapshotsResponse{}
+	mi := &file_proto_schedula_v1_appointments_proto_msgTypes[120]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCalendarSnapshotsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCalendarSnapshotsResponse) ProtoMessage() {}
+
+func (x *ListCalendarSnapshotsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_schedula_v1_appointments_proto_msgTypes[120]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCalendarSnapshotsResponse.ProtoReflect.Descriptor instead.
+func (*ListCalendarSnapshotsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_schedula_v1_appointments_proto_rawDescGZIP(), []int{120}
+}
+
+func (x *ListCalendarSnapshotsResponse) GetSnapshots() []*CalendarSnapshot {
+	if x != nil {
+		return x.Snapshots
+	}
+	return nil
+}
+
+type RestoreCalendarSnapshotRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	SnapshotId    string                 `protobuf:"bytes,2,opt,name=snapshot_id,json=snapshotId,proto3" json:"snapshot_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RestoreCalendarSnapshotRequest) Reset() {
+	*x = RestoreCalendarSnapshotRequest{}
+	mi := &file_proto_schedula_v1_appointments_proto_msgTypes[121]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestoreCalendarSnapshotRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreCalendarSnapshotRequest) ProtoMessage() {}
+
+func (x *RestoreCalendarSnapshotRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_schedula_v1_appointments_proto_msgTypes[121]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreCalendarSnapshotRequest.ProtoReflect.Descriptor instead.
+func (*RestoreCalendarSnapshotRequest) Descriptor() ([]byte, []int) {
+	return file_proto_schedula_v1_appointments_proto_rawDescGZIP(), []int{121}
+}
+
+func (x *RestoreCalendarSnapshotRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *RestoreCalendarSnapshotRequest) GetSnapshotId() string {
+	if x != nil {
+		return x.SnapshotId
+	}
+	return ""
+}
+
+type RestoreCalendarSnapshotResponse struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	Snapshot            *CalendarSnapshot      `protobuf:"bytes,1,opt,name=snapshot,proto3" json:"snapshot,omitempty"`
+	AppointmentsCreated int32                  `protobuf:"varint,2,opt,name=appointments_created,json=appointmentsCreated,proto3" json:"appointments_created,omitempty"`
+	AppointmentsUpdated int32                  `protobuf:"varint,3,opt,name=appointments_updated,json=appointmentsUpdated,proto3" json:"appointments_updated,omitempty"`
+	AppointmentsDeleted int32                  `protobuf:"varint,4,opt,name=appointments_deleted,json=appointmentsDeleted,proto3" json:"appointments_deleted,omitempty"`
+	SeriesCreated       int32                  `protobuf:"varint,5,opt,name=series_created,json=seriesCreated,proto3" json:"series_created,omitempty"`
+	SeriesUpdated       int32                  `protobuf:"varint,6,opt,name=series_updated,json=seriesUpdated,proto3" json:"series_updated,omitempty"`
+	SeriesDeleted       int32                  `protobuf:"varint,7,opt,name=series_deleted,json=seriesDeleted,proto3" json:"series_deleted,omitempty"`
+	ContactsChanged     int32                  `protobuf:"varint,8,opt,name=contacts_changed,json=contactsChanged,proto3" json:"contacts_changed,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *RestoreCalendarSnapshotResponse) Reset() {
+	*x = RestoreCalendarSnapshotResponse{}
+	mi := &file_proto_schedula_v1_appointments_proto_msgTypes[122]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestoreCalendarSnapshotResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreCalendarSnapshotResponse) ProtoMessage() {}
+
+func (x *RestoreCalendarSnapshotResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_schedula_v1_appointments_proto_msgTypes[122]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreCalendarSnapshotResponse.ProtoReflect.Descriptor instead.
+func (*RestoreCalendarSnapshotResponse) Descriptor() ([]byte, []int) {
+	return file_proto_schedula_v1_appointments_proto_rawDescGZIP(), []int{122}
+}
+
+func (x *RestoreCalendarSnapshotResponse) GetSnapshot() *CalendarSnapshot {
+	if x != nil {
+		return x.Snapshot
+	}
+	return nil
+}
+
+func (x *RestoreCalendarSnapshotResponse) GetAppointmentsCreated() int32 {
+	if x != nil {
+		return x.AppointmentsCreated
+	}
+	return 0
+}
+
+func (x *RestoreCalendarSnapshotResponse) GetAppointmentsUpdated() int32 {
+	if x != nil {
+		return x.AppointmentsUpdated
+	}
+	return 0
+}
+
+func (x *RestoreCalendarSnapshotResponse) GetAppointmentsDeleted() int32 {
+	if x != nil {
+		return x.AppointmentsDeleted
+	}
+	return 0
+}
+
+func (x *RestoreCalendarSnapshotResponse) GetSeriesCreated() int32 {
+	if x != nil {
+		return x.SeriesCreated
+	}
+	return 0
+}
+
+func (x *RestoreCalendarSnapshotResponse) GetSeriesUpdated() int32 {
+	if x != nil {
+		return x.SeriesUpdated
+	}
+	return 0
+}
+
+func (x *RestoreCalendarSnapshotResponse) GetSeriesDeleted() int32 {
+	if x != nil {
+		return x.SeriesDeleted
+	}
+	return 0
+}
+
+func (x *RestoreCalendarSnapshotResponse) GetContactsChanged() int32 {
+	if x != nil {
+		return x.ContactsChanged
+	}
+	return 0
+}
+
+type Contact struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Email         string                 `protobuf:"bytes,4,opt,name=email,proto3" json:"email,omitempty"`
+	Phone         string                 `protobuf:"bytes,5,opt,name=phone,proto3" json:"phone,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Contact) Reset() {
+	*x = Contact{}
+	mi := &file_proto_schedula_v1_appointments_proto_msgTypes[123]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Contact) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Contact) ProtoMessage() {}
+
+func (x *Contact) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_schedula_v1_appointments_proto_msgTypes[123]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Contact.ProtoReflect.Descriptor instead.
+func (*Contact) Descriptor() ([]byte, []int) {
+	return file_proto_schedula_v1_appointments_proto_rawDescGZIP(), []int{123}
+}
+
+func (x *Contact) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Contact) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *Contact) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Contact) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *Contact) GetPhone() string {
+	if x != nil {
+		return x.Phone
+	}
+	return ""
+}
+
+func (x *Contact) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Contact) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type CreateContactRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Email         string                 `protobuf:"bytes,3,opt,name=email,proto3" json:"email,omitempty"`
+	Phone         string                 `protobuf:"bytes,4,opt,name=phone,proto3" json:"phone,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateContactRequest) Reset() {
+	*x = CreateContactRequest{}
+	mi := &file_proto_schedula_v1_appointments_proto_msgTypes[124]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateContactRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateContactRequest) ProtoMessage() {}
+
+func (x *CreateContactRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_schedula_v1_appointments_proto_msgTypes[124]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateContactRequest.ProtoReflect.Descriptor instead.
+func (*CreateContactRequest) Descriptor() ([]byte, []int) {
+	return file_proto_schedula_v1_appointments_proto_rawDescGZIP(), []int{124}
+}
+
+func (x *CreateContactRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *CreateContactRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateContactRequest) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *CreateContactRequest) GetPhone() string {
+	if x != nil {
+		return x.Phone
+	}
+	return ""
+}
+
+type CreateContactResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Contact       *Contact               `protobuf:"bytes,1,opt,name=contact,proto3" json:"contact,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateContactResponse) Reset() {
+	*x = CreateContactResponse{}
+	mi := &file_proto_schedula_v1_appointments_proto_msgTypes[125]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateContactResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateContactResponse) ProtoMessage() {}
+
+func (x *CreateContactResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_schedula_v1_appointments_proto_msgTypes[125]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateContactResponse.ProtoReflect.Descriptor instead.
+func (*CreateContactResponse) Descriptor() ([]byte, []int) {
+	return file_proto_schedula_v1_appointments_proto_rawDescGZIP(), []int{125}
+}
+
+func (x *CreateContactResponse) GetContact() *Contact {
+	if x != nil {
+		return x.Contact
+	}
+	return nil
+}
+
+type GetContactRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	ContactId     string                 `protobuf:"bytes,2,opt,name=contact_id,json=contactId,proto3" json:"contact_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetContactRequest) Reset() {
+	*x = GetContactRequest{}
+	mi := &file_proto_schedula_v1_appointments_proto_msgTypes[126]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetContactRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetContactRequest) ProtoMessage() {}
+
+func (x *GetContactRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_schedula_v1_appointments_proto_msgTypes[126]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetContactRequest.ProtoReflect.Descriptor instead.
+func (*GetContactRequest) Descriptor() ([]byte, []int) {
+	return file_proto_schedula_v1_appointments_proto_rawDescGZIP(), []int{126}
+}
+
+func (x *GetContactRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *GetContactRequest) GetContactId() string {
+	if x != nil {
+		return x.ContactId
+	}
+	return ""
+}
+
+type GetContactResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Contact       *Contact               `protobuf:"bytes,1,opt,name=contact,proto3" json:"contact,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetContactResponse) Reset() {
+	*x = GetContactResponse{}
+	mi := &file_proto_schedula_v1_appointments_proto_msgTypes[127]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetContactResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetContactResponse) ProtoMessage() {}
+
+func (x *GetContactResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_schedula_v1_appointments_proto_msgTypes[127]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetContactResponse.ProtoReflect.Descriptor instead.
+func (*GetContactResponse) Descriptor() ([]byte, []int) {
+	return file_proto_schedula_v1_appointments_proto_rawDescGZIP(), []int{127}
+}
+
+func (x *GetContactResponse) GetContact() *Contact {
+	if x != nil {
+		return x.Contact
+	}
+	return nil
+}
+
+type UpdateContactRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	ContactId     string                 `protobuf:"bytes,2,opt,name=contact_id,json=contactId,proto3" json:"contact_id,omitempty"`
+	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Email         string                 `protobuf:"bytes,4,opt,name=email,proto3" json:"email,omitempty"`
+	Phone         string                 `protobuf:"bytes,5,opt,name=phone,proto3" json:"phone,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateContactRequest) Reset() {
+	*x = UpdateContactRequest{}
+	mi := &file_proto_schedula_v1_appointments_proto_msgTypes[128]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateContactRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateContactRequest) ProtoMessage() {}
+
+func (x *UpdateContactRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_schedula_v1_appointments_proto_msgTypes[128]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateContactRequest.ProtoReflect.Descriptor instead.
+func (*UpdateContactRequest) Descriptor() ([]byte, []int) {
+	return file_proto_schedula_v1_appointments_proto_rawDescGZIP(), []int{128}
+}
+
+func (x *UpdateContactRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *UpdateContactRequest) GetContactId() string {
+	if x != nil {
+		return x.ContactId
+	}
+	return ""
+}
+
+func (x *UpdateContactRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UpdateContactRequest) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *UpdateContactRequest) GetPhone() string {
+	if x != nil {
+		return x.Phone
+	}
+	return ""
+}
+
+type UpdateContactResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Contact       *Contact               `protobuf:"bytes,1,opt,name=contact,proto3" json:"contact,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateContactResponse) Reset() {
+	*x = UpdateContactResponse{}
+	mi := &file_proto_schedula_v1_appointments_proto_msgTypes[129]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateContactResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateContactResponse) ProtoMessage() {}
+
+func (x *UpdateContactResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_schedula_v1_appointments_proto_msgTypes[129]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateContactResponse.ProtoReflect.Descriptor instead.
+func (*UpdateContactResponse) Descriptor() ([]byte, []int) {
+	return file_proto_schedula_v1_appointments_proto_rawDescGZIP(), []int{129}
+}
+
+func (x *UpdateContactResponse) GetContact() *Contact {
+	if x != nil {
+		return x.Contact
+	}
+	return nil
+}
+
+type DeleteContactRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	ContactId     string                 `protobuf:"bytes,2,opt,name=contact_id,json=contactId,proto3" json:"contact_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteContactRequest) Reset() {
+	*x = DeleteContactRequest{}
+	mi := &file_proto_schedula_v1_appointments_proto_msgTypes[130]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteContactRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteContactRequest) ProtoMessage() {}
+
+func (x *DeleteContactRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_schedula_v1_appointments_proto_msgTypes[130]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteContactRequest.ProtoReflect.Descriptor instead.
+func (*DeleteContactRequest) Descriptor() ([]byte, []int) {
+	return file_proto_schedula_v1_appointments_proto_rawDescGZIP(), []int{130}
+}
+
+func (x *DeleteContactRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *DeleteContactRequest) GetContactId() string {
+	if x != nil {
+		return x.ContactId
+	}
+	return ""
+}
+
+type DeleteContactResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteContactResponse) Reset() {
+	*x = DeleteContactResponse{}
+	mi := &file_proto_schedula_v1_appointments_proto_msgTypes[131]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteContactResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteContactResponse) ProtoMessage() {}
+
+func (x *DeleteContactResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_schedula_v1_appointments_proto_msgTypes[131]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteContactResponse.ProtoReflect.Descriptor instead.
+func (*DeleteContactResponse) Descriptor() ([]byte, []int) {
+	return file_proto_schedula_v1_appointments_proto_rawDescGZIP(), []int{131}
+}
+
+type ListContactsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListContactsRequest) Reset() {
+	*x = ListContactsRequest{}
+	mi := &file_proto_schedula_v1_appointments_proto_msgTypes[132]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListContactsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListContactsRequest) ProtoMessage() {}
+
+func (x *ListContactsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_schedula_v1_appointments_proto_msgTypes[132]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListContactsRequest.ProtoReflect.Descriptor instead.
+func (*ListContactsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_schedula_v1_appointments_proto_rawDescGZIP(), []int{132}
+}
+
+func (x *ListContactsRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type ListContactsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Contacts      []*Contact             `protobuf:"bytes,1,rep,name=contacts,proto3" json:"contacts,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListContactsResponse) Reset() {
+	*x = ListContactsResponse{}
+	mi := &file_proto_schedula_v1_appointments_proto_msgTypes[133]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListContactsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListContactsResponse) ProtoMessage() {}
+
+func (x *ListContactsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_schedula_v1_appointments_proto_msgTypes[133]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListContactsResponse.ProtoReflect.Descriptor instead.
+func (*ListContactsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_schedula_v1_appointments_proto_rawDescGZIP(), []int{133}
+}
+
+func (x *ListContactsResponse) GetContacts() []*Contact {
+	if x != nil {
+		return x.Contacts
+	}
+	return nil
+}
+
+type Program struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Title         string                 `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
+	Notes         string                 `protobuf:"bytes,4,opt,name=notes,proto3" json:"notes,omitempty"`
+	CancelledAt   *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=cancelled_at,json=cancelledAt,proto3" json:"cancelled_at,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Program) Reset() {
+	*x = Program{}
+	mi := &file_proto_schedula_v1_appointments_proto_msgTypes[134]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Program) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Program) ProtoMessage() {}
+
+func (x *Program) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_schedula_v1_appointments_proto_msgTypes[134]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Program.ProtoReflect.Descriptor instead.
+func (*Program) Descriptor() ([]byte, []int) {
+	return file_proto_schedula_v1_appointments_proto_rawDescGZIP(), []int{134}
+}
+
+func (x *Program) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Program) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *Program) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *Program) GetNotes() string {
+	if x != nil {
+		return x.Notes
+	}
+	return ""
+}
+
+func (x *Program) GetCancelledAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CancelledAt
+	}
+	return nil
+}
+
+func (x *Program) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Program) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type ProgramProgress struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	TotalSessions     uint32                 `protobuf:"varint,1,opt,name=total_sessions,json=totalSessions,proto3" json:"total_sessions,omitempty"`
+	CompletedSessions uint32                 `protobuf:"varint,2,opt,name=completed_sessions,json=completedSessions,proto3" json:"completed_sessions,omitempty"`
+	RemainingSessions uint32                 `protobuf:"varint,3,opt,name=remaining_sessions,json=remainingSessions,proto3" json:"remaining_sessions,omitempty"`
+	NextSession       *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=next_session,json=nextSession,proto3" json:"next_session,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *ProgramProgress) Reset() {
+	*x = ProgramProgress{}
+	mi := &file_proto_schedula_v1_appointments_proto_msgTypes[135]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProgramProgress) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProgramProgress) ProtoMessage() {}
+
+func (x *ProgramProgress) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_schedula_v1_appointments_proto_msgTypes[135]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProgramProgress.ProtoReflect.Descriptor instead.
+func (*ProgramProgress) Descriptor() ([]byte, []int) {
+	return file_proto_schedula_v1_appointments_proto_rawDescGZIP(), []int{135}
+}
+
+func (x *ProgramProgress) GetTotalSessions() uint32 {
+	if x != nil {
+		return x.TotalSessions
+	}
+	return 0
+}
+
+func (x *ProgramProgress) GetCompletedSessions() uint32 {
+	if x != nil {
+		return x.CompletedSessions
+	}
+	return 0
+}
+
+func (x *ProgramProgress) GetRemainingSessions() uint32 {
+	if x != nil {
+		return x.RemainingSessions
+	}
+	return 0
+}
+
+func (x *ProgramProgress) GetNextSession() *timestamppb.Timestamp {
+	if x != nil {
+		return x.NextSession
+	}
+	return nil
+}
+
+type CreateProgramRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Title         string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	Notes         string                 `protobuf:"bytes,3,opt,name=notes,proto3" json:"notes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateProgramRequest) Reset() {
+	*x = CreateProgramRequest{}
+	mi := &file_proto_schedula_v1_appointments_proto_msgTypes[136]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateProgramRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateProgramRequest) ProtoMessage() {}
+
+func (x *CreateProgramRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_schedula_v1_appointments_proto_msgTypes[136]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateProgramRequest.ProtoReflect.Descriptor instead.
+func (*CreateProgramRequest) Descriptor() ([]byte, []int) {
+	return file_proto_schedula_v1_appointments_proto_rawDescGZIP(), []int{136}
+}
+
+func (x *CreateProgramRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *CreateProgramRequest) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *CreateProgramRequest) GetNotes() string {
+	if x != nil {
+		return x.Notes
+	}
+	return ""
+}
+
+type CreateProgramResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Program       *Program               `protobuf:"bytes,1,opt,name=program,proto3" json:"program,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateProgramResponse) Reset() {
+	*x = CreateProgramResponse{}
+	mi := &file_proto_schedula_v1_appointments_proto_msgTypes[137]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateProgramResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateProgramResponse) ProtoMessage() {}
+
+func (x *CreateProgramResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_schedula_v1_appointments_proto_msgTypes[137]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateProgramResponse.ProtoReflect.Descriptor instead.
+func (*CreateProgramResponse) Descriptor() ([]byte, []int) {
+	return file_proto_schedula_v1_appointments_proto_rawDescGZIP(), []int{137}
+}
+
+func (x *CreateProgramResponse) GetProgram() *Program {
+	if x != nil {
+		return x.Program
+	}
+	return nil
+}
+
+type GetProgramRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	ProgramId     string                 `protobuf:"bytes,2,opt,name=program_id,json=programId,proto3" json:"program_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetProgramRequest) Reset() {
+	*x = GetProgramRequest{}
+	mi := &file_proto_schedula_v1_appointments_proto_msgTypes[138]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetProgramRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetProgramRequest) ProtoMessage() {}
+
+func (x *GetProgramRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_schedula_v1_appointments_proto_msgTypes[138]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetProgramRequest.ProtoReflect.Descriptor instead.
+func (*GetProgramRequest) Descriptor() ([]byte, []int) {
+	return file_proto_schedula_v1_appointments_proto_rawDescGZIP(), []int{138}
+}
+
+func (x *GetProgramRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *GetProgramRequest) GetProgramId() string {
+	if x != nil {
+		return x.ProgramId
+	}
+	return ""
+}
+
+type GetProgramResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Program       *Program               `protobuf:"bytes,1,opt,name=program,proto3" json:"program,omitempty"`
+	Appointments  []*Appointment         `protobuf:"bytes,2,rep,name=appointments,proto3" json:"appointments,omitempty"`
+	Series        []*RecurringSeries     `protobuf:"bytes,3,rep,name=series,proto3" json:"series,omitempty"`
+	Progress      *ProgramProgress       `protobuf:"bytes,4,opt,name=progress,proto3" json:"progress,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetProgramResponse) Reset() {
+	*x = GetProgramResponse{}
+	mi := &file_proto_schedula_v1_appointments_proto_msgTypes[139]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetProgramResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetProgramResponse) ProtoMessage() {}
+
+func (x *GetProgramResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_schedula_v1_appointments_proto_msgTypes[139]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetProgramResponse.ProtoReflect.Descriptor instead.
+func (*GetProgramResponse) Descriptor() ([]byte, []int) {
+	return file_proto_schedula_v1_appointments_proto_rawDescGZIP(), []int{139}
+}
+
+func (x *GetProgramResponse) GetProgram() *Program {
+	if x != nil {
+		return x.Program
+	}
+	return nil
+}
+
+func (x *GetProgramResponse) GetAppointments() []*Appointment {
+	if x != nil {
+		return x.Appointments
+	}
+	return nil
+}
+
+func (x *GetProgramResponse) GetSeries() []*RecurringSeries {
+	if x != nil {
+		return x.Series
+	}
+	return nil
+}
+
+func (x *GetProgramResponse) GetProgress() *ProgramProgress {
+	if x != nil {
+		return x.Progress
+	}
+	return nil
+}
+
+type ListProgramsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListProgramsRequest) Reset() {
+	*x = ListProgramsRequest{}
+	mi := &file_proto_schedula_v1_appointments_proto_msgTypes[140]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListProgramsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListProgramsRequest) ProtoMessage() {}
+
+func (x *ListProgramsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_schedula_v1_appointments_proto_msgTypes[140]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListProgramsRequest.ProtoReflect.Descriptor instead.
+func (*ListProgramsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_schedula_v1_appointments_proto_rawDescGZIP(), []int{140}
+}
+
+func (x *ListProgramsRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type ListProgramsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Programs      []*Program             `protobuf:"bytes,1,rep,name=programs,proto3" json:"programs,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListProgramsResponse) Reset() {
+	*x = ListProgramsResponse{}
+	mi := &file_proto_schedula_v1_appointments_proto_msgTypes[141]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListProgramsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListProgramsResponse) ProtoMessage() {}
+
+func (x *ListProgramsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_schedula_v1_appointments_proto_msgTypes[141]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListProgramsResponse.ProtoReflect.Descriptor instead.
+func (*ListProgramsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_schedula_v1_appointments_proto_rawDescGZIP(), []int{141}
+}
+
+func (x *ListProgramsResponse) GetPrograms() []*Program {
+	if x != nil {
+		return x.Programs
+	}
+	return nil
+}
+
+type CancelProgramRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	ProgramId     string                 `protobuf:"bytes,2,opt,name=program_id,json=programId,proto3" json:"program_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelProgramRequest) Reset() {
+	*x = CancelProgramRequest{}
+	mi := &file_proto_schedula_v1_appointments_proto_msgTypes[142]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelProgramRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelProgramRequest) ProtoMessage() {}
+
+func (x *CancelProgramRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_schedula_v1_appointments_proto_msgTypes[142]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelProgramRequest.ProtoReflect.Descriptor instead.
+func (*CancelProgramRequest) Descriptor() ([]byte, []int) {
+	return file_proto_schedula_v1_appointments_proto_rawDescGZIP(), []int{142}
+}
+
+func (x *CancelProgramRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *CancelProgramRequest) GetProgramId() string {
+	if x != nil {
+		return x.ProgramId
+	}
+	return ""
+}
+
+type CancelProgramResponse struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	Program             *Program               `protobuf:"bytes,1,opt,name=program,proto3" json:"program,omitempty"`
+	AppointmentsDeleted int32                  `protobuf:"varint,2,opt,name=appointments_deleted,json=appointmentsDeleted,proto3" json:"appointments_deleted,omitempty"`
+	SeriesEnded         int32                  `protobuf:"varint,3,opt,name=series_ended,json=seriesEnded,proto3" json:"series_ended,omitempty"`
+	SeriesDeleted       int32                  `protobuf:"varint,4,opt,name=series_deleted,json=seriesDeleted,proto3" json:"series_deleted,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *CancelProgramResponse) Reset() {
+	*x = CancelProgramResponse{}
+	mi := &file_proto_schedula_v1_appointments_proto_msgTypes[143]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelProgramResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelProgramResponse) ProtoMessage() {}
+
+func (x *CancelProgramResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_schedula_v1_appointments_proto_msgTypes[143]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelProgramResponse.ProtoReflect.Descriptor instead.
+func (*CancelProgramResponse) Descriptor() ([]byte, []int) {
+	return file_proto_schedula_v1_appointments_proto_rawDescGZIP(), []int{143}
+}
+
+func (x *CancelProgramResponse) GetProgram() *Program {
+	if x != nil {
+		return x.Program
+	}
+	return nil
+}
+
+func (x *CancelProgramResponse) GetAppointmentsDeleted() int32 {
+	if x != nil {
+		return x.AppointmentsDeleted
+	}
+	return 0
+}
+
+func (x *CancelProgramResponse) GetSeriesEnded() int32 {
+	if x != nil {
+		return x.SeriesEnded
+	}
+	return 0
+}
+
+func (x *CancelProgramResponse) GetSeriesDeleted() int32 {
+	if x != nil {
+		return x.SeriesDeleted
+	}
+	return 0
+}
+
+type CheckInRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	AppointmentId string                 `protobuf:"bytes,2,opt,name=appointment_id,json=appointmentId,proto3" json:"appointment_id,omitempty"`
+	At            *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=at,proto3" json:"at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CheckInRequest) Reset() {
+	*x = CheckInRequest{}
+	mi := &file_proto_schedula_v1_appointments_proto_msgTypes[144]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CheckInRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckInRequest) ProtoMessage() {}
+
+func (x *CheckInRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_schedula_v1_appointments_proto_msgTypes[144]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckInRequest.ProtoReflect.Descriptor instead.
+func (*CheckInRequest) Descriptor() ([]byte, []int) {
+	return file_proto_schedula_v1_appointments_proto_rawDescGZIP(), []int{144}
+}
+
+func (x *CheckInRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *CheckInRequest) GetAppointmentId() string {
+	if x != nil {
+		return x.AppointmentId
+	}
+	return ""
+}
+
+func (x *CheckInRequest) GetAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.At
+	}
+	return nil
+}
+
+type CheckInResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Appointment   *Appointment           `protobuf:"bytes,1,opt,name=appointment,proto3" json:"appointment,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CheckInResponse) Reset() {
+	*x = CheckInResponse{}
+	mi := &file_proto_schedula_v1_appointments_proto_msgTypes[145]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CheckInResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckInResponse) ProtoMessage() {}
+
+func (x *CheckInResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_schedula_v1_appointments_proto_msgTypes[145]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckInResponse.ProtoReflect.Descriptor instead.
+func (*CheckInResponse) Descriptor() ([]byte, []int) {
+	return file_proto_schedula_v1_appointments_proto_rawDescGZIP(), []int{145}
+}
+
+func (x *CheckInResponse) GetAppointment() *Appointment {
+	if x != nil {
+		return x.Appointment
+	}
+	return nil
+}
+
+type CheckOutRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	AppointmentId string                 `protobuf:"bytes,2,opt,name=appointment_id,json=appointmentId,proto3" json:"appointment_id,omitempty"`
+	At            *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=at,proto3" json:"at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CheckOutRequest) Reset() {
+	*x = CheckOutRequest{}
+	mi := &file_proto_schedula_v1_appointments_proto_msgTypes[146]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CheckOutRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckOutRequest) ProtoMessage() {}
+
+func (x *CheckOutRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_schedula_v1_appointments_proto_msgTypes[146]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckOutRequest.ProtoReflect.Descriptor instead.
+func (*CheckOutRequest) Descriptor() ([]byte, []int) {
+	return file_proto_schedula_v1_appointments_proto_rawDescGZIP(), []int{146}
+}
+
+func (x *CheckOutRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *CheckOutRequest) GetAppointmentId() string {
+	if x != nil {
+		return x.AppointmentId
+	}
+	return ""
+}
+
+func (x *CheckOutRequest) GetAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.At
+	}
+	return nil
+}
+
+type CheckOutResponse struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Appointment     *Appointment           `protobuf:"bytes,1,opt,name=appointment,proto3" json:"appointment,omitempty"`
+	PlannedDuration *durationpb.Duration   `protobuf:"bytes,2,opt,name=planned_duration,json=plannedDuration,proto3" json:"planned_duration,omitempty"`
+	ActualDuration  *durationpb.Duration   `protobuf:"bytes,3,opt,name=actual_duration,json=actualDuration,proto3" json:"actual_duration,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *CheckOutResponse) Reset() {
+	*x = CheckOutResponse{}
+	mi := &file_proto_schedula_v1_appointments_proto_msgTypes[147]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CheckOutResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckOutResponse) ProtoMessage() {}
+
+func (x *CheckOutResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_schedula_v1_appointments_proto_msgTypes[147]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckOutResponse.ProtoReflect.Descriptor instead.
+func (*CheckOutResponse) Descriptor() ([]byte, []int) {
+	return file_proto_schedula_v1_appointments_proto_rawDescGZIP(), []int{147}
+}
+
+func (x *CheckOutResponse) GetAppointment() *Appointment {
+	if x != nil {
+		return x.Appointment
+	}
+	return nil
+}
+
+func (x *CheckOutResponse) GetPlannedDuration() *durationpb.Duration {
+	if x != nil {
+		return x.PlannedDuration
+	}
+	return nil
+}
+
+func (x *CheckOutResponse) GetActualDuration() *durationpb.Duration {
+	if x != nil {
+		return x.ActualDuration
+	}
+	return nil
+}
+
+type ExportBillableHoursRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	WindowStart   *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=window_start,json=windowStart,proto3" json:"window_start,omitempty"`
+	WindowEnd     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=window_end,json=windowEnd,proto3" json:"window_end,omitempty"`
+	TagKey        string                 `protobuf:"bytes,4,opt,name=tag_key,json=tagKey,proto3" json:"tag_key,omitempty"`
+	Period        BillablePeriod         `protobuf:"varint,5,opt,name=period,proto3,enum=schedula.v1.BillablePeriod" json:"period,omitempty"`
+	TimeZone      string                 `protobuf:"bytes,6,opt,name=time_zone,json=timeZone,proto3" json:"time_zone,omitempty"`
+	Format        BillableFormat         `protobuf:"varint,7,opt,name=format,proto3,enum=schedula.v1.BillableFormat" json:"format,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportBillableHoursRequest) Reset() {
+	*x = ExportBillableHoursRequest{}
+	mi := &file_proto_schedula_v1_appointments_proto_msgTypes[148]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportBillableHoursRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportBillableHoursRequest) ProtoMessage() {}
+
+func (x *ExportBillableHoursRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_schedula_v1_appointments_proto_msgTypes[148]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportBillableHoursRequest.ProtoReflect.Descriptor instead.
+func (*ExportBillableHoursRequest) Descriptor() ([]byte, []int) {
+	return file_proto_schedula_v1_appointments_proto_rawDescGZIP(), []int{148}
+}
+
+func (x *ExportBillableHoursRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ExportBillableHoursRequest) GetWindowStart() *timestamppb.Timestamp {
+	if x != nil {
+		return x.WindowStart
+	}
+	return nil
+}
+
+func (x *ExportBillableHoursRequest) GetWindowEnd() *timestamppb.Timestamp {
+	if x != nil {
+		return x.WindowEnd
+	}
+	return nil
+}
+
+func (x *ExportBillableHoursRequest) GetTagKey() string {
+	if x != nil {
+		return x.TagKey
+	}
+	return ""
+}
+
+func (x *ExportBillableHoursRequest) GetPeriod() BillablePeriod {
+	if x != nil {
+		return x.Period
+	}
+	return BillablePeriod_BILLABLE_PERIOD_UNSPECIFIED
+}
+
+func (x *ExportBillableHoursRequest) GetTimeZone() string {
+	if x != nil {
+		return x.TimeZone
+	}
+	return ""
+}
+
+func (x *ExportBillableHoursRequest) GetFormat() BillableFormat {
+	if x != nil {
+		return x.Format
+	}
+	return BillableFormat_BILLABLE_FORMAT_UNSPECIFIED
+}
+
+type ExportBillableHoursResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Data          []byte                 `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	ContentType   string                 `protobuf:"bytes,2,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportBillableHoursResponse) Reset() {
+	*x = ExportBillableHoursResponse{}
+	mi := &file_proto_schedula_v1_appointments_proto_msgTypes[149]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportBillableHoursResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportBillableHoursResponse) ProtoMessage() {}
+
+func (x *ExportBillableHoursResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_schedula_v1_appointments_proto_msgTypes[149]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportBillableHoursResponse.ProtoReflect.Descriptor instead.
+func (*ExportBillableHoursResponse) Descriptor() ([]byte, []int) {
+	return file_proto_schedula_v1_appointments_proto_rawDescGZIP(), []int{149}
+}
+
+func (x *ExportBillableHoursResponse) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *ExportBillableHoursResponse) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+type OfflineMutation struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Kind          MutationKind           `protobuf:"varint,1,opt,name=kind,proto3,enum=schedula.v1.MutationKind" json:"kind,omitempty"`
+	AppointmentId string                 `protobuf:"bytes,2,opt,name=appointment_id,json=appointmentId,proto3" json:"appointment_id,omitempty"`
+	Title         string                 `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
+	Notes         string                 `protobuf:"bytes,4,opt,name=notes,proto3" json:"notes,omitempty"`
+	StartTime     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	EndTime       *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	Metadata      map[string]string      `protobuf:"bytes,7,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	TimeZone      string                 `protobuf:"bytes,8,opt,name=time_zone,json=timeZone,proto3" json:"time_zone,omitempty"`
+	BaseUpdatedAt *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=base_updated_at,json=baseUpdatedAt,proto3" json:"base_updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OfflineMutation) Reset() {
+	*x = OfflineMutation{}
+	mi := &file_proto_schedula_v1_appointments_proto_msgTypes[150]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OfflineMutation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OfflineMutation) ProtoMessage() {}
+
+func (x *OfflineMutation) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_schedula_v1_appointments_proto_msgTypes[150]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OfflineMutation.ProtoReflect.Descriptor instead.
+func (*OfflineMutation) Descriptor() ([]byte, []int) {
+	return file_proto_schedula_v1_appointments_proto_rawDescGZIP(), []int{150}
+}
+
+func (x *OfflineMutation) GetKind() MutationKind {
+	if x != nil {
+		return x.Kind
+	}
+	return MutationKind_MUTATION_KIND_UNSPECIFIED
+}
+
+func (x *OfflineMutation) GetAppointmentId() string {
+	if x != nil {
+		return x.AppointmentId
+	}
+	return ""
+}
+
+func (x *OfflineMutation) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *OfflineMutation) GetNotes() string {
+	if x != nil {
+		return x.Notes
+	}
+	return ""
+}
+
+func (x *OfflineMutation) GetStartTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartTime
+	}
+	return nil
+}
+
+func (x *OfflineMutation) GetEndTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndTime
+	}
+	return nil
+}
+
+func (x *OfflineMutation) GetMetadata() map[string]string {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+func (x *OfflineMutation) GetTimeZone() string {
+	if x != nil {
+		return x.TimeZone
+	}
+	return ""
+}
+
+func (x *OfflineMutation) GetBaseUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.BaseUpdatedAt
+	}
+	return nil
+}
+
+type MutationResult struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        MutationStatus         `protobuf:"varint,1,opt,name=status,proto3,enum=schedula.v1.MutationStatus" json:"status,omitempty"`
+	Conflict      MutationConflict       `protobuf:"varint,2,opt,name=conflict,proto3,enum=schedula.v1.MutationConflict" json:"conflict,omitempty"`
+	Message       string                 `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	Current       *Appointment           `protobuf:"bytes,4,opt,name=current,proto3" json:"current,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MutationResult) Reset() {
+	*x = MutationResult{}
+	mi := &file_proto_schedula_v1_appointments_proto_msgTypes[151]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MutationResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MutationResult) ProtoMessage() {}
+
+func (x *MutationResult) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_schedula_v1_appointments_proto_msgTypes[151]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MutationResult.ProtoReflect.Descriptor instead.
+func (*MutationResult) Descriptor() ([]byte, []int) {
+	return file_proto_schedula_v1_appointments_proto_rawDescGZIP(), []int{151}
+}
+
+func (x *MutationResult) GetStatus() MutationStatus {
+	if x != nil {
+		return x.Status
+	}
+	return MutationStatus_MUTATION_STATUS_UNSPECIFIED
+}
+
+func (x *MutationResult) GetConflict() MutationConflict {
+	if x != nil {
+		return x.Conflict
+	}
+	return MutationConflict_MUTATION_CONFLICT_UNSPECIFIED
+}
+
+func (x *MutationResult) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *MutationResult) GetCurrent() *Appointment {
+	if x != nil {
+		return x.Current
+	}
+	return nil
+}
+
+type ReconcileCalendarRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Mutations     []*OfflineMutation     `protobuf:"bytes,2,rep,name=mutations,proto3" json:"mutations,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReconcileCalendarRequest) Reset() {
+	*x = ReconcileCalendarRequest{}
+	mi := &file_proto_schedula_v1_appointments_proto_msgTypes[152]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReconcileCalendarRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReconcileCalendarRequest) ProtoMessage() {}
+
+func (x *ReconcileCalendarRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_schedula_v1_appointments_proto_msgTypes[152]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReconcileCalendarRequest.ProtoReflect.Descriptor instead.
+func (*ReconcileCalendarRequest) Descriptor() ([]byte, []int) {
+	return file_proto_schedula_v1_appointments_proto_rawDescGZIP(), []int{152}
+}
+
+func (x *ReconcileCalendarRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ReconcileCalendarRequest) GetMutations() []*OfflineMutation {
+	if x != nil {
+		return x.Mutations
+	}
+	return nil
+}
+
+type ReconcileCalendarResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Results       []*MutationResult      `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReconcileCalendarResponse) Reset() {
+	*x = ReconcileCalendarResponse{}
+	mi := &file_proto_schedula_v1_appointments_proto_msgTypes[153]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReconcileCalendarResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReconcileCalendarResponse) ProtoMessage() {}
+
+func (x *ReconcileCalendarResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_schedula_v1_appointments_proto_msgTypes[153]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReconcileCalendarResponse.ProtoReflect.Descriptor instead.
+func (*ReconcileCalendarResponse) Descriptor() ([]byte, []int) {
+	return file_proto_schedula_v1_appointments_proto_rawDescGZIP(), []int{153}
+}
+
+func (x *ReconcileCalendarResponse) GetResults() []*MutationResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+type CreateEmbedTokenRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	SlotDuration  *durationpb.Duration   `protobuf:"bytes,2,opt,name=slot_duration,json=slotDuration,proto3" json:"slot_duration,omitempty"`
+	WorkingHours  *WorkingHours          `protobuf:"bytes,3,opt,name=working_hours,json=workingHours,proto3" json:"working_hours,omitempty"`
+	Ttl           *durationpb.Duration   `protobuf:"bytes,4,opt,name=ttl,proto3" json:"ttl,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateEmbedTokenRequest) Reset() {
+	*x = CreateEmbedTokenRequest{}
+	mi := &file_proto_schedula_v1_appointments_proto_msgTypes[154]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateEmbedTokenRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateEmbedTokenRequest) ProtoMessage() {}
+
+func (x *CreateEmbedTokenRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_schedula_v1_appointments_proto_msgTypes[154]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateEmbedTokenRequest.ProtoReflect.Descriptor instead.
+func (*CreateEmbedTokenRequest) Descriptor() ([]byte, []int) {
+	return file_proto_schedula_v1_appointments_proto_rawDescGZIP(), []int{154}
+}
+
+func (x *CreateEmbedTokenRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *CreateEmbedTokenRequest) GetSlotDuration() *durationpb.Duration {
+	if x != nil {
+		return x.SlotDuration
+	}
+	return nil
+}
+
+func (x *CreateEmbedTokenRequest) GetWorkingHours() *WorkingHours {
+	if x != nil {
+		return x.WorkingHours
+	}
+	return nil
+}
+
+func (x *CreateEmbedTokenRequest) GetTtl() *durationpb.Duration {
+	if x != nil {
+		return x.Ttl
+	}
+	return nil
+}
+
+type CreateEmbedTokenResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Interval      uint32                 `protobuf:"varint,1,opt,name=interval,proto3" json:"interval,omitempty"`
-	Weekdays      []Weekday              `protobuf:"varint,2,rep,packed,name=weekdays,proto3,enum=schedula.v1.Weekday" json:"weekdays,omitempty"`
-	Until         *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=until,proto3" json:"until,omitempty"`
-	Count         uint32                 `protobuf:"varint,4,opt,name=count,proto3" json:"count,omitempty"`
-	TimeZone      string                 `protobuf:"bytes,5,opt,name=time_zone,json=timeZone,proto3" json:"time_zone,omitempty"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	ExpiresAt     *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *WeeklyRecurrence) Reset() {
-	*x = WeeklyRecurrence{}
-	mi := &file_proto_schedula_v1_appointments_proto_msgTypes[0]
+func (x *CreateEmbedTokenResponse) Reset() {
+	*x = CreateEmbedTokenResponse{}
+	mi := &file_proto_schedula_v1_appointments_proto_msgTypes[155]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *WeeklyRecurrence) String() string {
+func (x *CreateEmbedTokenResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*WeeklyRecurrence) ProtoMessage() {}
+func (*CreateEmbedTokenResponse) ProtoMessage() {}
 
-func (x *WeeklyRecurrence) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_schedula_v1_appointments_proto_msgTypes[0]
+func (x *CreateEmbedTokenResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_schedula_v1_appointments_proto_msgTypes[155]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -122,75 +11064,183 @@ func (x *WeeklyRecurrence) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use WeeklyRecurrence.ProtoReflect.Descriptor instead.
-func (*WeeklyRecurrence) Descriptor() ([]byte, []int) {
-	return file_proto_schedula_v1_appointments_proto_rawDescGZIP(), []int{0}
+// Deprecated: Use CreateEmbedTokenResponse.ProtoReflect.Descriptor instead.
+func (*CreateEmbedTokenResponse) Descriptor() ([]byte, []int) {
+	return file_proto_schedula_v1_appointments_proto_rawDescGZIP(), []int{155}
 }
 
-func (x *WeeklyRecurrence) GetInterval() uint32 {
+func (x *CreateEmbedTokenResponse) GetToken() string {
 	if x != nil {
-		return x.Interval
+		return x.Token
 	}
-	return 0
+	return ""
 }
 
-func (x *WeeklyRecurrence) GetWeekdays() []Weekday {
+func (x *CreateEmbedTokenResponse) GetExpiresAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Weekdays
+		return x.ExpiresAt
 	}
 	return nil
 }
 
-func (x *WeeklyRecurrence) GetUntil() *timestamppb.Timestamp {
+type DailyBreak struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Label         string                 `protobuf:"bytes,1,opt,name=label,proto3" json:"label,omitempty"`
+	StartMinute   uint32                 `protobuf:"varint,2,opt,name=start_minute,json=startMinute,proto3" json:"start_minute,omitempty"`
+	EndMinute     uint32                 `protobuf:"varint,3,opt,name=end_minute,json=endMinute,proto3" json:"end_minute,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DailyBreak) Reset() {
+	*x = DailyBreak{}
+	mi := &file_proto_schedula_v1_appointments_proto_msgTypes[156]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DailyBreak) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DailyBreak) ProtoMessage() {}
+
+func (x *DailyBreak) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_schedula_v1_appointments_proto_msgTypes[156]
 	if x != nil {
-		return x.Until
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *WeeklyRecurrence) GetCount() uint32 {
+// Deprecated: Use DailyBreak.ProtoReflect.Descriptor instead.
+func (*DailyBreak) Descriptor() ([]byte, []int) {
+	return file_proto_schedula_v1_appointments_proto_rawDescGZIP(), []int{156}
+}
+
+func (x *DailyBreak) GetLabel() string {
 	if x != nil {
-		return x.Count
+		return x.Label
+	}
+	return ""
+}
+
+func (x *DailyBreak) GetStartMinute() uint32 {
+	if x != nil {
+		return x.StartMinute
 	}
 	return 0
 }
 
-func (x *WeeklyRecurrence) GetTimeZone() string {
+func (x *DailyBreak) GetEndMinute() uint32 {
+	if x != nil {
+		return x.EndMinute
+	}
+	return 0
+}
+
+type SlotSettings struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	UserId           string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	AlignmentMinutes uint32                 `protobuf:"varint,2,opt,name=alignment_minutes,json=alignmentMinutes,proto3" json:"alignment_minutes,omitempty"`
+	TimeZone         string                 `protobuf:"bytes,3,opt,name=time_zone,json=timeZone,proto3" json:"time_zone,omitempty"`
+	UpdatedAt        *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	DailyBreaks      []*DailyBreak          `protobuf:"bytes,5,rep,name=daily_breaks,json=dailyBreaks,proto3" json:"daily_breaks,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *SlotSettings) Reset() {
+	*x = SlotSettings{}
+	mi := &file_proto_schedula_v1_appointments_proto_msgTypes[157]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SlotSettings) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SlotSettings) ProtoMessage() {}
+
+func (x *SlotSettings) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_schedula_v1_appointments_proto_msgTypes[157]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SlotSettings.ProtoReflect.Descriptor instead.
+func (*SlotSettings) Descriptor() ([]byte, []int) {
+	return file_proto_schedula_v1_appointments_proto_rawDescGZIP(), []int{157}
+}
+
+func (x *SlotSettings) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *SlotSettings) GetAlignmentMinutes() uint32 {
+	if x != nil {
+		return x.AlignmentMinutes
+	}
+	return 0
+}
+
+func (x *SlotSettings) GetTimeZone() string {
 	if x != nil {
 		return x.TimeZone
 	}
 	return ""
 }
 
-type Appointment struct {
+func (x *SlotSettings) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *SlotSettings) GetDailyBreaks() []*DailyBreak {
+	if x != nil {
+		return x.DailyBreaks
+	}
+	return nil
+}
+
+type GetSlotSettingsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	Title         string                 `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
-	Notes         string                 `protobuf:"bytes,4,opt,name=notes,proto3" json:"notes,omitempty"`
-	StartTime     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
-	EndTime       *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
-	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Appointment) Reset() {
-	*x = Appointment{}
-	mi := &file_proto_schedula_v1_appointments_proto_msgTypes[1]
+func (x *GetSlotSettingsRequest) Reset() {
+	*x = GetSlotSettingsRequest{}
+	mi := &file_proto_schedula_v1_appointments_proto_msgTypes[158]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Appointment) String() string {
+func (x *GetSlotSettingsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Appointment) ProtoMessage() {}
+func (*GetSlotSettingsRequest) ProtoMessage() {}
 
-func (x *Appointment) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_schedula_v1_appointments_proto_msgTypes[1]
+func (x *GetSlotSettingsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_schedula_v1_appointments_proto_msgTypes[158]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -201,93 +11251,189 @@ func (x *Appointment) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Appointment.ProtoReflect.Descriptor instead.
-func (*Appointment) Descriptor() ([]byte, []int) {
-	return file_proto_schedula_v1_appointments_proto_rawDescGZIP(), []int{1}
+// Deprecated: Use GetSlotSettingsRequest.ProtoReflect.Descriptor instead.
+func (*GetSlotSettingsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_schedula_v1_appointments_proto_rawDescGZIP(), []int{158}
 }
 
-func (x *Appointment) GetId() string {
+func (x *GetSlotSettingsRequest) GetUserId() string {
 	if x != nil {
-		return x.Id
+		return x.UserId
 	}
 	return ""
 }
 
-func (x *Appointment) GetUserId() string {
+type GetSlotSettingsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Settings      *SlotSettings          `protobuf:"bytes,1,opt,name=settings,proto3" json:"settings,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSlotSettingsResponse) Reset() {
+	*x = GetSlotSettingsResponse{}
+	mi := &file_proto_schedula_v1_appointments_proto_msgTypes[159]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSlotSettingsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSlotSettingsResponse) ProtoMessage() {}
+
+func (x *GetSlotSettingsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_schedula_v1_appointments_proto_msgTypes[159]
 	if x != nil {
-		return x.UserId
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *Appointment) GetTitle() string {
+// Deprecated: Use GetSlotSettingsResponse.ProtoReflect.Descriptor instead.
+func (*GetSlotSettingsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_schedula_v1_appointments_proto_rawDescGZIP(), []int{159}
+}
+
+func (x *GetSlotSettingsResponse) GetSettings() *SlotSettings {
 	if x != nil {
-		return x.Title
+		return x.Settings
 	}
-	return ""
+	return nil
 }
 
-func (x *Appointment) GetNotes() string {
+type UpdateSlotSettingsRequest struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	UserId           string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	AlignmentMinutes uint32                 `protobuf:"varint,2,opt,name=alignment_minutes,json=alignmentMinutes,proto3" json:"alignment_minutes,omitempty"`
+	TimeZone         string                 `protobuf:"bytes,3,opt,name=time_zone,json=timeZone,proto3" json:"time_zone,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *UpdateSlotSettingsRequest) Reset() {
+	*x = UpdateSlotSettingsRequest{}
+	mi := &file_proto_schedula_v1_appointments_proto_msgTypes[160]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateSlotSettingsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateSlotSettingsRequest) ProtoMessage() {}
+
+func (x *UpdateSlotSettingsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_schedula_v1_appointments_proto_msgTypes[160]
 	if x != nil {
-		return x.Notes
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateSlotSettingsRequest.ProtoReflect.Descriptor instead.
+func (*UpdateSlotSettingsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_schedula_v1_appointments_proto_rawDescGZIP(), []int{160}
+}
+
+func (x *UpdateSlotSettingsRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
 	}
 	return ""
 }
 
-func (x *Appointment) GetStartTime() *timestamppb.Timestamp {
+func (x *UpdateSlotSettingsRequest) GetAlignmentMinutes() uint32 {
 	if x != nil {
-		return x.StartTime
+		return x.AlignmentMinutes
 	}
-	return nil
+	return 0
 }
 
-func (x *Appointment) GetEndTime() *timestamppb.Timestamp {
+func (x *UpdateSlotSettingsRequest) GetTimeZone() string {
 	if x != nil {
-		return x.EndTime
+		return x.TimeZone
 	}
-	return nil
+	return ""
 }
 
-func (x *Appointment) GetCreatedAt() *timestamppb.Timestamp {
+type UpdateSlotSettingsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Settings      *SlotSettings          `protobuf:"bytes,1,opt,name=settings,proto3" json:"settings,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateSlotSettingsResponse) Reset() {
+	*x = UpdateSlotSettingsResponse{}
+	mi := &file_proto_schedula_v1_appointments_proto_msgTypes[161]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateSlotSettingsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateSlotSettingsResponse) ProtoMessage() {}
+
+func (x *UpdateSlotSettingsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_schedula_v1_appointments_proto_msgTypes[161]
 	if x != nil {
-		return x.CreatedAt
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *Appointment) GetUpdatedAt() *timestamppb.Timestamp {
+// Deprecated: Use UpdateSlotSettingsResponse.ProtoReflect.Descriptor instead.
+func (*UpdateSlotSettingsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_schedula_v1_appointments_proto_rawDescGZIP(), []int{161}
+}
+
+func (x *UpdateSlotSettingsResponse) GetSettings() *SlotSettings {
 	if x != nil {
-		return x.UpdatedAt
+		return x.Settings
 	}
 	return nil
 }
 
-type CreateAppointmentRequest struct {
+type UpdateDailyBreaksRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	Title         string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
-	Notes         string                 `protobuf:"bytes,3,opt,name=notes,proto3" json:"notes,omitempty"`
-	StartTime     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
-	EndTime       *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	Breaks        []*DailyBreak          `protobuf:"bytes,2,rep,name=breaks,proto3" json:"breaks,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateAppointmentRequest) Reset() {
-	*x = CreateAppointmentRequest{}
-	mi := &file_proto_schedula_v1_appointments_proto_msgTypes[2]
+func (x *UpdateDailyBreaksRequest) Reset() {
+	*x = UpdateDailyBreaksRequest{}
+	mi := &file_proto_schedula_v1_appointments_proto_msgTypes[162]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateAppointmentRequest) String() string {
+func (x *UpdateDailyBreaksRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateAppointmentRequest) ProtoMessage() {}
+func (*UpdateDailyBreaksRequest) ProtoMessage() {}
 
-func (x *CreateAppointmentRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_schedula_v1_appointments_proto_msgTypes[2]
+func (x *UpdateDailyBreaksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_schedula_v1_appointments_proto_msgTypes[162]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -298,68 +11444,47 @@ func (x *CreateAppointmentRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateAppointmentRequest.ProtoReflect.Descriptor instead.
-func (*CreateAppointmentRequest) Descriptor() ([]byte, []int) {
-	return file_proto_schedula_v1_appointments_proto_rawDescGZIP(), []int{2}
+// Deprecated: Use UpdateDailyBreaksRequest.ProtoReflect.Descriptor instead.
+func (*UpdateDailyBreaksRequest) Descriptor() ([]byte, []int) {
+	return file_proto_schedula_v1_appointments_proto_rawDescGZIP(), []int{162}
 }
 
-func (x *CreateAppointmentRequest) GetUserId() string {
+func (x *UpdateDailyBreaksRequest) GetUserId() string {
 	if x != nil {
 		return x.UserId
 	}
 	return ""
 }
 
-func (x *CreateAppointmentRequest) GetTitle() string {
-	if x != nil {
-		return x.Title
-	}
-	return ""
-}
-
-func (x *CreateAppointmentRequest) GetNotes() string {
-	if x != nil {
-		return x.Notes
-	}
-	return ""
-}
-
-func (x *CreateAppointmentRequest) GetStartTime() *timestamppb.Timestamp {
-	if x != nil {
-		return x.StartTime
-	}
-	return nil
-}
-
-func (x *CreateAppointmentRequest) GetEndTime() *timestamppb.Timestamp {
+func (x *UpdateDailyBreaksRequest) GetBreaks() []*DailyBreak {
 	if x != nil {
-		return x.EndTime
+		return x.Breaks
 	}
 	return nil
 }
 
-type CreateAppointmentResponse struct {
+type UpdateDailyBreaksResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Appointment   *Appointment           `protobuf:"bytes,1,opt,name=appointment,proto3" json:"appointment,omitempty"`
+	Settings      *SlotSettings          `protobuf:"bytes,1,opt,name=settings,proto3" json:"settings,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateAppointmentResponse) Reset() {
-	*x = CreateAppointmentResponse{}
-	mi := &file_proto_schedula_v1_appointments_proto_msgTypes[3]
+func (x *UpdateDailyBreaksResponse) Reset() {
+	*x = UpdateDailyBreaksResponse{}
+	mi := &file_proto_schedula_v1_appointments_proto_msgTypes[163]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateAppointmentResponse) String() string {
+func (x *UpdateDailyBreaksResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateAppointmentResponse) ProtoMessage() {}
+func (*UpdateDailyBreaksResponse) ProtoMessage() {}
 
-func (x *CreateAppointmentResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_schedula_v1_appointments_proto_msgTypes[3]
+func (x *UpdateDailyBreaksResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_schedula_v1_appointments_proto_msgTypes[163]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -370,42 +11495,43 @@ func (x *CreateAppointmentResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateAppointmentResponse.ProtoReflect.Descriptor instead.
-func (*CreateAppointmentResponse) Descriptor() ([]byte, []int) {
-	return file_proto_schedula_v1_appointments_proto_rawDescGZIP(), []int{3}
+// Deprecated: Use UpdateDailyBreaksResponse.ProtoReflect.Descriptor instead.
+func (*UpdateDailyBreaksResponse) Descriptor() ([]byte, []int) {
+	return file_proto_schedula_v1_appointments_proto_rawDescGZIP(), []int{163}
 }
 
-func (x *CreateAppointmentResponse) GetAppointment() *Appointment {
+func (x *UpdateDailyBreaksResponse) GetSettings() *SlotSettings {
 	if x != nil {
-		return x.Appointment
+		return x.Settings
 	}
 	return nil
 }
 
-type ListAppointmentsRequest struct {
+type TimeOffRecurrence struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	WindowStart   *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=window_start,json=windowStart,proto3" json:"window_start,omitempty"`
-	WindowEnd     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=window_end,json=windowEnd,proto3" json:"window_end,omitempty"`
+	Interval      uint32                 `protobuf:"varint,1,opt,name=interval,proto3" json:"interval,omitempty"`
+	Weekdays      []Weekday              `protobuf:"varint,2,rep,packed,name=weekdays,proto3,enum=schedula.v1.Weekday" json:"weekdays,omitempty"`
+	Until         *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=until,proto3" json:"until,omitempty"`
+	TimeZone      string                 `protobuf:"bytes,4,opt,name=time_zone,json=timeZone,proto3" json:"time_zone,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListAppointmentsRequest) Reset() {
-	*x = ListAppointmentsRequest{}
-	mi := &file_proto_schedula_v1_appointments_proto_msgTypes[4]
+func (x *TimeOffRecurrence) Reset() {
+	*x = TimeOffRecurrence{}
+	mi := &file_proto_schedula_v1_appointments_proto_msgTypes[164]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListAppointmentsRequest) String() string {
+func (x *TimeOffRecurrence) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListAppointmentsRequest) ProtoMessage() {}
+func (*TimeOffRecurrence) ProtoMessage() {}
 
-func (x *ListAppointmentsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_schedula_v1_appointments_proto_msgTypes[4]
+func (x *TimeOffRecurrence) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_schedula_v1_appointments_proto_msgTypes[164]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -416,54 +11542,68 @@ func (x *ListAppointmentsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListAppointmentsRequest.ProtoReflect.Descriptor instead.
-func (*ListAppointmentsRequest) Descriptor() ([]byte, []int) {
-	return file_proto_schedula_v1_appointments_proto_rawDescGZIP(), []int{4}
+// Deprecated: Use TimeOffRecurrence.ProtoReflect.Descriptor instead.
+func (*TimeOffRecurrence) Descriptor() ([]byte, []int) {
+	return file_proto_schedula_v1_appointments_proto_rawDescGZIP(), []int{164}
 }
 
-func (x *ListAppointmentsRequest) GetUserId() string {
+func (x *TimeOffRecurrence) GetInterval() uint32 {
 	if x != nil {
-		return x.UserId
+		return x.Interval
 	}
-	return ""
+	return 0
 }
 
-func (x *ListAppointmentsRequest) GetWindowStart() *timestamppb.Timestamp {
+func (x *TimeOffRecurrence) GetWeekdays() []Weekday {
 	if x != nil {
-		return x.WindowStart
+		return x.Weekdays
 	}
 	return nil
 }
 
-func (x *ListAppointmentsRequest) GetWindowEnd() *timestamppb.Timestamp {
+func (x *TimeOffRecurrence) GetUntil() *timestamppb.Timestamp {
 	if x != nil {
-		return x.WindowEnd
+		return x.Until
 	}
 	return nil
 }
 
-type ListAppointmentsResponse struct {
+func (x *TimeOffRecurrence) GetTimeZone() string {
+	if x != nil {
+		return x.TimeZone
+	}
+	return ""
+}
+
+type TimeOff struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Appointments  []*Appointment         `protobuf:"bytes,1,rep,name=appointments,proto3" json:"appointments,omitempty"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Title         string                 `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
+	StartTime     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	EndTime       *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	Recurrence    *TimeOffRecurrence     `protobuf:"bytes,6,opt,name=recurrence,proto3" json:"recurrence,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListAppointmentsResponse) Reset() {
-	*x = ListAppointmentsResponse{}
-	mi := &file_proto_schedula_v1_appointments_proto_msgTypes[5]
+func (x *TimeOff) Reset() {
+	*x = TimeOff{}
+	mi := &file_proto_schedula_v1_appointments_proto_msgTypes[165]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListAppointmentsResponse) String() string {
+func (x *TimeOff) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListAppointmentsResponse) ProtoMessage() {}
+func (*TimeOff) ProtoMessage() {}
 
-func (x *ListAppointmentsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_schedula_v1_appointments_proto_msgTypes[5]
+func (x *TimeOff) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_schedula_v1_appointments_proto_msgTypes[165]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -474,41 +11614,93 @@ func (x *ListAppointmentsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListAppointmentsResponse.ProtoReflect.Descriptor instead.
-func (*ListAppointmentsResponse) Descriptor() ([]byte, []int) {
-	return file_proto_schedula_v1_appointments_proto_rawDescGZIP(), []int{5}
+// Deprecated: Use TimeOff.ProtoReflect.Descriptor instead.
+func (*TimeOff) Descriptor() ([]byte, []int) {
+	return file_proto_schedula_v1_appointments_proto_rawDescGZIP(), []int{165}
 }
 
-func (x *ListAppointmentsResponse) GetAppointments() []*Appointment {
+func (x *TimeOff) GetId() string {
 	if x != nil {
-		return x.Appointments
+		return x.Id
+	}
+	return ""
+}
+
+func (x *TimeOff) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *TimeOff) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *TimeOff) GetStartTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartTime
 	}
 	return nil
 }
 
-type DeleteAppointmentRequest struct {
+func (x *TimeOff) GetEndTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndTime
+	}
+	return nil
+}
+
+func (x *TimeOff) GetRecurrence() *TimeOffRecurrence {
+	if x != nil {
+		return x.Recurrence
+	}
+	return nil
+}
+
+func (x *TimeOff) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *TimeOff) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type CreateTimeOffRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	AppointmentId string                 `protobuf:"bytes,2,opt,name=appointment_id,json=appointmentId,proto3" json:"appointment_id,omitempty"`
+	Title         string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	StartTime     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	EndTime       *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	Recurrence    *TimeOffRecurrence     `protobuf:"bytes,5,opt,name=recurrence,proto3" json:"recurrence,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteAppointmentRequest) Reset() {
-	*x = DeleteAppointmentRequest{}
-	mi := &file_proto_schedula_v1_appointments_proto_msgTypes[6]
+func (x *CreateTimeOffRequest) Reset() {
+	*x = CreateTimeOffRequest{}
+	mi := &file_proto_schedula_v1_appointments_proto_msgTypes[166]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteAppointmentRequest) String() string {
+func (x *CreateTimeOffRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteAppointmentRequest) ProtoMessage() {}
+func (*CreateTimeOffRequest) ProtoMessage() {}
 
-func (x *DeleteAppointmentRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_schedula_v1_appointments_proto_msgTypes[6]
+func (x *CreateTimeOffRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_schedula_v1_appointments_proto_msgTypes[166]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -519,46 +11711,68 @@ func (x *DeleteAppointmentRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteAppointmentRequest.ProtoReflect.Descriptor instead.
-func (*DeleteAppointmentRequest) Descriptor() ([]byte, []int) {
-	return file_proto_schedula_v1_appointments_proto_rawDescGZIP(), []int{6}
+// Deprecated: Use CreateTimeOffRequest.ProtoReflect.Descriptor instead.
+func (*CreateTimeOffRequest) Descriptor() ([]byte, []int) {
+	return file_proto_schedula_v1_appointments_proto_rawDescGZIP(), []int{166}
 }
 
-func (x *DeleteAppointmentRequest) GetUserId() string {
+func (x *CreateTimeOffRequest) GetUserId() string {
 	if x != nil {
 		return x.UserId
 	}
 	return ""
 }
 
-func (x *DeleteAppointmentRequest) GetAppointmentId() string {
+func (x *CreateTimeOffRequest) GetTitle() string {
 	if x != nil {
-		return x.AppointmentId
+		return x.Title
 	}
 	return ""
 }
 
-type DeleteAppointmentResponse struct {
+func (x *CreateTimeOffRequest) GetStartTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartTime
+	}
+	return nil
+}
+
+func (x *CreateTimeOffRequest) GetEndTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndTime
+	}
+	return nil
+}
+
+func (x *CreateTimeOffRequest) GetRecurrence() *TimeOffRecurrence {
+	if x != nil {
+		return x.Recurrence
+	}
+	return nil
+}
+
+type CreateTimeOffResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
+	TimeOff       *TimeOff               `protobuf:"bytes,1,opt,name=time_off,json=timeOff,proto3" json:"time_off,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteAppointmentResponse) Reset() {
-	*x = DeleteAppointmentResponse{}
-	mi := &file_proto_schedula_v1_appointments_proto_msgTypes[7]
+func (x *CreateTimeOffResponse) Reset() {
+	*x = CreateTimeOffResponse{}
+	mi := &file_proto_schedula_v1_appointments_proto_msgTypes[167]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteAppointmentResponse) String() string {
+func (x *CreateTimeOffResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteAppointmentResponse) ProtoMessage() {}
+func (*CreateTimeOffResponse) ProtoMessage() {}
 
-func (x *DeleteAppointmentResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_schedula_v1_appointments_proto_msgTypes[7]
+func (x *CreateTimeOffResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_schedula_v1_appointments_proto_msgTypes[167]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -569,41 +11783,41 @@ func (x *DeleteAppointmentResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteAppointmentResponse.ProtoReflect.Descriptor instead.
-func (*DeleteAppointmentResponse) Descriptor() ([]byte, []int) {
-	return file_proto_schedula_v1_appointments_proto_rawDescGZIP(), []int{7}
+// Deprecated: Use CreateTimeOffResponse.ProtoReflect.Descriptor instead.
+func (*CreateTimeOffResponse) Descriptor() ([]byte, []int) {
+	return file_proto_schedula_v1_appointments_proto_rawDescGZIP(), []int{167}
 }
 
-type RecurringSeries struct {
+func (x *CreateTimeOffResponse) GetTimeOff() *TimeOff {
+	if x != nil {
+		return x.TimeOff
+	}
+	return nil
+}
+
+type GetTimeOffRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	Title         string                 `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
-	Notes         string                 `protobuf:"bytes,4,opt,name=notes,proto3" json:"notes,omitempty"`
-	StartTime     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
-	EndTime       *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
-	Weekly        *WeeklyRecurrence      `protobuf:"bytes,7,opt,name=weekly,proto3" json:"weekly,omitempty"`
-	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	TimeOffId     string                 `protobuf:"bytes,2,opt,name=time_off_id,json=timeOffId,proto3" json:"time_off_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RecurringSeries) Reset() {
-	*x = RecurringSeries{}
-	mi := &file_proto_schedula_v1_appointments_proto_msgTypes[8]
+func (x *GetTimeOffRequest) Reset() {
+	*x = GetTimeOffRequest{}
+	mi := &file_proto_schedula_v1_appointments_proto_msgTypes[168]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RecurringSeries) String() string {
+func (x *GetTimeOffRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RecurringSeries) ProtoMessage() {}
+func (*GetTimeOffRequest) ProtoMessage() {}
 
-func (x *RecurringSeries) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_schedula_v1_appointments_proto_msgTypes[8]
+func (x *GetTimeOffRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_schedula_v1_appointments_proto_msgTypes[168]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -614,101 +11828,96 @@ func (x *RecurringSeries) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RecurringSeries.ProtoReflect.Descriptor instead.
-func (*RecurringSeries) Descriptor() ([]byte, []int) {
-	return file_proto_schedula_v1_appointments_proto_rawDescGZIP(), []int{8}
-}
-
-func (x *RecurringSeries) GetId() string {
-	if x != nil {
-		return x.Id
-	}
-	return ""
+// Deprecated: Use GetTimeOffRequest.ProtoReflect.Descriptor instead.
+func (*GetTimeOffRequest) Descriptor() ([]byte, []int) {
+	return file_proto_schedula_v1_appointments_proto_rawDescGZIP(), []int{168}
 }
 
-func (x *RecurringSeries) GetUserId() string {
+func (x *GetTimeOffRequest) GetUserId() string {
 	if x != nil {
 		return x.UserId
 	}
 	return ""
 }
 
-func (x *RecurringSeries) GetTitle() string {
+func (x *GetTimeOffRequest) GetTimeOffId() string {
 	if x != nil {
-		return x.Title
+		return x.TimeOffId
 	}
 	return ""
 }
 
-func (x *RecurringSeries) GetNotes() string {
-	if x != nil {
-		return x.Notes
-	}
-	return ""
+type GetTimeOffResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TimeOff       *TimeOff               `protobuf:"bytes,1,opt,name=time_off,json=timeOff,proto3" json:"time_off,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RecurringSeries) GetStartTime() *timestamppb.Timestamp {
-	if x != nil {
-		return x.StartTime
-	}
-	return nil
+func (x *GetTimeOffResponse) Reset() {
+	*x = GetTimeOffResponse{}
+	mi := &file_proto_schedula_v1_appointments_proto_msgTypes[169]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *RecurringSeries) GetEndTime() *timestamppb.Timestamp {
-	if x != nil {
-		return x.EndTime
-	}
-	return nil
+func (x *GetTimeOffResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *RecurringSeries) GetWeekly() *WeeklyRecurrence {
+func (*GetTimeOffResponse) ProtoMessage() {}
+
+func (x *GetTimeOffResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_schedula_v1_appointments_proto_msgTypes[169]
 	if x != nil {
-		return x.Weekly
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *RecurringSeries) GetCreatedAt() *timestamppb.Timestamp {
-	if x != nil {
-		return x.CreatedAt
-	}
-	return nil
+// Deprecated: Use GetTimeOffResponse.ProtoReflect.Descriptor instead.
+func (*GetTimeOffResponse) Descriptor() ([]byte, []int) {
+	return file_proto_schedula_v1_appointments_proto_rawDescGZIP(), []int{169}
 }
 
-func (x *RecurringSeries) GetUpdatedAt() *timestamppb.Timestamp {
+func (x *GetTimeOffResponse) GetTimeOff() *TimeOff {
 	if x != nil {
-		return x.UpdatedAt
+		return x.TimeOff
 	}
 	return nil
 }
 
-type CreateRecurringSeriesRequest struct {
+type UpdateTimeOffRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	Title         string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
-	Notes         string                 `protobuf:"bytes,3,opt,name=notes,proto3" json:"notes,omitempty"`
+	TimeOffId     string                 `protobuf:"bytes,2,opt,name=time_off_id,json=timeOffId,proto3" json:"time_off_id,omitempty"`
+	Title         string                 `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
 	StartTime     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
 	EndTime       *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
-	Weekly        *WeeklyRecurrence      `protobuf:"bytes,6,opt,name=weekly,proto3" json:"weekly,omitempty"`
+	Recurrence    *TimeOffRecurrence     `protobuf:"bytes,6,opt,name=recurrence,proto3" json:"recurrence,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateRecurringSeriesRequest) Reset() {
-	*x = CreateRecurringSeriesRequest{}
-	mi := &file_proto_schedula_v1_appointments_proto_msgTypes[9]
+func (x *UpdateTimeOffRequest) Reset() {
+	*x = UpdateTimeOffRequest{}
+	mi := &file_proto_schedula_v1_appointments_proto_msgTypes[170]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateRecurringSeriesRequest) String() string {
+func (x *UpdateTimeOffRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateRecurringSeriesRequest) ProtoMessage() {}
+func (*UpdateTimeOffRequest) ProtoMessage() {}
 
-func (x *CreateRecurringSeriesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_schedula_v1_appointments_proto_msgTypes[9]
+func (x *UpdateTimeOffRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_schedula_v1_appointments_proto_msgTypes[170]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -719,75 +11928,75 @@ func (x *CreateRecurringSeriesRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateRecurringSeriesRequest.ProtoReflect.Descriptor instead.
-func (*CreateRecurringSeriesRequest) Descriptor() ([]byte, []int) {
-	return file_proto_schedula_v1_appointments_proto_rawDescGZIP(), []int{9}
+// Deprecated: Use UpdateTimeOffRequest.ProtoReflect.Descriptor instead.
+func (*UpdateTimeOffRequest) Descriptor() ([]byte, []int) {
+	return file_proto_schedula_v1_appointments_proto_rawDescGZIP(), []int{170}
 }
 
-func (x *CreateRecurringSeriesRequest) GetUserId() string {
+func (x *UpdateTimeOffRequest) GetUserId() string {
 	if x != nil {
 		return x.UserId
 	}
 	return ""
 }
 
-func (x *CreateRecurringSeriesRequest) GetTitle() string {
+func (x *UpdateTimeOffRequest) GetTimeOffId() string {
 	if x != nil {
-		return x.Title
+		return x.TimeOffId
 	}
 	return ""
 }
 
-func (x *CreateRecurringSeriesRequest) GetNotes() string {
+func (x *UpdateTimeOffRequest) GetTitle() string {
 	if x != nil {
-		return x.Notes
+		return x.Title
 	}
 	return ""
 }
 
-func (x *CreateRecurringSeriesRequest) GetStartTime() *timestamppb.Timestamp {
+func (x *UpdateTimeOffRequest) GetStartTime() *timestamppb.Timestamp {
 	if x != nil {
 		return x.StartTime
 	}
 	return nil
 }
 
-func (x *CreateRecurringSeriesRequest) GetEndTime() *timestamppb.Timestamp {
+func (x *UpdateTimeOffRequest) GetEndTime() *timestamppb.Timestamp {
 	if x != nil {
 		return x.EndTime
 	}
 	return nil
 }
 
-func (x *CreateRecurringSeriesRequest) GetWeekly() *WeeklyRecurrence {
+func (x *UpdateTimeOffRequest) GetRecurrence() *TimeOffRecurrence {
 	if x != nil {
-		return x.Weekly
+		return x.Recurrence
 	}
 	return nil
 }
 
-type CreateRecurringSeriesResponse struct {
+type UpdateTimeOffResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Series        *RecurringSeries       `protobuf:"bytes,1,opt,name=series,proto3" json:"series,omitempty"`
+	TimeOff       *TimeOff               `protobuf:"bytes,1,opt,name=time_off,json=timeOff,proto3" json:"time_off,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateRecurringSeriesResponse) Reset() {
-	*x = CreateRecurringSeriesResponse{}
-	mi := &file_proto_schedula_v1_appointments_proto_msgTypes[10]
+func (x *UpdateTimeOffResponse) Reset() {
+	*x = UpdateTimeOffResponse{}
+	mi := &file_proto_schedula_v1_appointments_proto_msgTypes[171]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateRecurringSeriesResponse) String() string {
+func (x *UpdateTimeOffResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateRecurringSeriesResponse) ProtoMessage() {}
+func (*UpdateTimeOffResponse) ProtoMessage() {}
 
-func (x *CreateRecurringSeriesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_schedula_v1_appointments_proto_msgTypes[10]
+func (x *UpdateTimeOffResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_schedula_v1_appointments_proto_msgTypes[171]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -798,46 +12007,41 @@ func (x *CreateRecurringSeriesResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateRecurringSeriesResponse.ProtoReflect.Descriptor instead.
-func (*CreateRecurringSeriesResponse) Descriptor() ([]byte, []int) {
-	return file_proto_schedula_v1_appointments_proto_rawDescGZIP(), []int{10}
+// Deprecated: Use UpdateTimeOffResponse.ProtoReflect.Descriptor instead.
+func (*UpdateTimeOffResponse) Descriptor() ([]byte, []int) {
+	return file_proto_schedula_v1_appointments_proto_rawDescGZIP(), []int{171}
 }
 
-func (x *CreateRecurringSeriesResponse) GetSeries() *RecurringSeries {
+func (x *UpdateTimeOffResponse) GetTimeOff() *TimeOff {
 	if x != nil {
-		return x.Series
+		return x.TimeOff
 	}
 	return nil
 }
 
-type Occurrence struct {
+type DeleteTimeOffRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	SeriesId      string                 `protobuf:"bytes,1,opt,name=series_id,json=seriesId,proto3" json:"series_id,omitempty"`
-	OccurrenceId  string                 `protobuf:"bytes,2,opt,name=occurrence_id,json=occurrenceId,proto3" json:"occurrence_id,omitempty"`
-	UserId        string                 `protobuf:"bytes,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	Title         string                 `protobuf:"bytes,4,opt,name=title,proto3" json:"title,omitempty"`
-	Notes         string                 `protobuf:"bytes,5,opt,name=notes,proto3" json:"notes,omitempty"`
-	StartTime     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
-	EndTime       *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	TimeOffId     string                 `protobuf:"bytes,2,opt,name=time_off_id,json=timeOffId,proto3" json:"time_off_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Occurrence) Reset() {
-	*x = Occurrence{}
-	mi := &file_proto_schedula_v1_appointments_proto_msgTypes[11]
+func (x *DeleteTimeOffRequest) Reset() {
+	*x = DeleteTimeOffRequest{}
+	mi := &file_proto_schedula_v1_appointments_proto_msgTypes[172]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Occurrence) String() string {
+func (x *DeleteTimeOffRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Occurrence) ProtoMessage() {}
+func (*DeleteTimeOffRequest) ProtoMessage() {}
 
-func (x *Occurrence) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_schedula_v1_appointments_proto_msgTypes[11]
+func (x *DeleteTimeOffRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_schedula_v1_appointments_proto_msgTypes[172]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -848,84 +12052,83 @@ func (x *Occurrence) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Occurrence.ProtoReflect.Descriptor instead.
-func (*Occurrence) Descriptor() ([]byte, []int) {
-	return file_proto_schedula_v1_appointments_proto_rawDescGZIP(), []int{11}
+// Deprecated: Use DeleteTimeOffRequest.ProtoReflect.Descriptor instead.
+func (*DeleteTimeOffRequest) Descriptor() ([]byte, []int) {
+	return file_proto_schedula_v1_appointments_proto_rawDescGZIP(), []int{172}
 }
 
-func (x *Occurrence) GetSeriesId() string {
+func (x *DeleteTimeOffRequest) GetUserId() string {
 	if x != nil {
-		return x.SeriesId
+		return x.UserId
 	}
 	return ""
 }
 
-func (x *Occurrence) GetOccurrenceId() string {
+func (x *DeleteTimeOffRequest) GetTimeOffId() string {
 	if x != nil {
-		return x.OccurrenceId
+		return x.TimeOffId
 	}
 	return ""
 }
 
-func (x *Occurrence) GetUserId() string {
-	if x != nil {
-		return x.UserId
-	}
-	return ""
+type DeleteTimeOffResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Occurrence) GetTitle() string {
-	if x != nil {
-		return x.Title
-	}
-	return ""
+func (x *DeleteTimeOffResponse) Reset() {
+	*x = DeleteTimeOffResponse{}
+	mi := &file_proto_schedula_v1_appointments_proto_msgTypes[173]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *Occurrence) GetNotes() string {
-	if x != nil {
-		return x.Notes
-	}
-	return ""
+func (x *DeleteTimeOffResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *Occurrence) GetStartTime() *timestamppb.Timestamp {
+func (*DeleteTimeOffResponse) ProtoMessage() {}
+
+func (x *DeleteTimeOffResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_schedula_v1_appointments_proto_msgTypes[173]
 	if x != nil {
-		return x.StartTime
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *Occurrence) GetEndTime() *timestamppb.Timestamp {
-	if x != nil {
-		return x.EndTime
-	}
-	return nil
+// Deprecated: Use DeleteTimeOffResponse.ProtoReflect.Descriptor instead.
+func (*DeleteTimeOffResponse) Descriptor() ([]byte, []int) {
+	return file_proto_schedula_v1_appointments_proto_rawDescGZIP(), []int{173}
 }
 
-type ListOccurrencesRequest struct {
+type ListTimeOffRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	WindowStart   *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=window_start,json=windowStart,proto3" json:"window_start,omitempty"`
-	WindowEnd     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=window_end,json=windowEnd,proto3" json:"window_end,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListOccurrencesRequest) Reset() {
-	*x = ListOccurrencesRequest{}
-	mi := &file_proto_schedula_v1_appointments_proto_msgTypes[12]
+func (x *ListTimeOffRequest) Reset() {
+	*x = ListTimeOffRequest{}
+	mi := &file_proto_schedula_v1_appointments_proto_msgTypes[174]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListOccurrencesRequest) String() string {
+func (x *ListTimeOffRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListOccurrencesRequest) ProtoMessage() {}
+func (*ListTimeOffRequest) ProtoMessage() {}
 
-func (x *ListOccurrencesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_schedula_v1_appointments_proto_msgTypes[12]
+func (x *ListTimeOffRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_schedula_v1_appointments_proto_msgTypes[174]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -936,54 +12139,40 @@ func (x *ListOccurrencesRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListOccurrencesRequest.ProtoReflect.Descriptor instead.
-func (*ListOccurrencesRequest) Descriptor() ([]byte, []int) {
-	return file_proto_schedula_v1_appointments_proto_rawDescGZIP(), []int{12}
+// Deprecated: Use ListTimeOffRequest.ProtoReflect.Descriptor instead.
+func (*ListTimeOffRequest) Descriptor() ([]byte, []int) {
+	return file_proto_schedula_v1_appointments_proto_rawDescGZIP(), []int{174}
 }
 
-func (x *ListOccurrencesRequest) GetUserId() string {
+func (x *ListTimeOffRequest) GetUserId() string {
 	if x != nil {
 		return x.UserId
 	}
 	return ""
 }
 
-func (x *ListOccurrencesRequest) GetWindowStart() *timestamppb.Timestamp {
-	if x != nil {
-		return x.WindowStart
-	}
-	return nil
-}
-
-func (x *ListOccurrencesRequest) GetWindowEnd() *timestamppb.Timestamp {
-	if x != nil {
-		return x.WindowEnd
-	}
-	return nil
-}
-
-type ListOccurrencesResponse struct {
+type ListTimeOffResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Occurrences   []*Occurrence          `protobuf:"bytes,1,rep,name=occurrences,proto3" json:"occurrences,omitempty"`
+	TimeOff       []*TimeOff             `protobuf:"bytes,1,rep,name=time_off,json=timeOff,proto3" json:"time_off,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListOccurrencesResponse) Reset() {
-	*x = ListOccurrencesResponse{}
-	mi := &file_proto_schedula_v1_appointments_proto_msgTypes[13]
+func (x *ListTimeOffResponse) Reset() {
+	*x = ListTimeOffResponse{}
+	mi := &file_proto_schedula_v1_appointments_proto_msgTypes[175]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListOccurrencesResponse) String() string {
+func (x *ListTimeOffResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListOccurrencesResponse) ProtoMessage() {}
+func (*ListTimeOffResponse) ProtoMessage() {}
 
-func (x *ListOccurrencesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_schedula_v1_appointments_proto_msgTypes[13]
+func (x *ListTimeOffResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_schedula_v1_appointments_proto_msgTypes[175]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -994,14 +12183,14 @@ func (x *ListOccurrencesResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListOccurrencesResponse.ProtoReflect.Descriptor instead.
-func (*ListOccurrencesResponse) Descriptor() ([]byte, []int) {
-	return file_proto_schedula_v1_appointments_proto_rawDescGZIP(), []int{13}
+// Deprecated: Use ListTimeOffResponse.ProtoReflect.Descriptor instead.
+func (*ListTimeOffResponse) Descriptor() ([]byte, []int) {
+	return file_proto_schedula_v1_appointments_proto_rawDescGZIP(), []int{175}
 }
 
-func (x *ListOccurrencesResponse) GetOccurrences() []*Occurrence {
+func (x *ListTimeOffResponse) GetTimeOff() []*TimeOff {
 	if x != nil {
-		return x.Occurrences
+		return x.TimeOff
 	}
 	return nil
 }
@@ -1010,13 +12199,24 @@ var File_proto_schedula_v1_appointments_proto protoreflect.FileDescriptor
 
 const file_proto_schedula_v1_appointments_proto_rawDesc = "" +
 	"\n" +
-	"$proto/schedula/v1/appointments.proto\x12\vschedula.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"\xc5\x01\n" +
+	"$proto/schedula/v1/appointments.proto\x12\vschedula.v1\x1a\x1egoogle/protobuf/duration.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xcd\x03\n" +
 	"\x10WeeklyRecurrence\x12\x1a\n" +
 	"\binterval\x18\x01 \x01(\rR\binterval\x120\n" +
 	"\bweekdays\x18\x02 \x03(\x0e2\x14.schedula.v1.WeekdayR\bweekdays\x120\n" +
 	"\x05until\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\x05until\x12\x14\n" +
 	"\x05count\x18\x04 \x01(\rR\x05count\x12\x1b\n" +
-	"\ttime_zone\x18\x05 \x01(\tR\btimeZone\"\xca\x02\n" +
+	"\ttime_zone\x18\x05 \x01(\tR\btimeZone\x12?\n" +
+	"\x0edst_gap_policy\x18\x06 \x01(\x0e2\x19.schedula.v1.DstGapPolicyR\fdstGapPolicy\x12Q\n" +
+	"\x14dst_ambiguous_policy\x18\a \x01(\x0e2\x1f.schedula.v1.DstAmbiguousPolicyR\x12dstAmbiguousPolicy\x123\n" +
+	"\n" +
+	"week_start\x18\b \x01(\x0e2\x14.schedula.v1.WeekdayR\tweekStart\x12=\n" +
+	"\rweekday_times\x18\t \x03(\v2\x18.schedula.v1.WeekdayTimeR\fweekdayTimes\"`\n" +
+	"\vWeekdayTime\x12.\n" +
+	"\aweekday\x18\x01 \x01(\x0e2\x14.schedula.v1.WeekdayR\aweekday\x12!\n" +
+	"\fstart_minute\x18\x02 \x01(\rR\vstartMinute\"5\n" +
+	"\vExternalRef\x12\x16\n" +
+	"\x06system\x18\x01 \x01(\tR\x06system\x12\x0e\n" +
+	"\x02id\x18\x02 \x01(\tR\x02id\"\xc3\a\n" +
 	"\vAppointment\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
 	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x14\n" +
@@ -1028,50 +12228,163 @@ const file_proto_schedula_v1_appointments_proto_rawDesc = "" +
 	"\n" +
 	"created_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
 	"\n" +
-	"updated_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\xd1\x01\n" +
+	"updated_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12B\n" +
+	"\bmetadata\x18\t \x03(\v2&.schedula.v1.Appointment.MetadataEntryR\bmetadata\x12;\n" +
+	"\fexternal_ref\x18\n" +
+	" \x01(\v2\x18.schedula.v1.ExternalRefR\vexternalRef\x12\x1b\n" +
+	"\ttime_zone\x18\v \x01(\tR\btimeZone\x12(\n" +
+	"\x10local_start_time\x18\f \x01(\tR\x0elocalStartTime\x12$\n" +
+	"\x0elocal_end_time\x18\r \x01(\tR\flocalEndTime\x12\x1d\n" +
+	"\n" +
+	"created_by\x18\x0e \x01(\tR\tcreatedBy\x12>\n" +
+	"\rchecked_in_at\x18\x0f \x01(\v2\x1a.google.protobuf.TimestampR\vcheckedInAt\x12@\n" +
+	"\x0echecked_out_at\x18\x10 \x01(\v2\x1a.google.protobuf.TimestampR\fcheckedOutAt\x12\x1d\n" +
+	"\n" +
+	"contact_id\x18\x11 \x01(\tR\tcontactId\x12\x16\n" +
+	"\x06source\x18\x12 \x01(\tR\x06source\x120\n" +
+	"\x04kind\x18\x13 \x01(\x0e2\x1c.schedula.v1.AppointmentKindR\x04kind\x12#\n" +
+	"\rprivate_notes\x18\x14 \x01(\tR\fprivateNotes\x12\x1d\n" +
+	"\n" +
+	"program_id\x18\x15 \x01(\tR\tprogramId\x1a;\n" +
+	"\rMetadataEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xe9\x04\n" +
 	"\x18CreateAppointmentRequest\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x14\n" +
 	"\x05title\x18\x02 \x01(\tR\x05title\x12\x14\n" +
 	"\x05notes\x18\x03 \x01(\tR\x05notes\x129\n" +
 	"\n" +
 	"start_time\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tstartTime\x125\n" +
-	"\bend_time\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\aendTime\"W\n" +
+	"\bend_time\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\aendTime\x12O\n" +
+	"\bmetadata\x18\x06 \x03(\v23.schedula.v1.CreateAppointmentRequest.MetadataEntryR\bmetadata\x12;\n" +
+	"\fexternal_ref\x18\a \x01(\v2\x18.schedula.v1.ExternalRefR\vexternalRef\x12\x1b\n" +
+	"\ttime_zone\x18\b \x01(\tR\btimeZone\x12\x19\n" +
+	"\bactor_id\x18\t \x01(\tR\aactorId\x12\x1d\n" +
+	"\n" +
+	"contact_id\x18\n" +
+	" \x01(\tR\tcontactId\x120\n" +
+	"\x04kind\x18\v \x01(\x0e2\x1c.schedula.v1.AppointmentKindR\x04kind\x12#\n" +
+	"\rprivate_notes\x18\f \x01(\tR\fprivateNotes\x12\x1d\n" +
+	"\n" +
+	"program_id\x18\r \x01(\tR\tprogramId\x1a;\n" +
+	"\rMetadataEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\x99\x01\n" +
+	"\x0fBlackoutWarning\x12\x14\n" +
+	"\x05title\x18\x01 \x01(\tR\x05title\x129\n" +
+	"\n" +
+	"start_time\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\tstartTime\x125\n" +
+	"\bend_time\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\aendTime\"7\n" +
+	"\aWarning\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\tR\x04code\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"\x82\x02\n" +
 	"\x19CreateAppointmentResponse\x12:\n" +
-	"\vappointment\x18\x01 \x01(\v2\x18.schedula.v1.AppointmentR\vappointment\"\xac\x01\n" +
+	"\vappointment\x18\x01 \x01(\v2\x18.schedula.v1.AppointmentR\vappointment\x12I\n" +
+	"\x11blackout_warnings\x18\x02 \x03(\v2\x1c.schedula.v1.BlackoutWarningR\x10blackoutWarnings\x12,\n" +
+	"\x12past_start_warning\x18\x03 \x01(\bR\x10pastStartWarning\x120\n" +
+	"\bwarnings\x18\x04 \x03(\v2\x14.schedula.v1.WarningR\bwarnings\"\x9f\x04\n" +
 	"\x17ListAppointmentsRequest\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\tR\x06userId\x12=\n" +
 	"\fwindow_start\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\vwindowStart\x129\n" +
 	"\n" +
-	"window_end\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\twindowEnd\"X\n" +
+	"window_end\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\twindowEnd\x12a\n" +
+	"\x0fmetadata_filter\x18\x04 \x03(\v28.schedula.v1.ListAppointmentsRequest.MetadataFilterEntryR\x0emetadataFilter\x12&\n" +
+	"\x0fsplit_time_zone\x18\x05 \x01(\tR\rsplitTimeZone\x12.\n" +
+	"\x13include_local_times\x18\x06 \x01(\bR\x11includeLocalTimes\x12\x1d\n" +
+	"\n" +
+	"start_sync\x18\a \x01(\bR\tstartSync\x12\x1d\n" +
+	"\n" +
+	"sync_token\x18\b \x01(\tR\tsyncToken\x12\x1d\n" +
+	"\n" +
+	"contact_id\x18\t \x01(\tR\tcontactId\x12\x16\n" +
+	"\x06source\x18\n" +
+	" \x01(\tR\x06source\x1aA\n" +
+	"\x13MetadataFilterEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xad\x01\n" +
+	"\n" +
+	"DaySegment\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1d\n" +
+	"\n" +
+	"local_date\x18\x02 \x01(\tR\tlocalDate\x129\n" +
+	"\n" +
+	"start_time\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\tstartTime\x125\n" +
+	"\bend_time\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\aendTime\"\xbc\x02\n" +
 	"\x18ListAppointmentsResponse\x12<\n" +
-	"\fappointments\x18\x01 \x03(\v2\x18.schedula.v1.AppointmentR\fappointments\"Z\n" +
+	"\fappointments\x18\x01 \x03(\v2\x18.schedula.v1.AppointmentR\fappointments\x12:\n" +
+	"\fday_segments\x18\x02 \x03(\v2\x17.schedula.v1.DaySegmentR\vdaySegments\x12&\n" +
+	"\x0fnext_sync_token\x18\x03 \x01(\tR\rnextSyncToken\x12\x1b\n" +
+	"\tfull_sync\x18\x04 \x01(\bR\bfullSync\x126\n" +
+	"\x17deleted_appointment_ids\x18\x05 \x03(\tR\x15deletedAppointmentIds\x12)\n" +
+	"\x10calendar_version\x18\x06 \x01(\x03R\x0fcalendarVersion\"z\n" +
+	"\"GetAppointmentByExternalRefRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12;\n" +
+	"\fexternal_ref\x18\x02 \x01(\v2\x18.schedula.v1.ExternalRefR\vexternalRef\"a\n" +
+	"#GetAppointmentByExternalRefResponse\x12:\n" +
+	"\vappointment\x18\x01 \x01(\v2\x18.schedula.v1.AppointmentR\vappointment\"u\n" +
 	"\x18DeleteAppointmentRequest\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\tR\x06userId\x12%\n" +
-	"\x0eappointment_id\x18\x02 \x01(\tR\rappointmentId\"\x1b\n" +
-	"\x19DeleteAppointmentResponse\"\x85\x03\n" +
+	"\x0eappointment_id\x18\x02 \x01(\tR\rappointmentId\x12\x19\n" +
+	"\bactor_id\x18\x03 \x01(\tR\aactorId\"\x1b\n" +
+	"\x19DeleteAppointmentResponse\"\xfd\x05\n" +
 	"\x0fRecurringSeries\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
 	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x14\n" +
 	"\x05title\x18\x03 \x01(\tR\x05title\x12\x14\n" +
 	"\x05notes\x18\x04 \x01(\tR\x05notes\x129\n" +
 	"\n" +
-	"start_time\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tstartTime\x125\n" +
-	"\bend_time\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\aendTime\x125\n" +
+	"start_time\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tstartTime\x129\n" +
+	"\bend_time\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampB\x02\x18\x01R\aendTime\x125\n" +
 	"\x06weekly\x18\a \x01(\v2\x1d.schedula.v1.WeeklyRecurrenceR\x06weekly\x129\n" +
 	"\n" +
 	"created_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
 	"\n" +
-	"updated_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\x8c\x02\n" +
+	"updated_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x123\n" +
+	"\x15occurrences_remaining\x18\n" +
+	" \x01(\rR\x14occurrencesRemaining\x12C\n" +
+	"\x0fnext_occurrence\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\x0enextOccurrence\x12F\n" +
+	"\bmetadata\x18\f \x03(\v2*.schedula.v1.RecurringSeries.MetadataEntryR\bmetadata\x12\x1d\n" +
+	"\n" +
+	"created_by\x18\r \x01(\tR\tcreatedBy\x12\x1d\n" +
+	"\n" +
+	"program_id\x18\x0e \x01(\tR\tprogramId\x125\n" +
+	"\bduration\x18\x0f \x01(\v2\x19.google.protobuf.DurationR\bduration\x1a;\n" +
+	"\rMetadataEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xba\x04\n" +
 	"\x1cCreateRecurringSeriesRequest\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x14\n" +
 	"\x05title\x18\x02 \x01(\tR\x05title\x12\x14\n" +
 	"\x05notes\x18\x03 \x01(\tR\x05notes\x129\n" +
 	"\n" +
-	"start_time\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tstartTime\x125\n" +
-	"\bend_time\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\aendTime\x125\n" +
-	"\x06weekly\x18\x06 \x01(\v2\x1d.schedula.v1.WeeklyRecurrenceR\x06weekly\"U\n" +
+	"start_time\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tstartTime\x129\n" +
+	"\bend_time\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampB\x02\x18\x01R\aendTime\x125\n" +
+	"\x06weekly\x18\x06 \x01(\v2\x1d.schedula.v1.WeeklyRecurrenceR\x06weekly\x12S\n" +
+	"\bmetadata\x18\a \x03(\v27.schedula.v1.CreateRecurringSeriesRequest.MetadataEntryR\bmetadata\x12%\n" +
+	"\x0eskip_conflicts\x18\b \x01(\bR\rskipConflicts\x12\x19\n" +
+	"\bactor_id\x18\t \x01(\tR\aactorId\x12\x1d\n" +
+	"\n" +
+	"program_id\x18\n" +
+	" \x01(\tR\tprogramId\x125\n" +
+	"\bduration\x18\v \x01(\v2\x19.google.protobuf.DurationR\bduration\x1a;\n" +
+	"\rMetadataEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\x9f\x02\n" +
 	"\x1dCreateRecurringSeriesResponse\x124\n" +
-	"\x06series\x18\x01 \x01(\v2\x1c.schedula.v1.RecurringSeriesR\x06series\"\x85\x02\n" +
+	"\x06series\x18\x01 \x01(\v2\x1c.schedula.v1.RecurringSeriesR\x06series\x12K\n" +
+	"\x13skipped_occurrences\x18\x02 \x03(\v2\x1a.google.protobuf.TimestampR\x12skippedOccurrences\x12I\n" +
+	"\x11blackout_warnings\x18\x03 \x03(\v2\x1c.schedula.v1.BlackoutWarningR\x10blackoutWarnings\x120\n" +
+	"\bwarnings\x18\x04 \x03(\v2\x14.schedula.v1.WarningR\bwarnings\"Q\n" +
+	"\x19GetRecurringSeriesRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1b\n" +
+	"\tseries_id\x18\x02 \x01(\tR\bseriesId\"R\n" +
+	"\x1aGetRecurringSeriesResponse\x124\n" +
+	"\x06series\x18\x01 \x01(\v2\x1c.schedula.v1.RecurringSeriesR\x06series\"g\n" +
+	"\x1aListRecurringSeriesRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x120\n" +
+	"\bweekdays\x18\x02 \x03(\x0e2\x14.schedula.v1.WeekdayR\bweekdays\"S\n" +
+	"\x1bListRecurringSeriesResponse\x124\n" +
+	"\x06series\x18\x01 \x03(\v2\x1c.schedula.v1.RecurringSeriesR\x06series\"\xf2\x03\n" +
 	"\n" +
 	"Occurrence\x12\x1b\n" +
 	"\tseries_id\x18\x01 \x01(\tR\bseriesId\x12#\n" +
@@ -1081,14 +12394,742 @@ const file_proto_schedula_v1_appointments_proto_rawDesc = "" +
 	"\x05notes\x18\x05 \x01(\tR\x05notes\x129\n" +
 	"\n" +
 	"start_time\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tstartTime\x125\n" +
-	"\bend_time\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\aendTime\"\xab\x01\n" +
+	"\bend_time\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\aendTime\x12A\n" +
+	"\bmetadata\x18\b \x03(\v2%.schedula.v1.Occurrence.MetadataEntryR\bmetadata\x12\x1b\n" +
+	"\ttime_zone\x18\t \x01(\tR\btimeZone\x12(\n" +
+	"\x10local_start_time\x18\n" +
+	" \x01(\tR\x0elocalStartTime\x12$\n" +
+	"\x0elocal_end_time\x18\v \x01(\tR\flocalEndTime\x1a;\n" +
+	"\rMetadataEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xfd\x02\n" +
 	"\x16ListOccurrencesRequest\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\tR\x06userId\x12=\n" +
 	"\fwindow_start\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\vwindowStart\x129\n" +
 	"\n" +
-	"window_end\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\twindowEnd\"T\n" +
+	"window_end\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\twindowEnd\x12&\n" +
+	"\x0fsplit_time_zone\x18\x04 \x01(\tR\rsplitTimeZone\x12.\n" +
+	"\x13include_local_times\x18\x05 \x01(\bR\x11includeLocalTimes\x12\x1d\n" +
+	"\n" +
+	"start_sync\x18\x06 \x01(\bR\tstartSync\x12\x1d\n" +
+	"\n" +
+	"sync_token\x18\a \x01(\tR\tsyncToken\x12:\n" +
+	"\vmax_horizon\x18\b \x01(\v2\x19.google.protobuf.DurationR\n" +
+	"maxHorizon\"\x8f\x03\n" +
 	"\x17ListOccurrencesResponse\x129\n" +
-	"\voccurrences\x18\x01 \x03(\v2\x17.schedula.v1.OccurrenceR\voccurrences*~\n" +
+	"\voccurrences\x18\x01 \x03(\v2\x17.schedula.v1.OccurrenceR\voccurrences\x12:\n" +
+	"\fday_segments\x18\x02 \x03(\v2\x17.schedula.v1.DaySegmentR\vdaySegments\x12&\n" +
+	"\x0fnext_sync_token\x18\x03 \x01(\tR\rnextSyncToken\x12\x1b\n" +
+	"\tfull_sync\x18\x04 \x01(\bR\bfullSync\x12,\n" +
+	"\x12changed_series_ids\x18\x05 \x03(\tR\x10changedSeriesIds\x12)\n" +
+	"\x10calendar_version\x18\x06 \x01(\x03R\x0fcalendarVersion\x12\x1c\n" +
+	"\ttruncated\x18\a \x01(\bR\ttruncated\x12A\n" +
+	"\x0eexpanded_until\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\rexpandedUntil\"\xb8\x02\n" +
+	"\x14OccurrenceAttendance\x12\x1b\n" +
+	"\tseries_id\x18\x01 \x01(\tR\bseriesId\x12#\n" +
+	"\roccurrence_id\x18\x02 \x01(\tR\foccurrenceId\x12%\n" +
+	"\x0eparticipant_id\x18\x03 \x01(\tR\rparticipantId\x125\n" +
+	"\x06status\x18\x04 \x01(\x0e2\x1d.schedula.v1.AttendanceStatusR\x06status\x12E\n" +
+	"\x10occurrence_start\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\x0foccurrenceStart\x129\n" +
+	"\n" +
+	"updated_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\xd0\x01\n" +
+	"\x15MarkAttendanceRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1b\n" +
+	"\tseries_id\x18\x02 \x01(\tR\bseriesId\x12#\n" +
+	"\roccurrence_id\x18\x03 \x01(\tR\foccurrenceId\x12%\n" +
+	"\x0eparticipant_id\x18\x04 \x01(\tR\rparticipantId\x125\n" +
+	"\x06status\x18\x05 \x01(\x0e2\x1d.schedula.v1.AttendanceStatusR\x06status\"[\n" +
+	"\x16MarkAttendanceResponse\x12A\n" +
+	"\n" +
+	"attendance\x18\x01 \x01(\v2!.schedula.v1.OccurrenceAttendanceR\n" +
+	"attendance\"w\n" +
+	"\x1aParticipantAttendanceStats\x12%\n" +
+	"\x0eparticipant_id\x18\x01 \x01(\tR\rparticipantId\x12\x1a\n" +
+	"\battended\x18\x02 \x01(\rR\battended\x12\x16\n" +
+	"\x06missed\x18\x03 \x01(\rR\x06missed\"Q\n" +
+	"\x19GetAttendanceStatsRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1b\n" +
+	"\tseries_id\x18\x02 \x01(\tR\bseriesId\"\x9a\x01\n" +
+	"\x1aGetAttendanceStatsResponse\x12K\n" +
+	"\fparticipants\x18\x01 \x03(\v2'.schedula.v1.ParticipantAttendanceStatsR\fparticipants\x12/\n" +
+	"\x13occurrences_tracked\x18\x02 \x01(\rR\x12occurrencesTracked\"+\n" +
+	"\x10GetLimitsRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\"\xde\x05\n" +
+	"\x11GetLimitsResponse\x12S\n" +
+	"\x18max_appointment_duration\x18\x01 \x01(\v2\x19.google.protobuf.DurationR\x16maxAppointmentDuration\x12J\n" +
+	"\x13recurring_lookahead\x18\x02 \x01(\v2\x19.google.protobuf.DurationR\x12recurringLookahead\x12(\n" +
+	"\x10max_title_length\x18\x03 \x01(\rR\x0emaxTitleLength\x12(\n" +
+	"\x10max_notes_length\x18\x04 \x01(\rR\x0emaxNotesLength\x12!\n" +
+	"\fmax_weekdays\x18\x05 \x01(\rR\vmaxWeekdays\x129\n" +
+	"\x19max_participant_id_length\x18\x06 \x01(\rR\x16maxParticipantIdLength\x12*\n" +
+	"\x11max_message_bytes\x18\a \x01(\rR\x0fmaxMessageBytes\x120\n" +
+	"\x14max_metadata_entries\x18\b \x01(\rR\x12maxMetadataEntries\x125\n" +
+	"\x17max_metadata_key_length\x18\t \x01(\rR\x14maxMetadataKeyLength\x129\n" +
+	"\x19max_metadata_value_length\x18\n" +
+	" \x01(\rR\x16maxMetadataValueLength\x12D\n" +
+	"\x0finterval_bounds\x18\v \x01(\x0e2\x1b.schedula.v1.IntervalBoundsR\x0eintervalBounds\x12*\n" +
+	"\x11max_active_series\x18\f \x01(\rR\x0fmaxActiveSeries\x124\n" +
+	"\x16max_future_occurrences\x18\r \x01(\rR\x14maxFutureOccurrences\"\xa8\x01\n" +
+	"\x13GetAnalyticsRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12=\n" +
+	"\fwindow_start\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\vwindowStart\x129\n" +
+	"\n" +
+	"window_end\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\twindowEnd\"\x93\x03\n" +
+	"\x14GetAnalyticsResponse\x12+\n" +
+	"\x11appointment_count\x18\x01 \x01(\rR\x10appointmentCount\x12D\n" +
+	"\x10average_duration\x18\x02 \x01(\v2\x19.google.protobuf.DurationR\x0faverageDuration\x12\x1a\n" +
+	"\battended\x18\x03 \x01(\rR\battended\x12\x16\n" +
+	"\x06missed\x18\x04 \x01(\rR\x06missed\x12 \n" +
+	"\fno_show_rate\x18\x05 \x01(\x01R\n" +
+	"noShowRate\x12\x1a\n" +
+	"\bsessions\x18\x06 \x01(\rR\bsessions\x12K\n" +
+	"\x14planned_session_time\x18\a \x01(\v2\x19.google.protobuf.DurationR\x12plannedSessionTime\x12I\n" +
+	"\x13actual_session_time\x18\b \x01(\v2\x19.google.protobuf.DurationR\x11actualSessionTime\"\xf1\x01\n" +
+	"\x15SuggestEndTimeRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x129\n" +
+	"\n" +
+	"start_time\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\tstartTime\x12D\n" +
+	"\x10desired_duration\x18\x03 \x01(\v2\x19.google.protobuf.DurationR\x0fdesiredDuration\x12>\n" +
+	"\rworking_hours\x18\x04 \x01(\v2\x19.schedula.v1.WorkingHoursR\fworkingHours\"\xa4\x01\n" +
+	"\x16SuggestEndTimeResponse\x125\n" +
+	"\bend_time\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\aendTime\x125\n" +
+	"\bduration\x18\x02 \x01(\v2\x19.google.protobuf.DurationR\bduration\x12\x1c\n" +
+	"\tshortened\x18\x03 \x01(\bR\tshortened\"\xe0\x01\n" +
+	"\bSlotHold\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x129\n" +
+	"\n" +
+	"start_time\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\tstartTime\x125\n" +
+	"\bend_time\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\aendTime\x129\n" +
+	"\n" +
+	"expires_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\"\xcc\x01\n" +
+	"\x12ReserveSlotRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x129\n" +
+	"\n" +
+	"start_time\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\tstartTime\x125\n" +
+	"\bend_time\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\aendTime\x12+\n" +
+	"\x03ttl\x18\x04 \x01(\v2\x19.google.protobuf.DurationR\x03ttl\"@\n" +
+	"\x13ReserveSlotResponse\x12)\n" +
+	"\x04hold\x18\x01 \x01(\v2\x15.schedula.v1.SlotHoldR\x04hold\"\xfa\x01\n" +
+	"\x12ConfirmHoldRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x17\n" +
+	"\ahold_id\x18\x02 \x01(\tR\x06holdId\x12\x14\n" +
+	"\x05title\x18\x03 \x01(\tR\x05title\x12\x14\n" +
+	"\x05notes\x18\x04 \x01(\tR\x05notes\x12I\n" +
+	"\bmetadata\x18\x05 \x03(\v2-.schedula.v1.ConfirmHoldRequest.MetadataEntryR\bmetadata\x1a;\n" +
+	"\rMetadataEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\x83\x01\n" +
+	"\x13ConfirmHoldResponse\x12:\n" +
+	"\vappointment\x18\x01 \x01(\v2\x18.schedula.v1.AppointmentR\vappointment\x120\n" +
+	"\bwarnings\x18\x02 \x03(\v2\x14.schedula.v1.WarningR\bwarnings\"F\n" +
+	"\x12ReleaseHoldRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x17\n" +
+	"\ahold_id\x18\x02 \x01(\tR\x06holdId\"\x15\n" +
+	"\x13ReleaseHoldResponse\"\xe3\x04\n" +
+	"\x13AppointmentProposal\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1f\n" +
+	"\vproposer_id\x18\x02 \x01(\tR\n" +
+	"proposerId\x12!\n" +
+	"\frecipient_id\x18\x03 \x01(\tR\vrecipientId\x12\x14\n" +
+	"\x05title\x18\x04 \x01(\tR\x05title\x12\x14\n" +
+	"\x05notes\x18\x05 \x01(\tR\x05notes\x129\n" +
+	"\n" +
+	"start_time\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tstartTime\x125\n" +
+	"\bend_time\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\aendTime\x123\n" +
+	"\x06status\x18\b \x01(\x0e2\x1b.schedula.v1.ProposalStatusR\x06status\x129\n" +
+	"\n" +
+	"expires_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\x129\n" +
+	"\n" +
+	"created_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12=\n" +
+	"\fresponded_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\vrespondedAt\x126\n" +
+	"\x17proposer_appointment_id\x18\f \x01(\tR\x15proposerAppointmentId\x128\n" +
+	"\x18recipient_appointment_id\x18\r \x01(\tR\x16recipientAppointmentId\"\xaa\x02\n" +
+	"\x19ProposeAppointmentRequest\x12\x1f\n" +
+	"\vproposer_id\x18\x01 \x01(\tR\n" +
+	"proposerId\x12!\n" +
+	"\frecipient_id\x18\x02 \x01(\tR\vrecipientId\x12\x14\n" +
+	"\x05title\x18\x03 \x01(\tR\x05title\x12\x14\n" +
+	"\x05notes\x18\x04 \x01(\tR\x05notes\x129\n" +
+	"\n" +
+	"start_time\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tstartTime\x125\n" +
+	"\bend_time\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\aendTime\x12+\n" +
+	"\x03ttl\x18\a \x01(\v2\x19.google.protobuf.DurationR\x03ttl\"Z\n" +
+	"\x1aProposeAppointmentResponse\x12<\n" +
+	"\bproposal\x18\x01 \x01(\v2 .schedula.v1.AppointmentProposalR\bproposal\"/\n" +
+	"\x14ListProposalsRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\"W\n" +
+	"\x15ListProposalsResponse\x12>\n" +
+	"\tproposals\x18\x01 \x03(\v2 .schedula.v1.AppointmentProposalR\tproposals\"Q\n" +
+	"\x15AcceptProposalRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1f\n" +
+	"\vproposal_id\x18\x02 \x01(\tR\n" +
+	"proposalId\"V\n" +
+	"\x16AcceptProposalResponse\x12<\n" +
+	"\bproposal\x18\x01 \x01(\v2 .schedula.v1.AppointmentProposalR\bproposal\"R\n" +
+	"\x16DeclineProposalRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1f\n" +
+	"\vproposal_id\x18\x02 \x01(\tR\n" +
+	"proposalId\"W\n" +
+	"\x17DeclineProposalResponse\x12<\n" +
+	"\bproposal\x18\x01 \x01(\v2 .schedula.v1.AppointmentProposalR\bproposal\"\xa4\x01\n" +
+	"\x0fAppointmentLink\x12%\n" +
+	"\x0eappointment_id\x18\x01 \x01(\tR\rappointmentId\x124\n" +
+	"\x16related_appointment_id\x18\x02 \x01(\tR\x14relatedAppointmentId\x124\n" +
+	"\x04kind\x18\x03 \x01(\x0e2 .schedula.v1.AppointmentLinkKindR\x04kind\"\xc5\x01\n" +
+	"\x17LinkAppointmentsRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12%\n" +
+	"\x0eappointment_id\x18\x02 \x01(\tR\rappointmentId\x124\n" +
+	"\x16related_appointment_id\x18\x03 \x01(\tR\x14relatedAppointmentId\x124\n" +
+	"\x04kind\x18\x04 \x01(\x0e2 .schedula.v1.AppointmentLinkKindR\x04kind\"L\n" +
+	"\x18LinkAppointmentsResponse\x120\n" +
+	"\x04link\x18\x01 \x01(\v2\x1c.schedula.v1.AppointmentLinkR\x04link\"\xc7\x01\n" +
+	"\x19UnlinkAppointmentsRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12%\n" +
+	"\x0eappointment_id\x18\x02 \x01(\tR\rappointmentId\x124\n" +
+	"\x16related_appointment_id\x18\x03 \x01(\tR\x14relatedAppointmentId\x124\n" +
+	"\x04kind\x18\x04 \x01(\x0e2 .schedula.v1.AppointmentLinkKindR\x04kind\"\x1c\n" +
+	"\x1aUnlinkAppointmentsResponse\"\x82\x01\n" +
+	"\x12RelatedAppointment\x120\n" +
+	"\x04link\x18\x01 \x01(\v2\x1c.schedula.v1.AppointmentLinkR\x04link\x12:\n" +
+	"\vappointment\x18\x02 \x01(\v2\x18.schedula.v1.AppointmentR\vappointment\"T\n" +
+	"\x12ListRelatedRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12%\n" +
+	"\x0eappointment_id\x18\x02 \x01(\tR\rappointmentId\"P\n" +
+	"\x13ListRelatedResponse\x129\n" +
+	"\arelated\x18\x01 \x03(\v2\x1f.schedula.v1.RelatedAppointmentR\arelated\"\xa2\x01\n" +
+	"\x18MergeAppointmentsRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1d\n" +
+	"\n" +
+	"primary_id\x18\x02 \x01(\tR\tprimaryId\x12#\n" +
+	"\rduplicate_ids\x18\x03 \x03(\tR\fduplicateIds\x12)\n" +
+	"\x10cover_duplicates\x18\x04 \x01(\bR\x0fcoverDuplicates\"\xc9\x01\n" +
+	"\x19MergeAppointmentsResponse\x12:\n" +
+	"\vappointment\x18\x01 \x01(\v2\x18.schedula.v1.AppointmentR\vappointment\x12\x1f\n" +
+	"\vdeleted_ids\x18\x02 \x03(\tR\n" +
+	"deletedIds\x12\x1f\n" +
+	"\vlinks_moved\x18\x03 \x01(\rR\n" +
+	"linksMoved\x12.\n" +
+	"\x13sync_mappings_moved\x18\x04 \x01(\rR\x11syncMappingsMoved\"\x80\x01\n" +
+	"\fBusyInterval\x129\n" +
+	"\n" +
+	"start_time\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\tstartTime\x125\n" +
+	"\bend_time\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\aendTime\"\x9a\x01\n" +
+	"\fUserFreeBusy\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12-\n" +
+	"\x04busy\x18\x02 \x03(\v2\x19.schedula.v1.BusyIntervalR\x04busy\x12\x1d\n" +
+	"\n" +
+	"error_code\x18\x03 \x01(\tR\terrorCode\x12#\n" +
+	"\rerror_message\x18\x04 \x01(\tR\ferrorMessage\"\xae\x01\n" +
+	"\x17BatchGetFreeBusyRequest\x12\x19\n" +
+	"\buser_ids\x18\x01 \x03(\tR\auserIds\x12=\n" +
+	"\fwindow_start\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\vwindowStart\x129\n" +
+	"\n" +
+	"window_end\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\twindowEnd\"O\n" +
+	"\x18BatchGetFreeBusyResponse\x123\n" +
+	"\aresults\x18\x01 \x03(\v2\x19.schedula.v1.UserFreeBusyR\aresults\"}\n" +
+	"\tTimeRange\x129\n" +
+	"\n" +
+	"start_time\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\tstartTime\x125\n" +
+	"\bend_time\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\aendTime\"\x9f\x01\n" +
+	"\fWorkingHours\x12\x1b\n" +
+	"\ttime_zone\x18\x01 \x01(\tR\btimeZone\x12!\n" +
+	"\fstart_minute\x18\x02 \x01(\rR\vstartMinute\x12\x1d\n" +
+	"\n" +
+	"end_minute\x18\x03 \x01(\rR\tendMinute\x120\n" +
+	"\bweekdays\x18\x04 \x03(\x0e2\x14.schedula.v1.WeekdayR\bweekdays\"\xa0\x01\n" +
+	"\x0fMeetingAttendee\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12>\n" +
+	"\rworking_hours\x18\x02 \x01(\v2\x19.schedula.v1.WorkingHoursR\fworkingHours\x124\n" +
+	"\tpreferred\x18\x03 \x03(\v2\x16.schedula.v1.TimeRangeR\tpreferred\"\xd9\x02\n" +
+	"\x1aSuggestMeetingTimesRequest\x12:\n" +
+	"\tattendees\x18\x01 \x03(\v2\x1c.schedula.v1.MeetingAttendeeR\tattendees\x125\n" +
+	"\bduration\x18\x02 \x01(\v2\x19.google.protobuf.DurationR\bduration\x12=\n" +
+	"\fwindow_start\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\vwindowStart\x129\n" +
+	"\n" +
+	"window_end\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\twindowEnd\x12-\n" +
+	"\x04step\x18\x05 \x01(\v2\x19.google.protobuf.DurationR\x04step\x12\x1f\n" +
+	"\vmax_results\x18\x06 \x01(\rR\n" +
+	"maxResults\"\xcf\x01\n" +
+	"\x11MeetingSuggestion\x129\n" +
+	"\n" +
+	"start_time\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\tstartTime\x125\n" +
+	"\bend_time\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\aendTime\x12\x14\n" +
+	"\x05score\x18\x03 \x01(\x01R\x05score\x122\n" +
+	"\x15outside_working_hours\x18\x04 \x03(\tR\x13outsideWorkingHours\"_\n" +
+	"\x1bSuggestMeetingTimesResponse\x12@\n" +
+	"\vsuggestions\x18\x01 \x03(\v2\x1e.schedula.v1.MeetingSuggestionR\vsuggestions\"\x97\x01\n" +
+	"\x0eSimulatedStaff\x12\x14\n" +
+	"\x05label\x18\x01 \x01(\tR\x05label\x12>\n" +
+	"\rworking_hours\x18\x02 \x01(\v2\x19.schedula.v1.WorkingHoursR\fworkingHours\x12/\n" +
+	"\x06breaks\x18\x03 \x03(\v2\x17.schedula.v1.DailyBreakR\x06breaks\"\xb9\x01\n" +
+	"\x0eBookingPattern\x12\x14\n" +
+	"\x05label\x18\x01 \x01(\tR\x05label\x125\n" +
+	"\bduration\x18\x02 \x01(\v2\x19.google.protobuf.DurationR\bduration\x12(\n" +
+	"\x10bookings_per_day\x18\x03 \x01(\rR\x0ebookingsPerDay\x120\n" +
+	"\bweekdays\x18\x04 \x03(\x0e2\x14.schedula.v1.WeekdayR\bweekdays\"\xcb\x02\n" +
+	"\x17SimulateScheduleRequest\x121\n" +
+	"\x05staff\x18\x01 \x03(\v2\x1b.schedula.v1.SimulatedStaffR\x05staff\x127\n" +
+	"\bpatterns\x18\x02 \x03(\v2\x1b.schedula.v1.BookingPatternR\bpatterns\x12=\n" +
+	"\fwindow_start\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\vwindowStart\x129\n" +
+	"\n" +
+	"window_end\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\twindowEnd\x12\x1b\n" +
+	"\ttime_zone\x18\x05 \x01(\tR\btimeZone\x12-\n" +
+	"\x04step\x18\x06 \x01(\v2\x19.google.protobuf.DurationR\x04step\"\xa1\x01\n" +
+	"\x13ScheduleUtilization\x125\n" +
+	"\bcapacity\x18\x01 \x01(\v2\x19.google.protobuf.DurationR\bcapacity\x121\n" +
+	"\x06booked\x18\x02 \x01(\v2\x19.google.protobuf.DurationR\x06booked\x12 \n" +
+	"\vutilization\x18\x03 \x01(\x01R\vutilization\"\xa0\x01\n" +
+	"\fSimulatedDay\x12\x12\n" +
+	"\x04date\x18\x01 \x01(\tR\x04date\x12B\n" +
+	"\vutilization\x18\x02 \x01(\v2 .schedula.v1.ScheduleUtilizationR\vutilization\x12\x1c\n" +
+	"\trequested\x18\x03 \x01(\rR\trequested\x12\x1a\n" +
+	"\bunbooked\x18\x04 \x01(\rR\bunbooked\"\x91\x01\n" +
+	"\x19SimulatedStaffUtilization\x12\x14\n" +
+	"\x05label\x18\x01 \x01(\tR\x05label\x12B\n" +
+	"\vutilization\x18\x02 \x01(\v2 .schedula.v1.ScheduleUtilizationR\vutilization\x12\x1a\n" +
+	"\bbookings\x18\x03 \x01(\rR\bbookings\"g\n" +
+	"\x15BookingPatternOutcome\x12\x14\n" +
+	"\x05label\x18\x01 \x01(\tR\x05label\x12\x1c\n" +
+	"\trequested\x18\x02 \x01(\rR\trequested\x12\x1a\n" +
+	"\bunbooked\x18\x03 \x01(\rR\bunbooked\"\xc5\x02\n" +
+	"\x18SimulateScheduleResponse\x12B\n" +
+	"\vutilization\x18\x01 \x01(\v2 .schedula.v1.ScheduleUtilizationR\vutilization\x12\x1c\n" +
+	"\trequested\x18\x02 \x01(\rR\trequested\x12\x1a\n" +
+	"\bunbooked\x18\x03 \x01(\rR\bunbooked\x12-\n" +
+	"\x04days\x18\x04 \x03(\v2\x19.schedula.v1.SimulatedDayR\x04days\x12<\n" +
+	"\x05staff\x18\x05 \x03(\v2&.schedula.v1.SimulatedStaffUtilizationR\x05staff\x12>\n" +
+	"\bpatterns\x18\x06 \x03(\v2\".schedula.v1.BookingPatternOutcomeR\bpatterns\"\xc9\x01\n" +
+	"\rSeriesFinding\x122\n" +
+	"\x04kind\x18\x01 \x01(\x0e2\x1e.schedula.v1.SeriesFindingKindR\x04kind\x12!\n" +
+	"\fexception_id\x18\x02 \x01(\tR\vexceptionId\x12E\n" +
+	"\x10occurrence_start\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\x0foccurrenceStart\x12\x1a\n" +
+	"\brepaired\x18\x04 \x01(\bR\brepaired\"j\n" +
+	"\x1cRepairRecurringSeriesRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1b\n" +
+	"\tseries_id\x18\x02 \x01(\tR\bseriesId\x12\x14\n" +
+	"\x05apply\x18\x03 \x01(\bR\x05apply\"s\n" +
+	"\x1dRepairRecurringSeriesResponse\x126\n" +
+	"\bfindings\x18\x01 \x03(\v2\x1a.schedula.v1.SeriesFindingR\bfindings\x12\x1a\n" +
+	"\brepaired\x18\x02 \x01(\rR\brepaired\"\xb8\x02\n" +
+	"\rCalendarEntry\x12%\n" +
+	"\x0eappointment_id\x18\x01 \x01(\tR\rappointmentId\x12\x1b\n" +
+	"\tseries_id\x18\x02 \x01(\tR\bseriesId\x12#\n" +
+	"\roccurrence_id\x18\x03 \x01(\tR\foccurrenceId\x12\x14\n" +
+	"\x05title\x18\x04 \x01(\tR\x05title\x12\x16\n" +
+	"\x06source\x18\x05 \x01(\tR\x06source\x129\n" +
+	"\n" +
+	"start_time\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tstartTime\x125\n" +
+	"\bend_time\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\aendTime\x12\x1e\n" +
+	"\n" +
+	"overridden\x18\b \x01(\bR\n" +
+	"overridden\"\xa8\x02\n" +
+	"\x10CalendarConflict\x120\n" +
+	"\x05first\x18\x01 \x01(\v2\x1a.schedula.v1.CalendarEntryR\x05first\x122\n" +
+	"\x06second\x18\x02 \x01(\v2\x1a.schedula.v1.CalendarEntryR\x06second\x12?\n" +
+	"\roverlap_start\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\foverlapStart\x12;\n" +
+	"\voverlap_end\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"overlapEnd\x120\n" +
+	"\x05cause\x18\x05 \x01(\x0e2\x1a.schedula.v1.ConflictCauseR\x05cause\"\xa9\x01\n" +
+	"\x14AuditCalendarRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12=\n" +
+	"\fwindow_start\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\vwindowStart\x129\n" +
+	"\n" +
+	"window_end\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\twindowEnd\"}\n" +
+	"\x15AuditCalendarResponse\x12;\n" +
+	"\tconflicts\x18\x01 \x03(\v2\x1d.schedula.v1.CalendarConflictR\tconflicts\x12'\n" +
+	"\x0fentries_scanned\x18\x02 \x01(\rR\x0eentriesScanned\"a\n" +
+	"\x15GetDailyAgendaRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x12\n" +
+	"\x04date\x18\x02 \x01(\tR\x04date\x12\x1b\n" +
+	"\ttime_zone\x18\x03 \x01(\tR\btimeZone\"\xd4\x01\n" +
+	"\n" +
+	"AgendaItem\x120\n" +
+	"\x05entry\x18\x01 \x01(\v2\x1a.schedula.v1.CalendarEntryR\x05entry\x12\x1c\n" +
+	"\tmilestone\x18\x02 \x01(\bR\tmilestone\x128\n" +
+	"\n" +
+	"gap_before\x18\x03 \x01(\v2\x19.google.protobuf.DurationR\tgapBefore\x12 \n" +
+	"\fback_to_back\x18\x04 \x01(\bR\n" +
+	"backToBack\x12\x1a\n" +
+	"\boverlaps\x18\x05 \x01(\bR\boverlaps\"\xb0\x01\n" +
+	"\x16GetDailyAgendaResponse\x12\x12\n" +
+	"\x04date\x18\x01 \x01(\tR\x04date\x12\x1b\n" +
+	"\ttime_zone\x18\x02 \x01(\tR\btimeZone\x12-\n" +
+	"\x05items\x18\x03 \x03(\v2\x17.schedula.v1.AgendaItemR\x05items\x126\n" +
+	"\tbusy_time\x18\x04 \x01(\v2\x19.google.protobuf.DurationR\bbusyTime\"g\n" +
+	"\x16GetDaySummariesRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1b\n" +
+	"\tfrom_date\x18\x02 \x01(\tR\bfromDate\x12\x17\n" +
+	"\ato_date\x18\x03 \x01(\tR\x06toDate\"\x9e\x01\n" +
+	"\n" +
+	"DaySummary\x12\x12\n" +
+	"\x04date\x18\x01 \x01(\tR\x04date\x12\"\n" +
+	"\fappointments\x18\x02 \x01(\rR\fappointments\x12 \n" +
+	"\voccurrences\x18\x03 \x01(\rR\voccurrences\x126\n" +
+	"\tbusy_time\x18\x04 \x01(\v2\x19.google.protobuf.DurationR\bbusyTime\"c\n" +
+	"\x17GetDaySummariesResponse\x12\x1b\n" +
+	"\ttime_zone\x18\x01 \x01(\tR\btimeZone\x12+\n" +
+	"\x04days\x18\x02 \x03(\v2\x17.schedula.v1.DaySummaryR\x04days\"\x96\x01\n" +
+	"\x16UpdateSeriesEndRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1b\n" +
+	"\tseries_id\x18\x02 \x01(\tR\bseriesId\x120\n" +
+	"\x05until\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\x05until\x12\x14\n" +
+	"\x05count\x18\x04 \x01(\rR\x05count\"~\n" +
+	"\x17UpdateSeriesEndResponse\x124\n" +
+	"\x06series\x18\x01 \x01(\v2\x1c.schedula.v1.RecurringSeriesR\x06series\x12-\n" +
+	"\x12removed_exceptions\x18\x02 \x01(\rR\x11removedExceptions\"\xea\x01\n" +
+	"\x1bChangeSeriesTimeZoneRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1d\n" +
+	"\n" +
+	"series_ids\x18\x02 \x03(\tR\tseriesIds\x12\x1b\n" +
+	"\ttime_zone\x18\x03 \x01(\tR\btimeZone\x12-\n" +
+	"\x04keep\x18\x04 \x01(\x0e2\x19.schedula.v1.TimeZoneKeepR\x04keep\x12\x18\n" +
+	"\apreview\x18\x05 \x01(\bR\apreview\x12-\n" +
+	"\x12confirmation_token\x18\x06 \x01(\tR\x11confirmationToken\"\x86\x02\n" +
+	"\x1cChangeSeriesTimeZoneResponse\x124\n" +
+	"\x06series\x18\x01 \x03(\v2\x1c.schedula.v1.RecurringSeriesR\x06series\x12-\n" +
+	"\x12removed_exceptions\x18\x02 \x01(\rR\x11removedExceptions\x12-\n" +
+	"\x12confirmation_token\x18\x03 \x01(\tR\x11confirmationToken\x12R\n" +
+	"\x17confirmation_expires_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\x15confirmationExpiresAt\"\xbf\x02\n" +
+	"\x16SkipOccurrencesRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1b\n" +
+	"\tseries_id\x18\x02 \x01(\tR\bseriesId\x12=\n" +
+	"\fwindow_start\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\vwindowStart\x129\n" +
+	"\n" +
+	"window_end\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\twindowEnd\x120\n" +
+	"\bweekdays\x18\x05 \x03(\x0e2\x14.schedula.v1.WeekdayR\bweekdays\x12\x14\n" +
+	"\x05apply\x18\x06 \x01(\bR\x05apply\x12-\n" +
+	"\x12confirmation_token\x18\a \x01(\tR\x11confirmationToken\"\xff\x01\n" +
+	"\x17SkipOccurrencesResponse\x12G\n" +
+	"\x11occurrence_starts\x18\x01 \x03(\v2\x1a.google.protobuf.TimestampR\x10occurrenceStarts\x12\x18\n" +
+	"\askipped\x18\x02 \x01(\rR\askipped\x12-\n" +
+	"\x12confirmation_token\x18\x03 \x01(\tR\x11confirmationToken\x12R\n" +
+	"\x17confirmation_expires_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\x15confirmationExpiresAt\"\x90\x01\n" +
+	"\x0fDelegationGrant\x12!\n" +
+	"\fprincipal_id\x18\x01 \x01(\tR\vprincipalId\x12\x1f\n" +
+	"\vdelegate_id\x18\x02 \x01(\tR\n" +
+	"delegateId\x129\n" +
+	"\n" +
+	"created_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"\\\n" +
+	"\x16GrantDelegationRequest\x12!\n" +
+	"\fprincipal_id\x18\x01 \x01(\tR\vprincipalId\x12\x1f\n" +
+	"\vdelegate_id\x18\x02 \x01(\tR\n" +
+	"delegateId\"M\n" +
+	"\x17GrantDelegationResponse\x122\n" +
+	"\x05grant\x18\x01 \x01(\v2\x1c.schedula.v1.DelegationGrantR\x05grant\"]\n" +
+	"\x17RevokeDelegationRequest\x12!\n" +
+	"\fprincipal_id\x18\x01 \x01(\tR\vprincipalId\x12\x1f\n" +
+	"\vdelegate_id\x18\x02 \x01(\tR\n" +
+	"delegateId\"\x1a\n" +
+	"\x18RevokeDelegationResponse\";\n" +
+	"\x16ListDelegationsRequest\x12!\n" +
+	"\fprincipal_id\x18\x01 \x01(\tR\vprincipalId\"O\n" +
+	"\x17ListDelegationsResponse\x124\n" +
+	"\x06grants\x18\x01 \x03(\v2\x1c.schedula.v1.DelegationGrantR\x06grants\"\xc9\x01\n" +
+	"\x17WatchOccurrencesRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1b\n" +
+	"\tseries_id\x18\x02 \x01(\tR\bseriesId\x12=\n" +
+	"\fwindow_start\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\vwindowStart\x129\n" +
+	"\n" +
+	"window_end\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\twindowEnd\"\x8b\x01\n" +
+	"\x18WatchOccurrencesResponse\x124\n" +
+	"\x06series\x18\x01 \x01(\v2\x1c.schedula.v1.RecurringSeriesR\x06series\x129\n" +
+	"\voccurrences\x18\x02 \x03(\v2\x17.schedula.v1.OccurrenceR\voccurrences\"\xcb\x01\n" +
+	"\x0eCalendarChange\x12:\n" +
+	"\ventity_type\x18\x01 \x01(\x0e2\x19.schedula.v1.ChangeEntityR\n" +
+	"entityType\x12\x1b\n" +
+	"\tentity_id\x18\x02 \x01(\tR\bentityId\x12%\n" +
+	"\x02op\x18\x03 \x01(\x0e2\x15.schedula.v1.ChangeOpR\x02op\x129\n" +
+	"\n" +
+	"changed_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tchangedAt\"\x9c\x01\n" +
+	"\x12ListChangesRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12!\n" +
+	"\fsince_cursor\x18\x02 \x01(\tR\vsinceCursor\x12\x1b\n" +
+	"\tpage_size\x18\x03 \x01(\x05R\bpageSize\x12-\n" +
+	"\x04wait\x18\x04 \x01(\v2\x19.google.protobuf.DurationR\x04wait\"\x88\x01\n" +
+	"\x13ListChangesResponse\x125\n" +
+	"\achanges\x18\x01 \x03(\v2\x1b.schedula.v1.CalendarChangeR\achanges\x12\x1f\n" +
+	"\vnext_cursor\x18\x02 \x01(\tR\n" +
+	"nextCursor\x12\x19\n" +
+	"\bhas_more\x18\x03 \x01(\bR\ahasMore\"0\n" +
+	"\x15ExportCalendarRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\"0\n" +
+	"\x16ExportCalendarResponse\x12\x16\n" +
+	"\x06bundle\x18\x01 \x01(\fR\x06bundle\"H\n" +
+	"\x15ImportCalendarRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x16\n" +
+	"\x06bundle\x18\x02 \x01(\fR\x06bundle\"\xd4\x01\n" +
+	"\x16ImportCalendarResponse\x123\n" +
+	"\x15appointments_imported\x18\x01 \x01(\x05R\x14appointmentsImported\x12'\n" +
+	"\x0fseries_imported\x18\x02 \x01(\x05R\x0eseriesImported\x12/\n" +
+	"\x13exceptions_imported\x18\x03 \x01(\x05R\x12exceptionsImported\x12+\n" +
+	"\x11contacts_imported\x18\x04 \x01(\x05R\x10contactsImported\"\xe6\x02\n" +
+	"\x10CalendarSnapshot\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x14\n" +
+	"\x05label\x18\x03 \x01(\tR\x05label\x12%\n" +
+	"\x0eformat_version\x18\x04 \x01(\x05R\rformatVersion\x12\x1a\n" +
+	"\bchecksum\x18\x05 \x01(\tR\bchecksum\x12\x1d\n" +
+	"\n" +
+	"size_bytes\x18\x06 \x01(\x05R\tsizeBytes\x12\x1a\n" +
+	"\bcontacts\x18\a \x01(\x05R\bcontacts\x12\"\n" +
+	"\fappointments\x18\b \x01(\x05R\fappointments\x12\x16\n" +
+	"\x06series\x18\t \x01(\x05R\x06series\x12\x1e\n" +
+	"\n" +
+	"exceptions\x18\n" +
+	" \x01(\x05R\n" +
+	"exceptions\x129\n" +
+	"\n" +
+	"created_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"N\n" +
+	"\x1dCreateCalendarSnapshotRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x14\n" +
+	"\x05label\x18\x02 \x01(\tR\x05label\"[\n" +
+	"\x1eCreateCalendarSnapshotResponse\x129\n" +
+	"\bsnapshot\x18\x01 \x01(\v2\x1d.schedula.v1.CalendarSnapshotR\bsnapshot\"7\n" +
+	"\x1cListCalendarSnapshotsRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\"\\\n" +
+	"\x1dListCalendarSnapshotsResponse\x12;\n" +
+	"\tsnapshots\x18\x01 \x03(\v2\x1d.schedula.v1.CalendarSnapshotR\tsnapshots\"Z\n" +
+	"\x1eRestoreCalendarSnapshotRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1f\n" +
+	"\vsnapshot_id\x18\x02 \x01(\tR\n" +
+	"snapshotId\"\x95\x03\n" +
+	"\x1fRestoreCalendarSnapshotResponse\x129\n" +
+	"\bsnapshot\x18\x01 \x01(\v2\x1d.schedula.v1.CalendarSnapshotR\bsnapshot\x121\n" +
+	"\x14appointments_created\x18\x02 \x01(\x05R\x13appointmentsCreated\x121\n" +
+	"\x14appointments_updated\x18\x03 \x01(\x05R\x13appointmentsUpdated\x121\n" +
+	"\x14appointments_deleted\x18\x04 \x01(\x05R\x13appointmentsDeleted\x12%\n" +
+	"\x0eseries_created\x18\x05 \x01(\x05R\rseriesCreated\x12%\n" +
+	"\x0eseries_updated\x18\x06 \x01(\x05R\rseriesUpdated\x12%\n" +
+	"\x0eseries_deleted\x18\a \x01(\x05R\rseriesDeleted\x12)\n" +
+	"\x10contacts_changed\x18\b \x01(\x05R\x0fcontactsChanged\"\xe8\x01\n" +
+	"\aContact\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12\x14\n" +
+	"\x05email\x18\x04 \x01(\tR\x05email\x12\x14\n" +
+	"\x05phone\x18\x05 \x01(\tR\x05phone\x129\n" +
+	"\n" +
+	"created_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"o\n" +
+	"\x14CreateContactRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x14\n" +
+	"\x05email\x18\x03 \x01(\tR\x05email\x12\x14\n" +
+	"\x05phone\x18\x04 \x01(\tR\x05phone\"G\n" +
+	"\x15CreateContactResponse\x12.\n" +
+	"\acontact\x18\x01 \x01(\v2\x14.schedula.v1.ContactR\acontact\"K\n" +
+	"\x11GetContactRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1d\n" +
+	"\n" +
+	"contact_id\x18\x02 \x01(\tR\tcontactId\"D\n" +
+	"\x12GetContactResponse\x12.\n" +
+	"\acontact\x18\x01 \x01(\v2\x14.schedula.v1.ContactR\acontact\"\x8e\x01\n" +
+	"\x14UpdateContactRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1d\n" +
+	"\n" +
+	"contact_id\x18\x02 \x01(\tR\tcontactId\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12\x14\n" +
+	"\x05email\x18\x04 \x01(\tR\x05email\x12\x14\n" +
+	"\x05phone\x18\x05 \x01(\tR\x05phone\"G\n" +
+	"\x15UpdateContactResponse\x12.\n" +
+	"\acontact\x18\x01 \x01(\v2\x14.schedula.v1.ContactR\acontact\"N\n" +
+	"\x14DeleteContactRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1d\n" +
+	"\n" +
+	"contact_id\x18\x02 \x01(\tR\tcontactId\"\x17\n" +
+	"\x15DeleteContactResponse\".\n" +
+	"\x13ListContactsRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\"H\n" +
+	"\x14ListContactsResponse\x120\n" +
+	"\bcontacts\x18\x01 \x03(\v2\x14.schedula.v1.ContactR\bcontacts\"\x93\x02\n" +
+	"\aProgram\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x14\n" +
+	"\x05title\x18\x03 \x01(\tR\x05title\x12\x14\n" +
+	"\x05notes\x18\x04 \x01(\tR\x05notes\x12=\n" +
+	"\fcancelled_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\vcancelledAt\x129\n" +
+	"\n" +
+	"created_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\xd5\x01\n" +
+	"\x0fProgramProgress\x12%\n" +
+	"\x0etotal_sessions\x18\x01 \x01(\rR\rtotalSessions\x12-\n" +
+	"\x12completed_sessions\x18\x02 \x01(\rR\x11completedSessions\x12-\n" +
+	"\x12remaining_sessions\x18\x03 \x01(\rR\x11remainingSessions\x12=\n" +
+	"\fnext_session\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\vnextSession\"[\n" +
+	"\x14CreateProgramRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x14\n" +
+	"\x05title\x18\x02 \x01(\tR\x05title\x12\x14\n" +
+	"\x05notes\x18\x03 \x01(\tR\x05notes\"G\n" +
+	"\x15CreateProgramResponse\x12.\n" +
+	"\aprogram\x18\x01 \x01(\v2\x14.schedula.v1.ProgramR\aprogram\"K\n" +
+	"\x11GetProgramRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1d\n" +
+	"\n" +
+	"program_id\x18\x02 \x01(\tR\tprogramId\"\xf2\x01\n" +
+	"\x12GetProgramResponse\x12.\n" +
+	"\aprogram\x18\x01 \x01(\v2\x14.schedula.v1.ProgramR\aprogram\x12<\n" +
+	"\fappointments\x18\x02 \x03(\v2\x18.schedula.v1.AppointmentR\fappointments\x124\n" +
+	"\x06series\x18\x03 \x03(\v2\x1c.schedula.v1.RecurringSeriesR\x06series\x128\n" +
+	"\bprogress\x18\x04 \x01(\v2\x1c.schedula.v1.ProgramProgressR\bprogress\".\n" +
+	"\x13ListProgramsRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\"H\n" +
+	"\x14ListProgramsResponse\x120\n" +
+	"\bprograms\x18\x01 \x03(\v2\x14.schedula.v1.ProgramR\bprograms\"N\n" +
+	"\x14CancelProgramRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1d\n" +
+	"\n" +
+	"program_id\x18\x02 \x01(\tR\tprogramId\"\xc4\x01\n" +
+	"\x15CancelProgramResponse\x12.\n" +
+	"\aprogram\x18\x01 \x01(\v2\x14.schedula.v1.ProgramR\aprogram\x121\n" +
+	"\x14appointments_deleted\x18\x02 \x01(\x05R\x13appointmentsDeleted\x12!\n" +
+	"\fseries_ended\x18\x03 \x01(\x05R\vseriesEnded\x12%\n" +
+	"\x0eseries_deleted\x18\x04 \x01(\x05R\rseriesDeleted\"|\n" +
+	"\x0eCheckInRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12%\n" +
+	"\x0eappointment_id\x18\x02 \x01(\tR\rappointmentId\x12*\n" +
+	"\x02at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\x02at\"M\n" +
+	"\x0fCheckInResponse\x12:\n" +
+	"\vappointment\x18\x01 \x01(\v2\x18.schedula.v1.AppointmentR\vappointment\"}\n" +
+	"\x0fCheckOutRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12%\n" +
+	"\x0eappointment_id\x18\x02 \x01(\tR\rappointmentId\x12*\n" +
+	"\x02at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\x02at\"\xd8\x01\n" +
+	"\x10CheckOutResponse\x12:\n" +
+	"\vappointment\x18\x01 \x01(\v2\x18.schedula.v1.AppointmentR\vappointment\x12D\n" +
+	"\x10planned_duration\x18\x02 \x01(\v2\x19.google.protobuf.DurationR\x0fplannedDuration\x12B\n" +
+	"\x0factual_duration\x18\x03 \x01(\v2\x19.google.protobuf.DurationR\x0eactualDuration\"\xcf\x02\n" +
+	"\x1aExportBillableHoursRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12=\n" +
+	"\fwindow_start\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\vwindowStart\x129\n" +
+	"\n" +
+	"window_end\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\twindowEnd\x12\x17\n" +
+	"\atag_key\x18\x04 \x01(\tR\x06tagKey\x123\n" +
+	"\x06period\x18\x05 \x01(\x0e2\x1b.schedula.v1.BillablePeriodR\x06period\x12\x1b\n" +
+	"\ttime_zone\x18\x06 \x01(\tR\btimeZone\x123\n" +
+	"\x06format\x18\a \x01(\x0e2\x1b.schedula.v1.BillableFormatR\x06format\"T\n" +
+	"\x1bExportBillableHoursResponse\x12\x12\n" +
+	"\x04data\x18\x01 \x01(\fR\x04data\x12!\n" +
+	"\fcontent_type\x18\x02 \x01(\tR\vcontentType\"\xeb\x03\n" +
+	"\x0fOfflineMutation\x12-\n" +
+	"\x04kind\x18\x01 \x01(\x0e2\x19.schedula.v1.MutationKindR\x04kind\x12%\n" +
+	"\x0eappointment_id\x18\x02 \x01(\tR\rappointmentId\x12\x14\n" +
+	"\x05title\x18\x03 \x01(\tR\x05title\x12\x14\n" +
+	"\x05notes\x18\x04 \x01(\tR\x05notes\x129\n" +
+	"\n" +
+	"start_time\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tstartTime\x125\n" +
+	"\bend_time\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\aendTime\x12F\n" +
+	"\bmetadata\x18\a \x03(\v2*.schedula.v1.OfflineMutation.MetadataEntryR\bmetadata\x12\x1b\n" +
+	"\ttime_zone\x18\b \x01(\tR\btimeZone\x12B\n" +
+	"\x0fbase_updated_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\rbaseUpdatedAt\x1a;\n" +
+	"\rMetadataEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xce\x01\n" +
+	"\x0eMutationResult\x123\n" +
+	"\x06status\x18\x01 \x01(\x0e2\x1b.schedula.v1.MutationStatusR\x06status\x129\n" +
+	"\bconflict\x18\x02 \x01(\x0e2\x1d.schedula.v1.MutationConflictR\bconflict\x12\x18\n" +
+	"\amessage\x18\x03 \x01(\tR\amessage\x122\n" +
+	"\acurrent\x18\x04 \x01(\v2\x18.schedula.v1.AppointmentR\acurrent\"o\n" +
+	"\x18ReconcileCalendarRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12:\n" +
+	"\tmutations\x18\x02 \x03(\v2\x1c.schedula.v1.OfflineMutationR\tmutations\"R\n" +
+	"\x19ReconcileCalendarResponse\x125\n" +
+	"\aresults\x18\x01 \x03(\v2\x1b.schedula.v1.MutationResultR\aresults\"\xdf\x01\n" +
+	"\x17CreateEmbedTokenRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12>\n" +
+	"\rslot_duration\x18\x02 \x01(\v2\x19.google.protobuf.DurationR\fslotDuration\x12>\n" +
+	"\rworking_hours\x18\x03 \x01(\v2\x19.schedula.v1.WorkingHoursR\fworkingHours\x12+\n" +
+	"\x03ttl\x18\x04 \x01(\v2\x19.google.protobuf.DurationR\x03ttl\"k\n" +
+	"\x18CreateEmbedTokenResponse\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\x129\n" +
+	"\n" +
+	"expires_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\"d\n" +
+	"\n" +
+	"DailyBreak\x12\x14\n" +
+	"\x05label\x18\x01 \x01(\tR\x05label\x12!\n" +
+	"\fstart_minute\x18\x02 \x01(\rR\vstartMinute\x12\x1d\n" +
+	"\n" +
+	"end_minute\x18\x03 \x01(\rR\tendMinute\"\xe8\x01\n" +
+	"\fSlotSettings\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12+\n" +
+	"\x11alignment_minutes\x18\x02 \x01(\rR\x10alignmentMinutes\x12\x1b\n" +
+	"\ttime_zone\x18\x03 \x01(\tR\btimeZone\x129\n" +
+	"\n" +
+	"updated_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12:\n" +
+	"\fdaily_breaks\x18\x05 \x03(\v2\x17.schedula.v1.DailyBreakR\vdailyBreaks\"1\n" +
+	"\x16GetSlotSettingsRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\"P\n" +
+	"\x17GetSlotSettingsResponse\x125\n" +
+	"\bsettings\x18\x01 \x01(\v2\x19.schedula.v1.SlotSettingsR\bsettings\"~\n" +
+	"\x19UpdateSlotSettingsRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12+\n" +
+	"\x11alignment_minutes\x18\x02 \x01(\rR\x10alignmentMinutes\x12\x1b\n" +
+	"\ttime_zone\x18\x03 \x01(\tR\btimeZone\"S\n" +
+	"\x1aUpdateSlotSettingsResponse\x125\n" +
+	"\bsettings\x18\x01 \x01(\v2\x19.schedula.v1.SlotSettingsR\bsettings\"d\n" +
+	"\x18UpdateDailyBreaksRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12/\n" +
+	"\x06breaks\x18\x02 \x03(\v2\x17.schedula.v1.DailyBreakR\x06breaks\"R\n" +
+	"\x19UpdateDailyBreaksResponse\x125\n" +
+	"\bsettings\x18\x01 \x01(\v2\x19.schedula.v1.SlotSettingsR\bsettings\"\xb0\x01\n" +
+	"\x11TimeOffRecurrence\x12\x1a\n" +
+	"\binterval\x18\x01 \x01(\rR\binterval\x120\n" +
+	"\bweekdays\x18\x02 \x03(\x0e2\x14.schedula.v1.WeekdayR\bweekdays\x120\n" +
+	"\x05until\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\x05until\x12\x1b\n" +
+	"\ttime_zone\x18\x04 \x01(\tR\btimeZone\"\xf0\x02\n" +
+	"\aTimeOff\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x14\n" +
+	"\x05title\x18\x03 \x01(\tR\x05title\x129\n" +
+	"\n" +
+	"start_time\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tstartTime\x125\n" +
+	"\bend_time\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\aendTime\x12>\n" +
+	"\n" +
+	"recurrence\x18\x06 \x01(\v2\x1e.schedula.v1.TimeOffRecurrenceR\n" +
+	"recurrence\x129\n" +
+	"\n" +
+	"created_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\xf7\x01\n" +
+	"\x14CreateTimeOffRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x14\n" +
+	"\x05title\x18\x02 \x01(\tR\x05title\x129\n" +
+	"\n" +
+	"start_time\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\tstartTime\x125\n" +
+	"\bend_time\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\aendTime\x12>\n" +
+	"\n" +
+	"recurrence\x18\x05 \x01(\v2\x1e.schedula.v1.TimeOffRecurrenceR\n" +
+	"recurrence\"H\n" +
+	"\x15CreateTimeOffResponse\x12/\n" +
+	"\btime_off\x18\x01 \x01(\v2\x14.schedula.v1.TimeOffR\atimeOff\"L\n" +
+	"\x11GetTimeOffRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1e\n" +
+	"\vtime_off_id\x18\x02 \x01(\tR\ttimeOffId\"E\n" +
+	"\x12GetTimeOffResponse\x12/\n" +
+	"\btime_off\x18\x01 \x01(\v2\x14.schedula.v1.TimeOffR\atimeOff\"\x97\x02\n" +
+	"\x14UpdateTimeOffRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1e\n" +
+	"\vtime_off_id\x18\x02 \x01(\tR\ttimeOffId\x12\x14\n" +
+	"\x05title\x18\x03 \x01(\tR\x05title\x129\n" +
+	"\n" +
+	"start_time\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tstartTime\x125\n" +
+	"\bend_time\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\aendTime\x12>\n" +
+	"\n" +
+	"recurrence\x18\x06 \x01(\v2\x1e.schedula.v1.TimeOffRecurrenceR\n" +
+	"recurrence\"H\n" +
+	"\x15UpdateTimeOffResponse\x12/\n" +
+	"\btime_off\x18\x01 \x01(\v2\x14.schedula.v1.TimeOffR\atimeOff\"O\n" +
+	"\x14DeleteTimeOffRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1e\n" +
+	"\vtime_off_id\x18\x02 \x01(\tR\ttimeOffId\"\x17\n" +
+	"\x15DeleteTimeOffResponse\"-\n" +
+	"\x12ListTimeOffRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\"F\n" +
+	"\x13ListTimeOffResponse\x12/\n" +
+	"\btime_off\x18\x01 \x03(\v2\x14.schedula.v1.TimeOffR\atimeOff*~\n" +
 	"\aWeekday\x12\x17\n" +
 	"\x13WEEKDAY_UNSPECIFIED\x10\x00\x12\n" +
 	"\n" +
@@ -1100,13 +13141,158 @@ const file_proto_schedula_v1_appointments_proto_rawDesc = "" +
 	"\x06FRIDAY\x10\x05\x12\f\n" +
 	"\bSATURDAY\x10\x06\x12\n" +
 	"\n" +
-	"\x06SUNDAY\x10\a2\x8c\x04\n" +
+	"\x06SUNDAY\x10\a*s\n" +
+	"\x10AttendanceStatus\x12!\n" +
+	"\x1dATTENDANCE_STATUS_UNSPECIFIED\x10\x00\x12\x1e\n" +
+	"\x1aATTENDANCE_STATUS_ATTENDED\x10\x01\x12\x1c\n" +
+	"\x18ATTENDANCE_STATUS_MISSED\x10\x02*\x88\x01\n" +
+	"\x13AppointmentLinkKind\x12%\n" +
+	"!APPOINTMENT_LINK_KIND_UNSPECIFIED\x10\x00\x12&\n" +
+	"\"APPOINTMENT_LINK_KIND_FOLLOW_UP_OF\x10\x01\x12\"\n" +
+	"\x1eAPPOINTMENT_LINK_KIND_PREP_FOR\x10\x02*i\n" +
+	"\fDstGapPolicy\x12\x1e\n" +
+	"\x1aDST_GAP_POLICY_UNSPECIFIED\x10\x00\x12 \n" +
+	"\x1cDST_GAP_POLICY_SHIFT_FORWARD\x10\x01\x12\x17\n" +
+	"\x13DST_GAP_POLICY_SKIP\x10\x02*|\n" +
+	"\x12DstAmbiguousPolicy\x12$\n" +
+	" DST_AMBIGUOUS_POLICY_UNSPECIFIED\x10\x00\x12 \n" +
+	"\x1cDST_AMBIGUOUS_POLICY_EARLIER\x10\x01\x12\x1e\n" +
+	"\x1aDST_AMBIGUOUS_POLICY_LATER\x10\x02*o\n" +
+	"\x0fAppointmentKind\x12 \n" +
+	"\x1cAPPOINTMENT_KIND_UNSPECIFIED\x10\x00\x12\x1a\n" +
+	"\x16APPOINTMENT_KIND_EVENT\x10\x01\x12\x1e\n" +
+	"\x1aAPPOINTMENT_KIND_MILESTONE\x10\x02*h\n" +
+	"\fTimeZoneKeep\x12\x1e\n" +
+	"\x1aTIME_ZONE_KEEP_UNSPECIFIED\x10\x00\x12\x1c\n" +
+	"\x18TIME_ZONE_KEEP_WALL_TIME\x10\x01\x12\x1a\n" +
+	"\x16TIME_ZONE_KEEP_INSTANT\x10\x02*d\n" +
+	"\x0eIntervalBounds\x12\x1f\n" +
+	"\x1bINTERVAL_BOUNDS_UNSPECIFIED\x10\x00\x121\n" +
+	"-INTERVAL_BOUNDS_START_INCLUSIVE_END_EXCLUSIVE\x10\x01*\x85\x01\n" +
+	"\rConflictCause\x12\x1e\n" +
+	"\x1aCONFLICT_CAUSE_UNSPECIFIED\x10\x00\x12\x1a\n" +
+	"\x16CONFLICT_CAUSE_OVERLAP\x10\x01\x12\x1b\n" +
+	"\x17CONFLICT_CAUSE_EXTERNAL\x10\x02\x12\x1b\n" +
+	"\x17CONFLICT_CAUSE_OVERRIDE\x10\x03*\xa7\x01\n" +
+	"\x0eProposalStatus\x12\x1f\n" +
+	"\x1bPROPOSAL_STATUS_UNSPECIFIED\x10\x00\x12\x1b\n" +
+	"\x17PROPOSAL_STATUS_PENDING\x10\x01\x12\x1c\n" +
+	"\x18PROPOSAL_STATUS_ACCEPTED\x10\x02\x12\x1c\n" +
+	"\x18PROPOSAL_STATUS_DECLINED\x10\x03\x12\x1b\n" +
+	"\x17PROPOSAL_STATUS_EXPIRED\x10\x04*\x94\x02\n" +
+	"\x11SeriesFindingKind\x12#\n" +
+	"\x1fSERIES_FINDING_KIND_UNSPECIFIED\x10\x00\x12)\n" +
+	"%SERIES_FINDING_KIND_INVALID_TIME_ZONE\x10\x01\x12$\n" +
+	" SERIES_FINDING_KIND_INVALID_RULE\x10\x02\x120\n" +
+	",SERIES_FINDING_KIND_EXCEPTION_OUTSIDE_SERIES\x10\x03\x12-\n" +
+	")SERIES_FINDING_KIND_EXCEPTION_OFF_PATTERN\x10\x04\x12(\n" +
+	"$SERIES_FINDING_KIND_INVALID_OVERRIDE\x10\x05*\x9a\x01\n" +
+	"\fChangeEntity\x12\x1d\n" +
+	"\x19CHANGE_ENTITY_UNSPECIFIED\x10\x00\x12\x1d\n" +
+	"\x19CHANGE_ENTITY_APPOINTMENT\x10\x01\x12\x18\n" +
+	"\x14CHANGE_ENTITY_SERIES\x10\x02\x12\x16\n" +
+	"\x12CHANGE_ENTITY_HOLD\x10\x03\x12\x1a\n" +
+	"\x16CHANGE_ENTITY_PROPOSAL\x10\x04*\x81\x01\n" +
+	"\bChangeOp\x12\x19\n" +
+	"\x15CHANGE_OP_UNSPECIFIED\x10\x00\x12\x15\n" +
+	"\x11CHANGE_OP_CREATED\x10\x01\x12\x15\n" +
+	"\x11CHANGE_OP_UPDATED\x10\x02\x12\x15\n" +
+	"\x11CHANGE_OP_DELETED\x10\x03\x12\x15\n" +
+	"\x11CHANGE_OP_EXPIRED\x10\x04*f\n" +
+	"\x0eBillablePeriod\x12\x1f\n" +
+	"\x1bBILLABLE_PERIOD_UNSPECIFIED\x10\x00\x12\x18\n" +
+	"\x14BILLABLE_PERIOD_WEEK\x10\x01\x12\x19\n" +
+	"\x15BILLABLE_PERIOD_MONTH\x10\x02*d\n" +
+	"\x0eBillableFormat\x12\x1f\n" +
+	"\x1bBILLABLE_FORMAT_UNSPECIFIED\x10\x00\x12\x17\n" +
+	"\x13BILLABLE_FORMAT_CSV\x10\x01\x12\x18\n" +
+	"\x14BILLABLE_FORMAT_JSON\x10\x02*{\n" +
+	"\fMutationKind\x12\x1d\n" +
+	"\x19MUTATION_KIND_UNSPECIFIED\x10\x00\x12\x18\n" +
+	"\x14MUTATION_KIND_CREATE\x10\x01\x12\x18\n" +
+	"\x14MUTATION_KIND_UPDATE\x10\x02\x12\x18\n" +
+	"\x14MUTATION_KIND_DELETE\x10\x03*\x8d\x01\n" +
+	"\x0eMutationStatus\x12\x1f\n" +
+	"\x1bMUTATION_STATUS_UNSPECIFIED\x10\x00\x12\x1c\n" +
+	"\x18MUTATION_STATUS_ACCEPTED\x10\x01\x12\x1e\n" +
+	"\x1aMUTATION_STATUS_CONFLICTED\x10\x02\x12\x1c\n" +
+	"\x18MUTATION_STATUS_REJECTED\x10\x03*\xb0\x01\n" +
+	"\x10MutationConflict\x12!\n" +
+	"\x1dMUTATION_CONFLICT_UNSPECIFIED\x10\x00\x12\x1d\n" +
+	"\x19MUTATION_CONFLICT_VERSION\x10\x01\x12\x1d\n" +
+	"\x19MUTATION_CONFLICT_DELETED\x10\x02\x12\x1c\n" +
+	"\x18MUTATION_CONFLICT_EXISTS\x10\x03\x12\x1d\n" +
+	"\x19MUTATION_CONFLICT_OVERLAP\x10\x042\xd10\n" +
 	"\x13AppointmentsService\x12b\n" +
 	"\x11CreateAppointment\x12%.schedula.v1.CreateAppointmentRequest\x1a&.schedula.v1.CreateAppointmentResponse\x12_\n" +
 	"\x10ListAppointments\x12$.schedula.v1.ListAppointmentsRequest\x1a%.schedula.v1.ListAppointmentsResponse\x12b\n" +
 	"\x11DeleteAppointment\x12%.schedula.v1.DeleteAppointmentRequest\x1a&.schedula.v1.DeleteAppointmentResponse\x12n\n" +
 	"\x15CreateRecurringSeries\x12).schedula.v1.CreateRecurringSeriesRequest\x1a*.schedula.v1.CreateRecurringSeriesResponse\x12\\\n" +
-	"\x0fListOccurrences\x12#.schedula.v1.ListOccurrencesRequest\x1a$.schedula.v1.ListOccurrencesResponseB<Z:schedula/backend/internal/gen/proto/schedula/v1;schedulev1b\x06proto3"
+	"\x0fListOccurrences\x12#.schedula.v1.ListOccurrencesRequest\x1a$.schedula.v1.ListOccurrencesResponse\x12e\n" +
+	"\x12GetRecurringSeries\x12&.schedula.v1.GetRecurringSeriesRequest\x1a'.schedula.v1.GetRecurringSeriesResponse\x12h\n" +
+	"\x13ListRecurringSeries\x12'.schedula.v1.ListRecurringSeriesRequest\x1a(.schedula.v1.ListRecurringSeriesResponse\x12Y\n" +
+	"\x0eMarkAttendance\x12\".schedula.v1.MarkAttendanceRequest\x1a#.schedula.v1.MarkAttendanceResponse\x12e\n" +
+	"\x12GetAttendanceStats\x12&.schedula.v1.GetAttendanceStatsRequest\x1a'.schedula.v1.GetAttendanceStatsResponse\x12J\n" +
+	"\tGetLimits\x12\x1d.schedula.v1.GetLimitsRequest\x1a\x1e.schedula.v1.GetLimitsResponse\x12\x80\x01\n" +
+	"\x1bGetAppointmentByExternalRef\x12/.schedula.v1.GetAppointmentByExternalRefRequest\x1a0.schedula.v1.GetAppointmentByExternalRefResponse\x12S\n" +
+	"\fGetAnalytics\x12 .schedula.v1.GetAnalyticsRequest\x1a!.schedula.v1.GetAnalyticsResponse\x12Y\n" +
+	"\x0eSuggestEndTime\x12\".schedula.v1.SuggestEndTimeRequest\x1a#.schedula.v1.SuggestEndTimeResponse\x12P\n" +
+	"\vReserveSlot\x12\x1f.schedula.v1.ReserveSlotRequest\x1a .schedula.v1.ReserveSlotResponse\x12P\n" +
+	"\vConfirmHold\x12\x1f.schedula.v1.ConfirmHoldRequest\x1a .schedula.v1.ConfirmHoldResponse\x12P\n" +
+	"\vReleaseHold\x12\x1f.schedula.v1.ReleaseHoldRequest\x1a .schedula.v1.ReleaseHoldResponse\x12e\n" +
+	"\x12ProposeAppointment\x12&.schedula.v1.ProposeAppointmentRequest\x1a'.schedula.v1.ProposeAppointmentResponse\x12V\n" +
+	"\rListProposals\x12!.schedula.v1.ListProposalsRequest\x1a\".schedula.v1.ListProposalsResponse\x12Y\n" +
+	"\x0eAcceptProposal\x12\".schedula.v1.AcceptProposalRequest\x1a#.schedula.v1.AcceptProposalResponse\x12\\\n" +
+	"\x0fDeclineProposal\x12#.schedula.v1.DeclineProposalRequest\x1a$.schedula.v1.DeclineProposalResponse\x12_\n" +
+	"\x10LinkAppointments\x12$.schedula.v1.LinkAppointmentsRequest\x1a%.schedula.v1.LinkAppointmentsResponse\x12e\n" +
+	"\x12UnlinkAppointments\x12&.schedula.v1.UnlinkAppointmentsRequest\x1a'.schedula.v1.UnlinkAppointmentsResponse\x12P\n" +
+	"\vListRelated\x12\x1f.schedula.v1.ListRelatedRequest\x1a .schedula.v1.ListRelatedResponse\x12b\n" +
+	"\x11MergeAppointments\x12%.schedula.v1.MergeAppointmentsRequest\x1a&.schedula.v1.MergeAppointmentsResponse\x12_\n" +
+	"\x10BatchGetFreeBusy\x12$.schedula.v1.BatchGetFreeBusyRequest\x1a%.schedula.v1.BatchGetFreeBusyResponse\x12h\n" +
+	"\x13SuggestMeetingTimes\x12'.schedula.v1.SuggestMeetingTimesRequest\x1a(.schedula.v1.SuggestMeetingTimesResponse\x12n\n" +
+	"\x15RepairRecurringSeries\x12).schedula.v1.RepairRecurringSeriesRequest\x1a*.schedula.v1.RepairRecurringSeriesResponse\x12\\\n" +
+	"\x0fSkipOccurrences\x12#.schedula.v1.SkipOccurrencesRequest\x1a$.schedula.v1.SkipOccurrencesResponse\x12\\\n" +
+	"\x0fUpdateSeriesEnd\x12#.schedula.v1.UpdateSeriesEndRequest\x1a$.schedula.v1.UpdateSeriesEndResponse\x12k\n" +
+	"\x14ChangeSeriesTimeZone\x12(.schedula.v1.ChangeSeriesTimeZoneRequest\x1a).schedula.v1.ChangeSeriesTimeZoneResponse\x12V\n" +
+	"\rAuditCalendar\x12!.schedula.v1.AuditCalendarRequest\x1a\".schedula.v1.AuditCalendarResponse\x12Y\n" +
+	"\x0eGetDailyAgenda\x12\".schedula.v1.GetDailyAgendaRequest\x1a#.schedula.v1.GetDailyAgendaResponse\x12\\\n" +
+	"\x0fGetDaySummaries\x12#.schedula.v1.GetDaySummariesRequest\x1a$.schedula.v1.GetDaySummariesResponse\x12\\\n" +
+	"\x0fGrantDelegation\x12#.schedula.v1.GrantDelegationRequest\x1a$.schedula.v1.GrantDelegationResponse\x12_\n" +
+	"\x10RevokeDelegation\x12$.schedula.v1.RevokeDelegationRequest\x1a%.schedula.v1.RevokeDelegationResponse\x12\\\n" +
+	"\x0fListDelegations\x12#.schedula.v1.ListDelegationsRequest\x1a$.schedula.v1.ListDelegationsResponse\x12Y\n" +
+	"\x0eExportCalendar\x12\".schedula.v1.ExportCalendarRequest\x1a#.schedula.v1.ExportCalendarResponse\x12a\n" +
+	"\x10WatchOccurrences\x12$.schedula.v1.WatchOccurrencesRequest\x1a%.schedula.v1.WatchOccurrencesResponse0\x01\x12P\n" +
+	"\vListChanges\x12\x1f.schedula.v1.ListChangesRequest\x1a .schedula.v1.ListChangesResponse\x12Y\n" +
+	"\x0eImportCalendar\x12\".schedula.v1.ImportCalendarRequest\x1a#.schedula.v1.ImportCalendarResponse\x12b\n" +
+	"\x11ReconcileCalendar\x12%.schedula.v1.ReconcileCalendarRequest\x1a&.schedula.v1.ReconcileCalendarResponse\x12D\n" +
+	"\aCheckIn\x12\x1b.schedula.v1.CheckInRequest\x1a\x1c.schedula.v1.CheckInResponse\x12G\n" +
+	"\bCheckOut\x12\x1c.schedula.v1.CheckOutRequest\x1a\x1d.schedula.v1.CheckOutResponse\x12h\n" +
+	"\x13ExportBillableHours\x12'.schedula.v1.ExportBillableHoursRequest\x1a(.schedula.v1.ExportBillableHoursResponse\x12V\n" +
+	"\rCreateContact\x12!.schedula.v1.CreateContactRequest\x1a\".schedula.v1.CreateContactResponse\x12M\n" +
+	"\n" +
+	"GetContact\x12\x1e.schedula.v1.GetContactRequest\x1a\x1f.schedula.v1.GetContactResponse\x12V\n" +
+	"\rUpdateContact\x12!.schedula.v1.UpdateContactRequest\x1a\".schedula.v1.UpdateContactResponse\x12V\n" +
+	"\rDeleteContact\x12!.schedula.v1.DeleteContactRequest\x1a\".schedula.v1.DeleteContactResponse\x12S\n" +
+	"\fListContacts\x12 .schedula.v1.ListContactsRequest\x1a!.schedula.v1.ListContactsResponse\x12V\n" +
+	"\rCreateProgram\x12!.schedula.v1.CreateProgramRequest\x1a\".schedula.v1.CreateProgramResponse\x12M\n" +
+	"\n" +
+	"GetProgram\x12\x1e.schedula.v1.GetProgramRequest\x1a\x1f.schedula.v1.GetProgramResponse\x12S\n" +
+	"\fListPrograms\x12 .schedula.v1.ListProgramsRequest\x1a!.schedula.v1.ListProgramsResponse\x12V\n" +
+	"\rCancelProgram\x12!.schedula.v1.CancelProgramRequest\x1a\".schedula.v1.CancelProgramResponse\x12_\n" +
+	"\x10CreateEmbedToken\x12$.schedula.v1.CreateEmbedTokenRequest\x1a%.schedula.v1.CreateEmbedTokenResponse\x12\\\n" +
+	"\x0fGetSlotSettings\x12#.schedula.v1.GetSlotSettingsRequest\x1a$.schedula.v1.GetSlotSettingsResponse\x12e\n" +
+	"\x12UpdateSlotSettings\x12&.schedula.v1.UpdateSlotSettingsRequest\x1a'.schedula.v1.UpdateSlotSettingsResponse\x12b\n" +
+	"\x11UpdateDailyBreaks\x12%.schedula.v1.UpdateDailyBreaksRequest\x1a&.schedula.v1.UpdateDailyBreaksResponse\x12V\n" +
+	"\rCreateTimeOff\x12!.schedula.v1.CreateTimeOffRequest\x1a\".schedula.v1.CreateTimeOffResponse\x12M\n" +
+	"\n" +
+	"GetTimeOff\x12\x1e.schedula.v1.GetTimeOffRequest\x1a\x1f.schedula.v1.GetTimeOffResponse\x12V\n" +
+	"\rUpdateTimeOff\x12!.schedula.v1.UpdateTimeOffRequest\x1a\".schedula.v1.UpdateTimeOffResponse\x12V\n" +
+	"\rDeleteTimeOff\x12!.schedula.v1.DeleteTimeOffRequest\x1a\".schedula.v1.DeleteTimeOffResponse\x12P\n" +
+	"\vListTimeOff\x12\x1f.schedula.v1.ListTimeOffRequest\x1a .schedula.v1.ListTimeOffResponse\x12_\n" +
+	"\x10SimulateSchedule\x12$.schedula.v1.SimulateScheduleRequest\x1a%.schedula.v1.SimulateScheduleResponse\x12q\n" +
+	"\x16CreateCalendarSnapshot\x12*.schedula.v1.CreateCalendarSnapshotRequest\x1a+.schedula.v1.CreateCalendarSnapshotResponse\x12n\n" +
+	"\x15ListCalendarSnapshots\x12).schedula.v1.ListCalendarSnapshotsRequest\x1a*.schedula.v1.ListCalendarSnapshotsResponse\x12t\n" +
+	"\x17RestoreCalendarSnapshot\x12+.schedula.v1.RestoreCalendarSnapshotRequest\x1a,.schedula.v1.RestoreCalendarSnapshotResponseB<Z:schedula/backend/internal/gen/proto/schedula/v1;schedulev1b\x06proto3"
 
 var (
 	file_proto_schedula_v1_appointments_proto_rawDescOnce sync.Once
@@ -1120,68 +13306,611 @@ func file_proto_schedula_v1_appointments_proto_rawDescGZIP() []byte {
 	return file_proto_schedula_v1_appointments_proto_rawDescData
 }
 
-var file_proto_schedula_v1_appointments_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_proto_schedula_v1_appointments_proto_msgTypes = make([]protoimpl.MessageInfo, 14)
+var file_proto_schedula_v1_appointments_proto_enumTypes = make([]protoimpl.EnumInfo, 18)
+var file_proto_schedula_v1_appointments_proto_msgTypes = make([]protoimpl.MessageInfo, 184)
 var file_proto_schedula_v1_appointments_proto_goTypes = []any{
-	(Weekday)(0),                          // 0: schedula.v1.Weekday
-	(*WeeklyRecurrence)(nil),              // 1: schedula.v1.WeeklyRecurrence
-	(*Appointment)(nil),                   // 2: schedula.v1.Appointment
-	(*CreateAppointmentRequest)(nil),      // 3: schedula.v1.CreateAppointmentRequest
-	(*CreateAppointmentResponse)(nil),     // 4: schedula.v1.CreateAppointmentResponse
-	(*ListAppointmentsRequest)(nil),       // 5: schedula.v1.ListAppointmentsRequest
-	(*ListAppointmentsResponse)(nil),      // 6: schedula.v1.ListAppointmentsResponse
-	(*DeleteAppointmentRequest)(nil),      // 7: schedula.v1.DeleteAppointmentRequest
-	(*DeleteAppointmentResponse)(nil),     // 8: schedula.v1.DeleteAppointmentResponse
-	(*RecurringSeries)(nil),               // 9: schedula.v1.RecurringSeries
-	(*CreateRecurringSeriesRequest)(nil),  // 10: schedula.v1.CreateRecurringSeriesRequest
-	(*CreateRecurringSeriesResponse)(nil), // 11: schedula.v1.CreateRecurringSeriesResponse
-	(*Occurrence)(nil),                    // 12: schedula.v1.Occurrence
-	(*ListOccurrencesRequest)(nil),        // 13: schedula.v1.ListOccurrencesRequest
-	(*ListOccurrencesResponse)(nil),       // 14: schedula.v1.ListOccurrencesResponse
-	(*timestamppb.Timestamp)(nil),         // 15: google.protobuf.Timestamp
+	(Weekday)(0),                                // 0: schedula.v1.Weekday
+	(AttendanceStatus)(0),                       // 1: schedula.v1.AttendanceStatus
+	(AppointmentLinkKind)(0),                    // 2: schedula.v1.AppointmentLinkKind
+	(DstGapPolicy)(0),                           // 3: schedula.v1.DstGapPolicy
+	(DstAmbiguousPolicy)(0),                     // 4: schedula.v1.DstAmbiguousPolicy
+	(AppointmentKind)(0),                        // 5: schedula.v1.AppointmentKind
+	(TimeZoneKeep)(0),                           // 6: schedula.v1.TimeZoneKeep
+	(IntervalBounds)(0),                         // 7: schedula.v1.IntervalBounds
+	(ConflictCause)(0),                          // 8: schedula.v1.ConflictCause
+	(ProposalStatus)(0),                         // 9: schedula.v1.ProposalStatus
+	(SeriesFindingKind)(0),                      // 10: schedula.v1.SeriesFindingKind
+	(ChangeEntity)(0),                           // 11: schedula.v1.ChangeEntity
+	(ChangeOp)(0),                               // 12: schedula.v1.ChangeOp
+	(BillablePeriod)(0),                         // 13: schedula.v1.BillablePeriod
+	(BillableFormat)(0),                         // 14: schedula.v1.BillableFormat
+	(MutationKind)(0),                           // 15: schedula.v1.MutationKind
+	(MutationStatus)(0),                         // 16: schedula.v1.MutationStatus
+	(MutationConflict)(0),                       // 17: schedula.v1.MutationConflict
+	(*WeeklyRecurrence)(nil),                    // 18: schedula.v1.WeeklyRecurrence
+	(*WeekdayTime)(nil),                         // 19: schedula.v1.WeekdayTime
+	(*ExternalRef)(nil),                         // 20: schedula.v1.ExternalRef
+	(*Appointment)(nil),                         // 21: schedula.v1.Appointment
+	(*CreateAppointmentRequest)(nil),            // 22: schedula.v1.CreateAppointmentRequest
+	(*BlackoutWarning)(nil),                     // 23: schedula.v1.BlackoutWarning
+	(*Warning)(nil),                             // 24: schedula.v1.Warning
+	(*CreateAppointmentResponse)(nil),           // 25: schedula.v1.CreateAppointmentResponse
+	(*ListAppointmentsRequest)(nil),             // 26: schedula.v1.ListAppointmentsRequest
+	(*DaySegment)(nil),                          // 27: schedula.v1.DaySegment
+	(*ListAppointmentsResponse)(nil),            // 28: schedula.v1.ListAppointmentsResponse
+	(*GetAppointmentByExternalRefRequest)(nil),  // 29: schedula.v1.GetAppointmentByExternalRefRequest
+	(*GetAppointmentByExternalRefResponse)(nil), // 30: schedula.v1.GetAppointmentByExternalRefResponse
+	(*DeleteAppointmentRequest)(nil),            // 31: schedula.v1.DeleteAppointmentRequest
+	(*DeleteAppointmentResponse)(nil),           // 32: schedula.v1.DeleteAppointmentResponse
+	(*RecurringSeries)(nil),                     // 33: schedula.v1.RecurringSeries
+	(*CreateRecurringSeriesRequest)(nil),        // 34: schedula.v1.CreateRecurringSeriesRequest
+	(*CreateRecurringSeriesResponse)(nil),       // 35: schedula.v1.CreateRecurringSeriesResponse
+	(*GetRecurringSeriesRequest)(nil),           // 36: schedula.v1.GetRecurringSeriesRequest
+	(*GetRecurringSeriesResponse)(nil),          // 37: schedula.v1.GetRecurringSeriesResponse
+	(*ListRecurringSeriesRequest)(nil),          // 38: schedula.v1.ListRecurringSeriesRequest
+	(*ListRecurringSeriesResponse)(nil),         // 39: schedula.v1.ListRecurringSeriesResponse
+	(*Occurrence)(nil),                          // 40: schedula.v1.Occurrence
+	(*ListOccurrencesRequest)(nil),              // 41: schedula.v1.ListOccurrencesRequest
+	(*ListOccurrencesResponse)(nil),             // 42: schedula.v1.ListOccurrencesResponse
+	(*OccurrenceAttendance)(nil),                // 43: schedula.v1.OccurrenceAttendance
+	(*MarkAttendanceRequest)(nil),               // 44: schedula.v1.MarkAttendanceRequest
+	(*MarkAttendanceResponse)(nil),              // 45: schedula.v1.MarkAttendanceResponse
+	(*ParticipantAttendanceStats)(nil),          // 46: schedula.v1.ParticipantAttendanceStats
+	(*GetAttendanceStatsRequest)(nil),           // 47: schedula.v1.GetAttendanceStatsRequest
+	(*GetAttendanceStatsResponse)(nil),          // 48: schedula.v1.GetAttendanceStatsResponse
+	(*GetLimitsRequest)(nil),                    // 49: schedula.v1.GetLimitsRequest
+	(*GetLimitsResponse)(nil),                   // 50: schedula.v1.GetLimitsResponse
+	(*GetAnalyticsRequest)(nil),                 // 51: schedula.v1.GetAnalyticsRequest
+	(*GetAnalyticsResponse)(nil),                // 52: schedula.v1.GetAnalyticsResponse
+	(*SuggestEndTimeRequest)(nil),               // 53: schedula.v1.SuggestEndTimeRequest
+	(*SuggestEndTimeResponse)(nil),              // 54: schedula.v1.SuggestEndTimeResponse
+	(*SlotHold)(nil),                            // 55: schedula.v1.SlotHold
+	(*ReserveSlotRequest)(nil),                  // 56: schedula.v1.ReserveSlotRequest
+	(*ReserveSlotResponse)(nil),                 // 57: schedula.v1.ReserveSlotResponse
+	(*ConfirmHoldRequest)(nil),                  // 58: schedula.v1.ConfirmHoldRequest
+	(*ConfirmHoldResponse)(nil),                 // 59: schedula.v1.ConfirmHoldResponse
+	(*ReleaseHoldRequest)(nil),                  // 60: schedula.v1.ReleaseHoldRequest
+	(*ReleaseHoldResponse)(nil),                 // 61: schedula.v1.ReleaseHoldResponse
+	(*AppointmentProposal)(nil),                 // 62: schedula.v1.AppointmentProposal
+	(*ProposeAppointmentRequest)(nil),           // 63: schedula.v1.ProposeAppointmentRequest
+	(*ProposeAppointmentResponse)(nil),          // 64: schedula.v1.ProposeAppointmentResponse
+	(*ListProposalsRequest)(nil),                // 65: schedula.v1.ListProposalsRequest
+	(*ListProposalsResponse)(nil),               // 66: schedula.v1.ListProposalsResponse
+	(*AcceptProposalRequest)(nil),               // 67: schedula.v1.AcceptProposalRequest
+	(*AcceptProposalResponse)(nil),              // 68: schedula.v1.AcceptProposalResponse
+	(*DeclineProposalRequest)(nil),              // 69: schedula.v1.DeclineProposalRequest
+	(*DeclineProposalResponse)(nil),             // 70: schedula.v1.DeclineProposalResponse
+	(*AppointmentLink)(nil),                     // 71: schedula.v1.AppointmentLink
+	(*LinkAppointmentsRequest)(nil),             // 72: schedula.v1.LinkAppointmentsRequest
+	(*LinkAppointmentsResponse)(nil),            // 73: schedula.v1.LinkAppointmentsResponse
+	(*UnlinkAppointmentsRequest)(nil),           // 74: schedula.v1.UnlinkAppointmentsRequest
+	(*UnlinkAppointmentsResponse)(nil),          // 75: schedula.v1.UnlinkAppointmentsResponse
+	(*RelatedAppointment)(nil),                  // 76: schedula.v1.RelatedAppointment
+	(*ListRelatedRequest)(nil),                  // 77: schedula.v1.ListRelatedRequest
+	(*ListRelatedResponse)(nil),                 // 78: schedula.v1.ListRelatedResponse
+	(*MergeAppointmentsRequest)(nil),            // 79: schedula.v1.MergeAppointmentsRequest
+	(*MergeAppointmentsResponse)(nil),           // 80: schedula.v1.MergeAppointmentsResponse
+	(*BusyInterval)(nil),                        // 81: schedula.v1.BusyInterval
+	(*UserFreeBusy)(nil),                        // 82: schedula.v1.UserFreeBusy
+	(*BatchGetFreeBusyRequest)(nil),             // 83: schedula.v1.BatchGetFreeBusyRequest
+	(*BatchGetFreeBusyResponse)(nil),            // 84: schedula.v1.BatchGetFreeBusyResponse
+	(*TimeRange)(nil),                           // 85: schedula.v1.TimeRange
+	(*WorkingHours)(nil),                        // 86: schedula.v1.WorkingHours
+	(*MeetingAttendee)(nil),                     // 87: schedula.v1.MeetingAttendee
+	(*SuggestMeetingTimesRequest)(nil),          // 88: schedula.v1.SuggestMeetingTimesRequest
+	(*MeetingSuggestion)(nil),                   // 89: schedula.v1.MeetingSuggestion
+	(*SuggestMeetingTimesResponse)(nil),         // 90: schedula.v1.SuggestMeetingTimesResponse
+	(*SimulatedStaff)(nil),                      // 91: schedula.v1.SimulatedStaff
+	(*BookingPattern)(nil),                      // 92: schedula.v1.BookingPattern
+	(*SimulateScheduleRequest)(nil),             // 93: schedula.v1.SimulateScheduleRequest
+	(*ScheduleUtilization)(nil),                 // 94: schedula.v1.ScheduleUtilization
+	(*SimulatedDay)(nil),                        // 95: schedula.v1.SimulatedDay
+	(*SimulatedStaffUtilization)(nil),           // 96: schedula.v1.SimulatedStaffUtilization
+	(*BookingPatternOutcome)(nil),               // 97: schedula.v1.BookingPatternOutcome
+	(*SimulateScheduleResponse)(nil),            // 98: schedula.v1.SimulateScheduleResponse
+	(*SeriesFinding)(nil),                       // 99: schedula.v1.SeriesFinding
+	(*RepairRecurringSeriesRequest)(nil),        // 100: schedula.v1.RepairRecurringSeriesRequest
+	(*RepairRecurringSeriesResponse)(nil),       // 101: schedula.v1.RepairRecurringSeriesResponse
+	(*CalendarEntry)(nil),                       // 102: schedula.v1.CalendarEntry
+	(*CalendarConflict)(nil),                    // 103: schedula.v1.CalendarConflict
+	(*AuditCalendarRequest)(nil),                // 104: schedula.v1.AuditCalendarRequest
+	(*AuditCalendarResponse)(nil),               // 105: schedula.v1.AuditCalendarResponse
+	(*GetDailyAgendaRequest)(nil),               // 106: schedula.v1.GetDailyAgendaRequest
+	(*AgendaItem)(nil),                          // 107: schedula.v1.AgendaItem
+	(*GetDailyAgendaResponse)(nil),              // 108: schedula.v1.GetDailyAgendaResponse
+	(*GetDaySummariesRequest)(nil),              // 109: schedula.v1.GetDaySummariesRequest
+	(*DaySummary)(nil),                          // 110: schedula.v1.DaySummary
+	(*GetDaySummariesResponse)(nil),             // 111: schedula.v1.GetDaySummariesResponse
+	(*UpdateSeriesEndRequest)(nil),              // 112: schedula.v1.UpdateSeriesEndRequest
+	(*UpdateSeriesEndResponse)(nil),             // 113: schedula.v1.UpdateSeriesEndResponse
+	(*ChangeSeriesTimeZoneRequest)(nil),         // 114: schedula.v1.ChangeSeriesTimeZoneRequest
+	(*ChangeSeriesTimeZoneResponse)(nil),        // 115: schedula.v1.ChangeSeriesTimeZoneResponse
+	(*SkipOccurrencesRequest)(nil),              // 116: schedula.v1.SkipOccurrencesRequest
+	(*SkipOccurrencesResponse)(nil),             // 117: schedula.v1.SkipOccurrencesResponse
+	(*DelegationGrant)(nil),                     // 118: schedula.v1.DelegationGrant
+	(*GrantDelegationRequest)(nil),              // 119: schedula.v1.GrantDelegationRequest
+	(*GrantDelegationResponse)(nil),             // 120: schedula.v1.GrantDelegationResponse
+	(*RevokeDelegationRequest)(nil),             // 121: schedula.v1.RevokeDelegationRequest
+	(*RevokeDelegationResponse)(nil),            // 122: schedula.v1.RevokeDelegationResponse
+	(*ListDelegationsRequest)(nil),              // 123: schedula.v1.ListDelegationsRequest
+	(*ListDelegationsResponse)(nil),             // 124: schedula.v1.ListDelegationsResponse
+	(*WatchOccurrencesRequest)(nil),             // 125: schedula.v1.WatchOccurrencesRequest
+	(*WatchOccurrencesResponse)(nil),            // 126: schedula.v1.WatchOccurrencesResponse
+	(*CalendarChange)(nil),                      // 127: schedula.v1.CalendarChange
+	(*ListChangesRequest)(nil),                  // 128: schedula.v1.ListChangesRequest
+	(*ListChangesResponse)(nil),                 // 129: schedula.v1.ListChangesResponse
+	(*ExportCalendarRequest)(nil),               // 130: schedula.v1.ExportCalendarRequest
+	(*ExportCalendarResponse)(nil),              // 131: schedula.v1.ExportCalendarResponse
+	(*ImportCalendarRequest)(nil),               // 132: schedula.v1.ImportCalendarRequest
+	(*ImportCalendarResponse)(nil),              // 133: schedula.v1.ImportCalendarResponse
+	(*CalendarSnapshot)(nil),                    // 134: schedula.v1.CalendarSnapshot
+	(*CreateCalendarSnapshotRequest)(nil),       // 135: schedula.v1.CreateCalendarSnapshotRequest
+	(*CreateCalendarSnapshotResponse)(nil),      // 136: schedula.v1.CreateCalendarSnapshotResponse
+	(*ListCalendarSnapshotsRequest)(nil),        // 137: schedula.v1.ListCalendarSnapshotsRequest
+	(*ListCalendarSnapshotsResponse)(nil),       // 138: schedula.v1.ListCalendarSnapshotsResponse
+	(*RestoreCalendarSnapshotRequest)(nil),      // 139: schedula.v1.RestoreCalendarSnapshotRequest
+	(*RestoreCalendarSnapshotResponse)(nil),     // 140: schedula.v1.RestoreCalendarSnapshotResponse
+	(*Contact)(nil),                             // 141: schedula.v1.Contact
+	(*CreateContactRequest)(nil),                // 142: schedula.v1.CreateContactRequest
+	(*CreateContactResponse)(nil),               // 143: schedula.v1.CreateContactResponse
+	(*GetContactRequest)(nil),                   // 144: schedula.v1.GetContactRequest
+	(*GetContactResponse)(nil),                  // 145: schedula.v1.GetContactResponse
+	(*UpdateContactRequest)(nil),                // 146: schedula.v1.UpdateContactRequest
+	(*UpdateContactResponse)(nil),               // 147: schedula.v1.UpdateContactResponse
+	(*DeleteContactRequest)(nil),                // 148: schedula.v1.DeleteContactRequest
+	(*DeleteContactResponse)(nil),               // 149: schedula.v1.DeleteContactResponse
+	(*ListContactsRequest)(nil),                 // 150: schedula.v1.ListContactsRequest
+	(*ListContactsResponse)(nil),                // 151: schedula.v1.ListContactsResponse
+	(*Program)(nil),                             // 152: schedula.v1.Program
+	(*ProgramProgress)(nil),                     // 153: schedula.v1.ProgramProgress
+	(*CreateProgramRequest)(nil),                // 154: schedula.v1.CreateProgramRequest
+	(*CreateProgramResponse)(nil),               // 155: schedula.v1.CreateProgramResponse
+	(*GetProgramRequest)(nil),                   // 156: schedula.v1.GetProgramRequest
+	(*GetProgramResponse)(nil),                  // 157: schedula.v1.GetProgramResponse
+	(*ListProgramsRequest)(nil),                 // 158: schedula.v1.ListProgramsRequest
+	(*ListProgramsResponse)(nil),                // 159: schedula.v1.ListProgramsResponse
+	(*CancelProgramRequest)(nil),                // 160: schedula.v1.CancelProgramRequest
+	(*CancelProgramResponse)(nil),               // 161: schedula.v1.CancelProgramResponse
+	(*CheckInRequest)(nil),                      // 162: schedula.v1.CheckInRequest
+	(*CheckInResponse)(nil),                     // 163: schedula.v1.CheckInResponse
+	(*CheckOutRequest)(nil),                     // 164: schedula.v1.CheckOutRequest
+	(*CheckOutResponse)(nil),                    // 165: schedula.v1.CheckOutResponse
+	(*ExportBillableHoursRequest)(nil),          // 166: schedula.v1.ExportBillableHoursRequest
+	(*ExportBillableHoursResponse)(nil),         // 167: schedula.v1.ExportBillableHoursResponse
+	(*OfflineMutation)(nil),                     // 168: schedula.v1.OfflineMutation
+	(*MutationResult)(nil),                      // 169: schedula.v1.MutationResult
+	(*ReconcileCalendarRequest)(nil),            // 170: schedula.v1.ReconcileCalendarRequest
+	(*ReconcileCalendarResponse)(nil),           // 171: schedula.v1.ReconcileCalendarResponse
+	(*CreateEmbedTokenRequest)(nil),             // 172: schedula.v1.CreateEmbedTokenRequest
+	(*CreateEmbedTokenResponse)(nil),            // 173: schedula.v1.CreateEmbedTokenResponse
+	(*DailyBreak)(nil),                          // 174: schedula.v1.DailyBreak
+	(*SlotSettings)(nil),                        // 175: schedula.v1.SlotSettings
+	(*GetSlotSettingsRequest)(nil),              // 176: schedula.v1.GetSlotSettingsRequest
+	(*GetSlotSettingsResponse)(nil),             // 177: schedula.v1.GetSlotSettingsResponse
+	(*UpdateSlotSettingsRequest)(nil),           // 178: schedula.v1.UpdateSlotSettingsRequest
+	(*UpdateSlotSettingsResponse)(nil),          // 179: schedula.v1.UpdateSlotSettingsResponse
+	(*UpdateDailyBreaksRequest)(nil),            // 180: schedula.v1.UpdateDailyBreaksRequest
+	(*UpdateDailyBreaksResponse)(nil),           // 181: schedula.v1.UpdateDailyBreaksResponse
+	(*TimeOffRecurrence)(nil),                   // 182: schedula.v1.TimeOffRecurrence
+	(*TimeOff)(nil),                             // 183: schedula.v1.TimeOff
+	(*CreateTimeOffRequest)(nil),                // 184: schedula.v1.CreateTimeOffRequest
+	(*CreateTimeOffResponse)(nil),               // 185: schedula.v1.CreateTimeOffResponse
+	(*GetTimeOffRequest)(nil),                   // 186: schedula.v1.GetTimeOffRequest
+	(*GetTimeOffResponse)(nil),                  // 187: schedula.v1.GetTimeOffResponse
+	(*UpdateTimeOffRequest)(nil),                // 188: schedula.v1.UpdateTimeOffRequest
+	(*UpdateTimeOffResponse)(nil),               // 189: schedula.v1.UpdateTimeOffResponse
+	(*DeleteTimeOffRequest)(nil),                // 190: schedula.v1.DeleteTimeOffRequest
+	(*DeleteTimeOffResponse)(nil),               // 191: schedula.v1.DeleteTimeOffResponse
+	(*ListTimeOffRequest)(nil),                  // 192: schedula.v1.ListTimeOffRequest
+	(*ListTimeOffResponse)(nil),                 // 193: schedula.v1.ListTimeOffResponse
+	nil,                                         // 194: schedula.v1.Appointment.MetadataEntry
+	nil,                                         // 195: schedula.v1.CreateAppointmentRequest.MetadataEntry
+	nil,                                         // 196: schedula.v1.ListAppointmentsRequest.MetadataFilterEntry
+	nil,                                         // 197: schedula.v1.RecurringSeries.MetadataEntry
+	nil,                                         // 198: schedula.v1.CreateRecurringSeriesRequest.MetadataEntry
+	nil,                                         // 199: schedula.v1.Occurrence.MetadataEntry
+	nil,                                         // 200: schedula.v1.ConfirmHoldRequest.MetadataEntry
+	nil,                                         // 201: schedula.v1.OfflineMutation.MetadataEntry
+	(*timestamppb.Timestamp)(nil),               // 202: google.protobuf.Timestamp
+	(*durationpb.Duration)(nil),                 // 203: google.protobuf.Duration
 }
 var file_proto_schedula_v1_appointments_proto_depIdxs = []int32{
-	0,  // 0: schedula.v1.WeeklyRecurrence.weekdays:type_name -> schedula.v1.Weekday
-	15, // 1: schedula.v1.WeeklyRecurrence.until:type_name -> google.protobuf.Timestamp
-	15, // 2: schedula.v1.Appointment.start_time:type_name -> google.protobuf.Timestamp
-	15, // 3: schedula.v1.Appointment.end_time:type_name -> google.protobuf.Timestamp
-	15, // 4: schedula.v1.Appointment.created_at:type_name -> google.protobuf.Timestamp
-	15, // 5: schedula.v1.Appointment.updated_at:type_name -> google.protobuf.Timestamp
-	15, // 6: schedula.v1.CreateAppointmentRequest.start_time:type_name -> google.protobuf.Timestamp
-	15, // 7: schedula.v1.CreateAppointmentRequest.end_time:type_name -> google.protobuf.Timestamp
-	2,  // 8: schedula.v1.CreateAppointmentResponse.appointment:type_name -> schedula.v1.Appointment
-	15, // 9: schedula.v1.ListAppointmentsRequest.window_start:type_name -> google.protobuf.Timestamp
-	15, // 10: schedula.v1.ListAppointmentsRequest.window_end:type_name -> google.protobuf.Timestamp
-	2,  // 11: schedula.v1.ListAppointmentsResponse.appointments:type_name -> schedula.v1.Appointment
-	15, // 12: schedula.v1.RecurringSeries.start_time:type_name -> google.protobuf.Timestamp
-	15, // 13: schedula.v1.RecurringSeries.end_time:type_name -> google.protobuf.Timestamp
-	1,  // 14: schedula.v1.RecurringSeries.weekly:type_name -> schedula.v1.WeeklyRecurrence
-	15, // 15: schedula.v1.RecurringSeries.created_at:type_name -> google.protobuf.Timestamp
-	15, // 16: schedula.v1.RecurringSeries.updated_at:type_name -> google.protobuf.Timestamp
-	15, // 17: schedula.v1.CreateRecurringSeriesRequest.start_time:type_name -> google.protobuf.Timestamp
-	15, // 18: schedula.v1.CreateRecurringSeriesRequest.end_time:type_name -> google.protobuf.Timestamp
-	1,  // 19: schedula.v1.CreateRecurringSeriesRequest.weekly:type_name -> schedula.v1.WeeklyRecurrence
-	9,  // 20: schedula.v1.CreateRecurringSeriesResponse.series:type_name -> schedula.v1.RecurringSeries
-	15, // 21: schedula.v1.Occurrence.start_time:type_name -> google.protobuf.Timestamp
-	15, // 22: schedula.v1.Occurrence.end_time:type_name -> google.protobuf.Timestamp
-	15, // 23: schedula.v1.ListOccurrencesRequest.window_start:type_name -> google.protobuf.Timestamp
-	15, // 24: schedula.v1.ListOccurrencesRequest.window_end:type_name -> google.protobuf.Timestamp
-	12, // 25: schedula.v1.ListOccurrencesResponse.occurrences:type_name -> schedula.v1.Occurrence
-	3,  // 26: schedula.v1.AppointmentsService.CreateAppointment:input_type -> schedula.v1.CreateAppointmentRequest
-	5,  // 27: schedula.v1.AppointmentsService.ListAppointments:input_type -> schedula.v1.ListAppointmentsRequest
-	7,  // 28: schedula.v1.AppointmentsService.DeleteAppointment:input_type -> schedula.v1.DeleteAppointmentRequest
-	10, // 29: schedula.v1.AppointmentsService.CreateRecurringSeries:input_type -> schedula.v1.CreateRecurringSeriesRequest
-	13, // 30: schedula.v1.AppointmentsService.ListOccurrences:input_type -> schedula.v1.ListOccurrencesRequest
-	4,  // 31: schedula.v1.AppointmentsService.CreateAppointment:output_type -> schedula.v1.CreateAppointmentResponse
-	6,  // 32: schedula.v1.AppointmentsService.ListAppointments:output_type -> schedula.v1.ListAppointmentsResponse
-	8,  // 33: schedula.v1.AppointmentsService.DeleteAppointment:output_type -> schedula.v1.DeleteAppointmentResponse
-	11, // 34: schedula.v1.AppointmentsService.CreateRecurringSeries:output_type -> schedula.v1.CreateRecurringSeriesResponse
-	14, // 35: schedula.v1.AppointmentsService.ListOccurrences:output_type -> schedula.v1.ListOccurrencesResponse
-	31, // [31:36] is the sub-list for method output_type
-	26, // [26:31] is the sub-list for method input_type
-	26, // [26:26] is the sub-list for extension type_name
-	26, // [26:26] is the sub-list for extension extendee
-	0,  // [0:26] is the sub-list for field type_name
+	0,   // 0: schedula.v1.WeeklyRecurrence.weekdays:type_name -> schedula.v1.Weekday
+	202, // 1: schedula.v1.WeeklyRecurrence.until:type_name -> google.protobuf.Timestamp
+	3,   // 2: schedula.v1.WeeklyRecurrence.dst_gap_policy:type_name -> schedula.v1.DstGapPolicy
+	4,   // 3: schedula.v1.WeeklyRecurrence.dst_ambiguous_policy:type_name -> schedula.v1.DstAmbiguousPolicy
+	0,   // 4: schedula.v1.WeeklyRecurrence.week_start:type_name -> schedula.v1.Weekday
+	19,  // 5: schedula.v1.WeeklyRecurrence.weekday_times:type_name -> schedula.v1.WeekdayTime
+	0,   // 6: schedula.v1.WeekdayTime.weekday:type_name -> schedula.v1.Weekday
+	202, // 7: schedula.v1.Appointment.start_time:type_name -> google.protobuf.Timestamp
+	202, // 8: schedula.v1.Appointment.end_time:type_name -> google.protobuf.Timestamp
+	202, // 9: schedula.v1.Appointment.created_at:type_name -> google.protobuf.Timestamp
+	202, // 10: schedula.v1.Appointment.updated_at:type_name -> google.protobuf.Timestamp
+	194, // 11: schedula.v1.Appointment.metadata:type_name -> schedula.v1.Appointment.MetadataEntry
+	20,  // 12: schedula.v1.Appointment.external_ref:type_name -> schedula.v1.ExternalRef
+	202, // 13: schedula.v1.Appointment.checked_in_at:type_name -> google.protobuf.Timestamp
+	202, // 14: schedula.v1.Appointment.checked_out_at:type_name -> google.protobuf.Timestamp
+	5,   // 15: schedula.v1.Appointment.kind:type_name -> schedula.v1.AppointmentKind
+	202, // 16: schedula.v1.CreateAppointmentRequest.start_time:type_name -> google.protobuf.Timestamp
+	202, // 17: schedula.v1.CreateAppointmentRequest.end_time:type_name -> google.protobuf.Timestamp
+	195, // 18: schedula.v1.CreateAppointmentRequest.metadata:type_name -> schedula.v1.CreateAppointmentRequest.MetadataEntry
+	20,  // 19: schedula.v1.CreateAppointmentRequest.external_ref:type_name -> schedula.v1.ExternalRef
+	5,   // 20: schedula.v1.CreateAppointmentRequest.kind:type_name -> schedula.v1.AppointmentKind
+	202, // 21: schedula.v1.BlackoutWarning.start_time:type_name -> google.protobuf.Timestamp
+	202, // 22: schedula.v1.BlackoutWarning.end_time:type_name -> google.protobuf.Timestamp
+	21,  // 23: schedula.v1.CreateAppointmentResponse.appointment:type_name -> schedula.v1.Appointment
+	23,  // 24: schedula.v1.CreateAppointmentResponse.blackout_warnings:type_name -> schedula.v1.BlackoutWarning
+	24,  // 25: schedula.v1.CreateAppointmentResponse.warnings:type_name -> schedula.v1.Warning
+	202, // 26: schedula.v1.ListAppointmentsRequest.window_start:type_name -> google.protobuf.Timestamp
+	202, // 27: schedula.v1.ListAppointmentsRequest.window_end:type_name -> google.protobuf.Timestamp
+	196, // 28: schedula.v1.ListAppointmentsRequest.metadata_filter:type_name -> schedula.v1.ListAppointmentsRequest.MetadataFilterEntry
+	202, // 29: schedula.v1.DaySegment.start_time:type_name -> google.protobuf.Timestamp
+	202, // 30: schedula.v1.DaySegment.end_time:type_name -> google.protobuf.Timestamp
+	21,  // 31: schedula.v1.ListAppointmentsResponse.appointments:type_name -> schedula.v1.Appointment
+	27,  // 32: schedula.v1.ListAppointmentsResponse.day_segments:type_name -> schedula.v1.DaySegment
+	20,  // 33: schedula.v1.GetAppointmentByExternalRefRequest.external_ref:type_name -> schedula.v1.ExternalRef
+	21,  // 34: schedula.v1.GetAppointmentByExternalRefResponse.appointment:type_name -> schedula.v1.Appointment
+	202, // 35: schedula.v1.RecurringSeries.start_time:type_name -> google.protobuf.Timestamp
+	202, // 36: schedula.v1.RecurringSeries.end_time:type_name -> google.protobuf.Timestamp
+	18,  // 37: schedula.v1.RecurringSeries.weekly:type_name -> schedula.v1.WeeklyRecurrence
+	202, // 38: schedula.v1.RecurringSeries.created_at:type_name -> google.protobuf.Timestamp
+	202, // 39: schedula.v1.RecurringSeries.updated_at:type_name -> google.protobuf.Timestamp
+	202, // 40: schedula.v1.RecurringSeries.next_occurrence:type_name -> google.protobuf.Timestamp
+	197, // 41: schedula.v1.RecurringSeries.metadata:type_name -> schedula.v1.RecurringSeries.MetadataEntry
+	203, // 42: schedula.v1.RecurringSeries.duration:type_name -> google.protobuf.Duration
+	202, // 43: schedula.v1.CreateRecurringSeriesRequest.start_time:type_name -> google.protobuf.Timestamp
+	202, // 44: schedula.v1.CreateRecurringSeriesRequest.end_time:type_name -> google.protobuf.Timestamp
+	18,  // 45: schedula.v1.CreateRecurringSeriesRequest.weekly:type_name -> schedula.v1.WeeklyRecurrence
+	198, // 46: schedula.v1.CreateRecurringSeriesRequest.metadata:type_name -> schedula.v1.CreateRecurringSeriesRequest.MetadataEntry
+	203, // 47: schedula.v1.CreateRecurringSeriesRequest.duration:type_name -> google.protobuf.Duration
+	33,  // 48: schedula.v1.CreateRecurringSeriesResponse.series:type_name -> schedula.v1.RecurringSeries
+	202, // 49: schedula.v1.CreateRecurringSeriesResponse.skipped_occurrences:type_name -> google.protobuf.Timestamp
+	23,  // 50: schedula.v1.CreateRecurringSeriesResponse.blackout_warnings:type_name -> schedula.v1.BlackoutWarning
+	24,  // 51: schedula.v1.CreateRecurringSeriesResponse.warnings:type_name -> schedula.v1.Warning
+	33,  // 52: schedula.v1.GetRecurringSeriesResponse.series:type_name -> schedula.v1.RecurringSeries
+	0,   // 53: schedula.v1.ListRecurringSeriesRequest.weekdays:type_name -> schedula.v1.Weekday
+	33,  // 54: schedula.v1.ListRecurringSeriesResponse.series:type_name -> schedula.v1.RecurringSeries
+	202, // 55: schedula.v1.Occurrence.start_time:type_name -> google.protobuf.Timestamp
+	202, // 56: schedula.v1.Occurrence.end_time:type_name -> google.protobuf.Timestamp
+	199, // 57: schedula.v1.Occurrence.metadata:type_name -> schedula.v1.Occurrence.MetadataEntry
+	202, // 58: schedula.v1.ListOccurrencesRequest.window_start:type_name -> google.protobuf.Timestamp
+	202, // 59: schedula.v1.ListOccurrencesRequest.window_end:type_name -> google.protobuf.Timestamp
+	203, // 60: schedula.v1.ListOccurrencesRequest.max_horizon:type_name -> google.protobuf.Duration
+	40,  // 61: schedula.v1.ListOccurrencesResponse.occurrences:type_name -> schedula.v1.Occurrence
+	27,  // 62: schedula.v1.ListOccurrencesResponse.day_segments:type_name -> schedula.v1.DaySegment
+	202, // 63: schedula.v1.ListOccurrencesResponse.expanded_until:type_name -> google.protobuf.Timestamp
+	1,   // 64: schedula.v1.OccurrenceAttendance.status:type_name -> schedula.v1.AttendanceStatus
+	202, // 65: schedula.v1.OccurrenceAttendance.occurrence_start:type_name -> google.protobuf.Timestamp
+	202, // 66: schedula.v1.OccurrenceAttendance.updated_at:type_name -> google.protobuf.Timestamp
+	1,   // 67: schedula.v1.MarkAttendanceRequest.status:type_name -> schedula.v1.AttendanceStatus
+	43,  // 68: schedula.v1.MarkAttendanceResponse.attendance:type_name -> schedula.v1.OccurrenceAttendance
+	46,  // 69: schedula.v1.GetAttendanceStatsResponse.participants:type_name -> schedula.v1.ParticipantAttendanceStats
+	203, // 70: schedula.v1.GetLimitsResponse.max_appointment_duration:type_name -> google.protobuf.Duration
+	203, // 71: schedula.v1.GetLimitsResponse.recurring_lookahead:type_name -> google.protobuf.Duration
+	7,   // 72: schedula.v1.GetLimitsResponse.interval_bounds:type_name -> schedula.v1.IntervalBounds
+	202, // 73: schedula.v1.GetAnalyticsRequest.window_start:type_name -> google.protobuf.Timestamp
+	202, // 74: schedula.v1.GetAnalyticsRequest.window_end:type_name -> google.protobuf.Timestamp
+	203, // 75: schedula.v1.GetAnalyticsResponse.average_duration:type_name -> google.protobuf.Duration
+	203, // 76: schedula.v1.GetAnalyticsResponse.planned_session_time:type_name -> google.protobuf.Duration
+	203, // 77: schedula.v1.GetAnalyticsResponse.actual_session_time:type_name -> google.protobuf.Duration
+	202, // 78: schedula.v1.SuggestEndTimeRequest.start_time:type_name -> google.protobuf.Timestamp
+	203, // 79: schedula.v1.SuggestEndTimeRequest.desired_duration:type_name -> google.protobuf.Duration
+	86,  // 80: schedula.v1.SuggestEndTimeRequest.working_hours:type_name -> schedula.v1.WorkingHours
+	202, // 81: schedula.v1.SuggestEndTimeResponse.end_time:type_name -> google.protobuf.Timestamp
+	203, // 82: schedula.v1.SuggestEndTimeResponse.duration:type_name -> google.protobuf.Duration
+	202, // 83: schedula.v1.SlotHold.start_time:type_name -> google.protobuf.Timestamp
+	202, // 84: schedula.v1.SlotHold.end_time:type_name -> google.protobuf.Timestamp
+	202, // 85: schedula.v1.SlotHold.expires_at:type_name -> google.protobuf.Timestamp
+	202, // 86: schedula.v1.ReserveSlotRequest.start_time:type_name -> google.protobuf.Timestamp
+	202, // 87: schedula.v1.ReserveSlotRequest.end_time:type_name -> google.protobuf.Timestamp
+	203, // 88: schedula.v1.ReserveSlotRequest.ttl:type_name -> google.protobuf.Duration
+	55,  // 89: schedula.v1.ReserveSlotResponse.hold:type_name -> schedula.v1.SlotHold
+	200, // 90: schedula.v1.ConfirmHoldRequest.metadata:type_name -> schedula.v1.ConfirmHoldRequest.MetadataEntry
+	21,  // 91: schedula.v1.ConfirmHoldResponse.appointment:type_name -> schedula.v1.Appointment
+	24,  // 92: schedula.v1.ConfirmHoldResponse.warnings:type_name -> schedula.v1.Warning
+	202, // 93: schedula.v1.AppointmentProposal.start_time:type_name -> google.protobuf.Timestamp
+	202, // 94: schedula.v1.AppointmentProposal.end_time:type_name -> google.protobuf.Timestamp
+	9,   // 95: schedula.v1.AppointmentProposal.status:type_name -> schedula.v1.ProposalStatus
+	202, // 96: schedula.v1.AppointmentProposal.expires_at:type_name -> google.protobuf.Timestamp
+	202, // 97: schedula.v1.AppointmentProposal.created_at:type_name -> google.protobuf.Timestamp
+	202, // 98: schedula.v1.AppointmentProposal.responded_at:type_name -> google.protobuf.Timestamp
+	202, // 99: schedula.v1.ProposeAppointmentRequest.start_time:type_name -> google.protobuf.Timestamp
+	202, // 100: schedula.v1.ProposeAppointmentRequest.end_time:type_name -> google.protobuf.Timestamp
+	203, // 101: schedula.v1.ProposeAppointmentRequest.ttl:type_name -> google.protobuf.Duration
+	62,  // 102: schedula.v1.ProposeAppointmentResponse.proposal:type_name -> schedula.v1.AppointmentProposal
+	62,  // 103: schedula.v1.ListProposalsResponse.proposals:type_name -> schedula.v1.AppointmentProposal
+	62,  // 104: schedula.v1.AcceptProposalResponse.proposal:type_name -> schedula.v1.AppointmentProposal
+	62,  // 105: schedula.v1.DeclineProposalResponse.proposal:type_name -> schedula.v1.AppointmentProposal
+	2,   // 106: schedula.v1.AppointmentLink.kind:type_name -> schedula.v1.AppointmentLinkKind
+	2,   // 107: schedula.v1.LinkAppointmentsRequest.kind:type_name -> schedula.v1.AppointmentLinkKind
+	71,  // 108: schedula.v1.LinkAppointmentsResponse.link:type_name -> schedula.v1.AppointmentLink
+	2,   // 109: schedula.v1.UnlinkAppointmentsRequest.kind:type_name -> schedula.v1.AppointmentLinkKind
+	71,  // 110: schedula.v1.RelatedAppointment.link:type_name -> schedula.v1.AppointmentLink
+	21,  // 111: schedula.v1.RelatedAppointment.appointment:type_name -> schedula.v1.Appointment
+	76,  // 112: schedula.v1.ListRelatedResponse.related:type_name -> schedula.v1.RelatedAppointment
+	21,  // 113: schedula.v1.MergeAppointmentsResponse.appointment:type_name -> schedula.v1.Appointment
+	202, // 114: schedula.v1.BusyInterval.start_time:type_name -> google.protobuf.Timestamp
+	202, // 115: schedula.v1.BusyInterval.end_time:type_name -> google.protobuf.Timestamp
+	81,  // 116: schedula.v1.UserFreeBusy.busy:type_name -> schedula.v1.BusyInterval
+	202, // 117: schedula.v1.BatchGetFreeBusyRequest.window_start:type_name -> google.protobuf.Timestamp
+	202, // 118: schedula.v1.BatchGetFreeBusyRequest.window_end:type_name -> google.protobuf.Timestamp
+	82,  // 119: schedula.v1.BatchGetFreeBusyResponse.results:type_name -> schedula.v1.UserFreeBusy
+	202, // 120: schedula.v1.TimeRange.start_time:type_name -> google.protobuf.Timestamp
+	202, // 121: schedula.v1.TimeRange.end_time:type_name -> google.protobuf.Timestamp
+	0,   // 122: schedula.v1.WorkingHours.weekdays:type_name -> schedula.v1.Weekday
+	86,  // 123: schedula.v1.MeetingAttendee.working_hours:type_name -> schedula.v1.WorkingHours
+	85,  // 124: schedula.v1.MeetingAttendee.preferred:type_name -> schedula.v1.TimeRange
+	87,  // 125: schedula.v1.SuggestMeetingTimesRequest.attendees:type_name -> schedula.v1.MeetingAttendee
+	203, // 126: schedula.v1.SuggestMeetingTimesRequest.duration:type_name -> google.protobuf.Duration
+	202, // 127: schedula.v1.SuggestMeetingTimesRequest.window_start:type_name -> google.protobuf.Timestamp
+	202, // 128: schedula.v1.SuggestMeetingTimesRequest.window_end:type_name -> google.protobuf.Timestamp
+	203, // 129: schedula.v1.SuggestMeetingTimesRequest.step:type_name -> google.protobuf.Duration
+	202, // 130: schedula.v1.MeetingSuggestion.start_time:type_name -> google.protobuf.Timestamp
+	202, // 131: schedula.v1.MeetingSuggestion.end_time:type_name -> google.protobuf.Timestamp
+	89,  // 132: schedula.v1.SuggestMeetingTimesResponse.suggestions:type_name -> schedula.v1.MeetingSuggestion
+	86,  // 133: schedula.v1.SimulatedStaff.working_hours:type_name -> schedula.v1.WorkingHours
+	174, // 134: schedula.v1.SimulatedStaff.breaks:type_name -> schedula.v1.DailyBreak
+	203, // 135: schedula.v1.BookingPattern.duration:type_name -> google.protobuf.Duration
+	0,   // 136: schedula.v1.BookingPattern.weekdays:type_name -> schedula.v1.Weekday
+	91,  // 137: schedula.v1.SimulateScheduleRequest.staff:type_name -> schedula.v1.SimulatedStaff
+	92,  // 138: schedula.v1.SimulateScheduleRequest.patterns:type_name -> schedula.v1.BookingPattern
+	202, // 139: schedula.v1.SimulateScheduleRequest.window_start:type_name -> google.protobuf.Timestamp
+	202, // 140: schedula.v1.SimulateScheduleRequest.window_end:type_name -> google.protobuf.Timestamp
+	203, // 141: schedula.v1.SimulateScheduleRequest.step:type_name -> google.protobuf.Duration
+	203, // 142: schedula.v1.ScheduleUtilization.capacity:type_name -> google.protobuf.Duration
+	203, // 143: schedula.v1.ScheduleUtilization.booked:type_name -> google.protobuf.Duration
+	94,  // 144: schedula.v1.SimulatedDay.utilization:type_name -> schedula.v1.ScheduleUtilization
+	94,  // 145: schedula.v1.SimulatedStaffUtilization.utilization:type_name -> schedula.v1.ScheduleUtilization
+	94,  // 146: schedula.v1.SimulateScheduleResponse.utilization:type_name -> schedula.v1.ScheduleUtilization
+	95,  // 147: schedula.v1.SimulateScheduleResponse.days:type_name -> schedula.v1.SimulatedDay
+	96,  // 148: schedula.v1.SimulateScheduleResponse.staff:type_name -> schedula.v1.SimulatedStaffUtilization
+	97,  // 149: schedula.v1.SimulateScheduleResponse.patterns:type_name -> schedula.v1.BookingPatternOutcome
+	10,  // 150: schedula.v1.SeriesFinding.kind:type_name -> schedula.v1.SeriesFindingKind
+	202, // 151: schedula.v1.SeriesFinding.occurrence_start:type_name -> google.protobuf.Timestamp
+	99,  // 152: schedula.v1.RepairRecurringSeriesResponse.findings:type_name -> schedula.v1.SeriesFinding
+	202, // 153: schedula.v1.CalendarEntry.start_time:type_name -> google.protobuf.Timestamp
+	202, // 154: schedula.v1.CalendarEntry.end_time:type_name -> google.protobuf.Timestamp
+	102, // 155: schedula.v1.CalendarConflict.first:type_name -> schedula.v1.CalendarEntry
+	102, // 156: schedula.v1.CalendarConflict.second:type_name -> schedula.v1.CalendarEntry
+	202, // 157: schedula.v1.CalendarConflict.overlap_start:type_name -> google.protobuf.Timestamp
+	202, // 158: schedula.v1.CalendarConflict.overlap_end:type_name -> google.protobuf.Timestamp
+	8,   // 159: schedula.v1.CalendarConflict.cause:type_name -> schedula.v1.ConflictCause
+	202, // 160: schedula.v1.AuditCalendarRequest.window_start:type_name -> google.protobuf.Timestamp
+	202, // 161: schedula.v1.AuditCalendarRequest.window_end:type_name -> google.protobuf.Timestamp
+	103, // 162: schedula.v1.AuditCalendarResponse.conflicts:type_name -> schedula.v1.CalendarConflict
+	102, // 163: schedula.v1.AgendaItem.entry:type_name -> schedula.v1.CalendarEntry
+	203, // 164: schedula.v1.AgendaItem.gap_before:type_name -> google.protobuf.Duration
+	107, // 165: schedula.v1.GetDailyAgendaResponse.items:type_name -> schedula.v1.AgendaItem
+	203, // 166: schedula.v1.GetDailyAgendaResponse.busy_time:type_name -> google.protobuf.Duration
+	203, // 167: schedula.v1.DaySummary.busy_time:type_name -> google.protobuf.Duration
+	110, // 168: schedula.v1.GetDaySummariesResponse.days:type_name -> schedula.v1.DaySummary
+	202, // 169: schedula.v1.UpdateSeriesEndRequest.until:type_name -> google.protobuf.Timestamp
+	33,  // 170: schedula.v1.UpdateSeriesEndResponse.series:type_name -> schedula.v1.RecurringSeries
+	6,   // 171: schedula.v1.ChangeSeriesTimeZoneRequest.keep:type_name -> schedula.v1.TimeZoneKeep
+	33,  // 172: schedula.v1.ChangeSeriesTimeZoneResponse.series:type_name -> schedula.v1.RecurringSeries
+	202, // 173: schedula.v1.ChangeSeriesTimeZoneResponse.confirmation_expires_at:type_name -> google.protobuf.Timestamp
+	202, // 174: schedula.v1.SkipOccurrencesRequest.window_start:type_name -> google.protobuf.Timestamp
+	202, // 175: schedula.v1.SkipOccurrencesRequest.window_end:type_name -> google.protobuf.Timestamp
+	0,   // 176: schedula.v1.SkipOccurrencesRequest.weekdays:type_name -> schedula.v1.Weekday
+	202, // 177: schedula.v1.SkipOccurrencesResponse.occurrence_starts:type_name -> google.protobuf.Timestamp
+	202, // 178: schedula.v1.SkipOccurrencesResponse.confirmation_expires_at:type_name -> google.protobuf.Timestamp
+	202, // 179: schedula.v1.DelegationGrant.created_at:type_name -> google.protobuf.Timestamp
+	118, // 180: schedula.v1.GrantDelegationResponse.grant:type_name -> schedula.v1.DelegationGrant
+	118, // 181: schedula.v1.ListDelegationsResponse.grants:type_name -> schedula.v1.DelegationGrant
+	202, // 182: schedula.v1.WatchOccurrencesRequest.window_start:type_name -> google.protobuf.Timestamp
+	202, // 183: schedula.v1.WatchOccurrencesRequest.window_end:type_name -> google.protobuf.Timestamp
+	33,  // 184: schedula.v1.WatchOccurrencesResponse.series:type_name -> schedula.v1.RecurringSeries
+	40,  // 185: schedula.v1.WatchOccurrencesResponse.occurrences:type_name -> schedula.v1.Occurrence
+	11,  // 186: schedula.v1.CalendarChange.entity_type:type_name -> schedula.v1.ChangeEntity
+	12,  // 187: schedula.v1.CalendarChange.op:type_name -> schedula.v1.ChangeOp
+	202, // 188: schedula.v1.CalendarChange.changed_at:type_name -> google.protobuf.Timestamp
+	203, // 189: schedula.v1.ListChangesRequest.wait:type_name -> google.protobuf.Duration
+	127, // 190: schedula.v1.ListChangesResponse.changes:type_name -> schedula.v1.CalendarChange
+	202, // 191: schedula.v1.CalendarSnapshot.created_at:type_name -> google.protobuf.Timestamp
+	134, // 192: schedula.v1.CreateCalendarSnapshotResponse.snapshot:type_name -> schedula.v1.CalendarSnapshot
+	134, // 193: schedula.v1.ListCalendarSnapshotsResponse.snapshots:type_name -> schedula.v1.CalendarSnapshot
+	134, // 194: schedula.v1.RestoreCalendarSnapshotResponse.snapshot:type_name -> schedula.v1.CalendarSnapshot
+	202, // 195: schedula.v1.Contact.created_at:type_name -> google.protobuf.Timestamp
+	202, // 196: schedula.v1.Contact.updated_at:type_name -> google.protobuf.Timestamp
+	141, // 197: schedula.v1.CreateContactResponse.contact:type_name -> schedula.v1.Contact
+	141, // 198: schedula.v1.GetContactResponse.contact:type_name -> schedula.v1.Contact
+	141, // 199: schedula.v1.UpdateContactResponse.contact:type_name -> schedula.v1.Contact
+	141, // 200: schedula.v1.ListContactsResponse.contacts:type_name -> schedula.v1.Contact
+	202, // 201: schedula.v1.Program.cancelled_at:type_name -> google.protobuf.Timestamp
+	202, // 202: schedula.v1.Program.created_at:type_name -> google.protobuf.Timestamp
+	202, // 203: schedula.v1.Program.updated_at:type_name -> google.protobuf.Timestamp
+	202, // 204: schedula.v1.ProgramProgress.next_session:type_name -> google.protobuf.Timestamp
+	152, // 205: schedula.v1.CreateProgramResponse.program:type_name -> schedula.v1.Program
+	152, // 206: schedula.v1.GetProgramResponse.program:type_name -> schedula.v1.Program
+	21,  // 207: schedula.v1.GetProgramResponse.appointments:type_name -> schedula.v1.Appointment
+	33,  // 208: schedula.v1.GetProgramResponse.series:type_name -> schedula.v1.RecurringSeries
+	153, // 209: schedula.v1.GetProgramResponse.progress:type_name -> schedula.v1.ProgramProgress
+	152, // 210: schedula.v1.ListProgramsResponse.programs:type_name -> schedula.v1.Program
+	152, // 211: schedula.v1.CancelProgramResponse.program:type_name -> schedula.v1.Program
+	202, // 212: schedula.v1.CheckInRequest.at:type_name -> google.protobuf.Timestamp
+	21,  // 213: schedula.v1.CheckInResponse.appointment:type_name -> schedula.v1.Appointment
+	202, // 214: schedula.v1.CheckOutRequest.at:type_name -> google.protobuf.Timestamp
+	21,  // 215: schedula.v1.CheckOutResponse.appointment:type_name -> schedula.v1.Appointment
+	203, // 216: schedula.v1.CheckOutResponse.planned_duration:type_name -> google.protobuf.Duration
+	203, // 217: schedula.v1.CheckOutResponse.actual_duration:type_name -> google.protobuf.Duration
+	202, // 218: schedula.v1.ExportBillableHoursRequest.window_start:type_name -> google.protobuf.Timestamp
+	202, // 219: schedula.v1.ExportBillableHoursRequest.window_end:type_name -> google.protobuf.Timestamp
+	13,  // 220: schedula.v1.ExportBillableHoursRequest.period:type_name -> schedula.v1.BillablePeriod
+	14,  // 221: schedula.v1.ExportBillableHoursRequest.format:type_name -> schedula.v1.BillableFormat
+	15,  // 222: schedula.v1.OfflineMutation.kind:type_name -> schedula.v1.MutationKind
+	202, // 223: schedula.v1.OfflineMutation.start_time:type_name -> google.protobuf.Timestamp
+	202, // 224: schedula.v1.OfflineMutation.end_time:type_name -> google.protobuf.Timestamp
+	201, // 225: schedula.v1.OfflineMutation.metadata:type_name -> schedula.v1.OfflineMutation.MetadataEntry
+	202, // 226: schedula.v1.OfflineMutation.base_updated_at:type_name -> google.protobuf.Timestamp
+	16,  // 227: schedula.v1.MutationResult.status:type_name -> schedula.v1.MutationStatus
+	17,  // 228: schedula.v1.MutationResult.conflict:type_name -> schedula.v1.MutationConflict
+	21,  // 229: schedula.v1.MutationResult.current:type_name -> schedula.v1.Appointment
+	168, // 230: schedula.v1.ReconcileCalendarRequest.mutations:type_name -> schedula.v1.OfflineMutation
+	169, // 231: schedula.v1.ReconcileCalendarResponse.results:type_name -> schedula.v1.MutationResult
+	203, // 232: schedula.v1.CreateEmbedTokenRequest.slot_duration:type_name -> google.protobuf.Duration
+	86,  // 233: schedula.v1.CreateEmbedTokenRequest.working_hours:type_name -> schedula.v1.WorkingHours
+	203, // 234: schedula.v1.CreateEmbedTokenRequest.ttl:type_name -> google.protobuf.Duration
+	202, // 235: schedula.v1.CreateEmbedTokenResponse.expires_at:type_name -> google.protobuf.Timestamp
+	202, // 236: schedula.v1.SlotSettings.updated_at:type_name -> google.protobuf.Timestamp
+	174, // 237: schedula.v1.SlotSettings.daily_breaks:type_name -> schedula.v1.DailyBreak
+	175, // 238: schedula.v1.GetSlotSettingsResponse.settings:type_name -> schedula.v1.SlotSettings
+	175, // 239: schedula.v1.UpdateSlotSettingsResponse.settings:type_name -> schedula.v1.SlotSettings
+	174, // 240: schedula.v1.UpdateDailyBreaksRequest.breaks:type_name -> schedula.v1.DailyBreak
+	175, // 241: schedula.v1.UpdateDailyBreaksResponse.settings:type_name -> schedula.v1.SlotSettings
+	0,   // 242: schedula.v1.TimeOffRecurrence.weekdays:type_name -> schedula.v1.Weekday
+	202, // 243: schedula.v1.TimeOffRecurrence.until:type_name -> google.protobuf.Timestamp
+	202, // 244: schedula.v1.TimeOff.start_time:type_name -> google.protobuf.Timestamp
+	202, // 245: schedula.v1.TimeOff.end_time:type_name -> google.protobuf.Timestamp
+	182, // 246: schedula.v1.TimeOff.recurrence:type_name -> schedula.v1.TimeOffRecurrence
+	202, // 247: schedula.v1.TimeOff.created_at:type_name -> google.protobuf.Timestamp
+	202, // 248: schedula.v1.TimeOff.updated_at:type_name -> google.protobuf.Timestamp
+	202, // 249: schedula.v1.CreateTimeOffRequest.start_time:type_name -> google.protobuf.Timestamp
+	202, // 250: schedula.v1.CreateTimeOffRequest.end_time:type_name -> google.protobuf.Timestamp
+	182, // 251: schedula.v1.CreateTimeOffRequest.recurrence:type_name -> schedula.v1.TimeOffRecurrence
+	183, // 252: schedula.v1.CreateTimeOffResponse.time_off:type_name -> schedula.v1.TimeOff
+	183, // 253: schedula.v1.GetTimeOffResponse.time_off:type_name -> schedula.v1.TimeOff
+	202, // 254: schedula.v1.UpdateTimeOffRequest.start_time:type_name -> google.protobuf.Timestamp
+	202, // 255: schedula.v1.UpdateTimeOffRequest.end_time:type_name -> google.protobuf.Timestamp
+	182, // 256: schedula.v1.UpdateTimeOffRequest.recurrence:type_name -> schedula.v1.TimeOffRecurrence
+	183, // 257: schedula.v1.UpdateTimeOffResponse.time_off:type_name -> schedula.v1.TimeOff
+	183, // 258: schedula.v1.ListTimeOffResponse.time_off:type_name -> schedula.v1.TimeOff
+	22,  // 259: schedula.v1.AppointmentsService.CreateAppointment:input_type -> schedula.v1.CreateAppointmentRequest
+	26,  // 260: schedula.v1.AppointmentsService.ListAppointments:input_type -> schedula.v1.ListAppointmentsRequest
+	31,  // 261: schedula.v1.AppointmentsService.DeleteAppointment:input_type -> schedula.v1.DeleteAppointmentRequest
+	34,  // 262: schedula.v1.AppointmentsService.CreateRecurringSeries:input_type -> schedula.v1.CreateRecurringSeriesRequest
+	41,  // 263: schedula.v1.AppointmentsService.ListOccurrences:input_type -> schedula.v1.ListOccurrencesRequest
+	36,  // 264: schedula.v1.AppointmentsService.GetRecurringSeries:input_type -> schedula.v1.GetRecurringSeriesRequest
+	38,  // 265: schedula.v1.AppointmentsService.ListRecurringSeries:input_type -> schedula.v1.ListRecurringSeriesRequest
+	44,  // 266: schedula.v1.AppointmentsService.MarkAttendance:input_type -> schedula.v1.MarkAttendanceRequest
+	47,  // 267: schedula.v1.AppointmentsService.GetAttendanceStats:input_type -> schedula.v1.GetAttendanceStatsRequest
+	49,  // 268: schedula.v1.AppointmentsService.GetLimits:input_type -> schedula.v1.GetLimitsRequest
+	29,  // 269: schedula.v1.AppointmentsService.GetAppointmentByExternalRef:input_type -> schedula.v1.GetAppointmentByExternalRefRequest
+	51,  // 270: schedula.v1.AppointmentsService.GetAnalytics:input_type -> schedula.v1.GetAnalyticsRequest
+	53,  // 271: schedula.v1.AppointmentsService.SuggestEndTime:input_type -> schedula.v1.SuggestEndTimeRequest
+	56,  // 272: schedula.v1.AppointmentsService.ReserveSlot:input_type -> schedula.v1.ReserveSlotRequest
+	58,  // 273: schedula.v1.AppointmentsService.ConfirmHold:input_type -> schedula.v1.ConfirmHoldRequest
+	60,  // 274: schedula.v1.AppointmentsService.ReleaseHold:input_type -> schedula.v1.ReleaseHoldRequest
+	63,  // 275: schedula.v1.AppointmentsService.ProposeAppointment:input_type -> schedula.v1.ProposeAppointmentRequest
+	65,  // 276: schedula.v1.AppointmentsService.ListProposals:input_type -> schedula.v1.ListProposalsRequest
+	67,  // 277: schedula.v1.AppointmentsService.AcceptProposal:input_type -> schedula.v1.AcceptProposalRequest
+	69,  // 278: schedula.v1.AppointmentsService.DeclineProposal:input_type -> schedula.v1.DeclineProposalRequest
+	72,  // 279: schedula.v1.AppointmentsService.LinkAppointments:input_type -> schedula.v1.LinkAppointmentsRequest
+	74,  // 280: schedula.v1.AppointmentsService.UnlinkAppointments:input_type -> schedula.v1.UnlinkAppointmentsRequest
+	77,  // 281: schedula.v1.AppointmentsService.ListRelated:input_type -> schedula.v1.ListRelatedRequest
+	79,  // 282: schedula.v1.AppointmentsService.MergeAppointments:input_type -> schedula.v1.MergeAppointmentsRequest
+	83,  // 283: schedula.v1.AppointmentsService.BatchGetFreeBusy:input_type -> schedula.v1.BatchGetFreeBusyRequest
+	88,  // 284: schedula.v1.AppointmentsService.SuggestMeetingTimes:input_type -> schedula.v1.SuggestMeetingTimesRequest
+	100, // 285: schedula.v1.AppointmentsService.RepairRecurringSeries:input_type -> schedula.v1.RepairRecurringSeriesRequest
+	116, // 286: schedula.v1.AppointmentsService.SkipOccurrences:input_type -> schedula.v1.SkipOccurrencesRequest
+	112, // 287: schedula.v1.AppointmentsService.UpdateSeriesEnd:input_type -> schedula.v1.UpdateSeriesEndRequest
+	114, // 288: schedula.v1.AppointmentsService.ChangeSeriesTimeZone:input_type -> schedula.v1.ChangeSeriesTimeZoneRequest
+	104, // 289: schedula.v1.AppointmentsService.AuditCalendar:input_type -> schedula.v1.AuditCalendarRequest
+	106, // 290: schedula.v1.AppointmentsService.GetDailyAgenda:input_type -> schedula.v1.GetDailyAgendaRequest
+	109, // 291: schedula.v1.AppointmentsService.GetDaySummaries:input_type -> schedula.v1.GetDaySummariesRequest
+	119, // 292: schedula.v1.AppointmentsService.GrantDelegation:input_type -> schedula.v1.GrantDelegationRequest
+	121, // 293: schedula.v1.AppointmentsService.RevokeDelegation:input_type -> schedula.v1.RevokeDelegationRequest
+	123, // 294: schedula.v1.AppointmentsService.ListDelegations:input_type -> schedula.v1.ListDelegationsRequest
+	130, // 295: schedula.v1.AppointmentsService.ExportCalendar:input_type -> schedula.v1.ExportCalendarRequest
+	125, // 296: schedula.v1.AppointmentsService.WatchOccurrences:input_type -> schedula.v1.WatchOccurrencesRequest
+	128, // 297: schedula.v1.AppointmentsService.ListChanges:input_type -> schedula.v1.ListChangesRequest
+	132, // 298: schedula.v1.AppointmentsService.ImportCalendar:input_type -> schedula.v1.ImportCalendarRequest
+	170, // 299: schedula.v1.AppointmentsService.ReconcileCalendar:input_type -> schedula.v1.ReconcileCalendarRequest
+	162, // 300: schedula.v1.AppointmentsService.CheckIn:input_type -> schedula.v1.CheckInRequest
+	164, // 301: schedula.v1.AppointmentsService.CheckOut:input_type -> schedula.v1.CheckOutRequest
+	166, // 302: schedula.v1.AppointmentsService.ExportBillableHours:input_type -> schedula.v1.ExportBillableHoursRequest
+	142, // 303: schedula.v1.AppointmentsService.CreateContact:input_type -> schedula.v1.CreateContactRequest
+	144, // 304: schedula.v1.AppointmentsService.GetContact:input_type -> schedula.v1.GetContactRequest
+	146, // 305: schedula.v1.AppointmentsService.UpdateContact:input_type -> schedula.v1.UpdateContactRequest
+	148, // 306: schedula.v1.AppointmentsService.DeleteContact:input_type -> schedula.v1.DeleteContactRequest
+	150, // 307: schedula.v1.AppointmentsService.ListContacts:input_type -> schedula.v1.ListContactsRequest
+	154, // 308: schedula.v1.AppointmentsService.CreateProgram:input_type -> schedula.v1.CreateProgramRequest
+	156, // 309: schedula.v1.AppointmentsService.GetProgram:input_type -> schedula.v1.GetProgramRequest
+	158, // 310: schedula.v1.AppointmentsService.ListPrograms:input_type -> schedula.v1.ListProgramsRequest
+	160, // 311: schedula.v1.AppointmentsService.CancelProgram:input_type -> schedula.v1.CancelProgramRequest
+	172, // 312: schedula.v1.AppointmentsService.CreateEmbedToken:input_type -> schedula.v1.CreateEmbedTokenRequest
+	176, // 313: schedula.v1.AppointmentsService.GetSlotSettings:input_type -> schedula.v1.GetSlotSettingsRequest
+	178, // 314: schedula.v1.AppointmentsService.UpdateSlotSettings:input_type -> schedula.v1.UpdateSlotSettingsRequest
+	180, // 315: schedula.v1.AppointmentsService.UpdateDailyBreaks:input_type -> schedula.v1.UpdateDailyBreaksRequest
+	184, // 316: schedula.v1.AppointmentsService.CreateTimeOff:input_type -> schedula.v1.CreateTimeOffRequest
+	186, // 317: schedula.v1.AppointmentsService.GetTimeOff:input_type -> schedula.v1.GetTimeOffRequest
+	188, // 318: schedula.v1.AppointmentsService.UpdateTimeOff:input_type -> schedula.v1.UpdateTimeOffRequest
+	190, // 319: schedula.v1.AppointmentsService.DeleteTimeOff:input_type -> schedula.v1.DeleteTimeOffRequest
+	192, // 320: schedula.v1.AppointmentsService.ListTimeOff:input_type -> schedula.v1.ListTimeOffRequest
+	93,  // 321: schedula.v1.AppointmentsService.SimulateSchedule:input_type -> schedula.v1.SimulateScheduleRequest
+	135, // 322: schedula.v1.AppointmentsService.CreateCalendarSnapshot:input_type -> schedula.v1.CreateCalendarSnapshotRequest
+	137, // 323: schedula.v1.AppointmentsService.ListCalendarSnapshots:input_type -> schedula.v1.ListCalendarSnapshotsRequest
+	139, // 324: schedula.v1.AppointmentsService.RestoreCalendarSnapshot:input_type -> schedula.v1.RestoreCalendarSnapshotRequest
+	25,  // 325: schedula.v1.AppointmentsService.CreateAppointment:output_type -> schedula.v1.CreateAppointmentResponse
+	28,  // 326: schedula.v1.AppointmentsService.ListAppointments:output_type -> schedula.v1.ListAppointmentsResponse
+	32,  // 327: schedula.v1.AppointmentsService.DeleteAppointment:output_type -> schedula.v1.DeleteAppointmentResponse
+	35,  // 328: schedula.v1.AppointmentsService.CreateRecurringSeries:output_type -> schedula.v1.CreateRecurringSeriesResponse
+	42,  // 329: schedula.v1.AppointmentsService.ListOccurrences:output_type -> schedula.v1.ListOccurrencesResponse
+	37,  // 330: schedula.v1.AppointmentsService.GetRecurringSeries:output_type -> schedula.v1.GetRecurringSeriesResponse
+	39,  // 331: schedula.v1.AppointmentsService.ListRecurringSeries:output_type -> schedula.v1.ListRecurringSeriesResponse
+	45,  // 332: schedula.v1.AppointmentsService.MarkAttendance:output_type -> schedula.v1.MarkAttendanceResponse
+	48,  // 333: schedula.v1.AppointmentsService.GetAttendanceStats:output_type -> schedula.v1.GetAttendanceStatsResponse
+	50,  // 334: schedula.v1.AppointmentsService.GetLimits:output_type -> schedula.v1.GetLimitsResponse
+	30,  // 335: schedula.v1.AppointmentsService.GetAppointmentByExternalRef:output_type -> schedula.v1.GetAppointmentByExternalRefResponse
+	52,  // 336: schedula.v1.AppointmentsService.GetAnalytics:output_type -> schedula.v1.GetAnalyticsResponse
+	54,  // 337: schedula.v1.AppointmentsService.SuggestEndTime:output_type -> schedula.v1.SuggestEndTimeResponse
+	57,  // 338: schedula.v1.AppointmentsService.ReserveSlot:output_type -> schedula.v1.ReserveSlotResponse
+	59,  // 339: schedula.v1.AppointmentsService.ConfirmHold:output_type -> schedula.v1.ConfirmHoldResponse
+	61,  // 340: schedula.v1.AppointmentsService.ReleaseHold:output_type -> schedula.v1.ReleaseHoldResponse
+	64,  // 341: schedula.v1.AppointmentsService.ProposeAppointment:output_type -> schedula.v1.ProposeAppointmentResponse
+	66,  // 342: schedula.v1.AppointmentsService.ListProposals:output_type -> schedula.v1.ListProposalsResponse
+	68,  // 343: schedula.v1.AppointmentsService.AcceptProposal:output_type -> schedula.v1.AcceptProposalResponse
+	70,  // 344: schedula.v1.AppointmentsService.DeclineProposal:output_type -> schedula.v1.DeclineProposalResponse
+	73,  // 345: schedula.v1.AppointmentsService.LinkAppointments:output_type -> schedula.v1.LinkAppointmentsResponse
+	75,  // 346: schedula.v1.AppointmentsService.UnlinkAppointments:output_type -> schedula.v1.UnlinkAppointmentsResponse
+	78,  // 347: schedula.v1.AppointmentsService.ListRelated:output_type -> schedula.v1.ListRelatedResponse
+	80,  // 348: schedula.v1.AppointmentsService.MergeAppointments:output_type -> schedula.v1.MergeAppointmentsResponse
+	84,  // 349: schedula.v1.AppointmentsService.BatchGetFreeBusy:output_type -> schedula.v1.BatchGetFreeBusyResponse
+	90,  // 350: schedula.v1.AppointmentsService.SuggestMeetingTimes:output_type -> schedula.v1.SuggestMeetingTimesResponse
+	101, // 351: schedula.v1.AppointmentsService.RepairRecurringSeries:output_type -> schedula.v1.RepairRecurringSeriesResponse
+	117, // 352: schedula.v1.AppointmentsService.SkipOccurrences:output_type -> schedula.v1.SkipOccurrencesResponse
+	113, // 353: schedula.v1.AppointmentsService.UpdateSeriesEnd:output_type -> schedula.v1.UpdateSeriesEndResponse
+	115, // 354: schedula.v1.AppointmentsService.ChangeSeriesTimeZone:output_type -> schedula.v1.ChangeSeriesTimeZoneResponse
+	105, // 355: schedula.v1.AppointmentsService.AuditCalendar:output_type -> schedula.v1.AuditCalendarResponse
+	108, // 356: schedula.v1.AppointmentsService.GetDailyAgenda:output_type -> schedula.v1.GetDailyAgendaResponse
+	111, // 357: schedula.v1.AppointmentsService.GetDaySummaries:output_type -> schedula.v1.GetDaySummariesResponse
+	120, // 358: schedula.v1.AppointmentsService.GrantDelegation:output_type -> schedula.v1.GrantDelegationResponse
+	122, // 359: schedula.v1.AppointmentsService.RevokeDelegation:output_type -> schedula.v1.RevokeDelegationResponse
+	124, // 360: schedula.v1.AppointmentsService.ListDelegations:output_type -> schedula.v1.ListDelegationsResponse
+	131, // 361: schedula.v1.AppointmentsService.ExportCalendar:output_type -> schedula.v1.ExportCalendarResponse
+	126, // 362: schedula.v1.AppointmentsService.WatchOccurrences:output_type -> schedula.v1.WatchOccurrencesResponse
+	129, // 363: schedula.v1.AppointmentsService.ListChanges:output_type -> schedula.v1.ListChangesResponse
+	133, // 364: schedula.v1.AppointmentsService.ImportCalendar:output_type -> schedula.v1.ImportCalendarResponse
+	171, // 365: schedula.v1.AppointmentsService.ReconcileCalendar:output_type -> schedula.v1.ReconcileCalendarResponse
+	163, // 366: schedula.v1.AppointmentsService.CheckIn:output_type -> schedula.v1.CheckInResponse
+	165, // 367: schedula.v1.AppointmentsService.CheckOut:output_type -> schedula.v1.CheckOutResponse
+	167, // 368: schedula.v1.AppointmentsService.ExportBillableHours:output_type -> schedula.v1.ExportBillableHoursResponse
+	143, // 369: schedula.v1.AppointmentsService.CreateContact:output_type -> schedula.v1.CreateContactResponse
+	145, // 370: schedula.v1.AppointmentsService.GetContact:output_type -> schedula.v1.GetContactResponse
+	147, // 371: schedula.v1.AppointmentsService.UpdateContact:output_type -> schedula.v1.UpdateContactResponse
+	149, // 372: schedula.v1.AppointmentsService.DeleteContact:output_type -> schedula.v1.DeleteContactResponse
+	151, // 373: schedula.v1.AppointmentsService.ListContacts:output_type -> schedula.v1.ListContactsResponse
+	155, // 374: schedula.v1.AppointmentsService.CreateProgram:output_type -> schedula.v1.CreateProgramResponse
+	157, // 375: schedula.v1.AppointmentsService.GetProgram:output_type -> schedula.v1.GetProgramResponse
+	159, // 376: schedula.v1.AppointmentsService.ListPrograms:output_type -> schedula.v1.ListProgramsResponse
+	161, // 377: schedula.v1.AppointmentsService.CancelProgram:output_type -> schedula.v1.CancelProgramResponse
+	173, // 378: schedula.v1.AppointmentsService.CreateEmbedToken:output_type -> schedula.v1.CreateEmbedTokenResponse
+	177, // 379: schedula.v1.AppointmentsService.GetSlotSettings:output_type -> schedula.v1.GetSlotSettingsResponse
+	179, // 380: schedula.v1.AppointmentsService.UpdateSlotSettings:output_type -> schedula.v1.UpdateSlotSettingsResponse
+	181, // 381: schedula.v1.AppointmentsService.UpdateDailyBreaks:output_type -> schedula.v1.UpdateDailyBreaksResponse
+	185, // 382: schedula.v1.AppointmentsService.CreateTimeOff:output_type -> schedula.v1.CreateTimeOffResponse
+	187, // 383: schedula.v1.AppointmentsService.GetTimeOff:output_type -> schedula.v1.GetTimeOffResponse
+	189, // 384: schedula.v1.AppointmentsService.UpdateTimeOff:output_type -> schedula.v1.UpdateTimeOffResponse
+	191, // 385: schedula.v1.AppointmentsService.DeleteTimeOff:output_type -> schedula.v1.DeleteTimeOffResponse
+	193, // 386: schedula.v1.AppointmentsService.ListTimeOff:output_type -> schedula.v1.ListTimeOffResponse
+	98,  // 387: schedula.v1.AppointmentsService.SimulateSchedule:output_type -> schedula.v1.SimulateScheduleResponse
+	136, // 388: schedula.v1.AppointmentsService.CreateCalendarSnapshot:output_type -> schedula.v1.CreateCalendarSnapshotResponse
+	138, // 389: schedula.v1.AppointmentsService.ListCalendarSnapshots:output_type -> schedula.v1.ListCalendarSnapshotsResponse
+	140, // 390: schedula.v1.AppointmentsService.RestoreCalendarSnapshot:output_type -> schedula.v1.RestoreCalendarSnapshotResponse
+	325, // [325:391] is the sub-list for method output_type
+	259, // [259:325] is the sub-list for method input_type
+	259, // [259:259] is the sub-list for extension type_name
+	259, // [259:259] is the sub-list for extension extendee
+	0,   // [0:259] is the sub-list for field type_name
 }
 
 func init() { file_proto_schedula_v1_appointments_proto_init() }
@@ -1194,8 +13923,8 @@ func file_proto_schedula_v1_appointments_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_proto_schedula_v1_appointments_proto_rawDesc), len(file_proto_schedula_v1_appointments_proto_rawDesc)),
-			NumEnums:      1,
-			NumMessages:   14,
+			NumEnums:      18,
+			NumMessages:   184,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
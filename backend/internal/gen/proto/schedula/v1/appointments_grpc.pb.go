@@ -19,11 +19,72 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	AppointmentsService_CreateAppointment_FullMethodName     = "/schedula.v1.AppointmentsService/CreateAppointment"
-	AppointmentsService_ListAppointments_FullMethodName      = "/schedula.v1.AppointmentsService/ListAppointments"
-	AppointmentsService_DeleteAppointment_FullMethodName     = "/schedula.v1.AppointmentsService/DeleteAppointment"
-	AppointmentsService_CreateRecurringSeries_FullMethodName = "/schedula.v1.AppointmentsService/CreateRecurringSeries"
-	AppointmentsService_ListOccurrences_FullMethodName       = "/schedula.v1.AppointmentsService/ListOccurrences"
+	AppointmentsService_CreateAppointment_FullMethodName           = "/schedula.v1.AppointmentsService/CreateAppointment"
+	AppointmentsService_ListAppointments_FullMethodName            = "/schedula.v1.AppointmentsService/ListAppointments"
+	AppointmentsService_DeleteAppointment_FullMethodName           = "/schedula.v1.AppointmentsService/DeleteAppointment"
+	AppointmentsService_CreateRecurringSeries_FullMethodName       = "/schedula.v1.AppointmentsService/CreateRecurringSeries"
+	AppointmentsService_ListOccurrences_FullMethodName             = "/schedula.v1.AppointmentsService/ListOccurrences"
+	AppointmentsService_GetRecurringSeries_FullMethodName          = "/schedula.v1.AppointmentsService/GetRecurringSeries"
+	AppointmentsService_ListRecurringSeries_FullMethodName         = "/schedula.v1.AppointmentsService/ListRecurringSeries"
+	AppointmentsService_MarkAttendance_FullMethodName              = "/schedula.v1.AppointmentsService/MarkAttendance"
+	AppointmentsService_GetAttendanceStats_FullMethodName          = "/schedula.v1.AppointmentsService/GetAttendanceStats"
+	AppointmentsService_GetLimits_FullMethodName                   = "/schedula.v1.AppointmentsService/GetLimits"
+	AppointmentsService_GetAppointmentByExternalRef_FullMethodName = "/schedula.v1.AppointmentsService/GetAppointmentByExternalRef"
+	AppointmentsService_GetAnalytics_FullMethodName                = "/schedula.v1.AppointmentsService/GetAnalytics"
+	AppointmentsService_SuggestEndTime_FullMethodName              = "/schedula.v1.AppointmentsService/SuggestEndTime"
+	AppointmentsService_ReserveSlot_FullMethodName                 = "/schedula.v1.AppointmentsService/ReserveSlot"
+	AppointmentsService_ConfirmHold_FullMethodName                 = "/schedula.v1.AppointmentsService/ConfirmHold"
+	AppointmentsService_ReleaseHold_FullMethodName                 = "/schedula.v1.AppointmentsService/ReleaseHold"
+	AppointmentsService_ProposeAppointment_FullMethodName          = "/schedula.v1.AppointmentsService/ProposeAppointment"
+	AppointmentsService_ListProposals_FullMethodName               = "/schedula.v1.AppointmentsService/ListProposals"
+	AppointmentsService_AcceptProposal_FullMethodName              = "/schedula.v1.AppointmentsService/AcceptProposal"
+	AppointmentsService_DeclineProposal_FullMethodName             = "/schedula.v1.AppointmentsService/DeclineProposal"
+	AppointmentsService_LinkAppointments_FullMethodName            = "/schedula.v1.AppointmentsService/LinkAppointments"
+	AppointmentsService_UnlinkAppointments_FullMethodName          = "/schedula.v1.AppointmentsService/UnlinkAppointments"
+	AppointmentsService_ListRelated_FullMethodName                 = "/schedula.v1.AppointmentsService/ListRelated"
+	AppointmentsService_MergeAppointments_FullMethodName           = "/schedula.v1.AppointmentsService/MergeAppointments"
+	AppointmentsService_BatchGetFreeBusy_FullMethodName            = "/schedula.v1.AppointmentsService/BatchGetFreeBusy"
+	AppointmentsService_SuggestMeetingTimes_FullMethodName         = "/schedula.v1.AppointmentsService/SuggestMeetingTimes"
+	AppointmentsService_RepairRecurringSeries_FullMethodName       = "/schedula.v1.AppointmentsService/RepairRecurringSeries"
+	AppointmentsService_SkipOccurrences_FullMethodName             = "/schedula.v1.AppointmentsService/SkipOccurrences"
+	AppointmentsService_UpdateSeriesEnd_FullMethodName             = "/schedula.v1.AppointmentsService/UpdateSeriesEnd"
+	AppointmentsService_ChangeSeriesTimeZone_FullMethodName        = "/schedula.v1.AppointmentsService/ChangeSeriesTimeZone"
+	AppointmentsService_AuditCalendar_FullMethodName               = "/schedula.v1.AppointmentsService/AuditCalendar"
+	AppointmentsService_GetDailyAgenda_FullMethodName              = "/schedula.v1.AppointmentsService/GetDailyAgenda"
+	AppointmentsService_GetDaySummaries_FullMethodName             = "/schedula.v1.AppointmentsService/GetDaySummaries"
+	AppointmentsService_GrantDelegation_FullMethodName             = "/schedula.v1.AppointmentsService/GrantDelegation"
+	AppointmentsService_RevokeDelegation_FullMethodName            = "/schedula.v1.AppointmentsService/RevokeDelegation"
+	AppointmentsService_ListDelegations_FullMethodName             = "/schedula.v1.AppointmentsService/ListDelegations"
+	AppointmentsService_ExportCalendar_FullMethodName              = "/schedula.v1.AppointmentsService/ExportCalendar"
+	AppointmentsService_WatchOccurrences_FullMethodName            = "/schedula.v1.AppointmentsService/WatchOccurrences"
+	AppointmentsService_ListChanges_FullMethodName                 = "/schedula.v1.AppointmentsService/ListChanges"
+	AppointmentsService_ImportCalendar_FullMethodName              = "/schedula.v1.AppointmentsService/ImportCalendar"
+	AppointmentsService_ReconcileCalendar_FullMethodName           = "/schedula.v1.AppointmentsService/ReconcileCalendar"
+	AppointmentsService_CheckIn_FullMethodName                     = "/schedula.v1.AppointmentsService/CheckIn"
+	AppointmentsService_CheckOut_FullMethodName                    = "/schedula.v1.AppointmentsService/CheckOut"
+	AppointmentsService_ExportBillableHours_FullMethodName         = "/schedula.v1.AppointmentsService/ExportBillableHours"
+	AppointmentsService_CreateContact_FullMethodName               = "/schedula.v1.AppointmentsService/CreateContact"
+	AppointmentsService_GetContact_FullMethodName                  = "/schedula.v1.AppointmentsService/GetContact"
+	AppointmentsService_UpdateContact_FullMethodName               = "/schedula.v1.AppointmentsService/UpdateContact"
+	AppointmentsService_DeleteContact_FullMethodName               = "/schedula.v1.AppointmentsService/DeleteContact"
+	AppointmentsService_ListContacts_FullMethodName                = "/schedula.v1.AppointmentsService/ListContacts"
+	AppointmentsService_CreateProgram_FullMethodName               = "/schedula.v1.AppointmentsService/CreateProgram"
+	AppointmentsService_GetProgram_FullMethodName                  = "/schedula.v1.AppointmentsService/GetProgram"
+	AppointmentsService_ListPrograms_FullMethodName                = "/schedula.v1.AppointmentsService/ListPrograms"
+	AppointmentsService_CancelProgram_FullMethodName               = "/schedula.v1.AppointmentsService/CancelProgram"
+	AppointmentsService_CreateEmbedToken_FullMethodName            = "/schedula.v1.AppointmentsService/CreateEmbedToken"
+	AppointmentsService_GetSlotSettings_FullMethodName             = "/schedula.v1.AppointmentsService/GetSlotSettings"
+	AppointmentsService_UpdateSlotSettings_FullMethodName          = "/schedula.v1.AppointmentsService/UpdateSlotSettings"
+	AppointmentsService_UpdateDailyBreaks_FullMethodName           = "/schedula.v1.AppointmentsService/UpdateDailyBreaks"
+	AppointmentsService_CreateTimeOff_FullMethodName               = "/schedula.v1.AppointmentsService/CreateTimeOff"
+	AppointmentsService_GetTimeOff_FullMethodName                  = "/schedula.v1.AppointmentsService/GetTimeOff"
+	AppointmentsService_UpdateTimeOff_FullMethodName               = "/schedula.v1.AppointmentsService/UpdateTimeOff"
+	AppointmentsService_DeleteTimeOff_FullMethodName               = "/schedula.v1.AppointmentsService/DeleteTimeOff"
+	AppointmentsService_ListTimeOff_FullMethodName                 = "/schedula.v1.AppointmentsService/ListTimeOff"
+	AppointmentsService_SimulateSchedule_FullMethodName            = "/schedula.v1.AppointmentsService/SimulateSchedule"
+	AppointmentsService_CreateCalendarSnapshot_FullMethodName      = "/schedula.v1.AppointmentsService/CreateCalendarSnapshot"
+	AppointmentsService_ListCalendarSnapshots_FullMethodName       = "/schedula.v1.AppointmentsService/ListCalendarSnapshots"
+	AppointmentsService_RestoreCalendarSnapshot_FullMethodName     = "/schedula.v1.AppointmentsService/RestoreCalendarSnapshot"
 )
 
 // AppointmentsServiceClient is the client API for AppointmentsService service.
@@ -35,6 +96,67 @@ type AppointmentsServiceClient interface {
 	DeleteAppointment(ctx context.Context, in *DeleteAppointmentRequest, opts ...grpc.CallOption) (*DeleteAppointmentResponse, error)
 	CreateRecurringSeries(ctx context.Context, in *CreateRecurringSeriesRequest, opts ...grpc.CallOption) (*CreateRecurringSeriesResponse, error)
 	ListOccurrences(ctx context.Context, in *ListOccurrencesRequest, opts ...grpc.CallOption) (*ListOccurrencesResponse, error)
+	GetRecurringSeries(ctx context.Context, in *GetRecurringSeriesRequest, opts ...grpc.CallOption) (*GetRecurringSeriesResponse, error)
+	ListRecurringSeries(ctx context.Context, in *ListRecurringSeriesRequest, opts ...grpc.CallOption) (*ListRecurringSeriesResponse, error)
+	MarkAttendance(ctx context.Context, in *MarkAttendanceRequest, opts ...grpc.CallOption) (*MarkAttendanceResponse, error)
+	GetAttendanceStats(ctx context.Context, in *GetAttendanceStatsRequest, opts ...grpc.CallOption) (*GetAttendanceStatsResponse, error)
+	GetLimits(ctx context.Context, in *GetLimitsRequest, opts ...grpc.CallOption) (*GetLimitsResponse, error)
+	GetAppointmentByExternalRef(ctx context.Context, in *GetAppointmentByExternalRefRequest, opts ...grpc.CallOption) (*GetAppointmentByExternalRefResponse, error)
+	GetAnalytics(ctx context.Context, in *GetAnalyticsRequest, opts ...grpc.CallOption) (*GetAnalyticsResponse, error)
+	SuggestEndTime(ctx context.Context, in *SuggestEndTimeRequest, opts ...grpc.CallOption) (*SuggestEndTimeResponse, error)
+	ReserveSlot(ctx context.Context, in *ReserveSlotRequest, opts ...grpc.CallOption) (*ReserveSlotResponse, error)
+	ConfirmHold(ctx context.Context, in *ConfirmHoldRequest, opts ...grpc.CallOption) (*ConfirmHoldResponse, error)
+	ReleaseHold(ctx context.Context, in *ReleaseHoldRequest, opts ...grpc.CallOption) (*ReleaseHoldResponse, error)
+	ProposeAppointment(ctx context.Context, in *ProposeAppointmentRequest, opts ...grpc.CallOption) (*ProposeAppointmentResponse, error)
+	ListProposals(ctx context.Context, in *ListProposalsRequest, opts ...grpc.CallOption) (*ListProposalsResponse, error)
+	AcceptProposal(ctx context.Context, in *AcceptProposalRequest, opts ...grpc.CallOption) (*AcceptProposalResponse, error)
+	DeclineProposal(ctx context.Context, in *DeclineProposalRequest, opts ...grpc.CallOption) (*DeclineProposalResponse, error)
+	LinkAppointments(ctx context.Context, in *LinkAppointmentsRequest, opts ...grpc.CallOption) (*LinkAppointmentsResponse, error)
+	UnlinkAppointments(ctx context.Context, in *UnlinkAppointmentsRequest, opts ...grpc.CallOption) (*UnlinkAppointmentsResponse, error)
+	ListRelated(ctx context.Context, in *ListRelatedRequest, opts ...grpc.CallOption) (*ListRelatedResponse, error)
+	MergeAppointments(ctx context.Context, in *MergeAppointmentsRequest, opts ...grpc.CallOption) (*MergeAppointmentsResponse, error)
+	BatchGetFreeBusy(ctx context.Context, in *BatchGetFreeBusyRequest, opts ...grpc.CallOption) (*BatchGetFreeBusyResponse, error)
+	SuggestMeetingTimes(ctx context.Context, in *SuggestMeetingTimesRequest, opts ...grpc.CallOption) (*SuggestMeetingTimesResponse, error)
+	RepairRecurringSeries(ctx context.Context, in *RepairRecurringSeriesRequest, opts ...grpc.CallOption) (*RepairRecurringSeriesResponse, error)
+	SkipOccurrences(ctx context.Context, in *SkipOccurrencesRequest, opts ...grpc.CallOption) (*SkipOccurrencesResponse, error)
+	UpdateSeriesEnd(ctx context.Context, in *UpdateSeriesEndRequest, opts ...grpc.CallOption) (*UpdateSeriesEndResponse, error)
+	ChangeSeriesTimeZone(ctx context.Context, in *ChangeSeriesTimeZoneRequest, opts ...grpc.CallOption) (*ChangeSeriesTimeZoneResponse, error)
+	AuditCalendar(ctx context.Context, in *AuditCalendarRequest, opts ...grpc.CallOption) (*AuditCalendarResponse, error)
+	GetDailyAgenda(ctx context.Context, in *GetDailyAgendaRequest, opts ...grpc.CallOption) (*GetDailyAgendaResponse, error)
+	GetDaySummaries(ctx context.Context, in *GetDaySummariesRequest, opts ...grpc.CallOption) (*GetDaySummariesResponse, error)
+	GrantDelegation(ctx context.Context, in *GrantDelegationRequest, opts ...grpc.CallOption) (*GrantDelegationResponse, error)
+	RevokeDelegation(ctx context.Context, in *RevokeDelegationRequest, opts ...grpc.CallOption) (*RevokeDelegationResponse, error)
+	ListDelegations(ctx context.Context, in *ListDelegationsRequest, opts ...grpc.CallOption) (*ListDelegationsResponse, error)
+	ExportCalendar(ctx context.Context, in *ExportCalendarRequest, opts ...grpc.CallOption) (*ExportCalendarResponse, error)
+	WatchOccurrences(ctx context.Context, in *WatchOccurrencesRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[WatchOccurrencesResponse], error)
+	ListChanges(ctx context.Context, in *ListChangesRequest, opts ...grpc.CallOption) (*ListChangesResponse, error)
+	ImportCalendar(ctx context.Context, in *ImportCalendarRequest, opts ...grpc.CallOption) (*ImportCalendarResponse, error)
+	ReconcileCalendar(ctx context.Context, in *ReconcileCalendarRequest, opts ...grpc.CallOption) (*ReconcileCalendarResponse, error)
+	CheckIn(ctx context.Context, in *CheckInRequest, opts ...grpc.CallOption) (*CheckInResponse, error)
+	CheckOut(ctx context.Context, in *CheckOutRequest, opts ...grpc.CallOption) (*CheckOutResponse, error)
+	ExportBillableHours(ctx context.Context, in *ExportBillableHoursRequest, opts ...grpc.CallOption) (*ExportBillableHoursResponse, error)
+	CreateContact(ctx context.Context, in *CreateContactRequest, opts ...grpc.CallOption) (*CreateContactResponse, error)
+	GetContact(ctx context.Context, in *GetContactRequest, opts ...grpc.CallOption) (*GetContactResponse, error)
+	UpdateContact(ctx context.Context, in *UpdateContactRequest, opts ...grpc.CallOption) (*UpdateContactResponse, error)
+	DeleteContact(ctx context.Context, in *DeleteContactRequest, opts ...grpc.CallOption) (*DeleteContactResponse, error)
+	ListContacts(ctx context.Context, in *ListContactsRequest, opts ...grpc.CallOption) (*ListContactsResponse, error)
+	CreateProgram(ctx context.Context, in *CreateProgramRequest, opts ...grpc.CallOption) (*CreateProgramResponse, error)
+	GetProgram(ctx context.Context, in *GetProgramRequest, opts ...grpc.CallOption) (*GetProgramResponse, error)
+	ListPrograms(ctx context.Context, in *ListProgramsRequest, opts ...grpc.CallOption) (*ListProgramsResponse, error)
+	CancelProgram(ctx context.Context, in *CancelProgramRequest, opts ...grpc.CallOption) (*CancelProgramResponse, error)
+	CreateEmbedToken(ctx context.Context, in *CreateEmbedTokenRequest, opts ...grpc.CallOption) (*CreateEmbedTokenResponse, error)
+	GetSlotSettings(ctx context.Context, in *GetSlotSettingsRequest, opts ...grpc.CallOption) (*GetSlotSettingsResponse, error)
+	UpdateSlotSettings(ctx context.Context, in *UpdateSlotSettingsRequest, opts ...grpc.CallOption) (*UpdateSlotSettingsResponse, error)
+	UpdateDailyBreaks(ctx context.Context, in *UpdateDailyBreaksRequest, opts ...grpc.CallOption) (*UpdateDailyBreaksResponse, error)
+	CreateTimeOff(ctx context.Context, in *CreateTimeOffRequest, opts ...grpc.CallOption) (*CreateTimeOffResponse, error)
+	GetTimeOff(ctx context.Context, in *GetTimeOffRequest, opts ...grpc.CallOption) (*GetTimeOffResponse, error)
+	UpdateTimeOff(ctx context.Context, in *UpdateTimeOffRequest, opts ...grpc.CallOption) (*UpdateTimeOffResponse, error)
+	DeleteTimeOff(ctx context.Context, in *DeleteTimeOffRequest, opts ...grpc.CallOption) (*DeleteTimeOffResponse, error)
+	ListTimeOff(ctx context.Context, in *ListTimeOffRequest, opts ...grpc.CallOption) (*ListTimeOffResponse, error)
+	SimulateSchedule(ctx context.Context, in *SimulateScheduleRequest, opts ...grpc.CallOption) (*SimulateScheduleResponse, error)
+	CreateCalendarSnapshot(ctx context.Context, in *CreateCalendarSnapshotRequest, opts ...grpc.CallOption) (*CreateCalendarSnapshotResponse, error)
+	ListCalendarSnapshots(ctx context.Context, in *ListCalendarSnapshotsRequest, opts ...grpc.CallOption) (*ListCalendarSnapshotsResponse, error)
+	RestoreCalendarSnapshot(ctx context.Context, in *RestoreCalendarSnapshotRequest, opts ...grpc.CallOption) (*RestoreCalendarSnapshotResponse, error)
 }
 
 type appointmentsServiceClient struct {
@@ -95,6 +217,625 @@ func (c *appointmentsServiceClient) ListOccurrences(ctx context.Context, in *Lis
 	return out, nil
 }
 
+func (c *appointmentsServiceClient) GetRecurringSeries(ctx context.Context, in *GetRecurringSeriesRequest, opts ...grpc.CallOption) (*GetRecurringSeriesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetRecurringSeriesResponse)
+	err := c.cc.Invoke(ctx, AppointmentsService_GetRecurringSeries_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *appointmentsServiceClient) ListRecurringSeries(ctx context.Context, in *ListRecurringSeriesRequest, opts ...grpc.CallOption) (*ListRecurringSeriesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListRecurringSeriesResponse)
+	err := c.cc.Invoke(ctx, AppointmentsService_ListRecurringSeries_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *appointmentsServiceClient) MarkAttendance(ctx context.Context, in *MarkAttendanceRequest, opts ...grpc.CallOption) (*MarkAttendanceResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MarkAttendanceResponse)
+	err := c.cc.Invoke(ctx, AppointmentsService_MarkAttendance_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *appointmentsServiceClient) GetAttendanceStats(ctx context.Context, in *GetAttendanceStatsRequest, opts ...grpc.CallOption) (*GetAttendanceStatsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetAttendanceStatsResponse)
+	err := c.cc.Invoke(ctx, AppointmentsService_GetAttendanceStats_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *appointmentsServiceClient) GetLimits(ctx context.Context, in *GetLimitsRequest, opts ...grpc.CallOption) (*GetLimitsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetLimitsResponse)
+	err := c.cc.Invoke(ctx, AppointmentsService_GetLimits_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *appointmentsServiceClient) GetAppointmentByExternalRef(ctx context.Context, in *GetAppointmentByExternalRefRequest, opts ...grpc.CallOption) (*GetAppointmentByExternalRefResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetAppointmentByExternalRefResponse)
+	err := c.cc.Invoke(ctx, AppointmentsService_GetAppointmentByExternalRef_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *appointmentsServiceClient) GetAnalytics(ctx context.Context, in *GetAnalyticsRequest, opts ...grpc.CallOption) (*GetAnalyticsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetAnalyticsResponse)
+	err := c.cc.Invoke(ctx, AppointmentsService_GetAnalytics_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *appointmentsServiceClient) SuggestEndTime(ctx context.Context, in *SuggestEndTimeRequest, opts ...grpc.CallOption) (*SuggestEndTimeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SuggestEndTimeResponse)
+	err := c.cc.Invoke(ctx, AppointmentsService_SuggestEndTime_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *appointmentsServiceClient) ReserveSlot(ctx context.Context, in *ReserveSlotRequest, opts ...grpc.CallOption) (*ReserveSlotResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReserveSlotResponse)
+	err := c.cc.Invoke(ctx, AppointmentsService_ReserveSlot_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *appointmentsServiceClient) ConfirmHold(ctx context.Context, in *ConfirmHoldRequest, opts ...grpc.CallOption) (*ConfirmHoldResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ConfirmHoldResponse)
+	err := c.cc.Invoke(ctx, AppointmentsService_ConfirmHold_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *appointmentsServiceClient) ReleaseHold(ctx context.Context, in *ReleaseHoldRequest, opts ...grpc.CallOption) (*ReleaseHoldResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReleaseHoldResponse)
+	err := c.cc.Invoke(ctx, AppointmentsService_ReleaseHold_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *appointmentsServiceClient) ProposeAppointment(ctx context.Context, in *ProposeAppointmentRequest, opts ...grpc.CallOption) (*ProposeAppointmentResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ProposeAppointmentResponse)
+	err := c.cc.Invoke(ctx, AppointmentsService_ProposeAppointment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *appointmentsServiceClient) ListProposals(ctx context.Context, in *ListProposalsRequest, opts ...grpc.CallOption) (*ListProposalsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListProposalsResponse)
+	err := c.cc.Invoke(ctx, AppointmentsService_ListProposals_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *appointmentsServiceClient) AcceptProposal(ctx context.Context, in *AcceptProposalRequest, opts ...grpc.CallOption) (*AcceptProposalResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AcceptProposalResponse)
+	err := c.cc.Invoke(ctx, AppointmentsService_AcceptProposal_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *appointmentsServiceClient) DeclineProposal(ctx context.Context, in *DeclineProposalRequest, opts ...grpc.CallOption) (*DeclineProposalResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeclineProposalResponse)
+	err := c.cc.Invoke(ctx, AppointmentsService_DeclineProposal_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *appointmentsServiceClient) LinkAppointments(ctx context.Context, in *LinkAppointmentsRequest, opts ...grpc.CallOption) (*LinkAppointmentsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(LinkAppointmentsResponse)
+	err := c.cc.Invoke(ctx, AppointmentsService_LinkAppointments_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *appointmentsServiceClient) UnlinkAppointments(ctx context.Context, in *UnlinkAppointmentsRequest, opts ...grpc.CallOption) (*UnlinkAppointmentsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UnlinkAppointmentsResponse)
+	err := c.cc.Invoke(ctx, AppointmentsService_UnlinkAppointments_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *appointmentsServiceClient) ListRelated(ctx context.Context, in *ListRelatedRequest, opts ...grpc.CallOption) (*ListRelatedResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListRelatedResponse)
+	err := c.cc.Invoke(ctx, AppointmentsService_ListRelated_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *appointmentsServiceClient) MergeAppointments(ctx context.Context, in *MergeAppointmentsRequest, opts ...grpc.CallOption) (*MergeAppointmentsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MergeAppointmentsResponse)
+	err := c.cc.Invoke(ctx, AppointmentsService_MergeAppointments_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *appointmentsServiceClient) BatchGetFreeBusy(ctx context.Context, in *BatchGetFreeBusyRequest, opts ...grpc.CallOption) (*BatchGetFreeBusyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BatchGetFreeBusyResponse)
+	err := c.cc.Invoke(ctx, AppointmentsService_BatchGetFreeBusy_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *appointmentsServiceClient) SuggestMeetingTimes(ctx context.Context, in *SuggestMeetingTimesRequest, opts ...grpc.CallOption) (*SuggestMeetingTimesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SuggestMeetingTimesResponse)
+	err := c.cc.Invoke(ctx, AppointmentsService_SuggestMeetingTimes_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *appointmentsServiceClient) RepairRecurringSeries(ctx context.Context, in *RepairRecurringSeriesRequest, opts ...grpc.CallOption) (*RepairRecurringSeriesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RepairRecurringSeriesResponse)
+	err := c.cc.Invoke(ctx, AppointmentsService_RepairRecurringSeries_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *appointmentsServiceClient) SkipOccurrences(ctx context.Context, in *SkipOccurrencesRequest, opts ...grpc.CallOption) (*SkipOccurrencesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SkipOccurrencesResponse)
+	err := c.cc.Invoke(ctx, AppointmentsService_SkipOccurrences_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *appointmentsServiceClient) UpdateSeriesEnd(ctx context.Context, in *UpdateSeriesEndRequest, opts ...grpc.CallOption) (*UpdateSeriesEndResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateSeriesEndResponse)
+	err := c.cc.Invoke(ctx, AppointmentsService_UpdateSeriesEnd_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *appointmentsServiceClient) ChangeSeriesTimeZone(ctx context.Context, in *ChangeSeriesTimeZoneRequest, opts ...grpc.CallOption) (*ChangeSeriesTimeZoneResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ChangeSeriesTimeZoneResponse)
+	err := c.cc.Invoke(ctx, AppointmentsService_ChangeSeriesTimeZone_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *appointmentsServiceClient) AuditCalendar(ctx context.Context, in *AuditCalendarRequest, opts ...grpc.CallOption) (*AuditCalendarResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AuditCalendarResponse)
+	err := c.cc.Invoke(ctx, AppointmentsService_AuditCalendar_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *appointmentsServiceClient) GetDailyAgenda(ctx context.Context, in *GetDailyAgendaRequest, opts ...grpc.CallOption) (*GetDailyAgendaResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetDailyAgendaResponse)
+	err := c.cc.Invoke(ctx, AppointmentsService_GetDailyAgenda_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *appointmentsServiceClient) GetDaySummaries(ctx context.Context, in *GetDaySummariesRequest, opts ...grpc.CallOption) (*GetDaySummariesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetDaySummariesResponse)
+	err := c.cc.Invoke(ctx, AppointmentsService_GetDaySummaries_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *appointmentsServiceClient) GrantDelegation(ctx context.Context, in *GrantDelegationRequest, opts ...grpc.CallOption) (*GrantDelegationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GrantDelegationResponse)
+	err := c.cc.Invoke(ctx, AppointmentsService_GrantDelegation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *appointmentsServiceClient) RevokeDelegation(ctx context.Context, in *RevokeDelegationRequest, opts ...grpc.CallOption) (*RevokeDelegationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RevokeDelegationResponse)
+	err := c.cc.Invoke(ctx, AppointmentsService_RevokeDelegation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *appointmentsServiceClient) ListDelegations(ctx context.Context, in *ListDelegationsRequest, opts ...grpc.CallOption) (*ListDelegationsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListDelegationsResponse)
+	err := c.cc.Invoke(ctx, AppointmentsService_ListDelegations_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *appointmentsServiceClient) ExportCalendar(ctx context.Context, in *ExportCalendarRequest, opts ...grpc.CallOption) (*ExportCalendarResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ExportCalendarResponse)
+	err := c.cc.Invoke(ctx, AppointmentsService_ExportCalendar_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *appointmentsServiceClient) WatchOccurrences(ctx context.Context, in *WatchOccurrencesRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[WatchOccurrencesResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &AppointmentsService_ServiceDesc.Streams[0], AppointmentsService_WatchOccurrences_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[WatchOccurrencesRequest, WatchOccurrencesResponse]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type AppointmentsService_WatchOccurrencesClient = grpc.ServerStreamingClient[WatchOccurrencesResponse]
+
+func (c *appointmentsServiceClient) ListChanges(ctx context.Context, in *ListChangesRequest, opts ...grpc.CallOption) (*ListChangesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListChangesResponse)
+	err := c.cc.Invoke(ctx, AppointmentsService_ListChanges_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *appointmentsServiceClient) ImportCalendar(ctx context.Context, in *ImportCalendarRequest, opts ...grpc.CallOption) (*ImportCalendarResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ImportCalendarResponse)
+	err := c.cc.Invoke(ctx, AppointmentsService_ImportCalendar_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *appointmentsServiceClient) ReconcileCalendar(ctx context.Context, in *ReconcileCalendarRequest, opts ...grpc.CallOption) (*ReconcileCalendarResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReconcileCalendarResponse)
+	err := c.cc.Invoke(ctx, AppointmentsService_ReconcileCalendar_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *appointmentsServiceClient) CheckIn(ctx context.Context, in *CheckInRequest, opts ...grpc.CallOption) (*CheckInResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CheckInResponse)
+	err := c.cc.Invoke(ctx, AppointmentsService_CheckIn_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *appointmentsServiceClient) CheckOut(ctx context.Context, in *CheckOutRequest, opts ...grpc.CallOption) (*CheckOutResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CheckOutResponse)
+	err := c.cc.Invoke(ctx, AppointmentsService_CheckOut_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *appointmentsServiceClient) ExportBillableHours(ctx context.Context, in *ExportBillableHoursRequest, opts ...grpc.CallOption) (*ExportBillableHoursResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ExportBillableHoursResponse)
+	err := c.cc.Invoke(ctx, AppointmentsService_ExportBillableHours_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *appointmentsServiceClient) CreateContact(ctx context.Context, in *CreateContactRequest, opts ...grpc.CallOption) (*CreateContactResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateContactResponse)
+	err := c.cc.Invoke(ctx, AppointmentsService_CreateContact_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *appointmentsServiceClient) GetContact(ctx context.Context, in *GetContactRequest, opts ...grpc.CallOption) (*GetContactResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetContactResponse)
+	err := c.cc.Invoke(ctx, AppointmentsService_GetContact_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *appointmentsServiceClient) UpdateContact(ctx context.Context, in *UpdateContactRequest, opts ...grpc.CallOption) (*UpdateContactResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateContactResponse)
+	err := c.cc.Invoke(ctx, AppointmentsService_UpdateContact_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *appointmentsServiceClient) DeleteContact(ctx context.Context, in *DeleteContactRequest, opts ...grpc.CallOption) (*DeleteContactResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteContactResponse)
+	err := c.cc.Invoke(ctx, AppointmentsService_DeleteContact_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *appointmentsServiceClient) ListContacts(ctx context.Context, in *ListContactsRequest, opts ...grpc.CallOption) (*ListContactsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListContactsResponse)
+	err := c.cc.Invoke(ctx, AppointmentsService_ListContacts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *appointmentsServiceClient) CreateProgram(ctx context.Context, in *CreateProgramRequest, opts ...grpc.CallOption) (*CreateProgramResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateProgramResponse)
+	err := c.cc.Invoke(ctx, AppointmentsService_CreateProgram_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *appointmentsServiceClient) GetProgram(ctx context.Context, in *GetProgramRequest, opts ...grpc.CallOption) (*GetProgramResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetProgramResponse)
+	err := c.cc.Invoke(ctx, AppointmentsService_GetProgram_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *appointmentsServiceClient) ListPrograms(ctx context.Context, in *ListProgramsRequest, opts ...grpc.CallOption) (*ListProgramsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListProgramsResponse)
+	err := c.cc.Invoke(ctx, AppointmentsService_ListPrograms_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *appointmentsServiceClient) CancelProgram(ctx context.Context, in *CancelProgramRequest, opts ...grpc.CallOption) (*CancelProgramResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CancelProgramResponse)
+	err := c.cc.Invoke(ctx, AppointmentsService_CancelProgram_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *appointmentsServiceClient) CreateEmbedToken(ctx context.Context, in *CreateEmbedTokenRequest, opts ...grpc.CallOption) (*CreateEmbedTokenResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateEmbedTokenResponse)
+	err := c.cc.Invoke(ctx, AppointmentsService_CreateEmbedToken_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *appointmentsServiceClient) GetSlotSettings(ctx context.Context, in *GetSlotSettingsRequest, opts ...grpc.CallOption) (*GetSlotSettingsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetSlotSettingsResponse)
+	err := c.cc.Invoke(ctx, AppointmentsService_GetSlotSettings_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *appointmentsServiceClient) UpdateSlotSettings(ctx context.Context, in *UpdateSlotSettingsRequest, opts ...grpc.CallOption) (*UpdateSlotSettingsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateSlotSettingsResponse)
+	err := c.cc.Invoke(ctx, AppointmentsService_UpdateSlotSettings_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *appointmentsServiceClient) UpdateDailyBreaks(ctx context.Context, in *UpdateDailyBreaksRequest, opts ...grpc.CallOption) (*UpdateDailyBreaksResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateDailyBreaksResponse)
+	err := c.cc.Invoke(ctx, AppointmentsService_UpdateDailyBreaks_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *appointmentsServiceClient) CreateTimeOff(ctx context.Context, in *CreateTimeOffRequest, opts ...grpc.CallOption) (*CreateTimeOffResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateTimeOffResponse)
+	err := c.cc.Invoke(ctx, AppointmentsService_CreateTimeOff_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *appointmentsServiceClient) GetTimeOff(ctx context.Context, in *GetTimeOffRequest, opts ...grpc.CallOption) (*GetTimeOffResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetTimeOffResponse)
+	err := c.cc.Invoke(ctx, AppointmentsService_GetTimeOff_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *appointmentsServiceClient) UpdateTimeOff(ctx context.Context, in *UpdateTimeOffRequest, opts ...grpc.CallOption) (*UpdateTimeOffResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateTimeOffResponse)
+	err := c.cc.Invoke(ctx, AppointmentsService_UpdateTimeOff_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *appointmentsServiceClient) DeleteTimeOff(ctx context.Context, in *DeleteTimeOffRequest, opts ...grpc.CallOption) (*DeleteTimeOffResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteTimeOffResponse)
+	err := c.cc.Invoke(ctx, AppointmentsService_DeleteTimeOff_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *appointmentsServiceClient) ListTimeOff(ctx context.Context, in *ListTimeOffRequest, opts ...grpc.CallOption) (*ListTimeOffResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListTimeOffResponse)
+	err := c.cc.Invoke(ctx, AppointmentsService_ListTimeOff_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *appointmentsServiceClient) SimulateSchedule(ctx context.Context, in *SimulateScheduleRequest, opts ...grpc.CallOption) (*SimulateScheduleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SimulateScheduleResponse)
+	err := c.cc.Invoke(ctx, AppointmentsService_SimulateSchedule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *appointmentsServiceClient) CreateCalendarSnapshot(ctx context.Context, in *CreateCalendarSnapshotRequest, opts ...grpc.CallOption) (*CreateCalendarSnapshotResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateCalendarSnapshotResponse)
+	err := c.cc.Invoke(ctx, AppointmentsService_CreateCalendarSnapshot_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *appointmentsServiceClient) ListCalendarSnapshots(ctx context.Context, in *ListCalendarSnapshotsRequest, opts ...grpc.CallOption) (*ListCalendarSnapshotsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListCalendarSnapshotsResponse)
+	err := c.cc.Invoke(ctx, AppointmentsService_ListCalendarSnapshots_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *appointmentsServiceClient) RestoreCalendarSnapshot(ctx context.Context, in *RestoreCalendarSnapshotRequest, opts ...grpc.CallOption) (*RestoreCalendarSnapshotResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RestoreCalendarSnapshotResponse)
+	err := c.cc.Invoke(ctx, AppointmentsService_RestoreCalendarSnapshot_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // AppointmentsServiceServer is the server API for AppointmentsService service.
 // All implementations must embed UnimplementedAppointmentsServiceServer
 // for forward compatibility.
@@ -104,138 +845,1473 @@ type AppointmentsServiceServer interface {
 	DeleteAppointment(context.Context, *DeleteAppointmentRequest) (*DeleteAppointmentResponse, error)
 	CreateRecurringSeries(context.Context, *CreateRecurringSeriesRequest) (*CreateRecurringSeriesResponse, error)
 	ListOccurrences(context.Context, *ListOccurrencesRequest) (*ListOccurrencesResponse, error)
+	GetRecurringSeries(context.Context, *GetRecurringSeriesRequest) (*GetRecurringSeriesResponse, error)
+	ListRecurringSeries(context.Context, *ListRecurringSeriesRequest) (*ListRecurringSeriesResponse, error)
+	MarkAttendance(context.Context, *MarkAttendanceRequest) (*MarkAttendanceResponse, error)
+	GetAttendanceStats(context.Context, *GetAttendanceStatsRequest) (*GetAttendanceStatsResponse, error)
+	GetLimits(context.Context, *GetLimitsRequest) (*GetLimitsResponse, error)
+	GetAppointmentByExternalRef(context.Context, *GetAppointmentByExternalRefRequest) (*GetAppointmentByExternalRefResponse, error)
+	GetAnalytics(context.Context, *GetAnalyticsRequest) (*GetAnalyticsResponse, error)
+	SuggestEndTime(context.Context, *SuggestEndTimeRequest) (*SuggestEndTimeResponse, error)
+	ReserveSlot(context.Context, *ReserveSlotRequest) (*ReserveSlotResponse, error)
+	ConfirmHold(context.Context, *ConfirmHoldRequest) (*ConfirmHoldResponse, error)
+	ReleaseHold(context.Context, *ReleaseHoldRequest) (*ReleaseHoldResponse, error)
+	ProposeAppointment(context.Context, *ProposeAppointmentRequest) (*ProposeAppointmentResponse, error)
+	ListProposals(context.Context, *ListProposalsRequest) (*ListProposalsResponse, error)
+	AcceptProposal(context.Context, *AcceptProposalRequest) (*AcceptProposalResponse, error)
+	DeclineProposal(context.Context, *DeclineProposalRequest) (*DeclineProposalResponse, error)
+	LinkAppointments(context.Context, *LinkAppointmentsRequest) (*LinkAppointmentsResponse, error)
+	UnlinkAppointments(context.Context, *UnlinkAppointmentsRequest) (*UnlinkAppointmentsResponse, error)
+	ListRelated(context.Context, *ListRelatedRequest) (*ListRelatedResponse, error)
+	MergeAppointments(context.Context, *MergeAppointmentsRequest) (*MergeAppointmentsResponse, error)
+	BatchGetFreeBusy(context.Context, *BatchGetFreeBusyRequest) (*BatchGetFreeBusyResponse, error)
+	SuggestMeetingTimes(context.Context, *SuggestMeetingTimesRequest) (*SuggestMeetingTimesResponse, error)
+	RepairRecurringSeries(context.Context, *RepairRecurringSeriesRequest) (*RepairRecurringSeriesResponse, error)
+	SkipOccurrences(context.Context, *SkipOccurrencesRequest) (*SkipOccurrencesResponse, error)
+	UpdateSeriesEnd(context.Context, *UpdateSeriesEndRequest) (*UpdateSeriesEndResponse, error)
+	ChangeSeriesTimeZone(context.Context, *ChangeSeriesTimeZoneRequest) (*ChangeSeriesTimeZoneResponse, error)
+	AuditCalendar(context.Context, *AuditCalendarRequest) (*AuditCalendarResponse, error)
+	GetDailyAgenda(context.Context, *GetDailyAgendaRequest) (*GetDailyAgendaResponse, error)
+	GetDaySummaries(context.Context, *GetDaySummariesRequest) (*GetDaySummariesResponse, error)
+	GrantDelegation(context.Context, *GrantDelegationRequest) (*GrantDelegationResponse, error)
+	RevokeDelegation(context.Context, *RevokeDelegationRequest) (*RevokeDelegationResponse, error)
+	ListDelegations(context.Context, *ListDelegationsRequest) (*ListDelegationsResponse, error)
+	ExportCalendar(context.Context, *ExportCalendarRequest) (*ExportCalendarResponse, error)
+	WatchOccurrences(*WatchOccurrencesRequest, grpc.ServerStreamingServer[WatchOccurrencesResponse]) error
+	ListChanges(context.Context, *ListChangesRequest) (*ListChangesResponse, error)
+	ImportCalendar(context.Context, *ImportCalendarRequest) (*ImportCalendarResponse, error)
+	ReconcileCalendar(context.Context, *ReconcileCalendarRequest) (*ReconcileCalendarResponse, error)
+	CheckIn(context.Context, *CheckInRequest) (*CheckInResponse, error)
+	CheckOut(context.Context, *CheckOutRequest) (*CheckOutResponse, error)
+	ExportBillableHours(context.Context, *ExportBillableHoursRequest) (*ExportBillableHoursResponse, error)
+	CreateContact(context.Context, *CreateContactRequest) (*CreateContactResponse, error)
+	GetContact(context.Context, *GetContactRequest) (*GetContactResponse, error)
+	UpdateContact(context.Context, *UpdateContactRequest) (*UpdateContactResponse, error)
+	DeleteContact(context.Context, *DeleteContactRequest) (*DeleteContactResponse, error)
+	ListContacts(context.Context, *ListContactsRequest) (*ListContactsResponse, error)
+	CreateProgram(context.Context, *CreateProgramRequest) (*CreateProgramResponse, error)
+	GetProgram(context.Context, *GetProgramRequest) (*GetProgramResponse, error)
+	ListPrograms(context.Context, *ListProgramsRequest) (*ListProgramsResponse, error)
+	CancelProgram(context.Context, *CancelProgramRequest) (*CancelProgramResponse, error)
+	CreateEmbedToken(context.Context, *CreateEmbedTokenRequest) (*CreateEmbedTokenResponse, error)
+	GetSlotSettings(context.Context, *GetSlotSettingsRequest) (*GetSlotSettingsResponse, error)
+	UpdateSlotSettings(context.Context, *UpdateSlotSettingsRequest) (*UpdateSlotSettingsResponse, error)
+	UpdateDailyBreaks(context.Context, *UpdateDailyBreaksRequest) (*UpdateDailyBreaksResponse, error)
+	CreateTimeOff(context.Context, *CreateTimeOffRequest) (*CreateTimeOffResponse, error)
+	GetTimeOff(context.Context, *GetTimeOffRequest) (*GetTimeOffResponse, error)
+	UpdateTimeOff(context.Context, *UpdateTimeOffRequest) (*UpdateTimeOffResponse, error)
+	DeleteTimeOff(context.Context, *DeleteTimeOffRequest) (*DeleteTimeOffResponse, error)
+	ListTimeOff(context.Context, *ListTimeOffRequest) (*ListTimeOffResponse, error)
+	SimulateSchedule(context.Context, *SimulateScheduleRequest) (*SimulateScheduleResponse, error)
+	CreateCalendarSnapshot(context.Context, *CreateCalendarSnapshotRequest) (*CreateCalendarSnapshotResponse, error)
+	ListCalendarSnapshots(context.Context, *ListCalendarSnapshotsRequest) (*ListCalendarSnapshotsResponse, error)
+	RestoreCalendarSnapshot(context.Context, *RestoreCalendarSnapshotRequest) (*RestoreCalendarSnapshotResponse, error)
+	mustEmbedUnimplementedAppointmentsServiceServer()
+}
+
+// UnimplementedAppointmentsServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedAppointmentsServiceServer struct{}
+
+func (UnimplementedAppointmentsServiceServer) CreateAppointment(context.Context, *CreateAppointmentRequest) (*CreateAppointmentResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateAppointment not implemented")
+}
+func (UnimplementedAppointmentsServiceServer) ListAppointments(context.Context, *ListAppointmentsRequest) (*ListAppointmentsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListAppointments not implemented")
+}
+func (UnimplementedAppointmentsServiceServer) DeleteAppointment(context.Context, *DeleteAppointmentRequest) (*DeleteAppointmentResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteAppointment not implemented")
+}
+func (UnimplementedAppointmentsServiceServer) CreateRecurringSeries(context.Context, *CreateRecurringSeriesRequest) (*CreateRecurringSeriesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateRecurringSeries not implemented")
+}
+func (UnimplementedAppointmentsServiceServer) ListOccurrences(context.Context, *ListOccurrencesRequest) (*ListOccurrencesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListOccurrences not implemented")
+}
+func (UnimplementedAppointmentsServiceServer) GetRecurringSeries(context.Context, *GetRecurringSeriesRequest) (*GetRecurringSeriesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetRecurringSeries not implemented")
+}
+func (UnimplementedAppointmentsServiceServer) ListRecurringSeries(context.Context, *ListRecurringSeriesRequest) (*ListRecurringSeriesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListRecurringSeries not implemented")
+}
+func (UnimplementedAppointmentsServiceServer) MarkAttendance(context.Context, *MarkAttendanceRequest) (*MarkAttendanceResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method MarkAttendance not implemented")
+}
+func (UnimplementedAppointmentsServiceServer) GetAttendanceStats(context.Context, *GetAttendanceStatsRequest) (*GetAttendanceStatsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetAttendanceStats not implemented")
+}
+func (UnimplementedAppointmentsServiceServer) GetLimits(context.Context, *GetLimitsRequest) (*GetLimitsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetLimits not implemented")
+}
+func (UnimplementedAppointmentsServiceServer) GetAppointmentByExternalRef(context.Context, *GetAppointmentByExternalRefRequest) (*GetAppointmentByExternalRefResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetAppointmentByExternalRef not implemented")
+}
+func (UnimplementedAppointmentsServiceServer) GetAnalytics(context.Context, *GetAnalyticsRequest) (*GetAnalyticsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetAnalytics not implemented")
+}
+func (UnimplementedAppointmentsServiceServer) SuggestEndTime(context.Context, *SuggestEndTimeRequest) (*SuggestEndTimeResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SuggestEndTime not implemented")
+}
+func (UnimplementedAppointmentsServiceServer) ReserveSlot(context.Context, *ReserveSlotRequest) (*ReserveSlotResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ReserveSlot not implemented")
+}
+func (UnimplementedAppointmentsServiceServer) ConfirmHold(context.Context, *ConfirmHoldRequest) (*ConfirmHoldResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ConfirmHold not implemented")
+}
+func (UnimplementedAppointmentsServiceServer) ReleaseHold(context.Context, *ReleaseHoldRequest) (*ReleaseHoldResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ReleaseHold not implemented")
+}
+func (UnimplementedAppointmentsServiceServer) ProposeAppointment(context.Context, *ProposeAppointmentRequest) (*ProposeAppointmentResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ProposeAppointment not implemented")
+}
+func (UnimplementedAppointmentsServiceServer) ListProposals(context.Context, *ListProposalsRequest) (*ListProposalsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListProposals not implemented")
+}
+func (UnimplementedAppointmentsServiceServer) AcceptProposal(context.Context, *AcceptProposalRequest) (*AcceptProposalResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method AcceptProposal not implemented")
+}
+func (UnimplementedAppointmentsServiceServer) DeclineProposal(context.Context, *DeclineProposalRequest) (*DeclineProposalResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeclineProposal not implemented")
+}
+func (UnimplementedAppointmentsServiceServer) LinkAppointments(context.Context, *LinkAppointmentsRequest) (*LinkAppointmentsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method LinkAppointments not implemented")
+}
+func (UnimplementedAppointmentsServiceServer) UnlinkAppointments(context.Context, *UnlinkAppointmentsRequest) (*UnlinkAppointmentsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method UnlinkAppointments not implemented")
+}
+func (UnimplementedAppointmentsServiceServer) ListRelated(context.Context, *ListRelatedRequest) (*ListRelatedResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListRelated not implemented")
+}
+func (UnimplementedAppointmentsServiceServer) MergeAppointments(context.Context, *MergeAppointmentsRequest) (*MergeAppointmentsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method MergeAppointments not implemented")
+}
+func (UnimplementedAppointmentsServiceServer) BatchGetFreeBusy(context.Context, *BatchGetFreeBusyRequest) (*BatchGetFreeBusyResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method BatchGetFreeBusy not implemented")
+}
+func (UnimplementedAppointmentsServiceServer) SuggestMeetingTimes(context.Context, *SuggestMeetingTimesRequest) (*SuggestMeetingTimesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SuggestMeetingTimes not implemented")
+}
+func (UnimplementedAppointmentsServiceServer) RepairRecurringSeries(context.Context, *RepairRecurringSeriesRequest) (*RepairRecurringSeriesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method RepairRecurringSeries not implemented")
+}
+func (UnimplementedAppointmentsServiceServer) SkipOccurrences(context.Context, *SkipOccurrencesRequest) (*SkipOccurrencesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SkipOccurrences not implemented")
+}
+func (UnimplementedAppointmentsServiceServer) UpdateSeriesEnd(context.Context, *UpdateSeriesEndRequest) (*UpdateSeriesEndResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateSeriesEnd not implemented")
+}
+func (UnimplementedAppointmentsServiceServer) ChangeSeriesTimeZone(context.Context, *ChangeSeriesTimeZoneRequest) (*ChangeSeriesTimeZoneResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ChangeSeriesTimeZone not implemented")
+}
+func (UnimplementedAppointmentsServiceServer) AuditCalendar(context.Context, *AuditCalendarRequest) (*AuditCalendarResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method AuditCalendar not implemented")
+}
+func (UnimplementedAppointmentsServiceServer) GetDailyAgenda(context.Context, *GetDailyAgendaRequest) (*GetDailyAgendaResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetDailyAgenda not implemented")
+}
+func (UnimplementedAppointmentsServiceServer) GetDaySummaries(context.Context, *GetDaySummariesRequest) (*GetDaySummariesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetDaySummaries not implemented")
+}
+func (UnimplementedAppointmentsServiceServer) GrantDelegation(context.Context, *GrantDelegationRequest) (*GrantDelegationResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GrantDelegation not implemented")
+}
+func (UnimplementedAppointmentsServiceServer) RevokeDelegation(context.Context, *RevokeDelegationRequest) (*RevokeDelegationResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method RevokeDelegation not implemented")
+}
+func (UnimplementedAppointmentsServiceServer) ListDelegations(context.Context, *ListDelegationsRequest) (*ListDelegationsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListDelegations not implemented")
+}
+func (UnimplementedAppointmentsServiceServer) ExportCalendar(context.Context, *ExportCalendarRequest) (*ExportCalendarResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ExportCalendar not implemented")
+}
+func (UnimplementedAppointmentsServiceServer) WatchOccurrences(*WatchOccurrencesRequest, grpc.ServerStreamingServer[WatchOccurrencesResponse]) error {
+	return status.Error(codes.Unimplemented, "method WatchOccurrences not implemented")
+}
+func (UnimplementedAppointmentsServiceServer) ListChanges(context.Context, *ListChangesRequest) (*ListChangesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListChanges not implemented")
+}
+func (UnimplementedAppointmentsServiceServer) ImportCalendar(context.Context, *ImportCalendarRequest) (*ImportCalendarResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ImportCalendar not implemented")
+}
+func (UnimplementedAppointmentsServiceServer) ReconcileCalendar(context.Context, *ReconcileCalendarRequest) (*ReconcileCalendarResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ReconcileCalendar not implemented")
+}
+func (UnimplementedAppointmentsServiceServer) CheckIn(context.Context, *CheckInRequest) (*CheckInResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CheckIn not implemented")
+}
+func (UnimplementedAppointmentsServiceServer) CheckOut(context.Context, *CheckOutRequest) (*CheckOutResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CheckOut not implemented")
+}
+func (UnimplementedAppointmentsServiceServer) ExportBillableHours(context.Context, *ExportBillableHoursRequest) (*ExportBillableHoursResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ExportBillableHours not implemented")
+}
+func (UnimplementedAppointmentsServiceServer) CreateContact(context.Context, *CreateContactRequest) (*CreateContactResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateContact not implemented")
+}
+func (UnimplementedAppointmentsServiceServer) GetContact(context.Context, *GetContactRequest) (*GetContactResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetContact not implemented")
+}
+func (UnimplementedAppointmentsServiceServer) UpdateContact(context.Context, *UpdateContactRequest) (*UpdateContactResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateContact not implemented")
+}
+func (UnimplementedAppointmentsServiceServer) DeleteContact(context.Context, *DeleteContactRequest) (*DeleteContactResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteContact not implemented")
+}
+func (UnimplementedAppointmentsServiceServer) ListContacts(context.Context, *ListContactsRequest) (*ListContactsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListContacts not implemented")
+}
+func (UnimplementedAppointmentsServiceServer) CreateProgram(context.Context, *CreateProgramRequest) (*CreateProgramResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateProgram not implemented")
+}
+func (UnimplementedAppointmentsServiceServer) GetProgram(context.Context, *GetProgramRequest) (*GetProgramResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetProgram not implemented")
+}
+func (UnimplementedAppointmentsServiceServer) ListPrograms(context.Context, *ListProgramsRequest) (*ListProgramsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListPrograms not implemented")
+}
+func (UnimplementedAppointmentsServiceServer) CancelProgram(context.Context, *CancelProgramRequest) (*CancelProgramResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CancelProgram not implemented")
+}
+func (UnimplementedAppointmentsServiceServer) CreateEmbedToken(context.Context, *CreateEmbedTokenRequest) (*CreateEmbedTokenResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateEmbedToken not implemented")
+}
+func (UnimplementedAppointmentsServiceServer) GetSlotSettings(context.Context, *GetSlotSettingsRequest) (*GetSlotSettingsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetSlotSettings not implemented")
+}
+func (UnimplementedAppointmentsServiceServer) UpdateSlotSettings(context.Context, *UpdateSlotSettingsRequest) (*UpdateSlotSettingsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateSlotSettings not implemented")
+}
+func (UnimplementedAppointmentsServiceServer) UpdateDailyBreaks(context.Context, *UpdateDailyBreaksRequest) (*UpdateDailyBreaksResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateDailyBreaks not implemented")
+}
+func (UnimplementedAppointmentsServiceServer) CreateTimeOff(context.Context, *CreateTimeOffRequest) (*CreateTimeOffResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateTimeOff not implemented")
+}
+func (UnimplementedAppointmentsServiceServer) GetTimeOff(context.Context, *GetTimeOffRequest) (*GetTimeOffResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetTimeOff not implemented")
+}
+func (UnimplementedAppointmentsServiceServer) UpdateTimeOff(context.Context, *UpdateTimeOffRequest) (*UpdateTimeOffResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateTimeOff not implemented")
+}
+func (UnimplementedAppointmentsServiceServer) DeleteTimeOff(context.Context, *DeleteTimeOffRequest) (*DeleteTimeOffResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteTimeOff not implemented")
+}
+func (UnimplementedAppointmentsServiceServer) ListTimeOff(context.Context, *ListTimeOffRequest) (*ListTimeOffResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListTimeOff not implemented")
+}
+func (UnimplementedAppointmentsServiceServer) SimulateSchedule(context.Context, *SimulateScheduleRequest) (*SimulateScheduleResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SimulateSchedule not implemented")
+}
+func (UnimplementedAppointmentsServiceServer) CreateCalendarSnapshot(context.Context, *CreateCalendarSnapshotRequest) (*CreateCalendarSnapshotResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateCalendarSnapshot not implemented")
+}
+func (UnimplementedAppointmentsServiceServer) ListCalendarSnapshots(context.Context, *ListCalendarSnapshotsRequest) (*ListCalendarSnapshotsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListCalendarSnapshots not implemented")
+}
+func (UnimplementedAppointmentsServiceServer) RestoreCalendarSnapshot(context.Context, *RestoreCalendarSnapshotRequest) (*RestoreCalendarSnapshotResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method RestoreCalendarSnapshot not implemented")
+}
+func (UnimplementedAppointmentsServiceServer) mustEmbedUnimplementedAppointmentsServiceServer() {}
+func (UnimplementedAppointmentsServiceServer) testEmbeddedByValue()                             {}
+
+// UnsafeAppointmentsServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to AppointmentsServiceServer will
+// result in compilation errors.
+type UnsafeAppointmentsServiceServer interface {
 	mustEmbedUnimplementedAppointmentsServiceServer()
 }
 
-// UnimplementedAppointmentsServiceServer must be embedded to have
-// forward compatible implementations.
-//
-// NOTE: this should be embedded by value instead of pointer to avoid a nil
-// pointer dereference when methods are called.
-type UnimplementedAppointmentsServiceServer struct{}
+func RegisterAppointmentsServiceServer(s grpc.ServiceRegistrar, srv AppointmentsServiceServer) {
+	// If the following call panics, it indicates UnimplementedAppointmentsServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&AppointmentsService_ServiceDesc, srv)
+}
+
+func _AppointmentsService_CreateAppointment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateAppointmentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppointmentsServiceServer).CreateAppointment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AppointmentsService_CreateAppointment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppointmentsServiceServer).CreateAppointment(ctx, req.(*CreateAppointmentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AppointmentsService_ListAppointments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAppointmentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppointmentsServiceServer).ListAppointments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AppointmentsService_ListAppointments_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppointmentsServiceServer).ListAppointments(ctx, req.(*ListAppointmentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AppointmentsService_DeleteAppointment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteAppointmentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppointmentsServiceServer).DeleteAppointment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AppointmentsService_DeleteAppointment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppointmentsServiceServer).DeleteAppointment(ctx, req.(*DeleteAppointmentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AppointmentsService_CreateRecurringSeries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateRecurringSeriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppointmentsServiceServer).CreateRecurringSeries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AppointmentsService_CreateRecurringSeries_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppointmentsServiceServer).CreateRecurringSeries(ctx, req.(*CreateRecurringSeriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AppointmentsService_ListOccurrences_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListOccurrencesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppointmentsServiceServer).ListOccurrences(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AppointmentsService_ListOccurrences_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppointmentsServiceServer).ListOccurrences(ctx, req.(*ListOccurrencesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AppointmentsService_GetRecurringSeries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRecurringSeriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppointmentsServiceServer).GetRecurringSeries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AppointmentsService_GetRecurringSeries_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppointmentsServiceServer).GetRecurringSeries(ctx, req.(*GetRecurringSeriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AppointmentsService_ListRecurringSeries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRecurringSeriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppointmentsServiceServer).ListRecurringSeries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AppointmentsService_ListRecurringSeries_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppointmentsServiceServer).ListRecurringSeries(ctx, req.(*ListRecurringSeriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AppointmentsService_MarkAttendance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MarkAttendanceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppointmentsServiceServer).MarkAttendance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AppointmentsService_MarkAttendance_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppointmentsServiceServer).MarkAttendance(ctx, req.(*MarkAttendanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AppointmentsService_GetAttendanceStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAttendanceStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppointmentsServiceServer).GetAttendanceStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AppointmentsService_GetAttendanceStats_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppointmentsServiceServer).GetAttendanceStats(ctx, req.(*GetAttendanceStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AppointmentsService_GetLimits_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetLimitsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppointmentsServiceServer).GetLimits(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AppointmentsService_GetLimits_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppointmentsServiceServer).GetLimits(ctx, req.(*GetLimitsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AppointmentsService_GetAppointmentByExternalRef_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAppointmentByExternalRefRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppointmentsServiceServer).GetAppointmentByExternalRef(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AppointmentsService_GetAppointmentByExternalRef_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppointmentsServiceServer).GetAppointmentByExternalRef(ctx, req.(*GetAppointmentByExternalRefRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AppointmentsService_GetAnalytics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAnalyticsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppointmentsServiceServer).GetAnalytics(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AppointmentsService_GetAnalytics_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppointmentsServiceServer).GetAnalytics(ctx, req.(*GetAnalyticsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AppointmentsService_SuggestEndTime_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SuggestEndTimeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppointmentsServiceServer).SuggestEndTime(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AppointmentsService_SuggestEndTime_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppointmentsServiceServer).SuggestEndTime(ctx, req.(*SuggestEndTimeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AppointmentsService_ReserveSlot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReserveSlotRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppointmentsServiceServer).ReserveSlot(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AppointmentsService_ReserveSlot_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppointmentsServiceServer).ReserveSlot(ctx, req.(*ReserveSlotRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AppointmentsService_ConfirmHold_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConfirmHoldRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppointmentsServiceServer).ConfirmHold(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AppointmentsService_ConfirmHold_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppointmentsServiceServer).ConfirmHold(ctx, req.(*ConfirmHoldRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AppointmentsService_ReleaseHold_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReleaseHoldRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppointmentsServiceServer).ReleaseHold(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AppointmentsService_ReleaseHold_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppointmentsServiceServer).ReleaseHold(ctx, req.(*ReleaseHoldRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AppointmentsService_ProposeAppointment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProposeAppointmentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppointmentsServiceServer).ProposeAppointment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AppointmentsService_ProposeAppointment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppointmentsServiceServer).ProposeAppointment(ctx, req.(*ProposeAppointmentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AppointmentsService_ListProposals_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListProposalsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppointmentsServiceServer).ListProposals(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AppointmentsService_ListProposals_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppointmentsServiceServer).ListProposals(ctx, req.(*ListProposalsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AppointmentsService_AcceptProposal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AcceptProposalRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppointmentsServiceServer).AcceptProposal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AppointmentsService_AcceptProposal_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppointmentsServiceServer).AcceptProposal(ctx, req.(*AcceptProposalRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AppointmentsService_DeclineProposal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeclineProposalRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppointmentsServiceServer).DeclineProposal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AppointmentsService_DeclineProposal_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppointmentsServiceServer).DeclineProposal(ctx, req.(*DeclineProposalRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AppointmentsService_LinkAppointments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LinkAppointmentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppointmentsServiceServer).LinkAppointments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AppointmentsService_LinkAppointments_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppointmentsServiceServer).LinkAppointments(ctx, req.(*LinkAppointmentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AppointmentsService_UnlinkAppointments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnlinkAppointmentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppointmentsServiceServer).UnlinkAppointments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AppointmentsService_UnlinkAppointments_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppointmentsServiceServer).UnlinkAppointments(ctx, req.(*UnlinkAppointmentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AppointmentsService_ListRelated_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRelatedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppointmentsServiceServer).ListRelated(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AppointmentsService_ListRelated_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppointmentsServiceServer).ListRelated(ctx, req.(*ListRelatedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AppointmentsService_MergeAppointments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MergeAppointmentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppointmentsServiceServer).MergeAppointments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AppointmentsService_MergeAppointments_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppointmentsServiceServer).MergeAppointments(ctx, req.(*MergeAppointmentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AppointmentsService_BatchGetFreeBusy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchGetFreeBusyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppointmentsServiceServer).BatchGetFreeBusy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AppointmentsService_BatchGetFreeBusy_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppointmentsServiceServer).BatchGetFreeBusy(ctx, req.(*BatchGetFreeBusyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AppointmentsService_SuggestMeetingTimes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SuggestMeetingTimesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppointmentsServiceServer).SuggestMeetingTimes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AppointmentsService_SuggestMeetingTimes_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppointmentsServiceServer).SuggestMeetingTimes(ctx, req.(*SuggestMeetingTimesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AppointmentsService_RepairRecurringSeries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RepairRecurringSeriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppointmentsServiceServer).RepairRecurringSeries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AppointmentsService_RepairRecurringSeries_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppointmentsServiceServer).RepairRecurringSeries(ctx, req.(*RepairRecurringSeriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AppointmentsService_SkipOccurrences_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SkipOccurrencesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppointmentsServiceServer).SkipOccurrences(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AppointmentsService_SkipOccurrences_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppointmentsServiceServer).SkipOccurrences(ctx, req.(*SkipOccurrencesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AppointmentsService_UpdateSeriesEnd_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateSeriesEndRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppointmentsServiceServer).UpdateSeriesEnd(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AppointmentsService_UpdateSeriesEnd_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppointmentsServiceServer).UpdateSeriesEnd(ctx, req.(*UpdateSeriesEndRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AppointmentsService_ChangeSeriesTimeZone_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChangeSeriesTimeZoneRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppointmentsServiceServer).ChangeSeriesTimeZone(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AppointmentsService_ChangeSeriesTimeZone_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppointmentsServiceServer).ChangeSeriesTimeZone(ctx, req.(*ChangeSeriesTimeZoneRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AppointmentsService_AuditCalendar_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AuditCalendarRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppointmentsServiceServer).AuditCalendar(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AppointmentsService_AuditCalendar_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppointmentsServiceServer).AuditCalendar(ctx, req.(*AuditCalendarRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AppointmentsService_GetDailyAgenda_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDailyAgendaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppointmentsServiceServer).GetDailyAgenda(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AppointmentsService_GetDailyAgenda_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppointmentsServiceServer).GetDailyAgenda(ctx, req.(*GetDailyAgendaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AppointmentsService_GetDaySummaries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDaySummariesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppointmentsServiceServer).GetDaySummaries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AppointmentsService_GetDaySummaries_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppointmentsServiceServer).GetDaySummaries(ctx, req.(*GetDaySummariesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AppointmentsService_GrantDelegation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GrantDelegationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppointmentsServiceServer).GrantDelegation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AppointmentsService_GrantDelegation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppointmentsServiceServer).GrantDelegation(ctx, req.(*GrantDelegationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AppointmentsService_RevokeDelegation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeDelegationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppointmentsServiceServer).RevokeDelegation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AppointmentsService_RevokeDelegation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppointmentsServiceServer).RevokeDelegation(ctx, req.(*RevokeDelegationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AppointmentsService_ListDelegations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListDelegationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppointmentsServiceServer).ListDelegations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AppointmentsService_ListDelegations_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppointmentsServiceServer).ListDelegations(ctx, req.(*ListDelegationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AppointmentsService_ExportCalendar_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportCalendarRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppointmentsServiceServer).ExportCalendar(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AppointmentsService_ExportCalendar_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppointmentsServiceServer).ExportCalendar(ctx, req.(*ExportCalendarRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AppointmentsService_WatchOccurrences_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchOccurrencesRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(AppointmentsServiceServer).WatchOccurrences(m, &grpc.GenericServerStream[WatchOccurrencesRequest, WatchOccurrencesResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type AppointmentsService_WatchOccurrencesServer = grpc.ServerStreamingServer[WatchOccurrencesResponse]
+
+func _AppointmentsService_ListChanges_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListChangesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppointmentsServiceServer).ListChanges(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AppointmentsService_ListChanges_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppointmentsServiceServer).ListChanges(ctx, req.(*ListChangesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AppointmentsService_ImportCalendar_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportCalendarRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppointmentsServiceServer).ImportCalendar(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AppointmentsService_ImportCalendar_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppointmentsServiceServer).ImportCalendar(ctx, req.(*ImportCalendarRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AppointmentsService_ReconcileCalendar_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReconcileCalendarRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppointmentsServiceServer).ReconcileCalendar(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AppointmentsService_ReconcileCalendar_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppointmentsServiceServer).ReconcileCalendar(ctx, req.(*ReconcileCalendarRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AppointmentsService_CheckIn_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CheckInRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppointmentsServiceServer).CheckIn(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AppointmentsService_CheckIn_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppointmentsServiceServer).CheckIn(ctx, req.(*CheckInRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AppointmentsService_CheckOut_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CheckOutRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppointmentsServiceServer).CheckOut(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AppointmentsService_CheckOut_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppointmentsServiceServer).CheckOut(ctx, req.(*CheckOutRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AppointmentsService_ExportBillableHours_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportBillableHoursRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppointmentsServiceServer).ExportBillableHours(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AppointmentsService_ExportBillableHours_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppointmentsServiceServer).ExportBillableHours(ctx, req.(*ExportBillableHoursRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AppointmentsService_CreateContact_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateContactRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppointmentsServiceServer).CreateContact(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AppointmentsService_CreateContact_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppointmentsServiceServer).CreateContact(ctx, req.(*CreateContactRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AppointmentsService_GetContact_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetContactRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppointmentsServiceServer).GetContact(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AppointmentsService_GetContact_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppointmentsServiceServer).GetContact(ctx, req.(*GetContactRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AppointmentsService_UpdateContact_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateContactRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppointmentsServiceServer).UpdateContact(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AppointmentsService_UpdateContact_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppointmentsServiceServer).UpdateContact(ctx, req.(*UpdateContactRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AppointmentsService_DeleteContact_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteContactRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppointmentsServiceServer).DeleteContact(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AppointmentsService_DeleteContact_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppointmentsServiceServer).DeleteContact(ctx, req.(*DeleteContactRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AppointmentsService_ListContacts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListContactsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppointmentsServiceServer).ListContacts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AppointmentsService_ListContacts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppointmentsServiceServer).ListContacts(ctx, req.(*ListContactsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AppointmentsService_CreateProgram_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateProgramRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppointmentsServiceServer).CreateProgram(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AppointmentsService_CreateProgram_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppointmentsServiceServer).CreateProgram(ctx, req.(*CreateProgramRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AppointmentsService_GetProgram_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetProgramRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppointmentsServiceServer).GetProgram(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AppointmentsService_GetProgram_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppointmentsServiceServer).GetProgram(ctx, req.(*GetProgramRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AppointmentsService_ListPrograms_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListProgramsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppointmentsServiceServer).ListPrograms(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AppointmentsService_ListPrograms_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppointmentsServiceServer).ListPrograms(ctx, req.(*ListProgramsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AppointmentsService_CancelProgram_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelProgramRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppointmentsServiceServer).CancelProgram(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AppointmentsService_CancelProgram_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppointmentsServiceServer).CancelProgram(ctx, req.(*CancelProgramRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AppointmentsService_CreateEmbedToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateEmbedTokenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppointmentsServiceServer).CreateEmbedToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AppointmentsService_CreateEmbedToken_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppointmentsServiceServer).CreateEmbedToken(ctx, req.(*CreateEmbedTokenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
 
-func (UnimplementedAppointmentsServiceServer) CreateAppointment(context.Context, *CreateAppointmentRequest) (*CreateAppointmentResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method CreateAppointment not implemented")
+func _AppointmentsService_GetSlotSettings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSlotSettingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppointmentsServiceServer).GetSlotSettings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AppointmentsService_GetSlotSettings_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppointmentsServiceServer).GetSlotSettings(ctx, req.(*GetSlotSettingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedAppointmentsServiceServer) ListAppointments(context.Context, *ListAppointmentsRequest) (*ListAppointmentsResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method ListAppointments not implemented")
+
+func _AppointmentsService_UpdateSlotSettings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateSlotSettingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppointmentsServiceServer).UpdateSlotSettings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AppointmentsService_UpdateSlotSettings_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppointmentsServiceServer).UpdateSlotSettings(ctx, req.(*UpdateSlotSettingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedAppointmentsServiceServer) DeleteAppointment(context.Context, *DeleteAppointmentRequest) (*DeleteAppointmentResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method DeleteAppointment not implemented")
+
+func _AppointmentsService_UpdateDailyBreaks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateDailyBreaksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppointmentsServiceServer).UpdateDailyBreaks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AppointmentsService_UpdateDailyBreaks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppointmentsServiceServer).UpdateDailyBreaks(ctx, req.(*UpdateDailyBreaksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedAppointmentsServiceServer) CreateRecurringSeries(context.Context, *CreateRecurringSeriesRequest) (*CreateRecurringSeriesResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method CreateRecurringSeries not implemented")
+
+func _AppointmentsService_CreateTimeOff_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateTimeOffRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppointmentsServiceServer).CreateTimeOff(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AppointmentsService_CreateTimeOff_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppointmentsServiceServer).CreateTimeOff(ctx, req.(*CreateTimeOffRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedAppointmentsServiceServer) ListOccurrences(context.Context, *ListOccurrencesRequest) (*ListOccurrencesResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method ListOccurrences not implemented")
+
+func _AppointmentsService_GetTimeOff_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTimeOffRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppointmentsServiceServer).GetTimeOff(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AppointmentsService_GetTimeOff_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppointmentsServiceServer).GetTimeOff(ctx, req.(*GetTimeOffRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedAppointmentsServiceServer) mustEmbedUnimplementedAppointmentsServiceServer() {}
-func (UnimplementedAppointmentsServiceServer) testEmbeddedByValue()                             {}
 
-// UnsafeAppointmentsServiceServer may be embedded to opt out of forward compatibility for this service.
-// Use of this interface is not recommended, as added methods to AppointmentsServiceServer will
-// result in compilation errors.
-type UnsafeAppointmentsServiceServer interface {
-	mustEmbedUnimplementedAppointmentsServiceServer()
+func _AppointmentsService_UpdateTimeOff_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateTimeOffRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppointmentsServiceServer).UpdateTimeOff(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AppointmentsService_UpdateTimeOff_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppointmentsServiceServer).UpdateTimeOff(ctx, req.(*UpdateTimeOffRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func RegisterAppointmentsServiceServer(s grpc.ServiceRegistrar, srv AppointmentsServiceServer) {
-	// If the following call panics, it indicates UnimplementedAppointmentsServiceServer was
-	// embedded by pointer and is nil.  This will cause panics if an
-	// unimplemented method is ever invoked, so we test this at initialization
-	// time to prevent it from happening at runtime later due to I/O.
-	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
-		t.testEmbeddedByValue()
+func _AppointmentsService_DeleteTimeOff_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteTimeOffRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	s.RegisterService(&AppointmentsService_ServiceDesc, srv)
+	if interceptor == nil {
+		return srv.(AppointmentsServiceServer).DeleteTimeOff(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AppointmentsService_DeleteTimeOff_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppointmentsServiceServer).DeleteTimeOff(ctx, req.(*DeleteTimeOffRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func _AppointmentsService_CreateAppointment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateAppointmentRequest)
+func _AppointmentsService_ListTimeOff_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTimeOffRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AppointmentsServiceServer).CreateAppointment(ctx, in)
+		return srv.(AppointmentsServiceServer).ListTimeOff(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: AppointmentsService_CreateAppointment_FullMethodName,
+		FullMethod: AppointmentsService_ListTimeOff_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AppointmentsServiceServer).CreateAppointment(ctx, req.(*CreateAppointmentRequest))
+		return srv.(AppointmentsServiceServer).ListTimeOff(ctx, req.(*ListTimeOffRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _AppointmentsService_ListAppointments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListAppointmentsRequest)
+func _AppointmentsService_SimulateSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SimulateScheduleRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AppointmentsServiceServer).ListAppointments(ctx, in)
+		return srv.(AppointmentsServiceServer).SimulateSchedule(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: AppointmentsService_ListAppointments_FullMethodName,
+		FullMethod: AppointmentsService_SimulateSchedule_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AppointmentsServiceServer).ListAppointments(ctx, req.(*ListAppointmentsRequest))
+		return srv.(AppointmentsServiceServer).SimulateSchedule(ctx, req.(*SimulateScheduleRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _AppointmentsService_DeleteAppointment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteAppointmentRequest)
+func _AppointmentsService_CreateCalendarSnapshot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateCalendarSnapshotRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AppointmentsServiceServer).DeleteAppointment(ctx, in)
+		return srv.(AppointmentsServiceServer).CreateCalendarSnapshot(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: AppointmentsService_DeleteAppointment_FullMethodName,
+		FullMethod: AppointmentsService_CreateCalendarSnapshot_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AppointmentsServiceServer).DeleteAppointment(ctx, req.(*DeleteAppointmentRequest))
+		return srv.(AppointmentsServiceServer).CreateCalendarSnapshot(ctx, req.(*CreateCalendarSnapshotRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _AppointmentsService_CreateRecurringSeries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateRecurringSeriesRequest)
+func _AppointmentsService_ListCalendarSnapshots_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCalendarSnapshotsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AppointmentsServiceServer).CreateRecurringSeries(ctx, in)
+		return srv.(AppointmentsServiceServer).ListCalendarSnapshots(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: AppointmentsService_CreateRecurringSeries_FullMethodName,
+		FullMethod: AppointmentsService_ListCalendarSnapshots_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AppointmentsServiceServer).CreateRecurringSeries(ctx, req.(*CreateRecurringSeriesRequest))
+		return srv.(AppointmentsServiceServer).ListCalendarSnapshots(ctx, req.(*ListCalendarSnapshotsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _AppointmentsService_ListOccurrences_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListOccurrencesRequest)
+func _AppointmentsService_RestoreCalendarSnapshot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreCalendarSnapshotRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AppointmentsServiceServer).ListOccurrences(ctx, in)
+		return srv.(AppointmentsServiceServer).RestoreCalendarSnapshot(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: AppointmentsService_ListOccurrences_FullMethodName,
+		FullMethod: AppointmentsService_RestoreCalendarSnapshot_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AppointmentsServiceServer).ListOccurrences(ctx, req.(*ListOccurrencesRequest))
+		return srv.(AppointmentsServiceServer).RestoreCalendarSnapshot(ctx, req.(*RestoreCalendarSnapshotRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -267,7 +2343,253 @@ var AppointmentsService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListOccurrences",
 			Handler:    _AppointmentsService_ListOccurrences_Handler,
 		},
+		{
+			MethodName: "GetRecurringSeries",
+			Handler:    _AppointmentsService_GetRecurringSeries_Handler,
+		},
+		{
+			MethodName: "ListRecurringSeries",
+			Handler:    _AppointmentsService_ListRecurringSeries_Handler,
+		},
+		{
+			MethodName: "MarkAttendance",
+			Handler:    _AppointmentsService_MarkAttendance_Handler,
+		},
+		{
+			MethodName: "GetAttendanceStats",
+			Handler:    _AppointmentsService_GetAttendanceStats_Handler,
+		},
+		{
+			MethodName: "GetLimits",
+			Handler:    _AppointmentsService_GetLimits_Handler,
+		},
+		{
+			MethodName: "GetAppointmentByExternalRef",
+			Handler:    _AppointmentsService_GetAppointmentByExternalRef_Handler,
+		},
+		{
+			MethodName: "GetAnalytics",
+			Handler:    _AppointmentsService_GetAnalytics_Handler,
+		},
+		{
+			MethodName: "SuggestEndTime",
+			Handler:    _AppointmentsService_SuggestEndTime_Handler,
+		},
+		{
+			MethodName: "ReserveSlot",
+			Handler:    _AppointmentsService_ReserveSlot_Handler,
+		},
+		{
+			MethodName: "ConfirmHold",
+			Handler:    _AppointmentsService_ConfirmHold_Handler,
+		},
+		{
+			MethodName: "ReleaseHold",
+			Handler:    _AppointmentsService_ReleaseHold_Handler,
+		},
+		{
+			MethodName: "ProposeAppointment",
+			Handler:    _AppointmentsService_ProposeAppointment_Handler,
+		},
+		{
+			MethodName: "ListProposals",
+			Handler:    _AppointmentsService_ListProposals_Handler,
+		},
+		{
+			MethodName: "AcceptProposal",
+			Handler:    _AppointmentsService_AcceptProposal_Handler,
+		},
+		{
+			MethodName: "DeclineProposal",
+			Handler:    _AppointmentsService_DeclineProposal_Handler,
+		},
+		{
+			MethodName: "LinkAppointments",
+			Handler:    _AppointmentsService_LinkAppointments_Handler,
+		},
+		{
+			MethodName: "UnlinkAppointments",
+			Handler:    _AppointmentsService_UnlinkAppointments_Handler,
+		},
+		{
+			MethodName: "ListRelated",
+			Handler:    _AppointmentsService_ListRelated_Handler,
+		},
+		{
+			MethodName: "MergeAppointments",
+			Handler:    _AppointmentsService_MergeAppointments_Handler,
+		},
+		{
+			MethodName: "BatchGetFreeBusy",
+			Handler:    _AppointmentsService_BatchGetFreeBusy_Handler,
+		},
+		{
+			MethodName: "SuggestMeetingTimes",
+			Handler:    _AppointmentsService_SuggestMeetingTimes_Handler,
+		},
+		{
+			MethodName: "RepairRecurringSeries",
+			Handler:    _AppointmentsService_RepairRecurringSeries_Handler,
+		},
+		{
+			MethodName: "SkipOccurrences",
+			Handler:    _AppointmentsService_SkipOccurrences_Handler,
+		},
+		{
+			MethodName: "UpdateSeriesEnd",
+			Handler:    _AppointmentsService_UpdateSeriesEnd_Handler,
+		},
+		{
+			MethodName: "ChangeSeriesTimeZone",
+			Handler:    _AppointmentsService_ChangeSeriesTimeZone_Handler,
+		},
+		{
+			MethodName: "AuditCalendar",
+			Handler:    _AppointmentsService_AuditCalendar_Handler,
+		},
+		{
+			MethodName: "GetDailyAgenda",
+			Handler:    _AppointmentsService_GetDailyAgenda_Handler,
+		},
+		{
+			MethodName: "GetDaySummaries",
+			Handler:    _AppointmentsService_GetDaySummaries_Handler,
+		},
+		{
+			MethodName: "GrantDelegation",
+			Handler:    _AppointmentsService_GrantDelegation_Handler,
+		},
+		{
+			MethodName: "RevokeDelegation",
+			Handler:    _AppointmentsService_RevokeDelegation_Handler,
+		},
+		{
+			MethodName: "ListDelegations",
+			Handler:    _AppointmentsService_ListDelegations_Handler,
+		},
+		{
+			MethodName: "ExportCalendar",
+			Handler:    _AppointmentsService_ExportCalendar_Handler,
+		},
+		{
+			MethodName: "ListChanges",
+			Handler:    _AppointmentsService_ListChanges_Handler,
+		},
+		{
+			MethodName: "ImportCalendar",
+			Handler:    _AppointmentsService_ImportCalendar_Handler,
+		},
+		{
+			MethodName: "ReconcileCalendar",
+			Handler:    _AppointmentsService_ReconcileCalendar_Handler,
+		},
+		{
+			MethodName: "CheckIn",
+			Handler:    _AppointmentsService_CheckIn_Handler,
+		},
+		{
+			MethodName: "CheckOut",
+			Handler:    _AppointmentsService_CheckOut_Handler,
+		},
+		{
+			MethodName: "ExportBillableHours",
+			Handler:    _AppointmentsService_ExportBillableHours_Handler,
+		},
+		{
+			MethodName: "CreateContact",
+			Handler:    _AppointmentsService_CreateContact_Handler,
+		},
+		{
+			MethodName: "GetContact",
+			Handler:    _AppointmentsService_GetContact_Handler,
+		},
+		{
+			MethodName: "UpdateContact",
+			Handler:    _AppointmentsService_UpdateContact_Handler,
+		},
+		{
+			MethodName: "DeleteContact",
+			Handler:    _AppointmentsService_DeleteContact_Handler,
+		},
+		{
+			MethodName: "ListContacts",
+			Handler:    _AppointmentsService_ListContacts_Handler,
+		},
+		{
+			MethodName: "CreateProgram",
+			Handler:    _AppointmentsService_CreateProgram_Handler,
+		},
+		{
+			MethodName: "GetProgram",
+			Handler:    _AppointmentsService_GetProgram_Handler,
+		},
+		{
+			MethodName: "ListPrograms",
+			Handler:    _AppointmentsService_ListPrograms_Handler,
+		},
+		{
+			MethodName: "CancelProgram",
+			Handler:    _AppointmentsService_CancelProgram_Handler,
+		},
+		{
+			MethodName: "CreateEmbedToken",
+			Handler:    _AppointmentsService_CreateEmbedToken_Handler,
+		},
+		{
+			MethodName: "GetSlotSettings",
+			Handler:    _AppointmentsService_GetSlotSettings_Handler,
+		},
+		{
+			MethodName: "UpdateSlotSettings",
+			Handler:    _AppointmentsService_UpdateSlotSettings_Handler,
+		},
+		{
+			MethodName: "UpdateDailyBreaks",
+			Handler:    _AppointmentsService_UpdateDailyBreaks_Handler,
+		},
+		{
+			MethodName: "CreateTimeOff",
+			Handler:    _AppointmentsService_CreateTimeOff_Handler,
+		},
+		{
+			MethodName: "GetTimeOff",
+			Handler:    _AppointmentsService_GetTimeOff_Handler,
+		},
+		{
+			MethodName: "UpdateTimeOff",
+			Handler:    _AppointmentsService_UpdateTimeOff_Handler,
+		},
+		{
+			MethodName: "DeleteTimeOff",
+			Handler:    _AppointmentsService_DeleteTimeOff_Handler,
+		},
+		{
+			MethodName: "ListTimeOff",
+			Handler:    _AppointmentsService_ListTimeOff_Handler,
+		},
+		{
+			MethodName: "SimulateSchedule",
+			Handler:    _AppointmentsService_SimulateSchedule_Handler,
+		},
+		{
+			MethodName: "CreateCalendarSnapshot",
+			Handler:    _AppointmentsService_CreateCalendarSnapshot_Handler,
+		},
+		{
+			MethodName: "ListCalendarSnapshots",
+			Handler:    _AppointmentsService_ListCalendarSnapshots_Handler,
+		},
+		{
+			MethodName: "RestoreCalendarSnapshot",
+			Handler:    _AppointmentsService_RestoreCalendarSnapshot_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchOccurrences",
+			Handler:       _AppointmentsService_WatchOccurrences_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "proto/schedula/v1/appointments.proto",
 }
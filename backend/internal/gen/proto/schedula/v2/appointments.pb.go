@@ -0,0 +1,691 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: proto/schedula/v2/appointments.proto
+
+package schedulev2
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type AppointmentKind int32
+
+const (
+	AppointmentKind_APPOINTMENT_KIND_UNSPECIFIED AppointmentKind = 0
+	AppointmentKind_APPOINTMENT_KIND_EVENT       AppointmentKind = 1
+	AppointmentKind_APPOINTMENT_KIND_MILESTONE   AppointmentKind = 2
+)
+
+// Enum value maps for AppointmentKind.
+var (
+	AppointmentKind_name = map[int32]string{
+		0: "APPOINTMENT_KIND_UNSPECIFIED",
+		1: "APPOINTMENT_KIND_EVENT",
+		2: "APPOINTMENT_KIND_MILESTONE",
+	}
+	AppointmentKind_value = map[string]int32{
+		"APPOINTMENT_KIND_UNSPECIFIED": 0,
+		"APPOINTMENT_KIND_EVENT":       1,
+		"APPOINTMENT_KIND_MILESTONE":   2,
+	}
+)
+
+func (x AppointmentKind) Enum() *AppointmentKind {
+	p := new(AppointmentKind)
+	*p = x
+	return p
+}
+
+func (x AppointmentKind) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (AppointmentKind) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_schedula_v2_appointments_proto_enumTypes[0].Descriptor()
+}
+
+func (AppointmentKind) Type() protoreflect.EnumType {
+	return &file_proto_schedula_v2_appointments_proto_enumTypes[0]
+}
+
+func (x AppointmentKind) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use AppointmentKind.Descriptor instead.
+func (AppointmentKind) EnumDescriptor() ([]byte, []int) {
+	return file_proto_schedula_v2_appointments_proto_rawDescGZIP(), []int{0}
+}
+
+type ExternalRef struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	System        string                 `protobuf:"bytes,1,opt,name=system,proto3" json:"system,omitempty"`
+	Id            string                 `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExternalRef) Reset() {
+	*x = ExternalRef{}
+	mi := &file_proto_schedula_v2_appointments_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExternalRef) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExternalRef) ProtoMessage() {}
+
+func (x *ExternalRef) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_schedula_v2_appointments_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExternalRef.ProtoReflect.Descriptor instead.
+func (*ExternalRef) Descriptor() ([]byte, []int) {
+	return file_proto_schedula_v2_appointments_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ExternalRef) GetSystem() string {
+	if x != nil {
+		return x.System
+	}
+	return ""
+}
+
+func (x *ExternalRef) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type Appointment struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Title         string                 `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
+	Notes         string                 `protobuf:"bytes,4,opt,name=notes,proto3" json:"notes,omitempty"`
+	StartTime     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	EndTime       *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	CreateTime    *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
+	UpdateTime    *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=update_time,json=updateTime,proto3" json:"update_time,omitempty"`
+	Metadata      map[string]string      `protobuf:"bytes,9,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	ExternalRef   *ExternalRef           `protobuf:"bytes,10,opt,name=external_ref,json=externalRef,proto3" json:"external_ref,omitempty"`
+	TimeZone      string                 `protobuf:"bytes,11,opt,name=time_zone,json=timeZone,proto3" json:"time_zone,omitempty"`
+	CreatedBy     string                 `protobuf:"bytes,12,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
+	CheckInTime   *timestamppb.Timestamp `protobuf:"bytes,13,opt,name=check_in_time,json=checkInTime,proto3" json:"check_in_time,omitempty"`
+	CheckOutTime  *timestamppb.Timestamp `protobuf:"bytes,14,opt,name=check_out_time,json=checkOutTime,proto3" json:"check_out_time,omitempty"`
+	ContactId     string                 `protobuf:"bytes,15,opt,name=contact_id,json=contactId,proto3" json:"contact_id,omitempty"`
+	Source        string                 `protobuf:"bytes,16,opt,name=source,proto3" json:"source,omitempty"`
+	Kind          AppointmentKind        `protobuf:"varint,17,opt,name=kind,proto3,enum=schedula.v2.AppointmentKind" json:"kind,omitempty"`
+	PrivateNotes  string                 `protobuf:"bytes,18,opt,name=private_notes,json=privateNotes,proto3" json:"private_notes,omitempty"`
+	ProgramId     string                 `protobuf:"bytes,19,opt,name=program_id,json=programId,proto3" json:"program_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Appointment) Reset() {
+	*x = Appointment{}
+	mi := &file_proto_schedula_v2_appointments_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Appointment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Appointment) ProtoMessage() {}
+
+func (x *Appointment) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_schedula_v2_appointments_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Appointment.ProtoReflect.Descriptor instead.
+func (*Appointment) Descriptor() ([]byte, []int) {
+	return file_proto_schedula_v2_appointments_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Appointment) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Appointment) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *Appointment) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *Appointment) GetNotes() string {
+	if x != nil {
+		return x.Notes
+	}
+	return ""
+}
+
+func (x *Appointment) GetStartTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartTime
+	}
+	return nil
+}
+
+func (x *Appointment) GetEndTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndTime
+	}
+	return nil
+}
+
+func (x *Appointment) GetCreateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreateTime
+	}
+	return nil
+}
+
+func (x *Appointment) GetUpdateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdateTime
+	}
+	return nil
+}
+
+func (x *Appointment) GetMetadata() map[string]string {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+func (x *Appointment) GetExternalRef() *ExternalRef {
+	if x != nil {
+		return x.ExternalRef
+	}
+	return nil
+}
+
+func (x *Appointment) GetTimeZone() string {
+	if x != nil {
+		return x.TimeZone
+	}
+	return ""
+}
+
+func (x *Appointment) GetCreatedBy() string {
+	if x != nil {
+		return x.CreatedBy
+	}
+	return ""
+}
+
+func (x *Appointment) GetCheckInTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CheckInTime
+	}
+	return nil
+}
+
+func (x *Appointment) GetCheckOutTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CheckOutTime
+	}
+	return nil
+}
+
+func (x *Appointment) GetContactId() string {
+	if x != nil {
+		return x.ContactId
+	}
+	return ""
+}
+
+func (x *Appointment) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+func (x *Appointment) GetKind() AppointmentKind {
+	if x != nil {
+		return x.Kind
+	}
+	return AppointmentKind_APPOINTMENT_KIND_UNSPECIFIED
+}
+
+func (x *Appointment) GetPrivateNotes() string {
+	if x != nil {
+		return x.PrivateNotes
+	}
+	return ""
+}
+
+func (x *Appointment) GetProgramId() string {
+	if x != nil {
+		return x.ProgramId
+	}
+	return ""
+}
+
+type ListAppointmentsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	WindowStart   *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=window_start,json=windowStart,proto3" json:"window_start,omitempty"`
+	WindowEnd     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=window_end,json=windowEnd,proto3" json:"window_end,omitempty"`
+	PageSize      int32                  `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken     string                 `protobuf:"bytes,5,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	ContactId     string                 `protobuf:"bytes,6,opt,name=contact_id,json=contactId,proto3" json:"contact_id,omitempty"`
+	Source        string                 `protobuf:"bytes,7,opt,name=source,proto3" json:"source,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAppointmentsRequest) Reset() {
+	*x = ListAppointmentsRequest{}
+	mi := &file_proto_schedula_v2_appointments_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAppointmentsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAppointmentsRequest) ProtoMessage() {}
+
+func (x *ListAppointmentsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_schedula_v2_appointments_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAppointmentsRequest.ProtoReflect.Descriptor instead.
+func (*ListAppointmentsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_schedula_v2_appointments_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ListAppointmentsRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ListAppointmentsRequest) GetWindowStart() *timestamppb.Timestamp {
+	if x != nil {
+		return x.WindowStart
+	}
+	return nil
+}
+
+func (x *ListAppointmentsRequest) GetWindowEnd() *timestamppb.Timestamp {
+	if x != nil {
+		return x.WindowEnd
+	}
+	return nil
+}
+
+func (x *ListAppointmentsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListAppointmentsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListAppointmentsRequest) GetContactId() string {
+	if x != nil {
+		return x.ContactId
+	}
+	return ""
+}
+
+func (x *ListAppointmentsRequest) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+type ListAppointmentsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Appointments  []*Appointment         `protobuf:"bytes,1,rep,name=appointments,proto3" json:"appointments,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAppointmentsResponse) Reset() {
+	*x = ListAppointmentsResponse{}
+	mi := &file_proto_schedula_v2_appointments_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAppointmentsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAppointmentsResponse) ProtoMessage() {}
+
+func (x *ListAppointmentsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_schedula_v2_appointments_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAppointmentsResponse.ProtoReflect.Descriptor instead.
+func (*ListAppointmentsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_schedula_v2_appointments_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ListAppointmentsResponse) GetAppointments() []*Appointment {
+	if x != nil {
+		return x.Appointments
+	}
+	return nil
+}
+
+func (x *ListAppointmentsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type DeleteAppointmentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	AppointmentId string                 `protobuf:"bytes,2,opt,name=appointment_id,json=appointmentId,proto3" json:"appointment_id,omitempty"`
+	ActorId       string                 `protobuf:"bytes,3,opt,name=actor_id,json=actorId,proto3" json:"actor_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteAppointmentRequest) Reset() {
+	*x = DeleteAppointmentRequest{}
+	mi := &file_proto_schedula_v2_appointments_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteAppointmentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteAppointmentRequest) ProtoMessage() {}
+
+func (x *DeleteAppointmentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_schedula_v2_appointments_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteAppointmentRequest.ProtoReflect.Descriptor instead.
+func (*DeleteAppointmentRequest) Descriptor() ([]byte, []int) {
+	return file_proto_schedula_v2_appointments_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *DeleteAppointmentRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *DeleteAppointmentRequest) GetAppointmentId() string {
+	if x != nil {
+		return x.AppointmentId
+	}
+	return ""
+}
+
+func (x *DeleteAppointmentRequest) GetActorId() string {
+	if x != nil {
+		return x.ActorId
+	}
+	return ""
+}
+
+type DeleteAppointmentResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteAppointmentResponse) Reset() {
+	*x = DeleteAppointmentResponse{}
+	mi := &file_proto_schedula_v2_appointments_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteAppointmentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteAppointmentResponse) ProtoMessage() {}
+
+func (x *DeleteAppointmentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_schedula_v2_appointments_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteAppointmentResponse.ProtoReflect.Descriptor instead.
+func (*DeleteAppointmentResponse) Descriptor() ([]byte, []int) {
+	return file_proto_schedula_v2_appointments_proto_rawDescGZIP(), []int{5}
+}
+
+var File_proto_schedula_v2_appointments_proto protoreflect.FileDescriptor
+
+const file_proto_schedula_v2_appointments_proto_rawDesc = "" +
+	"\n" +
+	"$proto/schedula/v2/appointments.proto\x12\vschedula.v2\x1a\x1fgoogle/protobuf/timestamp.proto\"5\n" +
+	"\vExternalRef\x12\x16\n" +
+	"\x06system\x18\x01 \x01(\tR\x06system\x12\x0e\n" +
+	"\x02id\x18\x02 \x01(\tR\x02id\"\xf7\x06\n" +
+	"\vAppointment\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x14\n" +
+	"\x05title\x18\x03 \x01(\tR\x05title\x12\x14\n" +
+	"\x05notes\x18\x04 \x01(\tR\x05notes\x129\n" +
+	"\n" +
+	"start_time\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tstartTime\x125\n" +
+	"\bend_time\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\aendTime\x12;\n" +
+	"\vcreate_time\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"createTime\x12;\n" +
+	"\vupdate_time\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"updateTime\x12B\n" +
+	"\bmetadata\x18\t \x03(\v2&.schedula.v2.Appointment.MetadataEntryR\bmetadata\x12;\n" +
+	"\fexternal_ref\x18\n" +
+	" \x01(\v2\x18.schedula.v2.ExternalRefR\vexternalRef\x12\x1b\n" +
+	"\ttime_zone\x18\v \x01(\tR\btimeZone\x12\x1d\n" +
+	"\n" +
+	"created_by\x18\f \x01(\tR\tcreatedBy\x12>\n" +
+	"\rcheck_in_time\x18\r \x01(\v2\x1a.google.protobuf.TimestampR\vcheckInTime\x12@\n" +
+	"\x0echeck_out_time\x18\x0e \x01(\v2\x1a.google.protobuf.TimestampR\fcheckOutTime\x12\x1d\n" +
+	"\n" +
+	"contact_id\x18\x0f \x01(\tR\tcontactId\x12\x16\n" +
+	"\x06source\x18\x10 \x01(\tR\x06source\x120\n" +
+	"\x04kind\x18\x11 \x01(\x0e2\x1c.schedula.v2.AppointmentKindR\x04kind\x12#\n" +
+	"\rprivate_notes\x18\x12 \x01(\tR\fprivateNotes\x12\x1d\n" +
+	"\n" +
+	"program_id\x18\x13 \x01(\tR\tprogramId\x1a;\n" +
+	"\rMetadataEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\x9f\x02\n" +
+	"\x17ListAppointmentsRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12=\n" +
+	"\fwindow_start\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\vwindowStart\x129\n" +
+	"\n" +
+	"window_end\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\twindowEnd\x12\x1b\n" +
+	"\tpage_size\x18\x04 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x05 \x01(\tR\tpageToken\x12\x1d\n" +
+	"\n" +
+	"contact_id\x18\x06 \x01(\tR\tcontactId\x12\x16\n" +
+	"\x06source\x18\a \x01(\tR\x06source\"\x80\x01\n" +
+	"\x18ListAppointmentsResponse\x12<\n" +
+	"\fappointments\x18\x01 \x03(\v2\x18.schedula.v2.AppointmentR\fappointments\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"u\n" +
+	"\x18DeleteAppointmentRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12%\n" +
+	"\x0eappointment_id\x18\x02 \x01(\tR\rappointmentId\x12\x19\n" +
+	"\bactor_id\x18\x03 \x01(\tR\aactorId\"\x1b\n" +
+	"\x19DeleteAppointmentResponse*o\n" +
+	"\x0fAppointmentKind\x12 \n" +
+	"\x1cAPPOINTMENT_KIND_UNSPECIFIED\x10\x00\x12\x1a\n" +
+	"\x16APPOINTMENT_KIND_EVENT\x10\x01\x12\x1e\n" +
+	"\x1aAPPOINTMENT_KIND_MILESTONE\x10\x022\xda\x01\n" +
+	"\x13AppointmentsService\x12_\n" +
+	"\x10ListAppointments\x12$.schedula.v2.ListAppointmentsRequest\x1a%.schedula.v2.ListAppointmentsResponse\x12b\n" +
+	"\x11DeleteAppointment\x12%.schedula.v2.DeleteAppointmentRequest\x1a&.schedula.v2.DeleteAppointmentResponseB<Z:schedula/backend/internal/gen/proto/schedula/v2;schedulev2b\x06proto3"
+
+var (
+	file_proto_schedula_v2_appointments_proto_rawDescOnce sync.Once
+	file_proto_schedula_v2_appointments_proto_rawDescData []byte
+)
+
+func file_proto_schedula_v2_appointments_proto_rawDescGZIP() []byte {
+	file_proto_schedula_v2_appointments_proto_rawDescOnce.Do(func() {
+		file_proto_schedula_v2_appointments_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_proto_schedula_v2_appointments_proto_rawDesc), len(file_proto_schedula_v2_appointments_proto_rawDesc)))
+	})
+	return file_proto_schedula_v2_appointments_proto_rawDescData
+}
+
+var file_proto_schedula_v2_appointments_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_proto_schedula_v2_appointments_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
+var file_proto_schedula_v2_appointments_proto_goTypes = []any{
+	(AppointmentKind)(0),              // 0: schedula.v2.AppointmentKind
+	(*ExternalRef)(nil),               // 1: schedula.v2.ExternalRef
+	(*Appointment)(nil),               // 2: schedula.v2.Appointment
+	(*ListAppointmentsRequest)(nil),   // 3: schedula.v2.ListAppointmentsRequest
+	(*ListAppointmentsResponse)(nil),  // 4: schedula.v2.ListAppointmentsResponse
+	(*DeleteAppointmentRequest)(nil),  // 5: schedula.v2.DeleteAppointmentRequest
+	(*DeleteAppointmentResponse)(nil), // 6: schedula.v2.DeleteAppointmentResponse
+	nil,                               // 7: schedula.v2.Appointment.MetadataEntry
+	(*timestamppb.Timestamp)(nil),     // 8: google.protobuf.Timestamp
+}
+var file_proto_schedula_v2_appointments_proto_depIdxs = []int32{
+	8,  // 0: schedula.v2.Appointment.start_time:type_name -> google.protobuf.Timestamp
+	8,  // 1: schedula.v2.Appointment.end_time:type_name -> google.protobuf.Timestamp
+	8,  // 2: schedula.v2.Appointment.create_time:type_name -> google.protobuf.Timestamp
+	8,  // 3: schedula.v2.Appointment.update_time:type_name -> google.protobuf.Timestamp
+	7,  // 4: schedula.v2.Appointment.metadata:type_name -> schedula.v2.Appointment.MetadataEntry
+	1,  // 5: schedula.v2.Appointment.external_ref:type_name -> schedula.v2.ExternalRef
+	8,  // 6: schedula.v2.Appointment.check_in_time:type_name -> google.protobuf.Timestamp
+	8,  // 7: schedula.v2.Appointment.check_out_time:type_name -> google.protobuf.Timestamp
+	0,  // 8: schedula.v2.Appointment.kind:type_name -> schedula.v2.AppointmentKind
+	8,  // 9: schedula.v2.ListAppointmentsRequest.window_start:type_name -> google.protobuf.Timestamp
+	8,  // 10: schedula.v2.ListAppointmentsRequest.window_end:type_name -> google.protobuf.Timestamp
+	2,  // 11: schedula.v2.ListAppointmentsResponse.appointments:type_name -> schedula.v2.Appointment
+	3,  // 12: schedula.v2.AppointmentsService.ListAppointments:input_type -> schedula.v2.ListAppointmentsRequest
+	5,  // 13: schedula.v2.AppointmentsService.DeleteAppointment:input_type -> schedula.v2.DeleteAppointmentRequest
+	4,  // 14: schedula.v2.AppointmentsService.ListAppointments:output_type -> schedula.v2.ListAppointmentsResponse
+	6,  // 15: schedula.v2.AppointmentsService.DeleteAppointment:output_type -> schedula.v2.DeleteAppointmentResponse
+	14, // [14:16] is the sub-list for method output_type
+	12, // [12:14] is the sub-list for method input_type
+	12, // [12:12] is the sub-list for extension type_name
+	12, // [12:12] is the sub-list for extension extendee
+	0,  // [0:12] is the sub-list for field type_name
+}
+
+func init() { file_proto_schedula_v2_appointments_proto_init() }
+func file_proto_schedula_v2_appointments_proto_init() {
+	if File_proto_schedula_v2_appointments_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_proto_schedula_v2_appointments_proto_rawDesc), len(file_proto_schedula_v2_appointments_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   7,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_proto_schedula_v2_appointments_proto_goTypes,
+		DependencyIndexes: file_proto_schedula_v2_appointments_proto_depIdxs,
+		EnumInfos:         file_proto_schedula_v2_appointments_proto_enumTypes,
+		MessageInfos:      file_proto_schedula_v2_appointments_proto_msgTypes,
+	}.Build()
+	File_proto_schedula_v2_appointments_proto = out.File
+	file_proto_schedula_v2_appointments_proto_goTypes = nil
+	file_proto_schedula_v2_appointments_proto_depIdxs = nil
+}
@@ -0,0 +1,159 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.6.0
+// - protoc             (unknown)
+// source: proto/schedula/v2/appointments.proto
+
+package schedulev2
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	AppointmentsService_ListAppointments_FullMethodName  = "/schedula.v2.AppointmentsService/ListAppointments"
+	AppointmentsService_DeleteAppointment_FullMethodName = "/schedula.v2.AppointmentsService/DeleteAppointment"
+)
+
+// AppointmentsServiceClient is the client API for AppointmentsService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type AppointmentsServiceClient interface {
+	ListAppointments(ctx context.Context, in *ListAppointmentsRequest, opts ...grpc.CallOption) (*ListAppointmentsResponse, error)
+	DeleteAppointment(ctx context.Context, in *DeleteAppointmentRequest, opts ...grpc.CallOption) (*DeleteAppointmentResponse, error)
+}
+
+type appointmentsServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewAppointmentsServiceClient(cc grpc.ClientConnInterface) AppointmentsServiceClient {
+	return &appointmentsServiceClient{cc}
+}
+
+func (c *appointmentsServiceClient) ListAppointments(ctx context.Context, in *ListAppointmentsRequest, opts ...grpc.CallOption) (*ListAppointmentsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListAppointmentsResponse)
+	err := c.cc.Invoke(ctx, AppointmentsService_ListAppointments_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *appointmentsServiceClient) DeleteAppointment(ctx context.Context, in *DeleteAppointmentRequest, opts ...grpc.CallOption) (*DeleteAppointmentResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteAppointmentResponse)
+	err := c.cc.Invoke(ctx, AppointmentsService_DeleteAppointment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// AppointmentsServiceServer is the server API for AppointmentsService service.
+// All implementations must embed UnimplementedAppointmentsServiceServer
+// for forward compatibility.
+type AppointmentsServiceServer interface {
+	ListAppointments(context.Context, *ListAppointmentsRequest) (*ListAppointmentsResponse, error)
+	DeleteAppointment(context.Context, *DeleteAppointmentRequest) (*DeleteAppointmentResponse, error)
+	mustEmbedUnimplementedAppointmentsServiceServer()
+}
+
+// UnimplementedAppointmentsServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedAppointmentsServiceServer struct{}
+
+func (UnimplementedAppointmentsServiceServer) ListAppointments(context.Context, *ListAppointmentsRequest) (*ListAppointmentsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListAppointments not implemented")
+}
+func (UnimplementedAppointmentsServiceServer) DeleteAppointment(context.Context, *DeleteAppointmentRequest) (*DeleteAppointmentResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteAppointment not implemented")
+}
+func (UnimplementedAppointmentsServiceServer) mustEmbedUnimplementedAppointmentsServiceServer() {}
+func (UnimplementedAppointmentsServiceServer) testEmbeddedByValue()                             {}
+
+// UnsafeAppointmentsServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to AppointmentsServiceServer will
+// result in compilation errors.
+type UnsafeAppointmentsServiceServer interface {
+	mustEmbedUnimplementedAppointmentsServiceServer()
+}
+
+func RegisterAppointmentsServiceServer(s grpc.ServiceRegistrar, srv AppointmentsServiceServer) {
+	// If the following call panics, it indicates UnimplementedAppointmentsServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&AppointmentsService_ServiceDesc, srv)
+}
+
+func _AppointmentsService_ListAppointments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAppointmentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppointmentsServiceServer).ListAppointments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AppointmentsService_ListAppointments_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppointmentsServiceServer).ListAppointments(ctx, req.(*ListAppointmentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AppointmentsService_DeleteAppointment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteAppointmentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppointmentsServiceServer).DeleteAppointment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AppointmentsService_DeleteAppointment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppointmentsServiceServer).DeleteAppointment(ctx, req.(*DeleteAppointmentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// AppointmentsService_ServiceDesc is the grpc.ServiceDesc for AppointmentsService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var AppointmentsService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "schedula.v2.AppointmentsService",
+	HandlerType: (*AppointmentsServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListAppointments",
+			Handler:    _AppointmentsService_ListAppointments_Handler,
+		},
+		{
+			MethodName: "DeleteAppointment",
+			Handler:    _AppointmentsService_DeleteAppointment_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "proto/schedula/v2/appointments.proto",
+}
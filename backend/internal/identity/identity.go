@@ -0,0 +1,89 @@
+// Package identity answers what Schedula knows about the user behind a user
+// id. Calendars only ever store the id, an opaque string; a Directory is
+// where a deployment that manages its users plugs that knowledge in, so the
+// service works the same with or without an identity system behind it.
+package identity
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"schedula/backend/internal/domain"
+	"schedula/backend/internal/store"
+)
+
+// Directory modes, as named in config.
+const (
+	// ModeStandalone keeps user ids opaque: every id is an active user and
+	// nothing is provisioned. It suits single-team deployments.
+	ModeStandalone = "standalone"
+	// ModeProvisioned reads users provisioned through the admin RPCs, so an
+	// identity system can deactivate them. Unprovisioned ids stay active.
+	ModeProvisioned = "provisioned"
+)
+
+// Directory tells the service which users may write.
+type Directory interface {
+	Mode() string
+	// Active reports whether userID may write. A user the directory does
+	// not know is active, so calendars keep working for ids it has not
+	// been told about.
+	Active(ctx context.Context, userID string) (bool, error)
+	// Provisions reports whether users and groups can be provisioned into
+	// the directory. The provisioning RPCs fail when it cannot.
+	Provisions() bool
+}
+
+// UserLookup reads one provisioned user, returning store.ErrNotFound for an
+// id that was never provisioned. store.AppointmentRepository provides it.
+type UserLookup interface {
+	GetProvisionedUser(ctx context.Context, userID string) (domain.ProvisionedUser, error)
+}
+
+// Standalone is the directory of ModeStandalone. It does no I/O.
+type Standalone struct{}
+
+func (Standalone) Mode() string { return ModeStandalone }
+
+func (Standalone) Active(context.Context, string) (bool, error) { return true, nil }
+
+func (Standalone) Provisions() bool { return false }
+
+// Provisioned is the directory of ModeProvisioned.
+type Provisioned struct {
+	Users UserLookup
+}
+
+func (Provisioned) Mode() string { return ModeProvisioned }
+
+func (p Provisioned) Active(ctx context.Context, userID string) (bool, error) {
+	user, err := p.Users.GetProvisionedUser(ctx, userID)
+	if errors.Is(err, store.ErrNotFound) {
+		return true, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	return user.Active, nil
+}
+
+func (Provisioned) Provisions() bool { return true }
+
+// Modes lists the directory modes config accepts.
+func Modes() []string {
+	return []string{ModeProvisioned, ModeStandalone}
+}
+
+// New returns the directory for mode. users is only read in
+// ModeProvisioned.
+func New(mode string, users UserLookup) (Directory, error) {
+	switch mode {
+	case ModeStandalone:
+		return Standalone{}, nil
+	case ModeProvisioned:
+		return Provisioned{Users: users}, nil
+	}
+	return nil, fmt.Errorf("unknown identity mode %q (want one of %s)", mode, strings.Join(Modes(), ", "))
+}
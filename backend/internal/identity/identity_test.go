@@ -0,0 +1,73 @@
+package identity
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"schedula/backend/internal/domain"
+	"schedula/backend/internal/store"
+)
+
+type lookupFunc func(ctx context.Context, userID string) (domain.ProvisionedUser, error)
+
+func (f lookupFunc) GetProvisionedUser(ctx context.Context, userID string) (domain.ProvisionedUser, error) {
+	return f(ctx, userID)
+}
+
+func TestProvisioned_Active(t *testing.T) {
+	boom := errors.New("boom")
+	dir, err := New(ModeProvisioned, lookupFunc(func(ctx context.Context, userID string) (domain.ProvisionedUser, error) {
+		switch userID {
+		case "frozen":
+			return domain.ProvisionedUser{UserID: userID}, nil
+		case "active":
+			return domain.ProvisionedUser{UserID: userID, Active: true}, nil
+		case "broken":
+			return domain.ProvisionedUser{}, boom
+		}
+		return domain.ProvisionedUser{}, store.ErrNotFound
+	}))
+	if err != nil {
+		t.Fatalf("New error: %v", err)
+	}
+	if !dir.Provisions() {
+		t.Fatalf("provisioned directory does not provision")
+	}
+
+	for _, tc := range []struct {
+		userID string
+		want   bool
+	}{
+		{"active", true},
+		{"frozen", false},
+		{"unknown", true},
+	} {
+		got, err := dir.Active(context.Background(), tc.userID)
+		if err != nil || got != tc.want {
+			t.Fatalf("Active(%q) = %v, %v; want %v", tc.userID, got, err, tc.want)
+		}
+	}
+	if _, err := dir.Active(context.Background(), "broken"); !errors.Is(err, boom) {
+		t.Fatalf("Active(broken) error = %v, want the lookup error", err)
+	}
+}
+
+func TestStandalone_NeverLooksUp(t *testing.T) {
+	dir, err := New(ModeStandalone, lookupFunc(func(ctx context.Context, userID string) (domain.ProvisionedUser, error) {
+		t.Fatalf("standalone directory looked up %q", userID)
+		return domain.ProvisionedUser{}, nil
+	}))
+	if err != nil {
+		t.Fatalf("New error: %v", err)
+	}
+	if active, err := dir.Active(context.Background(), "anyone"); !active || err != nil {
+		t.Fatalf("Active = %v, %v; want true", active, err)
+	}
+	if dir.Provisions() {
+		t.Fatalf("standalone directory provisions")
+	}
+	if _, err := New("ldap", nil); err == nil {
+		t.Fatalf("New(ldap) succeeded, want an unknown mode error")
+	}
+}
@@ -0,0 +1,137 @@
+// Package lifecycle runs the server's listeners and background jobs as one
+// unit: it starts them together, stops on a signal or the first failure, and
+// shuts listeners down in order before the jobs.
+package lifecycle
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"sync"
+	"time"
+)
+
+type server struct {
+	name  string
+	serve func() error
+	stop  func(ctx context.Context) error
+}
+
+type job struct {
+	name string
+	run  func(ctx context.Context) error
+}
+
+// Group collects listeners and jobs to run together. Add everything before
+// calling Run.
+type Group struct {
+	log     *slog.Logger
+	servers []server
+	jobs    []job
+}
+
+func NewGroup(log *slog.Logger) *Group {
+	return &Group{log: log}
+}
+
+// AddServer registers a listener. serve blocks until the listener stops;
+// stop shuts it down gracefully, giving up when its context is done.
+// Listeners are stopped in the reverse of the order they were added, so a
+// front door added last closes first.
+func (g *Group) AddServer(name string, serve func() error, stop func(ctx context.Context) error) {
+	g.servers = append(g.servers, server{name: name, serve: serve, stop: stop})
+}
+
+// AddJob registers a background job. run must return once its context is
+// cancelled, which happens after every listener has stopped. An error it
+// returns before then stops the group; returning nil early does not.
+func (g *Group) AddJob(name string, run func(ctx context.Context) error) {
+	g.jobs = append(g.jobs, job{name: name, run: run})
+}
+
+type failure struct {
+	name string
+	err  error
+}
+
+// Run starts every listener and job and blocks until ctx is done or one of
+// them fails. It then stops the listeners in order, cancels the jobs and
+// waits for all of them, within shutdownTimeout overall. It returns the first
+// failure, or nil when the group was stopped through ctx.
+func (g *Group) Run(ctx context.Context, shutdownTimeout time.Duration) error {
+	jobCtx, cancelJobs := context.WithCancel(context.Background())
+	defer cancelJobs()
+
+	var (
+		wg       sync.WaitGroup
+		mu       sync.Mutex
+		stopping bool
+	)
+	failures := make(chan failure, len(g.servers)+len(g.jobs))
+	report := func(name string, err error) {
+		mu.Lock()
+		defer mu.Unlock()
+		if !stopping {
+			failures <- failure{name: name, err: err}
+		}
+	}
+
+	for _, s := range g.servers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			err := s.serve()
+			if err == nil {
+				err = errors.New("stopped unexpectedly")
+			}
+			report(s.name, err)
+		}()
+	}
+	for _, j := range g.jobs {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if err := j.run(jobCtx); err != nil {
+				report(j.name, err)
+			}
+		}()
+	}
+
+	var first error
+	select {
+	case <-ctx.Done():
+		g.log.Info("shutdown signal received")
+	case f := <-failures:
+		first = fmt.Errorf("%s: %w", f.name, f.err)
+		g.log.Error("component failed; shutting down", slog.String("component", f.name), slog.Any("err", f.err))
+	}
+	mu.Lock()
+	stopping = true
+	mu.Unlock()
+
+	stopCtx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
+	defer cancel()
+	for i := len(g.servers) - 1; i >= 0; i-- {
+		s := g.servers[i]
+		g.log.Info("stopping", slog.String("component", s.name))
+		if err := s.stop(stopCtx); err != nil {
+			g.log.Warn("graceful stop failed", slog.String("component", s.name), slog.Any("err", err))
+			continue
+		}
+		g.log.Info("stopped", slog.String("component", s.name))
+	}
+	cancelJobs()
+
+	done := make(chan struct{})
+	go func() {
+		wg.Wait()
+		close(done)
+	}()
+	select {
+	case <-done:
+	case <-stopCtx.Done():
+		g.log.Warn("shutdown timed out waiting for components to exit")
+	}
+	return first
+}
@@ -0,0 +1,88 @@
+package lifecycle
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"slices"
+	"sync"
+	"testing"
+	"time"
+)
+
+// fakeServer blocks in serve until stopped or failed.
+type fakeServer struct {
+	name  string
+	done  chan error
+	order *[]string
+	mu    *sync.Mutex
+}
+
+func newFakeServer(name string, order *[]string, mu *sync.Mutex) *fakeServer {
+	return &fakeServer{name: name, done: make(chan error, 1), order: order, mu: mu}
+}
+
+func (s *fakeServer) serve() error { return <-s.done }
+
+func (s *fakeServer) stop(ctx context.Context) error {
+	s.mu.Lock()
+	*s.order = append(*s.order, s.name)
+	s.mu.Unlock()
+	select {
+	case s.done <- nil:
+	default:
+	}
+	return nil
+}
+
+func TestGroupRun_FirstFailureStopsEverythingInOrder(t *testing.T) {
+	var (
+		mu    sync.Mutex
+		order []string
+	)
+	grpcSrv := newFakeServer("grpc", &order, &mu)
+	httpSrv := newFakeServer("http", &order, &mu)
+	jobStopped := make(chan struct{})
+
+	g := NewGroup(slog.Default())
+	g.AddServer("grpc", grpcSrv.serve, grpcSrv.stop)
+	g.AddServer("http", httpSrv.serve, httpSrv.stop)
+	g.AddJob("sweeper", func(ctx context.Context) error {
+		<-ctx.Done()
+		mu.Lock()
+		order = append(order, "sweeper")
+		mu.Unlock()
+		close(jobStopped)
+		return nil
+	})
+
+	bindErr := errors.New("address already in use")
+	httpSrv.done <- bindErr
+	err := g.Run(context.Background(), time.Second)
+	if !errors.Is(err, bindErr) {
+		t.Fatalf("Run error = %v, want %v", err, bindErr)
+	}
+	<-jobStopped
+	if want := []string{"http", "grpc", "sweeper"}; !slices.Equal(order, want) {
+		t.Fatalf("stop order = %v, want %v", order, want)
+	}
+}
+
+func TestGroupRun_ContextDoneIsClean(t *testing.T) {
+	var mu sync.Mutex
+	var order []string
+	srv := newFakeServer("grpc", &order, &mu)
+
+	g := NewGroup(slog.Default())
+	g.AddServer("grpc", srv.serve, srv.stop)
+	g.AddJob("pool-stats", func(ctx context.Context) error { return nil })
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if err := g.Run(ctx, time.Second); err != nil {
+		t.Fatalf("Run error = %v, want nil", err)
+	}
+	if !slices.Equal(order, []string{"grpc"}) {
+		t.Fatalf("stop order = %v, want [grpc]", order)
+	}
+}
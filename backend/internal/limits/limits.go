@@ -0,0 +1,70 @@
+// Package limits holds the request size limits enforced by the server.
+package limits
+
+import "context"
+
+// Limits bounds request payloads. Zero values disable the corresponding check.
+type Limits struct {
+	MaxMessageBytes     int
+	MaxTitleLength      int
+	MaxNotesLength      int
+	MaxWeekdays         int
+	MaxParticipantIDLen int
+	MaxMetadataEntries  int
+	MaxMetadataKeyLen   int
+	MaxMetadataValueLen int
+	// MaxActiveSeries and MaxFutureOccurrences cap a user's recurring series
+	// that still have occurrences ahead, and those occurrences in total.
+	// They are checked when a series is created.
+	MaxActiveSeries      int
+	MaxFutureOccurrences int
+}
+
+func Default() Limits {
+	return Limits{
+		MaxMessageBytes:      1 << 20,
+		MaxTitleLength:       200,
+		MaxNotesLength:       5000,
+		MaxWeekdays:          7,
+		MaxParticipantIDLen:  256,
+		MaxMetadataEntries:   32,
+		MaxMetadataKeyLen:    64,
+		MaxMetadataValueLen:  1024,
+		MaxActiveSeries:      200,
+		MaxFutureOccurrences: 10000,
+	}
+}
+
+// Scale multiplies the per-field limits by n, for users granted more room.
+// MaxMessageBytes is left alone: the transport enforces it before the user
+// is known. MaxWeekdays is left alone as there are only seven.
+func (l Limits) Scale(n int) Limits {
+	if n <= 1 {
+		return l
+	}
+	l.MaxTitleLength *= n
+	l.MaxNotesLength *= n
+	l.MaxParticipantIDLen *= n
+	l.MaxMetadataEntries *= n
+	l.MaxMetadataKeyLen *= n
+	l.MaxMetadataValueLen *= n
+	l.MaxActiveSeries *= n
+	l.MaxFutureOccurrences *= n
+	return l
+}
+
+type contextKey struct{}
+
+// NewContext returns ctx carrying limits that replace the server's for the
+// request, such as a user's scaled limits.
+func NewContext(ctx context.Context, l Limits) context.Context {
+	return context.WithValue(ctx, contextKey{}, l)
+}
+
+// FromContext returns the limits carried by ctx, or fallback.
+func FromContext(ctx context.Context, fallback Limits) Limits {
+	if l, ok := ctx.Value(contextKey{}).(Limits); ok {
+		return l
+	}
+	return fallback
+}
@@ -0,0 +1,262 @@
+// Package lockwait times waits for the per-user calendar lock, so operators
+// can spot hot calendars before their writes start timing out. Like sli and
+// usage, it is held in memory and covers this process only.
+package lockwait
+
+import (
+	"fmt"
+	"io"
+	"slices"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	DefaultWindow    = 15 * time.Minute
+	DefaultBucket    = time.Minute
+	DefaultMaxUsers  = 10000
+	DefaultContended = 10 * time.Millisecond
+)
+
+// MetricsTopUsers is how many of the most contended calendars WriteMetrics
+// labels by user id. Labelling every user would give the scrape unbounded
+// cardinality.
+const MetricsTopUsers = 10
+
+// OverflowUser collects waits from users that arrive once MaxUsers users are
+// already being tracked.
+const OverflowUser = "_other"
+
+// WaitBounds are the upper bounds of the lock wait histogram.
+var WaitBounds = [...]time.Duration{
+	time.Millisecond,
+	5 * time.Millisecond,
+	10 * time.Millisecond,
+	25 * time.Millisecond,
+	50 * time.Millisecond,
+	100 * time.Millisecond,
+	250 * time.Millisecond,
+	500 * time.Millisecond,
+	time.Second,
+	2500 * time.Millisecond,
+	5 * time.Second,
+}
+
+// UserContention is one calendar's lock waits in the window. Contended
+// counts the waits of at least the tracker's contended threshold.
+type UserContention struct {
+	UserID       string
+	Acquisitions int64
+	Contended    int64
+	TotalWait    time.Duration
+	LongestWait  time.Duration
+}
+
+type counts struct {
+	acquisitions int64
+	contended    int64
+	total        time.Duration
+	longest      time.Duration
+}
+
+type bucket struct {
+	index int64
+	counts
+}
+
+type userBuckets struct {
+	ring []bucket
+	last int64
+}
+
+// Tracker is safe for concurrent use.
+type Tracker struct {
+	mu        sync.Mutex
+	bucket    time.Duration
+	buckets   int
+	maxUsers  int
+	contended time.Duration
+	now       func() time.Time
+	users     map[string]*userBuckets
+	lastSweep int64
+
+	waits     [len(WaitBounds) + 1]int64
+	waitSum   time.Duration
+	waitCount int64
+	contCount int64
+}
+
+// New returns a Tracker whose window is rounded up to whole buckets. A wait
+// of at least contended counts as contention. Zero values take the defaults.
+func New(window, bucketSize time.Duration, maxUsers int, contended time.Duration) *Tracker {
+	if window <= 0 {
+		window = DefaultWindow
+	}
+	if bucketSize <= 0 {
+		bucketSize = DefaultBucket
+	}
+	if maxUsers <= 0 {
+		maxUsers = DefaultMaxUsers
+	}
+	if contended <= 0 {
+		contended = DefaultContended
+	}
+	n := int((window + bucketSize - 1) / bucketSize)
+	return &Tracker{
+		bucket:    bucketSize,
+		buckets:   n,
+		maxUsers:  maxUsers,
+		contended: contended,
+		now:       time.Now,
+		users:     make(map[string]*userBuckets),
+	}
+}
+
+func (t *Tracker) Window() time.Duration {
+	return time.Duration(t.buckets) * t.bucket
+}
+
+// Record counts one acquisition of userID's calendar lock after waiting for
+// wait.
+func (t *Tracker) Record(userID string, wait time.Duration) {
+	one := counts{acquisitions: 1, total: wait, longest: wait}
+	if wait >= t.contended {
+		one.contended = 1
+	}
+	i, _ := slices.BinarySearch(WaitBounds[:], wait)
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.waits[i]++
+	t.waitSum += wait
+	t.waitCount++
+	t.contCount += one.contended
+
+	idx := t.now().UnixNano() / int64(t.bucket)
+	u, ok := t.users[userID]
+	if !ok {
+		if len(t.users) >= t.maxUsers {
+			t.sweep(idx)
+		}
+		if len(t.users) >= t.maxUsers {
+			userID = OverflowUser
+			u = t.users[OverflowUser]
+		}
+		if u == nil {
+			u = &userBuckets{ring: make([]bucket, t.buckets)}
+			t.users[userID] = u
+		}
+	}
+
+	b := &u.ring[idx%int64(t.buckets)]
+	if b.index != idx {
+		*b = bucket{index: idx}
+	}
+	b.add(one)
+	u.last = idx
+}
+
+func (c *counts) add(o counts) {
+	c.acquisitions += o.acquisitions
+	c.contended += o.contended
+	c.total += o.total
+	c.longest = max(c.longest, o.longest)
+}
+
+// sweep drops users with nothing left in the window. It runs at most once
+// per bucket, since a full tracker would otherwise scan on every new id.
+func (t *Tracker) sweep(idx int64) {
+	if t.lastSweep == idx {
+		return
+	}
+	t.lastSweep = idx
+	for id, u := range t.users {
+		if u.last <= idx-int64(t.buckets) {
+			delete(t.users, id)
+		}
+	}
+}
+
+// Top returns up to n calendars with contended waits in the window, most
+// total wait first. n <= 0 returns them all.
+func (t *Tracker) Top(n int) []UserContention {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	idx := t.now().UnixNano() / int64(t.bucket)
+	var out []UserContention
+	for id, u := range t.users {
+		var sum counts
+		for _, b := range u.ring {
+			if b.index <= idx-int64(t.buckets) || b.index > idx {
+				continue
+			}
+			sum.add(b.counts)
+		}
+		if sum.contended == 0 {
+			continue
+		}
+		out = append(out, UserContention{
+			UserID:       id,
+			Acquisitions: sum.acquisitions,
+			Contended:    sum.contended,
+			TotalWait:    sum.total,
+			LongestWait:  sum.longest,
+		})
+	}
+	sort.Slice(out, func(i, j int) bool {
+		if out[i].TotalWait != out[j].TotalWait {
+			return out[i].TotalWait > out[j].TotalWait
+		}
+		return out[i].UserID < out[j].UserID
+	})
+	if n > 0 && len(out) > n {
+		out = out[:n]
+	}
+	return out
+}
+
+// WriteMetrics writes the lock wait histogram and contention counter since
+// the process started, and the MetricsTopUsers most contended calendars in
+// the window, in the Prometheus text exposition format.
+func (t *Tracker) WriteMetrics(w io.Writer) error {
+	top := t.Top(MetricsTopUsers)
+
+	t.mu.Lock()
+	waits, sum, count, contended := t.waits, t.waitSum, t.waitCount, t.contCount
+	t.mu.Unlock()
+
+	var sb strings.Builder
+	sb.WriteString("# HELP schedula_calendar_lock_wait_seconds Time spent waiting for a user's calendar lock.\n")
+	sb.WriteString("# TYPE schedula_calendar_lock_wait_seconds histogram\n")
+	var cum int64
+	for i, bound := range WaitBounds {
+		cum += waits[i]
+		fmt.Fprintf(&sb, "schedula_calendar_lock_wait_seconds_bucket{le=%q} %d\n", formatSeconds(bound), cum)
+	}
+	fmt.Fprintf(&sb, "schedula_calendar_lock_wait_seconds_bucket{le=\"+Inf\"} %d\n", count)
+	fmt.Fprintf(&sb, "schedula_calendar_lock_wait_seconds_sum %s\n", formatSeconds(sum))
+	fmt.Fprintf(&sb, "schedula_calendar_lock_wait_seconds_count %d\n", count)
+	sb.WriteString("# HELP schedula_calendar_lock_contended_total Calendar lock acquisitions that had to wait.\n")
+	sb.WriteString("# TYPE schedula_calendar_lock_contended_total counter\n")
+	fmt.Fprintf(&sb, "schedula_calendar_lock_contended_total %d\n", contended)
+	sb.WriteString("# HELP schedula_calendar_lock_hot_wait_seconds Total lock wait of the most contended calendars over the recent window, by user.\n")
+	sb.WriteString("# TYPE schedula_calendar_lock_hot_wait_seconds gauge\n")
+	for _, u := range top {
+		fmt.Fprintf(&sb, "schedula_calendar_lock_hot_wait_seconds{user_id=%q} %s\n", u.UserID, formatSeconds(u.TotalWait))
+	}
+	sb.WriteString("# HELP schedula_calendar_lock_hot_contended Contended lock acquisitions of the most contended calendars over the recent window, by user.\n")
+	sb.WriteString("# TYPE schedula_calendar_lock_hot_contended gauge\n")
+	for _, u := range top {
+		fmt.Fprintf(&sb, "schedula_calendar_lock_hot_contended{user_id=%q} %d\n", u.UserID, u.Contended)
+	}
+	_, err := io.WriteString(w, sb.String())
+	return err
+}
+
+func formatSeconds(d time.Duration) string {
+	return fmt.Sprintf("%g", d.Seconds())
+}
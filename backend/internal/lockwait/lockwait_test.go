@@ -0,0 +1,69 @@
+package lockwait
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestTracker_ReportsHotCalendars(t *testing.T) {
+	now := time.Date(2030, 1, 7, 9, 0, 0, 0, time.UTC)
+	tr := New(15*time.Minute, time.Minute, 0, 10*time.Millisecond)
+	tr.now = func() time.Time { return now }
+
+	tr.Record("old", 2*time.Second)
+	now = now.Add(20 * time.Minute)
+	for range 40 {
+		tr.Record("hot", 300*time.Millisecond)
+	}
+	tr.Record("warm", 50*time.Millisecond)
+	tr.Record("warm", 2*time.Millisecond)
+	tr.Record("quiet", time.Millisecond)
+
+	top := tr.Top(0)
+	if len(top) != 2 || top[0].UserID != "hot" || top[1].UserID != "warm" {
+		t.Fatalf("top = %+v, want hot then warm; old has left the window and quiet never waited", top)
+	}
+	if top[0].Contended != 40 || top[0].TotalWait != 12*time.Second || top[0].LongestWait != 300*time.Millisecond {
+		t.Fatalf("hot = %+v", top[0])
+	}
+	if top[1].Acquisitions != 2 || top[1].Contended != 1 {
+		t.Fatalf("warm = %+v, want 2 acquisitions with 1 contended", top[1])
+	}
+	if got := tr.Top(1); len(got) != 1 || got[0].UserID != "hot" {
+		t.Fatalf("Top(1) = %+v", got)
+	}
+
+	var sb strings.Builder
+	if err := tr.WriteMetrics(&sb); err != nil {
+		t.Fatalf("WriteMetrics error: %v", err)
+	}
+	for _, want := range []string{
+		`schedula_calendar_lock_wait_seconds_bucket{le="0.001"} 1`,
+		`schedula_calendar_lock_wait_seconds_bucket{le="0.25"} 3`,
+		`schedula_calendar_lock_wait_seconds_bucket{le="0.5"} 43`,
+		`schedula_calendar_lock_wait_seconds_count 44`,
+		`schedula_calendar_lock_contended_total 42`,
+		`schedula_calendar_lock_hot_wait_seconds{user_id="hot"} 12`,
+		`schedula_calendar_lock_hot_contended{user_id="warm"} 1`,
+	} {
+		if !strings.Contains(sb.String(), want) {
+			t.Fatalf("metrics missing %q:\n%s", want, sb.String())
+		}
+	}
+	if strings.Contains(sb.String(), `user_id="old"`) {
+		t.Fatalf("metrics label a calendar outside the window:\n%s", sb.String())
+	}
+}
+
+func TestTracker_OverflowsPastMaxUsers(t *testing.T) {
+	tr := New(time.Minute, time.Minute, 1, time.Millisecond)
+	tr.Record("a", time.Second)
+	tr.Record("b", time.Second)
+	tr.Record("c", time.Second)
+
+	top := tr.Top(0)
+	if len(top) != 2 || top[0].UserID != OverflowUser || top[0].Contended != 2 || top[1].UserID != "a" {
+		t.Fatalf("top = %+v, want b and c folded into %s", top, OverflowUser)
+	}
+}
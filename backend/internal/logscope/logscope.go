@@ -0,0 +1,223 @@
+// Package logscope raises log verbosity for single users or requests while
+// the rest of the process logs at its configured level. Targets are held in
+// memory and expire on their own, so a forgotten one cannot leave debug
+// logging on.
+package logscope
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"log/slog"
+	"slices"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// Kind names the log attribute a target matches.
+type Kind string
+
+const (
+	KindUser    Kind = "user_id"
+	KindRequest Kind = "request_id"
+)
+
+const (
+	DefaultTTL = 15 * time.Minute
+	MaxTTL     = 24 * time.Hour
+	MaxTargets = 20
+)
+
+var ErrTooManyTargets = errors.New("too many log targets")
+
+type Target struct {
+	Kind      Kind
+	ID        string
+	ExpiresAt time.Time
+}
+
+type targetKey struct {
+	kind Kind
+	id   string
+}
+
+// Targets is safe for concurrent use.
+type Targets struct {
+	mu     sync.RWMutex
+	now    func() time.Time
+	active map[targetKey]time.Time
+	// count mirrors len(active) so handlers can skip the lock when nothing
+	// is targeted, which is nearly always.
+	count atomic.Int32
+}
+
+func NewTargets() *Targets {
+	return &Targets{now: time.Now, active: make(map[targetKey]time.Time)}
+}
+
+// Set targets id for ttl, replacing any earlier expiry. A zero ttl takes
+// DefaultTTL; longer than MaxTTL is capped.
+func (t *Targets) Set(kind Kind, id string, ttl time.Duration) (Target, error) {
+	if ttl <= 0 {
+		ttl = DefaultTTL
+	}
+	ttl = min(ttl, MaxTTL)
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	now := t.now()
+	t.expire(now)
+	k := targetKey{kind, id}
+	if _, ok := t.active[k]; !ok && len(t.active) >= MaxTargets {
+		return Target{}, ErrTooManyTargets
+	}
+	t.active[k] = now.Add(ttl)
+	t.count.Store(int32(len(t.active)))
+	return Target{Kind: kind, ID: id, ExpiresAt: now.Add(ttl)}, nil
+}
+
+// Clear removes a target and reports whether it was active.
+func (t *Targets) Clear(kind Kind, id string) bool {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.expire(t.now())
+	k := targetKey{kind, id}
+	_, ok := t.active[k]
+	delete(t.active, k)
+	t.count.Store(int32(len(t.active)))
+	return ok
+}
+
+// List returns the active targets by kind and id.
+func (t *Targets) List() []Target {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.expire(t.now())
+	out := make([]Target, 0, len(t.active))
+	for k, exp := range t.active {
+		out = append(out, Target{Kind: k.kind, ID: k.id, ExpiresAt: exp})
+	}
+	slices.SortFunc(out, func(a, b Target) int {
+		return cmp.Or(cmp.Compare(a.Kind, b.Kind), cmp.Compare(a.ID, b.ID))
+	})
+	return out
+}
+
+func (t *Targets) expire(now time.Time) {
+	for k, exp := range t.active {
+		if !exp.After(now) {
+			delete(t.active, k)
+		}
+	}
+	t.count.Store(int32(len(t.active)))
+}
+
+func (t *Targets) any() bool {
+	return t.count.Load() > 0
+}
+
+func (t *Targets) match(kind Kind, id string) bool {
+	if id == "" {
+		return false
+	}
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	exp, ok := t.active[targetKey{kind, id}]
+	return ok && exp.After(t.now())
+}
+
+type scopeKey struct{}
+
+type scope struct {
+	userID    string
+	requestID string
+}
+
+// WithScope returns ctx marked with the user and request it serves, so
+// records logged with it match targets without repeating the attributes.
+func WithScope(ctx context.Context, userID, requestID string) context.Context {
+	return context.WithValue(ctx, scopeKey{}, scope{userID: userID, requestID: requestID})
+}
+
+// Handler passes records at level and above to next, and records below it
+// only when they belong to a target: by a user_id or request_id attribute
+// outside any group, or by the scope of the context they were logged with.
+// next should enable every level, since Handler does the filtering.
+type Handler struct {
+	next    slog.Handler
+	level   slog.Leveler
+	targets *Targets
+	// attrs are the user_id and request_id attributes added with WithAttrs.
+	attrs   []slog.Attr
+	grouped bool
+}
+
+func NewHandler(next slog.Handler, level slog.Leveler, targets *Targets) *Handler {
+	return &Handler{next: next, level: level, targets: targets}
+}
+
+func (h *Handler) Enabled(ctx context.Context, level slog.Level) bool {
+	if level < h.level.Level() && !h.targets.any() {
+		return false
+	}
+	return h.next.Enabled(ctx, level)
+}
+
+func (h *Handler) Handle(ctx context.Context, r slog.Record) error {
+	if r.Level < h.level.Level() && !h.targeted(ctx, r) {
+		return nil
+	}
+	return h.next.Handle(ctx, r)
+}
+
+func (h *Handler) targeted(ctx context.Context, r slog.Record) bool {
+	if sc, ok := ctx.Value(scopeKey{}).(scope); ok {
+		if h.targets.match(KindUser, sc.userID) || h.targets.match(KindRequest, sc.requestID) {
+			return true
+		}
+	}
+	for _, a := range h.attrs {
+		if h.matchAttr(a) {
+			return true
+		}
+	}
+	if h.grouped {
+		return false
+	}
+	found := false
+	r.Attrs(func(a slog.Attr) bool {
+		found = h.matchAttr(a)
+		return !found
+	})
+	return found
+}
+
+func (h *Handler) matchAttr(a slog.Attr) bool {
+	switch Kind(a.Key) {
+	case KindUser, KindRequest:
+		return h.targets.match(Kind(a.Key), a.Value.String())
+	}
+	return false
+}
+
+func (h *Handler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	out := *h
+	out.next = h.next.WithAttrs(attrs)
+	if !h.grouped {
+		out.attrs = slices.Clone(h.attrs)
+		for _, a := range attrs {
+			if a.Key == string(KindUser) || a.Key == string(KindRequest) {
+				out.attrs = append(out.attrs, a)
+			}
+		}
+	}
+	return &out
+}
+
+func (h *Handler) WithGroup(name string) slog.Handler {
+	out := *h
+	out.next = h.next.WithGroup(name)
+	out.grouped = true
+	return &out
+}
@@ -0,0 +1,64 @@
+package logscope
+
+import (
+	"bytes"
+	"context"
+	"log/slog"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestHandler_LogsDebugOnlyForTargets(t *testing.T) {
+	now := time.Date(2030, 1, 7, 9, 0, 0, 0, time.UTC)
+	targets := NewTargets()
+	targets.now = func() time.Time { return now }
+	var buf bytes.Buffer
+	log := slog.New(NewHandler(slog.NewTextHandler(&buf, &slog.HandlerOptions{Level: slog.LevelDebug}), slog.LevelInfo, targets))
+
+	log.Debug("untargeted", slog.String("user_id", "u1"))
+	if _, err := targets.Set(KindUser, "u1", 10*time.Minute); err != nil {
+		t.Fatalf("Set error: %v", err)
+	}
+	if _, err := targets.Set(KindRequest, "r9", 0); err != nil {
+		t.Fatalf("Set error: %v", err)
+	}
+	log.Debug("by attr", slog.String("user_id", "u1"))
+	log.With(slog.String("user_id", "u1")).Debug("by with")
+	log.DebugContext(WithScope(context.Background(), "", "r9"), "by scope")
+	log.Debug("other user", slog.String("user_id", "u2"))
+	log.WithGroup("req").Debug("grouped", slog.String("user_id", "u1"))
+	log.Info("info", slog.String("user_id", "u2"))
+
+	// Ten minutes on, the user target has expired but the request target,
+	// on the default fifteen minutes, has not.
+	now = now.Add(10 * time.Minute)
+	log.Debug("expired", slog.String("user_id", "u1"))
+	if got := targets.List(); len(got) != 1 || got[0].Kind != KindRequest || !got[0].ExpiresAt.Equal(now.Add(5*time.Minute)) {
+		t.Fatalf("targets = %+v, want only r9", got)
+	}
+
+	out := buf.String()
+	for _, want := range []string{"msg=\"by attr\"", "msg=\"by with\"", "msg=\"by scope\"", "msg=info"} {
+		if !strings.Contains(out, want) {
+			t.Fatalf("log missing %s:\n%s", want, out)
+		}
+	}
+	for _, unwanted := range []string{"untargeted", "other user", "grouped", "expired"} {
+		if strings.Contains(out, unwanted) {
+			t.Fatalf("log has %s:\n%s", unwanted, out)
+		}
+	}
+
+	if !targets.Clear(KindRequest, "r9") || targets.Clear(KindRequest, "r9") {
+		t.Fatalf("Clear should report the target once")
+	}
+	for i := range MaxTargets {
+		if _, err := targets.Set(KindUser, string(rune('a'+i)), 0); err != nil {
+			t.Fatalf("Set %d error: %v", i, err)
+		}
+	}
+	if _, err := targets.Set(KindUser, "one-more", 0); err != ErrTooManyTargets {
+		t.Fatalf("error = %v, want ErrTooManyTargets", err)
+	}
+}
@@ -0,0 +1,222 @@
+// Package scheduling ranks candidate meeting slots for a group of attendees.
+// It works on busy intervals the caller has already loaded and does no I/O.
+package scheduling
+
+import (
+	"sort"
+	"time"
+
+	"schedula/backend/internal/domain"
+)
+
+// Scoring weights. A slot outside someone's working hours costs more than an
+// hour of extra load, and a preferred slot earns a little back.
+const (
+	outsideHoursPenalty = 3.0
+	loadPenaltyPerHour  = 0.25
+	preferredBonus      = 1.0
+)
+
+// WorkingHours is a weekly local-time availability pattern. StartMinute and
+// EndMinute count minutes from local midnight; Weekdays uses time.Weekday.
+type WorkingHours struct {
+	Location    *time.Location
+	StartMinute int
+	EndMinute   int
+	Weekdays    []time.Weekday
+}
+
+// Attendee is one participant's calendar state and preferences. A nil
+// WorkingHours means any time is acceptable.
+type Attendee struct {
+	ID           string
+	Busy         []domain.BusyInterval
+	WorkingHours *WorkingHours
+	Preferred    []domain.BusyInterval
+}
+
+type Constraints struct {
+	WindowStart time.Time
+	WindowEnd   time.Time
+	Duration    time.Duration
+	Step        time.Duration
+	MaxResults  int
+	// Location anchors the Step grid to local midnight, so a 30 minute step
+	// lands on :00 and :30 local time even in zones offset by 45 minutes.
+	// Nil anchors it in UTC.
+	Location *time.Location
+}
+
+// firstStart is the first grid point at or after WindowStart.
+func (c Constraints) firstStart() time.Time {
+	start := c.WindowStart.UTC().Truncate(c.Step)
+	if c.Location != nil {
+		local := c.WindowStart.In(c.Location)
+		start = time.Date(local.Year(), local.Month(), local.Day(), 0, 0, 0, 0, c.Location).UTC()
+	}
+	for start.Before(c.WindowStart) {
+		start = start.Add(c.Step)
+	}
+	return start
+}
+
+// Suggestion is a free slot for every attendee. OutsideHours lists attendees
+// for whom the slot falls outside their working hours.
+type Suggestion struct {
+	Start        time.Time
+	End          time.Time
+	Score        float64
+	OutsideHours []string
+}
+
+// Suggest walks the window in Step increments, drops slots where any attendee
+// is busy, and returns the best MaxResults by score, earliest first on ties.
+func Suggest(attendees []Attendee, c Constraints) []Suggestion {
+	if c.Duration <= 0 || c.Step <= 0 || c.MaxResults <= 0 {
+		return nil
+	}
+
+	busy := make([][]domain.BusyInterval, len(attendees))
+	for i, a := range attendees {
+		busy[i] = domain.MergeBusy(a.Busy)
+	}
+
+	var out []Suggestion
+	for start := c.firstStart(); !start.Add(c.Duration).After(c.WindowEnd); start = start.Add(c.Step) {
+		end := start.Add(c.Duration)
+		s, ok := score(attendees, busy, start, end)
+		if ok {
+			out = append(out, s)
+		}
+	}
+
+	sort.SliceStable(out, func(i, j int) bool { return out[i].Score > out[j].Score })
+	if len(out) > c.MaxResults {
+		out = out[:c.MaxResults]
+	}
+	return out
+}
+
+// FreeSlots walks the window in Step increments and returns up to MaxResults
+// slots, earliest first, where the attendee is free and inside their working
+// hours. Unlike Suggest it never offers out-of-hours time, since it publishes
+// availability rather than ranking options for an organizer.
+func FreeSlots(a Attendee, c Constraints) []Suggestion {
+	if c.Duration <= 0 || c.Step <= 0 || c.MaxResults <= 0 {
+		return nil
+	}
+
+	busy := domain.MergeBusy(a.Busy)
+	var out []Suggestion
+	for start := c.firstStart(); !start.Add(c.Duration).After(c.WindowEnd) && len(out) < c.MaxResults; start = start.Add(c.Step) {
+		end := start.Add(c.Duration)
+		if overlapsAny(busy, start, end) {
+			continue
+		}
+		if a.WorkingHours != nil && !a.WorkingHours.contains(start, end) {
+			continue
+		}
+		out = append(out, Suggestion{Start: start, End: end})
+	}
+	return out
+}
+
+func score(attendees []Attendee, busy [][]domain.BusyInterval, start, end time.Time) (Suggestion, bool) {
+	s := Suggestion{Start: start, End: end}
+	for i, a := range attendees {
+		if overlapsAny(busy[i], start, end) {
+			return Suggestion{}, false
+		}
+		if a.WorkingHours != nil && !a.WorkingHours.contains(start, end) {
+			s.Score -= outsideHoursPenalty
+			s.OutsideHours = append(s.OutsideHours, a.ID)
+		}
+		s.Score -= loadPenaltyPerHour * dayLoad(busy[i], start, a.location()).Hours()
+		if overlapsAny(a.Preferred, start, end) {
+			s.Score += preferredBonus
+		}
+	}
+	return s, true
+}
+
+func (a Attendee) location() *time.Location {
+	if a.WorkingHours != nil && a.WorkingHours.Location != nil {
+		return a.WorkingHours.Location
+	}
+	return time.UTC
+}
+
+// contains reports whether [start, end) sits inside one working-hours block.
+func (w *WorkingHours) contains(start, end time.Time) bool {
+	loc := w.Location
+	if loc == nil {
+		loc = time.UTC
+	}
+	local := start.In(loc)
+	if len(w.Weekdays) > 0 {
+		found := false
+		for _, wd := range w.Weekdays {
+			if wd == local.Weekday() {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	day := time.Date(local.Year(), local.Month(), local.Day(), 0, 0, 0, 0, loc)
+	open := day.Add(time.Duration(w.StartMinute) * time.Minute)
+	closeAt := day.Add(time.Duration(w.EndMinute) * time.Minute)
+	return !start.Before(open) && !end.After(closeAt)
+}
+
+// WorkingUntil returns how far from start a booking can run before working
+// hours close, capped at end. Blocks that meet, such as one ending at
+// midnight and the next starting then, count as one. ok is false when start
+// is outside working hours.
+func (w *WorkingHours) WorkingUntil(start, end time.Time) (until time.Time, ok bool) {
+	spans := w.spans(start, end)
+	if len(spans) == 0 || !spans[0].Start.Equal(start.UTC()) {
+		return time.Time{}, false
+	}
+	until = spans[0].End
+	for _, s := range spans[1:] {
+		if !s.Start.Equal(until) {
+			break
+		}
+		until = s.End
+	}
+	return until, true
+}
+
+// dayLoad is how much of the local day containing t is already booked.
+func dayLoad(busy []domain.BusyInterval, t time.Time, loc *time.Location) time.Duration {
+	local := t.In(loc)
+	dayStart := time.Date(local.Year(), local.Month(), local.Day(), 0, 0, 0, 0, loc)
+	dayEnd := dayStart.AddDate(0, 0, 1)
+
+	var total time.Duration
+	for _, b := range busy {
+		from, to := b.Start, b.End
+		if from.Before(dayStart) {
+			from = dayStart
+		}
+		if to.After(dayEnd) {
+			to = dayEnd
+		}
+		if to.After(from) {
+			total += to.Sub(from)
+		}
+	}
+	return total
+}
+
+func overlapsAny(intervals []domain.BusyInterval, start, end time.Time) bool {
+	for _, iv := range intervals {
+		if domain.Overlaps(iv.Start, iv.End, start, end) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,162 @@
+package scheduling
+
+import (
+	"testing"
+	"time"
+
+	"schedula/backend/internal/domain"
+)
+
+func at(h, m int) time.Time { return time.Date(2026, 1, 5, h, m, 0, 0, time.UTC) }
+
+func TestSuggest_SkipsBusySlots(t *testing.T) {
+	got := Suggest([]Attendee{
+		{ID: "a", Busy: []domain.BusyInterval{{Start: at(9, 0), End: at(10, 0)}}},
+		{ID: "b", Busy: []domain.BusyInterval{{Start: at(10, 30), End: at(11, 0)}}},
+	}, Constraints{
+		WindowStart: at(9, 0),
+		WindowEnd:   at(12, 0),
+		Duration:    30 * time.Minute,
+		Step:        30 * time.Minute,
+		MaxResults:  10,
+	})
+
+	want := []time.Time{at(10, 0), at(11, 0), at(11, 30)}
+	if len(got) != len(want) {
+		t.Fatalf("len(got) = %d, want %d: %+v", len(got), len(want), got)
+	}
+	for i := range want {
+		if !got[i].Start.Equal(want[i]) {
+			t.Fatalf("got[%d].Start = %v, want %v", i, got[i].Start, want[i])
+		}
+	}
+}
+
+func TestSuggest_RanksWorkingHoursAndPreferences(t *testing.T) {
+	ny, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Fatalf("LoadLocation error: %v", err)
+	}
+
+	// 14:00-22:00 UTC is 09:00-17:00 in New York on Monday 2026-01-05.
+	got := Suggest([]Attendee{
+		{ID: "london"},
+		{
+			ID: "ny",
+			WorkingHours: &WorkingHours{
+				Location:    ny,
+				StartMinute: 9 * 60,
+				EndMinute:   17 * 60,
+				Weekdays:    []time.Weekday{time.Monday},
+			},
+			Preferred: []domain.BusyInterval{{Start: at(15, 0), End: at(16, 0)}},
+		},
+	}, Constraints{
+		WindowStart: at(12, 0),
+		WindowEnd:   at(17, 0),
+		Duration:    time.Hour,
+		Step:        time.Hour,
+		MaxResults:  3,
+	})
+
+	if len(got) != 3 {
+		t.Fatalf("len(got) = %d, want 3", len(got))
+	}
+	if !got[0].Start.Equal(at(15, 0)) {
+		t.Fatalf("best = %v, want preferred 15:00", got[0].Start)
+	}
+	if !got[1].Start.Equal(at(14, 0)) || !got[2].Start.Equal(at(16, 0)) {
+		t.Fatalf("next = %v, %v, want 14:00, 16:00 (earliest first on ties)", got[1].Start, got[2].Start)
+	}
+	for _, s := range got {
+		if len(s.OutsideHours) != 0 {
+			t.Fatalf("slot %v outside hours for %v", s.Start, s.OutsideHours)
+		}
+	}
+}
+
+func TestSuggest_OutsideHoursStillOfferedWhenNothingElseFits(t *testing.T) {
+	got := Suggest([]Attendee{{
+		ID:           "a",
+		WorkingHours: &WorkingHours{StartMinute: 9 * 60, EndMinute: 10 * 60},
+		Busy:         []domain.BusyInterval{{Start: at(9, 0), End: at(10, 0)}},
+	}}, Constraints{
+		WindowStart: at(9, 0),
+		WindowEnd:   at(11, 0),
+		Duration:    time.Hour,
+		Step:        time.Hour,
+		MaxResults:  5,
+	})
+
+	if len(got) != 1 || !got[0].Start.Equal(at(10, 0)) {
+		t.Fatalf("got = %+v, want single 10:00 slot", got)
+	}
+	if len(got[0].OutsideHours) != 1 || got[0].OutsideHours[0] != "a" {
+		t.Fatalf("outside hours = %v, want [a]", got[0].OutsideHours)
+	}
+}
+
+func TestSuggest_PrefersLighterDays(t *testing.T) {
+	monday := at(9, 0)
+	tuesday := monday.AddDate(0, 0, 1)
+
+	got := Suggest([]Attendee{{
+		ID:   "a",
+		Busy: []domain.BusyInterval{{Start: monday.Add(2 * time.Hour), End: monday.Add(6 * time.Hour)}},
+	}}, Constraints{
+		WindowStart: monday,
+		WindowEnd:   tuesday.Add(time.Hour),
+		Duration:    time.Hour,
+		Step:        time.Hour,
+		MaxResults:  1,
+	})
+
+	if len(got) != 1 || got[0].Start.Weekday() != time.Tuesday {
+		t.Fatalf("got = %+v, want a tuesday slot first", got)
+	}
+}
+
+func TestFreeSlots_EarliestFirstInsideWorkingHours(t *testing.T) {
+	got := FreeSlots(Attendee{
+		ID:           "a",
+		Busy:         []domain.BusyInterval{{Start: at(9, 30), End: at(10, 0)}},
+		WorkingHours: &WorkingHours{Location: time.UTC, StartMinute: 9 * 60, EndMinute: 11 * 60},
+	}, Constraints{
+		WindowStart: at(8, 0),
+		WindowEnd:   at(12, 0),
+		Duration:    30 * time.Minute,
+		Step:        30 * time.Minute,
+		MaxResults:  2,
+	})
+
+	want := []time.Time{at(9, 0), at(10, 0)}
+	if len(got) != len(want) {
+		t.Fatalf("len(got) = %d, want %d: %+v", len(got), len(want), got)
+	}
+	for i := range want {
+		if !got[i].Start.Equal(want[i]) {
+			t.Fatalf("got[%d].Start = %v, want %v", i, got[i].Start, want[i])
+		}
+	}
+}
+
+func TestFreeSlots_StepAnchoredToLocalMidnight(t *testing.T) {
+	kathmandu, err := time.LoadLocation("Asia/Kathmandu")
+	if err != nil {
+		t.Fatalf("LoadLocation error: %v", err)
+	}
+	got := FreeSlots(Attendee{ID: "a"}, Constraints{
+		WindowStart: at(9, 0),
+		WindowEnd:   at(10, 0),
+		Duration:    30 * time.Minute,
+		Step:        30 * time.Minute,
+		MaxResults:  10,
+		Location:    kathmandu,
+	})
+
+	// UTC+5:45, so local :00 and :30 are :15 and :45 UTC.
+	want := []time.Time{at(9, 15)}
+	if len(got) != len(want) || !got[0].Start.Equal(want[0]) {
+		t.Fatalf("got %+v, want starts %v", got, want)
+	}
+}
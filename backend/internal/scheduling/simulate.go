@@ -0,0 +1,256 @@
+package scheduling
+
+import (
+	"slices"
+	"time"
+
+	"schedula/backend/internal/domain"
+)
+
+// Staff is one simulated person: the hours they work and the breaks they
+// take, both in their working hours' location.
+type Staff struct {
+	Label        string
+	WorkingHours WorkingHours
+	Breaks       []domain.DailyBreak
+}
+
+// BookingPattern is demand of one kind: PerDay bookings of Duration on each
+// of Weekdays, or on every day when Weekdays is empty.
+type BookingPattern struct {
+	Label    string
+	Duration time.Duration
+	PerDay   int
+	Weekdays []time.Weekday
+}
+
+// Simulation is a hypothetical schedule played over a window. Days are cut
+// at local midnight in Location, which also anchors the Step grid; nil is
+// UTC.
+type Simulation struct {
+	Staff       []Staff
+	Patterns    []BookingPattern
+	WindowStart time.Time
+	WindowEnd   time.Time
+	Step        time.Duration
+	Location    *time.Location
+}
+
+// Utilization compares working time, breaks excluded, with the time booked.
+type Utilization struct {
+	Capacity time.Duration
+	Booked   time.Duration
+}
+
+// Ratio is the share of capacity booked, or 0 when there is no capacity.
+func (u Utilization) Ratio() float64 {
+	if u.Capacity <= 0 {
+		return 0
+	}
+	return float64(u.Booked) / float64(u.Capacity)
+}
+
+type DayUtilization struct {
+	Date time.Time
+	Utilization
+	Requested int
+	Unbooked  int
+}
+
+type StaffUtilization struct {
+	Label string
+	Utilization
+	Bookings int
+}
+
+type PatternOutcome struct {
+	Label     string
+	Requested int
+	Unbooked  int
+}
+
+type SimulationResult struct {
+	Utilization
+	Requested int
+	Unbooked  int
+	Days      []DayUtilization
+	Staff     []StaffUtilization
+	Patterns  []PatternOutcome
+}
+
+// Simulate books each day's demand into the staff's free time and reports
+// how full the schedule gets. Requests are taken round robin across
+// patterns, so no pattern gets all the early slots. Each one goes to the
+// earliest slot FreeSlots finds for any staff member, the least booked that
+// day on a tie; a request with no slot left that day counts as unbooked.
+func Simulate(sim Simulation) SimulationResult {
+	loc := sim.Location
+	if loc == nil {
+		loc = time.UTC
+	}
+	res := SimulationResult{
+		Staff:    make([]StaffUtilization, len(sim.Staff)),
+		Patterns: make([]PatternOutcome, len(sim.Patterns)),
+	}
+	busy := make([][]domain.BusyInterval, len(sim.Staff))
+	open := make([][]domain.BusyInterval, len(sim.Staff))
+	for i, st := range sim.Staff {
+		res.Staff[i].Label = st.Label
+		hoursLoc := st.WorkingHours.Location
+		if hoursLoc == nil {
+			hoursLoc = time.UTC
+		}
+		busy[i] = domain.DailyBreakSpans(st.Breaks, hoursLoc, sim.WindowStart, sim.WindowEnd)
+		open[i] = without(st.WorkingHours.spans(sim.WindowStart, sim.WindowEnd), busy[i])
+	}
+	for i, p := range sim.Patterns {
+		res.Patterns[i].Label = p.Label
+	}
+
+	first := sim.WindowStart.In(loc)
+	for day := time.Date(first.Year(), first.Month(), first.Day(), 0, 0, 0, 0, loc); day.Before(sim.WindowEnd); day = day.AddDate(0, 0, 1) {
+		from := maxTime(day, sim.WindowStart)
+		to := minTime(day.AddDate(0, 0, 1), sim.WindowEnd)
+		d := DayUtilization{Date: day}
+		for i := range sim.Staff {
+			c := clippedTotal(open[i], from, to)
+			d.Capacity += c
+			res.Staff[i].Capacity += c
+		}
+
+		booked := make([]time.Duration, len(sim.Staff))
+		for _, pi := range dayRequests(sim.Patterns, day.Weekday()) {
+			p := sim.Patterns[pi]
+			d.Requested++
+			res.Patterns[pi].Requested++
+
+			best := -1
+			var slot Suggestion
+			for i, st := range sim.Staff {
+				hours := st.WorkingHours
+				free := FreeSlots(Attendee{ID: st.Label, Busy: busy[i], WorkingHours: &hours}, Constraints{
+					WindowStart: from,
+					WindowEnd:   to,
+					Duration:    p.Duration,
+					Step:        sim.Step,
+					MaxResults:  1,
+					Location:    loc,
+				})
+				if len(free) == 0 {
+					continue
+				}
+				if best < 0 || free[0].Start.Before(slot.Start) || (free[0].Start.Equal(slot.Start) && booked[i] < booked[best]) {
+					best, slot = i, free[0]
+				}
+			}
+			if best < 0 {
+				d.Unbooked++
+				res.Patterns[pi].Unbooked++
+				continue
+			}
+			busy[best] = append(busy[best], domain.BusyInterval{Start: slot.Start, End: slot.End})
+			booked[best] += p.Duration
+			d.Booked += p.Duration
+			res.Staff[best].Booked += p.Duration
+			res.Staff[best].Bookings++
+		}
+
+		res.Capacity += d.Capacity
+		res.Booked += d.Booked
+		res.Requested += d.Requested
+		res.Unbooked += d.Unbooked
+		res.Days = append(res.Days, d)
+	}
+	return res
+}
+
+// dayRequests lists the pattern index of each request made on a weekday,
+// interleaving patterns one request at a time.
+func dayRequests(patterns []BookingPattern, wd time.Weekday) []int {
+	remaining := make([]int, len(patterns))
+	for i, p := range patterns {
+		if len(p.Weekdays) == 0 || slices.Contains(p.Weekdays, wd) {
+			remaining[i] = p.PerDay
+		}
+	}
+	var out []int
+	for more := true; more; {
+		more = false
+		for i := range remaining {
+			if remaining[i] > 0 {
+				out = append(out, i)
+				remaining[i]--
+				more = true
+			}
+		}
+	}
+	return out
+}
+
+// spans returns the working-hours blocks that overlap [start, end), clipped
+// to it, measured the same way contains measures them.
+func (w *WorkingHours) spans(start, end time.Time) []domain.BusyInterval {
+	loc := w.Location
+	if loc == nil {
+		loc = time.UTC
+	}
+	var out []domain.BusyInterval
+	first := start.In(loc).AddDate(0, 0, -1)
+	for day := time.Date(first.Year(), first.Month(), first.Day(), 0, 0, 0, 0, loc); day.Before(end); day = day.AddDate(0, 0, 1) {
+		if len(w.Weekdays) > 0 && !slices.Contains(w.Weekdays, day.Weekday()) {
+			continue
+		}
+		from := maxTime(day.Add(time.Duration(w.StartMinute)*time.Minute), start)
+		to := minTime(day.Add(time.Duration(w.EndMinute)*time.Minute), end)
+		if to.After(from) {
+			out = append(out, domain.BusyInterval{Start: from.UTC(), End: to.UTC()})
+		}
+	}
+	return out
+}
+
+// without cuts the cut intervals out of spans.
+func without(spans, cut []domain.BusyInterval) []domain.BusyInterval {
+	cut = domain.MergeBusy(cut)
+	var out []domain.BusyInterval
+	for _, s := range spans {
+		cur := s.Start
+		for _, c := range cut {
+			if !c.End.After(cur) || !c.Start.Before(s.End) {
+				continue
+			}
+			if c.Start.After(cur) {
+				out = append(out, domain.BusyInterval{Start: cur, End: c.Start})
+			}
+			cur = c.End
+		}
+		if s.End.After(cur) {
+			out = append(out, domain.BusyInterval{Start: cur, End: s.End})
+		}
+	}
+	return out
+}
+
+func clippedTotal(intervals []domain.BusyInterval, from, to time.Time) time.Duration {
+	var total time.Duration
+	for _, iv := range intervals {
+		if d := minTime(iv.End, to).Sub(maxTime(iv.Start, from)); d > 0 {
+			total += d
+		}
+	}
+	return total
+}
+
+func minTime(a, b time.Time) time.Time {
+	if a.Before(b) {
+		return a
+	}
+	return b
+}
+
+func maxTime(a, b time.Time) time.Time {
+	if a.After(b) {
+		return a
+	}
+	return b
+}
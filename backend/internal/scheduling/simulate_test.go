@@ -0,0 +1,45 @@
+package scheduling
+
+import (
+	"testing"
+	"time"
+
+	"schedula/backend/internal/domain"
+)
+
+func TestSimulate_BooksDemandIntoFreeTime(t *testing.T) {
+	hours := WorkingHours{Location: time.UTC, StartMinute: 9 * 60, EndMinute: 12 * 60}
+	got := Simulate(Simulation{
+		Staff: []Staff{
+			{Label: "ana", WorkingHours: hours},
+			{Label: "ben", WorkingHours: hours, Breaks: []domain.DailyBreak{{Label: "coffee", StartMinute: 10 * 60, EndMinute: 10*60 + 30}}},
+		},
+		Patterns: []BookingPattern{
+			{Label: "consult", Duration: time.Hour, PerDay: 5},
+			{Label: "check-up", Duration: 30 * time.Minute, PerDay: 2, Weekdays: []time.Weekday{time.Monday}},
+		},
+		WindowStart: at(0, 0),
+		WindowEnd:   at(0, 0).AddDate(0, 0, 2),
+		Step:        30 * time.Minute,
+	})
+
+	// Monday: ana takes three consults back to back; ben takes both
+	// check-ups and a consult around his break, and the fifth consult has
+	// nowhere left to go. Tuesday has no check-ups, so every consult fits.
+	if got.Capacity != 11*time.Hour || got.Booked != 10*time.Hour || got.Requested != 12 || got.Unbooked != 1 {
+		t.Fatalf("total = %v booked of %v, %d requested, %d unbooked", got.Booked, got.Capacity, got.Requested, got.Unbooked)
+	}
+	if len(got.Days) != 2 || got.Days[0].Booked != 5*time.Hour || got.Days[0].Unbooked != 1 || got.Days[1].Booked != 5*time.Hour || got.Days[1].Unbooked != 0 {
+		t.Fatalf("days = %+v", got.Days)
+	}
+	ana, ben := got.Staff[0], got.Staff[1]
+	if ana.Ratio() != 1 || ana.Bookings != 6 {
+		t.Fatalf("ana = %+v, want fully booked with 6 bookings", ana)
+	}
+	if ben.Capacity != 5*time.Hour || ben.Booked != 4*time.Hour || ben.Bookings != 5 {
+		t.Fatalf("ben = %+v, want 4h of 5h over 5 bookings", ben)
+	}
+	if got.Patterns[0].Requested != 10 || got.Patterns[0].Unbooked != 1 || got.Patterns[1].Requested != 2 || got.Patterns[1].Unbooked != 0 {
+		t.Fatalf("patterns = %+v", got.Patterns)
+	}
+}
@@ -0,0 +1,187 @@
+package appointments
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	"schedula/backend/internal/domain"
+	"schedula/backend/internal/store"
+)
+
+// AdvisoryBuffer is how close another booking may end before, or start
+// after, a new one before the back-to-back advisory fires.
+const AdvisoryBuffer = 15 * time.Minute
+
+// advisoryTarget is what a write booked: the spans it occupies, and the ids
+// that let checks tell it apart from the rest of the calendar.
+type advisoryTarget struct {
+	userID        string
+	spans         []domain.BusyInterval
+	appointmentID uuid.UUID
+	seriesID      uuid.UUID
+}
+
+// advisoryCheck looks at a write that has already been stored and returns
+// what the caller should know about it.
+type advisoryCheck func(ctx context.Context, s *Service, t advisoryTarget) ([]domain.Warning, error)
+
+// advisoryChecks run after every create, in this order. There is no stored
+// working-hours model yet; daily breaks are the only availability a user
+// has told us about.
+var advisoryChecks = []advisoryCheck{
+	checkBackToBack,
+	checkDailyBreaks,
+}
+
+// advise runs the advisory checks. A check that fails is skipped, because
+// advice must never turn a write that succeeded into an error.
+func (s *Service) advise(ctx context.Context, t advisoryTarget) []domain.Warning {
+	if len(t.spans) == 0 {
+		return nil
+	}
+	var out []domain.Warning
+	for _, check := range advisoryChecks {
+		warnings, err := check(ctx, s, t)
+		if err != nil {
+			continue
+		}
+		out = append(out, warnings...)
+	}
+	return out
+}
+
+// checkBackToBack warns about appointments and occurrences that end or start
+// within AdvisoryBuffer of a booked span. Entries that overlap a span are
+// conflicts rather than advice and are left to the conflict checks.
+func checkBackToBack(ctx context.Context, s *Service, t advisoryTarget) ([]domain.Warning, error) {
+	from := t.spans[0].Start.Add(-AdvisoryBuffer)
+	to := t.spans[len(t.spans)-1].End.Add(AdvisoryBuffer)
+	appts, err := s.repo.List(ctx, t.userID, from, to, store.AppointmentFilter{})
+	if err != nil {
+		return nil, err
+	}
+	occs, err := s.repo.ListOccurrences(ctx, t.userID, from, to)
+	if err != nil {
+		return nil, err
+	}
+	others := make([]CalendarEntry, 0, len(appts)+len(occs))
+	for _, a := range appts {
+		if a.ID == t.appointmentID || a.Milestone() {
+			continue
+		}
+		others = append(others, CalendarEntry{Title: a.Title, StartTime: a.StartTime.UTC(), EndTime: a.EndTime.UTC()})
+	}
+	for _, o := range occs {
+		if o.SeriesID == t.seriesID {
+			continue
+		}
+		others = append(others, CalendarEntry{Title: o.Title, StartTime: o.StartTime.UTC(), EndTime: o.EndTime.UTC()})
+	}
+
+	var out []domain.Warning
+	affected := 0
+	for _, span := range t.spans {
+		hit := false
+		for _, e := range others {
+			var msg string
+			switch {
+			case !e.EndTime.After(span.Start) && span.Start.Sub(e.EndTime) < AdvisoryBuffer:
+				msg = fmt.Sprintf("Starts %s after %q ends.", gapText(span.Start.Sub(e.EndTime)), e.Title)
+			case !e.StartTime.Before(span.End) && e.StartTime.Sub(span.End) < AdvisoryBuffer:
+				msg = fmt.Sprintf("Ends %s before %q starts.", gapText(e.StartTime.Sub(span.End)), e.Title)
+			default:
+				continue
+			}
+			hit = true
+			if t.seriesID == uuid.Nil {
+				out = append(out, domain.Warning{Code: domain.WarningCodeBackToBack, Message: msg})
+			}
+		}
+		if hit {
+			affected++
+		}
+	}
+	if t.seriesID != uuid.Nil && affected > 0 {
+		out = append(out, domain.Warning{
+			Code:    domain.WarningCodeBackToBack,
+			Message: fmt.Sprintf("%s within %d minutes of another booking.", occurrencesText(affected), int(AdvisoryBuffer/time.Minute)),
+		})
+	}
+	return out, nil
+}
+
+// checkDailyBreaks warns about booked spans that run into one of the user's
+// daily breaks.
+func checkDailyBreaks(ctx context.Context, s *Service, t advisoryTarget) ([]domain.Warning, error) {
+	settings, err := s.repo.GetUserSettings(ctx, t.userID)
+	if err != nil {
+		return nil, err
+	}
+	if len(settings.DailyBreaks) == 0 {
+		return nil, nil
+	}
+	loc := slotLocation(settings)
+
+	var out []domain.Warning
+	affected := 0
+	for _, span := range t.spans {
+		hit := false
+		for _, b := range settings.DailyBreaks {
+			if len(domain.DailyBreakSpans([]domain.DailyBreak{b}, loc, span.Start, span.End)) == 0 {
+				continue
+			}
+			hit = true
+			if t.seriesID == uuid.Nil {
+				msg := "Overlaps a daily break."
+				if b.Label != "" {
+					msg = fmt.Sprintf("Overlaps the daily break %q.", b.Label)
+				}
+				out = append(out, domain.Warning{Code: domain.WarningCodeDailyBreak, Message: msg})
+			}
+		}
+		if hit {
+			affected++
+		}
+	}
+	if t.seriesID != uuid.Nil && affected > 0 {
+		out = append(out, domain.Warning{
+			Code:    domain.WarningCodeDailyBreak,
+			Message: fmt.Sprintf("%s during a daily break.", occurrencesText(affected)),
+		})
+	}
+	return out, nil
+}
+
+// createWarnings turns the warnings a create already reports in their own
+// fields into advisories, ahead of the ones from the checks.
+func createWarnings(blackouts []domain.Blackout, pastStart bool) []domain.Warning {
+	var out []domain.Warning
+	if pastStart {
+		out = append(out, domain.Warning{Code: domain.WarningCodePastStart, Message: "Starts in the past."})
+	}
+	for _, b := range blackouts {
+		out = append(out, domain.Warning{Code: domain.WarningCodeBlackout, Message: fmt.Sprintf("Overlaps the blackout %q.", b.Title)})
+	}
+	return out
+}
+
+func gapText(d time.Duration) string {
+	switch minutes := int(d / time.Minute); minutes {
+	case 0:
+		return "right"
+	case 1:
+		return "1 minute"
+	default:
+		return fmt.Sprintf("%d minutes", minutes)
+	}
+}
+
+func occurrencesText(n int) string {
+	if n == 1 {
+		return "1 occurrence falls"
+	}
+	return fmt.Sprintf("%d occurrences fall", n)
+}
@@ -0,0 +1,123 @@
+package appointments
+
+import (
+	"cmp"
+	"context"
+	"slices"
+	"strings"
+	"time"
+
+	"schedula/backend/internal/domain"
+	"schedula/backend/internal/store"
+)
+
+// AgendaItem is one appointment, milestone or occurrence on a day. Gaps and
+// flags compare it with the timed items before it; milestones take no time
+// and are left out of them.
+type AgendaItem struct {
+	CalendarEntry
+	Milestone bool
+	// GapBefore is the free time since the latest earlier item ended, or
+	// zero for the first timed item of the day and for items that overlap.
+	GapBefore time.Duration
+	// BackToBack reports that the item starts within AdvisoryBuffer of an
+	// earlier item ending, the same rule the create advisories use.
+	BackToBack bool
+	// Overlaps reports that the item starts before an earlier one ends.
+	Overlaps bool
+}
+
+// DailyAgenda is a user's day in one time zone, items in start order.
+// BusyTime counts time covered by at least one timed item, within the day.
+type DailyAgenda struct {
+	Date     time.Time
+	TimeZone string
+	Items    []AgendaItem
+	BusyTime time.Duration
+}
+
+// GetDailyAgenda lists the appointments and occurrences that touch a local
+// day, date as YYYY-MM-DD. An empty timeZone uses the zone of the user's slot
+// settings. Items that cross midnight are listed with their full times.
+func (s *Service) GetDailyAgenda(ctx context.Context, userID, date, timeZone string) (DailyAgenda, error) {
+	if userID == "" {
+		return DailyAgenda{}, validationError("user_id is required")
+	}
+	timeZone = strings.TrimSpace(timeZone)
+	if timeZone == "" {
+		settings, err := s.repo.GetUserSettings(ctx, userID)
+		if err != nil {
+			return DailyAgenda{}, err
+		}
+		timeZone = slotLocation(settings).String()
+	}
+	loc, err := time.LoadLocation(timeZone)
+	if err != nil {
+		return DailyAgenda{}, validationError("invalid time_zone")
+	}
+	day, err := time.ParseInLocation(time.DateOnly, strings.TrimSpace(date), loc)
+	if err != nil {
+		return DailyAgenda{}, validationError("date must be YYYY-MM-DD")
+	}
+	start := day.UTC()
+	end := day.AddDate(0, 0, 1).UTC()
+
+	appts, err := s.repo.List(ctx, userID, start, end, store.AppointmentFilter{})
+	if err != nil {
+		return DailyAgenda{}, err
+	}
+	occs, err := s.repo.ListOccurrences(ctx, userID, start, end)
+	if err != nil {
+		return DailyAgenda{}, err
+	}
+
+	items := make([]AgendaItem, 0, len(appts)+len(occs))
+	for _, a := range appts {
+		items = append(items, AgendaItem{
+			CalendarEntry: CalendarEntry{AppointmentID: a.ID, Title: a.Title, Source: a.Source, StartTime: a.StartTime.UTC(), EndTime: a.EndTime.UTC()},
+			Milestone:     a.Milestone(),
+		})
+	}
+	for _, o := range occs {
+		items = append(items, AgendaItem{
+			CalendarEntry: CalendarEntry{SeriesID: o.SeriesID, OccurrenceID: o.ID, Title: o.Title, StartTime: o.StartTime.UTC(), EndTime: o.EndTime.UTC()},
+		})
+	}
+	slices.SortFunc(items, func(a, b AgendaItem) int {
+		return cmp.Or(a.StartTime.Compare(b.StartTime), a.EndTime.Compare(b.EndTime))
+	})
+
+	agenda := DailyAgenda{Date: day, TimeZone: loc.String(), Items: items}
+	var busy []domain.BusyInterval
+	var lastEnd time.Time
+	for i := range items {
+		it := &items[i]
+		if it.Milestone {
+			continue
+		}
+		if !lastEnd.IsZero() {
+			switch gap := it.StartTime.Sub(lastEnd); {
+			case gap < 0:
+				it.Overlaps = true
+			default:
+				it.GapBefore = gap
+				it.BackToBack = gap < AdvisoryBuffer
+			}
+		}
+		if it.EndTime.After(lastEnd) {
+			lastEnd = it.EndTime
+		}
+		from, to := it.StartTime, it.EndTime
+		if from.Before(start) {
+			from = start
+		}
+		if to.After(end) {
+			to = end
+		}
+		busy = append(busy, domain.BusyInterval{Start: from, End: to})
+	}
+	for _, iv := range domain.MergeBusy(busy) {
+		agenda.BusyTime += iv.End.Sub(iv.Start)
+	}
+	return agenda, nil
+}
@@ -0,0 +1,181 @@
+package appointments
+
+import (
+	"cmp"
+	"context"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"schedula/backend/internal/domain"
+	"schedula/backend/internal/store"
+)
+
+// MaxAuditWindow bounds one AuditCalendar call.
+const MaxAuditWindow = 366 * 24 * time.Hour
+
+// ConflictCause is the most likely reason two entries came to overlap.
+type ConflictCause string
+
+const (
+	// ConflictCauseOverride means an overridden occurrence was moved or
+	// stretched into the other entry.
+	ConflictCauseOverride ConflictCause = "override"
+	// ConflictCauseExternal means an appointment from calendar import or
+	// sync is involved. Imports are not checked for conflicts, and synced
+	// rows mirror a calendar that does not check against this one.
+	ConflictCauseExternal ConflictCause = "external"
+	// ConflictCauseOverlap covers the rest, such as rows written before a
+	// check existed.
+	ConflictCauseOverlap ConflictCause = "overlap"
+)
+
+// CalendarEntry is one side of a conflict: an appointment, or an occurrence
+// of a series with AppointmentID nil.
+type CalendarEntry struct {
+	AppointmentID uuid.UUID
+	SeriesID      uuid.UUID
+	OccurrenceID  string
+	Title         string
+	Source        string
+	StartTime     time.Time
+	EndTime       time.Time
+	// Overridden reports that the occurrence has an override exception.
+	Overridden bool
+}
+
+// CalendarConflict is a pair of entries that overlap, earlier start first.
+type CalendarConflict struct {
+	First        CalendarEntry
+	Second       CalendarEntry
+	OverlapStart time.Time
+	OverlapEnd   time.Time
+	Cause        ConflictCause
+}
+
+// CalendarAudit lists the conflicts found in a window, by overlap start, and
+// how many entries were checked.
+type CalendarAudit struct {
+	Conflicts      []CalendarConflict
+	EntriesScanned int
+}
+
+// AuditCalendar reports every pair of appointments and occurrences that
+// overlap within the window. Writes through the service never create one,
+// so each conflict is data that came in another way. It only reports; fixing
+// a conflict is left to the caller.
+func (s *Service) AuditCalendar(ctx context.Context, userID string, windowStart, windowEnd time.Time) (CalendarAudit, error) {
+	if userID == "" {
+		return CalendarAudit{}, validationError("user_id is required")
+	}
+	start, end := windowStart.UTC(), windowEnd.UTC()
+	if !end.After(start) {
+		return CalendarAudit{}, validationError("window_end must be after window_start")
+	}
+	if end.Sub(start) > MaxAuditWindow {
+		return CalendarAudit{}, validationError("window too long")
+	}
+
+	appts, err := s.repo.List(ctx, userID, start, end, store.AppointmentFilter{})
+	if err != nil {
+		return CalendarAudit{}, err
+	}
+	occs, err := s.repo.ListOccurrences(ctx, userID, start, end)
+	if err != nil {
+		return CalendarAudit{}, err
+	}
+
+	entries := make([]CalendarEntry, 0, len(appts)+len(occs))
+	for _, a := range appts {
+		if a.Milestone() {
+			continue
+		}
+		entries = append(entries, CalendarEntry{AppointmentID: a.ID, Title: a.Title, Source: a.Source, StartTime: a.StartTime.UTC(), EndTime: a.EndTime.UTC()})
+	}
+	for _, o := range occs {
+		entries = append(entries, CalendarEntry{SeriesID: o.SeriesID, OccurrenceID: o.ID, Title: o.Title, StartTime: o.StartTime.UTC(), EndTime: o.EndTime.UTC()})
+	}
+
+	audit := CalendarAudit{EntriesScanned: len(entries)}
+	overrides := make(map[uuid.UUID]map[int64]bool)
+	for _, c := range findConflicts(entries, start, end) {
+		for _, e := range []*CalendarEntry{&c.First, &c.Second} {
+			if e.OccurrenceID == "" {
+				continue
+			}
+			if e.Overridden, err = s.occurrenceOverridden(ctx, overrides, e.SeriesID, e.OccurrenceID); err != nil {
+				return CalendarAudit{}, err
+			}
+		}
+		c.Cause = conflictCause(c)
+		audit.Conflicts = append(audit.Conflicts, c)
+	}
+	return audit, nil
+}
+
+// findConflicts pairs up entries that overlap each other inside the window.
+// Entries that only touch do not conflict.
+func findConflicts(entries []CalendarEntry, windowStart, windowEnd time.Time) []CalendarConflict {
+	slices.SortFunc(entries, func(a, b CalendarEntry) int {
+		return cmp.Or(a.StartTime.Compare(b.StartTime), a.EndTime.Compare(b.EndTime))
+	})
+	var out []CalendarConflict
+	var active []CalendarEntry
+	for _, e := range entries {
+		active = slices.DeleteFunc(active, func(a CalendarEntry) bool { return !a.EndTime.After(e.StartTime) })
+		for _, a := range active {
+			overlapStart := e.StartTime
+			overlapEnd := a.EndTime
+			if e.EndTime.Before(overlapEnd) {
+				overlapEnd = e.EndTime
+			}
+			if !overlapEnd.After(overlapStart) || !overlapStart.Before(windowEnd) || !overlapEnd.After(windowStart) {
+				continue
+			}
+			out = append(out, CalendarConflict{First: a, Second: e, OverlapStart: overlapStart, OverlapEnd: overlapEnd})
+		}
+		active = append(active, e)
+	}
+	slices.SortStableFunc(out, func(a, b CalendarConflict) int { return a.OverlapStart.Compare(b.OverlapStart) })
+	return out
+}
+
+// occurrenceOverridden looks the occurrence's original start up in its
+// series' override exceptions, loading each series' exceptions once.
+func (s *Service) occurrenceOverridden(ctx context.Context, cache map[uuid.UUID]map[int64]bool, seriesID uuid.UUID, occurrenceID string) (bool, error) {
+	_, originalStart, err := domain.DecodeOccurrenceID(occurrenceID)
+	if err != nil {
+		return false, nil
+	}
+	starts, ok := cache[seriesID]
+	if !ok {
+		exceptions, err := s.repo.ListSeriesExceptions(ctx, seriesID)
+		if err != nil {
+			return false, err
+		}
+		starts = make(map[int64]bool, len(exceptions))
+		for _, ex := range exceptions {
+			if ex.Kind == domain.RecurringExceptionKindOverride {
+				starts[ex.OccurrenceStart.UTC().UnixNano()] = true
+			}
+		}
+		cache[seriesID] = starts
+	}
+	return starts[originalStart.UTC().UnixNano()], nil
+}
+
+func conflictCause(c CalendarConflict) ConflictCause {
+	external := func(e CalendarEntry) bool {
+		return e.Source == domain.SourceImport || strings.HasPrefix(e.Source, domain.SourceSyncPrefix)
+	}
+	switch {
+	case c.First.Overridden || c.Second.Overridden:
+		return ConflictCauseOverride
+	case external(c.First) || external(c.Second):
+		return ConflictCauseExternal
+	default:
+		return ConflictCauseOverlap
+	}
+}
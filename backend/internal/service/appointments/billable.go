@@ -0,0 +1,224 @@
+package appointments
+
+import (
+	"bytes"
+	"cmp"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"schedula/backend/internal/store"
+)
+
+// DefaultBillableTagKey is the metadata key that names an appointment's
+// client when the caller does not pick one.
+const DefaultBillableTagKey = "client"
+
+// MaxBillableWindow bounds one billable-hours export.
+const MaxBillableWindow = 366 * 24 * time.Hour
+
+type BillablePeriod string
+
+const (
+	BillablePeriodWeek  BillablePeriod = "week"
+	BillablePeriodMonth BillablePeriod = "month"
+)
+
+type BillableFormat string
+
+const (
+	BillableFormatCSV  BillableFormat = "csv"
+	BillableFormatJSON BillableFormat = "json"
+)
+
+type BillableHoursInput struct {
+	UserID      string
+	WindowStart time.Time
+	WindowEnd   time.Time
+	// TagKey is the metadata key holding the client; DefaultBillableTagKey
+	// when empty.
+	TagKey string
+	Period BillablePeriod
+	// TimeZone decides which week or month an appointment falls in; UTC when
+	// empty.
+	TimeZone string
+	Format   BillableFormat
+}
+
+// BillableSummary is the billed time for one client in one period. Client is
+// empty for appointments without the tag.
+type BillableSummary struct {
+	Client       string
+	PeriodStart  time.Time
+	Appointments int
+	Billed       time.Duration
+}
+
+type BillableExport struct {
+	Rows        []BillableSummary
+	Data        []byte
+	ContentType string
+}
+
+// ExportBillableHours totals completed appointments starting in the window
+// per client and per week or month. An appointment counts once it has ended,
+// or been checked out; it bills its checked-in time when recorded and its
+// booked time otherwise.
+func (s *Service) ExportBillableHours(ctx context.Context, in BillableHoursInput) (BillableExport, error) {
+	if in.UserID == "" {
+		return BillableExport{}, validationError("user_id is required")
+	}
+	start := in.WindowStart.UTC()
+	end := in.WindowEnd.UTC()
+	if !end.After(start) {
+		return BillableExport{}, validationError("window_end must be after window_start")
+	}
+	if end.Sub(start) > MaxBillableWindow {
+		return BillableExport{}, validationError("window too long")
+	}
+	switch in.Period {
+	case BillablePeriodWeek, BillablePeriodMonth:
+	default:
+		return BillableExport{}, validationError("period must be week or month")
+	}
+	switch in.Format {
+	case BillableFormatCSV, BillableFormatJSON:
+	default:
+		return BillableExport{}, validationError("format must be csv or json")
+	}
+	tagKey := strings.TrimSpace(in.TagKey)
+	if tagKey == "" {
+		tagKey = DefaultBillableTagKey
+	}
+	loc := time.UTC
+	if tz := strings.TrimSpace(in.TimeZone); tz != "" {
+		l, err := time.LoadLocation(tz)
+		if err != nil {
+			return BillableExport{}, validationError("invalid time_zone")
+		}
+		loc = l
+	}
+
+	appts, err := s.repo.List(ctx, in.UserID, start, end, store.AppointmentFilter{})
+	if err != nil {
+		return BillableExport{}, err
+	}
+
+	type bucket struct {
+		client string
+		period time.Time
+	}
+	now := s.now()
+	totals := make(map[bucket]*BillableSummary)
+	for _, a := range appts {
+		// List returns overlaps; only bill what starts inside the window so
+		// adjacent exports never count an appointment twice.
+		if a.StartTime.Before(start) || a.Milestone() {
+			continue
+		}
+		billed, checkedOut := a.ActualDuration()
+		if !checkedOut {
+			if a.EndTime.After(now) {
+				continue
+			}
+			billed = a.EndTime.Sub(a.StartTime)
+		}
+		key := bucket{client: a.Metadata[tagKey], period: periodStart(a.StartTime.In(loc), in.Period)}
+		sum, ok := totals[key]
+		if !ok {
+			sum = &BillableSummary{Client: key.client, PeriodStart: key.period}
+			totals[key] = sum
+		}
+		sum.Appointments++
+		sum.Billed += billed
+	}
+
+	rows := make([]BillableSummary, 0, len(totals))
+	for _, sum := range totals {
+		rows = append(rows, *sum)
+	}
+	slices.SortFunc(rows, func(a, b BillableSummary) int {
+		if c := a.PeriodStart.Compare(b.PeriodStart); c != 0 {
+			return c
+		}
+		return cmp.Compare(a.Client, b.Client)
+	})
+
+	out := BillableExport{Rows: rows}
+	if in.Format == BillableFormatCSV {
+		out.Data, err = billableCSV(rows)
+		out.ContentType = "text/csv"
+	} else {
+		out.Data, err = billableJSON(tagKey, in.Period, loc, rows)
+		out.ContentType = "application/json"
+	}
+	if err != nil {
+		return BillableExport{}, err
+	}
+	return out, nil
+}
+
+// periodStart is local midnight on the Monday of t's week or the first of
+// its month.
+func periodStart(t time.Time, period BillablePeriod) time.Time {
+	day := time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, t.Location())
+	if period == BillablePeriodMonth {
+		return day.AddDate(0, 0, 1-day.Day())
+	}
+	return day.AddDate(0, 0, -((int(day.Weekday()) + 6) % 7))
+}
+
+// billableHours formats d as decimal hours, which is what invoicing tools
+// import.
+func billableHours(d time.Duration) string {
+	return strconv.FormatFloat(d.Hours(), 'f', 2, 64)
+}
+
+func billableCSV(rows []BillableSummary) ([]byte, error) {
+	var buf bytes.Buffer
+	w := csv.NewWriter(&buf)
+	records := [][]string{{"client", "period_start", "appointments", "billable_hours"}}
+	for _, r := range rows {
+		records = append(records, []string{
+			r.Client,
+			r.PeriodStart.Format(time.DateOnly),
+			strconv.Itoa(r.Appointments),
+			billableHours(r.Billed),
+		})
+	}
+	if err := w.WriteAll(records); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+type billableDocument struct {
+	TagKey   string         `json:"tag_key"`
+	Period   BillablePeriod `json:"period"`
+	TimeZone string         `json:"time_zone"`
+	Rows     []billableRow  `json:"rows"`
+}
+
+type billableRow struct {
+	Client        string      `json:"client"`
+	PeriodStart   string      `json:"period_start"`
+	Appointments  int         `json:"appointments"`
+	BillableHours json.Number `json:"billable_hours"`
+}
+
+func billableJSON(tagKey string, period BillablePeriod, loc *time.Location, rows []BillableSummary) ([]byte, error) {
+	doc := billableDocument{TagKey: tagKey, Period: period, TimeZone: loc.String(), Rows: make([]billableRow, 0, len(rows))}
+	for _, r := range rows {
+		doc.Rows = append(doc.Rows, billableRow{
+			Client:        r.Client,
+			PeriodStart:   r.PeriodStart.Format(time.DateOnly),
+			Appointments:  r.Appointments,
+			BillableHours: json.Number(billableHours(r.Billed)),
+		})
+	}
+	return json.MarshalIndent(doc, "", "  ")
+}
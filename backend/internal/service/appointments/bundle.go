@@ -0,0 +1,460 @@
+package appointments
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"maps"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"schedula/backend/internal/domain"
+	"schedula/backend/internal/events"
+	"schedula/backend/internal/store"
+)
+
+// CalendarBundleVersion is the bundle format ExportCalendar writes and the
+// only one ImportCalendar accepts.
+const CalendarBundleVersion = 1
+
+// calendarBundle is the portable JSON form of a user's calendar. Field names
+// are part of the format; change them only with a version bump.
+type calendarBundle struct {
+	Version      int                 `json:"version"`
+	UserID       string              `json:"user_id"`
+	ExportedAt   time.Time           `json:"exported_at"`
+	Contacts     []bundleContact     `json:"contacts,omitempty"`
+	Appointments []bundleAppointment `json:"appointments"`
+	Series       []bundleSeries      `json:"series"`
+
+	// Checksum is "sha256:" and the hex digest of the bundle marshalled with
+	// Checksum empty.
+	Checksum string `json:"checksum"`
+}
+
+type bundleContact struct {
+	ID        uuid.UUID `json:"id"`
+	Name      string    `json:"name"`
+	Email     string    `json:"email,omitempty"`
+	Phone     string    `json:"phone,omitempty"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+type bundleAppointment struct {
+	ID             uuid.UUID         `json:"id"`
+	Title          string            `json:"title"`
+	Notes          string            `json:"notes,omitempty"`
+	PrivateNotes   string            `json:"private_notes,omitempty"`
+	StartTime      time.Time         `json:"start_time"`
+	EndTime        time.Time         `json:"end_time"`
+	TimeZone       string            `json:"time_zone,omitempty"`
+	Metadata       map[string]string `json:"metadata,omitempty"`
+	ExternalSystem string            `json:"external_system,omitempty"`
+	ExternalID     string            `json:"external_id,omitempty"`
+	CreatedBy      string            `json:"created_by,omitempty"`
+	CreatedAt      time.Time         `json:"created_at"`
+	UpdatedAt      time.Time         `json:"updated_at"`
+	CheckedInAt    *time.Time        `json:"checked_in_at,omitempty"`
+	CheckedOutAt   *time.Time        `json:"checked_out_at,omitempty"`
+	ContactID      *uuid.UUID        `json:"contact_id,omitempty"`
+	Source         string            `json:"source,omitempty"`
+	Kind           string            `json:"kind,omitempty"`
+}
+
+type bundleSeries struct {
+	ID              uuid.UUID            `json:"id"`
+	Title           string               `json:"title"`
+	Notes           string               `json:"notes,omitempty"`
+	TimeZone        string               `json:"time_zone"`
+	DTStart         time.Time            `json:"dtstart"`
+	DurationSeconds int                  `json:"duration_seconds"`
+	Frequency       string               `json:"frequency"`
+	Interval        int                  `json:"interval"`
+	ByWeekday       []int16              `json:"byweekday"`
+	Until           *time.Time           `json:"until,omitempty"`
+	Count           *int                 `json:"count,omitempty"`
+	WeekStart       int16                `json:"wkst,omitempty"`
+	DSTGap          string               `json:"dst_gap_policy,omitempty"`
+	DSTAmbiguous    string               `json:"dst_ambiguous_policy,omitempty"`
+	WeekdayTimes    []domain.WeekdayTime `json:"weekday_times,omitempty"`
+	Metadata        map[string]string    `json:"metadata,omitempty"`
+	CreatedBy       string               `json:"created_by,omitempty"`
+	CreatedAt       time.Time            `json:"created_at"`
+	UpdatedAt       time.Time            `json:"updated_at"`
+	Exceptions      []bundleException    `json:"exceptions,omitempty"`
+}
+
+type bundleException struct {
+	ID              uuid.UUID  `json:"id"`
+	OccurrenceStart time.Time  `json:"occurrence_start"`
+	Kind            string     `json:"kind"`
+	OverrideStart   *time.Time `json:"override_start,omitempty"`
+	OverrideEnd     *time.Time `json:"override_end,omitempty"`
+	OverrideTitle   *string    `json:"override_title,omitempty"`
+	OverrideNotes   *string    `json:"override_notes,omitempty"`
+	CreatedAt       time.Time  `json:"created_at"`
+	UpdatedAt       time.Time  `json:"updated_at"`
+}
+
+func (b calendarBundle) checksum() (string, error) {
+	b.Checksum = ""
+	raw, err := json.Marshal(b)
+	if err != nil {
+		return "", err
+	}
+	sum := sha256.Sum256(raw)
+	return "sha256:" + hex.EncodeToString(sum[:]), nil
+}
+
+// ExportCalendar returns the user's appointments, series and exceptions as a
+// versioned JSON bundle that ImportCalendar on any deployment can load.
+func (s *Service) ExportCalendar(ctx context.Context, userID string) ([]byte, error) {
+	if userID == "" {
+		return nil, validationError("user_id is required")
+	}
+	snap, err := s.repo.ExportCalendar(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	_, raw, err := encodeBundle(userID, snap, s.now())
+	return raw, err
+}
+
+// encodeBundle converts snap to a checksummed bundle and its JSON.
+func encodeBundle(userID string, snap store.CalendarSnapshot, at time.Time) (calendarBundle, []byte, error) {
+	b := calendarBundle{
+		Version:      CalendarBundleVersion,
+		UserID:       userID,
+		ExportedAt:   at.UTC(),
+		Appointments: make([]bundleAppointment, 0, len(snap.Appointments)),
+		Series:       make([]bundleSeries, 0, len(snap.Series)),
+	}
+	for _, c := range snap.Contacts {
+		b.Contacts = append(b.Contacts, bundleContact{
+			ID:        c.ID,
+			Name:      c.Name,
+			Email:     c.Email,
+			Phone:     c.Phone,
+			CreatedAt: c.CreatedAt.UTC(),
+			UpdatedAt: c.UpdatedAt.UTC(),
+		})
+	}
+	for _, a := range snap.Appointments {
+		b.Appointments = append(b.Appointments, bundleAppointment{
+			ID:             a.ID,
+			Title:          a.Title,
+			Notes:          a.Notes,
+			PrivateNotes:   a.PrivateNotes,
+			StartTime:      a.StartTime.UTC(),
+			EndTime:        a.EndTime.UTC(),
+			TimeZone:       a.Timezone,
+			Metadata:       a.Metadata,
+			ExternalSystem: a.ExternalSystem,
+			ExternalID:     a.ExternalID,
+			CreatedBy:      a.CreatedBy,
+			CreatedAt:      a.CreatedAt.UTC(),
+			UpdatedAt:      a.UpdatedAt.UTC(),
+			CheckedInAt:    a.CheckedInAt,
+			CheckedOutAt:   a.CheckedOutAt,
+			ContactID:      a.ContactID,
+			Source:         a.Source,
+			Kind:           string(a.Kind),
+		})
+	}
+	exceptions := make(map[uuid.UUID][]bundleException)
+	for _, e := range snap.Exceptions {
+		exceptions[e.SeriesID] = append(exceptions[e.SeriesID], bundleException{
+			ID:              e.ID,
+			OccurrenceStart: e.OccurrenceStart.UTC(),
+			Kind:            string(e.Kind),
+			OverrideStart:   e.OverrideStart,
+			OverrideEnd:     e.OverrideEnd,
+			OverrideTitle:   e.OverrideTitle,
+			OverrideNotes:   e.OverrideNotes,
+			CreatedAt:       e.CreatedAt.UTC(),
+			UpdatedAt:       e.UpdatedAt.UTC(),
+		})
+	}
+	for _, sr := range snap.Series {
+		b.Series = append(b.Series, bundleSeries{
+			ID:              sr.ID,
+			Title:           sr.Title,
+			Notes:           sr.Notes,
+			TimeZone:        sr.Timezone,
+			DTStart:         sr.DTStart.UTC(),
+			DurationSeconds: sr.DurationSeconds,
+			Frequency:       string(sr.Frequency),
+			Interval:        sr.Interval,
+			ByWeekday:       sr.ByWeekday,
+			Until:           sr.Until,
+			Count:           sr.Count,
+			WeekStart:       sr.WeekStart,
+			DSTGap:          string(sr.DSTGap),
+			DSTAmbiguous:    string(sr.DSTAmbiguous),
+			WeekdayTimes:    sr.WeekdayTimes,
+			Metadata:        sr.Metadata,
+			CreatedBy:       sr.CreatedBy,
+			CreatedAt:       sr.CreatedAt.UTC(),
+			UpdatedAt:       sr.UpdatedAt.UTC(),
+			Exceptions:      exceptions[sr.ID],
+		})
+	}
+
+	var err error
+	if b.Checksum, err = b.checksum(); err != nil {
+		return calendarBundle{}, nil, err
+	}
+	raw, err := json.Marshal(b)
+	if err != nil {
+		return calendarBundle{}, nil, err
+	}
+	return b, raw, nil
+}
+
+type ImportCalendarInput struct {
+	UserID string
+	Bundle []byte
+}
+
+type ImportCalendarResult struct {
+	Contacts     int
+	Appointments int
+	Series       int
+	Exceptions   int
+}
+
+// ImportCalendar loads a bundle from ExportCalendar into UserID's calendar,
+// which must be empty. The bundle's user id is informational; everything is
+// imported under UserID with its original ids. Blackouts are not checked,
+// since the bundle is history rather than new bookings.
+func (s *Service) ImportCalendar(ctx context.Context, in ImportCalendarInput) (ImportCalendarResult, error) {
+	if in.UserID == "" {
+		return ImportCalendarResult{}, validationError("user_id is required")
+	}
+	if len(in.Bundle) == 0 {
+		return ImportCalendarResult{}, validationError("bundle is required")
+	}
+	if err := s.authorizeOwner(ctx, in.UserID); err != nil {
+		return ImportCalendarResult{}, err
+	}
+
+	b, err := decodeBundle(in.Bundle)
+	if err != nil {
+		return ImportCalendarResult{}, err
+	}
+	snap, err := bundleSnapshot(b)
+	if err != nil {
+		return ImportCalendarResult{}, err
+	}
+	if err := s.repo.ImportCalendar(ctx, in.UserID, snap); err != nil {
+		return ImportCalendarResult{}, err
+	}
+	for _, appt := range snap.Appointments {
+		s.events.Publish(ctx, events.AppointmentCreated{UserID: in.UserID, AppointmentID: appt.ID})
+	}
+	for _, series := range snap.Series {
+		s.events.Publish(ctx, events.SeriesCreated{UserID: in.UserID, SeriesID: series.ID})
+	}
+
+	return ImportCalendarResult{
+		Contacts:     len(snap.Contacts),
+		Appointments: len(snap.Appointments),
+		Series:       len(snap.Series),
+		Exceptions:   len(snap.Exceptions),
+	}, nil
+}
+
+// decodeBundle parses raw and checks its version and checksum.
+func decodeBundle(raw []byte) (calendarBundle, error) {
+	var b calendarBundle
+	dec := json.NewDecoder(bytes.NewReader(raw))
+	dec.DisallowUnknownFields()
+	if err := dec.Decode(&b); err != nil {
+		return calendarBundle{}, validationError("bundle is not valid JSON")
+	}
+	if b.Version != CalendarBundleVersion {
+		return calendarBundle{}, validationError("unsupported bundle version")
+	}
+	want, err := b.checksum()
+	if err != nil {
+		return calendarBundle{}, err
+	}
+	if b.Checksum != want {
+		return calendarBundle{}, validationError("bundle checksum mismatch")
+	}
+	return b, nil
+}
+
+// bundleSnapshot checks the bundle's rows against the same rules the create
+// paths enforce and converts them for the repository. The checksum catches
+// corruption; this catches bundles edited or written by hand.
+func bundleSnapshot(b calendarBundle) (store.CalendarSnapshot, error) {
+	seen := make(map[uuid.UUID]bool)
+	fresh := func(id uuid.UUID) bool {
+		if id == uuid.Nil || seen[id] {
+			return false
+		}
+		seen[id] = true
+		return true
+	}
+
+	var snap store.CalendarSnapshot
+	for _, c := range b.Contacts {
+		if !fresh(c.ID) {
+			return store.CalendarSnapshot{}, validationError("bundle has a missing or repeated contact id")
+		}
+		contact, err := contactFromInput(ContactInput{UserID: b.UserID, Name: c.Name, Email: c.Email, Phone: c.Phone})
+		if err != nil {
+			return store.CalendarSnapshot{}, validationError("bundle contact " + c.ID.String() + " is invalid")
+		}
+		contact.ID = c.ID
+		contact.CreatedAt = c.CreatedAt.UTC()
+		contact.UpdatedAt = c.UpdatedAt.UTC()
+		snap.Contacts = append(snap.Contacts, contact)
+	}
+	contacts := maps.Clone(seen)
+
+	for _, a := range b.Appointments {
+		if !fresh(a.ID) {
+			return store.CalendarSnapshot{}, validationError("bundle has a missing or repeated appointment id")
+		}
+		kind := domain.AppointmentKind(a.Kind)
+		if kind == "" {
+			kind = domain.AppointmentKindEvent
+		}
+		if !kind.Valid() {
+			return store.CalendarSnapshot{}, validationError("bundle appointment " + a.ID.String() + " has an invalid kind")
+		}
+		validRange := a.EndTime.After(a.StartTime) && a.EndTime.Sub(a.StartTime) <= MaxAppointmentDuration
+		if kind == domain.AppointmentKindMilestone {
+			validRange = a.EndTime.Equal(a.StartTime)
+		}
+		if !validRange {
+			return store.CalendarSnapshot{}, validationError("bundle appointment " + a.ID.String() + " has an invalid time range")
+		}
+		if a.TimeZone != "" {
+			if _, err := time.LoadLocation(a.TimeZone); err != nil {
+				return store.CalendarSnapshot{}, validationError("bundle appointment " + a.ID.String() + " has an invalid time_zone")
+			}
+		}
+		if a.CheckedOutAt != nil && (a.CheckedInAt == nil || a.CheckedOutAt.Before(*a.CheckedInAt)) {
+			return store.CalendarSnapshot{}, validationError("bundle appointment " + a.ID.String() + " has a check-out without a matching check-in")
+		}
+		if a.ContactID != nil && !contacts[*a.ContactID] {
+			return store.CalendarSnapshot{}, validationError("bundle appointment " + a.ID.String() + " names a contact that is not in the bundle")
+		}
+		// Imported appointments keep the source they were exported with.
+		source := strings.TrimSpace(a.Source)
+		if source == "" {
+			source = domain.SourceImport
+		}
+		if len(source) > MaxSourceFilterLength {
+			return store.CalendarSnapshot{}, validationError("bundle appointment " + a.ID.String() + " has an invalid source")
+		}
+		snap.Appointments = append(snap.Appointments, domain.Appointment{
+			ID:             a.ID,
+			Title:          a.Title,
+			Notes:          a.Notes,
+			PrivateNotes:   a.PrivateNotes,
+			StartTime:      a.StartTime.UTC(),
+			EndTime:        a.EndTime.UTC(),
+			Timezone:       a.TimeZone,
+			Metadata:       a.Metadata,
+			ExternalSystem: a.ExternalSystem,
+			ExternalID:     a.ExternalID,
+			CreatedBy:      a.CreatedBy,
+			CreatedAt:      a.CreatedAt.UTC(),
+			UpdatedAt:      a.UpdatedAt.UTC(),
+			CheckedInAt:    a.CheckedInAt,
+			CheckedOutAt:   a.CheckedOutAt,
+			ContactID:      a.ContactID,
+			Source:         source,
+			Kind:           kind,
+		})
+	}
+
+	for _, sr := range b.Series {
+		invalid := validationError("bundle series " + sr.ID.String() + " is invalid")
+		if !fresh(sr.ID) {
+			return store.CalendarSnapshot{}, validationError("bundle has a missing or repeated series id")
+		}
+		if domain.RecurrenceFrequency(sr.Frequency) != domain.RecurrenceFrequencyWeekly || sr.Interval < 1 ||
+			sr.DurationSeconds <= 0 || len(sr.ByWeekday) == 0 || (sr.Until == nil && sr.Count == nil) {
+			return store.CalendarSnapshot{}, invalid
+		}
+		for _, wd := range sr.ByWeekday {
+			if wd < 1 || wd > 7 {
+				return store.CalendarSnapshot{}, invalid
+			}
+		}
+		for _, wt := range sr.WeekdayTimes {
+			if !slices.Contains(sr.ByWeekday, wt.Weekday) || wt.StartMinute < 0 || wt.StartMinute >= domain.MinutesPerDay {
+				return store.CalendarSnapshot{}, invalid
+			}
+		}
+		if _, err := time.LoadLocation(sr.TimeZone); err != nil {
+			return store.CalendarSnapshot{}, invalid
+		}
+		gap, ambiguous := domain.DSTGapPolicy(sr.DSTGap), domain.DSTAmbiguousPolicy(sr.DSTAmbiguous)
+		if (gap != "" && !gap.Valid()) || (ambiguous != "" && !ambiguous.Valid()) {
+			return store.CalendarSnapshot{}, invalid
+		}
+
+		snap.Series = append(snap.Series, domain.RecurringSeries{
+			ID:              sr.ID,
+			Title:           sr.Title,
+			Notes:           sr.Notes,
+			Timezone:        sr.TimeZone,
+			DTStart:         sr.DTStart.UTC(),
+			DurationSeconds: sr.DurationSeconds,
+			Frequency:       domain.RecurrenceFrequency(sr.Frequency),
+			Interval:        sr.Interval,
+			ByWeekday:       sr.ByWeekday,
+			Until:           sr.Until,
+			Count:           sr.Count,
+			WeekStart:       sr.WeekStart,
+			DSTGap:          gap,
+			DSTAmbiguous:    ambiguous,
+			WeekdayTimes:    sr.WeekdayTimes,
+			Metadata:        sr.Metadata,
+			CreatedBy:       sr.CreatedBy,
+			CreatedAt:       sr.CreatedAt.UTC(),
+			UpdatedAt:       sr.UpdatedAt.UTC(),
+		})
+
+		for _, e := range sr.Exceptions {
+			if !fresh(e.ID) {
+				return store.CalendarSnapshot{}, validationError("bundle has a missing or repeated exception id")
+			}
+			kind := domain.RecurringExceptionKind(e.Kind)
+			switch kind {
+			case domain.RecurringExceptionKindSkip:
+			case domain.RecurringExceptionKindOverride:
+				if e.OverrideStart == nil || e.OverrideEnd == nil || !e.OverrideEnd.After(*e.OverrideStart) {
+					return store.CalendarSnapshot{}, invalid
+				}
+			default:
+				return store.CalendarSnapshot{}, invalid
+			}
+			snap.Exceptions = append(snap.Exceptions, domain.RecurringException{
+				ID:              e.ID,
+				SeriesID:        sr.ID,
+				OccurrenceStart: e.OccurrenceStart.UTC(),
+				Kind:            kind,
+				OverrideStart:   e.OverrideStart,
+				OverrideEnd:     e.OverrideEnd,
+				OverrideTitle:   e.OverrideTitle,
+				OverrideNotes:   e.OverrideNotes,
+				CreatedAt:       e.CreatedAt.UTC(),
+				UpdatedAt:       e.UpdatedAt.UTC(),
+			})
+		}
+	}
+	return snap, nil
+}
@@ -0,0 +1,200 @@
+package appointments
+
+import (
+	"context"
+	"errors"
+	"net/url"
+	"strings"
+
+	"github.com/google/uuid"
+
+	"schedula/backend/internal/domain"
+	"schedula/backend/internal/events"
+	"schedula/backend/internal/store"
+)
+
+// MaxCalendarSnapshots is how many snapshots a user keeps. Creating one past
+// the cap deletes the oldest.
+const MaxCalendarSnapshots = 20
+
+// MaxSnapshotLabelLength bounds a snapshot's label.
+const MaxSnapshotLabelLength = 200
+
+// ErrSnapshotsUnavailable is returned by the snapshot methods when no blob
+// store is configured.
+var ErrSnapshotsUnavailable = errors.New("calendar snapshots are not configured")
+
+// ErrSnapshotCorrupt is returned when a snapshot's blob is missing or no
+// longer matches the checksum recorded when it was taken.
+var ErrSnapshotCorrupt = errors.New("calendar snapshot is corrupt")
+
+// SetSnapshotBlobs stores calendar snapshots in b.
+func (s *Service) SetSnapshotBlobs(b store.BlobStore) {
+	s.blobs = b
+}
+
+type CreateCalendarSnapshotInput struct {
+	UserID string
+	Label  string
+}
+
+// CreateCalendarSnapshot saves the user's calendar as a bundle in blob
+// storage. Pruning past MaxCalendarSnapshots is best effort; a prune that
+// fails is retried by the next snapshot.
+func (s *Service) CreateCalendarSnapshot(ctx context.Context, in CreateCalendarSnapshotInput) (domain.CalendarSnapshotInfo, error) {
+	if in.UserID == "" {
+		return domain.CalendarSnapshotInfo{}, validationError("user_id is required")
+	}
+	label := strings.TrimSpace(in.Label)
+	if len(label) > MaxSnapshotLabelLength {
+		return domain.CalendarSnapshotInfo{}, validationError("label is too long")
+	}
+	if s.blobs == nil {
+		return domain.CalendarSnapshotInfo{}, ErrSnapshotsUnavailable
+	}
+	if err := s.authorizeOwner(ctx, in.UserID); err != nil {
+		return domain.CalendarSnapshotInfo{}, err
+	}
+
+	snap, err := s.repo.ExportCalendar(ctx, in.UserID)
+	if err != nil {
+		return domain.CalendarSnapshotInfo{}, err
+	}
+	now := s.now().UTC()
+	b, raw, err := encodeBundle(in.UserID, snap, now)
+	if err != nil {
+		return domain.CalendarSnapshotInfo{}, err
+	}
+	id, err := uuid.NewV7()
+	if err != nil {
+		return domain.CalendarSnapshotInfo{}, err
+	}
+	info := domain.CalendarSnapshotInfo{
+		ID:            id,
+		UserID:        in.UserID,
+		Label:         label,
+		FormatVersion: b.Version,
+		BlobKey:       snapshotBlobKey(in.UserID, id),
+		Checksum:      b.Checksum,
+		SizeBytes:     len(raw),
+		Contacts:      len(snap.Contacts),
+		Appointments:  len(snap.Appointments),
+		Series:        len(snap.Series),
+		Exceptions:    len(snap.Exceptions),
+		CreatedAt:     now,
+	}
+	if err := s.blobs.PutBlob(ctx, info.BlobKey, raw); err != nil {
+		return domain.CalendarSnapshotInfo{}, err
+	}
+	if err := s.repo.SaveCalendarSnapshotInfo(ctx, info); err != nil {
+		_ = s.blobs.DeleteBlob(ctx, info.BlobKey)
+		return domain.CalendarSnapshotInfo{}, err
+	}
+
+	if saved, err := s.repo.ListCalendarSnapshotInfo(ctx, in.UserID); err == nil && len(saved) > MaxCalendarSnapshots {
+		for _, old := range saved[MaxCalendarSnapshots:] {
+			if err := s.repo.DeleteCalendarSnapshotInfo(ctx, in.UserID, old.ID); err != nil && !errors.Is(err, store.ErrNotFound) {
+				break
+			}
+			_ = s.blobs.DeleteBlob(ctx, old.BlobKey)
+		}
+	}
+	return info, nil
+}
+
+// ListCalendarSnapshots returns the user's snapshots, newest first.
+func (s *Service) ListCalendarSnapshots(ctx context.Context, userID string) ([]domain.CalendarSnapshotInfo, error) {
+	if userID == "" {
+		return nil, validationError("user_id is required")
+	}
+	if s.blobs == nil {
+		return nil, ErrSnapshotsUnavailable
+	}
+	return s.repo.ListCalendarSnapshotInfo(ctx, userID)
+}
+
+type RestoreCalendarResult struct {
+	Snapshot            domain.CalendarSnapshotInfo
+	AppointmentsCreated int
+	AppointmentsUpdated int
+	AppointmentsDeleted int
+	SeriesCreated       int
+	SeriesUpdated       int
+	SeriesDeleted       int
+	Contacts            int
+}
+
+// RestoreCalendarSnapshot puts the user's calendar back to the snapshot in
+// one transaction. Anything created since the snapshot is deleted and
+// anything deleted since is recreated with its old id; rows that have not
+// changed are left alone.
+func (s *Service) RestoreCalendarSnapshot(ctx context.Context, userID string, snapshotID uuid.UUID) (RestoreCalendarResult, error) {
+	if userID == "" {
+		return RestoreCalendarResult{}, validationError("user_id is required")
+	}
+	if snapshotID == uuid.Nil {
+		return RestoreCalendarResult{}, validationError("snapshot_id is required")
+	}
+	if s.blobs == nil {
+		return RestoreCalendarResult{}, ErrSnapshotsUnavailable
+	}
+	if err := s.authorizeOwner(ctx, userID); err != nil {
+		return RestoreCalendarResult{}, err
+	}
+
+	info, err := s.repo.GetCalendarSnapshotInfo(ctx, userID, snapshotID)
+	if err != nil {
+		return RestoreCalendarResult{}, err
+	}
+	raw, err := s.blobs.GetBlob(ctx, info.BlobKey)
+	if errors.Is(err, store.ErrNotFound) {
+		return RestoreCalendarResult{}, ErrSnapshotCorrupt
+	}
+	if err != nil {
+		return RestoreCalendarResult{}, err
+	}
+	b, err := decodeBundle(raw)
+	if err != nil || b.Checksum != info.Checksum {
+		return RestoreCalendarResult{}, ErrSnapshotCorrupt
+	}
+	snap, err := bundleSnapshot(b)
+	if err != nil {
+		return RestoreCalendarResult{}, ErrSnapshotCorrupt
+	}
+
+	restored, err := s.repo.RestoreCalendar(ctx, userID, snap)
+	if err != nil {
+		return RestoreCalendarResult{}, err
+	}
+	for _, id := range restored.AppointmentsCreated {
+		s.events.Publish(ctx, events.AppointmentCreated{UserID: userID, AppointmentID: id})
+	}
+	for _, id := range restored.AppointmentsUpdated {
+		s.events.Publish(ctx, events.AppointmentUpdated{UserID: userID, AppointmentID: id})
+	}
+	for _, id := range restored.AppointmentsDeleted {
+		s.events.Publish(ctx, events.AppointmentDeleted{UserID: userID, AppointmentID: id})
+	}
+	for _, id := range restored.SeriesCreated {
+		s.events.Publish(ctx, events.SeriesCreated{UserID: userID, SeriesID: id})
+	}
+	for _, id := range append(restored.SeriesUpdated, restored.SeriesDeleted...) {
+		s.events.Publish(ctx, events.SeriesUpdated{UserID: userID, SeriesID: id})
+	}
+
+	return RestoreCalendarResult{
+		Snapshot:            info,
+		AppointmentsCreated: len(restored.AppointmentsCreated),
+		AppointmentsUpdated: len(restored.AppointmentsUpdated),
+		AppointmentsDeleted: len(restored.AppointmentsDeleted),
+		SeriesCreated:       len(restored.SeriesCreated),
+		SeriesUpdated:       len(restored.SeriesUpdated),
+		SeriesDeleted:       len(restored.SeriesDeleted),
+		Contacts:            restored.Contacts,
+	}, nil
+}
+
+// snapshotBlobKey escapes userID so it stays one path segment.
+func snapshotBlobKey(userID string, id uuid.UUID) string {
+	return "calendar-snapshots/" + url.PathEscape(userID) + "/" + id.String() + ".json"
+}
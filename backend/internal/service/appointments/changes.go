@@ -0,0 +1,134 @@
+package appointments
+
+import (
+	"context"
+	"encoding/base64"
+	"strconv"
+	"strings"
+	"time"
+
+	"schedula/backend/internal/domain"
+)
+
+const (
+	DefaultChangesPageSize = 100
+	MaxChangesPageSize     = 1000
+
+	// MaxChangesWait caps how long ListChanges holds a request open waiting
+	// for the first change.
+	MaxChangesWait = 30 * time.Second
+)
+
+// changesPollInterval is how often a waiting ListChanges re-reads the log.
+const changesPollInterval = time.Second
+
+// changesDeadlineMargin is left between the end of a wait and the request
+// deadline so the empty response still makes it back.
+const changesDeadlineMargin = 500 * time.Millisecond
+
+const changeCursorPrefix = "c1:"
+
+// encodeChangeCursor wraps a change log sequence number so clients treat it
+// as opaque.
+func encodeChangeCursor(seq int64) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(changeCursorPrefix + strconv.FormatInt(seq, 10)))
+}
+
+func decodeChangeCursor(cursor string) (int64, error) {
+	if cursor == "" {
+		return 0, nil
+	}
+	raw, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return 0, validationError("invalid cursor")
+	}
+	s, ok := strings.CutPrefix(string(raw), changeCursorPrefix)
+	if !ok {
+		return 0, validationError("invalid cursor")
+	}
+	seq, err := strconv.ParseInt(s, 10, 64)
+	if err != nil || seq < 0 {
+		return 0, validationError("invalid cursor")
+	}
+	return seq, nil
+}
+
+type ListChangesInput struct {
+	UserID string
+	// Cursor is the NextCursor of a previous page; empty starts from the
+	// beginning of the log.
+	Cursor string
+	Limit  int
+	// Wait holds the call open up to this long, or until the request
+	// deadline, when there are no changes yet.
+	Wait time.Duration
+}
+
+type ChangesPage struct {
+	Changes    []domain.CalendarChange
+	NextCursor string
+	HasMore    bool
+}
+
+// ListChanges returns the user's calendar changes after the cursor. Clients
+// keep NextCursor and pass it back to fetch only what changed since.
+func (s *Service) ListChanges(ctx context.Context, in ListChangesInput) (ChangesPage, error) {
+	if in.UserID == "" {
+		return ChangesPage{}, validationError("user_id is required")
+	}
+	after, err := decodeChangeCursor(in.Cursor)
+	if err != nil {
+		return ChangesPage{}, err
+	}
+	limit := in.Limit
+	if limit == 0 {
+		limit = DefaultChangesPageSize
+	}
+	if limit < 0 || limit > MaxChangesPageSize {
+		return ChangesPage{}, validationError("page_size must be between 1 and 1000")
+	}
+	if in.Wait < 0 {
+		return ChangesPage{}, validationError("wait must not be negative")
+	}
+	wait := min(in.Wait, MaxChangesWait)
+	waitUntil := s.now().Add(wait)
+	if deadline, ok := ctx.Deadline(); ok && deadline.Add(-changesDeadlineMargin).Before(waitUntil) {
+		waitUntil = deadline.Add(-changesDeadlineMargin)
+	}
+
+	for {
+		// One extra row tells us whether another page follows.
+		changes, err := s.repo.ListChanges(ctx, in.UserID, after, limit+1)
+		if err != nil {
+			return ChangesPage{}, err
+		}
+		if len(changes) > 0 || !s.now().Add(changesPollInterval).Before(waitUntil) {
+			page := ChangesPage{NextCursor: encodeChangeCursor(after)}
+			if len(changes) > limit {
+				changes = changes[:limit]
+				page.HasMore = true
+			}
+			if len(changes) > 0 {
+				page.NextCursor = encodeChangeCursor(changes[len(changes)-1].Seq)
+			}
+			page.Changes = changes
+			return page, nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return ChangesPage{}, ctx.Err()
+		case <-time.After(changesPollInterval):
+		}
+	}
+}
+
+// CalendarVersion returns the user's calendar version, which advances once
+// per committed write. Reads report the version taken before they run, so a
+// client's copy is never labeled newer than it is.
+func (s *Service) CalendarVersion(ctx context.Context, userID string) (int64, error) {
+	if userID == "" {
+		return 0, validationError("user_id is required")
+	}
+	return s.repo.CalendarVersion(ctx, userID)
+}
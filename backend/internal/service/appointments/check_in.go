@@ -0,0 +1,74 @@
+package appointments
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+
+	"schedula/backend/internal/domain"
+	"schedula/backend/internal/events"
+)
+
+// SessionInput identifies the appointment to check in or out. At defaults to
+// now; clients set it to record a time they captured offline.
+type SessionInput struct {
+	UserID        string
+	AppointmentID uuid.UUID
+	At            time.Time
+}
+
+// CheckIn records when an appointment actually started. It returns
+// store.ErrConflict if it is already checked in.
+func (s *Service) CheckIn(ctx context.Context, in SessionInput) (domain.Appointment, error) {
+	at, err := s.sessionTime(in)
+	if err != nil {
+		return domain.Appointment{}, err
+	}
+	if err := s.authorizeOwner(ctx, in.UserID); err != nil {
+		return domain.Appointment{}, err
+	}
+	appt, err := s.repo.CheckInAppointment(ctx, in.UserID, in.AppointmentID, at)
+	if err != nil {
+		return domain.Appointment{}, err
+	}
+	s.events.Publish(ctx, events.AppointmentUpdated{UserID: in.UserID, AppointmentID: appt.ID})
+	return appt, nil
+}
+
+// CheckOut records when a checked-in appointment actually ended. It returns
+// store.ErrConflict if it is not checked in, is already checked out, or At
+// is before the check-in.
+func (s *Service) CheckOut(ctx context.Context, in SessionInput) (domain.Appointment, error) {
+	at, err := s.sessionTime(in)
+	if err != nil {
+		return domain.Appointment{}, err
+	}
+	if err := s.authorizeOwner(ctx, in.UserID); err != nil {
+		return domain.Appointment{}, err
+	}
+	appt, err := s.repo.CheckOutAppointment(ctx, in.UserID, in.AppointmentID, at)
+	if err != nil {
+		return domain.Appointment{}, err
+	}
+	s.events.Publish(ctx, events.AppointmentUpdated{UserID: in.UserID, AppointmentID: appt.ID})
+	return appt, nil
+}
+
+func (s *Service) sessionTime(in SessionInput) (time.Time, error) {
+	if in.UserID == "" {
+		return time.Time{}, validationError("user_id is required")
+	}
+	if in.AppointmentID == uuid.Nil {
+		return time.Time{}, validationError("appointment_id is required")
+	}
+	now := s.now().UTC()
+	if in.At.IsZero() {
+		return now, nil
+	}
+	at := in.At.UTC()
+	if !s.timePolicy().NotFuture(at) {
+		return time.Time{}, validationError("at must not be in the future")
+	}
+	return at, nil
+}
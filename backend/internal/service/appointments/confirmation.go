@@ -0,0 +1,73 @@
+package appointments
+
+import (
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ErrConfirmationRequired is returned when a bulk change over its threshold
+// is applied without a confirmation token from a preview of the same change,
+// or with one that has expired or no longer matches what would change.
+var ErrConfirmationRequired = errors.New("bulk change needs confirmation")
+
+// Bulk changes larger than these must be previewed first, and the preview's
+// confirmation token sent with the change within ConfirmationTTL.
+const (
+	SkipConfirmThreshold     = 20
+	TimeZoneConfirmThreshold = 10
+	ConfirmationTTL          = 10 * time.Minute
+)
+
+const confirmationPrefix = "c1."
+
+// Confirmation is returned by a preview of a bulk change over its threshold.
+type Confirmation struct {
+	Token     string
+	ExpiresAt time.Time
+}
+
+// confirmation returns a token for op by userID over items, the keys of
+// everything the change would touch. The token is a digest, not a signature:
+// it stops a client applying a change nobody looked at, or one whose items
+// have changed since the preview, and the owner could make the same change
+// in batches under the threshold anyway.
+func (s *Service) confirmation(op, userID string, items []string) Confirmation {
+	expires := s.now().Add(ConfirmationTTL).Truncate(time.Second)
+	exp := strconv.FormatInt(expires.Unix(), 10)
+	return Confirmation{
+		Token:     confirmationPrefix + exp + "." + confirmationDigest(op, userID, exp, items),
+		ExpiresAt: expires,
+	}
+}
+
+// checkConfirmation fails with ErrConfirmationRequired unless token was
+// returned by a preview of the same change that has not expired.
+func (s *Service) checkConfirmation(token, op, userID string, items []string) error {
+	rest, ok := strings.CutPrefix(token, confirmationPrefix)
+	if !ok {
+		return fmt.Errorf("%w: %d items would change; preview first", ErrConfirmationRequired, len(items))
+	}
+	exp, digest, ok := strings.Cut(rest, ".")
+	unix, err := strconv.ParseInt(exp, 10, 64)
+	if !ok || err != nil || digest != confirmationDigest(op, userID, exp, items) {
+		return fmt.Errorf("%w: the change no longer matches its preview", ErrConfirmationRequired)
+	}
+	if !s.now().Before(time.Unix(unix, 0)) {
+		return fmt.Errorf("%w: the preview has expired", ErrConfirmationRequired)
+	}
+	return nil
+}
+
+func confirmationDigest(op, userID, exp string, items []string) string {
+	h := sha256.New()
+	for _, part := range append([]string{op, userID, exp}, items...) {
+		h.Write([]byte(part))
+		h.Write([]byte{0})
+	}
+	return base64.RawURLEncoding.EncodeToString(h.Sum(nil))
+}
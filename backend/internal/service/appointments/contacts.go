@@ -0,0 +1,132 @@
+package appointments
+
+import (
+	"context"
+	"net/mail"
+	"strings"
+
+	"github.com/google/uuid"
+
+	"schedula/backend/internal/domain"
+)
+
+const (
+	MaxContactNameLength  = 200
+	MaxContactEmailLength = 254
+	MaxContactPhoneLength = 32
+)
+
+type ContactInput struct {
+	UserID string
+	// ContactID is ignored by CreateContact.
+	ContactID uuid.UUID
+	Name      string
+	Email     string
+	Phone     string
+}
+
+func (s *Service) CreateContact(ctx context.Context, in ContactInput) (domain.Contact, error) {
+	contact, err := contactFromInput(in)
+	if err != nil {
+		return domain.Contact{}, err
+	}
+	if err := s.authorizeOwner(ctx, in.UserID); err != nil {
+		return domain.Contact{}, err
+	}
+	return s.repo.CreateContact(ctx, contact)
+}
+
+// UpdateContact replaces all of a contact's fields; empty email or phone
+// clears them.
+func (s *Service) UpdateContact(ctx context.Context, in ContactInput) (domain.Contact, error) {
+	if in.ContactID == uuid.Nil {
+		return domain.Contact{}, validationError("contact_id is required")
+	}
+	contact, err := contactFromInput(in)
+	if err != nil {
+		return domain.Contact{}, err
+	}
+	contact.ID = in.ContactID
+	if err := s.authorizeOwner(ctx, in.UserID); err != nil {
+		return domain.Contact{}, err
+	}
+	return s.repo.UpdateContact(ctx, contact)
+}
+
+func (s *Service) GetContact(ctx context.Context, userID string, contactID uuid.UUID) (domain.Contact, error) {
+	if userID == "" {
+		return domain.Contact{}, validationError("user_id is required")
+	}
+	if contactID == uuid.Nil {
+		return domain.Contact{}, validationError("contact_id is required")
+	}
+	return s.repo.GetContact(ctx, userID, contactID)
+}
+
+// DeleteContact removes a contact. Its appointments stay, without a contact.
+func (s *Service) DeleteContact(ctx context.Context, userID string, contactID uuid.UUID) error {
+	if userID == "" {
+		return validationError("user_id is required")
+	}
+	if contactID == uuid.Nil {
+		return validationError("contact_id is required")
+	}
+	if err := s.authorizeOwner(ctx, userID); err != nil {
+		return err
+	}
+	return s.repo.DeleteContact(ctx, userID, contactID)
+}
+
+func (s *Service) ListContacts(ctx context.Context, userID string) ([]domain.Contact, error) {
+	if userID == "" {
+		return nil, validationError("user_id is required")
+	}
+	return s.repo.ListContacts(ctx, userID)
+}
+
+func contactFromInput(in ContactInput) (domain.Contact, error) {
+	if in.UserID == "" {
+		return domain.Contact{}, validationError("user_id is required")
+	}
+	name := strings.TrimSpace(in.Name)
+	if name == "" {
+		return domain.Contact{}, validationError("name is required")
+	}
+	if len(name) > MaxContactNameLength {
+		return domain.Contact{}, validationError("name too long")
+	}
+
+	email := strings.TrimSpace(in.Email)
+	if email != "" {
+		addr, err := mail.ParseAddress(email)
+		if err != nil || addr.Address != email || len(email) > MaxContactEmailLength {
+			return domain.Contact{}, validationError("invalid email")
+		}
+	}
+	phone := strings.TrimSpace(in.Phone)
+	if phone != "" && !validPhone(phone) {
+		return domain.Contact{}, validationError("invalid phone")
+	}
+
+	return domain.Contact{UserID: in.UserID, Name: name, Email: email, Phone: phone}, nil
+}
+
+// validPhone accepts the digits, spaces and punctuation people type into
+// phone fields, with at least a few digits. Numbers are not normalized.
+func validPhone(phone string) bool {
+	if len(phone) > MaxContactPhoneLength {
+		return false
+	}
+	digits := 0
+	for i, r := range phone {
+		switch {
+		case r >= '0' && r <= '9':
+			digits++
+		case r == '+' && i == 0:
+		case strings.ContainsRune(" -().", r):
+		default:
+			return false
+		}
+	}
+	return digits >= 3
+}
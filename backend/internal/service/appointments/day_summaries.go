@@ -0,0 +1,163 @@
+package appointments
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"schedula/backend/internal/domain"
+	"schedula/backend/internal/store"
+)
+
+// Day summary bounds. A build covers DaySummaryPastDays before today to
+// DaySummaryAheadDays after it, in the user's slot time zone; ranges outside
+// that are summarized from the calendar on each call.
+const (
+	DaySummaryPastDays  = 92
+	DaySummaryAheadDays = 366
+	MaxDaySummaryDays   = 93
+	// DaySummaryMaxAge is how old a build may get before the refresh job
+	// rebuilds it, so its window keeps up with today.
+	DaySummaryMaxAge = 24 * time.Hour
+)
+
+// DaySummaries is a user's days in one time zone, oldest first. Days with
+// nothing on them are left out.
+type DaySummaries struct {
+	TimeZone string
+	Days     []domain.DaySummary
+}
+
+// GetDaySummaries returns a summary of each local day from from to to, both
+// YYYY-MM-DD and inclusive, in the zone of the user's slot settings. Days are
+// read from the stored summaries when they are current; otherwise only the
+// requested days are summarized from the calendar, so a read after a write
+// sees it, and the refresh job rebuilds the stored ones. It never writes.
+func (s *Service) GetDaySummaries(ctx context.Context, userID, from, to string) (DaySummaries, error) {
+	if userID == "" {
+		return DaySummaries{}, validationError("user_id is required")
+	}
+	first, err := time.Parse(time.DateOnly, strings.TrimSpace(from))
+	if err != nil {
+		return DaySummaries{}, validationError("from_date must be YYYY-MM-DD")
+	}
+	last, err := time.Parse(time.DateOnly, strings.TrimSpace(to))
+	if err != nil {
+		return DaySummaries{}, validationError("to_date must be YYYY-MM-DD")
+	}
+	end := last.AddDate(0, 0, 1)
+	if !end.After(first) {
+		return DaySummaries{}, validationError("to_date must not be before from_date")
+	}
+	if end.Sub(first) > MaxDaySummaryDays*24*time.Hour {
+		return DaySummaries{}, validationError(fmt.Sprintf("at most %d days may be summarized at once", MaxDaySummaryDays))
+	}
+
+	settings, err := s.repo.GetUserSettings(ctx, userID)
+	if err != nil {
+		return DaySummaries{}, err
+	}
+	loc := slotLocation(settings)
+	out := DaySummaries{TimeZone: loc.String()}
+
+	build, err := s.repo.GetDaySummaryBuild(ctx, userID)
+	if err != nil && !errors.Is(err, store.ErrNotFound) {
+		return DaySummaries{}, err
+	}
+	version, err := s.repo.CalendarVersion(ctx, userID)
+	if err != nil {
+		return DaySummaries{}, err
+	}
+	if build.UserID != "" && build.CalendarVersion == version && build.TimeZone == loc.String() && build.Covers(first, end) {
+		out.Days, err = s.repo.ListDaySummaries(ctx, userID, first, end)
+	} else {
+		out.Days, err = s.summarizeDays(ctx, userID, loc, first, end)
+	}
+	if err != nil {
+		return DaySummaries{}, err
+	}
+	return out, nil
+}
+
+// RebuildDaySummaries rebuilds the user's stored day summaries from their
+// calendar and returns how many days have something on them.
+func (s *Service) RebuildDaySummaries(ctx context.Context, userID string) (int, error) {
+	if userID == "" {
+		return 0, validationError("user_id is required")
+	}
+	settings, err := s.repo.GetUserSettings(ctx, userID)
+	if err != nil {
+		return 0, err
+	}
+	_, days, err := s.rebuildDaySummaries(ctx, userID, slotLocation(settings))
+	return len(days), err
+}
+
+// RefreshDaySummaries rebuilds up to limit users whose summaries are behind
+// their calendar or older than DaySummaryMaxAge, and returns how many it
+// rebuilt.
+func (s *Service) RefreshDaySummaries(ctx context.Context, limit int) (int, error) {
+	users, err := s.repo.ListStaleDaySummaryUsers(ctx, s.now().Add(-DaySummaryMaxAge), limit)
+	if err != nil {
+		return 0, err
+	}
+	for i, userID := range users {
+		if _, err := s.RebuildDaySummaries(ctx, userID); err != nil {
+			return i, fmt.Errorf("rebuild day summaries for %s: %w", userID, err)
+		}
+	}
+	return len(users), nil
+}
+
+// ClearDaySummaries drops every stored summary, so each user's are rebuilt
+// on their next read or by the refresh job.
+func (s *Service) ClearDaySummaries(ctx context.Context) error {
+	return s.repo.ClearDaySummaries(ctx)
+}
+
+// rebuildDaySummaries summarizes the build window around today and stores
+// it. The calendar version is read before the calendar, so a write that
+// lands in between leaves the build behind and it is rebuilt again, never
+// marked current with the write missing.
+func (s *Service) rebuildDaySummaries(ctx context.Context, userID string, loc *time.Location) (domain.DaySummaryBuild, []domain.DaySummary, error) {
+	version, err := s.repo.CalendarVersion(ctx, userID)
+	if err != nil {
+		return domain.DaySummaryBuild{}, nil, err
+	}
+	now := s.now().In(loc)
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+	build := domain.DaySummaryBuild{
+		UserID:          userID,
+		TimeZone:        loc.String(),
+		CalendarVersion: version,
+		WindowStart:     today.AddDate(0, 0, -DaySummaryPastDays),
+		WindowEnd:       today.AddDate(0, 0, DaySummaryAheadDays),
+		BuiltAt:         s.now().UTC(),
+	}
+	days, err := s.summarizeDays(ctx, userID, loc, build.WindowStart, build.WindowEnd)
+	if err != nil {
+		return domain.DaySummaryBuild{}, nil, err
+	}
+	if _, err := s.repo.ReplaceDaySummaries(ctx, build, days); err != nil {
+		return domain.DaySummaryBuild{}, nil, err
+	}
+	return build, days, nil
+}
+
+// summarizeDays reads the calendar for the local days in [from, to), both
+// dates at midnight UTC, the same way GetDailyAgenda does.
+func (s *Service) summarizeDays(ctx context.Context, userID string, loc *time.Location, from, to time.Time) ([]domain.DaySummary, error) {
+	start := time.Date(from.Year(), from.Month(), from.Day(), 0, 0, 0, 0, loc)
+	end := time.Date(to.Year(), to.Month(), to.Day(), 0, 0, 0, 0, loc)
+	appts, err := s.repo.List(ctx, userID, start.UTC(), end.UTC(), store.AppointmentFilter{})
+	if err != nil {
+		return nil, err
+	}
+	occs, err := s.repo.ListOccurrences(ctx, userID, start.UTC(), end.UTC())
+	if err != nil {
+		return nil, err
+	}
+	return domain.SummarizeDays(userID, loc, start, end, appts, occs), nil
+}
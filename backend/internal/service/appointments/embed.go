@@ -0,0 +1,237 @@
+package appointments
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"strings"
+	"time"
+
+	"schedula/backend/internal/domain"
+	"schedula/backend/internal/scheduling"
+)
+
+// ErrEmbedDisabled is returned by the embed methods when the server has no
+// embed signing secret.
+var ErrEmbedDisabled = errors.New("embed tokens are not configured")
+
+// ErrInvalidEmbedToken is returned for embed tokens that are malformed,
+// signed with another secret, or expired.
+var ErrInvalidEmbedToken = errors.New("invalid or expired embed token")
+
+// Embed token and slot bounds. Tokens are published in third-party pages, so
+// they expire; the window cap keeps one public read small.
+const (
+	DefaultEmbedTokenTTL     = 30 * 24 * time.Hour
+	MaxEmbedTokenTTL         = 365 * 24 * time.Hour
+	DefaultEmbedSlotDuration = 30 * time.Minute
+	DefaultEmbedWindow       = 7 * 24 * time.Hour
+	MaxEmbedWindow           = 31 * 24 * time.Hour
+	MaxEmbedSlots            = 500
+)
+
+const embedTokenPrefix = "e1."
+
+// EnableEmbedTokens turns on CreateEmbedToken and EmbedSlots, signing tokens
+// with secret. Every server that verifies tokens needs the same secret.
+func (s *Service) EnableEmbedTokens(secret []byte) {
+	if len(secret) == 0 {
+		return
+	}
+	s.embedKey = secret
+}
+
+// EmbedTokenInput configures what a widget shows. SlotDuration is also the
+// step between offered start times. Without WorkingHours every free slot is
+// offered.
+type EmbedTokenInput struct {
+	UserID       string
+	SlotDuration time.Duration
+	WorkingHours *WorkingHoursInput
+	TTL          time.Duration
+}
+
+type EmbedToken struct {
+	Token     string
+	ExpiresAt time.Time
+}
+
+type embedClaims struct {
+	UserID       string             `json:"u"`
+	SlotSeconds  int64              `json:"d"`
+	ExpiresAt    int64              `json:"exp"`
+	WorkingHours *WorkingHoursInput `json:"wh,omitempty"`
+}
+
+// CreateEmbedToken signs a token that lets anyone holding it read the user's
+// open slots, and nothing else, until it expires.
+func (s *Service) CreateEmbedToken(ctx context.Context, in EmbedTokenInput) (EmbedToken, error) {
+	if s.embedKey == nil {
+		return EmbedToken{}, ErrEmbedDisabled
+	}
+	if in.UserID == "" {
+		return EmbedToken{}, validationError("user_id is required")
+	}
+	duration := in.SlotDuration
+	if duration == 0 {
+		duration = DefaultEmbedSlotDuration
+	}
+	if duration < MinSuggestionStep || duration > MaxAppointmentDuration || duration%time.Minute != 0 {
+		return EmbedToken{}, validationError("slot_duration must be whole minutes between 5m and 24h")
+	}
+	ttl := in.TTL
+	if ttl == 0 {
+		ttl = DefaultEmbedTokenTTL
+	}
+	if ttl < 0 || ttl > MaxEmbedTokenTTL {
+		return EmbedToken{}, validationError("ttl out of range")
+	}
+	if in.WorkingHours != nil {
+		if _, err := workingHours(*in.WorkingHours); err != nil {
+			return EmbedToken{}, err
+		}
+	}
+	if err := s.authorizeOwner(ctx, in.UserID); err != nil {
+		return EmbedToken{}, err
+	}
+
+	expires := s.now().UTC().Add(ttl).Truncate(time.Second)
+	claims := embedClaims{
+		UserID:       in.UserID,
+		SlotSeconds:  int64(duration / time.Second),
+		ExpiresAt:    expires.Unix(),
+		WorkingHours: in.WorkingHours,
+	}
+	payload, err := json.Marshal(claims)
+	if err != nil {
+		return EmbedToken{}, err
+	}
+	body := embedTokenPrefix + base64.RawURLEncoding.EncodeToString(payload)
+	return EmbedToken{Token: body + "." + s.embedSignature(body), ExpiresAt: expires}, nil
+}
+
+func (s *Service) embedSignature(body string) string {
+	mac := hmac.New(sha256.New, s.embedKey)
+	mac.Write([]byte(body))
+	return base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
+
+func (s *Service) verifyEmbedToken(token string) (embedClaims, error) {
+	rest, ok := strings.CutPrefix(token, embedTokenPrefix)
+	if !ok {
+		return embedClaims{}, ErrInvalidEmbedToken
+	}
+	encoded, sig, ok := strings.Cut(rest, ".")
+	if !ok || !hmac.Equal([]byte(sig), []byte(s.embedSignature(embedTokenPrefix+encoded))) {
+		return embedClaims{}, ErrInvalidEmbedToken
+	}
+	payload, err := base64.RawURLEncoding.DecodeString(encoded)
+	if err != nil {
+		return embedClaims{}, ErrInvalidEmbedToken
+	}
+	var claims embedClaims
+	if err := json.Unmarshal(payload, &claims); err != nil || claims.UserID == "" || claims.SlotSeconds <= 0 {
+		return embedClaims{}, ErrInvalidEmbedToken
+	}
+	if !s.now().Before(time.Unix(claims.ExpiresAt, 0)) {
+		return embedClaims{}, ErrInvalidEmbedToken
+	}
+	return claims, nil
+}
+
+// EmbedAvailability is what a widget renders. TimeZone is the working hours
+// zone, empty when the token has none.
+type EmbedAvailability struct {
+	Slots        []scheduling.Suggestion
+	SlotDuration time.Duration
+	TimeZone     string
+	ExpiresAt    time.Time
+}
+
+// EmbedSlots returns the open slots for the token's user in the window,
+// earliest first, on the user's slot alignment grid. A zero window means the
+// next DefaultEmbedWindow, and slots never start before the minimum booking
+// notice. Appointments, series occurrences, time off, daily breaks and
+// block-mode blackouts all take time away; holds are short-lived and are not
+// consulted.
+func (s *Service) EmbedSlots(ctx context.Context, token string, windowStart, windowEnd time.Time) (EmbedAvailability, error) {
+	if s.embedKey == nil {
+		return EmbedAvailability{}, ErrEmbedDisabled
+	}
+	claims, err := s.verifyEmbedToken(token)
+	if err != nil {
+		return EmbedAvailability{}, err
+	}
+	// A frozen calendar's links stop working, and work again once the user
+	// is reactivated if they have not expired by then.
+	if err := s.checkActive(ctx, claims.UserID); err != nil {
+		if errors.Is(err, ErrUserDeactivated) {
+			return EmbedAvailability{}, ErrInvalidEmbedToken
+		}
+		return EmbedAvailability{}, err
+	}
+
+	earliest := s.timePolicy().EarliestStart()
+	start := windowStart.UTC()
+	if start.IsZero() || start.Before(earliest) {
+		start = earliest
+	}
+	end := windowEnd.UTC()
+	if end.IsZero() {
+		end = start.Add(DefaultEmbedWindow)
+	}
+	if !end.After(start) {
+		return EmbedAvailability{}, validationError("end must be after start")
+	}
+	if end.Sub(start) > MaxEmbedWindow {
+		return EmbedAvailability{}, validationError("window too long")
+	}
+
+	attendee := scheduling.Attendee{ID: claims.UserID}
+	out := EmbedAvailability{SlotDuration: time.Duration(claims.SlotSeconds) * time.Second, ExpiresAt: time.Unix(claims.ExpiresAt, 0).UTC()}
+	if claims.WorkingHours != nil {
+		wh, err := workingHours(*claims.WorkingHours)
+		if err != nil {
+			return EmbedAvailability{}, ErrInvalidEmbedToken
+		}
+		attendee.WorkingHours = &wh
+		out.TimeZone = wh.Location.String()
+	}
+
+	attendee.Busy, err = s.userBusy(ctx, claims.UserID, start, end)
+	if err != nil {
+		return EmbedAvailability{}, err
+	}
+	blackouts, err := s.repo.ListBlackouts(ctx, start, end)
+	if err != nil {
+		return EmbedAvailability{}, err
+	}
+	for _, b := range blackouts {
+		if b.Mode == domain.BlackoutModeBlock {
+			attendee.Busy = append(attendee.Busy, domain.BusyInterval{Start: b.StartTime, End: b.EndTime})
+		}
+	}
+
+	// Offer every start the user's alignment allows; without one, slots sit
+	// back to back.
+	settings, err := s.repo.GetUserSettings(ctx, claims.UserID)
+	if err != nil {
+		return EmbedAvailability{}, err
+	}
+	c := scheduling.Constraints{
+		WindowStart: start,
+		WindowEnd:   end,
+		Duration:    out.SlotDuration,
+		Step:        out.SlotDuration,
+		MaxResults:  MaxEmbedSlots,
+	}
+	if settings.SlotAlignmentMinutes > 0 {
+		c.Step = time.Duration(settings.SlotAlignmentMinutes) * time.Minute
+		c.Location = slotLocation(settings)
+	}
+	out.Slots = scheduling.FreeSlots(attendee, c)
+	return out, nil
+}
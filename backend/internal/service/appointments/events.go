@@ -0,0 +1,29 @@
+package appointments
+
+import (
+	"context"
+
+	"schedula/backend/internal/events"
+)
+
+// Events returns the bus the service publishes domain events on once a
+// write has committed. Subsystems outside the service subscribe here.
+func (s *Service) Events() *events.Bus {
+	return s.events
+}
+
+// subscribeSeriesListeners keeps the occurrence cache and series watchers in
+// step with series writes made through this process.
+func (s *Service) subscribeSeriesListeners() {
+	events.Subscribe(s.events, func(ctx context.Context, e events.SeriesCreated) {
+		s.invalidateOccurrences(e.UserID)
+	})
+	events.Subscribe(s.events, func(ctx context.Context, e events.SeriesUpdated) {
+		s.invalidateOccurrences(e.UserID)
+		s.watchers.notify(e.SeriesID)
+	})
+	events.Subscribe(s.events, func(ctx context.Context, e events.ExceptionUpserted) {
+		s.invalidateOccurrences(e.UserID)
+		s.watchers.notify(e.SeriesID)
+	})
+}
@@ -0,0 +1,42 @@
+package appointments
+
+import "time"
+
+// ExpiryTTLs are the lifetimes of slot holds and proposals: the default
+// applied when a request names none, and the longest a request may ask for.
+type ExpiryTTLs struct {
+	HoldDefault     time.Duration
+	HoldMax         time.Duration
+	ProposalDefault time.Duration
+	ProposalMax     time.Duration
+}
+
+// DefaultExpiryTTLs returns the built-in lifetimes.
+func DefaultExpiryTTLs() ExpiryTTLs {
+	return ExpiryTTLs{
+		HoldDefault:     DefaultHoldTTL,
+		HoldMax:         MaxHoldTTL,
+		ProposalDefault: DefaultProposalTTL,
+		ProposalMax:     MaxProposalTTL,
+	}
+}
+
+// SetExpiryTTLs replaces the hold and proposal lifetimes. A zero field keeps
+// its built-in value, and a default longer than its maximum is cut to it.
+func (s *Service) SetExpiryTTLs(t ExpiryTTLs) {
+	d := DefaultExpiryTTLs()
+	pick := func(v, fallback time.Duration) time.Duration {
+		if v > 0 {
+			return v
+		}
+		return fallback
+	}
+	s.expiry = ExpiryTTLs{
+		HoldDefault:     pick(t.HoldDefault, d.HoldDefault),
+		HoldMax:         pick(t.HoldMax, d.HoldMax),
+		ProposalDefault: pick(t.ProposalDefault, d.ProposalDefault),
+		ProposalMax:     pick(t.ProposalMax, d.ProposalMax),
+	}
+	s.expiry.HoldDefault = min(s.expiry.HoldDefault, s.expiry.HoldMax)
+	s.expiry.ProposalDefault = min(s.expiry.ProposalDefault, s.expiry.ProposalMax)
+}
@@ -0,0 +1,66 @@
+package appointments
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"schedula/backend/internal/events"
+	"schedula/backend/internal/store"
+)
+
+// MaxMergeDuplicates caps how many duplicates one merge deletes.
+const MaxMergeDuplicates = 50
+
+// MergeInput names the appointment to keep and the duplicates to fold into
+// it. With CoverDuplicates set the kept appointment widens to the earliest
+// start and latest end among them.
+type MergeInput struct {
+	UserID          string
+	PrimaryID       uuid.UUID
+	DuplicateIDs    []uuid.UUID
+	CoverDuplicates bool
+}
+
+// MergeAppointments folds duplicates, such as the copies an import and a
+// sync both created, into the primary appointment and deletes them, all in
+// one transaction.
+func (s *Service) MergeAppointments(ctx context.Context, in MergeInput) (store.AppointmentMerge, error) {
+	if in.UserID == "" {
+		return store.AppointmentMerge{}, validationError("user_id is required")
+	}
+	if in.PrimaryID == uuid.Nil {
+		return store.AppointmentMerge{}, validationError("primary_id is required")
+	}
+	if len(in.DuplicateIDs) == 0 {
+		return store.AppointmentMerge{}, validationError("duplicate_ids is required")
+	}
+	if len(in.DuplicateIDs) > MaxMergeDuplicates {
+		return store.AppointmentMerge{}, validationError(fmt.Sprintf("at most %d duplicates may be merged at once", MaxMergeDuplicates))
+	}
+	seen := make(map[uuid.UUID]bool, len(in.DuplicateIDs))
+	for _, id := range in.DuplicateIDs {
+		switch {
+		case id == uuid.Nil:
+			return store.AppointmentMerge{}, validationError("duplicate_ids must not be empty")
+		case id == in.PrimaryID:
+			return store.AppointmentMerge{}, validationError("an appointment cannot be merged into itself")
+		case seen[id]:
+			return store.AppointmentMerge{}, validationError("duplicate_ids must not repeat")
+		}
+		seen[id] = true
+	}
+	if err := s.authorizeOwner(ctx, in.UserID); err != nil {
+		return store.AppointmentMerge{}, err
+	}
+	merged, err := s.repo.MergeAppointments(ctx, in.UserID, in.PrimaryID, in.DuplicateIDs, in.CoverDuplicates, s.now().UTC())
+	if err != nil {
+		return store.AppointmentMerge{}, err
+	}
+	s.events.Publish(ctx, events.AppointmentUpdated{UserID: in.UserID, AppointmentID: merged.Appointment.ID})
+	for _, id := range merged.Deleted {
+		s.events.Publish(ctx, events.AppointmentDeleted{UserID: in.UserID, AppointmentID: id})
+	}
+	return merged, nil
+}
@@ -0,0 +1,224 @@
+package appointments
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"schedula/backend/internal/domain"
+)
+
+// OccurrenceCacheDays is how far ahead the occurrence cache expands each
+// user's calendar, counted from the start of the current UTC day.
+const OccurrenceCacheDays = 7
+
+// occurrenceCacheIdle is how long a user's entry survives without a read
+// before the refresh job stops keeping it warm.
+const occurrenceCacheIdle = 24 * time.Hour
+
+type occurrenceCacheEntry struct {
+	start    time.Time
+	end      time.Time
+	occs     []domain.RecurringOccurrence
+	version  int64
+	loadedAt time.Time
+	readAt   time.Time
+}
+
+// occurrenceCache holds each recently active user's expanded occurrences for
+// the coming week. Writes through this process invalidate the user's entry.
+// Each entry also records the calendar version read before it was loaded, and
+// a read that finds a newer version reloads, so writes through other
+// instances are never served stale.
+type occurrenceCache struct {
+	ttl     time.Duration
+	mu      sync.Mutex
+	entries map[string]*occurrenceCacheEntry
+	gens    map[string]uint64
+}
+
+func newOccurrenceCache(ttl time.Duration) *occurrenceCache {
+	return &occurrenceCache{
+		ttl:     ttl,
+		entries: make(map[string]*occurrenceCacheEntry),
+		gens:    make(map[string]uint64),
+	}
+}
+
+// cacheWindow returns the window cached for a read at now. It runs one day
+// past OccurrenceCacheDays so "the next seven days" starting mid-day fits.
+func cacheWindow(now time.Time) (time.Time, time.Time) {
+	start := now.UTC().Truncate(24 * time.Hour)
+	return start, start.AddDate(0, 0, OccurrenceCacheDays+1)
+}
+
+// get returns the cached occurrences overlapping [start, end) when a fresh
+// entry loaded at version covers the whole window.
+func (c *occurrenceCache) get(userID string, start, end, now time.Time, version int64) ([]domain.RecurringOccurrence, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	e, ok := c.entries[userID]
+	if !ok || e.version != version || now.Sub(e.loadedAt) >= c.ttl {
+		return nil, false
+	}
+	if start.Before(e.start) || end.After(e.end) {
+		return nil, false
+	}
+	e.readAt = now
+	out := make([]domain.RecurringOccurrence, 0, len(e.occs))
+	for _, occ := range e.occs {
+		if occ.StartTime.Before(end) && occ.EndTime.After(start) {
+			out = append(out, occ)
+		}
+	}
+	return out, true
+}
+
+// generation returns the user's invalidation counter. Loads capture it before
+// reading so a write that lands mid-load is not overwritten by stale data.
+func (c *occurrenceCache) generation(userID string) uint64 {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.gens[userID]
+}
+
+func (c *occurrenceCache) put(userID string, gen uint64, start, end time.Time, occs []domain.RecurringOccurrence, version int64, now time.Time) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.gens[userID] != gen {
+		return
+	}
+	readAt := now
+	if prev, ok := c.entries[userID]; ok {
+		readAt = prev.readAt
+	}
+	c.entries[userID] = &occurrenceCacheEntry{
+		start:    start,
+		end:      end,
+		occs:     occs,
+		version:  version,
+		loadedAt: now,
+		readAt:   readAt,
+	}
+}
+
+func (c *occurrenceCache) version(userID string) int64 {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if e, ok := c.entries[userID]; ok {
+		return e.version
+	}
+	return -1
+}
+
+func (c *occurrenceCache) invalidate(userID string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.gens[userID]++
+	delete(c.entries, userID)
+}
+
+// active returns the users read within occurrenceCacheIdle and drops the
+// rest.
+func (c *occurrenceCache) active(now time.Time) []string {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	users := make([]string, 0, len(c.entries))
+	for userID, e := range c.entries {
+		if now.Sub(e.readAt) >= occurrenceCacheIdle {
+			delete(c.entries, userID)
+			delete(c.gens, userID)
+			continue
+		}
+		users = append(users, userID)
+	}
+	return users
+}
+
+// EnableOccurrenceCache turns on the next-week occurrence cache. ListOccurrences
+// serves windows inside the cached week from memory, and entries older than
+// ttl are reloaded on read. RefreshOccurrenceCache keeps active users warm.
+func (s *Service) EnableOccurrenceCache(ttl time.Duration) {
+	if ttl <= 0 {
+		return
+	}
+	s.occCache = newOccurrenceCache(ttl)
+}
+
+// RefreshOccurrenceCache reloads the cached week for every user read recently
+// and returns how many entries it refreshed. A failed user keeps its old
+// entry until the TTL expires; the first such error is returned after the
+// rest are refreshed. It is a no-op when the cache is disabled.
+func (s *Service) RefreshOccurrenceCache(ctx context.Context) (int, error) {
+	if s.occCache == nil {
+		return 0, nil
+	}
+	refreshed := 0
+	var firstErr error
+	for _, userID := range s.occCache.active(s.now()) {
+		if err := ctx.Err(); err != nil {
+			return refreshed, err
+		}
+		if _, err := s.loadOccurrenceCache(ctx, userID); err != nil {
+			if firstErr == nil {
+				firstErr = err
+			}
+			continue
+		}
+		refreshed++
+	}
+	return refreshed, firstErr
+}
+
+func (s *Service) loadOccurrenceCache(ctx context.Context, userID string) ([]domain.RecurringOccurrence, error) {
+	now := s.now().UTC()
+	gen := s.occCache.generation(userID)
+	version, err := s.repo.CalendarVersion(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	start, end := cacheWindow(now)
+	occs, err := s.repo.ListOccurrences(ctx, userID, start, end)
+	if err != nil {
+		return nil, err
+	}
+	s.occCache.put(userID, gen, start, end, occs, version, now)
+	return occs, nil
+}
+
+// cachedOccurrences serves ListOccurrences from the cache when the window
+// falls inside the cached week. ok is false when the caller should read
+// through to the repository.
+func (s *Service) cachedOccurrences(ctx context.Context, userID string, start, end time.Time) ([]domain.RecurringOccurrence, bool, error) {
+	if s.occCache == nil {
+		return nil, false, nil
+	}
+	now := s.now().UTC()
+	cacheStart, cacheEnd := cacheWindow(now)
+	if start.Before(cacheStart) || end.After(cacheEnd) {
+		return nil, false, nil
+	}
+	version, err := s.repo.CalendarVersion(ctx, userID)
+	if err != nil {
+		return nil, false, err
+	}
+	if occs, ok := s.occCache.get(userID, start, end, now, version); ok {
+		return occs, true, nil
+	}
+	if _, err := s.loadOccurrenceCache(ctx, userID); err != nil {
+		return nil, false, err
+	}
+	// The reload may have seen a newer version than ours; either is at least
+	// as new as what the caller was told.
+	occs, ok := s.occCache.get(userID, start, end, now, s.occCache.version(userID))
+	return occs, ok, nil
+}
+
+func (s *Service) invalidateOccurrences(userID string) {
+	if s.occCache != nil {
+		s.occCache.invalidate(userID)
+	}
+}
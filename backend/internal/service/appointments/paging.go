@@ -0,0 +1,63 @@
+package appointments
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+
+	"schedula/backend/internal/domain"
+)
+
+// ErrPageSnapshotStale is returned when a paged listing cannot continue from
+// the snapshot its first page was taken at without dropping or repeating
+// appointments. The caller restarts from the first page.
+var ErrPageSnapshotStale = errors.New("calendar changed since the first page")
+
+// MaxPageSnapshotDelta is the most change log entries a later page replays
+// to stay on its snapshot, the same bound as sync tokens.
+const MaxPageSnapshotDelta = MaxSyncDelta
+
+// PageSnapshot returns the change log seq a paged listing is pinned to. Like
+// a sync token's head it is read before the first page's data, so a write in
+// between is left out of later pages rather than half-seen.
+func (s *Service) PageSnapshot(ctx context.Context, userID string) (int64, error) {
+	if userID == "" {
+		return 0, validationError("user_id is required")
+	}
+	return s.repo.LatestChangeSeq(ctx, userID)
+}
+
+// AppointmentsAddedSince returns the appointments created after seq. Later
+// pages leave them out, so paging sees the calendar as of its first page;
+// deleted appointments are simply gone. An appointment updated since may
+// have moved across the page boundary and would be dropped or listed twice,
+// so that, like more than MaxPageSnapshotDelta changes, is
+// ErrPageSnapshotStale.
+func (s *Service) AppointmentsAddedSince(ctx context.Context, userID string, seq int64) (map[uuid.UUID]bool, error) {
+	if userID == "" {
+		return nil, validationError("user_id is required")
+	}
+	changes, err := s.repo.ListChanges(ctx, userID, seq, MaxPageSnapshotDelta+1)
+	if err != nil {
+		return nil, err
+	}
+	if len(changes) > MaxPageSnapshotDelta {
+		return nil, ErrPageSnapshotStale
+	}
+	added := make(map[uuid.UUID]bool)
+	for _, c := range changes {
+		if c.EntityType != domain.ChangeEntityAppointment {
+			continue
+		}
+		switch c.Op {
+		case domain.ChangeOpCreated:
+			added[c.EntityID] = true
+		case domain.ChangeOpUpdated:
+			if !added[c.EntityID] {
+				return nil, ErrPageSnapshotStale
+			}
+		}
+	}
+	return added, nil
+}
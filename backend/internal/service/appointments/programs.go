@@ -0,0 +1,135 @@
+package appointments
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/google/uuid"
+
+	"schedula/backend/internal/domain"
+	"schedula/backend/internal/events"
+	"schedula/backend/internal/store"
+)
+
+type ProgramInput struct {
+	UserID string
+	Title  string
+	Notes  string
+}
+
+// ProgramOverview is a program with its members and progress. Series carry
+// their remaining-occurrence fields.
+type ProgramOverview struct {
+	Program      domain.Program
+	Appointments []domain.Appointment
+	Series       []domain.RecurringSeries
+	Progress     domain.ProgramProgress
+}
+
+func (s *Service) CreateProgram(ctx context.Context, in ProgramInput) (domain.Program, error) {
+	if in.UserID == "" {
+		return domain.Program{}, validationError("user_id is required")
+	}
+	title := strings.TrimSpace(in.Title)
+	if title == "" {
+		return domain.Program{}, validationError("title is required")
+	}
+	if err := s.checkText(ctx, title, in.Notes); err != nil {
+		return domain.Program{}, err
+	}
+	if err := s.authorizeOwner(ctx, in.UserID); err != nil {
+		return domain.Program{}, err
+	}
+	return s.repo.CreateProgram(ctx, domain.Program{UserID: in.UserID, Title: title, Notes: in.Notes})
+}
+
+func (s *Service) ListPrograms(ctx context.Context, userID string) ([]domain.Program, error) {
+	if userID == "" {
+		return nil, validationError("user_id is required")
+	}
+	return s.repo.ListPrograms(ctx, userID)
+}
+
+// GetProgram returns the program's one-offs and series and counts its
+// sessions over each series' whole run, skips and moves applied.
+func (s *Service) GetProgram(ctx context.Context, userID string, programID uuid.UUID) (ProgramOverview, error) {
+	if userID == "" {
+		return ProgramOverview{}, validationError("user_id is required")
+	}
+	if programID == uuid.Nil {
+		return ProgramOverview{}, validationError("program_id is required")
+	}
+	program, err := s.repo.GetProgram(ctx, userID, programID)
+	if err != nil {
+		return ProgramOverview{}, err
+	}
+	members, err := s.repo.ListProgramMembers(ctx, userID, programID)
+	if err != nil {
+		return ProgramOverview{}, err
+	}
+
+	now := s.now().UTC()
+	out := ProgramOverview{Program: program, Appointments: members.Appointments}
+	for _, a := range members.Appointments {
+		out.Progress.Add(a.StartTime, a.EndTime, now)
+	}
+	for _, series := range members.Series {
+		occs, err := s.repo.ListSeriesOccurrences(ctx, series, series.DTStart, domain.SeriesHorizonEnd(series, store.RecurringConflictLookahead))
+		if err != nil {
+			return ProgramOverview{}, err
+		}
+		for _, o := range occs {
+			out.Progress.Add(o.StartTime, o.EndTime, now)
+		}
+		out.Series = append(out.Series, series.WithProgress(occs, now))
+	}
+	return out, nil
+}
+
+// CancelProgram cancels every session of the program that has not started:
+// see store.AppointmentRepository.CancelProgram.
+func (s *Service) CancelProgram(ctx context.Context, userID string, programID uuid.UUID) (store.ProgramCancellation, error) {
+	if userID == "" {
+		return store.ProgramCancellation{}, validationError("user_id is required")
+	}
+	if programID == uuid.Nil {
+		return store.ProgramCancellation{}, validationError("program_id is required")
+	}
+	if err := s.authorizeOwner(ctx, userID); err != nil {
+		return store.ProgramCancellation{}, err
+	}
+	members, err := s.repo.ListProgramMembers(ctx, userID, programID)
+	if err != nil {
+		return store.ProgramCancellation{}, err
+	}
+	out, err := s.repo.CancelProgram(ctx, userID, programID, s.now().UTC())
+	if err != nil {
+		return store.ProgramCancellation{}, err
+	}
+	for _, id := range out.AppointmentsDeleted {
+		s.events.Publish(ctx, events.AppointmentDeleted{UserID: userID, AppointmentID: id})
+	}
+	if out.SeriesEnded+out.SeriesDeleted > 0 {
+		for _, series := range members.Series {
+			s.events.Publish(ctx, events.SeriesUpdated{UserID: userID, SeriesID: series.ID})
+		}
+	}
+	return out, nil
+}
+
+// checkProgram requires programID to name one of the user's programs that
+// still takes members.
+func (s *Service) checkProgram(ctx context.Context, userID string, programID uuid.UUID) error {
+	program, err := s.repo.GetProgram(ctx, userID, programID)
+	if errors.Is(err, store.ErrNotFound) {
+		return validationError("program_id does not name one of this user's programs")
+	}
+	if err != nil {
+		return err
+	}
+	if program.CancelledAt != nil {
+		return validationError("program is cancelled")
+	}
+	return nil
+}
@@ -0,0 +1,194 @@
+package appointments
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"schedula/backend/internal/domain"
+	"schedula/backend/internal/events"
+	"schedula/backend/internal/store"
+)
+
+// A proposal waits on another person rather than on a checkout step, so it
+// lives much longer than a booking hold. It never outlives its start. These
+// are the built-in values; see SetExpiryTTLs.
+const (
+	DefaultProposalTTL = 48 * time.Hour
+	MaxProposalTTL     = 14 * 24 * time.Hour
+)
+
+// ErrProposalClosed is returned when a proposal is answered after it was
+// accepted, declined or expired.
+var ErrProposalClosed = errors.New("proposal is no longer pending")
+
+type ProposeAppointmentInput struct {
+	ProposerID  string
+	RecipientID string
+	Title       string
+	Notes       string
+	StartTime   time.Time
+	EndTime     time.Time
+	TTL         time.Duration
+}
+
+// ProposeAppointment offers a time to another user. The slot is held on the
+// proposer's calendar until the recipient answers or the proposal expires;
+// the recipient's calendar is only checked on acceptance.
+func (s *Service) ProposeAppointment(ctx context.Context, in ProposeAppointmentInput) (domain.AppointmentProposal, error) {
+	if in.ProposerID == "" || in.RecipientID == "" {
+		return domain.AppointmentProposal{}, validationError("proposer_id and recipient_id are required")
+	}
+	if in.ProposerID == in.RecipientID {
+		return domain.AppointmentProposal{}, validationError("recipient_id must differ from proposer_id")
+	}
+	title := strings.TrimSpace(in.Title)
+	if title == "" {
+		return domain.AppointmentProposal{}, validationError("title is required")
+	}
+	if err := s.checkText(ctx, title, in.Notes); err != nil {
+		return domain.AppointmentProposal{}, err
+	}
+
+	start := in.StartTime.UTC()
+	end := in.EndTime.UTC()
+	if !end.After(start) {
+		return domain.AppointmentProposal{}, validationError("end_time must be after start_time")
+	}
+	if end.Sub(start) > MaxAppointmentDuration {
+		return domain.AppointmentProposal{}, validationError("duration too long")
+	}
+	ttl := in.TTL
+	if ttl == 0 {
+		ttl = s.expiry.ProposalDefault
+	}
+	if ttl < 0 {
+		return domain.AppointmentProposal{}, validationError("ttl must not be negative")
+	}
+	if ttl > s.expiry.ProposalMax {
+		return domain.AppointmentProposal{}, validationError("ttl too long")
+	}
+	if policy := s.timePolicy(); !policy.NotPast(start) {
+		return domain.AppointmentProposal{}, validationError("start_time is in the past")
+	} else if !policy.MeetsNotice(start) {
+		return domain.AppointmentProposal{}, validationError("start_time is within the minimum booking notice")
+	}
+	if err := s.checkSlotAlignment(ctx, in.ProposerID, start); err != nil {
+		return domain.AppointmentProposal{}, err
+	}
+	if _, err := s.checkBlackouts(ctx, []domain.BusyInterval{{Start: start, End: end}}); err != nil {
+		return domain.AppointmentProposal{}, err
+	}
+	if err := s.checkActive(ctx, in.ProposerID, in.RecipientID); err != nil {
+		return domain.AppointmentProposal{}, err
+	}
+
+	expiresAt := s.now().UTC().Add(ttl)
+	if expiresAt.After(start) {
+		expiresAt = start
+	}
+	return s.repo.CreateProposal(ctx, domain.AppointmentProposal{
+		ProposerID:  in.ProposerID,
+		RecipientID: in.RecipientID,
+		Title:       title,
+		Notes:       in.Notes,
+		StartTime:   start,
+		EndTime:     end,
+		ExpiresAt:   expiresAt,
+	})
+}
+
+// ListProposals returns the pending proposals the user sent or received.
+func (s *Service) ListProposals(ctx context.Context, userID string) ([]domain.AppointmentProposal, error) {
+	if userID == "" {
+		return nil, validationError("user_id is required")
+	}
+	return s.repo.ListProposals(ctx, userID)
+}
+
+// AcceptProposal books the proposal on both calendars in one transaction, or
+// on neither. Only the recipient may accept. The appointment ids are derived
+// from the proposal, so accepting again returns the accepted proposal.
+func (s *Service) AcceptProposal(ctx context.Context, userID string, proposalID uuid.UUID) (domain.AppointmentProposal, error) {
+	p, err := s.proposalFor(ctx, userID, proposalID)
+	if err != nil {
+		return domain.AppointmentProposal{}, err
+	}
+	if p.RecipientID != userID {
+		return domain.AppointmentProposal{}, ErrNotAuthorized
+	}
+	if err := s.checkActive(ctx, p.ProposerID, p.RecipientID); err != nil {
+		return domain.AppointmentProposal{}, err
+	}
+
+	book := func(owner string) domain.Appointment {
+		return domain.Appointment{
+			ID:     uuid.NewSHA1(uuid.NameSpaceOID, []byte("schedula:accept_proposal:"+owner+":"+p.ID.String())),
+			UserID: owner,
+			Title:  p.Title,
+			Notes:  p.Notes,
+			Source: domain.SourceProposal,
+		}
+	}
+	accepted, err := s.repo.AcceptProposal(ctx, p.ID, book(p.ProposerID), book(p.RecipientID), s.now().UTC())
+	if err != nil {
+		return domain.AppointmentProposal{}, err
+	}
+	if accepted.Status != domain.ProposalStatusAccepted {
+		return domain.AppointmentProposal{}, ErrProposalClosed
+	}
+	if accepted.ProposerAppointmentID != nil {
+		s.events.Publish(ctx, events.AppointmentCreated{UserID: accepted.ProposerID, AppointmentID: *accepted.ProposerAppointmentID})
+	}
+	if accepted.RecipientAppointmentID != nil {
+		s.events.Publish(ctx, events.AppointmentCreated{UserID: accepted.RecipientID, AppointmentID: *accepted.RecipientAppointmentID})
+	}
+	return accepted, nil
+}
+
+// DeclineProposal closes the proposal and frees the proposer's slot. The
+// recipient declines with it; the proposer withdraws with it.
+func (s *Service) DeclineProposal(ctx context.Context, userID string, proposalID uuid.UUID) (domain.AppointmentProposal, error) {
+	if _, err := s.proposalFor(ctx, userID, proposalID); err != nil {
+		return domain.AppointmentProposal{}, err
+	}
+	if err := s.authorizeOwner(ctx, userID); err != nil {
+		return domain.AppointmentProposal{}, err
+	}
+	declined, err := s.repo.DeclineProposal(ctx, proposalID, s.now().UTC())
+	if err != nil {
+		return domain.AppointmentProposal{}, err
+	}
+	if declined.Status != domain.ProposalStatusDeclined {
+		return domain.AppointmentProposal{}, ErrProposalClosed
+	}
+	return declined, nil
+}
+
+// ExpireProposals marks pending proposals past their expiry as expired.
+// Both sides see the expiry in their change logs.
+func (s *Service) ExpireProposals(ctx context.Context) (int, error) {
+	return s.repo.ExpireProposals(ctx, s.now().UTC())
+}
+
+// proposalFor loads a proposal the user is a party to. Other users get
+// store.ErrNotFound, so proposal ids cannot be probed.
+func (s *Service) proposalFor(ctx context.Context, userID string, proposalID uuid.UUID) (domain.AppointmentProposal, error) {
+	if userID == "" {
+		return domain.AppointmentProposal{}, validationError("user_id is required")
+	}
+	if proposalID == uuid.Nil {
+		return domain.AppointmentProposal{}, validationError("proposal_id is required")
+	}
+	p, err := s.repo.GetProposal(ctx, proposalID)
+	if err != nil {
+		return domain.AppointmentProposal{}, err
+	}
+	if p.ProposerID != userID && p.RecipientID != userID {
+		return domain.AppointmentProposal{}, store.ErrNotFound
+	}
+	return p, nil
+}
@@ -0,0 +1,221 @@
+package appointments
+
+import (
+	"context"
+	"errors"
+	"net/mail"
+	"slices"
+	"strings"
+
+	"github.com/google/uuid"
+
+	"schedula/backend/internal/domain"
+	"schedula/backend/internal/store"
+)
+
+// ErrUserDeactivated is returned when a write would change a deactivated
+// user's calendar, which is frozen, or be made by a deactivated delegate.
+// Reads still work, and the calendar is kept as it was for reactivation.
+var ErrUserDeactivated = errors.New("user is deactivated")
+
+// ErrProvisioningDisabled is returned by the provisioning methods when the
+// identity directory does not keep users, as in standalone mode.
+var ErrProvisioningDisabled = errors.New("user provisioning is disabled")
+
+const (
+	MaxDisplayNameLength   = 200
+	MaxExternalIDLength    = 255
+	MaxUserGroupNameLength = 200
+	// MaxUserGroupMembers bounds one SetUserGroupMembers call.
+	MaxUserGroupMembers = 1000
+)
+
+// ProvisionUserInput is a user as an identity system sees them. Provisioning
+// an existing user replaces all of these fields.
+type ProvisionUserInput struct {
+	UserID      string
+	DisplayName string
+	Email       string
+	ExternalID  string
+	Active      bool
+}
+
+// ProvisionUser creates or replaces a provisioned user. Setting Active to
+// false deactivates them; setting it to true again reactivates them.
+func (s *Service) ProvisionUser(ctx context.Context, in ProvisionUserInput) (domain.ProvisionedUser, error) {
+	if !s.identity.Provisions() {
+		return domain.ProvisionedUser{}, ErrProvisioningDisabled
+	}
+	if in.UserID == "" {
+		return domain.ProvisionedUser{}, validationError("user_id is required")
+	}
+	name := strings.TrimSpace(in.DisplayName)
+	if name == "" {
+		return domain.ProvisionedUser{}, validationError("display_name is required")
+	}
+	if len(name) > MaxDisplayNameLength {
+		return domain.ProvisionedUser{}, validationError("display_name too long")
+	}
+	email := strings.TrimSpace(in.Email)
+	if email != "" {
+		addr, err := mail.ParseAddress(email)
+		if err != nil || addr.Address != email || len(email) > MaxContactEmailLength {
+			return domain.ProvisionedUser{}, validationError("invalid email")
+		}
+	}
+	externalID := strings.TrimSpace(in.ExternalID)
+	if len(externalID) > MaxExternalIDLength {
+		return domain.ProvisionedUser{}, validationError("external_id too long")
+	}
+
+	user := domain.ProvisionedUser{UserID: in.UserID, DisplayName: name, Email: email, ExternalID: externalID, Active: in.Active}
+	if !in.Active {
+		existing, err := s.repo.GetProvisionedUser(ctx, in.UserID)
+		switch {
+		case err == nil && !existing.Active:
+			user.DeactivatedAt = existing.DeactivatedAt
+		case err == nil, errors.Is(err, store.ErrNotFound):
+			now := s.now().UTC()
+			user.DeactivatedAt = &now
+		default:
+			return domain.ProvisionedUser{}, err
+		}
+	}
+	out, err := s.repo.UpsertProvisionedUser(ctx, user)
+	if errors.Is(err, store.ErrDuplicate) {
+		return domain.ProvisionedUser{}, validationError("external_id belongs to another user")
+	}
+	return out, err
+}
+
+// DeactivateUser freezes a user's calendar: reads keep working, while every
+// write, including those of their delegates and of booking links, fails
+// with ErrUserDeactivated until ReactivateUser. Nothing is deleted, which is
+// what sets it apart from a retention purge. A user that was never
+// provisioned is provisioned under their id so the freeze has a record.
+func (s *Service) DeactivateUser(ctx context.Context, userID string) (domain.ProvisionedUser, error) {
+	if !s.identity.Provisions() {
+		return domain.ProvisionedUser{}, ErrProvisioningDisabled
+	}
+	if userID == "" {
+		return domain.ProvisionedUser{}, validationError("user_id is required")
+	}
+	user, err := s.repo.GetProvisionedUser(ctx, userID)
+	switch {
+	case errors.Is(err, store.ErrNotFound):
+		user = domain.ProvisionedUser{UserID: userID, DisplayName: userID}
+	case err != nil:
+		return domain.ProvisionedUser{}, err
+	case !user.Active:
+		return user, nil
+	}
+	now := s.now().UTC()
+	user.Active = false
+	user.DeactivatedAt = &now
+	return s.repo.UpsertProvisionedUser(ctx, user)
+}
+
+// ReactivateUser lifts DeactivateUser's freeze. It returns store.ErrNotFound
+// for users that were never provisioned, who are already active.
+func (s *Service) ReactivateUser(ctx context.Context, userID string) (domain.ProvisionedUser, error) {
+	if !s.identity.Provisions() {
+		return domain.ProvisionedUser{}, ErrProvisioningDisabled
+	}
+	if userID == "" {
+		return domain.ProvisionedUser{}, validationError("user_id is required")
+	}
+	user, err := s.repo.GetProvisionedUser(ctx, userID)
+	if err != nil {
+		return domain.ProvisionedUser{}, err
+	}
+	if user.Active {
+		return user, nil
+	}
+	user.Active = true
+	user.DeactivatedAt = nil
+	return s.repo.UpsertProvisionedUser(ctx, user)
+}
+
+func (s *Service) ListProvisionedUsers(ctx context.Context) ([]domain.ProvisionedUser, error) {
+	if !s.identity.Provisions() {
+		return nil, ErrProvisioningDisabled
+	}
+	return s.repo.ListProvisionedUsers(ctx)
+}
+
+func (s *Service) CreateUserGroup(ctx context.Context, name, externalID string) (domain.UserGroup, error) {
+	if !s.identity.Provisions() {
+		return domain.UserGroup{}, ErrProvisioningDisabled
+	}
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return domain.UserGroup{}, validationError("name is required")
+	}
+	if len(name) > MaxUserGroupNameLength {
+		return domain.UserGroup{}, validationError("name too long")
+	}
+	externalID = strings.TrimSpace(externalID)
+	if len(externalID) > MaxExternalIDLength {
+		return domain.UserGroup{}, validationError("external_id too long")
+	}
+	group, err := s.repo.CreateUserGroup(ctx, domain.UserGroup{Name: name, ExternalID: externalID})
+	if errors.Is(err, store.ErrDuplicate) {
+		return domain.UserGroup{}, validationError("a group with that name already exists")
+	}
+	return group, err
+}
+
+// SetUserGroupMembers replaces a group's members, as a SCIM PUT does. Every
+// member must be a provisioned user; deactivated users may stay members.
+func (s *Service) SetUserGroupMembers(ctx context.Context, groupID uuid.UUID, userIDs []string) (domain.UserGroup, error) {
+	if !s.identity.Provisions() {
+		return domain.UserGroup{}, ErrProvisioningDisabled
+	}
+	if groupID == uuid.Nil {
+		return domain.UserGroup{}, validationError("group_id is required")
+	}
+	if len(userIDs) > MaxUserGroupMembers {
+		return domain.UserGroup{}, validationError("too many members")
+	}
+	members := slices.Clone(userIDs)
+	slices.Sort(members)
+	members = slices.Compact(members)
+	for _, id := range members {
+		if id == "" {
+			return domain.UserGroup{}, validationError("member user_id is required")
+		}
+		if _, err := s.repo.GetProvisionedUser(ctx, id); err != nil {
+			if errors.Is(err, store.ErrNotFound) {
+				return domain.UserGroup{}, validationError("member " + id + " is not a provisioned user")
+			}
+			return domain.UserGroup{}, err
+		}
+	}
+	return s.repo.SetUserGroupMembers(ctx, groupID, members)
+}
+
+func (s *Service) ListUserGroups(ctx context.Context) ([]domain.UserGroup, error) {
+	if !s.identity.Provisions() {
+		return nil, ErrProvisioningDisabled
+	}
+	return s.repo.ListUserGroups(ctx)
+}
+
+// checkActive returns ErrUserDeactivated if any of userIDs is deactivated.
+// Empty ids and users never provisioned pass. Writes go through
+// authorizeActor, which calls it.
+func (s *Service) checkActive(ctx context.Context, userIDs ...string) error {
+	for _, id := range userIDs {
+		if id == "" {
+			continue
+		}
+		active, err := s.identity.Active(ctx, id)
+		if err != nil {
+			return err
+		}
+		if !active {
+			return ErrUserDeactivated
+		}
+	}
+	return nil
+}
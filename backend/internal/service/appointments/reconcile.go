@@ -0,0 +1,176 @@
+package appointments
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"schedula/backend/internal/domain"
+	"schedula/backend/internal/events"
+	"schedula/backend/internal/store"
+)
+
+// MaxReconcileMutations bounds one ReconcileCalendar batch, which holds the
+// user's calendar lock for its whole duration.
+const MaxReconcileMutations = 500
+
+// OfflineMutation is one appointment change made on a client while offline.
+// Creates carry a client-generated AppointmentID. Updates carry the full new
+// state. BaseUpdatedAt is the updated_at the client last saw and is ignored
+// for creates.
+type OfflineMutation struct {
+	Kind          store.MutationKind
+	AppointmentID uuid.UUID
+	Title         string
+	Notes         string
+	StartTime     time.Time
+	EndTime       time.Time
+	Metadata      map[string]string
+	TimeZone      string
+	BaseUpdatedAt time.Time
+}
+
+type ReconcileInput struct {
+	UserID    string
+	Mutations []OfflineMutation
+}
+
+// MutationResult is the outcome of one OfflineMutation. Rejected is set when
+// the mutation was invalid and never attempted; otherwise the embedded
+// outcome says whether it was accepted or conflicted, with the server's copy.
+type MutationResult struct {
+	store.MutationOutcome
+	Rejected string
+}
+
+func (r MutationResult) Accepted() bool {
+	return r.Rejected == "" && r.Conflict == ""
+}
+
+// ReconcileCalendar applies a client's offline mutations in order in one
+// transaction. Invalid mutations are rejected individually rather than
+// failing the batch, so one bad edit cannot wedge a client's upload queue.
+func (s *Service) ReconcileCalendar(ctx context.Context, in ReconcileInput) ([]MutationResult, error) {
+	if in.UserID == "" {
+		return nil, validationError("user_id is required")
+	}
+	if len(in.Mutations) == 0 {
+		return nil, validationError("mutations are required")
+	}
+	if len(in.Mutations) > MaxReconcileMutations {
+		return nil, validationError(fmt.Sprintf("at most %d mutations are allowed", MaxReconcileMutations))
+	}
+
+	if err := s.authorizeOwner(ctx, in.UserID); err != nil {
+		return nil, err
+	}
+
+	results := make([]MutationResult, len(in.Mutations))
+	var apply []store.CalendarMutation
+	var applied []int
+	for i, m := range in.Mutations {
+		mutation, err := s.offlineMutation(ctx, in.UserID, m)
+		if err != nil {
+			var vErr *ValidationError
+			if !errors.As(err, &vErr) && !errors.Is(err, ErrBlackout) {
+				return nil, err
+			}
+			results[i].Rejected = err.Error()
+			continue
+		}
+		apply = append(apply, mutation)
+		applied = append(applied, i)
+	}
+	if len(apply) == 0 {
+		return results, nil
+	}
+
+	outcomes, err := s.repo.ReconcileCalendar(ctx, in.UserID, apply)
+	if err != nil {
+		return nil, err
+	}
+	for j, i := range applied {
+		results[i].MutationOutcome = outcomes[j]
+		if outcomes[j].Conflict == "" {
+			s.publishMutation(ctx, in.UserID, apply[j])
+		}
+	}
+	return results, nil
+}
+
+func (s *Service) publishMutation(ctx context.Context, userID string, m store.CalendarMutation) {
+	switch m.Kind {
+	case store.MutationCreate:
+		s.events.Publish(ctx, events.AppointmentCreated{UserID: userID, AppointmentID: m.Appointment.ID})
+	case store.MutationUpdate:
+		s.events.Publish(ctx, events.AppointmentUpdated{UserID: userID, AppointmentID: m.Appointment.ID})
+	case store.MutationDelete:
+		s.events.Publish(ctx, events.AppointmentDeleted{UserID: userID, AppointmentID: m.Appointment.ID})
+	}
+}
+
+func (s *Service) offlineMutation(ctx context.Context, userID string, m OfflineMutation) (store.CalendarMutation, error) {
+	if m.AppointmentID == uuid.Nil {
+		return store.CalendarMutation{}, validationError("appointment_id is required")
+	}
+	out := store.CalendarMutation{
+		Kind:          m.Kind,
+		Appointment:   domain.Appointment{ID: m.AppointmentID, UserID: userID},
+		BaseUpdatedAt: m.BaseUpdatedAt.UTC(),
+	}
+	switch m.Kind {
+	case store.MutationCreate:
+		out.BaseUpdatedAt = time.Time{}
+		out.Appointment.Source = domain.SourceOffline
+	case store.MutationUpdate, store.MutationDelete:
+		if m.BaseUpdatedAt.IsZero() {
+			return store.CalendarMutation{}, validationError("base_updated_at is required")
+		}
+		if m.Kind == store.MutationDelete {
+			return out, nil
+		}
+	default:
+		return store.CalendarMutation{}, validationError("kind is required")
+	}
+
+	title := strings.TrimSpace(m.Title)
+	if title == "" {
+		return store.CalendarMutation{}, validationError("title is required")
+	}
+	if err := s.checkText(ctx, title, m.Notes); err != nil {
+		return store.CalendarMutation{}, err
+	}
+	metadata, err := s.checkMetadata(ctx, m.Metadata)
+	if err != nil {
+		return store.CalendarMutation{}, err
+	}
+	start := m.StartTime.UTC()
+	end := m.EndTime.UTC()
+	if !end.After(start) {
+		return store.CalendarMutation{}, validationError("end_time must be after start_time")
+	}
+	if end.Sub(start) > MaxAppointmentDuration {
+		return store.CalendarMutation{}, validationError("duration too long")
+	}
+	tz := strings.TrimSpace(m.TimeZone)
+	if tz != "" {
+		if _, err := time.LoadLocation(tz); err != nil {
+			return store.CalendarMutation{}, validationError("invalid time_zone")
+		}
+	}
+	if _, err := s.checkBlackouts(ctx, []domain.BusyInterval{{Start: start, End: end}}); err != nil {
+		return store.CalendarMutation{}, err
+	}
+
+	out.Appointment.Title = title
+	out.Appointment.Notes = m.Notes
+	out.Appointment.StartTime = start
+	out.Appointment.EndTime = end
+	out.Appointment.Metadata = metadata
+	out.Appointment.Timezone = tz
+	return out, nil
+}
@@ -0,0 +1,147 @@
+package appointments
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"schedula/backend/internal/domain"
+	"schedula/backend/internal/limits"
+)
+
+// Request policy bounds. A user's timeout can only be raised so far, since a
+// long request holds a connection and often a calendar lock.
+const (
+	MaxRequestTimeout   = 5 * time.Minute
+	MaxLimitsMultiplier = 10
+)
+
+// maxPolicyCacheEntries bounds the request policy cache; past it, expired
+// entries are dropped and, if that is not enough, the cache starts over.
+const maxPolicyCacheEntries = 10000
+
+// RequestPolicy is how the server treats one user's requests. A zero Timeout
+// leaves the server's timeout in place.
+type RequestPolicy struct {
+	Timeout time.Duration
+	Limits  limits.Limits
+}
+
+type policyEntry struct {
+	policy  RequestPolicy
+	expires time.Time
+}
+
+type requestPolicyCache struct {
+	ttl     time.Duration
+	mu      sync.Mutex
+	entries map[string]policyEntry
+}
+
+// EnableRequestPolicyCache keeps looked-up request policies for ttl, so the
+// interceptors that consult them on every call rarely reach the database.
+// Updates through UpdateRequestPolicy drop the entry at once on this
+// instance; other instances see them within ttl.
+func (s *Service) EnableRequestPolicyCache(ttl time.Duration) {
+	if ttl <= 0 {
+		return
+	}
+	s.policyCache = &requestPolicyCache{ttl: ttl, entries: make(map[string]policyEntry)}
+}
+
+// RequestPolicy returns userID's request policy, from the cache when
+// enabled.
+func (s *Service) RequestPolicy(ctx context.Context, userID string) (RequestPolicy, error) {
+	if userID == "" {
+		return RequestPolicy{Limits: s.limits}, nil
+	}
+	now := s.now()
+	c := s.policyCache
+	if c != nil {
+		c.mu.Lock()
+		e, ok := c.entries[userID]
+		c.mu.Unlock()
+		if ok && now.Before(e.expires) {
+			return e.policy, nil
+		}
+	}
+
+	settings, err := s.repo.GetUserSettings(ctx, userID)
+	if err != nil {
+		return RequestPolicy{}, err
+	}
+	policy := s.requestPolicy(settings)
+	if c != nil {
+		c.mu.Lock()
+		if len(c.entries) >= maxPolicyCacheEntries {
+			for id, e := range c.entries {
+				if !now.Before(e.expires) {
+					delete(c.entries, id)
+				}
+			}
+			if len(c.entries) >= maxPolicyCacheEntries {
+				c.entries = make(map[string]policyEntry)
+			}
+		}
+		c.entries[userID] = policyEntry{policy: policy, expires: now.Add(c.ttl)}
+		c.mu.Unlock()
+	}
+	return policy, nil
+}
+
+func (s *Service) requestPolicy(settings domain.UserSettings) RequestPolicy {
+	p := RequestPolicy{Limits: s.limits}
+	if settings.RequestTimeoutSeconds != nil {
+		p.Timeout = time.Duration(*settings.RequestTimeoutSeconds) * time.Second
+	}
+	if settings.LimitsMultiplier != nil {
+		p.Limits = s.limits.Scale(*settings.LimitsMultiplier)
+	}
+	return p
+}
+
+// UpdateRequestPolicy sets userID's request timeout and limits multiplier.
+// A nil timeout or multiplier returns that part to the server's default. It
+// returns the settings and the policy now in effect.
+func (s *Service) UpdateRequestPolicy(ctx context.Context, userID string, timeout *time.Duration, multiplier *int) (domain.UserSettings, RequestPolicy, error) {
+	if userID == "" {
+		return domain.UserSettings{}, RequestPolicy{}, validationError("user_id is required")
+	}
+	var seconds *int
+	if timeout != nil {
+		if *timeout < time.Second || *timeout%time.Second != 0 {
+			return domain.UserSettings{}, RequestPolicy{}, validationError("request_timeout must be whole seconds")
+		}
+		if *timeout > MaxRequestTimeout {
+			return domain.UserSettings{}, RequestPolicy{}, validationError("request_timeout too long")
+		}
+		n := int(*timeout / time.Second)
+		seconds = &n
+	}
+	if multiplier != nil && (*multiplier < 1 || *multiplier > MaxLimitsMultiplier) {
+		return domain.UserSettings{}, RequestPolicy{}, validationError("limits_multiplier out of range")
+	}
+
+	settings, err := s.repo.GetUserSettings(ctx, userID)
+	if err != nil {
+		return domain.UserSettings{}, RequestPolicy{}, err
+	}
+	settings.RequestTimeoutSeconds = seconds
+	settings.LimitsMultiplier = multiplier
+	settings, err = s.repo.UpdateUserSettings(ctx, settings)
+	if err != nil {
+		return domain.UserSettings{}, RequestPolicy{}, err
+	}
+	if c := s.policyCache; c != nil {
+		c.mu.Lock()
+		delete(c.entries, userID)
+		c.mu.Unlock()
+	}
+	return settings, s.requestPolicy(settings), nil
+}
+
+// limitsFor returns the limits for the request in ctx: the user's, when the
+// request policy interceptor set them, otherwise the server's.
+func (s *Service) limitsFor(ctx context.Context) limits.Limits {
+	return limits.FromContext(ctx, s.limits)
+}
@@ -0,0 +1,89 @@
+package appointments
+
+import (
+	"context"
+
+	"schedula/backend/internal/domain"
+	"schedula/backend/internal/events"
+	"schedula/backend/internal/store"
+)
+
+// PurgeBatchSize is the most appointments one purge transaction deletes, so
+// a purge never holds a user's calendar lock for long.
+const PurgeBatchSize = 500
+
+// SetRetentionDays sets the server-wide retention: appointments that ended
+// more than days ago are purged. 0 keeps them forever. Users without an
+// override follow it.
+func (s *Service) SetRetentionDays(days int) {
+	if days >= 0 {
+		s.retentionDays = days
+	}
+}
+
+// UpdateRetentionPolicy sets userID's retention override in days; nil
+// returns the user to the server-wide retention. It returns the settings and
+// the retention now in effect for the user.
+func (s *Service) UpdateRetentionPolicy(ctx context.Context, userID string, days *int) (domain.UserSettings, int, error) {
+	if userID == "" {
+		return domain.UserSettings{}, 0, validationError("user_id is required")
+	}
+	if days != nil && *days < 0 {
+		return domain.UserSettings{}, 0, validationError("retention_days must not be negative")
+	}
+	settings, err := s.repo.GetUserSettings(ctx, userID)
+	if err != nil {
+		return domain.UserSettings{}, 0, err
+	}
+	settings.RetentionDays = days
+	settings, err = s.repo.UpdateUserSettings(ctx, settings)
+	if err != nil {
+		return domain.UserSettings{}, 0, err
+	}
+	return settings, s.effectiveRetentionDays(settings), nil
+}
+
+func (s *Service) effectiveRetentionDays(settings domain.UserSettings) int {
+	if settings.RetentionDays != nil {
+		return *settings.RetentionDays
+	}
+	return s.retentionDays
+}
+
+// PurgeExpiredAppointments deletes appointments past their owner's retention
+// and reports, per user, how many went and the cutoff used. With dryRun set
+// it only counts them. Each user is purged in batches of PurgeBatchSize; on
+// an error the users already purged are returned with it.
+func (s *Service) PurgeExpiredAppointments(ctx context.Context, dryRun bool) ([]store.RetentionPurge, error) {
+	expired, err := s.repo.ExpiredAppointments(ctx, s.now().UTC(), s.retentionDays)
+	if err != nil {
+		return nil, err
+	}
+	if dryRun {
+		return expired, nil
+	}
+	out := make([]store.RetentionPurge, 0, len(expired))
+	for _, e := range expired {
+		purged := store.RetentionPurge{UserID: e.UserID, Cutoff: e.Cutoff}
+		for {
+			ids, err := s.repo.PurgeAppointments(ctx, e.UserID, e.Cutoff, PurgeBatchSize)
+			purged.Count += len(ids)
+			if err != nil {
+				if purged.Count > 0 {
+					out = append(out, purged)
+				}
+				return out, err
+			}
+			for _, id := range ids {
+				s.events.Publish(ctx, events.AppointmentDeleted{UserID: e.UserID, AppointmentID: id})
+			}
+			if len(ids) < PurgeBatchSize {
+				break
+			}
+		}
+		if purged.Count > 0 {
+			out = append(out, purged)
+		}
+	}
+	return out, nil
+}
@@ -0,0 +1,65 @@
+package appointments
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	"schedula/backend/internal/events"
+)
+
+// CompactionBatchSize is how many candidate series one page of a compaction
+// run loads.
+const CompactionBatchSize = 100
+
+// CompactionSummary totals what a compaction run removed.
+type CompactionSummary struct {
+	SeriesCompacted   int
+	ExceptionsRemoved int
+	SeriesFolded      int
+	Archived          int
+}
+
+// CompactSeriesExceptions compacts every series whose last occurrence ended
+// more than age ago: exceptions that match no occurrence are deleted,
+// trailing skips go with an end pulled back to the last kept occurrence, and
+// a series with every occurrence skipped or overridden is replaced by
+// archived appointments for its overrides. What any series expands to is
+// unchanged. On an error the totals so far are returned with it.
+func (s *Service) CompactSeriesExceptions(ctx context.Context, age time.Duration) (CompactionSummary, error) {
+	var out CompactionSummary
+	if age <= 0 {
+		return out, nil
+	}
+	now := s.now().UTC()
+	cutoff := now.Add(-age)
+	after := uuid.Nil
+	for {
+		page, err := s.repo.ListCompactableSeries(ctx, cutoff, after, CompactionBatchSize)
+		if err != nil {
+			return out, err
+		}
+		for _, series := range page {
+			after = series.ID
+			c, err := s.repo.CompactRecurringSeries(ctx, series.UserID, series.ID, cutoff, now)
+			if err != nil {
+				return out, fmt.Errorf("compact series %s: %w", series.ID, err)
+			}
+			if c.Empty() {
+				continue
+			}
+			out.SeriesCompacted++
+			out.ExceptionsRemoved += c.Removed
+			if c.Fold {
+				out.SeriesFolded++
+				out.Archived += len(c.Archived)
+			}
+			s.events.Publish(ctx, events.SeriesUpdated{UserID: series.UserID, SeriesID: series.ID})
+		}
+		if len(page) < CompactionBatchSize {
+			return out, nil
+		}
+	}
+}
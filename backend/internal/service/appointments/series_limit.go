@@ -0,0 +1,55 @@
+package appointments
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"schedula/backend/internal/domain"
+	"schedula/backend/internal/store"
+)
+
+// ErrSeriesLimit is returned when creating a series would take the user past
+// MaxActiveSeries or MaxFutureOccurrences.
+var ErrSeriesLimit = errors.New("recurring series limit reached")
+
+// checkSeriesLimits counts the user's series that still have occurrences
+// from now on, and those occurrences, and fails when adding a series with
+// added future occurrences would exceed the request's limits. A series
+// entirely in the past adds nothing and is always allowed. The limits
+// are soft: the count is taken outside the calendar lock, so creates racing
+// each other can each pass.
+//
+// Occurrences are counted from the rule, so skipped ones still count; that
+// is the most the expander can be asked to produce for the series.
+func (s *Service) checkSeriesLimits(ctx context.Context, userID string, added int, now time.Time) error {
+	lim := s.limitsFor(ctx)
+	if added == 0 || (lim.MaxActiveSeries <= 0 && lim.MaxFutureOccurrences <= 0) {
+		return nil
+	}
+	rows, err := s.repo.ListActiveRecurringSeries(ctx, userID, now)
+	if err != nil {
+		return err
+	}
+	active, future := 0, added
+	for _, series := range rows {
+		occs, err := domain.GenerateWeeklyOccurrences(series, now, domain.SeriesHorizonEnd(series, store.RecurringConflictLookahead))
+		if err != nil {
+			return err
+		}
+		remaining := series.WithProgress(occs, now).OccurrencesRemaining
+		if remaining == 0 {
+			continue
+		}
+		active++
+		future += remaining
+	}
+	if lim.MaxActiveSeries > 0 && active >= lim.MaxActiveSeries {
+		return fmt.Errorf("%w: at most %d series may have occurrences ahead", ErrSeriesLimit, lim.MaxActiveSeries)
+	}
+	if lim.MaxFutureOccurrences > 0 && future > lim.MaxFutureOccurrences {
+		return fmt.Errorf("%w: at most %d future occurrences are allowed across series", ErrSeriesLimit, lim.MaxFutureOccurrences)
+	}
+	return nil
+}
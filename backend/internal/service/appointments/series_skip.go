@@ -0,0 +1,170 @@
+package appointments
+
+import (
+	"context"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+
+	"schedula/backend/internal/domain"
+	"schedula/backend/internal/events"
+	"schedula/backend/internal/store"
+)
+
+// MaxSkipWindow bounds the occurrences one SkipOccurrences call can match.
+const MaxSkipWindow = 366 * 24 * time.Hour
+
+// SkipOccurrencesInput selects occurrences of a series to cancel together,
+// such as every Friday session. Without a window it matches occurrences from
+// now to the end of the series.
+type SkipOccurrencesInput struct {
+	UserID      string
+	SeriesID    uuid.UUID
+	WindowStart time.Time
+	WindowEnd   time.Time
+	// Weekdays, 1 (Monday) to 7 (Sunday), keeps occurrences that start on
+	// those days in the series' time zone. Empty matches every day.
+	Weekdays []int16
+	// Apply writes the skips; otherwise the call only previews them. More
+	// than SkipConfirmThreshold skips need the ConfirmationToken of a
+	// preview of the same skips.
+	Apply             bool
+	ConfirmationToken string
+}
+
+// SkipOccurrencesResult lists the original starts of the matched
+// occurrences, oldest first, and how many skips were written. A preview of
+// more than SkipConfirmThreshold skips carries the Confirmation to apply
+// them with.
+type SkipOccurrencesResult struct {
+	OccurrenceStarts []time.Time
+	Skipped          int
+	Confirmation     *Confirmation
+}
+
+// SkipOccurrences cancels every occurrence of a series that matches the rule
+// by writing a skip exception for each, all in one transaction. Occurrences
+// already skipped are not matched again; overridden ones are, and lose their
+// override.
+func (s *Service) SkipOccurrences(ctx context.Context, in SkipOccurrencesInput) (SkipOccurrencesResult, error) {
+	if in.UserID == "" {
+		return SkipOccurrencesResult{}, validationError("user_id is required")
+	}
+	if in.SeriesID == uuid.Nil {
+		return SkipOccurrencesResult{}, validationError("series_id is required")
+	}
+	if in.WindowStart.IsZero() != in.WindowEnd.IsZero() {
+		return SkipOccurrencesResult{}, validationError("window_start and window_end must be set together")
+	}
+	for _, wd := range in.Weekdays {
+		if wd < 1 || wd > 7 {
+			return SkipOccurrencesResult{}, validationError("invalid weekday")
+		}
+	}
+
+	series, err := s.repo.GetRecurringSeries(ctx, in.UserID, in.SeriesID)
+	if err != nil {
+		return SkipOccurrencesResult{}, err
+	}
+
+	start, end := in.WindowStart.UTC(), in.WindowEnd.UTC()
+	if in.WindowStart.IsZero() {
+		start = s.now().UTC()
+		end = domain.SeriesHorizonEnd(series, store.RecurringConflictLookahead)
+		if !end.After(start) {
+			return SkipOccurrencesResult{}, nil
+		}
+	}
+	if !end.After(start) {
+		return SkipOccurrencesResult{}, validationError("window_end must be after window_start")
+	}
+	if end.Sub(start) > MaxSkipWindow {
+		return SkipOccurrencesResult{}, validationError("window too long")
+	}
+
+	starts, err := s.matchOccurrences(ctx, series, start, end, in.Weekdays)
+	if err != nil {
+		return SkipOccurrencesResult{}, err
+	}
+	result := SkipOccurrencesResult{OccurrenceStarts: starts}
+	var items []string
+	if len(starts) > SkipConfirmThreshold {
+		items = make([]string, 0, len(starts)+1)
+		items = append(items, in.SeriesID.String())
+		for _, at := range starts {
+			items = append(items, at.Format(time.RFC3339Nano))
+		}
+	}
+	if !in.Apply {
+		if items != nil {
+			c := s.confirmation("skip_occurrences", in.UserID, items)
+			result.Confirmation = &c
+		}
+		return result, nil
+	}
+	if len(starts) == 0 {
+		return result, nil
+	}
+	if err := s.authorizeOwner(ctx, in.UserID); err != nil {
+		return SkipOccurrencesResult{}, err
+	}
+	if items != nil {
+		if err := s.checkConfirmation(in.ConfirmationToken, "skip_occurrences", in.UserID, items); err != nil {
+			return SkipOccurrencesResult{}, err
+		}
+	}
+
+	n, err := s.repo.SkipRecurringOccurrences(ctx, in.UserID, in.SeriesID, starts)
+	if err != nil {
+		return SkipOccurrencesResult{}, err
+	}
+	for _, start := range starts {
+		s.events.Publish(ctx, events.ExceptionUpserted{UserID: in.UserID, SeriesID: in.SeriesID, OccurrenceStart: start})
+	}
+	result.Skipped = n
+	return result, nil
+}
+
+// matchOccurrences returns the original starts of the series' occurrences
+// that start in [start, end) on one of weekdays and are not already skipped.
+func (s *Service) matchOccurrences(ctx context.Context, series domain.RecurringSeries, start, end time.Time, weekdays []int16) ([]time.Time, error) {
+	loc, err := time.LoadLocation(series.Timezone)
+	if err != nil {
+		return nil, validationError("series has an invalid time_zone")
+	}
+	occs, err := domain.GenerateWeeklyOccurrences(series, start, end)
+	if err != nil {
+		return nil, validationError(err.Error())
+	}
+	exceptions, err := s.repo.ListSeriesExceptions(ctx, series.ID)
+	if err != nil {
+		return nil, err
+	}
+	skipped := make(map[int64]bool, len(exceptions))
+	for _, ex := range exceptions {
+		if ex.Kind == domain.RecurringExceptionKindSkip {
+			skipped[ex.OccurrenceStart.UTC().UnixNano()] = true
+		}
+	}
+
+	var out []time.Time
+	for _, o := range occs {
+		at := o.StartTime.UTC()
+		if at.Before(start) || !at.Before(end) || skipped[at.UnixNano()] {
+			continue
+		}
+		if len(weekdays) > 0 && !slices.Contains(weekdays, isoWeekday(at.In(loc))) {
+			continue
+		}
+		out = append(out, at)
+	}
+	return out, nil
+}
+
+func isoWeekday(t time.Time) int16 {
+	if wd := t.Weekday(); wd != time.Sunday {
+		return int16(wd)
+	}
+	return 7
+}
@@ -0,0 +1,151 @@
+package appointments
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"schedula/backend/internal/domain"
+	"schedula/backend/internal/events"
+	"schedula/backend/internal/store"
+)
+
+// MaxSeriesPerTimeZoneChange bounds one ChangeSeriesTimeZone call, which
+// holds the user's calendar lock while it rewrites and rechecks every series.
+const MaxSeriesPerTimeZoneChange = 100
+
+// ChangeSeriesTimeZoneInput moves series to another time zone. Keep decides
+// whether occurrences keep their local time of day or their instants.
+// Preview returns the moved series without writing them; moving more than
+// TimeZoneConfirmThreshold series needs the ConfirmationToken of a preview
+// of the same move.
+type ChangeSeriesTimeZoneInput struct {
+	UserID            string
+	SeriesIDs         []uuid.UUID
+	TimeZone          string
+	Keep              domain.TimeZoneKeep
+	Preview           bool
+	ConfirmationToken string
+}
+
+// ChangeSeriesTimeZoneResult holds the moved series and how many exceptions
+// were, or in a preview would be, deleted. A preview of more than
+// TimeZoneConfirmThreshold series carries the Confirmation to apply it with;
+// its series have no progress.
+type ChangeSeriesTimeZoneResult struct {
+	Series            []domain.RecurringSeries
+	RemovedExceptions int
+	Confirmation      *Confirmation
+}
+
+// ChangeSeriesTimeZone moves the user's series to a new time zone, for a
+// user who has moved. The whole series moves, including past occurrences;
+// a user who wants history left alone can end the series and start a new
+// one instead. Exceptions move with their occurrences, and the number that
+// no longer match one and were deleted is returned. The moved occurrences
+// are checked for conflicts as a new series would be, and nothing changes
+// if any conflict.
+func (s *Service) ChangeSeriesTimeZone(ctx context.Context, in ChangeSeriesTimeZoneInput) (ChangeSeriesTimeZoneResult, error) {
+	if in.UserID == "" {
+		return ChangeSeriesTimeZoneResult{}, validationError("user_id is required")
+	}
+	if len(in.SeriesIDs) == 0 {
+		return ChangeSeriesTimeZoneResult{}, validationError("series_ids is required")
+	}
+	if len(in.SeriesIDs) > MaxSeriesPerTimeZoneChange {
+		return ChangeSeriesTimeZoneResult{}, validationError(fmt.Sprintf("at most %d series may be moved at once", MaxSeriesPerTimeZoneChange))
+	}
+	tz := strings.TrimSpace(in.TimeZone)
+	if tz == "" {
+		return ChangeSeriesTimeZoneResult{}, validationError("time_zone is required")
+	}
+	if _, err := time.LoadLocation(tz); err != nil {
+		return ChangeSeriesTimeZoneResult{}, validationError("invalid time_zone")
+	}
+	if !in.Keep.Valid() {
+		return ChangeSeriesTimeZoneResult{}, validationError("keep is required")
+	}
+	ids := make([]uuid.UUID, 0, len(in.SeriesIDs))
+	seen := make(map[uuid.UUID]struct{}, len(in.SeriesIDs))
+	for _, id := range in.SeriesIDs {
+		if id == uuid.Nil {
+			return ChangeSeriesTimeZoneResult{}, validationError("series_ids must not contain an empty id")
+		}
+		if _, ok := seen[id]; ok {
+			continue
+		}
+		seen[id] = struct{}{}
+		ids = append(ids, id)
+	}
+	if err := s.authorizeOwner(ctx, in.UserID); err != nil {
+		return ChangeSeriesTimeZoneResult{}, err
+	}
+
+	// Check each move up front so a rule that cannot keep its instants is
+	// reported as invalid rather than failing inside the transaction.
+	var preview ChangeSeriesTimeZoneResult
+	for _, id := range ids {
+		series, err := s.repo.GetRecurringSeries(ctx, in.UserID, id)
+		if err != nil {
+			return ChangeSeriesTimeZoneResult{}, err
+		}
+		var exceptions []domain.RecurringException
+		if in.Preview {
+			if exceptions, err = s.repo.ListSeriesExceptions(ctx, id); err != nil {
+				return ChangeSeriesTimeZoneResult{}, err
+			}
+		}
+		moved, _, dropped, err := domain.MoveSeriesTimeZone(series, exceptions, tz, in.Keep, store.RecurringConflictLookahead)
+		if err != nil {
+			return ChangeSeriesTimeZoneResult{}, validationError(err.Error())
+		}
+		preview.Series = append(preview.Series, moved)
+		preview.RemovedExceptions += len(dropped)
+	}
+
+	var items []string
+	if len(ids) > TimeZoneConfirmThreshold {
+		items = make([]string, 0, len(ids)+2)
+		items = append(items, tz, string(in.Keep))
+		for _, id := range ids {
+			items = append(items, id.String())
+		}
+		slices.Sort(items[2:])
+	}
+	if in.Preview {
+		if items != nil {
+			c := s.confirmation("change_series_time_zone", in.UserID, items)
+			preview.Confirmation = &c
+		}
+		return preview, nil
+	}
+	if items != nil {
+		if err := s.checkConfirmation(in.ConfirmationToken, "change_series_time_zone", in.UserID, items); err != nil {
+			return ChangeSeriesTimeZoneResult{}, err
+		}
+	}
+
+	moved, dropped, err := s.repo.MoveRecurringSeriesTimeZone(ctx, in.UserID, ids, tz, in.Keep)
+	if err != nil {
+		return ChangeSeriesTimeZoneResult{}, err
+	}
+	now := s.now().UTC()
+	for i, series := range moved {
+		s.events.Publish(ctx, events.SeriesUpdated{UserID: in.UserID, SeriesID: series.ID})
+		horizonEnd := domain.SeriesHorizonEnd(series, store.RecurringConflictLookahead)
+		if !horizonEnd.After(now) {
+			moved[i] = series.WithProgress(nil, now)
+			continue
+		}
+		occs, err := s.repo.ListSeriesOccurrences(ctx, series, now, horizonEnd)
+		if err != nil {
+			return ChangeSeriesTimeZoneResult{}, err
+		}
+		moved[i] = series.WithProgress(occs, now)
+	}
+	return ChangeSeriesTimeZoneResult{Series: moved, RemovedExceptions: dropped}, nil
+}
@@ -0,0 +1,162 @@
+package appointments
+
+import (
+	"context"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+
+	"schedula/backend/internal/domain"
+)
+
+// SeriesWatchPollInterval is how often WatchRecurringSeries re-reads the
+// series. Changes made through this process are pushed straight away; the
+// poll picks up changes made through other instances.
+const SeriesWatchPollInterval = 5 * time.Second
+
+// SeriesSnapshot is the state of one series as sent to a watcher.
+type SeriesSnapshot struct {
+	Series      domain.RecurringSeries
+	Exceptions  []domain.RecurringException
+	Occurrences []domain.RecurringOccurrence
+}
+
+// version identifies the stored state of the snapshot: the series row and
+// its exceptions. Occurrences are derived from those, so they are left out.
+func (s SeriesSnapshot) version() string {
+	var b strings.Builder
+	b.WriteString(strconv.FormatInt(s.Series.UpdatedAt.UnixNano(), 10))
+	for _, e := range s.Exceptions {
+		b.WriteString("," + e.ID.String() + "@" + strconv.FormatInt(e.UpdatedAt.UnixNano(), 10))
+	}
+	return b.String()
+}
+
+// seriesWatchers fans out change notifications to WatchRecurringSeries calls
+// in this process. The zero value is ready to use.
+type seriesWatchers struct {
+	mu   sync.Mutex
+	subs map[uuid.UUID]map[chan struct{}]struct{}
+}
+
+func (w *seriesWatchers) subscribe(seriesID uuid.UUID) (<-chan struct{}, func()) {
+	ch := make(chan struct{}, 1)
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if w.subs == nil {
+		w.subs = make(map[uuid.UUID]map[chan struct{}]struct{})
+	}
+	if w.subs[seriesID] == nil {
+		w.subs[seriesID] = make(map[chan struct{}]struct{})
+	}
+	w.subs[seriesID][ch] = struct{}{}
+
+	return ch, func() {
+		w.mu.Lock()
+		defer w.mu.Unlock()
+		delete(w.subs[seriesID], ch)
+		if len(w.subs[seriesID]) == 0 {
+			delete(w.subs, seriesID)
+		}
+	}
+}
+
+// notify wakes every watcher of the series. A watcher that has not yet
+// handled its last wake-up is already due to reload, so it is not blocked on.
+func (w *seriesWatchers) notify(seriesID uuid.UUID) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	for ch := range w.subs[seriesID] {
+		select {
+		case ch <- struct{}{}:
+		default:
+		}
+	}
+}
+
+type WatchSeriesInput struct {
+	UserID      string
+	SeriesID    uuid.UUID
+	WindowStart time.Time
+	WindowEnd   time.Time
+}
+
+// WatchRecurringSeries sends a snapshot of the series and its occurrences in
+// the window, then a new one each time the rule or exceptions change, until
+// ctx is done or send fails. It returns store.ErrNotFound once the series is
+// gone.
+func (s *Service) WatchRecurringSeries(ctx context.Context, in WatchSeriesInput, send func(SeriesSnapshot) error) error {
+	if in.UserID == "" {
+		return validationError("user_id is required")
+	}
+	if in.SeriesID == uuid.Nil {
+		return validationError("series_id is required")
+	}
+	start := in.WindowStart.UTC()
+	end := in.WindowEnd.UTC()
+	if !end.After(start) {
+		return validationError("window_end must be after window_start")
+	}
+
+	// Subscribe before the first read so a change landing in between still
+	// triggers a reload.
+	changed, unsubscribe := s.watchers.subscribe(in.SeriesID)
+	defer unsubscribe()
+
+	snap, err := s.seriesSnapshot(ctx, in.UserID, in.SeriesID, start, end)
+	if err != nil {
+		return err
+	}
+	if err := send(snap); err != nil {
+		return err
+	}
+	sent := snap.version()
+
+	poll := s.watchPoll
+	if poll <= 0 {
+		poll = SeriesWatchPollInterval
+	}
+	ticker := time.NewTicker(poll)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		case <-changed:
+		}
+		snap, err := s.seriesSnapshot(ctx, in.UserID, in.SeriesID, start, end)
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil
+			}
+			return err
+		}
+		if v := snap.version(); v != sent {
+			if err := send(snap); err != nil {
+				return err
+			}
+			sent = v
+		}
+	}
+}
+
+func (s *Service) seriesSnapshot(ctx context.Context, userID string, seriesID uuid.UUID, start, end time.Time) (SeriesSnapshot, error) {
+	series, err := s.repo.GetRecurringSeries(ctx, userID, seriesID)
+	if err != nil {
+		return SeriesSnapshot{}, err
+	}
+	exceptions, err := s.repo.ListSeriesExceptions(ctx, seriesID)
+	if err != nil {
+		return SeriesSnapshot{}, err
+	}
+	occs, err := s.repo.ListSeriesOccurrences(ctx, series, start, end)
+	if err != nil {
+		return SeriesSnapshot{}, err
+	}
+	return SeriesSnapshot{Series: series, Exceptions: exceptions, Occurrences: occs}, nil
+}
@@ -2,13 +2,24 @@ package appointments
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"slices"
 	"strings"
+	"sync"
 	"time"
+	"unicode/utf8"
 
 	"github.com/google/uuid"
 
 	"schedula/backend/internal/domain"
+	"schedula/backend/internal/events"
+	"schedula/backend/internal/identity"
+	"schedula/backend/internal/limits"
+	"schedula/backend/internal/scheduling"
 	"schedula/backend/internal/store"
+	"schedula/backend/internal/timepolicy"
+	"schedula/backend/internal/timing"
 )
 
 type ValidationError struct {
@@ -23,21 +34,179 @@ func validationError(msg string) error {
 	return &ValidationError{msg: msg}
 }
 
+// ErrNotAuthorized is returned when an actor writes to another user's
+// calendar without a delegation grant from that user.
+var ErrNotAuthorized = errors.New("actor is not authorized for this calendar")
+
+// ErrBlackout is returned when a booking overlaps a block-mode blackout.
+var ErrBlackout = errors.New("time falls within a blackout period")
+
+// ErrOutsideWorkingHours is returned when SuggestEndTime is asked to start
+// outside the working hours it was given.
+var ErrOutsideWorkingHours = errors.New("start is outside working hours")
+
+// MaxAppointmentDuration bounds a single appointment or series occurrence.
+const MaxAppointmentDuration = 24 * time.Hour
+
+// DefaultHoldTTL applies when ReserveSlot is called without a TTL; MaxHoldTTL
+// caps how long a hold may block a slot. Both are only the built-in values;
+// see SetExpiryTTLs.
+const (
+	DefaultHoldTTL = 5 * time.Minute
+	MaxHoldTTL     = 30 * time.Minute
+)
+
 type Service struct {
-	repo store.AppointmentRepository
+	repo   store.AppointmentRepository
+	limits limits.Limits
+	now    func() time.Time
+	timing timepolicy.Policy
+	// pastStart is the server-wide PastStartPolicy; users may override it.
+	pastStart domain.PastStartPolicy
+	// retentionDays is the server-wide retention; users may override it.
+	retentionDays int
+	occCache      *occurrenceCache
+	policyCache   *requestPolicyCache
+	embedKey      []byte
+	expiry        ExpiryTTLs
+
+	watchers  seriesWatchers
+	watchPoll time.Duration
+	events    *events.Bus
+
+	// blobs holds calendar snapshots; nil disables them.
+	blobs store.BlobStore
+	// identity says which users may write and whether users can be
+	// provisioned.
+	identity identity.Directory
 }
 
 func NewService(repo store.AppointmentRepository) *Service {
-	return &Service{repo: repo}
+	return NewServiceWithLimits(repo, limits.Default())
+}
+
+func NewServiceWithLimits(repo store.AppointmentRepository, lim limits.Limits) *Service {
+	s := &Service{repo: repo, limits: lim, now: time.Now, timing: timepolicy.Default(), pastStart: domain.PastStartAllow, expiry: DefaultExpiryTTLs(), events: events.NewBus(), identity: identity.Provisioned{Users: repo}}
+	s.subscribeSeriesListeners()
+	return s
+}
+
+// SetIdentity replaces the identity directory, which is
+// identity.Provisioned over the repository by default.
+func (s *Service) SetIdentity(d identity.Directory) {
+	s.identity = d
+}
+
+// SetTimePolicy sets the clock skew and minimum booking notice. The policy's
+// own clock is ignored; checks always use the service clock.
+func (s *Service) SetTimePolicy(p timepolicy.Policy) {
+	s.timing = p
+}
+
+func (s *Service) timePolicy() timepolicy.Policy {
+	p := s.timing
+	p.Now = s.now
+	return p
+}
+
+// checkMetadata validates metadata against the request's limits and returns
+// a copy, or nil when there is none.
+func (s *Service) checkMetadata(ctx context.Context, metadata map[string]string) (map[string]string, error) {
+	if len(metadata) == 0 {
+		return nil, nil
+	}
+	lim := s.limitsFor(ctx)
+	if lim.MaxMetadataEntries > 0 && len(metadata) > lim.MaxMetadataEntries {
+		return nil, validationError("too many metadata entries")
+	}
+	out := make(map[string]string, len(metadata))
+	for k, v := range metadata {
+		if strings.TrimSpace(k) == "" {
+			return nil, validationError("metadata keys must not be empty")
+		}
+		if lim.MaxMetadataKeyLen > 0 && len(k) > lim.MaxMetadataKeyLen {
+			return nil, validationError("metadata key too long")
+		}
+		if lim.MaxMetadataValueLen > 0 && len(v) > lim.MaxMetadataValueLen {
+			return nil, validationError("metadata value too long")
+		}
+		out[k] = v
+	}
+	return out, nil
+}
+
+func (s *Service) Limits() limits.Limits {
+	return s.limits
+}
+
+// checkText enforces the request's title and notes limits, counted in
+// characters rather than bytes.
+func (s *Service) checkText(ctx context.Context, title, notes string) error {
+	lim := s.limitsFor(ctx)
+	if lim.MaxTitleLength > 0 && utf8.RuneCountInString(title) > lim.MaxTitleLength {
+		return validationError("title too long")
+	}
+	if lim.MaxNotesLength > 0 && utf8.RuneCountInString(notes) > lim.MaxNotesLength {
+		return validationError("notes too long")
+	}
+	return nil
 }
 
 type CreateInput struct {
 	UserID         string
 	Title          string
 	Notes          string
+	PrivateNotes   string
 	StartTime      time.Time
 	EndTime        time.Time
 	IdempotencyKey string
+	Metadata       map[string]string
+	ExternalRef    *ExternalRef
+
+	// ActorID is who is making the request. It defaults to UserID; any other
+	// actor needs a delegation grant from UserID.
+	ActorID string
+
+	// TimeZone optionally records the IANA zone the client booked in, so
+	// reads can return it alongside the UTC times.
+	TimeZone string
+
+	// ContactID optionally links one of the user's contacts.
+	ContactID *uuid.UUID
+
+	// ProgramID optionally puts the appointment in one of the user's
+	// programs that is not cancelled.
+	ProgramID *uuid.UUID
+
+	// Kind defaults to an event. A milestone has EndTime equal to StartTime,
+	// or zero to mean the same.
+	Kind domain.AppointmentKind
+
+	// AllowPastStart skips the past start policy. Only the admin backfill
+	// RPC and the schedula-backfill tool set it.
+	AllowPastStart bool
+}
+
+// ExternalRef identifies an appointment in another system, such as a
+// calendar provider or CRM. It is unique per user.
+type ExternalRef struct {
+	System string
+	ID     string
+}
+
+func normalizeExternalRef(ref ExternalRef) (ExternalRef, error) {
+	ref.System = strings.TrimSpace(ref.System)
+	ref.ID = strings.TrimSpace(ref.ID)
+	if ref.System == "" || ref.ID == "" {
+		return ExternalRef{}, validationError("external_ref requires system and id")
+	}
+	if len(ref.System) > 64 {
+		return ExternalRef{}, validationError("external_ref.system too long")
+	}
+	if len(ref.ID) > 256 {
+		return ExternalRef{}, validationError("external_ref.id too long")
+	}
+	return ref, nil
 }
 
 func (s *Service) Create(ctx context.Context, in CreateInput) (domain.Appointment, error) {
@@ -48,57 +217,812 @@ func (s *Service) Create(ctx context.Context, in CreateInput) (domain.Appointmen
 	if in.UserID == "" {
 		return domain.Appointment{}, validationError("user_id is required")
 	}
-
+	if err := s.checkText(ctx, title, in.Notes); err != nil {
+		return domain.Appointment{}, err
+	}
+	if lim := s.limitsFor(ctx); lim.MaxNotesLength > 0 && utf8.RuneCountInString(in.PrivateNotes) > lim.MaxNotesLength {
+		return domain.Appointment{}, validationError("private_notes too long")
+	}
+	metadata, err := s.checkMetadata(ctx, in.Metadata)
+	if err != nil {
+		return domain.Appointment{}, err
+	}
+
+	kind := in.Kind
+	if kind == "" {
+		kind = domain.AppointmentKindEvent
+	}
+	if !kind.Valid() {
+		return domain.Appointment{}, validationError("invalid kind")
+	}
+	start := in.StartTime.UTC()
+	end := in.EndTime.UTC()
+	if kind == domain.AppointmentKindMilestone {
+		if in.EndTime.IsZero() {
+			end = start
+		}
+		if !end.Equal(start) {
+			return domain.Appointment{}, validationError("a milestone's end_time must equal its start_time")
+		}
+	} else {
+		if end.Equal(start) || end.Before(start) {
+			return domain.Appointment{}, validationError("end_time must be after start_time")
+		}
+		if end.Sub(start) > MaxAppointmentDuration {
+			return domain.Appointment{}, validationError("duration too long")
+		}
+	}
+
+	appt := domain.Appointment{
+		UserID:       in.UserID,
+		Title:        title,
+		Notes:        in.Notes,
+		PrivateNotes: in.PrivateNotes,
+		StartTime:    start,
+		EndTime:      end,
+		Metadata:     metadata,
+		Source:       domain.SourceManual,
+		Kind:         kind,
+	}
+	if tz := strings.TrimSpace(in.TimeZone); tz != "" {
+		if _, err := time.LoadLocation(tz); err != nil {
+			return domain.Appointment{}, validationError("invalid time_zone")
+		}
+		appt.Timezone = tz
+	}
+	if in.ExternalRef != nil {
+		ref, err := normalizeExternalRef(*in.ExternalRef)
+		if err != nil {
+			return domain.Appointment{}, err
+		}
+		appt.ExternalSystem = ref.System
+		appt.ExternalID = ref.ID
+		appt.Source = domain.SyncSource(ref.System)
+	}
+	if err := s.applySourceDefault(ctx, &appt); err != nil {
+		return domain.Appointment{}, err
+	}
+
+	key := strings.TrimSpace(in.IdempotencyKey)
+	if key != "" {
+		if len(key) > 256 {
+			return domain.Appointment{}, validationError("idempotency_key too long")
+		}
+		appt.ID = uuid.NewSHA1(uuid.NameSpaceOID, []byte("schedula:create_appointment:"+in.UserID+":"+key))
+	}
+
+	createdBy, err := s.authorizeActor(ctx, in.ActorID, in.UserID)
+	if err != nil {
+		return domain.Appointment{}, err
+	}
+	// Private notes are the owner's to write as well as to read.
+	if createdBy != "" && in.PrivateNotes != "" {
+		return domain.Appointment{}, validationError("private_notes can only be set by the calendar's owner")
+	}
+	appt.CreatedBy = createdBy
+
+	if in.ContactID != nil {
+		if _, err := s.repo.GetContact(ctx, in.UserID, *in.ContactID); err != nil {
+			if errors.Is(err, store.ErrNotFound) {
+				return domain.Appointment{}, validationError("contact_id does not name one of this user's contacts")
+			}
+			return domain.Appointment{}, err
+		}
+		appt.ContactID = in.ContactID
+	}
+	if in.ProgramID != nil {
+		if err := s.checkProgram(ctx, in.UserID, *in.ProgramID); err != nil {
+			return domain.Appointment{}, err
+		}
+		appt.ProgramID = in.ProgramID
+	}
+	pastStartWarning := false
+	if !in.AllowPastStart {
+		if pastStartWarning, err = s.checkPastStart(ctx, in.UserID, start); err != nil {
+			return domain.Appointment{}, err
+		}
+	}
+
+	// A milestone takes no time, so the rules that keep time free or tidy
+	// do not apply to it.
+	var warnings []domain.Blackout
+	if !appt.Milestone() {
+		if err := s.checkSlotAlignment(ctx, in.UserID, start); err != nil {
+			return domain.Appointment{}, err
+		}
+		// Time off blocks bookings others make; the user may still book over it.
+		if createdBy != "" {
+			if err := s.checkTimeOff(ctx, in.UserID, start, end); err != nil {
+				return domain.Appointment{}, err
+			}
+		}
+		warnings, err = s.checkBlackouts(ctx, []domain.BusyInterval{{Start: start, End: end}})
+		if err != nil {
+			return domain.Appointment{}, err
+		}
+	}
+
+	created, err := s.repo.Create(ctx, appt)
+	if err != nil {
+		return domain.Appointment{}, err
+	}
+	s.events.Publish(ctx, events.AppointmentCreated{UserID: created.UserID, AppointmentID: created.ID})
+	// Whatever a delegate gets back, a replay included, leaves out the
+	// owner's private notes.
+	created = created.VisibleTo(createdBy)
+	created.BlackoutWarnings = warnings
+	created.PastStartWarning = pastStartWarning
+	// The store's warnings are the overlaps the conflict policy let through.
+	created.Warnings = append(createWarnings(warnings, pastStartWarning), created.Warnings...)
+	created.SourceDefault = appt.SourceDefault
+	if !created.Milestone() {
+		created.Warnings = append(created.Warnings, s.advise(ctx, advisoryTarget{
+			userID:        created.UserID,
+			spans:         []domain.BusyInterval{{Start: created.StartTime.UTC(), End: created.EndTime.UTC()}},
+			appointmentID: created.ID,
+		})...)
+	}
+	return created, nil
+}
+
+// MaxSourceFilterLength bounds a source filter; the longest real source is
+// a sync prefix and a 64-byte external system.
+const MaxSourceFilterLength = 80
+
+func (s *Service) List(ctx context.Context, userID string, windowStart, windowEnd time.Time, filter store.AppointmentFilter) ([]domain.Appointment, error) {
+	if userID == "" {
+		return nil, validationError("user_id is required")
+	}
+	metadata, err := s.checkMetadata(ctx, filter.Metadata)
+	if err != nil {
+		return nil, err
+	}
+	filter.Metadata = metadata
+	filter.Source = strings.TrimSpace(filter.Source)
+	if len(filter.Source) > MaxSourceFilterLength {
+		return nil, validationError("source too long")
+	}
+
+	start := windowStart.UTC()
+	end := windowEnd.UTC()
+	if end.Equal(start) || end.Before(start) {
+		return nil, validationError("window_end must be after window_start")
+	}
+
+	return s.repo.List(ctx, userID, start, end, filter)
+}
+
+// EndTimeSuggestion is a proposed end for a booking and whether busy time or
+// the end of the working day cut it short.
+type EndTimeSuggestion struct {
+	EndTime   time.Time
+	Shortened bool
+}
+
+// SuggestEndTime proposes an end for a booking starting at start that lasts up
+// to desired, ending early where the user's next busy time begins. With hours
+// set it also ends no later than the working day. It returns
+// store.ErrConflict when start itself is busy, and ErrOutsideWorkingHours
+// when start is outside hours.
+func (s *Service) SuggestEndTime(ctx context.Context, userID string, start time.Time, desired time.Duration, hours *WorkingHoursInput) (EndTimeSuggestion, error) {
+	if userID == "" {
+		return EndTimeSuggestion{}, validationError("user_id is required")
+	}
+	if desired <= 0 {
+		return EndTimeSuggestion{}, validationError("desired_duration must be positive")
+	}
+	if desired > MaxAppointmentDuration {
+		return EndTimeSuggestion{}, validationError("duration too long")
+	}
+
+	start = start.UTC()
+	end := start.Add(desired)
+	clamped := false
+	if hours != nil {
+		wh, err := workingHours(*hours)
+		if err != nil {
+			return EndTimeSuggestion{}, err
+		}
+		until, ok := wh.WorkingUntil(start, end)
+		if !ok {
+			return EndTimeSuggestion{}, ErrOutsideWorkingHours
+		}
+		clamped = until.Before(end)
+		end = until
+	}
+
+	busy, err := s.userBusy(ctx, userID, start, end)
+	if err != nil {
+		return EndTimeSuggestion{}, err
+	}
+
+	// busy is merged and sorted, so only the first interval matters.
+	if len(busy) == 0 {
+		return EndTimeSuggestion{EndTime: end, Shortened: clamped}, nil
+	}
+	if !busy[0].Start.After(start) {
+		return EndTimeSuggestion{}, store.ErrConflict
+	}
+	return EndTimeSuggestion{EndTime: busy[0].Start, Shortened: true}, nil
+}
+
+// MaxFreeBusyUsers caps how many calendars one BatchGetFreeBusy call reads.
+const MaxFreeBusyUsers = 50
+
+// freeBusyConcurrency bounds parallel repo reads so a large batch cannot take
+// every pooled connection at once.
+const freeBusyConcurrency = 8
+
+// UserFreeBusy is one user's busy time in a batch. Err is set instead of Busy
+// when that user's calendar could not be read.
+type UserFreeBusy struct {
+	UserID string
+	Busy   []domain.BusyInterval
+	Err    error
+}
+
+// BatchGetFreeBusy returns merged busy intervals, clipped to the window, for
+// each distinct user in request order. A failed read is reported on that
+// user's result and does not fail the batch.
+func (s *Service) BatchGetFreeBusy(ctx context.Context, userIDs []string, windowStart, windowEnd time.Time) ([]UserFreeBusy, error) {
+	if len(userIDs) == 0 {
+		return nil, validationError("user_ids is required")
+	}
+	start := windowStart.UTC()
+	end := windowEnd.UTC()
+	if end.Equal(start) || end.Before(start) {
+		return nil, validationError("window_end must be after window_start")
+	}
+
+	ids := make([]string, 0, len(userIDs))
+	seen := make(map[string]struct{}, len(userIDs))
+	for _, id := range userIDs {
+		if id == "" {
+			return nil, validationError("user_ids must not contain empty ids")
+		}
+		if _, ok := seen[id]; ok {
+			continue
+		}
+		seen[id] = struct{}{}
+		ids = append(ids, id)
+	}
+	if len(ids) > MaxFreeBusyUsers {
+		return nil, validationError("too many user_ids")
+	}
+
+	out := make([]UserFreeBusy, len(ids))
+	sem := make(chan struct{}, freeBusyConcurrency)
+	var wg sync.WaitGroup
+	for i, id := range ids {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			sem <- struct{}{}
+			defer func() { <-sem }()
+
+			busy, err := s.userBusy(ctx, id, start, end)
+			out[i] = UserFreeBusy{UserID: id, Busy: busy, Err: err}
+		}()
+	}
+	wg.Wait()
+
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// userBusy covers appointments, series occurrences, time off, daily breaks
+// and unexpired slot holds.
+func (s *Service) userBusy(ctx context.Context, userID string, start, end time.Time) ([]domain.BusyInterval, error) {
+	appts, err := s.repo.List(ctx, userID, start, end, store.AppointmentFilter{})
+	if err != nil {
+		return nil, err
+	}
+	occs, err := s.repo.ListOccurrences(ctx, userID, start, end)
+	if err != nil {
+		return nil, err
+	}
+
+	clip := func(from, to time.Time) domain.BusyInterval {
+		iv := domain.BusyInterval{Start: from.UTC(), End: to.UTC()}
+		if iv.Start.Before(start) {
+			iv.Start = start
+		}
+		if iv.End.After(end) {
+			iv.End = end
+		}
+		return iv
+	}
+	away, err := s.timeOffBusy(ctx, userID, start, end)
+	if err != nil {
+		return nil, err
+	}
+	settings, err := s.repo.GetUserSettings(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	breaks := domain.DailyBreakSpans(settings.DailyBreaks, slotLocation(settings), start, end)
+	holds, err := s.repo.ListActiveHolds(ctx, userID, start, end)
+	if err != nil {
+		return nil, err
+	}
+
+	intervals := make([]domain.BusyInterval, 0, len(appts)+len(occs)+len(away)+len(breaks)+len(holds))
+	intervals = append(intervals, away...)
+	intervals = append(intervals, breaks...)
+	for _, h := range holds {
+		intervals = append(intervals, clip(h.StartTime, h.EndTime))
+	}
+	for _, a := range appts {
+		if a.Milestone() {
+			continue
+		}
+		intervals = append(intervals, clip(a.StartTime, a.EndTime))
+	}
+	for _, o := range occs {
+		intervals = append(intervals, clip(o.StartTime, o.EndTime))
+	}
+	return domain.MergeBusy(intervals), nil
+}
+
+// Meeting suggestion bounds. The window cap keeps the slot walk small; the
+// step is the granularity of candidate start times.
+const (
+	MaxSuggestionWindow   = 14 * 24 * time.Hour
+	DefaultSuggestionStep = 15 * time.Minute
+	MinSuggestionStep     = 5 * time.Minute
+	DefaultMaxSuggestions = 5
+	MaxSuggestions        = 50
+)
+
+// WorkingHoursInput is an attendee's local working day. Minutes count from
+// local midnight; empty Weekdays means every day.
+type WorkingHoursInput struct {
+	TimeZone    string
+	StartMinute int
+	EndMinute   int
+	Weekdays    []int16
+}
+
+type MeetingAttendeeInput struct {
+	UserID       string
+	WorkingHours *WorkingHoursInput
+	Preferred    []domain.BusyInterval
+}
+
+type SuggestMeetingTimesInput struct {
+	Attendees   []MeetingAttendeeInput
+	Duration    time.Duration
+	WindowStart time.Time
+	WindowEnd   time.Time
+	Step        time.Duration
+	MaxResults  int
+}
+
+// SuggestMeetingTimes loads every attendee's busy time and ranks free slots
+// with the scheduling engine. Unlike BatchGetFreeBusy it fails if any
+// calendar cannot be read, since a slot is only free if everyone is.
+func (s *Service) SuggestMeetingTimes(ctx context.Context, in SuggestMeetingTimesInput) ([]scheduling.Suggestion, error) {
+	if len(in.Attendees) == 0 {
+		return nil, validationError("attendees is required")
+	}
+	if in.Duration <= 0 {
+		return nil, validationError("duration must be positive")
+	}
+	if in.Duration > MaxAppointmentDuration {
+		return nil, validationError("duration too long")
+	}
+	start := in.WindowStart.UTC()
+	end := in.WindowEnd.UTC()
+	if end.Equal(start) || end.Before(start) {
+		return nil, validationError("window_end must be after window_start")
+	}
+	if end.Sub(start) > MaxSuggestionWindow {
+		return nil, validationError("window too long")
+	}
+
+	step := in.Step
+	if step == 0 {
+		step = DefaultSuggestionStep
+	}
+	if step < MinSuggestionStep {
+		return nil, validationError("step too short")
+	}
+	maxResults := in.MaxResults
+	if maxResults == 0 {
+		maxResults = DefaultMaxSuggestions
+	}
+	if maxResults < 0 || maxResults > MaxSuggestions {
+		return nil, validationError("max_results out of range")
+	}
+
+	attendees := make([]scheduling.Attendee, len(in.Attendees))
+	ids := make([]string, len(in.Attendees))
+	for i, a := range in.Attendees {
+		if a.UserID == "" {
+			return nil, validationError("attendee user_id is required")
+		}
+		ids[i] = a.UserID
+		attendees[i] = scheduling.Attendee{ID: a.UserID, Preferred: a.Preferred}
+		if a.WorkingHours != nil {
+			wh, err := workingHours(*a.WorkingHours)
+			if err != nil {
+				return nil, err
+			}
+			attendees[i].WorkingHours = &wh
+		}
+	}
+
+	// Read a day either side so the load score sees whole local days.
+	results, err := s.BatchGetFreeBusy(ctx, ids, start.Add(-24*time.Hour), end.Add(24*time.Hour))
+	if err != nil {
+		return nil, err
+	}
+	busy := make(map[string][]domain.BusyInterval, len(results))
+	for _, r := range results {
+		if r.Err != nil {
+			return nil, r.Err
+		}
+		busy[r.UserID] = r.Busy
+	}
+	for i := range attendees {
+		attendees[i].Busy = busy[attendees[i].ID]
+	}
+
+	return scheduling.Suggest(attendees, scheduling.Constraints{
+		WindowStart: start,
+		WindowEnd:   end,
+		Duration:    in.Duration,
+		Step:        step,
+		MaxResults:  maxResults,
+	}), nil
+}
+
+func workingHours(in WorkingHoursInput) (scheduling.WorkingHours, error) {
+	loc, err := time.LoadLocation(strings.TrimSpace(in.TimeZone))
+	if err != nil || strings.TrimSpace(in.TimeZone) == "" {
+		return scheduling.WorkingHours{}, validationError("invalid working_hours time_zone")
+	}
+	if in.StartMinute < 0 || in.EndMinute > 24*60 || in.EndMinute <= in.StartMinute {
+		return scheduling.WorkingHours{}, validationError("invalid working_hours range")
+	}
+	wh := scheduling.WorkingHours{Location: loc, StartMinute: in.StartMinute, EndMinute: in.EndMinute}
+	for _, wd := range in.Weekdays {
+		if wd < 1 || wd > 7 {
+			return scheduling.WorkingHours{}, validationError("invalid weekday")
+		}
+		wh.Weekdays = append(wh.Weekdays, time.Weekday(wd%7))
+	}
+	return wh, nil
+}
+
+// SeriesRepairReport lists what RepairRecurringSeries found and how many
+// exceptions it deleted.
+type SeriesRepairReport struct {
+	SeriesID uuid.UUID
+	Findings []domain.SeriesFinding
+	Repaired int
+}
+
+// RepairRecurringSeries audits a series' exceptions against its current
+// expansion. With apply set, exceptions behind each finding are deleted;
+// otherwise it only reports.
+func (s *Service) RepairRecurringSeries(ctx context.Context, userID string, seriesID uuid.UUID, apply bool) (SeriesRepairReport, error) {
+	if userID == "" {
+		return SeriesRepairReport{}, validationError("user_id is required")
+	}
+	if seriesID == uuid.Nil {
+		return SeriesRepairReport{}, validationError("series_id is required")
+	}
+	if apply {
+		if err := s.authorizeOwner(ctx, userID); err != nil {
+			return SeriesRepairReport{}, err
+		}
+	}
+
+	series, err := s.repo.GetRecurringSeries(ctx, userID, seriesID)
+	if err != nil {
+		return SeriesRepairReport{}, err
+	}
+	exceptions, err := s.repo.ListSeriesExceptions(ctx, seriesID)
+	if err != nil {
+		return SeriesRepairReport{}, err
+	}
+
+	report := SeriesRepairReport{
+		SeriesID: seriesID,
+		Findings: domain.AuditRecurringSeries(series, exceptions, store.RecurringConflictLookahead),
+	}
+	if !apply {
+		return report, nil
+	}
+
+	var ids []uuid.UUID
+	for _, f := range report.Findings {
+		if f.Repairable() {
+			ids = append(ids, f.ExceptionID)
+		}
+	}
+	n, err := s.repo.DeleteRecurringExceptions(ctx, seriesID, ids)
+	if err != nil {
+		return SeriesRepairReport{}, err
+	}
+	s.events.Publish(ctx, events.SeriesUpdated{UserID: userID, SeriesID: seriesID})
+	for i := range report.Findings {
+		report.Findings[i].Repaired = report.Findings[i].Repairable()
+	}
+	report.Repaired = n
+	return report, nil
+}
+
+// UpdateSeriesEndInput sets a new end for a series. At least one of Until
+// and Count is required; together they replace the series' current bounds.
+type UpdateSeriesEndInput struct {
+	UserID   string
+	SeriesID uuid.UUID
+	Until    *time.Time
+	Count    *int
+}
+
+// UpdateSeriesEnd shortens a series. Exceptions dated after the new last
+// occurrence are deleted with the change, and their number is returned.
+// A new end that would add occurrences is rejected, since they were never
+// checked for conflicts.
+func (s *Service) UpdateSeriesEnd(ctx context.Context, in UpdateSeriesEndInput) (domain.RecurringSeries, int, error) {
+	if in.UserID == "" {
+		return domain.RecurringSeries{}, 0, validationError("user_id is required")
+	}
+	if in.SeriesID == uuid.Nil {
+		return domain.RecurringSeries{}, 0, validationError("series_id is required")
+	}
+	if in.Until == nil && in.Count == nil {
+		return domain.RecurringSeries{}, 0, validationError("until or count is required")
+	}
+	if in.Count != nil && *in.Count < 1 {
+		return domain.RecurringSeries{}, 0, validationError("count must be at least 1")
+	}
+	if err := s.authorizeOwner(ctx, in.UserID); err != nil {
+		return domain.RecurringSeries{}, 0, err
+	}
+
+	series, err := s.repo.GetRecurringSeries(ctx, in.UserID, in.SeriesID)
+	if err != nil {
+		return domain.RecurringSeries{}, 0, err
+	}
+	shortened := series
+	shortened.Until = nil
+	if in.Until != nil {
+		u := in.Until.UTC()
+		shortened.Until = &u
+	}
+	shortened.Count = in.Count
+
+	horizonEnd := domain.SeriesHorizonEnd(series, store.RecurringConflictLookahead)
+	before, err := domain.GenerateWeeklyOccurrences(series, series.DTStart, horizonEnd)
+	if err != nil {
+		return domain.RecurringSeries{}, 0, validationError(err.Error())
+	}
+	after, err := domain.GenerateWeeklyOccurrences(shortened, series.DTStart, horizonEnd)
+	if err != nil {
+		return domain.RecurringSeries{}, 0, validationError(err.Error())
+	}
+	if len(after) == 0 {
+		return domain.RecurringSeries{}, 0, validationError("the new end leaves the series with no occurrences")
+	}
+	if len(before) == 0 || after[len(after)-1].StartTime.After(before[len(before)-1].StartTime) {
+		return domain.RecurringSeries{}, 0, validationError("the new end must not extend the series")
+	}
+
+	removed, err := s.repo.UpdateRecurringSeriesEnd(ctx, shortened)
+	if err != nil {
+		return domain.RecurringSeries{}, 0, err
+	}
+	s.events.Publish(ctx, events.SeriesUpdated{UserID: in.UserID, SeriesID: in.SeriesID})
+
+	now := s.now().UTC()
+	newHorizonEnd := domain.SeriesHorizonEnd(shortened, store.RecurringConflictLookahead)
+	if !newHorizonEnd.After(now) {
+		return shortened.WithProgress(nil, now), removed, nil
+	}
+	occs, err := s.repo.ListSeriesOccurrences(ctx, shortened, now, newHorizonEnd)
+	if err != nil {
+		return domain.RecurringSeries{}, 0, err
+	}
+	return shortened.WithProgress(occs, now), removed, nil
+}
+
+func (s *Service) GetByExternalRef(ctx context.Context, userID string, ref ExternalRef) (domain.Appointment, error) {
+	if userID == "" {
+		return domain.Appointment{}, validationError("user_id is required")
+	}
+	ref, err := normalizeExternalRef(ref)
+	if err != nil {
+		return domain.Appointment{}, err
+	}
+	return s.repo.GetByExternalRef(ctx, userID, ref.System, ref.ID)
+}
+
+type DeleteInput struct {
+	UserID        string
+	AppointmentID uuid.UUID
+
+	// ActorID is who is making the request; see CreateInput.ActorID.
+	ActorID string
+}
+
+func (s *Service) Delete(ctx context.Context, in DeleteInput) error {
+	if in.UserID == "" {
+		return validationError("user_id is required")
+	}
+	if in.AppointmentID == uuid.Nil {
+		return validationError("appointment_id is required")
+	}
+	if _, err := s.authorizeActor(ctx, in.ActorID, in.UserID); err != nil {
+		return err
+	}
+	if err := s.repo.Delete(ctx, in.UserID, in.AppointmentID); err != nil {
+		return err
+	}
+	s.events.Publish(ctx, events.AppointmentDeleted{UserID: in.UserID, AppointmentID: in.AppointmentID})
+	return nil
+}
+
+// authorizeActor checks that actorID may write to userID's calendar and
+// returns the actor to record as created_by, which is empty when users act
+// for themselves. Nobody may write to a frozen calendar, and a deactivated
+// actor may not write to anyone's.
+func (s *Service) authorizeActor(ctx context.Context, actorID, userID string) (string, error) {
+	actorID = strings.TrimSpace(actorID)
+	if actorID == "" || actorID == userID {
+		return "", s.checkActive(ctx, userID)
+	}
+	if err := s.checkActive(ctx, userID, actorID); err != nil {
+		return "", err
+	}
+	ok, err := s.repo.HasDelegation(ctx, userID, actorID)
+	if err != nil {
+		return "", err
+	}
+	if !ok {
+		return "", ErrNotAuthorized
+	}
+	return actorID, nil
+}
+
+// authorizeOwner checks that userID may write to their own calendar, which
+// they may unless it is frozen.
+func (s *Service) authorizeOwner(ctx context.Context, userID string) error {
+	_, err := s.authorizeActor(ctx, "", userID)
+	return err
+}
+
+func validateDelegation(principalID, delegateID string) error {
+	if principalID == "" {
+		return validationError("principal_id is required")
+	}
+	if delegateID == "" {
+		return validationError("delegate_id is required")
+	}
+	if principalID == delegateID {
+		return validationError("delegate_id must differ from principal_id")
+	}
+	return nil
+}
+
+// GrantDelegation lets delegateID create and delete appointments on
+// principalID's calendar. Granting twice is a no-op.
+func (s *Service) GrantDelegation(ctx context.Context, principalID, delegateID string) (domain.DelegationGrant, error) {
+	principalID = strings.TrimSpace(principalID)
+	delegateID = strings.TrimSpace(delegateID)
+	if err := validateDelegation(principalID, delegateID); err != nil {
+		return domain.DelegationGrant{}, err
+	}
+	// Revoking stays open on a frozen calendar, since it only removes access.
+	if err := s.authorizeOwner(ctx, principalID); err != nil {
+		return domain.DelegationGrant{}, err
+	}
+	return s.repo.GrantDelegation(ctx, domain.DelegationGrant{PrincipalID: principalID, DelegateID: delegateID})
+}
+
+func (s *Service) RevokeDelegation(ctx context.Context, principalID, delegateID string) error {
+	principalID = strings.TrimSpace(principalID)
+	delegateID = strings.TrimSpace(delegateID)
+	if err := validateDelegation(principalID, delegateID); err != nil {
+		return err
+	}
+	return s.repo.RevokeDelegation(ctx, principalID, delegateID)
+}
+
+func (s *Service) ListDelegations(ctx context.Context, principalID string) ([]domain.DelegationGrant, error) {
+	if principalID == "" {
+		return nil, validationError("principal_id is required")
+	}
+	return s.repo.ListDelegations(ctx, principalID)
+}
+
+type CreateBlackoutInput struct {
+	Title     string
+	StartTime time.Time
+	EndTime   time.Time
+	// Mode defaults to domain.BlackoutModeBlock.
+	Mode domain.BlackoutMode
+}
+
+// CreateBlackout adds a deployment-wide blackout. Existing bookings inside
+// the window are left alone; it only affects new ones.
+func (s *Service) CreateBlackout(ctx context.Context, in CreateBlackoutInput) (domain.Blackout, error) {
+	title := strings.TrimSpace(in.Title)
+	if title == "" {
+		return domain.Blackout{}, validationError("title is required")
+	}
 	start := in.StartTime.UTC()
 	end := in.EndTime.UTC()
-	if end.Equal(start) || end.Before(start) {
-		return domain.Appointment{}, validationError("end_time must be after start_time")
+	if !end.After(start) {
+		return domain.Blackout{}, validationError("end_time must be after start_time")
 	}
-	if end.Sub(start) > 24*time.Hour {
-		return domain.Appointment{}, validationError("duration too long")
+	mode := in.Mode
+	if mode == "" {
+		mode = domain.BlackoutModeBlock
 	}
-
-	appt := domain.Appointment{
-		UserID:    in.UserID,
+	if !mode.Valid() {
+		return domain.Blackout{}, validationError("invalid mode")
+	}
+	return s.repo.CreateBlackout(ctx, domain.Blackout{
 		Title:     title,
-		Notes:     in.Notes,
 		StartTime: start,
 		EndTime:   end,
-	}
-
-	key := strings.TrimSpace(in.IdempotencyKey)
-	if key != "" {
-		if len(key) > 256 {
-			return domain.Appointment{}, validationError("idempotency_key too long")
-		}
-		appt.ID = uuid.NewSHA1(uuid.NameSpaceOID, []byte("schedula:create_appointment:"+in.UserID+":"+key))
-	}
-
-	return s.repo.Create(ctx, appt)
+		Mode:      mode,
+	})
 }
 
-func (s *Service) List(ctx context.Context, userID string, windowStart, windowEnd time.Time) ([]domain.Appointment, error) {
-	if userID == "" {
-		return nil, validationError("user_id is required")
+func (s *Service) DeleteBlackout(ctx context.Context, blackoutID uuid.UUID) error {
+	if blackoutID == uuid.Nil {
+		return validationError("blackout_id is required")
 	}
+	return s.repo.DeleteBlackout(ctx, blackoutID)
+}
 
+func (s *Service) ListBlackouts(ctx context.Context, windowStart, windowEnd time.Time) ([]domain.Blackout, error) {
 	start := windowStart.UTC()
 	end := windowEnd.UTC()
-	if end.Equal(start) || end.Before(start) {
+	if !end.After(start) {
 		return nil, validationError("window_end must be after window_start")
 	}
-
-	return s.repo.List(ctx, userID, start, end)
+	return s.repo.ListBlackouts(ctx, start, end)
 }
 
-func (s *Service) Delete(ctx context.Context, userID string, appointmentID uuid.UUID) error {
-	if userID == "" {
-		return validationError("user_id is required")
+// checkBlackouts returns the warn-mode blackouts overlapping any of spans,
+// or an error wrapping ErrBlackout if a block-mode one does.
+func (s *Service) checkBlackouts(ctx context.Context, spans []domain.BusyInterval) ([]domain.Blackout, error) {
+	if len(spans) == 0 {
+		return nil, nil
 	}
-	if appointmentID == uuid.Nil {
-		return validationError("appointment_id is required")
+	windowStart, windowEnd := spans[0].Start, spans[0].End
+	for _, sp := range spans[1:] {
+		if sp.Start.Before(windowStart) {
+			windowStart = sp.Start
+		}
+		if sp.End.After(windowEnd) {
+			windowEnd = sp.End
+		}
+	}
+	blackouts, err := s.repo.ListBlackouts(ctx, windowStart, windowEnd)
+	if err != nil {
+		return nil, err
+	}
+
+	var warnings []domain.Blackout
+	for _, b := range blackouts {
+		if !slices.ContainsFunc(spans, func(sp domain.BusyInterval) bool { return b.Overlaps(sp.Start, sp.End) }) {
+			continue
+		}
+		if b.Mode == domain.BlackoutModeBlock {
+			return nil, fmt.Errorf("%w: %s", ErrBlackout, b.Title)
+		}
+		warnings = append(warnings, b)
 	}
-	return s.repo.Delete(ctx, userID, appointmentID)
+	return warnings, nil
 }
 
 type CreateRecurringSeriesInput struct {
@@ -108,8 +1032,24 @@ type CreateRecurringSeriesInput struct {
 	StartTime time.Time
 	EndTime   time.Time
 	Rule      RecurrenceRuleInput
+	Metadata  map[string]string
+
+	// ProgramID optionally puts the series in one of the user's programs
+	// that is not cancelled.
+	ProgramID *uuid.UUID
+
+	// SkipConflicts creates the series even if up to MaxSkippedConflicts
+	// occurrences overlap existing bookings, skipping those occurrences.
+	SkipConflicts bool
+
+	// ActorID is who is making the request; see CreateInput.ActorID.
+	ActorID string
 }
 
+// MaxSkippedConflicts is how many conflicting occurrences CreateRecurringSeries
+// will skip when SkipConflicts is set; more than that is still a conflict.
+const MaxSkippedConflicts = 2
+
 type RecurrenceRuleInput struct {
 	Frequency domain.RecurrenceFrequency
 	Interval  int
@@ -117,6 +1057,18 @@ type RecurrenceRuleInput struct {
 	Until     *time.Time
 	Count     *int
 	TimeZone  string
+
+	// WeekStart is the RFC 5545 WKST (1 = Monday ... 7 = Sunday) that decides
+	// which weeks an interval above 1 skips. Zero means Monday.
+	WeekStart int16
+
+	// DSTGap and DSTAmbiguous default to shift_forward and earlier.
+	DSTGap       domain.DSTGapPolicy
+	DSTAmbiguous domain.DSTAmbiguousPolicy
+
+	// WeekdayTimes start some of the weekdays at their own local time
+	// instead of StartTime's. Each must name a weekday in ByWeekday.
+	WeekdayTimes []domain.WeekdayTime
 }
 
 func (s *Service) CreateRecurringSeries(ctx context.Context, in CreateRecurringSeriesInput) (domain.RecurringSeries, error) {
@@ -127,6 +1079,13 @@ func (s *Service) CreateRecurringSeries(ctx context.Context, in CreateRecurringS
 	if in.UserID == "" {
 		return domain.RecurringSeries{}, validationError("user_id is required")
 	}
+	if err := s.checkText(ctx, title, in.Notes); err != nil {
+		return domain.RecurringSeries{}, err
+	}
+	metadata, err := s.checkMetadata(ctx, in.Metadata)
+	if err != nil {
+		return domain.RecurringSeries{}, err
+	}
 
 	frequency := in.Rule.Frequency
 	if frequency == "" {
@@ -145,12 +1104,35 @@ func (s *Service) CreateRecurringSeries(ctx context.Context, in CreateRecurringS
 		return domain.RecurringSeries{}, validationError("invalid time_zone")
 	}
 
+	weekStart := in.Rule.WeekStart
+	if weekStart == 0 {
+		weekStart = 1
+	}
+	if weekStart < 1 || weekStart > 7 {
+		return domain.RecurringSeries{}, validationError("invalid week_start")
+	}
+
+	dstGap := in.Rule.DSTGap
+	if dstGap == "" {
+		dstGap = domain.DSTGapShiftForward
+	}
+	if !dstGap.Valid() {
+		return domain.RecurringSeries{}, validationError("invalid dst_gap_policy")
+	}
+	dstAmbiguous := in.Rule.DSTAmbiguous
+	if dstAmbiguous == "" {
+		dstAmbiguous = domain.DSTAmbiguousEarlier
+	}
+	if !dstAmbiguous.Valid() {
+		return domain.RecurringSeries{}, validationError("invalid dst_ambiguous_policy")
+	}
+
 	start := in.StartTime.UTC()
 	end := in.EndTime.UTC()
 	if end.Equal(start) || end.Before(start) {
 		return domain.RecurringSeries{}, validationError("end_time must be after start_time")
 	}
-	if end.Sub(start) > 24*time.Hour {
+	if end.Sub(start) > MaxAppointmentDuration {
 		return domain.RecurringSeries{}, validationError("duration too long")
 	}
 	durationSeconds := int(end.Sub(start) / time.Second)
@@ -163,6 +1145,9 @@ func (s *Service) CreateRecurringSeries(ctx context.Context, in CreateRecurringS
 		return domain.RecurringSeries{}, validationError("interval must be at least 1")
 	}
 
+	if s.limits.MaxWeekdays > 0 && len(in.Rule.ByWeekday) > s.limits.MaxWeekdays {
+		return domain.RecurringSeries{}, validationError("too many weekdays")
+	}
 	weekdays := in.Rule.ByWeekday
 	if len(weekdays) == 0 {
 		weekday := start.In(loc).Weekday()
@@ -199,6 +1184,11 @@ func (s *Service) CreateRecurringSeries(ctx context.Context, in CreateRecurringS
 		normalized[j+1] = key
 	}
 
+	weekdayTimes, err := normalizeWeekdayTimes(in.Rule.WeekdayTimes, dedup, start.In(loc))
+	if err != nil {
+		return domain.RecurringSeries{}, err
+	}
+
 	var untilUTC *time.Time
 	if in.Rule.Until != nil {
 		u := in.Rule.Until.UTC()
@@ -233,6 +1223,11 @@ func (s *Service) CreateRecurringSeries(ctx context.Context, in CreateRecurringS
 		ByWeekday:       normalized,
 		Until:           untilUTC,
 		Count:           count,
+		WeekStart:       weekStart,
+		DSTGap:          dstGap,
+		DSTAmbiguous:    dstAmbiguous,
+		WeekdayTimes:    weekdayTimes,
+		Metadata:        metadata,
 	}
 
 	lookaheadEnd := start.Add(store.RecurringConflictLookahead)
@@ -252,13 +1247,21 @@ func (s *Service) CreateRecurringSeries(ctx context.Context, in CreateRecurringS
 	seriesForCount := series
 	seriesForCount.Until = &occLimitEnd
 	seriesForCount.Count = nil
+	expandStart := time.Now()
 	occs, err := domain.GenerateWeeklyOccurrences(seriesForCount, start, occLimitEnd.Add(duration))
+	timing.Since(ctx, timing.Expansion, expandStart)
 	if err != nil {
 		return domain.RecurringSeries{}, err
 	}
 	if len(occs) == 0 {
 		return domain.RecurringSeries{}, validationError("recurrence rule produces no occurrences")
 	}
+	// With per-weekday times a long occurrence can run into the next day's.
+	for i := 1; i < len(occs); i++ {
+		if occs[i].StartTime.Before(occs[i-1].EndTime) {
+			return domain.RecurringSeries{}, validationError("occurrences of the series overlap each other")
+		}
+	}
 	if count != nil && *count > len(occs) {
 		if untilUTC != nil && untilUTC.Before(lookaheadEnd) {
 			return domain.RecurringSeries{}, validationError("count exceeds occurrences available before until")
@@ -266,7 +1269,171 @@ func (s *Service) CreateRecurringSeries(ctx context.Context, in CreateRecurringS
 		return domain.RecurringSeries{}, validationError("count exceeds occurrences available within 180 days of start_time")
 	}
 
-	return s.repo.CreateRecurringSeries(ctx, series)
+	createdBy, err := s.authorizeActor(ctx, in.ActorID, in.UserID)
+	if err != nil {
+		return domain.RecurringSeries{}, err
+	}
+	series.CreatedBy = createdBy
+
+	if in.ProgramID != nil {
+		if err := s.checkProgram(ctx, in.UserID, *in.ProgramID); err != nil {
+			return domain.RecurringSeries{}, err
+		}
+		series.ProgramID = in.ProgramID
+	}
+
+	booked := occs
+	if count != nil {
+		booked = occs[:*count]
+	}
+	added := 0
+	now := s.now().UTC()
+	for _, o := range booked {
+		if !o.StartTime.Before(now) {
+			added++
+		}
+	}
+	if err := s.checkSeriesLimits(ctx, in.UserID, added, now); err != nil {
+		return domain.RecurringSeries{}, err
+	}
+
+	spans := make([]domain.BusyInterval, 0, len(booked))
+	for _, o := range booked {
+		spans = append(spans, domain.BusyInterval{Start: o.StartTime, End: o.EndTime})
+	}
+	warnings, err := s.checkBlackouts(ctx, spans)
+	if err != nil {
+		return domain.RecurringSeries{}, err
+	}
+
+	var created domain.RecurringSeries
+	if in.SkipConflicts {
+		created, err = s.repo.CreateRecurringSeriesSkippingConflicts(ctx, series, MaxSkippedConflicts)
+	} else {
+		created, err = s.repo.CreateRecurringSeries(ctx, series)
+	}
+	if err != nil {
+		return domain.RecurringSeries{}, err
+	}
+	s.events.Publish(ctx, events.SeriesCreated{UserID: in.UserID, SeriesID: created.ID})
+
+	// A new series has no exceptions other than the skips just added, so the
+	// generated occurrences minus those are the whole story.
+	expandStart = time.Now()
+	occs, err = domain.GenerateWeeklyOccurrences(created, now, domain.SeriesHorizonEnd(created, store.RecurringConflictLookahead))
+	timing.Since(ctx, timing.Expansion, expandStart)
+	if err != nil {
+		return domain.RecurringSeries{}, err
+	}
+	if len(created.SkippedOccurrences) > 0 {
+		kept := occs[:0]
+		for _, o := range occs {
+			if !slices.ContainsFunc(created.SkippedOccurrences, o.StartTime.Equal) {
+				kept = append(kept, o)
+			}
+		}
+		occs = kept
+	}
+	created = created.WithProgress(occs, now)
+	created.BlackoutWarnings = warnings
+	created.Warnings = append(createWarnings(warnings, false), created.Warnings...)
+	spans = spans[:0]
+	for _, o := range occs {
+		spans = append(spans, domain.BusyInterval{Start: o.StartTime.UTC(), End: o.EndTime.UTC()})
+	}
+	created.Warnings = append(created.Warnings, s.advise(ctx, advisoryTarget{userID: created.UserID, spans: spans, seriesID: created.ID})...)
+	return created, nil
+}
+
+// normalizeWeekdayTimes checks a rule's per-weekday start times against its
+// weekdays and sorts them by weekday. A time given for start's own weekday
+// must agree with start, which is the series' first occurrence.
+func normalizeWeekdayTimes(in []domain.WeekdayTime, weekdays map[int16]struct{}, start time.Time) ([]domain.WeekdayTime, error) {
+	if len(in) == 0 {
+		return nil, nil
+	}
+	startWeekday := int16(start.Weekday())
+	if startWeekday == 0 {
+		startWeekday = 7
+	}
+	out := make([]domain.WeekdayTime, 0, len(in))
+	seen := make(map[int16]struct{}, len(in))
+	for _, wt := range in {
+		if _, ok := weekdays[wt.Weekday]; !ok {
+			return nil, validationError("weekday_times must name weekdays of the rule")
+		}
+		if _, ok := seen[wt.Weekday]; ok {
+			return nil, validationError("weekday_times has a weekday more than once")
+		}
+		seen[wt.Weekday] = struct{}{}
+		if wt.StartMinute < 0 || wt.StartMinute >= domain.MinutesPerDay {
+			return nil, validationError("invalid weekday start_minute")
+		}
+		if wt.Weekday == startWeekday && (start.Hour()*60+start.Minute() != wt.StartMinute || start.Second() != 0 || start.Nanosecond() != 0) {
+			return nil, validationError("weekday_times conflicts with start_time")
+		}
+		out = append(out, wt)
+	}
+	slices.SortFunc(out, func(a, b domain.WeekdayTime) int { return int(a.Weekday) - int(b.Weekday) })
+	return out, nil
+}
+
+func (s *Service) GetRecurringSeries(ctx context.Context, userID string, seriesID uuid.UUID) (domain.RecurringSeries, error) {
+	if userID == "" {
+		return domain.RecurringSeries{}, validationError("user_id is required")
+	}
+	if seriesID == uuid.Nil {
+		return domain.RecurringSeries{}, validationError("series_id is required")
+	}
+
+	series, err := s.repo.GetRecurringSeries(ctx, userID, seriesID)
+	if err != nil {
+		return domain.RecurringSeries{}, err
+	}
+
+	now := s.now().UTC()
+	horizonEnd := domain.SeriesHorizonEnd(series, store.RecurringConflictLookahead)
+	if !horizonEnd.After(now) {
+		return series.WithProgress(nil, now), nil
+	}
+	occs, err := s.repo.ListSeriesOccurrences(ctx, series, now, horizonEnd)
+	if err != nil {
+		return domain.RecurringSeries{}, err
+	}
+	return series.WithProgress(occs, now), nil
+}
+
+// ListRecurringSeries returns the user's series that repeat on any of
+// weekdays, numbered as ByWeekday is, or every series when weekdays is
+// empty. Series are listed as stored, without progress.
+func (s *Service) ListRecurringSeries(ctx context.Context, userID string, weekdays []int16) ([]domain.RecurringSeries, error) {
+	if userID == "" {
+		return nil, validationError("user_id is required")
+	}
+	mask := domain.AllWeekdays
+	if len(weekdays) > 0 {
+		for _, wd := range weekdays {
+			if wd < 1 || wd > 7 {
+				return nil, validationError("invalid weekday")
+			}
+		}
+		mask = domain.WeekdayMaskOf(weekdays)
+	}
+	return s.repo.ListSeriesOnWeekdays(ctx, userID, mask)
+}
+
+// CapOccurrenceWindow shortens a list window to end at most maxHorizon after
+// its start, so a client can bound how much of a long window is expanded
+// and page on from the returned end. Zero means no cap. truncated reports
+// that the window was shortened.
+func CapOccurrenceWindow(windowStart, windowEnd time.Time, maxHorizon time.Duration) (end time.Time, truncated bool, err error) {
+	if maxHorizon < 0 {
+		return time.Time{}, false, validationError("max_horizon must not be negative")
+	}
+	if maxHorizon == 0 || windowEnd.Sub(windowStart) <= maxHorizon {
+		return windowEnd, false, nil
+	}
+	return windowStart.Add(maxHorizon), true, nil
 }
 
 func (s *Service) ListOccurrences(ctx context.Context, userID string, windowStart, windowEnd time.Time) ([]domain.RecurringOccurrence, error) {
@@ -280,5 +1447,303 @@ func (s *Service) ListOccurrences(ctx context.Context, userID string, windowStar
 		return nil, validationError("window_end must be after window_start")
 	}
 
+	occs, ok, err := s.cachedOccurrences(ctx, userID, start, end)
+	if err != nil {
+		return nil, err
+	}
+	if ok {
+		return occs, nil
+	}
 	return s.repo.ListOccurrences(ctx, userID, start, end)
 }
+
+type MarkAttendanceInput struct {
+	UserID        string
+	SeriesID      uuid.UUID
+	OccurrenceID  string
+	ParticipantID string
+	Status        domain.AttendanceStatus
+}
+
+func (s *Service) MarkAttendance(ctx context.Context, in MarkAttendanceInput) (domain.OccurrenceAttendance, error) {
+	if in.UserID == "" {
+		return domain.OccurrenceAttendance{}, validationError("user_id is required")
+	}
+	if in.SeriesID == uuid.Nil {
+		return domain.OccurrenceAttendance{}, validationError("series_id is required")
+	}
+	participantID := strings.TrimSpace(in.ParticipantID)
+	if participantID == "" {
+		return domain.OccurrenceAttendance{}, validationError("participant_id is required")
+	}
+	if lim := s.limitsFor(ctx); lim.MaxParticipantIDLen > 0 && len(participantID) > lim.MaxParticipantIDLen {
+		return domain.OccurrenceAttendance{}, validationError("participant_id too long")
+	}
+	if in.Status != domain.AttendanceStatusAttended && in.Status != domain.AttendanceStatusMissed {
+		return domain.OccurrenceAttendance{}, validationError("invalid attendance status")
+	}
+	occurrenceSeriesID, occurrenceStart, err := domain.ResolveOccurrenceID(in.OccurrenceID, in.SeriesID, s.now())
+	if errors.Is(err, domain.ErrLegacyOccurrenceIDRetired) {
+		return domain.OccurrenceAttendance{}, validationError("occurrence_id uses the retired timestamp format; use the id ListOccurrences returns")
+	}
+	if err != nil {
+		return domain.OccurrenceAttendance{}, validationError("invalid occurrence_id")
+	}
+	if occurrenceSeriesID != in.SeriesID {
+		return domain.OccurrenceAttendance{}, validationError("occurrence_id belongs to a different series")
+	}
+
+	series, err := s.repo.GetRecurringSeries(ctx, in.UserID, in.SeriesID)
+	if err != nil {
+		return domain.OccurrenceAttendance{}, err
+	}
+
+	// Attendance is keyed by the generated occurrence start, so an id must
+	// name an occurrence the rule actually produces.
+	duration := time.Duration(series.DurationSeconds) * time.Second
+	occs, err := domain.GenerateWeeklyOccurrences(series, occurrenceStart, occurrenceStart.Add(duration))
+	if err != nil {
+		return domain.OccurrenceAttendance{}, err
+	}
+	found := false
+	for _, o := range occs {
+		if o.StartTime.Equal(occurrenceStart) {
+			found = true
+			break
+		}
+	}
+	if !found {
+		return domain.OccurrenceAttendance{}, validationError("occurrence_id does not match an occurrence of the series")
+	}
+	if !s.timePolicy().NotFuture(occurrenceStart) {
+		return domain.OccurrenceAttendance{}, validationError("attendance can only be marked once the occurrence has started")
+	}
+	if err := s.authorizeOwner(ctx, in.UserID); err != nil {
+		return domain.OccurrenceAttendance{}, err
+	}
+
+	return s.repo.MarkAttendance(ctx, domain.OccurrenceAttendance{
+		SeriesID:        series.ID,
+		OccurrenceStart: occurrenceStart,
+		ParticipantID:   participantID,
+		Status:          in.Status,
+	})
+}
+
+func (s *Service) GetAttendanceStats(ctx context.Context, userID string, seriesID uuid.UUID) (domain.AttendanceStats, error) {
+	if userID == "" {
+		return domain.AttendanceStats{}, validationError("user_id is required")
+	}
+	if seriesID == uuid.Nil {
+		return domain.AttendanceStats{}, validationError("series_id is required")
+	}
+
+	series, err := s.repo.GetRecurringSeries(ctx, userID, seriesID)
+	if err != nil {
+		return domain.AttendanceStats{}, err
+	}
+	records, err := s.repo.ListAttendance(ctx, series.ID)
+	if err != nil {
+		return domain.AttendanceStats{}, err
+	}
+	return domain.SummarizeAttendance(records), nil
+}
+
+func (s *Service) UserAnalytics(ctx context.Context, userID string, windowStart, windowEnd time.Time) (domain.UserAnalytics, error) {
+	if userID == "" {
+		return domain.UserAnalytics{}, validationError("user_id is required")
+	}
+
+	start := windowStart.UTC()
+	end := windowEnd.UTC()
+	if end.Equal(start) || end.Before(start) {
+		return domain.UserAnalytics{}, validationError("window_end must be after window_start")
+	}
+
+	return s.repo.UserAnalytics(ctx, userID, start, end)
+}
+
+type ReserveSlotInput struct {
+	UserID    string
+	StartTime time.Time
+	EndTime   time.Time
+	TTL       time.Duration
+}
+
+func (s *Service) ReserveSlot(ctx context.Context, in ReserveSlotInput) (domain.SlotHold, error) {
+	if in.UserID == "" {
+		return domain.SlotHold{}, validationError("user_id is required")
+	}
+
+	start := in.StartTime.UTC()
+	end := in.EndTime.UTC()
+	if end.Equal(start) || end.Before(start) {
+		return domain.SlotHold{}, validationError("end_time must be after start_time")
+	}
+	if end.Sub(start) > MaxAppointmentDuration {
+		return domain.SlotHold{}, validationError("duration too long")
+	}
+
+	ttl := in.TTL
+	if ttl == 0 {
+		ttl = s.expiry.HoldDefault
+	}
+	if ttl < 0 {
+		return domain.SlotHold{}, validationError("ttl must not be negative")
+	}
+	if ttl > s.expiry.HoldMax {
+		return domain.SlotHold{}, validationError("ttl too long")
+	}
+	if policy := s.timePolicy(); !policy.NotPast(start) {
+		return domain.SlotHold{}, validationError("start_time is in the past")
+	} else if !policy.MeetsNotice(start) {
+		return domain.SlotHold{}, validationError("start_time is within the minimum booking notice")
+	}
+	if err := s.checkSlotAlignment(ctx, in.UserID, start); err != nil {
+		return domain.SlotHold{}, err
+	}
+	if err := s.checkTimeOff(ctx, in.UserID, start, end); err != nil {
+		return domain.SlotHold{}, err
+	}
+	if _, err := s.checkBlackouts(ctx, []domain.BusyInterval{{Start: start, End: end}}); err != nil {
+		return domain.SlotHold{}, err
+	}
+	if err := s.authorizeOwner(ctx, in.UserID); err != nil {
+		return domain.SlotHold{}, err
+	}
+
+	return s.repo.ReserveSlot(ctx, domain.SlotHold{
+		UserID:    in.UserID,
+		StartTime: start,
+		EndTime:   end,
+		ExpiresAt: s.now().UTC().Add(ttl),
+	})
+}
+
+type ConfirmHoldInput struct {
+	UserID   string
+	HoldID   uuid.UUID
+	Title    string
+	Notes    string
+	Metadata map[string]string
+}
+
+// ConfirmHold books the held slot as an appointment. The appointment id is
+// derived from the hold, so repeating a confirm returns the same appointment.
+func (s *Service) ConfirmHold(ctx context.Context, in ConfirmHoldInput) (domain.Appointment, error) {
+	if in.UserID == "" {
+		return domain.Appointment{}, validationError("user_id is required")
+	}
+	if in.HoldID == uuid.Nil {
+		return domain.Appointment{}, validationError("hold_id is required")
+	}
+	title := strings.TrimSpace(in.Title)
+	if title == "" {
+		return domain.Appointment{}, validationError("title is required")
+	}
+	if err := s.checkText(ctx, title, in.Notes); err != nil {
+		return domain.Appointment{}, err
+	}
+	metadata, err := s.checkMetadata(ctx, in.Metadata)
+	if err != nil {
+		return domain.Appointment{}, err
+	}
+	if err := s.authorizeOwner(ctx, in.UserID); err != nil {
+		return domain.Appointment{}, err
+	}
+
+	appt := domain.Appointment{
+		ID:       uuid.NewSHA1(uuid.NameSpaceOID, []byte("schedula:confirm_hold:"+in.UserID+":"+in.HoldID.String())),
+		UserID:   in.UserID,
+		Title:    title,
+		Notes:    in.Notes,
+		Metadata: metadata,
+		Source:   domain.SourceBooking,
+	}
+	if err := s.applySourceDefault(ctx, &appt); err != nil {
+		return domain.Appointment{}, err
+	}
+	confirmed, err := s.repo.ConfirmHold(ctx, in.HoldID, appt)
+	if err != nil {
+		return domain.Appointment{}, err
+	}
+	s.events.Publish(ctx, events.AppointmentCreated{UserID: confirmed.UserID, AppointmentID: confirmed.ID})
+	confirmed.SourceDefault = appt.SourceDefault
+	confirmed.Warnings = append(confirmed.Warnings, s.advise(ctx, advisoryTarget{
+		userID:        confirmed.UserID,
+		spans:         []domain.BusyInterval{{Start: confirmed.StartTime.UTC(), End: confirmed.EndTime.UTC()}},
+		appointmentID: confirmed.ID,
+	})...)
+	return confirmed, nil
+}
+
+func (s *Service) ReleaseHold(ctx context.Context, userID string, holdID uuid.UUID) error {
+	if userID == "" {
+		return validationError("user_id is required")
+	}
+	if holdID == uuid.Nil {
+		return validationError("hold_id is required")
+	}
+	if err := s.authorizeOwner(ctx, userID); err != nil {
+		return err
+	}
+	return s.repo.ReleaseHold(ctx, userID, holdID)
+}
+
+// DeleteExpiredHolds removes holds that expired before now and reports how
+// many were deleted. Each expiry lands in the owner's change log, so a
+// client waiting on ListChanges learns the slot is free again.
+func (s *Service) DeleteExpiredHolds(ctx context.Context) (int, error) {
+	return s.repo.DeleteExpiredHolds(ctx, s.now().UTC())
+}
+
+func (s *Service) LinkAppointments(ctx context.Context, userID string, appointmentID, relatedID uuid.UUID, kind domain.AppointmentLinkKind) (domain.AppointmentLink, error) {
+	if err := validateLink(userID, appointmentID, relatedID, kind); err != nil {
+		return domain.AppointmentLink{}, err
+	}
+	if err := s.authorizeOwner(ctx, userID); err != nil {
+		return domain.AppointmentLink{}, err
+	}
+	return s.repo.LinkAppointments(ctx, domain.AppointmentLink{
+		UserID:        userID,
+		AppointmentID: appointmentID,
+		RelatedID:     relatedID,
+		Kind:          kind,
+	})
+}
+
+func (s *Service) UnlinkAppointments(ctx context.Context, userID string, appointmentID, relatedID uuid.UUID, kind domain.AppointmentLinkKind) error {
+	if err := validateLink(userID, appointmentID, relatedID, kind); err != nil {
+		return err
+	}
+	if err := s.authorizeOwner(ctx, userID); err != nil {
+		return err
+	}
+	return s.repo.UnlinkAppointments(ctx, userID, appointmentID, relatedID, kind)
+}
+
+func (s *Service) ListRelated(ctx context.Context, userID string, appointmentID uuid.UUID) ([]domain.RelatedAppointment, error) {
+	if userID == "" {
+		return nil, validationError("user_id is required")
+	}
+	if appointmentID == uuid.Nil {
+		return nil, validationError("appointment_id is required")
+	}
+	return s.repo.ListRelated(ctx, userID, appointmentID)
+}
+
+func validateLink(userID string, appointmentID, relatedID uuid.UUID, kind domain.AppointmentLinkKind) error {
+	if userID == "" {
+		return validationError("user_id is required")
+	}
+	if appointmentID == uuid.Nil || relatedID == uuid.Nil {
+		return validationError("appointment_id and related_appointment_id are required")
+	}
+	if appointmentID == relatedID {
+		return validationError("an appointment cannot be linked to itself")
+	}
+	if kind != domain.AppointmentLinkKindFollowUpOf && kind != domain.AppointmentLinkKindPrepFor {
+		return validationError("invalid link kind")
+	}
+	return nil
+}
@@ -1,23 +1,106 @@
 package appointments
 
 import (
+	"bytes"
 	"context"
 	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
 	"testing"
 	"time"
 
 	"github.com/google/uuid"
 
 	"schedula/backend/internal/domain"
+	"schedula/backend/internal/events"
+	"schedula/backend/internal/identity"
+	"schedula/backend/internal/limits"
 	"schedula/backend/internal/store"
+	"schedula/backend/internal/timepolicy"
 )
 
 type fakeRepo struct {
 	createFn              func(ctx context.Context, appt domain.Appointment) (domain.Appointment, error)
-	listFn                func(ctx context.Context, userID string, windowStart, windowEnd time.Time) ([]domain.Appointment, error)
+	listFn                func(ctx context.Context, userID string, windowStart, windowEnd time.Time, filter store.AppointmentFilter) ([]domain.Appointment, error)
 	deleteFn              func(ctx context.Context, userID string, appointmentID uuid.UUID) error
+	getByExternalRef      func(ctx context.Context, userID, system, externalID string) (domain.Appointment, error)
 	createRecurringSeries func(ctx context.Context, series domain.RecurringSeries) (domain.RecurringSeries, error)
+	createSkipping        func(ctx context.Context, series domain.RecurringSeries, maxSkips int) (domain.RecurringSeries, error)
 	listOccurrences       func(ctx context.Context, userID string, windowStart, windowEnd time.Time) ([]domain.RecurringOccurrence, error)
+	getRecurringSeries    func(ctx context.Context, userID string, seriesID uuid.UUID) (domain.RecurringSeries, error)
+	listActiveSeries      func(ctx context.Context, userID string, at time.Time) ([]domain.RecurringSeries, error)
+	listSeriesOnWeekdays  func(ctx context.Context, userID string, weekdays domain.WeekdayMask) ([]domain.RecurringSeries, error)
+	listSeriesExceptions  func(ctx context.Context, seriesID uuid.UUID) ([]domain.RecurringException, error)
+	skipOccurrences       func(ctx context.Context, userID string, seriesID uuid.UUID, occurrenceStarts []time.Time) (int, error)
+	updateSeriesEnd       func(ctx context.Context, series domain.RecurringSeries) (int, error)
+	moveSeriesTimeZone    func(ctx context.Context, userID string, seriesIDs []uuid.UUID, tz string, keep domain.TimeZoneKeep) ([]domain.RecurringSeries, int, error)
+	deleteExceptions      func(ctx context.Context, seriesID uuid.UUID, exceptionIDs []uuid.UUID) (int, error)
+	listSeriesOccurrences func(ctx context.Context, series domain.RecurringSeries, windowStart, windowEnd time.Time) ([]domain.RecurringOccurrence, error)
+	markAttendance        func(ctx context.Context, attendance domain.OccurrenceAttendance) (domain.OccurrenceAttendance, error)
+	listAttendance        func(ctx context.Context, seriesID uuid.UUID) ([]domain.OccurrenceAttendance, error)
+	userAnalytics         func(ctx context.Context, userID string, windowStart, windowEnd time.Time) (domain.UserAnalytics, error)
+	createContact         func(ctx context.Context, contact domain.Contact) (domain.Contact, error)
+	getContact            func(ctx context.Context, userID string, contactID uuid.UUID) (domain.Contact, error)
+	updateContact         func(ctx context.Context, contact domain.Contact) (domain.Contact, error)
+	deleteContact         func(ctx context.Context, userID string, contactID uuid.UUID) error
+	listContacts          func(ctx context.Context, userID string) ([]domain.Contact, error)
+	createProgram         func(ctx context.Context, program domain.Program) (domain.Program, error)
+	getProgram            func(ctx context.Context, userID string, programID uuid.UUID) (domain.Program, error)
+	listPrograms          func(ctx context.Context, userID string) ([]domain.Program, error)
+	listProgramMembers    func(ctx context.Context, userID string, programID uuid.UUID) (store.ProgramMembers, error)
+	cancelProgram         func(ctx context.Context, userID string, programID uuid.UUID, at time.Time) (store.ProgramCancellation, error)
+	getProvisionedUser    func(ctx context.Context, userID string) (domain.ProvisionedUser, error)
+	upsertProvisionedUser func(ctx context.Context, user domain.ProvisionedUser) (domain.ProvisionedUser, error)
+	setUserGroupMembers   func(ctx context.Context, groupID uuid.UUID, userIDs []string) (domain.UserGroup, error)
+	checkIn               func(ctx context.Context, userID string, appointmentID uuid.UUID, at time.Time) (domain.Appointment, error)
+	checkOut              func(ctx context.Context, userID string, appointmentID uuid.UUID, at time.Time) (domain.Appointment, error)
+	reserveSlot           func(ctx context.Context, hold domain.SlotHold) (domain.SlotHold, error)
+	deleteExpiredHolds    func(ctx context.Context, before time.Time) (int, error)
+	confirmHold           func(ctx context.Context, holdID uuid.UUID, appt domain.Appointment) (domain.Appointment, error)
+	releaseHold           func(ctx context.Context, userID string, holdID uuid.UUID) error
+	listActiveHolds       func(ctx context.Context, userID string, windowStart, windowEnd time.Time) ([]domain.SlotHold, error)
+	createProposal        func(ctx context.Context, proposal domain.AppointmentProposal) (domain.AppointmentProposal, error)
+	getProposal           func(ctx context.Context, proposalID uuid.UUID) (domain.AppointmentProposal, error)
+	listProposals         func(ctx context.Context, userID string) ([]domain.AppointmentProposal, error)
+	acceptProposal        func(ctx context.Context, proposalID uuid.UUID, proposerAppt, recipientAppt domain.Appointment, at time.Time) (domain.AppointmentProposal, error)
+	declineProposal       func(ctx context.Context, proposalID uuid.UUID, at time.Time) (domain.AppointmentProposal, error)
+	expireProposals       func(ctx context.Context, before time.Time) (int, error)
+	linkAppointments      func(ctx context.Context, link domain.AppointmentLink) (domain.AppointmentLink, error)
+	unlinkAppointments    func(ctx context.Context, userID string, appointmentID, relatedID uuid.UUID, kind domain.AppointmentLinkKind) error
+	listRelated           func(ctx context.Context, userID string, appointmentID uuid.UUID) ([]domain.RelatedAppointment, error)
+	mergeAppointments     func(ctx context.Context, userID string, primaryID uuid.UUID, duplicateIDs []uuid.UUID, cover bool, at time.Time) (store.AppointmentMerge, error)
+	listCompactable       func(ctx context.Context, endedBefore time.Time, afterID uuid.UUID, limit int) ([]domain.RecurringSeries, error)
+	compactSeries         func(ctx context.Context, userID string, seriesID uuid.UUID, cutoff, at time.Time) (domain.SeriesCompaction, error)
+	grantDelegation       func(ctx context.Context, grant domain.DelegationGrant) (domain.DelegationGrant, error)
+	revokeDelegation      func(ctx context.Context, principalID, delegateID string) error
+	listDelegations       func(ctx context.Context, principalID string) ([]domain.DelegationGrant, error)
+	hasDelegation         func(ctx context.Context, principalID, delegateID string) (bool, error)
+	createBlackout        func(ctx context.Context, blackout domain.Blackout) (domain.Blackout, error)
+	deleteBlackout        func(ctx context.Context, blackoutID uuid.UUID) error
+	listBlackouts         func(ctx context.Context, windowStart, windowEnd time.Time) ([]domain.Blackout, error)
+	listChanges           func(ctx context.Context, userID string, afterSeq int64, limit int) ([]domain.CalendarChange, error)
+	latestChangeSeq       func(ctx context.Context, userID string) (int64, error)
+	calendarVersion       func(ctx context.Context, userID string) (int64, error)
+	getDaySummaryBuild    func(ctx context.Context, userID string) (domain.DaySummaryBuild, error)
+	listDaySummaries      func(ctx context.Context, userID string, from, to time.Time) ([]domain.DaySummary, error)
+	replaceDaySummaries   func(ctx context.Context, build domain.DaySummaryBuild, days []domain.DaySummary) (bool, error)
+	staleDaySummaryUsers  func(ctx context.Context, builtBefore time.Time, limit int) ([]string, error)
+	getUserSettings       func(ctx context.Context, userID string) (domain.UserSettings, error)
+	updateUserSettings    func(ctx context.Context, settings domain.UserSettings) (domain.UserSettings, error)
+	expiredAppointments   func(ctx context.Context, now time.Time, defaultDays int) ([]store.RetentionPurge, error)
+	purgeAppointments     func(ctx context.Context, userID string, cutoff time.Time, limit int) ([]uuid.UUID, error)
+	createTimeOff         func(ctx context.Context, timeOff domain.TimeOff) (domain.TimeOff, error)
+	getTimeOff            func(ctx context.Context, userID string, timeOffID uuid.UUID) (domain.TimeOff, error)
+	updateTimeOff         func(ctx context.Context, timeOff domain.TimeOff) (domain.TimeOff, error)
+	deleteTimeOff         func(ctx context.Context, userID string, timeOffID uuid.UUID) error
+	listTimeOff           func(ctx context.Context, userID string) ([]domain.TimeOff, error)
+	reconcileCalendar     func(ctx context.Context, userID string, mutations []store.CalendarMutation) ([]store.MutationOutcome, error)
+	exportCalendar        func(ctx context.Context, userID string) (store.CalendarSnapshot, error)
+	importCalendar        func(ctx context.Context, userID string, snapshot store.CalendarSnapshot) error
+	restoreCalendar       func(ctx context.Context, userID string, snapshot store.CalendarSnapshot) (store.CalendarRestore, error)
+	snapshotInfos         []domain.CalendarSnapshotInfo
 }
 
 func (f *fakeRepo) Create(ctx context.Context, appt domain.Appointment) (domain.Appointment, error) {
@@ -27,11 +110,11 @@ func (f *fakeRepo) Create(ctx context.Context, appt domain.Appointment) (domain.
 	return f.createFn(ctx, appt)
 }
 
-func (f *fakeRepo) List(ctx context.Context, userID string, windowStart, windowEnd time.Time) ([]domain.Appointment, error) {
+func (f *fakeRepo) List(ctx context.Context, userID string, windowStart, windowEnd time.Time, filter store.AppointmentFilter) ([]domain.Appointment, error) {
 	if f.listFn == nil {
-		panic("List not configured")
+		return nil, nil
 	}
-	return f.listFn(ctx, userID, windowStart, windowEnd)
+	return f.listFn(ctx, userID, windowStart, windowEnd, filter)
 }
 
 func (f *fakeRepo) Delete(ctx context.Context, userID string, appointmentID uuid.UUID) error {
@@ -41,248 +124,4154 @@ func (f *fakeRepo) Delete(ctx context.Context, userID string, appointmentID uuid
 	return f.deleteFn(ctx, userID, appointmentID)
 }
 
+func (f *fakeRepo) GetByExternalRef(ctx context.Context, userID, system, externalID string) (domain.Appointment, error) {
+	if f.getByExternalRef == nil {
+		panic("GetByExternalRef not configured")
+	}
+	return f.getByExternalRef(ctx, userID, system, externalID)
+}
+
 func (f *fakeRepo) CreateRecurringSeries(ctx context.Context, series domain.RecurringSeries) (domain.RecurringSeries, error) {
 	if f.createRecurringSeries == nil {
 		panic("CreateRecurringSeries not configured")
 	}
-	return f.createRecurringSeries(ctx, series)
+	return f.createRecurringSeries(ctx, series)
+}
+
+func (f *fakeRepo) CreateRecurringSeriesSkippingConflicts(ctx context.Context, series domain.RecurringSeries, maxSkips int) (domain.RecurringSeries, error) {
+	if f.createSkipping == nil {
+		panic("CreateRecurringSeriesSkippingConflicts not configured")
+	}
+	return f.createSkipping(ctx, series, maxSkips)
+}
+
+func (f *fakeRepo) ListSeriesExceptions(ctx context.Context, seriesID uuid.UUID) ([]domain.RecurringException, error) {
+	if f.listSeriesExceptions == nil {
+		panic("ListSeriesExceptions not configured")
+	}
+	return f.listSeriesExceptions(ctx, seriesID)
+}
+
+func (f *fakeRepo) DeleteRecurringExceptions(ctx context.Context, seriesID uuid.UUID, exceptionIDs []uuid.UUID) (int, error) {
+	if f.deleteExceptions == nil {
+		panic("DeleteRecurringExceptions not configured")
+	}
+	return f.deleteExceptions(ctx, seriesID, exceptionIDs)
+}
+
+func (f *fakeRepo) UpdateRecurringSeriesEnd(ctx context.Context, series domain.RecurringSeries) (int, error) {
+	if f.updateSeriesEnd == nil {
+		panic("UpdateRecurringSeriesEnd not configured")
+	}
+	return f.updateSeriesEnd(ctx, series)
+}
+
+func (f *fakeRepo) MoveRecurringSeriesTimeZone(ctx context.Context, userID string, seriesIDs []uuid.UUID, tz string, keep domain.TimeZoneKeep) ([]domain.RecurringSeries, int, error) {
+	if f.moveSeriesTimeZone == nil {
+		panic("MoveRecurringSeriesTimeZone not configured")
+	}
+	return f.moveSeriesTimeZone(ctx, userID, seriesIDs, tz, keep)
+}
+
+func (f *fakeRepo) SkipRecurringOccurrences(ctx context.Context, userID string, seriesID uuid.UUID, occurrenceStarts []time.Time) (int, error) {
+	if f.skipOccurrences == nil {
+		panic("SkipRecurringOccurrences not configured")
+	}
+	return f.skipOccurrences(ctx, userID, seriesID, occurrenceStarts)
+}
+
+func (f *fakeRepo) ListOccurrences(ctx context.Context, userID string, windowStart, windowEnd time.Time) ([]domain.RecurringOccurrence, error) {
+	if f.listOccurrences == nil {
+		return nil, nil
+	}
+	return f.listOccurrences(ctx, userID, windowStart, windowEnd)
+}
+
+func (f *fakeRepo) GetRecurringSeries(ctx context.Context, userID string, seriesID uuid.UUID) (domain.RecurringSeries, error) {
+	if f.getRecurringSeries == nil {
+		panic("GetRecurringSeries not configured")
+	}
+	return f.getRecurringSeries(ctx, userID, seriesID)
+}
+
+// ListActiveRecurringSeries defaults to none, since every series create
+// counts the user's active series.
+func (f *fakeRepo) ListActiveRecurringSeries(ctx context.Context, userID string, at time.Time) ([]domain.RecurringSeries, error) {
+	if f.listActiveSeries == nil {
+		return nil, nil
+	}
+	return f.listActiveSeries(ctx, userID, at)
+}
+
+func (f *fakeRepo) ListSeriesOnWeekdays(ctx context.Context, userID string, weekdays domain.WeekdayMask) ([]domain.RecurringSeries, error) {
+	if f.listSeriesOnWeekdays == nil {
+		panic("ListSeriesOnWeekdays not configured")
+	}
+	return f.listSeriesOnWeekdays(ctx, userID, weekdays)
+}
+
+func (f *fakeRepo) ListSeriesOccurrences(ctx context.Context, series domain.RecurringSeries, windowStart, windowEnd time.Time) ([]domain.RecurringOccurrence, error) {
+	if f.listSeriesOccurrences == nil {
+		panic("ListSeriesOccurrences not configured")
+	}
+	return f.listSeriesOccurrences(ctx, series, windowStart, windowEnd)
+}
+
+func (f *fakeRepo) MarkAttendance(ctx context.Context, attendance domain.OccurrenceAttendance) (domain.OccurrenceAttendance, error) {
+	if f.markAttendance == nil {
+		panic("MarkAttendance not configured")
+	}
+	return f.markAttendance(ctx, attendance)
+}
+
+func (f *fakeRepo) ListAttendance(ctx context.Context, seriesID uuid.UUID) ([]domain.OccurrenceAttendance, error) {
+	if f.listAttendance == nil {
+		panic("ListAttendance not configured")
+	}
+	return f.listAttendance(ctx, seriesID)
+}
+
+func (f *fakeRepo) CreateContact(ctx context.Context, contact domain.Contact) (domain.Contact, error) {
+	if f.createContact == nil {
+		panic("CreateContact not configured")
+	}
+	return f.createContact(ctx, contact)
+}
+
+func (f *fakeRepo) GetContact(ctx context.Context, userID string, contactID uuid.UUID) (domain.Contact, error) {
+	if f.getContact == nil {
+		panic("GetContact not configured")
+	}
+	return f.getContact(ctx, userID, contactID)
+}
+
+func (f *fakeRepo) UpdateContact(ctx context.Context, contact domain.Contact) (domain.Contact, error) {
+	if f.updateContact == nil {
+		panic("UpdateContact not configured")
+	}
+	return f.updateContact(ctx, contact)
+}
+
+func (f *fakeRepo) CreateProgram(ctx context.Context, program domain.Program) (domain.Program, error) {
+	if f.createProgram == nil {
+		panic("CreateProgram not configured")
+	}
+	return f.createProgram(ctx, program)
+}
+
+func (f *fakeRepo) GetProgram(ctx context.Context, userID string, programID uuid.UUID) (domain.Program, error) {
+	if f.getProgram == nil {
+		panic("GetProgram not configured")
+	}
+	return f.getProgram(ctx, userID, programID)
+}
+
+func (f *fakeRepo) ListPrograms(ctx context.Context, userID string) ([]domain.Program, error) {
+	if f.listPrograms == nil {
+		panic("ListPrograms not configured")
+	}
+	return f.listPrograms(ctx, userID)
+}
+
+func (f *fakeRepo) ListProgramMembers(ctx context.Context, userID string, programID uuid.UUID) (store.ProgramMembers, error) {
+	if f.listProgramMembers == nil {
+		panic("ListProgramMembers not configured")
+	}
+	return f.listProgramMembers(ctx, userID, programID)
+}
+
+func (f *fakeRepo) CancelProgram(ctx context.Context, userID string, programID uuid.UUID, at time.Time) (store.ProgramCancellation, error) {
+	if f.cancelProgram == nil {
+		panic("CancelProgram not configured")
+	}
+	return f.cancelProgram(ctx, userID, programID, at)
+}
+
+func (f *fakeRepo) DeleteContact(ctx context.Context, userID string, contactID uuid.UUID) error {
+	if f.deleteContact == nil {
+		panic("DeleteContact not configured")
+	}
+	return f.deleteContact(ctx, userID, contactID)
+}
+
+func (f *fakeRepo) ListContacts(ctx context.Context, userID string) ([]domain.Contact, error) {
+	if f.listContacts == nil {
+		panic("ListContacts not configured")
+	}
+	return f.listContacts(ctx, userID)
+}
+
+func (f *fakeRepo) CheckInAppointment(ctx context.Context, userID string, appointmentID uuid.UUID, at time.Time) (domain.Appointment, error) {
+	if f.checkIn == nil {
+		panic("CheckInAppointment not configured")
+	}
+	return f.checkIn(ctx, userID, appointmentID, at)
+}
+
+func (f *fakeRepo) CheckOutAppointment(ctx context.Context, userID string, appointmentID uuid.UUID, at time.Time) (domain.Appointment, error) {
+	if f.checkOut == nil {
+		panic("CheckOutAppointment not configured")
+	}
+	return f.checkOut(ctx, userID, appointmentID, at)
+}
+
+func (f *fakeRepo) UserAnalytics(ctx context.Context, userID string, windowStart, windowEnd time.Time) (domain.UserAnalytics, error) {
+	if f.userAnalytics == nil {
+		panic("UserAnalytics not configured")
+	}
+	return f.userAnalytics(ctx, userID, windowStart, windowEnd)
+}
+
+func (f *fakeRepo) ReserveSlot(ctx context.Context, hold domain.SlotHold) (domain.SlotHold, error) {
+	if f.reserveSlot == nil {
+		panic("ReserveSlot not configured")
+	}
+	return f.reserveSlot(ctx, hold)
+}
+
+func (f *fakeRepo) DeleteExpiredHolds(ctx context.Context, before time.Time) (int, error) {
+	if f.deleteExpiredHolds == nil {
+		panic("DeleteExpiredHolds not configured")
+	}
+	return f.deleteExpiredHolds(ctx, before)
+}
+
+func (f *fakeRepo) ConfirmHold(ctx context.Context, holdID uuid.UUID, appt domain.Appointment) (domain.Appointment, error) {
+	if f.confirmHold == nil {
+		panic("ConfirmHold not configured")
+	}
+	return f.confirmHold(ctx, holdID, appt)
+}
+
+func (f *fakeRepo) ReleaseHold(ctx context.Context, userID string, holdID uuid.UUID) error {
+	if f.releaseHold == nil {
+		panic("ReleaseHold not configured")
+	}
+	return f.releaseHold(ctx, userID, holdID)
+}
+
+func (f *fakeRepo) ListActiveHolds(ctx context.Context, userID string, windowStart, windowEnd time.Time) ([]domain.SlotHold, error) {
+	if f.listActiveHolds == nil {
+		return nil, nil
+	}
+	return f.listActiveHolds(ctx, userID, windowStart, windowEnd)
+}
+
+func (f *fakeRepo) CreateProposal(ctx context.Context, proposal domain.AppointmentProposal) (domain.AppointmentProposal, error) {
+	if f.createProposal == nil {
+		panic("CreateProposal not configured")
+	}
+	return f.createProposal(ctx, proposal)
+}
+
+func (f *fakeRepo) GetProposal(ctx context.Context, proposalID uuid.UUID) (domain.AppointmentProposal, error) {
+	if f.getProposal == nil {
+		panic("GetProposal not configured")
+	}
+	return f.getProposal(ctx, proposalID)
+}
+
+func (f *fakeRepo) ListProposals(ctx context.Context, userID string) ([]domain.AppointmentProposal, error) {
+	if f.listProposals == nil {
+		panic("ListProposals not configured")
+	}
+	return f.listProposals(ctx, userID)
+}
+
+func (f *fakeRepo) AcceptProposal(ctx context.Context, proposalID uuid.UUID, proposerAppt, recipientAppt domain.Appointment, at time.Time) (domain.AppointmentProposal, error) {
+	if f.acceptProposal == nil {
+		panic("AcceptProposal not configured")
+	}
+	return f.acceptProposal(ctx, proposalID, proposerAppt, recipientAppt, at)
+}
+
+func (f *fakeRepo) DeclineProposal(ctx context.Context, proposalID uuid.UUID, at time.Time) (domain.AppointmentProposal, error) {
+	if f.declineProposal == nil {
+		panic("DeclineProposal not configured")
+	}
+	return f.declineProposal(ctx, proposalID, at)
+}
+
+func (f *fakeRepo) ExpireProposals(ctx context.Context, before time.Time) (int, error) {
+	if f.expireProposals == nil {
+		panic("ExpireProposals not configured")
+	}
+	return f.expireProposals(ctx, before)
+}
+
+func (f *fakeRepo) LinkAppointments(ctx context.Context, link domain.AppointmentLink) (domain.AppointmentLink, error) {
+	if f.linkAppointments == nil {
+		panic("LinkAppointments not configured")
+	}
+	return f.linkAppointments(ctx, link)
+}
+
+func (f *fakeRepo) UnlinkAppointments(ctx context.Context, userID string, appointmentID, relatedID uuid.UUID, kind domain.AppointmentLinkKind) error {
+	if f.unlinkAppointments == nil {
+		panic("UnlinkAppointments not configured")
+	}
+	return f.unlinkAppointments(ctx, userID, appointmentID, relatedID, kind)
+}
+
+func (f *fakeRepo) ListRelated(ctx context.Context, userID string, appointmentID uuid.UUID) ([]domain.RelatedAppointment, error) {
+	if f.listRelated == nil {
+		panic("ListRelated not configured")
+	}
+	return f.listRelated(ctx, userID, appointmentID)
+}
+
+func (f *fakeRepo) MergeAppointments(ctx context.Context, userID string, primaryID uuid.UUID, duplicateIDs []uuid.UUID, cover bool, at time.Time) (store.AppointmentMerge, error) {
+	if f.mergeAppointments == nil {
+		panic("MergeAppointments not configured")
+	}
+	return f.mergeAppointments(ctx, userID, primaryID, duplicateIDs, cover, at)
+}
+
+func (f *fakeRepo) ListCompactableSeries(ctx context.Context, endedBefore time.Time, afterID uuid.UUID, limit int) ([]domain.RecurringSeries, error) {
+	if f.listCompactable == nil {
+		panic("ListCompactableSeries not configured")
+	}
+	return f.listCompactable(ctx, endedBefore, afterID, limit)
+}
+
+func (f *fakeRepo) CompactRecurringSeries(ctx context.Context, userID string, seriesID uuid.UUID, cutoff, at time.Time) (domain.SeriesCompaction, error) {
+	if f.compactSeries == nil {
+		panic("CompactRecurringSeries not configured")
+	}
+	return f.compactSeries(ctx, userID, seriesID, cutoff, at)
+}
+
+func (f *fakeRepo) GrantDelegation(ctx context.Context, grant domain.DelegationGrant) (domain.DelegationGrant, error) {
+	if f.grantDelegation == nil {
+		panic("GrantDelegation not configured")
+	}
+	return f.grantDelegation(ctx, grant)
+}
+
+func (f *fakeRepo) RevokeDelegation(ctx context.Context, principalID, delegateID string) error {
+	if f.revokeDelegation == nil {
+		panic("RevokeDelegation not configured")
+	}
+	return f.revokeDelegation(ctx, principalID, delegateID)
+}
+
+func (f *fakeRepo) ListDelegations(ctx context.Context, principalID string) ([]domain.DelegationGrant, error) {
+	if f.listDelegations == nil {
+		panic("ListDelegations not configured")
+	}
+	return f.listDelegations(ctx, principalID)
+}
+
+func (f *fakeRepo) HasDelegation(ctx context.Context, principalID, delegateID string) (bool, error) {
+	if f.hasDelegation == nil {
+		panic("HasDelegation not configured")
+	}
+	return f.hasDelegation(ctx, principalID, delegateID)
+}
+
+func (f *fakeRepo) CreateBlackout(ctx context.Context, blackout domain.Blackout) (domain.Blackout, error) {
+	if f.createBlackout == nil {
+		panic("CreateBlackout not configured")
+	}
+	return f.createBlackout(ctx, blackout)
+}
+
+func (f *fakeRepo) DeleteBlackout(ctx context.Context, blackoutID uuid.UUID) error {
+	if f.deleteBlackout == nil {
+		panic("DeleteBlackout not configured")
+	}
+	return f.deleteBlackout(ctx, blackoutID)
+}
+
+// ListBlackouts reports no blackouts unless configured, since every booking
+// path consults it.
+func (f *fakeRepo) ListBlackouts(ctx context.Context, windowStart, windowEnd time.Time) ([]domain.Blackout, error) {
+	if f.listBlackouts == nil {
+		return nil, nil
+	}
+	return f.listBlackouts(ctx, windowStart, windowEnd)
+}
+
+func (f *fakeRepo) ListChanges(ctx context.Context, userID string, afterSeq int64, limit int) ([]domain.CalendarChange, error) {
+	if f.listChanges == nil {
+		panic("ListChanges not configured")
+	}
+	return f.listChanges(ctx, userID, afterSeq, limit)
+}
+
+func (f *fakeRepo) LatestChangeSeq(ctx context.Context, userID string) (int64, error) {
+	if f.latestChangeSeq == nil {
+		panic("LatestChangeSeq not configured")
+	}
+	return f.latestChangeSeq(ctx, userID)
+}
+
+func (f *fakeRepo) CalendarVersion(ctx context.Context, userID string) (int64, error) {
+	if f.calendarVersion == nil {
+		panic("CalendarVersion not configured")
+	}
+	return f.calendarVersion(ctx, userID)
+}
+
+func (f *fakeRepo) GetDaySummaryBuild(ctx context.Context, userID string) (domain.DaySummaryBuild, error) {
+	if f.getDaySummaryBuild == nil {
+		panic("GetDaySummaryBuild not configured")
+	}
+	return f.getDaySummaryBuild(ctx, userID)
+}
+
+func (f *fakeRepo) ListDaySummaries(ctx context.Context, userID string, from, to time.Time) ([]domain.DaySummary, error) {
+	if f.listDaySummaries == nil {
+		panic("ListDaySummaries not configured")
+	}
+	return f.listDaySummaries(ctx, userID, from, to)
+}
+
+func (f *fakeRepo) ReplaceDaySummaries(ctx context.Context, build domain.DaySummaryBuild, days []domain.DaySummary) (bool, error) {
+	if f.replaceDaySummaries == nil {
+		panic("ReplaceDaySummaries not configured")
+	}
+	return f.replaceDaySummaries(ctx, build, days)
+}
+
+func (f *fakeRepo) ListStaleDaySummaryUsers(ctx context.Context, builtBefore time.Time, limit int) ([]string, error) {
+	if f.staleDaySummaryUsers == nil {
+		panic("ListStaleDaySummaryUsers not configured")
+	}
+	return f.staleDaySummaryUsers(ctx, builtBefore, limit)
+}
+
+func (f *fakeRepo) ClearDaySummaries(ctx context.Context) error {
+	panic("ClearDaySummaries not configured")
+}
+
+// GetUserSettings defaults to no settings, since every create and hold reads
+// them and most tests have no rules to apply.
+func (f *fakeRepo) GetUserSettings(ctx context.Context, userID string) (domain.UserSettings, error) {
+	if f.getUserSettings == nil {
+		return domain.UserSettings{UserID: userID}, nil
+	}
+	return f.getUserSettings(ctx, userID)
+}
+
+func (f *fakeRepo) UpdateUserSettings(ctx context.Context, settings domain.UserSettings) (domain.UserSettings, error) {
+	if f.updateUserSettings == nil {
+		panic("UpdateUserSettings not configured")
+	}
+	return f.updateUserSettings(ctx, settings)
+}
+
+func (f *fakeRepo) ExpiredAppointments(ctx context.Context, now time.Time, defaultDays int) ([]store.RetentionPurge, error) {
+	if f.expiredAppointments == nil {
+		panic("ExpiredAppointments not configured")
+	}
+	return f.expiredAppointments(ctx, now, defaultDays)
+}
+
+func (f *fakeRepo) PurgeAppointments(ctx context.Context, userID string, cutoff time.Time, limit int) ([]uuid.UUID, error) {
+	if f.purgeAppointments == nil {
+		panic("PurgeAppointments not configured")
+	}
+	return f.purgeAppointments(ctx, userID, cutoff, limit)
+}
+
+func (f *fakeRepo) CreateTimeOff(ctx context.Context, timeOff domain.TimeOff) (domain.TimeOff, error) {
+	if f.createTimeOff == nil {
+		panic("CreateTimeOff not configured")
+	}
+	return f.createTimeOff(ctx, timeOff)
+}
+
+func (f *fakeRepo) GetTimeOff(ctx context.Context, userID string, timeOffID uuid.UUID) (domain.TimeOff, error) {
+	if f.getTimeOff == nil {
+		panic("GetTimeOff not configured")
+	}
+	return f.getTimeOff(ctx, userID, timeOffID)
+}
+
+func (f *fakeRepo) UpdateTimeOff(ctx context.Context, timeOff domain.TimeOff) (domain.TimeOff, error) {
+	if f.updateTimeOff == nil {
+		panic("UpdateTimeOff not configured")
+	}
+	return f.updateTimeOff(ctx, timeOff)
+}
+
+func (f *fakeRepo) DeleteTimeOff(ctx context.Context, userID string, timeOffID uuid.UUID) error {
+	if f.deleteTimeOff == nil {
+		panic("DeleteTimeOff not configured")
+	}
+	return f.deleteTimeOff(ctx, userID, timeOffID)
+}
+
+// ListTimeOff defaults to none, since holds and free/busy reads consult it.
+func (f *fakeRepo) ListTimeOff(ctx context.Context, userID string) ([]domain.TimeOff, error) {
+	if f.listTimeOff == nil {
+		return nil, nil
+	}
+	return f.listTimeOff(ctx, userID)
+}
+
+// GetProvisionedUser defaults to not provisioned, since every booking path
+// checks whether its users are deactivated.
+func (f *fakeRepo) GetProvisionedUser(ctx context.Context, userID string) (domain.ProvisionedUser, error) {
+	if f.getProvisionedUser == nil {
+		return domain.ProvisionedUser{}, store.ErrNotFound
+	}
+	return f.getProvisionedUser(ctx, userID)
+}
+
+func (f *fakeRepo) UpsertProvisionedUser(ctx context.Context, user domain.ProvisionedUser) (domain.ProvisionedUser, error) {
+	if f.upsertProvisionedUser == nil {
+		panic("UpsertProvisionedUser not configured")
+	}
+	return f.upsertProvisionedUser(ctx, user)
+}
+
+func (f *fakeRepo) ListProvisionedUsers(ctx context.Context) ([]domain.ProvisionedUser, error) {
+	panic("ListProvisionedUsers not configured")
+}
+
+func (f *fakeRepo) CreateUserGroup(ctx context.Context, group domain.UserGroup) (domain.UserGroup, error) {
+	panic("CreateUserGroup not configured")
+}
+
+func (f *fakeRepo) SetUserGroupMembers(ctx context.Context, groupID uuid.UUID, userIDs []string) (domain.UserGroup, error) {
+	if f.setUserGroupMembers == nil {
+		panic("SetUserGroupMembers not configured")
+	}
+	return f.setUserGroupMembers(ctx, groupID, userIDs)
+}
+
+func (f *fakeRepo) ListUserGroups(ctx context.Context) ([]domain.UserGroup, error) {
+	panic("ListUserGroups not configured")
+}
+
+func (f *fakeRepo) UpsertSyncMapping(ctx context.Context, mapping domain.SyncMapping) (domain.SyncMapping, error) {
+	panic("UpsertSyncMapping not configured")
+}
+
+func (f *fakeRepo) GetSyncMapping(ctx context.Context, userID, provider, externalID string) (domain.SyncMapping, error) {
+	panic("GetSyncMapping not configured")
+}
+
+func (f *fakeRepo) GetSyncMappingByAppointment(ctx context.Context, userID, provider string, appointmentID uuid.UUID) (domain.SyncMapping, error) {
+	panic("GetSyncMappingByAppointment not configured")
+}
+
+func (f *fakeRepo) ListSyncMappings(ctx context.Context, userID, provider string, filter store.SyncMappingFilter) ([]domain.SyncMapping, error) {
+	panic("ListSyncMappings not configured")
+}
+
+func (f *fakeRepo) DeleteSyncMapping(ctx context.Context, userID, provider, externalID string) error {
+	panic("DeleteSyncMapping not configured")
+}
+
+func (f *fakeRepo) ReconcileCalendar(ctx context.Context, userID string, mutations []store.CalendarMutation) ([]store.MutationOutcome, error) {
+	if f.reconcileCalendar == nil {
+		panic("ReconcileCalendar not configured")
+	}
+	return f.reconcileCalendar(ctx, userID, mutations)
+}
+
+func (f *fakeRepo) ExportCalendar(ctx context.Context, userID string) (store.CalendarSnapshot, error) {
+	if f.exportCalendar == nil {
+		panic("ExportCalendar not configured")
+	}
+	return f.exportCalendar(ctx, userID)
+}
+
+func (f *fakeRepo) ImportCalendar(ctx context.Context, userID string, snapshot store.CalendarSnapshot) error {
+	if f.importCalendar == nil {
+		panic("ImportCalendar not configured")
+	}
+	return f.importCalendar(ctx, userID, snapshot)
+}
+
+func (f *fakeRepo) RestoreCalendar(ctx context.Context, userID string, snapshot store.CalendarSnapshot) (store.CalendarRestore, error) {
+	if f.restoreCalendar == nil {
+		panic("RestoreCalendar not configured")
+	}
+	return f.restoreCalendar(ctx, userID, snapshot)
+}
+
+// The snapshot records are kept in memory, newest first, like the store
+// lists them.
+func (f *fakeRepo) SaveCalendarSnapshotInfo(ctx context.Context, info domain.CalendarSnapshotInfo) error {
+	f.snapshotInfos = append([]domain.CalendarSnapshotInfo{info}, f.snapshotInfos...)
+	return nil
+}
+
+func (f *fakeRepo) ListCalendarSnapshotInfo(ctx context.Context, userID string) ([]domain.CalendarSnapshotInfo, error) {
+	var out []domain.CalendarSnapshotInfo
+	for _, info := range f.snapshotInfos {
+		if info.UserID == userID {
+			out = append(out, info)
+		}
+	}
+	return out, nil
+}
+
+func (f *fakeRepo) GetCalendarSnapshotInfo(ctx context.Context, userID string, snapshotID uuid.UUID) (domain.CalendarSnapshotInfo, error) {
+	for _, info := range f.snapshotInfos {
+		if info.UserID == userID && info.ID == snapshotID {
+			return info, nil
+		}
+	}
+	return domain.CalendarSnapshotInfo{}, store.ErrNotFound
+}
+
+func (f *fakeRepo) DeleteCalendarSnapshotInfo(ctx context.Context, userID string, snapshotID uuid.UUID) error {
+	for i, info := range f.snapshotInfos {
+		if info.UserID == userID && info.ID == snapshotID {
+			f.snapshotInfos = append(f.snapshotInfos[:i], f.snapshotInfos[i+1:]...)
+			return nil
+		}
+	}
+	return store.ErrNotFound
+}
+
+type fakeBlobs map[string][]byte
+
+func (b fakeBlobs) PutBlob(ctx context.Context, key string, data []byte) error {
+	b[key] = data
+	return nil
+}
+
+func (b fakeBlobs) GetBlob(ctx context.Context, key string) ([]byte, error) {
+	data, ok := b[key]
+	if !ok {
+		return nil, store.ErrNotFound
+	}
+	return data, nil
+}
+
+func (b fakeBlobs) DeleteBlob(ctx context.Context, key string) error {
+	delete(b, key)
+	return nil
+}
+
+func TestServiceCreate_ValidationErrorType(t *testing.T) {
+	svc := NewService(&fakeRepo{
+		createFn: func(ctx context.Context, appt domain.Appointment) (domain.Appointment, error) {
+			return appt, nil
+		},
+	})
+
+	_, err := svc.Create(context.Background(), CreateInput{
+		UserID:    "",
+		Title:     "x",
+		StartTime: time.Date(2026, 1, 1, 10, 0, 0, 0, time.UTC),
+		EndTime:   time.Date(2026, 1, 1, 11, 0, 0, 0, time.UTC),
+	})
+	if err == nil {
+		t.Fatalf("expected error")
+	}
+	var vErr *ValidationError
+	if !errors.As(err, &vErr) {
+		t.Fatalf("error type = %T, want *ValidationError", err)
+	}
+	if vErr.Error() != "user_id is required" {
+		t.Fatalf("error = %q, want %q", vErr.Error(), "user_id is required")
+	}
+
+	_, err = svc.Create(context.Background(), CreateInput{
+		UserID:    "u1",
+		Title:     "x",
+		StartTime: time.Date(2026, 1, 1, 10, 0, 0, 0, time.UTC),
+		EndTime:   time.Date(2026, 1, 1, 11, 0, 0, 0, time.UTC),
+		TimeZone:  "Mars/Olympus",
+	})
+	if !errors.As(err, &vErr) || vErr.Error() != "invalid time_zone" {
+		t.Fatalf("error = %v, want invalid time_zone", err)
+	}
+}
+
+func TestServiceCreate_TrimsTitleAndNormalizesTimesToUTC(t *testing.T) {
+	loc, err := time.LoadLocation("America/Los_Angeles")
+	if err != nil {
+		t.Fatalf("LoadLocation error: %v", err)
+	}
+
+	var got domain.Appointment
+	svc := NewService(&fakeRepo{
+		createFn: func(ctx context.Context, appt domain.Appointment) (domain.Appointment, error) {
+			got = appt
+			return appt, nil
+		},
+	})
+
+	startLocal := time.Date(2026, 1, 10, 9, 0, 0, 0, loc)
+	endLocal := time.Date(2026, 1, 10, 10, 0, 0, 0, loc)
+
+	_, err = svc.Create(context.Background(), CreateInput{
+		UserID:    "u1",
+		Title:     "  hello  ",
+		StartTime: startLocal,
+		EndTime:   endLocal,
+		TimeZone:  " America/Los_Angeles ",
+	})
+	if err != nil {
+		t.Fatalf("Create error: %v", err)
+	}
+	if got.Timezone != "America/Los_Angeles" {
+		t.Fatalf("timezone = %q, want America/Los_Angeles", got.Timezone)
+	}
+	if got.Title != "hello" {
+		t.Fatalf("title = %q, want %q", got.Title, "hello")
+	}
+	if got.StartTime.Location() != time.UTC || got.EndTime.Location() != time.UTC {
+		t.Fatalf("expected UTC times, got start=%v end=%v", got.StartTime, got.EndTime)
+	}
+	if !got.EndTime.After(got.StartTime) {
+		t.Fatalf("end_time must be after start_time")
+	}
+}
+
+func TestServiceCreate_IdempotencyKeyDeterministicUUID(t *testing.T) {
+	var ids []uuid.UUID
+	svc := NewService(&fakeRepo{
+		createFn: func(ctx context.Context, appt domain.Appointment) (domain.Appointment, error) {
+			ids = append(ids, appt.ID)
+			return appt, nil
+		},
+	})
+
+	start := time.Date(2026, 1, 1, 10, 0, 0, 0, time.UTC)
+	end := start.Add(time.Hour)
+
+	_, err := svc.Create(context.Background(), CreateInput{
+		UserID:         "u1",
+		Title:          "t",
+		StartTime:      start,
+		EndTime:        end,
+		IdempotencyKey: "k1",
+	})
+	if err != nil {
+		t.Fatalf("Create error: %v", err)
+	}
+	_, err = svc.Create(context.Background(), CreateInput{
+		UserID:         "u1",
+		Title:          "t",
+		StartTime:      start,
+		EndTime:        end,
+		IdempotencyKey: "k1",
+	})
+	if err != nil {
+		t.Fatalf("Create error: %v", err)
+	}
+	if len(ids) != 2 {
+		t.Fatalf("captured ids = %d, want 2", len(ids))
+	}
+	if ids[0] == uuid.Nil || ids[1] == uuid.Nil {
+		t.Fatalf("expected non-nil ids")
+	}
+	if ids[0] != ids[1] {
+		t.Fatalf("ids differ: %s vs %s", ids[0], ids[1])
+	}
+}
+
+func TestServiceCreate_IdempotencyKeyDifferentKeyDifferentUUID(t *testing.T) {
+	var ids []uuid.UUID
+	svc := NewService(&fakeRepo{
+		createFn: func(ctx context.Context, appt domain.Appointment) (domain.Appointment, error) {
+			ids = append(ids, appt.ID)
+			return appt, nil
+		},
+	})
+
+	start := time.Date(2026, 1, 1, 10, 0, 0, 0, time.UTC)
+	end := start.Add(time.Hour)
+
+	_, err := svc.Create(context.Background(), CreateInput{
+		UserID:         "u1",
+		Title:          "t",
+		StartTime:      start,
+		EndTime:        end,
+		IdempotencyKey: "k1",
+	})
+	if err != nil {
+		t.Fatalf("Create error: %v", err)
+	}
+	_, err = svc.Create(context.Background(), CreateInput{
+		UserID:         "u1",
+		Title:          "t",
+		StartTime:      start,
+		EndTime:        end,
+		IdempotencyKey: "k2",
+	})
+	if err != nil {
+		t.Fatalf("Create error: %v", err)
+	}
+	if len(ids) != 2 {
+		t.Fatalf("captured ids = %d, want 2", len(ids))
+	}
+	if ids[0] == ids[1] {
+		t.Fatalf("expected different ids, got %s", ids[0])
+	}
+}
+
+func TestServiceCreate_PropagatesStoreErrors(t *testing.T) {
+	svc := NewService(&fakeRepo{
+		createFn: func(ctx context.Context, appt domain.Appointment) (domain.Appointment, error) {
+			return domain.Appointment{}, store.ErrConflict
+		},
+	})
+
+	_, err := svc.Create(context.Background(), CreateInput{
+		UserID:    "u1",
+		Title:     "t",
+		StartTime: time.Date(2026, 1, 1, 10, 0, 0, 0, time.UTC),
+		EndTime:   time.Date(2026, 1, 1, 11, 0, 0, 0, time.UTC),
+	})
+	if !errors.Is(err, store.ErrConflict) {
+		t.Fatalf("error = %v, want %v", err, store.ErrConflict)
+	}
+}
+
+func TestServiceCreateRecurringSeries_DefaultWeekdayAndIntervalNormalization(t *testing.T) {
+	count := 1
+	var got domain.RecurringSeries
+
+	svc := NewService(&fakeRepo{
+		createRecurringSeries: func(ctx context.Context, series domain.RecurringSeries) (domain.RecurringSeries, error) {
+			got = series
+			return series, nil
+		},
+	})
+
+	start := time.Date(2026, 1, 5, 9, 0, 0, 0, time.UTC)
+	end := start.Add(time.Hour)
+
+	_, err := svc.CreateRecurringSeries(context.Background(), CreateRecurringSeriesInput{
+		UserID:    "u1",
+		Title:     "t",
+		StartTime: start,
+		EndTime:   end,
+		Rule: RecurrenceRuleInput{
+			Interval: 0,
+			Count:    &count,
+			TimeZone: "UTC",
+		},
+	})
+	if err != nil {
+		t.Fatalf("CreateRecurringSeries error: %v", err)
+	}
+
+	if got.Interval != 1 {
+		t.Fatalf("interval = %d, want 1", got.Interval)
+	}
+	if len(got.ByWeekday) != 1 || got.ByWeekday[0] != 1 {
+		t.Fatalf("byweekday = %v, want [1]", got.ByWeekday)
+	}
+	if got.WeekStart != 1 {
+		t.Fatalf("week start = %d, want 1", got.WeekStart)
+	}
+	if got.DSTGap != domain.DSTGapShiftForward || got.DSTAmbiguous != domain.DSTAmbiguousEarlier {
+		t.Fatalf("dst policies = %q/%q, want shift_forward/earlier", got.DSTGap, got.DSTAmbiguous)
+	}
+}
+
+func TestServiceCreateRecurringSeries_RejectsUnknownDSTPolicy(t *testing.T) {
+	count := 1
+	svc := NewService(&fakeRepo{})
+
+	start := time.Date(2026, 1, 5, 9, 0, 0, 0, time.UTC)
+	_, err := svc.CreateRecurringSeries(context.Background(), CreateRecurringSeriesInput{
+		UserID:    "u1",
+		Title:     "t",
+		StartTime: start,
+		EndTime:   start.Add(time.Hour),
+		Rule: RecurrenceRuleInput{
+			Count:    &count,
+			TimeZone: "UTC",
+			DSTGap:   "round_down",
+		},
+	})
+	var vErr *ValidationError
+	if !errors.As(err, &vErr) || vErr.Error() != "invalid dst_gap_policy" {
+		t.Fatalf("error = %v, want invalid dst_gap_policy", err)
+	}
+}
+
+func TestServiceCreateRecurringSeries_RequiresUntilOrCount(t *testing.T) {
+	svc := NewService(&fakeRepo{
+		createRecurringSeries: func(ctx context.Context, series domain.RecurringSeries) (domain.RecurringSeries, error) {
+			return series, nil
+		},
+	})
+
+	start := time.Date(2026, 1, 5, 9, 0, 0, 0, time.UTC)
+	end := start.Add(time.Hour)
+
+	_, err := svc.CreateRecurringSeries(context.Background(), CreateRecurringSeriesInput{
+		UserID:    "u1",
+		Title:     "t",
+		StartTime: start,
+		EndTime:   end,
+		Rule: RecurrenceRuleInput{
+			TimeZone: "UTC",
+		},
+	})
+	if err == nil {
+		t.Fatalf("expected error")
+	}
+	var vErr *ValidationError
+	if !errors.As(err, &vErr) {
+		t.Fatalf("error type = %T, want *ValidationError", err)
+	}
+	if vErr.Error() != "until or count is required" {
+		t.Fatalf("error = %q, want %q", vErr.Error(), "until or count is required")
+	}
+}
+
+func TestServiceGetRecurringSeries_ReportsRemainingOccurrences(t *testing.T) {
+	count := 4
+	series := domain.RecurringSeries{
+		ID:              uuid.MustParse("00000000-0000-0000-0000-000000000401"),
+		UserID:          "u1",
+		Title:           "t",
+		Timezone:        "UTC",
+		DTStart:         time.Date(2026, 1, 5, 9, 0, 0, 0, time.UTC),
+		DurationSeconds: 3600,
+		Frequency:       domain.RecurrenceFrequencyWeekly,
+		Interval:        1,
+		ByWeekday:       []int16{1},
+		Count:           &count,
+	}
+	now := time.Date(2026, 1, 13, 0, 0, 0, 0, time.UTC)
+
+	svc := NewService(&fakeRepo{
+		getRecurringSeries: func(ctx context.Context, userID string, seriesID uuid.UUID) (domain.RecurringSeries, error) {
+			return series, nil
+		},
+		listSeriesOccurrences: func(ctx context.Context, s domain.RecurringSeries, windowStart, windowEnd time.Time) ([]domain.RecurringOccurrence, error) {
+			if !windowStart.Equal(now) {
+				t.Fatalf("windowStart = %v, want %v", windowStart, now)
+			}
+			occs, err := domain.GenerateWeeklyOccurrences(s, windowStart, windowEnd)
+			if err != nil {
+				return nil, err
+			}
+			// Skip the 2026-01-19 occurrence.
+			return occs[1:], nil
+		},
+	})
+	svc.now = func() time.Time { return now }
+
+	got, err := svc.GetRecurringSeries(context.Background(), "u1", series.ID)
+	if err != nil {
+		t.Fatalf("GetRecurringSeries error: %v", err)
+	}
+	if got.OccurrencesRemaining != 1 {
+		t.Fatalf("occurrences remaining = %d, want 1", got.OccurrencesRemaining)
+	}
+	wantNext := time.Date(2026, 1, 26, 9, 0, 0, 0, time.UTC)
+	if got.NextOccurrence == nil || !got.NextOccurrence.Equal(wantNext) {
+		t.Fatalf("next occurrence = %v, want %v", got.NextOccurrence, wantNext)
+	}
+}
+
+func TestServiceGetRecurringSeries_FinishedSeriesHasNoNextOccurrence(t *testing.T) {
+	until := time.Date(2026, 1, 12, 9, 0, 0, 0, time.UTC)
+	series := domain.RecurringSeries{
+		ID:              uuid.MustParse("00000000-0000-0000-0000-000000000402"),
+		UserID:          "u1",
+		Title:           "t",
+		Timezone:        "UTC",
+		DTStart:         time.Date(2026, 1, 5, 9, 0, 0, 0, time.UTC),
+		DurationSeconds: 3600,
+		Frequency:       domain.RecurrenceFrequencyWeekly,
+		Interval:        1,
+		ByWeekday:       []int16{1},
+		Until:           &until,
+	}
+
+	svc := NewService(&fakeRepo{
+		getRecurringSeries: func(ctx context.Context, userID string, seriesID uuid.UUID) (domain.RecurringSeries, error) {
+			return series, nil
+		},
+	})
+	svc.now = func() time.Time { return time.Date(2026, 2, 1, 0, 0, 0, 0, time.UTC) }
+
+	got, err := svc.GetRecurringSeries(context.Background(), "u1", series.ID)
+	if err != nil {
+		t.Fatalf("GetRecurringSeries error: %v", err)
+	}
+	if got.OccurrencesRemaining != 0 || got.NextOccurrence != nil {
+		t.Fatalf("progress = (%d, %v), want (0, nil)", got.OccurrencesRemaining, got.NextOccurrence)
+	}
+}
+
+func TestServiceListRecurringSeries_MasksWeekdays(t *testing.T) {
+	var gotMask domain.WeekdayMask
+	svc := NewService(&fakeRepo{
+		listSeriesOnWeekdays: func(ctx context.Context, userID string, weekdays domain.WeekdayMask) ([]domain.RecurringSeries, error) {
+			gotMask = weekdays
+			return nil, nil
+		},
+	})
+
+	if _, err := svc.ListRecurringSeries(context.Background(), "u1", []int16{2, 5}); err != nil {
+		t.Fatalf("ListRecurringSeries error: %v", err)
+	}
+	if gotMask != domain.WeekdayMaskOf([]int16{2, 5}) {
+		t.Fatalf("mask = %07b, want Tuesday and Friday", gotMask)
+	}
+
+	if _, err := svc.ListRecurringSeries(context.Background(), "u1", nil); err != nil {
+		t.Fatalf("ListRecurringSeries error: %v", err)
+	}
+	if gotMask != domain.AllWeekdays {
+		t.Fatalf("mask = %07b, want every weekday", gotMask)
+	}
+
+	var vErr *ValidationError
+	if _, err := svc.ListRecurringSeries(context.Background(), "u1", []int16{8}); !errors.As(err, &vErr) {
+		t.Fatalf("err = %v, want ValidationError", err)
+	}
+}
+
+func TestServiceMarkAttendance_ValidatesOccurrence(t *testing.T) {
+	count := 4
+	series := domain.RecurringSeries{
+		ID:              uuid.MustParse("00000000-0000-0000-0000-000000000501"),
+		UserID:          "u1",
+		Title:           "t",
+		Timezone:        "UTC",
+		DTStart:         time.Date(2026, 1, 5, 9, 0, 0, 0, time.UTC),
+		DurationSeconds: 3600,
+		Frequency:       domain.RecurrenceFrequencyWeekly,
+		Interval:        1,
+		ByWeekday:       []int16{1},
+		Count:           &count,
+	}
+
+	var marked domain.OccurrenceAttendance
+	svc := NewService(&fakeRepo{
+		getRecurringSeries: func(ctx context.Context, userID string, seriesID uuid.UUID) (domain.RecurringSeries, error) {
+			return series, nil
+		},
+		markAttendance: func(ctx context.Context, attendance domain.OccurrenceAttendance) (domain.OccurrenceAttendance, error) {
+			marked = attendance
+			return attendance, nil
+		},
+	})
+	svc.now = func() time.Time { return time.Date(2026, 1, 20, 0, 0, 0, 0, time.UTC) }
+
+	occurrenceID := func(t time.Time) string {
+		return domain.EncodeOccurrenceID(series.ID, t)
+	}
+
+	tests := []struct {
+		name         string
+		occurrenceID string
+		wantErr      string
+	}{
+		{name: "malformed", occurrenceID: "abc", wantErr: "invalid occurrence_id"},
+		{name: "other series", occurrenceID: domain.EncodeOccurrenceID(uuid.New(), time.Date(2026, 1, 12, 9, 0, 0, 0, time.UTC)), wantErr: "occurrence_id belongs to a different series"},
+		{name: "not an occurrence", occurrenceID: occurrenceID(time.Date(2026, 1, 6, 9, 0, 0, 0, time.UTC)), wantErr: "occurrence_id does not match an occurrence of the series"},
+		{name: "future occurrence", occurrenceID: occurrenceID(time.Date(2026, 1, 26, 9, 0, 0, 0, time.UTC)), wantErr: "attendance can only be marked once the occurrence has started"},
+		{name: "past occurrence", occurrenceID: occurrenceID(time.Date(2026, 1, 12, 9, 0, 0, 0, time.UTC))},
+		{name: "legacy id", occurrenceID: fmt.Sprint(time.Date(2026, 1, 12, 9, 0, 0, 0, time.UTC).UnixNano())},
+		{name: "legacy id not an occurrence", occurrenceID: fmt.Sprint(time.Date(2026, 1, 13, 9, 0, 0, 0, time.UTC).UnixNano()), wantErr: "occurrence_id does not match an occurrence of the series"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := svc.MarkAttendance(context.Background(), MarkAttendanceInput{
+				UserID:        "u1",
+				SeriesID:      series.ID,
+				OccurrenceID:  tt.occurrenceID,
+				ParticipantID: " p1 ",
+				Status:        domain.AttendanceStatusAttended,
+			})
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Fatalf("MarkAttendance error: %v", err)
+				}
+				if marked.ParticipantID != "p1" {
+					t.Fatalf("participant_id = %q, want %q", marked.ParticipantID, "p1")
+				}
+				return
+			}
+			var vErr *ValidationError
+			if !errors.As(err, &vErr) {
+				t.Fatalf("error type = %T, want *ValidationError", err)
+			}
+			if vErr.Error() != tt.wantErr {
+				t.Fatalf("error = %q, want %q", vErr.Error(), tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestServiceCreateRecurringSeries_EnforcesSeriesLimits(t *testing.T) {
+	now := time.Date(2026, 1, 5, 0, 0, 0, 0, time.UTC)
+	ten, one := 10, 1
+	existing := []domain.RecurringSeries{
+		// Ten Mondays from today.
+		{UserID: "u1", Timezone: "UTC", DTStart: now.Add(9 * time.Hour), DurationSeconds: 3600, Frequency: domain.RecurrenceFrequencyWeekly, Interval: 1, ByWeekday: []int16{1}, Count: &ten},
+		// Over before today, so not active.
+		{UserID: "u1", Timezone: "UTC", DTStart: now.AddDate(0, 0, -14).Add(9 * time.Hour), DurationSeconds: 3600, Frequency: domain.RecurrenceFrequencyWeekly, Interval: 1, ByWeekday: []int16{1}, Count: &one},
+	}
+	newService := func(lim limits.Limits) *Service {
+		svc := NewServiceWithLimits(&fakeRepo{
+			listActiveSeries: func(ctx context.Context, userID string, at time.Time) ([]domain.RecurringSeries, error) {
+				return existing, nil
+			},
+			createRecurringSeries: func(ctx context.Context, series domain.RecurringSeries) (domain.RecurringSeries, error) {
+				return series, nil
+			},
+		}, lim)
+		svc.now = func() time.Time { return now }
+		return svc
+	}
+	create := func(svc *Service, start time.Time, count int) error {
+		_, err := svc.CreateRecurringSeries(context.Background(), CreateRecurringSeriesInput{
+			UserID:    "u1",
+			Title:     "Tuesdays",
+			StartTime: start,
+			EndTime:   start.Add(time.Hour),
+			Rule:      RecurrenceRuleInput{ByWeekday: []int16{2}, Count: &count, TimeZone: "UTC"},
+		})
+		return err
+	}
+	tuesday := now.AddDate(0, 0, 1).Add(9 * time.Hour)
+
+	svc := newService(limits.Limits{MaxActiveSeries: 2, MaxFutureOccurrences: 15})
+	if err := create(svc, tuesday, 5); err != nil {
+		t.Fatalf("create within limits error: %v", err)
+	}
+	if err := create(svc, tuesday, 6); !errors.Is(err, ErrSeriesLimit) {
+		t.Fatalf("create over the occurrence limit error = %v, want ErrSeriesLimit", err)
+	}
+
+	svc = newService(limits.Limits{MaxActiveSeries: 1})
+	if err := create(svc, tuesday, 1); !errors.Is(err, ErrSeriesLimit) {
+		t.Fatalf("create over the series limit error = %v, want ErrSeriesLimit", err)
+	}
+	// A backdated series adds nothing ahead of today.
+	if err := create(svc, tuesday.AddDate(0, 0, -28), 3); err != nil {
+		t.Fatalf("backdated create error: %v", err)
+	}
+}
+
+func TestServiceCreate_EnforcesTextLimits(t *testing.T) {
+	svc := NewServiceWithLimits(&fakeRepo{
+		createFn: func(ctx context.Context, appt domain.Appointment) (domain.Appointment, error) {
+			return appt, nil
+		},
+	}, limits.Limits{MaxTitleLength: 5, MaxNotesLength: 3})
+
+	start := time.Date(2026, 1, 1, 10, 0, 0, 0, time.UTC)
+	tests := []struct {
+		title   string
+		notes   string
+		wantErr string
+	}{
+		{title: "héllo", notes: "abc"},
+		{title: "héllo!", wantErr: "title too long"},
+		{title: "t", notes: "abcd", wantErr: "notes too long"},
+	}
+
+	for _, tt := range tests {
+		_, err := svc.Create(context.Background(), CreateInput{
+			UserID:    "u1",
+			Title:     tt.title,
+			Notes:     tt.notes,
+			StartTime: start,
+			EndTime:   start.Add(time.Hour),
+		})
+		if tt.wantErr == "" {
+			if err != nil {
+				t.Fatalf("Create(%q, %q) error: %v", tt.title, tt.notes, err)
+			}
+			continue
+		}
+		var vErr *ValidationError
+		if !errors.As(err, &vErr) || vErr.Error() != tt.wantErr {
+			t.Fatalf("Create(%q, %q) error = %v, want %q", tt.title, tt.notes, err, tt.wantErr)
+		}
+	}
+}
+
+func TestServiceCreate_ValidatesMetadata(t *testing.T) {
+	var got domain.Appointment
+	svc := NewServiceWithLimits(&fakeRepo{
+		createFn: func(ctx context.Context, appt domain.Appointment) (domain.Appointment, error) {
+			got = appt
+			return appt, nil
+		},
+	}, limits.Limits{MaxMetadataEntries: 2, MaxMetadataKeyLen: 8, MaxMetadataValueLen: 4})
+
+	start := time.Date(2026, 1, 1, 10, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name     string
+		metadata map[string]string
+		wantErr  string
+	}{
+		{name: "valid", metadata: map[string]string{"crm_id": "42"}},
+		{name: "too many entries", metadata: map[string]string{"a": "1", "b": "2", "c": "3"}, wantErr: "too many metadata entries"},
+		{name: "empty key", metadata: map[string]string{" ": "1"}, wantErr: "metadata keys must not be empty"},
+		{name: "key too long", metadata: map[string]string{"external_id": "1"}, wantErr: "metadata key too long"},
+		{name: "value too long", metadata: map[string]string{"crm_id": "12345"}, wantErr: "metadata value too long"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := svc.Create(context.Background(), CreateInput{
+				UserID:    "u1",
+				Title:     "t",
+				StartTime: start,
+				EndTime:   start.Add(time.Hour),
+				Metadata:  tt.metadata,
+			})
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Fatalf("Create error: %v", err)
+				}
+				if got.Metadata["crm_id"] != "42" {
+					t.Fatalf("metadata = %v, want crm_id=42", got.Metadata)
+				}
+				return
+			}
+			var vErr *ValidationError
+			if !errors.As(err, &vErr) || vErr.Error() != tt.wantErr {
+				t.Fatalf("error = %v, want %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestServiceGetByExternalRef_RequiresSystemAndID(t *testing.T) {
+	svc := NewService(&fakeRepo{
+		getByExternalRef: func(ctx context.Context, userID, system, externalID string) (domain.Appointment, error) {
+			return domain.Appointment{UserID: userID, ExternalSystem: system, ExternalID: externalID}, nil
+		},
+	})
+
+	_, err := svc.GetByExternalRef(context.Background(), "u1", ExternalRef{System: "google"})
+	var vErr *ValidationError
+	if !errors.As(err, &vErr) {
+		t.Fatalf("error type = %T, want *ValidationError", err)
+	}
+
+	got, err := svc.GetByExternalRef(context.Background(), "u1", ExternalRef{System: " google ", ID: " evt-1 "})
+	if err != nil {
+		t.Fatalf("GetByExternalRef error: %v", err)
+	}
+	if got.ExternalSystem != "google" || got.ExternalID != "evt-1" {
+		t.Fatalf("external ref = %q/%q, want google/evt-1", got.ExternalSystem, got.ExternalID)
+	}
+}
+
+func TestServiceSuggestEndTime_StopsAtNextBooking(t *testing.T) {
+	start := time.Date(2026, 3, 2, 9, 0, 0, 0, time.UTC)
+	repo := &fakeRepo{
+		listFn: func(ctx context.Context, userID string, windowStart, windowEnd time.Time, filter store.AppointmentFilter) ([]domain.Appointment, error) {
+			return []domain.Appointment{{StartTime: start.Add(45 * time.Minute), EndTime: start.Add(2 * time.Hour)}}, nil
+		},
+		listOccurrences: func(ctx context.Context, userID string, windowStart, windowEnd time.Time) ([]domain.RecurringOccurrence, error) {
+			return []domain.RecurringOccurrence{{StartTime: start.Add(50 * time.Minute), EndTime: start.Add(time.Hour)}}, nil
+		},
+	}
+	svc := NewService(repo)
+
+	got, err := svc.SuggestEndTime(context.Background(), "u1", start, time.Hour, nil)
+	if err != nil {
+		t.Fatalf("SuggestEndTime error: %v", err)
+	}
+	if !got.EndTime.Equal(start.Add(45*time.Minute)) || !got.Shortened {
+		t.Fatalf("suggestion = %+v, want end at 09:45 shortened", got)
+	}
+
+	repo.listFn = func(ctx context.Context, userID string, windowStart, windowEnd time.Time, filter store.AppointmentFilter) ([]domain.Appointment, error) {
+		return []domain.Appointment{{StartTime: start.Add(-time.Hour), EndTime: start.Add(time.Minute)}}, nil
+	}
+	if _, err := svc.SuggestEndTime(context.Background(), "u1", start, time.Hour, nil); !errors.Is(err, store.ErrConflict) {
+		t.Fatalf("error = %v, want %v", err, store.ErrConflict)
+	}
+}
+
+func TestServiceSuggestEndTime_StopsAtTimeOff(t *testing.T) {
+	// Away from 10:00 every Monday in UTC.
+	start := time.Date(2030, 1, 7, 9, 0, 0, 0, time.UTC)
+	away := domain.TimeOff{
+		ID:        uuid.New(),
+		UserID:    "u1",
+		Title:     "School run",
+		StartTime: time.Date(2030, 1, 7, 10, 0, 0, 0, time.UTC),
+		EndTime:   time.Date(2030, 1, 7, 11, 0, 0, 0, time.UTC),
+		Timezone:  "UTC",
+		Interval:  1,
+		ByWeekday: []int16{1},
+	}
+	svc := NewService(&fakeRepo{
+		listTimeOff: func(ctx context.Context, userID string) ([]domain.TimeOff, error) {
+			return []domain.TimeOff{away}, nil
+		},
+		listFn: func(ctx context.Context, userID string, windowStart, windowEnd time.Time, filter store.AppointmentFilter) ([]domain.Appointment, error) {
+			return nil, nil
+		},
+		listOccurrences: func(ctx context.Context, userID string, windowStart, windowEnd time.Time) ([]domain.RecurringOccurrence, error) {
+			return nil, nil
+		},
+	})
+	ctx := context.Background()
+
+	got, err := svc.SuggestEndTime(ctx, "u1", start, 2*time.Hour, nil)
+	if err != nil {
+		t.Fatalf("SuggestEndTime error: %v", err)
+	}
+	if !got.EndTime.Equal(start.Add(time.Hour)) || !got.Shortened {
+		t.Fatalf("suggestion = %+v, want end at 10:00 shortened", got)
+	}
+	if _, err := svc.SuggestEndTime(ctx, "u1", start.Add(90*time.Minute), time.Hour, nil); !errors.Is(err, store.ErrConflict) {
+		t.Fatalf("start inside time off error = %v, want %v", err, store.ErrConflict)
+	}
+}
+
+func TestServiceSuggestEndTime_StopsAtDailyBreak(t *testing.T) {
+	svc := NewService(&fakeRepo{
+		getUserSettings: func(ctx context.Context, userID string) (domain.UserSettings, error) {
+			return domain.UserSettings{
+				UserID:       "u1",
+				SlotTimeZone: "Europe/Berlin",
+				DailyBreaks:  []domain.DailyBreak{{Label: "Lunch", StartMinute: 720, EndMinute: 780}},
+			}, nil
+		},
+		listFn: func(ctx context.Context, userID string, windowStart, windowEnd time.Time, filter store.AppointmentFilter) ([]domain.Appointment, error) {
+			return nil, nil
+		},
+		listOccurrences: func(ctx context.Context, userID string, windowStart, windowEnd time.Time) ([]domain.RecurringOccurrence, error) {
+			return nil, nil
+		},
+	})
+	ctx := context.Background()
+
+	// Lunch at 12:00 Berlin is 11:00 UTC in January.
+	start := time.Date(2030, 1, 7, 10, 0, 0, 0, time.UTC)
+	got, err := svc.SuggestEndTime(ctx, "u1", start, 2*time.Hour, nil)
+	if err != nil {
+		t.Fatalf("SuggestEndTime error: %v", err)
+	}
+	if !got.EndTime.Equal(start.Add(time.Hour)) || !got.Shortened {
+		t.Fatalf("suggestion = %+v, want end at 11:00 UTC shortened", got)
+	}
+	if _, err := svc.SuggestEndTime(ctx, "u1", start.Add(90*time.Minute), time.Hour, nil); !errors.Is(err, store.ErrConflict) {
+		t.Fatalf("start inside the break error = %v, want %v", err, store.ErrConflict)
+	}
+}
+
+func TestServiceSuggestEndTime_StopsAtActiveHold(t *testing.T) {
+	start := time.Date(2030, 1, 7, 9, 0, 0, 0, time.UTC)
+	svc := NewService(&fakeRepo{
+		listActiveHolds: func(ctx context.Context, userID string, windowStart, windowEnd time.Time) ([]domain.SlotHold, error) {
+			return []domain.SlotHold{{UserID: "u1", StartTime: start.Add(20 * time.Minute), EndTime: start.Add(50 * time.Minute), ExpiresAt: start}}, nil
+		},
+		listFn: func(ctx context.Context, userID string, windowStart, windowEnd time.Time, filter store.AppointmentFilter) ([]domain.Appointment, error) {
+			return nil, nil
+		},
+		listOccurrences: func(ctx context.Context, userID string, windowStart, windowEnd time.Time) ([]domain.RecurringOccurrence, error) {
+			return nil, nil
+		},
+	})
+
+	got, err := svc.SuggestEndTime(context.Background(), "u1", start, time.Hour, nil)
+	if err != nil {
+		t.Fatalf("SuggestEndTime error: %v", err)
+	}
+	if !got.EndTime.Equal(start.Add(20*time.Minute)) || !got.Shortened {
+		t.Fatalf("suggestion = %+v, want end at 09:20 shortened", got)
+	}
+	if _, err := svc.SuggestEndTime(context.Background(), "u1", start.Add(30*time.Minute), time.Hour, nil); !errors.Is(err, store.ErrConflict) {
+		t.Fatalf("start inside the hold error = %v, want %v", err, store.ErrConflict)
+	}
+}
+
+func TestServiceSuggestEndTime_ClampsToWorkingHours(t *testing.T) {
+	svc := NewService(&fakeRepo{
+		listFn: func(ctx context.Context, userID string, windowStart, windowEnd time.Time, filter store.AppointmentFilter) ([]domain.Appointment, error) {
+			return nil, nil
+		},
+		listOccurrences: func(ctx context.Context, userID string, windowStart, windowEnd time.Time) ([]domain.RecurringOccurrence, error) {
+			return nil, nil
+		},
+	})
+	ctx := context.Background()
+	// 09:00 to 17:30 in Berlin, which is 08:00 to 16:30 UTC in January.
+	hours := &WorkingHoursInput{TimeZone: "Europe/Berlin", StartMinute: 9 * 60, EndMinute: 17*60 + 30}
+
+	start := time.Date(2030, 1, 7, 15, 0, 0, 0, time.UTC)
+	got, err := svc.SuggestEndTime(ctx, "u1", start, 2*time.Hour, hours)
+	if err != nil {
+		t.Fatalf("SuggestEndTime error: %v", err)
+	}
+	if !got.EndTime.Equal(time.Date(2030, 1, 7, 16, 30, 0, 0, time.UTC)) || !got.Shortened {
+		t.Fatalf("suggestion = %+v, want end at 16:30 UTC shortened", got)
+	}
+
+	got, err = svc.SuggestEndTime(ctx, "u1", start, time.Hour, hours)
+	if err != nil || !got.EndTime.Equal(start.Add(time.Hour)) || got.Shortened {
+		t.Fatalf("suggestion inside hours = %+v, err = %v, want the full hour", got, err)
+	}
+	if _, err := svc.SuggestEndTime(ctx, "u1", start.Add(2*time.Hour), time.Hour, hours); !errors.Is(err, ErrOutsideWorkingHours) {
+		t.Fatalf("start after hours error = %v, want %v", err, ErrOutsideWorkingHours)
+	}
+}
+
+func TestServiceSkipOccurrences_MatchesLocalWeekday(t *testing.T) {
+	la, _ := time.LoadLocation("America/Los_Angeles")
+	count := 6
+	// Mondays and Fridays at 17:00 in Los Angeles, which is 01:00 UTC the
+	// next day.
+	series := domain.RecurringSeries{
+		ID:              uuid.MustParse("00000000-0000-0000-0000-000000000601"),
+		UserID:          "u1",
+		Title:           "Session",
+		Timezone:        "America/Los_Angeles",
+		DTStart:         time.Date(2026, 1, 5, 17, 0, 0, 0, la),
+		DurationSeconds: 3600,
+		Frequency:       domain.RecurrenceFrequencyWeekly,
+		Interval:        1,
+		ByWeekday:       []int16{1, 5},
+		Count:           &count,
+	}
+	var written []time.Time
+	repo := &fakeRepo{
+		getRecurringSeries: func(ctx context.Context, userID string, seriesID uuid.UUID) (domain.RecurringSeries, error) {
+			return series, nil
+		},
+		listSeriesExceptions: func(ctx context.Context, seriesID uuid.UUID) ([]domain.RecurringException, error) {
+			return []domain.RecurringException{{SeriesID: series.ID, OccurrenceStart: time.Date(2026, 1, 16, 17, 0, 0, 0, la).UTC(), Kind: domain.RecurringExceptionKindSkip}}, nil
+		},
+		skipOccurrences: func(ctx context.Context, userID string, seriesID uuid.UUID, occurrenceStarts []time.Time) (int, error) {
+			written = occurrenceStarts
+			return len(occurrenceStarts), nil
+		},
+	}
+	svc := NewService(repo)
+	svc.now = func() time.Time { return time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC) }
+
+	want := []time.Time{time.Date(2026, 1, 9, 17, 0, 0, 0, la).UTC(), time.Date(2026, 1, 23, 17, 0, 0, 0, la).UTC()}
+	in := SkipOccurrencesInput{UserID: "u1", SeriesID: series.ID, Weekdays: []int16{5}}
+	preview, err := svc.SkipOccurrences(context.Background(), in)
+	if err != nil {
+		t.Fatalf("preview error: %v", err)
+	}
+	if !slices.EqualFunc(preview.OccurrenceStarts, want, time.Time.Equal) || preview.Skipped != 0 || written != nil {
+		t.Fatalf("preview = %+v, written %v; want %v and nothing written", preview, written, want)
+	}
+
+	in.Apply = true
+	applied, err := svc.SkipOccurrences(context.Background(), in)
+	if err != nil {
+		t.Fatalf("apply error: %v", err)
+	}
+	if applied.Skipped != 2 || !slices.EqualFunc(written, want, time.Time.Equal) {
+		t.Fatalf("applied = %+v, written %v; want %v", applied, written, want)
+	}
+
+	var vErr *ValidationError
+	in.WindowStart = time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	if _, err := svc.SkipOccurrences(context.Background(), in); !errors.As(err, &vErr) {
+		t.Fatalf("half-open window error = %v, want *ValidationError", err)
+	}
+}
+
+func TestServiceSkipOccurrences_LargeSkipNeedsConfirmation(t *testing.T) {
+	count := SkipConfirmThreshold + 5
+	series := domain.RecurringSeries{
+		ID:              uuid.New(),
+		UserID:          "u1",
+		Timezone:        "UTC",
+		DTStart:         time.Date(2026, 1, 5, 9, 0, 0, 0, time.UTC),
+		DurationSeconds: 3600,
+		Frequency:       domain.RecurrenceFrequencyWeekly,
+		Interval:        1,
+		ByWeekday:       []int16{1},
+		Count:           &count,
+	}
+	var exceptions []domain.RecurringException
+	writes := 0
+	svc := NewService(&fakeRepo{
+		getRecurringSeries: func(ctx context.Context, userID string, seriesID uuid.UUID) (domain.RecurringSeries, error) {
+			return series, nil
+		},
+		listSeriesExceptions: func(ctx context.Context, seriesID uuid.UUID) ([]domain.RecurringException, error) {
+			return exceptions, nil
+		},
+		skipOccurrences: func(ctx context.Context, userID string, seriesID uuid.UUID, occurrenceStarts []time.Time) (int, error) {
+			writes++
+			return len(occurrenceStarts), nil
+		},
+	})
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	svc.now = func() time.Time { return now }
+
+	in := SkipOccurrencesInput{UserID: "u1", SeriesID: series.ID, Apply: true}
+	if _, err := svc.SkipOccurrences(context.Background(), in); !errors.Is(err, ErrConfirmationRequired) {
+		t.Fatalf("unpreviewed apply error = %v, want ErrConfirmationRequired", err)
+	}
+	in.Apply = false
+	preview, err := svc.SkipOccurrences(context.Background(), in)
+	if err != nil {
+		t.Fatalf("preview error: %v", err)
+	}
+	if len(preview.OccurrenceStarts) != count || preview.Confirmation == nil || !preview.Confirmation.ExpiresAt.Equal(now.Add(ConfirmationTTL)) {
+		t.Fatalf("preview = %+v, want %d starts and a confirmation", preview, count)
+	}
+	in.Apply, in.ConfirmationToken = true, preview.Confirmation.Token
+
+	// A token from another user, or for a set that has changed since the
+	// preview, does not confirm.
+	other := in
+	other.UserID = "u2"
+	if _, err := svc.SkipOccurrences(context.Background(), other); !errors.Is(err, ErrConfirmationRequired) {
+		t.Fatalf("other user's apply error = %v, want ErrConfirmationRequired", err)
+	}
+	exceptions = []domain.RecurringException{{SeriesID: series.ID, OccurrenceStart: series.DTStart, Kind: domain.RecurringExceptionKindSkip}}
+	if _, err := svc.SkipOccurrences(context.Background(), in); !errors.Is(err, ErrConfirmationRequired) {
+		t.Fatalf("changed set apply error = %v, want ErrConfirmationRequired", err)
+	}
+	exceptions = nil
+
+	now = now.Add(ConfirmationTTL)
+	if _, err := svc.SkipOccurrences(context.Background(), in); !errors.Is(err, ErrConfirmationRequired) {
+		t.Fatalf("expired apply error = %v, want ErrConfirmationRequired", err)
+	}
+	now = now.Add(-time.Minute)
+	applied, err := svc.SkipOccurrences(context.Background(), in)
+	if err != nil {
+		t.Fatalf("confirmed apply error: %v", err)
+	}
+	if applied.Skipped != count || writes != 1 {
+		t.Fatalf("applied = %+v after %d writes, want %d skipped in one write", applied, writes, count)
+	}
+}
+
+func TestServiceChangeSeriesTimeZone_ManySeriesNeedConfirmation(t *testing.T) {
+	count := 2
+	repo := &fakeRepo{
+		getRecurringSeries: func(ctx context.Context, userID string, id uuid.UUID) (domain.RecurringSeries, error) {
+			return domain.RecurringSeries{
+				ID:              id,
+				UserID:          userID,
+				Timezone:        "UTC",
+				DTStart:         time.Date(2026, 1, 5, 9, 0, 0, 0, time.UTC),
+				DurationSeconds: 3600,
+				Frequency:       domain.RecurrenceFrequencyWeekly,
+				Interval:        1,
+				ByWeekday:       []int16{1},
+				Count:           &count,
+			}, nil
+		},
+		listSeriesExceptions: func(ctx context.Context, seriesID uuid.UUID) ([]domain.RecurringException, error) {
+			return []domain.RecurringException{{ID: uuid.New(), SeriesID: seriesID, OccurrenceStart: time.Date(2026, 1, 8, 9, 0, 0, 0, time.UTC)}}, nil
+		},
+		moveSeriesTimeZone: func(ctx context.Context, userID string, ids []uuid.UUID, tz string, keep domain.TimeZoneKeep) ([]domain.RecurringSeries, int, error) {
+			return nil, 0, nil
+		},
+	}
+	svc := NewService(repo)
+	svc.now = func() time.Time { return time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC) }
+
+	ids := make([]uuid.UUID, TimeZoneConfirmThreshold+1)
+	for i := range ids {
+		ids[i] = uuid.New()
+	}
+	in := ChangeSeriesTimeZoneInput{UserID: "u1", SeriesIDs: ids, TimeZone: "Europe/London", Keep: domain.TimeZoneKeepWallTime}
+	if _, err := svc.ChangeSeriesTimeZone(context.Background(), in); !errors.Is(err, ErrConfirmationRequired) {
+		t.Fatalf("unpreviewed change error = %v, want ErrConfirmationRequired", err)
+	}
+
+	in.Preview = true
+	preview, err := svc.ChangeSeriesTimeZone(context.Background(), in)
+	if err != nil {
+		t.Fatalf("preview error: %v", err)
+	}
+	if len(preview.Series) != len(ids) || preview.Series[0].Timezone != "Europe/London" || preview.RemovedExceptions != len(ids) || preview.Confirmation == nil {
+		t.Fatalf("preview = %+v, want every series moved, its off-pattern exception counted, and a confirmation", preview)
+	}
+
+	in.Preview, in.ConfirmationToken = false, preview.Confirmation.Token
+	in.Keep = domain.TimeZoneKeepInstant
+	if _, err := svc.ChangeSeriesTimeZone(context.Background(), in); !errors.Is(err, ErrConfirmationRequired) {
+		t.Fatalf("different keep error = %v, want ErrConfirmationRequired", err)
+	}
+	in.Keep = domain.TimeZoneKeepWallTime
+	slices.Reverse(in.SeriesIDs)
+	if _, err := svc.ChangeSeriesTimeZone(context.Background(), in); err != nil {
+		t.Fatalf("confirmed change error: %v", err)
+	}
+}
+
+func TestServiceChangeSeriesTimeZone(t *testing.T) {
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	seriesID := uuid.New()
+	count := 3
+	ny, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Fatalf("LoadLocation error: %v", err)
+	}
+	stored := domain.RecurringSeries{
+		ID:              seriesID,
+		UserID:          "u1",
+		Timezone:        "America/New_York",
+		DTStart:         time.Date(2026, 1, 5, 9, 0, 0, 0, ny).UTC(),
+		DurationSeconds: 3600,
+		Frequency:       domain.RecurrenceFrequencyWeekly,
+		Interval:        1,
+		ByWeekday:       []int16{1},
+		Count:           &count,
+	}
+	var moveCalls int
+	svc := NewService(&fakeRepo{
+		getRecurringSeries: func(ctx context.Context, userID string, id uuid.UUID) (domain.RecurringSeries, error) {
+			if id != seriesID {
+				return domain.RecurringSeries{}, store.ErrNotFound
+			}
+			return stored, nil
+		},
+		moveSeriesTimeZone: func(ctx context.Context, userID string, ids []uuid.UUID, tz string, keep domain.TimeZoneKeep) ([]domain.RecurringSeries, int, error) {
+			moveCalls++
+			if len(ids) != 1 || ids[0] != seriesID || tz != "Europe/London" || keep != domain.TimeZoneKeepWallTime {
+				t.Fatalf("move(%v, %s, %s), want the one series to London keeping wall time", ids, tz, keep)
+			}
+			moved, _, _, err := domain.MoveSeriesTimeZone(stored, nil, tz, keep, store.RecurringConflictLookahead)
+			return []domain.RecurringSeries{moved}, 2, err
+		},
+		listSeriesOccurrences: func(ctx context.Context, series domain.RecurringSeries, windowStart, windowEnd time.Time) ([]domain.RecurringOccurrence, error) {
+			return domain.GenerateWeeklyOccurrences(series, windowStart, windowEnd)
+		},
+	})
+	svc.now = func() time.Time { return now }
+
+	result, err := svc.ChangeSeriesTimeZone(context.Background(), ChangeSeriesTimeZoneInput{
+		UserID:    "u1",
+		SeriesIDs: []uuid.UUID{seriesID, seriesID},
+		TimeZone:  "Europe/London",
+		Keep:      domain.TimeZoneKeepWallTime,
+	})
+	if err != nil {
+		t.Fatalf("ChangeSeriesTimeZone error: %v", err)
+	}
+	if moved := result.Series; result.RemovedExceptions != 2 || len(moved) != 1 || moved[0].Timezone != "Europe/London" || moved[0].OccurrencesRemaining != 3 {
+		t.Fatalf("result = %+v; want one series in London with 3 to go and 2 removed", result)
+	}
+
+	tests := []struct {
+		name string
+		in   ChangeSeriesTimeZoneInput
+	}{
+		{"no series", ChangeSeriesTimeZoneInput{UserID: "u1", TimeZone: "Europe/London", Keep: domain.TimeZoneKeepInstant}},
+		{"bad zone", ChangeSeriesTimeZoneInput{UserID: "u1", SeriesIDs: []uuid.UUID{seriesID}, TimeZone: "Mars/Olympus", Keep: domain.TimeZoneKeepInstant}},
+		{"no keep", ChangeSeriesTimeZoneInput{UserID: "u1", SeriesIDs: []uuid.UUID{seriesID}, TimeZone: "Europe/London"}},
+	}
+	for _, tt := range tests {
+		var vErr *ValidationError
+		if _, err := svc.ChangeSeriesTimeZone(context.Background(), tt.in); !errors.As(err, &vErr) {
+			t.Fatalf("%s: error = %v, want a validation error", tt.name, err)
+		}
+	}
+	if _, err := svc.ChangeSeriesTimeZone(context.Background(), ChangeSeriesTimeZoneInput{
+		UserID: "u1", SeriesIDs: []uuid.UUID{uuid.New()}, TimeZone: "Europe/London", Keep: domain.TimeZoneKeepInstant,
+	}); !errors.Is(err, store.ErrNotFound) {
+		t.Fatalf("unknown series error = %v, want store.ErrNotFound", err)
+	}
+	if moveCalls != 1 {
+		t.Fatalf("move called %d times, want once", moveCalls)
+	}
+}
+
+func TestServiceUpdateSeriesEnd_ShrinksOnly(t *testing.T) {
+	count := 6
+	series := domain.RecurringSeries{
+		ID:              uuid.MustParse("00000000-0000-0000-0000-000000000701"),
+		UserID:          "u1",
+		Title:           "Session",
+		Timezone:        "UTC",
+		DTStart:         time.Date(2026, 1, 5, 9, 0, 0, 0, time.UTC),
+		DurationSeconds: 3600,
+		Frequency:       domain.RecurrenceFrequencyWeekly,
+		Interval:        1,
+		ByWeekday:       []int16{1},
+		Count:           &count,
+	}
+	var written *domain.RecurringSeries
+	repo := &fakeRepo{
+		getRecurringSeries: func(ctx context.Context, userID string, seriesID uuid.UUID) (domain.RecurringSeries, error) {
+			return series, nil
+		},
+		updateSeriesEnd: func(ctx context.Context, s domain.RecurringSeries) (int, error) {
+			written = &s
+			return 2, nil
+		},
+		listSeriesOccurrences: func(ctx context.Context, s domain.RecurringSeries, windowStart, windowEnd time.Time) ([]domain.RecurringOccurrence, error) {
+			return domain.GenerateWeeklyOccurrences(s, windowStart, windowEnd)
+		},
+	}
+	svc := NewService(repo)
+	svc.now = func() time.Time { return time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC) }
+
+	var vErr *ValidationError
+	longer := 8
+	if _, _, err := svc.UpdateSeriesEnd(context.Background(), UpdateSeriesEndInput{UserID: "u1", SeriesID: series.ID, Count: &longer}); !errors.As(err, &vErr) || written != nil {
+		t.Fatalf("extend error = %v, written %v; want *ValidationError and nothing written", err, written)
+	}
+	until := time.Date(2026, 1, 4, 0, 0, 0, 0, time.UTC)
+	if _, _, err := svc.UpdateSeriesEnd(context.Background(), UpdateSeriesEndInput{UserID: "u1", SeriesID: series.ID, Until: &until}); !errors.As(err, &vErr) {
+		t.Fatalf("empty series error = %v, want *ValidationError", err)
+	}
+
+	until = time.Date(2026, 1, 20, 0, 0, 0, 0, time.UTC)
+	got, removed, err := svc.UpdateSeriesEnd(context.Background(), UpdateSeriesEndInput{UserID: "u1", SeriesID: series.ID, Until: &until})
+	if err != nil {
+		t.Fatalf("UpdateSeriesEnd error: %v", err)
+	}
+	if written == nil || written.Count != nil || written.Until == nil || !written.Until.Equal(until) {
+		t.Fatalf("written = %+v, want until %s and no count", written, until)
+	}
+	if removed != 2 || got.OccurrencesRemaining != 3 {
+		t.Fatalf("removed = %d, remaining = %d; want 2 and 3", removed, got.OccurrencesRemaining)
+	}
+}
+
+func TestServiceAuditCalendar_ReportsOverlapsWithCause(t *testing.T) {
+	day := time.Date(2030, 1, 7, 0, 0, 0, 0, time.UTC)
+	seriesID := uuid.MustParse("00000000-0000-0000-0000-000000000801")
+	imported := domain.Appointment{ID: uuid.MustParse("00000000-0000-0000-0000-000000000811"), Title: "Imported", Source: domain.SourceImport, StartTime: day.Add(9 * time.Hour), EndTime: day.Add(10 * time.Hour)}
+	manual := domain.Appointment{ID: uuid.MustParse("00000000-0000-0000-0000-000000000812"), Title: "Manual", Source: domain.SourceManual, StartTime: day.Add(9*time.Hour + 30*time.Minute), EndTime: day.Add(11 * time.Hour)}
+	adjacent := domain.Appointment{ID: uuid.MustParse("00000000-0000-0000-0000-000000000813"), Title: "Adjacent", Source: domain.SourceManual, StartTime: day.Add(15 * time.Hour), EndTime: day.Add(16 * time.Hour)}
+	milestone := domain.Appointment{ID: uuid.MustParse("00000000-0000-0000-0000-000000000814"), Title: "Launch", Kind: domain.AppointmentKindMilestone, StartTime: day.Add(14*time.Hour + 30*time.Minute), EndTime: day.Add(14*time.Hour + 30*time.Minute)}
+	// The 12:00 occurrence was overridden to run 14:00 to 15:00.
+	originalStart := day.Add(12 * time.Hour)
+	moved := domain.RecurringOccurrence{ID: domain.EncodeOccurrenceID(seriesID, originalStart), SeriesID: seriesID, Title: "Standup", StartTime: day.Add(14 * time.Hour), EndTime: day.Add(15 * time.Hour)}
+	clash := domain.Appointment{ID: uuid.MustParse("00000000-0000-0000-0000-000000000815"), Title: "Review", Source: domain.SourceManual, StartTime: day.Add(14 * time.Hour), EndTime: day.Add(14*time.Hour + 30*time.Minute)}
+
+	svc := NewService(&fakeRepo{
+		listFn: func(ctx context.Context, userID string, windowStart, windowEnd time.Time, filter store.AppointmentFilter) ([]domain.Appointment, error) {
+			return []domain.Appointment{imported, manual, adjacent, milestone, clash}, nil
+		},
+		listOccurrences: func(ctx context.Context, userID string, windowStart, windowEnd time.Time) ([]domain.RecurringOccurrence, error) {
+			return []domain.RecurringOccurrence{moved}, nil
+		},
+		listSeriesExceptions: func(ctx context.Context, id uuid.UUID) ([]domain.RecurringException, error) {
+			return []domain.RecurringException{{SeriesID: id, OccurrenceStart: originalStart, Kind: domain.RecurringExceptionKindOverride}}, nil
+		},
+	})
+
+	audit, err := svc.AuditCalendar(context.Background(), "u1", day, day.Add(24*time.Hour))
+	if err != nil {
+		t.Fatalf("AuditCalendar error: %v", err)
+	}
+	if audit.EntriesScanned != 5 || len(audit.Conflicts) != 2 {
+		t.Fatalf("audit = %+v, want 5 entries and 2 conflicts", audit)
+	}
+	first := audit.Conflicts[0]
+	if first.First.AppointmentID != imported.ID || first.Second.AppointmentID != manual.ID || first.Cause != ConflictCauseExternal ||
+		!first.OverlapStart.Equal(manual.StartTime) || !first.OverlapEnd.Equal(imported.EndTime) {
+		t.Fatalf("first conflict = %+v, want imported and manual, external", first)
+	}
+	second := audit.Conflicts[1]
+	if second.Cause != ConflictCauseOverride || !(second.First.Overridden || second.Second.Overridden) || !second.OverlapEnd.Equal(clash.EndTime) {
+		t.Fatalf("second conflict = %+v, want the overridden occurrence and the review", second)
+	}
+
+	var vErr *ValidationError
+	if _, err := svc.AuditCalendar(context.Background(), "u1", day, day.Add(MaxAuditWindow+time.Hour)); !errors.As(err, &vErr) {
+		t.Fatalf("long window error = %v, want *ValidationError", err)
+	}
+}
+
+func TestServiceCreate_Milestone(t *testing.T) {
+	at := time.Date(2030, 1, 7, 17, 5, 0, 0, time.UTC)
+	svc := NewService(&fakeRepo{
+		getUserSettings: func(ctx context.Context, userID string) (domain.UserSettings, error) {
+			return domain.UserSettings{UserID: userID, SlotAlignmentMinutes: 30}, nil
+		},
+		createFn: func(ctx context.Context, appt domain.Appointment) (domain.Appointment, error) {
+			return appt, nil
+		},
+		listBlackouts: func(ctx context.Context, windowStart, windowEnd time.Time) ([]domain.Blackout, error) {
+			return []domain.Blackout{{Title: "Freeze", StartTime: at.Add(-time.Hour), EndTime: at.Add(time.Hour), Mode: domain.BlackoutModeBlock}}, nil
+		},
+	})
+
+	// Off the slot grid and inside a blocking blackout, but a milestone
+	// takes no time, so neither rule applies.
+	appt, err := svc.Create(context.Background(), CreateInput{UserID: "u1", Title: "Report due", StartTime: at, Kind: domain.AppointmentKindMilestone})
+	if err != nil {
+		t.Fatalf("Create milestone error: %v", err)
+	}
+	if !appt.Milestone() || !appt.EndTime.Equal(at) {
+		t.Fatalf("milestone = %+v, want kind milestone ending at %s", appt, at)
+	}
+
+	var vErr *ValidationError
+	if _, err := svc.Create(context.Background(), CreateInput{UserID: "u1", Title: "Report due", StartTime: at, EndTime: at.Add(time.Minute), Kind: domain.AppointmentKindMilestone}); !errors.As(err, &vErr) {
+		t.Fatalf("milestone with duration error = %v, want *ValidationError", err)
+	}
+	if _, err := svc.Create(context.Background(), CreateInput{UserID: "u1", Title: "Call", StartTime: at, EndTime: at}); !errors.As(err, &vErr) {
+		t.Fatalf("zero-length event error = %v, want *ValidationError", err)
+	}
+}
+
+func TestServiceSuggestEndTime_IgnoresMilestones(t *testing.T) {
+	start := time.Date(2026, 3, 2, 9, 0, 0, 0, time.UTC)
+	svc := NewService(&fakeRepo{
+		listFn: func(ctx context.Context, userID string, windowStart, windowEnd time.Time, filter store.AppointmentFilter) ([]domain.Appointment, error) {
+			return []domain.Appointment{
+				{StartTime: start, EndTime: start, Kind: domain.AppointmentKindMilestone},
+				{StartTime: start.Add(30 * time.Minute), EndTime: start.Add(30 * time.Minute), Kind: domain.AppointmentKindMilestone},
+			}, nil
+		},
+		listOccurrences: func(ctx context.Context, userID string, windowStart, windowEnd time.Time) ([]domain.RecurringOccurrence, error) {
+			return nil, nil
+		},
+	})
+
+	got, err := svc.SuggestEndTime(context.Background(), "u1", start, time.Hour, nil)
+	if err != nil {
+		t.Fatalf("SuggestEndTime error: %v", err)
+	}
+	if !got.EndTime.Equal(start.Add(time.Hour)) || got.Shortened {
+		t.Fatalf("suggestion = %+v, want the full hour", got)
+	}
+}
+
+func TestServiceReserveSlot_AppliesTTL(t *testing.T) {
+	now := time.Date(2026, 3, 2, 8, 0, 0, 0, time.UTC)
+	svc := NewService(&fakeRepo{
+		reserveSlot: func(ctx context.Context, hold domain.SlotHold) (domain.SlotHold, error) {
+			return hold, nil
+		},
+	})
+	svc.now = func() time.Time { return now }
+
+	start := now.Add(time.Hour)
+	got, err := svc.ReserveSlot(context.Background(), ReserveSlotInput{UserID: "u1", StartTime: start, EndTime: start.Add(30 * time.Minute)})
+	if err != nil {
+		t.Fatalf("ReserveSlot error: %v", err)
+	}
+	if !got.ExpiresAt.Equal(now.Add(DefaultHoldTTL)) {
+		t.Fatalf("expires_at = %v, want %v", got.ExpiresAt, now.Add(DefaultHoldTTL))
+	}
+
+	_, err = svc.ReserveSlot(context.Background(), ReserveSlotInput{UserID: "u1", StartTime: start, EndTime: start.Add(30 * time.Minute), TTL: time.Hour})
+	var vErr *ValidationError
+	if !errors.As(err, &vErr) {
+		t.Fatalf("error = %v, want *ValidationError", err)
+	}
+}
+
+func TestServiceReserveSlot_AppliesConfiguredTTLs(t *testing.T) {
+	now := time.Date(2026, 3, 2, 8, 0, 0, 0, time.UTC)
+	svc := NewService(&fakeRepo{
+		reserveSlot: func(ctx context.Context, hold domain.SlotHold) (domain.SlotHold, error) {
+			return hold, nil
+		},
+	})
+	svc.now = func() time.Time { return now }
+	// The default is longer than the maximum, so it is cut to the maximum.
+	svc.SetExpiryTTLs(ExpiryTTLs{HoldDefault: 3 * time.Hour, HoldMax: 2 * time.Hour})
+
+	start := now.Add(24 * time.Hour)
+	got, err := svc.ReserveSlot(context.Background(), ReserveSlotInput{UserID: "u1", StartTime: start, EndTime: start.Add(30 * time.Minute)})
+	if err != nil {
+		t.Fatalf("ReserveSlot error: %v", err)
+	}
+	if want := now.Add(2 * time.Hour); !got.ExpiresAt.Equal(want) {
+		t.Fatalf("expires_at = %v, want %v", got.ExpiresAt, want)
+	}
+	if _, err := svc.ReserveSlot(context.Background(), ReserveSlotInput{UserID: "u1", StartTime: start, EndTime: start.Add(30 * time.Minute), TTL: time.Hour}); err != nil {
+		t.Fatalf("ReserveSlot within configured max error: %v", err)
+	}
+	if svc.expiry.ProposalDefault != DefaultProposalTTL || svc.expiry.ProposalMax != MaxProposalTTL {
+		t.Fatalf("proposal ttls = %+v, want the built-in values", svc.expiry)
+	}
+}
+
+func TestServiceReserveSlot_AppliesTimePolicy(t *testing.T) {
+	now := time.Date(2026, 3, 2, 8, 0, 0, 0, time.UTC)
+	svc := NewService(&fakeRepo{
+		reserveSlot: func(ctx context.Context, hold domain.SlotHold) (domain.SlotHold, error) {
+			return hold, nil
+		},
+	})
+	svc.now = func() time.Time { return now }
+
+	reserve := func(start time.Time) error {
+		_, err := svc.ReserveSlot(context.Background(), ReserveSlotInput{UserID: "u1", StartTime: start, EndTime: start.Add(30 * time.Minute)})
+		return err
+	}
+	// A client a minute behind the server books what it sees as now.
+	if err := reserve(now.Add(-time.Minute)); err != nil {
+		t.Fatalf("start within skew error: %v", err)
+	}
+	if err := reserve(now.Add(-10 * time.Minute)); err == nil || err.Error() != "start_time is in the past" {
+		t.Fatalf("past start error = %v", err)
+	}
+
+	svc.SetTimePolicy(timepolicy.Policy{Skew: time.Minute, MinNotice: 2 * time.Hour})
+	if err := reserve(now.Add(time.Hour)); err == nil || err.Error() != "start_time is within the minimum booking notice" {
+		t.Fatalf("short notice error = %v", err)
+	}
+	if err := reserve(now.Add(2*time.Hour - 30*time.Second)); err != nil {
+		t.Fatalf("notice met within skew error: %v", err)
+	}
+}
+
+func TestServiceCreate_AppliesPastStartPolicy(t *testing.T) {
+	now := time.Date(2026, 3, 2, 8, 0, 0, 0, time.UTC)
+	overrides := map[string]domain.PastStartPolicy{"strict": domain.PastStartReject}
+	svc := NewService(&fakeRepo{
+		getUserSettings: func(ctx context.Context, userID string) (domain.UserSettings, error) {
+			return domain.UserSettings{UserID: userID, PastStartPolicy: overrides[userID]}, nil
+		},
+		createFn: func(ctx context.Context, appt domain.Appointment) (domain.Appointment, error) {
+			return appt, nil
+		},
+		listBlackouts: func(ctx context.Context, windowStart, windowEnd time.Time) ([]domain.Blackout, error) {
+			return nil, nil
+		},
+	})
+	svc.now = func() time.Time { return now }
+
+	create := func(userID string, start time.Time, bypass bool) (domain.Appointment, error) {
+		return svc.Create(context.Background(), CreateInput{UserID: userID, Title: "Call", StartTime: start, EndTime: start.Add(time.Hour), AllowPastStart: bypass})
+	}
+	past := now.Add(-time.Hour)
+
+	// The default allows past starts without a warning.
+	if appt, err := create("u1", past, false); err != nil || appt.PastStartWarning {
+		t.Fatalf("allow: warning = %v, err = %v", appt.PastStartWarning, err)
+	}
+
+	svc.SetPastStartPolicy(domain.PastStartWarn)
+	if appt, err := create("u1", past, false); err != nil || !appt.PastStartWarning {
+		t.Fatalf("warn: warning = %v, err = %v", appt.PastStartWarning, err)
+	}
+	// A start within the clock skew is not in the past.
+	if appt, err := create("u1", now.Add(-time.Minute), false); err != nil || appt.PastStartWarning {
+		t.Fatalf("warn within skew: warning = %v, err = %v", appt.PastStartWarning, err)
+	}
+
+	// The user's override wins over the server-wide policy.
+	if _, err := create("strict", past, false); !errors.Is(err, ErrPastStart) {
+		t.Fatalf("reject override error = %v, want ErrPastStart", err)
+	}
+	if _, err := create("strict", now.Add(time.Hour), false); err != nil {
+		t.Fatalf("reject override future start error: %v", err)
+	}
+	if appt, err := create("strict", past, true); err != nil || appt.PastStartWarning {
+		t.Fatalf("bypass: warning = %v, err = %v", appt.PastStartWarning, err)
+	}
+}
+
+func TestServiceCreate_AppliesSourceDefault(t *testing.T) {
+	defaults := []domain.SourceDefault{{Source: domain.SourceManual, TitlePrefix: "[Desk] ", NotesFooter: "Booked at the front desk."}}
+	svc := NewServiceWithLimits(&fakeRepo{
+		getUserSettings: func(ctx context.Context, userID string) (domain.UserSettings, error) {
+			return domain.UserSettings{UserID: userID, SourceDefaults: defaults}, nil
+		},
+		createFn: func(ctx context.Context, appt domain.Appointment) (domain.Appointment, error) {
+			return appt, nil
+		},
+	}, limits.Limits{MaxTitleLength: 12, MaxNotesLength: 100})
+
+	start := time.Date(2026, 1, 1, 10, 0, 0, 0, time.UTC)
+	create := func(title, notes string) (domain.Appointment, error) {
+		return svc.Create(context.Background(), CreateInput{UserID: "u1", Title: title, Notes: notes, StartTime: start, EndTime: start.Add(time.Hour)})
+	}
+
+	appt, err := create("Call", "Bring ID.")
+	if err != nil {
+		t.Fatalf("Create error: %v", err)
+	}
+	if appt.Title != "[Desk] Call" || appt.Notes != "Bring ID.\n\nBooked at the front desk." || appt.SourceDefault == nil {
+		t.Fatalf("appt = %q / %q, source default %v", appt.Title, appt.Notes, appt.SourceDefault)
+	}
+
+	// A title that already carries the prefix is not prefixed twice.
+	if appt, err := create("[Desk] Call", ""); err != nil || appt.Title != "[Desk] Call" {
+		t.Fatalf("prefixed title = %q, err = %v", appt.Title, err)
+	}
+
+	var vErr *ValidationError
+	if _, err := create("Long call", ""); !errors.As(err, &vErr) || !strings.Contains(vErr.Error(), "title prefix") {
+		t.Fatalf("over-limit error = %v, want a title prefix validation error", err)
+	}
+}
+
+func TestNormalizeSourceDefaults(t *testing.T) {
+	valid := []domain.SourceDefault{
+		{Source: " booking ", TitlePrefix: "  [Web] "},
+		{Source: "sync:", NotesFooter: "Synced."},
+		{Source: "sync:google", TitlePrefix: "G: "},
+	}
+	got, err := normalizeSourceDefaults(valid)
+	if err != nil {
+		t.Fatalf("normalizeSourceDefaults error: %v", err)
+	}
+	if got[0].Source != "booking" || got[0].TitlePrefix != "[Web] " {
+		t.Fatalf("normalized = %+v", got[0])
+	}
+
+	for _, bad := range [][]domain.SourceDefault{
+		{{Source: "import", TitlePrefix: "x"}},
+		{{Source: "sync: google", TitlePrefix: "x"}},
+		{{Source: "manual"}},
+		{{Source: "manual", TitlePrefix: "   "}},
+		{{Source: "manual", TitlePrefix: "a"}, {Source: "manual", NotesFooter: "b"}},
+		{{Source: "manual", TitlePrefix: strings.Repeat("x", MaxTitlePrefixLength+1)}},
+	} {
+		var vErr *ValidationError
+		if _, err := normalizeSourceDefaults(bad); !errors.As(err, &vErr) {
+			t.Fatalf("normalizeSourceDefaults(%+v) error = %v, want a validation error", bad, err)
+		}
+	}
+}
+
+func TestServiceUpdateSourceDefaults_RefusesFrozenCalendar(t *testing.T) {
+	deactivated := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	svc := NewService(&fakeRepo{
+		getProvisionedUser: func(ctx context.Context, userID string) (domain.ProvisionedUser, error) {
+			return domain.ProvisionedUser{UserID: userID, DeactivatedAt: &deactivated}, nil
+		},
+		updateUserSettings: func(ctx context.Context, settings domain.UserSettings) (domain.UserSettings, error) {
+			t.Fatalf("UpdateUserSettings called for a frozen calendar")
+			return settings, nil
+		},
+	})
+
+	defaults := []domain.SourceDefault{{Source: domain.SourceManual, TitlePrefix: "[Desk] "}}
+	if _, err := svc.UpdateSourceDefaults(context.Background(), "u1", defaults); !errors.Is(err, ErrUserDeactivated) {
+		t.Fatalf("UpdateSourceDefaults error = %v, want ErrUserDeactivated", err)
+	}
+}
+
+func TestServiceConfirmHold_DerivesAppointmentIDFromHold(t *testing.T) {
+	var ids []uuid.UUID
+	svc := NewService(&fakeRepo{
+		confirmHold: func(ctx context.Context, holdID uuid.UUID, appt domain.Appointment) (domain.Appointment, error) {
+			ids = append(ids, appt.ID)
+			return appt, nil
+		},
+	})
+
+	holdID := uuid.MustParse("00000000-0000-0000-0000-000000000077")
+	for i := 0; i < 2; i++ {
+		if _, err := svc.ConfirmHold(context.Background(), ConfirmHoldInput{UserID: "u1", HoldID: holdID, Title: "Intro call"}); err != nil {
+			t.Fatalf("ConfirmHold error: %v", err)
+		}
+	}
+	if ids[0] == uuid.Nil || ids[0] != ids[1] {
+		t.Fatalf("appointment ids = %v, want the same non-nil id", ids)
+	}
+}
+
+func TestServiceMergeAppointments_Validates(t *testing.T) {
+	var merged []uuid.UUID
+	svc := NewService(&fakeRepo{
+		mergeAppointments: func(ctx context.Context, userID string, primaryID uuid.UUID, duplicateIDs []uuid.UUID, cover bool, at time.Time) (store.AppointmentMerge, error) {
+			merged = duplicateIDs
+			return store.AppointmentMerge{Deleted: duplicateIDs}, nil
+		},
+	})
+
+	primary := uuid.MustParse("00000000-0000-0000-0000-000000000001")
+	dup := uuid.MustParse("00000000-0000-0000-0000-000000000002")
+	tooMany := make([]uuid.UUID, MaxMergeDuplicates+1)
+	for i := range tooMany {
+		tooMany[i] = uuid.New()
+	}
+	for _, ids := range [][]uuid.UUID{nil, {primary}, {dup, dup}, {uuid.Nil}, tooMany} {
+		var vErr *ValidationError
+		if _, err := svc.MergeAppointments(context.Background(), MergeInput{UserID: "u1", PrimaryID: primary, DuplicateIDs: ids}); !errors.As(err, &vErr) {
+			t.Fatalf("MergeAppointments(%d ids) error = %v, want a validation error", len(ids), err)
+		}
+	}
+	if merged != nil {
+		t.Fatalf("store called for an invalid merge")
+	}
+
+	out, err := svc.MergeAppointments(context.Background(), MergeInput{UserID: "u1", PrimaryID: primary, DuplicateIDs: []uuid.UUID{dup}})
+	if err != nil || len(out.Deleted) != 1 || merged[0] != dup {
+		t.Fatalf("MergeAppointments = %+v, %v", out, err)
+	}
+}
+
+func TestServiceLinkAppointments_Validates(t *testing.T) {
+	svc := NewService(&fakeRepo{})
+	a := uuid.MustParse("00000000-0000-0000-0000-000000000001")
+	b := uuid.MustParse("00000000-0000-0000-0000-000000000002")
+
+	tests := []struct {
+		name      string
+		related   uuid.UUID
+		kind      domain.AppointmentLinkKind
+		wantError string
+	}{
+		{name: "self link", related: a, kind: domain.AppointmentLinkKindPrepFor, wantError: "an appointment cannot be linked to itself"},
+		{name: "unknown kind", related: b, kind: "blocks", wantError: "invalid link kind"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := svc.LinkAppointments(context.Background(), "u1", a, tt.related, tt.kind)
+			var vErr *ValidationError
+			if !errors.As(err, &vErr) || vErr.Error() != tt.wantError {
+				t.Fatalf("error = %v, want %q", err, tt.wantError)
+			}
+		})
+	}
+}
+
+func TestServiceCreateRecurringSeries_SkipConflictsExcludesSkippedFromProgress(t *testing.T) {
+	count := 3
+	start := time.Date(2026, 1, 5, 9, 0, 0, 0, time.UTC)
+	svc := NewService(&fakeRepo{
+		createSkipping: func(ctx context.Context, series domain.RecurringSeries, maxSkips int) (domain.RecurringSeries, error) {
+			if maxSkips != MaxSkippedConflicts {
+				t.Fatalf("maxSkips = %d, want %d", maxSkips, MaxSkippedConflicts)
+			}
+			series.SkippedOccurrences = []time.Time{start}
+			return series, nil
+		},
+	})
+	svc.now = func() time.Time { return start.Add(-time.Hour) }
+
+	got, err := svc.CreateRecurringSeries(context.Background(), CreateRecurringSeriesInput{
+		UserID:        "u1",
+		Title:         "t",
+		StartTime:     start,
+		EndTime:       start.Add(time.Hour),
+		Rule:          RecurrenceRuleInput{Count: &count, TimeZone: "UTC"},
+		SkipConflicts: true,
+	})
+	if err != nil {
+		t.Fatalf("CreateRecurringSeries error: %v", err)
+	}
+	if got.OccurrencesRemaining != 2 {
+		t.Fatalf("occurrences remaining = %d, want 2", got.OccurrencesRemaining)
+	}
+	if got.NextOccurrence == nil || !got.NextOccurrence.Equal(start.AddDate(0, 0, 7)) {
+		t.Fatalf("next occurrence = %v, want %v", got.NextOccurrence, start.AddDate(0, 0, 7))
+	}
+}
+
+func TestServiceBatchGetFreeBusy_MergesClipsAndIsolatesFailures(t *testing.T) {
+	start := time.Date(2026, 1, 5, 9, 0, 0, 0, time.UTC)
+	end := start.Add(8 * time.Hour)
+	failure := errors.New("boom")
+
+	svc := NewService(&fakeRepo{
+		listFn: func(ctx context.Context, userID string, windowStart, windowEnd time.Time, filter store.AppointmentFilter) ([]domain.Appointment, error) {
+			if userID == "u2" {
+				return nil, failure
+			}
+			return []domain.Appointment{
+				{StartTime: start.Add(-time.Hour), EndTime: start.Add(time.Hour)},
+				{StartTime: start.Add(3 * time.Hour), EndTime: start.Add(4 * time.Hour)},
+			}, nil
+		},
+		listOccurrences: func(ctx context.Context, userID string, windowStart, windowEnd time.Time) ([]domain.RecurringOccurrence, error) {
+			return []domain.RecurringOccurrence{
+				{StartTime: start.Add(30 * time.Minute), EndTime: start.Add(2 * time.Hour)},
+			}, nil
+		},
+	})
+
+	got, err := svc.BatchGetFreeBusy(context.Background(), []string{"u1", "u2", "u1"}, start, end)
+	if err != nil {
+		t.Fatalf("BatchGetFreeBusy error: %v", err)
+	}
+	if len(got) != 2 {
+		t.Fatalf("len(results) = %d, want 2 after dedup", len(got))
+	}
+	if got[0].UserID != "u1" || got[0].Err != nil {
+		t.Fatalf("results[0] = %+v, want u1 without error", got[0])
+	}
+	want := []domain.BusyInterval{
+		{Start: start, End: start.Add(2 * time.Hour)},
+		{Start: start.Add(3 * time.Hour), End: start.Add(4 * time.Hour)},
+	}
+	if len(got[0].Busy) != len(want) {
+		t.Fatalf("busy = %+v, want %+v", got[0].Busy, want)
+	}
+	for i := range want {
+		if !got[0].Busy[i].Start.Equal(want[i].Start) || !got[0].Busy[i].End.Equal(want[i].End) {
+			t.Fatalf("busy[%d] = %+v, want %+v", i, got[0].Busy[i], want[i])
+		}
+	}
+	if got[1].UserID != "u2" || !errors.Is(got[1].Err, failure) {
+		t.Fatalf("results[1] = %+v, want u2 with error", got[1])
+	}
+
+	ids := make([]string, MaxFreeBusyUsers+1)
+	for i := range ids {
+		ids[i] = fmt.Sprintf("u%d", i)
+	}
+	if _, err := svc.BatchGetFreeBusy(context.Background(), ids, start, end); err == nil || err.Error() != "too many user_ids" {
+		t.Fatalf("error = %v, want too many user_ids", err)
+	}
+}
+
+func TestServiceSuggestMeetingTimes(t *testing.T) {
+	start := time.Date(2026, 1, 5, 9, 0, 0, 0, time.UTC)
+	failing := errors.New("boom")
+	svc := NewService(&fakeRepo{
+		listFn: func(ctx context.Context, userID string, windowStart, windowEnd time.Time, filter store.AppointmentFilter) ([]domain.Appointment, error) {
+			if userID == "broken" {
+				return nil, failing
+			}
+			if userID == "u1" {
+				return []domain.Appointment{{StartTime: start, EndTime: start.Add(time.Hour)}}, nil
+			}
+			return nil, nil
+		},
+		listOccurrences: func(ctx context.Context, userID string, windowStart, windowEnd time.Time) ([]domain.RecurringOccurrence, error) {
+			return nil, nil
+		},
+	})
+
+	in := SuggestMeetingTimesInput{
+		Attendees:   []MeetingAttendeeInput{{UserID: "u1"}, {UserID: "u2"}},
+		Duration:    time.Hour,
+		WindowStart: start,
+		WindowEnd:   start.Add(3 * time.Hour),
+		Step:        time.Hour,
+	}
+	got, err := svc.SuggestMeetingTimes(context.Background(), in)
+	if err != nil {
+		t.Fatalf("SuggestMeetingTimes error: %v", err)
+	}
+	if len(got) != 2 || !got[0].Start.Equal(start.Add(time.Hour)) {
+		t.Fatalf("suggestions = %+v, want 10:00 and 11:00", got)
+	}
+
+	in.Attendees = append(in.Attendees, MeetingAttendeeInput{UserID: "broken"})
+	if _, err := svc.SuggestMeetingTimes(context.Background(), in); !errors.Is(err, failing) {
+		t.Fatalf("error = %v, want read failure", err)
+	}
+
+	in.Attendees = []MeetingAttendeeInput{{UserID: "u1", WorkingHours: &WorkingHoursInput{TimeZone: "UTC", StartMinute: 600, EndMinute: 540}}}
+	var vErr *ValidationError
+	if _, err := svc.SuggestMeetingTimes(context.Background(), in); !errors.As(err, &vErr) || vErr.Error() != "invalid working_hours range" {
+		t.Fatalf("error = %v, want invalid working_hours range", err)
+	}
+}
+
+func TestServiceSimulateSchedule(t *testing.T) {
+	start := time.Date(2026, 1, 5, 0, 0, 0, 0, time.UTC)
+	svc := NewService(&fakeRepo{})
+
+	in := SimulateScheduleInput{
+		Staff: []SimulatedStaffInput{{
+			Label:        "dr-a",
+			WorkingHours: WorkingHoursInput{TimeZone: "UTC", StartMinute: 540, EndMinute: 720},
+			Breaks:       []domain.DailyBreak{{Label: "coffee", StartMinute: 600, EndMinute: 615}},
+		}},
+		Patterns:    []BookingPatternInput{{Label: "consult", Duration: time.Hour, PerDay: 3, Weekdays: []int16{1}}},
+		WindowStart: start,
+		WindowEnd:   start.AddDate(0, 0, 2),
+	}
+	got, err := svc.SimulateSchedule(context.Background(), in)
+	if err != nil {
+		t.Fatalf("SimulateSchedule error: %v", err)
+	}
+	// Monday only: 9:00 fits, the break splits the rest into 10:15-12:00,
+	// which takes one more hour.
+	if got.Capacity != 5*time.Hour+30*time.Minute || got.Booked != 2*time.Hour || got.Requested != 3 || got.Unbooked != 1 {
+		t.Fatalf("result = %+v, want 2h of 5h30m booked with 1 of 3 unbooked", got.Utilization)
+	}
+
+	var vErr *ValidationError
+	in.Patterns[0].Weekdays = []int16{8}
+	if _, err := svc.SimulateSchedule(context.Background(), in); !errors.As(err, &vErr) || vErr.Error() != "invalid weekday" {
+		t.Fatalf("error = %v, want invalid weekday", err)
+	}
+	in.Patterns[0].Weekdays = nil
+	in.WindowEnd = start.Add(MaxSimulationWindow + time.Hour)
+	if _, err := svc.SimulateSchedule(context.Background(), in); !errors.As(err, &vErr) || vErr.Error() != "window too long" {
+		t.Fatalf("error = %v, want window too long", err)
+	}
+}
+
+func TestServiceRepairRecurringSeries_DeletesOnlyWhenApplied(t *testing.T) {
+	count := 2
+	series := domain.RecurringSeries{
+		ID:              uuid.New(),
+		UserID:          "u1",
+		Timezone:        "UTC",
+		DTStart:         time.Date(2026, 1, 5, 9, 0, 0, 0, time.UTC),
+		DurationSeconds: 3600,
+		Frequency:       domain.RecurrenceFrequencyWeekly,
+		Interval:        1,
+		ByWeekday:       []int16{1},
+		Count:           &count,
+	}
+	stray := domain.RecurringException{ID: uuid.New(), SeriesID: series.ID, OccurrenceStart: series.DTStart.Add(time.Hour), Kind: domain.RecurringExceptionKindSkip}
+
+	var deleted []uuid.UUID
+	svc := NewService(&fakeRepo{
+		getRecurringSeries: func(ctx context.Context, userID string, seriesID uuid.UUID) (domain.RecurringSeries, error) {
+			return series, nil
+		},
+		listSeriesExceptions: func(ctx context.Context, seriesID uuid.UUID) ([]domain.RecurringException, error) {
+			return []domain.RecurringException{stray}, nil
+		},
+		deleteExceptions: func(ctx context.Context, seriesID uuid.UUID, exceptionIDs []uuid.UUID) (int, error) {
+			deleted = exceptionIDs
+			return len(exceptionIDs), nil
+		},
+	})
+
+	report, err := svc.RepairRecurringSeries(context.Background(), "u1", series.ID, false)
+	if err != nil {
+		t.Fatalf("RepairRecurringSeries error: %v", err)
+	}
+	if len(report.Findings) != 1 || report.Findings[0].Repaired || deleted != nil {
+		t.Fatalf("dry run report = %+v, deleted = %v", report, deleted)
+	}
+
+	report, err = svc.RepairRecurringSeries(context.Background(), "u1", series.ID, true)
+	if err != nil {
+		t.Fatalf("RepairRecurringSeries error: %v", err)
+	}
+	if report.Repaired != 1 || !report.Findings[0].Repaired || len(deleted) != 1 || deleted[0] != stray.ID {
+		t.Fatalf("apply report = %+v, deleted = %v", report, deleted)
+	}
+}
+
+func TestServiceListOccurrences_ServesCachedWeek(t *testing.T) {
+	now := time.Date(2026, 3, 2, 10, 0, 0, 0, time.UTC)
+	occ := domain.RecurringOccurrence{StartTime: now.Add(24 * time.Hour), EndTime: now.Add(25 * time.Hour)}
+	var loads []time.Time
+	var version int64 = 4
+	svc := NewService(&fakeRepo{
+		listOccurrences: func(ctx context.Context, userID string, windowStart, windowEnd time.Time) ([]domain.RecurringOccurrence, error) {
+			loads = append(loads, windowStart)
+			return []domain.RecurringOccurrence{occ}, nil
+		},
+		calendarVersion: func(ctx context.Context, userID string) (int64, error) {
+			return version, nil
+		},
+	})
+	svc.now = func() time.Time { return now }
+	svc.EnableOccurrenceCache(time.Minute)
+
+	for range 2 {
+		got, err := svc.ListOccurrences(context.Background(), "u1", now, now.Add(7*24*time.Hour))
+		if err != nil {
+			t.Fatalf("ListOccurrences error: %v", err)
+		}
+		if len(got) != 1 {
+			t.Fatalf("occurrences = %d, want 1", len(got))
+		}
+	}
+	if len(loads) != 1 || !loads[0].Equal(time.Date(2026, 3, 2, 0, 0, 0, 0, time.UTC)) {
+		t.Fatalf("loads = %v, want one load of the week starting 2026-03-02", loads)
+	}
+
+	got, err := svc.ListOccurrences(context.Background(), "u1", now, now.Add(time.Hour))
+	if err != nil || len(got) != 0 {
+		t.Fatalf("narrow window = %v, %v, want no occurrences from cache", got, err)
+	}
+
+	if _, err := svc.ListOccurrences(context.Background(), "u1", now, now.Add(30*24*time.Hour)); err != nil {
+		t.Fatalf("ListOccurrences error: %v", err)
+	}
+	if len(loads) != 2 {
+		t.Fatalf("loads = %d, want a read-through for a window past the cached week", len(loads))
+	}
+
+	svc.invalidateOccurrences("u1")
+	if _, err := svc.ListOccurrences(context.Background(), "u1", now, now.Add(time.Hour)); err != nil {
+		t.Fatalf("ListOccurrences error: %v", err)
+	}
+	if len(loads) != 3 {
+		t.Fatalf("loads = %d, want a reload after invalidation", len(loads))
+	}
+
+	// A write through another instance only shows up as a new version.
+	version++
+	if _, err := svc.ListOccurrences(context.Background(), "u1", now, now.Add(time.Hour)); err != nil {
+		t.Fatalf("ListOccurrences error: %v", err)
+	}
+	if len(loads) != 4 {
+		t.Fatalf("loads = %d, want a reload after the calendar version moved", len(loads))
+	}
+
+	refreshed, err := svc.RefreshOccurrenceCache(context.Background())
+	if err != nil || refreshed != 1 {
+		t.Fatalf("RefreshOccurrenceCache = %d, %v, want 1 user", refreshed, err)
+	}
+	now = now.Add(25 * time.Hour)
+	if refreshed, _ := svc.RefreshOccurrenceCache(context.Background()); refreshed != 0 {
+		t.Fatalf("refreshed = %d, want idle users dropped", refreshed)
+	}
+}
+
+func TestServiceCreate_DelegatedActorNeedsGrant(t *testing.T) {
+	var got domain.Appointment
+	granted := map[string]bool{"assistant": true}
+	svc := NewService(&fakeRepo{
+		createFn: func(ctx context.Context, appt domain.Appointment) (domain.Appointment, error) {
+			got = appt
+			return appt, nil
+		},
+		hasDelegation: func(ctx context.Context, principalID, delegateID string) (bool, error) {
+			if principalID != "boss" {
+				t.Fatalf("principal = %q, want boss", principalID)
+			}
+			return granted[delegateID], nil
+		},
+	})
+	in := CreateInput{
+		UserID:    "boss",
+		Title:     "1:1",
+		StartTime: time.Date(2026, 1, 1, 10, 0, 0, 0, time.UTC),
+		EndTime:   time.Date(2026, 1, 1, 11, 0, 0, 0, time.UTC),
+	}
+
+	in.ActorID = "assistant"
+	if _, err := svc.Create(context.Background(), in); err != nil {
+		t.Fatalf("Create error: %v", err)
+	}
+	if got.CreatedBy != "assistant" {
+		t.Fatalf("created_by = %q, want assistant", got.CreatedBy)
+	}
+
+	in.ActorID = "boss"
+	if _, err := svc.Create(context.Background(), in); err != nil {
+		t.Fatalf("Create error: %v", err)
+	}
+	if got.CreatedBy != "" {
+		t.Fatalf("created_by = %q, want empty when users act for themselves", got.CreatedBy)
+	}
+
+	in.ActorID = "intern"
+	if _, err := svc.Create(context.Background(), in); !errors.Is(err, ErrNotAuthorized) {
+		t.Fatalf("error = %v, want ErrNotAuthorized", err)
+	}
+	err := svc.Delete(context.Background(), DeleteInput{UserID: "boss", AppointmentID: uuid.New(), ActorID: "intern"})
+	if !errors.Is(err, ErrNotAuthorized) {
+		t.Fatalf("delete error = %v, want ErrNotAuthorized", err)
+	}
+
+	var vErr *ValidationError
+	if _, err := svc.GrantDelegation(context.Background(), "boss", " boss "); !errors.As(err, &vErr) {
+		t.Fatalf("self grant error = %v, want *ValidationError", err)
+	}
+}
+
+func TestServiceCreate_PrivateNotesStayWithOwner(t *testing.T) {
+	stored := domain.Appointment{UserID: "boss", Title: "1:1", PrivateNotes: "Raise the reorg."}
+	svc := NewService(&fakeRepo{
+		createFn: func(ctx context.Context, appt domain.Appointment) (domain.Appointment, error) {
+			// Every create replays the stored appointment, as an idempotent
+			// retry would.
+			return stored, nil
+		},
+		hasDelegation: func(ctx context.Context, principalID, delegateID string) (bool, error) {
+			return true, nil
+		},
+	})
+	in := CreateInput{
+		UserID:    "boss",
+		Title:     "1:1",
+		StartTime: time.Date(2026, 1, 1, 10, 0, 0, 0, time.UTC),
+		EndTime:   time.Date(2026, 1, 1, 11, 0, 0, 0, time.UTC),
+	}
+
+	appt, err := svc.Create(context.Background(), in)
+	if err != nil || appt.PrivateNotes != stored.PrivateNotes {
+		t.Fatalf("owner private notes = %q, err = %v", appt.PrivateNotes, err)
+	}
+	in.ActorID = "assistant"
+	appt, err = svc.Create(context.Background(), in)
+	if err != nil || appt.PrivateNotes != "" {
+		t.Fatalf("delegate private notes = %q, err = %v", appt.PrivateNotes, err)
+	}
+
+	in.PrivateNotes = "Mine now."
+	var vErr *ValidationError
+	if _, err := svc.Create(context.Background(), in); !errors.As(err, &vErr) {
+		t.Fatalf("delegate writing private notes error = %v, want *ValidationError", err)
+	}
+}
+
+func TestServiceCreate_Blackouts(t *testing.T) {
+	blackouts := []domain.Blackout{
+		{Title: "Offsite", StartTime: time.Date(2026, 1, 2, 0, 0, 0, 0, time.UTC), EndTime: time.Date(2026, 1, 3, 0, 0, 0, 0, time.UTC), Mode: domain.BlackoutModeWarn},
+		{Title: "Holiday", StartTime: time.Date(2026, 1, 5, 0, 0, 0, 0, time.UTC), EndTime: time.Date(2026, 1, 6, 0, 0, 0, 0, time.UTC), Mode: domain.BlackoutModeBlock},
+	}
+	created := 0
+	svc := NewService(&fakeRepo{
+		createFn: func(ctx context.Context, appt domain.Appointment) (domain.Appointment, error) {
+			created++
+			return appt, nil
+		},
+		listBlackouts: func(ctx context.Context, windowStart, windowEnd time.Time) ([]domain.Blackout, error) {
+			var out []domain.Blackout
+			for _, b := range blackouts {
+				if b.Overlaps(windowStart, windowEnd) {
+					out = append(out, b)
+				}
+			}
+			return out, nil
+		},
+	})
+	in := CreateInput{
+		UserID:    "u1",
+		Title:     "Standup",
+		StartTime: time.Date(2026, 1, 2, 9, 0, 0, 0, time.UTC),
+		EndTime:   time.Date(2026, 1, 2, 9, 30, 0, 0, time.UTC),
+	}
+
+	appt, err := svc.Create(context.Background(), in)
+	if err != nil {
+		t.Fatalf("Create error: %v", err)
+	}
+	if len(appt.BlackoutWarnings) != 1 || appt.BlackoutWarnings[0].Title != "Offsite" {
+		t.Fatalf("warnings = %+v, want Offsite", appt.BlackoutWarnings)
+	}
+
+	in.StartTime = time.Date(2026, 1, 5, 9, 0, 0, 0, time.UTC)
+	in.EndTime = time.Date(2026, 1, 5, 9, 30, 0, 0, time.UTC)
+	if _, err := svc.Create(context.Background(), in); !errors.Is(err, ErrBlackout) {
+		t.Fatalf("error = %v, want ErrBlackout", err)
+	}
+	if created != 1 {
+		t.Fatalf("created %d appointments, want the blocked one rejected", created)
+	}
+
+	var vErr *ValidationError
+	_, err = svc.CreateBlackout(context.Background(), CreateBlackoutInput{Title: "x", StartTime: in.EndTime, EndTime: in.StartTime})
+	if !errors.As(err, &vErr) {
+		t.Fatalf("CreateBlackout error = %v, want *ValidationError", err)
+	}
+}
+
+func TestServiceCalendarBundle_RoundTrips(t *testing.T) {
+	until := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+	seriesID := uuid.New()
+	override := "Moved"
+	overrideStart := time.Date(2026, 1, 6, 11, 0, 0, 0, time.UTC)
+	overrideEnd := overrideStart.Add(30 * time.Minute)
+	exported := store.CalendarSnapshot{
+		Appointments: []domain.Appointment{{
+			ID:        uuid.New(),
+			UserID:    "u1",
+			Title:     "Dentist",
+			StartTime: time.Date(2026, 1, 2, 9, 0, 0, 0, time.UTC),
+			EndTime:   time.Date(2026, 1, 2, 10, 0, 0, 0, time.UTC),
+			Timezone:  "Europe/Berlin",
+			Metadata:  map[string]string{"source": "crm"},
+		}},
+		Series: []domain.RecurringSeries{{
+			ID:              seriesID,
+			UserID:          "u1",
+			Title:           "1:1",
+			Timezone:        "UTC",
+			DTStart:         time.Date(2026, 1, 6, 10, 0, 0, 0, time.UTC),
+			DurationSeconds: 1800,
+			Frequency:       domain.RecurrenceFrequencyWeekly,
+			Interval:        1,
+			ByWeekday:       []int16{2},
+			Until:           &until,
+			DSTGap:          domain.DSTGapSkip,
+		}},
+		Exceptions: []domain.RecurringException{{
+			ID:              uuid.New(),
+			SeriesID:        seriesID,
+			OccurrenceStart: time.Date(2026, 1, 6, 10, 0, 0, 0, time.UTC),
+			Kind:            domain.RecurringExceptionKindOverride,
+			OverrideStart:   &overrideStart,
+			OverrideEnd:     &overrideEnd,
+			OverrideTitle:   &override,
+		}},
+	}
+
+	var imported store.CalendarSnapshot
+	svc := NewService(&fakeRepo{
+		exportCalendar: func(ctx context.Context, userID string) (store.CalendarSnapshot, error) {
+			return exported, nil
+		},
+		importCalendar: func(ctx context.Context, userID string, snapshot store.CalendarSnapshot) error {
+			if userID != "u2" {
+				t.Fatalf("import user = %q, want u2", userID)
+			}
+			imported = snapshot
+			return nil
+		},
+	})
+
+	bundle, err := svc.ExportCalendar(context.Background(), "u1")
+	if err != nil {
+		t.Fatalf("ExportCalendar error: %v", err)
+	}
+	res, err := svc.ImportCalendar(context.Background(), ImportCalendarInput{UserID: "u2", Bundle: bundle})
+	if err != nil {
+		t.Fatalf("ImportCalendar error: %v", err)
+	}
+	if res != (ImportCalendarResult{Appointments: 1, Series: 1, Exceptions: 1}) {
+		t.Fatalf("result = %+v", res)
+	}
+	if imported.Appointments[0].ID != exported.Appointments[0].ID || imported.Appointments[0].Metadata["source"] != "crm" {
+		t.Fatalf("appointment = %+v", imported.Appointments[0])
+	}
+	if imported.Series[0].DSTGap != domain.DSTGapSkip || imported.Exceptions[0].SeriesID != seriesID || *imported.Exceptions[0].OverrideTitle != "Moved" {
+		t.Fatalf("series = %+v, exception = %+v", imported.Series[0], imported.Exceptions[0])
+	}
+
+	tampered := bytes.Replace(bundle, []byte("Dentist"), []byte("Dentisx"), 1)
+	var vErr *ValidationError
+	if _, err := svc.ImportCalendar(context.Background(), ImportCalendarInput{UserID: "u2", Bundle: tampered}); !errors.As(err, &vErr) || vErr.Error() != "bundle checksum mismatch" {
+		t.Fatalf("tampered import error = %v, want checksum mismatch", err)
+	}
+}
+
+func TestServiceCalendarSnapshot_CreateAndRestore(t *testing.T) {
+	appt := domain.Appointment{
+		ID:        uuid.New(),
+		UserID:    "u1",
+		Title:     "Dentist",
+		StartTime: time.Date(2026, 1, 2, 9, 0, 0, 0, time.UTC),
+		EndTime:   time.Date(2026, 1, 2, 10, 0, 0, 0, time.UTC),
+	}
+	deleted := uuid.New()
+	var restored store.CalendarSnapshot
+	blobs := fakeBlobs{}
+	svc := NewService(&fakeRepo{
+		exportCalendar: func(ctx context.Context, userID string) (store.CalendarSnapshot, error) {
+			return store.CalendarSnapshot{Appointments: []domain.Appointment{appt}}, nil
+		},
+		restoreCalendar: func(ctx context.Context, userID string, snapshot store.CalendarSnapshot) (store.CalendarRestore, error) {
+			restored = snapshot
+			return store.CalendarRestore{AppointmentsCreated: []uuid.UUID{appt.ID}, AppointmentsDeleted: []uuid.UUID{deleted}}, nil
+		},
+	})
+
+	if _, err := svc.CreateCalendarSnapshot(context.Background(), CreateCalendarSnapshotInput{UserID: "u1"}); !errors.Is(err, ErrSnapshotsUnavailable) {
+		t.Fatalf("without blobs: error = %v, want ErrSnapshotsUnavailable", err)
+	}
+	svc.SetSnapshotBlobs(blobs)
+
+	info, err := svc.CreateCalendarSnapshot(context.Background(), CreateCalendarSnapshotInput{UserID: "u1", Label: " before import "})
+	if err != nil {
+		t.Fatalf("CreateCalendarSnapshot error: %v", err)
+	}
+	if info.Label != "before import" || info.Appointments != 1 || info.FormatVersion != CalendarBundleVersion || len(blobs[info.BlobKey]) != info.SizeBytes {
+		t.Fatalf("snapshot = %+v", info)
+	}
+
+	var published []string
+	events.Subscribe(svc.Events(), func(ctx context.Context, e events.AppointmentCreated) { published = append(published, "created") })
+	events.Subscribe(svc.Events(), func(ctx context.Context, e events.AppointmentDeleted) { published = append(published, "deleted") })
+	res, err := svc.RestoreCalendarSnapshot(context.Background(), "u1", info.ID)
+	if err != nil {
+		t.Fatalf("RestoreCalendarSnapshot error: %v", err)
+	}
+	if res.AppointmentsCreated != 1 || res.AppointmentsDeleted != 1 || res.Snapshot.ID != info.ID {
+		t.Fatalf("result = %+v", res)
+	}
+	if len(restored.Appointments) != 1 || restored.Appointments[0].ID != appt.ID || restored.Appointments[0].Title != "Dentist" {
+		t.Fatalf("restored = %+v", restored)
+	}
+	if !slices.Equal(published, []string{"created", "deleted"}) {
+		t.Fatalf("published = %v", published)
+	}
+
+	if _, err := svc.RestoreCalendarSnapshot(context.Background(), "u2", info.ID); !errors.Is(err, store.ErrNotFound) {
+		t.Fatalf("other user's snapshot: error = %v, want ErrNotFound", err)
+	}
+	blobs[info.BlobKey] = bytes.Replace(blobs[info.BlobKey], []byte("Dentist"), []byte("Dentisx"), 1)
+	if _, err := svc.RestoreCalendarSnapshot(context.Background(), "u1", info.ID); !errors.Is(err, ErrSnapshotCorrupt) {
+		t.Fatalf("tampered blob: error = %v, want ErrSnapshotCorrupt", err)
+	}
+}
+
+func TestServiceCreateCalendarSnapshot_PrunesOldest(t *testing.T) {
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	blobs := fakeBlobs{}
+	svc := NewService(&fakeRepo{
+		exportCalendar: func(ctx context.Context, userID string) (store.CalendarSnapshot, error) {
+			return store.CalendarSnapshot{}, nil
+		},
+	})
+	svc.now = func() time.Time { return now }
+	svc.SetSnapshotBlobs(blobs)
+
+	var first domain.CalendarSnapshotInfo
+	for i := 0; i <= MaxCalendarSnapshots; i++ {
+		info, err := svc.CreateCalendarSnapshot(context.Background(), CreateCalendarSnapshotInput{UserID: "u1"})
+		if err != nil {
+			t.Fatalf("snapshot %d: %v", i, err)
+		}
+		if i == 0 {
+			first = info
+		}
+		now = now.Add(time.Minute)
+	}
+
+	saved, err := svc.ListCalendarSnapshots(context.Background(), "u1")
+	if err != nil {
+		t.Fatalf("ListCalendarSnapshots error: %v", err)
+	}
+	if len(saved) != MaxCalendarSnapshots || len(blobs) != MaxCalendarSnapshots {
+		t.Fatalf("kept %d records and %d blobs, want %d", len(saved), len(blobs), MaxCalendarSnapshots)
+	}
+	if _, ok := blobs[first.BlobKey]; ok || saved[len(saved)-1].ID == first.ID {
+		t.Fatalf("oldest snapshot was not pruned")
+	}
+}
+
+func TestServiceWatchRecurringSeries_PushesRepairs(t *testing.T) {
+	seriesID := uuid.New()
+	until := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+	series := domain.RecurringSeries{
+		ID:              seriesID,
+		UserID:          "u1",
+		Title:           "1:1",
+		Timezone:        "UTC",
+		DTStart:         time.Date(2026, 1, 6, 10, 0, 0, 0, time.UTC),
+		DurationSeconds: 1800,
+		Frequency:       domain.RecurrenceFrequencyWeekly,
+		Interval:        1,
+		ByWeekday:       []int16{2},
+		Until:           &until,
+	}
+	// A skip on a day the rule never produces is a stale exception that
+	// RepairRecurringSeries removes.
+	var mu sync.Mutex
+	exceptions := []domain.RecurringException{{
+		ID:              uuid.New(),
+		SeriesID:        seriesID,
+		OccurrenceStart: time.Date(2026, 1, 7, 10, 0, 0, 0, time.UTC),
+		Kind:            domain.RecurringExceptionKindSkip,
+	}}
+	svc := NewService(&fakeRepo{
+		getRecurringSeries: func(ctx context.Context, userID string, id uuid.UUID) (domain.RecurringSeries, error) {
+			return series, nil
+		},
+		listSeriesExceptions: func(ctx context.Context, id uuid.UUID) ([]domain.RecurringException, error) {
+			mu.Lock()
+			defer mu.Unlock()
+			return slices.Clone(exceptions), nil
+		},
+		listSeriesOccurrences: func(ctx context.Context, s domain.RecurringSeries, windowStart, windowEnd time.Time) ([]domain.RecurringOccurrence, error) {
+			return nil, nil
+		},
+		deleteExceptions: func(ctx context.Context, id uuid.UUID, ids []uuid.UUID) (int, error) {
+			mu.Lock()
+			defer mu.Unlock()
+			exceptions = nil
+			return len(ids), nil
+		},
+	})
+	svc.watchPoll = time.Hour
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	snaps := make(chan SeriesSnapshot, 4)
+	done := make(chan error, 1)
+	go func() {
+		done <- svc.WatchRecurringSeries(ctx, WatchSeriesInput{
+			UserID:      "u1",
+			SeriesID:    seriesID,
+			WindowStart: series.DTStart,
+			WindowEnd:   until,
+		}, func(s SeriesSnapshot) error {
+			snaps <- s
+			return nil
+		})
+	}()
+
+	if first := <-snaps; len(first.Exceptions) != 1 {
+		t.Fatalf("initial exceptions = %d, want 1", len(first.Exceptions))
+	}
+	report, err := svc.RepairRecurringSeries(context.Background(), "u1", seriesID, true)
+	if err != nil || report.Repaired != 1 {
+		t.Fatalf("RepairRecurringSeries = %+v, %v", report, err)
+	}
+	select {
+	case next := <-snaps:
+		if len(next.Exceptions) != 0 {
+			t.Fatalf("exceptions after repair = %d, want 0", len(next.Exceptions))
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("no snapshot pushed after repair")
+	}
+
+	cancel()
+	if err := <-done; err != nil {
+		t.Fatalf("WatchRecurringSeries error: %v", err)
+	}
+}
+
+func TestServiceListChanges_PagesWithCursor(t *testing.T) {
+	log := make([]domain.CalendarChange, 5)
+	for i := range log {
+		log[i] = domain.CalendarChange{Seq: int64(i + 1), UserID: "u1", EntityType: domain.ChangeEntityAppointment, EntityID: uuid.New(), Op: domain.ChangeOpCreated}
+	}
+	svc := NewService(&fakeRepo{
+		listChanges: func(ctx context.Context, userID string, afterSeq int64, limit int) ([]domain.CalendarChange, error) {
+			var out []domain.CalendarChange
+			for _, c := range log {
+				if c.Seq > afterSeq && len(out) < limit {
+					out = append(out, c)
+				}
+			}
+			return out, nil
+		},
+	})
+
+	page, err := svc.ListChanges(context.Background(), ListChangesInput{UserID: "u1", Limit: 3})
+	if err != nil {
+		t.Fatalf("ListChanges error: %v", err)
+	}
+	if len(page.Changes) != 3 || !page.HasMore {
+		t.Fatalf("first page = %d changes, has_more %v", len(page.Changes), page.HasMore)
+	}
+	page, err = svc.ListChanges(context.Background(), ListChangesInput{UserID: "u1", Cursor: page.NextCursor, Limit: 3})
+	if err != nil {
+		t.Fatalf("ListChanges error: %v", err)
+	}
+	if len(page.Changes) != 2 || page.HasMore || page.Changes[0].Seq != 4 {
+		t.Fatalf("second page = %+v", page)
+	}
+	last := page.NextCursor
+	page, err = svc.ListChanges(context.Background(), ListChangesInput{UserID: "u1", Cursor: last})
+	if err != nil {
+		t.Fatalf("ListChanges error: %v", err)
+	}
+	if len(page.Changes) != 0 || page.NextCursor != last {
+		t.Fatalf("caught-up page = %+v, want no changes and the same cursor", page)
+	}
+
+	var vErr *ValidationError
+	if _, err := svc.ListChanges(context.Background(), ListChangesInput{UserID: "u1", Cursor: "not-a-cursor"}); !errors.As(err, &vErr) {
+		t.Fatalf("bad cursor error = %v, want *ValidationError", err)
+	}
+}
+
+func TestServiceSyncAppointments_ReturnsDeltaSinceToken(t *testing.T) {
+	start := time.Date(2030, 1, 7, 0, 0, 0, 0, time.UTC)
+	end := start.AddDate(0, 0, 7)
+	kept := domain.Appointment{ID: uuid.New(), UserID: "u1", StartTime: start.Add(9 * time.Hour), EndTime: start.Add(10 * time.Hour)}
+	added := domain.Appointment{ID: uuid.New(), UserID: "u1", StartTime: start.Add(11 * time.Hour), EndTime: start.Add(12 * time.Hour)}
+	removed := uuid.New()
+
+	appts := []domain.Appointment{kept}
+	var log []domain.CalendarChange
+	svc := NewService(&fakeRepo{
+		listFn: func(ctx context.Context, userID string, windowStart, windowEnd time.Time, filter store.AppointmentFilter) ([]domain.Appointment, error) {
+			return appts, nil
+		},
+		latestChangeSeq: func(ctx context.Context, userID string) (int64, error) {
+			return int64(len(log)), nil
+		},
+		listChanges: func(ctx context.Context, userID string, afterSeq int64, limit int) ([]domain.CalendarChange, error) {
+			var out []domain.CalendarChange
+			for _, c := range log {
+				if c.Seq > afterSeq && len(out) < limit {
+					out = append(out, c)
+				}
+			}
+			return out, nil
+		},
+	})
+	filter := store.AppointmentFilter{Metadata: map[string]string{"team": "core"}}
+
+	first, err := svc.SyncAppointments(context.Background(), "u1", start, end, filter, "")
+	if err != nil {
+		t.Fatalf("SyncAppointments error: %v", err)
+	}
+	if !first.FullSync || len(first.Appointments) != 1 || first.NextSyncToken == "" {
+		t.Fatalf("first sync = %+v, want full result with a token", first)
+	}
+
+	appts = []domain.Appointment{kept, added}
+	log = []domain.CalendarChange{
+		{Seq: 1, EntityType: domain.ChangeEntityAppointment, EntityID: added.ID, Op: domain.ChangeOpCreated},
+		{Seq: 2, EntityType: domain.ChangeEntityAppointment, EntityID: removed, Op: domain.ChangeOpCreated},
+		{Seq: 3, EntityType: domain.ChangeEntityAppointment, EntityID: removed, Op: domain.ChangeOpDeleted},
+		{Seq: 4, EntityType: domain.ChangeEntitySeries, EntityID: uuid.New(), Op: domain.ChangeOpCreated},
+	}
+	delta, err := svc.SyncAppointments(context.Background(), "u1", start, end, filter, first.NextSyncToken)
+	if err != nil {
+		t.Fatalf("SyncAppointments error: %v", err)
+	}
+	if delta.FullSync || len(delta.Appointments) != 1 || delta.Appointments[0].ID != added.ID {
+		t.Fatalf("delta appointments = %+v, want only the new one", delta)
+	}
+	if len(delta.DeletedIDs) != 1 || delta.DeletedIDs[0] != removed {
+		t.Fatalf("delta deleted = %v, want [%s]", delta.DeletedIDs, removed)
+	}
+
+	caughtUp, err := svc.SyncAppointments(context.Background(), "u1", start, end, filter, delta.NextSyncToken)
+	if err != nil {
+		t.Fatalf("SyncAppointments error: %v", err)
+	}
+	if caughtUp.FullSync || len(caughtUp.Appointments) != 0 || len(caughtUp.DeletedIDs) != 0 {
+		t.Fatalf("caught-up sync = %+v, want empty delta", caughtUp)
+	}
+
+	var vErr *ValidationError
+	if _, err := svc.SyncAppointments(context.Background(), "u1", start, end.AddDate(0, 0, 1), filter, delta.NextSyncToken); !errors.As(err, &vErr) {
+		t.Fatalf("token for another window error = %v, want *ValidationError", err)
+	}
+}
+
+func TestServiceReconcileCalendar_RejectsInvalidAndKeepsOrder(t *testing.T) {
+	start := time.Date(2030, 1, 7, 9, 0, 0, 0, time.UTC)
+	created := uuid.New()
+	edited := uuid.New()
+	base := time.Date(2030, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	var got []store.CalendarMutation
+	svc := NewService(&fakeRepo{
+		reconcileCalendar: func(ctx context.Context, userID string, mutations []store.CalendarMutation) ([]store.MutationOutcome, error) {
+			got = mutations
+			current := domain.Appointment{ID: edited, UserID: userID, Title: "Theirs", UpdatedAt: base.Add(time.Hour)}
+			return []store.MutationOutcome{{}, {Conflict: store.ConflictVersion, Current: &current}}, nil
+		},
+	})
+
+	results, err := svc.ReconcileCalendar(context.Background(), ReconcileInput{
+		UserID: "u1",
+		Mutations: []OfflineMutation{
+			{Kind: store.MutationCreate, AppointmentID: created, Title: " Offline ", StartTime: start, EndTime: start.Add(time.Hour)},
+			{Kind: store.MutationUpdate, AppointmentID: uuid.New(), Title: "No base", StartTime: start, EndTime: start.Add(time.Hour)},
+			{Kind: store.MutationUpdate, AppointmentID: edited, Title: "Mine", StartTime: start, EndTime: start.Add(time.Hour), BaseUpdatedAt: base},
+		},
+	})
+	if err != nil {
+		t.Fatalf("ReconcileCalendar error: %v", err)
+	}
+	if len(got) != 2 || got[0].Appointment.ID != created || got[0].Appointment.Title != "Offline" || got[1].Appointment.ID != edited {
+		t.Fatalf("repo mutations = %+v, want the two valid ones in order", got)
+	}
+	if len(results) != 3 || !results[0].Accepted() {
+		t.Fatalf("results = %+v", results)
+	}
+	if results[1].Rejected != "base_updated_at is required" {
+		t.Fatalf("second result rejected = %q", results[1].Rejected)
+	}
+	if results[2].Conflict != store.ConflictVersion || results[2].Current == nil || results[2].Current.Title != "Theirs" {
+		t.Fatalf("third result = %+v, want version conflict with server copy", results[2])
+	}
+}
+
+func TestServiceCheckIn_DefaultsToNowAndRejectsFutureTimes(t *testing.T) {
+	now := time.Date(2030, 1, 7, 9, 2, 0, 0, time.UTC)
+	id := uuid.New()
+	var gotAt time.Time
+	svc := NewService(&fakeRepo{
+		checkIn: func(ctx context.Context, userID string, appointmentID uuid.UUID, at time.Time) (domain.Appointment, error) {
+			gotAt = at
+			return domain.Appointment{ID: appointmentID, UserID: userID, CheckedInAt: &at}, nil
+		},
+	})
+	svc.now = func() time.Time { return now }
+
+	if _, err := svc.CheckIn(context.Background(), SessionInput{UserID: "u1", AppointmentID: id}); err != nil {
+		t.Fatalf("CheckIn error: %v", err)
+	}
+	if !gotAt.Equal(now) {
+		t.Fatalf("check-in at = %v, want %v", gotAt, now)
+	}
+
+	var vErr *ValidationError
+	_, err := svc.CheckOut(context.Background(), SessionInput{UserID: "u1", AppointmentID: id, At: now.Add(time.Hour)})
+	if !errors.As(err, &vErr) {
+		t.Fatalf("future check-out error = %v, want *ValidationError", err)
+	}
+}
+
+func TestServiceSessionAndProgramWrites_PublishEvents(t *testing.T) {
+	now := time.Date(2030, 1, 7, 9, 2, 0, 0, time.UTC)
+	programID := uuid.New()
+	cancelled := []uuid.UUID{uuid.New(), uuid.New()}
+	svc := NewService(&fakeRepo{
+		checkIn: func(ctx context.Context, userID string, appointmentID uuid.UUID, at time.Time) (domain.Appointment, error) {
+			return domain.Appointment{ID: appointmentID, UserID: userID, CheckedInAt: &at}, nil
+		},
+		checkOut: func(ctx context.Context, userID string, appointmentID uuid.UUID, at time.Time) (domain.Appointment, error) {
+			return domain.Appointment{ID: appointmentID, UserID: userID, CheckedOutAt: &at}, nil
+		},
+		listProgramMembers: func(ctx context.Context, userID string, programID uuid.UUID) (store.ProgramMembers, error) {
+			return store.ProgramMembers{}, nil
+		},
+		cancelProgram: func(ctx context.Context, userID string, programID uuid.UUID, at time.Time) (store.ProgramCancellation, error) {
+			return store.ProgramCancellation{AppointmentsDeleted: cancelled}, nil
+		},
+	})
+	svc.now = func() time.Time { return now }
+	var updated, deleted []uuid.UUID
+	events.Subscribe(svc.Events(), func(ctx context.Context, e events.AppointmentUpdated) { updated = append(updated, e.AppointmentID) })
+	events.Subscribe(svc.Events(), func(ctx context.Context, e events.AppointmentDeleted) { deleted = append(deleted, e.AppointmentID) })
+	ctx := context.Background()
+
+	id := uuid.New()
+	if _, err := svc.CheckIn(ctx, SessionInput{UserID: "u1", AppointmentID: id}); err != nil {
+		t.Fatalf("CheckIn error: %v", err)
+	}
+	if _, err := svc.CheckOut(ctx, SessionInput{UserID: "u1", AppointmentID: id}); err != nil {
+		t.Fatalf("CheckOut error: %v", err)
+	}
+	if len(updated) != 2 || updated[0] != id || updated[1] != id {
+		t.Fatalf("updated events = %v, want two for %s", updated, id)
+	}
+
+	if _, err := svc.CancelProgram(ctx, "u1", programID); err != nil {
+		t.Fatalf("CancelProgram error: %v", err)
+	}
+	if !slices.Equal(deleted, cancelled) {
+		t.Fatalf("deleted events = %v, want %v", deleted, cancelled)
+	}
+}
+
+func TestServiceExportBillableHours_GroupsByClientAndWeek(t *testing.T) {
+	// Monday 2030-01-07 and the following Monday.
+	wk1 := time.Date(2030, 1, 7, 9, 0, 0, 0, time.UTC)
+	wk2 := wk1.AddDate(0, 0, 7)
+	checkIn, checkOut := wk1.Add(24*time.Hour), wk1.Add(24*time.Hour+45*time.Minute)
+	appts := []domain.Appointment{
+		{StartTime: wk1.Add(-2 * time.Hour), EndTime: wk1.Add(time.Hour), Metadata: map[string]string{"client": "acme"}},
+		{StartTime: wk1, EndTime: wk1.Add(time.Hour), Metadata: map[string]string{"client": "acme"}},
+		{StartTime: wk1.Add(24 * time.Hour), EndTime: wk1.Add(25 * time.Hour), Metadata: map[string]string{"client": "acme"}, CheckedInAt: &checkIn, CheckedOutAt: &checkOut},
+		{StartTime: wk1.Add(48 * time.Hour), EndTime: wk1.Add(48*time.Hour + 30*time.Minute)},
+		{StartTime: wk2, EndTime: wk2.Add(2 * time.Hour), Metadata: map[string]string{"client": "acme"}},
+		{StartTime: wk2.Add(24 * time.Hour), EndTime: wk2.Add(25 * time.Hour), Metadata: map[string]string{"client": "globex"}},
+	}
+	svc := NewService(&fakeRepo{
+		listFn: func(ctx context.Context, userID string, windowStart, windowEnd time.Time, filter store.AppointmentFilter) ([]domain.Appointment, error) {
+			return appts, nil
+		},
+	})
+	// Only the globex session is still in the future.
+	svc.now = func() time.Time { return wk2.Add(12 * time.Hour) }
+
+	export, err := svc.ExportBillableHours(context.Background(), BillableHoursInput{
+		UserID:      "u1",
+		WindowStart: wk1.Add(-time.Hour),
+		WindowEnd:   wk2.AddDate(0, 0, 7),
+		Period:      BillablePeriodWeek,
+		Format:      BillableFormatCSV,
+	})
+	if err != nil {
+		t.Fatalf("ExportBillableHours error: %v", err)
+	}
+	want := "client,period_start,appointments,billable_hours\n" +
+		",2030-01-07,1,0.50\n" +
+		"acme,2030-01-07,2,1.75\n" +
+		"acme,2030-01-14,1,2.00\n"
+	if string(export.Data) != want {
+		t.Fatalf("csv =\n%s\nwant\n%s", export.Data, want)
+	}
+
+	var vErr *ValidationError
+	if _, err := svc.ExportBillableHours(context.Background(), BillableHoursInput{UserID: "u1", WindowStart: wk1, WindowEnd: wk2, Period: "day", Format: BillableFormatCSV}); !errors.As(err, &vErr) {
+		t.Fatalf("bad period error = %v, want *ValidationError", err)
+	}
+}
+
+func TestServiceContacts_ValidateFieldsAndLinkedContact(t *testing.T) {
+	svc := NewService(&fakeRepo{
+		getContact: func(ctx context.Context, userID string, contactID uuid.UUID) (domain.Contact, error) {
+			return domain.Contact{}, store.ErrNotFound
+		},
+	})
+
+	for _, in := range []ContactInput{
+		{UserID: "u1", Name: "  "},
+		{UserID: "u1", Name: "Ada", Email: "not-an-email"},
+		{UserID: "u1", Name: "Ada", Email: "Ada <ada@example.com>"},
+		{UserID: "u1", Name: "Ada", Phone: "call me"},
+	} {
+		var vErr *ValidationError
+		if _, err := svc.CreateContact(context.Background(), in); !errors.As(err, &vErr) {
+			t.Fatalf("CreateContact(%+v) error = %v, want *ValidationError", in, err)
+		}
+	}
+
+	contactID := uuid.New()
+	start := time.Now().Add(time.Hour)
+	var vErr *ValidationError
+	_, err := svc.Create(context.Background(), CreateInput{UserID: "u1", Title: "Call", StartTime: start, EndTime: start.Add(time.Hour), ContactID: &contactID})
+	if !errors.As(err, &vErr) {
+		t.Fatalf("Create with unknown contact error = %v, want *ValidationError", err)
+	}
+}
+
+func TestServiceEmbedSlots_VerifiesTokenAndSkipsBusyTime(t *testing.T) {
+	now := time.Date(2030, 1, 7, 8, 0, 0, 0, time.UTC)
+	svc := NewService(&fakeRepo{
+		listFn: func(ctx context.Context, userID string, windowStart, windowEnd time.Time, filter store.AppointmentFilter) ([]domain.Appointment, error) {
+			if userID != "u1" {
+				t.Fatalf("userID = %q, want u1", userID)
+			}
+			return []domain.Appointment{{StartTime: now.Add(2 * time.Hour), EndTime: now.Add(3 * time.Hour)}}, nil
+		},
+		listOccurrences: func(ctx context.Context, userID string, windowStart, windowEnd time.Time) ([]domain.RecurringOccurrence, error) {
+			return nil, nil
+		},
+		listBlackouts: func(ctx context.Context, windowStart, windowEnd time.Time) ([]domain.Blackout, error) {
+			return nil, nil
+		},
+	})
+	svc.now = func() time.Time { return now }
+
+	if _, err := svc.CreateEmbedToken(context.Background(), EmbedTokenInput{UserID: "u1"}); !errors.Is(err, ErrEmbedDisabled) {
+		t.Fatalf("CreateEmbedToken without secret error = %v, want ErrEmbedDisabled", err)
+	}
+	svc.EnableEmbedTokens([]byte("secret"))
+
+	token, err := svc.CreateEmbedToken(context.Background(), EmbedTokenInput{
+		UserID:       "u1",
+		SlotDuration: time.Hour,
+		WorkingHours: &WorkingHoursInput{TimeZone: "UTC", StartMinute: 9 * 60, EndMinute: 13 * 60},
+		TTL:          time.Hour,
+	})
+	if err != nil {
+		t.Fatalf("CreateEmbedToken error: %v", err)
+	}
+
+	avail, err := svc.EmbedSlots(context.Background(), token.Token, now, now.Add(24*time.Hour))
+	if err != nil {
+		t.Fatalf("EmbedSlots error: %v", err)
+	}
+	want := []time.Time{now.Add(time.Hour), now.Add(3 * time.Hour), now.Add(4 * time.Hour)}
+	if len(avail.Slots) != len(want) {
+		t.Fatalf("slots = %+v, want starts %v", avail.Slots, want)
+	}
+	for i := range want {
+		if !avail.Slots[i].Start.Equal(want[i]) {
+			t.Fatalf("slot[%d] = %v, want %v", i, avail.Slots[i].Start, want[i])
+		}
+	}
+
+	if _, err := svc.EmbedSlots(context.Background(), token.Token+"x", now, time.Time{}); !errors.Is(err, ErrInvalidEmbedToken) {
+		t.Fatalf("tampered token error = %v, want ErrInvalidEmbedToken", err)
+	}
+	svc.now = func() time.Time { return now.Add(2 * time.Hour) }
+	if _, err := svc.EmbedSlots(context.Background(), token.Token, time.Time{}, time.Time{}); !errors.Is(err, ErrInvalidEmbedToken) {
+		t.Fatalf("expired token error = %v, want ErrInvalidEmbedToken", err)
+	}
+}
+
+func TestServiceCreate_EnforcesSlotAlignment(t *testing.T) {
+	svc := NewService(&fakeRepo{
+		getUserSettings: func(ctx context.Context, userID string) (domain.UserSettings, error) {
+			return domain.UserSettings{UserID: userID, SlotAlignmentMinutes: 30, SlotTimeZone: "Asia/Kolkata"}, nil
+		},
+		createFn: func(ctx context.Context, appt domain.Appointment) (domain.Appointment, error) {
+			return appt, nil
+		},
+		listBlackouts: func(ctx context.Context, windowStart, windowEnd time.Time) ([]domain.Blackout, error) {
+			return nil, nil
+		},
+	})
+
+	// 09:30 UTC is 15:00 in Kolkata, 09:00 UTC is 14:30; 09:15 UTC is 14:45.
+	for _, tc := range []struct {
+		start time.Time
+		ok    bool
+	}{
+		{time.Date(2030, 1, 7, 9, 30, 0, 0, time.UTC), true},
+		{time.Date(2030, 1, 7, 9, 0, 0, 0, time.UTC), true},
+		{time.Date(2030, 1, 7, 9, 15, 0, 0, time.UTC), false},
+	} {
+		_, err := svc.Create(context.Background(), CreateInput{UserID: "u1", Title: "Call", StartTime: tc.start, EndTime: tc.start.Add(time.Hour)})
+		var vErr *ValidationError
+		if tc.ok && err != nil {
+			t.Fatalf("Create at %v error: %v", tc.start, err)
+		}
+		if !tc.ok && (!errors.As(err, &vErr) || !strings.Contains(err.Error(), "30-minute boundary in Asia/Kolkata")) {
+			t.Fatalf("Create at %v error = %v, want alignment ValidationError", tc.start, err)
+		}
+	}
+
+	var vErr *ValidationError
+	if _, err := svc.UpdateSlotSettings(context.Background(), SlotSettingsInput{UserID: "u1", AlignmentMinutes: 25}); !errors.As(err, &vErr) {
+		t.Fatalf("UpdateSlotSettings(25) error = %v, want *ValidationError", err)
+	}
+}
+
+func TestServiceTimeOff_BlocksOthersAndShowsBusy(t *testing.T) {
+	// Every Friday 13:00-17:00 in Berlin (UTC+1 in January).
+	away := domain.TimeOff{
+		ID:        uuid.New(),
+		UserID:    "u1",
+		Title:     "Friday afternoons",
+		StartTime: time.Date(2030, 1, 4, 12, 0, 0, 0, time.UTC),
+		EndTime:   time.Date(2030, 1, 4, 16, 0, 0, 0, time.UTC),
+		Timezone:  "Europe/Berlin",
+		Interval:  1,
+		ByWeekday: []int16{5},
+	}
+	var created []domain.Appointment
+	svc := NewService(&fakeRepo{
+		listTimeOff: func(ctx context.Context, userID string) ([]domain.TimeOff, error) {
+			return []domain.TimeOff{away}, nil
+		},
+		hasDelegation: func(ctx context.Context, principalID, delegateID string) (bool, error) {
+			return true, nil
+		},
+		createFn: func(ctx context.Context, appt domain.Appointment) (domain.Appointment, error) {
+			created = append(created, appt)
+			return appt, nil
+		},
+		listBlackouts: func(ctx context.Context, windowStart, windowEnd time.Time) ([]domain.Blackout, error) {
+			return nil, nil
+		},
+		listFn: func(ctx context.Context, userID string, windowStart, windowEnd time.Time, filter store.AppointmentFilter) ([]domain.Appointment, error) {
+			return nil, nil
+		},
+		listOccurrences: func(ctx context.Context, userID string, windowStart, windowEnd time.Time) ([]domain.RecurringOccurrence, error) {
+			return nil, nil
+		},
+	})
+	ctx := context.Background()
+	start := time.Date(2030, 1, 18, 14, 0, 0, 0, time.UTC)
+
+	if _, err := svc.ReserveSlot(ctx, ReserveSlotInput{UserID: "u1", StartTime: start, EndTime: start.Add(30 * time.Minute)}); !errors.Is(err, ErrTimeOff) {
+		t.Fatalf("ReserveSlot error = %v, want ErrTimeOff", err)
+	}
+	if _, err := svc.Create(ctx, CreateInput{UserID: "u1", ActorID: "assistant", Title: "Call", StartTime: start, EndTime: start.Add(30 * time.Minute)}); !errors.Is(err, ErrTimeOff) {
+		t.Fatalf("delegated Create error = %v, want ErrTimeOff", err)
+	}
+	if _, err := svc.Create(ctx, CreateInput{UserID: "u1", Title: "Call", StartTime: start, EndTime: start.Add(30 * time.Minute)}); err != nil {
+		t.Fatalf("own Create error: %v", err)
+	}
+	if len(created) != 1 {
+		t.Fatalf("created %d appointments, want 1", len(created))
+	}
+
+	results, err := svc.BatchGetFreeBusy(ctx, []string{"u1"}, time.Date(2030, 1, 14, 0, 0, 0, 0, time.UTC), time.Date(2030, 1, 21, 0, 0, 0, 0, time.UTC))
+	if err != nil {
+		t.Fatalf("BatchGetFreeBusy error: %v", err)
+	}
+	busy := results[0].Busy
+	wantStart := time.Date(2030, 1, 18, 12, 0, 0, 0, time.UTC)
+	if len(busy) != 1 || !busy[0].Start.Equal(wantStart) || !busy[0].End.Equal(wantStart.Add(4*time.Hour)) {
+		t.Fatalf("busy = %+v, want the Friday 12:00-16:00 UTC span", busy)
+	}
+}
+
+func TestServiceDailyBreaks_BlockAvailabilityAndSurviveSlotUpdates(t *testing.T) {
+	stored := domain.UserSettings{UserID: "u1", SlotTimeZone: "Europe/Berlin"}
+	svc := NewService(&fakeRepo{
+		getUserSettings: func(ctx context.Context, userID string) (domain.UserSettings, error) {
+			return stored, nil
+		},
+		updateUserSettings: func(ctx context.Context, settings domain.UserSettings) (domain.UserSettings, error) {
+			stored = settings
+			return settings, nil
+		},
+		listFn: func(ctx context.Context, userID string, windowStart, windowEnd time.Time, filter store.AppointmentFilter) ([]domain.Appointment, error) {
+			return nil, nil
+		},
+		listOccurrences: func(ctx context.Context, userID string, windowStart, windowEnd time.Time) ([]domain.RecurringOccurrence, error) {
+			return nil, nil
+		},
+	})
+	ctx := context.Background()
+
+	var vErr *ValidationError
+	overlapping := []domain.DailyBreak{{StartMinute: 720, EndMinute: 780}, {StartMinute: 750, EndMinute: 800}}
+	if _, err := svc.UpdateDailyBreaks(ctx, "u1", overlapping); !errors.As(err, &vErr) {
+		t.Fatalf("overlapping breaks error = %v, want *ValidationError", err)
+	}
+	if _, err := svc.UpdateDailyBreaks(ctx, "u1", []domain.DailyBreak{{Label: " Lunch ", StartMinute: 720, EndMinute: 780}}); err != nil {
+		t.Fatalf("UpdateDailyBreaks error: %v", err)
+	}
+	if _, err := svc.UpdateSlotSettings(ctx, SlotSettingsInput{UserID: "u1", AlignmentMinutes: 30, TimeZone: "Europe/Berlin"}); err != nil {
+		t.Fatalf("UpdateSlotSettings error: %v", err)
+	}
+	if len(stored.DailyBreaks) != 1 || stored.DailyBreaks[0].Label != "Lunch" {
+		t.Fatalf("breaks after slot update = %+v, want the lunch break kept", stored.DailyBreaks)
+	}
+
+	// Lunch at 12:00 Berlin is 11:00 UTC in January.
+	day := time.Date(2030, 1, 7, 0, 0, 0, 0, time.UTC)
+	results, err := svc.BatchGetFreeBusy(ctx, []string{"u1"}, day.Add(8*time.Hour), day.Add(16*time.Hour))
+	if err != nil {
+		t.Fatalf("BatchGetFreeBusy error: %v", err)
+	}
+	busy := results[0].Busy
+	if len(busy) != 1 || !busy[0].Start.Equal(day.Add(11*time.Hour)) || !busy[0].End.Equal(day.Add(12*time.Hour)) {
+		t.Fatalf("busy = %+v, want 11:00-12:00 UTC", busy)
+	}
+}
+
+func TestServiceCreate_StampsSource(t *testing.T) {
+	var filters []store.AppointmentFilter
+	svc := NewService(&fakeRepo{
+		createFn: func(ctx context.Context, appt domain.Appointment) (domain.Appointment, error) {
+			return appt, nil
+		},
+		listBlackouts: func(ctx context.Context, windowStart, windowEnd time.Time) ([]domain.Blackout, error) {
+			return nil, nil
+		},
+		listFn: func(ctx context.Context, userID string, windowStart, windowEnd time.Time, filter store.AppointmentFilter) ([]domain.Appointment, error) {
+			filters = append(filters, filter)
+			return nil, nil
+		},
+	})
+	ctx := context.Background()
+	start := time.Date(2030, 1, 7, 9, 0, 0, 0, time.UTC)
+
+	manual, err := svc.Create(ctx, CreateInput{UserID: "u1", Title: "Call", StartTime: start, EndTime: start.Add(time.Hour)})
+	if err != nil {
+		t.Fatalf("Create error: %v", err)
+	}
+	synced, err := svc.Create(ctx, CreateInput{UserID: "u1", Title: "Call", StartTime: start, EndTime: start.Add(time.Hour), ExternalRef: &ExternalRef{System: "google", ID: "evt-1"}})
+	if err != nil {
+		t.Fatalf("Create with external ref error: %v", err)
+	}
+	if manual.Source != domain.SourceManual || synced.Source != "sync:google" {
+		t.Fatalf("sources = %q, %q, want manual and sync:google", manual.Source, synced.Source)
+	}
+
+	// Create lists neighbours for its advisories; only the List call counts.
+	filters = nil
+	if _, err := svc.List(ctx, "u1", start, start.Add(time.Hour), store.AppointmentFilter{Source: " sync: "}); err != nil {
+		t.Fatalf("List error: %v", err)
+	}
+	if len(filters) != 1 || filters[0].Source != "sync:" {
+		t.Fatalf("filters = %+v, want trimmed sync: prefix", filters)
+	}
+}
+
+func TestServiceCreate_AdvisesWithoutBlocking(t *testing.T) {
+	start := time.Date(2030, 1, 7, 12, 0, 0, 0, time.UTC)
+	var createdID uuid.UUID
+	svc := NewService(&fakeRepo{
+		createFn: func(ctx context.Context, appt domain.Appointment) (domain.Appointment, error) {
+			appt.ID = uuid.New()
+			createdID = appt.ID
+			return appt, nil
+		},
+		listFn: func(ctx context.Context, userID string, windowStart, windowEnd time.Time, filter store.AppointmentFilter) ([]domain.Appointment, error) {
+			return []domain.Appointment{
+				{ID: createdID, Title: "Review", StartTime: start, EndTime: start.Add(time.Hour)},
+				{ID: uuid.New(), Title: "Standup", StartTime: start.Add(-30 * time.Minute), EndTime: start.Add(-5 * time.Minute)},
+				{ID: uuid.New(), Title: "Breakfast", StartTime: start.Add(-3 * time.Hour), EndTime: start.Add(-2 * time.Hour)},
+			}, nil
+		},
+		listOccurrences: func(ctx context.Context, userID string, windowStart, windowEnd time.Time) ([]domain.RecurringOccurrence, error) {
+			return []domain.RecurringOccurrence{
+				{SeriesID: uuid.New(), Title: "1:1", StartTime: start.Add(time.Hour), EndTime: start.Add(90 * time.Minute)},
+			}, nil
+		},
+		getUserSettings: func(ctx context.Context, userID string) (domain.UserSettings, error) {
+			return domain.UserSettings{UserID: userID, DailyBreaks: []domain.DailyBreak{{Label: "Lunch", StartMinute: 750, EndMinute: 780}}}, nil
+		},
+	})
+
+	appt, err := svc.Create(context.Background(), CreateInput{UserID: "u1", Title: "Review", StartTime: start, EndTime: start.Add(time.Hour)})
+	if err != nil {
+		t.Fatalf("Create error: %v", err)
+	}
+	want := []domain.Warning{
+		{Code: domain.WarningCodeBackToBack, Message: `Starts 5 minutes after "Standup" ends.`},
+		{Code: domain.WarningCodeBackToBack, Message: `Ends right before "1:1" starts.`},
+		{Code: domain.WarningCodeDailyBreak, Message: `Overlaps the daily break "Lunch".`},
+	}
+	if !slices.Equal(appt.Warnings, want) {
+		t.Fatalf("warnings = %+v, want %+v", appt.Warnings, want)
+	}
+}
+
+func TestServiceCreateRecurringSeries_SummarizesAdvisories(t *testing.T) {
+	start := time.Date(2030, 1, 7, 12, 0, 0, 0, time.UTC)
+	seriesID := uuid.New()
+	count := 3
+	svc := NewService(&fakeRepo{
+		createRecurringSeries: func(ctx context.Context, series domain.RecurringSeries) (domain.RecurringSeries, error) {
+			series.ID = seriesID
+			return series, nil
+		},
+		listOccurrences: func(ctx context.Context, userID string, windowStart, windowEnd time.Time) ([]domain.RecurringOccurrence, error) {
+			next := start.AddDate(0, 0, 7)
+			return []domain.RecurringOccurrence{
+				{SeriesID: seriesID, Title: "Sync", StartTime: next, EndTime: next.Add(time.Hour)},
+				{SeriesID: uuid.New(), Title: "1:1", StartTime: next.Add(65 * time.Minute), EndTime: next.Add(95 * time.Minute)},
+			}, nil
+		},
+		getUserSettings: func(ctx context.Context, userID string) (domain.UserSettings, error) {
+			return domain.UserSettings{UserID: userID, DailyBreaks: []domain.DailyBreak{{StartMinute: 750, EndMinute: 780}}}, nil
+		},
+	})
+	svc.now = func() time.Time { return time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC) }
+
+	series, err := svc.CreateRecurringSeries(context.Background(), CreateRecurringSeriesInput{
+		UserID:    "u1",
+		Title:     "Sync",
+		StartTime: start,
+		EndTime:   start.Add(time.Hour),
+		Rule:      RecurrenceRuleInput{Count: &count, TimeZone: "UTC"},
+	})
+	if err != nil {
+		t.Fatalf("CreateRecurringSeries error: %v", err)
+	}
+	want := []domain.Warning{
+		{Code: domain.WarningCodeBackToBack, Message: "1 occurrence falls within 15 minutes of another booking."},
+		{Code: domain.WarningCodeDailyBreak, Message: "3 occurrences fall during a daily break."},
+	}
+	if !slices.Equal(series.Warnings, want) {
+		t.Fatalf("warnings = %+v, want %+v", series.Warnings, want)
+	}
+}
+
+func TestServiceGetDailyAgenda_AnnotatesGapsAndBusyTime(t *testing.T) {
+	loc, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Fatal(err)
+	}
+	at := func(hour, minute int) time.Time { return time.Date(2030, 1, 7, hour, minute, 0, 0, loc) }
+	var gotStart, gotEnd time.Time
+	svc := NewService(&fakeRepo{
+		listFn: func(ctx context.Context, userID string, windowStart, windowEnd time.Time, filter store.AppointmentFilter) ([]domain.Appointment, error) {
+			gotStart, gotEnd = windowStart, windowEnd
+			return []domain.Appointment{
+				{ID: uuid.New(), Title: "Late", StartTime: at(23, 0), EndTime: at(25, 0)},
+				{ID: uuid.New(), Title: "Plan", StartTime: at(9, 0), EndTime: at(10, 0)},
+				{ID: uuid.New(), Title: "Clash", StartTime: at(10, 30), EndTime: at(11, 30)},
+				{ID: uuid.New(), Title: "Launch", StartTime: at(12, 0), EndTime: at(12, 0), Kind: domain.AppointmentKindMilestone},
+				{ID: uuid.New(), Title: "Review", StartTime: at(14, 0), EndTime: at(15, 0)},
+			}, nil
+		},
+		listOccurrences: func(ctx context.Context, userID string, windowStart, windowEnd time.Time) ([]domain.RecurringOccurrence, error) {
+			return []domain.RecurringOccurrence{{SeriesID: uuid.New(), Title: "Standup", StartTime: at(10, 5), EndTime: at(11, 0)}}, nil
+		},
+	})
+
+	agenda, err := svc.GetDailyAgenda(context.Background(), "u1", "2030-01-07", "America/New_York")
+	if err != nil {
+		t.Fatalf("GetDailyAgenda error: %v", err)
+	}
+	if !gotStart.Equal(at(0, 0)) || !gotEnd.Equal(at(24, 0)) {
+		t.Fatalf("window = %v..%v, want the local day", gotStart, gotEnd)
+	}
+	type row struct {
+		title      string
+		gap        time.Duration
+		backToBack bool
+		overlaps   bool
+	}
+	var got []row
+	for _, it := range agenda.Items {
+		got = append(got, row{it.Title, it.GapBefore, it.BackToBack, it.Overlaps})
+	}
+	want := []row{
+		{"Plan", 0, false, false},
+		{"Standup", 5 * time.Minute, true, false},
+		{"Clash", 0, false, true},
+		{"Launch", 0, false, false},
+		{"Review", 150 * time.Minute, false, false},
+		{"Late", 8 * time.Hour, false, false},
+	}
+	if !slices.Equal(got, want) {
+		t.Fatalf("items = %+v, want %+v", got, want)
+	}
+	if want := 4*time.Hour + 25*time.Minute; agenda.BusyTime != want {
+		t.Fatalf("busy time = %v, want %v", agenda.BusyTime, want)
+	}
+
+	var vErr *ValidationError
+	if _, err := svc.GetDailyAgenda(context.Background(), "u1", "07/01/2030", "UTC"); !errors.As(err, &vErr) {
+		t.Fatalf("bad date: err = %v, want validation error", err)
+	}
+}
+
+func TestServiceGetDaySummaries_SummarizesRangeWhenBehind(t *testing.T) {
+	now := time.Date(2030, 1, 7, 15, 0, 0, 0, time.UTC)
+	day := func(d int) time.Time { return time.Date(2030, 1, d, 0, 0, 0, 0, time.UTC) }
+	version := int64(3)
+	build := domain.DaySummaryBuild{UserID: "u1", TimeZone: "UTC", CalendarVersion: 3, WindowStart: day(1), WindowEnd: day(31)}
+	var stored bool
+	var listed [2]time.Time
+	svc := NewService(&fakeRepo{
+		getDaySummaryBuild: func(ctx context.Context, userID string) (domain.DaySummaryBuild, error) {
+			return build, nil
+		},
+		calendarVersion: func(ctx context.Context, userID string) (int64, error) {
+			return version, nil
+		},
+		listDaySummaries: func(ctx context.Context, userID string, from, to time.Time) ([]domain.DaySummary, error) {
+			stored = true
+			return []domain.DaySummary{{UserID: userID, Day: day(8), Appointments: 2}}, nil
+		},
+		listFn: func(ctx context.Context, userID string, windowStart, windowEnd time.Time, filter store.AppointmentFilter) ([]domain.Appointment, error) {
+			listed = [2]time.Time{windowStart, windowEnd}
+			return []domain.Appointment{
+				{ID: uuid.New(), StartTime: day(8).Add(9 * time.Hour), EndTime: day(8).Add(10 * time.Hour)},
+			}, nil
+		},
+		listOccurrences: func(ctx context.Context, userID string, windowStart, windowEnd time.Time) ([]domain.RecurringOccurrence, error) {
+			return nil, nil
+		},
+		replaceDaySummaries: func(ctx context.Context, b domain.DaySummaryBuild, days []domain.DaySummary) (bool, error) {
+			t.Fatalf("GetDaySummaries wrote a build")
+			return false, nil
+		},
+	})
+	svc.now = func() time.Time { return now }
+
+	got, err := svc.GetDaySummaries(context.Background(), "u1", "2030-01-08", "2030-01-09")
+	if err != nil {
+		t.Fatalf("GetDaySummaries error: %v", err)
+	}
+	if !stored || len(got.Days) != 1 || got.Days[0].Appointments != 2 {
+		t.Fatalf("current build: stored=%v days=%+v, want the stored summary", stored, got.Days)
+	}
+
+	version, stored = 4, false
+	got, err = svc.GetDaySummaries(context.Background(), "u1", "2030-01-08", "2030-01-09")
+	if err != nil {
+		t.Fatalf("GetDaySummaries error: %v", err)
+	}
+	if stored || len(got.Days) != 1 || got.Days[0].Appointments != 1 || got.Days[0].BusySeconds != 3600 {
+		t.Fatalf("stale build: stored=%v days=%+v, want a fresh summary of the 8th", stored, got.Days)
+	}
+	// Only the requested days are read, not the whole build window.
+	if !listed[0].Equal(day(8)) || !listed[1].Equal(day(10)) {
+		t.Fatalf("read %v to %v, want the 8th and 9th only", listed[0], listed[1])
+	}
+
+	var vErr *ValidationError
+	if _, err := svc.GetDaySummaries(context.Background(), "u1", "2030-01-01", "2030-06-01"); !errors.As(err, &vErr) {
+		t.Fatalf("long range: err = %v, want validation error", err)
+	}
+}
+
+func TestServiceProposeAppointment_ExpiresNoLaterThanStart(t *testing.T) {
+	now := time.Date(2030, 1, 7, 9, 0, 0, 0, time.UTC)
+	var got domain.AppointmentProposal
+	svc := NewService(&fakeRepo{
+		createProposal: func(ctx context.Context, proposal domain.AppointmentProposal) (domain.AppointmentProposal, error) {
+			got = proposal
+			return proposal, nil
+		},
+	})
+	svc.now = func() time.Time { return now }
+	svc.SetTimePolicy(timepolicy.Policy{Now: svc.now})
+
+	in := ProposeAppointmentInput{ProposerID: "alice", RecipientID: "bob", Title: " Intro ", StartTime: now.Add(3 * time.Hour), EndTime: now.Add(4 * time.Hour)}
+	if _, err := svc.ProposeAppointment(context.Background(), in); err != nil {
+		t.Fatalf("ProposeAppointment error: %v", err)
+	}
+	if got.Title != "Intro" || !got.ExpiresAt.Equal(in.StartTime) {
+		t.Fatalf("proposal = %+v, want trimmed title and expiry at start", got)
+	}
+
+	in.StartTime = now.Add(7 * 24 * time.Hour)
+	in.EndTime = in.StartTime.Add(time.Hour)
+	if _, err := svc.ProposeAppointment(context.Background(), in); err != nil {
+		t.Fatalf("ProposeAppointment error: %v", err)
+	}
+	if want := now.Add(DefaultProposalTTL); !got.ExpiresAt.Equal(want) {
+		t.Fatalf("expires_at = %v, want %v", got.ExpiresAt, want)
+	}
+
+	var vErr *ValidationError
+	in.RecipientID = "alice"
+	if _, err := svc.ProposeAppointment(context.Background(), in); !errors.As(err, &vErr) {
+		t.Fatalf("self proposal: err = %v, want validation error", err)
+	}
+}
+
+func TestServiceAcceptProposal_BooksBothCalendars(t *testing.T) {
+	now := time.Date(2030, 1, 7, 9, 0, 0, 0, time.UTC)
+	proposal := domain.AppointmentProposal{
+		ID:          uuid.New(),
+		ProposerID:  "alice",
+		RecipientID: "bob",
+		Title:       "Intro",
+		Status:      domain.ProposalStatusPending,
+		ExpiresAt:   now.Add(time.Hour),
+	}
+	var booked []domain.Appointment
+	svc := NewService(&fakeRepo{
+		getProposal: func(ctx context.Context, proposalID uuid.UUID) (domain.AppointmentProposal, error) {
+			return proposal, nil
+		},
+		acceptProposal: func(ctx context.Context, proposalID uuid.UUID, proposerAppt, recipientAppt domain.Appointment, at time.Time) (domain.AppointmentProposal, error) {
+			booked = []domain.Appointment{proposerAppt, recipientAppt}
+			if !proposal.Open(at) {
+				return proposal, nil
+			}
+			accepted := proposal
+			accepted.Status = domain.ProposalStatusAccepted
+			return accepted, nil
+		},
+	})
+	svc.now = func() time.Time { return now }
+	ctx := context.Background()
+
+	if _, err := svc.AcceptProposal(ctx, "alice", proposal.ID); !errors.Is(err, ErrNotAuthorized) {
+		t.Fatalf("proposer accept: err = %v, want ErrNotAuthorized", err)
+	}
+	if _, err := svc.AcceptProposal(ctx, "carol", proposal.ID); !errors.Is(err, store.ErrNotFound) {
+		t.Fatalf("stranger accept: err = %v, want ErrNotFound", err)
+	}
+
+	accepted, err := svc.AcceptProposal(ctx, "bob", proposal.ID)
+	if err != nil || accepted.Status != domain.ProposalStatusAccepted {
+		t.Fatalf("accept = %+v, %v", accepted, err)
+	}
+	if len(booked) != 2 || booked[0].UserID != "alice" || booked[1].UserID != "bob" || booked[0].ID == booked[1].ID ||
+		booked[0].Source != domain.SourceProposal || booked[1].Title != "Intro" {
+		t.Fatalf("booked = %+v", booked)
+	}
+
+	now = now.Add(2 * time.Hour)
+	if _, err := svc.AcceptProposal(ctx, "bob", proposal.ID); !errors.Is(err, ErrProposalClosed) {
+		t.Fatalf("expired accept: err = %v, want ErrProposalClosed", err)
+	}
 }
 
-func (f *fakeRepo) ListOccurrences(ctx context.Context, userID string, windowStart, windowEnd time.Time) ([]domain.RecurringOccurrence, error) {
-	if f.listOccurrences == nil {
-		panic("ListOccurrences not configured")
+func TestServiceAppointmentsAddedSince_HoldsPagesToSnapshot(t *testing.T) {
+	created, edited, removed, existing := uuid.New(), uuid.New(), uuid.New(), uuid.New()
+	var log []domain.CalendarChange
+	svc := NewService(&fakeRepo{
+		listChanges: func(ctx context.Context, userID string, afterSeq int64, limit int) ([]domain.CalendarChange, error) {
+			var out []domain.CalendarChange
+			for _, c := range log {
+				if c.Seq > afterSeq && len(out) < limit {
+					out = append(out, c)
+				}
+			}
+			return out, nil
+		},
+	})
+	change := func(id uuid.UUID, op domain.ChangeOp) {
+		log = append(log, domain.CalendarChange{Seq: int64(len(log) + 1), EntityType: domain.ChangeEntityAppointment, EntityID: id, Op: op})
+	}
+
+	change(existing, domain.ChangeOpCreated)
+	change(created, domain.ChangeOpCreated)
+	change(edited, domain.ChangeOpCreated)
+	change(edited, domain.ChangeOpUpdated)
+	change(removed, domain.ChangeOpDeleted)
+	log = append(log, domain.CalendarChange{Seq: int64(len(log) + 1), EntityType: domain.ChangeEntitySeries, EntityID: uuid.New(), Op: domain.ChangeOpUpdated})
+
+	added, err := svc.AppointmentsAddedSince(context.Background(), "u1", 1)
+	if err != nil {
+		t.Fatalf("AppointmentsAddedSince error: %v", err)
+	}
+	if len(added) != 2 || !added[created] || !added[edited] {
+		t.Fatalf("added = %v, want %s and %s", added, created, edited)
+	}
+
+	// existing was on the first page's snapshot; an update may have moved it
+	// across the page boundary.
+	change(existing, domain.ChangeOpUpdated)
+	if _, err := svc.AppointmentsAddedSince(context.Background(), "u1", 1); !errors.Is(err, ErrPageSnapshotStale) {
+		t.Fatalf("after update err = %v, want ErrPageSnapshotStale", err)
+	}
+
+	log = nil
+	for range MaxPageSnapshotDelta + 1 {
+		change(uuid.New(), domain.ChangeOpCreated)
+	}
+	if _, err := svc.AppointmentsAddedSince(context.Background(), "u1", 0); !errors.Is(err, ErrPageSnapshotStale) {
+		t.Fatalf("long log err = %v, want ErrPageSnapshotStale", err)
 	}
-	return f.listOccurrences(ctx, userID, windowStart, windowEnd)
 }
 
-func TestServiceCreate_ValidationErrorType(t *testing.T) {
+func TestServicePurgeExpiredAppointments_BatchesAndDryRuns(t *testing.T) {
+	now := time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC)
+	cutoff := now.AddDate(-2, 0, 0)
+	remaining := PurgeBatchSize + 3
+	var gotDays int
+	var batches []int
 	svc := NewService(&fakeRepo{
-		createFn: func(ctx context.Context, appt domain.Appointment) (domain.Appointment, error) {
-			return appt, nil
+		expiredAppointments: func(ctx context.Context, at time.Time, defaultDays int) ([]store.RetentionPurge, error) {
+			gotDays = defaultDays
+			return []store.RetentionPurge{{UserID: "u1", Cutoff: cutoff, Count: remaining}}, nil
+		},
+		purgeAppointments: func(ctx context.Context, userID string, before time.Time, limit int) ([]uuid.UUID, error) {
+			if userID != "u1" || !before.Equal(cutoff) {
+				t.Fatalf("purge(%q, %v), want u1 before %v", userID, before, cutoff)
+			}
+			n := min(limit, remaining)
+			remaining -= n
+			batches = append(batches, n)
+			ids := make([]uuid.UUID, n)
+			for i := range ids {
+				ids[i] = uuid.New()
+			}
+			return ids, nil
 		},
 	})
+	deleted := 0
+	events.Subscribe(svc.Events(), func(ctx context.Context, e events.AppointmentDeleted) { deleted++ })
+	svc.now = func() time.Time { return now }
+	svc.SetRetentionDays(730)
 
-	_, err := svc.Create(context.Background(), CreateInput{
-		UserID:    "",
-		Title:     "x",
-		StartTime: time.Date(2026, 1, 1, 10, 0, 0, 0, time.UTC),
-		EndTime:   time.Date(2026, 1, 1, 11, 0, 0, 0, time.UTC),
-	})
-	if err == nil {
-		t.Fatalf("expected error")
+	preview, err := svc.PurgeExpiredAppointments(context.Background(), true)
+	if err != nil {
+		t.Fatalf("dry run error: %v", err)
 	}
-	var vErr *ValidationError
-	if !errors.As(err, &vErr) {
-		t.Fatalf("error type = %T, want *ValidationError", err)
+	if gotDays != 730 || len(preview) != 1 || preview[0].Count != PurgeBatchSize+3 || len(batches) != 0 {
+		t.Fatalf("dry run = %+v with %d default days, %d batches deleted", preview, gotDays, len(batches))
 	}
-	if vErr.Error() != "user_id is required" {
-		t.Fatalf("error = %q, want %q", vErr.Error(), "user_id is required")
+
+	purged, err := svc.PurgeExpiredAppointments(context.Background(), false)
+	if err != nil {
+		t.Fatalf("PurgeExpiredAppointments error: %v", err)
+	}
+	if len(purged) != 1 || purged[0].Count != PurgeBatchSize+3 {
+		t.Fatalf("purged = %+v, want %d for u1", purged, PurgeBatchSize+3)
+	}
+	if want := []int{PurgeBatchSize, 3}; !slices.Equal(batches, want) {
+		t.Fatalf("batches = %v, want %v", batches, want)
+	}
+	if deleted != PurgeBatchSize+3 {
+		t.Fatalf("%d deleted events, want %d", deleted, PurgeBatchSize+3)
 	}
 }
 
-func TestServiceCreate_TrimsTitleAndNormalizesTimesToUTC(t *testing.T) {
-	loc, err := time.LoadLocation("America/Los_Angeles")
+func TestServiceRequestPolicy_CachesAndScalesLimits(t *testing.T) {
+	stored := map[string]domain.UserSettings{}
+	reads := 0
+	svc := NewServiceWithLimits(&fakeRepo{
+		getUserSettings: func(ctx context.Context, userID string) (domain.UserSettings, error) {
+			reads++
+			if s, ok := stored[userID]; ok {
+				return s, nil
+			}
+			return domain.UserSettings{UserID: userID}, nil
+		},
+		updateUserSettings: func(ctx context.Context, settings domain.UserSettings) (domain.UserSettings, error) {
+			stored[settings.UserID] = settings
+			return settings, nil
+		},
+	}, limits.Limits{MaxTitleLength: 10, MaxNotesLength: 100, MaxMetadataEntries: 4, MaxMessageBytes: 1 << 20})
+	svc.EnableRequestPolicyCache(time.Minute)
+
+	policy, err := svc.RequestPolicy(context.Background(), "premium")
+	if err != nil || policy.Timeout != 0 || policy.Limits.MaxTitleLength != 10 {
+		t.Fatalf("default policy = %+v, err = %v", policy, err)
+	}
+	if _, err := svc.RequestPolicy(context.Background(), "premium"); err != nil || reads != 1 {
+		t.Fatalf("cached lookup: reads = %d, err = %v", reads, err)
+	}
+
+	timeout, multiplier := 30*time.Second, 3
+	if _, _, err := svc.UpdateRequestPolicy(context.Background(), "premium", &timeout, &multiplier); err != nil {
+		t.Fatalf("UpdateRequestPolicy error: %v", err)
+	}
+	// The update drops the cached entry, so the next lookup sees it.
+	policy, err = svc.RequestPolicy(context.Background(), "premium")
 	if err != nil {
-		t.Fatalf("LoadLocation error: %v", err)
+		t.Fatalf("RequestPolicy error: %v", err)
+	}
+	if policy.Timeout != 30*time.Second || policy.Limits.MaxTitleLength != 30 || policy.Limits.MaxMetadataEntries != 12 {
+		t.Fatalf("premium policy = %+v", policy)
+	}
+	if policy.Limits.MaxMessageBytes != 1<<20 {
+		t.Fatalf("MaxMessageBytes = %d, want unscaled", policy.Limits.MaxMessageBytes)
 	}
 
-	var got domain.Appointment
+	// A title over the server's limit fits within the user's.
+	ctx := limits.NewContext(context.Background(), policy.Limits)
+	if err := svc.checkText(ctx, strings.Repeat("x", 20), ""); err != nil {
+		t.Fatalf("checkText with premium limits: %v", err)
+	}
+	if err := svc.checkText(context.Background(), strings.Repeat("x", 20), ""); err == nil {
+		t.Fatal("checkText with server limits: want error")
+	}
+
+	for _, tt := range []struct {
+		timeout    time.Duration
+		multiplier int
+	}{
+		{1500 * time.Millisecond, 1},
+		{MaxRequestTimeout + time.Second, 1},
+		{time.Minute, 0},
+		{time.Minute, MaxLimitsMultiplier + 1},
+	} {
+		var vErr *ValidationError
+		if _, _, err := svc.UpdateRequestPolicy(context.Background(), "premium", &tt.timeout, &tt.multiplier); !errors.As(err, &vErr) {
+			t.Fatalf("UpdateRequestPolicy(%v, %d) error = %v, want validation error", tt.timeout, tt.multiplier, err)
+		}
+	}
+}
+
+func TestServiceCreateRecurringSeries_WeekdayTimes(t *testing.T) {
+	// A Monday.
+	start := time.Date(2026, 1, 5, 9, 0, 0, 0, time.UTC)
+	count := 4
+	var saved domain.RecurringSeries
 	svc := NewService(&fakeRepo{
-		createFn: func(ctx context.Context, appt domain.Appointment) (domain.Appointment, error) {
-			got = appt
-			return appt, nil
+		createRecurringSeries: func(ctx context.Context, series domain.RecurringSeries) (domain.RecurringSeries, error) {
+			saved = series
+			return series, nil
 		},
 	})
+	svc.now = func() time.Time { return start.Add(-time.Hour) }
 
-	startLocal := time.Date(2026, 1, 10, 9, 0, 0, 0, loc)
-	endLocal := time.Date(2026, 1, 10, 10, 0, 0, 0, loc)
+	create := func(weekdays []int16, duration time.Duration, times ...domain.WeekdayTime) error {
+		_, err := svc.CreateRecurringSeries(context.Background(), CreateRecurringSeriesInput{
+			UserID:    "u1",
+			Title:     "t",
+			StartTime: start,
+			EndTime:   start.Add(duration),
+			Rule:      RecurrenceRuleInput{ByWeekday: weekdays, Count: &count, TimeZone: "UTC", WeekdayTimes: times},
+		})
+		return err
+	}
 
-	_, err = svc.Create(context.Background(), CreateInput{
-		UserID:    "u1",
-		Title:     "  hello  ",
-		StartTime: startLocal,
-		EndTime:   endLocal,
+	if err := create([]int16{3, 1}, time.Hour, domain.WeekdayTime{Weekday: 3, StartMinute: 14 * 60}); err != nil {
+		t.Fatalf("CreateRecurringSeries error: %v", err)
+	}
+	if len(saved.WeekdayTimes) != 1 || saved.WeekdayTimes[0] != (domain.WeekdayTime{Weekday: 3, StartMinute: 14 * 60}) {
+		t.Fatalf("saved weekday times = %v", saved.WeekdayTimes)
+	}
+
+	invalid := []struct {
+		name     string
+		weekdays []int16
+		duration time.Duration
+		times    []domain.WeekdayTime
+	}{
+		{"weekday not in rule", []int16{1, 3}, time.Hour, []domain.WeekdayTime{{Weekday: 5, StartMinute: 600}}},
+		{"weekday repeated", []int16{1, 3}, time.Hour, []domain.WeekdayTime{{Weekday: 3, StartMinute: 600}, {Weekday: 3, StartMinute: 660}}},
+		{"minute out of range", []int16{1, 3}, time.Hour, []domain.WeekdayTime{{Weekday: 3, StartMinute: domain.MinutesPerDay}}},
+		{"disagrees with start_time", []int16{1, 3}, time.Hour, []domain.WeekdayTime{{Weekday: 1, StartMinute: 600}}},
+		// Monday 9:00 for 20 hours runs past Tuesday 4:00.
+		{"overlapping occurrences", []int16{1, 2}, 20 * time.Hour, []domain.WeekdayTime{{Weekday: 2, StartMinute: 4 * 60}}},
+	}
+	for _, tt := range invalid {
+		var vErr *ValidationError
+		if err := create(tt.weekdays, tt.duration, tt.times...); !errors.As(err, &vErr) {
+			t.Fatalf("%s: error = %v, want validation error", tt.name, err)
+		}
+	}
+}
+
+func TestServiceGetProgram_CountsSessions(t *testing.T) {
+	programID := uuid.MustParse("00000000-0000-0000-0000-0000000000a1")
+	// Three weekly classes from Monday 2026-01-05 and an exam; it is now
+	// during the second class.
+	dtstart := time.Date(2026, 1, 5, 10, 0, 0, 0, time.UTC)
+	count := 3
+	series := domain.RecurringSeries{
+		ID: uuid.MustParse("00000000-0000-0000-0000-0000000000b1"), UserID: "u1", Timezone: "UTC", DTStart: dtstart,
+		DurationSeconds: 3600, Frequency: domain.RecurrenceFrequencyWeekly, Interval: 1, ByWeekday: []int16{1}, Count: &count,
+	}
+	exam := domain.Appointment{UserID: "u1", StartTime: dtstart.AddDate(0, 0, 21), EndTime: dtstart.AddDate(0, 0, 21).Add(2 * time.Hour)}
+	svc := NewService(&fakeRepo{
+		getProgram: func(ctx context.Context, userID string, id uuid.UUID) (domain.Program, error) {
+			return domain.Program{ID: id, UserID: userID, Title: "course"}, nil
+		},
+		listProgramMembers: func(ctx context.Context, userID string, id uuid.UUID) (store.ProgramMembers, error) {
+			return store.ProgramMembers{Appointments: []domain.Appointment{exam}, Series: []domain.RecurringSeries{series}}, nil
+		},
+		listSeriesOccurrences: func(ctx context.Context, s domain.RecurringSeries, windowStart, windowEnd time.Time) ([]domain.RecurringOccurrence, error) {
+			return domain.GenerateWeeklyOccurrences(s, windowStart, windowEnd)
+		},
 	})
+	svc.now = func() time.Time { return dtstart.AddDate(0, 0, 7).Add(30 * time.Minute) }
+
+	got, err := svc.GetProgram(context.Background(), "u1", programID)
 	if err != nil {
-		t.Fatalf("Create error: %v", err)
+		t.Fatalf("GetProgram error: %v", err)
 	}
-	if got.Title != "hello" {
-		t.Fatalf("title = %q, want %q", got.Title, "hello")
+	p := got.Progress
+	if p.Total != 4 || p.Completed != 1 || p.Remaining != 3 {
+		t.Fatalf("progress = %+v, want 4 total, 1 completed, 3 remaining", p)
 	}
-	if got.StartTime.Location() != time.UTC || got.EndTime.Location() != time.UTC {
-		t.Fatalf("expected UTC times, got start=%v end=%v", got.StartTime, got.EndTime)
+	if p.NextSession == nil || !p.NextSession.Equal(dtstart.AddDate(0, 0, 14)) {
+		t.Fatalf("next session = %v, want the third class", p.NextSession)
 	}
-	if !got.EndTime.After(got.StartTime) {
-		t.Fatalf("end_time must be after start_time")
+	if len(got.Series) != 1 || got.Series[0].OccurrencesRemaining != 1 {
+		t.Fatalf("series = %+v, want one with 1 occurrence remaining", got.Series)
 	}
 }
 
-func TestServiceCreate_IdempotencyKeyDeterministicUUID(t *testing.T) {
-	var ids []uuid.UUID
+func TestServiceCreate_RejectsCancelledProgram(t *testing.T) {
+	start := time.Date(2026, 1, 5, 10, 0, 0, 0, time.UTC)
+	cancelled := start.Add(-time.Hour)
+	programID := uuid.MustParse("00000000-0000-0000-0000-0000000000a1")
 	svc := NewService(&fakeRepo{
+		getProgram: func(ctx context.Context, userID string, id uuid.UUID) (domain.Program, error) {
+			return domain.Program{ID: id, UserID: userID, CancelledAt: &cancelled}, nil
+		},
 		createFn: func(ctx context.Context, appt domain.Appointment) (domain.Appointment, error) {
-			ids = append(ids, appt.ID)
+			t.Fatalf("Create called for a cancelled program")
 			return appt, nil
 		},
 	})
+	svc.now = func() time.Time { return cancelled }
 
-	start := time.Date(2026, 1, 1, 10, 0, 0, 0, time.UTC)
-	end := start.Add(time.Hour)
+	_, err := svc.Create(context.Background(), CreateInput{UserID: "u1", Title: "t", StartTime: start, EndTime: start.Add(time.Hour), ProgramID: &programID})
+	var vErr *ValidationError
+	if !errors.As(err, &vErr) {
+		t.Fatalf("error = %v, want validation error", err)
+	}
+}
 
-	_, err := svc.Create(context.Background(), CreateInput{
-		UserID:         "u1",
-		Title:          "t",
-		StartTime:      start,
-		EndTime:        end,
-		IdempotencyKey: "k1",
+func TestServiceDeactivateUser_FreezesCalendar(t *testing.T) {
+	start := time.Date(2026, 1, 5, 10, 0, 0, 0, time.UTC)
+	users := map[string]domain.ProvisionedUser{}
+	svc := NewService(&fakeRepo{
+		getProvisionedUser: func(ctx context.Context, userID string) (domain.ProvisionedUser, error) {
+			u, ok := users[userID]
+			if !ok {
+				return domain.ProvisionedUser{}, store.ErrNotFound
+			}
+			return u, nil
+		},
+		upsertProvisionedUser: func(ctx context.Context, user domain.ProvisionedUser) (domain.ProvisionedUser, error) {
+			users[user.UserID] = user
+			return user, nil
+		},
+		hasDelegation: func(ctx context.Context, principalID, delegateID string) (bool, error) {
+			return true, nil
+		},
+		listFn: func(ctx context.Context, userID string, windowStart, windowEnd time.Time, filter store.AppointmentFilter) ([]domain.Appointment, error) {
+			return nil, nil
+		},
+		createFn: func(ctx context.Context, appt domain.Appointment) (domain.Appointment, error) {
+			return appt, nil
+		},
+		deleteFn: func(ctx context.Context, userID string, appointmentID uuid.UUID) error {
+			return nil
+		},
+		listOccurrences: func(ctx context.Context, userID string, windowStart, windowEnd time.Time) ([]domain.RecurringOccurrence, error) {
+			return nil, nil
+		},
+		listBlackouts: func(ctx context.Context, windowStart, windowEnd time.Time) ([]domain.Blackout, error) {
+			return nil, nil
+		},
 	})
+	svc.now = func() time.Time { return start.Add(-time.Hour) }
+	svc.EnableEmbedTokens([]byte("secret"))
+	ctx := context.Background()
+	create := CreateInput{UserID: "u1", Title: "t", StartTime: start, EndTime: start.Add(time.Hour)}
+
+	token, err := svc.CreateEmbedToken(ctx, EmbedTokenInput{UserID: "u1"})
 	if err != nil {
-		t.Fatalf("Create error: %v", err)
+		t.Fatalf("CreateEmbedToken error: %v", err)
 	}
-	_, err = svc.Create(context.Background(), CreateInput{
-		UserID:         "u1",
-		Title:          "t",
-		StartTime:      start,
-		EndTime:        end,
-		IdempotencyKey: "k1",
-	})
-	if err != nil {
-		t.Fatalf("Create error: %v", err)
+	// Users that were never provisioned can be frozen too.
+	if _, err := svc.DeactivateUser(ctx, "u1"); err != nil {
+		t.Fatalf("DeactivateUser error: %v", err)
 	}
-	if len(ids) != 2 {
-		t.Fatalf("captured ids = %d, want 2", len(ids))
+
+	if _, err := svc.Create(ctx, create); !errors.Is(err, ErrUserDeactivated) {
+		t.Fatalf("Create error = %v, want ErrUserDeactivated", err)
 	}
-	if ids[0] == uuid.Nil || ids[1] == uuid.Nil {
-		t.Fatalf("expected non-nil ids")
+	backdated := create
+	backdated.AllowPastStart = true
+	if _, err := svc.Create(ctx, backdated); !errors.Is(err, ErrUserDeactivated) {
+		t.Fatalf("backdated Create error = %v, want ErrUserDeactivated", err)
 	}
-	if ids[0] != ids[1] {
-		t.Fatalf("ids differ: %s vs %s", ids[0], ids[1])
+	if err := svc.Delete(ctx, DeleteInput{UserID: "u1", AppointmentID: uuid.New()}); !errors.Is(err, ErrUserDeactivated) {
+		t.Fatalf("Delete error = %v, want ErrUserDeactivated", err)
+	}
+	// A deactivated delegate cannot write to a calendar that is not frozen.
+	if _, err := svc.Create(ctx, CreateInput{UserID: "u2", ActorID: "u1", Title: "t", StartTime: start, EndTime: start.Add(time.Hour)}); !errors.Is(err, ErrUserDeactivated) {
+		t.Fatalf("delegated Create error = %v, want ErrUserDeactivated", err)
+	}
+	if _, err := svc.EmbedSlots(ctx, token.Token, time.Time{}, time.Time{}); !errors.Is(err, ErrInvalidEmbedToken) {
+		t.Fatalf("EmbedSlots error = %v, want ErrInvalidEmbedToken", err)
+	}
+	if _, err := svc.List(ctx, "u1", start, start.Add(time.Hour), store.AppointmentFilter{}); err != nil {
+		t.Fatalf("List on a frozen calendar error: %v", err)
+	}
+
+	if _, err := svc.ReactivateUser(ctx, "u1"); err != nil {
+		t.Fatalf("ReactivateUser error: %v", err)
+	}
+	if _, err := svc.Create(ctx, create); err != nil {
+		t.Fatalf("Create after reactivation error: %v", err)
+	}
+	if _, err := svc.EmbedSlots(ctx, token.Token, time.Time{}, time.Time{}); err != nil {
+		t.Fatalf("EmbedSlots after reactivation error: %v", err)
 	}
 }
 
-func TestServiceCreate_IdempotencyKeyDifferentKeyDifferentUUID(t *testing.T) {
-	var ids []uuid.UUID
+func TestServiceStandaloneIdentity_SkipsUserLookups(t *testing.T) {
+	start := time.Date(2026, 1, 5, 10, 0, 0, 0, time.UTC)
 	svc := NewService(&fakeRepo{
+		getProvisionedUser: func(ctx context.Context, userID string) (domain.ProvisionedUser, error) {
+			t.Fatalf("standalone service looked up user %q", userID)
+			return domain.ProvisionedUser{}, nil
+		},
+		hasDelegation: func(ctx context.Context, principalID, delegateID string) (bool, error) {
+			return true, nil
+		},
+		listFn: func(ctx context.Context, userID string, windowStart, windowEnd time.Time, filter store.AppointmentFilter) ([]domain.Appointment, error) {
+			return nil, nil
+		},
 		createFn: func(ctx context.Context, appt domain.Appointment) (domain.Appointment, error) {
-			ids = append(ids, appt.ID)
 			return appt, nil
 		},
+		listOccurrences: func(ctx context.Context, userID string, windowStart, windowEnd time.Time) ([]domain.RecurringOccurrence, error) {
+			return nil, nil
+		},
+		listBlackouts: func(ctx context.Context, windowStart, windowEnd time.Time) ([]domain.Blackout, error) {
+			return nil, nil
+		},
 	})
+	svc.now = func() time.Time { return start.Add(-time.Hour) }
+	svc.SetIdentity(identity.Standalone{})
+	ctx := context.Background()
 
-	start := time.Date(2026, 1, 1, 10, 0, 0, 0, time.UTC)
-	end := start.Add(time.Hour)
-
-	_, err := svc.Create(context.Background(), CreateInput{
-		UserID:         "u1",
-		Title:          "t",
-		StartTime:      start,
-		EndTime:        end,
-		IdempotencyKey: "k1",
-	})
-	if err != nil {
-		t.Fatalf("Create error: %v", err)
-	}
-	_, err = svc.Create(context.Background(), CreateInput{
-		UserID:         "u1",
-		Title:          "t",
-		StartTime:      start,
-		EndTime:        end,
-		IdempotencyKey: "k2",
-	})
-	if err != nil {
+	if _, err := svc.Create(ctx, CreateInput{UserID: "u1", ActorID: "u2", Title: "t", StartTime: start, EndTime: start.Add(time.Hour)}); err != nil {
 		t.Fatalf("Create error: %v", err)
 	}
-	if len(ids) != 2 {
-		t.Fatalf("captured ids = %d, want 2", len(ids))
+	if _, err := svc.DeactivateUser(ctx, "u1"); !errors.Is(err, ErrProvisioningDisabled) {
+		t.Fatalf("DeactivateUser error = %v, want ErrProvisioningDisabled", err)
 	}
-	if ids[0] == ids[1] {
-		t.Fatalf("expected different ids, got %s", ids[0])
+	if _, err := svc.ListUserGroups(ctx); !errors.Is(err, ErrProvisioningDisabled) {
+		t.Fatalf("ListUserGroups error = %v, want ErrProvisioningDisabled", err)
 	}
 }
 
-func TestServiceCreate_PropagatesStoreErrors(t *testing.T) {
+func TestServiceProvisionUser_KeepsDeactivationTime(t *testing.T) {
+	now := time.Date(2026, 1, 5, 10, 0, 0, 0, time.UTC)
+	first := now.Add(-48 * time.Hour)
+	var saved domain.ProvisionedUser
 	svc := NewService(&fakeRepo{
-		createFn: func(ctx context.Context, appt domain.Appointment) (domain.Appointment, error) {
-			return domain.Appointment{}, store.ErrConflict
+		getProvisionedUser: func(ctx context.Context, userID string) (domain.ProvisionedUser, error) {
+			return domain.ProvisionedUser{UserID: userID, DeactivatedAt: &first}, nil
+		},
+		upsertProvisionedUser: func(ctx context.Context, user domain.ProvisionedUser) (domain.ProvisionedUser, error) {
+			saved = user
+			return user, nil
 		},
 	})
+	svc.now = func() time.Time { return now }
 
-	_, err := svc.Create(context.Background(), CreateInput{
-		UserID:    "u1",
-		Title:     "t",
-		StartTime: time.Date(2026, 1, 1, 10, 0, 0, 0, time.UTC),
-		EndTime:   time.Date(2026, 1, 1, 11, 0, 0, 0, time.UTC),
-	})
-	if !errors.Is(err, store.ErrConflict) {
-		t.Fatalf("error = %v, want %v", err, store.ErrConflict)
+	if _, err := svc.ProvisionUser(context.Background(), ProvisionUserInput{UserID: "u1", DisplayName: "Ada", Email: "not an email"}); err == nil {
+		t.Fatal("ProvisionUser accepted an invalid email")
+	}
+	if _, err := svc.ProvisionUser(context.Background(), ProvisionUserInput{UserID: "u1", DisplayName: " Ada "}); err != nil {
+		t.Fatalf("ProvisionUser error: %v", err)
+	}
+	if saved.DisplayName != "Ada" || saved.Active || saved.DeactivatedAt == nil || !saved.DeactivatedAt.Equal(first) {
+		t.Fatalf("saved = %+v, want the first deactivation kept", saved)
+	}
+	if _, err := svc.ProvisionUser(context.Background(), ProvisionUserInput{UserID: "u1", DisplayName: "Ada", Active: true}); err != nil {
+		t.Fatalf("ProvisionUser error: %v", err)
+	}
+	if !saved.Active || saved.DeactivatedAt != nil {
+		t.Fatalf("saved = %+v, want reactivated", saved)
 	}
 }
 
-func TestServiceCreateRecurringSeries_DefaultWeekdayAndIntervalNormalization(t *testing.T) {
-	count := 1
-	var got domain.RecurringSeries
-
+func TestServiceSetUserGroupMembers_RequiresProvisionedUsers(t *testing.T) {
+	groupID := uuid.MustParse("00000000-0000-0000-0000-0000000000b1")
+	var members []string
 	svc := NewService(&fakeRepo{
-		createRecurringSeries: func(ctx context.Context, series domain.RecurringSeries) (domain.RecurringSeries, error) {
-			got = series
-			return series, nil
+		getProvisionedUser: func(ctx context.Context, userID string) (domain.ProvisionedUser, error) {
+			if userID == "stranger" {
+				return domain.ProvisionedUser{}, store.ErrNotFound
+			}
+			return domain.ProvisionedUser{UserID: userID}, nil
+		},
+		setUserGroupMembers: func(ctx context.Context, id uuid.UUID, userIDs []string) (domain.UserGroup, error) {
+			members = userIDs
+			return domain.UserGroup{ID: id, Members: userIDs}, nil
 		},
 	})
 
-	start := time.Date(2026, 1, 5, 9, 0, 0, 0, time.UTC)
-	end := start.Add(time.Hour)
+	_, err := svc.SetUserGroupMembers(context.Background(), groupID, []string{"u1", "stranger"})
+	var vErr *ValidationError
+	if !errors.As(err, &vErr) {
+		t.Fatalf("error = %v, want validation error", err)
+	}
+	if _, err := svc.SetUserGroupMembers(context.Background(), groupID, []string{"u2", "u1", "u2"}); err != nil {
+		t.Fatalf("SetUserGroupMembers error: %v", err)
+	}
+	if !slices.Equal(members, []string{"u1", "u2"}) {
+		t.Fatalf("members = %v, want [u1 u2]", members)
+	}
+}
 
-	_, err := svc.CreateRecurringSeries(context.Background(), CreateRecurringSeriesInput{
-		UserID:    "u1",
-		Title:     "t",
-		StartTime: start,
-		EndTime:   end,
-		Rule: RecurrenceRuleInput{
-			Interval: 0,
-			Count:    &count,
-			TimeZone: "UTC",
+func TestServiceCompactSeriesExceptions_PagesAndTotals(t *testing.T) {
+	now := time.Date(2026, 6, 1, 0, 0, 0, 0, time.UTC)
+	page := make([]domain.RecurringSeries, CompactionBatchSize)
+	for i := range page {
+		page[i] = domain.RecurringSeries{ID: uuid.New(), UserID: "u1"}
+	}
+	folded := domain.RecurringSeries{ID: uuid.New(), UserID: "u2"}
+	var afters []uuid.UUID
+	svc := NewService(&fakeRepo{
+		listCompactable: func(ctx context.Context, endedBefore time.Time, afterID uuid.UUID, limit int) ([]domain.RecurringSeries, error) {
+			if !endedBefore.Equal(now.Add(-24 * time.Hour)) {
+				t.Fatalf("endedBefore = %v", endedBefore)
+			}
+			afters = append(afters, afterID)
+			if afterID == uuid.Nil {
+				return page, nil
+			}
+			return []domain.RecurringSeries{folded}, nil
+		},
+		compactSeries: func(ctx context.Context, userID string, seriesID uuid.UUID, cutoff, at time.Time) (domain.SeriesCompaction, error) {
+			switch seriesID {
+			case folded.ID:
+				return domain.SeriesCompaction{SeriesID: seriesID, Fold: true, Archived: make([]domain.Appointment, 2), Removed: 3}, nil
+			case page[0].ID:
+				return domain.SeriesCompaction{SeriesID: seriesID, Dead: []uuid.UUID{uuid.New()}, Removed: 1}, nil
+			}
+			return domain.SeriesCompaction{}, nil
 		},
 	})
+	svc.now = func() time.Time { return now }
+
+	if out, err := svc.CompactSeriesExceptions(context.Background(), 0); err != nil || out != (CompactionSummary{}) || afters != nil {
+		t.Fatalf("disabled compaction = %+v, %v", out, err)
+	}
+	out, err := svc.CompactSeriesExceptions(context.Background(), 24*time.Hour)
 	if err != nil {
-		t.Fatalf("CreateRecurringSeries error: %v", err)
+		t.Fatalf("CompactSeriesExceptions error: %v", err)
 	}
-
-	if got.Interval != 1 {
-		t.Fatalf("interval = %d, want 1", got.Interval)
+	want := CompactionSummary{SeriesCompacted: 2, ExceptionsRemoved: 4, SeriesFolded: 1, Archived: 2}
+	if out != want {
+		t.Fatalf("summary = %+v, want %+v", out, want)
 	}
-	if len(got.ByWeekday) != 1 || got.ByWeekday[0] != 1 {
-		t.Fatalf("byweekday = %v, want [1]", got.ByWeekday)
+	if len(afters) != 2 || afters[1] != page[len(page)-1].ID {
+		t.Fatalf("pages after = %v, want the last id of the first page", afters)
 	}
 }
 
-func TestServiceCreateRecurringSeries_RequiresUntilOrCount(t *testing.T) {
+func TestServiceDelete_PublishesOnlyAfterCommit(t *testing.T) {
+	fail := true
 	svc := NewService(&fakeRepo{
-		createRecurringSeries: func(ctx context.Context, series domain.RecurringSeries) (domain.RecurringSeries, error) {
-			return series, nil
+		deleteFn: func(ctx context.Context, userID string, appointmentID uuid.UUID) error {
+			if fail {
+				return store.ErrNotFound
+			}
+			return nil
 		},
 	})
+	var got []events.AppointmentDeleted
+	events.Subscribe(svc.Events(), func(ctx context.Context, e events.AppointmentDeleted) { got = append(got, e) })
 
-	start := time.Date(2026, 1, 5, 9, 0, 0, 0, time.UTC)
-	end := start.Add(time.Hour)
-
-	_, err := svc.CreateRecurringSeries(context.Background(), CreateRecurringSeriesInput{
-		UserID:    "u1",
-		Title:     "t",
-		StartTime: start,
-		EndTime:   end,
-		Rule: RecurrenceRuleInput{
-			TimeZone: "UTC",
-		},
-	})
-	if err == nil {
-		t.Fatalf("expected error")
+	id := uuid.MustParse("00000000-0000-0000-0000-000000000901")
+	if err := svc.Delete(context.Background(), DeleteInput{UserID: "u1", AppointmentID: id}); !errors.Is(err, store.ErrNotFound) {
+		t.Fatalf("Delete error = %v, want store.ErrNotFound", err)
 	}
-	var vErr *ValidationError
-	if !errors.As(err, &vErr) {
-		t.Fatalf("error type = %T, want *ValidationError", err)
+	if len(got) != 0 {
+		t.Fatalf("events after a failed delete = %v, want none", got)
 	}
-	if vErr.Error() != "until or count is required" {
-		t.Fatalf("error = %q, want %q", vErr.Error(), "until or count is required")
+
+	fail = false
+	if err := svc.Delete(context.Background(), DeleteInput{UserID: "u1", AppointmentID: id}); err != nil {
+		t.Fatalf("Delete error: %v", err)
+	}
+	if len(got) != 1 || got[0] != (events.AppointmentDeleted{UserID: "u1", AppointmentID: id}) {
+		t.Fatalf("events = %v, want one appointment.deleted for %s", got, id)
 	}
 }
-
@@ -0,0 +1,125 @@
+package appointments
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"schedula/backend/internal/domain"
+	"schedula/backend/internal/scheduling"
+)
+
+// Simulation bounds. Every request walks each staff member's free slots, so
+// the window, staff and daily demand together bound the work per call.
+const (
+	MaxSimulationWindow        = 31 * 24 * time.Hour
+	MaxSimulatedStaff          = 25
+	MaxSimulatedPatterns       = 20
+	MaxSimulatedBookingsPerDay = 200
+)
+
+type SimulatedStaffInput struct {
+	Label        string
+	WorkingHours WorkingHoursInput
+	// Breaks are in the working hours' zone.
+	Breaks []domain.DailyBreak
+}
+
+// BookingPatternInput is demand of one kind: PerDay bookings of Duration on
+// each of Weekdays (1 Monday to 7 Sunday), or every day when empty.
+type BookingPatternInput struct {
+	Label    string
+	Duration time.Duration
+	PerDay   int
+	Weekdays []int16
+}
+
+type SimulateScheduleInput struct {
+	Staff       []SimulatedStaffInput
+	Patterns    []BookingPatternInput
+	WindowStart time.Time
+	WindowEnd   time.Time
+	// TimeZone is where the reported days start; empty is UTC.
+	TimeZone string
+	Step     time.Duration
+}
+
+// SimulateSchedule plays hypothetical staff hours and booking demand over a
+// window with the scheduling engine and reports projected utilization. It
+// reads and writes nothing, so no user's calendar is involved.
+func (s *Service) SimulateSchedule(_ context.Context, in SimulateScheduleInput) (scheduling.SimulationResult, error) {
+	start := in.WindowStart.UTC()
+	end := in.WindowEnd.UTC()
+	if !end.After(start) {
+		return scheduling.SimulationResult{}, validationError("window_end must be after window_start")
+	}
+	if end.Sub(start) > MaxSimulationWindow {
+		return scheduling.SimulationResult{}, validationError("window too long")
+	}
+	loc := time.UTC
+	if tz := strings.TrimSpace(in.TimeZone); tz != "" {
+		var err error
+		if loc, err = time.LoadLocation(tz); err != nil {
+			return scheduling.SimulationResult{}, validationError("invalid time_zone")
+		}
+	}
+	step := in.Step
+	if step == 0 {
+		step = DefaultSuggestionStep
+	}
+	if step < MinSuggestionStep {
+		return scheduling.SimulationResult{}, validationError("step too short")
+	}
+
+	if len(in.Staff) == 0 {
+		return scheduling.SimulationResult{}, validationError("staff is required")
+	}
+	if len(in.Staff) > MaxSimulatedStaff {
+		return scheduling.SimulationResult{}, validationError("too many staff")
+	}
+	sim := scheduling.Simulation{WindowStart: start, WindowEnd: end, Step: step, Location: loc}
+	for _, st := range in.Staff {
+		wh, err := workingHours(st.WorkingHours)
+		if err != nil {
+			return scheduling.SimulationResult{}, err
+		}
+		breaks, err := normalizeDailyBreaks(st.Breaks)
+		if err != nil {
+			return scheduling.SimulationResult{}, err
+		}
+		sim.Staff = append(sim.Staff, scheduling.Staff{Label: strings.TrimSpace(st.Label), WorkingHours: wh, Breaks: breaks})
+	}
+
+	if len(in.Patterns) == 0 {
+		return scheduling.SimulationResult{}, validationError("patterns is required")
+	}
+	if len(in.Patterns) > MaxSimulatedPatterns {
+		return scheduling.SimulationResult{}, validationError("too many patterns")
+	}
+	perDay := 0
+	for _, p := range in.Patterns {
+		if p.Duration <= 0 {
+			return scheduling.SimulationResult{}, validationError("pattern duration must be positive")
+		}
+		if p.Duration > MaxAppointmentDuration {
+			return scheduling.SimulationResult{}, validationError("pattern duration too long")
+		}
+		if p.PerDay < 0 {
+			return scheduling.SimulationResult{}, validationError("bookings_per_day must not be negative")
+		}
+		perDay += p.PerDay
+		pattern := scheduling.BookingPattern{Label: strings.TrimSpace(p.Label), Duration: p.Duration, PerDay: p.PerDay}
+		for _, wd := range p.Weekdays {
+			if wd < 1 || wd > 7 {
+				return scheduling.SimulationResult{}, validationError("invalid weekday")
+			}
+			pattern.Weekdays = append(pattern.Weekdays, time.Weekday(wd%7))
+		}
+		sim.Patterns = append(sim.Patterns, pattern)
+	}
+	if perDay > MaxSimulatedBookingsPerDay {
+		return scheduling.SimulationResult{}, validationError("too many bookings per day")
+	}
+
+	return scheduling.Simulate(sim), nil
+}
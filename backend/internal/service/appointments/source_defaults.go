@@ -0,0 +1,121 @@
+package appointments
+
+import (
+	"context"
+	"strings"
+	"unicode/utf8"
+
+	"schedula/backend/internal/domain"
+)
+
+// Source default limits. A prefix longer than a few words would crowd out
+// the title it decorates.
+const (
+	MaxSourceDefaults    = 10
+	MaxTitlePrefixLength = 40
+	MaxNotesFooterLength = 500
+)
+
+// UpdateSourceDefaults replaces userID's source defaults; an empty list
+// clears them. Each default names the manual or booking source, or a sync
+// source, and sets a title prefix, a notes footer or both.
+func (s *Service) UpdateSourceDefaults(ctx context.Context, userID string, defaults []domain.SourceDefault) (domain.UserSettings, error) {
+	if userID == "" {
+		return domain.UserSettings{}, validationError("user_id is required")
+	}
+	normalized, err := normalizeSourceDefaults(defaults)
+	if err != nil {
+		return domain.UserSettings{}, err
+	}
+	if err := s.authorizeOwner(ctx, userID); err != nil {
+		return domain.UserSettings{}, err
+	}
+	settings, err := s.repo.GetUserSettings(ctx, userID)
+	if err != nil {
+		return domain.UserSettings{}, err
+	}
+	settings.SourceDefaults = nil
+	if len(normalized) > 0 {
+		settings.SourceDefaults = normalized
+	}
+	return s.repo.UpdateUserSettings(ctx, settings)
+}
+
+func normalizeSourceDefaults(defaults []domain.SourceDefault) ([]domain.SourceDefault, error) {
+	if len(defaults) > MaxSourceDefaults {
+		return nil, validationError("too many source defaults")
+	}
+	seen := make(map[string]bool, len(defaults))
+	normalized := make([]domain.SourceDefault, 0, len(defaults))
+	for _, d := range defaults {
+		d.Source = strings.TrimSpace(d.Source)
+		if !decoratedSource(d.Source) {
+			return nil, validationError("source must be manual, booking, sync: or sync:<system>")
+		}
+		if seen[d.Source] {
+			return nil, validationError("each source may have only one default")
+		}
+		seen[d.Source] = true
+		// The prefix keeps its trailing space, which separates it from the
+		// title.
+		d.TitlePrefix = strings.TrimLeft(d.TitlePrefix, " \t")
+		d.NotesFooter = strings.TrimSpace(d.NotesFooter)
+		if d.TitlePrefix == "" && d.NotesFooter == "" {
+			return nil, validationError("a source default needs a title_prefix or a notes_footer")
+		}
+		if strings.TrimSpace(d.TitlePrefix) == "" && d.TitlePrefix != "" {
+			return nil, validationError("title_prefix must not be only spaces")
+		}
+		if utf8.RuneCountInString(d.TitlePrefix) > MaxTitlePrefixLength {
+			return nil, validationError("title_prefix too long")
+		}
+		if utf8.RuneCountInString(d.NotesFooter) > MaxNotesFooterLength {
+			return nil, validationError("notes_footer too long")
+		}
+		normalized = append(normalized, d)
+	}
+	return normalized, nil
+}
+
+// decoratedSource reports whether source names a create path that applies
+// source defaults.
+func decoratedSource(source string) bool {
+	switch source {
+	case domain.SourceManual, domain.SourceBooking, domain.SourceSyncPrefix:
+		return true
+	}
+	system, ok := strings.CutPrefix(source, domain.SourceSyncPrefix)
+	if !ok {
+		return false
+	}
+	ref, err := normalizeExternalRef(ExternalRef{System: system, ID: "-"})
+	return err == nil && ref.System == system
+}
+
+// applySourceDefault decorates appt with its owner's default for its
+// source, then checks the decorated text against the limits, so a prefix
+// cannot carry a title past them.
+func (s *Service) applySourceDefault(ctx context.Context, appt *domain.Appointment) error {
+	settings, err := s.repo.GetUserSettings(ctx, appt.UserID)
+	if err != nil {
+		return err
+	}
+	d, ok := domain.MatchSourceDefault(settings.SourceDefaults, appt.Source)
+	if !ok {
+		return nil
+	}
+	title, notes := d.Apply(appt.Title, appt.Notes)
+	if title == appt.Title && notes == appt.Notes {
+		return nil
+	}
+	lim := s.limitsFor(ctx)
+	if lim.MaxTitleLength > 0 && utf8.RuneCountInString(title) > lim.MaxTitleLength {
+		return validationError("title too long once the calendar's title prefix is added")
+	}
+	if lim.MaxNotesLength > 0 && utf8.RuneCountInString(notes) > lim.MaxNotesLength {
+		return validationError("notes too long once the calendar's notes footer is added")
+	}
+	appt.Title, appt.Notes = title, notes
+	appt.SourceDefault = &d
+	return nil
+}
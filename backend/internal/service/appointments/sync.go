@@ -0,0 +1,223 @@
+package appointments
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"maps"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"schedula/backend/internal/domain"
+	"schedula/backend/internal/store"
+)
+
+// MaxSyncDelta is the most change log entries a sync replays. Beyond it a
+// full result is cheaper than the delta, so FullSync is returned instead.
+const MaxSyncDelta = MaxChangesPageSize
+
+const syncTokenPrefix = "t1:"
+
+// syncQueryKey binds a sync token to the list it was issued for; a token from
+// one window or filter cannot produce a correct delta for another.
+func syncQueryKey(kind string, start, end time.Time, filter store.AppointmentFilter) string {
+	h := sha256.New()
+	fmt.Fprintf(h, "%s|%d|%d", kind, start.UnixNano(), end.UnixNano())
+	for _, k := range slices.Sorted(maps.Keys(filter.Metadata)) {
+		fmt.Fprintf(h, "|%q=%q", k, filter.Metadata[k])
+	}
+	if filter.ContactID != nil {
+		fmt.Fprintf(h, "|contact=%s", filter.ContactID)
+	}
+	if filter.Source != "" {
+		fmt.Fprintf(h, "|source=%q", filter.Source)
+	}
+	return hex.EncodeToString(h.Sum(nil)[:8])
+}
+
+func encodeSyncToken(seq int64, key string) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(syncTokenPrefix + strconv.FormatInt(seq, 10) + ":" + key))
+}
+
+func decodeSyncToken(token, key string) (int64, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return 0, validationError("invalid sync_token")
+	}
+	rest, ok := strings.CutPrefix(string(raw), syncTokenPrefix)
+	if !ok {
+		return 0, validationError("invalid sync_token")
+	}
+	seqPart, tokenKey, ok := strings.Cut(rest, ":")
+	seq, err := strconv.ParseInt(seqPart, 10, 64)
+	if !ok || err != nil || seq < 0 {
+		return 0, validationError("invalid sync_token")
+	}
+	if tokenKey != key {
+		return 0, validationError("sync_token was issued for a different window or filter")
+	}
+	return seq, nil
+}
+
+// syncChanges returns the latest op per entity since seq, or ok false when
+// there are more than MaxSyncDelta entries to replay.
+func (s *Service) syncChanges(ctx context.Context, userID string, seq int64, entity domain.ChangeEntity) (ops map[uuid.UUID]domain.ChangeOp, next int64, ok bool, err error) {
+	changes, err := s.repo.ListChanges(ctx, userID, seq, MaxSyncDelta+1)
+	if err != nil {
+		return nil, 0, false, err
+	}
+	if len(changes) > MaxSyncDelta {
+		return nil, 0, false, nil
+	}
+	next = seq
+	ops = make(map[uuid.UUID]domain.ChangeOp)
+	for _, c := range changes {
+		next = c.Seq
+		if c.EntityType == entity {
+			ops[c.EntityID] = c.Op
+		}
+	}
+	return ops, next, true, nil
+}
+
+type AppointmentsSync struct {
+	// Appointments is the whole window when FullSync is set, and otherwise
+	// only those created or changed since the token.
+	Appointments []domain.Appointment
+	// DeletedIDs are appointments to drop: deleted, or no longer in the
+	// window.
+	DeletedIDs []uuid.UUID
+	FullSync   bool
+	// NextSyncToken is passed back on the next call to get only what changed.
+	NextSyncToken string
+}
+
+// SyncAppointments lists the window like List and issues a sync token. With
+// token set it returns only the changes since that token was issued.
+func (s *Service) SyncAppointments(ctx context.Context, userID string, windowStart, windowEnd time.Time, filter store.AppointmentFilter, token string) (AppointmentsSync, error) {
+	if userID == "" {
+		return AppointmentsSync{}, validationError("user_id is required")
+	}
+	key := syncQueryKey("appointments", windowStart.UTC(), windowEnd.UTC(), filter)
+
+	var ops map[uuid.UUID]domain.ChangeOp
+	var head int64
+	full := true
+	if token != "" {
+		seq, err := decodeSyncToken(token, key)
+		if err != nil {
+			return AppointmentsSync{}, err
+		}
+		var ok bool
+		if ops, head, ok, err = s.syncChanges(ctx, userID, seq, domain.ChangeEntityAppointment); err != nil {
+			return AppointmentsSync{}, err
+		}
+		full = !ok
+	}
+	if full {
+		// Read the head before the data: a write in between is then replayed
+		// on the next sync rather than lost.
+		var err error
+		if head, err = s.repo.LatestChangeSeq(ctx, userID); err != nil {
+			return AppointmentsSync{}, err
+		}
+	}
+	if !full && len(ops) == 0 {
+		return AppointmentsSync{NextSyncToken: encodeSyncToken(head, key)}, nil
+	}
+
+	appts, err := s.List(ctx, userID, windowStart, windowEnd, filter)
+	if err != nil {
+		return AppointmentsSync{}, err
+	}
+	out := AppointmentsSync{FullSync: full, NextSyncToken: encodeSyncToken(head, key)}
+	if full {
+		out.Appointments = appts
+		return out, nil
+	}
+	inWindow := make(map[uuid.UUID]bool, len(appts))
+	for _, a := range appts {
+		inWindow[a.ID] = true
+		if _, ok := ops[a.ID]; ok {
+			out.Appointments = append(out.Appointments, a)
+		}
+	}
+	// A changed appointment missing from the window was deleted or moved out
+	// of it; either way the client should drop it.
+	for id := range ops {
+		if !inWindow[id] {
+			out.DeletedIDs = append(out.DeletedIDs, id)
+		}
+	}
+	slices.SortFunc(out.DeletedIDs, func(a, b uuid.UUID) int { return strings.Compare(a.String(), b.String()) })
+	return out, nil
+}
+
+type OccurrencesSync struct {
+	// Occurrences is the whole window when FullSync is set, and otherwise
+	// the current occurrences of ChangedSeriesIDs.
+	Occurrences []domain.RecurringOccurrence
+	// ChangedSeriesIDs lists series whose cached occurrences the client
+	// should drop and replace with those returned; a deleted series simply
+	// has none.
+	ChangedSeriesIDs []uuid.UUID
+	FullSync         bool
+	NextSyncToken    string
+}
+
+// SyncOccurrences is SyncAppointments for ListOccurrences. Deltas are per
+// series, since one exception can move any of a series' occurrences.
+func (s *Service) SyncOccurrences(ctx context.Context, userID string, windowStart, windowEnd time.Time, token string) (OccurrencesSync, error) {
+	if userID == "" {
+		return OccurrencesSync{}, validationError("user_id is required")
+	}
+	key := syncQueryKey("occurrences", windowStart.UTC(), windowEnd.UTC(), store.AppointmentFilter{})
+
+	var ops map[uuid.UUID]domain.ChangeOp
+	var head int64
+	full := true
+	if token != "" {
+		seq, err := decodeSyncToken(token, key)
+		if err != nil {
+			return OccurrencesSync{}, err
+		}
+		var ok bool
+		if ops, head, ok, err = s.syncChanges(ctx, userID, seq, domain.ChangeEntitySeries); err != nil {
+			return OccurrencesSync{}, err
+		}
+		full = !ok
+	}
+	if full {
+		var err error
+		if head, err = s.repo.LatestChangeSeq(ctx, userID); err != nil {
+			return OccurrencesSync{}, err
+		}
+	}
+	if !full && len(ops) == 0 {
+		return OccurrencesSync{NextSyncToken: encodeSyncToken(head, key)}, nil
+	}
+
+	occs, err := s.ListOccurrences(ctx, userID, windowStart, windowEnd)
+	if err != nil {
+		return OccurrencesSync{}, err
+	}
+	out := OccurrencesSync{FullSync: full, NextSyncToken: encodeSyncToken(head, key)}
+	if full {
+		out.Occurrences = occs
+		return out, nil
+	}
+	for _, o := range occs {
+		if _, ok := ops[o.SeriesID]; ok {
+			out.Occurrences = append(out.Occurrences, o)
+		}
+	}
+	out.ChangedSeriesIDs = slices.Collect(maps.Keys(ops))
+	slices.SortFunc(out.ChangedSeriesIDs, func(a, b uuid.UUID) int { return strings.Compare(a.String(), b.String()) })
+	return out, nil
+}
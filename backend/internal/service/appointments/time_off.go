@@ -0,0 +1,219 @@
+package appointments
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"schedula/backend/internal/domain"
+)
+
+// ErrTimeOff is returned when a booking made by someone other than the user
+// overlaps the user's time off.
+var ErrTimeOff = errors.New("user is away at that time")
+
+const (
+	MaxTimeOffDuration = 366 * 24 * time.Hour
+	// MaxRecurringTimeOffDuration keeps one occurrence from running into the
+	// next weekday's.
+	MaxRecurringTimeOffDuration = 24 * time.Hour
+)
+
+type TimeOffInput struct {
+	UserID string
+	// TimeOffID is ignored by CreateTimeOff.
+	TimeOffID uuid.UUID
+	Title     string
+	StartTime time.Time
+	EndTime   time.Time
+	// Recurrence is nil for a single span.
+	Recurrence *TimeOffRecurrenceInput
+}
+
+// TimeOffRecurrenceInput repeats time off weekly. ByWeekday uses 1 = Monday
+// ... 7 = Sunday; an empty list means StartTime's weekday in TimeZone.
+type TimeOffRecurrenceInput struct {
+	Interval  int
+	ByWeekday []int16
+	Until     *time.Time
+	TimeZone  string
+}
+
+func (s *Service) CreateTimeOff(ctx context.Context, in TimeOffInput) (domain.TimeOff, error) {
+	timeOff, err := s.timeOffFromInput(ctx, in)
+	if err != nil {
+		return domain.TimeOff{}, err
+	}
+	if err := s.authorizeOwner(ctx, in.UserID); err != nil {
+		return domain.TimeOff{}, err
+	}
+	return s.repo.CreateTimeOff(ctx, timeOff)
+}
+
+// UpdateTimeOff replaces all of a record's fields; a nil Recurrence makes it
+// a single span.
+func (s *Service) UpdateTimeOff(ctx context.Context, in TimeOffInput) (domain.TimeOff, error) {
+	if in.TimeOffID == uuid.Nil {
+		return domain.TimeOff{}, validationError("time_off_id is required")
+	}
+	timeOff, err := s.timeOffFromInput(ctx, in)
+	if err != nil {
+		return domain.TimeOff{}, err
+	}
+	timeOff.ID = in.TimeOffID
+	if err := s.authorizeOwner(ctx, in.UserID); err != nil {
+		return domain.TimeOff{}, err
+	}
+	return s.repo.UpdateTimeOff(ctx, timeOff)
+}
+
+func (s *Service) GetTimeOff(ctx context.Context, userID string, timeOffID uuid.UUID) (domain.TimeOff, error) {
+	if userID == "" {
+		return domain.TimeOff{}, validationError("user_id is required")
+	}
+	if timeOffID == uuid.Nil {
+		return domain.TimeOff{}, validationError("time_off_id is required")
+	}
+	return s.repo.GetTimeOff(ctx, userID, timeOffID)
+}
+
+func (s *Service) DeleteTimeOff(ctx context.Context, userID string, timeOffID uuid.UUID) error {
+	if userID == "" {
+		return validationError("user_id is required")
+	}
+	if timeOffID == uuid.Nil {
+		return validationError("time_off_id is required")
+	}
+	if err := s.authorizeOwner(ctx, userID); err != nil {
+		return err
+	}
+	return s.repo.DeleteTimeOff(ctx, userID, timeOffID)
+}
+
+func (s *Service) ListTimeOff(ctx context.Context, userID string) ([]domain.TimeOff, error) {
+	if userID == "" {
+		return nil, validationError("user_id is required")
+	}
+	return s.repo.ListTimeOff(ctx, userID)
+}
+
+func (s *Service) timeOffFromInput(ctx context.Context, in TimeOffInput) (domain.TimeOff, error) {
+	if in.UserID == "" {
+		return domain.TimeOff{}, validationError("user_id is required")
+	}
+	title := strings.TrimSpace(in.Title)
+	if title == "" {
+		return domain.TimeOff{}, validationError("title is required")
+	}
+	if err := s.checkText(ctx, title, ""); err != nil {
+		return domain.TimeOff{}, err
+	}
+
+	start := in.StartTime.UTC()
+	end := in.EndTime.UTC()
+	if !end.After(start) {
+		return domain.TimeOff{}, validationError("end_time must be after start_time")
+	}
+	if end.Sub(start) > MaxTimeOffDuration {
+		return domain.TimeOff{}, validationError("duration too long")
+	}
+	out := domain.TimeOff{UserID: in.UserID, Title: title, StartTime: start, EndTime: end}
+
+	rule := in.Recurrence
+	if rule == nil {
+		return out, nil
+	}
+	if end.Sub(start) > MaxRecurringTimeOffDuration {
+		return domain.TimeOff{}, validationError("recurring time off must last at most 24 hours")
+	}
+	tz := strings.TrimSpace(rule.TimeZone)
+	if tz == "" {
+		return domain.TimeOff{}, validationError("time_zone is required")
+	}
+	loc, err := time.LoadLocation(tz)
+	if err != nil {
+		return domain.TimeOff{}, validationError("invalid time_zone")
+	}
+	interval := rule.Interval
+	if interval == 0 {
+		interval = 1
+	}
+	if interval < 1 {
+		return domain.TimeOff{}, validationError("interval must be at least 1")
+	}
+
+	weekdays := rule.ByWeekday
+	if len(weekdays) == 0 {
+		weekday := start.In(loc).Weekday()
+		if weekday == time.Sunday {
+			weekdays = []int16{7}
+		} else {
+			weekdays = []int16{int16(weekday)}
+		}
+	}
+	seen := make(map[int16]bool, len(weekdays))
+	normalized := make([]int16, 0, len(weekdays))
+	for _, wd := range weekdays {
+		if wd < 1 || wd > 7 {
+			return domain.TimeOff{}, validationError("invalid weekday")
+		}
+		if !seen[wd] {
+			seen[wd] = true
+			normalized = append(normalized, wd)
+		}
+	}
+	sort.Slice(normalized, func(i, j int) bool { return normalized[i] < normalized[j] })
+
+	if rule.Until != nil {
+		until := rule.Until.UTC()
+		if until.Before(start) {
+			return domain.TimeOff{}, validationError("until must not be before start_time")
+		}
+		out.Until = &until
+	}
+	out.Timezone = tz
+	out.Interval = interval
+	out.ByWeekday = normalized
+	return out, nil
+}
+
+// timeOffBusy returns the user's time off within [start, end).
+func (s *Service) timeOffBusy(ctx context.Context, userID string, start, end time.Time) ([]domain.BusyInterval, error) {
+	records, err := s.repo.ListTimeOff(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	var out []domain.BusyInterval
+	for _, t := range records {
+		spans, err := t.Spans(start, end)
+		if err != nil {
+			return nil, fmt.Errorf("expand time off %s: %w", t.ID, err)
+		}
+		out = append(out, spans...)
+	}
+	return out, nil
+}
+
+// checkTimeOff returns an error wrapping ErrTimeOff if [start, end) overlaps
+// the user's time off.
+func (s *Service) checkTimeOff(ctx context.Context, userID string, start, end time.Time) error {
+	records, err := s.repo.ListTimeOff(ctx, userID)
+	if err != nil {
+		return err
+	}
+	for _, t := range records {
+		spans, err := t.Spans(start, end)
+		if err != nil {
+			return fmt.Errorf("expand time off %s: %w", t.ID, err)
+		}
+		if len(spans) > 0 {
+			return fmt.Errorf("%w: %s", ErrTimeOff, t.Title)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,200 @@
+package appointments
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"schedula/backend/internal/domain"
+)
+
+// SlotSettingsInput sets a user's booking alignment. AlignmentMinutes must
+// divide an hour, or be 0 to allow any start minute.
+type SlotSettingsInput struct {
+	UserID           string
+	AlignmentMinutes int
+	TimeZone         string
+}
+
+func (s *Service) GetSlotSettings(ctx context.Context, userID string) (domain.UserSettings, error) {
+	if userID == "" {
+		return domain.UserSettings{}, validationError("user_id is required")
+	}
+	return s.repo.GetUserSettings(ctx, userID)
+}
+
+func (s *Service) UpdateSlotSettings(ctx context.Context, in SlotSettingsInput) (domain.UserSettings, error) {
+	if in.UserID == "" {
+		return domain.UserSettings{}, validationError("user_id is required")
+	}
+	m := in.AlignmentMinutes
+	if m != 0 && (m < 5 || m > 60 || 60%m != 0) {
+		return domain.UserSettings{}, validationError("alignment_minutes must be 0 or one of 5, 6, 10, 12, 15, 20, 30, 60")
+	}
+	tz := strings.TrimSpace(in.TimeZone)
+	if tz != "" {
+		if _, err := time.LoadLocation(tz); err != nil {
+			return domain.UserSettings{}, validationError("invalid time_zone")
+		}
+	}
+	if err := s.authorizeOwner(ctx, in.UserID); err != nil {
+		return domain.UserSettings{}, err
+	}
+	settings, err := s.repo.GetUserSettings(ctx, in.UserID)
+	if err != nil {
+		return domain.UserSettings{}, err
+	}
+	settings.SlotAlignmentMinutes = m
+	settings.SlotTimeZone = tz
+	return s.repo.UpdateUserSettings(ctx, settings)
+}
+
+const (
+	MaxDailyBreaks         = 6
+	MaxDailyBreakLabelSize = 100
+)
+
+// UpdateDailyBreaks replaces the user's daily breaks; an empty list clears
+// them. Breaks are measured in the settings' time zone and must not overlap.
+func (s *Service) UpdateDailyBreaks(ctx context.Context, userID string, breaks []domain.DailyBreak) (domain.UserSettings, error) {
+	if userID == "" {
+		return domain.UserSettings{}, validationError("user_id is required")
+	}
+	normalized, err := normalizeDailyBreaks(breaks)
+	if err != nil {
+		return domain.UserSettings{}, err
+	}
+	if err := s.authorizeOwner(ctx, userID); err != nil {
+		return domain.UserSettings{}, err
+	}
+
+	settings, err := s.repo.GetUserSettings(ctx, userID)
+	if err != nil {
+		return domain.UserSettings{}, err
+	}
+	settings.DailyBreaks = nil
+	if len(normalized) > 0 {
+		settings.DailyBreaks = normalized
+	}
+	return s.repo.UpdateUserSettings(ctx, settings)
+}
+
+// normalizeDailyBreaks trims labels and sorts breaks by start, rejecting
+// breaks that leave the day or overlap.
+func normalizeDailyBreaks(breaks []domain.DailyBreak) ([]domain.DailyBreak, error) {
+	if len(breaks) > MaxDailyBreaks {
+		return nil, validationError("too many breaks")
+	}
+	normalized := make([]domain.DailyBreak, 0, len(breaks))
+	for _, b := range breaks {
+		b.Label = strings.TrimSpace(b.Label)
+		if len(b.Label) > MaxDailyBreakLabelSize {
+			return nil, validationError("break label too long")
+		}
+		if b.StartMinute < 0 || b.EndMinute > 24*60 || b.EndMinute <= b.StartMinute {
+			return nil, validationError("breaks must end after they start, within one day")
+		}
+		normalized = append(normalized, b)
+	}
+	sort.Slice(normalized, func(i, j int) bool { return normalized[i].StartMinute < normalized[j].StartMinute })
+	for i := 1; i < len(normalized); i++ {
+		if normalized[i].StartMinute < normalized[i-1].EndMinute {
+			return nil, validationError("breaks must not overlap")
+		}
+	}
+	return normalized, nil
+}
+
+// ErrPastStart is returned when a reject policy refuses an appointment whose
+// start has already passed.
+var ErrPastStart = errors.New("start time has already passed")
+
+// SetPastStartPolicy sets the server-wide policy for appointments created
+// with a start in the past. Users without an override follow it.
+func (s *Service) SetPastStartPolicy(p domain.PastStartPolicy) {
+	if p.Valid() {
+		s.pastStart = p
+	}
+}
+
+// UpdatePastStartPolicy sets userID's override; an empty policy returns the
+// user to the server-wide one. It returns the settings and the policy now in
+// effect for the user.
+func (s *Service) UpdatePastStartPolicy(ctx context.Context, userID string, p domain.PastStartPolicy) (domain.UserSettings, domain.PastStartPolicy, error) {
+	if userID == "" {
+		return domain.UserSettings{}, "", validationError("user_id is required")
+	}
+	if p != "" && !p.Valid() {
+		return domain.UserSettings{}, "", validationError("invalid past start policy")
+	}
+	settings, err := s.repo.GetUserSettings(ctx, userID)
+	if err != nil {
+		return domain.UserSettings{}, "", err
+	}
+	settings.PastStartPolicy = p
+	settings, err = s.repo.UpdateUserSettings(ctx, settings)
+	if err != nil {
+		return domain.UserSettings{}, "", err
+	}
+	return settings, s.effectivePastStartPolicy(settings), nil
+}
+
+func (s *Service) effectivePastStartPolicy(settings domain.UserSettings) domain.PastStartPolicy {
+	if settings.PastStartPolicy != "" {
+		return settings.PastStartPolicy
+	}
+	return s.pastStart
+}
+
+// checkPastStart applies the user's past start policy to a new appointment.
+// It reports whether the create should carry a warning. Starts within the
+// clock skew of now count as current, not past.
+func (s *Service) checkPastStart(ctx context.Context, userID string, start time.Time) (bool, error) {
+	if s.timePolicy().NotPast(start) {
+		return false, nil
+	}
+	settings, err := s.repo.GetUserSettings(ctx, userID)
+	if err != nil {
+		return false, err
+	}
+	switch s.effectivePastStartPolicy(settings) {
+	case domain.PastStartReject:
+		return false, ErrPastStart
+	case domain.PastStartWarn:
+		return true, nil
+	}
+	return false, nil
+}
+
+// slotLocation is the zone a user's alignment is measured in.
+func slotLocation(settings domain.UserSettings) *time.Location {
+	if settings.SlotTimeZone == "" {
+		return time.UTC
+	}
+	loc, err := time.LoadLocation(settings.SlotTimeZone)
+	if err != nil {
+		return time.UTC
+	}
+	return loc
+}
+
+// checkSlotAlignment rejects a booking that does not start on the user's
+// alignment grid.
+func (s *Service) checkSlotAlignment(ctx context.Context, userID string, start time.Time) error {
+	settings, err := s.repo.GetUserSettings(ctx, userID)
+	if err != nil {
+		return err
+	}
+	step := settings.SlotAlignmentMinutes
+	if step == 0 {
+		return nil
+	}
+	local := start.In(slotLocation(settings))
+	if local.Second() != 0 || local.Nanosecond() != 0 || local.Minute()%step != 0 {
+		return validationError(fmt.Sprintf("start_time must fall on a %d-minute boundary in %s", step, slotLocation(settings)))
+	}
+	return nil
+}
@@ -0,0 +1,277 @@
+// Package sli keeps service level indicators per RPC method over a rolling
+// window: availability, booking conflict rate and latency. Like usage, it
+// is held in memory and covers this process only.
+package sli
+
+import (
+	"fmt"
+	"io"
+	"maps"
+	"math"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	DefaultWindow = time.Hour
+	DefaultBucket = time.Minute
+)
+
+// LatencyBounds are the upper bounds of the latency histogram. Percentiles
+// are reported as the bound of the bucket they fall in, so they never
+// understate, but no higher than the slowest request.
+var LatencyBounds = [...]time.Duration{
+	5 * time.Millisecond,
+	10 * time.Millisecond,
+	25 * time.Millisecond,
+	50 * time.Millisecond,
+	100 * time.Millisecond,
+	250 * time.Millisecond,
+	500 * time.Millisecond,
+	time.Second,
+	2500 * time.Millisecond,
+	5 * time.Second,
+	10 * time.Second,
+}
+
+// serverErrorCodes are the status codes that count against availability.
+// Rejections of the request itself, such as InvalidArgument or a booking
+// conflict's FailedPrecondition, are the service working as designed.
+var serverErrorCodes = map[string]bool{
+	"Unknown":          true,
+	"DeadlineExceeded": true,
+	"Internal":         true,
+	"Unavailable":      true,
+	"DataLoss":         true,
+}
+
+type counts struct {
+	requests     int64
+	serverErrors int64
+	conflicts    int64
+	latency      [len(LatencyBounds) + 1]int64
+	latencySum   time.Duration
+	slowest      time.Duration
+}
+
+func (c *counts) add(o *counts) {
+	c.requests += o.requests
+	c.serverErrors += o.serverErrors
+	c.conflicts += o.conflicts
+	for i := range c.latency {
+		c.latency[i] += o.latency[i]
+	}
+	c.latencySum += o.latencySum
+	c.slowest = max(c.slowest, o.slowest)
+}
+
+// percentile returns the latency at or below which q of the requests fall.
+func (c *counts) percentile(q float64) time.Duration {
+	if c.requests == 0 {
+		return 0
+	}
+	rank := int64(math.Ceil(q * float64(c.requests)))
+	var seen int64
+	for i, n := range c.latency[:len(LatencyBounds)] {
+		if seen += n; seen >= rank {
+			return min(LatencyBounds[i], c.slowest)
+		}
+	}
+	return c.slowest
+}
+
+type bucket struct {
+	index   int64
+	methods map[string]*counts
+}
+
+// MethodSummary is one method's indicators over a window. Total summaries
+// use an empty Method.
+type MethodSummary struct {
+	Method       string
+	Requests     int64
+	ServerErrors int64
+	Conflicts    int64
+	P99Latency   time.Duration
+}
+
+// Availability is the share of requests without a server error, or 1 when
+// there were none.
+func (m MethodSummary) Availability() float64 {
+	if m.Requests == 0 {
+		return 1
+	}
+	return 1 - float64(m.ServerErrors)/float64(m.Requests)
+}
+
+// ConflictRate is the share of requests rejected by a booking conflict.
+func (m MethodSummary) ConflictRate() float64 {
+	if m.Requests == 0 {
+		return 0
+	}
+	return float64(m.Conflicts) / float64(m.Requests)
+}
+
+type Summary struct {
+	Window  time.Duration
+	Total   MethodSummary
+	Methods []MethodSummary
+}
+
+// Tracker is safe for concurrent use.
+type Tracker struct {
+	mu     sync.Mutex
+	bucket time.Duration
+	ring   []bucket
+	now    func() time.Time
+	totals map[string]*counts
+}
+
+// New returns a Tracker whose window is rounded up to whole buckets. Zero
+// values take the defaults.
+func New(window, bucketSize time.Duration) *Tracker {
+	if window <= 0 {
+		window = DefaultWindow
+	}
+	if bucketSize <= 0 {
+		bucketSize = DefaultBucket
+	}
+	n := int((window + bucketSize - 1) / bucketSize)
+	return &Tracker{
+		bucket: bucketSize,
+		ring:   make([]bucket, n),
+		now:    time.Now,
+		totals: make(map[string]*counts),
+	}
+}
+
+func (t *Tracker) Window() time.Duration {
+	return time.Duration(len(t.ring)) * t.bucket
+}
+
+// Record counts one request. code is the status code name, such as "OK" or
+// "Internal"; conflict reports that the request lost a booking conflict.
+func (t *Tracker) Record(method, code string, conflict bool, latency time.Duration) {
+	one := counts{requests: 1, latencySum: latency, slowest: latency}
+	if serverErrorCodes[code] {
+		one.serverErrors = 1
+	}
+	if conflict {
+		one.conflicts = 1
+	}
+	i, _ := slices.BinarySearch(LatencyBounds[:], latency)
+	one.latency[i] = 1
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	total := t.totals[method]
+	if total == nil {
+		total = &counts{}
+		t.totals[method] = total
+	}
+	total.add(&one)
+
+	idx := t.now().UnixNano() / int64(t.bucket)
+	b := &t.ring[idx%int64(len(t.ring))]
+	if b.index != idx || b.methods == nil {
+		*b = bucket{index: idx, methods: make(map[string]*counts)}
+	}
+	c := b.methods[method]
+	if c == nil {
+		c = &counts{}
+		b.methods[method] = c
+	}
+	c.add(&one)
+}
+
+// Summary reports the indicators over the most recent window, rounded up to
+// whole buckets and capped at the tracker's window. Methods are in name
+// order.
+func (t *Tracker) Summary(window time.Duration) Summary {
+	n := int64((window + t.bucket - 1) / t.bucket)
+	n = max(1, min(n, int64(len(t.ring))))
+
+	t.mu.Lock()
+	idx := t.now().UnixNano() / int64(t.bucket)
+	methods := map[string]*counts{}
+	var total counts
+	for _, b := range t.ring {
+		if b.index <= idx-n || b.index > idx {
+			continue
+		}
+		for m, c := range b.methods {
+			sum := methods[m]
+			if sum == nil {
+				sum = &counts{}
+				methods[m] = sum
+			}
+			sum.add(c)
+			total.add(c)
+		}
+	}
+	t.mu.Unlock()
+
+	out := Summary{Window: time.Duration(n) * t.bucket, Total: summarize("", &total)}
+	for _, m := range slices.Sorted(maps.Keys(methods)) {
+		out.Methods = append(out.Methods, summarize(m, methods[m]))
+	}
+	return out
+}
+
+func summarize(method string, c *counts) MethodSummary {
+	return MethodSummary{
+		Method:       method,
+		Requests:     c.requests,
+		ServerErrors: c.serverErrors,
+		Conflicts:    c.conflicts,
+		P99Latency:   c.percentile(0.99),
+	}
+}
+
+// WriteMetrics writes the latency histogram and the server error and
+// conflict counters per method, since the process started, in the
+// Prometheus text exposition format. Request totals by status code come
+// from usage.
+func (t *Tracker) WriteMetrics(w io.Writer) error {
+	t.mu.Lock()
+	totals := make(map[string]counts, len(t.totals))
+	for m, c := range t.totals {
+		totals[m] = *c
+	}
+	t.mu.Unlock()
+
+	methods := slices.Sorted(maps.Keys(totals))
+	var sb strings.Builder
+	sb.WriteString("# HELP schedula_grpc_request_duration_seconds gRPC request latency, by method.\n")
+	sb.WriteString("# TYPE schedula_grpc_request_duration_seconds histogram\n")
+	for _, m := range methods {
+		c := totals[m]
+		var cum int64
+		for i, bound := range LatencyBounds {
+			cum += c.latency[i]
+			fmt.Fprintf(&sb, "schedula_grpc_request_duration_seconds_bucket{method=%q,le=%q} %d\n", m, formatSeconds(bound), cum)
+		}
+		fmt.Fprintf(&sb, "schedula_grpc_request_duration_seconds_bucket{method=%q,le=\"+Inf\"} %d\n", m, c.requests)
+		fmt.Fprintf(&sb, "schedula_grpc_request_duration_seconds_sum{method=%q} %s\n", m, formatSeconds(c.latencySum))
+		fmt.Fprintf(&sb, "schedula_grpc_request_duration_seconds_count{method=%q} %d\n", m, c.requests)
+	}
+	sb.WriteString("# HELP schedula_grpc_server_errors_total gRPC requests that failed on the server side, by method.\n")
+	sb.WriteString("# TYPE schedula_grpc_server_errors_total counter\n")
+	for _, m := range methods {
+		fmt.Fprintf(&sb, "schedula_grpc_server_errors_total{method=%q} %d\n", m, totals[m].serverErrors)
+	}
+	sb.WriteString("# HELP schedula_grpc_booking_conflicts_total gRPC requests rejected by a booking conflict, by method.\n")
+	sb.WriteString("# TYPE schedula_grpc_booking_conflicts_total counter\n")
+	for _, m := range methods {
+		fmt.Fprintf(&sb, "schedula_grpc_booking_conflicts_total{method=%q} %d\n", m, totals[m].conflicts)
+	}
+	_, err := io.WriteString(w, sb.String())
+	return err
+}
+
+func formatSeconds(d time.Duration) string {
+	return fmt.Sprintf("%g", d.Seconds())
+}
@@ -0,0 +1,65 @@
+package sli
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestTracker_SummarizesRecentWindows(t *testing.T) {
+	now := time.Date(2030, 1, 7, 9, 0, 0, 0, time.UTC)
+	tr := New(time.Hour, time.Minute)
+	tr.now = func() time.Time { return now }
+
+	tr.Record("CreateAppointment", "Internal", false, 3*time.Second)
+	now = now.Add(30 * time.Minute)
+	for range 98 {
+		tr.Record("ListAppointments", "OK", false, 20*time.Millisecond)
+	}
+	tr.Record("ListAppointments", "Unavailable", false, 40*time.Millisecond)
+	tr.Record("CreateAppointment", "FailedPrecondition", true, 15*time.Second)
+	tr.Record("CreateAppointment", "OK", false, 8*time.Millisecond)
+
+	recent := tr.Summary(5 * time.Minute)
+	if recent.Window != 5*time.Minute || recent.Total.Requests != 101 || recent.Total.ServerErrors != 1 {
+		t.Fatalf("recent total = %+v over %v", recent.Total, recent.Window)
+	}
+	if len(recent.Methods) != 2 || recent.Methods[0].Method != "CreateAppointment" {
+		t.Fatalf("methods = %+v, want CreateAppointment then ListAppointments", recent.Methods)
+	}
+	create, list := recent.Methods[0], recent.Methods[1]
+	if create.Requests != 2 || create.ConflictRate() != 0.5 || create.Availability() != 1 {
+		t.Fatalf("create = %+v, want 2 requests with one conflict and no server error", create)
+	}
+	// The 99th of 99 list requests falls in the 50ms bucket, but none took
+	// longer than 40ms; the slow conflict is past every bound.
+	if list.P99Latency != 40*time.Millisecond || create.P99Latency != 15*time.Second {
+		t.Fatalf("p99 = list %v, create %v", list.P99Latency, create.P99Latency)
+	}
+	if got := list.Availability(); got < 0.989 || got > 0.991 {
+		t.Fatalf("list availability = %v, want 98/99", got)
+	}
+
+	// The hour reaches back to the first internal error; longer windows are
+	// capped at the tracker's.
+	hour := tr.Summary(2 * time.Hour)
+	if hour.Window != time.Hour || hour.Total.Requests != 102 || hour.Total.ServerErrors != 2 {
+		t.Fatalf("hour total = %+v over %v", hour.Total, hour.Window)
+	}
+
+	var sb strings.Builder
+	if err := tr.WriteMetrics(&sb); err != nil {
+		t.Fatalf("WriteMetrics error: %v", err)
+	}
+	for _, want := range []string{
+		`schedula_grpc_request_duration_seconds_bucket{method="ListAppointments",le="0.025"} 98`,
+		`schedula_grpc_request_duration_seconds_bucket{method="CreateAppointment",le="+Inf"} 3`,
+		`schedula_grpc_request_duration_seconds_count{method="ListAppointments"} 99`,
+		`schedula_grpc_server_errors_total{method="CreateAppointment"} 1`,
+		`schedula_grpc_booking_conflicts_total{method="CreateAppointment"} 1`,
+	} {
+		if !strings.Contains(sb.String(), want) {
+			t.Fatalf("metrics missing %q:\n%s", want, sb.String())
+		}
+	}
+}
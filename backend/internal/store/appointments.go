@@ -11,11 +11,312 @@ import (
 
 const RecurringConflictLookahead = 180 * 24 * time.Hour
 
+// AppointmentFilter narrows List results. Metadata matches appointments whose
+// metadata contains every given key/value pair. ContactID, when set, keeps
+// only appointments with that contact.
+type AppointmentFilter struct {
+	Metadata  map[string]string
+	ContactID *uuid.UUID
+	// Source matches appointments created from that source; a value ending
+	// in ":" matches every source with that prefix, such as "sync:".
+	Source string
+}
+
+// CalendarSnapshot is every contact, appointment, series and series
+// exception a user owns, as read or written in one transaction.
+type CalendarSnapshot struct {
+	Contacts     []domain.Contact
+	Appointments []domain.Appointment
+	Series       []domain.RecurringSeries
+	Exceptions   []domain.RecurringException
+}
+
+// CalendarRestore lists the appointments and series a restore touched. A
+// rewritten appointment is deleted and created again, so it appears in
+// AppointmentsUpdated only.
+type CalendarRestore struct {
+	AppointmentsCreated []uuid.UUID
+	AppointmentsUpdated []uuid.UUID
+	AppointmentsDeleted []uuid.UUID
+	SeriesCreated       []uuid.UUID
+	SeriesUpdated       []uuid.UUID
+	SeriesDeleted       []uuid.UUID
+	Contacts            int
+}
+
+// ProgramMembers is everything a program groups.
+type ProgramMembers struct {
+	Appointments []domain.Appointment
+	Series       []domain.RecurringSeries
+}
+
+// ProgramCancellation reports what CancelProgram changed: the ids of the
+// one-offs it deleted, the series it ended early, and the series it deleted
+// because none of their occurrences had started.
+type ProgramCancellation struct {
+	Program             domain.Program
+	AppointmentsDeleted []uuid.UUID
+	SeriesEnded         int
+	SeriesDeleted       int
+}
+
+type MutationKind string
+
+const (
+	MutationCreate MutationKind = "create"
+	MutationUpdate MutationKind = "update"
+	MutationDelete MutationKind = "delete"
+)
+
+// CalendarMutation is one change a client made while offline. Appointment
+// carries the full new state for creates and updates, and only the id for
+// deletes. BaseUpdatedAt is the updated_at the client last saw; it is zero
+// for creates.
+type CalendarMutation struct {
+	Kind          MutationKind
+	Appointment   domain.Appointment
+	BaseUpdatedAt time.Time
+}
+
+type MutationConflict string
+
+const (
+	// ConflictVersion means the appointment changed on the server since the
+	// client's base version.
+	ConflictVersion MutationConflict = "version"
+	// ConflictDeleted means the appointment the client edited no longer
+	// exists.
+	ConflictDeleted MutationConflict = "deleted"
+	// ConflictExists means a create reused an id that holds different data.
+	ConflictExists MutationConflict = "exists"
+	// ConflictOverlap means the new times overlap another booking or hold.
+	ConflictOverlap MutationConflict = "overlap"
+)
+
+// MutationOutcome reports how one CalendarMutation was applied. Conflict is
+// empty when it was accepted. Current is the server's copy after the
+// mutation, or nil when the appointment does not exist.
+type MutationOutcome struct {
+	Conflict MutationConflict
+	Current  *domain.Appointment
+}
+
+// RetentionPurge is one user's appointments past retention: Count of them
+// ended before Cutoff.
+type RetentionPurge struct {
+	UserID string
+	Cutoff time.Time
+	Count  int
+}
+
+// AppointmentMerge reports what MergeAppointments changed: the surviving
+// appointment as stored, the duplicates it deleted, and the links and sync
+// mappings it moved onto the survivor.
+type AppointmentMerge struct {
+	Appointment   domain.Appointment
+	Deleted       []uuid.UUID
+	LinksMoved    int
+	MappingsMoved int
+}
+
+// SyncMappingFilter narrows ListSyncMappings. A connector that upserts every
+// event it sees in a full sync finds the events the provider deleted by
+// listing with SyncedBefore set to when the run started, and finds the
+// appointments deleted here with Orphaned.
+type SyncMappingFilter struct {
+	// SyncedBefore keeps mappings last synced before it, when non-zero.
+	SyncedBefore time.Time
+	// Orphaned keeps mappings whose appointment has been deleted.
+	Orphaned bool
+}
+
 type AppointmentRepository interface {
 	Create(ctx context.Context, appt domain.Appointment) (domain.Appointment, error)
-	List(ctx context.Context, userID string, windowStart, windowEnd time.Time) ([]domain.Appointment, error)
+	List(ctx context.Context, userID string, windowStart, windowEnd time.Time, filter AppointmentFilter) ([]domain.Appointment, error)
 	Delete(ctx context.Context, userID string, appointmentID uuid.UUID) error
+	GetByExternalRef(ctx context.Context, userID, system, externalID string) (domain.Appointment, error)
 
 	CreateRecurringSeries(ctx context.Context, series domain.RecurringSeries) (domain.RecurringSeries, error)
+	CreateRecurringSeriesSkippingConflicts(ctx context.Context, series domain.RecurringSeries, maxSkips int) (domain.RecurringSeries, error)
 	ListOccurrences(ctx context.Context, userID string, windowStart, windowEnd time.Time) ([]domain.RecurringOccurrence, error)
+	GetRecurringSeries(ctx context.Context, userID string, seriesID uuid.UUID) (domain.RecurringSeries, error)
+	// ListActiveRecurringSeries returns the user's series that may still have
+	// an occurrence ending after at.
+	ListActiveRecurringSeries(ctx context.Context, userID string, at time.Time) ([]domain.RecurringSeries, error)
+	// ListSeriesOnWeekdays returns the user's series that repeat on any day
+	// in weekdays, ended or not.
+	ListSeriesOnWeekdays(ctx context.Context, userID string, weekdays domain.WeekdayMask) ([]domain.RecurringSeries, error)
+	ListSeriesExceptions(ctx context.Context, seriesID uuid.UUID) ([]domain.RecurringException, error)
+	DeleteRecurringExceptions(ctx context.Context, seriesID uuid.UUID, exceptionIDs []uuid.UUID) (int, error)
+	UpdateRecurringSeriesEnd(ctx context.Context, series domain.RecurringSeries) (int, error)
+	MoveRecurringSeriesTimeZone(ctx context.Context, userID string, seriesIDs []uuid.UUID, tz string, keep domain.TimeZoneKeep) ([]domain.RecurringSeries, int, error)
+	// ListCompactableSeries pages, by id after afterID, through series of any
+	// user that have an until or a count, started before endedBefore, and
+	// have at least one exception.
+	ListCompactableSeries(ctx context.Context, endedBefore time.Time, afterID uuid.UUID, limit int) ([]domain.RecurringSeries, error)
+	// CompactRecurringSeries plans and applies the compaction of the user's
+	// series under the calendar lock, and returns what it applied. A series
+	// with attendance, or whose archived appointments would overlap another
+	// booking, is kept rather than folded.
+	CompactRecurringSeries(ctx context.Context, userID string, seriesID uuid.UUID, cutoff, at time.Time) (domain.SeriesCompaction, error)
+	SkipRecurringOccurrences(ctx context.Context, userID string, seriesID uuid.UUID, occurrenceStarts []time.Time) (int, error)
+	ListSeriesOccurrences(ctx context.Context, series domain.RecurringSeries, windowStart, windowEnd time.Time) ([]domain.RecurringOccurrence, error)
+
+	MarkAttendance(ctx context.Context, attendance domain.OccurrenceAttendance) (domain.OccurrenceAttendance, error)
+	ListAttendance(ctx context.Context, seriesID uuid.UUID) ([]domain.OccurrenceAttendance, error)
+
+	CheckInAppointment(ctx context.Context, userID string, appointmentID uuid.UUID, at time.Time) (domain.Appointment, error)
+	CheckOutAppointment(ctx context.Context, userID string, appointmentID uuid.UUID, at time.Time) (domain.Appointment, error)
+	UserAnalytics(ctx context.Context, userID string, windowStart, windowEnd time.Time) (domain.UserAnalytics, error)
+
+	ReserveSlot(ctx context.Context, hold domain.SlotHold) (domain.SlotHold, error)
+	// DeleteExpiredHolds deletes holds that expired at or before before and
+	// logs each one as expired in its owner's change log.
+	DeleteExpiredHolds(ctx context.Context, before time.Time) (int, error)
+	ConfirmHold(ctx context.Context, holdID uuid.UUID, appt domain.Appointment) (domain.Appointment, error)
+	ReleaseHold(ctx context.Context, userID string, holdID uuid.UUID) error
+	// ListActiveHolds returns the user's unexpired holds overlapping the
+	// window.
+	ListActiveHolds(ctx context.Context, userID string, windowStart, windowEnd time.Time) ([]domain.SlotHold, error)
+
+	// CreateProposal places a hold for the proposal on the proposer's
+	// calendar and stores the proposal. It returns ErrConflict when the
+	// proposer's slot is taken.
+	CreateProposal(ctx context.Context, proposal domain.AppointmentProposal) (domain.AppointmentProposal, error)
+	GetProposal(ctx context.Context, proposalID uuid.UUID) (domain.AppointmentProposal, error)
+	// ListProposals returns the user's pending proposals, sent or received.
+	ListProposals(ctx context.Context, userID string) ([]domain.AppointmentProposal, error)
+	// AcceptProposal books proposerAppt and recipientAppt and marks the
+	// proposal accepted, all in one transaction holding both calendars'
+	// locks. A proposal that is not open at the time is returned unchanged.
+	AcceptProposal(ctx context.Context, proposalID uuid.UUID, proposerAppt, recipientAppt domain.Appointment, at time.Time) (domain.AppointmentProposal, error)
+	// DeclineProposal releases the proposer's hold and marks the proposal
+	// declined. A proposal that is not open at the time is returned
+	// unchanged.
+	DeclineProposal(ctx context.Context, proposalID uuid.UUID, at time.Time) (domain.AppointmentProposal, error)
+	// ExpireProposals marks pending proposals that expired at or before
+	// before as expired and logs the expiry in the proposer's and the
+	// recipient's change logs.
+	ExpireProposals(ctx context.Context, before time.Time) (int, error)
+
+	LinkAppointments(ctx context.Context, link domain.AppointmentLink) (domain.AppointmentLink, error)
+	UnlinkAppointments(ctx context.Context, userID string, appointmentID, relatedID uuid.UUID, kind domain.AppointmentLinkKind) error
+	ListRelated(ctx context.Context, userID string, appointmentID uuid.UUID) ([]domain.RelatedAppointment, error)
+	// MergeAppointments folds duplicateIDs into primaryID in one transaction
+	// holding the calendar lock. It moves the duplicates' links and sync
+	// mappings onto the primary, fills the primary's empty external
+	// reference, contact and program from them, and deletes them. With cover
+	// set the primary also widens to span them. It returns ErrNotFound unless
+	// every appointment is the user's, and ErrConflict when the widened
+	// primary overlaps another booking or a hold.
+	MergeAppointments(ctx context.Context, userID string, primaryID uuid.UUID, duplicateIDs []uuid.UUID, cover bool, at time.Time) (AppointmentMerge, error)
+
+	GrantDelegation(ctx context.Context, grant domain.DelegationGrant) (domain.DelegationGrant, error)
+	RevokeDelegation(ctx context.Context, principalID, delegateID string) error
+	ListDelegations(ctx context.Context, principalID string) ([]domain.DelegationGrant, error)
+	HasDelegation(ctx context.Context, principalID, delegateID string) (bool, error)
+
+	CreateBlackout(ctx context.Context, blackout domain.Blackout) (domain.Blackout, error)
+	DeleteBlackout(ctx context.Context, blackoutID uuid.UUID) error
+	ListBlackouts(ctx context.Context, windowStart, windowEnd time.Time) ([]domain.Blackout, error)
+
+	CreateContact(ctx context.Context, contact domain.Contact) (domain.Contact, error)
+	GetContact(ctx context.Context, userID string, contactID uuid.UUID) (domain.Contact, error)
+	UpdateContact(ctx context.Context, contact domain.Contact) (domain.Contact, error)
+	// DeleteContact also clears contact_id on the user's appointments.
+	DeleteContact(ctx context.Context, userID string, contactID uuid.UUID) error
+	ListContacts(ctx context.Context, userID string) ([]domain.Contact, error)
+
+	CreateProgram(ctx context.Context, program domain.Program) (domain.Program, error)
+	GetProgram(ctx context.Context, userID string, programID uuid.UUID) (domain.Program, error)
+	ListPrograms(ctx context.Context, userID string) ([]domain.Program, error)
+	ListProgramMembers(ctx context.Context, userID string, programID uuid.UUID) (ProgramMembers, error)
+	// CancelProgram cancels everything in the program from at on, in one
+	// transaction: it deletes the one-offs starting at or after at, ends
+	// each series at its last occurrence before at, and marks the program
+	// cancelled. Cancelling a cancelled program changes nothing.
+	CancelProgram(ctx context.Context, userID string, programID uuid.UUID, at time.Time) (ProgramCancellation, error)
+
+	GetProvisionedUser(ctx context.Context, userID string) (domain.ProvisionedUser, error)
+	// UpsertProvisionedUser creates or replaces the user's row. It returns
+	// ErrDuplicate when another user already has the external id.
+	UpsertProvisionedUser(ctx context.Context, user domain.ProvisionedUser) (domain.ProvisionedUser, error)
+	ListProvisionedUsers(ctx context.Context) ([]domain.ProvisionedUser, error)
+	// CreateUserGroup returns ErrDuplicate when the name is taken, ignoring
+	// case.
+	CreateUserGroup(ctx context.Context, group domain.UserGroup) (domain.UserGroup, error)
+	// SetUserGroupMembers replaces the group's members.
+	SetUserGroupMembers(ctx context.Context, groupID uuid.UUID, userIDs []string) (domain.UserGroup, error)
+	ListUserGroups(ctx context.Context) ([]domain.UserGroup, error)
+
+	// UpsertSyncMapping creates the mapping for the provider's event or
+	// replaces its appointment, etag and last synced time, so replaying a
+	// sync is harmless. A zero LastSyncedAt means now. It returns
+	// ErrNotFound when the appointment is not the user's and ErrDuplicate
+	// when it is already mapped to another event of the same provider.
+	UpsertSyncMapping(ctx context.Context, mapping domain.SyncMapping) (domain.SyncMapping, error)
+	GetSyncMapping(ctx context.Context, userID, provider, externalID string) (domain.SyncMapping, error)
+	GetSyncMappingByAppointment(ctx context.Context, userID, provider string, appointmentID uuid.UUID) (domain.SyncMapping, error)
+	// ListSyncMappings returns the user's mappings for the provider by
+	// external id.
+	ListSyncMappings(ctx context.Context, userID, provider string, filter SyncMappingFilter) ([]domain.SyncMapping, error)
+	DeleteSyncMapping(ctx context.Context, userID, provider, externalID string) error
+
+	CreateTimeOff(ctx context.Context, timeOff domain.TimeOff) (domain.TimeOff, error)
+	GetTimeOff(ctx context.Context, userID string, timeOffID uuid.UUID) (domain.TimeOff, error)
+	UpdateTimeOff(ctx context.Context, timeOff domain.TimeOff) (domain.TimeOff, error)
+	DeleteTimeOff(ctx context.Context, userID string, timeOffID uuid.UUID) error
+	ListTimeOff(ctx context.Context, userID string) ([]domain.TimeOff, error)
+
+	ListChanges(ctx context.Context, userID string, afterSeq int64, limit int) ([]domain.CalendarChange, error)
+	LatestChangeSeq(ctx context.Context, userID string) (int64, error)
+	CalendarVersion(ctx context.Context, userID string) (int64, error)
+
+	// GetDaySummaryBuild returns the record of the user's last day summary
+	// build, or ErrNotFound before the first.
+	GetDaySummaryBuild(ctx context.Context, userID string) (domain.DaySummaryBuild, error)
+	// ListDaySummaries returns the user's stored summaries for days in
+	// [from, to), oldest first.
+	ListDaySummaries(ctx context.Context, userID string, from, to time.Time) ([]domain.DaySummary, error)
+	// ReplaceDaySummaries stores days as the user's summaries and build as
+	// their record, unless a build from a later calendar version is already
+	// stored. It reports whether it wrote.
+	ReplaceDaySummaries(ctx context.Context, build domain.DaySummaryBuild, days []domain.DaySummary) (bool, error)
+	// ListStaleDaySummaryUsers returns up to limit users whose calendar has
+	// changed since their last build, or whose build is older than
+	// builtBefore, users never built first.
+	ListStaleDaySummaryUsers(ctx context.Context, builtBefore time.Time, limit int) ([]string, error)
+	// ClearDaySummaries deletes every user's summaries and builds, so all
+	// of them are rebuilt.
+	ClearDaySummaries(ctx context.Context) error
+
+	GetUserSettings(ctx context.Context, userID string) (domain.UserSettings, error)
+	UpdateUserSettings(ctx context.Context, settings domain.UserSettings) (domain.UserSettings, error)
+	// ExpiredAppointments counts, per user, the appointments that ended more
+	// than the user's retention before now. Users without a retention
+	// override use defaultDays; a retention of 0 days keeps everything.
+	ExpiredAppointments(ctx context.Context, now time.Time, defaultDays int) ([]RetentionPurge, error)
+	// PurgeAppointments deletes up to limit of userID's appointments that
+	// ended before cutoff, oldest first, logs each deletion as a change and
+	// returns the deleted ids.
+	PurgeAppointments(ctx context.Context, userID string, cutoff time.Time, limit int) ([]uuid.UUID, error)
+
+	ExportCalendar(ctx context.Context, userID string) (CalendarSnapshot, error)
+	// ImportCalendar writes snapshot into userID's calendar, keeping row ids.
+	// It returns ErrConflict unless the calendar is empty.
+	ImportCalendar(ctx context.Context, userID string, snapshot CalendarSnapshot) error
+	// RestoreCalendar makes userID's calendar match snapshot in one
+	// transaction: rows the snapshot lacks are deleted, rows it holds at
+	// another version are rewritten and missing rows are inserted, all with
+	// their snapshot ids. Unchanged rows are left alone.
+	RestoreCalendar(ctx context.Context, userID string, snapshot CalendarSnapshot) (CalendarRestore, error)
+	SaveCalendarSnapshotInfo(ctx context.Context, info domain.CalendarSnapshotInfo) error
+	// ListCalendarSnapshotInfo returns userID's snapshots, newest first.
+	ListCalendarSnapshotInfo(ctx context.Context, userID string) ([]domain.CalendarSnapshotInfo, error)
+	GetCalendarSnapshotInfo(ctx context.Context, userID string, snapshotID uuid.UUID) (domain.CalendarSnapshotInfo, error)
+	DeleteCalendarSnapshotInfo(ctx context.Context, userID string, snapshotID uuid.UUID) error
+	// ReconcileCalendar applies mutations in order in one transaction and
+	// returns an outcome for each. A conflicted mutation is skipped without
+	// undoing the others.
+	ReconcileCalendar(ctx context.Context, userID string, mutations []CalendarMutation) ([]MutationOutcome, error)
 }
@@ -0,0 +1,15 @@
+package store
+
+import "context"
+
+// BlobStore keeps opaque byte blobs by key. Keys are chosen by the caller
+// and are "/"-separated paths, so an object store can back it as well as
+// the database.
+type BlobStore interface {
+	// PutBlob stores data under key, replacing any blob already there.
+	PutBlob(ctx context.Context, key string, data []byte) error
+	// GetBlob returns the blob under key, or ErrNotFound.
+	GetBlob(ctx context.Context, key string) ([]byte, error)
+	// DeleteBlob removes the blob under key. A missing key is not an error.
+	DeleteBlob(ctx context.Context, key string) error
+}
@@ -10,12 +10,24 @@ import (
 
 type CalendarTx interface {
 	CreateAppointment(ctx context.Context, appt domain.Appointment) (domain.Appointment, error)
+	// ListAppointments returns the appointments that occupy time in the
+	// window, for conflict checks; milestones are left out.
 	ListAppointments(ctx context.Context, userID string, windowStart, windowEnd time.Time) ([]domain.Appointment, error)
 	DeleteAppointment(ctx context.Context, userID string, appointmentID uuid.UUID) error
 
 	CreateRecurringSeries(ctx context.Context, series domain.RecurringSeries) (domain.RecurringSeries, error)
-	ListRecurringSeries(ctx context.Context, userID string) ([]domain.RecurringSeries, error)
+	ListRecurringSeries(ctx context.Context, userID string, windowStart, windowEnd time.Time) ([]domain.RecurringSeries, error)
+	// ListSeriesConflictCandidates is ListRecurringSeries for series's user,
+	// less series whose weekdays rule out an overlap with series. What is
+	// left may still not overlap; it is only a pre-filter for expansion.
+	ListSeriesConflictCandidates(ctx context.Context, series domain.RecurringSeries, windowStart, windowEnd time.Time) ([]domain.RecurringSeries, error)
 	ListRecurringExceptions(ctx context.Context, seriesID uuid.UUID, windowStart, windowEnd time.Time) ([]domain.RecurringException, error)
 	UpsertRecurringException(ctx context.Context, ex domain.RecurringException) (domain.RecurringException, error)
 	DeleteRecurringSeries(ctx context.Context, userID string, seriesID uuid.UUID) error
+
+	CreateSlotHold(ctx context.Context, hold domain.SlotHold) (domain.SlotHold, error)
+	ListActiveSlotHolds(ctx context.Context, userID string, windowStart, windowEnd time.Time) ([]domain.SlotHold, error)
+	GetSlotHold(ctx context.Context, userID string, holdID uuid.UUID) (domain.SlotHold, error)
+	DeleteSlotHold(ctx context.Context, userID string, holdID uuid.UUID) error
+	GetAppointment(ctx context.Context, userID string, appointmentID uuid.UUID) (domain.Appointment, error)
 }
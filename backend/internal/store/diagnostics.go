@@ -0,0 +1,45 @@
+package store
+
+import "time"
+
+// TableStats is the size and vacuum state of one table. DeadTupleRatio is the
+// share of dead tuples, a cheap proxy for table bloat.
+type TableStats struct {
+	Name           string
+	TotalBytes     int64
+	TableBytes     int64
+	IndexBytes     int64
+	LiveTuples     int64
+	DeadTuples     int64
+	DeadTupleRatio float64
+	LastVacuum     *time.Time
+	LastAutovacuum *time.Time
+}
+
+// IndexStats is the size and health of one index. BytesPerTuple is meant to
+// be trended: a steady climb without schema changes indicates bloat.
+type IndexStats struct {
+	Name                string
+	Table               string
+	Bytes               int64
+	BytesPerTuple       float64
+	Scans               int64
+	Valid               bool
+	Ready               bool
+	ExclusionConstraint bool
+}
+
+type PoolStats struct {
+	MaxOpen      int
+	Open         int
+	InUse        int
+	Idle         int
+	WaitCount    int64
+	WaitDuration time.Duration
+}
+
+type DatabaseDiagnostics struct {
+	Tables  []TableStats
+	Indexes []IndexStats
+	Pool    PoolStats
+}
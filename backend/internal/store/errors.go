@@ -6,4 +6,8 @@ var (
 	ErrConflict            = errors.New("conflict")
 	ErrNotFound            = errors.New("not found")
 	ErrIdempotencyConflict = errors.New("idempotency key conflict")
+	ErrDuplicate           = errors.New("duplicate")
+	ErrSerialization       = errors.New("serialization failure")
+	ErrUnavailable         = errors.New("store unavailable")
+	ErrReadOnly            = errors.New("store is read-only")
 )
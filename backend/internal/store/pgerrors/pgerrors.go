@@ -0,0 +1,105 @@
+// Package pgerrors maps Postgres driver errors onto the typed errors in the
+// store package so every repository and transport handles them the same way.
+package pgerrors
+
+import (
+	"context"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"net"
+	"strings"
+
+	"github.com/jackc/pgx/v5/pgconn"
+
+	"schedula/backend/internal/store"
+)
+
+const (
+	CodeReadOnlyTransaction  = "25006"
+	CodeUniqueViolation      = "23505"
+	CodeForeignKeyViolation  = "23503"
+	CodeExclusionViolation   = "23P01"
+	CodeSerializationFailure = "40001"
+	CodeDeadlockDetected     = "40P01"
+	CodeUndefinedTable       = "42P01"
+	CodeAdminShutdown        = "57P01"
+	CodeCannotConnectNow     = "57P03"
+)
+
+// Code returns the SQLSTATE of err, or "" when err is not a Postgres error.
+func Code(err error) string {
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) {
+		return pgErr.Code
+	}
+	return ""
+}
+
+// Constraint returns the constraint name reported with err, if any.
+func Constraint(err error) string {
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) {
+		return pgErr.ConstraintName
+	}
+	return ""
+}
+
+func IsUniqueViolation(err error) bool {
+	return Code(err) == CodeUniqueViolation
+}
+
+func IsExclusionViolation(err error) bool {
+	return Code(err) == CodeExclusionViolation
+}
+
+func IsUndefinedTable(err error) bool {
+	return Code(err) == CodeUndefinedTable
+}
+
+// Classify converts err into a store error. Conflicts and duplicates are
+// returned as the bare sentinel; serialization and connection failures wrap
+// the driver error so the cause is still logged. Unrecognized errors are
+// returned unchanged.
+func Classify(err error) error {
+	if err == nil {
+		return nil
+	}
+	if errors.Is(err, store.ErrConflict) ||
+		errors.Is(err, store.ErrNotFound) ||
+		errors.Is(err, store.ErrDuplicate) ||
+		errors.Is(err, store.ErrIdempotencyConflict) ||
+		errors.Is(err, store.ErrSerialization) ||
+		errors.Is(err, store.ErrUnavailable) ||
+		errors.Is(err, store.ErrReadOnly) ||
+		errors.Is(err, context.Canceled) ||
+		errors.Is(err, context.DeadlineExceeded) {
+		return err
+	}
+
+	switch code := Code(err); {
+	case code == CodeExclusionViolation:
+		return store.ErrConflict
+	case code == CodeUniqueViolation:
+		return store.ErrDuplicate
+	case code == CodeReadOnlyTransaction:
+		return fmt.Errorf("%w: %w", store.ErrReadOnly, err)
+	case code == CodeSerializationFailure, code == CodeDeadlockDetected:
+		return fmt.Errorf("%w: %w", store.ErrSerialization, err)
+	case strings.HasPrefix(code, "08"), code == CodeAdminShutdown, code == CodeCannotConnectNow:
+		return fmt.Errorf("%w: %w", store.ErrUnavailable, err)
+	case code != "":
+		return err
+	}
+
+	var connectErr *pgconn.ConnectError
+	if errors.As(err, &connectErr) || errors.Is(err, driver.ErrBadConn) {
+		return fmt.Errorf("%w: %w", store.ErrUnavailable, err)
+	}
+	var netErr net.Error
+	if errors.As(err, &netErr) {
+		return fmt.Errorf("%w: %w", store.ErrUnavailable, err)
+	}
+
+	return err
+}
@@ -0,0 +1,70 @@
+package pgerrors
+
+import (
+	"context"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"net"
+	"testing"
+
+	"github.com/jackc/pgx/v5/pgconn"
+
+	"schedula/backend/internal/store"
+)
+
+func TestClassify(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want error
+	}{
+		{name: "exclusion violation", err: &pgconn.PgError{Code: CodeExclusionViolation}, want: store.ErrConflict},
+		{name: "unique violation", err: &pgconn.PgError{Code: CodeUniqueViolation}, want: store.ErrDuplicate},
+		{name: "serialization failure", err: &pgconn.PgError{Code: CodeSerializationFailure}, want: store.ErrSerialization},
+		{name: "deadlock", err: &pgconn.PgError{Code: CodeDeadlockDetected}, want: store.ErrSerialization},
+		{name: "connection exception", err: &pgconn.PgError{Code: "08006"}, want: store.ErrUnavailable},
+		{name: "admin shutdown", err: &pgconn.PgError{Code: CodeAdminShutdown}, want: store.ErrUnavailable},
+		{name: "read-only transaction", err: &pgconn.PgError{Code: CodeReadOnlyTransaction}, want: store.ErrReadOnly},
+		{name: "bad conn", err: fmt.Errorf("query: %w", driver.ErrBadConn), want: store.ErrUnavailable},
+		{name: "net error", err: &net.OpError{Op: "dial", Err: errors.New("refused")}, want: store.ErrUnavailable},
+		{name: "store error passthrough", err: store.ErrNotFound, want: store.ErrNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Classify(tt.err)
+			if !errors.Is(got, tt.want) {
+				t.Fatalf("Classify(%v) = %v, want %v", tt.err, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestClassify_ConflictIsBareSentinel(t *testing.T) {
+	got := Classify(&pgconn.PgError{Code: CodeExclusionViolation, ConstraintName: "appointments_no_overlap"})
+	if got != store.ErrConflict {
+		t.Fatalf("Classify = %v, want bare %v", got, store.ErrConflict)
+	}
+}
+
+func TestClassify_KeepsDriverCause(t *testing.T) {
+	cause := &pgconn.PgError{Code: CodeSerializationFailure, Message: "could not serialize access"}
+	got := Classify(cause)
+	var pgErr *pgconn.PgError
+	if !errors.As(got, &pgErr) || pgErr != cause {
+		t.Fatalf("Classify lost driver error: %v", got)
+	}
+}
+
+func TestClassify_LeavesUnknownErrorsUnchanged(t *testing.T) {
+	for _, err := range []error{
+		nil,
+		context.DeadlineExceeded,
+		&pgconn.PgError{Code: "22001"},
+	} {
+		if got := Classify(err); got != err {
+			t.Fatalf("Classify(%v) = %v, want unchanged", err, got)
+		}
+	}
+}
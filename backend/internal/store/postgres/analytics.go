@@ -0,0 +1,80 @@
+package postgres
+
+import (
+	"context"
+	"time"
+
+	"github.com/uptrace/bun"
+
+	"schedula/backend/internal/domain"
+	"schedula/backend/internal/store/pgerrors"
+)
+
+func (r *AppointmentRepo) UserAnalytics(ctx context.Context, userID string, windowStart, windowEnd time.Time) (domain.UserAnalytics, error) {
+	out, err := userAnalytics(ctx, r.db, userID, windowStart, windowEnd)
+	if err != nil {
+		return domain.UserAnalytics{}, pgerrors.Classify(err)
+	}
+	return out, nil
+}
+
+// userAnalytics aggregates one-off appointments starting in the window and
+// attendance recorded for the user's series occurrences in the window.
+// Milestones take no time, so they are left out of the appointment count and
+// average duration.
+func userAnalytics(ctx context.Context, db bun.IDB, userID string, windowStart, windowEnd time.Time) (domain.UserAnalytics, error) {
+	var count int
+	var avgSeconds float64
+	err := db.NewSelect().
+		Model((*domain.Appointment)(nil)).
+		ColumnExpr("count(*) AS count").
+		ColumnExpr("coalesce(avg(extract(epoch FROM end_time - start_time)), 0)::float8 AS avg_seconds").
+		Where("user_id = ?", userID).
+		Where("start_time >= ?", windowStart).
+		Where("start_time < ?", windowEnd).
+		Where("kind <> ?", domain.AppointmentKindMilestone).
+		Scan(ctx, &count, &avgSeconds)
+	if err != nil {
+		return domain.UserAnalytics{}, err
+	}
+
+	var attended, missed int
+	err = db.NewSelect().
+		TableExpr("occurrence_attendance AS a").
+		Join("JOIN recurring_series AS s ON s.id = a.series_id").
+		ColumnExpr("count(*) FILTER (WHERE a.status = ?) AS attended", domain.AttendanceStatusAttended).
+		ColumnExpr("count(*) FILTER (WHERE a.status = ?) AS missed", domain.AttendanceStatusMissed).
+		Where("s.user_id = ?", userID).
+		Where("a.occurrence_start >= ?", windowStart).
+		Where("a.occurrence_start < ?", windowEnd).
+		Scan(ctx, &attended, &missed)
+	if err != nil {
+		return domain.UserAnalytics{}, err
+	}
+
+	var sessions int
+	var plannedSeconds, actualSeconds float64
+	err = db.NewSelect().
+		Model((*domain.Appointment)(nil)).
+		ColumnExpr("count(*) AS sessions").
+		ColumnExpr("coalesce(sum(extract(epoch FROM end_time - start_time)), 0)::float8 AS planned_seconds").
+		ColumnExpr("coalesce(sum(extract(epoch FROM checked_out_at - checked_in_at)), 0)::float8 AS actual_seconds").
+		Where("user_id = ?", userID).
+		Where("start_time >= ?", windowStart).
+		Where("start_time < ?", windowEnd).
+		Where("checked_out_at IS NOT NULL").
+		Scan(ctx, &sessions, &plannedSeconds, &actualSeconds)
+	if err != nil {
+		return domain.UserAnalytics{}, err
+	}
+
+	return domain.UserAnalytics{
+		AppointmentCount: count,
+		AverageDuration:  time.Duration(avgSeconds * float64(time.Second)),
+		Attended:         attended,
+		Missed:           missed,
+		Sessions:         sessions,
+		PlannedTime:      time.Duration(plannedSeconds * float64(time.Second)),
+		ActualTime:       time.Duration(actualSeconds * float64(time.Second)),
+	}, nil
+}
@@ -2,28 +2,56 @@ package postgres
 
 import (
 	"context"
+	"database/sql"
+	"encoding/json"
 	"errors"
+	"fmt"
+	"maps"
 	"sort"
+	"strings"
 	"time"
 
 	"github.com/google/uuid"
-	"github.com/jackc/pgx/v5/pgconn"
 	"github.com/uptrace/bun"
 
+	"schedula/backend/internal/conflicts"
 	"schedula/backend/internal/domain"
 	"schedula/backend/internal/store"
+	"schedula/backend/internal/store/pgerrors"
+	"schedula/backend/internal/timing"
 )
 
 type AppointmentRepo struct {
-	db *bun.DB
+	db   *bun.DB
+	opts RepoOptions
 }
 
 func NewAppointmentRepo(db *bun.DB) *AppointmentRepo {
-	return &AppointmentRepo{db: db}
+	return NewAppointmentRepoWithOptions(db, RepoOptions{})
+}
+
+func NewAppointmentRepoWithOptions(db *bun.DB, opts RepoOptions) *AppointmentRepo {
+	return &AppointmentRepo{db: db, opts: opts}
 }
 
 type calendarTx struct {
 	tx bun.Tx
+	// policy judges overlapping bookings; nil is conflicts.Strict.
+	policy conflicts.Policy
+}
+
+func (r calendarTx) conflictPolicy() conflicts.Policy {
+	if r.policy == nil {
+		return conflicts.Strict{}
+	}
+	return r.policy
+}
+
+func (r *AppointmentRepo) conflictPolicy() conflicts.Policy {
+	if r.opts.ConflictPolicy == nil {
+		return conflicts.Strict{}
+	}
+	return r.opts.ConflictPolicy
 }
 
 func (r *AppointmentRepo) Create(ctx context.Context, appt domain.Appointment) (domain.Appointment, error) {
@@ -42,21 +70,57 @@ func (r *AppointmentRepo) Create(ctx context.Context, appt domain.Appointment) (
 	return out, nil
 }
 
-func (r *AppointmentRepo) List(ctx context.Context, userID string, windowStart, windowEnd time.Time) ([]domain.Appointment, error) {
+func (r *AppointmentRepo) List(ctx context.Context, userID string, windowStart, windowEnd time.Time, filter store.AppointmentFilter) ([]domain.Appointment, error) {
 	var rows []domain.Appointment
-	err := r.db.NewSelect().
+	q := r.db.NewSelect().
 		Model(&rows).
 		Where("user_id = ?", userID).
 		Where("start_time < ?", windowEnd).
-		Where("end_time > ?", windowStart).
-		OrderExpr("start_time ASC").
-		Scan(ctx)
-	if err != nil {
+		Where("(end_time > ? OR start_time = ?)", windowStart, windowStart).
+		OrderExpr("start_time ASC")
+	if len(filter.Metadata) > 0 {
+		contains, err := json.Marshal(filter.Metadata)
+		if err != nil {
+			return nil, err
+		}
+		q = q.Where("metadata @> ?::jsonb", string(contains))
+	}
+	if filter.ContactID != nil {
+		q = q.Where("contact_id = ?", *filter.ContactID)
+	}
+	if prefix, ok := strings.CutSuffix(filter.Source, ":"); ok {
+		q = q.Where("starts_with(source, ?)", prefix+":")
+	} else if filter.Source != "" {
+		q = q.Where("source = ?", filter.Source)
+	}
+	if err := r.checkPlan(ctx, q, "appointments"); err != nil {
 		return nil, err
 	}
+	err := q.Scan(ctx)
+	if err != nil {
+		return nil, pgerrors.Classify(err)
+	}
 	return rows, nil
 }
 
+func (r *AppointmentRepo) GetByExternalRef(ctx context.Context, userID, system, externalID string) (domain.Appointment, error) {
+	var row domain.Appointment
+	err := r.db.NewSelect().
+		Model(&row).
+		Where("user_id = ?", userID).
+		Where("external_system = ?", system).
+		Where("external_id = ?", externalID).
+		Limit(1).
+		Scan(ctx)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return domain.Appointment{}, store.ErrNotFound
+		}
+		return domain.Appointment{}, pgerrors.Classify(err)
+	}
+	return row, nil
+}
+
 func (r *AppointmentRepo) Delete(ctx context.Context, userID string, appointmentID uuid.UUID) error {
 	return r.InUserTransaction(ctx, userID, func(ctx context.Context, tx store.CalendarTx) error {
 		return tx.DeleteAppointment(ctx, userID, appointmentID)
@@ -66,13 +130,51 @@ func (r *AppointmentRepo) Delete(ctx context.Context, userID string, appointment
 func (r *AppointmentRepo) CreateRecurringSeries(ctx context.Context, series domain.RecurringSeries) (domain.RecurringSeries, error) {
 	var out domain.RecurringSeries
 	err := r.InUserTransaction(ctx, series.UserID, func(ctx context.Context, tx store.CalendarTx) error {
-		if err := ensureNoRecurringSeriesConflicts(ctx, tx, series); err != nil {
+		j, err := judgeRecurringSeries(ctx, tx, r.conflictPolicy(), series, 0)
+		if err != nil {
+			return err
+		}
+		s, err := tx.CreateRecurringSeries(ctx, series)
+		if err != nil {
+			return err
+		}
+		s.Warnings = j.warnings
+		out = s
+		return nil
+	})
+	if err != nil {
+		return domain.RecurringSeries{}, err
+	}
+	return out, nil
+}
+
+// CreateRecurringSeriesSkippingConflicts creates series like
+// CreateRecurringSeries, but when at most maxSkips occurrences conflict it adds
+// a skip exception for each of them instead of failing. The skipped starts are
+// returned in SkippedOccurrences.
+func (r *AppointmentRepo) CreateRecurringSeriesSkippingConflicts(ctx context.Context, series domain.RecurringSeries, maxSkips int) (domain.RecurringSeries, error) {
+	var out domain.RecurringSeries
+	err := r.InUserTransaction(ctx, series.UserID, func(ctx context.Context, tx store.CalendarTx) error {
+		j, err := judgeRecurringSeries(ctx, tx, r.conflictPolicy(), series, maxSkips)
+		if err != nil {
 			return err
 		}
 		s, err := tx.CreateRecurringSeries(ctx, series)
 		if err != nil {
 			return err
 		}
+		for _, start := range j.rejected {
+			_, err := tx.UpsertRecurringException(ctx, domain.RecurringException{
+				SeriesID:        s.ID,
+				OccurrenceStart: start,
+				Kind:            domain.RecurringExceptionKindSkip,
+			})
+			if err != nil {
+				return err
+			}
+		}
+		s.SkippedOccurrences = j.rejected
+		s.Warnings = j.warnings
 		out = s
 		return nil
 	})
@@ -84,56 +186,406 @@ func (r *AppointmentRepo) CreateRecurringSeries(ctx context.Context, series doma
 
 func (r *AppointmentRepo) ListOccurrences(ctx context.Context, userID string, windowStart, windowEnd time.Time) ([]domain.RecurringOccurrence, error) {
 	var seriesRows []domain.RecurringSeries
-	err := r.db.NewSelect().
+	q := r.db.NewSelect().
 		Model(&seriesRows).
-		Where("user_id = ?", userID).
-		Where("dtstart < ?", windowEnd).
-		Scan(ctx)
-	if err != nil {
+		Where("user_id = ?", userID)
+	q = whereSeriesActiveIn(q, windowStart, windowEnd)
+	if err := r.checkPlan(ctx, q, "recurring_series"); err != nil {
 		return nil, err
 	}
+	err := q.Scan(ctx)
+	if err != nil {
+		return nil, pgerrors.Classify(err)
+	}
 
 	out := make([]domain.RecurringOccurrence, 0, len(seriesRows))
-	exWindowStart := windowStart.Add(-14 * 24 * time.Hour)
-	exWindowEnd := windowEnd.Add(14 * 24 * time.Hour)
-
 	for _, s := range seriesRows {
-		occs, err := domain.GenerateWeeklyOccurrences(s, windowStart, windowEnd)
+		occs, err := r.ListSeriesOccurrences(ctx, s, windowStart, windowEnd)
 		if err != nil {
 			return nil, err
 		}
-		if len(occs) == 0 {
-			continue
+		out = append(out, occs...)
+	}
+
+	sort.Slice(out, func(i, j int) bool {
+		return out[i].StartTime.Before(out[j].StartTime)
+	})
+
+	return out, nil
+}
+
+// whereSeriesActiveIn keeps series whose first occurrence starts before the
+// window ends and whose last possible occurrence ends after it starts. Series
+// bounded only by count are kept and left to expansion.
+func whereSeriesActiveIn(q *bun.SelectQuery, windowStart, windowEnd time.Time) *bun.SelectQuery {
+	return q.
+		Where("dtstart < ?", windowEnd).
+		Where("until IS NULL OR until + duration_seconds * interval '1 second' > ?", windowStart)
+}
+
+// ListActiveRecurringSeries keeps series that may still have an occurrence
+// ending after at. Every occurrence starts within the lookahead of dtstart, so
+// that bounds series with only a count.
+func (r *AppointmentRepo) ListActiveRecurringSeries(ctx context.Context, userID string, at time.Time) ([]domain.RecurringSeries, error) {
+	return listActiveRecurringSeries(ctx, r.db, userID, at)
+}
+
+// ListSeriesOnWeekdays filters on by_weekday_mask, which every series write
+// keeps in step with byweekday.
+func (r *AppointmentRepo) ListSeriesOnWeekdays(ctx context.Context, userID string, weekdays domain.WeekdayMask) ([]domain.RecurringSeries, error) {
+	return listSeriesOnWeekdays(ctx, r.db, userID, weekdays)
+}
+
+func listSeriesOnWeekdays(ctx context.Context, db bun.IDB, userID string, weekdays domain.WeekdayMask) ([]domain.RecurringSeries, error) {
+	var rows []domain.RecurringSeries
+	err := db.NewSelect().
+		Model(&rows).
+		Where("user_id = ?", userID).
+		Where("by_weekday_mask & ? <> 0", int16(weekdays)).
+		OrderExpr("dtstart, id").
+		Scan(ctx)
+	if err != nil {
+		return nil, pgerrors.Classify(err)
+	}
+	return rows, nil
+}
+
+func listActiveRecurringSeries(ctx context.Context, db bun.IDB, userID string, at time.Time) ([]domain.RecurringSeries, error) {
+	var rows []domain.RecurringSeries
+	err := db.NewSelect().
+		Model(&rows).
+		Where("user_id = ?", userID).
+		Where("dtstart + duration_seconds * interval '1 second' > ?", at.Add(-store.RecurringConflictLookahead)).
+		Where("until IS NULL OR until + duration_seconds * interval '1 second' > ?", at).
+		OrderExpr("dtstart, id").
+		Scan(ctx)
+	if err != nil {
+		return nil, pgerrors.Classify(err)
+	}
+	return rows, nil
+}
+
+func (r *AppointmentRepo) GetRecurringSeries(ctx context.Context, userID string, seriesID uuid.UUID) (domain.RecurringSeries, error) {
+	var row domain.RecurringSeries
+	err := r.db.NewSelect().
+		Model(&row).
+		Where("user_id = ?", userID).
+		Where("id = ?", seriesID).
+		Limit(1).
+		Scan(ctx)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return domain.RecurringSeries{}, store.ErrNotFound
 		}
+		return domain.RecurringSeries{}, pgerrors.Classify(err)
+	}
+	return row, nil
+}
 
-		var exRows []domain.RecurringException
-		err = r.db.NewSelect().
-			Model(&exRows).
-			Where("series_id = ?", s.ID).
-			Where("occurrence_start >= ?", exWindowStart).
-			Where("occurrence_start < ?", exWindowEnd).
-			Scan(ctx)
+// ListSeriesExceptions returns every exception of the series, oldest
+// occurrence first.
+func (r *AppointmentRepo) ListSeriesExceptions(ctx context.Context, seriesID uuid.UUID) ([]domain.RecurringException, error) {
+	var rows []domain.RecurringException
+	err := r.db.NewSelect().
+		Model(&rows).
+		Where("series_id = ?", seriesID).
+		OrderExpr("occurrence_start ASC").
+		Scan(ctx)
+	if err != nil {
+		return nil, pgerrors.Classify(err)
+	}
+	return rows, nil
+}
+
+// DeleteRecurringExceptions removes the given exceptions of the series and
+// reports how many existed. It takes the owner's calendar lock so the change
+// log entry is ordered with the user's other writes.
+func (r *AppointmentRepo) DeleteRecurringExceptions(ctx context.Context, seriesID uuid.UUID, exceptionIDs []uuid.UUID) (int, error) {
+	if len(exceptionIDs) == 0 {
+		return 0, nil
+	}
+	var n int64
+	err := r.db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		var userID string
+		err := tx.NewSelect().
+			Model((*domain.RecurringSeries)(nil)).
+			Column("user_id").
+			Where("id = ?", seriesID).
+			Scan(ctx, &userID)
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil
+		}
 		if err != nil {
-			return nil, err
+			return err
+		}
+		if err := r.lockCalendar(ctx, tx, userID); err != nil {
+			return err
 		}
 
-		out = append(out, applyRecurringExceptions(occs, exRows, windowStart, windowEnd)...)
+		res, err := tx.NewDelete().
+			Model((*domain.RecurringException)(nil)).
+			Where("series_id = ?", seriesID).
+			Where("id IN (?)", bun.In(exceptionIDs)).
+			Exec(ctx)
+		if err != nil {
+			return err
+		}
+		if n, err = res.RowsAffected(); err != nil || n == 0 {
+			return err
+		}
+		return recordChange(ctx, tx, userID, domain.ChangeEntitySeries, seriesID, domain.ChangeOpUpdated)
+	})
+	if err != nil {
+		return 0, pgerrors.Classify(err)
 	}
+	return int(n), nil
+}
 
-	sort.Slice(out, func(i, j int) bool {
-		return out[i].StartTime.Before(out[j].StartTime)
+// UpdateRecurringSeriesEnd stores series' Until and Count and, in the same
+// transaction, deletes the exceptions a shorter rule leaves dated after its
+// last occurrence. It returns how many were deleted, or store.ErrNotFound if
+// the series is not the user's.
+func (r *AppointmentRepo) UpdateRecurringSeriesEnd(ctx context.Context, series domain.RecurringSeries) (int, error) {
+	var removed int
+	err := r.db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		if err := r.lockCalendar(ctx, tx, series.UserID); err != nil {
+			return err
+		}
+		var err error
+		removed, err = setSeriesEnd(ctx, tx, series)
+		return err
 	})
+	if err != nil {
+		return 0, pgerrors.Classify(err)
+	}
+	return removed, nil
+}
 
-	return out, nil
+// setSeriesEnd is UpdateRecurringSeriesEnd within a transaction that holds
+// the calendar lock.
+func setSeriesEnd(ctx context.Context, tx bun.Tx, series domain.RecurringSeries) (int, error) {
+	res, err := tx.NewUpdate().
+		Model((*domain.RecurringSeries)(nil)).
+		Set("until = ?", series.Until).
+		Set("count = ?", series.Count).
+		Set("updated_at = ?", time.Now().UTC()).
+		Where("user_id = ?", series.UserID).
+		Where("id = ?", series.ID).
+		Exec(ctx)
+	if err != nil {
+		return 0, err
+	}
+	if n, err := res.RowsAffected(); err != nil || n == 0 {
+		if err == nil {
+			err = store.ErrNotFound
+		}
+		return 0, err
+	}
+
+	var exRows []domain.RecurringException
+	if err := tx.NewSelect().Model(&exRows).Where("series_id = ?", series.ID).Scan(ctx); err != nil {
+		return 0, err
+	}
+	orphaned, err := exceptionsBeyondEnd(series, exRows)
+	if err != nil {
+		return 0, err
+	}
+	if len(orphaned) > 0 {
+		if _, err := tx.NewDelete().
+			Model((*domain.RecurringException)(nil)).
+			Where("series_id = ?", series.ID).
+			Where("id IN (?)", bun.In(orphaned)).
+			Exec(ctx); err != nil {
+			return 0, err
+		}
+	}
+	if err := recordChange(ctx, tx, series.UserID, domain.ChangeEntitySeries, series.ID, domain.ChangeOpUpdated); err != nil {
+		return 0, err
+	}
+	return len(orphaned), nil
+}
+
+// exceptionsBeyondEnd returns the ids of the exceptions dated after the
+// series' last occurrence. Exceptions before the first occurrence or off the
+// weekly pattern are left for RepairRecurringSeries to report.
+func exceptionsBeyondEnd(series domain.RecurringSeries, exceptions []domain.RecurringException) ([]uuid.UUID, error) {
+	occs, err := domain.GenerateWeeklyOccurrences(series, series.DTStart, domain.SeriesHorizonEnd(series, store.RecurringConflictLookahead))
+	if err != nil {
+		return nil, err
+	}
+	var ids []uuid.UUID
+	for _, ex := range exceptions {
+		at := ex.OccurrenceStart.UTC()
+		if at.Before(series.DTStart) {
+			continue
+		}
+		if len(occs) == 0 || at.After(occs[len(occs)-1].StartTime) {
+			ids = append(ids, ex.ID)
+		}
+	}
+	return ids, nil
+}
+
+// SkipRecurringOccurrences writes a skip exception for each occurrence start
+// of the user's series in one transaction, replacing any override already
+// there. It returns store.ErrNotFound if the series is not the user's.
+func (r *AppointmentRepo) SkipRecurringOccurrences(ctx context.Context, userID string, seriesID uuid.UUID, occurrenceStarts []time.Time) (int, error) {
+	if len(occurrenceStarts) == 0 {
+		return 0, nil
+	}
+	err := r.db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		if err := r.lockCalendar(ctx, tx, userID); err != nil {
+			return err
+		}
+		exists, err := tx.NewSelect().
+			Model((*domain.RecurringSeries)(nil)).
+			Where("user_id = ?", userID).
+			Where("id = ?", seriesID).
+			Exists(ctx)
+		if err != nil {
+			return err
+		}
+		if !exists {
+			return store.ErrNotFound
+		}
+
+		rows := make([]domain.RecurringException, 0, len(occurrenceStarts))
+		for _, start := range occurrenceStarts {
+			rows = append(rows, domain.RecurringException{
+				SeriesID:        seriesID,
+				OccurrenceStart: start.UTC(),
+				Kind:            domain.RecurringExceptionKindSkip,
+			})
+		}
+		_, err = tx.NewInsert().
+			Model(&rows).
+			On("CONFLICT (series_id, occurrence_start) DO UPDATE").
+			Set("kind = EXCLUDED.kind").
+			Set("override_start = NULL").
+			Set("override_end = NULL").
+			Set("override_title = NULL").
+			Set("override_notes = NULL").
+			Set("updated_at = EXCLUDED.updated_at").
+			Exec(ctx)
+		if err != nil {
+			return err
+		}
+		return recordChange(ctx, tx, userID, domain.ChangeEntitySeries, seriesID, domain.ChangeOpUpdated)
+	})
+	if err != nil {
+		return 0, pgerrors.Classify(err)
+	}
+	return len(occurrenceStarts), nil
+}
+
+// ListSeriesOccurrences expands a single series over the window with its
+// skip and override exceptions applied.
+func (r *AppointmentRepo) ListSeriesOccurrences(ctx context.Context, series domain.RecurringSeries, windowStart, windowEnd time.Time) ([]domain.RecurringOccurrence, error) {
+	expandStart := time.Now()
+	occs, err := domain.GenerateWeeklyOccurrences(series, windowStart, windowEnd)
+	timing.Since(ctx, timing.Expansion, expandStart)
+	if err != nil {
+		return nil, err
+	}
+	if len(occs) == 0 {
+		return nil, nil
+	}
+
+	var exRows []domain.RecurringException
+	err = r.db.NewSelect().
+		Model(&exRows).
+		Where("series_id = ?", series.ID).
+		Where("occurrence_start >= ?", windowStart.Add(-14*24*time.Hour)).
+		Where("occurrence_start < ?", windowEnd.Add(14*24*time.Hour)).
+		Scan(ctx)
+	if err != nil {
+		return nil, pgerrors.Classify(err)
+	}
+
+	return applyRecurringExceptions(occs, exRows, windowStart, windowEnd), nil
+}
+
+// MarkAttendance upserts the participant's attendance for one occurrence.
+// Occurrences cancelled by a skip exception report store.ErrNotFound.
+func (r *AppointmentRepo) MarkAttendance(ctx context.Context, attendance domain.OccurrenceAttendance) (domain.OccurrenceAttendance, error) {
+	skipped, err := r.db.NewSelect().
+		Model((*domain.RecurringException)(nil)).
+		Where("series_id = ?", attendance.SeriesID).
+		Where("occurrence_start = ?", attendance.OccurrenceStart).
+		Where("kind = ?", domain.RecurringExceptionKindSkip).
+		Exists(ctx)
+	if err != nil {
+		return domain.OccurrenceAttendance{}, pgerrors.Classify(err)
+	}
+	if skipped {
+		return domain.OccurrenceAttendance{}, store.ErrNotFound
+	}
+
+	m := domain.OccurrenceAttendance{
+		ID:              attendance.ID,
+		SeriesID:        attendance.SeriesID,
+		OccurrenceStart: attendance.OccurrenceStart,
+		ParticipantID:   attendance.ParticipantID,
+		Status:          attendance.Status,
+		CreatedAt:       attendance.CreatedAt,
+		UpdatedAt:       attendance.UpdatedAt,
+	}
+
+	_, err = r.db.NewInsert().
+		Model(&m).
+		On("CONFLICT (series_id, occurrence_start, participant_id) DO UPDATE").
+		Set("status = EXCLUDED.status").
+		Set("updated_at = EXCLUDED.updated_at").
+		Returning("*").
+		Exec(ctx)
+	if err != nil {
+		return domain.OccurrenceAttendance{}, pgerrors.Classify(err)
+	}
+	return m, nil
+}
+
+func (r *AppointmentRepo) ListAttendance(ctx context.Context, seriesID uuid.UUID) ([]domain.OccurrenceAttendance, error) {
+	var rows []domain.OccurrenceAttendance
+	err := r.db.NewSelect().
+		Model(&rows).
+		Where("series_id = ?", seriesID).
+		OrderExpr("occurrence_start ASC, participant_id ASC").
+		Scan(ctx)
+	if err != nil {
+		return nil, pgerrors.Classify(err)
+	}
+	return rows, nil
 }
 
 func (r *AppointmentRepo) InUserTransaction(ctx context.Context, userID string, fn func(ctx context.Context, tx store.CalendarTx) error) error {
-	return r.db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
-		if err := lockUserCalendar(ctx, tx, userID); err != nil {
+	err := r.db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		if err := r.lockCalendar(ctx, tx, userID); err != nil {
 			return err
 		}
-		return fn(ctx, calendarTx{tx: tx})
+		return fn(ctx, calendarTx{tx: tx, policy: r.opts.ConflictPolicy})
 	})
+	return pgerrors.Classify(err)
+}
+
+func sameOptionalID(a, b *uuid.UUID) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return *a == *b
+}
+
+// lockCalendar takes the user's calendar lock, reporting how long it waited
+// to ObserveLockWait and to the request's timing breakdown.
+func (r *AppointmentRepo) lockCalendar(ctx context.Context, tx bun.Tx, userID string) error {
+	start := time.Now()
+	if err := lockUserCalendar(ctx, tx, userID); err != nil {
+		return err
+	}
+	timing.Since(ctx, timing.LockWait, start)
+	if r.opts.ObserveLockWait != nil {
+		r.opts.ObserveLockWait(ctx, userID, time.Since(start))
+	}
+	return nil
 }
 
 func lockUserCalendar(ctx context.Context, tx bun.Tx, userID string) error {
@@ -143,45 +595,87 @@ func lockUserCalendar(ctx context.Context, tx bun.Tx, userID string) error {
 
 func (r calendarTx) CreateAppointment(ctx context.Context, appt domain.Appointment) (domain.Appointment, error) {
 	m := domain.Appointment{
-		ID:        appt.ID,
-		UserID:    appt.UserID,
-		Title:     appt.Title,
-		Notes:     appt.Notes,
-		StartTime: appt.StartTime,
-		EndTime:   appt.EndTime,
-		CreatedAt: appt.CreatedAt,
-		UpdatedAt: appt.UpdatedAt,
+		ID:             appt.ID,
+		UserID:         appt.UserID,
+		Title:          appt.Title,
+		Notes:          appt.Notes,
+		PrivateNotes:   appt.PrivateNotes,
+		StartTime:      appt.StartTime,
+		EndTime:        appt.EndTime,
+		CreatedAt:      appt.CreatedAt,
+		UpdatedAt:      appt.UpdatedAt,
+		Metadata:       appt.Metadata,
+		ExternalSystem: appt.ExternalSystem,
+		ExternalID:     appt.ExternalID,
+		Timezone:       appt.Timezone,
+		CreatedBy:      appt.CreatedBy,
+		CheckedInAt:    appt.CheckedInAt,
+		CheckedOutAt:   appt.CheckedOutAt,
+		ContactID:      appt.ContactID,
+		ProgramID:      appt.ProgramID,
+		Source:         appt.Source,
+		Kind:           appt.Kind,
+	}
+
+	if !appt.Milestone() {
+		holds, err := r.ListActiveSlotHolds(ctx, appt.UserID, appt.StartTime, appt.EndTime)
+		if err != nil {
+			return domain.Appointment{}, pgerrors.Classify(err)
+		}
+		if len(holds) > 0 {
+			return domain.Appointment{}, store.ErrConflict
+		}
+		warnings, allowed, err := r.judgeOverlaps(ctx, appt)
+		if err != nil {
+			return domain.Appointment{}, err
+		}
+		m.OverlapAllowed = allowed
+		appt.OverlapAllowed = allowed
+		appt.Warnings = append(appt.Warnings, warnings...)
 	}
 
 	_, err := r.tx.NewInsert().Model(&m).Exec(ctx)
 	if err != nil {
-		var pgErr *pgconn.PgError
-		if errors.As(err, &pgErr) {
-			if pgErr.Code == "23P01" && pgErr.ConstraintName == "appointments_no_overlap" {
-				return domain.Appointment{}, store.ErrConflict
+		if pgerrors.IsExclusionViolation(err) && pgerrors.Constraint(err) == "appointments_no_overlap" {
+			return domain.Appointment{}, store.ErrConflict
+		}
+		if pgerrors.IsUniqueViolation(err) && pgerrors.Constraint(err) == "appointments_external_ref_idx" {
+			return domain.Appointment{}, store.ErrDuplicate
+		}
+		if pgerrors.IsUniqueViolation(err) {
+			var existing domain.Appointment
+			selectErr := r.tx.NewSelect().
+				Model(&existing).
+				Where("id = ?", m.ID).
+				Limit(1).
+				Scan(ctx)
+			if selectErr != nil {
+				return domain.Appointment{}, pgerrors.Classify(err)
 			}
-			if pgErr.Code == "23505" {
-				var existing domain.Appointment
-				selectErr := r.tx.NewSelect().
-					Model(&existing).
-					Where("id = ?", m.ID).
-					Limit(1).
-					Scan(ctx)
-				if selectErr != nil {
-					return domain.Appointment{}, err
-				}
-
-				if existing.UserID != appt.UserID ||
-					existing.Title != appt.Title ||
-					existing.Notes != appt.Notes ||
-					!existing.StartTime.Equal(appt.StartTime) ||
-					!existing.EndTime.Equal(appt.EndTime) {
-					return domain.Appointment{}, store.ErrIdempotencyConflict
-				}
-
-				return existing, nil
+
+			if existing.UserID != appt.UserID ||
+				existing.Title != appt.Title ||
+				existing.Notes != appt.Notes ||
+				existing.PrivateNotes != appt.PrivateNotes ||
+				!existing.StartTime.Equal(appt.StartTime) ||
+				!existing.EndTime.Equal(appt.EndTime) ||
+				!maps.Equal(existing.Metadata, appt.Metadata) ||
+				existing.ExternalSystem != appt.ExternalSystem ||
+				existing.ExternalID != appt.ExternalID ||
+				!sameOptionalID(existing.ContactID, appt.ContactID) ||
+				!sameOptionalID(existing.ProgramID, appt.ProgramID) ||
+				storedKind(existing.Kind) != storedKind(appt.Kind) ||
+				existing.Source != appt.Source ||
+				existing.Timezone != appt.Timezone ||
+				existing.CreatedBy != appt.CreatedBy {
+				return domain.Appointment{}, store.ErrIdempotencyConflict
 			}
+
+			return existing, nil
 		}
+		return domain.Appointment{}, pgerrors.Classify(err)
+	}
+	if err := recordChange(ctx, r.tx, m.UserID, domain.ChangeEntityAppointment, m.ID, domain.ChangeOpCreated); err != nil {
 		return domain.Appointment{}, err
 	}
 
@@ -189,6 +683,70 @@ func (r calendarTx) CreateAppointment(ctx context.Context, appt domain.Appointme
 	return appt, nil
 }
 
+// storedKind is the kind a row inserted with k holds: the column default
+// fills in an empty one.
+func storedKind(k domain.AppointmentKind) domain.AppointmentKind {
+	if k == "" {
+		return domain.AppointmentKindEvent
+	}
+	return k
+}
+
+// judgeOverlaps asks the conflict policy about the appointments appt would
+// overlap, flagged ones included, which the overlap constraint cannot see. It
+// returns the warnings to report and whether appt must be stored outside
+// the constraint. A rejected overlap is store.ErrConflict. The row appt
+// replays, when it has an id, is not an overlap.
+func (r calendarTx) judgeOverlaps(ctx context.Context, appt domain.Appointment) ([]domain.Warning, bool, error) {
+	existing, err := r.ListAppointments(ctx, appt.UserID, appt.StartTime, appt.EndTime)
+	if err != nil {
+		return nil, false, err
+	}
+	booking := appointmentEntry(appt)
+	var overlapping []conflicts.Entry
+	for _, e := range existing {
+		if appt.ID != uuid.Nil && e.ID == appt.ID {
+			continue
+		}
+		overlapping = append(overlapping, appointmentEntry(e))
+	}
+	if len(overlapping) == 0 {
+		return nil, false, nil
+	}
+	return judge(r.conflictPolicy(), booking, overlapping)
+}
+
+// judge turns the policy's verdict on booking into warnings, or
+// store.ErrConflict when it rejects. allowed reports that the booking stands
+// despite overlapping.
+func judge(policy conflicts.Policy, booking conflicts.Entry, overlapping []conflicts.Entry) ([]domain.Warning, bool, error) {
+	switch v := policy.Decide(booking, overlapping); v {
+	case conflicts.Reject:
+		return nil, false, store.ErrConflict
+	case conflicts.Allow:
+		return nil, true, nil
+	case conflicts.Warn:
+		warnings := make([]domain.Warning, 0, len(overlapping))
+		for _, e := range overlapping {
+			warnings = append(warnings, domain.Warning{Code: domain.WarningCodeOverlap, Message: fmt.Sprintf("Overlaps %q.", e.Title)})
+		}
+		return warnings, true, nil
+	default:
+		return nil, false, fmt.Errorf("conflict policy %q returned unknown verdict %q", policy.Name(), v)
+	}
+}
+
+func appointmentEntry(a domain.Appointment) conflicts.Entry {
+	return conflicts.Entry{
+		Kind:     conflicts.EntryAppointment,
+		ID:       a.ID,
+		Title:    a.Title,
+		Start:    a.StartTime.UTC(),
+		End:      a.EndTime.UTC(),
+		Resource: conflicts.Resource(a.Metadata),
+	}
+}
+
 func (r calendarTx) ListAppointments(ctx context.Context, userID string, windowStart, windowEnd time.Time) ([]domain.Appointment, error) {
 	var rows []domain.Appointment
 	err := r.tx.NewSelect().
@@ -196,6 +754,7 @@ func (r calendarTx) ListAppointments(ctx context.Context, userID string, windowS
 		Where("user_id = ?", userID).
 		Where("start_time < ?", windowEnd).
 		Where("end_time > ?", windowStart).
+		Where("kind <> ?", domain.AppointmentKindMilestone).
 		OrderExpr("start_time ASC").
 		Scan(ctx)
 	if err != nil {
@@ -204,6 +763,23 @@ func (r calendarTx) ListAppointments(ctx context.Context, userID string, windowS
 	return rows, nil
 }
 
+func (r calendarTx) GetAppointment(ctx context.Context, userID string, appointmentID uuid.UUID) (domain.Appointment, error) {
+	var appt domain.Appointment
+	err := r.tx.NewSelect().
+		Model(&appt).
+		Where("user_id = ?", userID).
+		Where("id = ?", appointmentID).
+		Limit(1).
+		Scan(ctx)
+	if errors.Is(err, sql.ErrNoRows) {
+		return domain.Appointment{}, store.ErrNotFound
+	}
+	if err != nil {
+		return domain.Appointment{}, err
+	}
+	return appt, nil
+}
+
 func (r calendarTx) DeleteAppointment(ctx context.Context, userID string, appointmentID uuid.UUID) error {
 	res, err := r.tx.NewDelete().
 		Model((*domain.Appointment)(nil)).
@@ -220,7 +796,7 @@ func (r calendarTx) DeleteAppointment(ctx context.Context, userID string, appoin
 	if affected == 0 {
 		return store.ErrNotFound
 	}
-	return nil
+	return recordChange(ctx, r.tx, userID, domain.ChangeEntityAppointment, appointmentID, domain.ChangeOpDeleted)
 }
 
 func (r calendarTx) CreateRecurringSeries(ctx context.Context, series domain.RecurringSeries) (domain.RecurringSeries, error) {
@@ -235,25 +811,89 @@ func (r calendarTx) CreateRecurringSeries(ctx context.Context, series domain.Rec
 		Frequency:       series.Frequency,
 		Interval:        series.Interval,
 		ByWeekday:       series.ByWeekday,
+		ByWeekdayMask:   domain.WeekdayMaskOf(series.ByWeekday),
 		Until:           series.Until,
 		Count:           series.Count,
+		WeekStart:       series.WeekStart,
+		DSTGap:          series.DSTGap,
+		DSTAmbiguous:    series.DSTAmbiguous,
+		WeekdayTimes:    series.WeekdayTimes,
 		CreatedAt:       series.CreatedAt,
 		UpdatedAt:       series.UpdatedAt,
+		Metadata:        series.Metadata,
+		CreatedBy:       series.CreatedBy,
+		ProgramID:       series.ProgramID,
 	}
 
 	_, err := r.tx.NewInsert().Model(&m).Exec(ctx)
 	if err != nil {
 		return domain.RecurringSeries{}, err
 	}
+	if err := recordChange(ctx, r.tx, m.UserID, domain.ChangeEntitySeries, m.ID, domain.ChangeOpCreated); err != nil {
+		return domain.RecurringSeries{}, err
+	}
 	series.ID = m.ID
 	return series, nil
 }
 
-func (r calendarTx) ListRecurringSeries(ctx context.Context, userID string) ([]domain.RecurringSeries, error) {
+// ListRecurringSeries returns the user's series that can have an occurrence
+// in the window.
+func (r calendarTx) ListRecurringSeries(ctx context.Context, userID string, windowStart, windowEnd time.Time) ([]domain.RecurringSeries, error) {
 	var rows []domain.RecurringSeries
-	err := r.tx.NewSelect().
+	q := r.tx.NewSelect().
 		Model(&rows).
-		Where("user_id = ?", userID).
+		Where("user_id = ?", userID)
+	err := whereSeriesActiveIn(q, windowStart, windowEnd).
+		OrderExpr("dtstart ASC").
+		Scan(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return rows, nil
+}
+
+// maxWeekdayFilterDuration is the longest series the conflict pre-filter
+// judges by weekday. An occurrence no longer than a day less the hour a DST
+// change can add ends by the day after its start, so two such series in
+// one time zone can only overlap when their start days are at most a day
+// apart.
+const maxWeekdayFilterDuration = 23 * time.Hour
+
+// weekdayFilterMask returns the start days a series must have for its
+// occurrences to overlap series's, or false when series is too long to
+// filter by weekday at all.
+func weekdayFilterMask(series domain.RecurringSeries) (domain.WeekdayMask, bool) {
+	if time.Duration(series.DurationSeconds)*time.Second > maxWeekdayFilterDuration {
+		return 0, false
+	}
+	return domain.WeekdayMaskOf(series.ByWeekday).Widen(1), true
+}
+
+// ListSeriesConflictCandidates keeps every series it cannot rule out from
+// SQL alone: one in another time zone, whose local days need not line up;
+// one too long to judge by weekday; or one with an override near the window,
+// which may move an occurrence to any day. The rest must start within a day
+// of one of series's weekdays. The override window matches the one
+// judgeSeriesOccurrences reads exceptions from.
+func (r calendarTx) ListSeriesConflictCandidates(ctx context.Context, series domain.RecurringSeries, windowStart, windowEnd time.Time) ([]domain.RecurringSeries, error) {
+	mask, ok := weekdayFilterMask(series)
+	if !ok {
+		return r.ListRecurringSeries(ctx, series.UserID, windowStart, windowEnd)
+	}
+
+	var rows []domain.RecurringSeries
+	q := r.tx.NewSelect().
+		Model(&rows).
+		Where("user_id = ?", series.UserID).
+		WhereGroup(" AND ", func(q *bun.SelectQuery) *bun.SelectQuery {
+			return q.
+				Where("timezone <> ?", series.Timezone).
+				WhereOr("duration_seconds > ?", int(maxWeekdayFilterDuration/time.Second)).
+				WhereOr("by_weekday_mask & ? <> 0", int16(mask)).
+				WhereOr("EXISTS (SELECT 1 FROM recurring_exceptions AS e WHERE e.series_id = recurring_series.id AND e.kind = ? AND e.occurrence_start >= ? AND e.occurrence_start < ?)",
+					domain.RecurringExceptionKindOverride, windowStart.Add(-14*24*time.Hour), windowEnd.Add(14*24*time.Hour))
+		})
+	err := whereSeriesActiveIn(q, windowStart, windowEnd).
 		OrderExpr("dtstart ASC").
 		Scan(ctx)
 	if err != nil {
@@ -302,6 +942,9 @@ func (r calendarTx) UpsertRecurringException(ctx context.Context, ex domain.Recu
 	if err != nil {
 		return domain.RecurringException{}, err
 	}
+	if err := recordSeriesUpdate(ctx, r.tx, m.SeriesID); err != nil {
+		return domain.RecurringException{}, err
+	}
 	return m, nil
 }
 
@@ -321,7 +964,7 @@ func (r calendarTx) DeleteRecurringSeries(ctx context.Context, userID string, se
 	if affected == 0 {
 		return store.ErrNotFound
 	}
-	return nil
+	return recordChange(ctx, r.tx, userID, domain.ChangeEntitySeries, seriesID, domain.ChangeOpDeleted)
 }
 
 type timeSpan struct {
@@ -330,19 +973,57 @@ type timeSpan struct {
 }
 
 func ensureNoRecurringSeriesConflicts(ctx context.Context, tx store.CalendarTx, series domain.RecurringSeries) error {
-	windowStart := series.DTStart.UTC()
-	windowEnd := windowStart.Add(store.RecurringConflictLookahead)
-	if series.Until != nil && series.Until.UTC().Before(windowEnd) {
-		windowEnd = series.Until.UTC()
+	_, err := judgeRecurringSeries(ctx, tx, conflicts.Strict{}, series, 0)
+	return err
+}
+
+// recurringSeriesConflicts returns the start of each occurrence of series that
+// overlaps an existing appointment or occurrence. Occurrences of series that
+// overlap each other are reported as store.ErrConflict, since skipping dates
+// cannot fix the rule itself. When series is already stored, its own row is
+// left out of the existing bookings.
+func recurringSeriesConflicts(ctx context.Context, tx store.CalendarTx, series domain.RecurringSeries) ([]time.Time, error) {
+	j, err := judgeSeriesOccurrences(ctx, tx, conflicts.Strict{}, series)
+	return j.rejected, err
+}
+
+// seriesJudgement is the conflict policy's view of a series' occurrences:
+// the starts it rejected, and the warnings for those it let overlap.
+type seriesJudgement struct {
+	rejected []time.Time
+	warnings []domain.Warning
+}
+
+// judgeRecurringSeries judges series under policy and fails with
+// store.ErrConflict when more than maxSkips occurrences are rejected.
+func judgeRecurringSeries(ctx context.Context, tx store.CalendarTx, policy conflicts.Policy, series domain.RecurringSeries, maxSkips int) (seriesJudgement, error) {
+	j, err := judgeSeriesOccurrences(ctx, tx, policy, series)
+	if err != nil {
+		return seriesJudgement{}, err
 	}
-	windowEnd = windowEnd.Add(time.Duration(series.DurationSeconds) * time.Second)
+	if len(j.rejected) > maxSkips {
+		return seriesJudgement{}, store.ErrConflict
+	}
+	return j, nil
+}
 
+// judgeSeriesOccurrences asks policy about each occurrence of series that
+// overlaps an existing appointment or occurrence, as recurringSeriesConflicts
+// describes. Occurrences that overlap nothing never reach the policy. An
+// occurrence that overlaps an active slot hold is rejected without asking,
+// since holds block every booking under every policy.
+func judgeSeriesOccurrences(ctx context.Context, tx store.CalendarTx, policy conflicts.Policy, series domain.RecurringSeries) (seriesJudgement, error) {
+	windowStart := series.DTStart.UTC()
+	windowEnd := domain.SeriesHorizonEnd(series, store.RecurringConflictLookahead)
+
+	expandStart := time.Now()
 	newOccs, err := domain.GenerateWeeklyOccurrences(series, windowStart, windowEnd)
+	timing.Since(ctx, timing.Expansion, expandStart)
 	if err != nil {
-		return err
+		return seriesJudgement{}, err
 	}
 	if len(newOccs) == 0 {
-		return nil
+		return seriesJudgement{}, nil
 	}
 	sort.Slice(newOccs, func(i, j int) bool {
 		return newOccs[i].StartTime.Before(newOccs[j].StartTime)
@@ -351,32 +1032,37 @@ func ensureNoRecurringSeriesConflicts(ctx context.Context, tx store.CalendarTx,
 
 	for i := 1; i < len(newOccs); i++ {
 		if newOccs[i-1].EndTime.After(newOccs[i].StartTime) {
-			return store.ErrConflict
+			return seriesJudgement{}, store.ErrConflict
 		}
 	}
 
 	appts, err := tx.ListAppointments(ctx, series.UserID, windowStart, windowEnd)
 	if err != nil {
-		return err
+		return seriesJudgement{}, err
 	}
 
-	existing := make([]timeSpan, 0, len(appts))
+	existing := make([]conflicts.Entry, 0, len(appts))
 	for _, a := range appts {
-		existing = append(existing, timeSpan{Start: a.StartTime.UTC(), End: a.EndTime.UTC()})
+		existing = append(existing, appointmentEntry(a))
 	}
 
-	seriesRows, err := tx.ListRecurringSeries(ctx, series.UserID)
+	seriesRows, err := tx.ListSeriesConflictCandidates(ctx, series, windowStart, windowEnd)
 	if err != nil {
-		return err
+		return seriesJudgement{}, err
 	}
 
 	exWindowStart := windowStart.Add(-14 * 24 * time.Hour)
 	exWindowEnd := windowEnd.Add(14 * 24 * time.Hour)
 
 	for _, s := range seriesRows {
+		if s.ID == series.ID {
+			continue
+		}
+		expandStart := time.Now()
 		occs, err := domain.GenerateWeeklyOccurrences(s, windowStart, windowEnd)
+		timing.Since(ctx, timing.Expansion, expandStart)
 		if err != nil {
-			return err
+			return seriesJudgement{}, err
 		}
 		if len(occs) == 0 {
 			continue
@@ -384,26 +1070,113 @@ func ensureNoRecurringSeriesConflicts(ctx context.Context, tx store.CalendarTx,
 
 		exRows, err := tx.ListRecurringExceptions(ctx, s.ID, exWindowStart, exWindowEnd)
 		if err != nil {
-			return err
+			return seriesJudgement{}, err
 		}
 
 		occs = applyRecurringExceptions(occs, exRows, windowStart, windowEnd)
 		for _, o := range occs {
-			existing = append(existing, timeSpan{Start: o.StartTime.UTC(), End: o.EndTime.UTC()})
+			existing = append(existing, occurrenceEntry(o))
 		}
 	}
 
+	spans := make([]timeSpan, 0, len(existing))
+	for _, e := range existing {
+		spans = append(spans, timeSpan{Start: e.Start, End: e.End})
+	}
+	busy := newBusyIndex(spans)
+	sort.Slice(existing, func(i, j int) bool {
+		return existing[i].Start.Before(existing[j].Start)
+	})
+
+	holds, err := tx.ListActiveSlotHolds(ctx, series.UserID, windowStart, windowEnd)
+	if err != nil {
+		return seriesJudgement{}, pgerrors.Classify(err)
+	}
+	holdSpans := make([]timeSpan, 0, len(holds))
+	for _, h := range holds {
+		holdSpans = append(holdSpans, timeSpan{Start: h.StartTime.UTC(), End: h.EndTime.UTC()})
+	}
+	held := newBusyIndex(holdSpans)
+
+	var out seriesJudgement
 	for _, n := range newOccs {
-		ns := n.StartTime.UTC()
-		ne := n.EndTime.UTC()
+		if held.overlaps(n.StartTime.UTC(), n.EndTime.UTC()) {
+			out.rejected = append(out.rejected, n.StartTime.UTC())
+			continue
+		}
+		if !busy.overlaps(n.StartTime.UTC(), n.EndTime.UTC()) {
+			continue
+		}
+		booking := occurrenceEntry(n)
+		var overlapping []conflicts.Entry
 		for _, e := range existing {
-			if ns.Before(e.End) && ne.After(e.Start) {
-				return store.ErrConflict
+			if !e.Start.Before(booking.End) {
+				break
+			}
+			if conflicts.Overlaps(booking, e) {
+				overlapping = append(overlapping, e)
+			}
+		}
+		warnings, _, err := judge(policy, booking, overlapping)
+		if errors.Is(err, store.ErrConflict) {
+			out.rejected = append(out.rejected, booking.Start)
+			continue
+		}
+		if err != nil {
+			return seriesJudgement{}, err
+		}
+		out.warnings = append(out.warnings, warnings...)
+	}
+
+	return out, nil
+}
+
+func occurrenceEntry(o domain.RecurringOccurrence) conflicts.Entry {
+	return conflicts.Entry{
+		Kind:     conflicts.EntryOccurrence,
+		ID:       o.SeriesID,
+		Title:    o.Title,
+		Start:    o.StartTime.UTC(),
+		End:      o.EndTime.UTC(),
+		Resource: conflicts.Resource(o.Metadata),
+	}
+}
+
+// busyIndex holds busy time as sorted, disjoint spans so that overlap checks
+// are a binary search rather than a scan of every existing booking. It
+// follows the same '[)' bounds as appointments_no_overlap: spans that touch
+// do not overlap, and an empty span, such as a milestone's, overlaps
+// nothing. boundary_contract_test.go holds the two to the same cases.
+type busyIndex []timeSpan
+
+func newBusyIndex(spans []timeSpan) busyIndex {
+	sort.Slice(spans, func(i, j int) bool {
+		return spans[i].Start.Before(spans[j].Start)
+	})
+	merged := make(busyIndex, 0, len(spans))
+	for _, s := range spans {
+		if !s.End.After(s.Start) {
+			continue
+		}
+		if n := len(merged); n > 0 && !s.Start.After(merged[n-1].End) {
+			if s.End.After(merged[n-1].End) {
+				merged[n-1].End = s.End
 			}
+			continue
 		}
+		merged = append(merged, s)
 	}
+	return merged
+}
 
-	return nil
+func (b busyIndex) overlaps(start, end time.Time) bool {
+	if !end.After(start) {
+		return false
+	}
+	i := sort.Search(len(b), func(i int) bool {
+		return b[i].End.After(start)
+	})
+	return i < len(b) && b[i].Start.Before(end)
 }
 
 func applyRecurringExceptions(occs []domain.RecurringOccurrence, exs []domain.RecurringException, windowStart, windowEnd time.Time) []domain.RecurringOccurrence {
@@ -455,6 +1228,8 @@ func applyRecurringExceptions(occs []domain.RecurringOccurrence, exs []domain.Re
 				Notes:     notes,
 				StartTime: start,
 				EndTime:   end,
+				Metadata:  o.Metadata,
+				Timezone:  o.Timezone,
 			})
 		}
 	}
@@ -129,6 +129,64 @@ func TestPostgresIntegration_AppointmentCreateListOverlapAndIdempotency(t *testi
 		if err != store.ErrIdempotencyConflict {
 			return fmt.Errorf("idempotency err = %v, want %v", err, store.ErrIdempotencyConflict)
 		}
+		_, err = c.CreateAppointment(ctx, domain.Appointment{
+			ID:        a1.ID,
+			UserID:    userID,
+			Title:     "t",
+			StartTime: start,
+			EndTime:   end,
+			Source:    domain.SourceBooking,
+		})
+		if err != store.ErrIdempotencyConflict {
+			return fmt.Errorf("idempotency err for another source = %v, want %v", err, store.ErrIdempotencyConflict)
+		}
+
+		holdStart := end.Add(2 * time.Hour)
+		hold, err := reserveSlot(ctx, c, domain.SlotHold{
+			UserID:    userID,
+			StartTime: holdStart,
+			EndTime:   holdStart.Add(time.Hour),
+			ExpiresAt: time.Now().Add(5 * time.Minute),
+		})
+		if err != nil {
+			return err
+		}
+		_, err = c.CreateAppointment(ctx, domain.Appointment{
+			UserID:    userID,
+			Title:     "held",
+			StartTime: holdStart.Add(30 * time.Minute),
+			EndTime:   holdStart.Add(90 * time.Minute),
+		})
+		if err != store.ErrConflict {
+			return fmt.Errorf("create over hold err = %v, want %v", err, store.ErrConflict)
+		}
+
+		confirmed, err := confirmHold(ctx, c, hold.ID, domain.Appointment{
+			ID:     uuid.MustParse("00000000-0000-0000-0000-000000000904"),
+			UserID: userID,
+			Title:  "held",
+		})
+		if err != nil {
+			return err
+		}
+		if !confirmed.StartTime.Equal(holdStart) {
+			return fmt.Errorf("confirmed start = %v, want %v", confirmed.StartTime, holdStart)
+		}
+		replayed, err := confirmHold(ctx, c, hold.ID, domain.Appointment{ID: confirmed.ID, UserID: userID, Title: "held"})
+		if err != nil {
+			return err
+		}
+		if replayed.ID != confirmed.ID {
+			return fmt.Errorf("replayed id = %s, want %s", replayed.ID, confirmed.ID)
+		}
+
+		stats, err := userAnalytics(ctx, tx, userID, start, end.Add(time.Hour))
+		if err != nil {
+			return err
+		}
+		if stats.AppointmentCount != 2 || stats.AverageDuration != time.Hour {
+			return fmt.Errorf("analytics = %+v, want 2 appointments averaging 1h", stats)
+		}
 
 		return nil
 	})
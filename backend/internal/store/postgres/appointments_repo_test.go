@@ -2,19 +2,23 @@ package postgres
 
 import (
 	"context"
+	"slices"
+	"strconv"
 	"testing"
 	"time"
 
 	"github.com/google/uuid"
 
+	"schedula/backend/internal/conflicts"
 	"schedula/backend/internal/domain"
 	"schedula/backend/internal/store"
 )
 
 type fakeCalendarTx struct {
 	listAppointmentsFn        func(ctx context.Context, userID string, windowStart, windowEnd time.Time) ([]domain.Appointment, error)
-	listRecurringSeriesFn     func(ctx context.Context, userID string) ([]domain.RecurringSeries, error)
+	listRecurringSeriesFn     func(ctx context.Context, userID string, windowStart, windowEnd time.Time) ([]domain.RecurringSeries, error)
 	listRecurringExceptionsFn func(ctx context.Context, seriesID uuid.UUID, windowStart, windowEnd time.Time) ([]domain.RecurringException, error)
+	listActiveSlotHoldsFn     func(ctx context.Context, userID string, windowStart, windowEnd time.Time) ([]domain.SlotHold, error)
 }
 
 func (f *fakeCalendarTx) CreateAppointment(ctx context.Context, appt domain.Appointment) (domain.Appointment, error) {
@@ -36,11 +40,15 @@ func (f *fakeCalendarTx) CreateRecurringSeries(ctx context.Context, series domai
 	panic("not used")
 }
 
-func (f *fakeCalendarTx) ListRecurringSeries(ctx context.Context, userID string) ([]domain.RecurringSeries, error) {
+func (f *fakeCalendarTx) ListRecurringSeries(ctx context.Context, userID string, windowStart, windowEnd time.Time) ([]domain.RecurringSeries, error) {
 	if f.listRecurringSeriesFn == nil {
 		return nil, nil
 	}
-	return f.listRecurringSeriesFn(ctx, userID)
+	return f.listRecurringSeriesFn(ctx, userID, windowStart, windowEnd)
+}
+
+func (f *fakeCalendarTx) ListSeriesConflictCandidates(ctx context.Context, series domain.RecurringSeries, windowStart, windowEnd time.Time) ([]domain.RecurringSeries, error) {
+	return f.ListRecurringSeries(ctx, series.UserID, windowStart, windowEnd)
 }
 
 func (f *fakeCalendarTx) ListRecurringExceptions(ctx context.Context, seriesID uuid.UUID, windowStart, windowEnd time.Time) ([]domain.RecurringException, error) {
@@ -58,6 +66,29 @@ func (f *fakeCalendarTx) DeleteRecurringSeries(ctx context.Context, userID strin
 	panic("not used")
 }
 
+func (f *fakeCalendarTx) CreateSlotHold(ctx context.Context, hold domain.SlotHold) (domain.SlotHold, error) {
+	panic("not used")
+}
+
+func (f *fakeCalendarTx) ListActiveSlotHolds(ctx context.Context, userID string, windowStart, windowEnd time.Time) ([]domain.SlotHold, error) {
+	if f.listActiveSlotHoldsFn == nil {
+		return nil, nil
+	}
+	return f.listActiveSlotHoldsFn(ctx, userID, windowStart, windowEnd)
+}
+
+func (f *fakeCalendarTx) GetSlotHold(ctx context.Context, userID string, holdID uuid.UUID) (domain.SlotHold, error) {
+	panic("not used")
+}
+
+func (f *fakeCalendarTx) DeleteSlotHold(ctx context.Context, userID string, holdID uuid.UUID) error {
+	panic("not used")
+}
+
+func (f *fakeCalendarTx) GetAppointment(ctx context.Context, userID string, appointmentID uuid.UUID) (domain.Appointment, error) {
+	panic("not used")
+}
+
 func TestApplyRecurringExceptions(t *testing.T) {
 	baseTime := time.Date(2026, 1, 5, 9, 0, 0, 0, time.UTC)
 	windowStart := baseTime
@@ -175,6 +206,40 @@ func TestApplyRecurringExceptions(t *testing.T) {
 	})
 }
 
+func TestExceptionsBeyondEnd(t *testing.T) {
+	dtStart := time.Date(2026, 1, 5, 9, 0, 0, 0, time.UTC)
+	count := 3
+	// Mondays and Wednesdays, cut to three occurrences: Jan 5, 7 and 12.
+	series := domain.RecurringSeries{
+		ID:              uuid.MustParse("00000000-0000-0000-0000-000000000201"),
+		Timezone:        "UTC",
+		DTStart:         dtStart,
+		DurationSeconds: 1800,
+		Frequency:       domain.RecurrenceFrequencyWeekly,
+		Interval:        1,
+		ByWeekday:       []int16{1, 3},
+		Count:           &count,
+	}
+	exception := func(id string, at time.Time) domain.RecurringException {
+		return domain.RecurringException{ID: uuid.MustParse(id), SeriesID: series.ID, OccurrenceStart: at}
+	}
+	exs := []domain.RecurringException{
+		exception("00000000-0000-0000-0000-000000000211", dtStart.AddDate(0, 0, -7)),
+		exception("00000000-0000-0000-0000-000000000212", dtStart.AddDate(0, 0, 7)),
+		exception("00000000-0000-0000-0000-000000000213", dtStart.AddDate(0, 0, 9)),
+		exception("00000000-0000-0000-0000-000000000214", dtStart.AddDate(0, 0, 14)),
+	}
+
+	got, err := exceptionsBeyondEnd(series, exs)
+	if err != nil {
+		t.Fatalf("exceptionsBeyondEnd error: %v", err)
+	}
+	want := []uuid.UUID{exs[2].ID, exs[3].ID}
+	if !slices.Equal(got, want) {
+		t.Fatalf("exceptionsBeyondEnd = %v, want %v", got, want)
+	}
+}
+
 func TestEnsureNoRecurringSeriesConflicts(t *testing.T) {
 	baseSeries := func(dtstart time.Time) domain.RecurringSeries {
 		until := time.Date(2026, 1, 20, 0, 0, 0, 0, time.UTC)
@@ -222,7 +287,7 @@ func TestEnsureNoRecurringSeriesConflicts(t *testing.T) {
 		existingSeries.ID = uuid.MustParse("00000000-0000-0000-0000-000000000202")
 
 		tx := &fakeCalendarTx{
-			listRecurringSeriesFn: func(ctx context.Context, userID string) ([]domain.RecurringSeries, error) {
+			listRecurringSeriesFn: func(ctx context.Context, userID string, windowStart, windowEnd time.Time) ([]domain.RecurringSeries, error) {
 				return []domain.RecurringSeries{existingSeries}, nil
 			},
 		}
@@ -244,7 +309,7 @@ func TestEnsureNoRecurringSeriesConflicts(t *testing.T) {
 		conflictingOccurrenceStart := time.Date(2026, 1, 5, 9, 0, 0, 0, time.UTC)
 
 		tx := &fakeCalendarTx{
-			listRecurringSeriesFn: func(ctx context.Context, userID string) ([]domain.RecurringSeries, error) {
+			listRecurringSeriesFn: func(ctx context.Context, userID string, windowStart, windowEnd time.Time) ([]domain.RecurringSeries, error) {
 				return []domain.RecurringSeries{existingSeries}, nil
 			},
 			listRecurringExceptionsFn: func(ctx context.Context, seriesID uuid.UUID, windowStart, windowEnd time.Time) ([]domain.RecurringException, error) {
@@ -290,3 +355,202 @@ func TestEnsureNoRecurringSeriesConflicts(t *testing.T) {
 		}
 	})
 }
+
+func TestRecurringSeriesConflicts_ReturnsConflictingStarts(t *testing.T) {
+	until := time.Date(2026, 1, 27, 0, 0, 0, 0, time.UTC)
+	series := domain.RecurringSeries{
+		ID:              uuid.MustParse("00000000-0000-0000-0000-000000000201"),
+		UserID:          "u1",
+		Title:           "t",
+		Timezone:        "UTC",
+		DTStart:         time.Date(2026, 1, 5, 9, 0, 0, 0, time.UTC),
+		DurationSeconds: 3600,
+		Frequency:       domain.RecurrenceFrequencyWeekly,
+		Interval:        1,
+		ByWeekday:       []int16{1},
+		Until:           &until,
+	}
+	tx := &fakeCalendarTx{
+		listAppointmentsFn: func(ctx context.Context, userID string, windowStart, windowEnd time.Time) ([]domain.Appointment, error) {
+			return []domain.Appointment{
+				{StartTime: time.Date(2026, 1, 12, 9, 30, 0, 0, time.UTC), EndTime: time.Date(2026, 1, 12, 11, 0, 0, 0, time.UTC)},
+				{StartTime: time.Date(2026, 1, 26, 8, 0, 0, 0, time.UTC), EndTime: time.Date(2026, 1, 26, 9, 15, 0, 0, time.UTC)},
+			}, nil
+		},
+	}
+
+	got, err := recurringSeriesConflicts(context.Background(), tx, series)
+	if err != nil {
+		t.Fatalf("recurringSeriesConflicts error: %v", err)
+	}
+	want := []time.Time{
+		time.Date(2026, 1, 12, 9, 0, 0, 0, time.UTC),
+		time.Date(2026, 1, 26, 9, 0, 0, 0, time.UTC),
+	}
+	if len(got) != len(want) {
+		t.Fatalf("conflicts = %v, want %v", got, want)
+	}
+	for i := range want {
+		if !got[i].Equal(want[i]) {
+			t.Fatalf("conflicts[%d] = %v, want %v", i, got[i], want[i])
+		}
+	}
+}
+
+func TestBusyIndex_Overlaps(t *testing.T) {
+	at := func(h int) time.Time { return time.Date(2026, 1, 5, h, 0, 0, 0, time.UTC) }
+	busy := newBusyIndex([]timeSpan{
+		{Start: at(13), End: at(14)},
+		{Start: at(9), End: at(10)},
+		{Start: at(9), End: at(11)},
+	})
+
+	tests := []struct {
+		start, end time.Time
+		want       bool
+	}{
+		{start: at(8), end: at(9), want: false},
+		{start: at(10), end: at(12), want: true},
+		{start: at(11), end: at(13), want: false},
+		{start: at(12), end: at(15), want: true},
+		{start: at(14), end: at(15), want: false},
+	}
+	for _, tt := range tests {
+		if got := busy.overlaps(tt.start, tt.end); got != tt.want {
+			t.Fatalf("overlaps(%s, %s) = %v, want %v", tt.start.Format("15:04"), tt.end.Format("15:04"), got, tt.want)
+		}
+	}
+}
+
+func BenchmarkRecurringSeriesConflicts(b *testing.B) {
+	for _, n := range []int{10, 100, 500} {
+		b.Run(strconv.Itoa(n)+"_series", func(b *testing.B) {
+			dtstart := time.Date(2026, 1, 5, 0, 0, 0, 0, time.UTC)
+			existing := make([]domain.RecurringSeries, 0, n)
+			for i := 0; i < n; i++ {
+				existing = append(existing, domain.RecurringSeries{
+					ID:              uuid.New(),
+					UserID:          "u1",
+					Timezone:        "UTC",
+					DTStart:         dtstart.Add(time.Duration(i%5)*24*time.Hour + time.Duration(i/5)*15*time.Minute),
+					DurationSeconds: 600,
+					Frequency:       domain.RecurrenceFrequencyWeekly,
+					Interval:        1,
+					ByWeekday:       []int16{int16(i%5) + 1},
+				})
+			}
+			tx := &fakeCalendarTx{
+				listRecurringSeriesFn: func(ctx context.Context, userID string, windowStart, windowEnd time.Time) ([]domain.RecurringSeries, error) {
+					return existing, nil
+				},
+			}
+			series := domain.RecurringSeries{
+				UserID:          "u1",
+				Timezone:        "UTC",
+				DTStart:         dtstart.Add(6 * 24 * time.Hour),
+				DurationSeconds: 3600,
+				Frequency:       domain.RecurrenceFrequencyWeekly,
+				Interval:        1,
+				ByWeekday:       []int16{7},
+			}
+
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				if _, err := recurringSeriesConflicts(context.Background(), tx, series); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
+
+func TestJudgeSeriesOccurrences_Policies(t *testing.T) {
+	until := time.Date(2026, 1, 19, 9, 0, 0, 0, time.UTC)
+	series := domain.RecurringSeries{
+		ID:              uuid.MustParse("00000000-0000-0000-0000-000000000401"),
+		UserID:          "u1",
+		Title:           "t",
+		Timezone:        "UTC",
+		DTStart:         time.Date(2026, 1, 5, 9, 0, 0, 0, time.UTC),
+		DurationSeconds: 3600,
+		Frequency:       domain.RecurrenceFrequencyWeekly,
+		Interval:        1,
+		ByWeekday:       []int16{1},
+		Until:           &until,
+		Metadata:        map[string]string{conflicts.ResourceKey: "room-a"},
+	}
+	tx := &fakeCalendarTx{
+		listAppointmentsFn: func(ctx context.Context, userID string, windowStart, windowEnd time.Time) ([]domain.Appointment, error) {
+			return []domain.Appointment{
+				{Title: "other room", StartTime: time.Date(2026, 1, 5, 9, 30, 0, 0, time.UTC), EndTime: time.Date(2026, 1, 5, 10, 30, 0, 0, time.UTC), Metadata: map[string]string{conflicts.ResourceKey: "room-b"}},
+				{Title: "same room", StartTime: time.Date(2026, 1, 12, 9, 30, 0, 0, time.UTC), EndTime: time.Date(2026, 1, 12, 9, 45, 0, 0, time.UTC), Metadata: map[string]string{conflicts.ResourceKey: "room-a"}},
+			}, nil
+		},
+	}
+
+	j, err := judgeSeriesOccurrences(context.Background(), tx, conflicts.ResourceAware{}, series)
+	if err != nil {
+		t.Fatalf("resource: %v", err)
+	}
+	if len(j.rejected) != 1 || !j.rejected[0].Equal(time.Date(2026, 1, 12, 9, 0, 0, 0, time.UTC)) || len(j.warnings) != 0 {
+		t.Fatalf("resource = %+v, want only the same-room week rejected", j)
+	}
+
+	j, err = judgeSeriesOccurrences(context.Background(), tx, conflicts.Advisory{}, series)
+	if err != nil {
+		t.Fatalf("advisory: %v", err)
+	}
+	if len(j.rejected) != 0 || len(j.warnings) != 2 || j.warnings[1].Code != domain.WarningCodeOverlap {
+		t.Fatalf("advisory = %+v, want a warning per overlap", j)
+	}
+}
+
+func TestJudgeRecurringSeries_RejectsHeldOccurrences(t *testing.T) {
+	until := time.Date(2026, 1, 19, 9, 0, 0, 0, time.UTC)
+	series := domain.RecurringSeries{
+		UserID:          "u1",
+		Title:           "t",
+		Timezone:        "UTC",
+		DTStart:         time.Date(2026, 1, 5, 9, 0, 0, 0, time.UTC),
+		DurationSeconds: 3600,
+		Frequency:       domain.RecurrenceFrequencyWeekly,
+		Interval:        1,
+		ByWeekday:       []int16{1},
+		Until:           &until,
+	}
+	held := time.Date(2026, 1, 12, 9, 30, 0, 0, time.UTC)
+	tx := &fakeCalendarTx{
+		listActiveSlotHoldsFn: func(ctx context.Context, userID string, windowStart, windowEnd time.Time) ([]domain.SlotHold, error) {
+			return []domain.SlotHold{{UserID: userID, StartTime: held, EndTime: held.Add(30 * time.Minute)}}, nil
+		},
+	}
+
+	// Holds are not the policy's to judge, so even the advisory policy
+	// rejects the held week.
+	if _, err := judgeRecurringSeries(context.Background(), tx, conflicts.Advisory{}, series, 0); err != store.ErrConflict {
+		t.Fatalf("err = %v, want %v", err, store.ErrConflict)
+	}
+	j, err := judgeRecurringSeries(context.Background(), tx, conflicts.Advisory{}, series, 1)
+	if err != nil {
+		t.Fatalf("judgeRecurringSeries error: %v", err)
+	}
+	if len(j.rejected) != 1 || !j.rejected[0].Equal(time.Date(2026, 1, 12, 9, 0, 0, 0, time.UTC)) || len(j.warnings) != 0 {
+		t.Fatalf("judgement = %+v, want only the held week skipped", j)
+	}
+}
+
+func TestWeekdayFilterMask(t *testing.T) {
+	series := domain.RecurringSeries{ByWeekday: []int16{2, 7}, DurationSeconds: 3600}
+	mask, ok := weekdayFilterMask(series)
+	if !ok {
+		t.Fatalf("an hour-long series is not filtered by weekday")
+	}
+	if want := domain.WeekdayMaskOf([]int16{1, 2, 3, 6, 7}); mask != want {
+		t.Fatalf("mask = %07b, want %07b", mask, want)
+	}
+
+	series.DurationSeconds = int((maxWeekdayFilterDuration + time.Second) / time.Second)
+	if _, ok := weekdayFilterMask(series); ok {
+		t.Fatalf("a series longer than %v is filtered by weekday", maxWeekdayFilterDuration)
+	}
+}
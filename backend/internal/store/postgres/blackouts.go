@@ -0,0 +1,60 @@
+package postgres
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+
+	"schedula/backend/internal/domain"
+	"schedula/backend/internal/store"
+	"schedula/backend/internal/store/pgerrors"
+)
+
+func (r *AppointmentRepo) CreateBlackout(ctx context.Context, blackout domain.Blackout) (domain.Blackout, error) {
+	m := domain.Blackout{
+		ID:        blackout.ID,
+		Title:     blackout.Title,
+		StartTime: blackout.StartTime,
+		EndTime:   blackout.EndTime,
+		Mode:      blackout.Mode,
+		CreatedAt: blackout.CreatedAt,
+	}
+	if _, err := r.db.NewInsert().Model(&m).Exec(ctx); err != nil {
+		return domain.Blackout{}, pgerrors.Classify(err)
+	}
+	return m, nil
+}
+
+func (r *AppointmentRepo) DeleteBlackout(ctx context.Context, blackoutID uuid.UUID) error {
+	res, err := r.db.NewDelete().
+		Model((*domain.Blackout)(nil)).
+		Where("id = ?", blackoutID).
+		Exec(ctx)
+	if err != nil {
+		return pgerrors.Classify(err)
+	}
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if affected == 0 {
+		return store.ErrNotFound
+	}
+	return nil
+}
+
+// ListBlackouts returns the blackouts overlapping the window, earliest first.
+func (r *AppointmentRepo) ListBlackouts(ctx context.Context, windowStart, windowEnd time.Time) ([]domain.Blackout, error) {
+	var rows []domain.Blackout
+	err := r.db.NewSelect().
+		Model(&rows).
+		Where("start_time < ?", windowEnd).
+		Where("end_time > ?", windowStart).
+		OrderExpr("start_time ASC").
+		Scan(ctx)
+	if err != nil {
+		return nil, pgerrors.Classify(err)
+	}
+	return rows, nil
+}
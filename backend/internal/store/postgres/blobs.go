@@ -0,0 +1,48 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/uptrace/bun"
+
+	"schedula/backend/internal/store"
+	"schedula/backend/internal/store/pgerrors"
+)
+
+// BlobRepo is a store.BlobStore in the blobs table. It suits blobs of a few
+// megabytes, such as calendar snapshots; larger ones belong in an object
+// store behind the same interface.
+type BlobRepo struct {
+	db *bun.DB
+}
+
+func NewBlobRepo(db *bun.DB) *BlobRepo {
+	return &BlobRepo{db: db}
+}
+
+func (r *BlobRepo) PutBlob(ctx context.Context, key string, data []byte) error {
+	_, err := r.db.NewRaw(
+		"INSERT INTO blobs (key, data) VALUES (?, ?) ON CONFLICT (key) DO UPDATE SET data = EXCLUDED.data, created_at = now()",
+		key, data,
+	).Exec(ctx)
+	return pgerrors.Classify(err)
+}
+
+func (r *BlobRepo) GetBlob(ctx context.Context, key string) ([]byte, error) {
+	var data []byte
+	err := r.db.NewRaw("SELECT data FROM blobs WHERE key = ?", key).Scan(ctx, &data)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, store.ErrNotFound
+		}
+		return nil, pgerrors.Classify(err)
+	}
+	return data, nil
+}
+
+func (r *BlobRepo) DeleteBlob(ctx context.Context, key string) error {
+	_, err := r.db.NewRaw("DELETE FROM blobs WHERE key = ?", key).Exec(ctx)
+	return pgerrors.Classify(err)
+}
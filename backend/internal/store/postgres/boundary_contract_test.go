@@ -0,0 +1,155 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/uptrace/bun"
+
+	"schedula/backend/internal/domain"
+	"schedula/backend/internal/store"
+)
+
+// boundaryCase is one row of the overlap contract: a booking already on the
+// calendar, a new one, and whether the new one conflicts. An existing span
+// with equal ends is a milestone.
+type boundaryCase struct {
+	name      string
+	existing  timeSpan
+	candidate timeSpan
+	conflict  bool
+}
+
+// boundaryContract is the overlap contract that appointments_no_overlap in
+// Postgres and busyIndex in Go must both meet, so one-off bookings and
+// recurring series agree on what a conflict is. Times are whole seconds, the
+// finest a series duration can express.
+func boundaryContract() []boundaryCase {
+	// A Monday, so a one-occurrence weekly series can start on it.
+	at := func(h, m, s int) time.Time { return time.Date(2026, 1, 5, h, m, s, 0, time.UTC) }
+	meeting := timeSpan{Start: at(10, 0, 0), End: at(11, 0, 0)}
+	return []boundaryCase{
+		{"back to back before", meeting, timeSpan{Start: at(9, 0, 0), End: at(10, 0, 0)}, false},
+		{"back to back after", meeting, timeSpan{Start: at(11, 0, 0), End: at(12, 0, 0)}, false},
+		{"one second into the start", meeting, timeSpan{Start: at(9, 0, 0), End: at(10, 0, 1)}, true},
+		{"one second before the end", meeting, timeSpan{Start: at(10, 59, 59), End: at(12, 0, 0)}, true},
+		{"same span", meeting, meeting, true},
+		{"inside", meeting, timeSpan{Start: at(10, 15, 0), End: at(10, 45, 0)}, true},
+		{"around", meeting, timeSpan{Start: at(9, 0, 0), End: at(12, 0, 0)}, true},
+		{"shares the start", meeting, timeSpan{Start: at(10, 0, 0), End: at(10, 30, 0)}, true},
+		{"shares the end", meeting, timeSpan{Start: at(10, 30, 0), End: at(11, 0, 0)}, true},
+		{"milestone inside", timeSpan{Start: at(10, 30, 0), End: at(10, 30, 0)}, meeting, false},
+		{"milestone at the start", timeSpan{Start: at(10, 0, 0), End: at(10, 0, 0)}, meeting, false},
+	}
+}
+
+func contractAppointment(userID, title string, span timeSpan) domain.Appointment {
+	appt := domain.Appointment{UserID: userID, Title: title, StartTime: span.Start, EndTime: span.End, Kind: domain.AppointmentKindEvent}
+	if span.End.Equal(span.Start) {
+		appt.Kind = domain.AppointmentKindMilestone
+	}
+	return appt
+}
+
+func TestBoundaryContract_RecurringSeriesChecker(t *testing.T) {
+	for _, tt := range boundaryContract() {
+		existing := contractAppointment("u1", "existing", tt.existing)
+		tx := &fakeCalendarTx{
+			listAppointmentsFn: func(ctx context.Context, userID string, windowStart, windowEnd time.Time) ([]domain.Appointment, error) {
+				return []domain.Appointment{existing}, nil
+			},
+		}
+		until := tt.candidate.Start
+		series := domain.RecurringSeries{
+			UserID:          "u1",
+			Title:           "candidate",
+			Timezone:        "UTC",
+			DTStart:         tt.candidate.Start,
+			DurationSeconds: int(tt.candidate.End.Sub(tt.candidate.Start) / time.Second),
+			Frequency:       domain.RecurrenceFrequencyWeekly,
+			Interval:        1,
+			ByWeekday:       []int16{1},
+			Until:           &until,
+		}
+
+		err := ensureNoRecurringSeriesConflicts(context.Background(), tx, series)
+		if err != nil && !errors.Is(err, store.ErrConflict) {
+			t.Fatalf("%s: error = %v", tt.name, err)
+		}
+		if got := errors.Is(err, store.ErrConflict); got != tt.conflict {
+			t.Fatalf("%s: series conflict = %v, want %v", tt.name, got, tt.conflict)
+		}
+		if tt.existing.End.After(tt.existing.Start) {
+			if got := domain.Overlaps(tt.existing.Start, tt.existing.End, tt.candidate.Start, tt.candidate.End); got != tt.conflict {
+				t.Fatalf("%s: domain.Overlaps = %v, want %v", tt.name, got, tt.conflict)
+			}
+		}
+	}
+}
+
+func TestPostgresIntegration_BoundaryContract(t *testing.T) {
+	databaseURL := strings.TrimSpace(os.Getenv("SCHEDULA_TEST_DATABASE_URL"))
+	if databaseURL == "" {
+		t.Skip("SCHEDULA_TEST_DATABASE_URL not set")
+	}
+
+	db, err := Open(databaseURL, PoolConfig{MaxOpenConns: 1})
+	if err != nil {
+		t.Fatalf("Open error: %v", err)
+	}
+	t.Cleanup(func() {
+		_ = Close(db)
+	})
+
+	schema := "schedula_test_" + randomHex(t, 8)
+	t.Cleanup(func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+		_, _ = db.NewRaw("DROP SCHEMA IF EXISTS " + schema + " CASCADE").Exec(ctx)
+	})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	err = db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		if _, err := tx.NewRaw("CREATE SCHEMA " + schema).Exec(ctx); err != nil {
+			return err
+		}
+		if _, err := tx.NewRaw("SET LOCAL search_path TO " + schema).Exec(ctx); err != nil {
+			return err
+		}
+		if err := applyMigrations(ctx, tx); err != nil {
+			return err
+		}
+
+		c := calendarTx{tx: tx}
+		// Each case gets its own user, since the constraint is per user. The
+		// candidate goes in a savepoint, as reconcile does, so a conflict does
+		// not abort the transaction.
+		for i, tt := range boundaryContract() {
+			userID := fmt.Sprintf("contract-%d", i)
+			if _, err := c.CreateAppointment(ctx, contractAppointment(userID, "existing", tt.existing)); err != nil {
+				return fmt.Errorf("%s: existing: %w", tt.name, err)
+			}
+			err := tx.RunInTx(ctx, nil, func(ctx context.Context, sp bun.Tx) error {
+				_, err := calendarTx{tx: sp}.CreateAppointment(ctx, contractAppointment(userID, "candidate", tt.candidate))
+				return err
+			})
+			if err != nil && !errors.Is(err, store.ErrConflict) {
+				return fmt.Errorf("%s: candidate: %w", tt.name, err)
+			}
+			if got := errors.Is(err, store.ErrConflict); got != tt.conflict {
+				return fmt.Errorf("%s: constraint conflict = %v, want %v", tt.name, got, tt.conflict)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("tx error: %v", err)
+	}
+}
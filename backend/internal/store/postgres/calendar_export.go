@@ -0,0 +1,113 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/uptrace/bun"
+
+	"schedula/backend/internal/domain"
+	"schedula/backend/internal/store"
+	"schedula/backend/internal/store/pgerrors"
+)
+
+// ExportCalendar reads the user's whole calendar in one repeatable-read
+// transaction so the contacts, appointments, series and exceptions agree.
+func (r *AppointmentRepo) ExportCalendar(ctx context.Context, userID string) (store.CalendarSnapshot, error) {
+	var out store.CalendarSnapshot
+	err := r.db.RunInTx(ctx, &sql.TxOptions{Isolation: sql.LevelRepeatableRead, ReadOnly: true}, func(ctx context.Context, tx bun.Tx) error {
+		err := tx.NewSelect().
+			Model(&out.Contacts).
+			Where("user_id = ?", userID).
+			OrderExpr("id ASC").
+			Scan(ctx)
+		if err != nil {
+			return err
+		}
+		err = tx.NewSelect().
+			Model(&out.Appointments).
+			Where("user_id = ?", userID).
+			OrderExpr("start_time ASC, id ASC").
+			Scan(ctx)
+		if err != nil {
+			return err
+		}
+		err = tx.NewSelect().
+			Model(&out.Series).
+			Where("user_id = ?", userID).
+			OrderExpr("dtstart ASC, id ASC").
+			Scan(ctx)
+		if err != nil {
+			return err
+		}
+		if len(out.Series) == 0 {
+			return nil
+		}
+		seriesIDs := make([]any, 0, len(out.Series))
+		for _, s := range out.Series {
+			seriesIDs = append(seriesIDs, s.ID)
+		}
+		return tx.NewSelect().
+			Model(&out.Exceptions).
+			Where("series_id IN (?)", bun.In(seriesIDs)).
+			OrderExpr("series_id ASC, occurrence_start ASC").
+			Scan(ctx)
+	})
+	if err != nil {
+		return store.CalendarSnapshot{}, pgerrors.Classify(err)
+	}
+	return out, nil
+}
+
+// ImportCalendar inserts the snapshot under the user's calendar lock. Only
+// an empty calendar is accepted: the snapshot is consistent with itself, so
+// loading it as-is needs no conflict checks beyond the overlap constraint.
+func (r *AppointmentRepo) ImportCalendar(ctx context.Context, userID string, snapshot store.CalendarSnapshot) error {
+	err := r.db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		if err := r.lockCalendar(ctx, tx, userID); err != nil {
+			return err
+		}
+		for _, model := range []any{(*domain.Appointment)(nil), (*domain.RecurringSeries)(nil), (*domain.Contact)(nil)} {
+			exists, err := tx.NewSelect().Model(model).Where("user_id = ?", userID).Exists(ctx)
+			if err != nil {
+				return err
+			}
+			if exists {
+				return store.ErrConflict
+			}
+		}
+
+		for _, contact := range snapshot.Contacts {
+			contact.UserID = userID
+			if _, err := tx.NewInsert().Model(&contact).Exec(ctx); err != nil {
+				return err
+			}
+		}
+		cal := calendarTx{tx: tx, policy: r.opts.ConflictPolicy}
+		for _, appt := range snapshot.Appointments {
+			appt.UserID = userID
+			if _, err := cal.CreateAppointment(ctx, appt); err != nil {
+				// The calendar is empty, so an id clash is another user's row
+				// rather than a replayed create.
+				if errors.Is(err, store.ErrIdempotencyConflict) {
+					return store.ErrDuplicate
+				}
+				return err
+			}
+		}
+		for _, series := range snapshot.Series {
+			series.UserID = userID
+			if _, err := cal.CreateRecurringSeries(ctx, series); err != nil {
+				return err
+			}
+		}
+		for _, ex := range snapshot.Exceptions {
+			if _, err := cal.UpsertRecurringException(ctx, ex); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	return pgerrors.Classify(err)
+}
@@ -0,0 +1,330 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"maps"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+
+	"schedula/backend/internal/domain"
+	"schedula/backend/internal/store"
+	"schedula/backend/internal/store/pgerrors"
+)
+
+// RestoreCalendar rewrites the user's calendar to the snapshot under the
+// calendar lock. Rows are compared by content rather than updated_at, which
+// some partial updates leave alone. Contacts and series are updated in
+// place so attendance and other rows hanging off them survive; appointments
+// that differ are deleted and created again, since updating them one at a
+// time can trip the overlap constraint on a state between the live calendar
+// and the snapshot. Bundles do not carry programs, so a rewritten
+// appointment keeps the program its live row had.
+func (r *AppointmentRepo) RestoreCalendar(ctx context.Context, userID string, snapshot store.CalendarSnapshot) (store.CalendarRestore, error) {
+	var out store.CalendarRestore
+	err := r.db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		if err := r.lockCalendar(ctx, tx, userID); err != nil {
+			return err
+		}
+		var err error
+		out, err = restoreCalendar(ctx, calendarTx{tx: tx, policy: r.opts.ConflictPolicy}, userID, snapshot)
+		return err
+	})
+	if err != nil {
+		return store.CalendarRestore{}, pgerrors.Classify(err)
+	}
+	return out, nil
+}
+
+func restoreCalendar(ctx context.Context, cal calendarTx, userID string, snapshot store.CalendarSnapshot) (store.CalendarRestore, error) {
+	tx := cal.tx
+	var out store.CalendarRestore
+
+	// Contacts first, so restored appointments can link to them. Contacts
+	// the snapshot lacks go last, once no appointment points at them.
+	var liveContacts []domain.Contact
+	if err := tx.NewSelect().Model(&liveContacts).Where("user_id = ?", userID).Scan(ctx); err != nil {
+		return out, err
+	}
+	contacts := make(map[uuid.UUID]domain.Contact, len(liveContacts))
+	for _, c := range liveContacts {
+		contacts[c.ID] = c
+	}
+	for _, c := range snapshot.Contacts {
+		c.UserID = userID
+		live, ok := contacts[c.ID]
+		delete(contacts, c.ID)
+		switch {
+		case !ok:
+			if _, err := tx.NewInsert().Model(&c).Exec(ctx); err != nil {
+				return out, err
+			}
+		case live.Name != c.Name || live.Email != c.Email || live.Phone != c.Phone:
+			_, err := tx.NewUpdate().
+				Model(&c).
+				Column("name", "email", "phone", "updated_at").
+				Where("user_id = ?", userID).
+				Where("id = ?", c.ID).
+				Exec(ctx)
+			if err != nil {
+				return out, err
+			}
+		default:
+			continue
+		}
+		out.Contacts++
+	}
+
+	// Series: deleting one drops its exceptions and attendance with it.
+	var liveSeries []domain.RecurringSeries
+	if err := tx.NewSelect().Model(&liveSeries).Where("user_id = ?", userID).Scan(ctx); err != nil {
+		return out, err
+	}
+	series := make(map[uuid.UUID]domain.RecurringSeries, len(liveSeries))
+	for _, s := range liveSeries {
+		series[s.ID] = s
+	}
+	wanted := make(map[uuid.UUID]bool, len(snapshot.Series))
+	for _, s := range snapshot.Series {
+		wanted[s.ID] = true
+	}
+	for _, s := range liveSeries {
+		if wanted[s.ID] {
+			continue
+		}
+		if err := cal.DeleteRecurringSeries(ctx, userID, s.ID); err != nil {
+			return out, err
+		}
+		out.SeriesDeleted = append(out.SeriesDeleted, s.ID)
+	}
+
+	exceptions := make(map[uuid.UUID][]domain.RecurringException)
+	for _, ex := range snapshot.Exceptions {
+		exceptions[ex.SeriesID] = append(exceptions[ex.SeriesID], ex)
+	}
+	for _, s := range snapshot.Series {
+		s.UserID = userID
+		live, ok := series[s.ID]
+		if !ok {
+			if _, err := cal.CreateRecurringSeries(ctx, s); err != nil {
+				return out, err
+			}
+			for _, ex := range exceptions[s.ID] {
+				if _, err := cal.UpsertRecurringException(ctx, ex); err != nil {
+					return out, err
+				}
+			}
+			out.SeriesCreated = append(out.SeriesCreated, s.ID)
+			continue
+		}
+
+		updated := false
+		if !sameSeriesRule(live, s) {
+			s.ByWeekdayMask = domain.WeekdayMaskOf(s.ByWeekday)
+			_, err := tx.NewUpdate().
+				Model(&s).
+				Column("title", "notes", "timezone", "dtstart", "duration_seconds", "frequency", "interval",
+					"byweekday", "by_weekday_mask", "until", "count", "wkst", "dst_gap_policy", "dst_ambiguous_policy",
+					"weekday_times", "metadata", "created_by", "updated_at").
+				Where("user_id = ?", userID).
+				Where("id = ?", s.ID).
+				Exec(ctx)
+			if err != nil {
+				return out, err
+			}
+			if err := recordChange(ctx, tx, userID, domain.ChangeEntitySeries, s.ID, domain.ChangeOpUpdated); err != nil {
+				return out, err
+			}
+			updated = true
+		}
+
+		var liveExceptions []domain.RecurringException
+		if err := tx.NewSelect().Model(&liveExceptions).Where("series_id = ?", s.ID).Scan(ctx); err != nil {
+			return out, err
+		}
+		if !sameExceptions(liveExceptions, exceptions[s.ID]) {
+			_, err := tx.NewDelete().Model((*domain.RecurringException)(nil)).Where("series_id = ?", s.ID).Exec(ctx)
+			if err != nil {
+				return out, err
+			}
+			for _, ex := range exceptions[s.ID] {
+				if _, err := cal.UpsertRecurringException(ctx, ex); err != nil {
+					return out, err
+				}
+			}
+			if len(exceptions[s.ID]) == 0 {
+				if err := recordSeriesUpdate(ctx, tx, s.ID); err != nil {
+					return out, err
+				}
+			}
+			updated = true
+		}
+		if updated {
+			out.SeriesUpdated = append(out.SeriesUpdated, s.ID)
+		}
+	}
+
+	// Appointments: clear every row that goes or changes before creating
+	// any, so the overlap constraint only ever sees the snapshot's rows and
+	// the ones it shares with the live calendar.
+	var liveAppts []domain.Appointment
+	if err := tx.NewSelect().Model(&liveAppts).Where("user_id = ?", userID).Scan(ctx); err != nil {
+		return out, err
+	}
+	appts := make(map[uuid.UUID]domain.Appointment, len(liveAppts))
+	for _, a := range liveAppts {
+		appts[a.ID] = a
+	}
+	var rewrite []domain.Appointment
+	for _, a := range snapshot.Appointments {
+		a.UserID = userID
+		live, ok := appts[a.ID]
+		delete(appts, a.ID)
+		if ok && sameAppointment(live, a) {
+			continue
+		}
+		if ok {
+			a.ProgramID = live.ProgramID
+			if err := deleteRestoredAppointment(ctx, tx, userID, a.ID); err != nil {
+				return out, err
+			}
+			out.AppointmentsUpdated = append(out.AppointmentsUpdated, a.ID)
+		} else {
+			out.AppointmentsCreated = append(out.AppointmentsCreated, a.ID)
+		}
+		rewrite = append(rewrite, a)
+	}
+	for _, a := range liveAppts {
+		if _, gone := appts[a.ID]; !gone {
+			continue
+		}
+		if err := deleteRestoredAppointment(ctx, tx, userID, a.ID); err != nil {
+			return out, err
+		}
+		out.AppointmentsDeleted = append(out.AppointmentsDeleted, a.ID)
+	}
+	for _, a := range rewrite {
+		if _, err := cal.CreateAppointment(ctx, a); err != nil {
+			// Every id was cleared above, so a clash is another user's row
+			// rather than a replayed create.
+			if errors.Is(err, store.ErrIdempotencyConflict) {
+				return out, store.ErrDuplicate
+			}
+			return out, err
+		}
+	}
+
+	for _, c := range liveContacts {
+		if _, gone := contacts[c.ID]; !gone {
+			continue
+		}
+		_, err := tx.NewDelete().
+			Model((*domain.Contact)(nil)).
+			Where("user_id = ?", userID).
+			Where("id = ?", c.ID).
+			Exec(ctx)
+		if err != nil {
+			return out, err
+		}
+		out.Contacts++
+	}
+	return out, nil
+}
+
+func deleteRestoredAppointment(ctx context.Context, tx bun.Tx, userID string, id uuid.UUID) error {
+	_, err := tx.NewDelete().
+		Model((*domain.Appointment)(nil)).
+		Where("user_id = ?", userID).
+		Where("id = ?", id).
+		Exec(ctx)
+	if err != nil {
+		return err
+	}
+	return recordChange(ctx, tx, userID, domain.ChangeEntityAppointment, id, domain.ChangeOpDeleted)
+}
+
+// sameAppointment compares the fields a calendar bundle carries. Loading a
+// bundle gives rows without a source or kind the import defaults, so those
+// count as equal to the defaults here.
+func sameAppointment(live, want domain.Appointment) bool {
+	for _, a := range []*domain.Appointment{&live, &want} {
+		if a.Source == "" {
+			a.Source = domain.SourceImport
+		}
+		if a.Kind == "" {
+			a.Kind = domain.AppointmentKindEvent
+		}
+	}
+	return live.Title == want.Title &&
+		live.Notes == want.Notes &&
+		live.PrivateNotes == want.PrivateNotes &&
+		live.StartTime.Equal(want.StartTime) &&
+		live.EndTime.Equal(want.EndTime) &&
+		live.Timezone == want.Timezone &&
+		maps.Equal(live.Metadata, want.Metadata) &&
+		live.ExternalSystem == want.ExternalSystem &&
+		live.ExternalID == want.ExternalID &&
+		live.CreatedBy == want.CreatedBy &&
+		sameOptionalTime(live.CheckedInAt, want.CheckedInAt) &&
+		sameOptionalTime(live.CheckedOutAt, want.CheckedOutAt) &&
+		sameOptionalID(live.ContactID, want.ContactID) &&
+		live.Source == want.Source &&
+		live.Kind == want.Kind
+}
+
+// sameSeriesRule compares the fields a calendar bundle carries, exceptions
+// aside.
+func sameSeriesRule(live, want domain.RecurringSeries) bool {
+	return live.Title == want.Title &&
+		live.Notes == want.Notes &&
+		live.Timezone == want.Timezone &&
+		live.DTStart.Equal(want.DTStart) &&
+		live.DurationSeconds == want.DurationSeconds &&
+		live.Frequency == want.Frequency &&
+		live.Interval == want.Interval &&
+		slices.Equal(live.ByWeekday, want.ByWeekday) &&
+		sameOptionalTime(live.Until, want.Until) &&
+		((live.Count == nil && want.Count == nil) || (live.Count != nil && want.Count != nil && *live.Count == *want.Count)) &&
+		live.WeekStart == want.WeekStart &&
+		live.DSTGap == want.DSTGap &&
+		live.DSTAmbiguous == want.DSTAmbiguous &&
+		slices.Equal(live.WeekdayTimes, want.WeekdayTimes) &&
+		maps.Equal(live.Metadata, want.Metadata) &&
+		live.CreatedBy == want.CreatedBy
+}
+
+func sameExceptions(live, want []domain.RecurringException) bool {
+	if len(live) != len(want) {
+		return false
+	}
+	byID := make(map[uuid.UUID]domain.RecurringException, len(live))
+	for _, ex := range live {
+		byID[ex.ID] = ex
+	}
+	for _, w := range want {
+		l, ok := byID[w.ID]
+		if !ok || !l.OccurrenceStart.Equal(w.OccurrenceStart) || l.Kind != w.Kind ||
+			!sameOptionalTime(l.OverrideStart, w.OverrideStart) || !sameOptionalTime(l.OverrideEnd, w.OverrideEnd) ||
+			!sameOptionalString(l.OverrideTitle, w.OverrideTitle) || !sameOptionalString(l.OverrideNotes, w.OverrideNotes) {
+			return false
+		}
+	}
+	return true
+}
+
+func sameOptionalTime(a, b *time.Time) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return a.Equal(*b)
+}
+
+func sameOptionalString(a, b *string) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return *a == *b
+}
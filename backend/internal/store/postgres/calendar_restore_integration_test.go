@@ -0,0 +1,138 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/uptrace/bun"
+
+	"schedula/backend/internal/domain"
+	"schedula/backend/internal/store"
+)
+
+func TestPostgresIntegration_RestoreCalendar(t *testing.T) {
+	databaseURL := strings.TrimSpace(os.Getenv("SCHEDULA_TEST_DATABASE_URL"))
+	if databaseURL == "" {
+		t.Skip("SCHEDULA_TEST_DATABASE_URL not set")
+	}
+
+	db, err := Open(databaseURL, PoolConfig{MaxOpenConns: 1})
+	if err != nil {
+		t.Fatalf("Open error: %v", err)
+	}
+	t.Cleanup(func() {
+		_ = Close(db)
+	})
+
+	schema := "schedula_test_" + randomHex(t, 8)
+	t.Cleanup(func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+		_, _ = db.NewRaw("DROP SCHEMA IF EXISTS " + schema + " CASCADE").Exec(ctx)
+	})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	err = db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		if _, err := tx.NewRaw("CREATE SCHEMA " + schema).Exec(ctx); err != nil {
+			return err
+		}
+		if _, err := tx.NewRaw("SET LOCAL search_path TO " + schema).Exec(ctx); err != nil {
+			return err
+		}
+		if err := applyMigrations(ctx, tx); err != nil {
+			return err
+		}
+
+		c := calendarTx{tx: tx}
+		at := func(hour int) time.Time { return time.Date(2026, 3, 2, hour, 0, 0, 0, time.UTC) }
+		book := func(title string, start int) (domain.Appointment, error) {
+			return c.CreateAppointment(ctx, domain.Appointment{UserID: "u1", Title: title, StartTime: at(start), EndTime: at(start + 1)})
+		}
+		kept, err := book("kept", 9)
+		if err != nil {
+			return err
+		}
+		moved, err := book("moved", 10)
+		if err != nil {
+			return err
+		}
+		count := 3
+		series, err := c.CreateRecurringSeries(ctx, domain.RecurringSeries{
+			UserID: "u1", Title: "standup", Timezone: "UTC", DTStart: at(8), DurationSeconds: 900,
+			Frequency: domain.RecurrenceFrequencyWeekly, Interval: 1, ByWeekday: []int16{1}, Count: &count,
+		})
+		if err != nil {
+			return err
+		}
+
+		var snap store.CalendarSnapshot
+		if err := tx.NewSelect().Model(&snap.Appointments).Where("user_id = ?", "u1").Scan(ctx); err != nil {
+			return err
+		}
+		if err := tx.NewSelect().Model(&snap.Series).Where("user_id = ?", "u1").Scan(ctx); err != nil {
+			return err
+		}
+
+		// After the snapshot: one appointment moves out of its slot, another
+		// takes it, the first is deleted and the series gains a skip.
+		if _, err := tx.NewUpdate().Model((*domain.Appointment)(nil)).Set("start_time = ?, end_time = ?", at(11), at(12)).Where("id = ?", moved.ID).Exec(ctx); err != nil {
+			return err
+		}
+		squatter, err := book("squatter", 10)
+		if err != nil {
+			return err
+		}
+		if _, err := tx.NewDelete().Model((*domain.Appointment)(nil)).Where("id = ?", kept.ID).Exec(ctx); err != nil {
+			return err
+		}
+		if _, err := c.UpsertRecurringException(ctx, domain.RecurringException{
+			SeriesID: series.ID, OccurrenceStart: series.DTStart, Kind: domain.RecurringExceptionKindSkip,
+		}); err != nil {
+			return err
+		}
+
+		out, err := restoreCalendar(ctx, c, "u1", snap)
+		if err != nil {
+			return err
+		}
+		if len(out.AppointmentsCreated) != 1 || out.AppointmentsCreated[0] != kept.ID ||
+			len(out.AppointmentsUpdated) != 1 || out.AppointmentsUpdated[0] != moved.ID ||
+			len(out.AppointmentsDeleted) != 1 || out.AppointmentsDeleted[0] != squatter.ID ||
+			len(out.SeriesUpdated) != 1 || len(out.SeriesCreated)+len(out.SeriesDeleted) != 0 {
+			return fmt.Errorf("restore = %+v", out)
+		}
+
+		var appts []domain.Appointment
+		if err := tx.NewSelect().Model(&appts).Where("user_id = ?", "u1").OrderExpr("start_time").Scan(ctx); err != nil {
+			return err
+		}
+		if len(appts) != 2 || appts[0].ID != kept.ID || appts[1].ID != moved.ID || !appts[1].StartTime.Equal(at(10)) {
+			return fmt.Errorf("appointments after restore = %+v", appts)
+		}
+		skips, err := tx.NewSelect().Model((*domain.RecurringException)(nil)).Where("series_id = ?", series.ID).Count(ctx)
+		if err != nil {
+			return err
+		}
+		if skips != 0 {
+			return fmt.Errorf("series keeps %d exceptions, want none", skips)
+		}
+
+		again, err := restoreCalendar(ctx, c, "u1", snap)
+		if err != nil {
+			return err
+		}
+		if len(again.AppointmentsCreated)+len(again.AppointmentsUpdated)+len(again.AppointmentsDeleted)+len(again.SeriesUpdated) != 0 {
+			return fmt.Errorf("second restore = %+v, want no changes", again)
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("tx error: %v", err)
+	}
+}
@@ -0,0 +1,67 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/google/uuid"
+
+	"schedula/backend/internal/domain"
+	"schedula/backend/internal/store"
+	"schedula/backend/internal/store/pgerrors"
+)
+
+func (r *AppointmentRepo) SaveCalendarSnapshotInfo(ctx context.Context, info domain.CalendarSnapshotInfo) error {
+	_, err := r.db.NewInsert().Model(&info).Exec(ctx)
+	return pgerrors.Classify(err)
+}
+
+func (r *AppointmentRepo) ListCalendarSnapshotInfo(ctx context.Context, userID string) ([]domain.CalendarSnapshotInfo, error) {
+	var out []domain.CalendarSnapshotInfo
+	err := r.db.NewSelect().
+		Model(&out).
+		Where("user_id = ?", userID).
+		OrderExpr("created_at DESC, id DESC").
+		Scan(ctx)
+	if err != nil {
+		return nil, pgerrors.Classify(err)
+	}
+	return out, nil
+}
+
+func (r *AppointmentRepo) GetCalendarSnapshotInfo(ctx context.Context, userID string, snapshotID uuid.UUID) (domain.CalendarSnapshotInfo, error) {
+	var out domain.CalendarSnapshotInfo
+	err := r.db.NewSelect().
+		Model(&out).
+		Where("user_id = ?", userID).
+		Where("id = ?", snapshotID).
+		Limit(1).
+		Scan(ctx)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return domain.CalendarSnapshotInfo{}, store.ErrNotFound
+		}
+		return domain.CalendarSnapshotInfo{}, pgerrors.Classify(err)
+	}
+	return out, nil
+}
+
+func (r *AppointmentRepo) DeleteCalendarSnapshotInfo(ctx context.Context, userID string, snapshotID uuid.UUID) error {
+	res, err := r.db.NewDelete().
+		Model((*domain.CalendarSnapshotInfo)(nil)).
+		Where("user_id = ?", userID).
+		Where("id = ?", snapshotID).
+		Exec(ctx)
+	if err != nil {
+		return pgerrors.Classify(err)
+	}
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if affected == 0 {
+		return store.ErrNotFound
+	}
+	return nil
+}
@@ -0,0 +1,103 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+
+	"schedula/backend/internal/domain"
+	"schedula/backend/internal/store/pgerrors"
+)
+
+// recordChange appends to the user's change log. Callers must hold the
+// user's calendar lock so sequence numbers follow commit order.
+func recordChange(ctx context.Context, db bun.IDB, userID string, entity domain.ChangeEntity, entityID uuid.UUID, op domain.ChangeOp) error {
+	_, err := db.NewInsert().
+		Model(&domain.CalendarChange{UserID: userID, EntityType: entity, EntityID: entityID, Op: op}).
+		ExcludeColumn("seq", "changed_at").
+		Exec(ctx)
+	if err != nil {
+		return err
+	}
+	return bumpCalendarVersion(ctx, db, "SELECT ?::text", userID)
+}
+
+// bumpCalendarVersion advances the version of the user selected by
+// userQuery once per transaction, however many changes it records. A bump
+// made inside a rolled-back savepoint rolls back with it, so the next change
+// bumps again.
+func bumpCalendarVersion(ctx context.Context, db bun.IDB, userQuery string, args ...any) error {
+	_, err := db.NewRaw(
+		"INSERT INTO calendar_versions (user_id, version, xact_id, updated_at) "+
+			"SELECT u, 1, txid_current(), now() FROM ("+userQuery+") AS s(u) "+
+			"ON CONFLICT (user_id) DO UPDATE SET version = calendar_versions.version + 1, xact_id = EXCLUDED.xact_id, updated_at = EXCLUDED.updated_at "+
+			"WHERE calendar_versions.xact_id <> EXCLUDED.xact_id",
+		args...,
+	).Exec(ctx)
+	return err
+}
+
+// recordSeriesUpdate logs an update to a series the caller knows only by id.
+func recordSeriesUpdate(ctx context.Context, db bun.IDB, seriesID uuid.UUID) error {
+	_, err := db.NewRaw(
+		"INSERT INTO calendar_changes (user_id, entity_type, entity_id, op) SELECT user_id, ?, id, ? FROM recurring_series WHERE id = ?",
+		domain.ChangeEntitySeries, domain.ChangeOpUpdated, seriesID,
+	).Exec(ctx)
+	if err != nil {
+		return err
+	}
+	return bumpCalendarVersion(ctx, db, "SELECT user_id FROM recurring_series WHERE id = ?", seriesID)
+}
+
+// ListChanges returns up to limit entries of the user's change log after
+// seq afterSeq, oldest first.
+func (r *AppointmentRepo) ListChanges(ctx context.Context, userID string, afterSeq int64, limit int) ([]domain.CalendarChange, error) {
+	var rows []domain.CalendarChange
+	err := r.db.NewSelect().
+		Model(&rows).
+		Where("user_id = ?", userID).
+		Where("seq > ?", afterSeq).
+		OrderExpr("seq ASC").
+		Limit(limit).
+		Scan(ctx)
+	if err != nil {
+		return nil, pgerrors.Classify(err)
+	}
+	return rows, nil
+}
+
+// CalendarVersion returns the user's calendar version, or 0 before their
+// first change.
+func (r *AppointmentRepo) CalendarVersion(ctx context.Context, userID string) (int64, error) {
+	var version int64
+	err := r.db.NewSelect().
+		Model((*domain.CalendarVersion)(nil)).
+		Column("version").
+		Where("user_id = ?", userID).
+		Scan(ctx, &version)
+	if errors.Is(err, sql.ErrNoRows) {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, pgerrors.Classify(err)
+	}
+	return version, nil
+}
+
+// LatestChangeSeq returns the seq of the user's newest change, or 0 when the
+// log is empty.
+func (r *AppointmentRepo) LatestChangeSeq(ctx context.Context, userID string) (int64, error) {
+	var seq int64
+	err := r.db.NewSelect().
+		Model((*domain.CalendarChange)(nil)).
+		ColumnExpr("COALESCE(MAX(seq), 0)").
+		Where("user_id = ?", userID).
+		Scan(ctx, &seq)
+	if err != nil {
+		return 0, pgerrors.Classify(err)
+	}
+	return seq, nil
+}
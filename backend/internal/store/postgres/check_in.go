@@ -0,0 +1,82 @@
+package postgres
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+
+	"schedula/backend/internal/domain"
+	"schedula/backend/internal/store"
+	"schedula/backend/internal/store/pgerrors"
+)
+
+// CheckInAppointment records when the session started. It returns
+// ErrConflict if the appointment is already checked in.
+func (r *AppointmentRepo) CheckInAppointment(ctx context.Context, userID string, appointmentID uuid.UUID, at time.Time) (domain.Appointment, error) {
+	var out domain.Appointment
+	err := r.db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		if err := r.lockCalendar(ctx, tx, userID); err != nil {
+			return err
+		}
+		cal := calendarTx{tx: tx}
+		appt, err := cal.GetAppointment(ctx, userID, appointmentID)
+		if err != nil {
+			return err
+		}
+		if appt.CheckedInAt != nil {
+			return store.ErrConflict
+		}
+		appt.CheckedInAt = &at
+		out, err = cal.setSessionTimes(ctx, appt)
+		return err
+	})
+	if err != nil {
+		return domain.Appointment{}, pgerrors.Classify(err)
+	}
+	return out, nil
+}
+
+// CheckOutAppointment records when the session ended. It returns
+// ErrConflict unless the appointment is checked in, not yet checked out, and
+// at is no earlier than the check-in.
+func (r *AppointmentRepo) CheckOutAppointment(ctx context.Context, userID string, appointmentID uuid.UUID, at time.Time) (domain.Appointment, error) {
+	var out domain.Appointment
+	err := r.db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		if err := r.lockCalendar(ctx, tx, userID); err != nil {
+			return err
+		}
+		cal := calendarTx{tx: tx}
+		appt, err := cal.GetAppointment(ctx, userID, appointmentID)
+		if err != nil {
+			return err
+		}
+		if appt.CheckedInAt == nil || appt.CheckedOutAt != nil || at.Before(*appt.CheckedInAt) {
+			return store.ErrConflict
+		}
+		appt.CheckedOutAt = &at
+		out, err = cal.setSessionTimes(ctx, appt)
+		return err
+	})
+	if err != nil {
+		return domain.Appointment{}, pgerrors.Classify(err)
+	}
+	return out, nil
+}
+
+func (r calendarTx) setSessionTimes(ctx context.Context, appt domain.Appointment) (domain.Appointment, error) {
+	_, err := r.tx.NewUpdate().
+		Model(&appt).
+		Column("checked_in_at", "checked_out_at", "updated_at").
+		WherePK().
+		Returning("updated_at").
+		Exec(ctx)
+	if err != nil {
+		return domain.Appointment{}, err
+	}
+	if err := recordChange(ctx, r.tx, appt.UserID, domain.ChangeEntityAppointment, appt.ID, domain.ChangeOpUpdated); err != nil {
+		return domain.Appointment{}, err
+	}
+	return appt, nil
+}
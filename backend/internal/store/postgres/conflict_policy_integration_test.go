@@ -0,0 +1,101 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+
+	"schedula/backend/internal/conflicts"
+	"schedula/backend/internal/domain"
+	"schedula/backend/internal/store"
+)
+
+func TestPostgresIntegration_ConflictPolicy(t *testing.T) {
+	databaseURL := strings.TrimSpace(os.Getenv("SCHEDULA_TEST_DATABASE_URL"))
+	if databaseURL == "" {
+		t.Skip("SCHEDULA_TEST_DATABASE_URL not set")
+	}
+
+	db, err := Open(databaseURL, PoolConfig{MaxOpenConns: 1})
+	if err != nil {
+		t.Fatalf("Open error: %v", err)
+	}
+	t.Cleanup(func() {
+		_ = Close(db)
+	})
+
+	schema := "schedula_test_" + randomHex(t, 8)
+	t.Cleanup(func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+		_, _ = db.NewRaw("DROP SCHEMA IF EXISTS " + schema + " CASCADE").Exec(ctx)
+	})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	err = db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		if _, err := tx.NewRaw("CREATE SCHEMA " + schema).Exec(ctx); err != nil {
+			return err
+		}
+		if _, err := tx.NewRaw("SET LOCAL search_path TO " + schema).Exec(ctx); err != nil {
+			return err
+		}
+		if err := applyMigrations(ctx, tx); err != nil {
+			return err
+		}
+
+		at := time.Date(2026, 3, 2, 12, 0, 0, 0, time.UTC)
+		start := time.Date(2026, 3, 3, 9, 0, 0, 0, time.UTC)
+		appt := func(title, resource string, offset time.Duration) domain.Appointment {
+			return domain.Appointment{
+				ID: uuid.New(), UserID: "u1", Title: title,
+				StartTime: start.Add(offset), EndTime: start.Add(offset + time.Hour),
+				Metadata: map[string]string{conflicts.ResourceKey: resource}, CreatedAt: at, UpdatedAt: at,
+			}
+		}
+		strict := calendarTx{tx: tx}
+		if _, err := strict.CreateAppointment(ctx, appt("Desk 1", "desk-1", 0)); err != nil {
+			return err
+		}
+
+		// Another desk may share the hour under the resource policy, and the
+		// row is kept out of the overlap constraint.
+		resource := calendarTx{tx: tx, policy: conflicts.ResourceAware{}}
+		desk2, err := resource.CreateAppointment(ctx, appt("Desk 2", "desk-2", 30*time.Minute))
+		if err != nil {
+			return err
+		}
+		if !desk2.OverlapAllowed || len(desk2.Warnings) != 0 {
+			return fmt.Errorf("desk 2 = allowed %v, warnings %v", desk2.OverlapAllowed, desk2.Warnings)
+		}
+		if _, err := resource.CreateAppointment(ctx, appt("Desk 1 again", "desk-1", 15*time.Minute)); !errors.Is(err, store.ErrConflict) {
+			return fmt.Errorf("same desk error = %v, want ErrConflict", err)
+		}
+
+		// A strict write still sees the flagged row the constraint cannot.
+		if _, err := strict.CreateAppointment(ctx, appt("Late", "", 75*time.Minute)); !errors.Is(err, store.ErrConflict) {
+			return fmt.Errorf("strict over a flagged row error = %v, want ErrConflict", err)
+		}
+
+		advisory := calendarTx{tx: tx, policy: conflicts.Advisory{}}
+		late, err := advisory.CreateAppointment(ctx, appt("Late", "", 75*time.Minute))
+		if err != nil {
+			return err
+		}
+		if len(late.Warnings) != 1 || late.Warnings[0].Code != domain.WarningCodeOverlap {
+			return fmt.Errorf("advisory warnings = %v, want one overlap", late.Warnings)
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("tx error: %v", err)
+	}
+}
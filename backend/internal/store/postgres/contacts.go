@@ -0,0 +1,128 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+
+	"schedula/backend/internal/domain"
+	"schedula/backend/internal/store"
+	"schedula/backend/internal/store/pgerrors"
+)
+
+func (r *AppointmentRepo) CreateContact(ctx context.Context, contact domain.Contact) (domain.Contact, error) {
+	m := domain.Contact{
+		ID:     contact.ID,
+		UserID: contact.UserID,
+		Name:   contact.Name,
+		Email:  contact.Email,
+		Phone:  contact.Phone,
+	}
+	if _, err := r.db.NewInsert().Model(&m).Exec(ctx); err != nil {
+		return domain.Contact{}, pgerrors.Classify(err)
+	}
+	return m, nil
+}
+
+func (r *AppointmentRepo) GetContact(ctx context.Context, userID string, contactID uuid.UUID) (domain.Contact, error) {
+	var out domain.Contact
+	err := r.db.NewSelect().
+		Model(&out).
+		Where("user_id = ?", userID).
+		Where("id = ?", contactID).
+		Limit(1).
+		Scan(ctx)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return domain.Contact{}, store.ErrNotFound
+		}
+		return domain.Contact{}, pgerrors.Classify(err)
+	}
+	return out, nil
+}
+
+// UpdateContact replaces the name, email and phone of the user's contact.
+func (r *AppointmentRepo) UpdateContact(ctx context.Context, contact domain.Contact) (domain.Contact, error) {
+	m := contact
+	res, err := r.db.NewUpdate().
+		Model(&m).
+		Column("name", "email", "phone", "updated_at").
+		Where("user_id = ?", contact.UserID).
+		Where("id = ?", contact.ID).
+		Returning("*").
+		Exec(ctx)
+	if err != nil {
+		return domain.Contact{}, pgerrors.Classify(err)
+	}
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return domain.Contact{}, err
+	}
+	if affected == 0 {
+		return domain.Contact{}, store.ErrNotFound
+	}
+	return m, nil
+}
+
+// DeleteContact removes the contact and unlinks its appointments. The
+// unlinking is logged as appointment updates so synced clients drop the
+// stale contact too.
+func (r *AppointmentRepo) DeleteContact(ctx context.Context, userID string, contactID uuid.UUID) error {
+	err := r.db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		if err := r.lockCalendar(ctx, tx, userID); err != nil {
+			return err
+		}
+		var unlinked []uuid.UUID
+		err := tx.NewUpdate().
+			Model((*domain.Appointment)(nil)).
+			Set("contact_id = NULL").
+			Set("updated_at = ?", time.Now().UTC()).
+			Where("user_id = ?", userID).
+			Where("contact_id = ?", contactID).
+			Returning("id").
+			Scan(ctx, &unlinked)
+		if err != nil && !errors.Is(err, sql.ErrNoRows) {
+			return err
+		}
+		for _, id := range unlinked {
+			if err := recordChange(ctx, tx, userID, domain.ChangeEntityAppointment, id, domain.ChangeOpUpdated); err != nil {
+				return err
+			}
+		}
+
+		res, err := tx.NewDelete().
+			Model((*domain.Contact)(nil)).
+			Where("user_id = ?", userID).
+			Where("id = ?", contactID).
+			Exec(ctx)
+		if err != nil {
+			return err
+		}
+		affected, err := res.RowsAffected()
+		if err != nil {
+			return err
+		}
+		if affected == 0 {
+			return store.ErrNotFound
+		}
+		return nil
+	})
+	return pgerrors.Classify(err)
+}
+
+func (r *AppointmentRepo) ListContacts(ctx context.Context, userID string) ([]domain.Contact, error) {
+	var rows []domain.Contact
+	err := r.db.NewSelect().
+		Model(&rows).
+		Where("user_id = ?", userID).
+		OrderExpr("lower(name) ASC, id ASC").
+		Scan(ctx)
+	if err != nil {
+		return nil, pgerrors.Classify(err)
+	}
+	return rows, nil
+}
@@ -0,0 +1,111 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/uptrace/bun"
+
+	"schedula/backend/internal/domain"
+	"schedula/backend/internal/store"
+	"schedula/backend/internal/store/pgerrors"
+)
+
+func (r *AppointmentRepo) GetDaySummaryBuild(ctx context.Context, userID string) (domain.DaySummaryBuild, error) {
+	var build domain.DaySummaryBuild
+	err := r.db.NewSelect().
+		Model(&build).
+		Where("user_id = ?", userID).
+		Scan(ctx)
+	if errors.Is(err, sql.ErrNoRows) {
+		return domain.DaySummaryBuild{}, store.ErrNotFound
+	}
+	if err != nil {
+		return domain.DaySummaryBuild{}, pgerrors.Classify(err)
+	}
+	return build, nil
+}
+
+func (r *AppointmentRepo) ListDaySummaries(ctx context.Context, userID string, from, to time.Time) ([]domain.DaySummary, error) {
+	var rows []domain.DaySummary
+	err := r.db.NewSelect().
+		Model(&rows).
+		Where("user_id = ?", userID).
+		Where("day >= ?::date AND day < ?::date", from.Format(time.DateOnly), to.Format(time.DateOnly)).
+		OrderExpr("day ASC").
+		Scan(ctx)
+	if err != nil {
+		return nil, pgerrors.Classify(err)
+	}
+	return rows, nil
+}
+
+// ReplaceDaySummaries upserts the build first. The upsert's row lock makes
+// concurrent rebuilds for one user take turns, and its version check lets
+// the one built from the later calendar win whichever commits last.
+func (r *AppointmentRepo) ReplaceDaySummaries(ctx context.Context, build domain.DaySummaryBuild, days []domain.DaySummary) (bool, error) {
+	wrote := false
+	err := r.db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		res, err := tx.NewInsert().
+			Model(&build).
+			On("CONFLICT (user_id) DO UPDATE").
+			Set("time_zone = EXCLUDED.time_zone").
+			Set("calendar_version = EXCLUDED.calendar_version").
+			Set("window_start = EXCLUDED.window_start").
+			Set("window_end = EXCLUDED.window_end").
+			Set("built_at = EXCLUDED.built_at").
+			Where("day_summary_builds.calendar_version <= EXCLUDED.calendar_version").
+			Exec(ctx)
+		if err != nil {
+			return err
+		}
+		if n, err := res.RowsAffected(); err != nil || n == 0 {
+			return err
+		}
+		if _, err := tx.NewDelete().
+			Model((*domain.DaySummary)(nil)).
+			Where("user_id = ?", build.UserID).
+			Exec(ctx); err != nil {
+			return err
+		}
+		if len(days) > 0 {
+			if _, err := tx.NewInsert().Model(&days).Exec(ctx); err != nil {
+				return err
+			}
+		}
+		wrote = true
+		return nil
+	})
+	if err != nil {
+		return false, pgerrors.Classify(err)
+	}
+	return wrote, nil
+}
+
+func (r *AppointmentRepo) ListStaleDaySummaryUsers(ctx context.Context, builtBefore time.Time, limit int) ([]string, error) {
+	var users []string
+	err := r.db.NewRaw(
+		"SELECT v.user_id FROM calendar_versions AS v "+
+			"LEFT JOIN day_summary_builds AS b ON b.user_id = v.user_id "+
+			"WHERE b.user_id IS NULL OR b.calendar_version < v.version OR b.built_at < ? "+
+			"ORDER BY b.built_at ASC NULLS FIRST, v.user_id ASC LIMIT ?",
+		builtBefore, limit,
+	).Scan(ctx, &users)
+	if err != nil {
+		return nil, pgerrors.Classify(err)
+	}
+	return users, nil
+}
+
+func (r *AppointmentRepo) ClearDaySummaries(ctx context.Context) error {
+	err := r.db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		if _, err := tx.NewDelete().Model((*domain.DaySummaryBuild)(nil)).Where("TRUE").Exec(ctx); err != nil {
+			return err
+		}
+		_, err := tx.NewDelete().Model((*domain.DaySummary)(nil)).Where("TRUE").Exec(ctx)
+		return err
+	})
+	return pgerrors.Classify(err)
+}
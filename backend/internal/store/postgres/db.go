@@ -1,10 +1,14 @@
 package postgres
 
 import (
+	"context"
 	"database/sql"
+	"database/sql/driver"
+	"fmt"
 	"time"
 
-	_ "github.com/jackc/pgx/v5/stdlib"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/stdlib"
 	"github.com/uptrace/bun"
 	"github.com/uptrace/bun/dialect/pgdialect"
 )
@@ -14,13 +18,41 @@ type PoolConfig struct {
 	MaxIdleConns    int
 	ConnMaxLifetime time.Duration
 	ConnMaxIdleTime time.Duration
+
+	// ReadOnly opens every session with default_transaction_read_only, so a
+	// replica deployment cannot write even if a mutation slips past the
+	// transport allowlist.
+	ReadOnly bool
+
+	// WrapConnector, when set, wraps the driver connector before the pool
+	// is built. Fault injection uses it to sit under every query.
+	WrapConnector func(driver.Connector) driver.Connector
+}
+
+type RetryConfig struct {
+	MaxWait        time.Duration
+	InitialBackoff time.Duration
+	MaxBackoff     time.Duration
+	OnRetry        func(attempt int, wait time.Duration, err error)
 }
 
 func Open(databaseURL string, pool PoolConfig) (*bun.DB, error) {
-	sqlDB, err := sql.Open("pgx", databaseURL)
+	return OpenWithRetry(context.Background(), databaseURL, pool, RetryConfig{})
+}
+
+func OpenWithRetry(ctx context.Context, databaseURL string, pool PoolConfig, retry RetryConfig) (*bun.DB, error) {
+	connConfig, err := pgx.ParseConfig(databaseURL)
 	if err != nil {
 		return nil, err
 	}
+	if pool.ReadOnly {
+		connConfig.RuntimeParams["default_transaction_read_only"] = "on"
+	}
+	var connector driver.Connector = stdlib.GetConnector(*connConfig)
+	if pool.WrapConnector != nil {
+		connector = pool.WrapConnector(connector)
+	}
+	sqlDB := sql.OpenDB(connector)
 
 	if pool.MaxOpenConns > 0 {
 		sqlDB.SetMaxOpenConns(pool.MaxOpenConns)
@@ -35,15 +67,63 @@ func Open(databaseURL string, pool PoolConfig) (*bun.DB, error) {
 		sqlDB.SetConnMaxIdleTime(pool.ConnMaxIdleTime)
 	}
 
-	if err := sqlDB.Ping(); err != nil {
+	if err := pingWithRetry(ctx, sqlDB.PingContext, retry); err != nil {
 		_ = sqlDB.Close()
 		return nil, err
 	}
 
 	db := bun.NewDB(sqlDB, pgdialect.New())
+	db.AddQueryHook(queryTimer{})
 	return db, nil
 }
 
+func pingWithRetry(ctx context.Context, ping func(context.Context) error, retry RetryConfig) error {
+	backoff := retry.InitialBackoff
+	if backoff <= 0 {
+		backoff = 250 * time.Millisecond
+	}
+	maxBackoff := retry.MaxBackoff
+	if maxBackoff <= 0 {
+		maxBackoff = 5 * time.Second
+	}
+	deadline := time.Now().Add(retry.MaxWait)
+
+	for attempt := 1; ; attempt++ {
+		err := ping(ctx)
+		if err == nil {
+			return nil
+		}
+		if retry.MaxWait <= 0 {
+			return err
+		}
+
+		remaining := time.Until(deadline)
+		if remaining <= 0 {
+			return fmt.Errorf("database unreachable after %d attempts: %w", attempt, err)
+		}
+		wait := backoff
+		if wait > remaining {
+			wait = remaining
+		}
+		if retry.OnRetry != nil {
+			retry.OnRetry(attempt, wait, err)
+		}
+
+		timer := time.NewTimer(wait)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return ctx.Err()
+		case <-timer.C:
+		}
+
+		backoff *= 2
+		if backoff > maxBackoff {
+			backoff = maxBackoff
+		}
+	}
+}
+
 func Close(db *bun.DB) error {
 	if db == nil {
 		return nil
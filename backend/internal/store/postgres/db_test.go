@@ -0,0 +1,83 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestPingWithRetry_NoRetryByDefault(t *testing.T) {
+	calls := 0
+	err := pingWithRetry(context.Background(), func(ctx context.Context) error {
+		calls++
+		return errors.New("down")
+	}, RetryConfig{})
+	if err == nil {
+		t.Fatalf("expected error")
+	}
+	if calls != 1 {
+		t.Fatalf("calls = %d, want 1", calls)
+	}
+}
+
+func TestPingWithRetry_RetriesUntilReachable(t *testing.T) {
+	calls := 0
+	var waits []time.Duration
+	err := pingWithRetry(context.Background(), func(ctx context.Context) error {
+		calls++
+		if calls < 4 {
+			return errors.New("down")
+		}
+		return nil
+	}, RetryConfig{
+		MaxWait:        time.Second,
+		InitialBackoff: time.Millisecond,
+		MaxBackoff:     2 * time.Millisecond,
+		OnRetry: func(attempt int, wait time.Duration, err error) {
+			waits = append(waits, wait)
+		},
+	})
+	if err != nil {
+		t.Fatalf("pingWithRetry error: %v", err)
+	}
+	if calls != 4 {
+		t.Fatalf("calls = %d, want 4", calls)
+	}
+	want := []time.Duration{time.Millisecond, 2 * time.Millisecond, 2 * time.Millisecond}
+	if len(waits) != len(want) {
+		t.Fatalf("waits = %v, want %v", waits, want)
+	}
+	for i := range want {
+		if waits[i] != want[i] {
+			t.Fatalf("waits = %v, want %v", waits, want)
+		}
+	}
+}
+
+func TestPingWithRetry_GivesUpAfterMaxWait(t *testing.T) {
+	down := errors.New("down")
+	err := pingWithRetry(context.Background(), func(ctx context.Context) error {
+		return down
+	}, RetryConfig{
+		MaxWait:        20 * time.Millisecond,
+		InitialBackoff: 5 * time.Millisecond,
+	})
+	if !errors.Is(err, down) {
+		t.Fatalf("error = %v, want wrapped %v", err, down)
+	}
+}
+
+func TestPingWithRetry_StopsOnContextCancel(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	err := pingWithRetry(ctx, func(ctx context.Context) error {
+		cancel()
+		return errors.New("down")
+	}, RetryConfig{
+		MaxWait:        time.Minute,
+		InitialBackoff: time.Minute,
+	})
+	if !errors.Is(err, context.Canceled) {
+		t.Fatalf("error = %v, want %v", err, context.Canceled)
+	}
+}
@@ -0,0 +1,83 @@
+package postgres
+
+import (
+	"context"
+
+	"schedula/backend/internal/domain"
+	"schedula/backend/internal/store"
+	"schedula/backend/internal/store/pgerrors"
+)
+
+// GrantDelegation records that the delegate may act for the principal.
+// Granting an existing pair returns the existing grant.
+func (r *AppointmentRepo) GrantDelegation(ctx context.Context, grant domain.DelegationGrant) (domain.DelegationGrant, error) {
+	m := domain.DelegationGrant{
+		ID:          grant.ID,
+		PrincipalID: grant.PrincipalID,
+		DelegateID:  grant.DelegateID,
+		CreatedAt:   grant.CreatedAt,
+	}
+	_, err := r.db.NewInsert().
+		Model(&m).
+		On("CONFLICT (principal_id, delegate_id) DO NOTHING").
+		Exec(ctx)
+	if err != nil {
+		return domain.DelegationGrant{}, pgerrors.Classify(err)
+	}
+
+	var out domain.DelegationGrant
+	err = r.db.NewSelect().
+		Model(&out).
+		Where("principal_id = ?", grant.PrincipalID).
+		Where("delegate_id = ?", grant.DelegateID).
+		Limit(1).
+		Scan(ctx)
+	if err != nil {
+		return domain.DelegationGrant{}, pgerrors.Classify(err)
+	}
+	return out, nil
+}
+
+func (r *AppointmentRepo) RevokeDelegation(ctx context.Context, principalID, delegateID string) error {
+	res, err := r.db.NewDelete().
+		Model((*domain.DelegationGrant)(nil)).
+		Where("principal_id = ?", principalID).
+		Where("delegate_id = ?", delegateID).
+		Exec(ctx)
+	if err != nil {
+		return pgerrors.Classify(err)
+	}
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if affected == 0 {
+		return store.ErrNotFound
+	}
+	return nil
+}
+
+func (r *AppointmentRepo) ListDelegations(ctx context.Context, principalID string) ([]domain.DelegationGrant, error) {
+	var rows []domain.DelegationGrant
+	err := r.db.NewSelect().
+		Model(&rows).
+		Where("principal_id = ?", principalID).
+		OrderExpr("created_at ASC, delegate_id ASC").
+		Scan(ctx)
+	if err != nil {
+		return nil, pgerrors.Classify(err)
+	}
+	return rows, nil
+}
+
+func (r *AppointmentRepo) HasDelegation(ctx context.Context, principalID, delegateID string) (bool, error) {
+	ok, err := r.db.NewSelect().
+		Model((*domain.DelegationGrant)(nil)).
+		Where("principal_id = ?", principalID).
+		Where("delegate_id = ?", delegateID).
+		Exists(ctx)
+	if err != nil {
+		return false, pgerrors.Classify(err)
+	}
+	return ok, nil
+}
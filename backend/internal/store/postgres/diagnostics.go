@@ -0,0 +1,148 @@
+package postgres
+
+import (
+	"context"
+	"time"
+
+	"github.com/uptrace/bun"
+
+	"schedula/backend/internal/store"
+	"schedula/backend/internal/store/pgerrors"
+)
+
+// DiagnosticsReader reports table, index and pool health for operators.
+type DiagnosticsReader struct {
+	db *bun.DB
+}
+
+func NewDiagnosticsReader(db *bun.DB) *DiagnosticsReader {
+	return &DiagnosticsReader{db: db}
+}
+
+type tableStatsRow struct {
+	Name           string     `bun:"name"`
+	TotalBytes     int64      `bun:"total_bytes"`
+	TableBytes     int64      `bun:"table_bytes"`
+	IndexBytes     int64      `bun:"index_bytes"`
+	LiveTuples     int64      `bun:"live_tuples"`
+	DeadTuples     int64      `bun:"dead_tuples"`
+	LastVacuum     *time.Time `bun:"last_vacuum"`
+	LastAutovacuum *time.Time `bun:"last_autovacuum"`
+}
+
+type indexStatsRow struct {
+	Name      string  `bun:"name"`
+	Table     string  `bun:"table_name"`
+	Bytes     int64   `bun:"bytes"`
+	Tuples    float64 `bun:"tuples"`
+	Scans     int64   `bun:"scans"`
+	Valid     bool    `bun:"valid"`
+	Ready     bool    `bun:"ready"`
+	Exclusion bool    `bun:"exclusion"`
+}
+
+// DatabaseDiagnostics reads statistics for the tables and indexes in the
+// current schema. Everything comes from the catalog and stats views, so it
+// takes no locks on user tables.
+func (r *DiagnosticsReader) DatabaseDiagnostics(ctx context.Context) (store.DatabaseDiagnostics, error) {
+	var tables []tableStatsRow
+	err := r.db.NewRaw(`
+		SELECT c.relname AS name,
+			pg_total_relation_size(c.oid) AS total_bytes,
+			pg_relation_size(c.oid) AS table_bytes,
+			pg_indexes_size(c.oid) AS index_bytes,
+			s.n_live_tup AS live_tuples,
+			s.n_dead_tup AS dead_tuples,
+			s.last_vacuum,
+			s.last_autovacuum
+		FROM pg_stat_user_tables s
+		JOIN pg_class c ON c.oid = s.relid
+		WHERE s.schemaname = current_schema()
+		ORDER BY total_bytes DESC`).Scan(ctx, &tables)
+	if err != nil {
+		return store.DatabaseDiagnostics{}, pgerrors.Classify(err)
+	}
+
+	var indexes []indexStatsRow
+	err = r.db.NewRaw(`
+		SELECT i.relname AS name,
+			t.relname AS table_name,
+			pg_relation_size(i.oid) AS bytes,
+			GREATEST(t.reltuples, 0)::float8 AS tuples,
+			COALESCE(s.idx_scan, 0) AS scans,
+			x.indisvalid AS valid,
+			x.indisready AS ready,
+			EXISTS (
+				SELECT 1 FROM pg_constraint k WHERE k.conindid = i.oid AND k.contype = 'x'
+			) AS exclusion
+		FROM pg_index x
+		JOIN pg_class i ON i.oid = x.indexrelid
+		JOIN pg_class t ON t.oid = x.indrelid
+		JOIN pg_namespace n ON n.oid = t.relnamespace
+		LEFT JOIN pg_stat_user_indexes s ON s.indexrelid = x.indexrelid
+		WHERE n.nspname = current_schema()
+		ORDER BY bytes DESC`).Scan(ctx, &indexes)
+	if err != nil {
+		return store.DatabaseDiagnostics{}, pgerrors.Classify(err)
+	}
+
+	out := store.DatabaseDiagnostics{
+		Tables:  make([]store.TableStats, 0, len(tables)),
+		Indexes: make([]store.IndexStats, 0, len(indexes)),
+		Pool:    poolStats(r.db),
+	}
+	for _, t := range tables {
+		out.Tables = append(out.Tables, store.TableStats{
+			Name:           t.Name,
+			TotalBytes:     t.TotalBytes,
+			TableBytes:     t.TableBytes,
+			IndexBytes:     t.IndexBytes,
+			LiveTuples:     t.LiveTuples,
+			DeadTuples:     t.DeadTuples,
+			DeadTupleRatio: deadTupleRatio(t.LiveTuples, t.DeadTuples),
+			LastVacuum:     t.LastVacuum,
+			LastAutovacuum: t.LastAutovacuum,
+		})
+	}
+	for _, i := range indexes {
+		out.Indexes = append(out.Indexes, store.IndexStats{
+			Name:                i.Name,
+			Table:               i.Table,
+			Bytes:               i.Bytes,
+			BytesPerTuple:       bytesPerTuple(i.Bytes, i.Tuples),
+			Scans:               i.Scans,
+			Valid:               i.Valid,
+			Ready:               i.Ready,
+			ExclusionConstraint: i.Exclusion,
+		})
+	}
+	return out, nil
+}
+
+func poolStats(db *bun.DB) store.PoolStats {
+	s := db.Stats()
+	return store.PoolStats{
+		MaxOpen:      s.MaxOpenConnections,
+		Open:         s.OpenConnections,
+		InUse:        s.InUse,
+		Idle:         s.Idle,
+		WaitCount:    s.WaitCount,
+		WaitDuration: s.WaitDuration,
+	}
+}
+
+func deadTupleRatio(live, dead int64) float64 {
+	if live+dead <= 0 {
+		return 0
+	}
+	return float64(dead) / float64(live+dead)
+}
+
+// bytesPerTuple treats never-analyzed tables (reltuples <= 0) as one tuple so
+// an empty table reports its raw index size instead of dividing by zero.
+func bytesPerTuple(bytes int64, tuples float64) float64 {
+	if tuples < 1 {
+		tuples = 1
+	}
+	return float64(bytes) / tuples
+}
@@ -0,0 +1,18 @@
+package postgres
+
+import "testing"
+
+func TestDiagnosticsRatios(t *testing.T) {
+	if got := deadTupleRatio(0, 0); got != 0 {
+		t.Fatalf("deadTupleRatio(0, 0) = %v, want 0", got)
+	}
+	if got := deadTupleRatio(75, 25); got != 0.25 {
+		t.Fatalf("deadTupleRatio(75, 25) = %v, want 0.25", got)
+	}
+	if got := bytesPerTuple(8192, -1); got != 8192 {
+		t.Fatalf("bytesPerTuple(8192, -1) = %v, want 8192", got)
+	}
+	if got := bytesPerTuple(8192, 128); got != 64 {
+		t.Fatalf("bytesPerTuple(8192, 128) = %v, want 64", got)
+	}
+}
@@ -0,0 +1,148 @@
+package postgres
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+
+	"schedula/backend/internal/domain"
+	"schedula/backend/internal/store/pgerrors"
+)
+
+// expiryBatchSize bounds how many users or proposals one expiry query picks
+// up. The sweeps loop until nothing is left.
+const expiryBatchSize = 100
+
+// DeleteExpiredHolds removes holds that expired before before, one user at a
+// time under the user's calendar lock, and logs each as expired in the
+// user's change log.
+func (r *AppointmentRepo) DeleteExpiredHolds(ctx context.Context, before time.Time) (int, error) {
+	deleted := 0
+	for {
+		var userIDs []string
+		err := r.db.NewSelect().
+			Model((*domain.SlotHold)(nil)).
+			ColumnExpr("DISTINCT user_id").
+			Where("expires_at <= ?", before).
+			Limit(expiryBatchSize).
+			Scan(ctx, &userIDs)
+		if err != nil {
+			return deleted, pgerrors.Classify(err)
+		}
+		if len(userIDs) == 0 {
+			return deleted, nil
+		}
+		for _, userID := range userIDs {
+			n, err := r.deleteExpiredHoldsOf(ctx, userID, before)
+			if err != nil {
+				return deleted, pgerrors.Classify(err)
+			}
+			deleted += n
+		}
+	}
+}
+
+func (r *AppointmentRepo) deleteExpiredHoldsOf(ctx context.Context, userID string, before time.Time) (int, error) {
+	var ids []uuid.UUID
+	err := r.db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		if err := r.lockCalendar(ctx, tx, userID); err != nil {
+			return err
+		}
+		var err error
+		ids, err = deleteExpiredHolds(ctx, tx, userID, before)
+		return err
+	})
+	return len(ids), err
+}
+
+// deleteExpiredHolds deletes the user's holds that expired at or before
+// before and logs each one. The caller must hold the user's calendar lock.
+func deleteExpiredHolds(ctx context.Context, tx bun.Tx, userID string, before time.Time) ([]uuid.UUID, error) {
+	var ids []uuid.UUID
+	if _, err := tx.NewDelete().
+		Model((*domain.SlotHold)(nil)).
+		Where("user_id = ?", userID).
+		Where("expires_at <= ?", before).
+		Returning("id").
+		Exec(ctx, &ids); err != nil {
+		return nil, err
+	}
+	for _, id := range ids {
+		if err := recordChange(ctx, tx, userID, domain.ChangeEntityHold, id, domain.ChangeOpExpired); err != nil {
+			return nil, err
+		}
+	}
+	return ids, nil
+}
+
+// ExpireProposals marks pending proposals past their expiry as expired and
+// logs the expiry on both the proposer's and the recipient's calendar, so
+// each side hears of it. Their holds expire at the same time and are left to
+// the hold sweep.
+func (r *AppointmentRepo) ExpireProposals(ctx context.Context, before time.Time) (int, error) {
+	expired := 0
+	for {
+		var due []domain.AppointmentProposal
+		err := r.db.NewSelect().
+			Model(&due).
+			Column("id", "proposer_id", "recipient_id").
+			Where("status = ?", domain.ProposalStatusPending).
+			Where("expires_at <= ?", before).
+			OrderExpr("expires_at ASC, id ASC").
+			Limit(expiryBatchSize).
+			Scan(ctx)
+		if err != nil {
+			return expired, pgerrors.Classify(err)
+		}
+		if len(due) == 0 {
+			return expired, nil
+		}
+		for _, p := range due {
+			ok, err := r.expireProposal(ctx, p, before)
+			if err != nil {
+				return expired, pgerrors.Classify(err)
+			}
+			if ok {
+				expired++
+			}
+		}
+	}
+}
+
+func (r *AppointmentRepo) expireProposal(ctx context.Context, p domain.AppointmentProposal, before time.Time) (bool, error) {
+	var ok bool
+	err := r.db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		if err := r.lockCalendars(ctx, tx, p.ProposerID, p.RecipientID); err != nil {
+			return err
+		}
+		var err error
+		ok, err = expireProposal(ctx, tx, p.ID, before)
+		return err
+	})
+	return ok, err
+}
+
+// expireProposal expires the proposal and logs it on both calendars, unless
+// it was answered since it was listed. The caller must hold both users'
+// calendar locks.
+func expireProposal(ctx context.Context, tx bun.Tx, proposalID uuid.UUID, before time.Time) (bool, error) {
+	p, err := proposalForUpdate(ctx, tx, proposalID)
+	if err != nil {
+		return false, err
+	}
+	if p.Status != domain.ProposalStatusPending || p.ExpiresAt.After(before) {
+		return false, nil
+	}
+	p.Status = domain.ProposalStatusExpired
+	if _, err := tx.NewUpdate().Model(&p).Column("status").WherePK().Exec(ctx); err != nil {
+		return false, err
+	}
+	for _, userID := range []string{p.ProposerID, p.RecipientID} {
+		if err := recordChange(ctx, tx, userID, domain.ChangeEntityProposal, p.ID, domain.ChangeOpExpired); err != nil {
+			return false, err
+		}
+	}
+	return true, nil
+}
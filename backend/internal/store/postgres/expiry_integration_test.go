@@ -0,0 +1,114 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/uptrace/bun"
+
+	"schedula/backend/internal/domain"
+)
+
+func TestPostgresIntegration_ExpiryLogsChanges(t *testing.T) {
+	databaseURL := strings.TrimSpace(os.Getenv("SCHEDULA_TEST_DATABASE_URL"))
+	if databaseURL == "" {
+		t.Skip("SCHEDULA_TEST_DATABASE_URL not set")
+	}
+
+	db, err := Open(databaseURL, PoolConfig{MaxOpenConns: 1})
+	if err != nil {
+		t.Fatalf("Open error: %v", err)
+	}
+	t.Cleanup(func() {
+		_ = Close(db)
+	})
+
+	schema := "schedula_test_" + randomHex(t, 8)
+	t.Cleanup(func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+		_, _ = db.NewRaw("DROP SCHEMA IF EXISTS " + schema + " CASCADE").Exec(ctx)
+	})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	err = db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		if _, err := tx.NewRaw("CREATE SCHEMA " + schema).Exec(ctx); err != nil {
+			return err
+		}
+		if _, err := tx.NewRaw("SET LOCAL search_path TO " + schema).Exec(ctx); err != nil {
+			return err
+		}
+		if err := applyMigrations(ctx, tx); err != nil {
+			return err
+		}
+
+		now := time.Date(2026, 3, 2, 12, 0, 0, 0, time.UTC)
+		start := now.Add(24 * time.Hour)
+		c := calendarTx{tx: tx}
+		expired, err := c.CreateSlotHold(ctx, domain.SlotHold{UserID: "u1", StartTime: start, EndTime: start.Add(time.Hour), ExpiresAt: now.Add(-time.Minute)})
+		if err != nil {
+			return err
+		}
+		live, err := c.CreateSlotHold(ctx, domain.SlotHold{UserID: "u1", StartTime: start.Add(2 * time.Hour), EndTime: start.Add(3 * time.Hour), ExpiresAt: now.Add(time.Minute)})
+		if err != nil {
+			return err
+		}
+		proposal := domain.AppointmentProposal{
+			ProposerID: "u1", RecipientID: "u2", Title: "Intro", StartTime: start, EndTime: start.Add(time.Hour),
+			HoldID: expired.ID, Status: domain.ProposalStatusPending, ExpiresAt: now.Add(-time.Minute),
+		}
+		if _, err := tx.NewInsert().Model(&proposal).Exec(ctx); err != nil {
+			return err
+		}
+
+		ok, err := expireProposal(ctx, tx, proposal.ID, now)
+		if err != nil {
+			return err
+		}
+		if !ok {
+			return fmt.Errorf("pending proposal past its expiry was not expired")
+		}
+		if ok, err := expireProposal(ctx, tx, proposal.ID, now); err != nil || ok {
+			return fmt.Errorf("second expiry = %v, %v; want false, nil", ok, err)
+		}
+		ids, err := deleteExpiredHolds(ctx, tx, "u1", now)
+		if err != nil {
+			return err
+		}
+		if len(ids) != 1 || ids[0] != expired.ID {
+			return fmt.Errorf("deleted holds = %v, want only %s", ids, expired.ID)
+		}
+		if _, err := c.GetSlotHold(ctx, "u1", live.ID); err != nil {
+			return fmt.Errorf("live hold: %w", err)
+		}
+
+		var changes []domain.CalendarChange
+		if err := tx.NewSelect().Model(&changes).OrderExpr("seq ASC").Scan(ctx); err != nil {
+			return err
+		}
+		want := []domain.CalendarChange{
+			{UserID: "u1", EntityType: domain.ChangeEntityProposal, EntityID: proposal.ID, Op: domain.ChangeOpExpired},
+			{UserID: "u2", EntityType: domain.ChangeEntityProposal, EntityID: proposal.ID, Op: domain.ChangeOpExpired},
+			{UserID: "u1", EntityType: domain.ChangeEntityHold, EntityID: expired.ID, Op: domain.ChangeOpExpired},
+		}
+		if len(changes) != len(want) {
+			return fmt.Errorf("changes = %+v, want %d entries", changes, len(want))
+		}
+		for i, w := range want {
+			g := changes[i]
+			if g.UserID != w.UserID || g.EntityType != w.EntityType || g.EntityID != w.EntityID || g.Op != w.Op {
+				return fmt.Errorf("change %d = %+v, want %+v", i, g, w)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("tx error: %v", err)
+	}
+}
@@ -0,0 +1,186 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+
+	"schedula/backend/internal/domain"
+	"schedula/backend/internal/store"
+	"schedula/backend/internal/store/pgerrors"
+)
+
+func (r *AppointmentRepo) ReserveSlot(ctx context.Context, hold domain.SlotHold) (domain.SlotHold, error) {
+	var out domain.SlotHold
+	err := r.InUserTransaction(ctx, hold.UserID, func(ctx context.Context, tx store.CalendarTx) error {
+		h, err := reserveSlot(ctx, tx, hold)
+		if err != nil {
+			return err
+		}
+		out = h
+		return nil
+	})
+	if err != nil {
+		return domain.SlotHold{}, err
+	}
+	return out, nil
+}
+
+// ConfirmHold turns the hold into appt, which takes the hold's times. appt.ID
+// must be derived from the hold so that a repeated confirm returns the
+// appointment created by the first one.
+func (r *AppointmentRepo) ConfirmHold(ctx context.Context, holdID uuid.UUID, appt domain.Appointment) (domain.Appointment, error) {
+	var out domain.Appointment
+	err := r.InUserTransaction(ctx, appt.UserID, func(ctx context.Context, tx store.CalendarTx) error {
+		a, err := confirmHold(ctx, tx, holdID, appt)
+		if err != nil {
+			return err
+		}
+		out = a
+		return nil
+	})
+	if err != nil {
+		return domain.Appointment{}, err
+	}
+	return out, nil
+}
+
+// ReleaseHold deletes the hold. Releasing a hold that no longer exists
+// succeeds, so clients can retry.
+func (r *AppointmentRepo) ReleaseHold(ctx context.Context, userID string, holdID uuid.UUID) error {
+	return r.InUserTransaction(ctx, userID, func(ctx context.Context, tx store.CalendarTx) error {
+		err := tx.DeleteSlotHold(ctx, userID, holdID)
+		if errors.Is(err, store.ErrNotFound) {
+			return nil
+		}
+		return err
+	})
+}
+
+// reserveSlot creates hold unless it overlaps an appointment or another
+// unexpired hold. The caller must hold the user's calendar lock.
+func reserveSlot(ctx context.Context, tx store.CalendarTx, hold domain.SlotHold) (domain.SlotHold, error) {
+	appts, err := tx.ListAppointments(ctx, hold.UserID, hold.StartTime, hold.EndTime)
+	if err != nil {
+		return domain.SlotHold{}, err
+	}
+	if len(appts) > 0 {
+		return domain.SlotHold{}, store.ErrConflict
+	}
+
+	holds, err := tx.ListActiveSlotHolds(ctx, hold.UserID, hold.StartTime, hold.EndTime)
+	if err != nil {
+		return domain.SlotHold{}, err
+	}
+	if len(holds) > 0 {
+		return domain.SlotHold{}, store.ErrConflict
+	}
+
+	return tx.CreateSlotHold(ctx, hold)
+}
+
+// confirmHold deletes the hold and creates appt in its place. An expired
+// hold is still honoured if nothing else has taken the slot since.
+func confirmHold(ctx context.Context, tx store.CalendarTx, holdID uuid.UUID, appt domain.Appointment) (domain.Appointment, error) {
+	hold, err := tx.GetSlotHold(ctx, appt.UserID, holdID)
+	if errors.Is(err, store.ErrNotFound) {
+		return tx.GetAppointment(ctx, appt.UserID, appt.ID)
+	}
+	if err != nil {
+		return domain.Appointment{}, err
+	}
+
+	if err := tx.DeleteSlotHold(ctx, hold.UserID, hold.ID); err != nil {
+		return domain.Appointment{}, err
+	}
+	appt.StartTime = hold.StartTime.UTC()
+	appt.EndTime = hold.EndTime.UTC()
+	return tx.CreateAppointment(ctx, appt)
+}
+
+func (r calendarTx) CreateSlotHold(ctx context.Context, hold domain.SlotHold) (domain.SlotHold, error) {
+	m := domain.SlotHold{
+		ID:        hold.ID,
+		UserID:    hold.UserID,
+		StartTime: hold.StartTime,
+		EndTime:   hold.EndTime,
+		ExpiresAt: hold.ExpiresAt,
+		CreatedAt: hold.CreatedAt,
+	}
+	if _, err := r.tx.NewInsert().Model(&m).Exec(ctx); err != nil {
+		return domain.SlotHold{}, err
+	}
+	return m, nil
+}
+
+// ListActiveHolds returns the user's holds overlapping the window that have
+// not expired.
+func (r *AppointmentRepo) ListActiveHolds(ctx context.Context, userID string, windowStart, windowEnd time.Time) ([]domain.SlotHold, error) {
+	rows, err := listActiveSlotHolds(ctx, r.db, userID, windowStart, windowEnd)
+	if err != nil {
+		return nil, pgerrors.Classify(err)
+	}
+	return rows, nil
+}
+
+// ListActiveSlotHolds returns the user's holds overlapping the window that
+// have not expired as of the transaction's start.
+func (r calendarTx) ListActiveSlotHolds(ctx context.Context, userID string, windowStart, windowEnd time.Time) ([]domain.SlotHold, error) {
+	return listActiveSlotHolds(ctx, r.tx, userID, windowStart, windowEnd)
+}
+
+func listActiveSlotHolds(ctx context.Context, db bun.IDB, userID string, windowStart, windowEnd time.Time) ([]domain.SlotHold, error) {
+	var rows []domain.SlotHold
+	err := db.NewSelect().
+		Model(&rows).
+		Where("user_id = ?", userID).
+		Where("start_time < ?", windowEnd).
+		Where("end_time > ?", windowStart).
+		Where("expires_at > now()").
+		OrderExpr("start_time ASC").
+		Scan(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return rows, nil
+}
+
+func (r calendarTx) GetSlotHold(ctx context.Context, userID string, holdID uuid.UUID) (domain.SlotHold, error) {
+	var hold domain.SlotHold
+	err := r.tx.NewSelect().
+		Model(&hold).
+		Where("user_id = ?", userID).
+		Where("id = ?", holdID).
+		Limit(1).
+		Scan(ctx)
+	if errors.Is(err, sql.ErrNoRows) {
+		return domain.SlotHold{}, store.ErrNotFound
+	}
+	if err != nil {
+		return domain.SlotHold{}, err
+	}
+	return hold, nil
+}
+
+func (r calendarTx) DeleteSlotHold(ctx context.Context, userID string, holdID uuid.UUID) error {
+	res, err := r.tx.NewDelete().
+		Model((*domain.SlotHold)(nil)).
+		Where("user_id = ?", userID).
+		Where("id = ?", holdID).
+		Exec(ctx)
+	if err != nil {
+		return err
+	}
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if affected == 0 {
+		return store.ErrNotFound
+	}
+	return nil
+}
@@ -0,0 +1,129 @@
+package postgres
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+
+	"schedula/backend/internal/domain"
+	"schedula/backend/internal/store"
+	"schedula/backend/internal/store/pgerrors"
+)
+
+// LinkAppointments records link. Both appointments must belong to the user.
+// Linking a pair that is already linked the same way returns the existing link.
+func (r *AppointmentRepo) LinkAppointments(ctx context.Context, link domain.AppointmentLink) (domain.AppointmentLink, error) {
+	owned, err := r.db.NewSelect().
+		Model((*domain.Appointment)(nil)).
+		Where("user_id = ?", link.UserID).
+		Where("id IN (?, ?)", link.AppointmentID, link.RelatedID).
+		Count(ctx)
+	if err != nil {
+		return domain.AppointmentLink{}, pgerrors.Classify(err)
+	}
+	if owned != 2 {
+		return domain.AppointmentLink{}, store.ErrNotFound
+	}
+
+	m := domain.AppointmentLink{
+		ID:            link.ID,
+		UserID:        link.UserID,
+		AppointmentID: link.AppointmentID,
+		RelatedID:     link.RelatedID,
+		Kind:          link.Kind,
+		CreatedAt:     link.CreatedAt,
+	}
+	_, err = r.db.NewInsert().
+		Model(&m).
+		On("CONFLICT (appointment_id, related_id, kind) DO NOTHING").
+		Exec(ctx)
+	if err != nil {
+		return domain.AppointmentLink{}, pgerrors.Classify(err)
+	}
+
+	var out domain.AppointmentLink
+	err = r.db.NewSelect().
+		Model(&out).
+		Where("appointment_id = ?", link.AppointmentID).
+		Where("related_id = ?", link.RelatedID).
+		Where("kind = ?", link.Kind).
+		Limit(1).
+		Scan(ctx)
+	if err != nil {
+		return domain.AppointmentLink{}, pgerrors.Classify(err)
+	}
+	return out, nil
+}
+
+func (r *AppointmentRepo) UnlinkAppointments(ctx context.Context, userID string, appointmentID, relatedID uuid.UUID, kind domain.AppointmentLinkKind) error {
+	res, err := r.db.NewDelete().
+		Model((*domain.AppointmentLink)(nil)).
+		Where("user_id = ?", userID).
+		Where("appointment_id = ?", appointmentID).
+		Where("related_id = ?", relatedID).
+		Where("kind = ?", kind).
+		Exec(ctx)
+	if err != nil {
+		return pgerrors.Classify(err)
+	}
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if affected == 0 {
+		return store.ErrNotFound
+	}
+	return nil
+}
+
+// ListRelated returns the links touching appointmentID in either direction,
+// each paired with the appointment at the other end, ordered by its start.
+func (r *AppointmentRepo) ListRelated(ctx context.Context, userID string, appointmentID uuid.UUID) ([]domain.RelatedAppointment, error) {
+	var links []domain.AppointmentLink
+	err := r.db.NewSelect().
+		Model(&links).
+		Where("user_id = ?", userID).
+		WhereGroup(" AND ", func(q *bun.SelectQuery) *bun.SelectQuery {
+			return q.Where("appointment_id = ?", appointmentID).WhereOr("related_id = ?", appointmentID)
+		}).
+		Scan(ctx)
+	if err != nil {
+		return nil, pgerrors.Classify(err)
+	}
+	if len(links) == 0 {
+		return nil, nil
+	}
+
+	otherIDs := make([]uuid.UUID, 0, len(links))
+	for _, l := range links {
+		otherIDs = append(otherIDs, otherEnd(l, appointmentID))
+	}
+	var appts []domain.Appointment
+	err = r.db.NewSelect().
+		Model(&appts).
+		Where("user_id = ?", userID).
+		Where("id IN (?)", bun.In(otherIDs)).
+		OrderExpr("start_time ASC").
+		Scan(ctx)
+	if err != nil {
+		return nil, pgerrors.Classify(err)
+	}
+
+	out := make([]domain.RelatedAppointment, 0, len(links))
+	for _, a := range appts {
+		for _, l := range links {
+			if otherEnd(l, appointmentID) == a.ID {
+				out = append(out, domain.RelatedAppointment{Link: l, Appointment: a})
+			}
+		}
+	}
+	return out, nil
+}
+
+func otherEnd(link domain.AppointmentLink, appointmentID uuid.UUID) uuid.UUID {
+	if link.AppointmentID == appointmentID {
+		return link.RelatedID
+	}
+	return link.AppointmentID
+}
@@ -0,0 +1,65 @@
+package postgres
+
+import (
+	"context"
+	"os"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"schedula/backend/internal/store"
+)
+
+func TestPostgresIntegration_ObserveLockWait(t *testing.T) {
+	databaseURL := strings.TrimSpace(os.Getenv("SCHEDULA_TEST_DATABASE_URL"))
+	if databaseURL == "" {
+		t.Skip("SCHEDULA_TEST_DATABASE_URL not set")
+	}
+
+	db, err := Open(databaseURL, PoolConfig{MaxOpenConns: 2})
+	if err != nil {
+		t.Fatalf("Open error: %v", err)
+	}
+	t.Cleanup(func() {
+		_ = Close(db)
+	})
+
+	var mu sync.Mutex
+	var waits []time.Duration
+	repo := NewAppointmentRepoWithOptions(db, RepoOptions{
+		ObserveLockWait: func(ctx context.Context, userID string, wait time.Duration) {
+			mu.Lock()
+			defer mu.Unlock()
+			waits = append(waits, wait)
+		},
+	})
+
+	// The advisory lock needs no tables, so the test runs without a schema.
+	userID := "lock-wait-" + randomHex(t, 8)
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	held := make(chan struct{})
+	done := make(chan error, 1)
+	go func() {
+		done <- repo.InUserTransaction(ctx, userID, func(ctx context.Context, tx store.CalendarTx) error {
+			close(held)
+			time.Sleep(300 * time.Millisecond)
+			return nil
+		})
+	}()
+	<-held
+	if err := repo.InUserTransaction(ctx, userID, func(ctx context.Context, tx store.CalendarTx) error { return nil }); err != nil {
+		t.Fatalf("InUserTransaction error: %v", err)
+	}
+	if err := <-done; err != nil {
+		t.Fatalf("holder InUserTransaction error: %v", err)
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+	if len(waits) != 2 || waits[1] < 200*time.Millisecond {
+		t.Fatalf("waits = %v, want the second transaction to wait for the first", waits)
+	}
+}
@@ -0,0 +1,244 @@
+package postgres
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+
+	"schedula/backend/internal/conflicts"
+	"schedula/backend/internal/domain"
+	"schedula/backend/internal/store"
+	"schedula/backend/internal/store/pgerrors"
+)
+
+func (r *AppointmentRepo) MergeAppointments(ctx context.Context, userID string, primaryID uuid.UUID, duplicateIDs []uuid.UUID, cover bool, at time.Time) (store.AppointmentMerge, error) {
+	var out store.AppointmentMerge
+	err := r.db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		if err := r.lockCalendar(ctx, tx, userID); err != nil {
+			return err
+		}
+		var err error
+		out, err = mergeAppointments(ctx, tx, r.opts.ConflictPolicy, userID, primaryID, duplicateIDs, cover, at)
+		return err
+	})
+	if err != nil {
+		return store.AppointmentMerge{}, pgerrors.Classify(err)
+	}
+	return out, nil
+}
+
+// mergeAppointments is MergeAppointments within a transaction that holds
+// the calendar lock.
+func mergeAppointments(ctx context.Context, tx bun.Tx, policy conflicts.Policy, userID string, primaryID uuid.UUID, duplicateIDs []uuid.UUID, cover bool, at time.Time) (store.AppointmentMerge, error) {
+	c := calendarTx{tx: tx, policy: policy}
+	primary, err := c.GetAppointment(ctx, userID, primaryID)
+	if err != nil {
+		return store.AppointmentMerge{}, err
+	}
+	var found []domain.Appointment
+	err = tx.NewSelect().
+		Model(&found).
+		Where("user_id = ?", userID).
+		Where("id IN (?)", bun.In(duplicateIDs)).
+		Scan(ctx)
+	if err != nil {
+		return store.AppointmentMerge{}, err
+	}
+	if len(found) != len(duplicateIDs) {
+		return store.AppointmentMerge{}, store.ErrNotFound
+	}
+	// Coalesce in the order the caller listed the duplicates.
+	byID := make(map[uuid.UUID]domain.Appointment, len(found))
+	for _, d := range found {
+		byID[d.ID] = d
+	}
+	dups := make([]domain.Appointment, 0, len(duplicateIDs))
+	for _, id := range duplicateIDs {
+		dups = append(dups, byID[id])
+	}
+	merged := mergeAppointment(primary, dups, cover)
+
+	out := store.AppointmentMerge{Deleted: duplicateIDs}
+	if out.LinksMoved, err = moveLinks(ctx, tx, userID, primaryID, duplicateIDs); err != nil {
+		return store.AppointmentMerge{}, err
+	}
+	// Mappings move before the duplicates are deleted, which would
+	// otherwise orphan them.
+	if out.MappingsMoved, err = moveSyncMappings(ctx, tx, userID, primaryID, duplicateIDs, at); err != nil {
+		return store.AppointmentMerge{}, err
+	}
+	for _, id := range duplicateIDs {
+		if err := c.DeleteAppointment(ctx, userID, id); err != nil {
+			return store.AppointmentMerge{}, err
+		}
+	}
+	// The duplicates are gone, so the primary can take their external
+	// reference and their time.
+	if !sameMergeFields(primary, merged) {
+		if err := c.updateMergedAppointment(ctx, primary, merged, at); err != nil {
+			return store.AppointmentMerge{}, err
+		}
+	}
+	if out.Appointment, err = c.GetAppointment(ctx, userID, primaryID); err != nil {
+		return store.AppointmentMerge{}, err
+	}
+	return out, nil
+}
+
+// mergeAppointment returns primary with its empty external reference,
+// contact and program filled from the first duplicate that has one. With
+// cover set, an event primary also widens to the earliest start and latest
+// end of the event duplicates; milestones keep their instant.
+func mergeAppointment(primary domain.Appointment, dups []domain.Appointment, cover bool) domain.Appointment {
+	m := primary
+	for _, d := range dups {
+		if m.ExternalSystem == "" && d.ExternalSystem != "" {
+			m.ExternalSystem, m.ExternalID = d.ExternalSystem, d.ExternalID
+		}
+		if m.ContactID == nil && d.ContactID != nil {
+			m.ContactID = d.ContactID
+		}
+		if m.ProgramID == nil && d.ProgramID != nil {
+			m.ProgramID = d.ProgramID
+		}
+		if !cover || primary.Milestone() || d.Milestone() {
+			continue
+		}
+		if d.StartTime.Before(m.StartTime) {
+			m.StartTime = d.StartTime
+		}
+		if d.EndTime.After(m.EndTime) {
+			m.EndTime = d.EndTime
+		}
+	}
+	return m
+}
+
+func sameMergeFields(a, b domain.Appointment) bool {
+	return a.StartTime.Equal(b.StartTime) &&
+		a.EndTime.Equal(b.EndTime) &&
+		a.ExternalSystem == b.ExternalSystem &&
+		a.ExternalID == b.ExternalID &&
+		sameOptionalID(a.ContactID, b.ContactID) &&
+		sameOptionalID(a.ProgramID, b.ProgramID)
+}
+
+// updateMergedAppointment writes the fields mergeAppointment may change. A
+// widened primary must not overlap another booking or an active hold.
+func (r calendarTx) updateMergedAppointment(ctx context.Context, existing, merged domain.Appointment, at time.Time) error {
+	if !existing.StartTime.Equal(merged.StartTime) || !existing.EndTime.Equal(merged.EndTime) {
+		holds, err := r.ListActiveSlotHolds(ctx, existing.UserID, merged.StartTime, merged.EndTime)
+		if err != nil {
+			return err
+		}
+		if len(holds) > 0 {
+			return store.ErrConflict
+		}
+	}
+
+	_, allowed, err := r.judgeOverlaps(ctx, merged)
+	if err != nil {
+		return err
+	}
+	merged.OverlapAllowed = allowed
+	merged.UpdatedAt = at
+	_, err = r.tx.NewUpdate().
+		Model(&merged).
+		Column("start_time", "end_time", "external_system", "external_id", "contact_id", "program_id", "overlap_allowed", "updated_at").
+		WherePK().
+		Exec(ctx)
+	if err != nil {
+		if pgerrors.IsExclusionViolation(err) && pgerrors.Constraint(err) == "appointments_no_overlap" {
+			return store.ErrConflict
+		}
+		return err
+	}
+	return recordChange(ctx, r.tx, existing.UserID, domain.ChangeEntityAppointment, existing.ID, domain.ChangeOpUpdated)
+}
+
+// moveLinks copies the links touching the duplicates onto the primary and
+// returns how many it added. A link between the primary and a duplicate, or
+// between two duplicates, would link the primary to itself and is dropped;
+// one the primary already has is kept once. The originals go with the
+// duplicates.
+func moveLinks(ctx context.Context, tx bun.Tx, userID string, primaryID uuid.UUID, duplicateIDs []uuid.UUID) (int, error) {
+	var links []domain.AppointmentLink
+	err := tx.NewSelect().
+		Model(&links).
+		Where("user_id = ?", userID).
+		WhereGroup(" AND ", func(q *bun.SelectQuery) *bun.SelectQuery {
+			return q.
+				Where("appointment_id IN (?)", bun.In(duplicateIDs)).
+				WhereOr("related_id IN (?)", bun.In(duplicateIDs))
+		}).
+		OrderExpr("created_at ASC, id ASC").
+		Scan(ctx)
+	if err != nil {
+		return 0, err
+	}
+	dup := make(map[uuid.UUID]bool, len(duplicateIDs))
+	for _, id := range duplicateIDs {
+		dup[id] = true
+	}
+
+	moved := 0
+	for _, l := range links {
+		if dup[l.AppointmentID] {
+			l.AppointmentID = primaryID
+		}
+		if dup[l.RelatedID] {
+			l.RelatedID = primaryID
+		}
+		if l.AppointmentID == l.RelatedID {
+			continue
+		}
+		m := domain.AppointmentLink{
+			UserID:        l.UserID,
+			AppointmentID: l.AppointmentID,
+			RelatedID:     l.RelatedID,
+			Kind:          l.Kind,
+			CreatedAt:     l.CreatedAt,
+		}
+		res, err := tx.NewInsert().
+			Model(&m).
+			On("CONFLICT (appointment_id, related_id, kind) DO NOTHING").
+			Exec(ctx)
+		if err != nil {
+			return 0, err
+		}
+		n, err := res.RowsAffected()
+		if err != nil {
+			return 0, err
+		}
+		moved += int(n)
+	}
+	return moved, nil
+}
+
+// moveSyncMappings points the duplicates' sync mappings at the primary and
+// returns how many it moved. A provider maps at most one event to an
+// appointment, so only the most recently synced mapping moves for each
+// provider the primary has none for. The rest are orphaned when the
+// duplicates are deleted, and the connector deletes those events on the
+// provider's side.
+func moveSyncMappings(ctx context.Context, tx bun.Tx, userID string, primaryID uuid.UUID, duplicateIDs []uuid.UUID, at time.Time) (int, error) {
+	res, err := tx.NewRaw(
+		"UPDATE sync_mappings SET appointment_id = ?, updated_at = ? "+
+			"WHERE (user_id, provider, external_id) IN ("+
+			"SELECT DISTINCT ON (provider) user_id, provider, external_id FROM sync_mappings "+
+			"WHERE user_id = ? AND appointment_id IN (?) "+
+			"AND provider NOT IN (SELECT provider FROM sync_mappings WHERE user_id = ? AND appointment_id = ?) "+
+			"ORDER BY provider, last_synced_at DESC, external_id ASC)",
+		primaryID, at.UTC(), userID, bun.In(duplicateIDs), userID, primaryID,
+	).Exec(ctx)
+	if err != nil {
+		return 0, err
+	}
+	n, err := res.RowsAffected()
+	if err != nil {
+		return 0, err
+	}
+	return int(n), nil
+}
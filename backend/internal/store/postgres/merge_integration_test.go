@@ -0,0 +1,120 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+
+	"schedula/backend/internal/domain"
+	"schedula/backend/internal/store"
+)
+
+func TestPostgresIntegration_MergeAppointments(t *testing.T) {
+	databaseURL := strings.TrimSpace(os.Getenv("SCHEDULA_TEST_DATABASE_URL"))
+	if databaseURL == "" {
+		t.Skip("SCHEDULA_TEST_DATABASE_URL not set")
+	}
+
+	db, err := Open(databaseURL, PoolConfig{MaxOpenConns: 1})
+	if err != nil {
+		t.Fatalf("Open error: %v", err)
+	}
+	t.Cleanup(func() {
+		_ = Close(db)
+	})
+
+	schema := "schedula_test_" + randomHex(t, 8)
+	t.Cleanup(func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+		_, _ = db.NewRaw("DROP SCHEMA IF EXISTS " + schema + " CASCADE").Exec(ctx)
+	})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	err = db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		if _, err := tx.NewRaw("CREATE SCHEMA " + schema).Exec(ctx); err != nil {
+			return err
+		}
+		if _, err := tx.NewRaw("SET LOCAL search_path TO " + schema).Exec(ctx); err != nil {
+			return err
+		}
+		if err := applyMigrations(ctx, tx); err != nil {
+			return err
+		}
+
+		userID := "u1"
+		at := time.Date(2026, 3, 2, 12, 0, 0, 0, time.UTC)
+		start := time.Date(2026, 3, 3, 9, 0, 0, 0, time.UTC)
+		c := calendarTx{tx: tx}
+		create := func(title string, start, end time.Time, system, externalID string) (domain.Appointment, error) {
+			return c.CreateAppointment(ctx, domain.Appointment{
+				ID: uuid.New(), UserID: userID, Title: title, StartTime: start, EndTime: end,
+				ExternalSystem: system, ExternalID: externalID, CreatedAt: at, UpdatedAt: at,
+			})
+		}
+		// The primary and the imported copy touch but do not overlap, as a
+		// sync that rounded the start would leave them.
+		primary, err := create("Review", start.Add(15*time.Minute), start.Add(time.Hour), "", "")
+		if err != nil {
+			return err
+		}
+		dup, err := create("Review", start, start.Add(15*time.Minute), "google", "evt-1")
+		if err != nil {
+			return err
+		}
+		prep, err := create("Prep", start.Add(-time.Hour), start.Add(-30*time.Minute), "", "")
+		if err != nil {
+			return err
+		}
+		if _, err := tx.NewInsert().Model(&domain.AppointmentLink{UserID: userID, AppointmentID: prep.ID, RelatedID: dup.ID, Kind: domain.AppointmentLinkKindPrepFor}).Exec(ctx); err != nil {
+			return err
+		}
+		if _, err := upsertSyncMapping(ctx, tx, domain.SyncMapping{UserID: userID, Provider: "google", ExternalID: "evt-1", AppointmentID: &dup.ID}); err != nil {
+			return err
+		}
+
+		out, err := mergeAppointments(ctx, tx, nil, userID, primary.ID, []uuid.UUID{dup.ID}, true, at)
+		if err != nil {
+			return err
+		}
+		if out.LinksMoved != 1 || out.MappingsMoved != 1 || len(out.Deleted) != 1 {
+			return fmt.Errorf("merge = %+v, want one link and one mapping moved", out)
+		}
+		got := out.Appointment
+		if !got.StartTime.Equal(start) || !got.EndTime.Equal(start.Add(time.Hour)) || got.ExternalSystem != "google" || got.ExternalID != "evt-1" {
+			return fmt.Errorf("merged = %v-%v %s/%s, want the covering span and the duplicate's external ref", got.StartTime, got.EndTime, got.ExternalSystem, got.ExternalID)
+		}
+		var mapping domain.SyncMapping
+		if err := tx.NewSelect().Model(&mapping).Where("user_id = ? AND provider = ? AND external_id = ?", userID, "google", "evt-1").Scan(ctx); err != nil {
+			return err
+		}
+		if mapping.AppointmentID == nil || *mapping.AppointmentID != primary.ID {
+			return fmt.Errorf("mapping appointment = %v, want the primary", mapping.AppointmentID)
+		}
+		var link domain.AppointmentLink
+		if err := tx.NewSelect().Model(&link).Where("appointment_id = ?", prep.ID).Scan(ctx); err != nil {
+			return err
+		}
+		if link.RelatedID != primary.ID {
+			return fmt.Errorf("link related = %s, want the primary", link.RelatedID)
+		}
+
+		// A duplicate that is not the user's fails the whole merge.
+		if _, err := mergeAppointments(ctx, tx, nil, userID, primary.ID, []uuid.UUID{uuid.New()}, false, at); !errors.Is(err, store.ErrNotFound) {
+			return fmt.Errorf("merge with unknown duplicate error = %v, want ErrNotFound", err)
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("tx error: %v", err)
+	}
+}
@@ -0,0 +1,109 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"io/fs"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/uptrace/bun"
+
+	"schedula/backend/internal/store/pgerrors"
+)
+
+type MigrationStatus struct {
+	Latest  int64
+	Current int64
+	Pending []int64
+	Unknown []int64
+}
+
+func (s MigrationStatus) UpToDate() bool {
+	return len(s.Pending) == 0 && len(s.Unknown) == 0
+}
+
+func CheckMigrations(ctx context.Context, db bun.IDB, migrations fs.FS) (MigrationStatus, error) {
+	embedded, err := migrationVersions(migrations)
+	if err != nil {
+		return MigrationStatus{}, err
+	}
+
+	// goose appends a row for every up and down, so a version counts as
+	// applied only if its latest row says so.
+	var applied []int64
+	err = db.NewRaw(`SELECT version_id FROM (
+		SELECT DISTINCT ON (version_id) version_id, is_applied
+		FROM goose_db_version
+		WHERE version_id > 0
+		ORDER BY version_id, id DESC
+	) latest WHERE is_applied`).Scan(ctx, &applied)
+	if err != nil {
+		if !pgerrors.IsUndefinedTable(err) {
+			return MigrationStatus{}, pgerrors.Classify(err)
+		}
+		applied = nil
+	}
+
+	return compareMigrationVersions(embedded, applied), nil
+}
+
+func migrationVersions(migrations fs.FS) ([]int64, error) {
+	entries, err := fs.ReadDir(migrations, ".")
+	if err != nil {
+		return nil, err
+	}
+
+	versions := make([]int64, 0, len(entries))
+	for _, e := range entries {
+		if e.IsDir() || path.Ext(e.Name()) != ".sql" {
+			continue
+		}
+		prefix, _, ok := strings.Cut(e.Name(), "_")
+		if !ok {
+			return nil, fmt.Errorf("migration %q: missing version prefix", e.Name())
+		}
+		v, err := strconv.ParseInt(prefix, 10, 64)
+		if err != nil || v < 1 {
+			return nil, fmt.Errorf("migration %q: invalid version prefix", e.Name())
+		}
+		versions = append(versions, v)
+	}
+	sort.Slice(versions, func(i, j int) bool { return versions[i] < versions[j] })
+	return versions, nil
+}
+
+func compareMigrationVersions(embedded, applied []int64) MigrationStatus {
+	var status MigrationStatus
+
+	appliedSet := make(map[int64]struct{}, len(applied))
+	for _, v := range applied {
+		appliedSet[v] = struct{}{}
+		if v > status.Current {
+			status.Current = v
+		}
+	}
+
+	embeddedSet := make(map[int64]struct{}, len(embedded))
+	for _, v := range embedded {
+		embeddedSet[v] = struct{}{}
+		if v > status.Latest {
+			status.Latest = v
+		}
+		if _, ok := appliedSet[v]; !ok {
+			status.Pending = append(status.Pending, v)
+		}
+	}
+
+	for _, v := range applied {
+		if _, ok := embeddedSet[v]; !ok {
+			status.Unknown = append(status.Unknown, v)
+		}
+	}
+	sort.Slice(status.Pending, func(i, j int) bool { return status.Pending[i] < status.Pending[j] })
+	sort.Slice(status.Unknown, func(i, j int) bool { return status.Unknown[i] < status.Unknown[j] })
+
+	return status
+}
@@ -0,0 +1,99 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/uptrace/bun"
+
+	"schedula/backend/migrations"
+)
+
+func TestPostgresIntegration_CheckMigrationsUsesLatestRowPerVersion(t *testing.T) {
+	databaseURL := strings.TrimSpace(os.Getenv("SCHEDULA_TEST_DATABASE_URL"))
+	if databaseURL == "" {
+		t.Skip("SCHEDULA_TEST_DATABASE_URL not set")
+	}
+
+	db, err := Open(databaseURL, PoolConfig{MaxOpenConns: 1})
+	if err != nil {
+		t.Fatalf("Open error: %v", err)
+	}
+	t.Cleanup(func() {
+		_ = Close(db)
+	})
+
+	schema := "schedula_test_" + randomHex(t, 8)
+	t.Cleanup(func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+		_, _ = db.NewRaw("DROP SCHEMA IF EXISTS " + schema + " CASCADE").Exec(ctx)
+	})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	embedded, err := migrationVersions(migrations.FS)
+	if err != nil {
+		t.Fatalf("migrationVersions error: %v", err)
+	}
+	latest := embedded[len(embedded)-1]
+
+	err = db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		if _, err := tx.NewRaw("CREATE SCHEMA " + schema).Exec(ctx); err != nil {
+			return err
+		}
+		if _, err := tx.NewRaw("SET LOCAL search_path TO " + schema).Exec(ctx); err != nil {
+			return err
+		}
+		if _, err := tx.NewRaw(`CREATE TABLE goose_db_version (
+			id SERIAL PRIMARY KEY,
+			version_id BIGINT NOT NULL,
+			is_applied BOOLEAN NOT NULL,
+			tstamp TIMESTAMP DEFAULT now()
+		)`).Exec(ctx); err != nil {
+			return err
+		}
+		record := func(version int64, applied bool) error {
+			_, err := tx.NewRaw("INSERT INTO goose_db_version (version_id, is_applied) VALUES (?, ?)", version, applied).Exec(ctx)
+			return err
+		}
+		for _, v := range embedded {
+			if err := record(v, true); err != nil {
+				return err
+			}
+		}
+
+		// Rolling the latest migration back leaves its up row in place.
+		if err := record(latest, false); err != nil {
+			return err
+		}
+		status, err := CheckMigrations(ctx, tx, migrations.FS)
+		if err != nil {
+			return err
+		}
+		if !reflect.DeepEqual(status.Pending, []int64{latest}) || status.Unknown != nil || status.Current >= latest {
+			return fmt.Errorf("status after down = %+v, want %d pending", status, latest)
+		}
+
+		if err := record(latest, true); err != nil {
+			return err
+		}
+		status, err = CheckMigrations(ctx, tx, migrations.FS)
+		if err != nil {
+			return err
+		}
+		if !status.UpToDate() || status.Current != latest {
+			return fmt.Errorf("status after re-up = %+v, want up to date at %d", status, latest)
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("tx error: %v", err)
+	}
+}
@@ -0,0 +1,90 @@
+package postgres
+
+import (
+	"reflect"
+	"testing"
+	"testing/fstest"
+
+	"schedula/backend/migrations"
+)
+
+func TestMigrationVersions_ParsesPrefixesInOrder(t *testing.T) {
+	fsys := fstest.MapFS{
+		"00002_second.sql": &fstest.MapFile{},
+		"00001_first.sql":  &fstest.MapFile{},
+		"migrations.go":    &fstest.MapFile{},
+	}
+
+	got, err := migrationVersions(fsys)
+	if err != nil {
+		t.Fatalf("migrationVersions error: %v", err)
+	}
+	if want := []int64{1, 2}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("versions = %v, want %v", got, want)
+	}
+}
+
+func TestMigrationVersions_RejectsInvalidPrefix(t *testing.T) {
+	fsys := fstest.MapFS{
+		"create_things.sql": &fstest.MapFile{},
+	}
+
+	if _, err := migrationVersions(fsys); err == nil {
+		t.Fatalf("expected error")
+	}
+}
+
+func TestMigrationVersions_EmbeddedMigrations(t *testing.T) {
+	got, err := migrationVersions(migrations.FS)
+	if err != nil {
+		t.Fatalf("migrationVersions error: %v", err)
+	}
+	if len(got) == 0 {
+		t.Fatalf("expected embedded migrations")
+	}
+}
+
+func TestCompareMigrationVersions(t *testing.T) {
+	tests := []struct {
+		name     string
+		embedded []int64
+		applied  []int64
+		want     MigrationStatus
+	}{
+		{
+			name:     "up to date",
+			embedded: []int64{1, 2},
+			applied:  []int64{2, 1},
+			want:     MigrationStatus{Latest: 2, Current: 2},
+		},
+		{
+			name:     "pending",
+			embedded: []int64{1, 2, 3},
+			applied:  []int64{1},
+			want:     MigrationStatus{Latest: 3, Current: 1, Pending: []int64{2, 3}},
+		},
+		{
+			name:     "database ahead of binary",
+			embedded: []int64{1, 2},
+			applied:  []int64{1, 2, 3},
+			want:     MigrationStatus{Latest: 2, Current: 3, Unknown: []int64{3}},
+		},
+		{
+			name:     "never migrated",
+			embedded: []int64{1},
+			want:     MigrationStatus{Latest: 1, Pending: []int64{1}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := compareMigrationVersions(tt.embedded, tt.applied)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Fatalf("status = %+v, want %+v", got, tt.want)
+			}
+			if got.UpToDate() != (len(tt.want.Pending) == 0 && len(tt.want.Unknown) == 0) {
+				t.Fatalf("UpToDate = %v", got.UpToDate())
+			}
+		})
+	}
+}
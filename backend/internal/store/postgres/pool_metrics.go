@@ -0,0 +1,53 @@
+package postgres
+
+import (
+	"database/sql"
+	"fmt"
+	"io"
+)
+
+// PoolMetrics reports the connection pool and the connect retries made
+// while opening it, so pool exhaustion and a flapping database show up on
+// /metrics rather than only in debug logs.
+type PoolMetrics struct {
+	stats          func() sql.DBStats
+	connectRetries int64
+}
+
+// NewPoolMetrics reads the pool through stats, normally the pool's Stats
+// method. connectRetries is the number of OnRetry calls OpenWithRetry made.
+func NewPoolMetrics(stats func() sql.DBStats, connectRetries int64) *PoolMetrics {
+	return &PoolMetrics{stats: stats, connectRetries: connectRetries}
+}
+
+// WriteMetrics writes the pool's current state in the Prometheus text
+// exposition format.
+func (m *PoolMetrics) WriteMetrics(w io.Writer) error {
+	s := m.stats()
+	_, err := fmt.Fprintf(w, "# HELP schedula_db_pool_connections Connections in the pool by state.\n"+
+		"# TYPE schedula_db_pool_connections gauge\n"+
+		"schedula_db_pool_connections{state=\"in_use\"} %d\n"+
+		"schedula_db_pool_connections{state=\"idle\"} %d\n"+
+		"# HELP schedula_db_pool_max_open_connections Configured limit on open connections; 0 is unlimited.\n"+
+		"# TYPE schedula_db_pool_max_open_connections gauge\n"+
+		"schedula_db_pool_max_open_connections %d\n"+
+		"# HELP schedula_db_pool_waits_total Connection requests that waited for a free connection.\n"+
+		"# TYPE schedula_db_pool_waits_total counter\n"+
+		"schedula_db_pool_waits_total %d\n"+
+		"# HELP schedula_db_pool_wait_seconds_total Time spent waiting for a free connection.\n"+
+		"# TYPE schedula_db_pool_wait_seconds_total counter\n"+
+		"schedula_db_pool_wait_seconds_total %g\n"+
+		"# HELP schedula_db_pool_closed_total Connections the pool closed, by reason. Each is reopened on demand.\n"+
+		"# TYPE schedula_db_pool_closed_total counter\n"+
+		"schedula_db_pool_closed_total{reason=\"max_idle\"} %d\n"+
+		"schedula_db_pool_closed_total{reason=\"max_idle_time\"} %d\n"+
+		"schedula_db_pool_closed_total{reason=\"max_lifetime\"} %d\n"+
+		"# HELP schedula_db_connect_retries_total Connection attempts retried while the database was unreachable at startup.\n"+
+		"# TYPE schedula_db_connect_retries_total counter\n"+
+		"schedula_db_connect_retries_total %d\n",
+		s.InUse, s.Idle, s.MaxOpenConnections,
+		s.WaitCount, s.WaitDuration.Seconds(),
+		s.MaxIdleClosed, s.MaxIdleTimeClosed, s.MaxLifetimeClosed,
+		m.connectRetries)
+	return err
+}
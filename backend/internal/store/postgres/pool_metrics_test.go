@@ -0,0 +1,29 @@
+package postgres
+
+import (
+	"database/sql"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestPoolMetrics_WritesPoolStateAndRetries(t *testing.T) {
+	stats := sql.DBStats{MaxOpenConnections: 10, OpenConnections: 4, InUse: 3, Idle: 1, WaitCount: 7, WaitDuration: 1500 * time.Millisecond, MaxLifetimeClosed: 2}
+	var sb strings.Builder
+	if err := NewPoolMetrics(func() sql.DBStats { return stats }, 3).WriteMetrics(&sb); err != nil {
+		t.Fatalf("WriteMetrics error: %v", err)
+	}
+	for _, want := range []string{
+		`schedula_db_pool_connections{state="in_use"} 3`,
+		`schedula_db_pool_connections{state="idle"} 1`,
+		"schedula_db_pool_max_open_connections 10",
+		"schedula_db_pool_waits_total 7",
+		"schedula_db_pool_wait_seconds_total 1.5",
+		`schedula_db_pool_closed_total{reason="max_lifetime"} 2`,
+		"schedula_db_connect_retries_total 3",
+	} {
+		if !strings.Contains(sb.String(), want+"\n") {
+			t.Fatalf("metrics missing %q:\n%s", want, sb.String())
+		}
+	}
+}
@@ -0,0 +1,217 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+
+	"schedula/backend/internal/domain"
+	"schedula/backend/internal/store"
+	"schedula/backend/internal/store/pgerrors"
+)
+
+func (r *AppointmentRepo) CreateProgram(ctx context.Context, program domain.Program) (domain.Program, error) {
+	m := domain.Program{
+		ID:     program.ID,
+		UserID: program.UserID,
+		Title:  program.Title,
+		Notes:  program.Notes,
+	}
+	if _, err := r.db.NewInsert().Model(&m).Exec(ctx); err != nil {
+		return domain.Program{}, pgerrors.Classify(err)
+	}
+	return m, nil
+}
+
+func (r *AppointmentRepo) GetProgram(ctx context.Context, userID string, programID uuid.UUID) (domain.Program, error) {
+	var out domain.Program
+	err := r.db.NewSelect().
+		Model(&out).
+		Where("user_id = ?", userID).
+		Where("id = ?", programID).
+		Limit(1).
+		Scan(ctx)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return domain.Program{}, store.ErrNotFound
+		}
+		return domain.Program{}, pgerrors.Classify(err)
+	}
+	return out, nil
+}
+
+func (r *AppointmentRepo) ListPrograms(ctx context.Context, userID string) ([]domain.Program, error) {
+	var rows []domain.Program
+	err := r.db.NewSelect().
+		Model(&rows).
+		Where("user_id = ?", userID).
+		OrderExpr("created_at ASC, id ASC").
+		Scan(ctx)
+	if err != nil {
+		return nil, pgerrors.Classify(err)
+	}
+	return rows, nil
+}
+
+// ListProgramMembers returns the program's one-offs by start time and its
+// series by first occurrence.
+func (r *AppointmentRepo) ListProgramMembers(ctx context.Context, userID string, programID uuid.UUID) (store.ProgramMembers, error) {
+	var out store.ProgramMembers
+	err := r.db.NewSelect().
+		Model(&out.Appointments).
+		Where("user_id = ?", userID).
+		Where("program_id = ?", programID).
+		OrderExpr("start_time ASC, id ASC").
+		Scan(ctx)
+	if err != nil {
+		return store.ProgramMembers{}, pgerrors.Classify(err)
+	}
+	err = r.db.NewSelect().
+		Model(&out.Series).
+		Where("user_id = ?", userID).
+		Where("program_id = ?", programID).
+		OrderExpr("dtstart ASC, id ASC").
+		Scan(ctx)
+	if err != nil {
+		return store.ProgramMembers{}, pgerrors.Classify(err)
+	}
+	return out, nil
+}
+
+func (r *AppointmentRepo) CancelProgram(ctx context.Context, userID string, programID uuid.UUID, at time.Time) (store.ProgramCancellation, error) {
+	var out store.ProgramCancellation
+	err := r.db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		if err := r.lockCalendar(ctx, tx, userID); err != nil {
+			return err
+		}
+		var err error
+		out, err = cancelProgram(ctx, tx, userID, programID, at)
+		return err
+	})
+	if err != nil {
+		return store.ProgramCancellation{}, pgerrors.Classify(err)
+	}
+	return out, nil
+}
+
+// cancelProgram is CancelProgram within a transaction that holds the
+// calendar lock.
+func cancelProgram(ctx context.Context, tx bun.Tx, userID string, programID uuid.UUID, at time.Time) (store.ProgramCancellation, error) {
+	var out store.ProgramCancellation
+	err := tx.NewSelect().
+		Model(&out.Program).
+		Where("user_id = ?", userID).
+		Where("id = ?", programID).
+		Limit(1).
+		Scan(ctx)
+	if errors.Is(err, sql.ErrNoRows) {
+		return store.ProgramCancellation{}, store.ErrNotFound
+	}
+	if err != nil {
+		return store.ProgramCancellation{}, err
+	}
+	if out.Program.CancelledAt != nil {
+		return out, nil
+	}
+
+	var deleted []uuid.UUID
+	err = tx.NewDelete().
+		Model((*domain.Appointment)(nil)).
+		Where("user_id = ?", userID).
+		Where("program_id = ?", programID).
+		Where("start_time >= ?", at).
+		Returning("id").
+		Scan(ctx, &deleted)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return store.ProgramCancellation{}, err
+	}
+	for _, id := range deleted {
+		if err := recordChange(ctx, tx, userID, domain.ChangeEntityAppointment, id, domain.ChangeOpDeleted); err != nil {
+			return store.ProgramCancellation{}, err
+		}
+	}
+	out.AppointmentsDeleted = deleted
+
+	var series []domain.RecurringSeries
+	err = tx.NewSelect().
+		Model(&series).
+		Where("user_id = ?", userID).
+		Where("program_id = ?", programID).
+		Scan(ctx)
+	if err != nil {
+		return store.ProgramCancellation{}, err
+	}
+	for _, s := range series {
+		ended, err := cancelSeriesFrom(ctx, tx, s, at)
+		if err != nil {
+			return store.ProgramCancellation{}, err
+		}
+		switch ended {
+		case seriesEnded:
+			out.SeriesEnded++
+		case seriesDeleted:
+			out.SeriesDeleted++
+		}
+	}
+
+	now := time.Now().UTC()
+	out.Program.CancelledAt = &at
+	out.Program.UpdatedAt = now
+	_, err = tx.NewUpdate().
+		Model((*domain.Program)(nil)).
+		Set("cancelled_at = ?", at).
+		Set("updated_at = ?", now).
+		Where("id = ?", programID).
+		Exec(ctx)
+	if err != nil {
+		return store.ProgramCancellation{}, err
+	}
+	return out, nil
+}
+
+type seriesCancellation int
+
+const (
+	seriesUnchanged seriesCancellation = iota
+	seriesEnded
+	seriesDeleted
+)
+
+// cancelSeriesFrom drops the occurrences of series that start at or after
+// at. A series with none left is deleted; one that ends before at is left
+// alone.
+func cancelSeriesFrom(ctx context.Context, tx bun.Tx, series domain.RecurringSeries, at time.Time) (seriesCancellation, error) {
+	occs, err := domain.GenerateWeeklyOccurrences(series, series.DTStart, domain.SeriesHorizonEnd(series, store.RecurringConflictLookahead))
+	if err != nil {
+		return seriesUnchanged, err
+	}
+	var last *domain.RecurringOccurrence
+	future := false
+	for i := range occs {
+		if occs[i].StartTime.Before(at) {
+			last = &occs[i]
+		} else {
+			future = true
+		}
+	}
+	if !future {
+		return seriesUnchanged, nil
+	}
+	if last == nil {
+		if err := (calendarTx{tx: tx}).DeleteRecurringSeries(ctx, series.UserID, series.ID); err != nil {
+			return seriesUnchanged, err
+		}
+		return seriesDeleted, nil
+	}
+	until := last.StartTime.UTC()
+	series.Until = &until
+	series.Count = nil
+	if _, err := setSeriesEnd(ctx, tx, series); err != nil {
+		return seriesUnchanged, err
+	}
+	return seriesEnded, nil
+}
@@ -0,0 +1,119 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/uptrace/bun"
+
+	"schedula/backend/internal/domain"
+)
+
+func TestPostgresIntegration_CancelProgram(t *testing.T) {
+	databaseURL := strings.TrimSpace(os.Getenv("SCHEDULA_TEST_DATABASE_URL"))
+	if databaseURL == "" {
+		t.Skip("SCHEDULA_TEST_DATABASE_URL not set")
+	}
+
+	db, err := Open(databaseURL, PoolConfig{MaxOpenConns: 1})
+	if err != nil {
+		t.Fatalf("Open error: %v", err)
+	}
+	t.Cleanup(func() {
+		_ = Close(db)
+	})
+
+	schema := "schedula_test_" + randomHex(t, 8)
+	t.Cleanup(func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+		_, _ = db.NewRaw("DROP SCHEMA IF EXISTS " + schema + " CASCADE").Exec(ctx)
+	})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	err = db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		if _, err := tx.NewRaw("CREATE SCHEMA " + schema).Exec(ctx); err != nil {
+			return err
+		}
+		if _, err := tx.NewRaw("SET LOCAL search_path TO " + schema).Exec(ctx); err != nil {
+			return err
+		}
+		if err := applyMigrations(ctx, tx); err != nil {
+			return err
+		}
+
+		userID := "u1"
+		program := domain.Program{UserID: userID, Title: "12-week course"}
+		if _, err := tx.NewInsert().Model(&program).Exec(ctx); err != nil {
+			return err
+		}
+
+		// Cancel on Monday 2026-01-19, two weeks into the course.
+		at := time.Date(2026, 1, 19, 8, 0, 0, 0, time.UTC)
+		c := calendarTx{tx: tx}
+		count := 12
+		for _, a := range []domain.Appointment{
+			{UserID: userID, Title: "orientation", StartTime: at.AddDate(0, 0, -15), EndTime: at.AddDate(0, 0, -15).Add(time.Hour)},
+			{UserID: userID, Title: "final exam", StartTime: at.AddDate(0, 0, 60), EndTime: at.AddDate(0, 0, 60).Add(2 * time.Hour)},
+		} {
+			a.ProgramID = &program.ID
+			if _, err := c.CreateAppointment(ctx, a); err != nil {
+				return err
+			}
+		}
+		classes, err := c.CreateRecurringSeries(ctx, domain.RecurringSeries{
+			UserID: userID, Title: "class", Timezone: "UTC", DTStart: time.Date(2026, 1, 5, 10, 0, 0, 0, time.UTC),
+			DurationSeconds: 3600, Frequency: domain.RecurrenceFrequencyWeekly, Interval: 1, ByWeekday: []int16{1}, Count: &count, ProgramID: &program.ID,
+		})
+		if err != nil {
+			return err
+		}
+		if _, err := c.CreateRecurringSeries(ctx, domain.RecurringSeries{
+			UserID: userID, Title: "lab", Timezone: "UTC", DTStart: time.Date(2026, 2, 4, 10, 0, 0, 0, time.UTC),
+			DurationSeconds: 3600, Frequency: domain.RecurrenceFrequencyWeekly, Interval: 1, ByWeekday: []int16{3}, Count: &count, ProgramID: &program.ID,
+		}); err != nil {
+			return err
+		}
+
+		out, err := cancelProgram(ctx, tx, userID, program.ID, at)
+		if err != nil {
+			return err
+		}
+		if len(out.AppointmentsDeleted) != 1 || out.SeriesEnded != 1 || out.SeriesDeleted != 1 || out.Program.CancelledAt == nil {
+			return fmt.Errorf("cancellation = %+v, want 1 one-off deleted, 1 series ended, 1 deleted", out)
+		}
+
+		var ended domain.RecurringSeries
+		if err := tx.NewSelect().Model(&ended).Where("id = ?", classes.ID).Scan(ctx); err != nil {
+			return err
+		}
+		if ended.Count != nil || ended.Until == nil || !ended.Until.Equal(time.Date(2026, 1, 12, 10, 0, 0, 0, time.UTC)) {
+			return fmt.Errorf("class series end = until %v count %v, want until the second class", ended.Until, ended.Count)
+		}
+		var left int
+		if left, err = tx.NewSelect().Model((*domain.Appointment)(nil)).Where("program_id = ?", program.ID).Count(ctx); err != nil {
+			return err
+		}
+		if left != 1 {
+			return fmt.Errorf("%d one-offs left, want the past one", left)
+		}
+
+		again, err := cancelProgram(ctx, tx, userID, program.ID, at.Add(time.Hour))
+		if err != nil {
+			return err
+		}
+		if len(again.AppointmentsDeleted)+again.SeriesEnded+again.SeriesDeleted != 0 || !again.Program.CancelledAt.Equal(at) {
+			return fmt.Errorf("second cancellation = %+v, want no change", again)
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("tx error: %v", err)
+	}
+}
@@ -0,0 +1,196 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+
+	"schedula/backend/internal/domain"
+	"schedula/backend/internal/store"
+	"schedula/backend/internal/store/pgerrors"
+)
+
+func (r *AppointmentRepo) CreateProposal(ctx context.Context, proposal domain.AppointmentProposal) (domain.AppointmentProposal, error) {
+	var out domain.AppointmentProposal
+	err := r.db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		if err := r.lockCalendar(ctx, tx, proposal.ProposerID); err != nil {
+			return err
+		}
+		hold, err := reserveSlot(ctx, calendarTx{tx: tx}, domain.SlotHold{
+			UserID:    proposal.ProposerID,
+			StartTime: proposal.StartTime,
+			EndTime:   proposal.EndTime,
+			ExpiresAt: proposal.ExpiresAt,
+		})
+		if err != nil {
+			return err
+		}
+		m := proposal
+		m.HoldID = hold.ID
+		m.Status = domain.ProposalStatusPending
+		if _, err := tx.NewInsert().Model(&m).Exec(ctx); err != nil {
+			return err
+		}
+		out = m
+		return nil
+	})
+	if err != nil {
+		return domain.AppointmentProposal{}, pgerrors.Classify(err)
+	}
+	return out, nil
+}
+
+func (r *AppointmentRepo) GetProposal(ctx context.Context, proposalID uuid.UUID) (domain.AppointmentProposal, error) {
+	var p domain.AppointmentProposal
+	err := r.db.NewSelect().
+		Model(&p).
+		Where("id = ?", proposalID).
+		Limit(1).
+		Scan(ctx)
+	if errors.Is(err, sql.ErrNoRows) {
+		return domain.AppointmentProposal{}, store.ErrNotFound
+	}
+	if err != nil {
+		return domain.AppointmentProposal{}, pgerrors.Classify(err)
+	}
+	return p, nil
+}
+
+func (r *AppointmentRepo) ListProposals(ctx context.Context, userID string) ([]domain.AppointmentProposal, error) {
+	var rows []domain.AppointmentProposal
+	err := r.db.NewSelect().
+		Model(&rows).
+		Where("status = ?", domain.ProposalStatusPending).
+		Where("expires_at > now()").
+		WhereGroup(" AND ", func(q *bun.SelectQuery) *bun.SelectQuery {
+			return q.Where("proposer_id = ?", userID).WhereOr("recipient_id = ?", userID)
+		}).
+		OrderExpr("start_time ASC, id ASC").
+		Scan(ctx)
+	if err != nil {
+		return nil, pgerrors.Classify(err)
+	}
+	return rows, nil
+}
+
+func (r *AppointmentRepo) AcceptProposal(ctx context.Context, proposalID uuid.UUID, proposerAppt, recipientAppt domain.Appointment, at time.Time) (domain.AppointmentProposal, error) {
+	var out domain.AppointmentProposal
+	err := r.db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		if err := r.lockCalendars(ctx, tx, proposerAppt.UserID, recipientAppt.UserID); err != nil {
+			return err
+		}
+		p, err := proposalForUpdate(ctx, tx, proposalID)
+		if err != nil {
+			return err
+		}
+		if p.ProposerID != proposerAppt.UserID || p.RecipientID != recipientAppt.UserID {
+			return store.ErrNotFound
+		}
+		if !p.Open(at) {
+			out = p
+			return nil
+		}
+
+		txc := calendarTx{tx: tx, policy: r.opts.ConflictPolicy}
+		if err := txc.DeleteSlotHold(ctx, p.ProposerID, p.HoldID); err != nil && !errors.Is(err, store.ErrNotFound) {
+			return err
+		}
+		for _, appt := range []*domain.Appointment{&proposerAppt, &recipientAppt} {
+			appt.StartTime = p.StartTime.UTC()
+			appt.EndTime = p.EndTime.UTC()
+			if *appt, err = txc.CreateAppointment(ctx, *appt); err != nil {
+				return err
+			}
+		}
+
+		p.Status = domain.ProposalStatusAccepted
+		p.RespondedAt = &at
+		p.ProposerAppointmentID = &proposerAppt.ID
+		p.RecipientAppointmentID = &recipientAppt.ID
+		if _, err := tx.NewUpdate().
+			Model(&p).
+			Column("status", "responded_at", "proposer_appointment_id", "recipient_appointment_id").
+			WherePK().
+			Exec(ctx); err != nil {
+			return err
+		}
+		out = p
+		return nil
+	})
+	if err != nil {
+		return domain.AppointmentProposal{}, pgerrors.Classify(err)
+	}
+	return out, nil
+}
+
+func (r *AppointmentRepo) DeclineProposal(ctx context.Context, proposalID uuid.UUID, at time.Time) (domain.AppointmentProposal, error) {
+	// The hold lives on the proposer's calendar, whose lock must be taken
+	// before the proposal row; reading the proposer first keeps that order.
+	current, err := r.GetProposal(ctx, proposalID)
+	if err != nil {
+		return domain.AppointmentProposal{}, err
+	}
+	var out domain.AppointmentProposal
+	err = r.db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		if err := r.lockCalendar(ctx, tx, current.ProposerID); err != nil {
+			return err
+		}
+		p, err := proposalForUpdate(ctx, tx, proposalID)
+		if err != nil {
+			return err
+		}
+		if !p.Open(at) {
+			out = p
+			return nil
+		}
+		if err := (calendarTx{tx: tx}).DeleteSlotHold(ctx, p.ProposerID, p.HoldID); err != nil && !errors.Is(err, store.ErrNotFound) {
+			return err
+		}
+		p.Status = domain.ProposalStatusDeclined
+		p.RespondedAt = &at
+		if _, err := tx.NewUpdate().Model(&p).Column("status", "responded_at").WherePK().Exec(ctx); err != nil {
+			return err
+		}
+		out = p
+		return nil
+	})
+	if err != nil {
+		return domain.AppointmentProposal{}, pgerrors.Classify(err)
+	}
+	return out, nil
+}
+
+func proposalForUpdate(ctx context.Context, tx bun.Tx, proposalID uuid.UUID) (domain.AppointmentProposal, error) {
+	var p domain.AppointmentProposal
+	err := tx.NewSelect().
+		Model(&p).
+		Where("id = ?", proposalID).
+		For("UPDATE").
+		Limit(1).
+		Scan(ctx)
+	if errors.Is(err, sql.ErrNoRows) {
+		return domain.AppointmentProposal{}, store.ErrNotFound
+	}
+	if err != nil {
+		return domain.AppointmentProposal{}, err
+	}
+	return p, nil
+}
+
+// lockCalendars takes several calendar locks in a fixed order, so two
+// transactions locking the same pair cannot deadlock.
+func (r *AppointmentRepo) lockCalendars(ctx context.Context, tx bun.Tx, userIDs ...string) error {
+	ids := slices.Clone(userIDs)
+	slices.Sort(ids)
+	for _, id := range slices.Compact(ids) {
+		if err := r.lockCalendar(ctx, tx, id); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,150 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+
+	"schedula/backend/internal/domain"
+	"schedula/backend/internal/store"
+	"schedula/backend/internal/store/pgerrors"
+)
+
+func (r *AppointmentRepo) GetProvisionedUser(ctx context.Context, userID string) (domain.ProvisionedUser, error) {
+	var out domain.ProvisionedUser
+	err := r.db.NewSelect().
+		Model(&out).
+		Where("user_id = ?", userID).
+		Limit(1).
+		Scan(ctx)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return domain.ProvisionedUser{}, store.ErrNotFound
+		}
+		return domain.ProvisionedUser{}, pgerrors.Classify(err)
+	}
+	return out, nil
+}
+
+func (r *AppointmentRepo) UpsertProvisionedUser(ctx context.Context, user domain.ProvisionedUser) (domain.ProvisionedUser, error) {
+	now := time.Now().UTC()
+	m := user
+	m.CreatedAt = now
+	m.UpdatedAt = now
+	_, err := r.db.NewInsert().
+		Model(&m).
+		On("CONFLICT (user_id) DO UPDATE").
+		Set("display_name = EXCLUDED.display_name").
+		Set("email = EXCLUDED.email").
+		Set("external_id = EXCLUDED.external_id").
+		Set("active = EXCLUDED.active").
+		Set("deactivated_at = EXCLUDED.deactivated_at").
+		Set("updated_at = EXCLUDED.updated_at").
+		Returning("*").
+		Exec(ctx)
+	if err != nil {
+		if pgerrors.IsUniqueViolation(err) && pgerrors.Constraint(err) == "provisioned_users_external_id_idx" {
+			return domain.ProvisionedUser{}, store.ErrDuplicate
+		}
+		return domain.ProvisionedUser{}, pgerrors.Classify(err)
+	}
+	return m, nil
+}
+
+func (r *AppointmentRepo) ListProvisionedUsers(ctx context.Context) ([]domain.ProvisionedUser, error) {
+	var rows []domain.ProvisionedUser
+	err := r.db.NewSelect().
+		Model(&rows).
+		OrderExpr("user_id ASC").
+		Scan(ctx)
+	if err != nil {
+		return nil, pgerrors.Classify(err)
+	}
+	return rows, nil
+}
+
+func (r *AppointmentRepo) CreateUserGroup(ctx context.Context, group domain.UserGroup) (domain.UserGroup, error) {
+	m := domain.UserGroup{
+		ID:         group.ID,
+		Name:       group.Name,
+		ExternalID: group.ExternalID,
+	}
+	if _, err := r.db.NewInsert().Model(&m).Exec(ctx); err != nil {
+		if pgerrors.IsUniqueViolation(err) && pgerrors.Constraint(err) == "user_groups_name_idx" {
+			return domain.UserGroup{}, store.ErrDuplicate
+		}
+		return domain.UserGroup{}, pgerrors.Classify(err)
+	}
+	return m, nil
+}
+
+func (r *AppointmentRepo) SetUserGroupMembers(ctx context.Context, groupID uuid.UUID, userIDs []string) (domain.UserGroup, error) {
+	var out domain.UserGroup
+	err := r.db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		err := tx.NewUpdate().
+			Model(&out).
+			Set("updated_at = ?", time.Now().UTC()).
+			Where("id = ?", groupID).
+			Returning("*").
+			Scan(ctx)
+		if errors.Is(err, sql.ErrNoRows) {
+			return store.ErrNotFound
+		}
+		if err != nil {
+			return err
+		}
+		if _, err := tx.NewDelete().
+			Model((*domain.UserGroupMember)(nil)).
+			Where("group_id = ?", groupID).
+			Exec(ctx); err != nil {
+			return err
+		}
+		if len(userIDs) == 0 {
+			return nil
+		}
+		members := make([]domain.UserGroupMember, 0, len(userIDs))
+		for _, id := range userIDs {
+			members = append(members, domain.UserGroupMember{GroupID: groupID, UserID: id})
+		}
+		_, err = tx.NewInsert().Model(&members).Exec(ctx)
+		return err
+	})
+	if err != nil {
+		return domain.UserGroup{}, pgerrors.Classify(err)
+	}
+	out.Members = userIDs
+	return out, nil
+}
+
+// ListUserGroups returns every group by name, each with its members by user
+// id.
+func (r *AppointmentRepo) ListUserGroups(ctx context.Context) ([]domain.UserGroup, error) {
+	var groups []domain.UserGroup
+	err := r.db.NewSelect().
+		Model(&groups).
+		OrderExpr("lower(name) ASC, id ASC").
+		Scan(ctx)
+	if err != nil {
+		return nil, pgerrors.Classify(err)
+	}
+	var members []domain.UserGroupMember
+	err = r.db.NewSelect().
+		Model(&members).
+		OrderExpr("user_id ASC").
+		Scan(ctx)
+	if err != nil {
+		return nil, pgerrors.Classify(err)
+	}
+	byGroup := make(map[uuid.UUID][]string, len(groups))
+	for _, m := range members {
+		byGroup[m.GroupID] = append(byGroup[m.GroupID], m.UserID)
+	}
+	for i := range groups {
+		groups[i].Members = byGroup[groups[i].ID]
+	}
+	return groups, nil
+}
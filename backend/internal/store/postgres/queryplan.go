@@ -0,0 +1,116 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/uptrace/bun"
+
+	"schedula/backend/internal/conflicts"
+	"schedula/backend/internal/store/pgerrors"
+)
+
+// ErrQueryPlan is returned in plan-check mode when a list query would read
+// its table without an index.
+var ErrQueryPlan = errors.New("query plan check failed")
+
+// RepoOptions tunes AppointmentRepo behaviour that is off by default.
+type RepoOptions struct {
+	// CheckQueryPlans runs EXPLAIN before every list query and fails the
+	// call if the main table is read with a sequential scan. It doubles the
+	// round trips, so it is meant for development and CI only.
+	CheckQueryPlans bool
+	// ObserveLockWait, when set, is called with how long each calendar
+	// write waited for the user's calendar lock, once the lock is held.
+	ObserveLockWait func(ctx context.Context, userID string, wait time.Duration)
+	// ConflictPolicy judges bookings that overlap the calendar; nil is
+	// conflicts.Strict.
+	ConflictPolicy conflicts.Policy
+}
+
+type planNode struct {
+	NodeType     string     `json:"Node Type"`
+	RelationName string     `json:"Relation Name"`
+	IndexName    string     `json:"Index Name"`
+	Plans        []planNode `json:"Plans"`
+}
+
+// planScan is one relation access found in an EXPLAIN plan.
+type planScan struct {
+	NodeType string
+	Relation string
+	Index    string
+}
+
+// parsePlanScans flattens the output of EXPLAIN (FORMAT JSON) into the list
+// of scans that touch a relation.
+func parsePlanScans(raw []byte) ([]planScan, error) {
+	var plans []struct {
+		Plan planNode `json:"Plan"`
+	}
+	if err := json.Unmarshal(raw, &plans); err != nil {
+		return nil, fmt.Errorf("parse explain output: %w", err)
+	}
+	var out []planScan
+	var walk func(n planNode)
+	walk = func(n planNode) {
+		if n.RelationName != "" {
+			out = append(out, planScan{NodeType: n.NodeType, Relation: n.RelationName, Index: n.IndexName})
+		}
+		for _, child := range n.Plans {
+			walk(child)
+		}
+	}
+	for _, p := range plans {
+		walk(p.Plan)
+	}
+	return out, nil
+}
+
+// checkScans reports the first scan of table that does not go through an
+// index.
+func checkScans(scans []planScan, table string) error {
+	found := false
+	for _, s := range scans {
+		if s.Relation != table {
+			continue
+		}
+		found = true
+		if s.NodeType == "Seq Scan" {
+			return fmt.Errorf("%w: sequential scan on %s", ErrQueryPlan, table)
+		}
+	}
+	if !found {
+		return fmt.Errorf("%w: no scan on %s", ErrQueryPlan, table)
+	}
+	return nil
+}
+
+// checkPlan explains q with sequential scans disabled. Small development
+// tables would otherwise be seq-scanned whatever indexes exist; with them
+// disabled the planner only falls back to one when no index can serve the
+// predicate, which is the regression this guards against.
+func (r *AppointmentRepo) checkPlan(ctx context.Context, q *bun.SelectQuery, table string) error {
+	if !r.opts.CheckQueryPlans {
+		return nil
+	}
+	var raw []byte
+	err := r.db.RunInTx(ctx, &sql.TxOptions{ReadOnly: true}, func(ctx context.Context, tx bun.Tx) error {
+		if _, err := tx.ExecContext(ctx, "SET LOCAL enable_seqscan = off"); err != nil {
+			return err
+		}
+		return tx.QueryRowContext(ctx, "EXPLAIN (FORMAT JSON) "+q.String()).Scan(&raw)
+	})
+	if err != nil {
+		return pgerrors.Classify(err)
+	}
+	scans, err := parsePlanScans(raw)
+	if err != nil {
+		return err
+	}
+	return checkScans(scans, table)
+}
@@ -0,0 +1,42 @@
+package postgres
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestParsePlanScans(t *testing.T) {
+	raw := []byte(`[{"Plan": {
+		"Node Type": "Sort",
+		"Plans": [{
+			"Node Type": "Bitmap Heap Scan",
+			"Relation Name": "appointments",
+			"Plans": [{
+				"Node Type": "Bitmap Index Scan",
+				"Index Name": "appointments_user_start_time_idx"
+			}]
+		}]
+	}}]`)
+	scans, err := parsePlanScans(raw)
+	if err != nil {
+		t.Fatalf("parsePlanScans error: %v", err)
+	}
+	if len(scans) != 1 || scans[0].Relation != "appointments" || scans[0].NodeType != "Bitmap Heap Scan" {
+		t.Fatalf("scans = %+v, want one bitmap heap scan on appointments", scans)
+	}
+	if err := checkScans(scans, "appointments"); err != nil {
+		t.Fatalf("checkScans error: %v", err)
+	}
+	if err := checkScans(scans, "recurring_series"); !errors.Is(err, ErrQueryPlan) {
+		t.Fatalf("checkScans(recurring_series) = %v, want ErrQueryPlan", err)
+	}
+
+	seq := []planScan{{NodeType: "Seq Scan", Relation: "recurring_series"}}
+	if err := checkScans(seq, "recurring_series"); !errors.Is(err, ErrQueryPlan) {
+		t.Fatalf("checkScans(seq) = %v, want ErrQueryPlan", err)
+	}
+
+	if _, err := parsePlanScans([]byte("not json")); err == nil {
+		t.Fatal("parsePlanScans expected error for invalid input")
+	}
+}
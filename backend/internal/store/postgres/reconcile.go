@@ -0,0 +1,165 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+	"time"
+
+	"github.com/uptrace/bun"
+
+	"schedula/backend/internal/conflicts"
+	"schedula/backend/internal/domain"
+	"schedula/backend/internal/store"
+	"schedula/backend/internal/store/pgerrors"
+)
+
+// ReconcileCalendar applies offline mutations under the user's calendar
+// lock. Each write runs in its own savepoint, so an overlap rolls back only
+// that mutation and later ones still see the earlier accepted writes.
+func (r *AppointmentRepo) ReconcileCalendar(ctx context.Context, userID string, mutations []store.CalendarMutation) ([]store.MutationOutcome, error) {
+	outcomes := make([]store.MutationOutcome, len(mutations))
+	err := r.db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		if err := r.lockCalendar(ctx, tx, userID); err != nil {
+			return err
+		}
+		for i, m := range mutations {
+			out, err := reconcileMutation(ctx, tx, r.opts.ConflictPolicy, userID, m)
+			if err != nil {
+				return err
+			}
+			outcomes[i] = out
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, pgerrors.Classify(err)
+	}
+	return outcomes, nil
+}
+
+func reconcileMutation(ctx context.Context, tx bun.Tx, policy conflicts.Policy, userID string, m store.CalendarMutation) (store.MutationOutcome, error) {
+	current, err := calendarTx{tx: tx}.GetAppointment(ctx, userID, m.Appointment.ID)
+	exists := err == nil
+	if err != nil && !errors.Is(err, store.ErrNotFound) {
+		return store.MutationOutcome{}, err
+	}
+	conflict := func(c store.MutationConflict) (store.MutationOutcome, error) {
+		out := store.MutationOutcome{Conflict: c}
+		if exists {
+			out.Current = &current
+		}
+		return out, nil
+	}
+
+	switch m.Kind {
+	case store.MutationCreate:
+		if exists {
+			// A retried upload of a create that already landed.
+			if sameAppointmentContent(current, m.Appointment) {
+				return store.MutationOutcome{Current: &current}, nil
+			}
+			return conflict(store.ConflictExists)
+		}
+	case store.MutationUpdate:
+		if !exists {
+			return conflict(store.ConflictDeleted)
+		}
+		if !sameVersion(current.UpdatedAt, m.BaseUpdatedAt) {
+			return conflict(store.ConflictVersion)
+		}
+	case store.MutationDelete:
+		if !exists {
+			// Already gone, which is what the client wanted.
+			return store.MutationOutcome{}, nil
+		}
+		if !sameVersion(current.UpdatedAt, m.BaseUpdatedAt) {
+			return conflict(store.ConflictVersion)
+		}
+	default:
+		return store.MutationOutcome{}, fmt.Errorf("unknown mutation kind %q", m.Kind)
+	}
+
+	err = tx.RunInTx(ctx, nil, func(ctx context.Context, sp bun.Tx) error {
+		spTx := calendarTx{tx: sp, policy: policy}
+		switch m.Kind {
+		case store.MutationCreate:
+			_, err := spTx.CreateAppointment(ctx, m.Appointment)
+			return err
+		case store.MutationUpdate:
+			return spTx.updateAppointment(ctx, current, m.Appointment)
+		default:
+			return spTx.DeleteAppointment(ctx, userID, current.ID)
+		}
+	})
+	switch {
+	case errors.Is(err, store.ErrConflict):
+		return conflict(store.ConflictOverlap)
+	case errors.Is(err, store.ErrDuplicate):
+		return conflict(store.ConflictExists)
+	case err != nil:
+		return store.MutationOutcome{}, err
+	}
+	if m.Kind == store.MutationDelete {
+		return store.MutationOutcome{}, nil
+	}
+
+	// Re-read so Current carries updated_at exactly as stored, ready to be
+	// the client's next base version.
+	current, err = calendarTx{tx: tx}.GetAppointment(ctx, userID, m.Appointment.ID)
+	if err != nil {
+		return store.MutationOutcome{}, err
+	}
+	return store.MutationOutcome{Current: &current}, nil
+}
+
+// updateAppointment overwrites the client-editable fields of existing.
+func (r calendarTx) updateAppointment(ctx context.Context, existing, next domain.Appointment) error {
+	holds, err := r.ListActiveSlotHolds(ctx, existing.UserID, next.StartTime, next.EndTime)
+	if err != nil {
+		return err
+	}
+	if len(holds) > 0 {
+		return store.ErrConflict
+	}
+
+	m := existing
+	m.Title = next.Title
+	m.Notes = next.Notes
+	m.StartTime = next.StartTime
+	m.EndTime = next.EndTime
+	m.Metadata = next.Metadata
+	m.Timezone = next.Timezone
+	// The conflict policy judges the new time; an offline edit has no way
+	// to return its warnings.
+	if _, m.OverlapAllowed, err = r.judgeOverlaps(ctx, m); err != nil {
+		return err
+	}
+	_, err = r.tx.NewUpdate().
+		Model(&m).
+		Column("title", "notes", "start_time", "end_time", "metadata", "timezone", "overlap_allowed", "updated_at").
+		WherePK().
+		Exec(ctx)
+	if err != nil {
+		if pgerrors.IsExclusionViolation(err) && pgerrors.Constraint(err) == "appointments_no_overlap" {
+			return store.ErrConflict
+		}
+		return err
+	}
+	return recordChange(ctx, r.tx, existing.UserID, domain.ChangeEntityAppointment, existing.ID, domain.ChangeOpUpdated)
+}
+
+func sameAppointmentContent(a, b domain.Appointment) bool {
+	return a.Title == b.Title &&
+		a.Notes == b.Notes &&
+		a.StartTime.Equal(b.StartTime) &&
+		a.EndTime.Equal(b.EndTime) &&
+		maps.Equal(a.Metadata, b.Metadata)
+}
+
+// sameVersion compares updated_at values at the microsecond precision
+// Postgres stores them with.
+func sameVersion(stored, base time.Time) bool {
+	return stored.Round(time.Microsecond).Equal(base.Round(time.Microsecond))
+}
@@ -0,0 +1,69 @@
+package postgres
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+
+	"schedula/backend/internal/domain"
+	"schedula/backend/internal/store"
+	"schedula/backend/internal/store/pgerrors"
+)
+
+func (r *AppointmentRepo) ExpiredAppointments(ctx context.Context, now time.Time, defaultDays int) ([]store.RetentionPurge, error) {
+	var rows []struct {
+		UserID string    `bun:"user_id"`
+		Cutoff time.Time `bun:"cutoff"`
+		Count  int       `bun:"count"`
+	}
+	err := r.db.NewRaw(
+		"SELECT a.user_id, p.cutoff, count(*) AS count "+
+			"FROM appointments AS a "+
+			"LEFT JOIN user_settings AS s ON s.user_id = a.user_id "+
+			"CROSS JOIN LATERAL (SELECT COALESCE(s.retention_days, ?) AS days) AS d "+
+			"CROSS JOIN LATERAL (SELECT ?::timestamptz - make_interval(days => d.days) AS cutoff) AS p "+
+			"WHERE d.days > 0 AND a.end_time < p.cutoff "+
+			"GROUP BY a.user_id, p.cutoff "+
+			"ORDER BY a.user_id",
+		defaultDays, now.UTC(),
+	).Scan(ctx, &rows)
+	if err != nil {
+		return nil, pgerrors.Classify(err)
+	}
+	out := make([]store.RetentionPurge, 0, len(rows))
+	for _, row := range rows {
+		out = append(out, store.RetentionPurge{UserID: row.UserID, Cutoff: row.Cutoff.UTC(), Count: row.Count})
+	}
+	return out, nil
+}
+
+func (r *AppointmentRepo) PurgeAppointments(ctx context.Context, userID string, cutoff time.Time, limit int) ([]uuid.UUID, error) {
+	var ids []uuid.UUID
+	err := r.db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		if err := r.lockCalendar(ctx, tx, userID); err != nil {
+			return err
+		}
+		ids = ids[:0]
+		err := tx.NewRaw(
+			"DELETE FROM appointments WHERE id IN ("+
+				"SELECT id FROM appointments WHERE user_id = ? AND end_time < ? ORDER BY end_time ASC, id ASC LIMIT ?"+
+				") RETURNING id",
+			userID, cutoff.UTC(), limit,
+		).Scan(ctx, &ids)
+		if err != nil {
+			return err
+		}
+		for _, id := range ids {
+			if err := recordChange(ctx, tx, userID, domain.ChangeEntityAppointment, id, domain.ChangeOpDeleted); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, pgerrors.Classify(err)
+	}
+	return ids, nil
+}
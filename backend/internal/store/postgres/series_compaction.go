@@ -0,0 +1,128 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+
+	"schedula/backend/internal/domain"
+	"schedula/backend/internal/store"
+	"schedula/backend/internal/store/pgerrors"
+)
+
+func (r *AppointmentRepo) ListCompactableSeries(ctx context.Context, endedBefore time.Time, afterID uuid.UUID, limit int) ([]domain.RecurringSeries, error) {
+	var rows []domain.RecurringSeries
+	err := r.db.NewSelect().
+		Model(&rows).
+		Where("id > ?", afterID).
+		Where("dtstart < ?", endedBefore.UTC()).
+		Where("(until IS NOT NULL AND until < ?) OR count IS NOT NULL", endedBefore.UTC()).
+		Where("EXISTS (SELECT 1 FROM recurring_exceptions AS e WHERE e.series_id = recurring_series.id)").
+		OrderExpr("id ASC").
+		Limit(limit).
+		Scan(ctx)
+	if err != nil {
+		return nil, pgerrors.Classify(err)
+	}
+	return rows, nil
+}
+
+func (r *AppointmentRepo) CompactRecurringSeries(ctx context.Context, userID string, seriesID uuid.UUID, cutoff, at time.Time) (domain.SeriesCompaction, error) {
+	var out domain.SeriesCompaction
+	err := r.db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		if err := r.lockCalendar(ctx, tx, userID); err != nil {
+			return err
+		}
+		var err error
+		out, err = compactRecurringSeries(ctx, tx, userID, seriesID, cutoff, at)
+		return err
+	})
+	if err != nil {
+		return domain.SeriesCompaction{}, pgerrors.Classify(err)
+	}
+	return out, nil
+}
+
+// compactRecurringSeries is CompactRecurringSeries within a transaction that
+// holds the calendar lock. A series not yet ended by cutoff gives an empty
+// compaction.
+func compactRecurringSeries(ctx context.Context, tx bun.Tx, userID string, seriesID uuid.UUID, cutoff, at time.Time) (domain.SeriesCompaction, error) {
+	var series domain.RecurringSeries
+	err := tx.NewSelect().
+		Model(&series).
+		Where("user_id = ?", userID).
+		Where("id = ?", seriesID).
+		Scan(ctx)
+	if errors.Is(err, sql.ErrNoRows) {
+		return domain.SeriesCompaction{}, store.ErrNotFound
+	}
+	if err != nil {
+		return domain.SeriesCompaction{}, err
+	}
+	var exceptions []domain.RecurringException
+	if err := tx.NewSelect().Model(&exceptions).Where("series_id = ?", seriesID).OrderExpr("occurrence_start ASC").Scan(ctx); err != nil {
+		return domain.SeriesCompaction{}, err
+	}
+	plan, ok, err := domain.PlanSeriesCompaction(series, exceptions, cutoff, at)
+	if err != nil || !ok || plan.Empty() {
+		return domain.SeriesCompaction{}, err
+	}
+
+	if plan.Fold {
+		// Deleting the series cascades to its attendance, which the archived
+		// appointments have nowhere to keep.
+		attended, err := tx.NewSelect().
+			Model((*domain.OccurrenceAttendance)(nil)).
+			Where("series_id = ?", seriesID).
+			Exists(ctx)
+		if err != nil {
+			return domain.SeriesCompaction{}, err
+		}
+		if !attended {
+			err = tx.RunInTx(ctx, nil, func(ctx context.Context, sp bun.Tx) error {
+				spTx := calendarTx{tx: sp}
+				for _, a := range plan.Archived {
+					if _, err := spTx.CreateAppointment(ctx, a); err != nil {
+						return err
+					}
+				}
+				return spTx.DeleteRecurringSeries(ctx, userID, seriesID)
+			})
+			if err == nil {
+				return plan, nil
+			}
+			if !errors.Is(err, store.ErrConflict) {
+				return domain.SeriesCompaction{}, err
+			}
+		}
+		plan = plan.WithoutFold()
+		if plan.Empty() {
+			return domain.SeriesCompaction{}, nil
+		}
+	}
+
+	if len(plan.Dead) > 0 {
+		if _, err := tx.NewDelete().
+			Model((*domain.RecurringException)(nil)).
+			Where("series_id = ?", seriesID).
+			Where("id IN (?)", bun.In(plan.Dead)).
+			Exec(ctx); err != nil {
+			return domain.SeriesCompaction{}, err
+		}
+	}
+	if plan.Until == nil {
+		if err := recordChange(ctx, tx, userID, domain.ChangeEntitySeries, seriesID, domain.ChangeOpUpdated); err != nil {
+			return domain.SeriesCompaction{}, err
+		}
+		return plan, nil
+	}
+	series.Until, series.Count = plan.Until, nil
+	if _, err := setSeriesEnd(ctx, tx, series); err != nil {
+		return domain.SeriesCompaction{}, err
+	}
+	return plan, nil
+}
@@ -0,0 +1,131 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/uptrace/bun"
+
+	"schedula/backend/internal/domain"
+)
+
+func TestPostgresIntegration_CompactRecurringSeries(t *testing.T) {
+	databaseURL := strings.TrimSpace(os.Getenv("SCHEDULA_TEST_DATABASE_URL"))
+	if databaseURL == "" {
+		t.Skip("SCHEDULA_TEST_DATABASE_URL not set")
+	}
+
+	db, err := Open(databaseURL, PoolConfig{MaxOpenConns: 1})
+	if err != nil {
+		t.Fatalf("Open error: %v", err)
+	}
+	t.Cleanup(func() {
+		_ = Close(db)
+	})
+
+	schema := "schedula_test_" + randomHex(t, 8)
+	t.Cleanup(func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+		_, _ = db.NewRaw("DROP SCHEMA IF EXISTS " + schema + " CASCADE").Exec(ctx)
+	})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	err = db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		if _, err := tx.NewRaw("CREATE SCHEMA " + schema).Exec(ctx); err != nil {
+			return err
+		}
+		if _, err := tx.NewRaw("SET LOCAL search_path TO " + schema).Exec(ctx); err != nil {
+			return err
+		}
+		if err := applyMigrations(ctx, tx); err != nil {
+			return err
+		}
+
+		c := calendarTx{tx: tx}
+		week := 7 * 24 * time.Hour
+		dtstart := time.Date(2026, 1, 5, 9, 0, 0, 0, time.UTC)
+		at := time.Date(2026, 6, 1, 0, 0, 0, 0, time.UTC)
+		cutoff := at.Add(-30 * 24 * time.Hour)
+		newSeries := func(title string) (domain.RecurringSeries, error) {
+			count := 3
+			return c.CreateRecurringSeries(ctx, domain.RecurringSeries{
+				UserID: "u1", Title: title, Timezone: "UTC",
+				DTStart: dtstart, DurationSeconds: 1800,
+				Frequency: domain.RecurrenceFrequencyWeekly, Interval: 1, ByWeekday: []int16{1}, Count: &count, WeekStart: 1,
+			})
+		}
+		except := func(series domain.RecurringSeries, n int, ex domain.RecurringException) error {
+			ex.SeriesID = series.ID
+			ex.OccurrenceStart = dtstart.Add(time.Duration(n) * week)
+			_, err := c.UpsertRecurringException(ctx, ex)
+			return err
+		}
+
+		// The last occurrence skipped: the end moves back and the skip goes.
+		trimmed, err := newSeries("standup")
+		if err != nil {
+			return err
+		}
+		if err := except(trimmed, 2, domain.RecurringException{Kind: domain.RecurringExceptionKindSkip}); err != nil {
+			return err
+		}
+		plan, err := compactRecurringSeries(ctx, tx, "u1", trimmed.ID, cutoff, at)
+		if err != nil {
+			return err
+		}
+		if plan.Removed != 1 || plan.Until == nil || !plan.Until.Equal(dtstart.Add(week)) {
+			return fmt.Errorf("trim = %+v, want the end at the second occurrence", plan)
+		}
+		var left int
+		if left, err = tx.NewSelect().Model((*domain.RecurringException)(nil)).Where("series_id = ?", trimmed.ID).Count(ctx); err != nil || left != 0 {
+			return fmt.Errorf("exceptions left = %d, %v", left, err)
+		}
+
+		// Every occurrence skipped or overridden: the series folds into its
+		// one override.
+		folded, err := newSeries("review")
+		if err != nil {
+			return err
+		}
+		moved := dtstart.Add(week - time.Hour)
+		title := "Moved review"
+		for n, ex := range []domain.RecurringException{
+			{Kind: domain.RecurringExceptionKindSkip},
+			{Kind: domain.RecurringExceptionKindOverride, OverrideStart: &moved, OverrideTitle: &title},
+			{Kind: domain.RecurringExceptionKindSkip},
+		} {
+			if err := except(folded, n, ex); err != nil {
+				return err
+			}
+		}
+		plan, err = compactRecurringSeries(ctx, tx, "u1", folded.ID, cutoff, at)
+		if err != nil {
+			return err
+		}
+		if !plan.Fold || len(plan.Archived) != 1 {
+			return fmt.Errorf("fold = %+v, want one archived appointment", plan)
+		}
+		archived, err := c.GetAppointment(ctx, "u1", plan.Archived[0].ID)
+		if err != nil {
+			return err
+		}
+		if archived.Title != title || !archived.StartTime.Equal(moved) || archived.Source != domain.SourceArchive {
+			return fmt.Errorf("archived = %+v", archived)
+		}
+		exists, err := tx.NewSelect().Model((*domain.RecurringSeries)(nil)).Where("id = ?", folded.ID).Exists(ctx)
+		if err != nil || exists {
+			return fmt.Errorf("folded series still exists: %v", err)
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("tx error: %v", err)
+	}
+}
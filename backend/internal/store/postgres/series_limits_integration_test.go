@@ -0,0 +1,96 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/uptrace/bun"
+
+	"schedula/backend/internal/domain"
+	"schedula/backend/internal/store"
+)
+
+func TestPostgresIntegration_ListActiveRecurringSeries(t *testing.T) {
+	databaseURL := strings.TrimSpace(os.Getenv("SCHEDULA_TEST_DATABASE_URL"))
+	if databaseURL == "" {
+		t.Skip("SCHEDULA_TEST_DATABASE_URL not set")
+	}
+
+	db, err := Open(databaseURL, PoolConfig{MaxOpenConns: 1})
+	if err != nil {
+		t.Fatalf("Open error: %v", err)
+	}
+	t.Cleanup(func() {
+		_ = Close(db)
+	})
+
+	schema := "schedula_test_" + randomHex(t, 8)
+	t.Cleanup(func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+		_, _ = db.NewRaw("DROP SCHEMA IF EXISTS " + schema + " CASCADE").Exec(ctx)
+	})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	err = db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		if _, err := tx.NewRaw("CREATE SCHEMA " + schema).Exec(ctx); err != nil {
+			return err
+		}
+		if _, err := tx.NewRaw("SET LOCAL search_path TO " + schema).Exec(ctx); err != nil {
+			return err
+		}
+		if err := applyMigrations(ctx, tx); err != nil {
+			return err
+		}
+
+		now := time.Date(2026, 6, 1, 0, 0, 0, 0, time.UTC)
+		c := calendarTx{tx: tx}
+		weekly := func(title string, start time.Time, until *time.Time, count *int) domain.RecurringSeries {
+			return domain.RecurringSeries{
+				UserID: "u1", Title: title, Timezone: "UTC", DTStart: start, DurationSeconds: 3600,
+				Frequency: domain.RecurrenceFrequencyWeekly, Interval: 1, ByWeekday: []int16{int16(start.Weekday())},
+				Until: until, Count: count, WeekStart: 1,
+				DSTGap: domain.DSTGapShiftForward, DSTAmbiguous: domain.DSTAmbiguousEarlier,
+			}
+		}
+		ended := now.AddDate(0, 0, -7)
+		later := now.AddDate(0, 1, 0)
+		five := 5
+		for _, s := range []domain.RecurringSeries{
+			weekly("ended by until", now.AddDate(0, -1, 0), &ended, nil),
+			weekly("runs on", now.AddDate(0, 0, -14), &later, nil),
+			// Started longer ago than the lookahead, so its count is used up.
+			weekly("old count", now.Add(-store.RecurringConflictLookahead).AddDate(0, 0, -7), nil, &five),
+			weekly("future count", now.AddDate(0, 0, 3), nil, &five),
+		} {
+			if _, err := c.CreateRecurringSeries(ctx, s); err != nil {
+				return fmt.Errorf("%s: %w", s.Title, err)
+			}
+		}
+
+		rows, err := listActiveRecurringSeries(ctx, tx, "u1", now)
+		if err != nil {
+			return err
+		}
+		var titles []string
+		for _, s := range rows {
+			titles = append(titles, s.Title)
+		}
+		if got := strings.Join(titles, ","); got != "runs on,future count" {
+			return fmt.Errorf("active series = %s, want runs on,future count", got)
+		}
+		if rows, err := listActiveRecurringSeries(ctx, tx, "u2", now); err != nil || len(rows) != 0 {
+			return fmt.Errorf("other user's active series = %v, %v; want none", rows, err)
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("tx error: %v", err)
+	}
+}
@@ -0,0 +1,173 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/uptrace/bun"
+
+	"schedula/backend/internal/domain"
+)
+
+func TestPostgresIntegration_SeriesDSTPoliciesRoundTrip(t *testing.T) {
+	databaseURL := strings.TrimSpace(os.Getenv("SCHEDULA_TEST_DATABASE_URL"))
+	if databaseURL == "" {
+		t.Skip("SCHEDULA_TEST_DATABASE_URL not set")
+	}
+
+	db, err := Open(databaseURL, PoolConfig{MaxOpenConns: 1})
+	if err != nil {
+		t.Fatalf("Open error: %v", err)
+	}
+	t.Cleanup(func() {
+		_ = Close(db)
+	})
+
+	schema := "schedula_test_" + randomHex(t, 8)
+	t.Cleanup(func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+		_, _ = db.NewRaw("DROP SCHEMA IF EXISTS " + schema + " CASCADE").Exec(ctx)
+	})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	err = db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		if _, err := tx.NewRaw("CREATE SCHEMA " + schema).Exec(ctx); err != nil {
+			return err
+		}
+		if _, err := tx.NewRaw("SET LOCAL search_path TO " + schema).Exec(ctx); err != nil {
+			return err
+		}
+		if err := applyMigrations(ctx, tx); err != nil {
+			return err
+		}
+
+		c := calendarTx{tx: tx}
+		count := 4
+		// 02:30 on Sunday 1 March in New York; the next Sunday falls in the gap.
+		dtstart := time.Date(2026, 3, 1, 7, 30, 0, 0, time.UTC)
+		base := domain.RecurringSeries{
+			UserID: "u1", Title: "night shift", Timezone: "America/New_York",
+			DTStart: dtstart, DurationSeconds: 1800,
+			Frequency: domain.RecurrenceFrequencyWeekly, Interval: 1, ByWeekday: []int16{7}, Count: &count,
+		}
+
+		custom := base
+		custom.DSTGap = domain.DSTGapSkip
+		custom.DSTAmbiguous = domain.DSTAmbiguousLater
+		created, err := c.CreateRecurringSeries(ctx, custom)
+		if err != nil {
+			return err
+		}
+		defaulted := base
+		defaulted.DTStart = dtstart.Add(time.Hour)
+		plain, err := c.CreateRecurringSeries(ctx, defaulted)
+		if err != nil {
+			return err
+		}
+
+		rows, err := c.ListRecurringSeries(ctx, "u1", dtstart, dtstart.AddDate(0, 2, 0))
+		if err != nil {
+			return err
+		}
+		got := map[string]domain.RecurringSeries{}
+		for _, row := range rows {
+			got[row.ID.String()] = row
+		}
+		if s := got[created.ID.String()]; s.DSTGap != domain.DSTGapSkip || s.DSTAmbiguous != domain.DSTAmbiguousLater {
+			return fmt.Errorf("stored policies = %q/%q, want skip/later", s.DSTGap, s.DSTAmbiguous)
+		}
+		if s := got[plain.ID.String()]; s.DSTGap != domain.DSTGapShiftForward || s.DSTAmbiguous != domain.DSTAmbiguousEarlier {
+			return fmt.Errorf("default policies = %q/%q, want shift_forward/earlier", s.DSTGap, s.DSTAmbiguous)
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("tx error: %v", err)
+	}
+}
+
+func TestPostgresIntegration_SeriesWeekStartRoundTrip(t *testing.T) {
+	databaseURL := strings.TrimSpace(os.Getenv("SCHEDULA_TEST_DATABASE_URL"))
+	if databaseURL == "" {
+		t.Skip("SCHEDULA_TEST_DATABASE_URL not set")
+	}
+
+	db, err := Open(databaseURL, PoolConfig{MaxOpenConns: 1})
+	if err != nil {
+		t.Fatalf("Open error: %v", err)
+	}
+	t.Cleanup(func() {
+		_ = Close(db)
+	})
+
+	schema := "schedula_test_" + randomHex(t, 8)
+	t.Cleanup(func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+		_, _ = db.NewRaw("DROP SCHEMA IF EXISTS " + schema + " CASCADE").Exec(ctx)
+	})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	err = db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		if _, err := tx.NewRaw("CREATE SCHEMA " + schema).Exec(ctx); err != nil {
+			return err
+		}
+		if _, err := tx.NewRaw("SET LOCAL search_path TO " + schema).Exec(ctx); err != nil {
+			return err
+		}
+		if err := applyMigrations(ctx, tx); err != nil {
+			return err
+		}
+
+		// RFC 5545 section 3.3.10: FREQ=WEEKLY;INTERVAL=2;COUNT=4;BYDAY=TU,SU
+		// expands differently for WKST=MO and WKST=SU.
+		c := calendarTx{tx: tx}
+		count := 4
+		dtstart := time.Date(1997, 8, 5, 9, 0, 0, 0, time.UTC)
+		wantDays := map[int16][]int{1: {5, 10, 19, 24}, 7: {5, 17, 19, 31}}
+		for _, wkst := range []int16{1, 7} {
+			created, err := c.CreateRecurringSeries(ctx, domain.RecurringSeries{
+				UserID: fmt.Sprintf("u%d", wkst), Title: "t", Timezone: "UTC",
+				DTStart: dtstart, DurationSeconds: 3600,
+				Frequency: domain.RecurrenceFrequencyWeekly, Interval: 2, ByWeekday: []int16{2, 7}, Count: &count,
+				WeekStart: wkst,
+			})
+			if err != nil {
+				return err
+			}
+
+			rows, err := c.ListRecurringSeries(ctx, created.UserID, dtstart, dtstart.AddDate(0, 2, 0))
+			if err != nil {
+				return err
+			}
+			if len(rows) != 1 || rows[0].WeekStart != wkst {
+				return fmt.Errorf("stored series = %+v, want one with wkst %d", rows, wkst)
+			}
+			occs, err := domain.GenerateWeeklyOccurrences(rows[0], dtstart, dtstart.AddDate(0, 2, 0))
+			if err != nil {
+				return err
+			}
+			if len(occs) != len(wantDays[wkst]) {
+				return fmt.Errorf("wkst %d: len(occs) = %d, want %d", wkst, len(occs), len(wantDays[wkst]))
+			}
+			for i, day := range wantDays[wkst] {
+				if want := time.Date(1997, 8, day, 9, 0, 0, 0, time.UTC); !occs[i].StartTime.Equal(want) {
+					return fmt.Errorf("wkst %d: occs[%d] = %v, want %v", wkst, i, occs[i].StartTime, want)
+				}
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("tx error: %v", err)
+	}
+}
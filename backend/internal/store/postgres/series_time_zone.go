@@ -0,0 +1,114 @@
+package postgres
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+
+	"schedula/backend/internal/conflicts"
+	"schedula/backend/internal/domain"
+	"schedula/backend/internal/store"
+	"schedula/backend/internal/store/pgerrors"
+)
+
+// MoveRecurringSeriesTimeZone moves the user's series to tz with their
+// exceptions, in one transaction. It returns store.ErrNotFound if a series
+// is not the user's and store.ErrConflict if a moved occurrence overlaps
+// another booking, and otherwise the moved series and how many exceptions
+// were deleted because they no longer match an occurrence.
+func (r *AppointmentRepo) MoveRecurringSeriesTimeZone(ctx context.Context, userID string, seriesIDs []uuid.UUID, tz string, keep domain.TimeZoneKeep) ([]domain.RecurringSeries, int, error) {
+	var (
+		out     []domain.RecurringSeries
+		dropped int
+	)
+	err := r.db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		if err := r.lockCalendar(ctx, tx, userID); err != nil {
+			return err
+		}
+		var err error
+		out, dropped, err = moveSeriesTimeZone(ctx, tx, r.conflictPolicy(), userID, seriesIDs, tz, keep)
+		return err
+	})
+	if err != nil {
+		return nil, 0, pgerrors.Classify(err)
+	}
+	return out, dropped, nil
+}
+
+// moveSeriesTimeZone is MoveRecurringSeriesTimeZone within a transaction
+// that holds the calendar lock. Every series is moved before any is checked
+// for conflicts, so series moved together are checked against each other's
+// new times.
+func moveSeriesTimeZone(ctx context.Context, tx bun.Tx, policy conflicts.Policy, userID string, seriesIDs []uuid.UUID, tz string, keep domain.TimeZoneKeep) ([]domain.RecurringSeries, int, error) {
+	var rows []domain.RecurringSeries
+	err := tx.NewSelect().
+		Model(&rows).
+		Where("user_id = ?", userID).
+		Where("id IN (?)", bun.In(seriesIDs)).
+		OrderExpr("dtstart, id").
+		Scan(ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+	if len(rows) != len(seriesIDs) {
+		return nil, 0, store.ErrNotFound
+	}
+
+	out := make([]domain.RecurringSeries, 0, len(rows))
+	dropped := 0
+	for _, series := range rows {
+		var exRows []domain.RecurringException
+		if err := tx.NewSelect().Model(&exRows).Where("series_id = ?", series.ID).Scan(ctx); err != nil {
+			return nil, 0, err
+		}
+		moved, kept, gone, err := domain.MoveSeriesTimeZone(series, exRows, tz, keep, store.RecurringConflictLookahead)
+		if err != nil {
+			return nil, 0, err
+		}
+		moved.ByWeekdayMask = domain.WeekdayMaskOf(moved.ByWeekday)
+		if _, err := tx.NewUpdate().
+			Model(&moved).
+			Column("timezone", "dtstart", "until", "byweekday", "by_weekday_mask", "weekday_times", "updated_at").
+			Where("user_id = ?", userID).
+			Where("id = ?", moved.ID).
+			Exec(ctx); err != nil {
+			return nil, 0, err
+		}
+
+		// Exceptions are keyed by occurrence start, and moved starts can
+		// land on another exception's old one, so they are replaced as a
+		// set rather than updated in place.
+		if len(exRows) > 0 {
+			if _, err := tx.NewDelete().
+				Model((*domain.RecurringException)(nil)).
+				Where("series_id = ?", series.ID).
+				Exec(ctx); err != nil {
+				return nil, 0, err
+			}
+		}
+		if len(kept) > 0 {
+			now := time.Now().UTC()
+			for i := range kept {
+				kept[i].UpdatedAt = now
+			}
+			if _, err := tx.NewInsert().Model(&kept).Exec(ctx); err != nil {
+				return nil, 0, err
+			}
+		}
+		if err := recordChange(ctx, tx, userID, domain.ChangeEntitySeries, series.ID, domain.ChangeOpUpdated); err != nil {
+			return nil, 0, err
+		}
+		out = append(out, moved)
+		dropped += len(gone)
+	}
+
+	cal := calendarTx{tx: tx}
+	for _, moved := range out {
+		if _, err := judgeRecurringSeries(ctx, cal, policy, moved, 0); err != nil {
+			return nil, 0, err
+		}
+	}
+	return out, dropped, nil
+}
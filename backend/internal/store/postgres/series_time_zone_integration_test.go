@@ -0,0 +1,123 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+
+	"schedula/backend/internal/conflicts"
+	"schedula/backend/internal/domain"
+	"schedula/backend/internal/store"
+)
+
+func TestPostgresIntegration_MoveSeriesTimeZone(t *testing.T) {
+	databaseURL := strings.TrimSpace(os.Getenv("SCHEDULA_TEST_DATABASE_URL"))
+	if databaseURL == "" {
+		t.Skip("SCHEDULA_TEST_DATABASE_URL not set")
+	}
+
+	db, err := Open(databaseURL, PoolConfig{MaxOpenConns: 1})
+	if err != nil {
+		t.Fatalf("Open error: %v", err)
+	}
+	t.Cleanup(func() {
+		_ = Close(db)
+	})
+
+	schema := "schedula_test_" + randomHex(t, 8)
+	t.Cleanup(func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+		_, _ = db.NewRaw("DROP SCHEMA IF EXISTS " + schema + " CASCADE").Exec(ctx)
+	})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	err = db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		if _, err := tx.NewRaw("CREATE SCHEMA " + schema).Exec(ctx); err != nil {
+			return err
+		}
+		if _, err := tx.NewRaw("SET LOCAL search_path TO " + schema).Exec(ctx); err != nil {
+			return err
+		}
+		if err := applyMigrations(ctx, tx); err != nil {
+			return err
+		}
+
+		ny, err := time.LoadLocation("America/New_York")
+		if err != nil {
+			return err
+		}
+		c := calendarTx{tx: tx}
+		count := 4
+		series, err := c.CreateRecurringSeries(ctx, domain.RecurringSeries{
+			UserID: "u1", Title: "standup", Timezone: "America/New_York",
+			DTStart: time.Date(2026, 1, 5, 9, 0, 0, 0, ny).UTC(), DurationSeconds: 1800,
+			Frequency: domain.RecurrenceFrequencyWeekly, Interval: 1, ByWeekday: []int16{1}, Count: &count, WeekStart: 1,
+			DSTGap: domain.DSTGapShiftForward, DSTAmbiguous: domain.DSTAmbiguousEarlier,
+		})
+		if err != nil {
+			return err
+		}
+		skipped := time.Date(2026, 1, 12, 9, 0, 0, 0, ny).UTC()
+		if _, err := c.UpsertRecurringException(ctx, domain.RecurringException{SeriesID: series.ID, OccurrenceStart: skipped, Kind: domain.RecurringExceptionKindSkip}); err != nil {
+			return err
+		}
+
+		// 9:00 in London is 4:00 in New York, where a call already sits on
+		// the third Monday.
+		callStart := time.Date(2026, 1, 19, 9, 0, 0, 0, time.UTC)
+		call, err := c.CreateAppointment(ctx, domain.Appointment{UserID: "u1", Title: "call", StartTime: callStart, EndTime: callStart.Add(time.Hour)})
+		if err != nil {
+			return err
+		}
+		err = tx.RunInTx(ctx, nil, func(ctx context.Context, sp bun.Tx) error {
+			_, _, err := moveSeriesTimeZone(ctx, sp, conflicts.Strict{}, "u1", []uuid.UUID{series.ID}, "Europe/London", domain.TimeZoneKeepWallTime)
+			return err
+		})
+		if !errors.Is(err, store.ErrConflict) {
+			return fmt.Errorf("move over the call error = %v, want store.ErrConflict", err)
+		}
+		if err := c.DeleteAppointment(ctx, "u1", call.ID); err != nil {
+			return err
+		}
+
+		moved, dropped, err := moveSeriesTimeZone(ctx, tx, conflicts.Strict{}, "u1", []uuid.UUID{series.ID}, "Europe/London", domain.TimeZoneKeepWallTime)
+		if err != nil {
+			return err
+		}
+		if dropped != 0 || len(moved) != 1 || !moved[0].DTStart.Equal(time.Date(2026, 1, 5, 9, 0, 0, 0, time.UTC)) {
+			return fmt.Errorf("moved = %+v, dropped %d; want 9:00 in London and nothing dropped", moved, dropped)
+		}
+		var stored domain.RecurringSeries
+		if err := tx.NewSelect().Model(&stored).Where("id = ?", series.ID).Scan(ctx); err != nil {
+			return err
+		}
+		if stored.Timezone != "Europe/London" || !stored.DTStart.Equal(moved[0].DTStart) {
+			return fmt.Errorf("stored series = %s at %v, want the move", stored.Timezone, stored.DTStart)
+		}
+		exs, err := c.ListRecurringExceptions(ctx, series.ID, stored.DTStart, stored.DTStart.AddDate(0, 1, 0))
+		if err != nil {
+			return err
+		}
+		if len(exs) != 1 || !exs[0].OccurrenceStart.Equal(time.Date(2026, 1, 12, 9, 0, 0, 0, time.UTC)) {
+			return fmt.Errorf("exceptions = %+v, want the skip on the second Monday at 9:00 in London", exs)
+		}
+
+		if _, _, err := moveSeriesTimeZone(ctx, tx, conflicts.Strict{}, "u2", []uuid.UUID{series.ID}, "UTC", domain.TimeZoneKeepInstant); !errors.Is(err, store.ErrNotFound) {
+			return fmt.Errorf("other user's move error = %v, want store.ErrNotFound", err)
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("tx error: %v", err)
+	}
+}
@@ -0,0 +1,144 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+
+	"schedula/backend/internal/domain"
+	"schedula/backend/internal/store"
+	"schedula/backend/internal/store/pgerrors"
+)
+
+func (r *AppointmentRepo) UpsertSyncMapping(ctx context.Context, mapping domain.SyncMapping) (domain.SyncMapping, error) {
+	return upsertSyncMapping(ctx, r.db, mapping)
+}
+
+func upsertSyncMapping(ctx context.Context, db bun.IDB, mapping domain.SyncMapping) (domain.SyncMapping, error) {
+	if mapping.AppointmentID != nil {
+		owned, err := db.NewSelect().
+			Model((*domain.Appointment)(nil)).
+			Where("user_id = ?", mapping.UserID).
+			Where("id = ?", *mapping.AppointmentID).
+			Exists(ctx)
+		if err != nil {
+			return domain.SyncMapping{}, pgerrors.Classify(err)
+		}
+		if !owned {
+			return domain.SyncMapping{}, store.ErrNotFound
+		}
+	}
+
+	now := time.Now().UTC()
+	m := domain.SyncMapping{
+		UserID:        mapping.UserID,
+		Provider:      mapping.Provider,
+		ExternalID:    mapping.ExternalID,
+		AppointmentID: mapping.AppointmentID,
+		ETag:          mapping.ETag,
+		LastSyncedAt:  mapping.LastSyncedAt.UTC(),
+		CreatedAt:     now,
+		UpdatedAt:     now,
+	}
+	if m.LastSyncedAt.IsZero() {
+		m.LastSyncedAt = now
+	}
+	_, err := db.NewInsert().
+		Model(&m).
+		On("CONFLICT (user_id, provider, external_id) DO UPDATE").
+		Set("appointment_id = EXCLUDED.appointment_id").
+		Set("etag = EXCLUDED.etag").
+		Set("last_synced_at = EXCLUDED.last_synced_at").
+		Set("updated_at = EXCLUDED.updated_at").
+		Returning("*").
+		Exec(ctx)
+	if err != nil {
+		// The appointment was deleted between the check and the insert.
+		if pgerrors.Code(err) == pgerrors.CodeForeignKeyViolation {
+			return domain.SyncMapping{}, store.ErrNotFound
+		}
+		return domain.SyncMapping{}, pgerrors.Classify(err)
+	}
+	return m, nil
+}
+
+func (r *AppointmentRepo) GetSyncMapping(ctx context.Context, userID, provider, externalID string) (domain.SyncMapping, error) {
+	var out domain.SyncMapping
+	err := r.db.NewSelect().
+		Model(&out).
+		Where("user_id = ?", userID).
+		Where("provider = ?", provider).
+		Where("external_id = ?", externalID).
+		Limit(1).
+		Scan(ctx)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return domain.SyncMapping{}, store.ErrNotFound
+		}
+		return domain.SyncMapping{}, pgerrors.Classify(err)
+	}
+	return out, nil
+}
+
+func (r *AppointmentRepo) GetSyncMappingByAppointment(ctx context.Context, userID, provider string, appointmentID uuid.UUID) (domain.SyncMapping, error) {
+	var out domain.SyncMapping
+	err := r.db.NewSelect().
+		Model(&out).
+		Where("user_id = ?", userID).
+		Where("provider = ?", provider).
+		Where("appointment_id = ?", appointmentID).
+		Limit(1).
+		Scan(ctx)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return domain.SyncMapping{}, store.ErrNotFound
+		}
+		return domain.SyncMapping{}, pgerrors.Classify(err)
+	}
+	return out, nil
+}
+
+func (r *AppointmentRepo) ListSyncMappings(ctx context.Context, userID, provider string, filter store.SyncMappingFilter) ([]domain.SyncMapping, error) {
+	return listSyncMappings(ctx, r.db, userID, provider, filter)
+}
+
+func listSyncMappings(ctx context.Context, db bun.IDB, userID, provider string, filter store.SyncMappingFilter) ([]domain.SyncMapping, error) {
+	var rows []domain.SyncMapping
+	q := db.NewSelect().
+		Model(&rows).
+		Where("user_id = ?", userID).
+		Where("provider = ?", provider)
+	if !filter.SyncedBefore.IsZero() {
+		q = q.Where("last_synced_at < ?", filter.SyncedBefore.UTC())
+	}
+	if filter.Orphaned {
+		q = q.Where("appointment_id IS NULL")
+	}
+	if err := q.OrderExpr("external_id ASC").Scan(ctx); err != nil {
+		return nil, pgerrors.Classify(err)
+	}
+	return rows, nil
+}
+
+func (r *AppointmentRepo) DeleteSyncMapping(ctx context.Context, userID, provider, externalID string) error {
+	res, err := r.db.NewDelete().
+		Model((*domain.SyncMapping)(nil)).
+		Where("user_id = ?", userID).
+		Where("provider = ?", provider).
+		Where("external_id = ?", externalID).
+		Exec(ctx)
+	if err != nil {
+		return pgerrors.Classify(err)
+	}
+	if n, err := res.RowsAffected(); err != nil || n == 0 {
+		if err == nil {
+			err = store.ErrNotFound
+		}
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,100 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/uptrace/bun"
+
+	"schedula/backend/internal/domain"
+	"schedula/backend/internal/store"
+)
+
+func TestPostgresIntegration_SyncMappings(t *testing.T) {
+	databaseURL := strings.TrimSpace(os.Getenv("SCHEDULA_TEST_DATABASE_URL"))
+	if databaseURL == "" {
+		t.Skip("SCHEDULA_TEST_DATABASE_URL not set")
+	}
+
+	db, err := Open(databaseURL, PoolConfig{MaxOpenConns: 1})
+	if err != nil {
+		t.Fatalf("Open error: %v", err)
+	}
+	t.Cleanup(func() {
+		_ = Close(db)
+	})
+
+	schema := "schedula_test_" + randomHex(t, 8)
+	t.Cleanup(func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+		_, _ = db.NewRaw("DROP SCHEMA IF EXISTS " + schema + " CASCADE").Exec(ctx)
+	})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	err = db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		if _, err := tx.NewRaw("CREATE SCHEMA " + schema).Exec(ctx); err != nil {
+			return err
+		}
+		if _, err := tx.NewRaw("SET LOCAL search_path TO " + schema).Exec(ctx); err != nil {
+			return err
+		}
+		if err := applyMigrations(ctx, tx); err != nil {
+			return err
+		}
+
+		start := time.Date(2026, 1, 5, 10, 0, 0, 0, time.UTC)
+		c := calendarTx{tx: tx}
+		appt, err := c.CreateAppointment(ctx, domain.Appointment{UserID: "u1", Title: "standup", StartTime: start, EndTime: start.Add(time.Hour)})
+		if err != nil {
+			return err
+		}
+
+		if _, err := upsertSyncMapping(ctx, tx, domain.SyncMapping{UserID: "u2", Provider: "google", ExternalID: "evt-1", AppointmentID: &appt.ID}); !errors.Is(err, store.ErrNotFound) {
+			return fmt.Errorf("mapping another user's appointment error = %v, want ErrNotFound", err)
+		}
+		runStart := start.Add(time.Hour)
+		for _, etag := range []string{"v1", "v2"} {
+			if _, err := upsertSyncMapping(ctx, tx, domain.SyncMapping{UserID: "u1", Provider: "google", ExternalID: "evt-1", AppointmentID: &appt.ID, ETag: etag, LastSyncedAt: runStart}); err != nil {
+				return fmt.Errorf("upsert %s: %w", etag, err)
+			}
+		}
+		// Not seen in the run that started at runStart.
+		if _, err := upsertSyncMapping(ctx, tx, domain.SyncMapping{UserID: "u1", Provider: "google", ExternalID: "evt-gone", LastSyncedAt: start}); err != nil {
+			return err
+		}
+		if _, err := upsertSyncMapping(ctx, tx, domain.SyncMapping{UserID: "u1", Provider: "google", ExternalID: "evt-2", AppointmentID: &appt.ID, LastSyncedAt: runStart}); !errors.Is(err, store.ErrDuplicate) {
+			return fmt.Errorf("second event for one appointment error = %v, want ErrDuplicate", err)
+		}
+
+		stale, err := listSyncMappings(ctx, tx, "u1", "google", store.SyncMappingFilter{SyncedBefore: runStart})
+		if err != nil {
+			return err
+		}
+		if len(stale) != 1 || stale[0].ExternalID != "evt-gone" {
+			return fmt.Errorf("stale mappings = %+v, want evt-gone", stale)
+		}
+
+		if err := c.DeleteAppointment(ctx, "u1", appt.ID); err != nil {
+			return err
+		}
+		orphaned, err := listSyncMappings(ctx, tx, "u1", "google", store.SyncMappingFilter{Orphaned: true})
+		if err != nil {
+			return err
+		}
+		if len(orphaned) != 2 || orphaned[0].ExternalID != "evt-1" || orphaned[0].ETag != "v2" {
+			return fmt.Errorf("orphaned mappings = %+v, want evt-1 at v2 and evt-gone", orphaned)
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("tx error: %v", err)
+	}
+}
@@ -0,0 +1,105 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/google/uuid"
+
+	"schedula/backend/internal/domain"
+	"schedula/backend/internal/store"
+	"schedula/backend/internal/store/pgerrors"
+)
+
+func (r *AppointmentRepo) CreateTimeOff(ctx context.Context, timeOff domain.TimeOff) (domain.TimeOff, error) {
+	m := domain.TimeOff{
+		ID:        timeOff.ID,
+		UserID:    timeOff.UserID,
+		Title:     timeOff.Title,
+		StartTime: timeOff.StartTime,
+		EndTime:   timeOff.EndTime,
+		Timezone:  timeOff.Timezone,
+		Interval:  timeOff.Interval,
+		ByWeekday: timeOff.ByWeekday,
+		Until:     timeOff.Until,
+	}
+	if _, err := r.db.NewInsert().Model(&m).Exec(ctx); err != nil {
+		return domain.TimeOff{}, pgerrors.Classify(err)
+	}
+	return m, nil
+}
+
+func (r *AppointmentRepo) GetTimeOff(ctx context.Context, userID string, timeOffID uuid.UUID) (domain.TimeOff, error) {
+	var out domain.TimeOff
+	err := r.db.NewSelect().
+		Model(&out).
+		Where("user_id = ?", userID).
+		Where("id = ?", timeOffID).
+		Limit(1).
+		Scan(ctx)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return domain.TimeOff{}, store.ErrNotFound
+		}
+		return domain.TimeOff{}, pgerrors.Classify(err)
+	}
+	return out, nil
+}
+
+// UpdateTimeOff replaces every field of the user's time-off record.
+func (r *AppointmentRepo) UpdateTimeOff(ctx context.Context, timeOff domain.TimeOff) (domain.TimeOff, error) {
+	m := timeOff
+	res, err := r.db.NewUpdate().
+		Model(&m).
+		Column("title", "start_time", "end_time", "timezone", "interval", "byweekday", "until", "updated_at").
+		Where("user_id = ?", timeOff.UserID).
+		Where("id = ?", timeOff.ID).
+		Returning("*").
+		Exec(ctx)
+	if err != nil {
+		return domain.TimeOff{}, pgerrors.Classify(err)
+	}
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return domain.TimeOff{}, err
+	}
+	if affected == 0 {
+		return domain.TimeOff{}, store.ErrNotFound
+	}
+	return m, nil
+}
+
+func (r *AppointmentRepo) DeleteTimeOff(ctx context.Context, userID string, timeOffID uuid.UUID) error {
+	res, err := r.db.NewDelete().
+		Model((*domain.TimeOff)(nil)).
+		Where("user_id = ?", userID).
+		Where("id = ?", timeOffID).
+		Exec(ctx)
+	if err != nil {
+		return pgerrors.Classify(err)
+	}
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if affected == 0 {
+		return store.ErrNotFound
+	}
+	return nil
+}
+
+// ListTimeOff returns all of the user's time-off records, earliest first.
+// Users keep few of them, so callers expand recurring ones in memory.
+func (r *AppointmentRepo) ListTimeOff(ctx context.Context, userID string) ([]domain.TimeOff, error) {
+	var rows []domain.TimeOff
+	err := r.db.NewSelect().
+		Model(&rows).
+		Where("user_id = ?", userID).
+		OrderExpr("start_time ASC, id ASC").
+		Scan(ctx)
+	if err != nil {
+		return nil, pgerrors.Classify(err)
+	}
+	return rows, nil
+}
@@ -0,0 +1,21 @@
+package postgres
+
+import (
+	"context"
+
+	"github.com/uptrace/bun"
+
+	"schedula/backend/internal/timing"
+)
+
+// queryTimer adds each query's time to the request's timing breakdown, for
+// requests that asked for one.
+type queryTimer struct{}
+
+func (queryTimer) BeforeQuery(ctx context.Context, _ *bun.QueryEvent) context.Context {
+	return ctx
+}
+
+func (queryTimer) AfterQuery(ctx context.Context, event *bun.QueryEvent) {
+	timing.Since(ctx, timing.SQL, event.StartTime)
+}
@@ -0,0 +1,51 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"schedula/backend/internal/domain"
+	"schedula/backend/internal/store/pgerrors"
+)
+
+// GetUserSettings returns the user's settings, or the zero value for a user
+// who never saved any.
+func (r *AppointmentRepo) GetUserSettings(ctx context.Context, userID string) (domain.UserSettings, error) {
+	out := domain.UserSettings{UserID: userID}
+	err := r.db.NewSelect().
+		Model(&out).
+		Where("user_id = ?", userID).
+		Scan(ctx)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return domain.UserSettings{UserID: userID}, nil
+		}
+		return domain.UserSettings{}, pgerrors.Classify(err)
+	}
+	return out, nil
+}
+
+func (r *AppointmentRepo) UpdateUserSettings(ctx context.Context, settings domain.UserSettings) (domain.UserSettings, error) {
+	m := settings
+	m.UpdatedAt = time.Now().UTC()
+	_, err := r.db.NewInsert().
+		Model(&m).
+		On("CONFLICT (user_id) DO UPDATE").
+		Set("slot_alignment_minutes = EXCLUDED.slot_alignment_minutes").
+		Set("slot_time_zone = EXCLUDED.slot_time_zone").
+		Set("daily_breaks = EXCLUDED.daily_breaks").
+		Set("past_start_policy = EXCLUDED.past_start_policy").
+		Set("retention_days = EXCLUDED.retention_days").
+		Set("request_timeout_seconds = EXCLUDED.request_timeout_seconds").
+		Set("limits_multiplier = EXCLUDED.limits_multiplier").
+		Set("source_defaults = EXCLUDED.source_defaults").
+		Set("updated_at = EXCLUDED.updated_at").
+		Returning("*").
+		Exec(ctx)
+	if err != nil {
+		return domain.UserSettings{}, pgerrors.Classify(err)
+	}
+	return m, nil
+}
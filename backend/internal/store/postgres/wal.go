@@ -0,0 +1,41 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/uptrace/bun"
+
+	"schedula/backend/internal/store/pgerrors"
+)
+
+// WALReader reads write-ahead log positions, so a write on the primary can
+// be matched with the moment a replica has replayed it.
+type WALReader struct {
+	db *bun.DB
+}
+
+func NewWALReader(db *bun.DB) *WALReader {
+	return &WALReader{db: db}
+}
+
+// Position returns the primary's current WAL position, such as "0/16B3748".
+// Read after a write commits, it is at or past the write's commit record.
+func (r *WALReader) Position(ctx context.Context) (string, error) {
+	var lsn string
+	if err := r.db.NewRaw("SELECT pg_current_wal_lsn()::text").Scan(ctx, &lsn); err != nil {
+		return "", pgerrors.Classify(err)
+	}
+	return lsn, nil
+}
+
+// Replayed reports whether this server's database has replayed the WAL up
+// to lsn. A database that is not a standby has nothing to replay, so it
+// always has.
+func (r *WALReader) Replayed(ctx context.Context, lsn string) (bool, error) {
+	var replayed sql.NullBool
+	if err := r.db.NewRaw("SELECT pg_last_wal_replay_lsn() >= ?::pg_lsn", lsn).Scan(ctx, &replayed); err != nil {
+		return false, pgerrors.Classify(err)
+	}
+	return !replayed.Valid || replayed.Bool, nil
+}
@@ -0,0 +1,131 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+
+	"schedula/backend/internal/domain"
+)
+
+func TestPostgresIntegration_WeekdayMaskFilters(t *testing.T) {
+	databaseURL := strings.TrimSpace(os.Getenv("SCHEDULA_TEST_DATABASE_URL"))
+	if databaseURL == "" {
+		t.Skip("SCHEDULA_TEST_DATABASE_URL not set")
+	}
+
+	db, err := Open(databaseURL, PoolConfig{MaxOpenConns: 1})
+	if err != nil {
+		t.Fatalf("Open error: %v", err)
+	}
+	t.Cleanup(func() {
+		_ = Close(db)
+	})
+
+	schema := "schedula_test_" + randomHex(t, 8)
+	t.Cleanup(func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+		_, _ = db.NewRaw("DROP SCHEMA IF EXISTS " + schema + " CASCADE").Exec(ctx)
+	})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	err = db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		if _, err := tx.NewRaw("CREATE SCHEMA " + schema).Exec(ctx); err != nil {
+			return err
+		}
+		if _, err := tx.NewRaw("SET LOCAL search_path TO " + schema).Exec(ctx); err != nil {
+			return err
+		}
+		if err := applyMigrations(ctx, tx); err != nil {
+			return err
+		}
+
+		c := calendarTx{tx: tx}
+		count := 4
+		create := func(title, tz string, dtstart time.Time, weekday int16) (domain.RecurringSeries, error) {
+			return c.CreateRecurringSeries(ctx, domain.RecurringSeries{
+				UserID: "u1", Title: title, Timezone: tz, DTStart: dtstart, DurationSeconds: 3600,
+				Frequency: domain.RecurrenceFrequencyWeekly, Interval: 1, ByWeekday: []int16{weekday}, Count: &count,
+			})
+		}
+		// 2026-01-06 is a Tuesday and 2026-01-09 a Friday.
+		tuesday, err := create("tuesday", "UTC", time.Date(2026, 1, 6, 9, 0, 0, 0, time.UTC), 2)
+		if err != nil {
+			return err
+		}
+		friday, err := create("friday", "UTC", time.Date(2026, 1, 9, 9, 0, 0, 0, time.UTC), 5)
+		if err != nil {
+			return err
+		}
+		fridayNY, err := create("friday new york", "America/New_York", time.Date(2026, 1, 9, 14, 0, 0, 0, time.UTC), 5)
+		if err != nil {
+			return err
+		}
+		moved, err := create("friday moved", "UTC", time.Date(2026, 1, 9, 11, 0, 0, 0, time.UTC), 5)
+		if err != nil {
+			return err
+		}
+		overrideStart := time.Date(2026, 1, 12, 11, 0, 0, 0, time.UTC)
+		overrideEnd := overrideStart.Add(time.Hour)
+		if _, err := c.UpsertRecurringException(ctx, domain.RecurringException{
+			SeriesID: moved.ID, OccurrenceStart: moved.DTStart, Kind: domain.RecurringExceptionKindOverride,
+			OverrideStart: &overrideStart, OverrideEnd: &overrideEnd,
+		}); err != nil {
+			return err
+		}
+
+		ids := func(rows []domain.RecurringSeries) []uuid.UUID {
+			out := make([]uuid.UUID, 0, len(rows))
+			for _, r := range rows {
+				out = append(out, r.ID)
+			}
+			slices.SortFunc(out, func(a, b uuid.UUID) int { return strings.Compare(a.String(), b.String()) })
+			return out
+		}
+		want := func(series ...domain.RecurringSeries) []uuid.UUID { return ids(series) }
+
+		onTuesday, err := listSeriesOnWeekdays(ctx, tx, "u1", domain.WeekdayMaskOf([]int16{2}))
+		if err != nil {
+			return err
+		}
+		if !slices.Equal(ids(onTuesday), want(tuesday)) {
+			return fmt.Errorf("series on Tuesday = %v, want only %q", ids(onTuesday), tuesday.Title)
+		}
+		onFriday, err := listSeriesOnWeekdays(ctx, tx, "u1", domain.WeekdayMaskOf([]int16{5}))
+		if err != nil {
+			return err
+		}
+		if !slices.Equal(ids(onFriday), want(friday, fridayNY, moved)) {
+			return fmt.Errorf("series on Friday = %v, want the three Friday series", ids(onFriday))
+		}
+
+		// A Monday series can only meet Sunday to Tuesday starts in its own
+		// time zone; the New York series and the one with an override are
+		// kept regardless.
+		monday := domain.RecurringSeries{
+			UserID: "u1", Timezone: "UTC", DTStart: time.Date(2026, 1, 5, 9, 0, 0, 0, time.UTC), DurationSeconds: 3600,
+			ByWeekday: []int16{1},
+		}
+		candidates, err := c.ListSeriesConflictCandidates(ctx, monday, monday.DTStart, monday.DTStart.AddDate(0, 1, 0))
+		if err != nil {
+			return err
+		}
+		if !slices.Equal(ids(candidates), want(tuesday, fridayNY, moved)) {
+			return fmt.Errorf("candidates = %v, want all but the plain Friday series", ids(candidates))
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("tx error: %v", err)
+	}
+}
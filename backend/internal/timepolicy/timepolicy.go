@@ -0,0 +1,54 @@
+// Package timepolicy decides whether times supplied by clients are
+// acceptable relative to the server clock. Client and server clocks never
+// agree exactly, so every comparison allows Skew either way.
+package timepolicy
+
+import "time"
+
+// DefaultSkew is the clock difference tolerated when none is configured.
+const DefaultSkew = 2 * time.Minute
+
+type Policy struct {
+	// Now is the server clock; nil means time.Now.
+	Now func() time.Time
+	// Skew is how far a client clock may be from the server's.
+	Skew time.Duration
+	// MinNotice is how far ahead of now a booking must start. Zero only
+	// rules out starts in the past.
+	MinNotice time.Duration
+}
+
+func Default() Policy {
+	return Policy{Skew: DefaultSkew}
+}
+
+func (p Policy) now() time.Time {
+	if p.Now == nil {
+		return time.Now().UTC()
+	}
+	return p.Now().UTC()
+}
+
+// NotFuture reports whether t has already happened, give or take Skew. It
+// checks times a client says it observed, such as a check-in.
+func (p Policy) NotFuture(t time.Time) bool {
+	return !t.After(p.now().Add(p.Skew))
+}
+
+// NotPast reports whether t is still ahead, give or take Skew.
+func (p Policy) NotPast(t time.Time) bool {
+	return !t.Before(p.now().Add(-p.Skew))
+}
+
+// MeetsNotice reports whether a booking starting at start respects
+// MinNotice, give or take Skew.
+func (p Policy) MeetsNotice(start time.Time) bool {
+	return !start.Before(p.now().Add(p.MinNotice - p.Skew))
+}
+
+// EarliestStart is the first time offered to someone looking for a slot.
+// Skew is not subtracted: a slot offered here must still meet the notice
+// when it is booked a moment later.
+func (p Policy) EarliestStart() time.Time {
+	return p.now().Add(p.MinNotice)
+}
@@ -0,0 +1,44 @@
+package timepolicy
+
+import (
+	"testing"
+	"time"
+)
+
+func TestPolicy_ToleratesSkew(t *testing.T) {
+	now := time.Date(2026, 3, 2, 9, 0, 0, 0, time.UTC)
+	p := Policy{Now: func() time.Time { return now }, Skew: 2 * time.Minute, MinNotice: time.Hour}
+
+	tests := []struct {
+		name string
+		got  bool
+		want bool
+	}{
+		{"observed slightly ahead of server", p.NotFuture(now.Add(90 * time.Second)), true},
+		{"observed beyond skew", p.NotFuture(now.Add(3 * time.Minute)), false},
+		{"start just behind server", p.NotPast(now.Add(-time.Minute)), true},
+		{"start beyond skew in the past", p.NotPast(now.Add(-3 * time.Minute)), false},
+		{"notice met within skew", p.MeetsNotice(now.Add(59 * time.Minute)), true},
+		{"notice short beyond skew", p.MeetsNotice(now.Add(57 * time.Minute)), false},
+	}
+	for _, tc := range tests {
+		if tc.got != tc.want {
+			t.Errorf("%s = %v, want %v", tc.name, tc.got, tc.want)
+		}
+	}
+
+	if got := p.EarliestStart(); !got.Equal(now.Add(time.Hour)) {
+		t.Fatalf("EarliestStart = %v, want %v", got, now.Add(time.Hour))
+	}
+}
+
+func TestPolicy_ZeroSkewIsExact(t *testing.T) {
+	now := time.Date(2026, 3, 2, 9, 0, 0, 0, time.UTC)
+	p := Policy{Now: func() time.Time { return now }}
+	if !p.NotFuture(now) || p.NotFuture(now.Add(time.Nanosecond)) {
+		t.Fatalf("NotFuture without skew should accept now and nothing later")
+	}
+	if !p.MeetsNotice(now) || p.MeetsNotice(now.Add(-time.Nanosecond)) {
+		t.Fatalf("MeetsNotice without notice or skew should accept now and nothing earlier")
+	}
+}
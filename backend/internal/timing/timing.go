@@ -0,0 +1,102 @@
+// Package timing breaks a single request's time down into phases, so an
+// operator chasing a slow call can see where it went without a profiler.
+// Recording is off unless the request's context carries a Recorder, and
+// every function here is a no-op without one.
+package timing
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+)
+
+type Phase string
+
+const (
+	// Validation is the time from the start of the request to its first
+	// lock wait, query or expansion. Services check their input before they
+	// touch the store, so this is mostly validation; a request that never
+	// reaches the store spends all of its time here.
+	Validation Phase = "validation"
+	// LockWait is time spent waiting for calendar locks.
+	LockWait Phase = "lock_wait"
+	// SQL is time spent in queries, not counting lock waits.
+	SQL Phase = "sql"
+	// Expansion is time spent expanding recurring series into occurrences.
+	Expansion Phase = "expansion"
+	// Total is the whole request.
+	Total Phase = "total"
+)
+
+// Phases lists the phases of a Breakdown in order.
+var Phases = [...]Phase{Validation, LockWait, SQL, Expansion, Total}
+
+// Span is the time one phase took.
+type Span struct {
+	Phase    Phase
+	Duration time.Duration
+}
+
+// Recorder collects the phases of one request. It is safe for concurrent
+// use, since a request may run queries from several goroutines.
+type Recorder struct {
+	mu        sync.Mutex
+	start     time.Time
+	firstWork time.Time
+	spent     map[Phase]time.Duration
+}
+
+type recorderKey struct{}
+
+// WithRecorder starts timing a request that began at start.
+func WithRecorder(ctx context.Context, start time.Time) (context.Context, *Recorder) {
+	r := &Recorder{start: start, spent: make(map[Phase]time.Duration)}
+	return context.WithValue(ctx, recorderKey{}, r), r
+}
+
+// Since records the time from start until now against phase, if ctx is
+// being timed.
+func Since(ctx context.Context, phase Phase, start time.Time) {
+	r, _ := ctx.Value(recorderKey{}).(*Recorder)
+	if r == nil {
+		return
+	}
+	d := time.Since(start)
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.spent[phase] += d
+	if r.firstWork.IsZero() || start.Before(r.firstWork) {
+		r.firstWork = start
+	}
+}
+
+// Breakdown returns every phase in Phases order, with Total running until
+// end. Lock waits are made of queries, so their time is taken out of SQL.
+func (r *Recorder) Breakdown(end time.Time) []Span {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	total := end.Sub(r.start)
+	validation := total
+	if !r.firstWork.IsZero() {
+		validation = max(r.firstWork.Sub(r.start), 0)
+	}
+	return []Span{
+		{Validation, validation},
+		{LockWait, r.spent[LockWait]},
+		{SQL, max(r.spent[SQL]-r.spent[LockWait], 0)},
+		{Expansion, r.spent[Expansion]},
+		{Total, total},
+	}
+}
+
+// Format renders spans like a Server-Timing header, durations in
+// milliseconds: "validation;dur=0.412, lock_wait;dur=0.000, ...".
+func Format(spans []Span) string {
+	parts := make([]string, len(spans))
+	for i, s := range spans {
+		parts[i] = fmt.Sprintf("%s;dur=%.3f", s.Phase, float64(s.Duration)/float64(time.Millisecond))
+	}
+	return strings.Join(parts, ", ")
+}
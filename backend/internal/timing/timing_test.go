@@ -0,0 +1,58 @@
+package timing
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestSinceWithoutRecorderIsNoop(t *testing.T) {
+	Since(context.Background(), SQL, time.Now())
+}
+
+func TestBreakdown(t *testing.T) {
+	start := time.Now().Add(-100 * time.Millisecond)
+	ctx, rec := WithRecorder(context.Background(), start)
+
+	Since(ctx, LockWait, time.Now().Add(-20*time.Millisecond))
+	Since(ctx, SQL, time.Now().Add(-50*time.Millisecond))
+	Since(ctx, Expansion, time.Now().Add(-5*time.Millisecond))
+
+	spans := rec.Breakdown(start.Add(100 * time.Millisecond))
+	if len(spans) != len(Phases) {
+		t.Fatalf("spans = %v, want %d", spans, len(Phases))
+	}
+	got := make(map[Phase]time.Duration)
+	for i, s := range spans {
+		if s.Phase != Phases[i] {
+			t.Fatalf("span %d phase = %s, want %s", i, s.Phase, Phases[i])
+		}
+		got[s.Phase] = s.Duration
+	}
+	if got[Total] != 100*time.Millisecond {
+		t.Fatalf("total = %v, want 100ms", got[Total])
+	}
+	// The SQL recorded started 50ms before the end, the earliest work.
+	if v := got[Validation]; v < 45*time.Millisecond || v > 55*time.Millisecond {
+		t.Fatalf("validation = %v, want about 50ms", v)
+	}
+	if sql, wait := got[SQL], got[LockWait]; sql < 25*time.Millisecond || sql > 35*time.Millisecond || wait < 20*time.Millisecond {
+		t.Fatalf("sql = %v, lock_wait = %v, want sql without the lock wait", sql, wait)
+	}
+}
+
+func TestBreakdownWithoutWork(t *testing.T) {
+	start := time.Now()
+	_, rec := WithRecorder(context.Background(), start)
+	spans := rec.Breakdown(start.Add(time.Millisecond))
+	if spans[0].Phase != Validation || spans[0].Duration != time.Millisecond {
+		t.Fatalf("validation = %v, want the whole request", spans[0])
+	}
+}
+
+func TestFormat(t *testing.T) {
+	got := Format([]Span{{Validation, 412 * time.Microsecond}, {Total, 3 * time.Millisecond}})
+	if want := "validation;dur=0.412, total;dur=3.000"; got != want {
+		t.Fatalf("Format = %q, want %q", got, want)
+	}
+}
@@ -0,0 +1,958 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"maps"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"schedula/backend/internal/domain"
+	schedulev1 "schedula/backend/internal/gen/proto/schedula/v1"
+	"schedula/backend/internal/logscope"
+	"schedula/backend/internal/service/appointments"
+	"schedula/backend/internal/sli"
+	"schedula/backend/internal/store"
+	"schedula/backend/internal/usage"
+)
+
+// AdminServer serves operator endpoints that are not scoped to a user.
+type AdminServer struct {
+	schedulev1.UnimplementedAdminServiceServer
+
+	diag      diagnosticsReader
+	blackouts blackoutManager
+	usage     usageReader
+	pastStart pastStartManager
+	retention retentionManager
+	health    healthReader
+	logTarget logTargetManager
+	policies  requestPolicyManager
+	users     userProvisioner
+	log       *slog.Logger
+}
+
+type diagnosticsReader interface {
+	DatabaseDiagnostics(ctx context.Context) (store.DatabaseDiagnostics, error)
+}
+
+// blackoutManager is implemented by *appointments.Service.
+type blackoutManager interface {
+	CreateBlackout(ctx context.Context, in appointments.CreateBlackoutInput) (domain.Blackout, error)
+	DeleteBlackout(ctx context.Context, blackoutID uuid.UUID) error
+	ListBlackouts(ctx context.Context, windowStart, windowEnd time.Time) ([]domain.Blackout, error)
+}
+
+// pastStartManager is implemented by *appointments.Service.
+type pastStartManager interface {
+	Create(ctx context.Context, in appointments.CreateInput) (domain.Appointment, error)
+	UpdatePastStartPolicy(ctx context.Context, userID string, p domain.PastStartPolicy) (domain.UserSettings, domain.PastStartPolicy, error)
+	UpdateSourceDefaults(ctx context.Context, userID string, defaults []domain.SourceDefault) (domain.UserSettings, error)
+}
+
+// retentionManager is implemented by *appointments.Service.
+type retentionManager interface {
+	UpdateRetentionPolicy(ctx context.Context, userID string, days *int) (domain.UserSettings, int, error)
+	PurgeExpiredAppointments(ctx context.Context, dryRun bool) ([]store.RetentionPurge, error)
+}
+
+// requestPolicyManager is implemented by *appointments.Service.
+type requestPolicyManager interface {
+	UpdateRequestPolicy(ctx context.Context, userID string, timeout *time.Duration, multiplier *int) (domain.UserSettings, appointments.RequestPolicy, error)
+}
+
+// userProvisioner is implemented by *appointments.Service.
+type userProvisioner interface {
+	ProvisionUser(ctx context.Context, in appointments.ProvisionUserInput) (domain.ProvisionedUser, error)
+	DeactivateUser(ctx context.Context, userID string) (domain.ProvisionedUser, error)
+	ReactivateUser(ctx context.Context, userID string) (domain.ProvisionedUser, error)
+	ListProvisionedUsers(ctx context.Context) ([]domain.ProvisionedUser, error)
+	CreateUserGroup(ctx context.Context, name, externalID string) (domain.UserGroup, error)
+	SetUserGroupMembers(ctx context.Context, groupID uuid.UUID, userIDs []string) (domain.UserGroup, error)
+	ListUserGroups(ctx context.Context) ([]domain.UserGroup, error)
+}
+
+// healthReader is implemented by *sli.Tracker.
+type healthReader interface {
+	Window() time.Duration
+	Summary(window time.Duration) sli.Summary
+}
+
+// logTargetManager is implemented by *logscope.Targets.
+type logTargetManager interface {
+	Set(kind logscope.Kind, id string, ttl time.Duration) (logscope.Target, error)
+	Clear(kind logscope.Kind, id string) bool
+	List() []logscope.Target
+}
+
+// usageReader is implemented by *usage.Tracker.
+type usageReader interface {
+	Window() time.Duration
+	User(userID string) usage.UserUsage
+	Top(n int) []usage.UserUsage
+}
+
+func NewAdminServer(diag diagnosticsReader, blackouts blackoutManager, tracker usageReader, pastStart pastStartManager, retention retentionManager, health healthReader, logTargets logTargetManager, policies requestPolicyManager, users userProvisioner, log *slog.Logger) *AdminServer {
+	if log == nil {
+		log = slog.Default()
+	}
+	return &AdminServer{
+		diag:      diag,
+		blackouts: blackouts,
+		usage:     tracker,
+		pastStart: pastStart,
+		retention: retention,
+		health:    health,
+		logTarget: logTargets,
+		policies:  policies,
+		users:     users,
+		log:       log.With(slog.String("component", "grpc.admin")),
+	}
+}
+
+func (s *AdminServer) GetDatabaseDiagnostics(ctx context.Context, req *schedulev1.GetDatabaseDiagnosticsRequest) (*schedulev1.GetDatabaseDiagnosticsResponse, error) {
+	log := s.log.With(slog.String("rpc", "GetDatabaseDiagnostics"))
+
+	diag, err := s.diag.DatabaseDiagnostics(ctx)
+	if err != nil {
+		if code, msg, ok := retryableStoreError(err); ok {
+			log.Warn("database diagnostics failed; retryable", slog.Any("err", err))
+			return nil, status.Error(code, msg)
+		}
+		log.Error("database diagnostics failed", slog.Any("err", err))
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+
+	resp := &schedulev1.GetDatabaseDiagnosticsResponse{
+		Tables:  make([]*schedulev1.TableStats, 0, len(diag.Tables)),
+		Indexes: make([]*schedulev1.IndexStats, 0, len(diag.Indexes)),
+		Pool: &schedulev1.PoolStats{
+			MaxOpen:      uint32(diag.Pool.MaxOpen),
+			Open:         uint32(diag.Pool.Open),
+			InUse:        uint32(diag.Pool.InUse),
+			Idle:         uint32(diag.Pool.Idle),
+			WaitCount:    diag.Pool.WaitCount,
+			WaitDuration: durationpb.New(diag.Pool.WaitDuration),
+		},
+	}
+	invalid := 0
+	for _, t := range diag.Tables {
+		resp.Tables = append(resp.Tables, &schedulev1.TableStats{
+			Name:           t.Name,
+			TotalBytes:     t.TotalBytes,
+			TableBytes:     t.TableBytes,
+			IndexBytes:     t.IndexBytes,
+			LiveTuples:     t.LiveTuples,
+			DeadTuples:     t.DeadTuples,
+			DeadTupleRatio: t.DeadTupleRatio,
+			LastVacuum:     optionalTimestamp(t.LastVacuum),
+			LastAutovacuum: optionalTimestamp(t.LastAutovacuum),
+		})
+	}
+	for _, i := range diag.Indexes {
+		if !i.Valid || !i.Ready {
+			invalid++
+		}
+		resp.Indexes = append(resp.Indexes, &schedulev1.IndexStats{
+			Name:                i.Name,
+			Table:               i.Table,
+			Bytes:               i.Bytes,
+			BytesPerTuple:       i.BytesPerTuple,
+			Scans:               i.Scans,
+			Valid:               i.Valid,
+			Ready:               i.Ready,
+			ExclusionConstraint: i.ExclusionConstraint,
+		})
+	}
+
+	if invalid > 0 {
+		log.Warn("database diagnostics found invalid indexes", slog.Int("invalid_indexes", invalid))
+	}
+	log.Debug(
+		"database diagnostics fetched",
+		slog.Int("tables", len(resp.Tables)),
+		slog.Int("indexes", len(resp.Indexes)),
+	)
+
+	return resp, nil
+}
+
+func (s *AdminServer) CreateBlackout(ctx context.Context, req *schedulev1.CreateBlackoutRequest) (*schedulev1.CreateBlackoutResponse, error) {
+	log := s.log.With(slog.String("rpc", "CreateBlackout"))
+
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request is required")
+	}
+	if req.StartTime == nil || req.EndTime == nil {
+		return nil, status.Error(codes.InvalidArgument, "start_time and end_time are required")
+	}
+	mode, ok := fromProtoBlackoutMode(req.Mode)
+	if !ok {
+		return nil, status.Error(codes.InvalidArgument, "invalid mode")
+	}
+
+	blackout, err := s.blackouts.CreateBlackout(ctx, appointments.CreateBlackoutInput{
+		Title:     req.Title,
+		StartTime: req.StartTime.AsTime(),
+		EndTime:   req.EndTime.AsTime(),
+		Mode:      mode,
+	})
+	if err != nil {
+		return nil, s.blackoutError(log, "blackout create", err)
+	}
+
+	log.Info(
+		"blackout created",
+		slog.String("blackout_id", blackout.ID.String()),
+		slog.String("mode", string(blackout.Mode)),
+		slog.Time("start_time", blackout.StartTime),
+		slog.Time("end_time", blackout.EndTime),
+	)
+	return &schedulev1.CreateBlackoutResponse{Blackout: toProtoBlackout(blackout)}, nil
+}
+
+func (s *AdminServer) DeleteBlackout(ctx context.Context, req *schedulev1.DeleteBlackoutRequest) (*schedulev1.DeleteBlackoutResponse, error) {
+	log := s.log.With(slog.String("rpc", "DeleteBlackout"))
+
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request is required")
+	}
+	blackoutID, err := uuid.Parse(req.BlackoutId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid blackout_id")
+	}
+
+	if err := s.blackouts.DeleteBlackout(ctx, blackoutID); err != nil {
+		if errors.Is(err, store.ErrNotFound) {
+			return nil, status.Error(codes.NotFound, "blackout not found")
+		}
+		return nil, s.blackoutError(log, "blackout delete", err)
+	}
+
+	log.Info("blackout deleted", slog.String("blackout_id", blackoutID.String()))
+	return &schedulev1.DeleteBlackoutResponse{}, nil
+}
+
+func (s *AdminServer) ListBlackouts(ctx context.Context, req *schedulev1.ListBlackoutsRequest) (*schedulev1.ListBlackoutsResponse, error) {
+	log := s.log.With(slog.String("rpc", "ListBlackouts"))
+
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request is required")
+	}
+	if req.WindowStart == nil || req.WindowEnd == nil {
+		return nil, status.Error(codes.InvalidArgument, "window_start and window_end are required")
+	}
+
+	blackouts, err := s.blackouts.ListBlackouts(ctx, req.WindowStart.AsTime(), req.WindowEnd.AsTime())
+	if err != nil {
+		return nil, s.blackoutError(log, "blackout list", err)
+	}
+
+	resp := &schedulev1.ListBlackoutsResponse{Blackouts: make([]*schedulev1.Blackout, 0, len(blackouts))}
+	for _, b := range blackouts {
+		resp.Blackouts = append(resp.Blackouts, toProtoBlackout(b))
+	}
+	log.Debug("blackouts listed", slog.Int("count", len(resp.Blackouts)))
+	return resp, nil
+}
+
+// Usage report bounds for GetAPIUsage without a user_id.
+const (
+	DefaultUsageUsers = 20
+	MaxUsageUsers     = 100
+)
+
+// GetAPIUsage reports request and error counts over the tracker's rolling
+// window: for one user when user_id is set, otherwise for the busiest users.
+// Counts cover the instance that serves the call.
+func (s *AdminServer) GetAPIUsage(ctx context.Context, req *schedulev1.GetAPIUsageRequest) (*schedulev1.GetAPIUsageResponse, error) {
+	log := s.log.With(slog.String("rpc", "GetAPIUsage"))
+
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request is required")
+	}
+	if s.usage == nil {
+		return nil, status.Error(codes.FailedPrecondition, "usage tracking is not enabled")
+	}
+
+	var users []usage.UserUsage
+	if req.UserId != "" {
+		users = []usage.UserUsage{s.usage.User(req.UserId)}
+	} else {
+		limit := int(req.Limit)
+		if limit == 0 {
+			limit = DefaultUsageUsers
+		}
+		if limit > MaxUsageUsers {
+			return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("limit must be at most %d", MaxUsageUsers))
+		}
+		users = s.usage.Top(limit)
+	}
+
+	resp := &schedulev1.GetAPIUsageResponse{
+		Window: durationpb.New(s.usage.Window()),
+		Users:  make([]*schedulev1.UserUsage, 0, len(users)),
+	}
+	for _, u := range users {
+		out := &schedulev1.UserUsage{UserId: u.UserID, Requests: u.Total.Requests, Errors: u.Total.Errors}
+		for _, m := range slices.Sorted(maps.Keys(u.Methods)) {
+			c := u.Methods[m]
+			out.Methods = append(out.Methods, &schedulev1.MethodUsage{Method: m, Requests: c.Requests, Errors: c.Errors})
+		}
+		resp.Users = append(resp.Users, out)
+	}
+	log.Debug("api usage read", slog.String("user_id", req.UserId), slog.Int("count", len(resp.Users)))
+	return resp, nil
+}
+
+// DefaultHealthWindows are summarized when GetServiceHealthSummary names no
+// windows; any longer than the tracker's window are dropped.
+var DefaultHealthWindows = []time.Duration{5 * time.Minute, time.Hour}
+
+const MaxHealthWindows = 6
+
+// GetServiceHealthSummary reports availability, booking conflict rate and
+// p99 latency per RPC method over each requested window. Like GetAPIUsage,
+// it covers the instance that serves the call.
+func (s *AdminServer) GetServiceHealthSummary(ctx context.Context, req *schedulev1.GetServiceHealthSummaryRequest) (*schedulev1.GetServiceHealthSummaryResponse, error) {
+	log := s.log.With(slog.String("rpc", "GetServiceHealthSummary"))
+
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request is required")
+	}
+	if s.health == nil {
+		return nil, status.Error(codes.FailedPrecondition, "health tracking is not enabled")
+	}
+	if len(req.Windows) > MaxHealthWindows {
+		return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("at most %d windows may be requested", MaxHealthWindows))
+	}
+
+	limit := s.health.Window()
+	var windows []time.Duration
+	for _, w := range req.Windows {
+		d := w.AsDuration()
+		if d <= 0 || d > limit {
+			return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("windows must be positive and at most %s", limit))
+		}
+		windows = append(windows, d)
+	}
+	if len(windows) == 0 {
+		for _, d := range DefaultHealthWindows {
+			if d <= limit {
+				windows = append(windows, d)
+			}
+		}
+		if len(windows) == 0 {
+			windows = []time.Duration{limit}
+		}
+	}
+
+	resp := &schedulev1.GetServiceHealthSummaryResponse{}
+	for _, d := range windows {
+		sum := s.health.Summary(d)
+		hw := &schedulev1.HealthWindow{Window: durationpb.New(sum.Window), Total: toProtoMethodHealth(sum.Total)}
+		for _, m := range sum.Methods {
+			hw.Methods = append(hw.Methods, toProtoMethodHealth(m))
+		}
+		resp.Windows = append(resp.Windows, hw)
+	}
+	log.Debug("service health read", slog.Int("windows", len(resp.Windows)))
+	return resp, nil
+}
+
+func toProtoMethodHealth(m sli.MethodSummary) *schedulev1.MethodHealth {
+	return &schedulev1.MethodHealth{
+		Method:       m.Method,
+		Requests:     m.Requests,
+		ServerErrors: m.ServerErrors,
+		Availability: m.Availability(),
+		Conflicts:    m.Conflicts,
+		ConflictRate: m.ConflictRate(),
+		P99Latency:   durationpb.New(m.P99Latency),
+	}
+}
+
+// SetLogTarget logs everything for one user or request, debug included, until
+// the target expires. Targets live on the instance that serves the call.
+func (s *AdminServer) SetLogTarget(ctx context.Context, req *schedulev1.SetLogTargetRequest) (*schedulev1.SetLogTargetResponse, error) {
+	log := s.log.With(slog.String("rpc", "SetLogTarget"))
+
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request is required")
+	}
+	if s.logTarget == nil {
+		return nil, status.Error(codes.FailedPrecondition, "log targeting is not enabled")
+	}
+	kind, ok := fromProtoLogTargetKind(req.Kind)
+	if !ok {
+		return nil, status.Error(codes.InvalidArgument, "invalid kind")
+	}
+	if req.Id == "" {
+		return nil, status.Error(codes.InvalidArgument, "id is required")
+	}
+	ttl := req.Ttl.AsDuration()
+	if ttl < 0 || ttl > logscope.MaxTTL {
+		return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("ttl must be between 0 and %s", logscope.MaxTTL))
+	}
+
+	target, err := s.logTarget.Set(kind, req.Id, ttl)
+	if errors.Is(err, logscope.ErrTooManyTargets) {
+		return nil, status.Error(codes.ResourceExhausted, fmt.Sprintf("at most %d log targets may be active", logscope.MaxTargets))
+	}
+	if err != nil {
+		log.Error("log target set failed", slog.Any("err", err))
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+
+	log.Info("log target set", slog.String("kind", string(kind)), slog.String("id", req.Id), slog.Time("expires_at", target.ExpiresAt))
+	return &schedulev1.SetLogTargetResponse{Target: toProtoLogTarget(target)}, nil
+}
+
+func (s *AdminServer) ClearLogTarget(ctx context.Context, req *schedulev1.ClearLogTargetRequest) (*schedulev1.ClearLogTargetResponse, error) {
+	log := s.log.With(slog.String("rpc", "ClearLogTarget"))
+
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request is required")
+	}
+	if s.logTarget == nil {
+		return nil, status.Error(codes.FailedPrecondition, "log targeting is not enabled")
+	}
+	kind, ok := fromProtoLogTargetKind(req.Kind)
+	if !ok {
+		return nil, status.Error(codes.InvalidArgument, "invalid kind")
+	}
+	if !s.logTarget.Clear(kind, req.Id) {
+		return nil, status.Error(codes.NotFound, "log target not found")
+	}
+
+	log.Info("log target cleared", slog.String("kind", string(kind)), slog.String("id", req.Id))
+	return &schedulev1.ClearLogTargetResponse{}, nil
+}
+
+func (s *AdminServer) ListLogTargets(ctx context.Context, req *schedulev1.ListLogTargetsRequest) (*schedulev1.ListLogTargetsResponse, error) {
+	log := s.log.With(slog.String("rpc", "ListLogTargets"))
+
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request is required")
+	}
+	if s.logTarget == nil {
+		return nil, status.Error(codes.FailedPrecondition, "log targeting is not enabled")
+	}
+
+	resp := &schedulev1.ListLogTargetsResponse{}
+	for _, t := range s.logTarget.List() {
+		resp.Targets = append(resp.Targets, toProtoLogTarget(t))
+	}
+	log.Debug("log targets listed", slog.Int("count", len(resp.Targets)))
+	return resp, nil
+}
+
+func fromProtoLogTargetKind(k schedulev1.LogTargetKind) (logscope.Kind, bool) {
+	switch k {
+	case schedulev1.LogTargetKind_LOG_TARGET_KIND_USER:
+		return logscope.KindUser, true
+	case schedulev1.LogTargetKind_LOG_TARGET_KIND_REQUEST:
+		return logscope.KindRequest, true
+	}
+	return "", false
+}
+
+func toProtoLogTarget(t logscope.Target) *schedulev1.LogTarget {
+	kind := schedulev1.LogTargetKind_LOG_TARGET_KIND_USER
+	if t.Kind == logscope.KindRequest {
+		kind = schedulev1.LogTargetKind_LOG_TARGET_KIND_REQUEST
+	}
+	return &schedulev1.LogTarget{Kind: kind, Id: t.ID, ExpiresAt: timestamppb.New(t.ExpiresAt)}
+}
+
+// UpdatePastStartPolicy sets a user's override of the server-wide past start
+// policy. UNSPECIFIED clears the override.
+func (s *AdminServer) UpdatePastStartPolicy(ctx context.Context, req *schedulev1.UpdatePastStartPolicyRequest) (*schedulev1.UpdatePastStartPolicyResponse, error) {
+	log := s.log.With(slog.String("rpc", "UpdatePastStartPolicy"))
+
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request is required")
+	}
+	policy, ok := fromProtoPastStartPolicy(req.Policy)
+	if !ok {
+		return nil, status.Error(codes.InvalidArgument, "invalid policy")
+	}
+
+	settings, effective, err := s.pastStart.UpdatePastStartPolicy(ctx, req.UserId, policy)
+	if err != nil {
+		return nil, s.blackoutError(log, "past start policy update", err)
+	}
+
+	log.Info(
+		"past start policy updated",
+		slog.String("user_id", settings.UserID),
+		slog.String("policy", string(settings.PastStartPolicy)),
+		slog.String("effective_policy", string(effective)),
+	)
+	return &schedulev1.UpdatePastStartPolicyResponse{
+		UserId:          settings.UserID,
+		Policy:          toProtoPastStartPolicy(settings.PastStartPolicy),
+		EffectivePolicy: toProtoPastStartPolicy(effective),
+	}, nil
+}
+
+// UpdateRetentionPolicy sets a user's override of the server-wide retention.
+// use_server_default clears the override; retention_days 0 keeps the user's
+// appointments forever.
+func (s *AdminServer) UpdateRetentionPolicy(ctx context.Context, req *schedulev1.UpdateRetentionPolicyRequest) (*schedulev1.UpdateRetentionPolicyResponse, error) {
+	log := s.log.With(slog.String("rpc", "UpdateRetentionPolicy"))
+
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request is required")
+	}
+	if req.UseServerDefault && req.RetentionDays != 0 {
+		return nil, status.Error(codes.InvalidArgument, "retention_days must be unset with use_server_default")
+	}
+	var days *int
+	if !req.UseServerDefault {
+		d := int(req.RetentionDays)
+		days = &d
+	}
+
+	settings, effective, err := s.retention.UpdateRetentionPolicy(ctx, req.UserId, days)
+	if err != nil {
+		return nil, s.blackoutError(log, "retention policy update", err)
+	}
+
+	resp := &schedulev1.UpdateRetentionPolicyResponse{
+		UserId:                 settings.UserID,
+		UsesServerDefault:      settings.RetentionDays == nil,
+		EffectiveRetentionDays: uint32(effective),
+	}
+	if settings.RetentionDays != nil {
+		resp.RetentionDays = uint32(*settings.RetentionDays)
+	}
+	log.Info(
+		"retention policy updated",
+		slog.String("user_id", settings.UserID),
+		slog.Bool("uses_server_default", resp.UsesServerDefault),
+		slog.Int("retention_days", int(resp.RetentionDays)),
+		slog.Int("effective_retention_days", effective),
+	)
+	return resp, nil
+}
+
+// UpdateRequestPolicy sets a user's request timeout and limits multiplier.
+// An unset request_timeout or a zero limits_multiplier returns that part to
+// the server's default. The timeout only ever lengthens a method's own.
+func (s *AdminServer) UpdateRequestPolicy(ctx context.Context, req *schedulev1.UpdateRequestPolicyRequest) (*schedulev1.UpdateRequestPolicyResponse, error) {
+	log := s.log.With(slog.String("rpc", "UpdateRequestPolicy"))
+
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request is required")
+	}
+	var timeout *time.Duration
+	if req.RequestTimeout != nil {
+		if err := req.RequestTimeout.CheckValid(); err != nil {
+			return nil, status.Error(codes.InvalidArgument, "request_timeout is invalid")
+		}
+		d := req.RequestTimeout.AsDuration()
+		timeout = &d
+	}
+	var multiplier *int
+	if req.LimitsMultiplier != 0 {
+		m := int(req.LimitsMultiplier)
+		multiplier = &m
+	}
+
+	settings, policy, err := s.policies.UpdateRequestPolicy(ctx, req.UserId, timeout, multiplier)
+	if err != nil {
+		return nil, s.blackoutError(log, "request policy update", err)
+	}
+
+	resp := &schedulev1.UpdateRequestPolicyResponse{
+		UserId:             settings.UserID,
+		MaxTitleLength:     uint32(policy.Limits.MaxTitleLength),
+		MaxNotesLength:     uint32(policy.Limits.MaxNotesLength),
+		MaxMetadataEntries: uint32(policy.Limits.MaxMetadataEntries),
+	}
+	if policy.Timeout > 0 {
+		resp.RequestTimeout = durationpb.New(policy.Timeout)
+	}
+	if settings.LimitsMultiplier != nil {
+		resp.LimitsMultiplier = uint32(*settings.LimitsMultiplier)
+	}
+	log.Info(
+		"request policy updated",
+		slog.String("user_id", settings.UserID),
+		slog.Duration("request_timeout", policy.Timeout),
+		slog.Int("limits_multiplier", int(resp.LimitsMultiplier)),
+	)
+	return resp, nil
+}
+
+// UpdateSourceDefaults replaces the title prefixes and notes footers added
+// to a user's new appointments by source. An empty list clears them.
+func (s *AdminServer) UpdateSourceDefaults(ctx context.Context, req *schedulev1.UpdateSourceDefaultsRequest) (*schedulev1.UpdateSourceDefaultsResponse, error) {
+	log := s.log.With(slog.String("rpc", "UpdateSourceDefaults"))
+
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request is required")
+	}
+	defaults := make([]domain.SourceDefault, 0, len(req.Defaults))
+	for _, d := range req.Defaults {
+		defaults = append(defaults, domain.SourceDefault{Source: d.GetSource(), TitlePrefix: d.GetTitlePrefix(), NotesFooter: d.GetNotesFooter()})
+	}
+
+	settings, err := s.pastStart.UpdateSourceDefaults(ctx, req.UserId, defaults)
+	if err != nil {
+		return nil, s.blackoutError(log, "source defaults update", err)
+	}
+
+	resp := &schedulev1.UpdateSourceDefaultsResponse{UserId: settings.UserID}
+	sources := make([]string, 0, len(settings.SourceDefaults))
+	for _, d := range settings.SourceDefaults {
+		resp.Defaults = append(resp.Defaults, &schedulev1.SourceDefault{Source: d.Source, TitlePrefix: d.TitlePrefix, NotesFooter: d.NotesFooter})
+		sources = append(sources, d.Source)
+	}
+	log.Info("source defaults updated", slog.String("user_id", settings.UserID), slog.Any("sources", sources))
+	return resp, nil
+}
+
+// ProvisionUser creates or replaces a user as an identity system sees them.
+// Provisioning with deactivated set blocks the user's new bookings; their
+// existing appointments are kept.
+func (s *AdminServer) ProvisionUser(ctx context.Context, req *schedulev1.ProvisionUserRequest) (*schedulev1.ProvisionUserResponse, error) {
+	log := s.log.With(slog.String("rpc", "ProvisionUser"))
+
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request is required")
+	}
+
+	user, err := s.users.ProvisionUser(ctx, appointments.ProvisionUserInput{
+		UserID:      req.UserId,
+		DisplayName: req.DisplayName,
+		Email:       req.Email,
+		ExternalID:  req.ExternalId,
+		Active:      !req.Deactivated,
+	})
+	if err != nil {
+		return nil, s.blackoutError(log, "user provision", err)
+	}
+
+	log.Info("user provisioned", slog.String("user_id", user.UserID), slog.Bool("active", user.Active))
+	return &schedulev1.ProvisionUserResponse{User: toProtoProvisionedUser(user)}, nil
+}
+
+// DeactivateUser freezes a user's calendar until ReactivateUser: reads keep
+// working and every write fails with FailedPrecondition. Nothing is deleted.
+func (s *AdminServer) DeactivateUser(ctx context.Context, req *schedulev1.DeactivateUserRequest) (*schedulev1.DeactivateUserResponse, error) {
+	log := s.log.With(slog.String("rpc", "DeactivateUser"))
+
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request is required")
+	}
+
+	user, err := s.users.DeactivateUser(ctx, req.UserId)
+	if err != nil {
+		return nil, s.blackoutError(log, "user deactivate", err)
+	}
+
+	log.Info("user deactivated", slog.String("user_id", user.UserID), slog.Time("deactivated_at", *user.DeactivatedAt))
+	return &schedulev1.DeactivateUserResponse{User: toProtoProvisionedUser(user)}, nil
+}
+
+func (s *AdminServer) ReactivateUser(ctx context.Context, req *schedulev1.ReactivateUserRequest) (*schedulev1.ReactivateUserResponse, error) {
+	log := s.log.With(slog.String("rpc", "ReactivateUser"))
+
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request is required")
+	}
+
+	user, err := s.users.ReactivateUser(ctx, req.UserId)
+	if err != nil {
+		if errors.Is(err, store.ErrNotFound) {
+			log.Info("user not provisioned", slog.String("user_id", req.UserId))
+			return nil, status.Error(codes.NotFound, "user not provisioned")
+		}
+		return nil, s.blackoutError(log, "user reactivate", err)
+	}
+
+	log.Info("user reactivated", slog.String("user_id", user.UserID))
+	return &schedulev1.ReactivateUserResponse{User: toProtoProvisionedUser(user)}, nil
+}
+
+func (s *AdminServer) ListProvisionedUsers(ctx context.Context, req *schedulev1.ListProvisionedUsersRequest) (*schedulev1.ListProvisionedUsersResponse, error) {
+	log := s.log.With(slog.String("rpc", "ListProvisionedUsers"))
+
+	users, err := s.users.ListProvisionedUsers(ctx)
+	if err != nil {
+		return nil, s.blackoutError(log, "provisioned user list", err)
+	}
+
+	resp := &schedulev1.ListProvisionedUsersResponse{Users: make([]*schedulev1.ProvisionedUser, 0, len(users))}
+	for _, u := range users {
+		resp.Users = append(resp.Users, toProtoProvisionedUser(u))
+	}
+	return resp, nil
+}
+
+func (s *AdminServer) CreateUserGroup(ctx context.Context, req *schedulev1.CreateUserGroupRequest) (*schedulev1.CreateUserGroupResponse, error) {
+	log := s.log.With(slog.String("rpc", "CreateUserGroup"))
+
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request is required")
+	}
+
+	group, err := s.users.CreateUserGroup(ctx, req.Name, req.ExternalId)
+	if err != nil {
+		return nil, s.blackoutError(log, "user group create", err)
+	}
+
+	log.Info("user group created", slog.String("group_id", group.ID.String()))
+	return &schedulev1.CreateUserGroupResponse{Group: toProtoUserGroup(group)}, nil
+}
+
+// SetUserGroupMembers replaces a group's members with member_ids.
+func (s *AdminServer) SetUserGroupMembers(ctx context.Context, req *schedulev1.SetUserGroupMembersRequest) (*schedulev1.SetUserGroupMembersResponse, error) {
+	log := s.log.With(slog.String("rpc", "SetUserGroupMembers"))
+
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request is required")
+	}
+	groupID, err := uuid.Parse(req.GroupId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid group_id")
+	}
+
+	group, err := s.users.SetUserGroupMembers(ctx, groupID, req.MemberIds)
+	if err != nil {
+		if errors.Is(err, store.ErrNotFound) {
+			log.Info("user group not found", slog.String("group_id", groupID.String()))
+			return nil, status.Error(codes.NotFound, "user group not found")
+		}
+		return nil, s.blackoutError(log, "user group members update", err)
+	}
+
+	log.Info("user group members updated", slog.String("group_id", group.ID.String()), slog.Int("members", len(group.Members)))
+	return &schedulev1.SetUserGroupMembersResponse{Group: toProtoUserGroup(group)}, nil
+}
+
+func (s *AdminServer) ListUserGroups(ctx context.Context, req *schedulev1.ListUserGroupsRequest) (*schedulev1.ListUserGroupsResponse, error) {
+	log := s.log.With(slog.String("rpc", "ListUserGroups"))
+
+	groups, err := s.users.ListUserGroups(ctx)
+	if err != nil {
+		return nil, s.blackoutError(log, "user group list", err)
+	}
+
+	resp := &schedulev1.ListUserGroupsResponse{Groups: make([]*schedulev1.UserGroup, 0, len(groups))}
+	for _, g := range groups {
+		resp.Groups = append(resp.Groups, toProtoUserGroup(g))
+	}
+	return resp, nil
+}
+
+func toProtoProvisionedUser(u domain.ProvisionedUser) *schedulev1.ProvisionedUser {
+	out := &schedulev1.ProvisionedUser{
+		UserId:      u.UserID,
+		DisplayName: u.DisplayName,
+		Email:       u.Email,
+		ExternalId:  u.ExternalID,
+		Active:      u.Active,
+		CreatedAt:   timestamppb.New(u.CreatedAt),
+		UpdatedAt:   timestamppb.New(u.UpdatedAt),
+	}
+	if u.DeactivatedAt != nil {
+		out.DeactivatedAt = timestamppb.New(*u.DeactivatedAt)
+	}
+	return out
+}
+
+func toProtoUserGroup(g domain.UserGroup) *schedulev1.UserGroup {
+	return &schedulev1.UserGroup{
+		Id:         g.ID.String(),
+		Name:       g.Name,
+		ExternalId: g.ExternalID,
+		MemberIds:  g.Members,
+		CreatedAt:  timestamppb.New(g.CreatedAt),
+		UpdatedAt:  timestamppb.New(g.UpdatedAt),
+	}
+}
+
+// PurgeExpiredAppointments runs the retention purge now, as the background
+// job does. A dry run only counts what would be deleted. Every user purged
+// is logged, which is the purge's audit trail.
+func (s *AdminServer) PurgeExpiredAppointments(ctx context.Context, req *schedulev1.PurgeExpiredAppointmentsRequest) (*schedulev1.PurgeExpiredAppointmentsResponse, error) {
+	log := s.log.With(slog.String("rpc", "PurgeExpiredAppointments"))
+
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request is required")
+	}
+
+	purged, err := s.retention.PurgeExpiredAppointments(ctx, req.DryRun)
+	LogRetentionPurges(log, purged, req.DryRun)
+	if err != nil {
+		return nil, s.blackoutError(log, "retention purge", err)
+	}
+
+	resp := &schedulev1.PurgeExpiredAppointmentsResponse{DryRun: req.DryRun}
+	for _, p := range purged {
+		resp.Users = append(resp.Users, &schedulev1.RetentionPurge{UserId: p.UserID, Cutoff: timestamppb.New(p.Cutoff), Count: int64(p.Count)})
+		resp.Total += int64(p.Count)
+	}
+	return resp, nil
+}
+
+// LogRetentionPurges writes one audit line per user a retention purge
+// deleted from, or would have in a dry run.
+func LogRetentionPurges(log *slog.Logger, purged []store.RetentionPurge, dryRun bool) {
+	msg := "appointments purged by retention"
+	if dryRun {
+		msg = "appointments past retention (dry run)"
+	}
+	for _, p := range purged {
+		log.Info(msg, slog.String("user_id", p.UserID), slog.Int("count", p.Count), slog.Time("cutoff", p.Cutoff))
+	}
+}
+
+// CreateBackdatedAppointment creates an appointment regardless of the past
+// start policy, for backfills and corrections. The reason is required and
+// logged so the bypass leaves a trail.
+func (s *AdminServer) CreateBackdatedAppointment(ctx context.Context, req *schedulev1.CreateBackdatedAppointmentRequest) (*schedulev1.CreateBackdatedAppointmentResponse, error) {
+	log := s.log.With(slog.String("rpc", "CreateBackdatedAppointment"))
+
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request is required")
+	}
+	if req.StartTime == nil || req.EndTime == nil {
+		return nil, status.Error(codes.InvalidArgument, "start_time and end_time are required")
+	}
+	if strings.TrimSpace(req.Reason) == "" {
+		return nil, status.Error(codes.InvalidArgument, "reason is required")
+	}
+
+	appt, err := s.pastStart.Create(ctx, appointments.CreateInput{
+		UserID:         req.UserId,
+		Title:          req.Title,
+		Notes:          req.Notes,
+		StartTime:      req.StartTime.AsTime(),
+		EndTime:        req.EndTime.AsTime(),
+		Metadata:       req.Metadata,
+		TimeZone:       req.TimeZone,
+		AllowPastStart: true,
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, store.ErrConflict):
+			return nil, status.Error(codes.FailedPrecondition, "The user already has an appointment during that time.")
+		case errors.Is(err, appointments.ErrBlackout):
+			return nil, status.Error(codes.FailedPrecondition, "That time falls within a blackout period.")
+		case errors.Is(err, appointments.ErrTimeOff):
+			return nil, status.Error(codes.FailedPrecondition, "The user is away at that time.")
+		}
+		return nil, s.blackoutError(log, "backdated appointment create", err)
+	}
+
+	log.Info(
+		"backdated appointment created",
+		slog.String("appointment_id", appt.ID.String()),
+		slog.String("user_id", appt.UserID),
+		slog.Time("start_time", appt.StartTime),
+		slog.Time("end_time", appt.EndTime),
+		slog.String("reason", req.Reason),
+	)
+	return &schedulev1.CreateBackdatedAppointmentResponse{
+		AppointmentId: appt.ID.String(),
+		StartTime:     timestamppb.New(appt.StartTime),
+		EndTime:       timestamppb.New(appt.EndTime),
+	}, nil
+}
+
+func (s *AdminServer) blackoutError(log *slog.Logger, op string, err error) error {
+	if errors.Is(err, appointments.ErrProvisioningDisabled) {
+		log.Info(op + " rejected; provisioning disabled")
+		return status.Error(codes.FailedPrecondition, "User provisioning is off because this server runs in standalone identity mode.")
+	}
+	var vErr *appointments.ValidationError
+	if errors.As(err, &vErr) {
+		log.Warn("invalid request", slog.Any("err", err))
+		return status.Error(codes.InvalidArgument, vErr.Error())
+	}
+	if code, msg, ok := writeRefusedError(err); ok {
+		log.Info(op+" refused", slog.Any("err", err))
+		return status.Error(code, msg)
+	}
+	if code, msg, ok := retryableStoreError(err); ok {
+		log.Warn(op+" failed; retryable", slog.Any("err", err))
+		return status.Error(code, msg)
+	}
+	log.Error(op+" failed", slog.Any("err", err))
+	return status.Error(codes.Internal, "internal error")
+}
+
+func fromProtoBlackoutMode(m schedulev1.BlackoutMode) (domain.BlackoutMode, bool) {
+	switch m {
+	case schedulev1.BlackoutMode_BLACKOUT_MODE_UNSPECIFIED:
+		return "", true
+	case schedulev1.BlackoutMode_BLACKOUT_MODE_BLOCK:
+		return domain.BlackoutModeBlock, true
+	case schedulev1.BlackoutMode_BLACKOUT_MODE_WARN:
+		return domain.BlackoutModeWarn, true
+	}
+	return "", false
+}
+
+func fromProtoPastStartPolicy(p schedulev1.PastStartPolicy) (domain.PastStartPolicy, bool) {
+	switch p {
+	case schedulev1.PastStartPolicy_PAST_START_POLICY_UNSPECIFIED:
+		return "", true
+	case schedulev1.PastStartPolicy_PAST_START_POLICY_ALLOW:
+		return domain.PastStartAllow, true
+	case schedulev1.PastStartPolicy_PAST_START_POLICY_WARN:
+		return domain.PastStartWarn, true
+	case schedulev1.PastStartPolicy_PAST_START_POLICY_REJECT:
+		return domain.PastStartReject, true
+	}
+	return "", false
+}
+
+func toProtoPastStartPolicy(p domain.PastStartPolicy) schedulev1.PastStartPolicy {
+	switch p {
+	case domain.PastStartAllow:
+		return schedulev1.PastStartPolicy_PAST_START_POLICY_ALLOW
+	case domain.PastStartWarn:
+		return schedulev1.PastStartPolicy_PAST_START_POLICY_WARN
+	case domain.PastStartReject:
+		return schedulev1.PastStartPolicy_PAST_START_POLICY_REJECT
+	case "":
+		return schedulev1.PastStartPolicy_PAST_START_POLICY_UNSPECIFIED
+	}
+	return unrepresentableEnum[schedulev1.PastStartPolicy]()
+}
+
+func toProtoBlackout(b domain.Blackout) *schedulev1.Blackout {
+	mode := schedulev1.BlackoutMode_BLACKOUT_MODE_BLOCK
+	if b.Mode == domain.BlackoutModeWarn {
+		mode = schedulev1.BlackoutMode_BLACKOUT_MODE_WARN
+	}
+	return &schedulev1.Blackout{
+		Id:        b.ID.String(),
+		Title:     b.Title,
+		StartTime: timestamppb.New(b.StartTime),
+		EndTime:   timestamppb.New(b.EndTime),
+		Mode:      mode,
+		CreatedAt: timestamppb.New(b.CreatedAt),
+	}
+}
+
+func optionalTimestamp(t *time.Time) *timestamppb.Timestamp {
+	if t == nil {
+		return nil
+	}
+	return timestamppb.New(*t)
+}
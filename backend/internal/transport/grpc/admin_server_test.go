@@ -0,0 +1,467 @@
+package grpc
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"schedula/backend/internal/domain"
+	schedulev1 "schedula/backend/internal/gen/proto/schedula/v1"
+	"schedula/backend/internal/logscope"
+	"schedula/backend/internal/service/appointments"
+	"schedula/backend/internal/sli"
+	"schedula/backend/internal/store"
+	"schedula/backend/internal/usage"
+)
+
+type fakeDiagnostics struct {
+	diag store.DatabaseDiagnostics
+	err  error
+}
+
+func (f fakeDiagnostics) DatabaseDiagnostics(ctx context.Context) (store.DatabaseDiagnostics, error) {
+	return f.diag, f.err
+}
+
+func TestGetDatabaseDiagnostics_MapsStats(t *testing.T) {
+	vacuumed := time.Date(2026, 1, 5, 3, 0, 0, 0, time.UTC)
+	srv := NewAdminServer(fakeDiagnostics{diag: store.DatabaseDiagnostics{
+		Tables: []store.TableStats{{Name: "appointments", TotalBytes: 4096, DeadTupleRatio: 0.1, LastAutovacuum: &vacuumed}},
+		Indexes: []store.IndexStats{{
+			Name:                "appointments_no_overlap",
+			Table:               "appointments",
+			Bytes:               2048,
+			Valid:               true,
+			Ready:               true,
+			ExclusionConstraint: true,
+		}},
+		Pool: store.PoolStats{MaxOpen: 10, Open: 3, InUse: 1, Idle: 2, WaitDuration: time.Second},
+	}}, nil, nil, nil, nil, nil, nil, nil, nil, slog.Default())
+
+	resp, err := srv.GetDatabaseDiagnostics(context.Background(), &schedulev1.GetDatabaseDiagnosticsRequest{})
+	if err != nil {
+		t.Fatalf("GetDatabaseDiagnostics error: %v", err)
+	}
+	if len(resp.Tables) != 1 || resp.Tables[0].LastVacuum != nil || !resp.Tables[0].LastAutovacuum.AsTime().Equal(vacuumed) {
+		t.Fatalf("tables = %+v", resp.Tables)
+	}
+	if len(resp.Indexes) != 1 || !resp.Indexes[0].ExclusionConstraint {
+		t.Fatalf("indexes = %+v", resp.Indexes)
+	}
+	if resp.Pool.MaxOpen != 10 || resp.Pool.WaitDuration.AsDuration() != time.Second {
+		t.Fatalf("pool = %+v", resp.Pool)
+	}
+}
+
+func TestGetDatabaseDiagnostics_MapsErrors(t *testing.T) {
+	tests := []struct {
+		err  error
+		want codes.Code
+	}{
+		{err: fmt.Errorf("%w: boom", store.ErrUnavailable), want: codes.Unavailable},
+		{err: errors.New("boom"), want: codes.Internal},
+	}
+	for _, tt := range tests {
+		srv := NewAdminServer(fakeDiagnostics{err: tt.err}, nil, nil, nil, nil, nil, nil, nil, nil, slog.Default())
+		_, err := srv.GetDatabaseDiagnostics(context.Background(), &schedulev1.GetDatabaseDiagnosticsRequest{})
+		if status.Code(err) != tt.want {
+			t.Fatalf("code = %s, want %s", status.Code(err), tt.want)
+		}
+	}
+}
+
+type fakeBlackouts struct {
+	created appointments.CreateBlackoutInput
+	err     error
+}
+
+func (f *fakeBlackouts) CreateBlackout(ctx context.Context, in appointments.CreateBlackoutInput) (domain.Blackout, error) {
+	f.created = in
+	if f.err != nil {
+		return domain.Blackout{}, f.err
+	}
+	return domain.Blackout{ID: uuid.New(), Title: in.Title, StartTime: in.StartTime, EndTime: in.EndTime, Mode: in.Mode}, nil
+}
+
+func (f *fakeBlackouts) DeleteBlackout(ctx context.Context, blackoutID uuid.UUID) error {
+	return f.err
+}
+
+func (f *fakeBlackouts) ListBlackouts(ctx context.Context, windowStart, windowEnd time.Time) ([]domain.Blackout, error) {
+	return nil, f.err
+}
+
+func TestCreateBlackout_MapsMode(t *testing.T) {
+	fake := &fakeBlackouts{}
+	srv := NewAdminServer(nil, fake, nil, nil, nil, nil, nil, nil, nil, slog.Default())
+	start := time.Date(2026, 12, 24, 0, 0, 0, 0, time.UTC)
+
+	resp, err := srv.CreateBlackout(context.Background(), &schedulev1.CreateBlackoutRequest{
+		Title:     "Holidays",
+		StartTime: timestamppb.New(start),
+		EndTime:   timestamppb.New(start.AddDate(0, 0, 2)),
+		Mode:      schedulev1.BlackoutMode_BLACKOUT_MODE_WARN,
+	})
+	if err != nil {
+		t.Fatalf("CreateBlackout error: %v", err)
+	}
+	if fake.created.Mode != domain.BlackoutModeWarn || resp.Blackout.Mode != schedulev1.BlackoutMode_BLACKOUT_MODE_WARN {
+		t.Fatalf("mode = %q / %v, want warn", fake.created.Mode, resp.Blackout.Mode)
+	}
+
+	_, err = srv.CreateBlackout(context.Background(), &schedulev1.CreateBlackoutRequest{
+		Title:     "Holidays",
+		StartTime: timestamppb.New(start),
+		EndTime:   timestamppb.New(start.AddDate(0, 0, 2)),
+		Mode:      schedulev1.BlackoutMode(99),
+	})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Fatalf("code = %s, want %s", status.Code(err), codes.InvalidArgument)
+	}
+
+	fake.err = store.ErrNotFound
+	_, err = srv.DeleteBlackout(context.Background(), &schedulev1.DeleteBlackoutRequest{BlackoutId: uuid.NewString()})
+	if status.Code(err) != codes.NotFound {
+		t.Fatalf("delete code = %s, want %s", status.Code(err), codes.NotFound)
+	}
+}
+
+type fakePastStart struct {
+	created  appointments.CreateInput
+	policy   domain.PastStartPolicy
+	defaults []domain.SourceDefault
+}
+
+func (f *fakePastStart) Create(ctx context.Context, in appointments.CreateInput) (domain.Appointment, error) {
+	f.created = in
+	return domain.Appointment{ID: uuid.New(), UserID: in.UserID, StartTime: in.StartTime, EndTime: in.EndTime}, nil
+}
+
+func (f *fakePastStart) UpdatePastStartPolicy(ctx context.Context, userID string, p domain.PastStartPolicy) (domain.UserSettings, domain.PastStartPolicy, error) {
+	f.policy = p
+	effective := p
+	if effective == "" {
+		effective = domain.PastStartAllow
+	}
+	return domain.UserSettings{UserID: userID, PastStartPolicy: p}, effective, nil
+}
+
+func (f *fakePastStart) UpdateSourceDefaults(ctx context.Context, userID string, defaults []domain.SourceDefault) (domain.UserSettings, error) {
+	f.defaults = defaults
+	return domain.UserSettings{UserID: userID, SourceDefaults: defaults}, nil
+}
+
+func TestPastStartAdmin_OverridesAndBackfills(t *testing.T) {
+	fake := &fakePastStart{}
+	srv := NewAdminServer(nil, nil, nil, fake, nil, nil, nil, nil, nil, slog.Default())
+
+	resp, err := srv.UpdatePastStartPolicy(context.Background(), &schedulev1.UpdatePastStartPolicyRequest{UserId: "u1"})
+	if err != nil {
+		t.Fatalf("UpdatePastStartPolicy error: %v", err)
+	}
+	if fake.policy != "" || resp.Policy != schedulev1.PastStartPolicy_PAST_START_POLICY_UNSPECIFIED || resp.EffectivePolicy != schedulev1.PastStartPolicy_PAST_START_POLICY_ALLOW {
+		t.Fatalf("cleared override = %q, response %v", fake.policy, resp)
+	}
+	if _, err := srv.UpdatePastStartPolicy(context.Background(), &schedulev1.UpdatePastStartPolicyRequest{UserId: "u1", Policy: schedulev1.PastStartPolicy(99)}); status.Code(err) != codes.InvalidArgument {
+		t.Fatalf("unknown policy code = %s, want %s", status.Code(err), codes.InvalidArgument)
+	}
+
+	start := time.Date(2025, 6, 2, 9, 0, 0, 0, time.UTC)
+	req := &schedulev1.CreateBackdatedAppointmentRequest{
+		UserId:    "u1",
+		Title:     "Missed entry",
+		StartTime: timestamppb.New(start),
+		EndTime:   timestamppb.New(start.Add(time.Hour)),
+	}
+	if _, err := srv.CreateBackdatedAppointment(context.Background(), req); status.Code(err) != codes.InvalidArgument {
+		t.Fatalf("missing reason code = %s, want %s", status.Code(err), codes.InvalidArgument)
+	}
+	req.Reason = "import from paper log"
+	if _, err := srv.CreateBackdatedAppointment(context.Background(), req); err != nil {
+		t.Fatalf("CreateBackdatedAppointment error: %v", err)
+	}
+	if !fake.created.AllowPastStart {
+		t.Fatal("backdated create did not bypass the past start policy")
+	}
+}
+
+func TestUpdateSourceDefaults_MapsDefaults(t *testing.T) {
+	fake := &fakePastStart{}
+	srv := NewAdminServer(nil, nil, nil, fake, nil, nil, nil, nil, nil, slog.Default())
+
+	resp, err := srv.UpdateSourceDefaults(context.Background(), &schedulev1.UpdateSourceDefaultsRequest{
+		UserId: "u1",
+		Defaults: []*schedulev1.SourceDefault{
+			{Source: "booking", TitlePrefix: "[Web] "},
+			{Source: "sync:", NotesFooter: "Synced from another calendar."},
+		},
+	})
+	if err != nil {
+		t.Fatalf("UpdateSourceDefaults error: %v", err)
+	}
+	want := []domain.SourceDefault{{Source: "booking", TitlePrefix: "[Web] "}, {Source: "sync:", NotesFooter: "Synced from another calendar."}}
+	if len(fake.defaults) != len(want) || fake.defaults[0] != want[0] || fake.defaults[1] != want[1] {
+		t.Fatalf("defaults = %+v, want %+v", fake.defaults, want)
+	}
+	if resp.UserId != "u1" || len(resp.Defaults) != 2 || resp.Defaults[0].TitlePrefix != "[Web] " || resp.Defaults[1].NotesFooter == "" {
+		t.Fatalf("resp = %+v", resp)
+	}
+}
+
+type fakeRetention struct {
+	days *int
+}
+
+func (f *fakeRetention) UpdateRetentionPolicy(ctx context.Context, userID string, days *int) (domain.UserSettings, int, error) {
+	f.days = days
+	effective := 730
+	if days != nil {
+		effective = *days
+	}
+	return domain.UserSettings{UserID: userID, RetentionDays: days}, effective, nil
+}
+
+func (f *fakeRetention) PurgeExpiredAppointments(ctx context.Context, dryRun bool) ([]store.RetentionPurge, error) {
+	return []store.RetentionPurge{{UserID: "u1", Count: 3}, {UserID: "u2", Count: 4}}, nil
+}
+
+func TestRetentionAdmin_SetsOverridesAndPurges(t *testing.T) {
+	fake := &fakeRetention{}
+	srv := NewAdminServer(nil, nil, nil, nil, fake, nil, nil, nil, nil, slog.Default())
+
+	resp, err := srv.UpdateRetentionPolicy(context.Background(), &schedulev1.UpdateRetentionPolicyRequest{UserId: "u1"})
+	if err != nil {
+		t.Fatalf("UpdateRetentionPolicy error: %v", err)
+	}
+	if fake.days == nil || *fake.days != 0 || resp.UsesServerDefault || resp.EffectiveRetentionDays != 0 {
+		t.Fatalf("keep forever = %v, response %v", fake.days, resp)
+	}
+	resp, err = srv.UpdateRetentionPolicy(context.Background(), &schedulev1.UpdateRetentionPolicyRequest{UserId: "u1", UseServerDefault: true})
+	if err != nil {
+		t.Fatalf("UpdateRetentionPolicy error: %v", err)
+	}
+	if fake.days != nil || !resp.UsesServerDefault || resp.EffectiveRetentionDays != 730 {
+		t.Fatalf("server default = %v, response %v", fake.days, resp)
+	}
+	if _, err := srv.UpdateRetentionPolicy(context.Background(), &schedulev1.UpdateRetentionPolicyRequest{UserId: "u1", RetentionDays: 30, UseServerDefault: true}); status.Code(err) != codes.InvalidArgument {
+		t.Fatalf("days with server default code = %s, want %s", status.Code(err), codes.InvalidArgument)
+	}
+
+	purge, err := srv.PurgeExpiredAppointments(context.Background(), &schedulev1.PurgeExpiredAppointmentsRequest{DryRun: true})
+	if err != nil {
+		t.Fatalf("PurgeExpiredAppointments error: %v", err)
+	}
+	if !purge.DryRun || len(purge.Users) != 2 || purge.Total != 7 {
+		t.Fatalf("purge response = %v", purge)
+	}
+}
+
+type fakeUsers struct {
+	provisioned appointments.ProvisionUserInput
+}
+
+func (f *fakeUsers) ProvisionUser(ctx context.Context, in appointments.ProvisionUserInput) (domain.ProvisionedUser, error) {
+	f.provisioned = in
+	return domain.ProvisionedUser{UserID: in.UserID, DisplayName: in.DisplayName, Active: in.Active}, nil
+}
+
+func (f *fakeUsers) DeactivateUser(ctx context.Context, userID string) (domain.ProvisionedUser, error) {
+	at := time.Date(2026, 1, 5, 10, 0, 0, 0, time.UTC)
+	return domain.ProvisionedUser{UserID: userID, DeactivatedAt: &at}, nil
+}
+
+func (f *fakeUsers) ReactivateUser(ctx context.Context, userID string) (domain.ProvisionedUser, error) {
+	return domain.ProvisionedUser{}, store.ErrNotFound
+}
+
+func (f *fakeUsers) ListProvisionedUsers(ctx context.Context) ([]domain.ProvisionedUser, error) {
+	return nil, nil
+}
+
+func (f *fakeUsers) CreateUserGroup(ctx context.Context, name, externalID string) (domain.UserGroup, error) {
+	return domain.UserGroup{}, nil
+}
+
+func (f *fakeUsers) SetUserGroupMembers(ctx context.Context, groupID uuid.UUID, userIDs []string) (domain.UserGroup, error) {
+	return domain.UserGroup{ID: groupID, Members: userIDs}, nil
+}
+
+func (f *fakeUsers) ListUserGroups(ctx context.Context) ([]domain.UserGroup, error) {
+	return nil, nil
+}
+
+func TestProvisioningAdmin_MapsRequests(t *testing.T) {
+	fake := &fakeUsers{}
+	srv := NewAdminServer(nil, nil, nil, nil, nil, nil, nil, nil, fake, slog.Default())
+
+	resp, err := srv.ProvisionUser(context.Background(), &schedulev1.ProvisionUserRequest{UserId: "u1", DisplayName: "Ada"})
+	if err != nil {
+		t.Fatalf("ProvisionUser error: %v", err)
+	}
+	if !fake.provisioned.Active || !resp.User.Active || resp.User.DeactivatedAt != nil {
+		t.Fatalf("provisioned %+v, response %v; want active by default", fake.provisioned, resp)
+	}
+	if _, err := srv.ProvisionUser(context.Background(), &schedulev1.ProvisionUserRequest{UserId: "u1", DisplayName: "Ada", Deactivated: true}); err != nil {
+		t.Fatalf("ProvisionUser error: %v", err)
+	}
+	if fake.provisioned.Active {
+		t.Fatal("deactivated provision left the user active")
+	}
+	deactivated, err := srv.DeactivateUser(context.Background(), &schedulev1.DeactivateUserRequest{UserId: "stranger"})
+	if err != nil {
+		t.Fatalf("DeactivateUser error: %v", err)
+	}
+	if deactivated.User.Active || deactivated.User.DeactivatedAt == nil {
+		t.Fatalf("deactivated = %v", deactivated.User)
+	}
+	if _, err := srv.ReactivateUser(context.Background(), &schedulev1.ReactivateUserRequest{UserId: "stranger"}); status.Code(err) != codes.NotFound {
+		t.Fatalf("reactivate unknown user code = %s, want %s", status.Code(err), codes.NotFound)
+	}
+	if _, err := srv.SetUserGroupMembers(context.Background(), &schedulev1.SetUserGroupMembersRequest{GroupId: "nope"}); status.Code(err) != codes.InvalidArgument {
+		t.Fatalf("invalid group id code = %s, want %s", status.Code(err), codes.InvalidArgument)
+	}
+	groupID := uuid.New()
+	group, err := srv.SetUserGroupMembers(context.Background(), &schedulev1.SetUserGroupMembersRequest{GroupId: groupID.String(), MemberIds: []string{"u1"}})
+	if err != nil {
+		t.Fatalf("SetUserGroupMembers error: %v", err)
+	}
+	if group.Group.Id != groupID.String() || len(group.Group.MemberIds) != 1 {
+		t.Fatalf("group = %v", group.Group)
+	}
+}
+
+func TestGetAPIUsage_ReportsInterceptedCalls(t *testing.T) {
+	tracker := usage.New(time.Hour, time.Minute, 0)
+	intercept := UsageInterceptor(tracker.Record)
+	info := &grpc.UnaryServerInfo{FullMethod: schedulev1.AppointmentsService_CreateAppointment_FullMethodName}
+	fail := func(ctx context.Context, req any) (any, error) {
+		return nil, status.Error(codes.FailedPrecondition, "blocked")
+	}
+	ok := func(ctx context.Context, req any) (any, error) { return nil, nil }
+	_, _ = intercept(context.Background(), &schedulev1.CreateAppointmentRequest{UserId: "u1"}, info, fail)
+	_, _ = intercept(context.Background(), &schedulev1.CreateAppointmentRequest{UserId: "u1"}, info, ok)
+	_, _ = intercept(context.Background(), &schedulev1.CreateAppointmentRequest{UserId: "u2"}, info, ok)
+
+	srv := NewAdminServer(nil, nil, tracker, nil, nil, nil, nil, nil, nil, slog.Default())
+	resp, err := srv.GetAPIUsage(context.Background(), &schedulev1.GetAPIUsageRequest{Limit: 1})
+	if err != nil {
+		t.Fatalf("GetAPIUsage error: %v", err)
+	}
+	if len(resp.Users) != 1 {
+		t.Fatalf("users = %+v, want only the busiest", resp.Users)
+	}
+	u := resp.Users[0]
+	if u.UserId != "u1" || u.Requests != 2 || u.Errors != 1 || len(u.Methods) != 1 || u.Methods[0].Method != "CreateAppointment" {
+		t.Fatalf("usage = %+v", u)
+	}
+	if resp.Window.AsDuration() != time.Hour {
+		t.Fatalf("window = %v, want 1h", resp.Window.AsDuration())
+	}
+
+	_, err = srv.GetAPIUsage(context.Background(), &schedulev1.GetAPIUsageRequest{Limit: MaxUsageUsers + 1})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Fatalf("code = %s, want %s", status.Code(err), codes.InvalidArgument)
+	}
+}
+
+func TestGetServiceHealthSummary_ReportsConflictsAndServerErrors(t *testing.T) {
+	tracker := sli.New(time.Hour, time.Minute)
+	intercept := SLIInterceptor(tracker.Record)
+	info := &grpc.UnaryServerInfo{FullMethod: schedulev1.AppointmentsService_CreateAppointment_FullMethodName}
+	conflict := func(ctx context.Context, req any) (any, error) {
+		markConflict(ctx)
+		return nil, status.Error(codes.FailedPrecondition, "taken")
+	}
+	broken := func(ctx context.Context, req any) (any, error) {
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+	ok := func(ctx context.Context, req any) (any, error) { return nil, nil }
+	_, _ = intercept(context.Background(), &schedulev1.CreateAppointmentRequest{}, info, conflict)
+	_, _ = intercept(context.Background(), &schedulev1.CreateAppointmentRequest{}, info, broken)
+	_, _ = intercept(context.Background(), &schedulev1.CreateAppointmentRequest{}, info, ok)
+	_, _ = intercept(context.Background(), &schedulev1.CreateAppointmentRequest{}, info, ok)
+
+	srv := NewAdminServer(nil, nil, nil, nil, nil, tracker, nil, nil, nil, slog.Default())
+	resp, err := srv.GetServiceHealthSummary(context.Background(), &schedulev1.GetServiceHealthSummaryRequest{})
+	if err != nil {
+		t.Fatalf("GetServiceHealthSummary error: %v", err)
+	}
+	if len(resp.Windows) != 2 || resp.Windows[0].Window.AsDuration() != 5*time.Minute || resp.Windows[1].Window.AsDuration() != time.Hour {
+		t.Fatalf("windows = %v, want the 5m and 1h defaults", resp.Windows)
+	}
+	total := resp.Windows[0].Total
+	if total.Requests != 4 || total.ServerErrors != 1 || total.Availability != 0.75 || total.Conflicts != 1 || total.ConflictRate != 0.25 {
+		t.Fatalf("total = %v", total)
+	}
+	if len(resp.Windows[0].Methods) != 1 || resp.Windows[0].Methods[0].Method != "CreateAppointment" {
+		t.Fatalf("methods = %v", resp.Windows[0].Methods)
+	}
+
+	_, err = srv.GetServiceHealthSummary(context.Background(), &schedulev1.GetServiceHealthSummaryRequest{
+		Windows: []*durationpb.Duration{durationpb.New(2 * time.Hour)},
+	})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Fatalf("code = %s, want %s", status.Code(err), codes.InvalidArgument)
+	}
+}
+
+func TestLogTargets_ScopeInterceptedCalls(t *testing.T) {
+	targets := logscope.NewTargets()
+	var buf bytes.Buffer
+	log := slog.New(logscope.NewHandler(slog.NewTextHandler(&buf, &slog.HandlerOptions{Level: slog.LevelDebug}), slog.LevelInfo, targets))
+	srv := NewAdminServer(nil, nil, nil, nil, nil, nil, targets, nil, nil, log)
+
+	resp, err := srv.SetLogTarget(context.Background(), &schedulev1.SetLogTargetRequest{Kind: schedulev1.LogTargetKind_LOG_TARGET_KIND_USER, Id: "u1"})
+	if err != nil {
+		t.Fatalf("SetLogTarget error: %v", err)
+	}
+	if resp.Target.Id != "u1" || resp.Target.ExpiresAt == nil {
+		t.Fatalf("target = %v", resp.Target)
+	}
+	buf.Reset()
+
+	intercept := LogScopeInterceptor(log)
+	info := &grpc.UnaryServerInfo{FullMethod: schedulev1.AppointmentsService_ListAppointments_FullMethodName}
+	handler := func(ctx context.Context, req any) (any, error) {
+		log.DebugContext(ctx, "handler detail")
+		return nil, nil
+	}
+	_, _ = intercept(context.Background(), &schedulev1.ListAppointmentsRequest{UserId: "u2"}, info, handler)
+	if buf.Len() != 0 {
+		t.Fatalf("untargeted call logged:\n%s", buf.String())
+	}
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(RequestIDHeader, "triage-1"))
+	_, _ = intercept(ctx, &schedulev1.ListAppointmentsRequest{UserId: "u1"}, info, handler)
+	if out := buf.String(); !strings.Contains(out, "handler detail") || !strings.Contains(out, "request_id=triage-1") {
+		t.Fatalf("targeted call log:\n%s", out)
+	}
+
+	list, err := srv.ListLogTargets(context.Background(), &schedulev1.ListLogTargetsRequest{})
+	if err != nil || len(list.Targets) != 1 {
+		t.Fatalf("ListLogTargets = %v, %v", list, err)
+	}
+	if _, err := srv.ClearLogTarget(context.Background(), &schedulev1.ClearLogTargetRequest{Kind: schedulev1.LogTargetKind_LOG_TARGET_KIND_USER, Id: "u1"}); err != nil {
+		t.Fatalf("ClearLogTarget error: %v", err)
+	}
+	_, err = srv.ClearLogTarget(context.Background(), &schedulev1.ClearLogTargetRequest{Kind: schedulev1.LogTargetKind_LOG_TARGET_KIND_USER, Id: "u1"})
+	if status.Code(err) != codes.NotFound {
+		t.Fatalf("code = %s, want %s", status.Code(err), codes.NotFound)
+	}
+	_, err = srv.SetLogTarget(context.Background(), &schedulev1.SetLogTargetRequest{Id: "u1"})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Fatalf("code = %s, want %s", status.Code(err), codes.InvalidArgument)
+	}
+}
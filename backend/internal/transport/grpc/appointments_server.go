@@ -3,18 +3,24 @@ package grpc
 import (
 	"context"
 	"errors"
+	"fmt"
 	"log/slog"
 	"strings"
 	"time"
 
 	"github.com/google/uuid"
+	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"schedula/backend/internal/domain"
 	schedulev1 "schedula/backend/internal/gen/proto/schedula/v1"
+	schedulev2 "schedula/backend/internal/gen/proto/schedula/v2"
+	"schedula/backend/internal/limits"
+	"schedula/backend/internal/scheduling"
 	"schedula/backend/internal/service/appointments"
 	"schedula/backend/internal/store"
 )
@@ -23,15 +29,82 @@ type AppointmentsServer struct {
 	schedulev1.UnimplementedAppointmentsServiceServer
 
 	svc appointmentsService
+	v2  *AppointmentsV2Server
 	log *slog.Logger
 }
 
 type appointmentsService interface {
 	Create(ctx context.Context, in appointments.CreateInput) (domain.Appointment, error)
-	List(ctx context.Context, userID string, windowStart, windowEnd time.Time) ([]domain.Appointment, error)
-	Delete(ctx context.Context, userID string, appointmentID uuid.UUID) error
+	List(ctx context.Context, userID string, windowStart, windowEnd time.Time, filter store.AppointmentFilter) ([]domain.Appointment, error)
+	Delete(ctx context.Context, in appointments.DeleteInput) error
+	PageSnapshot(ctx context.Context, userID string) (int64, error)
+	AppointmentsAddedSince(ctx context.Context, userID string, seq int64) (map[uuid.UUID]bool, error)
+	GetByExternalRef(ctx context.Context, userID string, ref appointments.ExternalRef) (domain.Appointment, error)
 	CreateRecurringSeries(ctx context.Context, in appointments.CreateRecurringSeriesInput) (domain.RecurringSeries, error)
 	ListOccurrences(ctx context.Context, userID string, windowStart, windowEnd time.Time) ([]domain.RecurringOccurrence, error)
+	GetRecurringSeries(ctx context.Context, userID string, seriesID uuid.UUID) (domain.RecurringSeries, error)
+	ListRecurringSeries(ctx context.Context, userID string, weekdays []int16) ([]domain.RecurringSeries, error)
+	MarkAttendance(ctx context.Context, in appointments.MarkAttendanceInput) (domain.OccurrenceAttendance, error)
+	GetAttendanceStats(ctx context.Context, userID string, seriesID uuid.UUID) (domain.AttendanceStats, error)
+	UserAnalytics(ctx context.Context, userID string, windowStart, windowEnd time.Time) (domain.UserAnalytics, error)
+	SuggestEndTime(ctx context.Context, userID string, start time.Time, desired time.Duration, hours *appointments.WorkingHoursInput) (appointments.EndTimeSuggestion, error)
+	ReserveSlot(ctx context.Context, in appointments.ReserveSlotInput) (domain.SlotHold, error)
+	ConfirmHold(ctx context.Context, in appointments.ConfirmHoldInput) (domain.Appointment, error)
+	ReleaseHold(ctx context.Context, userID string, holdID uuid.UUID) error
+	ProposeAppointment(ctx context.Context, in appointments.ProposeAppointmentInput) (domain.AppointmentProposal, error)
+	ListProposals(ctx context.Context, userID string) ([]domain.AppointmentProposal, error)
+	AcceptProposal(ctx context.Context, userID string, proposalID uuid.UUID) (domain.AppointmentProposal, error)
+	DeclineProposal(ctx context.Context, userID string, proposalID uuid.UUID) (domain.AppointmentProposal, error)
+	LinkAppointments(ctx context.Context, userID string, appointmentID, relatedID uuid.UUID, kind domain.AppointmentLinkKind) (domain.AppointmentLink, error)
+	UnlinkAppointments(ctx context.Context, userID string, appointmentID, relatedID uuid.UUID, kind domain.AppointmentLinkKind) error
+	ListRelated(ctx context.Context, userID string, appointmentID uuid.UUID) ([]domain.RelatedAppointment, error)
+	MergeAppointments(ctx context.Context, in appointments.MergeInput) (store.AppointmentMerge, error)
+	BatchGetFreeBusy(ctx context.Context, userIDs []string, windowStart, windowEnd time.Time) ([]appointments.UserFreeBusy, error)
+	SuggestMeetingTimes(ctx context.Context, in appointments.SuggestMeetingTimesInput) ([]scheduling.Suggestion, error)
+	SimulateSchedule(ctx context.Context, in appointments.SimulateScheduleInput) (scheduling.SimulationResult, error)
+	RepairRecurringSeries(ctx context.Context, userID string, seriesID uuid.UUID, apply bool) (appointments.SeriesRepairReport, error)
+	SkipOccurrences(ctx context.Context, in appointments.SkipOccurrencesInput) (appointments.SkipOccurrencesResult, error)
+	UpdateSeriesEnd(ctx context.Context, in appointments.UpdateSeriesEndInput) (domain.RecurringSeries, int, error)
+	ChangeSeriesTimeZone(ctx context.Context, in appointments.ChangeSeriesTimeZoneInput) (appointments.ChangeSeriesTimeZoneResult, error)
+	AuditCalendar(ctx context.Context, userID string, windowStart, windowEnd time.Time) (appointments.CalendarAudit, error)
+	GetDailyAgenda(ctx context.Context, userID, date, timeZone string) (appointments.DailyAgenda, error)
+	GetDaySummaries(ctx context.Context, userID, from, to string) (appointments.DaySummaries, error)
+	GrantDelegation(ctx context.Context, principalID, delegateID string) (domain.DelegationGrant, error)
+	RevokeDelegation(ctx context.Context, principalID, delegateID string) error
+	ListDelegations(ctx context.Context, principalID string) ([]domain.DelegationGrant, error)
+	WatchRecurringSeries(ctx context.Context, in appointments.WatchSeriesInput, send func(appointments.SeriesSnapshot) error) error
+	ListChanges(ctx context.Context, in appointments.ListChangesInput) (appointments.ChangesPage, error)
+	SyncAppointments(ctx context.Context, userID string, windowStart, windowEnd time.Time, filter store.AppointmentFilter, token string) (appointments.AppointmentsSync, error)
+	SyncOccurrences(ctx context.Context, userID string, windowStart, windowEnd time.Time, token string) (appointments.OccurrencesSync, error)
+	ReconcileCalendar(ctx context.Context, in appointments.ReconcileInput) ([]appointments.MutationResult, error)
+	CheckIn(ctx context.Context, in appointments.SessionInput) (domain.Appointment, error)
+	CheckOut(ctx context.Context, in appointments.SessionInput) (domain.Appointment, error)
+	ExportBillableHours(ctx context.Context, in appointments.BillableHoursInput) (appointments.BillableExport, error)
+	CreateContact(ctx context.Context, in appointments.ContactInput) (domain.Contact, error)
+	GetContact(ctx context.Context, userID string, contactID uuid.UUID) (domain.Contact, error)
+	UpdateContact(ctx context.Context, in appointments.ContactInput) (domain.Contact, error)
+	DeleteContact(ctx context.Context, userID string, contactID uuid.UUID) error
+	ListContacts(ctx context.Context, userID string) ([]domain.Contact, error)
+	CreateProgram(ctx context.Context, in appointments.ProgramInput) (domain.Program, error)
+	GetProgram(ctx context.Context, userID string, programID uuid.UUID) (appointments.ProgramOverview, error)
+	ListPrograms(ctx context.Context, userID string) ([]domain.Program, error)
+	CancelProgram(ctx context.Context, userID string, programID uuid.UUID) (store.ProgramCancellation, error)
+	CreateEmbedToken(ctx context.Context, in appointments.EmbedTokenInput) (appointments.EmbedToken, error)
+	CalendarVersion(ctx context.Context, userID string) (int64, error)
+	GetSlotSettings(ctx context.Context, userID string) (domain.UserSettings, error)
+	UpdateSlotSettings(ctx context.Context, in appointments.SlotSettingsInput) (domain.UserSettings, error)
+	UpdateDailyBreaks(ctx context.Context, userID string, breaks []domain.DailyBreak) (domain.UserSettings, error)
+	CreateTimeOff(ctx context.Context, in appointments.TimeOffInput) (domain.TimeOff, error)
+	GetTimeOff(ctx context.Context, userID string, timeOffID uuid.UUID) (domain.TimeOff, error)
+	UpdateTimeOff(ctx context.Context, in appointments.TimeOffInput) (domain.TimeOff, error)
+	DeleteTimeOff(ctx context.Context, userID string, timeOffID uuid.UUID) error
+	ListTimeOff(ctx context.Context, userID string) ([]domain.TimeOff, error)
+	ExportCalendar(ctx context.Context, userID string) ([]byte, error)
+	ImportCalendar(ctx context.Context, in appointments.ImportCalendarInput) (appointments.ImportCalendarResult, error)
+	CreateCalendarSnapshot(ctx context.Context, in appointments.CreateCalendarSnapshotInput) (domain.CalendarSnapshotInfo, error)
+	ListCalendarSnapshots(ctx context.Context, userID string) ([]domain.CalendarSnapshotInfo, error)
+	RestoreCalendarSnapshot(ctx context.Context, userID string, snapshotID uuid.UUID) (appointments.RestoreCalendarResult, error)
+	Limits() limits.Limits
 }
 
 func NewAppointmentsServer(svc appointmentsService, log *slog.Logger) *AppointmentsServer {
@@ -40,6 +113,7 @@ func NewAppointmentsServer(svc appointmentsService, log *slog.Logger) *Appointme
 	}
 	return &AppointmentsServer{
 		svc: svc,
+		v2:  NewAppointmentsV2Server(svc, log),
 		log: log.With(slog.String("component", "grpc.appointments")),
 	}
 }
@@ -51,26 +125,76 @@ func (s *AppointmentsServer) CreateAppointment(ctx context.Context, req *schedul
 		log.Warn("invalid request", slog.String("reason", "nil_request"))
 		return nil, status.Error(codes.InvalidArgument, "request is required")
 	}
-	if req.StartTime == nil || req.EndTime == nil {
+	kind, ok := fromProtoAppointmentKind(req.Kind)
+	if !ok {
+		log.Warn("invalid request", slog.String("reason", "invalid_kind"), slog.String("user_id", req.UserId))
+		return nil, status.Error(codes.InvalidArgument, "invalid kind")
+	}
+	// A milestone may leave end_time out; it ends where it starts.
+	if req.StartTime == nil || (req.EndTime == nil && kind != domain.AppointmentKindMilestone) {
 		log.Warn("invalid request", slog.String("reason", "missing_times"), slog.String("user_id", req.UserId))
 		return nil, status.Error(codes.InvalidArgument, "start_time and end_time are required")
 	}
+	var endTime time.Time
+	if req.EndTime != nil {
+		endTime = req.EndTime.AsTime()
+	}
+
+	var externalRef *appointments.ExternalRef
+	if req.ExternalRef != nil {
+		externalRef = &appointments.ExternalRef{System: req.ExternalRef.System, ID: req.ExternalRef.Id}
+	}
+	contactID, ok := optionalUUID(req.ContactId)
+	if !ok {
+		log.Warn("invalid request", slog.String("reason", "invalid_uuid"), slog.String("user_id", req.UserId))
+		return nil, status.Error(codes.InvalidArgument, "contact_id must be a UUID")
+	}
+	programID, ok := optionalUUID(req.ProgramId)
+	if !ok {
+		log.Warn("invalid request", slog.String("reason", "invalid_uuid"), slog.String("user_id", req.UserId))
+		return nil, status.Error(codes.InvalidArgument, "program_id must be a UUID")
+	}
 
 	appt, err := s.svc.Create(ctx, appointments.CreateInput{
 		UserID:         req.UserId,
 		Title:          req.Title,
 		Notes:          req.Notes,
+		PrivateNotes:   req.PrivateNotes,
 		StartTime:      req.StartTime.AsTime(),
-		EndTime:        req.EndTime.AsTime(),
+		EndTime:        endTime,
 		IdempotencyKey: idempotencyKey(ctx),
+		Metadata:       req.Metadata,
+		ExternalRef:    externalRef,
+		TimeZone:       req.TimeZone,
+		ActorID:        req.ActorId,
+		ContactID:      contactID,
+		ProgramID:      programID,
+		Kind:           kind,
 	})
 	if err != nil {
+		if errors.Is(err, appointments.ErrNotAuthorized) {
+			log.Warn("appointment create not authorized", slog.String("user_id", req.UserId), slog.String("actor_id", req.ActorId))
+			return nil, status.Error(codes.PermissionDenied, "You do not have delegated access to this calendar.")
+		}
+		if errors.Is(err, appointments.ErrBlackout) {
+			log.Info("appointment create blocked by blackout", slog.Any("err", err), slog.String("user_id", req.UserId))
+			return nil, status.Error(codes.FailedPrecondition, "That time falls within a blackout period. Pick a different slot.")
+		}
+		if errors.Is(err, appointments.ErrTimeOff) {
+			log.Info("appointment create blocked by time off", slog.Any("err", err), slog.String("user_id", req.UserId))
+			return nil, status.Error(codes.FailedPrecondition, "The user is away at that time. Pick a different slot.")
+		}
+		if errors.Is(err, appointments.ErrPastStart) {
+			log.Info("appointment create rejected; start in the past", slog.String("user_id", req.UserId), slog.Time("start_time", req.StartTime.AsTime()))
+			return nil, status.Error(codes.FailedPrecondition, "That time has already passed. Pick a future slot.")
+		}
 		if errors.Is(err, store.ErrConflict) {
+			markConflict(ctx)
 			log.Info(
 				"appointment create conflict",
 				slog.String("user_id", req.UserId),
 				slog.Time("start_time", req.StartTime.AsTime()),
-				slog.Time("end_time", req.EndTime.AsTime()),
+				slog.Time("end_time", endTime),
 			)
 			return nil, status.Error(codes.FailedPrecondition, "You already have an appointment during that time. Pick a different slot.")
 		}
@@ -78,11 +202,23 @@ func (s *AppointmentsServer) CreateAppointment(ctx context.Context, req *schedul
 			log.Info("appointment create idempotency conflict", slog.String("user_id", req.UserId))
 			return nil, status.Error(codes.FailedPrecondition, "This request key was already used for a different appointment. Try again.")
 		}
+		if errors.Is(err, store.ErrDuplicate) {
+			log.Info("appointment create duplicate external ref", slog.String("user_id", req.UserId))
+			return nil, status.Error(codes.AlreadyExists, "An appointment with this external reference already exists.")
+		}
 		var vErr *appointments.ValidationError
 		if errors.As(err, &vErr) {
 			log.Warn("invalid request", slog.Any("err", err), slog.String("user_id", req.UserId))
 			return nil, status.Error(codes.InvalidArgument, vErr.Error())
 		}
+		if code, msg, ok := writeRefusedError(err); ok {
+			log.Info("appointment create refused", slog.Any("err", err), slog.String("user_id", req.UserId))
+			return nil, status.Error(code, msg)
+		}
+		if code, msg, ok := retryableStoreError(err); ok {
+			log.Warn("appointment create failed; retryable", slog.Any("err", err), slog.String("user_id", req.UserId))
+			return nil, status.Error(code, msg)
+		}
 		log.Error("appointment create failed", slog.Any("err", err), slog.String("user_id", req.UserId))
 		return nil, status.Error(codes.Internal, "internal error")
 	}
@@ -91,15 +227,47 @@ func (s *AppointmentsServer) CreateAppointment(ctx context.Context, req *schedul
 		"appointment created",
 		slog.String("appointment_id", appt.ID.String()),
 		slog.String("user_id", appt.UserID),
+		slog.String("actor_id", actorOrUser(req.ActorId, appt.UserID)),
 		slog.Time("start_time", appt.StartTime),
 		slog.Time("end_time", appt.EndTime),
 	)
+	logSourceDefault(log, appt)
 
 	return &schedulev1.CreateAppointmentResponse{
-		Appointment: toProtoAppointment(appt),
+		Appointment:      toProtoAppointment(appt),
+		BlackoutWarnings: toProtoBlackoutWarnings(appt.BlackoutWarnings),
+		PastStartWarning: appt.PastStartWarning,
+		Warnings:         toProtoWarnings(appt.Warnings),
 	}, nil
 }
 
+// logSourceDefault records that the owner's source default changed the
+// title or notes of a new appointment. There is no audit table, so this line
+// is the trail.
+func logSourceDefault(log *slog.Logger, appt domain.Appointment) {
+	if appt.SourceDefault == nil {
+		return
+	}
+	log.Info(
+		"source default applied",
+		slog.String("appointment_id", appt.ID.String()),
+		slog.String("user_id", appt.UserID),
+		slog.String("source", appt.Source),
+		slog.String("default_source", appt.SourceDefault.Source),
+		slog.String("title_prefix", appt.SourceDefault.TitlePrefix),
+		slog.Bool("notes_footer", appt.SourceDefault.NotesFooter != ""),
+	)
+}
+
+// actorOrUser returns the actor to log for a write; an empty actor means the
+// user acted for themselves.
+func actorOrUser(actorID, userID string) string {
+	if actorID = strings.TrimSpace(actorID); actorID != "" {
+		return actorID
+	}
+	return userID
+}
+
 func idempotencyKey(ctx context.Context) string {
 	md, ok := metadata.FromIncomingContext(ctx)
 	if !ok {
@@ -126,21 +294,58 @@ func (s *AppointmentsServer) ListAppointments(ctx context.Context, req *schedule
 		log.Warn("invalid request", slog.String("reason", "missing_window"), slog.String("user_id", req.UserId))
 		return nil, status.Error(codes.InvalidArgument, "window_start and window_end are required")
 	}
+	splitLoc, ok := parseSplitTimeZone(req.SplitTimeZone)
+	if !ok {
+		log.Warn("invalid request", slog.String("reason", "invalid_time_zone"), slog.String("user_id", req.UserId))
+		return nil, status.Error(codes.InvalidArgument, "split_time_zone must be an IANA time zone")
+	}
 
-	appts, err := s.svc.List(ctx, req.UserId, req.WindowStart.AsTime(), req.WindowEnd.AsTime())
+	contactID, ok := optionalUUID(req.ContactId)
+	if !ok {
+		log.Warn("invalid request", slog.String("reason", "invalid_uuid"), slog.String("user_id", req.UserId))
+		return nil, status.Error(codes.InvalidArgument, "contact_id must be a UUID")
+	}
+	filter := store.AppointmentFilter{Metadata: req.MetadataFilter, ContactID: contactID, Source: req.Source}
+	var (
+		appts []domain.Appointment
+		sync  appointments.AppointmentsSync
+	)
+	// Read the version first so the list is at least as new as it claims.
+	version, err := s.svc.CalendarVersion(ctx, req.UserId)
+	switch {
+	case err != nil:
+	case req.StartSync || req.SyncToken != "":
+		sync, err = s.svc.SyncAppointments(ctx, req.UserId, req.WindowStart.AsTime(), req.WindowEnd.AsTime(), filter, req.SyncToken)
+		appts = sync.Appointments
+	default:
+		appts, err = s.svc.List(ctx, req.UserId, req.WindowStart.AsTime(), req.WindowEnd.AsTime(), filter)
+	}
 	if err != nil {
 		var vErr *appointments.ValidationError
 		if errors.As(err, &vErr) {
 			log.Warn("invalid request", slog.Any("err", err), slog.String("user_id", req.UserId))
 			return nil, status.Error(codes.InvalidArgument, vErr.Error())
 		}
+		if code, msg, ok := retryableStoreError(err); ok {
+			log.Warn("appointments list failed; retryable", slog.Any("err", err), slog.String("user_id", req.UserId))
+			return nil, status.Error(code, msg)
+		}
 		log.Error("appointments list failed", slog.Any("err", err), slog.String("user_id", req.UserId))
 		return nil, status.Error(codes.Internal, "internal error")
 	}
 
 	out := make([]*schedulev1.Appointment, 0, len(appts))
+	var segments []*schedulev1.DaySegment
+	locs := localTimeZones{}
 	for _, a := range appts {
-		out = append(out, toProtoAppointment(a))
+		pb := toProtoAppointment(a)
+		if req.IncludeLocalTimes {
+			pb.LocalStartTime, pb.LocalEndTime = locs.format(a.StartTime, a.EndTime, a.Timezone)
+		}
+		out = append(out, pb)
+		if splitLoc != nil {
+			segments = append(segments, toProtoDaySegments(a.ID.String(), a.StartTime, a.EndTime, splitLoc)...)
+		}
 	}
 
 	log.Debug(
@@ -151,37 +356,78 @@ func (s *AppointmentsServer) ListAppointments(ctx context.Context, req *schedule
 		slog.Time("window_end", req.WindowEnd.AsTime()),
 	)
 
-	return &schedulev1.ListAppointmentsResponse{Appointments: out}, nil
+	deleted := make([]string, 0, len(sync.DeletedIDs))
+	for _, id := range sync.DeletedIDs {
+		deleted = append(deleted, id.String())
+	}
+	return &schedulev1.ListAppointmentsResponse{
+		Appointments:          out,
+		DaySegments:           segments,
+		NextSyncToken:         sync.NextSyncToken,
+		FullSync:              sync.FullSync,
+		DeletedAppointmentIds: deleted,
+		CalendarVersion:       version,
+	}, nil
 }
 
-func (s *AppointmentsServer) DeleteAppointment(ctx context.Context, req *schedulev1.DeleteAppointmentRequest) (*schedulev1.DeleteAppointmentResponse, error) {
-	log := s.log.With(slog.String("rpc", "DeleteAppointment"))
+func (s *AppointmentsServer) GetAppointmentByExternalRef(ctx context.Context, req *schedulev1.GetAppointmentByExternalRefRequest) (*schedulev1.GetAppointmentByExternalRefResponse, error) {
+	log := s.log.With(slog.String("rpc", "GetAppointmentByExternalRef"))
 
 	if req == nil {
 		log.Warn("invalid request", slog.String("reason", "nil_request"))
 		return nil, status.Error(codes.InvalidArgument, "request is required")
 	}
-	id, err := uuid.Parse(req.AppointmentId)
-	if err != nil {
-		log.Warn("invalid request", slog.String("reason", "invalid_uuid"), slog.String("user_id", req.UserId))
-		return nil, status.Error(codes.InvalidArgument, "appointment_id must be a UUID")
+	if req.ExternalRef == nil {
+		log.Warn("invalid request", slog.String("reason", "missing_external_ref"), slog.String("user_id", req.UserId))
+		return nil, status.Error(codes.InvalidArgument, "external_ref is required")
 	}
 
-	if err := s.svc.Delete(ctx, req.UserId, id); err != nil {
+	appt, err := s.svc.GetByExternalRef(ctx, req.UserId, appointments.ExternalRef{
+		System: req.ExternalRef.System,
+		ID:     req.ExternalRef.Id,
+	})
+	if err != nil {
 		if errors.Is(err, store.ErrNotFound) {
-			log.Info("appointment not found", slog.String("appointment_id", id.String()), slog.String("user_id", req.UserId))
+			log.Info("appointment not found", slog.String("external_system", req.ExternalRef.System), slog.String("user_id", req.UserId))
 			return nil, status.Error(codes.NotFound, "appointment not found")
 		}
 		var vErr *appointments.ValidationError
 		if errors.As(err, &vErr) {
-			log.Warn("invalid request", slog.Any("err", err), slog.String("appointment_id", id.String()), slog.String("user_id", req.UserId))
+			log.Warn("invalid request", slog.Any("err", err), slog.String("user_id", req.UserId))
 			return nil, status.Error(codes.InvalidArgument, vErr.Error())
 		}
-		log.Error("appointment delete failed", slog.Any("err", err), slog.String("appointment_id", id.String()), slog.String("user_id", req.UserId))
+		if code, msg, ok := retryableStoreError(err); ok {
+			log.Warn("appointment lookup failed; retryable", slog.Any("err", err), slog.String("user_id", req.UserId))
+			return nil, status.Error(code, msg)
+		}
+		log.Error("appointment lookup failed", slog.Any("err", err), slog.String("user_id", req.UserId))
 		return nil, status.Error(codes.Internal, "internal error")
 	}
 
-	log.Info("appointment deleted", slog.String("appointment_id", id.String()), slog.String("user_id", req.UserId))
+	log.Debug(
+		"appointment fetched by external ref",
+		slog.String("appointment_id", appt.ID.String()),
+		slog.String("external_system", appt.ExternalSystem),
+		slog.String("user_id", appt.UserID),
+	)
+
+	return &schedulev1.GetAppointmentByExternalRefResponse{Appointment: toProtoAppointment(appt)}, nil
+}
+
+// DeleteAppointment is served by the v2 handler; v1 callers get the same
+// codes and messages without the v2 error details.
+func (s *AppointmentsServer) DeleteAppointment(ctx context.Context, req *schedulev1.DeleteAppointmentRequest) (*schedulev1.DeleteAppointmentResponse, error) {
+	if req == nil {
+		s.log.Warn("invalid request", slog.String("rpc", "DeleteAppointment"), slog.String("reason", "nil_request"))
+		return nil, status.Error(codes.InvalidArgument, "request is required")
+	}
+	if _, err := s.v2.DeleteAppointment(ctx, &schedulev2.DeleteAppointmentRequest{
+		UserId:        req.UserId,
+		AppointmentId: req.AppointmentId,
+		ActorId:       req.ActorId,
+	}); err != nil {
+		return nil, v1Error(err)
+	}
 	return &schedulev1.DeleteAppointmentResponse{}, nil
 }
 
@@ -192,14 +438,20 @@ func (s *AppointmentsServer) CreateRecurringSeries(ctx context.Context, req *sch
 		log.Warn("invalid request", slog.String("reason", "nil_request"))
 		return nil, status.Error(codes.InvalidArgument, "request is required")
 	}
-	if req.StartTime == nil || req.EndTime == nil {
-		log.Warn("invalid request", slog.String("reason", "missing_times"), slog.String("user_id", req.UserId))
-		return nil, status.Error(codes.InvalidArgument, "start_time and end_time are required")
+	endTime, reason, msg := seriesEndTime(req)
+	if reason != "" {
+		log.Warn("invalid request", slog.String("reason", reason), slog.String("user_id", req.UserId))
+		return nil, status.Error(codes.InvalidArgument, msg)
 	}
 	if req.Weekly == nil {
 		log.Warn("invalid request", slog.String("reason", "missing_weekly"), slog.String("user_id", req.UserId))
 		return nil, status.Error(codes.InvalidArgument, "weekly is required")
 	}
+	programID, ok := optionalUUID(req.ProgramId)
+	if !ok {
+		log.Warn("invalid request", slog.String("reason", "invalid_uuid"), slog.String("user_id", req.UserId))
+		return nil, status.Error(codes.InvalidArgument, "program_id must be a UUID")
+	}
 
 	var until *time.Time
 	if req.Weekly.Until != nil {
@@ -220,13 +472,17 @@ func (s *AppointmentsServer) CreateRecurringSeries(ctx context.Context, req *sch
 		}
 		weekdays = append(weekdays, int16(wd))
 	}
+	weekdayTimes := make([]domain.WeekdayTime, 0, len(req.Weekly.WeekdayTimes))
+	for _, wt := range req.Weekly.WeekdayTimes {
+		weekdayTimes = append(weekdayTimes, domain.WeekdayTime{Weekday: int16(wt.Weekday), StartMinute: int(wt.StartMinute)})
+	}
 
 	series, err := s.svc.CreateRecurringSeries(ctx, appointments.CreateRecurringSeriesInput{
 		UserID:    req.UserId,
 		Title:     req.Title,
 		Notes:     req.Notes,
 		StartTime: req.StartTime.AsTime(),
-		EndTime:   req.EndTime.AsTime(),
+		EndTime:   endTime,
 		Rule: appointments.RecurrenceRuleInput{
 			Frequency: domain.RecurrenceFrequencyWeekly,
 			Interval:  int(req.Weekly.Interval),
@@ -234,15 +490,37 @@ func (s *AppointmentsServer) CreateRecurringSeries(ctx context.Context, req *sch
 			Until:     until,
 			Count:     count,
 			TimeZone:  req.Weekly.TimeZone,
+			WeekStart: int16(req.Weekly.WeekStart),
+
+			DSTGap:       fromProtoDSTGap(req.Weekly.DstGapPolicy),
+			DSTAmbiguous: fromProtoDSTAmbiguous(req.Weekly.DstAmbiguousPolicy),
+			WeekdayTimes: weekdayTimes,
 		},
+		Metadata:      req.Metadata,
+		ProgramID:     programID,
+		SkipConflicts: req.SkipConflicts,
+		ActorID:       req.ActorId,
 	})
 	if err != nil {
+		if errors.Is(err, appointments.ErrNotAuthorized) {
+			log.Warn("recurring series create not authorized", slog.String("user_id", req.UserId), slog.String("actor_id", req.ActorId))
+			return nil, status.Error(codes.PermissionDenied, "You do not have delegated access to this calendar.")
+		}
+		if errors.Is(err, appointments.ErrBlackout) {
+			log.Info("recurring series create blocked by blackout", slog.Any("err", err), slog.String("user_id", req.UserId))
+			return nil, status.Error(codes.FailedPrecondition, "An occurrence falls within a blackout period. Pick a different schedule.")
+		}
+		if errors.Is(err, appointments.ErrSeriesLimit) {
+			log.Info("recurring series create over limit", slog.Any("err", err), slog.String("user_id", req.UserId))
+			return nil, status.Error(codes.ResourceExhausted, "You have too many recurring series with occurrences ahead. End or delete some before adding more.")
+		}
 		if errors.Is(err, store.ErrConflict) {
+			markConflict(ctx)
 			log.Info(
 				"recurring series create conflict",
 				slog.String("user_id", req.UserId),
 				slog.Time("start_time", req.StartTime.AsTime()),
-				slog.Time("end_time", req.EndTime.AsTime()),
+				slog.Time("end_time", endTime),
 			)
 			return nil, status.Error(codes.FailedPrecondition, "You already have an appointment during that time. Pick a different slot.")
 		}
@@ -251,6 +529,14 @@ func (s *AppointmentsServer) CreateRecurringSeries(ctx context.Context, req *sch
 			log.Warn("invalid request", slog.Any("err", err), slog.String("user_id", req.UserId))
 			return nil, status.Error(codes.InvalidArgument, vErr.Error())
 		}
+		if code, msg, ok := writeRefusedError(err); ok {
+			log.Info("recurring series create refused", slog.Any("err", err), slog.String("user_id", req.UserId))
+			return nil, status.Error(code, msg)
+		}
+		if code, msg, ok := retryableStoreError(err); ok {
+			log.Warn("recurring series create failed; retryable", slog.Any("err", err), slog.String("user_id", req.UserId))
+			return nil, status.Error(code, msg)
+		}
 		log.Error("recurring series create failed", slog.Any("err", err), slog.String("user_id", req.UserId))
 		return nil, status.Error(codes.Internal, "internal error")
 	}
@@ -259,10 +545,102 @@ func (s *AppointmentsServer) CreateRecurringSeries(ctx context.Context, req *sch
 		"recurring series created",
 		slog.String("series_id", series.ID.String()),
 		slog.String("user_id", series.UserID),
+		slog.String("actor_id", actorOrUser(req.ActorId, series.UserID)),
 		slog.Time("dtstart", series.DTStart),
+		slog.Int("skipped", len(series.SkippedOccurrences)),
+	)
+
+	skipped := make([]*timestamppb.Timestamp, 0, len(series.SkippedOccurrences))
+	for _, t := range series.SkippedOccurrences {
+		skipped = append(skipped, timestamppb.New(t))
+	}
+
+	return &schedulev1.CreateRecurringSeriesResponse{
+		Series:             toProtoRecurringSeries(series),
+		SkippedOccurrences: skipped,
+		BlackoutWarnings:   toProtoBlackoutWarnings(series.BlackoutWarnings),
+		Warnings:           toProtoWarnings(series.Warnings),
+	}, nil
+}
+
+func (s *AppointmentsServer) GetRecurringSeries(ctx context.Context, req *schedulev1.GetRecurringSeriesRequest) (*schedulev1.GetRecurringSeriesResponse, error) {
+	log := s.log.With(slog.String("rpc", "GetRecurringSeries"))
+
+	if req == nil {
+		log.Warn("invalid request", slog.String("reason", "nil_request"))
+		return nil, status.Error(codes.InvalidArgument, "request is required")
+	}
+	id, err := uuid.Parse(req.SeriesId)
+	if err != nil {
+		log.Warn("invalid request", slog.String("reason", "invalid_uuid"), slog.String("user_id", req.UserId))
+		return nil, status.Error(codes.InvalidArgument, "series_id must be a UUID")
+	}
+
+	series, err := s.svc.GetRecurringSeries(ctx, req.UserId, id)
+	if err != nil {
+		if errors.Is(err, store.ErrNotFound) {
+			log.Info("recurring series not found", slog.String("series_id", id.String()), slog.String("user_id", req.UserId))
+			return nil, status.Error(codes.NotFound, "recurring series not found")
+		}
+		var vErr *appointments.ValidationError
+		if errors.As(err, &vErr) {
+			log.Warn("invalid request", slog.Any("err", err), slog.String("series_id", id.String()), slog.String("user_id", req.UserId))
+			return nil, status.Error(codes.InvalidArgument, vErr.Error())
+		}
+		if code, msg, ok := retryableStoreError(err); ok {
+			log.Warn("recurring series get failed; retryable", slog.Any("err", err), slog.String("series_id", id.String()), slog.String("user_id", req.UserId))
+			return nil, status.Error(code, msg)
+		}
+		log.Error("recurring series get failed", slog.Any("err", err), slog.String("series_id", id.String()), slog.String("user_id", req.UserId))
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+
+	log.Debug(
+		"recurring series fetched",
+		slog.String("series_id", series.ID.String()),
+		slog.String("user_id", series.UserID),
+		slog.Int("occurrences_remaining", series.OccurrencesRemaining),
 	)
 
-	return &schedulev1.CreateRecurringSeriesResponse{Series: toProtoRecurringSeries(series)}, nil
+	return &schedulev1.GetRecurringSeriesResponse{Series: toProtoRecurringSeries(series)}, nil
+}
+
+func (s *AppointmentsServer) ListRecurringSeries(ctx context.Context, req *schedulev1.ListRecurringSeriesRequest) (*schedulev1.ListRecurringSeriesResponse, error) {
+	log := s.log.With(slog.String("rpc", "ListRecurringSeries"))
+
+	if req == nil {
+		log.Warn("invalid request", slog.String("reason", "nil_request"))
+		return nil, status.Error(codes.InvalidArgument, "request is required")
+	}
+	weekdays := make([]int16, 0, len(req.Weekdays))
+	for _, wd := range req.Weekdays {
+		if wd == schedulev1.Weekday_WEEKDAY_UNSPECIFIED {
+			continue
+		}
+		weekdays = append(weekdays, int16(wd))
+	}
+
+	series, err := s.svc.ListRecurringSeries(ctx, req.UserId, weekdays)
+	if err != nil {
+		var vErr *appointments.ValidationError
+		if errors.As(err, &vErr) {
+			log.Warn("invalid request", slog.Any("err", err), slog.String("user_id", req.UserId))
+			return nil, status.Error(codes.InvalidArgument, vErr.Error())
+		}
+		if code, msg, ok := retryableStoreError(err); ok {
+			log.Warn("recurring series list failed; retryable", slog.Any("err", err), slog.String("user_id", req.UserId))
+			return nil, status.Error(code, msg)
+		}
+		log.Error("recurring series list failed", slog.Any("err", err), slog.String("user_id", req.UserId))
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+
+	out := make([]*schedulev1.RecurringSeries, 0, len(series))
+	for _, sr := range series {
+		out = append(out, toProtoRecurringSeries(sr))
+	}
+	log.Debug("recurring series listed", slog.String("user_id", req.UserId), slog.Int("count", len(out)))
+	return &schedulev1.ListRecurringSeriesResponse{Series: out}, nil
 }
 
 func (s *AppointmentsServer) ListOccurrences(ctx context.Context, req *schedulev1.ListOccurrencesRequest) (*schedulev1.ListOccurrencesResponse, error) {
@@ -276,21 +654,56 @@ func (s *AppointmentsServer) ListOccurrences(ctx context.Context, req *schedulev
 		log.Warn("invalid request", slog.String("reason", "missing_window"), slog.String("user_id", req.UserId))
 		return nil, status.Error(codes.InvalidArgument, "window_start and window_end are required")
 	}
+	splitLoc, ok := parseSplitTimeZone(req.SplitTimeZone)
+	if !ok {
+		log.Warn("invalid request", slog.String("reason", "invalid_time_zone"), slog.String("user_id", req.UserId))
+		return nil, status.Error(codes.InvalidArgument, "split_time_zone must be an IANA time zone")
+	}
+	windowEnd, truncated, err := appointments.CapOccurrenceWindow(req.WindowStart.AsTime(), req.WindowEnd.AsTime(), req.MaxHorizon.AsDuration())
+	if err != nil {
+		log.Warn("invalid request", slog.Any("err", err), slog.String("user_id", req.UserId))
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
 
-	occs, err := s.svc.ListOccurrences(ctx, req.UserId, req.WindowStart.AsTime(), req.WindowEnd.AsTime())
+	var (
+		occs []domain.RecurringOccurrence
+		sync appointments.OccurrencesSync
+	)
+	version, err := s.svc.CalendarVersion(ctx, req.UserId)
+	switch {
+	case err != nil:
+	case req.StartSync || req.SyncToken != "":
+		sync, err = s.svc.SyncOccurrences(ctx, req.UserId, req.WindowStart.AsTime(), windowEnd, req.SyncToken)
+		occs = sync.Occurrences
+	default:
+		occs, err = s.svc.ListOccurrences(ctx, req.UserId, req.WindowStart.AsTime(), windowEnd)
+	}
 	if err != nil {
 		var vErr *appointments.ValidationError
 		if errors.As(err, &vErr) {
 			log.Warn("invalid request", slog.Any("err", err), slog.String("user_id", req.UserId))
 			return nil, status.Error(codes.InvalidArgument, vErr.Error())
 		}
+		if code, msg, ok := retryableStoreError(err); ok {
+			log.Warn("occurrences list failed; retryable", slog.Any("err", err), slog.String("user_id", req.UserId))
+			return nil, status.Error(code, msg)
+		}
 		log.Error("occurrences list failed", slog.Any("err", err), slog.String("user_id", req.UserId))
 		return nil, status.Error(codes.Internal, "internal error")
 	}
 
 	out := make([]*schedulev1.Occurrence, 0, len(occs))
+	var segments []*schedulev1.DaySegment
+	locs := localTimeZones{}
 	for _, o := range occs {
-		out = append(out, toProtoOccurrence(o))
+		pb := toProtoOccurrence(o)
+		if req.IncludeLocalTimes {
+			pb.LocalStartTime, pb.LocalEndTime = locs.format(o.StartTime, o.EndTime, o.Timezone)
+		}
+		out = append(out, pb)
+		if splitLoc != nil {
+			segments = append(segments, toProtoDaySegments(o.ID, o.StartTime, o.EndTime, splitLoc)...)
+		}
 	}
 
 	log.Debug(
@@ -298,77 +711,3270 @@ func (s *AppointmentsServer) ListOccurrences(ctx context.Context, req *schedulev
 		slog.String("user_id", req.UserId),
 		slog.Int("count", len(out)),
 		slog.Time("window_start", req.WindowStart.AsTime()),
-		slog.Time("window_end", req.WindowEnd.AsTime()),
+		slog.Time("window_end", windowEnd),
+		slog.Bool("truncated", truncated),
 	)
 
-	return &schedulev1.ListOccurrencesResponse{Occurrences: out}, nil
+	changed := make([]string, 0, len(sync.ChangedSeriesIDs))
+	for _, id := range sync.ChangedSeriesIDs {
+		changed = append(changed, id.String())
+	}
+	return &schedulev1.ListOccurrencesResponse{
+		Occurrences:      out,
+		DaySegments:      segments,
+		NextSyncToken:    sync.NextSyncToken,
+		FullSync:         sync.FullSync,
+		ChangedSeriesIds: changed,
+		CalendarVersion:  version,
+		Truncated:        truncated,
+		ExpandedUntil:    timestamppb.New(windowEnd),
+	}, nil
 }
 
-func toProtoAppointment(a domain.Appointment) *schedulev1.Appointment {
-	return &schedulev1.Appointment{
-		Id:        a.ID.String(),
-		UserId:    a.UserID,
-		Title:     a.Title,
-		Notes:     a.Notes,
-		StartTime: timestamppb.New(a.StartTime),
-		EndTime:   timestamppb.New(a.EndTime),
-		CreatedAt: timestamppb.New(a.CreatedAt),
-		UpdatedAt: timestamppb.New(a.UpdatedAt),
+func (s *AppointmentsServer) MarkAttendance(ctx context.Context, req *schedulev1.MarkAttendanceRequest) (*schedulev1.MarkAttendanceResponse, error) {
+	log := s.log.With(slog.String("rpc", "MarkAttendance"))
+
+	if req == nil {
+		log.Warn("invalid request", slog.String("reason", "nil_request"))
+		return nil, status.Error(codes.InvalidArgument, "request is required")
+	}
+	id, err := uuid.Parse(req.SeriesId)
+	if err != nil {
+		log.Warn("invalid request", slog.String("reason", "invalid_uuid"), slog.String("user_id", req.UserId))
+		return nil, status.Error(codes.InvalidArgument, "series_id must be a UUID")
 	}
-}
 
-func toProtoRecurringSeries(s domain.RecurringSeries) *schedulev1.RecurringSeries {
-	duration := time.Duration(s.DurationSeconds) * time.Second
+	var attendanceStatus domain.AttendanceStatus
+	switch req.Status {
+	case schedulev1.AttendanceStatus_ATTENDANCE_STATUS_ATTENDED:
+		attendanceStatus = domain.AttendanceStatusAttended
+	case schedulev1.AttendanceStatus_ATTENDANCE_STATUS_MISSED:
+		attendanceStatus = domain.AttendanceStatusMissed
+	default:
+		log.Warn("invalid request", slog.String("reason", "missing_status"), slog.String("user_id", req.UserId))
+		return nil, status.Error(codes.InvalidArgument, "status is required")
+	}
 
-	return &schedulev1.RecurringSeries{
-		Id:        s.ID.String(),
-		UserId:    s.UserID,
-		Title:     s.Title,
-		Notes:     s.Notes,
-		StartTime: timestamppb.New(s.DTStart),
-		EndTime:   timestamppb.New(s.DTStart.Add(duration)),
-		Weekly:    toProtoWeeklyRecurrence(s),
-		CreatedAt: timestamppb.New(s.CreatedAt),
-		UpdatedAt: timestamppb.New(s.UpdatedAt),
+	attendance, err := s.svc.MarkAttendance(ctx, appointments.MarkAttendanceInput{
+		UserID:        req.UserId,
+		SeriesID:      id,
+		OccurrenceID:  req.OccurrenceId,
+		ParticipantID: req.ParticipantId,
+		Status:        attendanceStatus,
+	})
+	if err != nil {
+		if errors.Is(err, store.ErrNotFound) {
+			log.Info("occurrence not found", slog.String("series_id", id.String()), slog.String("occurrence_id", req.OccurrenceId), slog.String("user_id", req.UserId))
+			return nil, status.Error(codes.NotFound, "occurrence not found")
+		}
+		var vErr *appointments.ValidationError
+		if errors.As(err, &vErr) {
+			log.Warn("invalid request", slog.Any("err", err), slog.String("series_id", id.String()), slog.String("user_id", req.UserId))
+			return nil, status.Error(codes.InvalidArgument, vErr.Error())
+		}
+		if code, msg, ok := writeRefusedError(err); ok {
+			log.Info("attendance mark refused", slog.Any("err", err), slog.String("series_id", id.String()), slog.String("user_id", req.UserId))
+			return nil, status.Error(code, msg)
+		}
+		if code, msg, ok := retryableStoreError(err); ok {
+			log.Warn("attendance mark failed; retryable", slog.Any("err", err), slog.String("series_id", id.String()), slog.String("user_id", req.UserId))
+			return nil, status.Error(code, msg)
+		}
+		log.Error("attendance mark failed", slog.Any("err", err), slog.String("series_id", id.String()), slog.String("user_id", req.UserId))
+		return nil, status.Error(codes.Internal, "internal error")
 	}
+
+	log.Info(
+		"attendance marked",
+		slog.String("series_id", id.String()),
+		slog.String("occurrence_id", req.OccurrenceId),
+		slog.String("participant_id", attendance.ParticipantID),
+		slog.String("status", string(attendance.Status)),
+		slog.String("user_id", req.UserId),
+	)
+
+	return &schedulev1.MarkAttendanceResponse{Attendance: toProtoAttendance(attendance)}, nil
 }
 
-func toProtoWeeklyRecurrence(s domain.RecurringSeries) *schedulev1.WeeklyRecurrence {
-	weekdays := make([]schedulev1.Weekday, 0, len(s.ByWeekday))
-	for _, wd := range s.ByWeekday {
-		if wd < 1 || wd > 7 {
-			continue
+func (s *AppointmentsServer) GetAttendanceStats(ctx context.Context, req *schedulev1.GetAttendanceStatsRequest) (*schedulev1.GetAttendanceStatsResponse, error) {
+	log := s.log.With(slog.String("rpc", "GetAttendanceStats"))
+
+	if req == nil {
+		log.Warn("invalid request", slog.String("reason", "nil_request"))
+		return nil, status.Error(codes.InvalidArgument, "request is required")
+	}
+	id, err := uuid.Parse(req.SeriesId)
+	if err != nil {
+		log.Warn("invalid request", slog.String("reason", "invalid_uuid"), slog.String("user_id", req.UserId))
+		return nil, status.Error(codes.InvalidArgument, "series_id must be a UUID")
+	}
+
+	stats, err := s.svc.GetAttendanceStats(ctx, req.UserId, id)
+	if err != nil {
+		if errors.Is(err, store.ErrNotFound) {
+			log.Info("recurring series not found", slog.String("series_id", id.String()), slog.String("user_id", req.UserId))
+			return nil, status.Error(codes.NotFound, "recurring series not found")
+		}
+		var vErr *appointments.ValidationError
+		if errors.As(err, &vErr) {
+			log.Warn("invalid request", slog.Any("err", err), slog.String("series_id", id.String()), slog.String("user_id", req.UserId))
+			return nil, status.Error(codes.InvalidArgument, vErr.Error())
 		}
-		weekdays = append(weekdays, schedulev1.Weekday(wd))
+		if code, msg, ok := retryableStoreError(err); ok {
+			log.Warn("attendance stats failed; retryable", slog.Any("err", err), slog.String("series_id", id.String()), slog.String("user_id", req.UserId))
+			return nil, status.Error(code, msg)
+		}
+		log.Error("attendance stats failed", slog.Any("err", err), slog.String("series_id", id.String()), slog.String("user_id", req.UserId))
+		return nil, status.Error(codes.Internal, "internal error")
 	}
 
-	var until *timestamppb.Timestamp
-	if s.Until != nil {
-		until = timestamppb.New(s.Until.UTC())
+	out := make([]*schedulev1.ParticipantAttendanceStats, 0, len(stats.Participants))
+	for _, p := range stats.Participants {
+		out = append(out, &schedulev1.ParticipantAttendanceStats{
+			ParticipantId: p.ParticipantID,
+			Attended:      uint32(p.Attended),
+			Missed:        uint32(p.Missed),
+		})
 	}
 
-	var count uint32
-	if s.Count != nil && *s.Count > 0 {
-		count = uint32(*s.Count)
+	log.Debug(
+		"attendance stats fetched",
+		slog.String("series_id", id.String()),
+		slog.String("user_id", req.UserId),
+		slog.Int("participants", len(out)),
+	)
+
+	return &schedulev1.GetAttendanceStatsResponse{
+		Participants:       out,
+		OccurrencesTracked: uint32(stats.OccurrencesTracked),
+	}, nil
+}
+
+func (s *AppointmentsServer) GetAnalytics(ctx context.Context, req *schedulev1.GetAnalyticsRequest) (*schedulev1.GetAnalyticsResponse, error) {
+	log := s.log.With(slog.String("rpc", "GetAnalytics"))
+
+	if req == nil {
+		log.Warn("invalid request", slog.String("reason", "nil_request"))
+		return nil, status.Error(codes.InvalidArgument, "request is required")
+	}
+	if req.WindowStart == nil || req.WindowEnd == nil {
+		log.Warn("invalid request", slog.String("reason", "missing_window"), slog.String("user_id", req.UserId))
+		return nil, status.Error(codes.InvalidArgument, "window_start and window_end are required")
 	}
 
-	return &schedulev1.WeeklyRecurrence{
-		Interval: uint32(s.Interval),
-		Weekdays: weekdays,
-		Until:    until,
-		Count:    count,
-		TimeZone: s.Timezone,
+	stats, err := s.svc.UserAnalytics(ctx, req.UserId, req.WindowStart.AsTime(), req.WindowEnd.AsTime())
+	if err != nil {
+		var vErr *appointments.ValidationError
+		if errors.As(err, &vErr) {
+			log.Warn("invalid request", slog.Any("err", err), slog.String("user_id", req.UserId))
+			return nil, status.Error(codes.InvalidArgument, vErr.Error())
+		}
+		if code, msg, ok := retryableStoreError(err); ok {
+			log.Warn("analytics failed; retryable", slog.Any("err", err), slog.String("user_id", req.UserId))
+			return nil, status.Error(code, msg)
+		}
+		log.Error("analytics failed", slog.Any("err", err), slog.String("user_id", req.UserId))
+		return nil, status.Error(codes.Internal, "internal error")
 	}
+
+	log.Debug(
+		"analytics fetched",
+		slog.String("user_id", req.UserId),
+		slog.Int("appointments", stats.AppointmentCount),
+		slog.Time("window_start", req.WindowStart.AsTime()),
+		slog.Time("window_end", req.WindowEnd.AsTime()),
+	)
+
+	return &schedulev1.GetAnalyticsResponse{
+		AppointmentCount:   uint32(stats.AppointmentCount),
+		AverageDuration:    durationpb.New(stats.AverageDuration),
+		Attended:           uint32(stats.Attended),
+		Missed:             uint32(stats.Missed),
+		NoShowRate:         stats.NoShowRate(),
+		Sessions:           uint32(stats.Sessions),
+		PlannedSessionTime: durationpb.New(stats.PlannedTime),
+		ActualSessionTime:  durationpb.New(stats.ActualTime),
+	}, nil
 }
 
-func toProtoOccurrence(o domain.RecurringOccurrence) *schedulev1.Occurrence {
-	return &schedulev1.Occurrence{
-		SeriesId:     o.SeriesID.String(),
-		OccurrenceId: o.ID,
-		UserId:       o.UserID,
-		Title:        o.Title,
-		Notes:        o.Notes,
-		StartTime:    timestamppb.New(o.StartTime),
-		EndTime:      timestamppb.New(o.EndTime),
+func (s *AppointmentsServer) SuggestEndTime(ctx context.Context, req *schedulev1.SuggestEndTimeRequest) (*schedulev1.SuggestEndTimeResponse, error) {
+	log := s.log.With(slog.String("rpc", "SuggestEndTime"))
+
+	if req == nil {
+		log.Warn("invalid request", slog.String("reason", "nil_request"))
+		return nil, status.Error(codes.InvalidArgument, "request is required")
+	}
+	if req.StartTime == nil || req.DesiredDuration == nil {
+		log.Warn("invalid request", slog.String("reason", "missing_start_or_duration"), slog.String("user_id", req.UserId))
+		return nil, status.Error(codes.InvalidArgument, "start_time and desired_duration are required")
+	}
+
+	start := req.StartTime.AsTime()
+	suggestion, err := s.svc.SuggestEndTime(ctx, req.UserId, start, req.DesiredDuration.AsDuration(), fromProtoWorkingHours(req.WorkingHours))
+	if err != nil {
+		if errors.Is(err, store.ErrConflict) {
+			log.Info("suggest end time conflict", slog.String("user_id", req.UserId), slog.Time("start_time", start))
+			return nil, status.Error(codes.FailedPrecondition, "You already have an appointment at that time. Pick a different start.")
+		}
+		if errors.Is(err, appointments.ErrOutsideWorkingHours) {
+			log.Info("suggest end time outside working hours", slog.String("user_id", req.UserId), slog.Time("start_time", start))
+			return nil, status.Error(codes.FailedPrecondition, "That start is outside working hours. Pick a start during the working day.")
+		}
+		var vErr *appointments.ValidationError
+		if errors.As(err, &vErr) {
+			log.Warn("invalid request", slog.Any("err", err), slog.String("user_id", req.UserId))
+			return nil, status.Error(codes.InvalidArgument, vErr.Error())
+		}
+		if code, msg, ok := retryableStoreError(err); ok {
+			log.Warn("suggest end time failed; retryable", slog.Any("err", err), slog.String("user_id", req.UserId))
+			return nil, status.Error(code, msg)
+		}
+		log.Error("suggest end time failed", slog.Any("err", err), slog.String("user_id", req.UserId))
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+
+	log.Debug(
+		"end time suggested",
+		slog.String("user_id", req.UserId),
+		slog.Time("start_time", start),
+		slog.Time("end_time", suggestion.EndTime),
+		slog.Bool("shortened", suggestion.Shortened),
+	)
+
+	return &schedulev1.SuggestEndTimeResponse{
+		EndTime:   timestamppb.New(suggestion.EndTime),
+		Duration:  durationpb.New(suggestion.EndTime.Sub(start)),
+		Shortened: suggestion.Shortened,
+	}, nil
+}
+
+func (s *AppointmentsServer) ReserveSlot(ctx context.Context, req *schedulev1.ReserveSlotRequest) (*schedulev1.ReserveSlotResponse, error) {
+	log := s.log.With(slog.String("rpc", "ReserveSlot"))
+
+	if req == nil {
+		log.Warn("invalid request", slog.String("reason", "nil_request"))
+		return nil, status.Error(codes.InvalidArgument, "request is required")
+	}
+	if req.StartTime == nil || req.EndTime == nil {
+		log.Warn("invalid request", slog.String("reason", "missing_times"), slog.String("user_id", req.UserId))
+		return nil, status.Error(codes.InvalidArgument, "start_time and end_time are required")
+	}
+
+	hold, err := s.svc.ReserveSlot(ctx, appointments.ReserveSlotInput{
+		UserID:    req.UserId,
+		StartTime: req.StartTime.AsTime(),
+		EndTime:   req.EndTime.AsTime(),
+		TTL:       req.Ttl.AsDuration(),
+	})
+	if err != nil {
+		if errors.Is(err, appointments.ErrBlackout) {
+			log.Info("slot reserve blocked by blackout", slog.Any("err", err), slog.String("user_id", req.UserId))
+			return nil, status.Error(codes.FailedPrecondition, "That time falls within a blackout period. Pick a different slot.")
+		}
+		if errors.Is(err, appointments.ErrTimeOff) {
+			log.Info("slot reserve blocked by time off", slog.Any("err", err), slog.String("user_id", req.UserId))
+			return nil, status.Error(codes.FailedPrecondition, "The user is away at that time. Pick a different slot.")
+		}
+		if errors.Is(err, store.ErrConflict) {
+			markConflict(ctx)
+			log.Info(
+				"slot reserve conflict",
+				slog.String("user_id", req.UserId),
+				slog.Time("start_time", req.StartTime.AsTime()),
+				slog.Time("end_time", req.EndTime.AsTime()),
+			)
+			return nil, status.Error(codes.FailedPrecondition, "That time is no longer available. Pick a different slot.")
+		}
+		var vErr *appointments.ValidationError
+		if errors.As(err, &vErr) {
+			log.Warn("invalid request", slog.Any("err", err), slog.String("user_id", req.UserId))
+			return nil, status.Error(codes.InvalidArgument, vErr.Error())
+		}
+		if code, msg, ok := writeRefusedError(err); ok {
+			log.Info("slot reserve refused", slog.Any("err", err), slog.String("user_id", req.UserId))
+			return nil, status.Error(code, msg)
+		}
+		if code, msg, ok := retryableStoreError(err); ok {
+			log.Warn("slot reserve failed; retryable", slog.Any("err", err), slog.String("user_id", req.UserId))
+			return nil, status.Error(code, msg)
+		}
+		log.Error("slot reserve failed", slog.Any("err", err), slog.String("user_id", req.UserId))
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+
+	log.Info(
+		"slot reserved",
+		slog.String("hold_id", hold.ID.String()),
+		slog.String("user_id", hold.UserID),
+		slog.Time("expires_at", hold.ExpiresAt),
+	)
+
+	return &schedulev1.ReserveSlotResponse{Hold: toProtoSlotHold(hold)}, nil
+}
+
+func (s *AppointmentsServer) ConfirmHold(ctx context.Context, req *schedulev1.ConfirmHoldRequest) (*schedulev1.ConfirmHoldResponse, error) {
+	log := s.log.With(slog.String("rpc", "ConfirmHold"))
+
+	if req == nil {
+		log.Warn("invalid request", slog.String("reason", "nil_request"))
+		return nil, status.Error(codes.InvalidArgument, "request is required")
+	}
+	id, err := uuid.Parse(req.HoldId)
+	if err != nil {
+		log.Warn("invalid request", slog.String("reason", "invalid_uuid"), slog.String("user_id", req.UserId))
+		return nil, status.Error(codes.InvalidArgument, "hold_id must be a UUID")
+	}
+
+	appt, err := s.svc.ConfirmHold(ctx, appointments.ConfirmHoldInput{
+		UserID:   req.UserId,
+		HoldID:   id,
+		Title:    req.Title,
+		Notes:    req.Notes,
+		Metadata: req.Metadata,
+	})
+	if err != nil {
+		if errors.Is(err, store.ErrNotFound) {
+			log.Info("hold not found", slog.String("hold_id", id.String()), slog.String("user_id", req.UserId))
+			return nil, status.Error(codes.NotFound, "hold not found")
+		}
+		if errors.Is(err, store.ErrConflict) {
+			markConflict(ctx)
+			log.Info("hold confirm conflict", slog.String("hold_id", id.String()), slog.String("user_id", req.UserId))
+			return nil, status.Error(codes.FailedPrecondition, "The hold expired and the slot was taken. Pick a different slot.")
+		}
+		var vErr *appointments.ValidationError
+		if errors.As(err, &vErr) {
+			log.Warn("invalid request", slog.Any("err", err), slog.String("hold_id", id.String()), slog.String("user_id", req.UserId))
+			return nil, status.Error(codes.InvalidArgument, vErr.Error())
+		}
+		if code, msg, ok := writeRefusedError(err); ok {
+			log.Info("hold confirm refused", slog.Any("err", err), slog.String("hold_id", id.String()), slog.String("user_id", req.UserId))
+			return nil, status.Error(code, msg)
+		}
+		if code, msg, ok := retryableStoreError(err); ok {
+			log.Warn("hold confirm failed; retryable", slog.Any("err", err), slog.String("hold_id", id.String()), slog.String("user_id", req.UserId))
+			return nil, status.Error(code, msg)
+		}
+		log.Error("hold confirm failed", slog.Any("err", err), slog.String("hold_id", id.String()), slog.String("user_id", req.UserId))
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+
+	log.Info(
+		"hold confirmed",
+		slog.String("hold_id", id.String()),
+		slog.String("appointment_id", appt.ID.String()),
+		slog.String("user_id", appt.UserID),
+	)
+	logSourceDefault(log, appt)
+
+	return &schedulev1.ConfirmHoldResponse{Appointment: toProtoAppointment(appt), Warnings: toProtoWarnings(appt.Warnings)}, nil
+}
+
+func (s *AppointmentsServer) ReleaseHold(ctx context.Context, req *schedulev1.ReleaseHoldRequest) (*schedulev1.ReleaseHoldResponse, error) {
+	log := s.log.With(slog.String("rpc", "ReleaseHold"))
+
+	if req == nil {
+		log.Warn("invalid request", slog.String("reason", "nil_request"))
+		return nil, status.Error(codes.InvalidArgument, "request is required")
+	}
+	id, err := uuid.Parse(req.HoldId)
+	if err != nil {
+		log.Warn("invalid request", slog.String("reason", "invalid_uuid"), slog.String("user_id", req.UserId))
+		return nil, status.Error(codes.InvalidArgument, "hold_id must be a UUID")
+	}
+
+	if err := s.svc.ReleaseHold(ctx, req.UserId, id); err != nil {
+		var vErr *appointments.ValidationError
+		if errors.As(err, &vErr) {
+			log.Warn("invalid request", slog.Any("err", err), slog.String("hold_id", id.String()), slog.String("user_id", req.UserId))
+			return nil, status.Error(codes.InvalidArgument, vErr.Error())
+		}
+		if code, msg, ok := writeRefusedError(err); ok {
+			log.Info("hold release refused", slog.Any("err", err), slog.String("hold_id", id.String()), slog.String("user_id", req.UserId))
+			return nil, status.Error(code, msg)
+		}
+		if code, msg, ok := retryableStoreError(err); ok {
+			log.Warn("hold release failed; retryable", slog.Any("err", err), slog.String("hold_id", id.String()), slog.String("user_id", req.UserId))
+			return nil, status.Error(code, msg)
+		}
+		log.Error("hold release failed", slog.Any("err", err), slog.String("hold_id", id.String()), slog.String("user_id", req.UserId))
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+
+	log.Info("hold released", slog.String("hold_id", id.String()), slog.String("user_id", req.UserId))
+	return &schedulev1.ReleaseHoldResponse{}, nil
+}
+
+func (s *AppointmentsServer) ProposeAppointment(ctx context.Context, req *schedulev1.ProposeAppointmentRequest) (*schedulev1.ProposeAppointmentResponse, error) {
+	log := s.log.With(slog.String("rpc", "ProposeAppointment"))
+
+	if req == nil {
+		log.Warn("invalid request", slog.String("reason", "nil_request"))
+		return nil, status.Error(codes.InvalidArgument, "request is required")
+	}
+	if req.StartTime == nil || req.EndTime == nil {
+		log.Warn("invalid request", slog.String("reason", "missing_times"), slog.String("user_id", req.ProposerId))
+		return nil, status.Error(codes.InvalidArgument, "start_time and end_time are required")
+	}
+
+	proposal, err := s.svc.ProposeAppointment(ctx, appointments.ProposeAppointmentInput{
+		ProposerID:  req.ProposerId,
+		RecipientID: req.RecipientId,
+		Title:       req.Title,
+		Notes:       req.Notes,
+		StartTime:   req.StartTime.AsTime(),
+		EndTime:     req.EndTime.AsTime(),
+		TTL:         req.Ttl.AsDuration(),
+	})
+	if err != nil {
+		if errors.Is(err, appointments.ErrBlackout) {
+			log.Info("proposal blocked by blackout", slog.Any("err", err), slog.String("user_id", req.ProposerId))
+			return nil, status.Error(codes.FailedPrecondition, "That time falls within a blackout period. Pick a different slot.")
+		}
+		if errors.Is(err, store.ErrConflict) {
+			markConflict(ctx)
+			log.Info("proposal conflict", slog.String("user_id", req.ProposerId), slog.Time("start_time", req.StartTime.AsTime()))
+			return nil, status.Error(codes.FailedPrecondition, "That time is no longer free on your calendar. Pick a different slot.")
+		}
+		var vErr *appointments.ValidationError
+		if errors.As(err, &vErr) {
+			log.Warn("invalid request", slog.Any("err", err), slog.String("user_id", req.ProposerId))
+			return nil, status.Error(codes.InvalidArgument, vErr.Error())
+		}
+		if code, msg, ok := writeRefusedError(err); ok {
+			log.Info("proposal refused", slog.Any("err", err), slog.String("user_id", req.ProposerId))
+			return nil, status.Error(code, msg)
+		}
+		if code, msg, ok := retryableStoreError(err); ok {
+			log.Warn("proposal failed; retryable", slog.Any("err", err), slog.String("user_id", req.ProposerId))
+			return nil, status.Error(code, msg)
+		}
+		log.Error("proposal failed", slog.Any("err", err), slog.String("user_id", req.ProposerId))
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+
+	log.Info(
+		"appointment proposed",
+		slog.String("proposal_id", proposal.ID.String()),
+		slog.String("user_id", proposal.ProposerID),
+		slog.String("recipient_id", proposal.RecipientID),
+		slog.Time("expires_at", proposal.ExpiresAt),
+	)
+	return &schedulev1.ProposeAppointmentResponse{Proposal: toProtoProposal(proposal)}, nil
+}
+
+func (s *AppointmentsServer) ListProposals(ctx context.Context, req *schedulev1.ListProposalsRequest) (*schedulev1.ListProposalsResponse, error) {
+	log := s.log.With(slog.String("rpc", "ListProposals"))
+
+	if req == nil {
+		log.Warn("invalid request", slog.String("reason", "nil_request"))
+		return nil, status.Error(codes.InvalidArgument, "request is required")
+	}
+
+	proposals, err := s.svc.ListProposals(ctx, req.UserId)
+	if err != nil {
+		var vErr *appointments.ValidationError
+		if errors.As(err, &vErr) {
+			log.Warn("invalid request", slog.Any("err", err), slog.String("user_id", req.UserId))
+			return nil, status.Error(codes.InvalidArgument, vErr.Error())
+		}
+		if code, msg, ok := retryableStoreError(err); ok {
+			log.Warn("proposal list failed; retryable", slog.Any("err", err), slog.String("user_id", req.UserId))
+			return nil, status.Error(code, msg)
+		}
+		log.Error("proposal list failed", slog.Any("err", err), slog.String("user_id", req.UserId))
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+
+	out := make([]*schedulev1.AppointmentProposal, 0, len(proposals))
+	for _, p := range proposals {
+		out = append(out, toProtoProposal(p))
+	}
+	return &schedulev1.ListProposalsResponse{Proposals: out}, nil
+}
+
+func (s *AppointmentsServer) AcceptProposal(ctx context.Context, req *schedulev1.AcceptProposalRequest) (*schedulev1.AcceptProposalResponse, error) {
+	log := s.log.With(slog.String("rpc", "AcceptProposal"))
+
+	if req == nil {
+		log.Warn("invalid request", slog.String("reason", "nil_request"))
+		return nil, status.Error(codes.InvalidArgument, "request is required")
+	}
+	id, err := uuid.Parse(req.ProposalId)
+	if err != nil {
+		log.Warn("invalid request", slog.String("reason", "invalid_uuid"), slog.String("user_id", req.UserId))
+		return nil, status.Error(codes.InvalidArgument, "proposal_id must be a UUID")
+	}
+
+	proposal, err := s.svc.AcceptProposal(ctx, req.UserId, id)
+	if err != nil {
+		if st := proposalAnswerError(log, err, "accept", id, req.UserId); st != nil {
+			return nil, st
+		}
+		if errors.Is(err, appointments.ErrNotAuthorized) {
+			log.Info("proposal accept denied; not the recipient", slog.String("proposal_id", id.String()), slog.String("user_id", req.UserId))
+			return nil, status.Error(codes.PermissionDenied, "Only the person a proposal was sent to can accept it.")
+		}
+		if errors.Is(err, store.ErrConflict) {
+			markConflict(ctx)
+			log.Info("proposal accept conflict", slog.String("proposal_id", id.String()), slog.String("user_id", req.UserId))
+			return nil, status.Error(codes.FailedPrecondition, "One of the calendars is no longer free at that time. Decline and propose another time.")
+		}
+		log.Error("proposal accept failed", slog.Any("err", err), slog.String("proposal_id", id.String()), slog.String("user_id", req.UserId))
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+
+	log.Info("proposal accepted", slog.String("proposal_id", id.String()), slog.String("user_id", req.UserId), slog.String("proposer_id", proposal.ProposerID))
+	return &schedulev1.AcceptProposalResponse{Proposal: toProtoProposal(proposal)}, nil
+}
+
+func (s *AppointmentsServer) DeclineProposal(ctx context.Context, req *schedulev1.DeclineProposalRequest) (*schedulev1.DeclineProposalResponse, error) {
+	log := s.log.With(slog.String("rpc", "DeclineProposal"))
+
+	if req == nil {
+		log.Warn("invalid request", slog.String("reason", "nil_request"))
+		return nil, status.Error(codes.InvalidArgument, "request is required")
+	}
+	id, err := uuid.Parse(req.ProposalId)
+	if err != nil {
+		log.Warn("invalid request", slog.String("reason", "invalid_uuid"), slog.String("user_id", req.UserId))
+		return nil, status.Error(codes.InvalidArgument, "proposal_id must be a UUID")
+	}
+
+	proposal, err := s.svc.DeclineProposal(ctx, req.UserId, id)
+	if err != nil {
+		if st := proposalAnswerError(log, err, "decline", id, req.UserId); st != nil {
+			return nil, st
+		}
+		log.Error("proposal decline failed", slog.Any("err", err), slog.String("proposal_id", id.String()), slog.String("user_id", req.UserId))
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+
+	log.Info("proposal declined", slog.String("proposal_id", id.String()), slog.String("user_id", req.UserId))
+	return &schedulev1.DeclineProposalResponse{Proposal: toProtoProposal(proposal)}, nil
+}
+
+// proposalAnswerError maps the errors accepting and declining share to a
+// status error. It returns nil for errors the caller maps itself.
+func proposalAnswerError(log *slog.Logger, err error, action string, id uuid.UUID, userID string) error {
+	if errors.Is(err, store.ErrNotFound) {
+		log.Info("proposal not found", slog.String("proposal_id", id.String()), slog.String("user_id", userID))
+		return status.Error(codes.NotFound, "proposal not found")
+	}
+	if errors.Is(err, appointments.ErrProposalClosed) {
+		log.Info("proposal "+action+" after close", slog.String("proposal_id", id.String()), slog.String("user_id", userID))
+		return status.Error(codes.FailedPrecondition, "This proposal was already answered or has expired.")
+	}
+	var vErr *appointments.ValidationError
+	if errors.As(err, &vErr) {
+		log.Warn("invalid request", slog.Any("err", err), slog.String("proposal_id", id.String()), slog.String("user_id", userID))
+		return status.Error(codes.InvalidArgument, vErr.Error())
+	}
+	if code, msg, ok := writeRefusedError(err); ok {
+		log.Info("proposal "+action+" refused", slog.Any("err", err), slog.String("proposal_id", id.String()), slog.String("user_id", userID))
+		return status.Error(code, msg)
+	}
+	if code, msg, ok := retryableStoreError(err); ok {
+		log.Warn("proposal "+action+" failed; retryable", slog.Any("err", err), slog.String("proposal_id", id.String()), slog.String("user_id", userID))
+		return status.Error(code, msg)
+	}
+	return nil
+}
+
+func (s *AppointmentsServer) LinkAppointments(ctx context.Context, req *schedulev1.LinkAppointmentsRequest) (*schedulev1.LinkAppointmentsResponse, error) {
+	log := s.log.With(slog.String("rpc", "LinkAppointments"))
+
+	if req == nil {
+		log.Warn("invalid request", slog.String("reason", "nil_request"))
+		return nil, status.Error(codes.InvalidArgument, "request is required")
+	}
+	id, relatedID, err := parseLinkIDs(req.AppointmentId, req.RelatedAppointmentId)
+	if err != nil {
+		log.Warn("invalid request", slog.String("reason", "invalid_uuid"), slog.String("user_id", req.UserId))
+		return nil, err
+	}
+	kind, ok := fromProtoLinkKind(req.Kind)
+	if !ok {
+		log.Warn("invalid request", slog.String("reason", "missing_kind"), slog.String("user_id", req.UserId))
+		return nil, status.Error(codes.InvalidArgument, "kind is required")
+	}
+
+	link, err := s.svc.LinkAppointments(ctx, req.UserId, id, relatedID, kind)
+	if err != nil {
+		if errors.Is(err, store.ErrNotFound) {
+			log.Info("appointment not found", slog.String("appointment_id", id.String()), slog.String("related_appointment_id", relatedID.String()), slog.String("user_id", req.UserId))
+			return nil, status.Error(codes.NotFound, "appointment not found")
+		}
+		var vErr *appointments.ValidationError
+		if errors.As(err, &vErr) {
+			log.Warn("invalid request", slog.Any("err", err), slog.String("appointment_id", id.String()), slog.String("user_id", req.UserId))
+			return nil, status.Error(codes.InvalidArgument, vErr.Error())
+		}
+		if code, msg, ok := writeRefusedError(err); ok {
+			log.Info("appointment link refused", slog.Any("err", err), slog.String("appointment_id", id.String()), slog.String("user_id", req.UserId))
+			return nil, status.Error(code, msg)
+		}
+		if code, msg, ok := retryableStoreError(err); ok {
+			log.Warn("appointment link failed; retryable", slog.Any("err", err), slog.String("appointment_id", id.String()), slog.String("user_id", req.UserId))
+			return nil, status.Error(code, msg)
+		}
+		log.Error("appointment link failed", slog.Any("err", err), slog.String("appointment_id", id.String()), slog.String("user_id", req.UserId))
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+
+	log.Info(
+		"appointments linked",
+		slog.String("appointment_id", id.String()),
+		slog.String("related_appointment_id", relatedID.String()),
+		slog.String("kind", string(kind)),
+		slog.String("user_id", req.UserId),
+	)
+
+	return &schedulev1.LinkAppointmentsResponse{Link: toProtoLink(link)}, nil
+}
+
+func (s *AppointmentsServer) UnlinkAppointments(ctx context.Context, req *schedulev1.UnlinkAppointmentsRequest) (*schedulev1.UnlinkAppointmentsResponse, error) {
+	log := s.log.With(slog.String("rpc", "UnlinkAppointments"))
+
+	if req == nil {
+		log.Warn("invalid request", slog.String("reason", "nil_request"))
+		return nil, status.Error(codes.InvalidArgument, "request is required")
+	}
+	id, relatedID, err := parseLinkIDs(req.AppointmentId, req.RelatedAppointmentId)
+	if err != nil {
+		log.Warn("invalid request", slog.String("reason", "invalid_uuid"), slog.String("user_id", req.UserId))
+		return nil, err
+	}
+	kind, ok := fromProtoLinkKind(req.Kind)
+	if !ok {
+		log.Warn("invalid request", slog.String("reason", "missing_kind"), slog.String("user_id", req.UserId))
+		return nil, status.Error(codes.InvalidArgument, "kind is required")
+	}
+
+	if err := s.svc.UnlinkAppointments(ctx, req.UserId, id, relatedID, kind); err != nil {
+		if errors.Is(err, store.ErrNotFound) {
+			log.Info("appointment link not found", slog.String("appointment_id", id.String()), slog.String("related_appointment_id", relatedID.String()), slog.String("user_id", req.UserId))
+			return nil, status.Error(codes.NotFound, "appointment link not found")
+		}
+		var vErr *appointments.ValidationError
+		if errors.As(err, &vErr) {
+			log.Warn("invalid request", slog.Any("err", err), slog.String("appointment_id", id.String()), slog.String("user_id", req.UserId))
+			return nil, status.Error(codes.InvalidArgument, vErr.Error())
+		}
+		if code, msg, ok := writeRefusedError(err); ok {
+			log.Info("appointment unlink refused", slog.Any("err", err), slog.String("appointment_id", id.String()), slog.String("user_id", req.UserId))
+			return nil, status.Error(code, msg)
+		}
+		if code, msg, ok := retryableStoreError(err); ok {
+			log.Warn("appointment unlink failed; retryable", slog.Any("err", err), slog.String("appointment_id", id.String()), slog.String("user_id", req.UserId))
+			return nil, status.Error(code, msg)
+		}
+		log.Error("appointment unlink failed", slog.Any("err", err), slog.String("appointment_id", id.String()), slog.String("user_id", req.UserId))
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+
+	log.Info(
+		"appointments unlinked",
+		slog.String("appointment_id", id.String()),
+		slog.String("related_appointment_id", relatedID.String()),
+		slog.String("kind", string(kind)),
+		slog.String("user_id", req.UserId),
+	)
+	return &schedulev1.UnlinkAppointmentsResponse{}, nil
+}
+
+func (s *AppointmentsServer) ListRelated(ctx context.Context, req *schedulev1.ListRelatedRequest) (*schedulev1.ListRelatedResponse, error) {
+	log := s.log.With(slog.String("rpc", "ListRelated"))
+
+	if req == nil {
+		log.Warn("invalid request", slog.String("reason", "nil_request"))
+		return nil, status.Error(codes.InvalidArgument, "request is required")
+	}
+	id, err := uuid.Parse(req.AppointmentId)
+	if err != nil {
+		log.Warn("invalid request", slog.String("reason", "invalid_uuid"), slog.String("user_id", req.UserId))
+		return nil, status.Error(codes.InvalidArgument, "appointment_id must be a UUID")
+	}
+
+	related, err := s.svc.ListRelated(ctx, req.UserId, id)
+	if err != nil {
+		var vErr *appointments.ValidationError
+		if errors.As(err, &vErr) {
+			log.Warn("invalid request", slog.Any("err", err), slog.String("appointment_id", id.String()), slog.String("user_id", req.UserId))
+			return nil, status.Error(codes.InvalidArgument, vErr.Error())
+		}
+		if code, msg, ok := retryableStoreError(err); ok {
+			log.Warn("related list failed; retryable", slog.Any("err", err), slog.String("appointment_id", id.String()), slog.String("user_id", req.UserId))
+			return nil, status.Error(code, msg)
+		}
+		log.Error("related list failed", slog.Any("err", err), slog.String("appointment_id", id.String()), slog.String("user_id", req.UserId))
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+
+	out := make([]*schedulev1.RelatedAppointment, 0, len(related))
+	for _, r := range related {
+		out = append(out, &schedulev1.RelatedAppointment{
+			Link:        toProtoLink(r.Link),
+			Appointment: toProtoAppointment(r.Appointment),
+		})
+	}
+
+	log.Debug(
+		"related appointments listed",
+		slog.String("appointment_id", id.String()),
+		slog.String("user_id", req.UserId),
+		slog.Int("count", len(out)),
+	)
+
+	return &schedulev1.ListRelatedResponse{Related: out}, nil
+}
+
+func (s *AppointmentsServer) MergeAppointments(ctx context.Context, req *schedulev1.MergeAppointmentsRequest) (*schedulev1.MergeAppointmentsResponse, error) {
+	log := s.log.With(slog.String("rpc", "MergeAppointments"))
+
+	if req == nil {
+		log.Warn("invalid request", slog.String("reason", "nil_request"))
+		return nil, status.Error(codes.InvalidArgument, "request is required")
+	}
+	primaryID, err := uuid.Parse(req.PrimaryId)
+	if err != nil {
+		log.Warn("invalid request", slog.String("reason", "invalid_uuid"), slog.String("user_id", req.UserId))
+		return nil, status.Error(codes.InvalidArgument, "primary_id must be a UUID")
+	}
+	duplicateIDs := make([]uuid.UUID, 0, len(req.DuplicateIds))
+	for _, raw := range req.DuplicateIds {
+		id, err := uuid.Parse(raw)
+		if err != nil {
+			log.Warn("invalid request", slog.String("reason", "invalid_uuid"), slog.String("user_id", req.UserId))
+			return nil, status.Error(codes.InvalidArgument, "duplicate_ids must be UUIDs")
+		}
+		duplicateIDs = append(duplicateIDs, id)
+	}
+
+	out, err := s.svc.MergeAppointments(ctx, appointments.MergeInput{
+		UserID:          req.UserId,
+		PrimaryID:       primaryID,
+		DuplicateIDs:    duplicateIDs,
+		CoverDuplicates: req.CoverDuplicates,
+	})
+	if err != nil {
+		if errors.Is(err, store.ErrNotFound) {
+			log.Info("appointment not found", slog.String("primary_id", primaryID.String()), slog.String("user_id", req.UserId))
+			return nil, status.Error(codes.NotFound, "appointment not found")
+		}
+		if errors.Is(err, store.ErrConflict) {
+			markConflict(ctx)
+			log.Info("appointment merge conflict", slog.String("primary_id", primaryID.String()), slog.String("user_id", req.UserId))
+			return nil, status.Error(codes.FailedPrecondition, "The merged appointment would overlap another booking. Merge without covering the duplicates, or move the other booking first.")
+		}
+		var vErr *appointments.ValidationError
+		if errors.As(err, &vErr) {
+			log.Warn("invalid request", slog.Any("err", err), slog.String("primary_id", primaryID.String()), slog.String("user_id", req.UserId))
+			return nil, status.Error(codes.InvalidArgument, vErr.Error())
+		}
+		if code, msg, ok := writeRefusedError(err); ok {
+			log.Info("appointment merge refused", slog.Any("err", err), slog.String("primary_id", primaryID.String()), slog.String("user_id", req.UserId))
+			return nil, status.Error(code, msg)
+		}
+		if code, msg, ok := retryableStoreError(err); ok {
+			log.Warn("appointment merge failed; retryable", slog.Any("err", err), slog.String("primary_id", primaryID.String()), slog.String("user_id", req.UserId))
+			return nil, status.Error(code, msg)
+		}
+		log.Error("appointment merge failed", slog.Any("err", err), slog.String("primary_id", primaryID.String()), slog.String("user_id", req.UserId))
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+
+	deleted := make([]string, 0, len(out.Deleted))
+	for _, id := range out.Deleted {
+		deleted = append(deleted, id.String())
+	}
+	// There is no audit table, so this line is the merge's audit entry.
+	log.Info(
+		"appointments merged",
+		slog.String("primary_id", primaryID.String()),
+		slog.Any("deleted_ids", deleted),
+		slog.Bool("cover_duplicates", req.CoverDuplicates),
+		slog.Int("links_moved", out.LinksMoved),
+		slog.Int("sync_mappings_moved", out.MappingsMoved),
+		slog.String("user_id", req.UserId),
+	)
+	return &schedulev1.MergeAppointmentsResponse{
+		Appointment:       toProtoAppointment(out.Appointment),
+		DeletedIds:        deleted,
+		LinksMoved:        uint32(out.LinksMoved),
+		SyncMappingsMoved: uint32(out.MappingsMoved),
+	}, nil
+}
+
+func (s *AppointmentsServer) BatchGetFreeBusy(ctx context.Context, req *schedulev1.BatchGetFreeBusyRequest) (*schedulev1.BatchGetFreeBusyResponse, error) {
+	log := s.log.With(slog.String("rpc", "BatchGetFreeBusy"))
+
+	if req == nil {
+		log.Warn("invalid request", slog.String("reason", "nil_request"))
+		return nil, status.Error(codes.InvalidArgument, "request is required")
+	}
+	if req.WindowStart == nil || req.WindowEnd == nil {
+		log.Warn("invalid request", slog.String("reason", "missing_window"), slog.Int("users", len(req.UserIds)))
+		return nil, status.Error(codes.InvalidArgument, "window_start and window_end are required")
+	}
+
+	results, err := s.svc.BatchGetFreeBusy(ctx, req.UserIds, req.WindowStart.AsTime(), req.WindowEnd.AsTime())
+	if err != nil {
+		var vErr *appointments.ValidationError
+		if errors.As(err, &vErr) {
+			log.Warn("invalid request", slog.Any("err", err), slog.Int("users", len(req.UserIds)))
+			return nil, status.Error(codes.InvalidArgument, vErr.Error())
+		}
+		if code, msg, ok := retryableStoreError(err); ok {
+			log.Warn("free/busy failed; retryable", slog.Any("err", err), slog.Int("users", len(req.UserIds)))
+			return nil, status.Error(code, msg)
+		}
+		log.Error("free/busy failed", slog.Any("err", err), slog.Int("users", len(req.UserIds)))
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+
+	out := make([]*schedulev1.UserFreeBusy, 0, len(results))
+	failed := 0
+	for _, r := range results {
+		fb := &schedulev1.UserFreeBusy{UserId: r.UserID}
+		if r.Err != nil {
+			failed++
+			code, msg, ok := retryableStoreError(r.Err)
+			if !ok {
+				code, msg = codes.Internal, "internal error"
+				log.Error("free/busy user failed", slog.Any("err", r.Err), slog.String("user_id", r.UserID))
+			} else {
+				log.Warn("free/busy user failed; retryable", slog.Any("err", r.Err), slog.String("user_id", r.UserID))
+			}
+			fb.ErrorCode = code.String()
+			fb.ErrorMessage = msg
+		}
+		for _, b := range r.Busy {
+			fb.Busy = append(fb.Busy, &schedulev1.BusyInterval{
+				StartTime: timestamppb.New(b.Start),
+				EndTime:   timestamppb.New(b.End),
+			})
+		}
+		out = append(out, fb)
+	}
+
+	log.Debug(
+		"free/busy fetched",
+		slog.Int("users", len(out)),
+		slog.Int("failed", failed),
+		slog.Time("window_start", req.WindowStart.AsTime()),
+		slog.Time("window_end", req.WindowEnd.AsTime()),
+	)
+
+	return &schedulev1.BatchGetFreeBusyResponse{Results: out}, nil
+}
+
+func (s *AppointmentsServer) SuggestMeetingTimes(ctx context.Context, req *schedulev1.SuggestMeetingTimesRequest) (*schedulev1.SuggestMeetingTimesResponse, error) {
+	log := s.log.With(slog.String("rpc", "SuggestMeetingTimes"))
+
+	if req == nil {
+		log.Warn("invalid request", slog.String("reason", "nil_request"))
+		return nil, status.Error(codes.InvalidArgument, "request is required")
+	}
+	if req.WindowStart == nil || req.WindowEnd == nil {
+		log.Warn("invalid request", slog.String("reason", "missing_window"), slog.Int("attendees", len(req.Attendees)))
+		return nil, status.Error(codes.InvalidArgument, "window_start and window_end are required")
+	}
+	if req.Duration == nil {
+		log.Warn("invalid request", slog.String("reason", "missing_duration"), slog.Int("attendees", len(req.Attendees)))
+		return nil, status.Error(codes.InvalidArgument, "duration is required")
+	}
+
+	in := appointments.SuggestMeetingTimesInput{
+		Duration:    req.Duration.AsDuration(),
+		WindowStart: req.WindowStart.AsTime(),
+		WindowEnd:   req.WindowEnd.AsTime(),
+		Step:        req.Step.AsDuration(),
+		MaxResults:  int(req.MaxResults),
+	}
+	for _, a := range req.Attendees {
+		if a == nil {
+			continue
+		}
+		attendee := appointments.MeetingAttendeeInput{UserID: a.UserId, WorkingHours: fromProtoWorkingHours(a.WorkingHours)}
+		for _, p := range a.Preferred {
+			if p == nil || p.StartTime == nil || p.EndTime == nil {
+				continue
+			}
+			attendee.Preferred = append(attendee.Preferred, domain.BusyInterval{Start: p.StartTime.AsTime(), End: p.EndTime.AsTime()})
+		}
+		in.Attendees = append(in.Attendees, attendee)
+	}
+
+	suggestions, err := s.svc.SuggestMeetingTimes(ctx, in)
+	if err != nil {
+		var vErr *appointments.ValidationError
+		if errors.As(err, &vErr) {
+			log.Warn("invalid request", slog.Any("err", err), slog.Int("attendees", len(in.Attendees)))
+			return nil, status.Error(codes.InvalidArgument, vErr.Error())
+		}
+		if code, msg, ok := retryableStoreError(err); ok {
+			log.Warn("meeting suggestions failed; retryable", slog.Any("err", err), slog.Int("attendees", len(in.Attendees)))
+			return nil, status.Error(code, msg)
+		}
+		log.Error("meeting suggestions failed", slog.Any("err", err), slog.Int("attendees", len(in.Attendees)))
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+
+	out := make([]*schedulev1.MeetingSuggestion, 0, len(suggestions))
+	for _, sg := range suggestions {
+		out = append(out, &schedulev1.MeetingSuggestion{
+			StartTime:           timestamppb.New(sg.Start),
+			EndTime:             timestamppb.New(sg.End),
+			Score:               sg.Score,
+			OutsideWorkingHours: sg.OutsideHours,
+		})
+	}
+
+	log.Debug(
+		"meeting times suggested",
+		slog.Int("attendees", len(in.Attendees)),
+		slog.Int("count", len(out)),
+		slog.Time("window_start", in.WindowStart),
+		slog.Time("window_end", in.WindowEnd),
+	)
+
+	return &schedulev1.SuggestMeetingTimesResponse{Suggestions: out}, nil
+}
+
+func (s *AppointmentsServer) SimulateSchedule(ctx context.Context, req *schedulev1.SimulateScheduleRequest) (*schedulev1.SimulateScheduleResponse, error) {
+	log := s.log.With(slog.String("rpc", "SimulateSchedule"))
+
+	if req == nil {
+		log.Warn("invalid request", slog.String("reason", "nil_request"))
+		return nil, status.Error(codes.InvalidArgument, "request is required")
+	}
+	if req.WindowStart == nil || req.WindowEnd == nil {
+		log.Warn("invalid request", slog.String("reason", "missing_window"), slog.Int("staff", len(req.Staff)))
+		return nil, status.Error(codes.InvalidArgument, "window_start and window_end are required")
+	}
+
+	in := appointments.SimulateScheduleInput{
+		WindowStart: req.WindowStart.AsTime(),
+		WindowEnd:   req.WindowEnd.AsTime(),
+		TimeZone:    req.TimeZone,
+		Step:        req.Step.AsDuration(),
+	}
+	for _, st := range req.Staff {
+		if st == nil {
+			continue
+		}
+		wh := fromProtoWorkingHours(st.WorkingHours)
+		if wh == nil {
+			log.Warn("invalid request", slog.String("reason", "missing_working_hours"), slog.String("label", st.Label))
+			return nil, status.Error(codes.InvalidArgument, "staff working_hours is required")
+		}
+		staff := appointments.SimulatedStaffInput{Label: st.Label, WorkingHours: *wh}
+		for _, b := range st.Breaks {
+			if b == nil {
+				continue
+			}
+			staff.Breaks = append(staff.Breaks, domain.DailyBreak{Label: b.Label, StartMinute: int(b.StartMinute), EndMinute: int(b.EndMinute)})
+		}
+		in.Staff = append(in.Staff, staff)
+	}
+	for _, p := range req.Patterns {
+		if p == nil {
+			continue
+		}
+		if p.Duration == nil {
+			log.Warn("invalid request", slog.String("reason", "missing_duration"), slog.String("label", p.Label))
+			return nil, status.Error(codes.InvalidArgument, "pattern duration is required")
+		}
+		pattern := appointments.BookingPatternInput{Label: p.Label, Duration: p.Duration.AsDuration(), PerDay: int(p.BookingsPerDay)}
+		for _, wd := range p.Weekdays {
+			if wd != schedulev1.Weekday_WEEKDAY_UNSPECIFIED {
+				pattern.Weekdays = append(pattern.Weekdays, int16(wd))
+			}
+		}
+		in.Patterns = append(in.Patterns, pattern)
+	}
+
+	res, err := s.svc.SimulateSchedule(ctx, in)
+	if err != nil {
+		var vErr *appointments.ValidationError
+		if errors.As(err, &vErr) {
+			log.Warn("invalid request", slog.Any("err", err), slog.Int("staff", len(in.Staff)), slog.Int("patterns", len(in.Patterns)))
+			return nil, status.Error(codes.InvalidArgument, vErr.Error())
+		}
+		log.Error("schedule simulation failed", slog.Any("err", err), slog.Int("staff", len(in.Staff)), slog.Int("patterns", len(in.Patterns)))
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+
+	out := &schedulev1.SimulateScheduleResponse{
+		Utilization: toProtoUtilization(res.Utilization),
+		Requested:   uint32(res.Requested),
+		Unbooked:    uint32(res.Unbooked),
+	}
+	for _, d := range res.Days {
+		out.Days = append(out.Days, &schedulev1.SimulatedDay{
+			Date:        d.Date.Format(time.DateOnly),
+			Utilization: toProtoUtilization(d.Utilization),
+			Requested:   uint32(d.Requested),
+			Unbooked:    uint32(d.Unbooked),
+		})
+	}
+	for _, st := range res.Staff {
+		out.Staff = append(out.Staff, &schedulev1.SimulatedStaffUtilization{
+			Label:       st.Label,
+			Utilization: toProtoUtilization(st.Utilization),
+			Bookings:    uint32(st.Bookings),
+		})
+	}
+	for _, p := range res.Patterns {
+		out.Patterns = append(out.Patterns, &schedulev1.BookingPatternOutcome{Label: p.Label, Requested: uint32(p.Requested), Unbooked: uint32(p.Unbooked)})
+	}
+
+	log.Debug(
+		"schedule simulated",
+		slog.Int("staff", len(in.Staff)),
+		slog.Int("patterns", len(in.Patterns)),
+		slog.Int("requested", res.Requested),
+		slog.Int("unbooked", res.Unbooked),
+		slog.Float64("utilization", res.Ratio()),
+	)
+
+	return out, nil
+}
+
+func toProtoUtilization(u scheduling.Utilization) *schedulev1.ScheduleUtilization {
+	return &schedulev1.ScheduleUtilization{
+		Capacity:    durationpb.New(u.Capacity),
+		Booked:      durationpb.New(u.Booked),
+		Utilization: u.Ratio(),
+	}
+}
+
+func (s *AppointmentsServer) RepairRecurringSeries(ctx context.Context, req *schedulev1.RepairRecurringSeriesRequest) (*schedulev1.RepairRecurringSeriesResponse, error) {
+	log := s.log.With(slog.String("rpc", "RepairRecurringSeries"))
+
+	if req == nil {
+		log.Warn("invalid request", slog.String("reason", "nil_request"))
+		return nil, status.Error(codes.InvalidArgument, "request is required")
+	}
+	id, err := uuid.Parse(req.SeriesId)
+	if err != nil {
+		log.Warn("invalid request", slog.String("reason", "invalid_uuid"), slog.String("user_id", req.UserId))
+		return nil, status.Error(codes.InvalidArgument, "series_id must be a UUID")
+	}
+
+	report, err := s.svc.RepairRecurringSeries(ctx, req.UserId, id, req.Apply)
+	if err != nil {
+		if errors.Is(err, store.ErrNotFound) {
+			log.Info("recurring series not found", slog.String("series_id", id.String()), slog.String("user_id", req.UserId))
+			return nil, status.Error(codes.NotFound, "recurring series not found")
+		}
+		var vErr *appointments.ValidationError
+		if errors.As(err, &vErr) {
+			log.Warn("invalid request", slog.Any("err", err), slog.String("series_id", id.String()), slog.String("user_id", req.UserId))
+			return nil, status.Error(codes.InvalidArgument, vErr.Error())
+		}
+		if code, msg, ok := writeRefusedError(err); ok {
+			log.Info("recurring series repair refused", slog.Any("err", err), slog.String("series_id", id.String()), slog.String("user_id", req.UserId))
+			return nil, status.Error(code, msg)
+		}
+		if code, msg, ok := retryableStoreError(err); ok {
+			log.Warn("recurring series repair failed; retryable", slog.Any("err", err), slog.String("series_id", id.String()), slog.String("user_id", req.UserId))
+			return nil, status.Error(code, msg)
+		}
+		log.Error("recurring series repair failed", slog.Any("err", err), slog.String("series_id", id.String()), slog.String("user_id", req.UserId))
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+
+	out := make([]*schedulev1.SeriesFinding, 0, len(report.Findings))
+	for _, f := range report.Findings {
+		out = append(out, toProtoSeriesFinding(f))
+	}
+
+	log.Info(
+		"recurring series audited",
+		slog.String("series_id", id.String()),
+		slog.String("user_id", req.UserId),
+		slog.Bool("apply", req.Apply),
+		slog.Int("findings", len(out)),
+		slog.Int("repaired", report.Repaired),
+	)
+
+	return &schedulev1.RepairRecurringSeriesResponse{Findings: out, Repaired: uint32(report.Repaired)}, nil
+}
+
+func (s *AppointmentsServer) SkipOccurrences(ctx context.Context, req *schedulev1.SkipOccurrencesRequest) (*schedulev1.SkipOccurrencesResponse, error) {
+	log := s.log.With(slog.String("rpc", "SkipOccurrences"))
+
+	if req == nil {
+		log.Warn("invalid request", slog.String("reason", "nil_request"))
+		return nil, status.Error(codes.InvalidArgument, "request is required")
+	}
+	id, err := uuid.Parse(req.SeriesId)
+	if err != nil {
+		log.Warn("invalid request", slog.String("reason", "invalid_uuid"), slog.String("user_id", req.UserId))
+		return nil, status.Error(codes.InvalidArgument, "series_id must be a UUID")
+	}
+	in := appointments.SkipOccurrencesInput{UserID: req.UserId, SeriesID: id, Apply: req.Apply, ConfirmationToken: req.ConfirmationToken}
+	if req.WindowStart != nil {
+		in.WindowStart = req.WindowStart.AsTime()
+	}
+	if req.WindowEnd != nil {
+		in.WindowEnd = req.WindowEnd.AsTime()
+	}
+	for _, wd := range req.Weekdays {
+		if wd == schedulev1.Weekday_WEEKDAY_UNSPECIFIED {
+			continue
+		}
+		in.Weekdays = append(in.Weekdays, int16(wd))
+	}
+
+	result, err := s.svc.SkipOccurrences(ctx, in)
+	if err != nil {
+		if errors.Is(err, store.ErrNotFound) {
+			log.Info("recurring series not found", slog.String("series_id", id.String()), slog.String("user_id", req.UserId))
+			return nil, status.Error(codes.NotFound, "recurring series not found")
+		}
+		if errors.Is(err, appointments.ErrConfirmationRequired) {
+			log.Info("occurrence skip not confirmed", slog.Any("err", err), slog.String("series_id", id.String()), slog.String("user_id", req.UserId))
+			return nil, status.Error(codes.FailedPrecondition, "This skips many occurrences. Preview it and send the confirmation_token the preview returns.")
+		}
+		var vErr *appointments.ValidationError
+		if errors.As(err, &vErr) {
+			log.Warn("invalid request", slog.Any("err", err), slog.String("series_id", id.String()), slog.String("user_id", req.UserId))
+			return nil, status.Error(codes.InvalidArgument, vErr.Error())
+		}
+		if code, msg, ok := writeRefusedError(err); ok {
+			log.Info("occurrence skip refused", slog.Any("err", err), slog.String("series_id", id.String()), slog.String("user_id", req.UserId))
+			return nil, status.Error(code, msg)
+		}
+		if code, msg, ok := retryableStoreError(err); ok {
+			log.Warn("occurrence skip failed; retryable", slog.Any("err", err), slog.String("series_id", id.String()), slog.String("user_id", req.UserId))
+			return nil, status.Error(code, msg)
+		}
+		log.Error("occurrence skip failed", slog.Any("err", err), slog.String("series_id", id.String()), slog.String("user_id", req.UserId))
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+
+	starts := make([]*timestamppb.Timestamp, 0, len(result.OccurrenceStarts))
+	for _, at := range result.OccurrenceStarts {
+		starts = append(starts, timestamppb.New(at))
+	}
+
+	log.Info(
+		"occurrences matched for skip",
+		slog.String("series_id", id.String()),
+		slog.String("user_id", req.UserId),
+		slog.Bool("apply", req.Apply),
+		slog.Int("matched", len(starts)),
+		slog.Int("skipped", result.Skipped),
+	)
+
+	resp := &schedulev1.SkipOccurrencesResponse{OccurrenceStarts: starts, Skipped: uint32(result.Skipped)}
+	if c := result.Confirmation; c != nil {
+		resp.ConfirmationToken = c.Token
+		resp.ConfirmationExpiresAt = timestamppb.New(c.ExpiresAt)
+	}
+	return resp, nil
+}
+
+func (s *AppointmentsServer) GetLimits(ctx context.Context, req *schedulev1.GetLimitsRequest) (*schedulev1.GetLimitsResponse, error) {
+	// With a user_id, the request policy interceptor has put that user's
+	// limits on the context.
+	lim := limits.FromContext(ctx, s.svc.Limits())
+
+	return &schedulev1.GetLimitsResponse{
+		MaxAppointmentDuration: durationpb.New(appointments.MaxAppointmentDuration),
+		RecurringLookahead:     durationpb.New(store.RecurringConflictLookahead),
+		MaxTitleLength:         uint32(lim.MaxTitleLength),
+		MaxNotesLength:         uint32(lim.MaxNotesLength),
+		MaxWeekdays:            uint32(lim.MaxWeekdays),
+		MaxParticipantIdLength: uint32(lim.MaxParticipantIDLen),
+		MaxMessageBytes:        uint32(lim.MaxMessageBytes),
+		MaxMetadataEntries:     uint32(lim.MaxMetadataEntries),
+		MaxMetadataKeyLength:   uint32(lim.MaxMetadataKeyLen),
+		MaxMetadataValueLength: uint32(lim.MaxMetadataValueLen),
+		MaxActiveSeries:        uint32(lim.MaxActiveSeries),
+		MaxFutureOccurrences:   uint32(lim.MaxFutureOccurrences),
+		// Every span starts inclusively and ends exclusively, so back-to-back
+		// bookings never conflict; see domain.Overlaps.
+		IntervalBounds: schedulev1.IntervalBounds_INTERVAL_BOUNDS_START_INCLUSIVE_END_EXCLUSIVE,
+	}, nil
+}
+
+func (s *AppointmentsServer) GrantDelegation(ctx context.Context, req *schedulev1.GrantDelegationRequest) (*schedulev1.GrantDelegationResponse, error) {
+	log := s.log.With(slog.String("rpc", "GrantDelegation"))
+
+	if req == nil {
+		log.Warn("invalid request", slog.String("reason", "nil_request"))
+		return nil, status.Error(codes.InvalidArgument, "request is required")
+	}
+
+	grant, err := s.svc.GrantDelegation(ctx, req.PrincipalId, req.DelegateId)
+	if err != nil {
+		var vErr *appointments.ValidationError
+		if errors.As(err, &vErr) {
+			log.Warn("invalid request", slog.Any("err", err), slog.String("principal_id", req.PrincipalId))
+			return nil, status.Error(codes.InvalidArgument, vErr.Error())
+		}
+		if code, msg, ok := writeRefusedError(err); ok {
+			log.Info("delegation grant refused", slog.Any("err", err), slog.String("principal_id", req.PrincipalId))
+			return nil, status.Error(code, msg)
+		}
+		if code, msg, ok := retryableStoreError(err); ok {
+			log.Warn("delegation grant failed; retryable", slog.Any("err", err), slog.String("principal_id", req.PrincipalId))
+			return nil, status.Error(code, msg)
+		}
+		log.Error("delegation grant failed", slog.Any("err", err), slog.String("principal_id", req.PrincipalId))
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+
+	log.Info("delegation granted", slog.String("principal_id", grant.PrincipalID), slog.String("delegate_id", grant.DelegateID))
+	return &schedulev1.GrantDelegationResponse{Grant: toProtoDelegationGrant(grant)}, nil
+}
+
+func (s *AppointmentsServer) RevokeDelegation(ctx context.Context, req *schedulev1.RevokeDelegationRequest) (*schedulev1.RevokeDelegationResponse, error) {
+	log := s.log.With(slog.String("rpc", "RevokeDelegation"))
+
+	if req == nil {
+		log.Warn("invalid request", slog.String("reason", "nil_request"))
+		return nil, status.Error(codes.InvalidArgument, "request is required")
+	}
+
+	if err := s.svc.RevokeDelegation(ctx, req.PrincipalId, req.DelegateId); err != nil {
+		if errors.Is(err, store.ErrNotFound) {
+			log.Info("delegation not found", slog.String("principal_id", req.PrincipalId), slog.String("delegate_id", req.DelegateId))
+			return nil, status.Error(codes.NotFound, "delegation not found")
+		}
+		var vErr *appointments.ValidationError
+		if errors.As(err, &vErr) {
+			log.Warn("invalid request", slog.Any("err", err), slog.String("principal_id", req.PrincipalId))
+			return nil, status.Error(codes.InvalidArgument, vErr.Error())
+		}
+		if code, msg, ok := writeRefusedError(err); ok {
+			log.Info("delegation revoke refused", slog.Any("err", err), slog.String("principal_id", req.PrincipalId))
+			return nil, status.Error(code, msg)
+		}
+		if code, msg, ok := retryableStoreError(err); ok {
+			log.Warn("delegation revoke failed; retryable", slog.Any("err", err), slog.String("principal_id", req.PrincipalId))
+			return nil, status.Error(code, msg)
+		}
+		log.Error("delegation revoke failed", slog.Any("err", err), slog.String("principal_id", req.PrincipalId))
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+
+	log.Info("delegation revoked", slog.String("principal_id", req.PrincipalId), slog.String("delegate_id", req.DelegateId))
+	return &schedulev1.RevokeDelegationResponse{}, nil
+}
+
+func (s *AppointmentsServer) ListDelegations(ctx context.Context, req *schedulev1.ListDelegationsRequest) (*schedulev1.ListDelegationsResponse, error) {
+	log := s.log.With(slog.String("rpc", "ListDelegations"))
+
+	if req == nil {
+		log.Warn("invalid request", slog.String("reason", "nil_request"))
+		return nil, status.Error(codes.InvalidArgument, "request is required")
+	}
+
+	grants, err := s.svc.ListDelegations(ctx, req.PrincipalId)
+	if err != nil {
+		var vErr *appointments.ValidationError
+		if errors.As(err, &vErr) {
+			log.Warn("invalid request", slog.Any("err", err), slog.String("principal_id", req.PrincipalId))
+			return nil, status.Error(codes.InvalidArgument, vErr.Error())
+		}
+		if code, msg, ok := retryableStoreError(err); ok {
+			log.Warn("delegations list failed; retryable", slog.Any("err", err), slog.String("principal_id", req.PrincipalId))
+			return nil, status.Error(code, msg)
+		}
+		log.Error("delegations list failed", slog.Any("err", err), slog.String("principal_id", req.PrincipalId))
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+
+	out := make([]*schedulev1.DelegationGrant, 0, len(grants))
+	for _, g := range grants {
+		out = append(out, toProtoDelegationGrant(g))
+	}
+
+	log.Debug("delegations listed", slog.String("principal_id", req.PrincipalId), slog.Int("count", len(out)))
+	return &schedulev1.ListDelegationsResponse{Grants: out}, nil
+}
+
+// WatchOccurrences streams the series and its occurrences in the window,
+// first as a snapshot and then again after every change to the rule or its
+// exceptions.
+func (s *AppointmentsServer) WatchOccurrences(req *schedulev1.WatchOccurrencesRequest, stream grpc.ServerStreamingServer[schedulev1.WatchOccurrencesResponse]) error {
+	log := s.log.With(slog.String("rpc", "WatchOccurrences"))
+
+	if req == nil {
+		log.Warn("invalid request", slog.String("reason", "nil_request"))
+		return status.Error(codes.InvalidArgument, "request is required")
+	}
+	if req.WindowStart == nil || req.WindowEnd == nil {
+		log.Warn("invalid request", slog.String("reason", "missing_window"), slog.String("user_id", req.UserId))
+		return status.Error(codes.InvalidArgument, "window_start and window_end are required")
+	}
+	seriesID, err := uuid.Parse(req.SeriesId)
+	if err != nil {
+		log.Warn("invalid request", slog.String("reason", "invalid_series_id"), slog.String("user_id", req.UserId))
+		return status.Error(codes.InvalidArgument, "invalid series_id")
+	}
+
+	sent := 0
+	var sendErr error
+	err = s.svc.WatchRecurringSeries(stream.Context(), appointments.WatchSeriesInput{
+		UserID:      req.UserId,
+		SeriesID:    seriesID,
+		WindowStart: req.WindowStart.AsTime(),
+		WindowEnd:   req.WindowEnd.AsTime(),
+	}, func(snap appointments.SeriesSnapshot) error {
+		occs := make([]*schedulev1.Occurrence, 0, len(snap.Occurrences))
+		for _, o := range snap.Occurrences {
+			occs = append(occs, toProtoOccurrence(o))
+		}
+		sendErr = stream.Send(&schedulev1.WatchOccurrencesResponse{
+			Series:      toProtoRecurringSeries(snap.Series),
+			Occurrences: occs,
+		})
+		if sendErr == nil {
+			sent++
+		}
+		return sendErr
+	})
+	if err != nil {
+		if errors.Is(err, store.ErrNotFound) {
+			log.Info("watched series not found", slog.String("user_id", req.UserId), slog.String("series_id", req.SeriesId))
+			return status.Error(codes.NotFound, "recurring series not found")
+		}
+		var vErr *appointments.ValidationError
+		if errors.As(err, &vErr) {
+			log.Warn("invalid request", slog.Any("err", err), slog.String("user_id", req.UserId))
+			return status.Error(codes.InvalidArgument, vErr.Error())
+		}
+		if sendErr != nil {
+			log.Debug("series watch send failed", slog.Any("err", err), slog.String("series_id", req.SeriesId))
+			return err
+		}
+		if code, msg, ok := retryableStoreError(err); ok {
+			log.Warn("series watch failed; retryable", slog.Any("err", err), slog.String("user_id", req.UserId))
+			return status.Error(code, msg)
+		}
+		log.Error("series watch failed", slog.Any("err", err), slog.String("user_id", req.UserId))
+		return status.Error(codes.Internal, "internal error")
+	}
+
+	log.Debug("series watch ended", slog.String("series_id", req.SeriesId), slog.Int("sent", sent))
+	return nil
+}
+
+// ListChanges serves incremental sync for clients that cannot hold a stream.
+// With wait set it long-polls, bounded by the request deadline.
+func (s *AppointmentsServer) ListChanges(ctx context.Context, req *schedulev1.ListChangesRequest) (*schedulev1.ListChangesResponse, error) {
+	log := s.log.With(slog.String("rpc", "ListChanges"))
+
+	if req == nil {
+		log.Warn("invalid request", slog.String("reason", "nil_request"))
+		return nil, status.Error(codes.InvalidArgument, "request is required")
+	}
+
+	page, err := s.svc.ListChanges(ctx, appointments.ListChangesInput{
+		UserID: req.UserId,
+		Cursor: req.SinceCursor,
+		Limit:  int(req.PageSize),
+		Wait:   req.Wait.AsDuration(),
+	})
+	if err != nil {
+		var vErr *appointments.ValidationError
+		if errors.As(err, &vErr) {
+			log.Warn("invalid request", slog.Any("err", err), slog.String("user_id", req.UserId))
+			return nil, status.Error(codes.InvalidArgument, vErr.Error())
+		}
+		if code, msg, ok := retryableStoreError(err); ok {
+			log.Warn("changes list failed; retryable", slog.Any("err", err), slog.String("user_id", req.UserId))
+			return nil, status.Error(code, msg)
+		}
+		log.Error("changes list failed", slog.Any("err", err), slog.String("user_id", req.UserId))
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+
+	out := make([]*schedulev1.CalendarChange, 0, len(page.Changes))
+	for _, c := range page.Changes {
+		out = append(out, toProtoCalendarChange(c))
+	}
+
+	log.Debug("changes listed", slog.String("user_id", req.UserId), slog.Int("count", len(out)), slog.Bool("has_more", page.HasMore))
+	return &schedulev1.ListChangesResponse{
+		Changes:    out,
+		NextCursor: page.NextCursor,
+		HasMore:    page.HasMore,
+	}, nil
+}
+
+func (s *AppointmentsServer) ExportCalendar(ctx context.Context, req *schedulev1.ExportCalendarRequest) (*schedulev1.ExportCalendarResponse, error) {
+	log := s.log.With(slog.String("rpc", "ExportCalendar"))
+
+	if req == nil {
+		log.Warn("invalid request", slog.String("reason", "nil_request"))
+		return nil, status.Error(codes.InvalidArgument, "request is required")
+	}
+
+	bundle, err := s.svc.ExportCalendar(ctx, req.UserId)
+	if err != nil {
+		var vErr *appointments.ValidationError
+		if errors.As(err, &vErr) {
+			log.Warn("invalid request", slog.Any("err", err), slog.String("user_id", req.UserId))
+			return nil, status.Error(codes.InvalidArgument, vErr.Error())
+		}
+		if code, msg, ok := retryableStoreError(err); ok {
+			log.Warn("calendar export failed; retryable", slog.Any("err", err), slog.String("user_id", req.UserId))
+			return nil, status.Error(code, msg)
+		}
+		log.Error("calendar export failed", slog.Any("err", err), slog.String("user_id", req.UserId))
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+
+	log.Info("calendar exported", slog.String("user_id", req.UserId), slog.Int("bytes", len(bundle)))
+	return &schedulev1.ExportCalendarResponse{Bundle: bundle}, nil
+}
+
+func (s *AppointmentsServer) ExportBillableHours(ctx context.Context, req *schedulev1.ExportBillableHoursRequest) (*schedulev1.ExportBillableHoursResponse, error) {
+	log := s.log.With(slog.String("rpc", "ExportBillableHours"))
+
+	if req == nil {
+		log.Warn("invalid request", slog.String("reason", "nil_request"))
+		return nil, status.Error(codes.InvalidArgument, "request is required")
+	}
+	if req.WindowStart == nil || req.WindowEnd == nil {
+		log.Warn("invalid request", slog.String("reason", "missing_window"), slog.String("user_id", req.UserId))
+		return nil, status.Error(codes.InvalidArgument, "window_start and window_end are required")
+	}
+
+	period := appointments.BillablePeriodWeek
+	if req.Period == schedulev1.BillablePeriod_BILLABLE_PERIOD_MONTH {
+		period = appointments.BillablePeriodMonth
+	}
+	format := appointments.BillableFormatCSV
+	if req.Format == schedulev1.BillableFormat_BILLABLE_FORMAT_JSON {
+		format = appointments.BillableFormatJSON
+	}
+	export, err := s.svc.ExportBillableHours(ctx, appointments.BillableHoursInput{
+		UserID:      req.UserId,
+		WindowStart: req.WindowStart.AsTime(),
+		WindowEnd:   req.WindowEnd.AsTime(),
+		TagKey:      req.TagKey,
+		Period:      period,
+		TimeZone:    req.TimeZone,
+		Format:      format,
+	})
+	if err != nil {
+		var vErr *appointments.ValidationError
+		if errors.As(err, &vErr) {
+			log.Warn("invalid request", slog.Any("err", err), slog.String("user_id", req.UserId))
+			return nil, status.Error(codes.InvalidArgument, vErr.Error())
+		}
+		if code, msg, ok := retryableStoreError(err); ok {
+			log.Warn("billable hours export failed; retryable", slog.Any("err", err), slog.String("user_id", req.UserId))
+			return nil, status.Error(code, msg)
+		}
+		log.Error("billable hours export failed", slog.Any("err", err), slog.String("user_id", req.UserId))
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+
+	log.Info("billable hours exported", slog.String("user_id", req.UserId), slog.Int("rows", len(export.Rows)), slog.String("content_type", export.ContentType))
+	return &schedulev1.ExportBillableHoursResponse{Data: export.Data, ContentType: export.ContentType}, nil
+}
+
+func (s *AppointmentsServer) AuditCalendar(ctx context.Context, req *schedulev1.AuditCalendarRequest) (*schedulev1.AuditCalendarResponse, error) {
+	log := s.log.With(slog.String("rpc", "AuditCalendar"))
+
+	if req == nil {
+		log.Warn("invalid request", slog.String("reason", "nil_request"))
+		return nil, status.Error(codes.InvalidArgument, "request is required")
+	}
+	if req.WindowStart == nil || req.WindowEnd == nil {
+		log.Warn("invalid request", slog.String("reason", "missing_window"), slog.String("user_id", req.UserId))
+		return nil, status.Error(codes.InvalidArgument, "window_start and window_end are required")
+	}
+
+	audit, err := s.svc.AuditCalendar(ctx, req.UserId, req.WindowStart.AsTime(), req.WindowEnd.AsTime())
+	if err != nil {
+		var vErr *appointments.ValidationError
+		if errors.As(err, &vErr) {
+			log.Warn("invalid request", slog.Any("err", err), slog.String("user_id", req.UserId))
+			return nil, status.Error(codes.InvalidArgument, vErr.Error())
+		}
+		if code, msg, ok := retryableStoreError(err); ok {
+			log.Warn("calendar audit failed; retryable", slog.Any("err", err), slog.String("user_id", req.UserId))
+			return nil, status.Error(code, msg)
+		}
+		log.Error("calendar audit failed", slog.Any("err", err), slog.String("user_id", req.UserId))
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+
+	conflicts := make([]*schedulev1.CalendarConflict, 0, len(audit.Conflicts))
+	for _, c := range audit.Conflicts {
+		conflicts = append(conflicts, toProtoCalendarConflict(c))
+	}
+
+	log.Info("calendar audited", slog.String("user_id", req.UserId), slog.Int("entries_scanned", audit.EntriesScanned), slog.Int("conflicts", len(conflicts)))
+	return &schedulev1.AuditCalendarResponse{Conflicts: conflicts, EntriesScanned: uint32(audit.EntriesScanned)}, nil
+}
+
+func (s *AppointmentsServer) GetDailyAgenda(ctx context.Context, req *schedulev1.GetDailyAgendaRequest) (*schedulev1.GetDailyAgendaResponse, error) {
+	log := s.log.With(slog.String("rpc", "GetDailyAgenda"))
+
+	if req == nil {
+		log.Warn("invalid request", slog.String("reason", "nil_request"))
+		return nil, status.Error(codes.InvalidArgument, "request is required")
+	}
+
+	agenda, err := s.svc.GetDailyAgenda(ctx, req.UserId, req.Date, req.TimeZone)
+	if err != nil {
+		var vErr *appointments.ValidationError
+		if errors.As(err, &vErr) {
+			log.Warn("invalid request", slog.Any("err", err), slog.String("user_id", req.UserId))
+			return nil, status.Error(codes.InvalidArgument, vErr.Error())
+		}
+		if code, msg, ok := retryableStoreError(err); ok {
+			log.Warn("daily agenda failed; retryable", slog.Any("err", err), slog.String("user_id", req.UserId))
+			return nil, status.Error(code, msg)
+		}
+		log.Error("daily agenda failed", slog.Any("err", err), slog.String("user_id", req.UserId))
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+
+	items := make([]*schedulev1.AgendaItem, 0, len(agenda.Items))
+	for _, it := range agenda.Items {
+		items = append(items, &schedulev1.AgendaItem{
+			Entry:      toProtoCalendarEntry(it.CalendarEntry),
+			Milestone:  it.Milestone,
+			GapBefore:  durationpb.New(it.GapBefore),
+			BackToBack: it.BackToBack,
+			Overlaps:   it.Overlaps,
+		})
+	}
+
+	log.Debug("daily agenda built", slog.String("user_id", req.UserId), slog.Int("items", len(items)))
+	return &schedulev1.GetDailyAgendaResponse{
+		Date:     agenda.Date.Format(time.DateOnly),
+		TimeZone: agenda.TimeZone,
+		Items:    items,
+		BusyTime: durationpb.New(agenda.BusyTime),
+	}, nil
+}
+
+func (s *AppointmentsServer) GetDaySummaries(ctx context.Context, req *schedulev1.GetDaySummariesRequest) (*schedulev1.GetDaySummariesResponse, error) {
+	log := s.log.With(slog.String("rpc", "GetDaySummaries"))
+
+	if req == nil {
+		log.Warn("invalid request", slog.String("reason", "nil_request"))
+		return nil, status.Error(codes.InvalidArgument, "request is required")
+	}
+
+	summaries, err := s.svc.GetDaySummaries(ctx, req.UserId, req.FromDate, req.ToDate)
+	if err != nil {
+		var vErr *appointments.ValidationError
+		if errors.As(err, &vErr) {
+			log.Warn("invalid request", slog.Any("err", err), slog.String("user_id", req.UserId))
+			return nil, status.Error(codes.InvalidArgument, vErr.Error())
+		}
+		if code, msg, ok := retryableStoreError(err); ok {
+			log.Warn("day summaries failed; retryable", slog.Any("err", err), slog.String("user_id", req.UserId))
+			return nil, status.Error(code, msg)
+		}
+		log.Error("day summaries failed", slog.Any("err", err), slog.String("user_id", req.UserId))
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+
+	days := make([]*schedulev1.DaySummary, 0, len(summaries.Days))
+	for _, d := range summaries.Days {
+		days = append(days, &schedulev1.DaySummary{
+			Date:         d.Day.Format(time.DateOnly),
+			Appointments: uint32(d.Appointments),
+			Occurrences:  uint32(d.Occurrences),
+			BusyTime:     durationpb.New(time.Duration(d.BusySeconds) * time.Second),
+		})
+	}
+
+	log.Debug("day summaries read", slog.String("user_id", req.UserId), slog.Int("days", len(days)))
+	return &schedulev1.GetDaySummariesResponse{TimeZone: summaries.TimeZone, Days: days}, nil
+}
+
+func (s *AppointmentsServer) ImportCalendar(ctx context.Context, req *schedulev1.ImportCalendarRequest) (*schedulev1.ImportCalendarResponse, error) {
+	log := s.log.With(slog.String("rpc", "ImportCalendar"))
+
+	if req == nil {
+		log.Warn("invalid request", slog.String("reason", "nil_request"))
+		return nil, status.Error(codes.InvalidArgument, "request is required")
+	}
+
+	res, err := s.svc.ImportCalendar(ctx, appointments.ImportCalendarInput{UserID: req.UserId, Bundle: req.Bundle})
+	if err != nil {
+		if errors.Is(err, store.ErrConflict) {
+			log.Info("calendar import into non-empty calendar", slog.String("user_id", req.UserId))
+			return nil, status.Error(codes.FailedPrecondition, "This calendar already has appointments. Import into an empty calendar.")
+		}
+		if errors.Is(err, store.ErrDuplicate) {
+			log.Info("calendar import id clash", slog.String("user_id", req.UserId))
+			return nil, status.Error(codes.AlreadyExists, "Some items in this bundle already exist on this server.")
+		}
+		var vErr *appointments.ValidationError
+		if errors.As(err, &vErr) {
+			log.Warn("invalid request", slog.Any("err", err), slog.String("user_id", req.UserId))
+			return nil, status.Error(codes.InvalidArgument, vErr.Error())
+		}
+		if code, msg, ok := writeRefusedError(err); ok {
+			log.Info("calendar import refused", slog.Any("err", err), slog.String("user_id", req.UserId))
+			return nil, status.Error(code, msg)
+		}
+		if code, msg, ok := retryableStoreError(err); ok {
+			log.Warn("calendar import failed; retryable", slog.Any("err", err), slog.String("user_id", req.UserId))
+			return nil, status.Error(code, msg)
+		}
+		log.Error("calendar import failed", slog.Any("err", err), slog.String("user_id", req.UserId))
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+
+	log.Info(
+		"calendar imported",
+		slog.String("user_id", req.UserId),
+		slog.Int("appointments", res.Appointments),
+		slog.Int("series", res.Series),
+		slog.Int("exceptions", res.Exceptions),
+	)
+	return &schedulev1.ImportCalendarResponse{
+		AppointmentsImported: int32(res.Appointments),
+		SeriesImported:       int32(res.Series),
+		ExceptionsImported:   int32(res.Exceptions),
+		ContactsImported:     int32(res.Contacts),
+	}, nil
+}
+
+func (s *AppointmentsServer) CreateCalendarSnapshot(ctx context.Context, req *schedulev1.CreateCalendarSnapshotRequest) (*schedulev1.CreateCalendarSnapshotResponse, error) {
+	log := s.log.With(slog.String("rpc", "CreateCalendarSnapshot"))
+
+	if req == nil {
+		log.Warn("invalid request", slog.String("reason", "nil_request"))
+		return nil, status.Error(codes.InvalidArgument, "request is required")
+	}
+
+	snap, err := s.svc.CreateCalendarSnapshot(ctx, appointments.CreateCalendarSnapshotInput{UserID: req.UserId, Label: req.Label})
+	if err != nil {
+		return nil, snapshotError(log, err, "create", uuid.Nil, req.UserId)
+	}
+
+	log.Info(
+		"calendar snapshot created",
+		slog.String("user_id", req.UserId),
+		slog.String("snapshot_id", snap.ID.String()),
+		slog.Int("bytes", snap.SizeBytes),
+	)
+	return &schedulev1.CreateCalendarSnapshotResponse{Snapshot: toProtoCalendarSnapshot(snap)}, nil
+}
+
+func (s *AppointmentsServer) ListCalendarSnapshots(ctx context.Context, req *schedulev1.ListCalendarSnapshotsRequest) (*schedulev1.ListCalendarSnapshotsResponse, error) {
+	log := s.log.With(slog.String("rpc", "ListCalendarSnapshots"))
+
+	if req == nil {
+		log.Warn("invalid request", slog.String("reason", "nil_request"))
+		return nil, status.Error(codes.InvalidArgument, "request is required")
+	}
+
+	snaps, err := s.svc.ListCalendarSnapshots(ctx, req.UserId)
+	if err != nil {
+		return nil, snapshotError(log, err, "list", uuid.Nil, req.UserId)
+	}
+
+	out := make([]*schedulev1.CalendarSnapshot, 0, len(snaps))
+	for _, snap := range snaps {
+		out = append(out, toProtoCalendarSnapshot(snap))
+	}
+	log.Debug("calendar snapshots listed", slog.String("user_id", req.UserId), slog.Int("count", len(out)))
+	return &schedulev1.ListCalendarSnapshotsResponse{Snapshots: out}, nil
+}
+
+func (s *AppointmentsServer) RestoreCalendarSnapshot(ctx context.Context, req *schedulev1.RestoreCalendarSnapshotRequest) (*schedulev1.RestoreCalendarSnapshotResponse, error) {
+	log := s.log.With(slog.String("rpc", "RestoreCalendarSnapshot"))
+
+	if req == nil {
+		log.Warn("invalid request", slog.String("reason", "nil_request"))
+		return nil, status.Error(codes.InvalidArgument, "request is required")
+	}
+	id, err := uuid.Parse(req.SnapshotId)
+	if err != nil {
+		log.Warn("invalid request", slog.String("reason", "invalid_uuid"), slog.String("user_id", req.UserId))
+		return nil, status.Error(codes.InvalidArgument, "snapshot_id must be a UUID")
+	}
+
+	res, err := s.svc.RestoreCalendarSnapshot(ctx, req.UserId, id)
+	if err != nil {
+		if errors.Is(err, store.ErrConflict) {
+			log.Info("calendar restore conflicts with a hold", slog.String("user_id", req.UserId), slog.String("snapshot_id", id.String()))
+			return nil, status.Error(codes.Aborted, "The snapshot overlaps a slot that is being held. Try again once the hold expires.")
+		}
+		if errors.Is(err, store.ErrDuplicate) {
+			log.Info("calendar restore id clash", slog.String("user_id", req.UserId), slog.String("snapshot_id", id.String()))
+			return nil, status.Error(codes.AlreadyExists, "Some items in this snapshot now belong to another calendar.")
+		}
+		return nil, snapshotError(log, err, "restore", id, req.UserId)
+	}
+
+	log.Info(
+		"calendar snapshot restored",
+		slog.String("user_id", req.UserId),
+		slog.String("snapshot_id", id.String()),
+		slog.Int("appointments_created", res.AppointmentsCreated),
+		slog.Int("appointments_updated", res.AppointmentsUpdated),
+		slog.Int("appointments_deleted", res.AppointmentsDeleted),
+		slog.Int("series_changed", res.SeriesCreated+res.SeriesUpdated+res.SeriesDeleted),
+	)
+	return &schedulev1.RestoreCalendarSnapshotResponse{
+		Snapshot:            toProtoCalendarSnapshot(res.Snapshot),
+		AppointmentsCreated: int32(res.AppointmentsCreated),
+		AppointmentsUpdated: int32(res.AppointmentsUpdated),
+		AppointmentsDeleted: int32(res.AppointmentsDeleted),
+		SeriesCreated:       int32(res.SeriesCreated),
+		SeriesUpdated:       int32(res.SeriesUpdated),
+		SeriesDeleted:       int32(res.SeriesDeleted),
+		ContactsChanged:     int32(res.Contacts),
+	}, nil
+}
+
+// ReconcileCalendar uploads an offline client's queued edits. Conflicts and
+// invalid edits are reported per mutation; only batch-level problems fail
+// the RPC.
+func (s *AppointmentsServer) ReconcileCalendar(ctx context.Context, req *schedulev1.ReconcileCalendarRequest) (*schedulev1.ReconcileCalendarResponse, error) {
+	log := s.log.With(slog.String("rpc", "ReconcileCalendar"))
+
+	if req == nil {
+		log.Warn("invalid request", slog.String("reason", "nil_request"))
+		return nil, status.Error(codes.InvalidArgument, "request is required")
+	}
+
+	mutations := make([]appointments.OfflineMutation, 0, len(req.Mutations))
+	for i, m := range req.Mutations {
+		id, err := uuid.Parse(m.GetAppointmentId())
+		if err != nil {
+			log.Warn("invalid request", slog.String("reason", "invalid_uuid"), slog.String("user_id", req.UserId))
+			return nil, status.Errorf(codes.InvalidArgument, "mutations[%d].appointment_id must be a UUID", i)
+		}
+		mutations = append(mutations, appointments.OfflineMutation{
+			Kind:          fromProtoMutationKind(m.GetKind()),
+			AppointmentID: id,
+			Title:         m.GetTitle(),
+			Notes:         m.GetNotes(),
+			StartTime:     optionalTime(m.GetStartTime()),
+			EndTime:       optionalTime(m.GetEndTime()),
+			Metadata:      m.GetMetadata(),
+			TimeZone:      m.GetTimeZone(),
+			BaseUpdatedAt: optionalTime(m.GetBaseUpdatedAt()),
+		})
+	}
+
+	results, err := s.svc.ReconcileCalendar(ctx, appointments.ReconcileInput{UserID: req.UserId, Mutations: mutations})
+	if err != nil {
+		var vErr *appointments.ValidationError
+		if errors.As(err, &vErr) {
+			log.Warn("invalid request", slog.Any("err", err), slog.String("user_id", req.UserId))
+			return nil, status.Error(codes.InvalidArgument, vErr.Error())
+		}
+		if code, msg, ok := writeRefusedError(err); ok {
+			log.Info("calendar reconcile refused", slog.Any("err", err), slog.String("user_id", req.UserId))
+			return nil, status.Error(code, msg)
+		}
+		if code, msg, ok := retryableStoreError(err); ok {
+			log.Warn("calendar reconcile failed; retryable", slog.Any("err", err), slog.String("user_id", req.UserId))
+			return nil, status.Error(code, msg)
+		}
+		log.Error("calendar reconcile failed", slog.Any("err", err), slog.String("user_id", req.UserId))
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+
+	out := make([]*schedulev1.MutationResult, 0, len(results))
+	accepted := 0
+	for _, r := range results {
+		if r.Accepted() {
+			accepted++
+		}
+		out = append(out, toProtoMutationResult(r))
+	}
+	log.Info(
+		"calendar reconciled",
+		slog.String("user_id", req.UserId),
+		slog.Int("mutations", len(results)),
+		slog.Int("accepted", accepted),
+	)
+	return &schedulev1.ReconcileCalendarResponse{Results: out}, nil
+}
+
+func (s *AppointmentsServer) CheckIn(ctx context.Context, req *schedulev1.CheckInRequest) (*schedulev1.CheckInResponse, error) {
+	log := s.log.With(slog.String("rpc", "CheckIn"))
+
+	if req == nil {
+		log.Warn("invalid request", slog.String("reason", "nil_request"))
+		return nil, status.Error(codes.InvalidArgument, "request is required")
+	}
+	id, err := uuid.Parse(req.AppointmentId)
+	if err != nil {
+		log.Warn("invalid request", slog.String("reason", "invalid_uuid"), slog.String("user_id", req.UserId))
+		return nil, status.Error(codes.InvalidArgument, "appointment_id must be a UUID")
+	}
+
+	appt, err := s.svc.CheckIn(ctx, appointments.SessionInput{UserID: req.UserId, AppointmentID: id, At: optionalTime(req.At)})
+	if err != nil {
+		if errors.Is(err, store.ErrConflict) {
+			log.Info("appointment already checked in", slog.String("appointment_id", id.String()), slog.String("user_id", req.UserId))
+			return nil, status.Error(codes.FailedPrecondition, "This appointment is already checked in.")
+		}
+		return nil, sessionError(log, err, "check-in", id, req.UserId)
+	}
+
+	log.Info("appointment checked in", slog.String("appointment_id", id.String()), slog.String("user_id", req.UserId))
+	return &schedulev1.CheckInResponse{Appointment: toProtoAppointment(appt)}, nil
+}
+
+func (s *AppointmentsServer) CheckOut(ctx context.Context, req *schedulev1.CheckOutRequest) (*schedulev1.CheckOutResponse, error) {
+	log := s.log.With(slog.String("rpc", "CheckOut"))
+
+	if req == nil {
+		log.Warn("invalid request", slog.String("reason", "nil_request"))
+		return nil, status.Error(codes.InvalidArgument, "request is required")
+	}
+	id, err := uuid.Parse(req.AppointmentId)
+	if err != nil {
+		log.Warn("invalid request", slog.String("reason", "invalid_uuid"), slog.String("user_id", req.UserId))
+		return nil, status.Error(codes.InvalidArgument, "appointment_id must be a UUID")
+	}
+
+	appt, err := s.svc.CheckOut(ctx, appointments.SessionInput{UserID: req.UserId, AppointmentID: id, At: optionalTime(req.At)})
+	if err != nil {
+		if errors.Is(err, store.ErrConflict) {
+			log.Info("appointment not open for check-out", slog.String("appointment_id", id.String()), slog.String("user_id", req.UserId))
+			return nil, status.Error(codes.FailedPrecondition, "Check this appointment in first. It can be checked out once, no earlier than its check-in.")
+		}
+		return nil, sessionError(log, err, "check-out", id, req.UserId)
+	}
+
+	actual, _ := appt.ActualDuration()
+	log.Info("appointment checked out", slog.String("appointment_id", id.String()), slog.String("user_id", req.UserId), slog.Duration("actual", actual))
+	return &schedulev1.CheckOutResponse{
+		Appointment:     toProtoAppointment(appt),
+		PlannedDuration: durationpb.New(appt.EndTime.Sub(appt.StartTime)),
+		ActualDuration:  durationpb.New(actual),
+	}, nil
+}
+
+// sessionError maps the errors CheckIn and CheckOut share.
+func sessionError(log *slog.Logger, err error, action string, id uuid.UUID, userID string) error {
+	if errors.Is(err, store.ErrNotFound) {
+		log.Info("appointment not found", slog.String("appointment_id", id.String()), slog.String("user_id", userID))
+		return status.Error(codes.NotFound, "appointment not found")
+	}
+	var vErr *appointments.ValidationError
+	if errors.As(err, &vErr) {
+		log.Warn("invalid request", slog.Any("err", err), slog.String("appointment_id", id.String()), slog.String("user_id", userID))
+		return status.Error(codes.InvalidArgument, vErr.Error())
+	}
+	if code, msg, ok := writeRefusedError(err); ok {
+		log.Info("appointment "+action+" refused", slog.Any("err", err), slog.String("appointment_id", id.String()), slog.String("user_id", userID))
+		return status.Error(code, msg)
+	}
+	if code, msg, ok := retryableStoreError(err); ok {
+		log.Warn("appointment "+action+" failed; retryable", slog.Any("err", err), slog.String("appointment_id", id.String()), slog.String("user_id", userID))
+		return status.Error(code, msg)
+	}
+	log.Error("appointment "+action+" failed", slog.Any("err", err), slog.String("appointment_id", id.String()), slog.String("user_id", userID))
+	return status.Error(codes.Internal, "internal error")
+}
+
+func (s *AppointmentsServer) CreateContact(ctx context.Context, req *schedulev1.CreateContactRequest) (*schedulev1.CreateContactResponse, error) {
+	log := s.log.With(slog.String("rpc", "CreateContact"))
+
+	if req == nil {
+		log.Warn("invalid request", slog.String("reason", "nil_request"))
+		return nil, status.Error(codes.InvalidArgument, "request is required")
+	}
+
+	contact, err := s.svc.CreateContact(ctx, appointments.ContactInput{UserID: req.UserId, Name: req.Name, Email: req.Email, Phone: req.Phone})
+	if err != nil {
+		return nil, contactError(log, err, "create", uuid.Nil, req.UserId)
+	}
+
+	log.Info("contact created", slog.String("contact_id", contact.ID.String()), slog.String("user_id", req.UserId))
+	return &schedulev1.CreateContactResponse{Contact: toProtoContact(contact)}, nil
+}
+
+func (s *AppointmentsServer) GetContact(ctx context.Context, req *schedulev1.GetContactRequest) (*schedulev1.GetContactResponse, error) {
+	log := s.log.With(slog.String("rpc", "GetContact"))
+
+	if req == nil {
+		log.Warn("invalid request", slog.String("reason", "nil_request"))
+		return nil, status.Error(codes.InvalidArgument, "request is required")
+	}
+	id, err := uuid.Parse(req.ContactId)
+	if err != nil {
+		log.Warn("invalid request", slog.String("reason", "invalid_uuid"), slog.String("user_id", req.UserId))
+		return nil, status.Error(codes.InvalidArgument, "contact_id must be a UUID")
+	}
+
+	contact, err := s.svc.GetContact(ctx, req.UserId, id)
+	if err != nil {
+		return nil, contactError(log, err, "get", id, req.UserId)
+	}
+
+	log.Debug("contact fetched", slog.String("contact_id", id.String()), slog.String("user_id", req.UserId))
+	return &schedulev1.GetContactResponse{Contact: toProtoContact(contact)}, nil
+}
+
+func (s *AppointmentsServer) UpdateContact(ctx context.Context, req *schedulev1.UpdateContactRequest) (*schedulev1.UpdateContactResponse, error) {
+	log := s.log.With(slog.String("rpc", "UpdateContact"))
+
+	if req == nil {
+		log.Warn("invalid request", slog.String("reason", "nil_request"))
+		return nil, status.Error(codes.InvalidArgument, "request is required")
+	}
+	id, err := uuid.Parse(req.ContactId)
+	if err != nil {
+		log.Warn("invalid request", slog.String("reason", "invalid_uuid"), slog.String("user_id", req.UserId))
+		return nil, status.Error(codes.InvalidArgument, "contact_id must be a UUID")
+	}
+
+	contact, err := s.svc.UpdateContact(ctx, appointments.ContactInput{UserID: req.UserId, ContactID: id, Name: req.Name, Email: req.Email, Phone: req.Phone})
+	if err != nil {
+		return nil, contactError(log, err, "update", id, req.UserId)
+	}
+
+	log.Info("contact updated", slog.String("contact_id", id.String()), slog.String("user_id", req.UserId))
+	return &schedulev1.UpdateContactResponse{Contact: toProtoContact(contact)}, nil
+}
+
+func (s *AppointmentsServer) DeleteContact(ctx context.Context, req *schedulev1.DeleteContactRequest) (*schedulev1.DeleteContactResponse, error) {
+	log := s.log.With(slog.String("rpc", "DeleteContact"))
+
+	if req == nil {
+		log.Warn("invalid request", slog.String("reason", "nil_request"))
+		return nil, status.Error(codes.InvalidArgument, "request is required")
+	}
+	id, err := uuid.Parse(req.ContactId)
+	if err != nil {
+		log.Warn("invalid request", slog.String("reason", "invalid_uuid"), slog.String("user_id", req.UserId))
+		return nil, status.Error(codes.InvalidArgument, "contact_id must be a UUID")
+	}
+
+	if err := s.svc.DeleteContact(ctx, req.UserId, id); err != nil {
+		return nil, contactError(log, err, "delete", id, req.UserId)
+	}
+
+	log.Info("contact deleted", slog.String("contact_id", id.String()), slog.String("user_id", req.UserId))
+	return &schedulev1.DeleteContactResponse{}, nil
+}
+
+func (s *AppointmentsServer) ListContacts(ctx context.Context, req *schedulev1.ListContactsRequest) (*schedulev1.ListContactsResponse, error) {
+	log := s.log.With(slog.String("rpc", "ListContacts"))
+
+	if req == nil {
+		log.Warn("invalid request", slog.String("reason", "nil_request"))
+		return nil, status.Error(codes.InvalidArgument, "request is required")
+	}
+
+	contacts, err := s.svc.ListContacts(ctx, req.UserId)
+	if err != nil {
+		return nil, contactError(log, err, "list", uuid.Nil, req.UserId)
+	}
+
+	out := make([]*schedulev1.Contact, 0, len(contacts))
+	for _, c := range contacts {
+		out = append(out, toProtoContact(c))
+	}
+	log.Debug("contacts listed", slog.String("user_id", req.UserId), slog.Int("count", len(out)))
+	return &schedulev1.ListContactsResponse{Contacts: out}, nil
+}
+
+func (s *AppointmentsServer) CreateProgram(ctx context.Context, req *schedulev1.CreateProgramRequest) (*schedulev1.CreateProgramResponse, error) {
+	log := s.log.With(slog.String("rpc", "CreateProgram"))
+
+	if req == nil {
+		log.Warn("invalid request", slog.String("reason", "nil_request"))
+		return nil, status.Error(codes.InvalidArgument, "request is required")
+	}
+
+	program, err := s.svc.CreateProgram(ctx, appointments.ProgramInput{UserID: req.UserId, Title: req.Title, Notes: req.Notes})
+	if err != nil {
+		return nil, programError(log, err, "create", uuid.Nil, req.UserId)
+	}
+
+	log.Info("program created", slog.String("program_id", program.ID.String()), slog.String("user_id", req.UserId))
+	return &schedulev1.CreateProgramResponse{Program: toProtoProgram(program)}, nil
+}
+
+func (s *AppointmentsServer) GetProgram(ctx context.Context, req *schedulev1.GetProgramRequest) (*schedulev1.GetProgramResponse, error) {
+	log := s.log.With(slog.String("rpc", "GetProgram"))
+
+	if req == nil {
+		log.Warn("invalid request", slog.String("reason", "nil_request"))
+		return nil, status.Error(codes.InvalidArgument, "request is required")
+	}
+	id, err := uuid.Parse(req.ProgramId)
+	if err != nil {
+		log.Warn("invalid request", slog.String("reason", "invalid_uuid"), slog.String("user_id", req.UserId))
+		return nil, status.Error(codes.InvalidArgument, "program_id must be a UUID")
+	}
+
+	overview, err := s.svc.GetProgram(ctx, req.UserId, id)
+	if err != nil {
+		return nil, programError(log, err, "get", id, req.UserId)
+	}
+
+	appts := make([]*schedulev1.Appointment, 0, len(overview.Appointments))
+	for _, a := range overview.Appointments {
+		appts = append(appts, toProtoAppointment(a))
+	}
+	series := make([]*schedulev1.RecurringSeries, 0, len(overview.Series))
+	for _, sr := range overview.Series {
+		series = append(series, toProtoRecurringSeries(sr))
+	}
+	p := overview.Progress
+	return &schedulev1.GetProgramResponse{
+		Program:      toProtoProgram(overview.Program),
+		Appointments: appts,
+		Series:       series,
+		Progress: &schedulev1.ProgramProgress{
+			TotalSessions:     uint32(p.Total),
+			CompletedSessions: uint32(p.Completed),
+			RemainingSessions: uint32(p.Remaining),
+			NextSession:       optionalTimestamp(p.NextSession),
+		},
+	}, nil
+}
+
+func (s *AppointmentsServer) ListPrograms(ctx context.Context, req *schedulev1.ListProgramsRequest) (*schedulev1.ListProgramsResponse, error) {
+	log := s.log.With(slog.String("rpc", "ListPrograms"))
+
+	if req == nil {
+		log.Warn("invalid request", slog.String("reason", "nil_request"))
+		return nil, status.Error(codes.InvalidArgument, "request is required")
+	}
+
+	programs, err := s.svc.ListPrograms(ctx, req.UserId)
+	if err != nil {
+		return nil, programError(log, err, "list", uuid.Nil, req.UserId)
+	}
+
+	out := make([]*schedulev1.Program, 0, len(programs))
+	for _, p := range programs {
+		out = append(out, toProtoProgram(p))
+	}
+	log.Debug("programs listed", slog.String("user_id", req.UserId), slog.Int("count", len(out)))
+	return &schedulev1.ListProgramsResponse{Programs: out}, nil
+}
+
+func (s *AppointmentsServer) CancelProgram(ctx context.Context, req *schedulev1.CancelProgramRequest) (*schedulev1.CancelProgramResponse, error) {
+	log := s.log.With(slog.String("rpc", "CancelProgram"))
+
+	if req == nil {
+		log.Warn("invalid request", slog.String("reason", "nil_request"))
+		return nil, status.Error(codes.InvalidArgument, "request is required")
+	}
+	id, err := uuid.Parse(req.ProgramId)
+	if err != nil {
+		log.Warn("invalid request", slog.String("reason", "invalid_uuid"), slog.String("user_id", req.UserId))
+		return nil, status.Error(codes.InvalidArgument, "program_id must be a UUID")
+	}
+
+	out, err := s.svc.CancelProgram(ctx, req.UserId, id)
+	if err != nil {
+		return nil, programError(log, err, "cancel", id, req.UserId)
+	}
+
+	log.Info("program cancelled",
+		slog.String("program_id", id.String()),
+		slog.String("user_id", req.UserId),
+		slog.Int("appointments_deleted", len(out.AppointmentsDeleted)),
+		slog.Int("series_ended", out.SeriesEnded),
+		slog.Int("series_deleted", out.SeriesDeleted),
+	)
+	return &schedulev1.CancelProgramResponse{
+		Program:             toProtoProgram(out.Program),
+		AppointmentsDeleted: int32(len(out.AppointmentsDeleted)),
+		SeriesEnded:         int32(out.SeriesEnded),
+		SeriesDeleted:       int32(out.SeriesDeleted),
+	}, nil
+}
+
+func (s *AppointmentsServer) CreateEmbedToken(ctx context.Context, req *schedulev1.CreateEmbedTokenRequest) (*schedulev1.CreateEmbedTokenResponse, error) {
+	log := s.log.With(slog.String("rpc", "CreateEmbedToken"))
+
+	if req == nil {
+		log.Warn("invalid request", slog.String("reason", "nil_request"))
+		return nil, status.Error(codes.InvalidArgument, "request is required")
+	}
+
+	token, err := s.svc.CreateEmbedToken(ctx, appointments.EmbedTokenInput{
+		UserID:       req.UserId,
+		SlotDuration: req.SlotDuration.AsDuration(),
+		WorkingHours: fromProtoWorkingHours(req.WorkingHours),
+		TTL:          req.Ttl.AsDuration(),
+	})
+	if err != nil {
+		if errors.Is(err, appointments.ErrEmbedDisabled) {
+			log.Warn("embed token refused", slog.String("reason", "embed_disabled"), slog.String("user_id", req.UserId))
+			return nil, status.Error(codes.FailedPrecondition, err.Error())
+		}
+		var vErr *appointments.ValidationError
+		if errors.As(err, &vErr) {
+			log.Warn("invalid request", slog.Any("err", err), slog.String("user_id", req.UserId))
+			return nil, status.Error(codes.InvalidArgument, vErr.Error())
+		}
+		if code, msg, ok := retryableStoreError(err); ok {
+			log.Warn("create embed token failed; retryable", slog.Any("err", err), slog.String("user_id", req.UserId))
+			return nil, status.Error(code, msg)
+		}
+		log.Error("create embed token failed", slog.Any("err", err), slog.String("user_id", req.UserId))
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+
+	log.Info("embed token created", slog.String("user_id", req.UserId), slog.Time("expires_at", token.ExpiresAt))
+	return &schedulev1.CreateEmbedTokenResponse{Token: token.Token, ExpiresAt: timestamppb.New(token.ExpiresAt)}, nil
+}
+
+func (s *AppointmentsServer) GetSlotSettings(ctx context.Context, req *schedulev1.GetSlotSettingsRequest) (*schedulev1.GetSlotSettingsResponse, error) {
+	log := s.log.With(slog.String("rpc", "GetSlotSettings"))
+
+	if req == nil {
+		log.Warn("invalid request", slog.String("reason", "nil_request"))
+		return nil, status.Error(codes.InvalidArgument, "request is required")
+	}
+
+	settings, err := s.svc.GetSlotSettings(ctx, req.UserId)
+	if err != nil {
+		return nil, settingsError(log, err, "get", req.UserId)
+	}
+
+	log.Debug("slot settings fetched", slog.String("user_id", req.UserId))
+	return &schedulev1.GetSlotSettingsResponse{Settings: toProtoSlotSettings(settings)}, nil
+}
+
+func (s *AppointmentsServer) UpdateSlotSettings(ctx context.Context, req *schedulev1.UpdateSlotSettingsRequest) (*schedulev1.UpdateSlotSettingsResponse, error) {
+	log := s.log.With(slog.String("rpc", "UpdateSlotSettings"))
+
+	if req == nil {
+		log.Warn("invalid request", slog.String("reason", "nil_request"))
+		return nil, status.Error(codes.InvalidArgument, "request is required")
+	}
+
+	settings, err := s.svc.UpdateSlotSettings(ctx, appointments.SlotSettingsInput{
+		UserID:           req.UserId,
+		AlignmentMinutes: int(req.AlignmentMinutes),
+		TimeZone:         req.TimeZone,
+	})
+	if err != nil {
+		return nil, settingsError(log, err, "update", req.UserId)
+	}
+
+	log.Info("slot settings updated", slog.String("user_id", req.UserId), slog.Int("alignment_minutes", settings.SlotAlignmentMinutes))
+	return &schedulev1.UpdateSlotSettingsResponse{Settings: toProtoSlotSettings(settings)}, nil
+}
+
+func (s *AppointmentsServer) UpdateDailyBreaks(ctx context.Context, req *schedulev1.UpdateDailyBreaksRequest) (*schedulev1.UpdateDailyBreaksResponse, error) {
+	log := s.log.With(slog.String("rpc", "UpdateDailyBreaks"))
+
+	if req == nil {
+		log.Warn("invalid request", slog.String("reason", "nil_request"))
+		return nil, status.Error(codes.InvalidArgument, "request is required")
+	}
+
+	breaks := make([]domain.DailyBreak, 0, len(req.Breaks))
+	for _, b := range req.Breaks {
+		if b == nil {
+			continue
+		}
+		breaks = append(breaks, domain.DailyBreak{Label: b.Label, StartMinute: int(b.StartMinute), EndMinute: int(b.EndMinute)})
+	}
+	settings, err := s.svc.UpdateDailyBreaks(ctx, req.UserId, breaks)
+	if err != nil {
+		return nil, settingsError(log, err, "update", req.UserId)
+	}
+
+	log.Info("daily breaks updated", slog.String("user_id", req.UserId), slog.Int("count", len(settings.DailyBreaks)))
+	return &schedulev1.UpdateDailyBreaksResponse{Settings: toProtoSlotSettings(settings)}, nil
+}
+
+func settingsError(log *slog.Logger, err error, action, userID string) error {
+	var vErr *appointments.ValidationError
+	if errors.As(err, &vErr) {
+		log.Warn("invalid request", slog.Any("err", err), slog.String("user_id", userID))
+		return status.Error(codes.InvalidArgument, vErr.Error())
+	}
+	if code, msg, ok := writeRefusedError(err); ok {
+		log.Info("slot settings "+action+" refused", slog.Any("err", err), slog.String("user_id", userID))
+		return status.Error(code, msg)
+	}
+	if code, msg, ok := retryableStoreError(err); ok {
+		log.Warn("slot settings "+action+" failed; retryable", slog.Any("err", err), slog.String("user_id", userID))
+		return status.Error(code, msg)
+	}
+	log.Error("slot settings "+action+" failed", slog.Any("err", err), slog.String("user_id", userID))
+	return status.Error(codes.Internal, "internal error")
+}
+
+func toProtoSlotSettings(u domain.UserSettings) *schedulev1.SlotSettings {
+	out := &schedulev1.SlotSettings{
+		UserId:           u.UserID,
+		AlignmentMinutes: uint32(u.SlotAlignmentMinutes),
+		TimeZone:         u.SlotTimeZone,
+	}
+	for _, b := range u.DailyBreaks {
+		out.DailyBreaks = append(out.DailyBreaks, &schedulev1.DailyBreak{Label: b.Label, StartMinute: uint32(b.StartMinute), EndMinute: uint32(b.EndMinute)})
+	}
+	if !u.UpdatedAt.IsZero() {
+		out.UpdatedAt = timestamppb.New(u.UpdatedAt)
+	}
+	return out
+}
+
+func (s *AppointmentsServer) CreateTimeOff(ctx context.Context, req *schedulev1.CreateTimeOffRequest) (*schedulev1.CreateTimeOffResponse, error) {
+	log := s.log.With(slog.String("rpc", "CreateTimeOff"))
+
+	if req == nil {
+		log.Warn("invalid request", slog.String("reason", "nil_request"))
+		return nil, status.Error(codes.InvalidArgument, "request is required")
+	}
+
+	timeOff, err := s.svc.CreateTimeOff(ctx, appointments.TimeOffInput{
+		UserID:     req.UserId,
+		Title:      req.Title,
+		StartTime:  req.StartTime.AsTime(),
+		EndTime:    req.EndTime.AsTime(),
+		Recurrence: fromProtoTimeOffRecurrence(req.Recurrence),
+	})
+	if err != nil {
+		return nil, timeOffError(log, err, "create", uuid.Nil, req.UserId)
+	}
+
+	log.Info("time off created", slog.String("time_off_id", timeOff.ID.String()), slog.String("user_id", req.UserId))
+	return &schedulev1.CreateTimeOffResponse{TimeOff: toProtoTimeOff(timeOff)}, nil
+}
+
+func (s *AppointmentsServer) GetTimeOff(ctx context.Context, req *schedulev1.GetTimeOffRequest) (*schedulev1.GetTimeOffResponse, error) {
+	log := s.log.With(slog.String("rpc", "GetTimeOff"))
+
+	if req == nil {
+		log.Warn("invalid request", slog.String("reason", "nil_request"))
+		return nil, status.Error(codes.InvalidArgument, "request is required")
+	}
+	id, err := uuid.Parse(req.TimeOffId)
+	if err != nil {
+		log.Warn("invalid request", slog.String("reason", "invalid_uuid"), slog.String("user_id", req.UserId))
+		return nil, status.Error(codes.InvalidArgument, "time_off_id must be a UUID")
+	}
+
+	timeOff, err := s.svc.GetTimeOff(ctx, req.UserId, id)
+	if err != nil {
+		return nil, timeOffError(log, err, "get", id, req.UserId)
+	}
+
+	log.Debug("time off fetched", slog.String("time_off_id", id.String()), slog.String("user_id", req.UserId))
+	return &schedulev1.GetTimeOffResponse{TimeOff: toProtoTimeOff(timeOff)}, nil
+}
+
+func (s *AppointmentsServer) UpdateTimeOff(ctx context.Context, req *schedulev1.UpdateTimeOffRequest) (*schedulev1.UpdateTimeOffResponse, error) {
+	log := s.log.With(slog.String("rpc", "UpdateTimeOff"))
+
+	if req == nil {
+		log.Warn("invalid request", slog.String("reason", "nil_request"))
+		return nil, status.Error(codes.InvalidArgument, "request is required")
+	}
+	id, err := uuid.Parse(req.TimeOffId)
+	if err != nil {
+		log.Warn("invalid request", slog.String("reason", "invalid_uuid"), slog.String("user_id", req.UserId))
+		return nil, status.Error(codes.InvalidArgument, "time_off_id must be a UUID")
+	}
+
+	timeOff, err := s.svc.UpdateTimeOff(ctx, appointments.TimeOffInput{
+		UserID:     req.UserId,
+		TimeOffID:  id,
+		Title:      req.Title,
+		StartTime:  req.StartTime.AsTime(),
+		EndTime:    req.EndTime.AsTime(),
+		Recurrence: fromProtoTimeOffRecurrence(req.Recurrence),
+	})
+	if err != nil {
+		return nil, timeOffError(log, err, "update", id, req.UserId)
+	}
+
+	log.Info("time off updated", slog.String("time_off_id", id.String()), slog.String("user_id", req.UserId))
+	return &schedulev1.UpdateTimeOffResponse{TimeOff: toProtoTimeOff(timeOff)}, nil
+}
+
+func (s *AppointmentsServer) DeleteTimeOff(ctx context.Context, req *schedulev1.DeleteTimeOffRequest) (*schedulev1.DeleteTimeOffResponse, error) {
+	log := s.log.With(slog.String("rpc", "DeleteTimeOff"))
+
+	if req == nil {
+		log.Warn("invalid request", slog.String("reason", "nil_request"))
+		return nil, status.Error(codes.InvalidArgument, "request is required")
+	}
+	id, err := uuid.Parse(req.TimeOffId)
+	if err != nil {
+		log.Warn("invalid request", slog.String("reason", "invalid_uuid"), slog.String("user_id", req.UserId))
+		return nil, status.Error(codes.InvalidArgument, "time_off_id must be a UUID")
+	}
+
+	if err := s.svc.DeleteTimeOff(ctx, req.UserId, id); err != nil {
+		return nil, timeOffError(log, err, "delete", id, req.UserId)
+	}
+
+	log.Info("time off deleted", slog.String("time_off_id", id.String()), slog.String("user_id", req.UserId))
+	return &schedulev1.DeleteTimeOffResponse{}, nil
+}
+
+func (s *AppointmentsServer) ListTimeOff(ctx context.Context, req *schedulev1.ListTimeOffRequest) (*schedulev1.ListTimeOffResponse, error) {
+	log := s.log.With(slog.String("rpc", "ListTimeOff"))
+
+	if req == nil {
+		log.Warn("invalid request", slog.String("reason", "nil_request"))
+		return nil, status.Error(codes.InvalidArgument, "request is required")
+	}
+
+	records, err := s.svc.ListTimeOff(ctx, req.UserId)
+	if err != nil {
+		return nil, timeOffError(log, err, "list", uuid.Nil, req.UserId)
+	}
+
+	out := make([]*schedulev1.TimeOff, 0, len(records))
+	for _, t := range records {
+		out = append(out, toProtoTimeOff(t))
+	}
+	log.Debug("time off listed", slog.String("user_id", req.UserId), slog.Int("count", len(out)))
+	return &schedulev1.ListTimeOffResponse{TimeOff: out}, nil
+}
+
+func timeOffError(log *slog.Logger, err error, action string, id uuid.UUID, userID string) error {
+	attrs := []any{slog.String("user_id", userID)}
+	if id != uuid.Nil {
+		attrs = append(attrs, slog.String("time_off_id", id.String()))
+	}
+	if errors.Is(err, store.ErrNotFound) {
+		log.Info("time off not found", attrs...)
+		return status.Error(codes.NotFound, "time off not found")
+	}
+	var vErr *appointments.ValidationError
+	if errors.As(err, &vErr) {
+		log.Warn("invalid request", append(attrs, slog.Any("err", err))...)
+		return status.Error(codes.InvalidArgument, vErr.Error())
+	}
+	if code, msg, ok := writeRefusedError(err); ok {
+		log.Info("time off "+action+" refused", append(attrs, slog.Any("err", err))...)
+		return status.Error(code, msg)
+	}
+	if code, msg, ok := retryableStoreError(err); ok {
+		log.Warn("time off "+action+" failed; retryable", append(attrs, slog.Any("err", err))...)
+		return status.Error(code, msg)
+	}
+	log.Error("time off "+action+" failed", append(attrs, slog.Any("err", err))...)
+	return status.Error(codes.Internal, "internal error")
+}
+
+func fromProtoTimeOffRecurrence(r *schedulev1.TimeOffRecurrence) *appointments.TimeOffRecurrenceInput {
+	if r == nil {
+		return nil
+	}
+	out := &appointments.TimeOffRecurrenceInput{Interval: int(r.Interval), TimeZone: r.TimeZone}
+	for _, wd := range r.Weekdays {
+		if wd == schedulev1.Weekday_WEEKDAY_UNSPECIFIED {
+			continue
+		}
+		out.ByWeekday = append(out.ByWeekday, int16(wd))
+	}
+	if r.Until != nil {
+		u := r.Until.AsTime()
+		out.Until = &u
+	}
+	return out
+}
+
+func toProtoTimeOff(t domain.TimeOff) *schedulev1.TimeOff {
+	out := &schedulev1.TimeOff{
+		Id:        t.ID.String(),
+		UserId:    t.UserID,
+		Title:     t.Title,
+		StartTime: timestamppb.New(t.StartTime),
+		EndTime:   timestamppb.New(t.EndTime),
+		CreatedAt: timestamppb.New(t.CreatedAt),
+		UpdatedAt: timestamppb.New(t.UpdatedAt),
+	}
+	if t.Recurring() {
+		r := &schedulev1.TimeOffRecurrence{Interval: uint32(t.Interval), TimeZone: t.Timezone}
+		for _, wd := range t.ByWeekday {
+			if keepWeekday(wd) {
+				r.Weekdays = append(r.Weekdays, schedulev1.Weekday(wd))
+			}
+		}
+		if t.Until != nil {
+			r.Until = timestamppb.New(*t.Until)
+		}
+		out.Recurrence = r
+	}
+	return out
+}
+
+// contactError maps the errors the contact RPCs share.
+func contactError(log *slog.Logger, err error, action string, id uuid.UUID, userID string) error {
+	attrs := []any{slog.String("user_id", userID)}
+	if id != uuid.Nil {
+		attrs = append(attrs, slog.String("contact_id", id.String()))
+	}
+	if errors.Is(err, store.ErrNotFound) {
+		log.Info("contact not found", attrs...)
+		return status.Error(codes.NotFound, "contact not found")
+	}
+	var vErr *appointments.ValidationError
+	if errors.As(err, &vErr) {
+		log.Warn("invalid request", append(attrs, slog.Any("err", err))...)
+		return status.Error(codes.InvalidArgument, vErr.Error())
+	}
+	if code, msg, ok := writeRefusedError(err); ok {
+		log.Info("contact "+action+" refused", append(attrs, slog.Any("err", err))...)
+		return status.Error(code, msg)
+	}
+	if code, msg, ok := retryableStoreError(err); ok {
+		log.Warn("contact "+action+" failed; retryable", append(attrs, slog.Any("err", err))...)
+		return status.Error(code, msg)
+	}
+	log.Error("contact "+action+" failed", append(attrs, slog.Any("err", err))...)
+	return status.Error(codes.Internal, "internal error")
+}
+
+func snapshotError(log *slog.Logger, err error, action string, id uuid.UUID, userID string) error {
+	attrs := []any{slog.String("user_id", userID)}
+	if id != uuid.Nil {
+		attrs = append(attrs, slog.String("snapshot_id", id.String()))
+	}
+	if errors.Is(err, appointments.ErrSnapshotsUnavailable) {
+		log.Warn("calendar snapshots not configured", attrs...)
+		return status.Error(codes.Unimplemented, "Calendar snapshots are not enabled on this server.")
+	}
+	if errors.Is(err, store.ErrNotFound) {
+		log.Info("calendar snapshot not found", attrs...)
+		return status.Error(codes.NotFound, "calendar snapshot not found")
+	}
+	if errors.Is(err, appointments.ErrSnapshotCorrupt) {
+		log.Error("calendar snapshot corrupt", append(attrs, slog.Any("err", err))...)
+		return status.Error(codes.DataLoss, "This snapshot can no longer be read. Pick another snapshot.")
+	}
+	var vErr *appointments.ValidationError
+	if errors.As(err, &vErr) {
+		log.Warn("invalid request", append(attrs, slog.Any("err", err))...)
+		return status.Error(codes.InvalidArgument, vErr.Error())
+	}
+	if code, msg, ok := writeRefusedError(err); ok {
+		log.Info("calendar snapshot "+action+" refused", append(attrs, slog.Any("err", err))...)
+		return status.Error(code, msg)
+	}
+	if code, msg, ok := retryableStoreError(err); ok {
+		log.Warn("calendar snapshot "+action+" failed; retryable", append(attrs, slog.Any("err", err))...)
+		return status.Error(code, msg)
+	}
+	log.Error("calendar snapshot "+action+" failed", append(attrs, slog.Any("err", err))...)
+	return status.Error(codes.Internal, "internal error")
+}
+
+func programError(log *slog.Logger, err error, action string, id uuid.UUID, userID string) error {
+	attrs := []any{slog.String("user_id", userID)}
+	if id != uuid.Nil {
+		attrs = append(attrs, slog.String("program_id", id.String()))
+	}
+	if errors.Is(err, store.ErrNotFound) {
+		log.Info("program not found", attrs...)
+		return status.Error(codes.NotFound, "program not found")
+	}
+	var vErr *appointments.ValidationError
+	if errors.As(err, &vErr) {
+		log.Warn("invalid request", append(attrs, slog.Any("err", err))...)
+		return status.Error(codes.InvalidArgument, vErr.Error())
+	}
+	if code, msg, ok := writeRefusedError(err); ok {
+		log.Info("program "+action+" refused", append(attrs, slog.Any("err", err))...)
+		return status.Error(code, msg)
+	}
+	if code, msg, ok := retryableStoreError(err); ok {
+		log.Warn("program "+action+" failed; retryable", append(attrs, slog.Any("err", err))...)
+		return status.Error(code, msg)
+	}
+	log.Error("program "+action+" failed", append(attrs, slog.Any("err", err))...)
+	return status.Error(codes.Internal, "internal error")
+}
+
+func retryableStoreError(err error) (codes.Code, string, bool) {
+	switch {
+	case errors.Is(err, store.ErrSerialization):
+		return codes.Aborted, "The calendar changed while saving. Try again.", true
+	case errors.Is(err, store.ErrUnavailable):
+		return codes.Unavailable, "The service is temporarily unavailable. Try again.", true
+	}
+	return codes.OK, "", false
+}
+
+// writeRefusedError maps errors for writes that will keep failing however
+// often they are retried: the server is a read-only replica, or the
+// calendar's user is deactivated.
+func writeRefusedError(err error) (codes.Code, string, bool) {
+	switch {
+	case errors.Is(err, store.ErrReadOnly):
+		return codes.FailedPrecondition, "This server is a read-only replica.", true
+	case errors.Is(err, appointments.ErrUserDeactivated):
+		return codes.FailedPrecondition, "This calendar is frozen because its user was deactivated.", true
+	}
+	return codes.OK, "", false
+}
+
+func toProtoBlackoutWarnings(blackouts []domain.Blackout) []*schedulev1.BlackoutWarning {
+	out := make([]*schedulev1.BlackoutWarning, 0, len(blackouts))
+	for _, b := range blackouts {
+		out = append(out, &schedulev1.BlackoutWarning{
+			Title:     b.Title,
+			StartTime: timestamppb.New(b.StartTime),
+			EndTime:   timestamppb.New(b.EndTime),
+		})
+	}
+	return out
+}
+
+func toProtoWarnings(warnings []domain.Warning) []*schedulev1.Warning {
+	out := make([]*schedulev1.Warning, 0, len(warnings))
+	for _, w := range warnings {
+		out = append(out, &schedulev1.Warning{Code: string(w.Code), Message: w.Message})
+	}
+	return out
+}
+
+func toProtoAppointment(a domain.Appointment) *schedulev1.Appointment {
+	var externalRef *schedulev1.ExternalRef
+	if a.ExternalSystem != "" {
+		externalRef = &schedulev1.ExternalRef{System: a.ExternalSystem, Id: a.ExternalID}
+	}
+
+	return &schedulev1.Appointment{
+		Id:           a.ID.String(),
+		UserId:       a.UserID,
+		Title:        a.Title,
+		Notes:        a.Notes,
+		PrivateNotes: a.PrivateNotes,
+		StartTime:    timestamppb.New(a.StartTime),
+		EndTime:      timestamppb.New(a.EndTime),
+		CreatedAt:    timestamppb.New(a.CreatedAt),
+		UpdatedAt:    timestamppb.New(a.UpdatedAt),
+		Metadata:     a.Metadata,
+		ExternalRef:  externalRef,
+		TimeZone:     a.Timezone,
+		CreatedBy:    a.CreatedBy,
+		CheckedInAt:  optionalTimestamp(a.CheckedInAt),
+		CheckedOutAt: optionalTimestamp(a.CheckedOutAt),
+		ContactId:    optionalUUIDString(a.ContactID),
+		ProgramId:    optionalUUIDString(a.ProgramID),
+		Source:       a.Source,
+		Kind:         toProtoAppointmentKind(a),
+	}
+}
+
+func toProtoAppointmentKind(a domain.Appointment) schedulev1.AppointmentKind {
+	if a.Milestone() {
+		return schedulev1.AppointmentKind_APPOINTMENT_KIND_MILESTONE
+	}
+	return schedulev1.AppointmentKind_APPOINTMENT_KIND_EVENT
+}
+
+func fromProtoAppointmentKind(k schedulev1.AppointmentKind) (domain.AppointmentKind, bool) {
+	switch k {
+	case schedulev1.AppointmentKind_APPOINTMENT_KIND_UNSPECIFIED:
+		return "", true
+	case schedulev1.AppointmentKind_APPOINTMENT_KIND_EVENT:
+		return domain.AppointmentKindEvent, true
+	case schedulev1.AppointmentKind_APPOINTMENT_KIND_MILESTONE:
+		return domain.AppointmentKindMilestone, true
+	}
+	return "", false
+}
+
+// seriesEndTime reads a series' first end from duration, or from the
+// deprecated end_time, which must agree with duration when both are set. On
+// a bad request it returns a log reason and the client message.
+func seriesEndTime(req *schedulev1.CreateRecurringSeriesRequest) (time.Time, string, string) {
+	if req.StartTime == nil || (req.Duration == nil && req.EndTime == nil) {
+		return time.Time{}, "missing_times", "start_time and duration are required"
+	}
+	start := req.StartTime.AsTime()
+	if req.Duration == nil {
+		return req.EndTime.AsTime(), "", ""
+	}
+	if err := req.Duration.CheckValid(); err != nil {
+		return time.Time{}, "invalid_duration", "duration is out of range"
+	}
+	d := req.Duration.AsDuration()
+	if d <= 0 || d%time.Second != 0 {
+		return time.Time{}, "invalid_duration", "duration must be a positive whole number of seconds"
+	}
+	end := start.Add(d)
+	if req.EndTime != nil && !req.EndTime.AsTime().Equal(end) {
+		return time.Time{}, "duration_mismatch", fmt.Sprintf("end_time is %s after start_time but duration is %s; send only duration", req.EndTime.AsTime().Sub(start), d)
+	}
+	return end, "", ""
+}
+
+func toProtoRecurringSeries(s domain.RecurringSeries) *schedulev1.RecurringSeries {
+	duration := time.Duration(s.DurationSeconds) * time.Second
+
+	var next *timestamppb.Timestamp
+	if s.NextOccurrence != nil {
+		next = timestamppb.New(s.NextOccurrence.UTC())
+	}
+
+	return &schedulev1.RecurringSeries{
+		Id:                   s.ID.String(),
+		UserId:               s.UserID,
+		Title:                s.Title,
+		Notes:                s.Notes,
+		StartTime:            timestamppb.New(s.DTStart),
+		EndTime:              timestamppb.New(s.DTStart.Add(duration)),
+		Duration:             durationpb.New(duration),
+		Weekly:               toProtoWeeklyRecurrence(s),
+		CreatedAt:            timestamppb.New(s.CreatedAt),
+		UpdatedAt:            timestamppb.New(s.UpdatedAt),
+		OccurrencesRemaining: uint32(s.OccurrencesRemaining),
+		NextOccurrence:       next,
+		Metadata:             s.Metadata,
+		CreatedBy:            s.CreatedBy,
+		ProgramId:            optionalUUIDString(s.ProgramID),
+	}
+}
+
+func toProtoWeeklyRecurrence(s domain.RecurringSeries) *schedulev1.WeeklyRecurrence {
+	weekdays := make([]schedulev1.Weekday, 0, len(s.ByWeekday))
+	for _, wd := range s.ByWeekday {
+		if keepWeekday(wd) {
+			weekdays = append(weekdays, schedulev1.Weekday(wd))
+		}
+	}
+
+	var until *timestamppb.Timestamp
+	if s.Until != nil {
+		until = timestamppb.New(s.Until.UTC())
+	}
+
+	var count uint32
+	if s.Count != nil && *s.Count > 0 {
+		count = uint32(*s.Count)
+	}
+
+	weekStart := schedulev1.Weekday_WEEKDAY_UNSPECIFIED
+	if s.WeekStart != 0 && keepWeekday(s.WeekStart) {
+		weekStart = schedulev1.Weekday(s.WeekStart)
+	}
+
+	var weekdayTimes []*schedulev1.WeekdayTime
+	for _, wt := range s.WeekdayTimes {
+		if !keepWeekday(wt.Weekday) {
+			continue
+		}
+		weekdayTimes = append(weekdayTimes, &schedulev1.WeekdayTime{Weekday: schedulev1.Weekday(wt.Weekday), StartMinute: uint32(wt.StartMinute)})
+	}
+
+	return &schedulev1.WeeklyRecurrence{
+		Interval: uint32(s.Interval),
+		Weekdays: weekdays,
+		Until:    until,
+		Count:    count,
+		TimeZone: s.Timezone,
+
+		WeekStart:          weekStart,
+		DstGapPolicy:       toProtoDSTGap(s.DSTGap),
+		DstAmbiguousPolicy: toProtoDSTAmbiguous(s.DSTAmbiguous),
+		WeekdayTimes:       weekdayTimes,
+	}
+}
+
+// fromProtoDSTGap leaves unspecified policies empty so the service default
+// applies.
+func fromProtoDSTGap(p schedulev1.DstGapPolicy) domain.DSTGapPolicy {
+	switch p {
+	case schedulev1.DstGapPolicy_DST_GAP_POLICY_SHIFT_FORWARD:
+		return domain.DSTGapShiftForward
+	case schedulev1.DstGapPolicy_DST_GAP_POLICY_SKIP:
+		return domain.DSTGapSkip
+	}
+	return ""
+}
+
+func fromProtoDSTAmbiguous(p schedulev1.DstAmbiguousPolicy) domain.DSTAmbiguousPolicy {
+	switch p {
+	case schedulev1.DstAmbiguousPolicy_DST_AMBIGUOUS_POLICY_EARLIER:
+		return domain.DSTAmbiguousEarlier
+	case schedulev1.DstAmbiguousPolicy_DST_AMBIGUOUS_POLICY_LATER:
+		return domain.DSTAmbiguousLater
+	}
+	return ""
+}
+
+func toProtoDSTGap(p domain.DSTGapPolicy) schedulev1.DstGapPolicy {
+	switch p {
+	case domain.DSTGapShiftForward:
+		return schedulev1.DstGapPolicy_DST_GAP_POLICY_SHIFT_FORWARD
+	case domain.DSTGapSkip:
+		return schedulev1.DstGapPolicy_DST_GAP_POLICY_SKIP
+	case "":
+		return schedulev1.DstGapPolicy_DST_GAP_POLICY_UNSPECIFIED
+	}
+	return unrepresentableEnum[schedulev1.DstGapPolicy]()
+}
+
+func toProtoDSTAmbiguous(p domain.DSTAmbiguousPolicy) schedulev1.DstAmbiguousPolicy {
+	switch p {
+	case domain.DSTAmbiguousEarlier:
+		return schedulev1.DstAmbiguousPolicy_DST_AMBIGUOUS_POLICY_EARLIER
+	case domain.DSTAmbiguousLater:
+		return schedulev1.DstAmbiguousPolicy_DST_AMBIGUOUS_POLICY_LATER
+	case "":
+		return schedulev1.DstAmbiguousPolicy_DST_AMBIGUOUS_POLICY_UNSPECIFIED
+	}
+	return unrepresentableEnum[schedulev1.DstAmbiguousPolicy]()
+}
+
+func toProtoAttendance(a domain.OccurrenceAttendance) *schedulev1.OccurrenceAttendance {
+	attendanceStatus := schedulev1.AttendanceStatus_ATTENDANCE_STATUS_UNSPECIFIED
+	switch a.Status {
+	case domain.AttendanceStatusAttended:
+		attendanceStatus = schedulev1.AttendanceStatus_ATTENDANCE_STATUS_ATTENDED
+	case domain.AttendanceStatusMissed:
+		attendanceStatus = schedulev1.AttendanceStatus_ATTENDANCE_STATUS_MISSED
+	case "":
+	default:
+		attendanceStatus = unrepresentableEnum[schedulev1.AttendanceStatus]()
+	}
+
+	return &schedulev1.OccurrenceAttendance{
+		SeriesId:        a.SeriesID.String(),
+		OccurrenceId:    domain.EncodeOccurrenceID(a.SeriesID, a.OccurrenceStart),
+		ParticipantId:   a.ParticipantID,
+		Status:          attendanceStatus,
+		OccurrenceStart: timestamppb.New(a.OccurrenceStart),
+		UpdatedAt:       timestamppb.New(a.UpdatedAt),
+	}
+}
+
+func toProtoOccurrence(o domain.RecurringOccurrence) *schedulev1.Occurrence {
+	return &schedulev1.Occurrence{
+		SeriesId:     o.SeriesID.String(),
+		OccurrenceId: o.ID,
+		UserId:       o.UserID,
+		Title:        o.Title,
+		Notes:        o.Notes,
+		StartTime:    timestamppb.New(o.StartTime),
+		EndTime:      timestamppb.New(o.EndTime),
+		Metadata:     o.Metadata,
+		TimeZone:     o.Timezone,
+	}
+}
+
+func toProtoSeriesFinding(f domain.SeriesFinding) *schedulev1.SeriesFinding {
+	kind := schedulev1.SeriesFindingKind_SERIES_FINDING_KIND_UNSPECIFIED
+	switch f.Kind {
+	case domain.SeriesFindingInvalidTimeZone:
+		kind = schedulev1.SeriesFindingKind_SERIES_FINDING_KIND_INVALID_TIME_ZONE
+	case domain.SeriesFindingInvalidRule:
+		kind = schedulev1.SeriesFindingKind_SERIES_FINDING_KIND_INVALID_RULE
+	case domain.SeriesFindingExceptionOutsideSeries:
+		kind = schedulev1.SeriesFindingKind_SERIES_FINDING_KIND_EXCEPTION_OUTSIDE_SERIES
+	case domain.SeriesFindingExceptionOffPattern:
+		kind = schedulev1.SeriesFindingKind_SERIES_FINDING_KIND_EXCEPTION_OFF_PATTERN
+	case domain.SeriesFindingInvalidOverride:
+		kind = schedulev1.SeriesFindingKind_SERIES_FINDING_KIND_INVALID_OVERRIDE
+	case "":
+	default:
+		kind = unrepresentableEnum[schedulev1.SeriesFindingKind]()
+	}
+
+	out := &schedulev1.SeriesFinding{Kind: kind, Repaired: f.Repaired}
+	if f.ExceptionID != uuid.Nil {
+		out.ExceptionId = f.ExceptionID.String()
+		out.OccurrenceStart = timestamppb.New(f.OccurrenceStart)
+	}
+	return out
+}
+
+func toProtoCalendarConflict(c appointments.CalendarConflict) *schedulev1.CalendarConflict {
+	cause := schedulev1.ConflictCause_CONFLICT_CAUSE_UNSPECIFIED
+	switch c.Cause {
+	case appointments.ConflictCauseOverlap:
+		cause = schedulev1.ConflictCause_CONFLICT_CAUSE_OVERLAP
+	case appointments.ConflictCauseExternal:
+		cause = schedulev1.ConflictCause_CONFLICT_CAUSE_EXTERNAL
+	case appointments.ConflictCauseOverride:
+		cause = schedulev1.ConflictCause_CONFLICT_CAUSE_OVERRIDE
+	}
+	return &schedulev1.CalendarConflict{
+		First:        toProtoCalendarEntry(c.First),
+		Second:       toProtoCalendarEntry(c.Second),
+		OverlapStart: timestamppb.New(c.OverlapStart),
+		OverlapEnd:   timestamppb.New(c.OverlapEnd),
+		Cause:        cause,
+	}
+}
+
+func toProtoCalendarEntry(e appointments.CalendarEntry) *schedulev1.CalendarEntry {
+	out := &schedulev1.CalendarEntry{
+		OccurrenceId: e.OccurrenceID,
+		Title:        e.Title,
+		Source:       e.Source,
+		StartTime:    timestamppb.New(e.StartTime),
+		EndTime:      timestamppb.New(e.EndTime),
+		Overridden:   e.Overridden,
+	}
+	if e.AppointmentID != uuid.Nil {
+		out.AppointmentId = e.AppointmentID.String()
+	}
+	if e.SeriesID != uuid.Nil {
+		out.SeriesId = e.SeriesID.String()
+	}
+	return out
+}
+
+func toProtoProposal(p domain.AppointmentProposal) *schedulev1.AppointmentProposal {
+	out := &schedulev1.AppointmentProposal{
+		Id:          p.ID.String(),
+		ProposerId:  p.ProposerID,
+		RecipientId: p.RecipientID,
+		Title:       p.Title,
+		Notes:       p.Notes,
+		StartTime:   timestamppb.New(p.StartTime),
+		EndTime:     timestamppb.New(p.EndTime),
+		ExpiresAt:   timestamppb.New(p.ExpiresAt),
+		CreatedAt:   timestamppb.New(p.CreatedAt),
+	}
+	switch p.Status {
+	case domain.ProposalStatusPending:
+		out.Status = schedulev1.ProposalStatus_PROPOSAL_STATUS_PENDING
+	case domain.ProposalStatusAccepted:
+		out.Status = schedulev1.ProposalStatus_PROPOSAL_STATUS_ACCEPTED
+	case domain.ProposalStatusDeclined:
+		out.Status = schedulev1.ProposalStatus_PROPOSAL_STATUS_DECLINED
+	case domain.ProposalStatusExpired:
+		out.Status = schedulev1.ProposalStatus_PROPOSAL_STATUS_EXPIRED
+	case "":
+	default:
+		out.Status = unrepresentableEnum[schedulev1.ProposalStatus]()
+	}
+	if p.RespondedAt != nil {
+		out.RespondedAt = timestamppb.New(*p.RespondedAt)
+	}
+	if p.ProposerAppointmentID != nil {
+		out.ProposerAppointmentId = p.ProposerAppointmentID.String()
+	}
+	if p.RecipientAppointmentID != nil {
+		out.RecipientAppointmentId = p.RecipientAppointmentID.String()
+	}
+	return out
+}
+
+func toProtoSlotHold(h domain.SlotHold) *schedulev1.SlotHold {
+	return &schedulev1.SlotHold{
+		Id:        h.ID.String(),
+		UserId:    h.UserID,
+		StartTime: timestamppb.New(h.StartTime),
+		EndTime:   timestamppb.New(h.EndTime),
+		ExpiresAt: timestamppb.New(h.ExpiresAt),
+	}
+}
+
+// parseSplitTimeZone returns a nil location when splitting was not requested.
+// localTimeZones caches zones loaded while rendering one response.
+type localTimeZones map[string]*time.Location
+
+// format renders start and end as RFC 3339 wall-clock strings with the
+// offset of tz. Records without a zone, or with one this host cannot load,
+// are rendered in UTC.
+func (l localTimeZones) format(start, end time.Time, tz string) (string, string) {
+	loc, ok := l[tz]
+	if !ok {
+		loc = time.UTC
+		if tz != "" {
+			if loaded, err := time.LoadLocation(tz); err == nil {
+				loc = loaded
+			}
+		}
+		l[tz] = loc
+	}
+	return start.In(loc).Format(time.RFC3339), end.In(loc).Format(time.RFC3339)
+}
+
+func parseSplitTimeZone(tz string) (*time.Location, bool) {
+	tz = strings.TrimSpace(tz)
+	if tz == "" {
+		return nil, true
+	}
+	loc, err := time.LoadLocation(tz)
+	if err != nil {
+		return nil, false
+	}
+	return loc, true
+}
+
+func toProtoDaySegments(id string, start, end time.Time, loc *time.Location) []*schedulev1.DaySegment {
+	spans := domain.SplitByLocalDay(start, end, loc)
+	out := make([]*schedulev1.DaySegment, 0, len(spans))
+	for _, s := range spans {
+		out = append(out, &schedulev1.DaySegment{
+			Id:        id,
+			LocalDate: s.Date.Format(time.DateOnly),
+			StartTime: timestamppb.New(s.Start),
+			EndTime:   timestamppb.New(s.End),
+		})
+	}
+	return out
+}
+
+func parseLinkIDs(appointmentID, relatedID string) (uuid.UUID, uuid.UUID, error) {
+	id, err := uuid.Parse(appointmentID)
+	if err != nil {
+		return uuid.Nil, uuid.Nil, status.Error(codes.InvalidArgument, "appointment_id must be a UUID")
+	}
+	related, err := uuid.Parse(relatedID)
+	if err != nil {
+		return uuid.Nil, uuid.Nil, status.Error(codes.InvalidArgument, "related_appointment_id must be a UUID")
+	}
+	return id, related, nil
+}
+
+func fromProtoLinkKind(kind schedulev1.AppointmentLinkKind) (domain.AppointmentLinkKind, bool) {
+	switch kind {
+	case schedulev1.AppointmentLinkKind_APPOINTMENT_LINK_KIND_FOLLOW_UP_OF:
+		return domain.AppointmentLinkKindFollowUpOf, true
+	case schedulev1.AppointmentLinkKind_APPOINTMENT_LINK_KIND_PREP_FOR:
+		return domain.AppointmentLinkKindPrepFor, true
+	}
+	return "", false
+}
+
+func toProtoCalendarChange(c domain.CalendarChange) *schedulev1.CalendarChange {
+	entity := schedulev1.ChangeEntity_CHANGE_ENTITY_UNSPECIFIED
+	switch c.EntityType {
+	case domain.ChangeEntityAppointment:
+		entity = schedulev1.ChangeEntity_CHANGE_ENTITY_APPOINTMENT
+	case domain.ChangeEntitySeries:
+		entity = schedulev1.ChangeEntity_CHANGE_ENTITY_SERIES
+	case domain.ChangeEntityHold:
+		entity = schedulev1.ChangeEntity_CHANGE_ENTITY_HOLD
+	case domain.ChangeEntityProposal:
+		entity = schedulev1.ChangeEntity_CHANGE_ENTITY_PROPOSAL
+	case "":
+	default:
+		entity = unrepresentableEnum[schedulev1.ChangeEntity]()
+	}
+	op := schedulev1.ChangeOp_CHANGE_OP_UNSPECIFIED
+	switch c.Op {
+	case domain.ChangeOpCreated:
+		op = schedulev1.ChangeOp_CHANGE_OP_CREATED
+	case domain.ChangeOpUpdated:
+		op = schedulev1.ChangeOp_CHANGE_OP_UPDATED
+	case domain.ChangeOpDeleted:
+		op = schedulev1.ChangeOp_CHANGE_OP_DELETED
+	case domain.ChangeOpExpired:
+		op = schedulev1.ChangeOp_CHANGE_OP_EXPIRED
+	case "":
+	default:
+		op = unrepresentableEnum[schedulev1.ChangeOp]()
+	}
+	return &schedulev1.CalendarChange{
+		EntityType: entity,
+		EntityId:   c.EntityID.String(),
+		Op:         op,
+		ChangedAt:  timestamppb.New(c.ChangedAt),
+	}
+}
+
+func fromProtoMutationKind(k schedulev1.MutationKind) store.MutationKind {
+	switch k {
+	case schedulev1.MutationKind_MUTATION_KIND_CREATE:
+		return store.MutationCreate
+	case schedulev1.MutationKind_MUTATION_KIND_UPDATE:
+		return store.MutationUpdate
+	case schedulev1.MutationKind_MUTATION_KIND_DELETE:
+		return store.MutationDelete
+	}
+	return ""
+}
+
+func toProtoMutationResult(r appointments.MutationResult) *schedulev1.MutationResult {
+	out := &schedulev1.MutationResult{Status: schedulev1.MutationStatus_MUTATION_STATUS_ACCEPTED}
+	switch {
+	case r.Rejected != "":
+		out.Status = schedulev1.MutationStatus_MUTATION_STATUS_REJECTED
+		out.Message = r.Rejected
+	case r.Conflict != "":
+		out.Status = schedulev1.MutationStatus_MUTATION_STATUS_CONFLICTED
+		switch r.Conflict {
+		case store.ConflictVersion:
+			out.Conflict = schedulev1.MutationConflict_MUTATION_CONFLICT_VERSION
+		case store.ConflictDeleted:
+			out.Conflict = schedulev1.MutationConflict_MUTATION_CONFLICT_DELETED
+		case store.ConflictExists:
+			out.Conflict = schedulev1.MutationConflict_MUTATION_CONFLICT_EXISTS
+		case store.ConflictOverlap:
+			out.Conflict = schedulev1.MutationConflict_MUTATION_CONFLICT_OVERLAP
+		}
+	}
+	if r.Current != nil {
+		out.Current = toProtoAppointment(*r.Current)
+	}
+	return out
+}
+
+func fromProtoWorkingHours(wh *schedulev1.WorkingHours) *appointments.WorkingHoursInput {
+	if wh == nil {
+		return nil
+	}
+	weekdays := make([]int16, 0, len(wh.Weekdays))
+	for _, wd := range wh.Weekdays {
+		if wd == schedulev1.Weekday_WEEKDAY_UNSPECIFIED {
+			continue
+		}
+		weekdays = append(weekdays, int16(wd))
+	}
+	return &appointments.WorkingHoursInput{
+		TimeZone:    wh.TimeZone,
+		StartMinute: int(wh.StartMinute),
+		EndMinute:   int(wh.EndMinute),
+		Weekdays:    weekdays,
+	}
+}
+
+// optionalUUID parses an optional id field; an empty string is no id.
+func optionalUUID(s string) (*uuid.UUID, bool) {
+	if s == "" {
+		return nil, true
+	}
+	id, err := uuid.Parse(s)
+	if err != nil {
+		return nil, false
+	}
+	return &id, true
+}
+
+func optionalUUIDString(id *uuid.UUID) string {
+	if id == nil {
+		return ""
+	}
+	return id.String()
+}
+
+func toProtoContact(c domain.Contact) *schedulev1.Contact {
+	return &schedulev1.Contact{
+		Id:        c.ID.String(),
+		UserId:    c.UserID,
+		Name:      c.Name,
+		Email:     c.Email,
+		Phone:     c.Phone,
+		CreatedAt: timestamppb.New(c.CreatedAt),
+		UpdatedAt: timestamppb.New(c.UpdatedAt),
+	}
+}
+
+func toProtoCalendarSnapshot(s domain.CalendarSnapshotInfo) *schedulev1.CalendarSnapshot {
+	return &schedulev1.CalendarSnapshot{
+		Id:            s.ID.String(),
+		UserId:        s.UserID,
+		Label:         s.Label,
+		FormatVersion: int32(s.FormatVersion),
+		Checksum:      s.Checksum,
+		SizeBytes:     int32(s.SizeBytes),
+		Contacts:      int32(s.Contacts),
+		Appointments:  int32(s.Appointments),
+		Series:        int32(s.Series),
+		Exceptions:    int32(s.Exceptions),
+		CreatedAt:     timestamppb.New(s.CreatedAt),
+	}
+}
+
+func toProtoProgram(p domain.Program) *schedulev1.Program {
+	return &schedulev1.Program{
+		Id:          p.ID.String(),
+		UserId:      p.UserID,
+		Title:       p.Title,
+		Notes:       p.Notes,
+		CancelledAt: optionalTimestamp(p.CancelledAt),
+		CreatedAt:   timestamppb.New(p.CreatedAt),
+		UpdatedAt:   timestamppb.New(p.UpdatedAt),
+	}
+}
+
+// optionalTime converts ts, treating an unset timestamp as the zero time
+// rather than the Unix epoch.
+func optionalTime(ts *timestamppb.Timestamp) time.Time {
+	if ts == nil {
+		return time.Time{}
+	}
+	return ts.AsTime()
+}
+
+func toProtoDelegationGrant(g domain.DelegationGrant) *schedulev1.DelegationGrant {
+	return &schedulev1.DelegationGrant{
+		PrincipalId: g.PrincipalID,
+		DelegateId:  g.DelegateID,
+		CreatedAt:   timestamppb.New(g.CreatedAt),
+	}
+}
+
+func toProtoLink(l domain.AppointmentLink) *schedulev1.AppointmentLink {
+	kind := schedulev1.AppointmentLinkKind_APPOINTMENT_LINK_KIND_UNSPECIFIED
+	switch l.Kind {
+	case domain.AppointmentLinkKindFollowUpOf:
+		kind = schedulev1.AppointmentLinkKind_APPOINTMENT_LINK_KIND_FOLLOW_UP_OF
+	case domain.AppointmentLinkKindPrepFor:
+		kind = schedulev1.AppointmentLinkKind_APPOINTMENT_LINK_KIND_PREP_FOR
+	case "":
+	default:
+		kind = unrepresentableEnum[schedulev1.AppointmentLinkKind]()
+	}
+
+	return &schedulev1.AppointmentLink{
+		AppointmentId:        l.AppointmentID.String(),
+		RelatedAppointmentId: l.RelatedID.String(),
+		Kind:                 kind,
+	}
+}
+
+func (s *AppointmentsServer) UpdateSeriesEnd(ctx context.Context, req *schedulev1.UpdateSeriesEndRequest) (*schedulev1.UpdateSeriesEndResponse, error) {
+	log := s.log.With(slog.String("rpc", "UpdateSeriesEnd"))
+
+	if req == nil {
+		log.Warn("invalid request", slog.String("reason", "nil_request"))
+		return nil, status.Error(codes.InvalidArgument, "request is required")
+	}
+	id, err := uuid.Parse(req.SeriesId)
+	if err != nil {
+		log.Warn("invalid request", slog.String("reason", "invalid_uuid"), slog.String("user_id", req.UserId))
+		return nil, status.Error(codes.InvalidArgument, "series_id must be a UUID")
+	}
+	in := appointments.UpdateSeriesEndInput{UserID: req.UserId, SeriesID: id}
+	if req.Until != nil {
+		until := req.Until.AsTime()
+		in.Until = &until
+	}
+	if req.Count > 0 {
+		count := int(req.Count)
+		in.Count = &count
+	}
+
+	series, removed, err := s.svc.UpdateSeriesEnd(ctx, in)
+	if err != nil {
+		if errors.Is(err, store.ErrNotFound) {
+			log.Info("recurring series not found", slog.String("series_id", id.String()), slog.String("user_id", req.UserId))
+			return nil, status.Error(codes.NotFound, "recurring series not found")
+		}
+		var vErr *appointments.ValidationError
+		if errors.As(err, &vErr) {
+			log.Warn("invalid request", slog.Any("err", err), slog.String("series_id", id.String()), slog.String("user_id", req.UserId))
+			return nil, status.Error(codes.InvalidArgument, vErr.Error())
+		}
+		if code, msg, ok := writeRefusedError(err); ok {
+			log.Info("series end update refused", slog.Any("err", err), slog.String("series_id", id.String()), slog.String("user_id", req.UserId))
+			return nil, status.Error(code, msg)
+		}
+		if code, msg, ok := retryableStoreError(err); ok {
+			log.Warn("series end update failed; retryable", slog.Any("err", err), slog.String("series_id", id.String()), slog.String("user_id", req.UserId))
+			return nil, status.Error(code, msg)
+		}
+		log.Error("series end update failed", slog.Any("err", err), slog.String("series_id", id.String()), slog.String("user_id", req.UserId))
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+
+	log.Info(
+		"series end updated",
+		slog.String("series_id", id.String()),
+		slog.String("user_id", req.UserId),
+		slog.Int("removed_exceptions", removed),
+	)
+
+	return &schedulev1.UpdateSeriesEndResponse{Series: toProtoRecurringSeries(series), RemovedExceptions: uint32(removed)}, nil
+}
+
+func (s *AppointmentsServer) ChangeSeriesTimeZone(ctx context.Context, req *schedulev1.ChangeSeriesTimeZoneRequest) (*schedulev1.ChangeSeriesTimeZoneResponse, error) {
+	log := s.log.With(slog.String("rpc", "ChangeSeriesTimeZone"))
+
+	if req == nil {
+		log.Warn("invalid request", slog.String("reason", "nil_request"))
+		return nil, status.Error(codes.InvalidArgument, "request is required")
+	}
+	ids := make([]uuid.UUID, 0, len(req.SeriesIds))
+	for _, raw := range req.SeriesIds {
+		id, err := uuid.Parse(raw)
+		if err != nil {
+			log.Warn("invalid request", slog.String("reason", "invalid_uuid"), slog.String("user_id", req.UserId))
+			return nil, status.Error(codes.InvalidArgument, "series_ids must be UUIDs")
+		}
+		ids = append(ids, id)
+	}
+	in := appointments.ChangeSeriesTimeZoneInput{
+		UserID:            req.UserId,
+		SeriesIDs:         ids,
+		TimeZone:          req.TimeZone,
+		Preview:           req.Preview,
+		ConfirmationToken: req.ConfirmationToken,
+	}
+	switch req.Keep {
+	case schedulev1.TimeZoneKeep_TIME_ZONE_KEEP_WALL_TIME:
+		in.Keep = domain.TimeZoneKeepWallTime
+	case schedulev1.TimeZoneKeep_TIME_ZONE_KEEP_INSTANT:
+		in.Keep = domain.TimeZoneKeepInstant
+	}
+
+	result, err := s.svc.ChangeSeriesTimeZone(ctx, in)
+	if err != nil {
+		if errors.Is(err, store.ErrNotFound) {
+			log.Info("recurring series not found", slog.String("user_id", req.UserId))
+			return nil, status.Error(codes.NotFound, "recurring series not found")
+		}
+		if errors.Is(err, store.ErrConflict) {
+			markConflict(ctx)
+			log.Info("series time zone change conflict", slog.String("user_id", req.UserId), slog.String("time_zone", req.TimeZone))
+			return nil, status.Error(codes.FailedPrecondition, "A moved occurrence would overlap another appointment. Nothing was changed.")
+		}
+		if errors.Is(err, appointments.ErrConfirmationRequired) {
+			log.Info("series time zone change not confirmed", slog.Any("err", err), slog.String("user_id", req.UserId))
+			return nil, status.Error(codes.FailedPrecondition, "This moves many series. Preview it and send the confirmation_token the preview returns.")
+		}
+		var vErr *appointments.ValidationError
+		if errors.As(err, &vErr) {
+			log.Warn("invalid request", slog.Any("err", err), slog.String("user_id", req.UserId))
+			return nil, status.Error(codes.InvalidArgument, vErr.Error())
+		}
+		if code, msg, ok := writeRefusedError(err); ok {
+			log.Info("series time zone change refused", slog.Any("err", err), slog.String("user_id", req.UserId))
+			return nil, status.Error(code, msg)
+		}
+		if code, msg, ok := retryableStoreError(err); ok {
+			log.Warn("series time zone change failed; retryable", slog.Any("err", err), slog.String("user_id", req.UserId))
+			return nil, status.Error(code, msg)
+		}
+		log.Error("series time zone change failed", slog.Any("err", err), slog.String("user_id", req.UserId))
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+
+	log.Info(
+		"series time zone changed",
+		slog.String("user_id", req.UserId),
+		slog.String("time_zone", req.TimeZone),
+		slog.String("keep", string(in.Keep)),
+		slog.Bool("preview", in.Preview),
+		slog.Int("series", len(result.Series)),
+		slog.Int("removed_exceptions", result.RemovedExceptions),
+	)
+
+	out := make([]*schedulev1.RecurringSeries, 0, len(result.Series))
+	for _, s := range result.Series {
+		out = append(out, toProtoRecurringSeries(s))
+	}
+	resp := &schedulev1.ChangeSeriesTimeZoneResponse{Series: out, RemovedExceptions: uint32(result.RemovedExceptions)}
+	if c := result.Confirmation; c != nil {
+		resp.ConfirmationToken = c.Token
+		resp.ConfirmationExpiresAt = timestamppb.New(c.ExpiresAt)
 	}
+	return resp, nil
 }
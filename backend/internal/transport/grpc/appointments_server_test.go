@@ -2,28 +2,103 @@ package grpc
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"log/slog"
+	"slices"
 	"testing"
 	"time"
 
 	"github.com/google/uuid"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"schedula/backend/internal/domain"
 	schedulev1 "schedula/backend/internal/gen/proto/schedula/v1"
+	schedulev2 "schedula/backend/internal/gen/proto/schedula/v2"
+	"schedula/backend/internal/limits"
+	"schedula/backend/internal/scheduling"
 	"schedula/backend/internal/service/appointments"
 	"schedula/backend/internal/store"
 )
 
 type fakeAppointmentsService struct {
 	createFn              func(ctx context.Context, in appointments.CreateInput) (domain.Appointment, error)
-	listFn                func(ctx context.Context, userID string, windowStart, windowEnd time.Time) ([]domain.Appointment, error)
-	deleteFn              func(ctx context.Context, userID string, appointmentID uuid.UUID) error
+	listFn                func(ctx context.Context, userID string, windowStart, windowEnd time.Time, filter store.AppointmentFilter) ([]domain.Appointment, error)
+	deleteFn              func(ctx context.Context, in appointments.DeleteInput) error
+	pageSnapshotFn        func(ctx context.Context, userID string) (int64, error)
+	addedSinceFn          func(ctx context.Context, userID string, seq int64) (map[uuid.UUID]bool, error)
+	getByExternalRefFn    func(ctx context.Context, userID string, ref appointments.ExternalRef) (domain.Appointment, error)
 	createRecurringSeries func(ctx context.Context, in appointments.CreateRecurringSeriesInput) (domain.RecurringSeries, error)
 	listOccurrencesFn     func(ctx context.Context, userID string, windowStart, windowEnd time.Time) ([]domain.RecurringOccurrence, error)
+	getRecurringSeriesFn  func(ctx context.Context, userID string, seriesID uuid.UUID) (domain.RecurringSeries, error)
+	listRecurringSeriesFn func(ctx context.Context, userID string, weekdays []int16) ([]domain.RecurringSeries, error)
+	markAttendanceFn      func(ctx context.Context, in appointments.MarkAttendanceInput) (domain.OccurrenceAttendance, error)
+	getAttendanceStatsFn  func(ctx context.Context, userID string, seriesID uuid.UUID) (domain.AttendanceStats, error)
+	userAnalyticsFn       func(ctx context.Context, userID string, windowStart, windowEnd time.Time) (domain.UserAnalytics, error)
+	batchFreeBusyFn       func(ctx context.Context, userIDs []string, windowStart, windowEnd time.Time) ([]appointments.UserFreeBusy, error)
+	suggestMeetingFn      func(ctx context.Context, in appointments.SuggestMeetingTimesInput) ([]scheduling.Suggestion, error)
+	simulateScheduleFn    func(ctx context.Context, in appointments.SimulateScheduleInput) (scheduling.SimulationResult, error)
+	repairSeriesFn        func(ctx context.Context, userID string, seriesID uuid.UUID, apply bool) (appointments.SeriesRepairReport, error)
+	skipOccurrencesFn     func(ctx context.Context, in appointments.SkipOccurrencesInput) (appointments.SkipOccurrencesResult, error)
+	updateSeriesEndFn     func(ctx context.Context, in appointments.UpdateSeriesEndInput) (domain.RecurringSeries, int, error)
+	changeTimeZoneFn      func(ctx context.Context, in appointments.ChangeSeriesTimeZoneInput) (appointments.ChangeSeriesTimeZoneResult, error)
+	auditCalendarFn       func(ctx context.Context, userID string, windowStart, windowEnd time.Time) (appointments.CalendarAudit, error)
+	getDailyAgendaFn      func(ctx context.Context, userID, date, timeZone string) (appointments.DailyAgenda, error)
+	getDaySummariesFn     func(ctx context.Context, userID, from, to string) (appointments.DaySummaries, error)
+	suggestEndTimeFn      func(ctx context.Context, userID string, start time.Time, desired time.Duration, hours *appointments.WorkingHoursInput) (appointments.EndTimeSuggestion, error)
+	reserveSlotFn         func(ctx context.Context, in appointments.ReserveSlotInput) (domain.SlotHold, error)
+	confirmHoldFn         func(ctx context.Context, in appointments.ConfirmHoldInput) (domain.Appointment, error)
+	releaseHoldFn         func(ctx context.Context, userID string, holdID uuid.UUID) error
+	proposeAppointmentFn  func(ctx context.Context, in appointments.ProposeAppointmentInput) (domain.AppointmentProposal, error)
+	listProposalsFn       func(ctx context.Context, userID string) ([]domain.AppointmentProposal, error)
+	acceptProposalFn      func(ctx context.Context, userID string, proposalID uuid.UUID) (domain.AppointmentProposal, error)
+	declineProposalFn     func(ctx context.Context, userID string, proposalID uuid.UUID) (domain.AppointmentProposal, error)
+	linkAppointmentsFn    func(ctx context.Context, userID string, appointmentID, relatedID uuid.UUID, kind domain.AppointmentLinkKind) (domain.AppointmentLink, error)
+	unlinkAppointmentsFn  func(ctx context.Context, userID string, appointmentID, relatedID uuid.UUID, kind domain.AppointmentLinkKind) error
+	listRelatedFn         func(ctx context.Context, userID string, appointmentID uuid.UUID) ([]domain.RelatedAppointment, error)
+	mergeAppointmentsFn   func(ctx context.Context, in appointments.MergeInput) (store.AppointmentMerge, error)
+	grantDelegationFn     func(ctx context.Context, principalID, delegateID string) (domain.DelegationGrant, error)
+	revokeDelegationFn    func(ctx context.Context, principalID, delegateID string) error
+	listDelegationsFn     func(ctx context.Context, principalID string) ([]domain.DelegationGrant, error)
+	watchSeriesFn         func(ctx context.Context, in appointments.WatchSeriesInput, send func(appointments.SeriesSnapshot) error) error
+	listChangesFn         func(ctx context.Context, in appointments.ListChangesInput) (appointments.ChangesPage, error)
+	syncAppointmentsFn    func(ctx context.Context, userID string, windowStart, windowEnd time.Time, filter store.AppointmentFilter, token string) (appointments.AppointmentsSync, error)
+	syncOccurrencesFn     func(ctx context.Context, userID string, windowStart, windowEnd time.Time, token string) (appointments.OccurrencesSync, error)
+	reconcileCalendarFn   func(ctx context.Context, in appointments.ReconcileInput) ([]appointments.MutationResult, error)
+	checkInFn             func(ctx context.Context, in appointments.SessionInput) (domain.Appointment, error)
+	checkOutFn            func(ctx context.Context, in appointments.SessionInput) (domain.Appointment, error)
+	exportBillableFn      func(ctx context.Context, in appointments.BillableHoursInput) (appointments.BillableExport, error)
+	createContactFn       func(ctx context.Context, in appointments.ContactInput) (domain.Contact, error)
+	getContactFn          func(ctx context.Context, userID string, contactID uuid.UUID) (domain.Contact, error)
+	updateContactFn       func(ctx context.Context, in appointments.ContactInput) (domain.Contact, error)
+	deleteContactFn       func(ctx context.Context, userID string, contactID uuid.UUID) error
+	listContactsFn        func(ctx context.Context, userID string) ([]domain.Contact, error)
+	createProgramFn       func(ctx context.Context, in appointments.ProgramInput) (domain.Program, error)
+	getProgramFn          func(ctx context.Context, userID string, programID uuid.UUID) (appointments.ProgramOverview, error)
+	listProgramsFn        func(ctx context.Context, userID string) ([]domain.Program, error)
+	cancelProgramFn       func(ctx context.Context, userID string, programID uuid.UUID) (store.ProgramCancellation, error)
+	createEmbedTokenFn    func(ctx context.Context, in appointments.EmbedTokenInput) (appointments.EmbedToken, error)
+	calendarVersionFn     func(ctx context.Context, userID string) (int64, error)
+	getSlotSettingsFn     func(ctx context.Context, userID string) (domain.UserSettings, error)
+	updateSlotSettingsFn  func(ctx context.Context, in appointments.SlotSettingsInput) (domain.UserSettings, error)
+	updateDailyBreaksFn   func(ctx context.Context, userID string, breaks []domain.DailyBreak) (domain.UserSettings, error)
+	createTimeOffFn       func(ctx context.Context, in appointments.TimeOffInput) (domain.TimeOff, error)
+	getTimeOffFn          func(ctx context.Context, userID string, timeOffID uuid.UUID) (domain.TimeOff, error)
+	updateTimeOffFn       func(ctx context.Context, in appointments.TimeOffInput) (domain.TimeOff, error)
+	deleteTimeOffFn       func(ctx context.Context, userID string, timeOffID uuid.UUID) error
+	listTimeOffFn         func(ctx context.Context, userID string) ([]domain.TimeOff, error)
+	exportCalendarFn      func(ctx context.Context, userID string) ([]byte, error)
+	importCalendarFn      func(ctx context.Context, in appointments.ImportCalendarInput) (appointments.ImportCalendarResult, error)
+	createSnapshotFn      func(ctx context.Context, in appointments.CreateCalendarSnapshotInput) (domain.CalendarSnapshotInfo, error)
+	listSnapshotsFn       func(ctx context.Context, userID string) ([]domain.CalendarSnapshotInfo, error)
+	restoreSnapshotFn     func(ctx context.Context, userID string, snapshotID uuid.UUID) (appointments.RestoreCalendarResult, error)
+	limits                limits.Limits
 }
 
 func (f *fakeAppointmentsService) Create(ctx context.Context, in appointments.CreateInput) (domain.Appointment, error) {
@@ -33,18 +108,39 @@ func (f *fakeAppointmentsService) Create(ctx context.Context, in appointments.Cr
 	return f.createFn(ctx, in)
 }
 
-func (f *fakeAppointmentsService) List(ctx context.Context, userID string, windowStart, windowEnd time.Time) ([]domain.Appointment, error) {
+func (f *fakeAppointmentsService) List(ctx context.Context, userID string, windowStart, windowEnd time.Time, filter store.AppointmentFilter) ([]domain.Appointment, error) {
 	if f.listFn == nil {
 		panic("List not configured")
 	}
-	return f.listFn(ctx, userID, windowStart, windowEnd)
+	return f.listFn(ctx, userID, windowStart, windowEnd, filter)
 }
 
-func (f *fakeAppointmentsService) Delete(ctx context.Context, userID string, appointmentID uuid.UUID) error {
+func (f *fakeAppointmentsService) Delete(ctx context.Context, in appointments.DeleteInput) error {
 	if f.deleteFn == nil {
 		panic("Delete not configured")
 	}
-	return f.deleteFn(ctx, userID, appointmentID)
+	return f.deleteFn(ctx, in)
+}
+
+func (f *fakeAppointmentsService) PageSnapshot(ctx context.Context, userID string) (int64, error) {
+	if f.pageSnapshotFn == nil {
+		return 0, nil
+	}
+	return f.pageSnapshotFn(ctx, userID)
+}
+
+func (f *fakeAppointmentsService) AppointmentsAddedSince(ctx context.Context, userID string, seq int64) (map[uuid.UUID]bool, error) {
+	if f.addedSinceFn == nil {
+		return nil, nil
+	}
+	return f.addedSinceFn(ctx, userID, seq)
+}
+
+func (f *fakeAppointmentsService) GetByExternalRef(ctx context.Context, userID string, ref appointments.ExternalRef) (domain.Appointment, error) {
+	if f.getByExternalRefFn == nil {
+		panic("GetByExternalRef not configured")
+	}
+	return f.getByExternalRefFn(ctx, userID, ref)
 }
 
 func (f *fakeAppointmentsService) CreateRecurringSeries(ctx context.Context, in appointments.CreateRecurringSeriesInput) (domain.RecurringSeries, error) {
@@ -61,6 +157,446 @@ func (f *fakeAppointmentsService) ListOccurrences(ctx context.Context, userID st
 	return f.listOccurrencesFn(ctx, userID, windowStart, windowEnd)
 }
 
+func (f *fakeAppointmentsService) GetRecurringSeries(ctx context.Context, userID string, seriesID uuid.UUID) (domain.RecurringSeries, error) {
+	if f.getRecurringSeriesFn == nil {
+		panic("GetRecurringSeries not configured")
+	}
+	return f.getRecurringSeriesFn(ctx, userID, seriesID)
+}
+
+func (f *fakeAppointmentsService) ListRecurringSeries(ctx context.Context, userID string, weekdays []int16) ([]domain.RecurringSeries, error) {
+	if f.listRecurringSeriesFn == nil {
+		panic("ListRecurringSeries not configured")
+	}
+	return f.listRecurringSeriesFn(ctx, userID, weekdays)
+}
+
+func (f *fakeAppointmentsService) MarkAttendance(ctx context.Context, in appointments.MarkAttendanceInput) (domain.OccurrenceAttendance, error) {
+	if f.markAttendanceFn == nil {
+		panic("MarkAttendance not configured")
+	}
+	return f.markAttendanceFn(ctx, in)
+}
+
+func (f *fakeAppointmentsService) GetAttendanceStats(ctx context.Context, userID string, seriesID uuid.UUID) (domain.AttendanceStats, error) {
+	if f.getAttendanceStatsFn == nil {
+		panic("GetAttendanceStats not configured")
+	}
+	return f.getAttendanceStatsFn(ctx, userID, seriesID)
+}
+
+func (f *fakeAppointmentsService) UserAnalytics(ctx context.Context, userID string, windowStart, windowEnd time.Time) (domain.UserAnalytics, error) {
+	if f.userAnalyticsFn == nil {
+		panic("UserAnalytics not configured")
+	}
+	return f.userAnalyticsFn(ctx, userID, windowStart, windowEnd)
+}
+
+func (f *fakeAppointmentsService) BatchGetFreeBusy(ctx context.Context, userIDs []string, windowStart, windowEnd time.Time) ([]appointments.UserFreeBusy, error) {
+	if f.batchFreeBusyFn == nil {
+		panic("BatchGetFreeBusy not configured")
+	}
+	return f.batchFreeBusyFn(ctx, userIDs, windowStart, windowEnd)
+}
+
+func (f *fakeAppointmentsService) SuggestMeetingTimes(ctx context.Context, in appointments.SuggestMeetingTimesInput) ([]scheduling.Suggestion, error) {
+	if f.suggestMeetingFn == nil {
+		panic("SuggestMeetingTimes not configured")
+	}
+	return f.suggestMeetingFn(ctx, in)
+}
+
+func (f *fakeAppointmentsService) SimulateSchedule(ctx context.Context, in appointments.SimulateScheduleInput) (scheduling.SimulationResult, error) {
+	if f.simulateScheduleFn == nil {
+		panic("SimulateSchedule not configured")
+	}
+	return f.simulateScheduleFn(ctx, in)
+}
+
+func (f *fakeAppointmentsService) AuditCalendar(ctx context.Context, userID string, windowStart, windowEnd time.Time) (appointments.CalendarAudit, error) {
+	if f.auditCalendarFn == nil {
+		panic("AuditCalendar not configured")
+	}
+	return f.auditCalendarFn(ctx, userID, windowStart, windowEnd)
+}
+
+func (f *fakeAppointmentsService) GetDailyAgenda(ctx context.Context, userID, date, timeZone string) (appointments.DailyAgenda, error) {
+	if f.getDailyAgendaFn == nil {
+		panic("GetDailyAgenda not configured")
+	}
+	return f.getDailyAgendaFn(ctx, userID, date, timeZone)
+}
+
+func (f *fakeAppointmentsService) GetDaySummaries(ctx context.Context, userID, from, to string) (appointments.DaySummaries, error) {
+	if f.getDaySummariesFn == nil {
+		panic("GetDaySummaries not configured")
+	}
+	return f.getDaySummariesFn(ctx, userID, from, to)
+}
+
+func (f *fakeAppointmentsService) ChangeSeriesTimeZone(ctx context.Context, in appointments.ChangeSeriesTimeZoneInput) (appointments.ChangeSeriesTimeZoneResult, error) {
+	if f.changeTimeZoneFn == nil {
+		panic("ChangeSeriesTimeZone not configured")
+	}
+	return f.changeTimeZoneFn(ctx, in)
+}
+
+func (f *fakeAppointmentsService) UpdateSeriesEnd(ctx context.Context, in appointments.UpdateSeriesEndInput) (domain.RecurringSeries, int, error) {
+	if f.updateSeriesEndFn == nil {
+		panic("UpdateSeriesEnd not configured")
+	}
+	return f.updateSeriesEndFn(ctx, in)
+}
+
+func (f *fakeAppointmentsService) SkipOccurrences(ctx context.Context, in appointments.SkipOccurrencesInput) (appointments.SkipOccurrencesResult, error) {
+	if f.skipOccurrencesFn == nil {
+		panic("SkipOccurrences not configured")
+	}
+	return f.skipOccurrencesFn(ctx, in)
+}
+
+func (f *fakeAppointmentsService) RepairRecurringSeries(ctx context.Context, userID string, seriesID uuid.UUID, apply bool) (appointments.SeriesRepairReport, error) {
+	if f.repairSeriesFn == nil {
+		panic("RepairRecurringSeries not configured")
+	}
+	return f.repairSeriesFn(ctx, userID, seriesID, apply)
+}
+
+func (f *fakeAppointmentsService) SuggestEndTime(ctx context.Context, userID string, start time.Time, desired time.Duration, hours *appointments.WorkingHoursInput) (appointments.EndTimeSuggestion, error) {
+	if f.suggestEndTimeFn == nil {
+		panic("SuggestEndTime not configured")
+	}
+	return f.suggestEndTimeFn(ctx, userID, start, desired, hours)
+}
+
+func (f *fakeAppointmentsService) ReserveSlot(ctx context.Context, in appointments.ReserveSlotInput) (domain.SlotHold, error) {
+	if f.reserveSlotFn == nil {
+		panic("ReserveSlot not configured")
+	}
+	return f.reserveSlotFn(ctx, in)
+}
+
+func (f *fakeAppointmentsService) ConfirmHold(ctx context.Context, in appointments.ConfirmHoldInput) (domain.Appointment, error) {
+	if f.confirmHoldFn == nil {
+		panic("ConfirmHold not configured")
+	}
+	return f.confirmHoldFn(ctx, in)
+}
+
+func (f *fakeAppointmentsService) ReleaseHold(ctx context.Context, userID string, holdID uuid.UUID) error {
+	if f.releaseHoldFn == nil {
+		panic("ReleaseHold not configured")
+	}
+	return f.releaseHoldFn(ctx, userID, holdID)
+}
+
+func (f *fakeAppointmentsService) ProposeAppointment(ctx context.Context, in appointments.ProposeAppointmentInput) (domain.AppointmentProposal, error) {
+	if f.proposeAppointmentFn == nil {
+		panic("ProposeAppointment not configured")
+	}
+	return f.proposeAppointmentFn(ctx, in)
+}
+
+func (f *fakeAppointmentsService) ListProposals(ctx context.Context, userID string) ([]domain.AppointmentProposal, error) {
+	if f.listProposalsFn == nil {
+		panic("ListProposals not configured")
+	}
+	return f.listProposalsFn(ctx, userID)
+}
+
+func (f *fakeAppointmentsService) AcceptProposal(ctx context.Context, userID string, proposalID uuid.UUID) (domain.AppointmentProposal, error) {
+	if f.acceptProposalFn == nil {
+		panic("AcceptProposal not configured")
+	}
+	return f.acceptProposalFn(ctx, userID, proposalID)
+}
+
+func (f *fakeAppointmentsService) DeclineProposal(ctx context.Context, userID string, proposalID uuid.UUID) (domain.AppointmentProposal, error) {
+	if f.declineProposalFn == nil {
+		panic("DeclineProposal not configured")
+	}
+	return f.declineProposalFn(ctx, userID, proposalID)
+}
+
+func (f *fakeAppointmentsService) LinkAppointments(ctx context.Context, userID string, appointmentID, relatedID uuid.UUID, kind domain.AppointmentLinkKind) (domain.AppointmentLink, error) {
+	if f.linkAppointmentsFn == nil {
+		panic("LinkAppointments not configured")
+	}
+	return f.linkAppointmentsFn(ctx, userID, appointmentID, relatedID, kind)
+}
+
+func (f *fakeAppointmentsService) UnlinkAppointments(ctx context.Context, userID string, appointmentID, relatedID uuid.UUID, kind domain.AppointmentLinkKind) error {
+	if f.unlinkAppointmentsFn == nil {
+		panic("UnlinkAppointments not configured")
+	}
+	return f.unlinkAppointmentsFn(ctx, userID, appointmentID, relatedID, kind)
+}
+
+func (f *fakeAppointmentsService) ListRelated(ctx context.Context, userID string, appointmentID uuid.UUID) ([]domain.RelatedAppointment, error) {
+	if f.listRelatedFn == nil {
+		panic("ListRelated not configured")
+	}
+	return f.listRelatedFn(ctx, userID, appointmentID)
+}
+
+func (f *fakeAppointmentsService) MergeAppointments(ctx context.Context, in appointments.MergeInput) (store.AppointmentMerge, error) {
+	if f.mergeAppointmentsFn == nil {
+		panic("MergeAppointments not configured")
+	}
+	return f.mergeAppointmentsFn(ctx, in)
+}
+
+func (f *fakeAppointmentsService) GrantDelegation(ctx context.Context, principalID, delegateID string) (domain.DelegationGrant, error) {
+	if f.grantDelegationFn == nil {
+		panic("GrantDelegation not configured")
+	}
+	return f.grantDelegationFn(ctx, principalID, delegateID)
+}
+
+func (f *fakeAppointmentsService) RevokeDelegation(ctx context.Context, principalID, delegateID string) error {
+	if f.revokeDelegationFn == nil {
+		panic("RevokeDelegation not configured")
+	}
+	return f.revokeDelegationFn(ctx, principalID, delegateID)
+}
+
+func (f *fakeAppointmentsService) ListDelegations(ctx context.Context, principalID string) ([]domain.DelegationGrant, error) {
+	if f.listDelegationsFn == nil {
+		panic("ListDelegations not configured")
+	}
+	return f.listDelegationsFn(ctx, principalID)
+}
+
+func (f *fakeAppointmentsService) WatchRecurringSeries(ctx context.Context, in appointments.WatchSeriesInput, send func(appointments.SeriesSnapshot) error) error {
+	if f.watchSeriesFn == nil {
+		panic("WatchRecurringSeries not configured")
+	}
+	return f.watchSeriesFn(ctx, in, send)
+}
+
+func (f *fakeAppointmentsService) ListChanges(ctx context.Context, in appointments.ListChangesInput) (appointments.ChangesPage, error) {
+	if f.listChangesFn == nil {
+		panic("ListChanges not configured")
+	}
+	return f.listChangesFn(ctx, in)
+}
+
+func (f *fakeAppointmentsService) SyncAppointments(ctx context.Context, userID string, windowStart, windowEnd time.Time, filter store.AppointmentFilter, token string) (appointments.AppointmentsSync, error) {
+	if f.syncAppointmentsFn == nil {
+		panic("SyncAppointments not configured")
+	}
+	return f.syncAppointmentsFn(ctx, userID, windowStart, windowEnd, filter, token)
+}
+
+func (f *fakeAppointmentsService) SyncOccurrences(ctx context.Context, userID string, windowStart, windowEnd time.Time, token string) (appointments.OccurrencesSync, error) {
+	if f.syncOccurrencesFn == nil {
+		panic("SyncOccurrences not configured")
+	}
+	return f.syncOccurrencesFn(ctx, userID, windowStart, windowEnd, token)
+}
+
+func (f *fakeAppointmentsService) ReconcileCalendar(ctx context.Context, in appointments.ReconcileInput) ([]appointments.MutationResult, error) {
+	if f.reconcileCalendarFn == nil {
+		panic("ReconcileCalendar not configured")
+	}
+	return f.reconcileCalendarFn(ctx, in)
+}
+
+func (f *fakeAppointmentsService) CheckIn(ctx context.Context, in appointments.SessionInput) (domain.Appointment, error) {
+	if f.checkInFn == nil {
+		panic("CheckIn not configured")
+	}
+	return f.checkInFn(ctx, in)
+}
+
+func (f *fakeAppointmentsService) CheckOut(ctx context.Context, in appointments.SessionInput) (domain.Appointment, error) {
+	if f.checkOutFn == nil {
+		panic("CheckOut not configured")
+	}
+	return f.checkOutFn(ctx, in)
+}
+
+func (f *fakeAppointmentsService) ExportBillableHours(ctx context.Context, in appointments.BillableHoursInput) (appointments.BillableExport, error) {
+	if f.exportBillableFn == nil {
+		panic("ExportBillableHours not configured")
+	}
+	return f.exportBillableFn(ctx, in)
+}
+
+func (f *fakeAppointmentsService) CreateContact(ctx context.Context, in appointments.ContactInput) (domain.Contact, error) {
+	if f.createContactFn == nil {
+		panic("CreateContact not configured")
+	}
+	return f.createContactFn(ctx, in)
+}
+
+func (f *fakeAppointmentsService) GetContact(ctx context.Context, userID string, contactID uuid.UUID) (domain.Contact, error) {
+	if f.getContactFn == nil {
+		panic("GetContact not configured")
+	}
+	return f.getContactFn(ctx, userID, contactID)
+}
+
+func (f *fakeAppointmentsService) UpdateContact(ctx context.Context, in appointments.ContactInput) (domain.Contact, error) {
+	if f.updateContactFn == nil {
+		panic("UpdateContact not configured")
+	}
+	return f.updateContactFn(ctx, in)
+}
+
+func (f *fakeAppointmentsService) DeleteContact(ctx context.Context, userID string, contactID uuid.UUID) error {
+	if f.deleteContactFn == nil {
+		panic("DeleteContact not configured")
+	}
+	return f.deleteContactFn(ctx, userID, contactID)
+}
+
+func (f *fakeAppointmentsService) ListContacts(ctx context.Context, userID string) ([]domain.Contact, error) {
+	if f.listContactsFn == nil {
+		panic("ListContacts not configured")
+	}
+	return f.listContactsFn(ctx, userID)
+}
+
+func (f *fakeAppointmentsService) CreateProgram(ctx context.Context, in appointments.ProgramInput) (domain.Program, error) {
+	if f.createProgramFn == nil {
+		panic("CreateProgram not configured")
+	}
+	return f.createProgramFn(ctx, in)
+}
+
+func (f *fakeAppointmentsService) GetProgram(ctx context.Context, userID string, programID uuid.UUID) (appointments.ProgramOverview, error) {
+	if f.getProgramFn == nil {
+		panic("GetProgram not configured")
+	}
+	return f.getProgramFn(ctx, userID, programID)
+}
+
+func (f *fakeAppointmentsService) ListPrograms(ctx context.Context, userID string) ([]domain.Program, error) {
+	if f.listProgramsFn == nil {
+		panic("ListPrograms not configured")
+	}
+	return f.listProgramsFn(ctx, userID)
+}
+
+func (f *fakeAppointmentsService) CancelProgram(ctx context.Context, userID string, programID uuid.UUID) (store.ProgramCancellation, error) {
+	if f.cancelProgramFn == nil {
+		panic("CancelProgram not configured")
+	}
+	return f.cancelProgramFn(ctx, userID, programID)
+}
+
+func (f *fakeAppointmentsService) CreateEmbedToken(ctx context.Context, in appointments.EmbedTokenInput) (appointments.EmbedToken, error) {
+	if f.createEmbedTokenFn == nil {
+		panic("CreateEmbedToken not configured")
+	}
+	return f.createEmbedTokenFn(ctx, in)
+}
+
+func (f *fakeAppointmentsService) GetSlotSettings(ctx context.Context, userID string) (domain.UserSettings, error) {
+	if f.getSlotSettingsFn == nil {
+		panic("GetSlotSettings not configured")
+	}
+	return f.getSlotSettingsFn(ctx, userID)
+}
+
+func (f *fakeAppointmentsService) UpdateSlotSettings(ctx context.Context, in appointments.SlotSettingsInput) (domain.UserSettings, error) {
+	if f.updateSlotSettingsFn == nil {
+		panic("UpdateSlotSettings not configured")
+	}
+	return f.updateSlotSettingsFn(ctx, in)
+}
+
+func (f *fakeAppointmentsService) UpdateDailyBreaks(ctx context.Context, userID string, breaks []domain.DailyBreak) (domain.UserSettings, error) {
+	if f.updateDailyBreaksFn == nil {
+		panic("UpdateDailyBreaks not configured")
+	}
+	return f.updateDailyBreaksFn(ctx, userID, breaks)
+}
+
+func (f *fakeAppointmentsService) CreateTimeOff(ctx context.Context, in appointments.TimeOffInput) (domain.TimeOff, error) {
+	if f.createTimeOffFn == nil {
+		panic("CreateTimeOff not configured")
+	}
+	return f.createTimeOffFn(ctx, in)
+}
+
+func (f *fakeAppointmentsService) GetTimeOff(ctx context.Context, userID string, timeOffID uuid.UUID) (domain.TimeOff, error) {
+	if f.getTimeOffFn == nil {
+		panic("GetTimeOff not configured")
+	}
+	return f.getTimeOffFn(ctx, userID, timeOffID)
+}
+
+func (f *fakeAppointmentsService) UpdateTimeOff(ctx context.Context, in appointments.TimeOffInput) (domain.TimeOff, error) {
+	if f.updateTimeOffFn == nil {
+		panic("UpdateTimeOff not configured")
+	}
+	return f.updateTimeOffFn(ctx, in)
+}
+
+func (f *fakeAppointmentsService) DeleteTimeOff(ctx context.Context, userID string, timeOffID uuid.UUID) error {
+	if f.deleteTimeOffFn == nil {
+		panic("DeleteTimeOff not configured")
+	}
+	return f.deleteTimeOffFn(ctx, userID, timeOffID)
+}
+
+func (f *fakeAppointmentsService) ListTimeOff(ctx context.Context, userID string) ([]domain.TimeOff, error) {
+	if f.listTimeOffFn == nil {
+		panic("ListTimeOff not configured")
+	}
+	return f.listTimeOffFn(ctx, userID)
+}
+
+// CalendarVersion reports 0 unless configured, since most list tests do not
+// care about it.
+func (f *fakeAppointmentsService) CalendarVersion(ctx context.Context, userID string) (int64, error) {
+	if f.calendarVersionFn == nil {
+		return 0, nil
+	}
+	return f.calendarVersionFn(ctx, userID)
+}
+
+func (f *fakeAppointmentsService) ExportCalendar(ctx context.Context, userID string) ([]byte, error) {
+	if f.exportCalendarFn == nil {
+		panic("ExportCalendar not configured")
+	}
+	return f.exportCalendarFn(ctx, userID)
+}
+
+func (f *fakeAppointmentsService) ImportCalendar(ctx context.Context, in appointments.ImportCalendarInput) (appointments.ImportCalendarResult, error) {
+	if f.importCalendarFn == nil {
+		panic("ImportCalendar not configured")
+	}
+	return f.importCalendarFn(ctx, in)
+}
+
+func (f *fakeAppointmentsService) CreateCalendarSnapshot(ctx context.Context, in appointments.CreateCalendarSnapshotInput) (domain.CalendarSnapshotInfo, error) {
+	if f.createSnapshotFn == nil {
+		panic("CreateCalendarSnapshot not configured")
+	}
+	return f.createSnapshotFn(ctx, in)
+}
+
+func (f *fakeAppointmentsService) ListCalendarSnapshots(ctx context.Context, userID string) ([]domain.CalendarSnapshotInfo, error) {
+	if f.listSnapshotsFn == nil {
+		panic("ListCalendarSnapshots not configured")
+	}
+	return f.listSnapshotsFn(ctx, userID)
+}
+
+func (f *fakeAppointmentsService) RestoreCalendarSnapshot(ctx context.Context, userID string, snapshotID uuid.UUID) (appointments.RestoreCalendarResult, error) {
+	if f.restoreSnapshotFn == nil {
+		panic("RestoreCalendarSnapshot not configured")
+	}
+	return f.restoreSnapshotFn(ctx, userID, snapshotID)
+}
+
+func (f *fakeAppointmentsService) Limits() limits.Limits {
+	return f.limits
+}
+
 func TestIdempotencyKey_ReadsHeadersAndTrims(t *testing.T) {
 	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs("idempotency-key", "  abc  "))
 	if got := idempotencyKey(ctx); got != "abc" {
@@ -163,7 +699,7 @@ func TestCreateAppointment_MapsValidationError(t *testing.T) {
 
 func TestDeleteAppointment_RejectsInvalidUUID(t *testing.T) {
 	srv := NewAppointmentsServer(&fakeAppointmentsService{
-		deleteFn: func(ctx context.Context, userID string, appointmentID uuid.UUID) error {
+		deleteFn: func(ctx context.Context, in appointments.DeleteInput) error {
 			return nil
 		},
 	}, slog.Default())
@@ -179,7 +715,7 @@ func TestDeleteAppointment_RejectsInvalidUUID(t *testing.T) {
 
 func TestDeleteAppointment_MapsNotFound(t *testing.T) {
 	srv := NewAppointmentsServer(&fakeAppointmentsService{
-		deleteFn: func(ctx context.Context, userID string, appointmentID uuid.UUID) error {
+		deleteFn: func(ctx context.Context, in appointments.DeleteInput) error {
 			return store.ErrNotFound
 		},
 	}, slog.Default())
@@ -193,6 +729,170 @@ func TestDeleteAppointment_MapsNotFound(t *testing.T) {
 	}
 }
 
+func TestAppointmentsV2_PagesAndAttachesErrorInfo(t *testing.T) {
+	base := time.Date(2026, 1, 5, 9, 0, 0, 0, time.UTC)
+	var appts []domain.Appointment
+	for i := range 5 {
+		appts = append(appts, domain.Appointment{
+			ID:        uuid.MustParse(fmt.Sprintf("00000000-0000-0000-0000-00000000003%d", i)),
+			UserID:    "u1",
+			StartTime: base.Add(time.Duration(i/2) * time.Hour),
+			EndTime:   base.Add(time.Duration(i/2)*time.Hour + 30*time.Minute),
+		})
+	}
+	fake := &fakeAppointmentsService{
+		listFn: func(ctx context.Context, userID string, windowStart, windowEnd time.Time, filter store.AppointmentFilter) ([]domain.Appointment, error) {
+			return append([]domain.Appointment(nil), appts...), nil
+		},
+		deleteFn: func(ctx context.Context, in appointments.DeleteInput) error {
+			return store.ErrNotFound
+		},
+	}
+	srv := NewAppointmentsV2Server(fake, slog.Default())
+
+	req := &schedulev2.ListAppointmentsRequest{
+		UserId:      "u1",
+		WindowStart: timestamppb.New(base),
+		WindowEnd:   timestamppb.New(base.Add(24 * time.Hour)),
+		PageSize:    2,
+	}
+	var got []string
+	for page := 0; ; page++ {
+		if page > 3 {
+			t.Fatalf("paging did not terminate")
+		}
+		resp, err := srv.ListAppointments(context.Background(), req)
+		if err != nil {
+			t.Fatalf("ListAppointments error: %v", err)
+		}
+		for _, a := range resp.Appointments {
+			got = append(got, a.Id)
+		}
+		if resp.NextPageToken == "" {
+			break
+		}
+		req.PageToken = resp.NextPageToken
+	}
+	if len(got) != len(appts) {
+		t.Fatalf("listed %d appointments, want %d", len(got), len(appts))
+	}
+	for i, a := range appts {
+		if got[i] != a.ID.String() {
+			t.Fatalf("appointment %d = %s, want %s", i, got[i], a.ID)
+		}
+	}
+
+	req.Source = "booking"
+	_, err := srv.ListAppointments(context.Background(), req)
+	if st := status.Convert(err); st.Code() != codes.InvalidArgument || errorInfoReason(st) != ReasonInvalidPageToken {
+		t.Fatalf("reused token = %s %q, want InvalidArgument %s", st.Code(), errorInfoReason(st), ReasonInvalidPageToken)
+	}
+
+	_, err = srv.DeleteAppointment(context.Background(), &schedulev2.DeleteAppointmentRequest{UserId: "u1", AppointmentId: appts[0].ID.String()})
+	if st := status.Convert(err); st.Code() != codes.NotFound || errorInfoReason(st) != ReasonAppointmentNotFound {
+		t.Fatalf("v2 delete = %s %q, want NotFound %s", st.Code(), errorInfoReason(st), ReasonAppointmentNotFound)
+	}
+
+	// The v1 shim keeps the code and message but drops the v2 details.
+	_, err = NewAppointmentsServer(fake, slog.Default()).DeleteAppointment(context.Background(), &schedulev1.DeleteAppointmentRequest{UserId: "u1", AppointmentId: appts[0].ID.String()})
+	st := status.Convert(err)
+	if st.Code() != codes.NotFound || st.Message() != "appointment not found" || len(st.Details()) != 0 {
+		t.Fatalf("v1 delete = %s %q with %d details", st.Code(), st.Message(), len(st.Details()))
+	}
+}
+
+func TestListAppointmentsV2_PagesFromSnapshotWhileCalendarChanges(t *testing.T) {
+	base := time.Date(2026, 1, 5, 9, 0, 0, 0, time.UTC)
+	at := func(id string, hour int) domain.Appointment {
+		return domain.Appointment{
+			ID:        uuid.MustParse("00000000-0000-0000-0000-0000000000" + id),
+			UserID:    "u1",
+			StartTime: base.Add(time.Duration(hour) * time.Hour),
+			EndTime:   base.Add(time.Duration(hour)*time.Hour + 30*time.Minute),
+		}
+	}
+	appts := []domain.Appointment{at("01", 0), at("02", 1), at("03", 2), at("04", 3), at("05", 4)}
+	want := []string{appts[0].ID.String(), appts[1].ID.String(), appts[2].ID.String(), appts[3].ID.String(), appts[4].ID.String()}
+
+	added := map[uuid.UUID]bool{}
+	var gotSeq int64
+	var staleErr error
+	fake := &fakeAppointmentsService{
+		listFn: func(ctx context.Context, userID string, windowStart, windowEnd time.Time, filter store.AppointmentFilter) ([]domain.Appointment, error) {
+			return append([]domain.Appointment(nil), appts...), nil
+		},
+		pageSnapshotFn: func(ctx context.Context, userID string) (int64, error) {
+			return 42, nil
+		},
+		addedSinceFn: func(ctx context.Context, userID string, seq int64) (map[uuid.UUID]bool, error) {
+			gotSeq = seq
+			return added, staleErr
+		},
+	}
+	srv := NewAppointmentsV2Server(fake, slog.Default())
+	req := &schedulev2.ListAppointmentsRequest{
+		UserId:      "u1",
+		WindowStart: timestamppb.New(base),
+		WindowEnd:   timestamppb.New(base.Add(24 * time.Hour)),
+		PageSize:    2,
+	}
+
+	var got []string
+	for page := 0; ; page++ {
+		if page > 3 {
+			t.Fatalf("paging did not terminate")
+		}
+		resp, err := srv.ListAppointments(context.Background(), req)
+		if err != nil {
+			t.Fatalf("ListAppointments error: %v", err)
+		}
+		for _, a := range resp.Appointments {
+			got = append(got, a.Id)
+		}
+		if resp.NextPageToken == "" {
+			break
+		}
+		req.PageToken = resp.NextPageToken
+		if page == 0 {
+			// Bookings land on both sides of the cursor and one ahead of it
+			// is deleted. Later pages neither pick up the new ones nor skip
+			// past the gap.
+			behind, ahead := at("06", 0), at("07", 3)
+			appts = append(appts, behind, ahead)
+			added[behind.ID], added[ahead.ID] = true, true
+			appts = slices.DeleteFunc(appts, func(a domain.Appointment) bool { return a.ID.String() == want[3] })
+			want = slices.Delete(want, 3, 4)
+		}
+	}
+	if gotSeq != 42 {
+		t.Fatalf("later pages replayed from seq %d, want the first page's 42", gotSeq)
+	}
+	if !slices.Equal(got, want) {
+		t.Fatalf("paged ids = %v, want %v", got, want)
+	}
+
+	req.PageToken = ""
+	first, err := srv.ListAppointments(context.Background(), req)
+	if err != nil {
+		t.Fatalf("ListAppointments error: %v", err)
+	}
+	req.PageToken = first.NextPageToken
+	staleErr = appointments.ErrPageSnapshotStale
+	_, err = srv.ListAppointments(context.Background(), req)
+	if st := status.Convert(err); st.Code() != codes.Aborted || errorInfoReason(st) != ReasonPageTokenStale {
+		t.Fatalf("stale snapshot = %s %q, want Aborted %s", st.Code(), errorInfoReason(st), ReasonPageTokenStale)
+	}
+}
+
+func errorInfoReason(st *status.Status) string {
+	for _, d := range st.Details() {
+		if info, ok := d.(*errdetails.ErrorInfo); ok {
+			return info.Reason
+		}
+	}
+	return ""
+}
+
 func TestCreateAppointment_MapsIdempotencyConflict(t *testing.T) {
 	srv := NewAppointmentsServer(&fakeAppointmentsService{
 		createFn: func(ctx context.Context, in appointments.CreateInput) (domain.Appointment, error) {
@@ -213,3 +913,1670 @@ func TestCreateAppointment_MapsIdempotencyConflict(t *testing.T) {
 		t.Fatalf("code = %s, want %s", status.Code(err), codes.FailedPrecondition)
 	}
 }
+
+func TestCreateAppointment_MapsRefusedWrites(t *testing.T) {
+	for _, refused := range []error{store.ErrReadOnly, appointments.ErrUserDeactivated} {
+		srv := NewAppointmentsServer(&fakeAppointmentsService{
+			createFn: func(ctx context.Context, in appointments.CreateInput) (domain.Appointment, error) {
+				return domain.Appointment{}, fmt.Errorf("create: %w", refused)
+			},
+		}, slog.Default())
+
+		start := time.Date(2026, 1, 1, 10, 0, 0, 0, time.UTC)
+		_, err := srv.CreateAppointment(context.Background(), &schedulev1.CreateAppointmentRequest{
+			UserId:    "u1",
+			Title:     "t",
+			StartTime: timestamppb.New(start),
+			EndTime:   timestamppb.New(start.Add(time.Hour)),
+		})
+		if status.Code(err) != codes.FailedPrecondition {
+			t.Fatalf("code = %s, want %s (err=%v)", status.Code(err), codes.FailedPrecondition, refused)
+		}
+	}
+}
+
+func TestListAppointments_MapsRetryableStoreErrors(t *testing.T) {
+	tests := []struct {
+		err  error
+		want codes.Code
+	}{
+		{err: fmt.Errorf("%w: boom", store.ErrSerialization), want: codes.Aborted},
+		{err: fmt.Errorf("%w: boom", store.ErrUnavailable), want: codes.Unavailable},
+		{err: errors.New("boom"), want: codes.Internal},
+	}
+
+	for _, tt := range tests {
+		srv := NewAppointmentsServer(&fakeAppointmentsService{
+			listFn: func(ctx context.Context, userID string, windowStart, windowEnd time.Time, filter store.AppointmentFilter) ([]domain.Appointment, error) {
+				return nil, tt.err
+			},
+		}, slog.Default())
+
+		start := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+		_, err := srv.ListAppointments(context.Background(), &schedulev1.ListAppointmentsRequest{
+			UserId:      "u1",
+			WindowStart: timestamppb.New(start),
+			WindowEnd:   timestamppb.New(start.Add(24 * time.Hour)),
+		})
+		if status.Code(err) != tt.want {
+			t.Fatalf("code = %s, want %s (err=%v)", status.Code(err), tt.want, tt.err)
+		}
+	}
+}
+
+func TestGetRecurringSeries_MapsNotFound(t *testing.T) {
+	srv := NewAppointmentsServer(&fakeAppointmentsService{
+		getRecurringSeriesFn: func(ctx context.Context, userID string, seriesID uuid.UUID) (domain.RecurringSeries, error) {
+			return domain.RecurringSeries{}, store.ErrNotFound
+		},
+	}, slog.Default())
+
+	_, err := srv.GetRecurringSeries(context.Background(), &schedulev1.GetRecurringSeriesRequest{
+		UserId:   "u1",
+		SeriesId: "00000000-0000-0000-0000-000000000030",
+	})
+	if status.Code(err) != codes.NotFound {
+		t.Fatalf("code = %s, want %s", status.Code(err), codes.NotFound)
+	}
+}
+
+func TestListRecurringSeries_PassesWeekdays(t *testing.T) {
+	var gotWeekdays []int16
+	srv := NewAppointmentsServer(&fakeAppointmentsService{
+		listRecurringSeriesFn: func(ctx context.Context, userID string, weekdays []int16) ([]domain.RecurringSeries, error) {
+			gotWeekdays = weekdays
+			return []domain.RecurringSeries{{
+				ID:              uuid.MustParse("00000000-0000-0000-0000-000000000032"),
+				UserID:          userID,
+				Timezone:        "UTC",
+				DTStart:         time.Date(2026, 1, 6, 9, 0, 0, 0, time.UTC),
+				DurationSeconds: 3600,
+				Frequency:       domain.RecurrenceFrequencyWeekly,
+				Interval:        1,
+				ByWeekday:       []int16{2},
+			}}, nil
+		},
+	}, slog.Default())
+
+	resp, err := srv.ListRecurringSeries(context.Background(), &schedulev1.ListRecurringSeriesRequest{
+		UserId:   "u1",
+		Weekdays: []schedulev1.Weekday{schedulev1.Weekday_TUESDAY, schedulev1.Weekday_WEEKDAY_UNSPECIFIED},
+	})
+	if err != nil {
+		t.Fatalf("ListRecurringSeries error: %v", err)
+	}
+	if !slices.Equal(gotWeekdays, []int16{2}) {
+		t.Fatalf("weekdays = %v, want [2]", gotWeekdays)
+	}
+	if len(resp.Series) != 1 || resp.Series[0].Id != "00000000-0000-0000-0000-000000000032" {
+		t.Fatalf("series = %v, want the one listed", resp.Series)
+	}
+}
+
+func TestGetRecurringSeries_ReturnsProgress(t *testing.T) {
+	next := time.Date(2026, 1, 26, 9, 0, 0, 0, time.UTC)
+	srv := NewAppointmentsServer(&fakeAppointmentsService{
+		getRecurringSeriesFn: func(ctx context.Context, userID string, seriesID uuid.UUID) (domain.RecurringSeries, error) {
+			return domain.RecurringSeries{
+				ID:                   seriesID,
+				UserID:               userID,
+				Timezone:             "UTC",
+				DTStart:              time.Date(2026, 1, 5, 9, 0, 0, 0, time.UTC),
+				DurationSeconds:      3600,
+				Frequency:            domain.RecurrenceFrequencyWeekly,
+				Interval:             1,
+				ByWeekday:            []int16{1},
+				OccurrencesRemaining: 2,
+				NextOccurrence:       &next,
+			}, nil
+		},
+	}, slog.Default())
+
+	resp, err := srv.GetRecurringSeries(context.Background(), &schedulev1.GetRecurringSeriesRequest{
+		UserId:   "u1",
+		SeriesId: "00000000-0000-0000-0000-000000000031",
+	})
+	if err != nil {
+		t.Fatalf("GetRecurringSeries error: %v", err)
+	}
+	if resp.Series.OccurrencesRemaining != 2 {
+		t.Fatalf("occurrences_remaining = %d, want 2", resp.Series.OccurrencesRemaining)
+	}
+	if !resp.Series.NextOccurrence.AsTime().Equal(next) {
+		t.Fatalf("next_occurrence = %v, want %v", resp.Series.NextOccurrence.AsTime(), next)
+	}
+}
+
+func TestMarkAttendance_RejectsMissingStatus(t *testing.T) {
+	srv := NewAppointmentsServer(&fakeAppointmentsService{}, slog.Default())
+
+	_, err := srv.MarkAttendance(context.Background(), &schedulev1.MarkAttendanceRequest{
+		UserId:        "u1",
+		SeriesId:      "00000000-0000-0000-0000-000000000040",
+		OccurrenceId:  "1767603600000000000",
+		ParticipantId: "p1",
+	})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Fatalf("code = %s, want %s", status.Code(err), codes.InvalidArgument)
+	}
+}
+
+func TestMarkAttendance_PassesStatusToService(t *testing.T) {
+	occurrenceStart := time.Date(2026, 1, 5, 9, 0, 0, 0, time.UTC)
+	seriesID := uuid.MustParse("00000000-0000-0000-0000-000000000041")
+	occurrenceID := domain.EncodeOccurrenceID(seriesID, occurrenceStart)
+	var got appointments.MarkAttendanceInput
+	srv := NewAppointmentsServer(&fakeAppointmentsService{
+		markAttendanceFn: func(ctx context.Context, in appointments.MarkAttendanceInput) (domain.OccurrenceAttendance, error) {
+			got = in
+			return domain.OccurrenceAttendance{
+				SeriesID:        in.SeriesID,
+				OccurrenceStart: occurrenceStart,
+				ParticipantID:   in.ParticipantID,
+				Status:          in.Status,
+			}, nil
+		},
+	}, slog.Default())
+
+	resp, err := srv.MarkAttendance(context.Background(), &schedulev1.MarkAttendanceRequest{
+		UserId:        "u1",
+		SeriesId:      seriesID.String(),
+		OccurrenceId:  occurrenceID,
+		ParticipantId: "p1",
+		Status:        schedulev1.AttendanceStatus_ATTENDANCE_STATUS_MISSED,
+	})
+	if err != nil {
+		t.Fatalf("MarkAttendance error: %v", err)
+	}
+	if got.Status != domain.AttendanceStatusMissed {
+		t.Fatalf("status = %q, want %q", got.Status, domain.AttendanceStatusMissed)
+	}
+	if got.OccurrenceID != occurrenceID || resp.Attendance.OccurrenceId != occurrenceID {
+		t.Fatalf("occurrence_id = %q / %q, want %q", got.OccurrenceID, resp.Attendance.OccurrenceId, occurrenceID)
+	}
+	if resp.Attendance.Status != schedulev1.AttendanceStatus_ATTENDANCE_STATUS_MISSED {
+		t.Fatalf("status = %s, want %s", resp.Attendance.Status, schedulev1.AttendanceStatus_ATTENDANCE_STATUS_MISSED)
+	}
+}
+
+func TestGetAttendanceStats_MapsNotFound(t *testing.T) {
+	srv := NewAppointmentsServer(&fakeAppointmentsService{
+		getAttendanceStatsFn: func(ctx context.Context, userID string, seriesID uuid.UUID) (domain.AttendanceStats, error) {
+			return domain.AttendanceStats{}, store.ErrNotFound
+		},
+	}, slog.Default())
+
+	_, err := srv.GetAttendanceStats(context.Background(), &schedulev1.GetAttendanceStatsRequest{
+		UserId:   "u1",
+		SeriesId: "00000000-0000-0000-0000-000000000042",
+	})
+	if status.Code(err) != codes.NotFound {
+		t.Fatalf("code = %s, want %s", status.Code(err), codes.NotFound)
+	}
+}
+
+func TestGetLimits_ReportsEffectiveLimits(t *testing.T) {
+	srv := NewAppointmentsServer(&fakeAppointmentsService{
+		limits: limits.Limits{MaxTitleLength: 80, MaxWeekdays: 7, MaxActiveSeries: 20, MaxFutureOccurrences: 500},
+	}, slog.Default())
+
+	resp, err := srv.GetLimits(context.Background(), &schedulev1.GetLimitsRequest{})
+	if err != nil {
+		t.Fatalf("GetLimits error: %v", err)
+	}
+	if resp.MaxTitleLength != 80 || resp.MaxWeekdays != 7 || resp.MaxNotesLength != 0 {
+		t.Fatalf("limits = %+v, want title=80 weekdays=7 notes=0", resp)
+	}
+	if resp.MaxActiveSeries != 20 || resp.MaxFutureOccurrences != 500 {
+		t.Fatalf("series limits = %d/%d, want 20/500", resp.MaxActiveSeries, resp.MaxFutureOccurrences)
+	}
+	if resp.MaxAppointmentDuration.AsDuration() != appointments.MaxAppointmentDuration {
+		t.Fatalf("max duration = %v, want %v", resp.MaxAppointmentDuration.AsDuration(), appointments.MaxAppointmentDuration)
+	}
+	if resp.RecurringLookahead.AsDuration() != store.RecurringConflictLookahead {
+		t.Fatalf("lookahead = %v, want %v", resp.RecurringLookahead.AsDuration(), store.RecurringConflictLookahead)
+	}
+	if resp.IntervalBounds != schedulev1.IntervalBounds_INTERVAL_BOUNDS_START_INCLUSIVE_END_EXCLUSIVE {
+		t.Fatalf("interval bounds = %s, want start inclusive, end exclusive", resp.IntervalBounds)
+	}
+}
+
+func TestCreateRecurringSeries_SeriesLimitIsResourceExhausted(t *testing.T) {
+	srv := NewAppointmentsServer(&fakeAppointmentsService{
+		createRecurringSeries: func(ctx context.Context, in appointments.CreateRecurringSeriesInput) (domain.RecurringSeries, error) {
+			return domain.RecurringSeries{}, fmt.Errorf("%w: at most 1 series may have occurrences ahead", appointments.ErrSeriesLimit)
+		},
+	}, slog.Default())
+
+	start := time.Date(2026, 1, 5, 9, 0, 0, 0, time.UTC)
+	_, err := srv.CreateRecurringSeries(context.Background(), &schedulev1.CreateRecurringSeriesRequest{
+		UserId:    "u1",
+		Title:     "Standup",
+		StartTime: timestamppb.New(start),
+		EndTime:   timestamppb.New(start.Add(15 * time.Minute)),
+		Weekly:    &schedulev1.WeeklyRecurrence{Count: 3, TimeZone: "UTC"},
+	})
+	if status.Code(err) != codes.ResourceExhausted {
+		t.Fatalf("code = %s, want %s", status.Code(err), codes.ResourceExhausted)
+	}
+}
+
+func TestCreateRecurringSeries_AcceptsDurationOrEndTime(t *testing.T) {
+	var got appointments.CreateRecurringSeriesInput
+	srv := NewAppointmentsServer(&fakeAppointmentsService{
+		createRecurringSeries: func(ctx context.Context, in appointments.CreateRecurringSeriesInput) (domain.RecurringSeries, error) {
+			got = in
+			return domain.RecurringSeries{ID: uuid.New(), UserID: in.UserID, DTStart: in.StartTime, DurationSeconds: int(in.EndTime.Sub(in.StartTime) / time.Second)}, nil
+		},
+	}, slog.Default())
+
+	start := time.Date(2026, 1, 5, 9, 0, 0, 0, time.UTC)
+	req := func(end *timestamppb.Timestamp, d *durationpb.Duration) *schedulev1.CreateRecurringSeriesRequest {
+		return &schedulev1.CreateRecurringSeriesRequest{
+			UserId: "u1", Title: "Standup", StartTime: timestamppb.New(start), EndTime: end, Duration: d,
+			Weekly: &schedulev1.WeeklyRecurrence{Count: 3, TimeZone: "UTC"},
+		}
+	}
+	end := timestamppb.New(start.Add(15 * time.Minute))
+	quarter := durationpb.New(15 * time.Minute)
+
+	for name, r := range map[string]*schedulev1.CreateRecurringSeriesRequest{
+		"duration": req(nil, quarter),
+		"end_time": req(end, nil),
+		"both":     req(end, quarter),
+	} {
+		got = appointments.CreateRecurringSeriesInput{}
+		resp, err := srv.CreateRecurringSeries(context.Background(), r)
+		if err != nil {
+			t.Fatalf("%s: CreateRecurringSeries error: %v", name, err)
+		}
+		if !got.EndTime.Equal(start.Add(15 * time.Minute)) {
+			t.Fatalf("%s: end = %v, want 15 minutes after start", name, got.EndTime)
+		}
+		if resp.Series.Duration.AsDuration() != 15*time.Minute {
+			t.Fatalf("%s: response duration = %v, want 15m", name, resp.Series.Duration.AsDuration())
+		}
+	}
+
+	for name, r := range map[string]*schedulev1.CreateRecurringSeriesRequest{
+		"neither":    req(nil, nil),
+		"mismatch":   req(timestamppb.New(start.Add(30*time.Minute)), quarter),
+		"negative":   req(nil, durationpb.New(-time.Minute)),
+		"sub-second": req(nil, durationpb.New(1500*time.Millisecond)),
+	} {
+		if _, err := srv.CreateRecurringSeries(context.Background(), r); status.Code(err) != codes.InvalidArgument {
+			t.Fatalf("%s: code = %v, want %v", name, status.Code(err), codes.InvalidArgument)
+		}
+	}
+}
+
+func TestRequestPolicyInterceptor_AppliesUserPolicy(t *testing.T) {
+	srv := NewAppointmentsServer(&fakeAppointmentsService{
+		limits: limits.Limits{MaxTitleLength: 80, MaxWeekdays: 7},
+	}, slog.Default())
+	intercept := RequestPolicyInterceptor(func(ctx context.Context, userID string) (appointments.RequestPolicy, error) {
+		if userID != "premium" {
+			return appointments.RequestPolicy{}, errors.New("db down")
+		}
+		return appointments.RequestPolicy{Timeout: time.Minute, Limits: limits.Limits{MaxTitleLength: 240, MaxWeekdays: 7}}, nil
+	}, slog.Default())
+	info := &grpc.UnaryServerInfo{FullMethod: schedulev1.AppointmentsService_GetLimits_FullMethodName}
+
+	var timeout time.Duration
+	handler := func(ctx context.Context, req any) (any, error) {
+		timeout = RequestPolicyTimeout(ctx)
+		return srv.GetLimits(ctx, req.(*schedulev1.GetLimitsRequest))
+	}
+	resp, err := intercept(context.Background(), &schedulev1.GetLimitsRequest{UserId: "premium"}, info, handler)
+	if err != nil {
+		t.Fatalf("GetLimits error: %v", err)
+	}
+	if got := resp.(*schedulev1.GetLimitsResponse).MaxTitleLength; got != 240 || timeout != time.Minute {
+		t.Fatalf("premium title limit = %d, timeout = %v, want 240 and 1m", got, timeout)
+	}
+
+	// A failed lookup falls back to the server's policy.
+	resp, err = intercept(context.Background(), &schedulev1.GetLimitsRequest{UserId: "u1"}, info, handler)
+	if err != nil {
+		t.Fatalf("GetLimits error: %v", err)
+	}
+	if got := resp.(*schedulev1.GetLimitsResponse).MaxTitleLength; got != 80 || timeout != 0 {
+		t.Fatalf("fallback title limit = %d, timeout = %v, want 80 and 0", got, timeout)
+	}
+}
+
+func TestListAppointments_PassesMetadataFilter(t *testing.T) {
+	var got store.AppointmentFilter
+	srv := NewAppointmentsServer(&fakeAppointmentsService{
+		listFn: func(ctx context.Context, userID string, windowStart, windowEnd time.Time, filter store.AppointmentFilter) ([]domain.Appointment, error) {
+			got = filter
+			return []domain.Appointment{{UserID: userID, Metadata: map[string]string{"crm_id": "42"}}}, nil
+		},
+	}, slog.Default())
+
+	start := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	resp, err := srv.ListAppointments(context.Background(), &schedulev1.ListAppointmentsRequest{
+		UserId:         "u1",
+		WindowStart:    timestamppb.New(start),
+		WindowEnd:      timestamppb.New(start.Add(24 * time.Hour)),
+		MetadataFilter: map[string]string{"crm_id": "42"},
+	})
+	if err != nil {
+		t.Fatalf("ListAppointments error: %v", err)
+	}
+	if got.Metadata["crm_id"] != "42" {
+		t.Fatalf("filter = %v, want crm_id=42", got.Metadata)
+	}
+	if resp.Appointments[0].Metadata["crm_id"] != "42" {
+		t.Fatalf("metadata = %v, want crm_id=42", resp.Appointments[0].Metadata)
+	}
+}
+
+func TestListAppointments_SplitsByLocalDay(t *testing.T) {
+	id := uuid.New()
+	// 22:00-01:30 in New York, which is entirely on one UTC day.
+	start := time.Date(2026, 1, 6, 3, 0, 0, 0, time.UTC)
+	srv := NewAppointmentsServer(&fakeAppointmentsService{
+		listFn: func(ctx context.Context, userID string, windowStart, windowEnd time.Time, filter store.AppointmentFilter) ([]domain.Appointment, error) {
+			return []domain.Appointment{{ID: id, UserID: userID, StartTime: start, EndTime: start.Add(3*time.Hour + 30*time.Minute)}}, nil
+		},
+	}, slog.Default())
+
+	resp, err := srv.ListAppointments(context.Background(), &schedulev1.ListAppointmentsRequest{
+		UserId:        "u1",
+		WindowStart:   timestamppb.New(start.Add(-24 * time.Hour)),
+		WindowEnd:     timestamppb.New(start.Add(24 * time.Hour)),
+		SplitTimeZone: "America/New_York",
+	})
+	if err != nil {
+		t.Fatalf("ListAppointments error: %v", err)
+	}
+	if len(resp.Appointments) != 1 {
+		t.Fatalf("len(appointments) = %d, want 1", len(resp.Appointments))
+	}
+	if len(resp.DaySegments) != 2 {
+		t.Fatalf("len(day_segments) = %d, want 2", len(resp.DaySegments))
+	}
+	first, second := resp.DaySegments[0], resp.DaySegments[1]
+	if first.Id != id.String() || second.Id != id.String() {
+		t.Fatalf("segment ids = %q, %q, want %q", first.Id, second.Id, id)
+	}
+	if first.LocalDate != "2026-01-05" || second.LocalDate != "2026-01-06" {
+		t.Fatalf("local dates = %q, %q, want 2026-01-05, 2026-01-06", first.LocalDate, second.LocalDate)
+	}
+	if !first.EndTime.AsTime().Equal(second.StartTime.AsTime()) {
+		t.Fatalf("segments are not contiguous: %v, %v", first.EndTime.AsTime(), second.StartTime.AsTime())
+	}
+
+	resp, err = srv.ListAppointments(context.Background(), &schedulev1.ListAppointmentsRequest{
+		UserId:      "u1",
+		WindowStart: timestamppb.New(start.Add(-24 * time.Hour)),
+		WindowEnd:   timestamppb.New(start.Add(24 * time.Hour)),
+	})
+	if err != nil {
+		t.Fatalf("ListAppointments error: %v", err)
+	}
+	if len(resp.DaySegments) != 0 {
+		t.Fatalf("len(day_segments) = %d, want 0 without split_time_zone", len(resp.DaySegments))
+	}
+}
+
+func TestListOccurrences_RejectsInvalidSplitTimeZone(t *testing.T) {
+	srv := NewAppointmentsServer(&fakeAppointmentsService{}, slog.Default())
+
+	start := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	_, err := srv.ListOccurrences(context.Background(), &schedulev1.ListOccurrencesRequest{
+		UserId:        "u1",
+		WindowStart:   timestamppb.New(start),
+		WindowEnd:     timestamppb.New(start.Add(24 * time.Hour)),
+		SplitTimeZone: "Mars/Olympus",
+	})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Fatalf("code = %s, want %s", status.Code(err), codes.InvalidArgument)
+	}
+}
+
+func TestCreateAppointment_MapsDuplicateExternalRef(t *testing.T) {
+	var got appointments.CreateInput
+	srv := NewAppointmentsServer(&fakeAppointmentsService{
+		createFn: func(ctx context.Context, in appointments.CreateInput) (domain.Appointment, error) {
+			got = in
+			return domain.Appointment{}, store.ErrDuplicate
+		},
+	}, slog.Default())
+
+	start := time.Date(2026, 1, 1, 10, 0, 0, 0, time.UTC)
+	_, err := srv.CreateAppointment(context.Background(), &schedulev1.CreateAppointmentRequest{
+		UserId:      "u1",
+		Title:       "t",
+		StartTime:   timestamppb.New(start),
+		EndTime:     timestamppb.New(start.Add(time.Hour)),
+		ExternalRef: &schedulev1.ExternalRef{System: "google", Id: "evt-1"},
+	})
+	if status.Code(err) != codes.AlreadyExists {
+		t.Fatalf("code = %s, want %s", status.Code(err), codes.AlreadyExists)
+	}
+	if got.ExternalRef == nil || got.ExternalRef.System != "google" || got.ExternalRef.ID != "evt-1" {
+		t.Fatalf("external ref = %+v, want google/evt-1", got.ExternalRef)
+	}
+}
+
+func TestGetAppointmentByExternalRef_MapsNotFound(t *testing.T) {
+	srv := NewAppointmentsServer(&fakeAppointmentsService{
+		getByExternalRefFn: func(ctx context.Context, userID string, ref appointments.ExternalRef) (domain.Appointment, error) {
+			return domain.Appointment{}, store.ErrNotFound
+		},
+	}, slog.Default())
+
+	_, err := srv.GetAppointmentByExternalRef(context.Background(), &schedulev1.GetAppointmentByExternalRefRequest{
+		UserId:      "u1",
+		ExternalRef: &schedulev1.ExternalRef{System: "google", Id: "evt-1"},
+	})
+	if status.Code(err) != codes.NotFound {
+		t.Fatalf("code = %s, want %s", status.Code(err), codes.NotFound)
+	}
+}
+
+func TestGetAnalytics_ReportsNoShowRate(t *testing.T) {
+	srv := NewAppointmentsServer(&fakeAppointmentsService{
+		userAnalyticsFn: func(ctx context.Context, userID string, windowStart, windowEnd time.Time) (domain.UserAnalytics, error) {
+			return domain.UserAnalytics{AppointmentCount: 2, AverageDuration: 45 * time.Minute, Attended: 3, Missed: 1}, nil
+		},
+	}, slog.Default())
+
+	start := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	resp, err := srv.GetAnalytics(context.Background(), &schedulev1.GetAnalyticsRequest{
+		UserId:      "u1",
+		WindowStart: timestamppb.New(start),
+		WindowEnd:   timestamppb.New(start.AddDate(0, 1, 0)),
+	})
+	if err != nil {
+		t.Fatalf("GetAnalytics error: %v", err)
+	}
+	if resp.AppointmentCount != 2 || resp.AverageDuration.AsDuration() != 45*time.Minute {
+		t.Fatalf("appointments = %d avg %v, want 2 avg 45m", resp.AppointmentCount, resp.AverageDuration.AsDuration())
+	}
+	if resp.NoShowRate != 0.25 {
+		t.Fatalf("no-show rate = %v, want 0.25", resp.NoShowRate)
+	}
+}
+
+func TestGetAnalytics_RequiresWindow(t *testing.T) {
+	srv := NewAppointmentsServer(&fakeAppointmentsService{}, slog.Default())
+
+	_, err := srv.GetAnalytics(context.Background(), &schedulev1.GetAnalyticsRequest{UserId: "u1"})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Fatalf("code = %s, want %s", status.Code(err), codes.InvalidArgument)
+	}
+}
+
+func TestSuggestEndTime_MapsConflict(t *testing.T) {
+	srv := NewAppointmentsServer(&fakeAppointmentsService{
+		suggestEndTimeFn: func(ctx context.Context, userID string, start time.Time, desired time.Duration, hours *appointments.WorkingHoursInput) (appointments.EndTimeSuggestion, error) {
+			return appointments.EndTimeSuggestion{}, store.ErrConflict
+		},
+	}, slog.Default())
+
+	_, err := srv.SuggestEndTime(context.Background(), &schedulev1.SuggestEndTimeRequest{
+		UserId:          "u1",
+		StartTime:       timestamppb.New(time.Date(2026, 3, 2, 9, 0, 0, 0, time.UTC)),
+		DesiredDuration: durationpb.New(time.Hour),
+	})
+	if status.Code(err) != codes.FailedPrecondition {
+		t.Fatalf("code = %s, want %s", status.Code(err), codes.FailedPrecondition)
+	}
+}
+
+func TestSuggestEndTime_PassesWorkingHours(t *testing.T) {
+	var got *appointments.WorkingHoursInput
+	srv := NewAppointmentsServer(&fakeAppointmentsService{
+		suggestEndTimeFn: func(ctx context.Context, userID string, start time.Time, desired time.Duration, hours *appointments.WorkingHoursInput) (appointments.EndTimeSuggestion, error) {
+			got = hours
+			return appointments.EndTimeSuggestion{}, appointments.ErrOutsideWorkingHours
+		},
+	}, slog.Default())
+
+	_, err := srv.SuggestEndTime(context.Background(), &schedulev1.SuggestEndTimeRequest{
+		UserId:          "u1",
+		StartTime:       timestamppb.New(time.Date(2026, 3, 2, 19, 0, 0, 0, time.UTC)),
+		DesiredDuration: durationpb.New(time.Hour),
+		WorkingHours:    &schedulev1.WorkingHours{TimeZone: "Europe/Berlin", StartMinute: 540, EndMinute: 1050},
+	})
+	if status.Code(err) != codes.FailedPrecondition {
+		t.Fatalf("code = %s, want %s", status.Code(err), codes.FailedPrecondition)
+	}
+	if got == nil || got.TimeZone != "Europe/Berlin" || got.EndMinute != 1050 {
+		t.Fatalf("working hours = %+v, want the request's", got)
+	}
+}
+
+func TestReserveSlot_MapsConflictAndPassesTTL(t *testing.T) {
+	var gotTTL time.Duration
+	srv := NewAppointmentsServer(&fakeAppointmentsService{
+		reserveSlotFn: func(ctx context.Context, in appointments.ReserveSlotInput) (domain.SlotHold, error) {
+			gotTTL = in.TTL
+			return domain.SlotHold{}, store.ErrConflict
+		},
+	}, slog.Default())
+
+	start := time.Date(2026, 3, 2, 9, 0, 0, 0, time.UTC)
+	_, err := srv.ReserveSlot(context.Background(), &schedulev1.ReserveSlotRequest{
+		UserId:    "u1",
+		StartTime: timestamppb.New(start),
+		EndTime:   timestamppb.New(start.Add(time.Hour)),
+		Ttl:       durationpb.New(2 * time.Minute),
+	})
+	if status.Code(err) != codes.FailedPrecondition {
+		t.Fatalf("code = %s, want %s", status.Code(err), codes.FailedPrecondition)
+	}
+	if gotTTL != 2*time.Minute {
+		t.Fatalf("ttl = %v, want 2m", gotTTL)
+	}
+}
+
+func TestConfirmHold_MapsErrors(t *testing.T) {
+	tests := []struct {
+		err  error
+		want codes.Code
+	}{
+		{err: store.ErrNotFound, want: codes.NotFound},
+		{err: store.ErrConflict, want: codes.FailedPrecondition},
+	}
+
+	for _, tt := range tests {
+		srv := NewAppointmentsServer(&fakeAppointmentsService{
+			confirmHoldFn: func(ctx context.Context, in appointments.ConfirmHoldInput) (domain.Appointment, error) {
+				return domain.Appointment{}, tt.err
+			},
+		}, slog.Default())
+
+		_, err := srv.ConfirmHold(context.Background(), &schedulev1.ConfirmHoldRequest{
+			UserId: "u1",
+			HoldId: "00000000-0000-0000-0000-000000000077",
+			Title:  "Intro call",
+		})
+		if status.Code(err) != tt.want {
+			t.Fatalf("%v: code = %s, want %s", tt.err, status.Code(err), tt.want)
+		}
+	}
+}
+
+func TestLinkAppointments_MapsKind(t *testing.T) {
+	var gotKind domain.AppointmentLinkKind
+	srv := NewAppointmentsServer(&fakeAppointmentsService{
+		linkAppointmentsFn: func(ctx context.Context, userID string, appointmentID, relatedID uuid.UUID, kind domain.AppointmentLinkKind) (domain.AppointmentLink, error) {
+			gotKind = kind
+			return domain.AppointmentLink{AppointmentID: appointmentID, RelatedID: relatedID, Kind: kind}, nil
+		},
+	}, slog.Default())
+
+	resp, err := srv.LinkAppointments(context.Background(), &schedulev1.LinkAppointmentsRequest{
+		UserId:               "u1",
+		AppointmentId:        "00000000-0000-0000-0000-000000000001",
+		RelatedAppointmentId: "00000000-0000-0000-0000-000000000002",
+		Kind:                 schedulev1.AppointmentLinkKind_APPOINTMENT_LINK_KIND_PREP_FOR,
+	})
+	if err != nil {
+		t.Fatalf("LinkAppointments error: %v", err)
+	}
+	if gotKind != domain.AppointmentLinkKindPrepFor || resp.Link.Kind != schedulev1.AppointmentLinkKind_APPOINTMENT_LINK_KIND_PREP_FOR {
+		t.Fatalf("kind = %q / %s, want prep_for", gotKind, resp.Link.Kind)
+	}
+
+	_, err = srv.LinkAppointments(context.Background(), &schedulev1.LinkAppointmentsRequest{
+		UserId:               "u1",
+		AppointmentId:        "00000000-0000-0000-0000-000000000001",
+		RelatedAppointmentId: "00000000-0000-0000-0000-000000000002",
+	})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Fatalf("code = %s, want %s", status.Code(err), codes.InvalidArgument)
+	}
+}
+
+func TestMergeAppointments_MapsResult(t *testing.T) {
+	var got appointments.MergeInput
+	srv := NewAppointmentsServer(&fakeAppointmentsService{
+		mergeAppointmentsFn: func(ctx context.Context, in appointments.MergeInput) (store.AppointmentMerge, error) {
+			got = in
+			if in.CoverDuplicates {
+				return store.AppointmentMerge{}, store.ErrConflict
+			}
+			return store.AppointmentMerge{
+				Appointment:   domain.Appointment{ID: in.PrimaryID, UserID: in.UserID, Title: "Review"},
+				Deleted:       in.DuplicateIDs,
+				LinksMoved:    2,
+				MappingsMoved: 1,
+			}, nil
+		},
+	}, slog.Default())
+
+	req := &schedulev1.MergeAppointmentsRequest{
+		UserId:       "u1",
+		PrimaryId:    "00000000-0000-0000-0000-000000000001",
+		DuplicateIds: []string{"00000000-0000-0000-0000-000000000002"},
+	}
+	resp, err := srv.MergeAppointments(context.Background(), req)
+	if err != nil {
+		t.Fatalf("MergeAppointments error: %v", err)
+	}
+	if got.PrimaryID.String() != req.PrimaryId || len(got.DuplicateIDs) != 1 {
+		t.Fatalf("input = %+v", got)
+	}
+	if resp.Appointment.GetId() != req.PrimaryId || len(resp.DeletedIds) != 1 || resp.DeletedIds[0] != req.DuplicateIds[0] || resp.LinksMoved != 2 || resp.SyncMappingsMoved != 1 {
+		t.Fatalf("resp = %+v", resp)
+	}
+
+	req.CoverDuplicates = true
+	if _, err := srv.MergeAppointments(context.Background(), req); status.Code(err) != codes.FailedPrecondition {
+		t.Fatalf("conflict code = %s, want %s", status.Code(err), codes.FailedPrecondition)
+	}
+	req.DuplicateIds = []string{"not-a-uuid"}
+	if _, err := srv.MergeAppointments(context.Background(), req); status.Code(err) != codes.InvalidArgument {
+		t.Fatalf("bad id code = %s, want %s", status.Code(err), codes.InvalidArgument)
+	}
+}
+
+func TestBatchGetFreeBusy_ReportsPartialFailures(t *testing.T) {
+	start := time.Date(2026, 1, 5, 9, 0, 0, 0, time.UTC)
+	srv := NewAppointmentsServer(&fakeAppointmentsService{
+		batchFreeBusyFn: func(ctx context.Context, userIDs []string, windowStart, windowEnd time.Time) ([]appointments.UserFreeBusy, error) {
+			return []appointments.UserFreeBusy{
+				{UserID: "u1", Busy: []domain.BusyInterval{{Start: start, End: start.Add(time.Hour)}}},
+				{UserID: "u2", Err: fmt.Errorf("%w: boom", store.ErrUnavailable)},
+				{UserID: "u3", Err: errors.New("boom")},
+			}, nil
+		},
+	}, slog.Default())
+
+	resp, err := srv.BatchGetFreeBusy(context.Background(), &schedulev1.BatchGetFreeBusyRequest{
+		UserIds:     []string{"u1", "u2", "u3"},
+		WindowStart: timestamppb.New(start),
+		WindowEnd:   timestamppb.New(start.Add(24 * time.Hour)),
+	})
+	if err != nil {
+		t.Fatalf("BatchGetFreeBusy error: %v", err)
+	}
+	if len(resp.Results) != 3 {
+		t.Fatalf("len(results) = %d, want 3", len(resp.Results))
+	}
+	if r := resp.Results[0]; r.ErrorCode != "" || len(r.Busy) != 1 || !r.Busy[0].EndTime.AsTime().Equal(start.Add(time.Hour)) {
+		t.Fatalf("results[0] = %+v, want one busy hour", r)
+	}
+	if r := resp.Results[1]; r.ErrorCode != codes.Unavailable.String() {
+		t.Fatalf("results[1].error_code = %q, want %q", r.ErrorCode, codes.Unavailable.String())
+	}
+	if r := resp.Results[2]; r.ErrorCode != codes.Internal.String() || r.ErrorMessage != "internal error" {
+		t.Fatalf("results[2] = %q/%q, want Internal/internal error", r.ErrorCode, r.ErrorMessage)
+	}
+}
+
+func TestSuggestMeetingTimes_MapsAttendeesAndSuggestions(t *testing.T) {
+	start := time.Date(2026, 1, 5, 14, 0, 0, 0, time.UTC)
+	var got appointments.SuggestMeetingTimesInput
+	srv := NewAppointmentsServer(&fakeAppointmentsService{
+		suggestMeetingFn: func(ctx context.Context, in appointments.SuggestMeetingTimesInput) ([]scheduling.Suggestion, error) {
+			got = in
+			return []scheduling.Suggestion{{Start: start, End: start.Add(time.Hour), Score: -0.5, OutsideHours: []string{"u2"}}}, nil
+		},
+	}, slog.Default())
+
+	resp, err := srv.SuggestMeetingTimes(context.Background(), &schedulev1.SuggestMeetingTimesRequest{
+		Attendees: []*schedulev1.MeetingAttendee{
+			{
+				UserId: "u1",
+				WorkingHours: &schedulev1.WorkingHours{
+					TimeZone:    "America/New_York",
+					StartMinute: 540,
+					EndMinute:   1020,
+					Weekdays:    []schedulev1.Weekday{schedulev1.Weekday_MONDAY, schedulev1.Weekday_WEEKDAY_UNSPECIFIED},
+				},
+				Preferred: []*schedulev1.TimeRange{{StartTime: timestamppb.New(start), EndTime: timestamppb.New(start.Add(time.Hour))}},
+			},
+			{UserId: "u2"},
+		},
+		Duration:    durationpb.New(time.Hour),
+		WindowStart: timestamppb.New(start),
+		WindowEnd:   timestamppb.New(start.Add(8 * time.Hour)),
+	})
+	if err != nil {
+		t.Fatalf("SuggestMeetingTimes error: %v", err)
+	}
+
+	if len(got.Attendees) != 2 || got.Attendees[0].WorkingHours == nil || got.Attendees[1].WorkingHours != nil {
+		t.Fatalf("attendees = %+v, want working hours on u1 only", got.Attendees)
+	}
+	wh := got.Attendees[0].WorkingHours
+	if wh.StartMinute != 540 || wh.EndMinute != 1020 || len(wh.Weekdays) != 1 || wh.Weekdays[0] != 1 {
+		t.Fatalf("working hours = %+v, want 540-1020 on [1]", wh)
+	}
+	if len(got.Attendees[0].Preferred) != 1 || got.Duration != time.Hour {
+		t.Fatalf("input = %+v, want one preferred range and 1h duration", got)
+	}
+	if len(resp.Suggestions) != 1 || resp.Suggestions[0].Score != -0.5 || resp.Suggestions[0].OutsideWorkingHours[0] != "u2" {
+		t.Fatalf("suggestions = %+v", resp.Suggestions)
+	}
+}
+
+func TestSimulateSchedule_MapsStaffPatternsAndUtilization(t *testing.T) {
+	start := time.Date(2026, 1, 5, 0, 0, 0, 0, time.UTC)
+	var got appointments.SimulateScheduleInput
+	srv := NewAppointmentsServer(&fakeAppointmentsService{
+		simulateScheduleFn: func(ctx context.Context, in appointments.SimulateScheduleInput) (scheduling.SimulationResult, error) {
+			got = in
+			day := scheduling.Utilization{Capacity: 8 * time.Hour, Booked: 2 * time.Hour}
+			return scheduling.SimulationResult{
+				Utilization: day,
+				Requested:   3,
+				Unbooked:    1,
+				Days:        []scheduling.DayUtilization{{Date: start, Utilization: day, Requested: 3, Unbooked: 1}},
+				Staff:       []scheduling.StaffUtilization{{Label: "dr-a", Utilization: day, Bookings: 2}},
+				Patterns:    []scheduling.PatternOutcome{{Label: "consult", Requested: 3, Unbooked: 1}},
+			}, nil
+		},
+	}, slog.Default())
+
+	resp, err := srv.SimulateSchedule(context.Background(), &schedulev1.SimulateScheduleRequest{
+		Staff: []*schedulev1.SimulatedStaff{{
+			Label:        "dr-a",
+			WorkingHours: &schedulev1.WorkingHours{TimeZone: "UTC", StartMinute: 540, EndMinute: 1020},
+			Breaks:       []*schedulev1.DailyBreak{{Label: "lunch", StartMinute: 720, EndMinute: 780}, nil},
+		}},
+		Patterns: []*schedulev1.BookingPattern{{
+			Label:          "consult",
+			Duration:       durationpb.New(time.Hour),
+			BookingsPerDay: 3,
+			Weekdays:       []schedulev1.Weekday{schedulev1.Weekday_MONDAY, schedulev1.Weekday_WEEKDAY_UNSPECIFIED},
+		}},
+		WindowStart: timestamppb.New(start),
+		WindowEnd:   timestamppb.New(start.Add(24 * time.Hour)),
+	})
+	if err != nil {
+		t.Fatalf("SimulateSchedule error: %v", err)
+	}
+
+	if len(got.Staff) != 1 || got.Staff[0].WorkingHours.StartMinute != 540 || len(got.Staff[0].Breaks) != 1 {
+		t.Fatalf("staff = %+v, want one member with hours and a lunch break", got.Staff)
+	}
+	if len(got.Patterns) != 1 || got.Patterns[0].PerDay != 3 || got.Patterns[0].Duration != time.Hour || len(got.Patterns[0].Weekdays) != 1 {
+		t.Fatalf("patterns = %+v, want 3 x 1h on Mondays", got.Patterns)
+	}
+	if resp.Utilization.Utilization != 0.25 || resp.Requested != 3 || resp.Unbooked != 1 {
+		t.Fatalf("totals = %+v, want 25%% with 1 of 3 unbooked", resp)
+	}
+	if len(resp.Days) != 1 || resp.Days[0].Date != "2026-01-05" || resp.Staff[0].Bookings != 2 || resp.Patterns[0].Unbooked != 1 {
+		t.Fatalf("breakdown = days %+v staff %+v patterns %+v", resp.Days, resp.Staff, resp.Patterns)
+	}
+}
+
+func TestSimulateSchedule_RequiresWorkingHours(t *testing.T) {
+	srv := NewAppointmentsServer(&fakeAppointmentsService{}, slog.Default())
+	start := time.Date(2026, 1, 5, 0, 0, 0, 0, time.UTC)
+
+	_, err := srv.SimulateSchedule(context.Background(), &schedulev1.SimulateScheduleRequest{
+		Staff:       []*schedulev1.SimulatedStaff{{Label: "dr-a"}},
+		WindowStart: timestamppb.New(start),
+		WindowEnd:   timestamppb.New(start.Add(24 * time.Hour)),
+	})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Fatalf("code = %v, want InvalidArgument", status.Code(err))
+	}
+}
+
+func TestRepairRecurringSeries_MapsFindings(t *testing.T) {
+	seriesID := uuid.New()
+	exceptionID := uuid.New()
+	start := time.Date(2026, 1, 5, 9, 0, 0, 0, time.UTC)
+	srv := NewAppointmentsServer(&fakeAppointmentsService{
+		repairSeriesFn: func(ctx context.Context, userID string, id uuid.UUID, apply bool) (appointments.SeriesRepairReport, error) {
+			if !apply {
+				t.Fatalf("apply = false, want true")
+			}
+			return appointments.SeriesRepairReport{
+				SeriesID: id,
+				Findings: []domain.SeriesFinding{
+					{Kind: domain.SeriesFindingExceptionOffPattern, ExceptionID: exceptionID, OccurrenceStart: start, Repaired: true},
+					{Kind: domain.SeriesFindingInvalidTimeZone},
+				},
+				Repaired: 1,
+			}, nil
+		},
+	}, slog.Default())
+
+	resp, err := srv.RepairRecurringSeries(context.Background(), &schedulev1.RepairRecurringSeriesRequest{
+		UserId:   "u1",
+		SeriesId: seriesID.String(),
+		Apply:    true,
+	})
+	if err != nil {
+		t.Fatalf("RepairRecurringSeries error: %v", err)
+	}
+	if resp.Repaired != 1 || len(resp.Findings) != 2 {
+		t.Fatalf("resp = %+v", resp)
+	}
+	first, second := resp.Findings[0], resp.Findings[1]
+	if first.Kind != schedulev1.SeriesFindingKind_SERIES_FINDING_KIND_EXCEPTION_OFF_PATTERN || first.ExceptionId != exceptionID.String() || !first.Repaired {
+		t.Fatalf("findings[0] = %+v", first)
+	}
+	if second.Kind != schedulev1.SeriesFindingKind_SERIES_FINDING_KIND_INVALID_TIME_ZONE || second.ExceptionId != "" || second.OccurrenceStart != nil {
+		t.Fatalf("findings[1] = %+v", second)
+	}
+}
+
+func TestSkipOccurrences_PassesRuleAndMapsNotFound(t *testing.T) {
+	seriesID := uuid.New()
+	start := time.Date(2026, 1, 9, 9, 0, 0, 0, time.UTC)
+	var got appointments.SkipOccurrencesInput
+	fake := &fakeAppointmentsService{
+		skipOccurrencesFn: func(ctx context.Context, in appointments.SkipOccurrencesInput) (appointments.SkipOccurrencesResult, error) {
+			got = in
+			return appointments.SkipOccurrencesResult{
+				OccurrenceStarts: []time.Time{start},
+				Confirmation:     &appointments.Confirmation{Token: "c1.tok", ExpiresAt: start},
+			}, nil
+		},
+	}
+	srv := NewAppointmentsServer(fake, slog.Default())
+
+	resp, err := srv.SkipOccurrences(context.Background(), &schedulev1.SkipOccurrencesRequest{
+		UserId:   "u1",
+		SeriesId: seriesID.String(),
+		Weekdays: []schedulev1.Weekday{schedulev1.Weekday_FRIDAY},
+	})
+	if err != nil {
+		t.Fatalf("SkipOccurrences error: %v", err)
+	}
+	if got.SeriesID != seriesID || got.Apply || !got.WindowStart.IsZero() || len(got.Weekdays) != 1 || got.Weekdays[0] != 5 {
+		t.Fatalf("input = %+v, want a preview of Fridays with no window", got)
+	}
+	if len(resp.OccurrenceStarts) != 1 || !resp.OccurrenceStarts[0].AsTime().Equal(start) || resp.Skipped != 0 {
+		t.Fatalf("resp = %+v", resp)
+	}
+	if resp.ConfirmationToken != "c1.tok" || !resp.ConfirmationExpiresAt.AsTime().Equal(start) {
+		t.Fatalf("confirmation = %q until %v", resp.ConfirmationToken, resp.ConfirmationExpiresAt)
+	}
+
+	fake.skipOccurrencesFn = func(ctx context.Context, in appointments.SkipOccurrencesInput) (appointments.SkipOccurrencesResult, error) {
+		got = in
+		return appointments.SkipOccurrencesResult{}, fmt.Errorf("%w: preview first", appointments.ErrConfirmationRequired)
+	}
+	_, err = srv.SkipOccurrences(context.Background(), &schedulev1.SkipOccurrencesRequest{UserId: "u1", SeriesId: seriesID.String(), Apply: true, ConfirmationToken: "c1.old"})
+	if status.Code(err) != codes.FailedPrecondition || got.ConfirmationToken != "c1.old" {
+		t.Fatalf("code = %s with token %q, want %s with the token passed through", status.Code(err), got.ConfirmationToken, codes.FailedPrecondition)
+	}
+
+	fake.skipOccurrencesFn = func(ctx context.Context, in appointments.SkipOccurrencesInput) (appointments.SkipOccurrencesResult, error) {
+		return appointments.SkipOccurrencesResult{}, store.ErrNotFound
+	}
+	_, err = srv.SkipOccurrences(context.Background(), &schedulev1.SkipOccurrencesRequest{UserId: "u1", SeriesId: seriesID.String(), Apply: true})
+	if status.Code(err) != codes.NotFound {
+		t.Fatalf("code = %s, want %s", status.Code(err), codes.NotFound)
+	}
+}
+
+func TestListOccurrences_IncludesLocalTimes(t *testing.T) {
+	start := time.Date(2026, 3, 9, 14, 0, 0, 0, time.UTC)
+	srv := NewAppointmentsServer(&fakeAppointmentsService{
+		listOccurrencesFn: func(ctx context.Context, userID string, windowStart, windowEnd time.Time) ([]domain.RecurringOccurrence, error) {
+			return []domain.RecurringOccurrence{
+				{ID: "a", StartTime: start, EndTime: start.Add(time.Hour), Timezone: "America/New_York"},
+				{ID: "b", StartTime: start, EndTime: start.Add(time.Hour)},
+			}, nil
+		},
+	}, slog.Default())
+
+	req := &schedulev1.ListOccurrencesRequest{
+		UserId:      "u1",
+		WindowStart: timestamppb.New(start.Add(-time.Hour)),
+		WindowEnd:   timestamppb.New(start.Add(2 * time.Hour)),
+	}
+	resp, err := srv.ListOccurrences(context.Background(), req)
+	if err != nil {
+		t.Fatalf("ListOccurrences error: %v", err)
+	}
+	if got := resp.Occurrences[0]; got.TimeZone != "America/New_York" || got.LocalStartTime != "" {
+		t.Fatalf("occurrence = %+v, want time zone without local times", got)
+	}
+
+	req.IncludeLocalTimes = true
+	resp, err = srv.ListOccurrences(context.Background(), req)
+	if err != nil {
+		t.Fatalf("ListOccurrences error: %v", err)
+	}
+	if got := resp.Occurrences[0]; got.LocalStartTime != "2026-03-09T10:00:00-04:00" || got.LocalEndTime != "2026-03-09T11:00:00-04:00" {
+		t.Fatalf("local times = %q, %q, want New York wall clock", got.LocalStartTime, got.LocalEndTime)
+	}
+	if got := resp.Occurrences[1]; got.LocalStartTime != "2026-03-09T14:00:00Z" {
+		t.Fatalf("local start = %q, want UTC for an occurrence without a zone", got.LocalStartTime)
+	}
+}
+
+func TestListOccurrences_CapsWindowAtMaxHorizon(t *testing.T) {
+	start := time.Date(2026, 3, 9, 0, 0, 0, 0, time.UTC)
+	var gotEnd time.Time
+	srv := NewAppointmentsServer(&fakeAppointmentsService{
+		listOccurrencesFn: func(ctx context.Context, userID string, windowStart, windowEnd time.Time) ([]domain.RecurringOccurrence, error) {
+			gotEnd = windowEnd
+			return nil, nil
+		},
+	}, slog.Default())
+
+	req := &schedulev1.ListOccurrencesRequest{
+		UserId:      "u1",
+		WindowStart: timestamppb.New(start),
+		WindowEnd:   timestamppb.New(start.AddDate(0, 6, 0)),
+		MaxHorizon:  durationpb.New(14 * 24 * time.Hour),
+	}
+	resp, err := srv.ListOccurrences(context.Background(), req)
+	if err != nil {
+		t.Fatalf("ListOccurrences error: %v", err)
+	}
+	want := start.AddDate(0, 0, 14)
+	if !gotEnd.Equal(want) || !resp.Truncated || !resp.ExpandedUntil.AsTime().Equal(want) {
+		t.Fatalf("window end = %v, truncated = %v, expanded until = %v, want %v", gotEnd, resp.Truncated, resp.ExpandedUntil.AsTime(), want)
+	}
+
+	req.MaxHorizon = durationpb.New(365 * 24 * time.Hour)
+	if resp, err = srv.ListOccurrences(context.Background(), req); err != nil || resp.Truncated || !gotEnd.Equal(req.WindowEnd.AsTime()) {
+		t.Fatalf("long horizon: truncated = %v, window end = %v, err = %v", resp.GetTruncated(), gotEnd, err)
+	}
+
+	req.MaxHorizon = durationpb.New(-time.Hour)
+	if _, err := srv.ListOccurrences(context.Background(), req); status.Code(err) != codes.InvalidArgument {
+		t.Fatalf("negative horizon code = %s, want %s", status.Code(err), codes.InvalidArgument)
+	}
+}
+
+func TestCreateAppointment_MapsNotAuthorized(t *testing.T) {
+	var gotActor string
+	srv := NewAppointmentsServer(&fakeAppointmentsService{
+		createFn: func(ctx context.Context, in appointments.CreateInput) (domain.Appointment, error) {
+			gotActor = in.ActorID
+			return domain.Appointment{}, appointments.ErrNotAuthorized
+		},
+	}, slog.Default())
+
+	start := time.Date(2026, 1, 1, 10, 0, 0, 0, time.UTC)
+	_, err := srv.CreateAppointment(context.Background(), &schedulev1.CreateAppointmentRequest{
+		UserId:    "boss",
+		ActorId:   "intern",
+		Title:     "t",
+		StartTime: timestamppb.New(start),
+		EndTime:   timestamppb.New(start.Add(time.Hour)),
+	})
+	if status.Code(err) != codes.PermissionDenied {
+		t.Fatalf("code = %s, want %s", status.Code(err), codes.PermissionDenied)
+	}
+	if gotActor != "intern" {
+		t.Fatalf("actor = %q, want intern", gotActor)
+	}
+}
+
+func TestImportCalendar_MapsNonEmptyCalendar(t *testing.T) {
+	srv := NewAppointmentsServer(&fakeAppointmentsService{
+		importCalendarFn: func(ctx context.Context, in appointments.ImportCalendarInput) (appointments.ImportCalendarResult, error) {
+			if in.UserID != "u1" || string(in.Bundle) != "{}" {
+				t.Fatalf("input = %+v", in)
+			}
+			return appointments.ImportCalendarResult{}, store.ErrConflict
+		},
+	}, slog.Default())
+
+	_, err := srv.ImportCalendar(context.Background(), &schedulev1.ImportCalendarRequest{UserId: "u1", Bundle: []byte("{}")})
+	if status.Code(err) != codes.FailedPrecondition {
+		t.Fatalf("code = %s, want %s", status.Code(err), codes.FailedPrecondition)
+	}
+}
+
+func TestRestoreCalendarSnapshot_MapsErrors(t *testing.T) {
+	snapID := uuid.New()
+	var restoreErr error
+	srv := NewAppointmentsServer(&fakeAppointmentsService{
+		restoreSnapshotFn: func(ctx context.Context, userID string, snapshotID uuid.UUID) (appointments.RestoreCalendarResult, error) {
+			if userID != "u1" || snapshotID != snapID {
+				t.Fatalf("restore(%q, %s)", userID, snapshotID)
+			}
+			if restoreErr != nil {
+				return appointments.RestoreCalendarResult{}, restoreErr
+			}
+			return appointments.RestoreCalendarResult{
+				Snapshot:            domain.CalendarSnapshotInfo{ID: snapID, UserID: "u1", Appointments: 2},
+				AppointmentsCreated: 1,
+				SeriesDeleted:       1,
+			}, nil
+		},
+	}, slog.Default())
+
+	_, err := srv.RestoreCalendarSnapshot(context.Background(), &schedulev1.RestoreCalendarSnapshotRequest{UserId: "u1", SnapshotId: "nope"})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Fatalf("bad id: code = %s, want %s", status.Code(err), codes.InvalidArgument)
+	}
+
+	resp, err := srv.RestoreCalendarSnapshot(context.Background(), &schedulev1.RestoreCalendarSnapshotRequest{UserId: "u1", SnapshotId: snapID.String()})
+	if err != nil {
+		t.Fatalf("RestoreCalendarSnapshot error: %v", err)
+	}
+	if resp.Snapshot.GetId() != snapID.String() || resp.AppointmentsCreated != 1 || resp.SeriesDeleted != 1 {
+		t.Fatalf("response = %+v", resp)
+	}
+
+	for _, tc := range []struct {
+		err  error
+		want codes.Code
+	}{
+		{appointments.ErrSnapshotsUnavailable, codes.Unimplemented},
+		{store.ErrNotFound, codes.NotFound},
+		{appointments.ErrSnapshotCorrupt, codes.DataLoss},
+		{store.ErrConflict, codes.Aborted},
+	} {
+		restoreErr = tc.err
+		_, err := srv.RestoreCalendarSnapshot(context.Background(), &schedulev1.RestoreCalendarSnapshotRequest{UserId: "u1", SnapshotId: snapID.String()})
+		if status.Code(err) != tc.want {
+			t.Fatalf("%v: code = %s, want %s", tc.err, status.Code(err), tc.want)
+		}
+	}
+}
+
+type fakeWatchStream struct {
+	grpc.ServerStream
+	ctx  context.Context
+	sent []*schedulev1.WatchOccurrencesResponse
+}
+
+func (f *fakeWatchStream) Context() context.Context { return f.ctx }
+
+func (f *fakeWatchStream) Send(resp *schedulev1.WatchOccurrencesResponse) error {
+	f.sent = append(f.sent, resp)
+	return nil
+}
+
+func TestWatchOccurrences_StreamsSnapshotsAndMapsNotFound(t *testing.T) {
+	seriesID := uuid.New()
+	start := time.Date(2026, 1, 5, 10, 0, 0, 0, time.UTC)
+	srv := NewAppointmentsServer(&fakeAppointmentsService{
+		watchSeriesFn: func(ctx context.Context, in appointments.WatchSeriesInput, send func(appointments.SeriesSnapshot) error) error {
+			if in.SeriesID != seriesID {
+				t.Fatalf("series id = %s, want %s", in.SeriesID, seriesID)
+			}
+			snap := appointments.SeriesSnapshot{
+				Series:      domain.RecurringSeries{ID: seriesID, UserID: "u1", Title: "1:1"},
+				Occurrences: []domain.RecurringOccurrence{{SeriesID: seriesID, StartTime: start, EndTime: start.Add(time.Hour)}},
+			}
+			if err := send(snap); err != nil {
+				return err
+			}
+			snap.Occurrences = nil
+			if err := send(snap); err != nil {
+				return err
+			}
+			return store.ErrNotFound
+		},
+	}, slog.Default())
+
+	stream := &fakeWatchStream{ctx: context.Background()}
+	err := srv.WatchOccurrences(&schedulev1.WatchOccurrencesRequest{
+		UserId:      "u1",
+		SeriesId:    seriesID.String(),
+		WindowStart: timestamppb.New(start),
+		WindowEnd:   timestamppb.New(start.AddDate(0, 0, 7)),
+	}, stream)
+	if status.Code(err) != codes.NotFound {
+		t.Fatalf("code = %s, want %s", status.Code(err), codes.NotFound)
+	}
+	if len(stream.sent) != 2 || len(stream.sent[0].Occurrences) != 1 || len(stream.sent[1].Occurrences) != 0 {
+		t.Fatalf("sent = %+v, want snapshot then update", stream.sent)
+	}
+}
+
+func TestListChanges_MapsPage(t *testing.T) {
+	id := uuid.New()
+	srv := NewAppointmentsServer(&fakeAppointmentsService{
+		listChangesFn: func(ctx context.Context, in appointments.ListChangesInput) (appointments.ChangesPage, error) {
+			if in.Cursor != "abc" || in.Limit != 50 || in.Wait != 20*time.Second {
+				t.Fatalf("input = %+v", in)
+			}
+			return appointments.ChangesPage{
+				Changes:    []domain.CalendarChange{{Seq: 7, EntityType: domain.ChangeEntitySeries, EntityID: id, Op: domain.ChangeOpDeleted}},
+				NextCursor: "def",
+			}, nil
+		},
+	}, slog.Default())
+
+	resp, err := srv.ListChanges(context.Background(), &schedulev1.ListChangesRequest{
+		UserId:      "u1",
+		SinceCursor: "abc",
+		PageSize:    50,
+		Wait:        durationpb.New(20 * time.Second),
+	})
+	if err != nil {
+		t.Fatalf("ListChanges error: %v", err)
+	}
+	if resp.NextCursor != "def" || len(resp.Changes) != 1 {
+		t.Fatalf("resp = %+v", resp)
+	}
+	c := resp.Changes[0]
+	if c.EntityType != schedulev1.ChangeEntity_CHANGE_ENTITY_SERIES || c.Op != schedulev1.ChangeOp_CHANGE_OP_DELETED || c.EntityId != id.String() {
+		t.Fatalf("change = %+v", c)
+	}
+}
+
+func TestListOccurrences_SyncTokenReturnsChangedSeries(t *testing.T) {
+	seriesID := uuid.New()
+	start := time.Date(2030, 1, 7, 0, 0, 0, 0, time.UTC)
+	srv := NewAppointmentsServer(&fakeAppointmentsService{
+		syncOccurrencesFn: func(ctx context.Context, userID string, windowStart, windowEnd time.Time, token string) (appointments.OccurrencesSync, error) {
+			if token != "tok1" {
+				t.Fatalf("token = %q, want tok1", token)
+			}
+			return appointments.OccurrencesSync{ChangedSeriesIDs: []uuid.UUID{seriesID}, NextSyncToken: "tok2"}, nil
+		},
+	}, slog.Default())
+
+	resp, err := srv.ListOccurrences(context.Background(), &schedulev1.ListOccurrencesRequest{
+		UserId:      "u1",
+		WindowStart: timestamppb.New(start),
+		WindowEnd:   timestamppb.New(start.AddDate(0, 0, 7)),
+		SyncToken:   "tok1",
+	})
+	if err != nil {
+		t.Fatalf("ListOccurrences error: %v", err)
+	}
+	if resp.NextSyncToken != "tok2" || resp.FullSync || len(resp.ChangedSeriesIds) != 1 || resp.ChangedSeriesIds[0] != seriesID.String() {
+		t.Fatalf("resp = %+v", resp)
+	}
+}
+
+func TestReconcileCalendar_MapsResults(t *testing.T) {
+	id := uuid.New()
+	base := time.Date(2030, 1, 1, 12, 0, 0, 0, time.UTC)
+	srv := NewAppointmentsServer(&fakeAppointmentsService{
+		reconcileCalendarFn: func(ctx context.Context, in appointments.ReconcileInput) ([]appointments.MutationResult, error) {
+			if len(in.Mutations) != 2 || in.Mutations[0].Kind != store.MutationDelete || !in.Mutations[0].BaseUpdatedAt.Equal(base) {
+				t.Fatalf("input = %+v", in)
+			}
+			if !in.Mutations[1].StartTime.IsZero() {
+				t.Fatalf("unset start_time = %v, want zero", in.Mutations[1].StartTime)
+			}
+			current := domain.Appointment{ID: id, UserID: "u1", Title: "Theirs"}
+			return []appointments.MutationResult{
+				{MutationOutcome: store.MutationOutcome{Conflict: store.ConflictVersion, Current: &current}},
+				{Rejected: "title is required"},
+			}, nil
+		},
+	}, slog.Default())
+
+	resp, err := srv.ReconcileCalendar(context.Background(), &schedulev1.ReconcileCalendarRequest{
+		UserId: "u1",
+		Mutations: []*schedulev1.OfflineMutation{
+			{Kind: schedulev1.MutationKind_MUTATION_KIND_DELETE, AppointmentId: id.String(), BaseUpdatedAt: timestamppb.New(base)},
+			{Kind: schedulev1.MutationKind_MUTATION_KIND_CREATE, AppointmentId: uuid.NewString()},
+		},
+	})
+	if err != nil {
+		t.Fatalf("ReconcileCalendar error: %v", err)
+	}
+	if len(resp.Results) != 2 {
+		t.Fatalf("results = %+v", resp.Results)
+	}
+	r := resp.Results[0]
+	if r.Status != schedulev1.MutationStatus_MUTATION_STATUS_CONFLICTED || r.Conflict != schedulev1.MutationConflict_MUTATION_CONFLICT_VERSION || r.Current.GetTitle() != "Theirs" {
+		t.Fatalf("first result = %+v", r)
+	}
+	if resp.Results[1].Status != schedulev1.MutationStatus_MUTATION_STATUS_REJECTED || resp.Results[1].Message != "title is required" {
+		t.Fatalf("second result = %+v", resp.Results[1])
+	}
+
+	_, err = srv.ReconcileCalendar(context.Background(), &schedulev1.ReconcileCalendarRequest{
+		UserId:    "u1",
+		Mutations: []*schedulev1.OfflineMutation{{AppointmentId: "nope"}},
+	})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Fatalf("code = %s, want %s", status.Code(err), codes.InvalidArgument)
+	}
+}
+
+func TestCheckOut_ReportsPlannedAndActualDurations(t *testing.T) {
+	id := uuid.New()
+	start := time.Date(2030, 1, 7, 9, 0, 0, 0, time.UTC)
+	in := start.Add(5 * time.Minute)
+	out := start.Add(55 * time.Minute)
+	srv := NewAppointmentsServer(&fakeAppointmentsService{
+		checkOutFn: func(ctx context.Context, req appointments.SessionInput) (domain.Appointment, error) {
+			if req.AppointmentID != id || !req.At.IsZero() {
+				t.Fatalf("input = %+v", req)
+			}
+			return domain.Appointment{ID: id, UserID: "u1", StartTime: start, EndTime: start.Add(time.Hour), CheckedInAt: &in, CheckedOutAt: &out}, nil
+		},
+	}, slog.Default())
+
+	resp, err := srv.CheckOut(context.Background(), &schedulev1.CheckOutRequest{UserId: "u1", AppointmentId: id.String()})
+	if err != nil {
+		t.Fatalf("CheckOut error: %v", err)
+	}
+	if resp.PlannedDuration.AsDuration() != time.Hour || resp.ActualDuration.AsDuration() != 50*time.Minute {
+		t.Fatalf("durations = %v planned, %v actual", resp.PlannedDuration.AsDuration(), resp.ActualDuration.AsDuration())
+	}
+	if !resp.Appointment.CheckedOutAt.AsTime().Equal(out) {
+		t.Fatalf("checked_out_at = %v, want %v", resp.Appointment.CheckedOutAt.AsTime(), out)
+	}
+
+	srv = NewAppointmentsServer(&fakeAppointmentsService{
+		checkOutFn: func(ctx context.Context, req appointments.SessionInput) (domain.Appointment, error) {
+			return domain.Appointment{}, store.ErrConflict
+		},
+	}, slog.Default())
+	_, err = srv.CheckOut(context.Background(), &schedulev1.CheckOutRequest{UserId: "u1", AppointmentId: id.String()})
+	if status.Code(err) != codes.FailedPrecondition {
+		t.Fatalf("code = %s, want %s", status.Code(err), codes.FailedPrecondition)
+	}
+}
+
+func TestExportBillableHours_MapsOptions(t *testing.T) {
+	start := time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC)
+	srv := NewAppointmentsServer(&fakeAppointmentsService{
+		exportBillableFn: func(ctx context.Context, in appointments.BillableHoursInput) (appointments.BillableExport, error) {
+			if in.Period != appointments.BillablePeriodMonth || in.Format != appointments.BillableFormatJSON || in.TagKey != "customer" {
+				t.Fatalf("input = %+v", in)
+			}
+			return appointments.BillableExport{Data: []byte("{}"), ContentType: "application/json"}, nil
+		},
+	}, slog.Default())
+
+	resp, err := srv.ExportBillableHours(context.Background(), &schedulev1.ExportBillableHoursRequest{
+		UserId:      "u1",
+		WindowStart: timestamppb.New(start),
+		WindowEnd:   timestamppb.New(start.AddDate(0, 3, 0)),
+		TagKey:      "customer",
+		Period:      schedulev1.BillablePeriod_BILLABLE_PERIOD_MONTH,
+		Format:      schedulev1.BillableFormat_BILLABLE_FORMAT_JSON,
+	})
+	if err != nil {
+		t.Fatalf("ExportBillableHours error: %v", err)
+	}
+	if resp.ContentType != "application/json" || string(resp.Data) != "{}" {
+		t.Fatalf("resp = %+v", resp)
+	}
+}
+
+func TestContacts_MapRequestsAndNotFound(t *testing.T) {
+	id := uuid.New()
+	srv := NewAppointmentsServer(&fakeAppointmentsService{
+		updateContactFn: func(ctx context.Context, in appointments.ContactInput) (domain.Contact, error) {
+			if in.ContactID != id || in.UserID != "u1" || in.Email != "ada@example.com" {
+				t.Fatalf("input = %+v", in)
+			}
+			return domain.Contact{ID: id, UserID: "u1", Name: in.Name, Email: in.Email}, nil
+		},
+		getContactFn: func(ctx context.Context, userID string, contactID uuid.UUID) (domain.Contact, error) {
+			return domain.Contact{}, store.ErrNotFound
+		},
+	}, slog.Default())
+
+	resp, err := srv.UpdateContact(context.Background(), &schedulev1.UpdateContactRequest{UserId: "u1", ContactId: id.String(), Name: "Ada", Email: "ada@example.com"})
+	if err != nil {
+		t.Fatalf("UpdateContact error: %v", err)
+	}
+	if resp.Contact.Id != id.String() || resp.Contact.Name != "Ada" {
+		t.Fatalf("contact = %+v", resp.Contact)
+	}
+
+	_, err = srv.GetContact(context.Background(), &schedulev1.GetContactRequest{UserId: "u1", ContactId: id.String()})
+	if status.Code(err) != codes.NotFound {
+		t.Fatalf("code = %s, want %s", status.Code(err), codes.NotFound)
+	}
+	_, err = srv.CreateAppointment(context.Background(), &schedulev1.CreateAppointmentRequest{UserId: "u1", Title: "Call", ContactId: "nope"})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Fatalf("code = %s, want %s", status.Code(err), codes.InvalidArgument)
+	}
+}
+
+func TestCreateEmbedToken_MapsWorkingHoursAndDisabled(t *testing.T) {
+	expires := time.Date(2030, 2, 1, 0, 0, 0, 0, time.UTC)
+	srv := NewAppointmentsServer(&fakeAppointmentsService{
+		createEmbedTokenFn: func(ctx context.Context, in appointments.EmbedTokenInput) (appointments.EmbedToken, error) {
+			if in.UserID == "off" {
+				return appointments.EmbedToken{}, appointments.ErrEmbedDisabled
+			}
+			if in.SlotDuration != 45*time.Minute || in.WorkingHours == nil || in.WorkingHours.TimeZone != "Europe/Berlin" || len(in.WorkingHours.Weekdays) != 1 {
+				t.Fatalf("input = %+v", in)
+			}
+			return appointments.EmbedToken{Token: "tok", ExpiresAt: expires}, nil
+		},
+	}, slog.Default())
+
+	resp, err := srv.CreateEmbedToken(context.Background(), &schedulev1.CreateEmbedTokenRequest{
+		UserId:       "u1",
+		SlotDuration: durationpb.New(45 * time.Minute),
+		WorkingHours: &schedulev1.WorkingHours{TimeZone: "Europe/Berlin", StartMinute: 540, EndMinute: 1020, Weekdays: []schedulev1.Weekday{schedulev1.Weekday_MONDAY}},
+	})
+	if err != nil {
+		t.Fatalf("CreateEmbedToken error: %v", err)
+	}
+	if resp.Token != "tok" || !resp.ExpiresAt.AsTime().Equal(expires) {
+		t.Fatalf("resp = %+v", resp)
+	}
+
+	_, err = srv.CreateEmbedToken(context.Background(), &schedulev1.CreateEmbedTokenRequest{UserId: "off"})
+	if status.Code(err) != codes.FailedPrecondition {
+		t.Fatalf("code = %s, want %s", status.Code(err), codes.FailedPrecondition)
+	}
+}
+
+func TestListAppointments_ReturnsVersionReadBeforeList(t *testing.T) {
+	var calls []string
+	srv := NewAppointmentsServer(&fakeAppointmentsService{
+		calendarVersionFn: func(ctx context.Context, userID string) (int64, error) {
+			calls = append(calls, "version")
+			return 12, nil
+		},
+		listFn: func(ctx context.Context, userID string, windowStart, windowEnd time.Time, filter store.AppointmentFilter) ([]domain.Appointment, error) {
+			calls = append(calls, "list")
+			return nil, nil
+		},
+	}, slog.Default())
+
+	start := time.Date(2030, 1, 7, 0, 0, 0, 0, time.UTC)
+	resp, err := srv.ListAppointments(context.Background(), &schedulev1.ListAppointmentsRequest{
+		UserId:      "u1",
+		WindowStart: timestamppb.New(start),
+		WindowEnd:   timestamppb.New(start.Add(24 * time.Hour)),
+	})
+	if err != nil {
+		t.Fatalf("ListAppointments error: %v", err)
+	}
+	if resp.CalendarVersion != 12 || len(calls) != 2 || calls[0] != "version" {
+		t.Fatalf("version = %d, calls = %v", resp.CalendarVersion, calls)
+	}
+}
+
+func TestUpdateSlotSettings_MapsInputAndValidation(t *testing.T) {
+	srv := NewAppointmentsServer(&fakeAppointmentsService{
+		updateSlotSettingsFn: func(ctx context.Context, in appointments.SlotSettingsInput) (domain.UserSettings, error) {
+			if in.AlignmentMinutes == 7 {
+				return domain.UserSettings{}, &appointments.ValidationError{}
+			}
+			return domain.UserSettings{UserID: in.UserID, SlotAlignmentMinutes: in.AlignmentMinutes, SlotTimeZone: in.TimeZone}, nil
+		},
+	}, slog.Default())
+
+	resp, err := srv.UpdateSlotSettings(context.Background(), &schedulev1.UpdateSlotSettingsRequest{UserId: "u1", AlignmentMinutes: 30, TimeZone: "Europe/Berlin"})
+	if err != nil {
+		t.Fatalf("UpdateSlotSettings error: %v", err)
+	}
+	if resp.Settings.AlignmentMinutes != 30 || resp.Settings.TimeZone != "Europe/Berlin" || resp.Settings.UpdatedAt != nil {
+		t.Fatalf("settings = %+v", resp.Settings)
+	}
+
+	_, err = srv.UpdateSlotSettings(context.Background(), &schedulev1.UpdateSlotSettingsRequest{UserId: "u1", AlignmentMinutes: 7})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Fatalf("code = %s, want %s", status.Code(err), codes.InvalidArgument)
+	}
+}
+
+func TestTimeOff_MapsRecurrenceAndErrors(t *testing.T) {
+	id := uuid.New()
+	start := time.Date(2030, 1, 4, 12, 0, 0, 0, time.UTC)
+	srv := NewAppointmentsServer(&fakeAppointmentsService{
+		createTimeOffFn: func(ctx context.Context, in appointments.TimeOffInput) (domain.TimeOff, error) {
+			r := in.Recurrence
+			if r == nil || r.TimeZone != "Europe/Berlin" || len(r.ByWeekday) != 1 || r.ByWeekday[0] != 5 || r.Until != nil {
+				t.Fatalf("input = %+v", in)
+			}
+			return domain.TimeOff{ID: id, UserID: in.UserID, Title: in.Title, StartTime: in.StartTime, EndTime: in.EndTime, Timezone: r.TimeZone, Interval: 1, ByWeekday: r.ByWeekday}, nil
+		},
+		deleteTimeOffFn: func(ctx context.Context, userID string, timeOffID uuid.UUID) error {
+			return store.ErrNotFound
+		},
+		reserveSlotFn: func(ctx context.Context, in appointments.ReserveSlotInput) (domain.SlotHold, error) {
+			return domain.SlotHold{}, fmt.Errorf("%w: Friday afternoons", appointments.ErrTimeOff)
+		},
+	}, slog.Default())
+
+	resp, err := srv.CreateTimeOff(context.Background(), &schedulev1.CreateTimeOffRequest{
+		UserId:     "u1",
+		Title:      "Friday afternoons",
+		StartTime:  timestamppb.New(start),
+		EndTime:    timestamppb.New(start.Add(4 * time.Hour)),
+		Recurrence: &schedulev1.TimeOffRecurrence{Weekdays: []schedulev1.Weekday{schedulev1.Weekday_FRIDAY}, TimeZone: "Europe/Berlin"},
+	})
+	if err != nil {
+		t.Fatalf("CreateTimeOff error: %v", err)
+	}
+	if got := resp.TimeOff.Recurrence; got == nil || got.Interval != 1 || len(got.Weekdays) != 1 || got.Weekdays[0] != schedulev1.Weekday_FRIDAY {
+		t.Fatalf("recurrence = %+v", got)
+	}
+
+	_, err = srv.DeleteTimeOff(context.Background(), &schedulev1.DeleteTimeOffRequest{UserId: "u1", TimeOffId: id.String()})
+	if status.Code(err) != codes.NotFound {
+		t.Fatalf("code = %s, want %s", status.Code(err), codes.NotFound)
+	}
+	_, err = srv.ReserveSlot(context.Background(), &schedulev1.ReserveSlotRequest{UserId: "u1", StartTime: timestamppb.New(start), EndTime: timestamppb.New(start.Add(time.Hour))})
+	if status.Code(err) != codes.FailedPrecondition {
+		t.Fatalf("code = %s, want %s", status.Code(err), codes.FailedPrecondition)
+	}
+}
+
+func TestUpdateSeriesEnd_TreatsZeroCountAsUnset(t *testing.T) {
+	seriesID := uuid.New()
+	until := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+	var got appointments.UpdateSeriesEndInput
+	fake := &fakeAppointmentsService{
+		updateSeriesEndFn: func(ctx context.Context, in appointments.UpdateSeriesEndInput) (domain.RecurringSeries, int, error) {
+			got = in
+			return domain.RecurringSeries{ID: seriesID, UserID: in.UserID, Until: in.Until}, 3, nil
+		},
+	}
+	srv := NewAppointmentsServer(fake, slog.Default())
+
+	resp, err := srv.UpdateSeriesEnd(context.Background(), &schedulev1.UpdateSeriesEndRequest{
+		UserId:   "u1",
+		SeriesId: seriesID.String(),
+		Until:    timestamppb.New(until),
+	})
+	if err != nil {
+		t.Fatalf("UpdateSeriesEnd error: %v", err)
+	}
+	if got.Count != nil || got.Until == nil || !got.Until.Equal(until) {
+		t.Fatalf("input = %+v, want until %s and no count", got, until)
+	}
+	if resp.RemovedExceptions != 3 || resp.Series.GetId() != seriesID.String() {
+		t.Fatalf("resp = %+v", resp)
+	}
+}
+
+func TestChangeSeriesTimeZone_MapsKeepAndConflicts(t *testing.T) {
+	seriesID := uuid.New()
+	expires := time.Date(2026, 1, 1, 0, 10, 0, 0, time.UTC)
+	var got appointments.ChangeSeriesTimeZoneInput
+	var fail error
+	fake := &fakeAppointmentsService{
+		changeTimeZoneFn: func(ctx context.Context, in appointments.ChangeSeriesTimeZoneInput) (appointments.ChangeSeriesTimeZoneResult, error) {
+			got = in
+			if fail != nil {
+				return appointments.ChangeSeriesTimeZoneResult{}, fail
+			}
+			return appointments.ChangeSeriesTimeZoneResult{
+				Series:            []domain.RecurringSeries{{ID: seriesID, UserID: in.UserID, Timezone: in.TimeZone}},
+				RemovedExceptions: 1,
+				Confirmation:      &appointments.Confirmation{Token: "c1.tok", ExpiresAt: expires},
+			}, nil
+		},
+	}
+	srv := NewAppointmentsServer(fake, slog.Default())
+	req := &schedulev1.ChangeSeriesTimeZoneRequest{
+		UserId:    "u1",
+		SeriesIds: []string{seriesID.String()},
+		TimeZone:  "Europe/London",
+		Keep:      schedulev1.TimeZoneKeep_TIME_ZONE_KEEP_INSTANT,
+		Preview:   true,
+	}
+
+	resp, err := srv.ChangeSeriesTimeZone(context.Background(), req)
+	if err != nil {
+		t.Fatalf("ChangeSeriesTimeZone error: %v", err)
+	}
+	if got.Keep != domain.TimeZoneKeepInstant || !got.Preview || len(got.SeriesIDs) != 1 || got.SeriesIDs[0] != seriesID {
+		t.Fatalf("input = %+v, want a preview keeping instants for the series", got)
+	}
+	if resp.RemovedExceptions != 1 || len(resp.Series) != 1 || resp.Series[0].GetId() != seriesID.String() {
+		t.Fatalf("resp = %+v", resp)
+	}
+	if resp.ConfirmationToken != "c1.tok" || !resp.ConfirmationExpiresAt.AsTime().Equal(expires) {
+		t.Fatalf("confirmation = %q until %v", resp.ConfirmationToken, resp.ConfirmationExpiresAt)
+	}
+
+	req.Preview, req.ConfirmationToken = false, "c1.tok"
+	fail = store.ErrConflict
+	if _, err := srv.ChangeSeriesTimeZone(context.Background(), req); status.Code(err) != codes.FailedPrecondition {
+		t.Fatalf("conflict code = %s, want %s", status.Code(err), codes.FailedPrecondition)
+	}
+	if got.Preview || got.ConfirmationToken != "c1.tok" {
+		t.Fatalf("input = %+v, want the token passed through", got)
+	}
+	fail = fmt.Errorf("%w: preview first", appointments.ErrConfirmationRequired)
+	if _, err := srv.ChangeSeriesTimeZone(context.Background(), req); status.Code(err) != codes.FailedPrecondition {
+		t.Fatalf("unconfirmed code = %s, want %s", status.Code(err), codes.FailedPrecondition)
+	}
+	req.SeriesIds = []string{"not-a-uuid"}
+	if _, err := srv.ChangeSeriesTimeZone(context.Background(), req); status.Code(err) != codes.InvalidArgument {
+		t.Fatalf("bad id code = %s, want %s", status.Code(err), codes.InvalidArgument)
+	}
+}
+
+func TestAuditCalendar_MapsConflicts(t *testing.T) {
+	apptID := uuid.New()
+	seriesID := uuid.New()
+	start := time.Date(2030, 1, 7, 9, 0, 0, 0, time.UTC)
+	fake := &fakeAppointmentsService{
+		auditCalendarFn: func(ctx context.Context, userID string, windowStart, windowEnd time.Time) (appointments.CalendarAudit, error) {
+			return appointments.CalendarAudit{
+				EntriesScanned: 2,
+				Conflicts: []appointments.CalendarConflict{{
+					First:        appointments.CalendarEntry{AppointmentID: apptID, StartTime: start, EndTime: start.Add(time.Hour)},
+					Second:       appointments.CalendarEntry{SeriesID: seriesID, OccurrenceID: "occ", StartTime: start, EndTime: start.Add(time.Hour), Overridden: true},
+					OverlapStart: start,
+					OverlapEnd:   start.Add(time.Hour),
+					Cause:        appointments.ConflictCauseOverride,
+				}},
+			}, nil
+		},
+	}
+	srv := NewAppointmentsServer(fake, slog.Default())
+
+	if _, err := srv.AuditCalendar(context.Background(), &schedulev1.AuditCalendarRequest{UserId: "u1"}); status.Code(err) != codes.InvalidArgument {
+		t.Fatalf("missing window code = %s, want %s", status.Code(err), codes.InvalidArgument)
+	}
+	resp, err := srv.AuditCalendar(context.Background(), &schedulev1.AuditCalendarRequest{
+		UserId:      "u1",
+		WindowStart: timestamppb.New(start),
+		WindowEnd:   timestamppb.New(start.Add(24 * time.Hour)),
+	})
+	if err != nil {
+		t.Fatalf("AuditCalendar error: %v", err)
+	}
+	if resp.EntriesScanned != 2 || len(resp.Conflicts) != 1 {
+		t.Fatalf("resp = %+v", resp)
+	}
+	c := resp.Conflicts[0]
+	if c.Cause != schedulev1.ConflictCause_CONFLICT_CAUSE_OVERRIDE || c.First.AppointmentId != apptID.String() || c.First.SeriesId != "" ||
+		c.Second.SeriesId != seriesID.String() || c.Second.AppointmentId != "" || !c.Second.Overridden {
+		t.Fatalf("conflict = %+v", c)
+	}
+}
+
+func TestGetDailyAgenda_MapsItems(t *testing.T) {
+	start := time.Date(2030, 1, 7, 9, 0, 0, 0, time.UTC)
+	fake := &fakeAppointmentsService{
+		getDailyAgendaFn: func(ctx context.Context, userID, date, timeZone string) (appointments.DailyAgenda, error) {
+			if date != "2030-01-07" || timeZone != "" {
+				return appointments.DailyAgenda{}, errors.New("unexpected input")
+			}
+			return appointments.DailyAgenda{
+				Date:     time.Date(2030, 1, 7, 0, 0, 0, 0, time.UTC),
+				TimeZone: "UTC",
+				Items: []appointments.AgendaItem{{
+					CalendarEntry: appointments.CalendarEntry{AppointmentID: uuid.New(), Title: "Plan", StartTime: start, EndTime: start.Add(time.Hour)},
+					GapBefore:     10 * time.Minute,
+					BackToBack:    true,
+				}},
+				BusyTime: time.Hour,
+			}, nil
+		},
+	}
+	srv := NewAppointmentsServer(fake, slog.Default())
+
+	resp, err := srv.GetDailyAgenda(context.Background(), &schedulev1.GetDailyAgendaRequest{UserId: "u1", Date: "2030-01-07"})
+	if err != nil {
+		t.Fatalf("GetDailyAgenda error: %v", err)
+	}
+	if resp.Date != "2030-01-07" || resp.TimeZone != "UTC" || resp.BusyTime.AsDuration() != time.Hour || len(resp.Items) != 1 {
+		t.Fatalf("resp = %+v", resp)
+	}
+	it := resp.Items[0]
+	if it.Entry.GetTitle() != "Plan" || it.GapBefore.AsDuration() != 10*time.Minute || !it.BackToBack || it.Overlaps {
+		t.Fatalf("item = %+v", it)
+	}
+}
+
+func TestGetDaySummaries_MapsDays(t *testing.T) {
+	fake := &fakeAppointmentsService{
+		getDaySummariesFn: func(ctx context.Context, userID, from, to string) (appointments.DaySummaries, error) {
+			if from != "2030-01-01" || to != "2030-01-31" {
+				return appointments.DaySummaries{}, errors.New("unexpected input")
+			}
+			return appointments.DaySummaries{
+				TimeZone: "Europe/Paris",
+				Days:     []domain.DaySummary{{UserID: userID, Day: time.Date(2030, 1, 7, 0, 0, 0, 0, time.UTC), Appointments: 2, Occurrences: 1, BusySeconds: 5400}},
+			}, nil
+		},
+	}
+	srv := NewAppointmentsServer(fake, slog.Default())
+
+	resp, err := srv.GetDaySummaries(context.Background(), &schedulev1.GetDaySummariesRequest{UserId: "u1", FromDate: "2030-01-01", ToDate: "2030-01-31"})
+	if err != nil {
+		t.Fatalf("GetDaySummaries error: %v", err)
+	}
+	if resp.TimeZone != "Europe/Paris" || len(resp.Days) != 1 {
+		t.Fatalf("resp = %+v", resp)
+	}
+	if d := resp.Days[0]; d.Date != "2030-01-07" || d.Appointments != 2 || d.Occurrences != 1 || d.BusyTime.AsDuration() != 90*time.Minute {
+		t.Fatalf("day = %+v", d)
+	}
+}
+
+func TestAcceptProposal_MapsErrors(t *testing.T) {
+	proposalID := uuid.New()
+	apptID := uuid.New()
+	errs := map[string]error{
+		"carol": appointments.ErrNotAuthorized,
+		"dave":  appointments.ErrProposalClosed,
+		"erin":  store.ErrConflict,
+	}
+	fake := &fakeAppointmentsService{
+		acceptProposalFn: func(ctx context.Context, userID string, id uuid.UUID) (domain.AppointmentProposal, error) {
+			if err := errs[userID]; err != nil {
+				return domain.AppointmentProposal{}, err
+			}
+			return domain.AppointmentProposal{ID: id, ProposerID: "alice", RecipientID: userID, Status: domain.ProposalStatusAccepted, RecipientAppointmentID: &apptID}, nil
+		},
+	}
+	srv := NewAppointmentsServer(fake, slog.Default())
+	ctx := context.Background()
+
+	if _, err := srv.AcceptProposal(ctx, &schedulev1.AcceptProposalRequest{UserId: "bob", ProposalId: "nope"}); status.Code(err) != codes.InvalidArgument {
+		t.Fatalf("bad id code = %s, want %s", status.Code(err), codes.InvalidArgument)
+	}
+	for user, want := range map[string]codes.Code{"carol": codes.PermissionDenied, "dave": codes.FailedPrecondition, "erin": codes.FailedPrecondition} {
+		if _, err := srv.AcceptProposal(ctx, &schedulev1.AcceptProposalRequest{UserId: user, ProposalId: proposalID.String()}); status.Code(err) != want {
+			t.Fatalf("%s: code = %s, want %s", user, status.Code(err), want)
+		}
+	}
+
+	resp, err := srv.AcceptProposal(ctx, &schedulev1.AcceptProposalRequest{UserId: "bob", ProposalId: proposalID.String()})
+	if err != nil {
+		t.Fatalf("AcceptProposal error: %v", err)
+	}
+	p := resp.Proposal
+	if p.Status != schedulev1.ProposalStatus_PROPOSAL_STATUS_ACCEPTED || p.RecipientAppointmentId != apptID.String() || p.ProposerAppointmentId != "" {
+		t.Fatalf("proposal = %+v", p)
+	}
+}
@@ -0,0 +1,335 @@
+package grpc
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"log/slog"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"schedula/backend/internal/domain"
+	schedulev2 "schedula/backend/internal/gen/proto/schedula/v2"
+	"schedula/backend/internal/service/appointments"
+	"schedula/backend/internal/store"
+)
+
+const (
+	DefaultV2PageSize = 50
+	MaxV2PageSize     = 500
+)
+
+// ErrorInfoDomain is the domain set on the ErrorInfo detail of every v2 error.
+const ErrorInfoDomain = "schedula.dev"
+
+// Reasons carried in the ErrorInfo detail of v2 errors. Clients branch on
+// these rather than on message text, which may change.
+const (
+	ReasonInvalidArgument     = "INVALID_ARGUMENT"
+	ReasonInvalidPageToken    = "INVALID_PAGE_TOKEN"
+	ReasonPageTokenStale      = "PAGE_TOKEN_STALE"
+	ReasonAppointmentNotFound = "APPOINTMENT_NOT_FOUND"
+	ReasonDelegationRequired  = "DELEGATION_REQUIRED"
+	ReasonStoreUnavailable    = "STORE_UNAVAILABLE"
+	ReasonWriteRefused        = "WRITE_REFUSED"
+)
+
+// AppointmentsV2Server serves schedula.v2. It runs on the same service as
+// the v1 server; only the wire shapes and error details differ.
+type AppointmentsV2Server struct {
+	schedulev2.UnimplementedAppointmentsServiceServer
+
+	svc appointmentsV2Service
+	log *slog.Logger
+}
+
+type appointmentsV2Service interface {
+	List(ctx context.Context, userID string, windowStart, windowEnd time.Time, filter store.AppointmentFilter) ([]domain.Appointment, error)
+	Delete(ctx context.Context, in appointments.DeleteInput) error
+	PageSnapshot(ctx context.Context, userID string) (int64, error)
+	AppointmentsAddedSince(ctx context.Context, userID string, seq int64) (map[uuid.UUID]bool, error)
+}
+
+func NewAppointmentsV2Server(svc appointmentsV2Service, log *slog.Logger) *AppointmentsV2Server {
+	if log == nil {
+		log = slog.Default()
+	}
+	return &AppointmentsV2Server{
+		svc: svc,
+		log: log.With(slog.String("component", "grpc.appointments.v2")),
+	}
+}
+
+func (s *AppointmentsV2Server) ListAppointments(ctx context.Context, req *schedulev2.ListAppointmentsRequest) (*schedulev2.ListAppointmentsResponse, error) {
+	log := s.log.With(slog.String("rpc", "ListAppointments"))
+
+	if req == nil {
+		log.Warn("invalid request", slog.String("reason", "nil_request"))
+		return nil, v2Error(codes.InvalidArgument, ReasonInvalidArgument, "request is required")
+	}
+	if req.WindowStart == nil || req.WindowEnd == nil {
+		log.Warn("invalid request", slog.String("reason", "missing_window"), slog.String("user_id", req.UserId))
+		return nil, v2Error(codes.InvalidArgument, ReasonInvalidArgument, "window_start and window_end are required")
+	}
+	if req.PageSize < 0 {
+		log.Warn("invalid request", slog.String("reason", "invalid_page_size"), slog.String("user_id", req.UserId))
+		return nil, v2Error(codes.InvalidArgument, ReasonInvalidArgument, "page_size must not be negative")
+	}
+	pageSize := int(req.PageSize)
+	if pageSize == 0 {
+		pageSize = DefaultV2PageSize
+	}
+	pageSize = min(pageSize, MaxV2PageSize)
+
+	contactID, ok := optionalUUID(req.ContactId)
+	if !ok {
+		log.Warn("invalid request", slog.String("reason", "invalid_uuid"), slog.String("user_id", req.UserId))
+		return nil, v2Error(codes.InvalidArgument, ReasonInvalidArgument, "contact_id must be a UUID")
+	}
+	start, end := req.WindowStart.AsTime(), req.WindowEnd.AsTime()
+	filter := store.AppointmentFilter{ContactID: contactID, Source: req.Source}
+	key := pageQueryKey(req.UserId, start, end, filter)
+	after, err := decodePageToken(req.PageToken, key)
+	if err != nil {
+		log.Warn("invalid request", slog.String("reason", "invalid_page_token"), slog.String("user_id", req.UserId))
+		return nil, v2Error(codes.InvalidArgument, ReasonInvalidPageToken, "page_token is invalid or was issued for a different request")
+	}
+
+	// Every page sees the calendar as of the first one: its snapshot travels
+	// in the token, and appointments added since are left out.
+	var snapshot int64
+	var added map[uuid.UUID]bool
+	if after == nil {
+		snapshot, err = s.svc.PageSnapshot(ctx, req.UserId)
+	} else {
+		snapshot = after.snapshot
+		added, err = s.svc.AppointmentsAddedSince(ctx, req.UserId, snapshot)
+	}
+	if errors.Is(err, appointments.ErrPageSnapshotStale) {
+		log.Info("page snapshot stale", slog.String("user_id", req.UserId), slog.Int64("snapshot", snapshot))
+		return nil, v2Error(codes.Aborted, ReasonPageTokenStale, "the calendar changed while paging; restart from the first page")
+	}
+
+	var appts []domain.Appointment
+	if err == nil {
+		appts, err = s.svc.List(ctx, req.UserId, start, end, filter)
+	}
+	if err != nil {
+		var vErr *appointments.ValidationError
+		if errors.As(err, &vErr) {
+			log.Warn("invalid request", slog.Any("err", err), slog.String("user_id", req.UserId))
+			return nil, v2Error(codes.InvalidArgument, ReasonInvalidArgument, vErr.Error())
+		}
+		if code, msg, ok := retryableStoreError(err); ok {
+			log.Warn("appointments list failed; retryable", slog.Any("err", err), slog.String("user_id", req.UserId))
+			return nil, v2Error(code, ReasonStoreUnavailable, msg)
+		}
+		log.Error("appointments list failed", slog.Any("err", err), slog.String("user_id", req.UserId))
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+
+	// The service lists a whole window; pages are cut from it here until the
+	// store can seek by (start_time, id) itself.
+	slices.SortFunc(appts, comparePagePosition)
+	if len(added) > 0 {
+		appts = slices.DeleteFunc(appts, func(a domain.Appointment) bool { return added[a.ID] })
+	}
+	if after != nil {
+		cursor := domain.Appointment{ID: after.id, StartTime: after.start}
+		i := slices.IndexFunc(appts, func(a domain.Appointment) bool { return comparePagePosition(a, cursor) > 0 })
+		if i < 0 {
+			i = len(appts)
+		}
+		appts = appts[i:]
+	}
+	var next string
+	if len(appts) > pageSize {
+		appts = appts[:pageSize]
+		last := appts[pageSize-1]
+		next = encodePageToken(pagePosition{start: last.StartTime, id: last.ID, snapshot: snapshot}, key)
+	}
+
+	out := make([]*schedulev2.Appointment, 0, len(appts))
+	for _, a := range appts {
+		out = append(out, toProtoV2Appointment(a))
+	}
+
+	log.Debug("appointments listed", slog.String("user_id", req.UserId), slog.Int("count", len(out)), slog.Bool("more", next != ""))
+	return &schedulev2.ListAppointmentsResponse{Appointments: out, NextPageToken: next}, nil
+}
+
+func (s *AppointmentsV2Server) DeleteAppointment(ctx context.Context, req *schedulev2.DeleteAppointmentRequest) (*schedulev2.DeleteAppointmentResponse, error) {
+	log := s.log.With(slog.String("rpc", "DeleteAppointment"))
+
+	if req == nil {
+		log.Warn("invalid request", slog.String("reason", "nil_request"))
+		return nil, v2Error(codes.InvalidArgument, ReasonInvalidArgument, "request is required")
+	}
+	id, err := uuid.Parse(req.AppointmentId)
+	if err != nil {
+		log.Warn("invalid request", slog.String("reason", "invalid_uuid"), slog.String("user_id", req.UserId))
+		return nil, v2Error(codes.InvalidArgument, ReasonInvalidArgument, "appointment_id must be a UUID")
+	}
+
+	if err := s.svc.Delete(ctx, appointments.DeleteInput{UserID: req.UserId, AppointmentID: id, ActorID: req.ActorId}); err != nil {
+		if errors.Is(err, appointments.ErrNotAuthorized) {
+			log.Warn("appointment delete not authorized", slog.String("appointment_id", id.String()), slog.String("user_id", req.UserId), slog.String("actor_id", req.ActorId))
+			return nil, v2Error(codes.PermissionDenied, ReasonDelegationRequired, "You do not have delegated access to this calendar.")
+		}
+		if errors.Is(err, store.ErrNotFound) {
+			log.Info("appointment not found", slog.String("appointment_id", id.String()), slog.String("user_id", req.UserId))
+			return nil, v2Error(codes.NotFound, ReasonAppointmentNotFound, "appointment not found")
+		}
+		var vErr *appointments.ValidationError
+		if errors.As(err, &vErr) {
+			log.Warn("invalid request", slog.Any("err", err), slog.String("appointment_id", id.String()), slog.String("user_id", req.UserId))
+			return nil, v2Error(codes.InvalidArgument, ReasonInvalidArgument, vErr.Error())
+		}
+		if code, msg, ok := writeRefusedError(err); ok {
+			log.Info("appointment delete refused", slog.Any("err", err), slog.String("appointment_id", id.String()), slog.String("user_id", req.UserId))
+			return nil, v2Error(code, ReasonWriteRefused, msg)
+		}
+		if code, msg, ok := retryableStoreError(err); ok {
+			log.Warn("appointment delete failed; retryable", slog.Any("err", err), slog.String("appointment_id", id.String()), slog.String("user_id", req.UserId))
+			return nil, v2Error(code, ReasonStoreUnavailable, msg)
+		}
+		log.Error("appointment delete failed", slog.Any("err", err), slog.String("appointment_id", id.String()), slog.String("user_id", req.UserId))
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+
+	log.Info("appointment deleted", slog.String("appointment_id", id.String()), slog.String("user_id", req.UserId), slog.String("actor_id", actorOrUser(req.ActorId, req.UserId)))
+	return &schedulev2.DeleteAppointmentResponse{}, nil
+}
+
+// v2Error builds a status carrying an ErrorInfo detail with reason.
+func v2Error(code codes.Code, reason, msg string) error {
+	st, err := status.New(code, msg).WithDetails(&errdetails.ErrorInfo{Reason: reason, Domain: ErrorInfoDomain})
+	if err != nil {
+		return status.Error(code, msg)
+	}
+	return st.Err()
+}
+
+// v1Error strips the v2 details from err so v1 clients see exactly the
+// code and message they always have.
+func v1Error(err error) error {
+	st, ok := status.FromError(err)
+	if !ok {
+		return status.Error(codes.Internal, "internal error")
+	}
+	return status.Error(st.Code(), st.Message())
+}
+
+// pagePosition is where the previous page ended, and the change log seq of
+// the snapshot the listing is pinned to.
+type pagePosition struct {
+	start    time.Time
+	id       uuid.UUID
+	snapshot int64
+}
+
+func comparePagePosition(a, b domain.Appointment) int {
+	if c := a.StartTime.Compare(b.StartTime); c != 0 {
+		return c
+	}
+	return strings.Compare(a.ID.String(), b.ID.String())
+}
+
+const pageTokenPrefix = "p2:"
+
+// pageQueryKey binds a page token to the request it was issued for, as
+// syncQueryKey does for sync tokens.
+func pageQueryKey(userID string, start, end time.Time, filter store.AppointmentFilter) string {
+	h := sha256.New()
+	fmt.Fprintf(h, "%q|%d|%d", userID, start.UnixNano(), end.UnixNano())
+	if filter.ContactID != nil {
+		fmt.Fprintf(h, "|contact=%s", filter.ContactID)
+	}
+	if filter.Source != "" {
+		fmt.Fprintf(h, "|source=%q", filter.Source)
+	}
+	return hex.EncodeToString(h.Sum(nil)[:8])
+}
+
+func encodePageToken(p pagePosition, key string) string {
+	raw := pageTokenPrefix + strconv.FormatInt(p.start.UnixNano(), 10) + ":" + p.id.String() + ":" + strconv.FormatInt(p.snapshot, 10) + ":" + key
+	return base64.RawURLEncoding.EncodeToString([]byte(raw))
+}
+
+func decodePageToken(token, key string) (*pagePosition, error) {
+	if token == "" {
+		return nil, nil
+	}
+	raw, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return nil, err
+	}
+	rest, ok := strings.CutPrefix(string(raw), pageTokenPrefix)
+	if !ok {
+		return nil, errors.New("unknown page token version")
+	}
+	parts := strings.Split(rest, ":")
+	if len(parts) != 4 || parts[3] != key {
+		return nil, errors.New("page token does not match request")
+	}
+	nanos, err := strconv.ParseInt(parts[0], 10, 64)
+	if err != nil {
+		return nil, err
+	}
+	id, err := uuid.Parse(parts[1])
+	if err != nil {
+		return nil, err
+	}
+	snapshot, err := strconv.ParseInt(parts[2], 10, 64)
+	if err != nil || snapshot < 0 {
+		return nil, errors.New("invalid page token snapshot")
+	}
+	return &pagePosition{start: time.Unix(0, nanos).UTC(), id: id, snapshot: snapshot}, nil
+}
+
+func toProtoV2Appointment(a domain.Appointment) *schedulev2.Appointment {
+	var externalRef *schedulev2.ExternalRef
+	if a.ExternalSystem != "" {
+		externalRef = &schedulev2.ExternalRef{System: a.ExternalSystem, Id: a.ExternalID}
+	}
+
+	return &schedulev2.Appointment{
+		Id:           a.ID.String(),
+		UserId:       a.UserID,
+		Title:        a.Title,
+		Notes:        a.Notes,
+		PrivateNotes: a.PrivateNotes,
+		StartTime:    timestamppb.New(a.StartTime),
+		EndTime:      timestamppb.New(a.EndTime),
+		CreateTime:   timestamppb.New(a.CreatedAt),
+		UpdateTime:   timestamppb.New(a.UpdatedAt),
+		Metadata:     a.Metadata,
+		ExternalRef:  externalRef,
+		TimeZone:     a.Timezone,
+		CreatedBy:    a.CreatedBy,
+		CheckInTime:  optionalTimestamp(a.CheckedInAt),
+		CheckOutTime: optionalTimestamp(a.CheckedOutAt),
+		ContactId:    optionalUUIDString(a.ContactID),
+		ProgramId:    optionalUUIDString(a.ProgramID),
+		Source:       a.Source,
+		Kind:         toProtoV2AppointmentKind(a),
+	}
+}
+
+func toProtoV2AppointmentKind(a domain.Appointment) schedulev2.AppointmentKind {
+	if a.Milestone() {
+		return schedulev2.AppointmentKind_APPOINTMENT_KIND_MILESTONE
+	}
+	return schedulev2.AppointmentKind_APPOINTMENT_KIND_EVENT
+}
@@ -0,0 +1,45 @@
+package grpc
+
+import (
+	"context"
+	"log/slog"
+	"path"
+	"strconv"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+// CalendarVersionHeader carries the user's calendar version on successful
+// write responses. List responses carry it in their body instead.
+const CalendarVersionHeader = "schedula-calendar-version"
+
+// CalendarVersionInterceptor sets CalendarVersionHeader after every
+// successful RPC outside readMethods whose request names a user. The version
+// is read after the write commits, so it is at least the write's own version;
+// a client that gets back exactly one more than it last saw knows no other
+// write landed in between. A failed version read does not fail the RPC.
+func CalendarVersionInterceptor(versions func(ctx context.Context, userID string) (int64, error), readMethods []string, log *slog.Logger) grpc.UnaryServerInterceptor {
+	skip := make(map[string]bool, len(readMethods))
+	for _, m := range readMethods {
+		skip[m] = true
+	}
+
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		resp, err := handler(ctx, req)
+		if err != nil || skip[info.FullMethod] || skip[path.Base(info.FullMethod)] {
+			return resp, err
+		}
+		r, ok := req.(interface{ GetUserId() string })
+		if !ok || r.GetUserId() == "" {
+			return resp, nil
+		}
+		version, vErr := versions(ctx, r.GetUserId())
+		if vErr != nil {
+			log.Warn("calendar version read failed", slog.String("rpc", path.Base(info.FullMethod)), slog.Any("err", vErr))
+			return resp, nil
+		}
+		_ = grpc.SetHeader(ctx, metadata.Pairs(CalendarVersionHeader, strconv.FormatInt(version, 10)))
+		return resp, nil
+	}
+}
@@ -0,0 +1,35 @@
+package grpc
+
+import (
+	"context"
+	"slices"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/encoding/gzip"
+	"google.golang.org/protobuf/proto"
+)
+
+// Compression names the server can send responses with. Importing gzip
+// registers it, so clients that send gzip requests also get gzip replies.
+const CompressionGzip = gzip.Name
+
+// CompressionInterceptor compresses unary responses of at least minBytes
+// with the named compressor when the client advertises it. Clients that do
+// not, and small responses where compression costs more than it saves, get
+// the default: the compressor the request came with, or none. An empty name
+// turns it off.
+func CompressionInterceptor(name string, minBytes int) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		resp, err := handler(ctx, req)
+		if err != nil || name == "" {
+			return resp, err
+		}
+		if msg, ok := resp.(proto.Message); !ok || (minBytes > 0 && proto.Size(msg) < minBytes) {
+			return resp, err
+		}
+		if accepted, _ := grpc.ClientSupportedCompressors(ctx); slices.Contains(accepted, name) {
+			_ = grpc.SetSendCompressor(ctx, name)
+		}
+		return resp, err
+	}
+}
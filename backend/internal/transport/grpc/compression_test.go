@@ -0,0 +1,165 @@
+package grpc
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"fmt"
+	"log/slog"
+	"net"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/stats"
+	"google.golang.org/grpc/test/bufconn"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"schedula/backend/internal/domain"
+	schedulev1 "schedula/backend/internal/gen/proto/schedula/v1"
+)
+
+// weeklyOccurrences returns n hour-long occurrences of one series, a week
+// apart, the shape of a long ListOccurrences response.
+func weeklyOccurrences(n int) []domain.RecurringOccurrence {
+	seriesID := uuid.New()
+	start := time.Date(2026, 1, 5, 9, 0, 0, 0, time.UTC)
+	occs := make([]domain.RecurringOccurrence, n)
+	for i := range occs {
+		at := start.AddDate(0, 0, 7*i)
+		occs[i] = domain.RecurringOccurrence{
+			ID:        fmt.Sprintf("%s:%d", seriesID, at.Unix()),
+			SeriesID:  seriesID,
+			UserID:    "u1",
+			Title:     "Weekly planning",
+			StartTime: at,
+			EndTime:   at.Add(time.Hour),
+			Timezone:  "Europe/Berlin",
+		}
+	}
+	return occs
+}
+
+// payloadRecorder keeps the sizes of the responses a client received.
+type payloadRecorder struct {
+	mu       sync.Mutex
+	payloads []*stats.InPayload
+}
+
+func (r *payloadRecorder) TagRPC(ctx context.Context, _ *stats.RPCTagInfo) context.Context {
+	return ctx
+}
+
+func (r *payloadRecorder) HandleRPC(_ context.Context, s stats.RPCStats) {
+	if in, ok := s.(*stats.InPayload); ok {
+		r.mu.Lock()
+		r.payloads = append(r.payloads, in)
+		r.mu.Unlock()
+	}
+}
+
+func (r *payloadRecorder) TagConn(ctx context.Context, _ *stats.ConnTagInfo) context.Context {
+	return ctx
+}
+
+func (r *payloadRecorder) HandleConn(context.Context, stats.ConnStats) {}
+
+func (r *payloadRecorder) last() *stats.InPayload {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.payloads[len(r.payloads)-1]
+}
+
+func TestCompressionInterceptor_CompressesLargeResponses(t *testing.T) {
+	fake := &fakeAppointmentsService{}
+	lis := bufconn.Listen(1 << 20)
+	server := grpc.NewServer(grpc.ChainUnaryInterceptor(CompressionInterceptor(CompressionGzip, 1024)))
+	schedulev1.RegisterAppointmentsServiceServer(server, NewAppointmentsServer(fake, slog.Default()))
+	go func() { _ = server.Serve(lis) }()
+	t.Cleanup(server.Stop)
+
+	recorder := &payloadRecorder{}
+	conn, err := grpc.NewClient("passthrough:///bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) { return lis.DialContext(ctx) }),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithStatsHandler(recorder),
+	)
+	if err != nil {
+		t.Fatalf("NewClient error: %v", err)
+	}
+	t.Cleanup(func() { _ = conn.Close() })
+	client := schedulev1.NewAppointmentsServiceClient(conn)
+
+	req := &schedulev1.ListOccurrencesRequest{
+		UserId:      "u1",
+		WindowStart: timestamppb.New(time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)),
+		WindowEnd:   timestamppb.New(time.Date(2027, 1, 1, 0, 0, 0, 0, time.UTC)),
+	}
+	for _, tc := range []struct {
+		name       string
+		n          int
+		compressed bool
+	}{
+		{name: "large", n: 50, compressed: true},
+		{name: "small", n: 1, compressed: false},
+	} {
+		fake.listOccurrencesFn = func(ctx context.Context, userID string, windowStart, windowEnd time.Time) ([]domain.RecurringOccurrence, error) {
+			return weeklyOccurrences(tc.n), nil
+		}
+		resp, err := client.ListOccurrences(context.Background(), req)
+		if err != nil {
+			t.Fatalf("%s: ListOccurrences error: %v", tc.name, err)
+		}
+		if len(resp.Occurrences) != tc.n {
+			t.Fatalf("%s: got %d occurrences, want %d", tc.name, len(resp.Occurrences), tc.n)
+		}
+		in := recorder.last()
+		if got := in.CompressedLength < in.Length; got != tc.compressed {
+			t.Fatalf("%s: compressed = %v (%d of %d bytes), want %v", tc.name, got, in.CompressedLength, in.Length, tc.compressed)
+		}
+	}
+}
+
+// BenchmarkListOccurrencesResponse_10k measures what gzip costs and saves on
+// a 10,000-occurrence ListOccurrences response.
+func BenchmarkListOccurrencesResponse_10k(b *testing.B) {
+	resp := &schedulev1.ListOccurrencesResponse{}
+	for _, o := range weeklyOccurrences(10_000) {
+		resp.Occurrences = append(resp.Occurrences, toProtoOccurrence(o))
+	}
+
+	b.Run("marshal", func(b *testing.B) {
+		var size int
+		for range b.N {
+			data, err := proto.Marshal(resp)
+			if err != nil {
+				b.Fatal(err)
+			}
+			size = len(data)
+		}
+		b.ReportMetric(float64(size), "wire-bytes")
+	})
+	b.Run("marshal+gzip", func(b *testing.B) {
+		var buf bytes.Buffer
+		zw := gzip.NewWriter(&buf)
+		for range b.N {
+			data, err := proto.Marshal(resp)
+			if err != nil {
+				b.Fatal(err)
+			}
+			buf.Reset()
+			zw.Reset(&buf)
+			if _, err := zw.Write(data); err != nil {
+				b.Fatal(err)
+			}
+			if err := zw.Close(); err != nil {
+				b.Fatal(err)
+			}
+		}
+		b.ReportMetric(float64(buf.Len()), "wire-bytes")
+	})
+}
@@ -0,0 +1,100 @@
+package grpc
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"path"
+	"regexp"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// ConsistencyTokenHeader carries the primary's WAL position on successful
+// write responses. A client that sends it back on a read to a replica sees
+// at least that write.
+const ConsistencyTokenHeader = "schedula-consistency-token"
+
+// consistencyPoll is how often a replica rechecks replay while a read waits.
+const consistencyPoll = 10 * time.Millisecond
+
+var consistencyTokenPattern = regexp.MustCompile(`^[0-9A-F]+/[0-9A-F]+$`)
+
+// ConsistencyTokenInterceptor sets ConsistencyTokenHeader after every
+// successful RPC outside readMethods. The position is read after the write
+// commits, so it covers the write. A failed read does not fail the RPC.
+func ConsistencyTokenInterceptor(position func(ctx context.Context) (string, error), readMethods []string, log *slog.Logger) grpc.UnaryServerInterceptor {
+	skip := make(map[string]bool, len(readMethods))
+	for _, m := range readMethods {
+		skip[m] = true
+	}
+
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		resp, err := handler(ctx, req)
+		if err != nil || skip[info.FullMethod] || skip[path.Base(info.FullMethod)] {
+			return resp, err
+		}
+		lsn, pErr := position(ctx)
+		if pErr != nil {
+			log.Warn("consistency token read failed", slog.String("rpc", path.Base(info.FullMethod)), slog.Any("err", pErr))
+			return resp, nil
+		}
+		_ = grpc.SetHeader(ctx, metadata.Pairs(ConsistencyTokenHeader, lsn))
+		return resp, nil
+	}
+}
+
+// ConsistencyWaitInterceptor holds a request carrying ConsistencyTokenHeader
+// until the replica has replayed the token's position, for up to maxWait or
+// the request deadline. A replica still behind after that rejects the
+// request with FailedPrecondition and a PrimaryRegionHeader hint, so the
+// client can read from the primary instead. Requests without a token are
+// served at once.
+func ConsistencyWaitInterceptor(replayed func(ctx context.Context, lsn string) (bool, error), region, primaryRegion string, maxWait time.Duration) grpc.UnaryServerInterceptor {
+	msg := "This replica has not caught up with the write yet. Retry against the primary."
+	if primaryRegion != "" {
+		msg = fmt.Sprintf("This replica in region %q has not caught up with the write yet. Retry in region %q.", region, primaryRegion)
+	}
+
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		md, _ := metadata.FromIncomingContext(ctx)
+		tokens := md.Get(ConsistencyTokenHeader)
+		if len(tokens) == 0 {
+			return handler(ctx, req)
+		}
+		lsn := tokens[len(tokens)-1]
+		if !consistencyTokenPattern.MatchString(lsn) {
+			return nil, status.Errorf(codes.InvalidArgument, "%s %q is not a valid token.", ConsistencyTokenHeader, lsn)
+		}
+
+		deadline := time.Now().Add(maxWait)
+		if d, ok := ctx.Deadline(); ok && d.Before(deadline) {
+			deadline = d
+		}
+		for {
+			ok, err := replayed(ctx, lsn)
+			if err != nil {
+				return nil, err
+			}
+			if ok {
+				return handler(ctx, req)
+			}
+			if !time.Now().Add(consistencyPoll).Before(deadline) {
+				break
+			}
+			select {
+			case <-ctx.Done():
+				return nil, status.FromContextError(ctx.Err()).Err()
+			case <-time.After(consistencyPoll):
+			}
+		}
+		if primaryRegion != "" {
+			_ = grpc.SetHeader(ctx, metadata.Pairs(PrimaryRegionHeader, primaryRegion))
+		}
+		return nil, status.Error(codes.FailedPrecondition, msg)
+	}
+}
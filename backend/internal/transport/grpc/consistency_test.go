@@ -0,0 +1,105 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"strings"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	schedulev1 "schedula/backend/internal/gen/proto/schedula/v1"
+)
+
+func TestConsistencyTokenInterceptor(t *testing.T) {
+	intercept := ConsistencyTokenInterceptor(func(ctx context.Context) (string, error) {
+		return "0/16B3748", nil
+	}, []string{"ListAppointments"}, slog.Default())
+	handler := func(ctx context.Context, req any) (any, error) { return "ok", nil }
+
+	stream := &headerStream{}
+	ctx := grpc.NewContextWithServerTransportStream(context.Background(), stream)
+	info := &grpc.UnaryServerInfo{FullMethod: schedulev1.AppointmentsService_CreateAppointment_FullMethodName}
+	if _, err := intercept(ctx, nil, info, handler); err != nil {
+		t.Fatalf("intercept error: %v", err)
+	}
+	if got := stream.header.Get(ConsistencyTokenHeader); len(got) != 1 || got[0] != "0/16B3748" {
+		t.Fatalf("header = %v, want 0/16B3748", got)
+	}
+
+	stream = &headerStream{}
+	ctx = grpc.NewContextWithServerTransportStream(context.Background(), stream)
+	info = &grpc.UnaryServerInfo{FullMethod: schedulev1.AppointmentsService_ListAppointments_FullMethodName}
+	if _, err := intercept(ctx, nil, info, handler); err != nil {
+		t.Fatalf("intercept error: %v", err)
+	}
+	if got := stream.header.Get(ConsistencyTokenHeader); len(got) != 0 {
+		t.Fatalf("read set header %v", got)
+	}
+
+	failing := func(ctx context.Context, req any) (any, error) { return nil, errors.New("boom") }
+	stream = &headerStream{}
+	ctx = grpc.NewContextWithServerTransportStream(context.Background(), stream)
+	info = &grpc.UnaryServerInfo{FullMethod: schedulev1.AppointmentsService_CreateAppointment_FullMethodName}
+	if _, err := intercept(ctx, nil, info, failing); err == nil {
+		t.Fatalf("intercept swallowed the handler error")
+	}
+	if got := stream.header.Get(ConsistencyTokenHeader); len(got) != 0 {
+		t.Fatalf("failed write set header %v", got)
+	}
+}
+
+func TestConsistencyWaitInterceptor(t *testing.T) {
+	info := &grpc.UnaryServerInfo{FullMethod: schedulev1.AppointmentsService_ListOccurrences_FullMethodName}
+	handler := func(ctx context.Context, req any) (any, error) { return "ok", nil }
+	withToken := func(token string) context.Context {
+		ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(ConsistencyTokenHeader, token))
+		return grpc.NewContextWithServerTransportStream(ctx, &headerStream{})
+	}
+
+	checks := 0
+	intercept := ConsistencyWaitInterceptor(func(ctx context.Context, lsn string) (bool, error) {
+		checks++
+		return checks >= 3, nil
+	}, "eu-west", "us-east", time.Second)
+	if _, err := intercept(withToken("0/16B3748"), nil, info, handler); err != nil {
+		t.Fatalf("caught-up read error: %v", err)
+	}
+	if checks != 3 {
+		t.Fatalf("replay checked %d times, want 3", checks)
+	}
+
+	checks = 0
+	if _, err := intercept(grpc.NewContextWithServerTransportStream(context.Background(), &headerStream{}), nil, info, handler); err != nil {
+		t.Fatalf("read without token error: %v", err)
+	}
+	if checks != 0 {
+		t.Fatalf("read without token checked replay")
+	}
+
+	_, err := intercept(withToken("not-an-lsn"), nil, info, handler)
+	if status.Code(err) != codes.InvalidArgument {
+		t.Fatalf("code = %v, want %v", status.Code(err), codes.InvalidArgument)
+	}
+
+	behind := ConsistencyWaitInterceptor(func(ctx context.Context, lsn string) (bool, error) {
+		return false, nil
+	}, "eu-west", "us-east", 30*time.Millisecond)
+	stream := &headerStream{}
+	ctx := grpc.NewContextWithServerTransportStream(metadata.NewIncomingContext(context.Background(), metadata.Pairs(ConsistencyTokenHeader, "0/16B3748")), stream)
+	_, err = behind(ctx, nil, info, handler)
+	if status.Code(err) != codes.FailedPrecondition {
+		t.Fatalf("code = %v, want %v", status.Code(err), codes.FailedPrecondition)
+	}
+	if !strings.Contains(status.Convert(err).Message(), `"us-east"`) {
+		t.Fatalf("message = %q, want primary region hint", status.Convert(err).Message())
+	}
+	if got := stream.header.Get(PrimaryRegionHeader); len(got) != 1 || got[0] != "us-east" {
+		t.Fatalf("header = %v, want us-east", got)
+	}
+}
@@ -0,0 +1,225 @@
+package grpc
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"log/slog"
+	"path"
+	"sort"
+	"strings"
+	"sync"
+	"sync/atomic"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// unrepresentable is the enum number converters emit in strict mode for a
+// domain value that has no proto counterpart, such as a DST policy string
+// no release ever wrote. No enum defines it, so ConversionInterceptor finds
+// it.
+const unrepresentable = -1
+
+var (
+	strictConversion      atomic.Bool
+	unrepresentableValues atomic.Int64
+)
+
+// SetStrictConversion makes converters keep values the proto types cannot
+// represent, for ConversionInterceptor to find. Otherwise they drop such
+// values from lists and leave other fields unspecified, and only count
+// them. Call it once at startup, before serving.
+func SetStrictConversion(strict bool) {
+	strictConversion.Store(strict)
+}
+
+// unrepresentableEnum is what a converter returns for a domain value its
+// proto enum has no counterpart for.
+func unrepresentableEnum[E ~int32]() E {
+	unrepresentableValues.Add(1)
+	if strictConversion.Load() {
+		return E(unrepresentable)
+	}
+	return 0
+}
+
+// keepWeekday reports whether a converter should emit the stored weekday
+// wd: always when it is 1-7, and otherwise only in strict mode.
+func keepWeekday(wd int16) bool {
+	if wd >= 1 && wd <= 7 {
+		return true
+	}
+	unrepresentableValues.Add(1)
+	return strictConversion.Load()
+}
+
+// maxConversionDiagnostics caps how many bad values a strict failure names,
+// so one corrupt calendar does not produce a megabyte of status message.
+const maxConversionDiagnostics = 5
+
+// ConversionErrors counts, per method, the responses strict mode failed
+// because they held values the proto types cannot represent.
+type ConversionErrors struct {
+	mu     sync.Mutex
+	counts map[string]int64
+}
+
+func NewConversionErrors() *ConversionErrors {
+	return &ConversionErrors{counts: make(map[string]int64)}
+}
+
+func (c *ConversionErrors) Record(method string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.counts[method]++
+}
+
+// WriteMetrics writes the counts since the process started, and the number
+// of unrepresentable values converters met in either mode, in the
+// Prometheus text exposition format.
+func (c *ConversionErrors) WriteMetrics(w io.Writer) error {
+	c.mu.Lock()
+	methods := make([]string, 0, len(c.counts))
+	for m := range c.counts {
+		methods = append(methods, m)
+	}
+	counts := make(map[string]int64, len(c.counts))
+	for m, n := range c.counts {
+		counts[m] = n
+	}
+	c.mu.Unlock()
+	sort.Strings(methods)
+
+	var sb strings.Builder
+	sb.WriteString("# HELP schedula_grpc_unrepresentable_values_total Domain values converters met that the proto types cannot represent.\n")
+	sb.WriteString("# TYPE schedula_grpc_unrepresentable_values_total counter\n")
+	fmt.Fprintf(&sb, "schedula_grpc_unrepresentable_values_total %d\n", unrepresentableValues.Load())
+	sb.WriteString("# HELP schedula_grpc_conversion_errors_total Responses strict mode failed for holding values the proto types cannot represent.\n")
+	sb.WriteString("# TYPE schedula_grpc_conversion_errors_total counter\n")
+	for _, m := range methods {
+		fmt.Fprintf(&sb, "schedula_grpc_conversion_errors_total{method=%q} %d\n", m, counts[m])
+	}
+	_, err := io.WriteString(w, sb.String())
+	return err
+}
+
+// ConversionInterceptor is the strict mode check. It fails every response
+// holding enum values its proto enum does not define, which converters in
+// strict mode emit for domain values they could not map and for weekday
+// numbers outside 1-7, with Internal, and names the fields, so corrupt rows
+// surface in testing and staging. Each failure is logged with the bad fields
+// and reported to record. Only install it with SetStrictConversion(true).
+func ConversionInterceptor(record func(method string), log *slog.Logger) grpc.UnaryServerInterceptor {
+	log = log.With(slog.String("component", "grpc.conversion"))
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		resp, err := handler(ctx, req)
+		if err != nil {
+			return resp, err
+		}
+		if err := checkResponse(ctx, resp, path.Base(info.FullMethod), record, log); err != nil {
+			return nil, err
+		}
+		return resp, nil
+	}
+}
+
+// ConversionStreamInterceptor applies the ConversionInterceptor check to
+// every message a streaming RPC sends, such as WatchAppointments events.
+// A failed check ends the stream.
+func ConversionStreamInterceptor(record func(method string), log *slog.Logger) grpc.StreamServerInterceptor {
+	log = log.With(slog.String("component", "grpc.conversion"))
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		return handler(srv, &conversionStream{ServerStream: ss, method: path.Base(info.FullMethod), record: record, log: log})
+	}
+}
+
+type conversionStream struct {
+	grpc.ServerStream
+	method string
+	record func(method string)
+	log    *slog.Logger
+}
+
+func (s *conversionStream) SendMsg(m any) error {
+	if err := checkResponse(s.Context(), m, s.method, s.record, s.log); err != nil {
+		return err
+	}
+	return s.ServerStream.SendMsg(m)
+}
+
+func checkResponse(ctx context.Context, resp any, method string, record func(method string), log *slog.Logger) error {
+	msg, ok := resp.(proto.Message)
+	if !ok || !msg.ProtoReflect().IsValid() {
+		return nil
+	}
+	problems := checkEnums(msg.ProtoReflect(), "")
+	if len(problems) == 0 {
+		return nil
+	}
+
+	record(method)
+	log.ErrorContext(ctx, "response holds values proto cannot represent", slog.String("method", method), slog.Any("fields", problems))
+	shown := problems
+	if len(shown) > maxConversionDiagnostics {
+		shown = shown[:maxConversionDiagnostics]
+	}
+	return status.Errorf(codes.Internal, "The response holds %d values that cannot be represented: %s.", len(problems), strings.Join(shown, "; "))
+}
+
+// checkEnums returns a description of every enum value in m, and in the
+// messages it holds, that its enum does not define.
+func checkEnums(m protoreflect.Message, prefix string) []string {
+	var problems []string
+	m.Range(func(fd protoreflect.FieldDescriptor, v protoreflect.Value) bool {
+		name := prefix + string(fd.Name())
+		switch {
+		case fd.IsMap():
+			v.Map().Range(func(k protoreflect.MapKey, mv protoreflect.Value) bool {
+				key := fmt.Sprintf("%s[%v]", name, k.Interface())
+				switch fd.MapValue().Kind() {
+				case protoreflect.EnumKind:
+					if !enumDefined(fd.MapValue(), mv) {
+						problems = append(problems, enumProblem(key, fd.MapValue(), mv))
+					}
+				case protoreflect.MessageKind, protoreflect.GroupKind:
+					problems = append(problems, checkEnums(mv.Message(), key+".")...)
+				}
+				return true
+			})
+		case fd.IsList():
+			list := v.List()
+			for i := 0; i < list.Len(); i++ {
+				item := list.Get(i)
+				key := fmt.Sprintf("%s[%d]", name, i)
+				switch fd.Kind() {
+				case protoreflect.EnumKind:
+					if !enumDefined(fd, item) {
+						problems = append(problems, enumProblem(key, fd, item))
+					}
+				case protoreflect.MessageKind, protoreflect.GroupKind:
+					problems = append(problems, checkEnums(item.Message(), key+".")...)
+				}
+			}
+		case fd.Kind() == protoreflect.EnumKind:
+			if !enumDefined(fd, v) {
+				problems = append(problems, enumProblem(name, fd, v))
+			}
+		case fd.Kind() == protoreflect.MessageKind || fd.Kind() == protoreflect.GroupKind:
+			problems = append(problems, checkEnums(v.Message(), name+".")...)
+		}
+		return true
+	})
+	return problems
+}
+
+func enumDefined(fd protoreflect.FieldDescriptor, v protoreflect.Value) bool {
+	return fd.Enum().Values().ByNumber(v.Enum()) != nil
+}
+
+func enumProblem(field string, fd protoreflect.FieldDescriptor, v protoreflect.Value) string {
+	return fmt.Sprintf("%s = %d is not a %s", field, v.Enum(), fd.Enum().Name())
+}
@@ -0,0 +1,128 @@
+package grpc
+
+import (
+	"bytes"
+	"context"
+	"log/slog"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"schedula/backend/internal/domain"
+	schedulev1 "schedula/backend/internal/gen/proto/schedula/v1"
+)
+
+// corruptSeries has a weekday and a DST gap policy no proto enum defines.
+var corruptSeries = domain.RecurringSeries{
+	ID: uuid.New(), UserID: "u1", Interval: 1, ByWeekday: []int16{1, 9},
+	DSTGap: "sideways", DSTAmbiguous: domain.DSTAmbiguousEarlier,
+}
+
+func setStrictConversion(t *testing.T, strict bool) {
+	t.Helper()
+	SetStrictConversion(strict)
+	t.Cleanup(func() { SetStrictConversion(false) })
+}
+
+func TestConversionInterceptor_FailsUnrepresentableValues(t *testing.T) {
+	setStrictConversion(t, true)
+	handler := func(ctx context.Context, req any) (any, error) {
+		return &schedulev1.GetRecurringSeriesResponse{Series: toProtoRecurringSeries(corruptSeries)}, nil
+	}
+	info := &grpc.UnaryServerInfo{FullMethod: schedulev1.AppointmentsService_GetRecurringSeries_FullMethodName}
+
+	var recorded []string
+	record := func(method string) { recorded = append(recorded, method) }
+
+	_, err := ConversionInterceptor(record, slog.Default())(context.Background(), nil, info, handler)
+	if status.Code(err) != codes.Internal {
+		t.Fatalf("strict code = %v, want %v", status.Code(err), codes.Internal)
+	}
+	msg := status.Convert(err).Message()
+	if !strings.Contains(msg, "series.weekly.weekdays[1] = 9 is not a Weekday") || !strings.Contains(msg, "series.weekly.dst_gap_policy = -1") {
+		t.Fatalf("strict message = %q, want both bad fields named", msg)
+	}
+	if len(recorded) != 1 || recorded[0] != "GetRecurringSeries" {
+		t.Fatalf("recorded = %v, want GetRecurringSeries", recorded)
+	}
+}
+
+func TestConverters_DropUnrepresentableValuesWhenLenient(t *testing.T) {
+	setStrictConversion(t, false)
+	before := unrepresentableValues.Load()
+
+	weekly := toProtoRecurringSeries(corruptSeries).GetWeekly()
+	if len(weekly.Weekdays) != 1 || weekly.Weekdays[0] != schedulev1.Weekday_MONDAY {
+		t.Fatalf("lenient weekdays = %v, want only Monday", weekly.Weekdays)
+	}
+	if weekly.DstGapPolicy != schedulev1.DstGapPolicy_DST_GAP_POLICY_UNSPECIFIED || weekly.DstAmbiguousPolicy != schedulev1.DstAmbiguousPolicy_DST_AMBIGUOUS_POLICY_EARLIER {
+		t.Fatalf("lenient policies = %v, %v", weekly.DstGapPolicy, weekly.DstAmbiguousPolicy)
+	}
+	if got := unrepresentableValues.Load() - before; got != 2 {
+		t.Fatalf("counted %d unrepresentable values, want 2", got)
+	}
+
+	var buf bytes.Buffer
+	if err := NewConversionErrors().WriteMetrics(&buf); err != nil {
+		t.Fatalf("WriteMetrics error: %v", err)
+	}
+	if !strings.Contains(buf.String(), "schedula_grpc_unrepresentable_values_total ") {
+		t.Fatalf("metrics = %q, want the unrepresentable values counter", buf.String())
+	}
+}
+
+func TestConversionInterceptor_PassesCleanResponses(t *testing.T) {
+	setStrictConversion(t, true)
+	handler := func(ctx context.Context, req any) (any, error) {
+		return &schedulev1.GetRecurringSeriesResponse{Series: toProtoRecurringSeries(domain.RecurringSeries{
+			ID: uuid.New(), UserID: "u1", Interval: 1, ByWeekday: []int16{1, 3},
+			DSTGap: domain.DSTGapSkip, DSTAmbiguous: domain.DSTAmbiguousLater,
+		})}, nil
+	}
+	info := &grpc.UnaryServerInfo{FullMethod: schedulev1.AppointmentsService_GetRecurringSeries_FullMethodName}
+	record := func(method string) { t.Fatalf("recorded %s for a clean response", method) }
+	if _, err := ConversionInterceptor(record, slog.Default())(context.Background(), nil, info, handler); err != nil {
+		t.Fatalf("strict error: %v", err)
+	}
+}
+
+type recordingStream struct {
+	grpc.ServerStream
+	sent []any
+}
+
+func (s *recordingStream) Context() context.Context { return context.Background() }
+
+func (s *recordingStream) SendMsg(m any) error {
+	s.sent = append(s.sent, m)
+	return nil
+}
+
+func TestConversionStreamInterceptor_ChecksEveryMessage(t *testing.T) {
+	setStrictConversion(t, true)
+	clean := domain.RecurringSeries{ID: uuid.New(), UserID: "u1", Interval: 1, ByWeekday: []int16{2}}
+	handler := func(srv any, ss grpc.ServerStream) error {
+		if err := ss.SendMsg(&schedulev1.WatchOccurrencesResponse{Series: toProtoRecurringSeries(clean)}); err != nil {
+			return err
+		}
+		return ss.SendMsg(&schedulev1.WatchOccurrencesResponse{Series: toProtoRecurringSeries(corruptSeries)})
+	}
+	info := &grpc.StreamServerInfo{FullMethod: schedulev1.AppointmentsService_WatchOccurrences_FullMethodName, IsServerStream: true}
+
+	var recorded []string
+	stream := &recordingStream{}
+	err := ConversionStreamInterceptor(func(method string) { recorded = append(recorded, method) }, slog.Default())(nil, stream, info, handler)
+	if status.Code(err) != codes.Internal {
+		t.Fatalf("stream code = %v, want %v", status.Code(err), codes.Internal)
+	}
+	if len(stream.sent) != 1 {
+		t.Fatalf("sent %d messages, want only the clean snapshot", len(stream.sent))
+	}
+	if len(recorded) != 1 || recorded[0] != "WatchOccurrences" {
+		t.Fatalf("recorded = %v, want WatchOccurrences", recorded)
+	}
+}
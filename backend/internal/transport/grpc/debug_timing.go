@@ -0,0 +1,46 @@
+package grpc
+
+import (
+	"context"
+	"crypto/subtle"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+
+	"schedula/backend/internal/timing"
+)
+
+// DebugTimingHeader asks for a timing breakdown of the call. Its value must
+// be the server's debug timing token.
+const DebugTimingHeader = "schedula-debug-timing"
+
+// TimingTrailer carries the breakdown, formatted like a Server-Timing
+// header.
+const TimingTrailer = "schedula-timing"
+
+// DebugTimingInterceptor times the phases of each RPC that sends
+// DebugTimingHeader with token and returns them in TimingTrailer. Calls
+// without the header, or with a wrong token, run untimed; the header never
+// fails a call. An empty token turns the mode off.
+func DebugTimingInterceptor(token string) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		if token == "" || !debugTimingAllowed(ctx, token) {
+			return handler(ctx, req)
+		}
+		ctx, rec := timing.WithRecorder(ctx, time.Now())
+		resp, err := handler(ctx, req)
+		_ = grpc.SetTrailer(ctx, metadata.Pairs(TimingTrailer, timing.Format(rec.Breakdown(time.Now()))))
+		return resp, err
+	}
+}
+
+func debugTimingAllowed(ctx context.Context, token string) bool {
+	md, _ := metadata.FromIncomingContext(ctx)
+	for _, v := range md.Get(DebugTimingHeader) {
+		if subtle.ConstantTimeCompare([]byte(v), []byte(token)) == 1 {
+			return true
+		}
+	}
+	return false
+}
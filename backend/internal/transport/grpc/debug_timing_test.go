@@ -0,0 +1,53 @@
+package grpc
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+
+	schedulev1 "schedula/backend/internal/gen/proto/schedula/v1"
+	"schedula/backend/internal/timing"
+)
+
+func TestDebugTimingInterceptor(t *testing.T) {
+	info := &grpc.UnaryServerInfo{FullMethod: schedulev1.AppointmentsService_ListOccurrences_FullMethodName}
+	handler := func(ctx context.Context, req any) (any, error) {
+		timing.Since(ctx, timing.SQL, time.Now().Add(-time.Millisecond))
+		return "ok", nil
+	}
+	call := func(intercept grpc.UnaryServerInterceptor, md metadata.MD) metadata.MD {
+		t.Helper()
+		stream := &headerStream{}
+		ctx := grpc.NewContextWithServerTransportStream(metadata.NewIncomingContext(context.Background(), md), stream)
+		if _, err := intercept(ctx, nil, info, handler); err != nil {
+			t.Fatalf("intercept error: %v", err)
+		}
+		return stream.trailer
+	}
+
+	intercept := DebugTimingInterceptor("s3cret")
+	trailer := call(intercept, metadata.Pairs(DebugTimingHeader, "s3cret"))
+	got := trailer.Get(TimingTrailer)
+	if len(got) != 1 {
+		t.Fatalf("trailer = %v, want one timing entry", trailer)
+	}
+	for _, phase := range timing.Phases {
+		if !strings.Contains(got[0], string(phase)+";dur=") {
+			t.Fatalf("timing = %q, missing %s", got[0], phase)
+		}
+	}
+
+	if trailer := call(intercept, metadata.Pairs(DebugTimingHeader, "guess")); len(trailer.Get(TimingTrailer)) != 0 {
+		t.Fatalf("wrong token got timing %v", trailer)
+	}
+	if trailer := call(intercept, metadata.MD{}); len(trailer.Get(TimingTrailer)) != 0 {
+		t.Fatalf("call without header got timing %v", trailer)
+	}
+	if trailer := call(DebugTimingInterceptor(""), metadata.Pairs(DebugTimingHeader, "")); len(trailer.Get(TimingTrailer)) != 0 {
+		t.Fatalf("disabled mode got timing %v", trailer)
+	}
+}
@@ -0,0 +1,76 @@
+package grpc
+
+import (
+	"context"
+	"log/slog"
+	"path"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	schedulev1 "schedula/backend/internal/gen/proto/schedula/v1"
+)
+
+// DefaultBatchMethods are the RPCs that run in the batch lane: imports,
+// exports and other calls that read or rewrite a whole calendar. Everything
+// else is interactive.
+var DefaultBatchMethods = []string{
+	schedulev1.AppointmentsService_ExportCalendar_FullMethodName,
+	schedulev1.AppointmentsService_ImportCalendar_FullMethodName,
+	schedulev1.AppointmentsService_CreateCalendarSnapshot_FullMethodName,
+	schedulev1.AppointmentsService_RestoreCalendarSnapshot_FullMethodName,
+	schedulev1.AppointmentsService_ReconcileCalendar_FullMethodName,
+	schedulev1.AppointmentsService_ExportBillableHours_FullMethodName,
+	schedulev1.AppointmentsService_AuditCalendar_FullMethodName,
+	schedulev1.AppointmentsService_GetAnalytics_FullMethodName,
+	schedulev1.AppointmentsService_SimulateSchedule_FullMethodName,
+	schedulev1.AppointmentsService_ChangeSeriesTimeZone_FullMethodName,
+	schedulev1.AdminService_PurgeExpiredAppointments_FullMethodName,
+}
+
+// LaneLimits caps how many RPCs of each lane run at once. Zero leaves a
+// lane unlimited.
+type LaneLimits struct {
+	Interactive int
+	Batch       int
+}
+
+// LaneInterceptor runs batch RPCs, named in batchMethods by full or bare
+// method name, and interactive RPCs in separate concurrency pools, so a
+// burst of imports cannot take the slots and database connections that
+// calendar reads need. An RPC waits for a slot in its lane until its
+// deadline, then fails with ResourceExhausted. Install it after the timeout
+// interceptor so every wait is bounded.
+func LaneInterceptor(batchMethods []string, limits LaneLimits, log *slog.Logger) grpc.UnaryServerInterceptor {
+	batch := make(map[string]bool, len(batchMethods))
+	for _, m := range batchMethods {
+		batch[m] = true
+	}
+	var interactiveSlots, batchSlots chan struct{}
+	if limits.Interactive > 0 {
+		interactiveSlots = make(chan struct{}, limits.Interactive)
+	}
+	if limits.Batch > 0 {
+		batchSlots = make(chan struct{}, limits.Batch)
+	}
+	log = log.With(slog.String("component", "grpc.lanes"))
+
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		lane, slots := "interactive", interactiveSlots
+		if batch[info.FullMethod] || batch[path.Base(info.FullMethod)] {
+			lane, slots = "batch", batchSlots
+		}
+		if slots == nil {
+			return handler(ctx, req)
+		}
+		select {
+		case slots <- struct{}{}:
+		case <-ctx.Done():
+			log.WarnContext(ctx, "lane full", slog.String("lane", lane), slog.String("method", path.Base(info.FullMethod)))
+			return nil, status.Errorf(codes.ResourceExhausted, "The server is busy with %s requests. Retry later.", lane)
+		}
+		defer func() { <-slots }()
+		return handler(ctx, req)
+	}
+}
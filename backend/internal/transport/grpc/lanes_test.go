@@ -0,0 +1,75 @@
+package grpc
+
+import (
+	"context"
+	"log/slog"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	schedulev1 "schedula/backend/internal/gen/proto/schedula/v1"
+)
+
+func TestLaneInterceptor_BatchCannotStarveInteractive(t *testing.T) {
+	intercept := LaneInterceptor([]string{schedulev1.AppointmentsService_ImportCalendar_FullMethodName, "ExportCalendar"}, LaneLimits{Interactive: 1, Batch: 1}, slog.Default())
+	importInfo := &grpc.UnaryServerInfo{FullMethod: schedulev1.AppointmentsService_ImportCalendar_FullMethodName}
+	exportInfo := &grpc.UnaryServerInfo{FullMethod: schedulev1.AppointmentsService_ExportCalendar_FullMethodName}
+	listInfo := &grpc.UnaryServerInfo{FullMethod: schedulev1.AppointmentsService_ListOccurrences_FullMethodName}
+
+	// An import holds the only batch slot until released.
+	started, release := make(chan struct{}), make(chan struct{})
+	done := make(chan error, 1)
+	go func() {
+		_, err := intercept(context.Background(), nil, importInfo, func(ctx context.Context, req any) (any, error) {
+			close(started)
+			<-release
+			return nil, nil
+		})
+		done <- err
+	}()
+	<-started
+
+	ok := func(ctx context.Context, req any) (any, error) { return "ok", nil }
+	if _, err := intercept(context.Background(), nil, listInfo, ok); err != nil {
+		t.Fatalf("interactive call during an import: %v", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	if _, err := intercept(ctx, nil, exportInfo, ok); status.Code(err) != codes.ResourceExhausted {
+		t.Fatalf("second batch call code = %v, want %v", status.Code(err), codes.ResourceExhausted)
+	}
+
+	close(release)
+	if err := <-done; err != nil {
+		t.Fatalf("import error: %v", err)
+	}
+	if _, err := intercept(context.Background(), nil, exportInfo, ok); err != nil {
+		t.Fatalf("batch call after the import finished: %v", err)
+	}
+}
+
+func TestLaneInterceptor_ZeroLimitIsUnlimited(t *testing.T) {
+	intercept := LaneInterceptor(DefaultBatchMethods, LaneLimits{}, slog.Default())
+	info := &grpc.UnaryServerInfo{FullMethod: schedulev1.AppointmentsService_ImportCalendar_FullMethodName}
+
+	// Nested calls would deadlock on a pool of any fixed size.
+	var call func(ctx context.Context, depth int) error
+	call = func(ctx context.Context, depth int) error {
+		_, err := intercept(ctx, nil, info, func(ctx context.Context, req any) (any, error) {
+			if depth == 0 {
+				return nil, nil
+			}
+			return nil, call(ctx, depth-1)
+		})
+		return err
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	if err := call(ctx, 8); err != nil {
+		t.Fatalf("nested calls error: %v", err)
+	}
+}
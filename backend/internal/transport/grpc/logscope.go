@@ -0,0 +1,69 @@
+package grpc
+
+import (
+	"context"
+	"log/slog"
+	"path"
+	"time"
+	"unicode"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"schedula/backend/internal/logscope"
+)
+
+// RequestIDHeader carries a request's id. Clients may send one to follow a
+// call in the logs; otherwise the server makes one up. Either way it is
+// returned in the response headers.
+const RequestIDHeader = "x-request-id"
+
+const maxRequestIDLength = 128
+
+// LogScopeInterceptor gives every unary RPC a request id and scopes its
+// context to that id and the request's user id, so log targets set with
+// SetLogTarget follow the call. Each finished RPC is logged at debug level.
+func LogScopeInterceptor(log *slog.Logger) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		requestID := incomingRequestID(ctx)
+		if requestID == "" {
+			requestID = uuid.NewString()
+		}
+		_ = grpc.SetHeader(ctx, metadata.Pairs(RequestIDHeader, requestID))
+
+		var userID string
+		if r, ok := req.(interface{ GetUserId() string }); ok {
+			userID = r.GetUserId()
+		}
+		ctx = logscope.WithScope(ctx, userID, requestID)
+
+		start := time.Now()
+		resp, err := handler(ctx, req)
+		log.LogAttrs(ctx, slog.LevelDebug, "rpc finished",
+			slog.String("rpc", path.Base(info.FullMethod)),
+			slog.String("request_id", requestID),
+			slog.String("user_id", userID),
+			slog.String("code", status.Code(err).String()),
+			slog.Duration("duration", time.Since(start)),
+		)
+		return resp, err
+	}
+}
+
+// incomingRequestID returns the client's request id, or "" when it is
+// missing, too long or not printable, so it is safe to log.
+func incomingRequestID(ctx context.Context) string {
+	md, _ := metadata.FromIncomingContext(ctx)
+	vals := md.Get(RequestIDHeader)
+	if len(vals) == 0 || len(vals[0]) > maxRequestIDLength {
+		return ""
+	}
+	for _, r := range vals[0] {
+		if !unicode.IsPrint(r) {
+			return ""
+		}
+	}
+	return vals[0]
+}
@@ -0,0 +1,115 @@
+// Package middleware assembles the interceptor chains from named
+// interceptors and a configured order, so deployments can reorder or turn
+// off interceptors without a code change.
+package middleware
+
+import (
+	"fmt"
+	"slices"
+
+	"google.golang.org/grpc"
+)
+
+// Interceptor is one link of the chain. Most have only a Unary half; one
+// that also checks streaming RPCs sets Stream too. Required interceptors
+// guard correctness, such as rejecting writes on a replica, and cannot be
+// disabled or left out of an order. One with neither half is known but
+// inactive in this process, such as the replica guard on a primary; it may
+// be named and is left out, so one config serves every role.
+type Interceptor struct {
+	Name     string
+	Unary    grpc.UnaryServerInterceptor
+	Stream   grpc.StreamServerInterceptor
+	Required bool
+}
+
+func (ic Interceptor) active() bool {
+	return ic.Unary != nil || ic.Stream != nil
+}
+
+// Config selects and orders interceptors by name. An empty Order keeps the
+// order they are offered in; a non-empty one lists every interceptor to run,
+// outermost first. Disabled names are dropped either way.
+type Config struct {
+	Order    []string
+	Disabled []string
+}
+
+// Chain returns the interceptors cfg selects, outermost first. Unknown or
+// repeated names and omitted required interceptors are errors, so a typo
+// cannot silently drop a guard.
+func Chain(available []Interceptor, cfg Config) ([]Interceptor, error) {
+	byName := make(map[string]Interceptor, len(available))
+	for _, ic := range available {
+		byName[ic.Name] = ic
+	}
+	for _, name := range cfg.Disabled {
+		ic, ok := byName[name]
+		if !ok {
+			return nil, fmt.Errorf("unknown interceptor %q", name)
+		}
+		if ic.Required {
+			return nil, fmt.Errorf("interceptor %q is required and cannot be disabled", name)
+		}
+	}
+
+	order := cfg.Order
+	if len(order) == 0 {
+		for _, ic := range available {
+			order = append(order, ic.Name)
+		}
+	}
+	out := make([]Interceptor, 0, len(order))
+	seen := make(map[string]bool, len(order))
+	for _, name := range order {
+		ic, ok := byName[name]
+		if !ok {
+			return nil, fmt.Errorf("unknown interceptor %q", name)
+		}
+		if seen[name] {
+			return nil, fmt.Errorf("interceptor %q listed twice", name)
+		}
+		seen[name] = true
+		if ic.active() && !slices.Contains(cfg.Disabled, name) {
+			out = append(out, ic)
+		}
+	}
+	for _, ic := range available {
+		if ic.Required && ic.active() && !seen[ic.Name] {
+			return nil, fmt.Errorf("interceptor %q is required but missing from the order", ic.Name)
+		}
+	}
+	return out, nil
+}
+
+// Unary returns the chain's interceptors for grpc.ChainUnaryInterceptor.
+func Unary(chain []Interceptor) []grpc.UnaryServerInterceptor {
+	out := make([]grpc.UnaryServerInterceptor, 0, len(chain))
+	for _, ic := range chain {
+		if ic.Unary != nil {
+			out = append(out, ic.Unary)
+		}
+	}
+	return out
+}
+
+// Stream returns the chain's stream halves, in the same order, for
+// grpc.ChainStreamInterceptor.
+func Stream(chain []Interceptor) []grpc.StreamServerInterceptor {
+	var out []grpc.StreamServerInterceptor
+	for _, ic := range chain {
+		if ic.Stream != nil {
+			out = append(out, ic.Stream)
+		}
+	}
+	return out
+}
+
+// Names lists the chain's interceptors, for the startup log.
+func Names(chain []Interceptor) []string {
+	out := make([]string, 0, len(chain))
+	for _, ic := range chain {
+		out = append(out, ic.Name)
+	}
+	return out
+}
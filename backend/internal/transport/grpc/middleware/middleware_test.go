@@ -0,0 +1,109 @@
+package middleware
+
+import (
+	"context"
+	"slices"
+	"strings"
+	"testing"
+
+	"google.golang.org/grpc"
+)
+
+// tracing returns an interceptor that appends name to calls before handing
+// on, so a chained call records the order interceptors ran in.
+func tracing(name string, calls *[]string) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		*calls = append(*calls, name)
+		return handler(ctx, req)
+	}
+}
+
+func TestChain_OrdersAndDisables(t *testing.T) {
+	var calls []string
+	available := []Interceptor{
+		{Name: "usage", Unary: tracing("usage", &calls)},
+		{Name: "timeout", Unary: tracing("timeout", &calls)},
+		{Name: "read_only", Unary: tracing("read_only", &calls), Required: true},
+		{Name: "calendar_version"},
+	}
+
+	chain, err := Chain(available, Config{})
+	if err != nil {
+		t.Fatalf("Chain error: %v", err)
+	}
+	if got := Names(chain); !slices.Equal(got, []string{"usage", "timeout", "read_only"}) {
+		t.Fatalf("default chain = %v", got)
+	}
+
+	chain, err = Chain(available, Config{Order: []string{"read_only", "calendar_version", "timeout", "usage"}, Disabled: []string{"timeout"}})
+	if err != nil {
+		t.Fatalf("Chain error: %v", err)
+	}
+	// Nest the chain the way grpc.ChainUnaryInterceptor does: the first
+	// interceptor is outermost.
+	handler := grpc.UnaryHandler(func(ctx context.Context, req any) (any, error) { return nil, nil })
+	for _, ic := range slices.Backward(Unary(chain)) {
+		next := handler
+		handler = func(ctx context.Context, req any) (any, error) {
+			return ic(ctx, req, &grpc.UnaryServerInfo{}, next)
+		}
+	}
+	if _, err := handler(context.Background(), nil); err != nil {
+		t.Fatalf("chain error: %v", err)
+	}
+	if !slices.Equal(calls, []string{"read_only", "usage"}) {
+		t.Fatalf("calls = %v, want read_only then usage", calls)
+	}
+
+	for _, tt := range []struct {
+		cfg  Config
+		want string
+	}{
+		{Config{Disabled: []string{"read_only"}}, "cannot be disabled"},
+		{Config{Disabled: []string{"auth"}}, "unknown interceptor"},
+		{Config{Order: []string{"usage", "usage", "read_only"}}, "listed twice"},
+		{Config{Order: []string{"usage", "timeout"}}, "missing from the order"},
+	} {
+		if _, err := Chain(available, tt.cfg); err == nil || !strings.Contains(err.Error(), tt.want) {
+			t.Fatalf("Chain(%+v) error = %v, want %q", tt.cfg, err, tt.want)
+		}
+	}
+}
+
+func TestStream_KeepsOnlyStreamHalvesInOrder(t *testing.T) {
+	var calls []string
+	streaming := func(name string) grpc.StreamServerInterceptor {
+		return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+			calls = append(calls, name)
+			return handler(srv, ss)
+		}
+	}
+	chain, err := Chain([]Interceptor{
+		{Name: "usage", Unary: tracing("usage", &calls)},
+		{Name: "conversion", Unary: tracing("conversion", &calls), Stream: streaming("conversion")},
+		{Name: "watch_only", Stream: streaming("watch_only")},
+	}, Config{})
+	if err != nil {
+		t.Fatalf("Chain error: %v", err)
+	}
+	if got := Names(chain); !slices.Equal(got, []string{"usage", "conversion", "watch_only"}) {
+		t.Fatalf("chain = %v", got)
+	}
+	if n := len(Unary(chain)); n != 2 {
+		t.Fatalf("len(Unary) = %d, want 2", n)
+	}
+
+	handler := grpc.StreamHandler(func(srv any, ss grpc.ServerStream) error { return nil })
+	for _, ic := range slices.Backward(Stream(chain)) {
+		next := handler
+		handler = func(srv any, ss grpc.ServerStream) error {
+			return ic(srv, ss, &grpc.StreamServerInfo{}, next)
+		}
+	}
+	if err := handler(nil, nil); err != nil {
+		t.Fatalf("chain error: %v", err)
+	}
+	if !slices.Equal(calls, []string{"conversion", "watch_only"}) {
+		t.Fatalf("calls = %v, want conversion then watch_only", calls)
+	}
+}
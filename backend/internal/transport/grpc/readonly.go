@@ -0,0 +1,87 @@
+package grpc
+
+import (
+	"context"
+	"fmt"
+	"path"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	schedulev1 "schedula/backend/internal/gen/proto/schedula/v1"
+	schedulev2 "schedula/backend/internal/gen/proto/schedula/v2"
+)
+
+// PrimaryRegionHeader is set on responses rejected by a read-only replica so
+// clients know where to send the write.
+const PrimaryRegionHeader = "schedula-primary-region"
+
+// DefaultReadMethods are the RPCs a read-only replica serves. RPCs that can
+// write, including RepairRecurringSeries and SkipOccurrences with apply set,
+// are left out.
+var DefaultReadMethods = []string{
+	schedulev1.AppointmentsService_ListAppointments_FullMethodName,
+	schedulev1.AppointmentsService_ListOccurrences_FullMethodName,
+	schedulev1.AppointmentsService_GetRecurringSeries_FullMethodName,
+	schedulev1.AppointmentsService_ListRecurringSeries_FullMethodName,
+	schedulev1.AppointmentsService_GetAttendanceStats_FullMethodName,
+	schedulev1.AppointmentsService_GetLimits_FullMethodName,
+	schedulev1.AppointmentsService_GetAppointmentByExternalRef_FullMethodName,
+	schedulev1.AppointmentsService_GetAnalytics_FullMethodName,
+	schedulev1.AppointmentsService_SuggestEndTime_FullMethodName,
+	schedulev1.AppointmentsService_ListRelated_FullMethodName,
+	schedulev1.AppointmentsService_ListProposals_FullMethodName,
+	schedulev1.AppointmentsService_BatchGetFreeBusy_FullMethodName,
+	schedulev1.AppointmentsService_SuggestMeetingTimes_FullMethodName,
+	schedulev1.AppointmentsService_ListDelegations_FullMethodName,
+	schedulev1.AppointmentsService_ExportCalendar_FullMethodName,
+	schedulev1.AppointmentsService_ListCalendarSnapshots_FullMethodName,
+	schedulev1.AppointmentsService_ListChanges_FullMethodName,
+	schedulev1.AppointmentsService_ExportBillableHours_FullMethodName,
+	schedulev1.AppointmentsService_GetContact_FullMethodName,
+	schedulev1.AppointmentsService_ListContacts_FullMethodName,
+	schedulev1.AppointmentsService_GetProgram_FullMethodName,
+	schedulev1.AppointmentsService_ListPrograms_FullMethodName,
+	schedulev1.AppointmentsService_CreateEmbedToken_FullMethodName,
+	schedulev1.AppointmentsService_GetSlotSettings_FullMethodName,
+	schedulev1.AppointmentsService_GetTimeOff_FullMethodName,
+	schedulev1.AppointmentsService_ListTimeOff_FullMethodName,
+	schedulev1.AppointmentsService_AuditCalendar_FullMethodName,
+	schedulev1.AppointmentsService_GetDailyAgenda_FullMethodName,
+	schedulev1.AppointmentsService_GetDaySummaries_FullMethodName,
+	schedulev1.AppointmentsService_SimulateSchedule_FullMethodName,
+	schedulev2.AppointmentsService_ListAppointments_FullMethodName,
+	schedulev1.AdminService_GetDatabaseDiagnostics_FullMethodName,
+	schedulev1.AdminService_ListBlackouts_FullMethodName,
+	schedulev1.AdminService_GetAPIUsage_FullMethodName,
+	schedulev1.AdminService_GetServiceHealthSummary_FullMethodName,
+	schedulev1.AdminService_ListLogTargets_FullMethodName,
+	schedulev1.AdminService_ListProvisionedUsers_FullMethodName,
+	schedulev1.AdminService_ListUserGroups_FullMethodName,
+}
+
+// ReadOnlyInterceptor rejects every RPC outside allowed with
+// FailedPrecondition and a PrimaryRegionHeader hint. Entries may be full
+// method names or bare ones such as "ListOccurrences".
+func ReadOnlyInterceptor(region, primaryRegion string, allowed []string) grpc.UnaryServerInterceptor {
+	allow := make(map[string]bool, len(allowed))
+	for _, m := range allowed {
+		allow[m] = true
+	}
+	msg := "This server is a read-only replica."
+	if primaryRegion != "" {
+		msg = fmt.Sprintf("This server is a read-only replica in region %q. Send writes to region %q.", region, primaryRegion)
+	}
+
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		if allow[info.FullMethod] || allow[path.Base(info.FullMethod)] {
+			return handler(ctx, req)
+		}
+		if primaryRegion != "" {
+			_ = grpc.SetHeader(ctx, metadata.Pairs(PrimaryRegionHeader, primaryRegion))
+		}
+		return nil, status.Error(codes.FailedPrecondition, msg)
+	}
+}
@@ -0,0 +1,130 @@
+package grpc
+
+import (
+	"context"
+	"log/slog"
+	"strings"
+	"testing"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	schedulev1 "schedula/backend/internal/gen/proto/schedula/v1"
+	schedulev2 "schedula/backend/internal/gen/proto/schedula/v2"
+)
+
+func TestReadOnlyInterceptor(t *testing.T) {
+	intercept := ReadOnlyInterceptor("eu-west", "us-east", []string{schedulev1.AppointmentsService_ListOccurrences_FullMethodName, "GetLimits"})
+	called := 0
+	handler := func(ctx context.Context, req any) (any, error) {
+		called++
+		return "ok", nil
+	}
+
+	for _, method := range []string{
+		schedulev1.AppointmentsService_ListOccurrences_FullMethodName,
+		"/schedula.v1.AppointmentsService/GetLimits",
+	} {
+		if _, err := intercept(context.Background(), nil, &grpc.UnaryServerInfo{FullMethod: method}, handler); err != nil {
+			t.Fatalf("%s error: %v", method, err)
+		}
+	}
+	if called != 2 {
+		t.Fatalf("handler called %d times, want 2", called)
+	}
+
+	_, err := intercept(context.Background(), nil, &grpc.UnaryServerInfo{FullMethod: schedulev1.AppointmentsService_CreateAppointment_FullMethodName}, handler)
+	if status.Code(err) != codes.FailedPrecondition {
+		t.Fatalf("code = %v, want %v", status.Code(err), codes.FailedPrecondition)
+	}
+	if !strings.Contains(status.Convert(err).Message(), `"us-east"`) {
+		t.Fatalf("message = %q, want primary region hint", status.Convert(err).Message())
+	}
+	if called != 2 {
+		t.Fatalf("handler called for a rejected write")
+	}
+}
+
+type headerStream struct {
+	grpc.ServerTransportStream
+	header  metadata.MD
+	trailer metadata.MD
+}
+
+func (s *headerStream) SetHeader(md metadata.MD) error {
+	s.header = metadata.Join(s.header, md)
+	return nil
+}
+
+func (s *headerStream) SetTrailer(md metadata.MD) error {
+	s.trailer = metadata.Join(s.trailer, md)
+	return nil
+}
+
+func TestCalendarVersionInterceptor(t *testing.T) {
+	intercept := CalendarVersionInterceptor(func(ctx context.Context, userID string) (int64, error) {
+		if userID != "u1" {
+			t.Fatalf("userID = %q, want u1", userID)
+		}
+		return 7, nil
+	}, []string{"ListAppointments"}, slog.Default())
+	handler := func(ctx context.Context, req any) (any, error) { return "ok", nil }
+
+	stream := &headerStream{}
+	ctx := grpc.NewContextWithServerTransportStream(context.Background(), stream)
+	info := &grpc.UnaryServerInfo{FullMethod: schedulev1.AppointmentsService_CreateAppointment_FullMethodName}
+	if _, err := intercept(ctx, &schedulev1.CreateAppointmentRequest{UserId: "u1"}, info, handler); err != nil {
+		t.Fatalf("intercept error: %v", err)
+	}
+	if got := stream.header.Get(CalendarVersionHeader); len(got) != 1 || got[0] != "7" {
+		t.Fatalf("header = %v, want 7", got)
+	}
+
+	stream = &headerStream{}
+	ctx = grpc.NewContextWithServerTransportStream(context.Background(), stream)
+	info = &grpc.UnaryServerInfo{FullMethod: schedulev1.AppointmentsService_ListAppointments_FullMethodName}
+	if _, err := intercept(ctx, &schedulev1.ListAppointmentsRequest{UserId: "u1"}, info, handler); err != nil {
+		t.Fatalf("intercept error: %v", err)
+	}
+	if len(stream.header) != 0 {
+		t.Fatalf("read method header = %v, want none", stream.header)
+	}
+}
+
+func TestAPIVersionInterceptor(t *testing.T) {
+	intercept := APIVersionInterceptor(DeprecatedMethods)
+	handler := func(ctx context.Context, req any) (any, error) { return "ok", nil }
+	v1Delete := &grpc.UnaryServerInfo{FullMethod: schedulev1.AppointmentsService_DeleteAppointment_FullMethodName}
+
+	stream := &headerStream{}
+	ctx := grpc.NewContextWithServerTransportStream(context.Background(), stream)
+	if _, err := intercept(ctx, nil, v1Delete, handler); err != nil {
+		t.Fatalf("intercept error: %v", err)
+	}
+	if got := stream.header.Get(APIVersionHeader); len(got) != 1 || got[0] != "v1" {
+		t.Fatalf("version header = %v, want v1", got)
+	}
+	if got := stream.header.Get(DeprecatedByHeader); len(got) != 1 || got[0] != schedulev2.AppointmentsService_DeleteAppointment_FullMethodName {
+		t.Fatalf("deprecated header = %v", got)
+	}
+
+	ctx = metadata.NewIncomingContext(grpc.NewContextWithServerTransportStream(context.Background(), &headerStream{}), metadata.Pairs(APIVersionHeader, "v2"))
+	_, err := intercept(ctx, nil, v1Delete, handler)
+	if status.Code(err) != codes.FailedPrecondition {
+		t.Fatalf("code = %v, want %v", status.Code(err), codes.FailedPrecondition)
+	}
+	if !strings.Contains(status.Convert(err).Message(), schedulev2.AppointmentsService_DeleteAppointment_FullMethodName) {
+		t.Fatalf("message = %q, want replacement hint", status.Convert(err).Message())
+	}
+	v2Delete := &grpc.UnaryServerInfo{FullMethod: schedulev2.AppointmentsService_DeleteAppointment_FullMethodName}
+	if _, err := intercept(ctx, nil, v2Delete, handler); err != nil {
+		t.Fatalf("v2 call pinned to v2 error: %v", err)
+	}
+
+	ctx = metadata.NewIncomingContext(grpc.NewContextWithServerTransportStream(context.Background(), &headerStream{}), metadata.Pairs(APIVersionHeader, "v9"))
+	if _, err := intercept(ctx, nil, v2Delete, handler); status.Code(err) != codes.InvalidArgument {
+		t.Fatalf("unknown version code = %v, want %v", status.Code(err), codes.InvalidArgument)
+	}
+}
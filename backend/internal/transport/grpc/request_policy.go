@@ -0,0 +1,49 @@
+package grpc
+
+import (
+	"context"
+	"log/slog"
+	"path"
+	"time"
+
+	"google.golang.org/grpc"
+
+	"schedula/backend/internal/limits"
+	"schedula/backend/internal/service/appointments"
+)
+
+type requestTimeoutKey struct{}
+
+// RequestPolicyInterceptor looks up the policy of the user a request names
+// and applies it: the user's limits go on the context for the service, and
+// their timeout for the timeout interceptor, which must run after it. When
+// the lookup fails the request goes ahead under the server's defaults.
+func RequestPolicyInterceptor(lookup func(ctx context.Context, userID string) (appointments.RequestPolicy, error), log *slog.Logger) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		r, ok := req.(interface{ GetUserId() string })
+		if !ok || r.GetUserId() == "" {
+			return handler(ctx, req)
+		}
+		policy, err := lookup(ctx, r.GetUserId())
+		if err != nil {
+			log.Warn("request policy lookup failed; using defaults",
+				slog.Any("err", err),
+				slog.String("rpc", path.Base(info.FullMethod)),
+				slog.String("user_id", r.GetUserId()),
+			)
+			return handler(ctx, req)
+		}
+		ctx = limits.NewContext(ctx, policy.Limits)
+		if policy.Timeout > 0 {
+			ctx = context.WithValue(ctx, requestTimeoutKey{}, policy.Timeout)
+		}
+		return handler(ctx, req)
+	}
+}
+
+// RequestPolicyTimeout returns the timeout RequestPolicyInterceptor found for
+// the request's user, or 0 when the server's applies.
+func RequestPolicyTimeout(ctx context.Context) time.Duration {
+	d, _ := ctx.Value(requestTimeoutKey{}).(time.Duration)
+	return d
+}
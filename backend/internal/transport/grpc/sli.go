@@ -0,0 +1,35 @@
+package grpc
+
+import (
+	"context"
+	"path"
+	"sync/atomic"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+)
+
+type conflictKey struct{}
+
+// SLIInterceptor times every unary RPC and reports its outcome to record.
+// Booking conflicts share FailedPrecondition with other rejections, so
+// handlers flag them with markConflict. Install it right after
+// UsageInterceptor so the time spent in later interceptors is counted.
+func SLIInterceptor(record func(method, code string, conflict bool, latency time.Duration)) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		start := time.Now()
+		var conflict atomic.Bool
+		resp, err := handler(context.WithValue(ctx, conflictKey{}, &conflict), req)
+		record(path.Base(info.FullMethod), status.Code(err).String(), conflict.Load(), time.Since(start))
+		return resp, err
+	}
+}
+
+// markConflict records that the RPC lost a booking conflict. It does
+// nothing outside SLIInterceptor.
+func markConflict(ctx context.Context) {
+	if c, ok := ctx.Value(conflictKey{}).(*atomic.Bool); ok {
+		c.Store(true)
+	}
+}
@@ -0,0 +1,24 @@
+package grpc
+
+import (
+	"context"
+	"path"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+)
+
+// UsageInterceptor reports every unary RPC's outcome to record, with the
+// request's user id when it names one. Install it first in the chain so
+// rejections by later interceptors are counted too.
+func UsageInterceptor(record func(userID, method, code string)) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		resp, err := handler(ctx, req)
+		var userID string
+		if r, ok := req.(interface{ GetUserId() string }); ok {
+			userID = r.GetUserId()
+		}
+		record(userID, path.Base(info.FullMethod), status.Code(err).String())
+		return resp, err
+	}
+}
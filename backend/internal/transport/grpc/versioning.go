@@ -0,0 +1,82 @@
+package grpc
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	schedulev1 "schedula/backend/internal/gen/proto/schedula/v1"
+	schedulev2 "schedula/backend/internal/gen/proto/schedula/v2"
+)
+
+const (
+	// APIVersionHeader is set on every response to the version of the
+	// package that served it. A client may send it on a request to pin a
+	// version; a call to a method of another version is then rejected
+	// rather than silently served with different semantics.
+	APIVersionHeader = "schedula-api-version"
+	// DeprecatedByHeader names the method that replaces a deprecated one.
+	DeprecatedByHeader = "schedula-deprecated-by"
+)
+
+// SupportedAPIVersions are the proto package versions this server registers.
+var SupportedAPIVersions = []string{"v1", "v2"}
+
+// DeprecatedMethods maps v1 methods to the v2 methods that replace them.
+// A v1 method stays served until it is removed from this map and its
+// package; listing it here only advertises the replacement.
+var DeprecatedMethods = map[string]string{
+	schedulev1.AppointmentsService_DeleteAppointment_FullMethodName: schedulev2.AppointmentsService_DeleteAppointment_FullMethodName,
+	schedulev1.AppointmentsService_ListAppointments_FullMethodName:  schedulev2.AppointmentsService_ListAppointments_FullMethodName,
+}
+
+// APIVersionInterceptor applies the version negotiation convention: it
+// reports the serving version and any replacement on the response headers,
+// and rejects requests that pin a version the method does not belong to.
+func APIVersionInterceptor(deprecated map[string]string) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		version := methodAPIVersion(info.FullMethod)
+		if version == "" {
+			return handler(ctx, req)
+		}
+		header := metadata.Pairs(APIVersionHeader, version)
+		replacement := deprecated[info.FullMethod]
+		if replacement != "" {
+			header.Set(DeprecatedByHeader, replacement)
+		}
+		_ = grpc.SetHeader(ctx, header)
+
+		md, _ := metadata.FromIncomingContext(ctx)
+		if want := md.Get(APIVersionHeader); len(want) > 0 && want[0] != version {
+			if !slices.Contains(SupportedAPIVersions, want[0]) {
+				return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("%s %q is not supported; use one of %s.", APIVersionHeader, want[0], strings.Join(SupportedAPIVersions, ", ")))
+			}
+			msg := fmt.Sprintf("%s is a %s method but the request asked for %s.", info.FullMethod, version, want[0])
+			if replacement != "" && methodAPIVersion(replacement) == want[0] {
+				msg += fmt.Sprintf(" Call %s instead.", replacement)
+			}
+			return nil, status.Error(codes.FailedPrecondition, msg)
+		}
+		return handler(ctx, req)
+	}
+}
+
+// methodAPIVersion returns the version segment of a schedula method's
+// package, e.g. "v1" for /schedula.v1.AppointmentsService/ListAppointments.
+func methodAPIVersion(fullMethod string) string {
+	rest, ok := strings.CutPrefix(fullMethod, "/schedula.")
+	if !ok {
+		return ""
+	}
+	version, _, ok := strings.Cut(rest, ".")
+	if !ok {
+		return ""
+	}
+	return version
+}
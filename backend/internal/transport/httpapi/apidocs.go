@@ -0,0 +1,164 @@
+package httpapi
+
+import (
+	"log/slog"
+	"net/http"
+
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/descriptorpb"
+)
+
+// API documentation paths. The descriptor set is what protoc and grpcurl
+// read; the JSON form is the same set for tools without protobuf support.
+const (
+	APIDescriptorsPath     = "/api/descriptors"
+	APIDescriptorsJSONPath = "/api/descriptors.json"
+	APIExplorerPath        = "/api/docs"
+)
+
+// apiDocsMaxAge lets clients reuse the descriptors briefly. They only change
+// with a deploy.
+const apiDocsMaxAge = "public, max-age=300"
+
+type apiDocs struct {
+	binary []byte
+	json   []byte
+}
+
+// NewAPIDocsHandler serves files and the files they import as a
+// FileDescriptorSet at APIDescriptorsPath, in JSON at APIDescriptorsJSONPath
+// and, when explorer is set, as a browsable page at APIExplorerPath. The set
+// is built once, so a bad descriptor fails at startup rather than per
+// request.
+func NewAPIDocsHandler(files []protoreflect.FileDescriptor, explorer bool, log *slog.Logger) (http.Handler, error) {
+	set := descriptorSet(files)
+	binary, err := proto.MarshalOptions{Deterministic: true}.Marshal(set)
+	if err != nil {
+		return nil, err
+	}
+	asJSON, err := protojson.Marshal(set)
+	if err != nil {
+		return nil, err
+	}
+	docs := apiDocs{binary: binary, json: asJSON}
+
+	mux := http.NewServeMux()
+	mux.Handle(APIDescriptorsPath, docs.serve("application/x-protobuf", docs.binary))
+	mux.Handle(APIDescriptorsJSONPath, docs.serve("application/json", docs.json))
+	if explorer {
+		mux.Handle(APIExplorerPath, docs.serve("text/html; charset=utf-8", []byte(apiExplorerPage)))
+	}
+	log.Debug("api docs ready", slog.Int("files", len(set.File)), slog.Bool("explorer", explorer))
+	return mux, nil
+}
+
+func (apiDocs) serve(contentType string, body []byte) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet && r.Method != http.MethodHead {
+			w.Header().Set("Allow", "GET, HEAD")
+			writeError(w, http.StatusMethodNotAllowed, "method not allowed")
+			return
+		}
+		w.Header().Set("Content-Type", contentType)
+		w.Header().Set("Cache-Control", apiDocsMaxAge)
+		if r.Method == http.MethodHead {
+			return
+		}
+		_, _ = w.Write(body)
+	})
+}
+
+// descriptorSet lists every file reachable from files, imports before the
+// files importing them, as protoc orders a set built with
+// --include_imports.
+func descriptorSet(files []protoreflect.FileDescriptor) *descriptorpb.FileDescriptorSet {
+	set := &descriptorpb.FileDescriptorSet{}
+	seen := make(map[string]bool)
+	var add func(fd protoreflect.FileDescriptor)
+	add = func(fd protoreflect.FileDescriptor) {
+		if seen[fd.Path()] {
+			return
+		}
+		seen[fd.Path()] = true
+		imports := fd.Imports()
+		for i := 0; i < imports.Len(); i++ {
+			add(imports.Get(i).FileDescriptor)
+		}
+		set.File = append(set.File, protodesc.ToFileDescriptorProto(fd))
+	}
+	for _, fd := range files {
+		add(fd)
+	}
+	return set
+}
+
+// apiExplorerPage renders APIDescriptorsJSONPath in the browser: every
+// service and method, with a method's request and response fields shown on
+// click. It loads nothing from outside the server.
+const apiExplorerPage = `<!doctype html>
+<html lang="en">
+<head>
+<meta charset="utf-8">
+<title>Schedula API</title>
+<style>
+body { font: 14px/1.4 system-ui, sans-serif; margin: 2rem; max-width: 60rem; }
+input { width: 100%; padding: .4rem; margin-bottom: 1rem; }
+h2 { font-size: 1.1rem; margin-top: 1.5rem; }
+details { margin: .2rem 0 .2rem 1rem; }
+summary { cursor: pointer; font-family: ui-monospace, monospace; }
+table { border-collapse: collapse; margin: .3rem 0 .6rem 1rem; }
+td { padding: .1rem .6rem; font-family: ui-monospace, monospace; }
+.muted { color: #666; }
+</style>
+</head>
+<body>
+<h1>Schedula API</h1>
+<p class="muted">Descriptors: <a href="descriptors">binary</a> · <a href="descriptors.json">JSON</a></p>
+<input id="filter" type="search" placeholder="Filter methods" autofocus>
+<div id="services">Loading…</div>
+<script>
+(async function () {
+  const set = await (await fetch("descriptors.json")).json();
+  const messages = {};
+  const services = [];
+  const collect = (prefix, list) => (list || []).forEach((m) => {
+    const name = prefix + "." + m.name;
+    messages[name] = m;
+    collect(name, m.nestedType);
+  });
+  for (const file of set.file) {
+    const pkg = file.package ? "." + file.package : "";
+    collect(pkg, file.messageType);
+    (file.service || []).forEach((s) => services.push({ name: pkg.slice(1) + "." + s.name, methods: s.method || [] }));
+  }
+  const fieldType = (f) => (f.typeName ? f.typeName.slice(1) : f.type.replace("TYPE_", "").toLowerCase());
+  const fields = (typeName) => {
+    const m = messages[typeName];
+    if (!m || !m.field) return "<p class=\"muted\">No fields.</p>";
+    return "<table>" + m.field.map((f) =>
+      "<tr><td>" + f.number + "</td><td>" + f.name + "</td><td>" +
+      (f.label === "LABEL_REPEATED" ? "repeated " : "") + fieldType(f) + "</td></tr>").join("") + "</table>";
+  };
+  const render = (query) => {
+    query = query.toLowerCase();
+    document.getElementById("services").innerHTML = services.map((s) => {
+      const methods = s.methods.filter((m) => (s.name + "/" + m.name).toLowerCase().includes(query));
+      if (!methods.length) return "";
+      return "<h2>" + s.name + "</h2>" + methods.map((m) =>
+        "<details><summary>" + m.name + "(" + m.inputType.slice(1) + ") → " +
+        (m.serverStreaming ? "stream " : "") + m.outputType.slice(1) + "</summary>" +
+        "<strong>Request</strong>" + fields(m.inputType) +
+        "<strong>Response</strong>" + fields(m.outputType) + "</details>").join("");
+    }).join("") || "<p class=\"muted\">No methods match.</p>";
+  };
+  const filter = document.getElementById("filter");
+  filter.addEventListener("input", () => render(filter.value));
+  render("");
+})();
+</script>
+</body>
+</html>
+`
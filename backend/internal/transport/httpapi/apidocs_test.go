@@ -0,0 +1,86 @@
+package httpapi
+
+import (
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/descriptorpb"
+
+	schedulev1 "schedula/backend/internal/gen/proto/schedula/v1"
+)
+
+func newTestAPIDocs(t *testing.T, explorer bool) http.Handler {
+	t.Helper()
+	h, err := NewAPIDocsHandler([]protoreflect.FileDescriptor{schedulev1.File_proto_schedula_v1_appointments_proto}, explorer, slog.Default())
+	if err != nil {
+		t.Fatalf("NewAPIDocsHandler error: %v", err)
+	}
+	return h
+}
+
+func TestAPIDocs_ServesDescriptorSetWithImportsFirst(t *testing.T) {
+	h := newTestAPIDocs(t, false)
+
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, APIDescriptorsPath, nil))
+	if rec.Code != http.StatusOK {
+		t.Fatalf("status = %d, want 200", rec.Code)
+	}
+	var set descriptorpb.FileDescriptorSet
+	if err := proto.Unmarshal(rec.Body.Bytes(), &set); err != nil {
+		t.Fatalf("unmarshal descriptor set: %v", err)
+	}
+	seen := make(map[string]bool)
+	for _, f := range set.File {
+		for _, dep := range f.Dependency {
+			if !seen[dep] {
+				t.Fatalf("%s is listed before its import %s", f.GetName(), dep)
+			}
+		}
+		seen[f.GetName()] = true
+	}
+	if last := set.File[len(set.File)-1]; last.GetName() != schedulev1.File_proto_schedula_v1_appointments_proto.Path() {
+		t.Fatalf("last file = %s, want the appointments proto", last.GetName())
+	}
+	if !seen["google/protobuf/timestamp.proto"] {
+		t.Fatalf("files = %v, want the imported timestamp proto", seen)
+	}
+}
+
+func TestAPIDocs_ServesJSON(t *testing.T) {
+	h := newTestAPIDocs(t, false)
+
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, APIDescriptorsJSONPath, nil))
+	if rec.Code != http.StatusOK || rec.Header().Get("Content-Type") != "application/json" {
+		t.Fatalf("status = %d, content type %q", rec.Code, rec.Header().Get("Content-Type"))
+	}
+	if !strings.Contains(rec.Body.String(), `"AppointmentsService"`) {
+		t.Fatalf("body does not name AppointmentsService")
+	}
+
+	rec = httptest.NewRecorder()
+	h.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, APIDescriptorsJSONPath, nil))
+	if rec.Code != http.StatusMethodNotAllowed {
+		t.Fatalf("POST status = %d, want 405", rec.Code)
+	}
+}
+
+func TestAPIDocs_ExplorerFollowsConfig(t *testing.T) {
+	rec := httptest.NewRecorder()
+	newTestAPIDocs(t, false).ServeHTTP(rec, httptest.NewRequest(http.MethodGet, APIExplorerPath, nil))
+	if rec.Code != http.StatusNotFound {
+		t.Fatalf("explorer off: status = %d, want 404", rec.Code)
+	}
+
+	rec = httptest.NewRecorder()
+	newTestAPIDocs(t, true).ServeHTTP(rec, httptest.NewRequest(http.MethodGet, APIExplorerPath, nil))
+	if rec.Code != http.StatusOK || !strings.HasPrefix(rec.Header().Get("Content-Type"), "text/html") {
+		t.Fatalf("explorer on: status = %d, content type %q", rec.Code, rec.Header().Get("Content-Type"))
+	}
+}
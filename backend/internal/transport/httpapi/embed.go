@@ -0,0 +1,147 @@
+// Package httpapi serves the plain HTTP endpoints that cannot go through
+// gRPC, such as the public slot feed behind embeddable booking widgets.
+package httpapi
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"net/http"
+	"strconv"
+	"time"
+
+	"schedula/backend/internal/service/appointments"
+	"schedula/backend/internal/store"
+)
+
+// EmbedSlotsPath is where widgets fetch a user's open slots.
+const EmbedSlotsPath = "/embed/v1/slots"
+
+// DefaultEmbedMaxAge is how long browsers and CDNs may reuse a slot response.
+// Slots move as soon as someone books, so this stays short.
+const DefaultEmbedMaxAge = time.Minute
+
+type EmbedService interface {
+	EmbedSlots(ctx context.Context, token string, windowStart, windowEnd time.Time) (appointments.EmbedAvailability, error)
+}
+
+type embedHandler struct {
+	svc    EmbedService
+	maxAge time.Duration
+	log    *slog.Logger
+}
+
+// NewEmbedHandler serves GET EmbedSlotsPath?token=...&start=...&end=..., with
+// start and end as optional RFC 3339 times. Responses may be read from any
+// origin and carry an ETag so polling widgets get 304s while nothing changes.
+func NewEmbedHandler(svc EmbedService, maxAge time.Duration, log *slog.Logger) http.Handler {
+	if maxAge <= 0 {
+		maxAge = DefaultEmbedMaxAge
+	}
+	mux := http.NewServeMux()
+	mux.Handle(EmbedSlotsPath, &embedHandler{svc: svc, maxAge: maxAge, log: log.With(slog.String("route", EmbedSlotsPath))})
+	return mux
+}
+
+type embedSlot struct {
+	Start time.Time `json:"start"`
+	End   time.Time `json:"end"`
+}
+
+type embedResponse struct {
+	SlotMinutes int         `json:"slot_minutes"`
+	TimeZone    string      `json:"time_zone,omitempty"`
+	Slots       []embedSlot `json:"slots"`
+}
+
+func (h *embedHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Access-Control-Allow-Origin", "*")
+	if r.Method != http.MethodGet && r.Method != http.MethodHead {
+		w.Header().Set("Allow", "GET, HEAD")
+		writeError(w, http.StatusMethodNotAllowed, "method not allowed")
+		return
+	}
+
+	q := r.URL.Query()
+	start, ok := optionalTime(q.Get("start"))
+	if !ok {
+		writeError(w, http.StatusBadRequest, "start must be an RFC 3339 time")
+		return
+	}
+	end, ok := optionalTime(q.Get("end"))
+	if !ok {
+		writeError(w, http.StatusBadRequest, "end must be an RFC 3339 time")
+		return
+	}
+
+	avail, err := h.svc.EmbedSlots(r.Context(), q.Get("token"), start, end)
+	if err != nil {
+		h.writeServiceError(w, err)
+		return
+	}
+
+	resp := embedResponse{SlotMinutes: int(avail.SlotDuration / time.Minute), TimeZone: avail.TimeZone, Slots: make([]embedSlot, 0, len(avail.Slots))}
+	for _, s := range avail.Slots {
+		resp.Slots = append(resp.Slots, embedSlot{Start: s.Start, End: s.End})
+	}
+	body, err := json.Marshal(resp)
+	if err != nil {
+		h.log.Error("embed slots encode failed", slog.Any("err", err))
+		writeError(w, http.StatusInternalServerError, "internal error")
+		return
+	}
+
+	sum := sha256.Sum256(body)
+	etag := `"` + hex.EncodeToString(sum[:16]) + `"`
+	// Never let a cache outlive the token it was fetched with.
+	maxAge := min(h.maxAge, time.Until(avail.ExpiresAt))
+	w.Header().Set("ETag", etag)
+	w.Header().Set("Cache-Control", "public, max-age="+strconv.Itoa(max(int(maxAge/time.Second), 0)))
+	if r.Header.Get("If-None-Match") == etag {
+		w.WriteHeader(http.StatusNotModified)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Content-Length", strconv.Itoa(len(body)))
+	w.WriteHeader(http.StatusOK)
+	if r.Method == http.MethodGet {
+		_, _ = w.Write(body)
+	}
+}
+
+func (h *embedHandler) writeServiceError(w http.ResponseWriter, err error) {
+	var vErr *appointments.ValidationError
+	switch {
+	case errors.Is(err, appointments.ErrEmbedDisabled):
+		writeError(w, http.StatusNotFound, "not found")
+	case errors.Is(err, appointments.ErrInvalidEmbedToken):
+		writeError(w, http.StatusUnauthorized, err.Error())
+	case errors.As(err, &vErr):
+		writeError(w, http.StatusBadRequest, vErr.Error())
+	case errors.Is(err, store.ErrUnavailable), errors.Is(err, context.DeadlineExceeded):
+		h.log.Warn("embed slots failed; retryable", slog.Any("err", err))
+		w.Header().Set("Retry-After", "1")
+		writeError(w, http.StatusServiceUnavailable, "temporarily unavailable")
+	default:
+		h.log.Error("embed slots failed", slog.Any("err", err))
+		writeError(w, http.StatusInternalServerError, "internal error")
+	}
+}
+
+func writeError(w http.ResponseWriter, code int, msg string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Cache-Control", "no-store")
+	w.WriteHeader(code)
+	_ = json.NewEncoder(w).Encode(map[string]string{"error": msg})
+}
+
+func optionalTime(raw string) (time.Time, bool) {
+	if raw == "" {
+		return time.Time{}, true
+	}
+	t, err := time.Parse(time.RFC3339, raw)
+	return t, err == nil
+}
@@ -0,0 +1,75 @@
+package httpapi
+
+import (
+	"context"
+	"encoding/json"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"schedula/backend/internal/scheduling"
+	"schedula/backend/internal/service/appointments"
+)
+
+type fakeEmbedService struct {
+	embedSlotsFn func(ctx context.Context, token string, windowStart, windowEnd time.Time) (appointments.EmbedAvailability, error)
+}
+
+func (f *fakeEmbedService) EmbedSlots(ctx context.Context, token string, windowStart, windowEnd time.Time) (appointments.EmbedAvailability, error) {
+	if f.embedSlotsFn == nil {
+		panic("EmbedSlots not configured")
+	}
+	return f.embedSlotsFn(ctx, token, windowStart, windowEnd)
+}
+
+func TestEmbedSlots_ServesJSONWithETag(t *testing.T) {
+	start := time.Date(2030, 1, 7, 9, 0, 0, 0, time.UTC)
+	h := NewEmbedHandler(&fakeEmbedService{
+		embedSlotsFn: func(ctx context.Context, token string, windowStart, windowEnd time.Time) (appointments.EmbedAvailability, error) {
+			if token != "good" {
+				return appointments.EmbedAvailability{}, appointments.ErrInvalidEmbedToken
+			}
+			if !windowStart.Equal(start) || !windowEnd.IsZero() {
+				t.Fatalf("window = %v..%v", windowStart, windowEnd)
+			}
+			return appointments.EmbedAvailability{
+				Slots:        []scheduling.Suggestion{{Start: start, End: start.Add(30 * time.Minute)}},
+				SlotDuration: 30 * time.Minute,
+				ExpiresAt:    time.Now().Add(time.Hour),
+			}, nil
+		},
+	}, 0, slog.Default())
+
+	target := EmbedSlotsPath + "?token=good&start=" + start.Format(time.RFC3339)
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, target, nil))
+	if rec.Code != http.StatusOK {
+		t.Fatalf("status = %d, body %s", rec.Code, rec.Body)
+	}
+	if got := rec.Header().Get("Cache-Control"); got != "public, max-age=60" {
+		t.Fatalf("Cache-Control = %q", got)
+	}
+	var resp embedResponse
+	if err := json.Unmarshal(rec.Body.Bytes(), &resp); err != nil {
+		t.Fatalf("decode: %v", err)
+	}
+	if resp.SlotMinutes != 30 || len(resp.Slots) != 1 || !resp.Slots[0].Start.Equal(start) {
+		t.Fatalf("resp = %+v", resp)
+	}
+
+	req := httptest.NewRequest(http.MethodGet, target, nil)
+	req.Header.Set("If-None-Match", rec.Header().Get("ETag"))
+	rec = httptest.NewRecorder()
+	h.ServeHTTP(rec, req)
+	if rec.Code != http.StatusNotModified {
+		t.Fatalf("conditional status = %d, want %d", rec.Code, http.StatusNotModified)
+	}
+
+	rec = httptest.NewRecorder()
+	h.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, EmbedSlotsPath+"?token=bad", nil))
+	if rec.Code != http.StatusUnauthorized {
+		t.Fatalf("bad token status = %d, want %d", rec.Code, http.StatusUnauthorized)
+	}
+}
@@ -0,0 +1,70 @@
+package httpapi
+
+import (
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+
+	"schedula/backend/internal/domain"
+)
+
+// MetricsPath is where Prometheus scrapes request counters and latency.
+const MetricsPath = "/metrics"
+
+type MetricsWriter interface {
+	WriteMetrics(w io.Writer) error
+}
+
+// MetricsWriters writes each writer's metrics in turn.
+type MetricsWriters []MetricsWriter
+
+func (ws MetricsWriters) WriteMetrics(w io.Writer) error {
+	for _, m := range ws {
+		if err := m.WriteMetrics(w); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// NewMetricsHandler serves GET MetricsPath in the Prometheus text format.
+func NewMetricsHandler(m MetricsWriter, log *slog.Logger) http.Handler {
+	log = log.With(slog.String("route", MetricsPath))
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet && r.Method != http.MethodHead {
+			w.Header().Set("Allow", "GET, HEAD")
+			http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+		w.Header().Set("Content-Type", "text/plain; version=0.0.4; charset=utf-8")
+		if err := m.WriteMetrics(w); err != nil {
+			log.Warn("metrics write failed", slog.Any("err", err))
+		}
+	})
+}
+
+// ExpansionLimitMetrics reports the recurring series expansions stopped by
+// domain.ExpansionLimits since the process started.
+type ExpansionLimitMetrics struct{}
+
+func (ExpansionLimitMetrics) WriteMetrics(w io.Writer) error {
+	_, err := fmt.Fprintf(w, "# HELP schedula_occurrence_expansion_limit_total Recurring series expansions stopped by the expansion limits.\n"+
+		"# TYPE schedula_occurrence_expansion_limit_total counter\n"+
+		"schedula_occurrence_expansion_limit_total %d\n", domain.ExpansionLimitHits())
+	return err
+}
+
+// LegacyOccurrenceIDMetrics reports the legacy occurrence ids accepted
+// during the deprecation window and rejected after it, so operators can see
+// when clients have moved off them.
+type LegacyOccurrenceIDMetrics struct{}
+
+func (LegacyOccurrenceIDMetrics) WriteMetrics(w io.Writer) error {
+	accepted, rejected := domain.LegacyOccurrenceIDCounts()
+	_, err := fmt.Fprintf(w, "# HELP schedula_legacy_occurrence_id_total Occurrence ids received in the pre-Decision 39 timestamp format.\n"+
+		"# TYPE schedula_legacy_occurrence_id_total counter\n"+
+		"schedula_legacy_occurrence_id_total{outcome=\"accepted\"} %d\n"+
+		"schedula_legacy_occurrence_id_total{outcome=\"rejected\"} %d\n", accepted, rejected)
+	return err
+}
@@ -0,0 +1,224 @@
+package httpapi
+
+import (
+	"compress/gzip"
+	"context"
+	"log/slog"
+	"net/http"
+	"runtime/debug"
+	"slices"
+	"strings"
+	"time"
+)
+
+// MiddlewareConfig configures the stack every HTTP listener runs.
+type MiddlewareConfig struct {
+	// CORSOrigins lists the origins allowed to read responses from a
+	// browser; "*" allows any. Empty sends no CORS headers, though a handler
+	// may still set its own, as the public embed feed does.
+	CORSOrigins []string
+	// RequestTimeout bounds each request's context. Zero leaves it unbounded.
+	RequestTimeout time.Duration
+}
+
+// Middleware wraps a handler in the shared stack, outermost first: request
+// logging, panic recovery, CORS, gzip and the request timeout.
+func Middleware(cfg MiddlewareConfig, log *slog.Logger) func(http.Handler) http.Handler {
+	return func(h http.Handler) http.Handler {
+		h = Timeout(cfg.RequestTimeout)(h)
+		h = Gzip(h)
+		h = CORS(cfg.CORSOrigins)(h)
+		h = Recover(log)(h)
+		return LogRequests(log)(h)
+	}
+}
+
+// statusWriter remembers the status and size of a response.
+type statusWriter struct {
+	http.ResponseWriter
+	status int
+	bytes  int
+}
+
+func (w *statusWriter) WriteHeader(code int) {
+	if w.status == 0 {
+		w.status = code
+	}
+	w.ResponseWriter.WriteHeader(code)
+}
+
+func (w *statusWriter) Write(b []byte) (int, error) {
+	if w.status == 0 {
+		w.status = http.StatusOK
+	}
+	n, err := w.ResponseWriter.Write(b)
+	w.bytes += n
+	return n, err
+}
+
+// LogRequests logs each request once it completes: at debug level, or warn
+// for server errors. The query string is left out since it may carry tokens.
+func LogRequests(log *slog.Logger) func(http.Handler) http.Handler {
+	return func(h http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			started := time.Now()
+			sw := &statusWriter{ResponseWriter: w}
+			h.ServeHTTP(sw, r)
+			if sw.status == 0 {
+				sw.status = http.StatusOK
+			}
+			level := slog.LevelDebug
+			if sw.status >= http.StatusInternalServerError {
+				level = slog.LevelWarn
+			}
+			log.LogAttrs(r.Context(), level, "http request",
+				slog.String("method", r.Method),
+				slog.String("path", r.URL.Path),
+				slog.Int("status", sw.status),
+				slog.Int("bytes", sw.bytes),
+				slog.Duration("duration", time.Since(started)),
+			)
+		})
+	}
+}
+
+// Recover turns a handler panic into a 500 and logs it with its stack,
+// rather than letting net/http drop the connection.
+func Recover(log *slog.Logger) func(http.Handler) http.Handler {
+	return func(h http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			sw := &statusWriter{ResponseWriter: w}
+			defer func() {
+				rec := recover()
+				if rec == nil {
+					return
+				}
+				if rec == http.ErrAbortHandler {
+					panic(rec)
+				}
+				log.Error("http handler panicked", slog.Any("panic", rec), slog.String("path", r.URL.Path), slog.String("stack", string(debug.Stack())))
+				if sw.status == 0 {
+					writeError(sw, http.StatusInternalServerError, "internal error")
+				}
+			}()
+			h.ServeHTTP(sw, r)
+		})
+	}
+}
+
+// CORS lets the listed origins read responses and answers their preflight
+// requests. Requests from other origins are served without CORS headers, so
+// browsers keep the response from the page.
+func CORS(origins []string) func(http.Handler) http.Handler {
+	anyOrigin := slices.Contains(origins, "*")
+	return func(h http.Handler) http.Handler {
+		if len(origins) == 0 {
+			return h
+		}
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			origin := r.Header.Get("Origin")
+			if origin == "" {
+				h.ServeHTTP(w, r)
+				return
+			}
+			allowOrigin := "*"
+			if !anyOrigin {
+				w.Header().Add("Vary", "Origin")
+				if !slices.Contains(origins, origin) {
+					h.ServeHTTP(w, r)
+					return
+				}
+				allowOrigin = origin
+			}
+			w.Header().Set("Access-Control-Allow-Origin", allowOrigin)
+			w.Header().Set("Access-Control-Expose-Headers", "ETag, Retry-After")
+			if r.Method == http.MethodOptions && r.Header.Get("Access-Control-Request-Method") != "" {
+				w.Header().Set("Access-Control-Allow-Methods", "GET, HEAD, OPTIONS")
+				if headers := r.Header.Get("Access-Control-Request-Headers"); headers != "" {
+					w.Header().Set("Access-Control-Allow-Headers", headers)
+				}
+				w.Header().Set("Access-Control-Max-Age", "600")
+				w.WriteHeader(http.StatusNoContent)
+				return
+			}
+			h.ServeHTTP(w, r)
+		})
+	}
+}
+
+// gzipWriter compresses the body once the handler commits to a status that
+// has one.
+type gzipWriter struct {
+	http.ResponseWriter
+	gz          *gzip.Writer
+	wroteHeader bool
+}
+
+func (w *gzipWriter) WriteHeader(code int) {
+	if w.wroteHeader {
+		return
+	}
+	w.wroteHeader = true
+	hdr := w.Header()
+	if code >= http.StatusOK && code != http.StatusNoContent && code != http.StatusNotModified && hdr.Get("Content-Encoding") == "" {
+		hdr.Del("Content-Length")
+		hdr.Set("Content-Encoding", "gzip")
+		w.gz = gzip.NewWriter(w.ResponseWriter)
+	}
+	w.ResponseWriter.WriteHeader(code)
+}
+
+func (w *gzipWriter) Write(b []byte) (int, error) {
+	if !w.wroteHeader {
+		w.WriteHeader(http.StatusOK)
+	}
+	if w.gz == nil {
+		return w.ResponseWriter.Write(b)
+	}
+	return w.gz.Write(b)
+}
+
+// Gzip compresses responses for clients that accept it. HEAD requests are
+// passed through so their headers match an uncompressed GET.
+func Gzip(h http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Add("Vary", "Accept-Encoding")
+		if r.Method == http.MethodHead || !acceptsGzip(r.Header.Get("Accept-Encoding")) {
+			h.ServeHTTP(w, r)
+			return
+		}
+		gw := &gzipWriter{ResponseWriter: w}
+		defer func() {
+			if gw.gz != nil {
+				_ = gw.gz.Close()
+			}
+		}()
+		h.ServeHTTP(gw, r)
+	})
+}
+
+func acceptsGzip(header string) bool {
+	for _, part := range strings.Split(header, ",") {
+		coding, params, _ := strings.Cut(strings.TrimSpace(part), ";")
+		if !strings.EqualFold(strings.TrimSpace(coding), "gzip") {
+			continue
+		}
+		return strings.ReplaceAll(strings.TrimSpace(params), " ", "") != "q=0"
+	}
+	return false
+}
+
+// Timeout bounds the request's context, so a handler's store calls fail with
+// context.DeadlineExceeded and it answers 503 like any other retryable error.
+func Timeout(d time.Duration) func(http.Handler) http.Handler {
+	return func(h http.Handler) http.Handler {
+		if d <= 0 {
+			return h
+		}
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			ctx, cancel := context.WithTimeout(r.Context(), d)
+			defer cancel()
+			h.ServeHTTP(w, r.WithContext(ctx))
+		})
+	}
+}
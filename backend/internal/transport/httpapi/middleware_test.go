@@ -0,0 +1,71 @@
+package httpapi
+
+import (
+	"compress/gzip"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+)
+
+func TestMiddleware_CORSFromConfig(t *testing.T) {
+	h := Middleware(MiddlewareConfig{CORSOrigins: []string{"https://app.example.com"}}, slog.Default())(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+
+	preflight := httptest.NewRequest(http.MethodOptions, "/metrics", nil)
+	preflight.Header.Set("Origin", "https://app.example.com")
+	preflight.Header.Set("Access-Control-Request-Method", http.MethodGet)
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, preflight)
+	if rec.Code != http.StatusNoContent || rec.Header().Get("Access-Control-Allow-Origin") != "https://app.example.com" {
+		t.Fatalf("preflight = %d, headers %v", rec.Code, rec.Header())
+	}
+
+	other := httptest.NewRequest(http.MethodGet, "/metrics", nil)
+	other.Header.Set("Origin", "https://evil.example.com")
+	rec = httptest.NewRecorder()
+	h.ServeHTTP(rec, other)
+	if rec.Code != http.StatusOK || rec.Header().Get("Access-Control-Allow-Origin") != "" {
+		t.Fatalf("unlisted origin = %d, headers %v", rec.Code, rec.Header())
+	}
+}
+
+func TestMiddleware_GzipDropsContentLength(t *testing.T) {
+	body := `{"slots":[]}`
+	h := Middleware(MiddlewareConfig{}, slog.Default())(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Length", strconv.Itoa(len(body)))
+		w.WriteHeader(http.StatusOK)
+		_, _ = io.WriteString(w, body)
+	}))
+
+	req := httptest.NewRequest(http.MethodGet, EmbedSlotsPath, nil)
+	req.Header.Set("Accept-Encoding", "br, gzip")
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, req)
+	if rec.Header().Get("Content-Encoding") != "gzip" || rec.Header().Get("Content-Length") != "" {
+		t.Fatalf("headers = %v, want gzip without Content-Length", rec.Header())
+	}
+	zr, err := gzip.NewReader(rec.Body)
+	if err != nil {
+		t.Fatalf("gzip.NewReader: %v", err)
+	}
+	got, err := io.ReadAll(zr)
+	if err != nil || string(got) != body {
+		t.Fatalf("body = %q, %v; want %q", got, err, body)
+	}
+}
+
+func TestMiddleware_RecoversPanic(t *testing.T) {
+	h := Middleware(MiddlewareConfig{}, slog.Default())(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		panic("boom")
+	}))
+
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, MetricsPath, nil))
+	if rec.Code != http.StatusInternalServerError {
+		t.Fatalf("status = %d, want %d", rec.Code, http.StatusInternalServerError)
+	}
+}
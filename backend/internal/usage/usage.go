@@ -0,0 +1,229 @@
+// Package usage counts API requests per user over a rolling window, for
+// abuse investigation and usage dashboards, and per method and status code
+// for metrics scrapes. Counts are kept in memory and cover this process only.
+package usage
+
+import (
+	"fmt"
+	"io"
+	"maps"
+	"slices"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	DefaultWindow   = time.Hour
+	DefaultBucket   = time.Minute
+	DefaultMaxUsers = 10000
+)
+
+// OverflowUser collects requests from users that arrive once MaxUsers users
+// are already being tracked, so a flood of ids cannot grow memory unbounded.
+const OverflowUser = "_other"
+
+type Counts struct {
+	Requests int64
+	Errors   int64
+}
+
+// UserUsage is one user's requests in the window, in total and per method.
+type UserUsage struct {
+	UserID  string
+	Total   Counts
+	Methods map[string]Counts
+}
+
+type bucket struct {
+	index   int64
+	methods map[string]Counts
+}
+
+type userBuckets struct {
+	ring []bucket
+	last int64
+}
+
+type methodCode struct {
+	method string
+	code   string
+}
+
+// Tracker is safe for concurrent use.
+type Tracker struct {
+	mu        sync.Mutex
+	bucket    time.Duration
+	buckets   int
+	maxUsers  int
+	now       func() time.Time
+	users     map[string]*userBuckets
+	totals    map[methodCode]int64
+	lastSweep int64
+}
+
+// New returns a Tracker whose window is rounded up to whole buckets. Zero
+// values take the defaults.
+func New(window, bucketSize time.Duration, maxUsers int) *Tracker {
+	if window <= 0 {
+		window = DefaultWindow
+	}
+	if bucketSize <= 0 {
+		bucketSize = DefaultBucket
+	}
+	if maxUsers <= 0 {
+		maxUsers = DefaultMaxUsers
+	}
+	n := int((window + bucketSize - 1) / bucketSize)
+	return &Tracker{
+		bucket:   bucketSize,
+		buckets:  n,
+		maxUsers: maxUsers,
+		now:      time.Now,
+		users:    make(map[string]*userBuckets),
+		totals:   make(map[methodCode]int64),
+	}
+}
+
+func (t *Tracker) Window() time.Duration {
+	return time.Duration(t.buckets) * t.bucket
+}
+
+// Record counts one request. code is the status code name, such as "OK" or
+// "NotFound"; anything but "OK" counts as an error. An empty userID is only
+// counted in the method totals.
+func (t *Tracker) Record(userID, method, code string) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.totals[methodCode{method, code}]++
+	if userID == "" {
+		return
+	}
+
+	idx := t.now().UnixNano() / int64(t.bucket)
+	u, ok := t.users[userID]
+	if !ok {
+		if len(t.users) >= t.maxUsers {
+			t.sweep(idx)
+		}
+		if len(t.users) >= t.maxUsers {
+			userID = OverflowUser
+			u = t.users[OverflowUser]
+		}
+		if u == nil {
+			u = &userBuckets{ring: make([]bucket, t.buckets)}
+			t.users[userID] = u
+		}
+	}
+
+	b := &u.ring[idx%int64(t.buckets)]
+	if b.index != idx || b.methods == nil {
+		*b = bucket{index: idx, methods: make(map[string]Counts)}
+	}
+	c := b.methods[method]
+	c.Requests++
+	if code != "OK" {
+		c.Errors++
+	}
+	b.methods[method] = c
+	u.last = idx
+}
+
+// sweep drops users with nothing left in the window. It runs at most once
+// per bucket, since a full tracker would otherwise scan on every new id.
+func (t *Tracker) sweep(idx int64) {
+	if t.lastSweep == idx {
+		return
+	}
+	t.lastSweep = idx
+	for id, u := range t.users {
+		if u.last <= idx-int64(t.buckets) {
+			delete(t.users, id)
+		}
+	}
+}
+
+// User returns userID's usage in the window; a user with no requests has a
+// zero Total and no methods.
+func (t *Tracker) User(userID string) UserUsage {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return t.userUsage(userID, t.now().UnixNano()/int64(t.bucket))
+}
+
+// Top returns up to n users with the most requests in the window, busiest
+// first, with ties broken by user id.
+func (t *Tracker) Top(n int) []UserUsage {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	idx := t.now().UnixNano() / int64(t.bucket)
+	out := make([]UserUsage, 0, len(t.users))
+	for id := range t.users {
+		if u := t.userUsage(id, idx); u.Total.Requests > 0 {
+			out = append(out, u)
+		}
+	}
+	sort.Slice(out, func(i, j int) bool {
+		if out[i].Total.Requests != out[j].Total.Requests {
+			return out[i].Total.Requests > out[j].Total.Requests
+		}
+		return out[i].UserID < out[j].UserID
+	})
+	if n > 0 && len(out) > n {
+		out = out[:n]
+	}
+	return out
+}
+
+func (t *Tracker) userUsage(userID string, idx int64) UserUsage {
+	out := UserUsage{UserID: userID, Methods: map[string]Counts{}}
+	u, ok := t.users[userID]
+	if !ok {
+		return out
+	}
+	for _, b := range u.ring {
+		if b.index <= idx-int64(t.buckets) || b.index > idx {
+			continue
+		}
+		for m, c := range b.methods {
+			sum := out.Methods[m]
+			sum.Requests += c.Requests
+			sum.Errors += c.Errors
+			out.Methods[m] = sum
+			out.Total.Requests += c.Requests
+			out.Total.Errors += c.Errors
+		}
+	}
+	return out
+}
+
+// WriteMetrics writes the method totals and the tracked user count in the
+// Prometheus text exposition format. Users are not labels: their number is
+// unbounded, which is what GetAPIUsage is for.
+func (t *Tracker) WriteMetrics(w io.Writer) error {
+	t.mu.Lock()
+	totals := maps.Clone(t.totals)
+	users := len(t.users)
+	t.mu.Unlock()
+
+	keys := slices.SortedFunc(maps.Keys(totals), func(a, b methodCode) int {
+		if c := strings.Compare(a.method, b.method); c != 0 {
+			return c
+		}
+		return strings.Compare(a.code, b.code)
+	})
+	var sb strings.Builder
+	sb.WriteString("# HELP schedula_grpc_requests_total gRPC requests handled, by method and status code.\n")
+	sb.WriteString("# TYPE schedula_grpc_requests_total counter\n")
+	for _, k := range keys {
+		fmt.Fprintf(&sb, "schedula_grpc_requests_total{method=%q,code=%q} %d\n", k.method, k.code, totals[k])
+	}
+	sb.WriteString("# HELP schedula_usage_tracked_users Users with usage held in the rolling window.\n")
+	sb.WriteString("# TYPE schedula_usage_tracked_users gauge\n")
+	fmt.Fprintf(&sb, "schedula_usage_tracked_users %d\n", users)
+	_, err := io.WriteString(w, sb.String())
+	return err
+}
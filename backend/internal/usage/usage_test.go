@@ -0,0 +1,53 @@
+package usage
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestTracker_RollsWindowAndCapsUsers(t *testing.T) {
+	now := time.Date(2030, 1, 7, 9, 0, 0, 0, time.UTC)
+	tr := New(10*time.Minute, time.Minute, 2)
+	tr.now = func() time.Time { return now }
+
+	tr.Record("u1", "ListAppointments", "OK")
+	tr.Record("u1", "CreateAppointment", "FailedPrecondition")
+	now = now.Add(5 * time.Minute)
+	tr.Record("u1", "ListAppointments", "OK")
+	tr.Record("u2", "ListAppointments", "OK")
+	tr.Record("u3", "ListAppointments", "OK")
+
+	u1 := tr.User("u1")
+	if u1.Total != (Counts{Requests: 3, Errors: 1}) || u1.Methods["ListAppointments"].Requests != 2 {
+		t.Fatalf("u1 = %+v", u1)
+	}
+	if got := tr.User(OverflowUser).Total.Requests; got != 1 {
+		t.Fatalf("overflow requests = %d, want 1", got)
+	}
+
+	// Six minutes on, the first minute has left the window.
+	now = now.Add(6 * time.Minute)
+	if got := tr.User("u1").Total; got != (Counts{Requests: 1}) {
+		t.Fatalf("u1 after roll = %+v, want one request", got)
+	}
+	// All three now have one request, so ties fall back to user id order.
+	top := tr.Top(2)
+	if len(top) != 2 || top[0].UserID != OverflowUser || top[1].UserID != "u1" {
+		t.Fatalf("top = %+v", top)
+	}
+
+	var sb strings.Builder
+	if err := tr.WriteMetrics(&sb); err != nil {
+		t.Fatalf("WriteMetrics error: %v", err)
+	}
+	for _, want := range []string{
+		`schedula_grpc_requests_total{method="CreateAppointment",code="FailedPrecondition"} 1`,
+		`schedula_grpc_requests_total{method="ListAppointments",code="OK"} 4`,
+		"schedula_usage_tracked_users 3",
+	} {
+		if !strings.Contains(sb.String(), want) {
+			t.Fatalf("metrics missing %q:\n%s", want, sb.String())
+		}
+	}
+}
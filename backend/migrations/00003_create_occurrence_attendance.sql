@@ -0,0 +1,19 @@
+-- +goose Up
+CREATE TABLE IF NOT EXISTS occurrence_attendance (
+    id UUID PRIMARY KEY,
+    series_id UUID NOT NULL REFERENCES recurring_series (id) ON DELETE CASCADE,
+    occurrence_start TIMESTAMPTZ NOT NULL,
+    participant_id TEXT NOT NULL,
+    status TEXT NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL,
+    updated_at TIMESTAMPTZ NOT NULL
+);
+
+ALTER TABLE occurrence_attendance
+ADD CONSTRAINT occurrence_attendance_status_check CHECK (status IN ('attended', 'missed'));
+
+CREATE UNIQUE INDEX IF NOT EXISTS occurrence_attendance_series_occurrence_participant_idx
+ON occurrence_attendance (series_id, occurrence_start, participant_id);
+
+-- +goose Down
+DROP TABLE IF EXISTS occurrence_attendance;
@@ -0,0 +1,14 @@
+-- +goose Up
+ALTER TABLE appointments
+ADD COLUMN IF NOT EXISTS metadata JSONB NOT NULL DEFAULT '{}'::jsonb;
+
+ALTER TABLE recurring_series
+ADD COLUMN IF NOT EXISTS metadata JSONB NOT NULL DEFAULT '{}'::jsonb;
+
+CREATE INDEX IF NOT EXISTS appointments_metadata_idx
+ON appointments USING gin (metadata jsonb_path_ops);
+
+-- +goose Down
+DROP INDEX IF EXISTS appointments_metadata_idx;
+ALTER TABLE recurring_series DROP COLUMN IF EXISTS metadata;
+ALTER TABLE appointments DROP COLUMN IF EXISTS metadata;
@@ -0,0 +1,19 @@
+-- +goose Up
+ALTER TABLE appointments
+ADD COLUMN IF NOT EXISTS external_system TEXT NULL;
+
+ALTER TABLE appointments
+ADD COLUMN IF NOT EXISTS external_id TEXT NULL;
+
+ALTER TABLE appointments
+ADD CONSTRAINT appointments_external_ref_complete CHECK ((external_system IS NULL) = (external_id IS NULL));
+
+CREATE UNIQUE INDEX IF NOT EXISTS appointments_external_ref_idx
+ON appointments (user_id, external_system, external_id)
+WHERE external_system IS NOT NULL;
+
+-- +goose Down
+DROP INDEX IF EXISTS appointments_external_ref_idx;
+ALTER TABLE appointments DROP CONSTRAINT IF EXISTS appointments_external_ref_complete;
+ALTER TABLE appointments DROP COLUMN IF EXISTS external_id;
+ALTER TABLE appointments DROP COLUMN IF EXISTS external_system;
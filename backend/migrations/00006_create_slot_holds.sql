@@ -0,0 +1,19 @@
+-- +goose Up
+CREATE TABLE IF NOT EXISTS slot_holds (
+    id UUID PRIMARY KEY,
+    user_id TEXT NOT NULL,
+    start_time TIMESTAMPTZ NOT NULL,
+    end_time TIMESTAMPTZ NOT NULL,
+    expires_at TIMESTAMPTZ NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL
+);
+
+ALTER TABLE slot_holds
+ADD CONSTRAINT slot_holds_valid_time_range CHECK (end_time > start_time);
+
+CREATE INDEX IF NOT EXISTS slot_holds_user_start_time_idx ON slot_holds (user_id, start_time);
+
+CREATE INDEX IF NOT EXISTS slot_holds_expires_at_idx ON slot_holds (expires_at);
+
+-- +goose Down
+DROP TABLE IF EXISTS slot_holds;
@@ -0,0 +1,23 @@
+-- +goose Up
+CREATE TABLE IF NOT EXISTS appointment_links (
+    id UUID PRIMARY KEY,
+    user_id TEXT NOT NULL,
+    appointment_id UUID NOT NULL REFERENCES appointments (id) ON DELETE CASCADE,
+    related_id UUID NOT NULL REFERENCES appointments (id) ON DELETE CASCADE,
+    kind TEXT NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL
+);
+
+ALTER TABLE appointment_links
+ADD CONSTRAINT appointment_links_kind_check CHECK (kind IN ('follow_up_of', 'prep_for'));
+
+ALTER TABLE appointment_links
+ADD CONSTRAINT appointment_links_not_self CHECK (appointment_id <> related_id);
+
+CREATE UNIQUE INDEX IF NOT EXISTS appointment_links_pair_kind_idx
+ON appointment_links (appointment_id, related_id, kind);
+
+CREATE INDEX IF NOT EXISTS appointment_links_related_idx ON appointment_links (related_id);
+
+-- +goose Down
+DROP TABLE IF EXISTS appointment_links;
@@ -0,0 +1,18 @@
+-- +goose Up
+ALTER TABLE recurring_series
+ADD COLUMN IF NOT EXISTS dst_gap_policy TEXT NOT NULL DEFAULT 'shift_forward';
+
+ALTER TABLE recurring_series
+ADD COLUMN IF NOT EXISTS dst_ambiguous_policy TEXT NOT NULL DEFAULT 'earlier';
+
+ALTER TABLE recurring_series
+ADD CONSTRAINT recurring_series_dst_gap_policy_check CHECK (dst_gap_policy IN ('shift_forward', 'skip'));
+
+ALTER TABLE recurring_series
+ADD CONSTRAINT recurring_series_dst_ambiguous_policy_check CHECK (dst_ambiguous_policy IN ('earlier', 'later'));
+
+-- +goose Down
+ALTER TABLE recurring_series DROP CONSTRAINT IF EXISTS recurring_series_dst_ambiguous_policy_check;
+ALTER TABLE recurring_series DROP CONSTRAINT IF EXISTS recurring_series_dst_gap_policy_check;
+ALTER TABLE recurring_series DROP COLUMN IF EXISTS dst_ambiguous_policy;
+ALTER TABLE recurring_series DROP COLUMN IF EXISTS dst_gap_policy;
@@ -0,0 +1,10 @@
+-- +goose Up
+ALTER TABLE recurring_series
+ADD COLUMN IF NOT EXISTS wkst SMALLINT NOT NULL DEFAULT 1;
+
+ALTER TABLE recurring_series
+ADD CONSTRAINT recurring_series_wkst_check CHECK (wkst BETWEEN 1 AND 7);
+
+-- +goose Down
+ALTER TABLE recurring_series DROP CONSTRAINT IF EXISTS recurring_series_wkst_check;
+ALTER TABLE recurring_series DROP COLUMN IF EXISTS wkst;
@@ -0,0 +1,9 @@
+-- +goose Up
+CREATE INDEX IF NOT EXISTS recurring_series_user_dtstart_idx ON recurring_series (user_id, dtstart);
+
+DROP INDEX IF EXISTS recurring_series_user_id_idx;
+
+-- +goose Down
+CREATE INDEX IF NOT EXISTS recurring_series_user_id_idx ON recurring_series (user_id);
+
+DROP INDEX IF EXISTS recurring_series_user_dtstart_idx;
@@ -0,0 +1,6 @@
+-- +goose Up
+ALTER TABLE appointments
+ADD COLUMN IF NOT EXISTS timezone TEXT;
+
+-- +goose Down
+ALTER TABLE appointments DROP COLUMN IF EXISTS timezone;
@@ -0,0 +1,24 @@
+-- +goose Up
+CREATE TABLE IF NOT EXISTS delegation_grants (
+    id UUID PRIMARY KEY,
+    principal_id TEXT NOT NULL,
+    delegate_id TEXT NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL
+);
+
+ALTER TABLE delegation_grants
+ADD CONSTRAINT delegation_grants_not_self CHECK (principal_id <> delegate_id);
+
+CREATE UNIQUE INDEX IF NOT EXISTS delegation_grants_pair_idx
+ON delegation_grants (principal_id, delegate_id);
+
+ALTER TABLE appointments
+ADD COLUMN IF NOT EXISTS created_by TEXT;
+
+ALTER TABLE recurring_series
+ADD COLUMN IF NOT EXISTS created_by TEXT;
+
+-- +goose Down
+ALTER TABLE recurring_series DROP COLUMN IF EXISTS created_by;
+ALTER TABLE appointments DROP COLUMN IF EXISTS created_by;
+DROP TABLE IF EXISTS delegation_grants;
@@ -0,0 +1,20 @@
+-- +goose Up
+CREATE TABLE IF NOT EXISTS blackouts (
+    id UUID PRIMARY KEY,
+    title TEXT NOT NULL,
+    start_time TIMESTAMPTZ NOT NULL,
+    end_time TIMESTAMPTZ NOT NULL,
+    mode TEXT NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL
+);
+
+ALTER TABLE blackouts
+ADD CONSTRAINT blackouts_valid_time_range CHECK (end_time > start_time);
+
+ALTER TABLE blackouts
+ADD CONSTRAINT blackouts_mode_check CHECK (mode IN ('block', 'warn'));
+
+CREATE INDEX IF NOT EXISTS blackouts_start_time_idx ON blackouts (start_time);
+
+-- +goose Down
+DROP TABLE IF EXISTS blackouts;
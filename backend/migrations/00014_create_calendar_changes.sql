@@ -0,0 +1,21 @@
+-- +goose Up
+CREATE TABLE IF NOT EXISTS calendar_changes (
+    seq BIGSERIAL PRIMARY KEY,
+    user_id TEXT NOT NULL,
+    entity_type TEXT NOT NULL,
+    entity_id UUID NOT NULL,
+    op TEXT NOT NULL,
+    changed_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+ALTER TABLE calendar_changes
+ADD CONSTRAINT calendar_changes_entity_type_check CHECK (entity_type IN ('appointment', 'series'));
+
+ALTER TABLE calendar_changes
+ADD CONSTRAINT calendar_changes_op_check CHECK (op IN ('created', 'updated', 'deleted'));
+
+CREATE INDEX IF NOT EXISTS calendar_changes_user_seq_idx
+ON calendar_changes (user_id, seq);
+
+-- +goose Down
+DROP TABLE IF EXISTS calendar_changes;
@@ -0,0 +1,14 @@
+-- +goose Up
+ALTER TABLE appointments
+ADD COLUMN IF NOT EXISTS checked_in_at TIMESTAMPTZ,
+ADD COLUMN IF NOT EXISTS checked_out_at TIMESTAMPTZ;
+
+ALTER TABLE appointments
+ADD CONSTRAINT appointments_check_out_after_check_in
+CHECK (checked_out_at IS NULL OR (checked_in_at IS NOT NULL AND checked_out_at >= checked_in_at));
+
+-- +goose Down
+ALTER TABLE appointments DROP CONSTRAINT IF EXISTS appointments_check_out_after_check_in;
+ALTER TABLE appointments
+DROP COLUMN IF EXISTS checked_out_at,
+DROP COLUMN IF EXISTS checked_in_at;
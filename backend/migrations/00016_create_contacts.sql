@@ -0,0 +1,25 @@
+-- +goose Up
+CREATE TABLE IF NOT EXISTS contacts (
+    id UUID PRIMARY KEY,
+    user_id TEXT NOT NULL,
+    name TEXT NOT NULL,
+    email TEXT,
+    phone TEXT,
+    created_at TIMESTAMPTZ NOT NULL,
+    updated_at TIMESTAMPTZ NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS contacts_user_name_idx
+ON contacts (user_id, lower(name));
+
+ALTER TABLE appointments
+ADD COLUMN IF NOT EXISTS contact_id UUID REFERENCES contacts (id) ON DELETE SET NULL;
+
+CREATE INDEX IF NOT EXISTS appointments_contact_start_idx
+ON appointments (contact_id, start_time)
+WHERE contact_id IS NOT NULL;
+
+-- +goose Down
+DROP INDEX IF EXISTS appointments_contact_start_idx;
+ALTER TABLE appointments DROP COLUMN IF EXISTS contact_id;
+DROP TABLE IF EXISTS contacts;
@@ -0,0 +1,16 @@
+-- +goose Up
+CREATE TABLE IF NOT EXISTS calendar_versions (
+    user_id TEXT PRIMARY KEY,
+    version BIGINT NOT NULL,
+    xact_id BIGINT NOT NULL DEFAULT 0,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+-- Count existing history so versions never go backwards for clients that
+-- already hold change log positions.
+INSERT INTO calendar_versions (user_id, version)
+SELECT user_id, COUNT(*) FROM calendar_changes GROUP BY user_id
+ON CONFLICT (user_id) DO NOTHING;
+
+-- +goose Down
+DROP TABLE IF EXISTS calendar_versions;
@@ -0,0 +1,14 @@
+-- +goose Up
+CREATE TABLE IF NOT EXISTS user_settings (
+    user_id TEXT PRIMARY KEY,
+    slot_alignment_minutes INTEGER NOT NULL DEFAULT 0,
+    slot_time_zone TEXT NOT NULL DEFAULT '',
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+ALTER TABLE user_settings
+ADD CONSTRAINT user_settings_slot_alignment_check
+CHECK (slot_alignment_minutes >= 0 AND slot_alignment_minutes <= 60);
+
+-- +goose Down
+DROP TABLE IF EXISTS user_settings;
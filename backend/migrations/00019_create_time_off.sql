@@ -0,0 +1,23 @@
+-- +goose Up
+CREATE TABLE IF NOT EXISTS time_off (
+    id UUID PRIMARY KEY,
+    user_id TEXT NOT NULL,
+    title TEXT NOT NULL,
+    start_time TIMESTAMPTZ NOT NULL,
+    end_time TIMESTAMPTZ NOT NULL,
+    timezone TEXT,
+    interval INTEGER NOT NULL DEFAULT 0,
+    byweekday SMALLINT[],
+    until TIMESTAMPTZ,
+    created_at TIMESTAMPTZ NOT NULL,
+    updated_at TIMESTAMPTZ NOT NULL
+);
+
+ALTER TABLE time_off
+ADD CONSTRAINT time_off_valid_time_range CHECK (end_time > start_time);
+
+CREATE INDEX IF NOT EXISTS time_off_user_start_idx
+ON time_off (user_id, start_time);
+
+-- +goose Down
+DROP TABLE IF EXISTS time_off;
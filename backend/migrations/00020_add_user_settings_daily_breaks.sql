@@ -0,0 +1,6 @@
+-- +goose Up
+ALTER TABLE user_settings
+ADD COLUMN IF NOT EXISTS daily_breaks JSONB;
+
+-- +goose Down
+ALTER TABLE user_settings DROP COLUMN IF EXISTS daily_breaks;
@@ -0,0 +1,17 @@
+-- +goose Up
+ALTER TABLE appointments
+ADD COLUMN IF NOT EXISTS source TEXT;
+
+-- Appointments created before sources were recorded are left without one,
+-- except those carrying an external reference, which came from a sync.
+UPDATE appointments
+SET source = 'sync:' || external_system
+WHERE source IS NULL AND external_system IS NOT NULL;
+
+CREATE INDEX IF NOT EXISTS appointments_user_source_start_idx
+ON appointments (user_id, source, start_time)
+WHERE source IS NOT NULL;
+
+-- +goose Down
+DROP INDEX IF EXISTS appointments_user_source_start_idx;
+ALTER TABLE appointments DROP COLUMN IF EXISTS source;
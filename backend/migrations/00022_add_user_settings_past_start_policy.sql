@@ -0,0 +1,10 @@
+-- +goose Up
+ALTER TABLE user_settings
+ADD COLUMN IF NOT EXISTS past_start_policy TEXT NOT NULL DEFAULT '';
+
+ALTER TABLE user_settings
+ADD CONSTRAINT user_settings_past_start_policy_check CHECK (past_start_policy IN ('', 'allow', 'warn', 'reject'));
+
+-- +goose Down
+ALTER TABLE user_settings DROP CONSTRAINT IF EXISTS user_settings_past_start_policy_check;
+ALTER TABLE user_settings DROP COLUMN IF EXISTS past_start_policy;
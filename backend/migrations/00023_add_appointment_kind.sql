@@ -0,0 +1,27 @@
+-- +goose Up
+ALTER TABLE appointments
+ADD COLUMN IF NOT EXISTS kind TEXT NOT NULL DEFAULT 'event';
+
+ALTER TABLE appointments
+ADD CONSTRAINT appointments_kind_check CHECK (kind IN ('event', 'milestone'));
+
+-- A milestone's range is empty, and an empty range overlaps nothing, so
+-- appointments_no_overlap already leaves milestones out of conflicts.
+ALTER TABLE appointments DROP CONSTRAINT IF EXISTS appointments_valid_time_range;
+
+ALTER TABLE appointments
+ADD CONSTRAINT appointments_valid_time_range CHECK (
+    (kind = 'event' AND end_time > start_time)
+    OR (kind = 'milestone' AND end_time = start_time)
+);
+
+-- +goose Down
+DELETE FROM appointments WHERE kind = 'milestone';
+
+ALTER TABLE appointments DROP CONSTRAINT IF EXISTS appointments_valid_time_range;
+
+ALTER TABLE appointments
+ADD CONSTRAINT appointments_valid_time_range CHECK (end_time > start_time);
+
+ALTER TABLE appointments DROP CONSTRAINT IF EXISTS appointments_kind_check;
+ALTER TABLE appointments DROP COLUMN IF EXISTS kind;
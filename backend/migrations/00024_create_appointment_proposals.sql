@@ -0,0 +1,38 @@
+-- +goose Up
+CREATE TABLE IF NOT EXISTS appointment_proposals (
+    id UUID PRIMARY KEY,
+    proposer_id TEXT NOT NULL,
+    recipient_id TEXT NOT NULL,
+    title TEXT NOT NULL,
+    notes TEXT NOT NULL DEFAULT '',
+    start_time TIMESTAMPTZ NOT NULL,
+    end_time TIMESTAMPTZ NOT NULL,
+    hold_id UUID NOT NULL,
+    status TEXT NOT NULL DEFAULT 'pending',
+    expires_at TIMESTAMPTZ NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL,
+    responded_at TIMESTAMPTZ,
+    proposer_appointment_id UUID,
+    recipient_appointment_id UUID
+);
+
+ALTER TABLE appointment_proposals
+ADD CONSTRAINT appointment_proposals_valid_time_range CHECK (end_time > start_time);
+
+ALTER TABLE appointment_proposals
+ADD CONSTRAINT appointment_proposals_status_check CHECK (status IN ('pending', 'accepted', 'declined', 'expired'));
+
+ALTER TABLE appointment_proposals
+ADD CONSTRAINT appointment_proposals_distinct_users CHECK (proposer_id <> recipient_id);
+
+CREATE INDEX IF NOT EXISTS appointment_proposals_proposer_idx
+ON appointment_proposals (proposer_id, start_time) WHERE status = 'pending';
+
+CREATE INDEX IF NOT EXISTS appointment_proposals_recipient_idx
+ON appointment_proposals (recipient_id, start_time) WHERE status = 'pending';
+
+CREATE INDEX IF NOT EXISTS appointment_proposals_expires_at_idx
+ON appointment_proposals (expires_at) WHERE status = 'pending';
+
+-- +goose Down
+DROP TABLE IF EXISTS appointment_proposals;
@@ -0,0 +1,10 @@
+-- +goose Up
+ALTER TABLE user_settings
+ADD COLUMN IF NOT EXISTS retention_days INTEGER;
+
+ALTER TABLE user_settings
+ADD CONSTRAINT user_settings_retention_days_check CHECK (retention_days IS NULL OR retention_days >= 0);
+
+-- +goose Down
+ALTER TABLE user_settings DROP CONSTRAINT IF EXISTS user_settings_retention_days_check;
+ALTER TABLE user_settings DROP COLUMN IF EXISTS retention_days;
@@ -0,0 +1,14 @@
+-- +goose Up
+ALTER TABLE user_settings
+ADD COLUMN IF NOT EXISTS request_timeout_seconds INTEGER,
+ADD COLUMN IF NOT EXISTS limits_multiplier INTEGER;
+
+ALTER TABLE user_settings
+ADD CONSTRAINT user_settings_request_timeout_seconds_check CHECK (request_timeout_seconds IS NULL OR request_timeout_seconds > 0),
+ADD CONSTRAINT user_settings_limits_multiplier_check CHECK (limits_multiplier IS NULL OR limits_multiplier >= 1);
+
+-- +goose Down
+ALTER TABLE user_settings DROP CONSTRAINT IF EXISTS user_settings_limits_multiplier_check;
+ALTER TABLE user_settings DROP CONSTRAINT IF EXISTS user_settings_request_timeout_seconds_check;
+ALTER TABLE user_settings DROP COLUMN IF EXISTS limits_multiplier;
+ALTER TABLE user_settings DROP COLUMN IF EXISTS request_timeout_seconds;
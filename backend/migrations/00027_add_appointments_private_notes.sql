@@ -0,0 +1,6 @@
+-- +goose Up
+ALTER TABLE appointments
+ADD COLUMN IF NOT EXISTS private_notes TEXT NULL;
+
+-- +goose Down
+ALTER TABLE appointments DROP COLUMN IF EXISTS private_notes;
@@ -0,0 +1,6 @@
+-- +goose Up
+ALTER TABLE recurring_series
+ADD COLUMN IF NOT EXISTS weekday_times JSONB NULL;
+
+-- +goose Down
+ALTER TABLE recurring_series DROP COLUMN IF EXISTS weekday_times;
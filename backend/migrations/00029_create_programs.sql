@@ -0,0 +1,34 @@
+-- +goose Up
+CREATE TABLE IF NOT EXISTS programs (
+    id UUID PRIMARY KEY,
+    user_id TEXT NOT NULL,
+    title TEXT NOT NULL,
+    notes TEXT,
+    cancelled_at TIMESTAMPTZ,
+    created_at TIMESTAMPTZ NOT NULL,
+    updated_at TIMESTAMPTZ NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS programs_user_created_idx
+ON programs (user_id, created_at);
+
+ALTER TABLE appointments
+ADD COLUMN IF NOT EXISTS program_id UUID REFERENCES programs (id) ON DELETE SET NULL;
+
+CREATE INDEX IF NOT EXISTS appointments_program_start_idx
+ON appointments (program_id, start_time)
+WHERE program_id IS NOT NULL;
+
+ALTER TABLE recurring_series
+ADD COLUMN IF NOT EXISTS program_id UUID REFERENCES programs (id) ON DELETE SET NULL;
+
+CREATE INDEX IF NOT EXISTS recurring_series_program_idx
+ON recurring_series (program_id)
+WHERE program_id IS NOT NULL;
+
+-- +goose Down
+DROP INDEX IF EXISTS recurring_series_program_idx;
+ALTER TABLE recurring_series DROP COLUMN IF EXISTS program_id;
+DROP INDEX IF EXISTS appointments_program_start_idx;
+ALTER TABLE appointments DROP COLUMN IF EXISTS program_id;
+DROP TABLE IF EXISTS programs;
@@ -0,0 +1,40 @@
+-- +goose Up
+CREATE TABLE IF NOT EXISTS provisioned_users (
+    user_id TEXT PRIMARY KEY,
+    display_name TEXT NOT NULL,
+    email TEXT,
+    external_id TEXT,
+    active BOOLEAN NOT NULL DEFAULT TRUE,
+    deactivated_at TIMESTAMPTZ,
+    created_at TIMESTAMPTZ NOT NULL,
+    updated_at TIMESTAMPTZ NOT NULL
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS provisioned_users_external_id_idx
+ON provisioned_users (external_id)
+WHERE external_id IS NOT NULL;
+
+CREATE TABLE IF NOT EXISTS user_groups (
+    id UUID PRIMARY KEY,
+    name TEXT NOT NULL,
+    external_id TEXT,
+    created_at TIMESTAMPTZ NOT NULL,
+    updated_at TIMESTAMPTZ NOT NULL
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS user_groups_name_idx
+ON user_groups (lower(name));
+
+CREATE TABLE IF NOT EXISTS user_group_members (
+    group_id UUID NOT NULL REFERENCES user_groups (id) ON DELETE CASCADE,
+    user_id TEXT NOT NULL REFERENCES provisioned_users (user_id) ON DELETE CASCADE,
+    PRIMARY KEY (group_id, user_id)
+);
+
+CREATE INDEX IF NOT EXISTS user_group_members_user_idx
+ON user_group_members (user_id);
+
+-- +goose Down
+DROP TABLE IF EXISTS user_group_members;
+DROP TABLE IF EXISTS user_groups;
+DROP TABLE IF EXISTS provisioned_users;
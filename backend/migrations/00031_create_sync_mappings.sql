@@ -0,0 +1,22 @@
+-- +goose Up
+CREATE TABLE IF NOT EXISTS sync_mappings (
+    user_id TEXT NOT NULL,
+    provider TEXT NOT NULL,
+    external_id TEXT NOT NULL,
+    appointment_id UUID REFERENCES appointments (id) ON DELETE SET NULL,
+    etag TEXT,
+    last_synced_at TIMESTAMPTZ NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL,
+    updated_at TIMESTAMPTZ NOT NULL,
+    PRIMARY KEY (user_id, provider, external_id)
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS sync_mappings_appointment_idx
+ON sync_mappings (provider, appointment_id)
+WHERE appointment_id IS NOT NULL;
+
+CREATE INDEX IF NOT EXISTS sync_mappings_last_synced_idx
+ON sync_mappings (user_id, provider, last_synced_at);
+
+-- +goose Down
+DROP TABLE IF EXISTS sync_mappings;
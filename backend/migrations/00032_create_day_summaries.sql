@@ -0,0 +1,22 @@
+-- +goose Up
+CREATE TABLE IF NOT EXISTS day_summaries (
+    user_id TEXT NOT NULL,
+    day DATE NOT NULL,
+    appointments INTEGER NOT NULL,
+    occurrences INTEGER NOT NULL,
+    busy_seconds INTEGER NOT NULL,
+    PRIMARY KEY (user_id, day)
+);
+
+CREATE TABLE IF NOT EXISTS day_summary_builds (
+    user_id TEXT PRIMARY KEY,
+    time_zone TEXT NOT NULL,
+    calendar_version BIGINT NOT NULL,
+    window_start DATE NOT NULL,
+    window_end DATE NOT NULL,
+    built_at TIMESTAMPTZ NOT NULL
+);
+
+-- +goose Down
+DROP TABLE IF EXISTS day_summary_builds;
+DROP TABLE IF EXISTS day_summaries;
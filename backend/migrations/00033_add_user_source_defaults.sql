@@ -0,0 +1,6 @@
+-- +goose Up
+ALTER TABLE user_settings
+ADD COLUMN IF NOT EXISTS source_defaults JSONB;
+
+-- +goose Down
+ALTER TABLE user_settings DROP COLUMN IF EXISTS source_defaults;
@@ -0,0 +1,35 @@
+-- +goose Up
+ALTER TABLE appointments
+ADD COLUMN IF NOT EXISTS overlap_allowed BOOLEAN NOT NULL DEFAULT false;
+
+-- A row the conflict policy let overlap sits outside the constraint. The
+-- store checks every new booking against all rows, flagged or not, before
+-- the policy is asked.
+ALTER TABLE appointments DROP CONSTRAINT IF EXISTS appointments_no_overlap;
+
+ALTER TABLE appointments
+ADD CONSTRAINT appointments_no_overlap EXCLUDE USING gist (
+    user_id
+    WITH
+        =,
+        tstzrange (start_time, end_time, '[)')
+    WITH
+        &&
+) WHERE (NOT overlap_allowed);
+
+-- +goose Down
+DELETE FROM appointments WHERE overlap_allowed;
+
+ALTER TABLE appointments DROP CONSTRAINT IF EXISTS appointments_no_overlap;
+
+ALTER TABLE appointments
+ADD CONSTRAINT appointments_no_overlap EXCLUDE USING gist (
+    user_id
+    WITH
+        =,
+        tstzrange (start_time, end_time, '[)')
+    WITH
+        &&
+);
+
+ALTER TABLE appointments DROP COLUMN IF EXISTS overlap_allowed;
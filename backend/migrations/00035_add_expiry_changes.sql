@@ -0,0 +1,25 @@
+-- +goose Up
+-- Expired holds and proposals are logged so clients waiting on them hear
+-- about the expiry through ListChanges.
+ALTER TABLE calendar_changes DROP CONSTRAINT IF EXISTS calendar_changes_entity_type_check;
+
+ALTER TABLE calendar_changes
+ADD CONSTRAINT calendar_changes_entity_type_check CHECK (entity_type IN ('appointment', 'series', 'hold', 'proposal'));
+
+ALTER TABLE calendar_changes DROP CONSTRAINT IF EXISTS calendar_changes_op_check;
+
+ALTER TABLE calendar_changes
+ADD CONSTRAINT calendar_changes_op_check CHECK (op IN ('created', 'updated', 'deleted', 'expired'));
+
+-- +goose Down
+DELETE FROM calendar_changes WHERE entity_type IN ('hold', 'proposal') OR op = 'expired';
+
+ALTER TABLE calendar_changes DROP CONSTRAINT IF EXISTS calendar_changes_entity_type_check;
+
+ALTER TABLE calendar_changes
+ADD CONSTRAINT calendar_changes_entity_type_check CHECK (entity_type IN ('appointment', 'series'));
+
+ALTER TABLE calendar_changes DROP CONSTRAINT IF EXISTS calendar_changes_op_check;
+
+ALTER TABLE calendar_changes
+ADD CONSTRAINT calendar_changes_op_check CHECK (op IN ('created', 'updated', 'deleted'));
@@ -0,0 +1,16 @@
+-- +goose Up
+-- by_weekday_mask mirrors byweekday as bits (1 << 0 for Monday through
+-- 1 << 6 for Sunday) so weekday filters are a bitwise AND. The repo keeps it
+-- in step with byweekday on every write.
+ALTER TABLE recurring_series
+ADD COLUMN by_weekday_mask SMALLINT NOT NULL DEFAULT 0;
+
+UPDATE recurring_series
+SET by_weekday_mask = (
+    SELECT COALESCE(SUM(DISTINCT 1 << (d - 1)), 0)
+    FROM unnest(byweekday) AS d
+    WHERE d BETWEEN 1 AND 7
+);
+
+-- +goose Down
+ALTER TABLE recurring_series DROP COLUMN IF EXISTS by_weekday_mask;
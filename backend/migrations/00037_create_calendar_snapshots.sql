@@ -0,0 +1,28 @@
+-- +goose Up
+CREATE TABLE IF NOT EXISTS blobs (
+    key TEXT PRIMARY KEY,
+    data BYTEA NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE TABLE IF NOT EXISTS calendar_snapshots (
+    id UUID PRIMARY KEY,
+    user_id TEXT NOT NULL,
+    label TEXT,
+    format_version INTEGER NOT NULL,
+    blob_key TEXT NOT NULL,
+    checksum TEXT NOT NULL,
+    size_bytes INTEGER NOT NULL,
+    contacts INTEGER NOT NULL,
+    appointments INTEGER NOT NULL,
+    series INTEGER NOT NULL,
+    exceptions INTEGER NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS calendar_snapshots_user_idx
+ON calendar_snapshots (user_id, created_at DESC);
+
+-- +goose Down
+DROP TABLE IF EXISTS calendar_snapshots;
+DROP TABLE IF EXISTS blobs;
@@ -0,0 +1,8 @@
+package migrations
+
+import "embed"
+
+// FS holds the goose migration files compiled into the server binary.
+//
+//go:embed *.sql
+var FS embed.FS
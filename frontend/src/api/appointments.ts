@@ -22,6 +22,7 @@ export type AppointmentModel = {
 	notes: string;
 	startTime: Date;
 	endTime: Date;
+	timeZone: string;
 };
 
 export type OccurrenceModel = {
@@ -33,6 +34,7 @@ export type OccurrenceModel = {
 	notes: string;
 	startTime: Date;
 	endTime: Date;
+	timeZone: string;
 };
 
 export type ScheduleItemModel = AppointmentModel | OccurrenceModel;
@@ -69,6 +71,7 @@ function mapAppointment(a: Appointment): AppointmentModel {
 		notes: a.notes,
 		startTime: toDate(a.startTime),
 		endTime: toDate(a.endTime),
+		timeZone: a.timeZone,
 	};
 }
 
@@ -82,6 +85,7 @@ function mapOccurrence(o: Occurrence): OccurrenceModel {
 		notes: o.notes,
 		startTime: toDate(o.startTime),
 		endTime: toDate(o.endTime),
+		timeZone: o.timeZone,
 	};
 }
 
@@ -124,14 +128,17 @@ function mapRecurringSeries(s: RecurringSeries): RecurringSeriesModel {
 	const weekdays = weekly.weekdays
 		.map((w) => Number(w) as WeeklyRecurrenceInput["weekdays"][number])
 		.filter((w) => w >= 1 && w <= 7);
+	const startTime = toDate(s.startTime);
 
 	return {
 		id: s.id,
 		userId: s.userId,
 		title: s.title,
 		notes: s.notes,
-		startTime: toDate(s.startTime),
-		endTime: toDate(s.endTime),
+		startTime,
+		endTime: s.duration
+			? new Date(startTime.getTime() + Number(s.duration.seconds) * 1000)
+			: toDate(s.endTime),
 		weekly: {
 			interval,
 			weekdays: weekdays.length ? weekdays : [1],
@@ -173,6 +180,7 @@ export async function createAppointment(input: {
 	notes: string;
 	startTime: Date;
 	endTime: Date;
+	timeZone?: string;
 	idempotencyKey?: string;
 }) {
 	const resp = await client.createAppointment(
@@ -182,6 +190,7 @@ export async function createAppointment(input: {
 			notes: input.notes,
 			startTime: timestampFromDate(input.startTime),
 			endTime: timestampFromDate(input.endTime),
+			timeZone: input.timeZone,
 		},
 		input.idempotencyKey
 			? { headers: { "Idempotency-Key": input.idempotencyKey } }
@@ -207,7 +216,13 @@ export async function createRecurringSeries(input: {
 		title: input.title,
 		notes: input.notes,
 		startTime: timestampFromDate(input.startTime),
-		endTime: timestampFromDate(input.endTime),
+		duration: {
+			seconds: BigInt(
+				Math.round(
+					(input.endTime.getTime() - input.startTime.getTime()) / 1000,
+				),
+			),
+		},
 		weekly: {
 			interval: weekly.interval,
 			weekdays: weekly.weekdays.map(weekdayToProto),
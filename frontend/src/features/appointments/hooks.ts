@@ -431,6 +431,7 @@ export function useDaySchedule() {
 					notes: createDialog.notes.trim(),
 					startTime: createDialog.start,
 					endTime: createDialog.end,
+					timeZone: createDialog.timeZone,
 					idempotencyKey: createDialog.idempotencyKey,
 				});
 			}
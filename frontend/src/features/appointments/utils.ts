@@ -133,6 +133,8 @@ export function layoutForDay(
 }
 
 export function formatRange(start: Date, end: Date) {
+	// Milestones start and end at the same instant.
+	if (start.getTime() === end.getTime()) return format(start, "h:mm a");
 	return `${format(start, "h:mm a")} – ${format(end, "h:mm a")}`;
 }
 
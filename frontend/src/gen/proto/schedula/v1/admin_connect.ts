@@ -0,0 +1,215 @@
+// @generated by protoc-gen-connect-es v1.6.1 with parameter "target=ts,import_extension=.js"
+// @generated from file proto/schedula/v1/admin.proto (package schedula.v1, syntax proto3)
+/* eslint-disable */
+// @ts-nocheck
+
+import { ClearLogTargetRequest, ClearLogTargetResponse, CreateBackdatedAppointmentRequest, CreateBackdatedAppointmentResponse, CreateBlackoutRequest, CreateBlackoutResponse, CreateUserGroupRequest, CreateUserGroupResponse, DeactivateUserRequest, DeactivateUserResponse, DeleteBlackoutRequest, DeleteBlackoutResponse, GetAPIUsageRequest, GetAPIUsageResponse, GetDatabaseDiagnosticsRequest, GetDatabaseDiagnosticsResponse, GetServiceHealthSummaryRequest, GetServiceHealthSummaryResponse, ListBlackoutsRequest, ListBlackoutsResponse, ListLogTargetsRequest, ListLogTargetsResponse, ListProvisionedUsersRequest, ListProvisionedUsersResponse, ListUserGroupsRequest, ListUserGroupsResponse, ProvisionUserRequest, ProvisionUserResponse, PurgeExpiredAppointmentsRequest, PurgeExpiredAppointmentsResponse, ReactivateUserRequest, ReactivateUserResponse, SetLogTargetRequest, SetLogTargetResponse, SetUserGroupMembersRequest, SetUserGroupMembersResponse, UpdatePastStartPolicyRequest, UpdatePastStartPolicyResponse, UpdateRequestPolicyRequest, UpdateRequestPolicyResponse, UpdateRetentionPolicyRequest, UpdateRetentionPolicyResponse, UpdateSourceDefaultsRequest, UpdateSourceDefaultsResponse } from "./admin_pb.js";
+import { MethodKind } from "@bufbuild/protobuf";
+
+/**
+ * @generated from service schedula.v1.AdminService
+ */
+export const AdminService = {
+  typeName: "schedula.v1.AdminService",
+  methods: {
+    /**
+     * @generated from rpc schedula.v1.AdminService.GetDatabaseDiagnostics
+     */
+    getDatabaseDiagnostics: {
+      name: "GetDatabaseDiagnostics",
+      I: GetDatabaseDiagnosticsRequest,
+      O: GetDatabaseDiagnosticsResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc schedula.v1.AdminService.CreateBlackout
+     */
+    createBlackout: {
+      name: "CreateBlackout",
+      I: CreateBlackoutRequest,
+      O: CreateBlackoutResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc schedula.v1.AdminService.DeleteBlackout
+     */
+    deleteBlackout: {
+      name: "DeleteBlackout",
+      I: DeleteBlackoutRequest,
+      O: DeleteBlackoutResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc schedula.v1.AdminService.ListBlackouts
+     */
+    listBlackouts: {
+      name: "ListBlackouts",
+      I: ListBlackoutsRequest,
+      O: ListBlackoutsResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc schedula.v1.AdminService.GetAPIUsage
+     */
+    getAPIUsage: {
+      name: "GetAPIUsage",
+      I: GetAPIUsageRequest,
+      O: GetAPIUsageResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc schedula.v1.AdminService.UpdatePastStartPolicy
+     */
+    updatePastStartPolicy: {
+      name: "UpdatePastStartPolicy",
+      I: UpdatePastStartPolicyRequest,
+      O: UpdatePastStartPolicyResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc schedula.v1.AdminService.CreateBackdatedAppointment
+     */
+    createBackdatedAppointment: {
+      name: "CreateBackdatedAppointment",
+      I: CreateBackdatedAppointmentRequest,
+      O: CreateBackdatedAppointmentResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc schedula.v1.AdminService.UpdateRetentionPolicy
+     */
+    updateRetentionPolicy: {
+      name: "UpdateRetentionPolicy",
+      I: UpdateRetentionPolicyRequest,
+      O: UpdateRetentionPolicyResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc schedula.v1.AdminService.PurgeExpiredAppointments
+     */
+    purgeExpiredAppointments: {
+      name: "PurgeExpiredAppointments",
+      I: PurgeExpiredAppointmentsRequest,
+      O: PurgeExpiredAppointmentsResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc schedula.v1.AdminService.GetServiceHealthSummary
+     */
+    getServiceHealthSummary: {
+      name: "GetServiceHealthSummary",
+      I: GetServiceHealthSummaryRequest,
+      O: GetServiceHealthSummaryResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc schedula.v1.AdminService.SetLogTarget
+     */
+    setLogTarget: {
+      name: "SetLogTarget",
+      I: SetLogTargetRequest,
+      O: SetLogTargetResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc schedula.v1.AdminService.ClearLogTarget
+     */
+    clearLogTarget: {
+      name: "ClearLogTarget",
+      I: ClearLogTargetRequest,
+      O: ClearLogTargetResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc schedula.v1.AdminService.ListLogTargets
+     */
+    listLogTargets: {
+      name: "ListLogTargets",
+      I: ListLogTargetsRequest,
+      O: ListLogTargetsResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc schedula.v1.AdminService.UpdateRequestPolicy
+     */
+    updateRequestPolicy: {
+      name: "UpdateRequestPolicy",
+      I: UpdateRequestPolicyRequest,
+      O: UpdateRequestPolicyResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc schedula.v1.AdminService.ProvisionUser
+     */
+    provisionUser: {
+      name: "ProvisionUser",
+      I: ProvisionUserRequest,
+      O: ProvisionUserResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc schedula.v1.AdminService.DeactivateUser
+     */
+    deactivateUser: {
+      name: "DeactivateUser",
+      I: DeactivateUserRequest,
+      O: DeactivateUserResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc schedula.v1.AdminService.ReactivateUser
+     */
+    reactivateUser: {
+      name: "ReactivateUser",
+      I: ReactivateUserRequest,
+      O: ReactivateUserResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc schedula.v1.AdminService.ListProvisionedUsers
+     */
+    listProvisionedUsers: {
+      name: "ListProvisionedUsers",
+      I: ListProvisionedUsersRequest,
+      O: ListProvisionedUsersResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc schedula.v1.AdminService.CreateUserGroup
+     */
+    createUserGroup: {
+      name: "CreateUserGroup",
+      I: CreateUserGroupRequest,
+      O: CreateUserGroupResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc schedula.v1.AdminService.SetUserGroupMembers
+     */
+    setUserGroupMembers: {
+      name: "SetUserGroupMembers",
+      I: SetUserGroupMembersRequest,
+      O: SetUserGroupMembersResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc schedula.v1.AdminService.ListUserGroups
+     */
+    listUserGroups: {
+      name: "ListUserGroups",
+      I: ListUserGroupsRequest,
+      O: ListUserGroupsResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc schedula.v1.AdminService.UpdateSourceDefaults
+     */
+    updateSourceDefaults: {
+      name: "UpdateSourceDefaults",
+      I: UpdateSourceDefaultsRequest,
+      O: UpdateSourceDefaultsResponse,
+      kind: MethodKind.Unary,
+    },
+  }
+} as const;
+
@@ -0,0 +1,1732 @@
+// @generated by protoc-gen-es v2.11.0 with parameter "target=ts,import_extension=.js"
+// @generated from file proto/schedula/v1/admin.proto (package schedula.v1, syntax proto3)
+/* eslint-disable */
+
+import type { GenEnum, GenFile, GenMessage, GenService } from "@bufbuild/protobuf/codegenv2";
+import { enumDesc, fileDesc, messageDesc, serviceDesc } from "@bufbuild/protobuf/codegenv2";
+import type { Duration, Timestamp } from "@bufbuild/protobuf/wkt";
+import { file_google_protobuf_duration, file_google_protobuf_timestamp } from "@bufbuild/protobuf/wkt";
+import type { Message } from "@bufbuild/protobuf";
+
+/**
+ * Describes the file proto/schedula/v1/admin.proto.
+ */
+export const file_proto_schedula_v1_admin: GenFile = /*@__PURE__*/
+  fileDesc("Ch1wcm90by9zY2hlZHVsYS92MS9hZG1pbi5wcm90bxILc2NoZWR1bGEudjEigwIKClRhYmxlU3RhdHMSDAoEbmFtZRgBIAEoCRITCgt0b3RhbF9ieXRlcxgCIAEoAxITCgt0YWJsZV9ieXRlcxgDIAEoAxITCgtpbmRleF9ieXRlcxgEIAEoAxITCgtsaXZlX3R1cGxlcxgFIAEoAxITCgtkZWFkX3R1cGxlcxgGIAEoAxIYChBkZWFkX3R1cGxlX3JhdGlvGAcgASgBEi8KC2xhc3RfdmFjdXVtGAggASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIzCg9sYXN0X2F1dG92YWN1dW0YCSABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wIpwBCgpJbmRleFN0YXRzEgwKBG5hbWUYASABKAkSDQoFdGFibGUYAiABKAkSDQoFYnl0ZXMYAyABKAMSFwoPYnl0ZXNfcGVyX3R1cGxlGAQgASgBEg0KBXNjYW5zGAUgASgDEg0KBXZhbGlkGAYgASgIEg0KBXJlYWR5GAcgASgIEhwKFGV4Y2x1c2lvbl9jb25zdHJhaW50GAggASgIIo8BCglQb29sU3RhdHMSEAoIbWF4X29wZW4YASABKA0SDAoEb3BlbhgCIAEoDRIOCgZpbl91c2UYAyABKA0SDAoEaWRsZRgEIAEoDRISCgp3YWl0X2NvdW50GAUgASgDEjAKDXdhaXRfZHVyYXRpb24YBiABKAsyGS5nb29nbGUucHJvdG9idWYuRHVyYXRpb24iHwodR2V0RGF0YWJhc2VEaWFnbm9zdGljc1JlcXVlc3QimQEKHkdldERhdGFiYXNlRGlhZ25vc3RpY3NSZXNwb25zZRInCgZ0YWJsZXMYASADKAsyFy5zY2hlZHVsYS52MS5UYWJsZVN0YXRzEigKB2luZGV4ZXMYAiADKAsyFy5zY2hlZHVsYS52MS5JbmRleFN0YXRzEiQKBHBvb2wYAyABKAsyFi5zY2hlZHVsYS52MS5Qb29sU3RhdHMi3AEKCEJsYWNrb3V0EgoKAmlkGAEgASgJEg0KBXRpdGxlGAIgASgJEi4KCnN0YXJ0X3RpbWUYAyABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEiwKCGVuZF90aW1lGAQgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBInCgRtb2RlGAUgASgOMhkuc2NoZWR1bGEudjEuQmxhY2tvdXRNb2RlEi4KCmNyZWF0ZWRfYXQYBiABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wIq0BChVDcmVhdGVCbGFja291dFJlcXVlc3QSDQoFdGl0bGUYASABKAkSLgoKc3RhcnRfdGltZRgCIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASLAoIZW5kX3RpbWUYAyABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEicKBG1vZGUYBCABKA4yGS5zY2hlZHVsYS52MS5CbGFja291dE1vZGUiQQoWQ3JlYXRlQmxhY2tvdXRSZXNwb25zZRInCghibGFja291dBgBIAEoCzIVLnNjaGVkdWxhLnYxLkJsYWNrb3V0IiwKFURlbGV0ZUJsYWNrb3V0UmVxdWVzdBITCgtibGFja291dF9pZBgBIAEoCSIYChZEZWxldGVCbGFja291dFJlc3BvbnNlIngKFExpc3RCbGFja291dHNSZXF1ZXN0EjAKDHdpbmRvd19zdGFydBgBIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASLgoKd2luZG93X2VuZBgCIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXAiQQoVTGlzdEJsYWNrb3V0c1Jlc3BvbnNlEigKCWJsYWNrb3V0cxgBIAMoCzIVLnNjaGVkdWxhLnYxLkJsYWNrb3V0Ij8KC01ldGhvZFVzYWdlEg4KBm1ldGhvZBgBIAEoCRIQCghyZXF1ZXN0cxgCIAEoAxIOCgZlcnJvcnMYAyABKAMiaQoJVXNlclVzYWdlEg8KB3VzZXJfaWQYASABKAkSEAoIcmVxdWVzdHMYAiABKAMSDgoGZXJyb3JzGAMgASgDEikKB21ldGhvZHMYBCADKAsyGC5zY2hlZHVsYS52MS5NZXRob2RVc2FnZSI0ChJHZXRBUElVc2FnZVJlcXVlc3QSDwoHdXNlcl9pZBgBIAEoCRINCgVsaW1pdBgCIAEoDSJnChNHZXRBUElVc2FnZVJlc3BvbnNlEikKBndpbmRvdxgBIAEoCzIZLmdvb2dsZS5wcm90b2J1Zi5EdXJhdGlvbhIlCgV1c2VycxgCIAMoCzIWLnNjaGVkdWxhLnYxLlVzZXJVc2FnZSK3AQoMTWV0aG9kSGVhbHRoEg4KBm1ldGhvZBgBIAEoCRIQCghyZXF1ZXN0cxgCIAEoAxIVCg1zZXJ2ZXJfZXJyb3JzGAMgASgDEhQKDGF2YWlsYWJpbGl0eRgEIAEoARIRCgljb25mbGljdHMYBSABKAMSFQoNY29uZmxpY3RfcmF0ZRgGIAEoARIuCgtwOTlfbGF0ZW5jeRgHIAEoCzIZLmdvb2dsZS5wcm90b2J1Zi5EdXJhdGlvbiKPAQoMSGVhbHRoV2luZG93EikKBndpbmRvdxgBIAEoCzIZLmdvb2dsZS5wcm90b2J1Zi5EdXJhdGlvbhIoCgV0b3RhbBgCIAEoCzIZLnNjaGVkdWxhLnYxLk1ldGhvZEhlYWx0aBIqCgdtZXRob2RzGAMgAygLMhkuc2NoZWR1bGEudjEuTWV0aG9kSGVhbHRoIkwKHkdldFNlcnZpY2VIZWFsdGhTdW1tYXJ5UmVxdWVzdBIqCgd3aW5kb3dzGAEgAygLMhkuZ29vZ2xlLnByb3RvYnVmLkR1cmF0aW9uIk0KH0dldFNlcnZpY2VIZWFsdGhTdW1tYXJ5UmVzcG9uc2USKgoHd2luZG93cxgBIAMoCzIZLnNjaGVkdWxhLnYxLkhlYWx0aFdpbmRvdyJxCglMb2dUYXJnZXQSKAoEa2luZBgBIAEoDjIaLnNjaGVkdWxhLnYxLkxvZ1RhcmdldEtpbmQSCgoCaWQYAiABKAkSLgoKZXhwaXJlc19hdBgDIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXAicwoTU2V0TG9nVGFyZ2V0UmVxdWVzdBIoCgRraW5kGAEgASgOMhouc2NoZWR1bGEudjEuTG9nVGFyZ2V0S2luZBIKCgJpZBgCIAEoCRImCgN0dGwYAyABKAsyGS5nb29nbGUucHJvdG9idWYuRHVyYXRpb24iPgoUU2V0TG9nVGFyZ2V0UmVzcG9uc2USJgoGdGFyZ2V0GAEgASgLMhYuc2NoZWR1bGEudjEuTG9nVGFyZ2V0Ik0KFUNsZWFyTG9nVGFyZ2V0UmVxdWVzdBIoCgRraW5kGAEgASgOMhouc2NoZWR1bGEudjEuTG9nVGFyZ2V0S2luZBIKCgJpZBgCIAEoCSIYChZDbGVhckxvZ1RhcmdldFJlc3BvbnNlIhcKFUxpc3RMb2dUYXJnZXRzUmVxdWVzdCJBChZMaXN0TG9nVGFyZ2V0c1Jlc3BvbnNlEicKB3RhcmdldHMYASADKAsyFi5zY2hlZHVsYS52MS5Mb2dUYXJnZXQifAoaVXBkYXRlUmVxdWVzdFBvbGljeVJlcXVlc3QSDwoHdXNlcl9pZBgBIAEoCRIyCg9yZXF1ZXN0X3RpbWVvdXQYAiABKAsyGS5nb29nbGUucHJvdG9idWYuRHVyYXRpb24SGQoRbGltaXRzX211bHRpcGxpZXIYAyABKA0izwEKG1VwZGF0ZVJlcXVlc3RQb2xpY3lSZXNwb25zZRIPCgd1c2VyX2lkGAEgASgJEjIKD3JlcXVlc3RfdGltZW91dBgCIAEoCzIZLmdvb2dsZS5wcm90b2J1Zi5EdXJhdGlvbhIZChFsaW1pdHNfbXVsdGlwbGllchgDIAEoDRIYChBtYXhfdGl0bGVfbGVuZ3RoGAQgASgNEhgKEG1heF9ub3Rlc19sZW5ndGgYBSABKA0SHAoUbWF4X21ldGFkYXRhX2VudHJpZXMYBiABKA0igAIKD1Byb3Zpc2lvbmVkVXNlchIPCgd1c2VyX2lkGAEgASgJEhQKDGRpc3BsYXlfbmFtZRgCIAEoCRINCgVlbWFpbBgDIAEoCRITCgtleHRlcm5hbF9pZBgEIAEoCRIOCgZhY3RpdmUYBSABKAgSMgoOZGVhY3RpdmF0ZWRfYXQYBiABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEi4KCmNyZWF0ZWRfYXQYByABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEi4KCnVwZGF0ZWRfYXQYCCABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wInYKFFByb3Zpc2lvblVzZXJSZXF1ZXN0Eg8KB3VzZXJfaWQYASABKAkSFAoMZGlzcGxheV9uYW1lGAIgASgJEg0KBWVtYWlsGAMgASgJEhMKC2V4dGVybmFsX2lkGAQgASgJEhMKC2RlYWN0aXZhdGVkGAUgASgIIkMKFVByb3Zpc2lvblVzZXJSZXNwb25zZRIqCgR1c2VyGAEgASgLMhwuc2NoZWR1bGEudjEuUHJvdmlzaW9uZWRVc2VyIigKFURlYWN0aXZhdGVVc2VyUmVxdWVzdBIPCgd1c2VyX2lkGAEgASgJIkQKFkRlYWN0aXZhdGVVc2VyUmVzcG9uc2USKgoEdXNlchgBIAEoCzIcLnNjaGVkdWxhLnYxLlByb3Zpc2lvbmVkVXNlciIoChVSZWFjdGl2YXRlVXNlclJlcXVlc3QSDwoHdXNlcl9pZBgBIAEoCSJEChZSZWFjdGl2YXRlVXNlclJlc3BvbnNlEioKBHVzZXIYASABKAsyHC5zY2hlZHVsYS52MS5Qcm92aXNpb25lZFVzZXIiHQobTGlzdFByb3Zpc2lvbmVkVXNlcnNSZXF1ZXN0IksKHExpc3RQcm92aXNpb25lZFVzZXJzUmVzcG9uc2USKwoFdXNlcnMYASADKAsyHC5zY2hlZHVsYS52MS5Qcm92aXNpb25lZFVzZXIirgEKCVVzZXJHcm91cBIKCgJpZBgBIAEoCRIMCgRuYW1lGAIgASgJEhMKC2V4dGVybmFsX2lkGAMgASgJEhIKCm1lbWJlcl9pZHMYBCADKAkSLgoKY3JlYXRlZF9hdBgFIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASLgoKdXBkYXRlZF9hdBgGIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXAiOwoWQ3JlYXRlVXNlckdyb3VwUmVxdWVzdBIMCgRuYW1lGAEgASgJEhMKC2V4dGVybmFsX2lkGAIgASgJIkAKF0NyZWF0ZVVzZXJHcm91cFJlc3BvbnNlEiUKBWdyb3VwGAEgASgLMhYuc2NoZWR1bGEudjEuVXNlckdyb3VwIkIKGlNldFVzZXJHcm91cE1lbWJlcnNSZXF1ZXN0EhAKCGdyb3VwX2lkGAEgASgJEhIKCm1lbWJlcl9pZHMYAiADKAkiRAobU2V0VXNlckdyb3VwTWVtYmVyc1Jlc3BvbnNlEiUKBWdyb3VwGAEgASgLMhYuc2NoZWR1bGEudjEuVXNlckdyb3VwIhcKFUxpc3RVc2VyR3JvdXBzUmVxdWVzdCJAChZMaXN0VXNlckdyb3Vwc1Jlc3BvbnNlEiYKBmdyb3VwcxgBIAMoCzIWLnNjaGVkdWxhLnYxLlVzZXJHcm91cCJdChxVcGRhdGVQYXN0U3RhcnRQb2xpY3lSZXF1ZXN0Eg8KB3VzZXJfaWQYASABKAkSLAoGcG9saWN5GAIgASgOMhwuc2NoZWR1bGEudjEuUGFzdFN0YXJ0UG9saWN5IpYBCh1VcGRhdGVQYXN0U3RhcnRQb2xpY3lSZXNwb25zZRIPCgd1c2VyX2lkGAEgASgJEiwKBnBvbGljeRgCIAEoDjIcLnNjaGVkdWxhLnYxLlBhc3RTdGFydFBvbGljeRI2ChBlZmZlY3RpdmVfcG9saWN5GAMgASgOMhwuc2NoZWR1bGEudjEuUGFzdFN0YXJ0UG9saWN5ImMKHFVwZGF0ZVJldGVudGlvblBvbGljeVJlcXVlc3QSDwoHdXNlcl9pZBgBIAEoCRIWCg5yZXRlbnRpb25fZGF5cxgCIAEoDRIaChJ1c2Vfc2VydmVyX2RlZmF1bHQYAyABKAgihwEKHVVwZGF0ZVJldGVudGlvblBvbGljeVJlc3BvbnNlEg8KB3VzZXJfaWQYASABKAkSFgoOcmV0ZW50aW9uX2RheXMYAiABKA0SGwoTdXNlc19zZXJ2ZXJfZGVmYXVsdBgDIAEoCBIgChhlZmZlY3RpdmVfcmV0ZW50aW9uX2RheXMYBCABKA0iMgofUHVyZ2VFeHBpcmVkQXBwb2ludG1lbnRzUmVxdWVzdBIPCgdkcnlfcnVuGAEgASgIIlwKDlJldGVudGlvblB1cmdlEg8KB3VzZXJfaWQYASABKAkSKgoGY3V0b2ZmGAIgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBINCgVjb3VudBgDIAEoAyJuCiBQdXJnZUV4cGlyZWRBcHBvaW50bWVudHNSZXNwb25zZRIPCgdkcnlfcnVuGAEgASgIEioKBXVzZXJzGAIgAygLMhsuc2NoZWR1bGEudjEuUmV0ZW50aW9uUHVyZ2USDQoFdG90YWwYAyABKAMi1AIKIUNyZWF0ZUJhY2tkYXRlZEFwcG9pbnRtZW50UmVxdWVzdBIPCgd1c2VyX2lkGAEgASgJEg0KBXRpdGxlGAIgASgJEg0KBW5vdGVzGAMgASgJEi4KCnN0YXJ0X3RpbWUYBCABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEiwKCGVuZF90aW1lGAUgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIRCgl0aW1lX3pvbmUYBiABKAkSTgoIbWV0YWRhdGEYByADKAsyPC5zY2hlZHVsYS52MS5DcmVhdGVCYWNrZGF0ZWRBcHBvaW50bWVudFJlcXVlc3QuTWV0YWRhdGFFbnRyeRIOCgZyZWFzb24YCCABKAkaLwoNTWV0YWRhdGFFbnRyeRILCgNrZXkYASABKAkSDQoFdmFsdWUYAiABKAk6AjgBIpoBCiJDcmVhdGVCYWNrZGF0ZWRBcHBvaW50bWVudFJlc3BvbnNlEhYKDmFwcG9pbnRtZW50X2lkGAEgASgJEi4KCnN0YXJ0X3RpbWUYAiABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEiwKCGVuZF90aW1lGAMgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcCJLCg1Tb3VyY2VEZWZhdWx0Eg4KBnNvdXJjZRgBIAEoCRIUCgx0aXRsZV9wcmVmaXgYAiABKAkSFAoMbm90ZXNfZm9vdGVyGAMgASgJIlwKG1VwZGF0ZVNvdXJjZURlZmF1bHRzUmVxdWVzdBIPCgd1c2VyX2lkGAEgASgJEiwKCGRlZmF1bHRzGAIgAygLMhouc2NoZWR1bGEudjEuU291cmNlRGVmYXVsdCJdChxVcGRhdGVTb3VyY2VEZWZhdWx0c1Jlc3BvbnNlEg8KB3VzZXJfaWQYASABKAkSLAoIZGVmYXVsdHMYAiADKAsyGi5zY2hlZHVsYS52MS5Tb3VyY2VEZWZhdWx0Kl4KDEJsYWNrb3V0TW9kZRIdChlCTEFDS09VVF9NT0RFX1VOU1BFQ0lGSUVEEAASFwoTQkxBQ0tPVVRfTU9ERV9CTE9DSxABEhYKEkJMQUNLT1VUX01PREVfV0FSThACKmcKDUxvZ1RhcmdldEtpbmQSHwobTE9HX1RBUkdFVF9LSU5EX1VOU1BFQ0lGSUVEEAASGAoUTE9HX1RBUkdFVF9LSU5EX1VTRVIQARIbChdMT0dfVEFSR0VUX0tJTkRfUkVRVUVTVBACKosBCg9QYXN0U3RhcnRQb2xpY3kSIQodUEFTVF9TVEFSVF9QT0xJQ1lfVU5TUEVDSUZJRUQQABIbChdQQVNUX1NUQVJUX1BPTElDWV9BTExPVxABEhoKFlBBU1RfU1RBUlRfUE9MSUNZX1dBUk4QAhIcChhQQVNUX1NUQVJUX1BPTElDWV9SRUpFQ1QQAzKvEQoMQWRtaW5TZXJ2aWNlEnEKFkdldERhdGFiYXNlRGlhZ25vc3RpY3MSKi5zY2hlZHVsYS52MS5HZXREYXRhYmFzZURpYWdub3N0aWNzUmVxdWVzdBorLnNjaGVkdWxhLnYxLkdldERhdGFiYXNlRGlhZ25vc3RpY3NSZXNwb25zZRJZCg5DcmVhdGVCbGFja291dBIiLnNjaGVkdWxhLnYxLkNyZWF0ZUJsYWNrb3V0UmVxdWVzdBojLnNjaGVkdWxhLnYxLkNyZWF0ZUJsYWNrb3V0UmVzcG9uc2USWQoORGVsZXRlQmxhY2tvdXQSIi5zY2hlZHVsYS52MS5EZWxldGVCbGFja291dFJlcXVlc3QaIy5zY2hlZHVsYS52MS5EZWxldGVCbGFja291dFJlc3BvbnNlElYKDUxpc3RCbGFja291dHMSIS5zY2hlZHVsYS52MS5MaXN0QmxhY2tvdXRzUmVxdWVzdBoiLnNjaGVkdWxhLnYxLkxpc3RCbGFja291dHNSZXNwb25zZRJQCgtHZXRBUElVc2FnZRIfLnNjaGVkdWxhLnYxLkdldEFQSVVzYWdlUmVxdWVzdBogLnNjaGVkdWxhLnYxLkdldEFQSVVzYWdlUmVzcG9uc2USbgoVVXBkYXRlUGFzdFN0YXJ0UG9saWN5Eikuc2NoZWR1bGEudjEuVXBkYXRlUGFzdFN0YXJ0UG9saWN5UmVxdWVzdBoqLnNjaGVkdWxhLnYxLlVwZGF0ZVBhc3RTdGFydFBvbGljeVJlc3BvbnNlEn0KGkNyZWF0ZUJhY2tkYXRlZEFwcG9pbnRtZW50Ei4uc2NoZWR1bGEudjEuQ3JlYXRlQmFja2RhdGVkQXBwb2ludG1lbnRSZXF1ZXN0Gi8uc2NoZWR1bGEudjEuQ3JlYXRlQmFja2RhdGVkQXBwb2ludG1lbnRSZXNwb25zZRJuChVVcGRhdGVSZXRlbnRpb25Qb2xpY3kSKS5zY2hlZHVsYS52MS5VcGRhdGVSZXRlbnRpb25Qb2xpY3lSZXF1ZXN0Giouc2NoZWR1bGEudjEuVXBkYXRlUmV0ZW50aW9uUG9saWN5UmVzcG9uc2USdwoYUHVyZ2VFeHBpcmVkQXBwb2ludG1lbnRzEiwuc2NoZWR1bGEudjEuUHVyZ2VFeHBpcmVkQXBwb2ludG1lbnRzUmVxdWVzdBotLnNjaGVkdWxhLnYxLlB1cmdlRXhwaXJlZEFwcG9pbnRtZW50c1Jlc3BvbnNlEnQKF0dldFNlcnZpY2VIZWFsdGhTdW1tYXJ5Eisuc2NoZWR1bGEudjEuR2V0U2VydmljZUhlYWx0aFN1bW1hcnlSZXF1ZXN0Giwuc2NoZWR1bGEudjEuR2V0U2VydmljZUhlYWx0aFN1bW1hcnlSZXNwb25zZRJTCgxTZXRMb2dUYXJnZXQSIC5zY2hlZHVsYS52MS5TZXRMb2dUYXJnZXRSZXF1ZXN0GiEuc2NoZWR1bGEudjEuU2V0TG9nVGFyZ2V0UmVzcG9uc2USWQoOQ2xlYXJMb2dUYXJnZXQSIi5zY2hlZHVsYS52MS5DbGVhckxvZ1RhcmdldFJlcXVlc3QaIy5zY2hlZHVsYS52MS5DbGVhckxvZ1RhcmdldFJlc3BvbnNlElkKDkxpc3RMb2dUYXJnZXRzEiIuc2NoZWR1bGEudjEuTGlzdExvZ1RhcmdldHNSZXF1ZXN0GiMuc2NoZWR1bGEudjEuTGlzdExvZ1RhcmdldHNSZXNwb25zZRJoChNVcGRhdGVSZXF1ZXN0UG9saWN5Eicuc2NoZWR1bGEudjEuVXBkYXRlUmVxdWVzdFBvbGljeVJlcXVlc3QaKC5zY2hlZHVsYS52MS5VcGRhdGVSZXF1ZXN0UG9saWN5UmVzcG9uc2USVgoNUHJvdmlzaW9uVXNlchIhLnNjaGVkdWxhLnYxLlByb3Zpc2lvblVzZXJSZXF1ZXN0GiIuc2NoZWR1bGEudjEuUHJvdmlzaW9uVXNlclJlc3BvbnNlElkKDkRlYWN0aXZhdGVVc2VyEiIuc2NoZWR1bGEudjEuRGVhY3RpdmF0ZVVzZXJSZXF1ZXN0GiMuc2NoZWR1bGEudjEuRGVhY3RpdmF0ZVVzZXJSZXNwb25zZRJZCg5SZWFjdGl2YXRlVXNlchIiLnNjaGVkdWxhLnYxLlJlYWN0aXZhdGVVc2VyUmVxdWVzdBojLnNjaGVkdWxhLnYxLlJlYWN0aXZhdGVVc2VyUmVzcG9uc2USawoUTGlzdFByb3Zpc2lvbmVkVXNlcnMSKC5zY2hlZHVsYS52MS5MaXN0UHJvdmlzaW9uZWRVc2Vyc1JlcXVlc3QaKS5zY2hlZHVsYS52MS5MaXN0UHJvdmlzaW9uZWRVc2Vyc1Jlc3BvbnNlElwKD0NyZWF0ZVVzZXJHcm91cBIjLnNjaGVkdWxhLnYxLkNyZWF0ZVVzZXJHcm91cFJlcXVlc3QaJC5zY2hlZHVsYS52MS5DcmVhdGVVc2VyR3JvdXBSZXNwb25zZRJoChNTZXRVc2VyR3JvdXBNZW1iZXJzEicuc2NoZWR1bGEudjEuU2V0VXNlckdyb3VwTWVtYmVyc1JlcXVlc3QaKC5zY2hlZHVsYS52MS5TZXRVc2VyR3JvdXBNZW1iZXJzUmVzcG9uc2USWQoOTGlzdFVzZXJHcm91cHMSIi5zY2hlZHVsYS52MS5MaXN0VXNlckdyb3Vwc1JlcXVlc3QaIy5zY2hlZHVsYS52MS5MaXN0VXNlckdyb3Vwc1Jlc3BvbnNlEmsKFFVwZGF0ZVNvdXJjZURlZmF1bHRzEiguc2NoZWR1bGEudjEuVXBkYXRlU291cmNlRGVmYXVsdHNSZXF1ZXN0Gikuc2NoZWR1bGEudjEuVXBkYXRlU291cmNlRGVmYXVsdHNSZXNwb25zZUI8WjpzY2hlZHVsYS9iYWNrZW5kL2ludGVybmFsL2dlbi9wcm90by9zY2hlZHVsYS92MTtzY2hlZHVsZXYxYgZwcm90bzM", [file_google_protobuf_duration, file_google_protobuf_timestamp]);
+
+/**
+ * @generated from message schedula.v1.TableStats
+ */
+export type TableStats = Message<"schedula.v1.TableStats"> & {
+  /**
+   * @generated from field: string name = 1;
+   */
+  name: string;
+
+  /**
+   * @generated from field: int64 total_bytes = 2;
+   */
+  totalBytes: bigint;
+
+  /**
+   * @generated from field: int64 table_bytes = 3;
+   */
+  tableBytes: bigint;
+
+  /**
+   * @generated from field: int64 index_bytes = 4;
+   */
+  indexBytes: bigint;
+
+  /**
+   * @generated from field: int64 live_tuples = 5;
+   */
+  liveTuples: bigint;
+
+  /**
+   * @generated from field: int64 dead_tuples = 6;
+   */
+  deadTuples: bigint;
+
+  /**
+   * @generated from field: double dead_tuple_ratio = 7;
+   */
+  deadTupleRatio: number;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp last_vacuum = 8;
+   */
+  lastVacuum?: Timestamp;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp last_autovacuum = 9;
+   */
+  lastAutovacuum?: Timestamp;
+};
+
+/**
+ * Describes the message schedula.v1.TableStats.
+ * Use `create(TableStatsSchema)` to create a new message.
+ */
+export const TableStatsSchema: GenMessage<TableStats> = /*@__PURE__*/
+  messageDesc(file_proto_schedula_v1_admin, 0);
+
+/**
+ * @generated from message schedula.v1.IndexStats
+ */
+export type IndexStats = Message<"schedula.v1.IndexStats"> & {
+  /**
+   * @generated from field: string name = 1;
+   */
+  name: string;
+
+  /**
+   * @generated from field: string table = 2;
+   */
+  table: string;
+
+  /**
+   * @generated from field: int64 bytes = 3;
+   */
+  bytes: bigint;
+
+  /**
+   * @generated from field: double bytes_per_tuple = 4;
+   */
+  bytesPerTuple: number;
+
+  /**
+   * @generated from field: int64 scans = 5;
+   */
+  scans: bigint;
+
+  /**
+   * @generated from field: bool valid = 6;
+   */
+  valid: boolean;
+
+  /**
+   * @generated from field: bool ready = 7;
+   */
+  ready: boolean;
+
+  /**
+   * @generated from field: bool exclusion_constraint = 8;
+   */
+  exclusionConstraint: boolean;
+};
+
+/**
+ * Describes the message schedula.v1.IndexStats.
+ * Use `create(IndexStatsSchema)` to create a new message.
+ */
+export const IndexStatsSchema: GenMessage<IndexStats> = /*@__PURE__*/
+  messageDesc(file_proto_schedula_v1_admin, 1);
+
+/**
+ * @generated from message schedula.v1.PoolStats
+ */
+export type PoolStats = Message<"schedula.v1.PoolStats"> & {
+  /**
+   * @generated from field: uint32 max_open = 1;
+   */
+  maxOpen: number;
+
+  /**
+   * @generated from field: uint32 open = 2;
+   */
+  open: number;
+
+  /**
+   * @generated from field: uint32 in_use = 3;
+   */
+  inUse: number;
+
+  /**
+   * @generated from field: uint32 idle = 4;
+   */
+  idle: number;
+
+  /**
+   * @generated from field: int64 wait_count = 5;
+   */
+  waitCount: bigint;
+
+  /**
+   * @generated from field: google.protobuf.Duration wait_duration = 6;
+   */
+  waitDuration?: Duration;
+};
+
+/**
+ * Describes the message schedula.v1.PoolStats.
+ * Use `create(PoolStatsSchema)` to create a new message.
+ */
+export const PoolStatsSchema: GenMessage<PoolStats> = /*@__PURE__*/
+  messageDesc(file_proto_schedula_v1_admin, 2);
+
+/**
+ * @generated from message schedula.v1.GetDatabaseDiagnosticsRequest
+ */
+export type GetDatabaseDiagnosticsRequest = Message<"schedula.v1.GetDatabaseDiagnosticsRequest"> & {
+};
+
+/**
+ * Describes the message schedula.v1.GetDatabaseDiagnosticsRequest.
+ * Use `create(GetDatabaseDiagnosticsRequestSchema)` to create a new message.
+ */
+export const GetDatabaseDiagnosticsRequestSchema: GenMessage<GetDatabaseDiagnosticsRequest> = /*@__PURE__*/
+  messageDesc(file_proto_schedula_v1_admin, 3);
+
+/**
+ * @generated from message schedula.v1.GetDatabaseDiagnosticsResponse
+ */
+export type GetDatabaseDiagnosticsResponse = Message<"schedula.v1.GetDatabaseDiagnosticsResponse"> & {
+  /**
+   * @generated from field: repeated schedula.v1.TableStats tables = 1;
+   */
+  tables: TableStats[];
+
+  /**
+   * @generated from field: repeated schedula.v1.IndexStats indexes = 2;
+   */
+  indexes: IndexStats[];
+
+  /**
+   * @generated from field: schedula.v1.PoolStats pool = 3;
+   */
+  pool?: PoolStats;
+};
+
+/**
+ * Describes the message schedula.v1.GetDatabaseDiagnosticsResponse.
+ * Use `create(GetDatabaseDiagnosticsResponseSchema)` to create a new message.
+ */
+export const GetDatabaseDiagnosticsResponseSchema: GenMessage<GetDatabaseDiagnosticsResponse> = /*@__PURE__*/
+  messageDesc(file_proto_schedula_v1_admin, 4);
+
+/**
+ * @generated from message schedula.v1.Blackout
+ */
+export type Blackout = Message<"schedula.v1.Blackout"> & {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id: string;
+
+  /**
+   * @generated from field: string title = 2;
+   */
+  title: string;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp start_time = 3;
+   */
+  startTime?: Timestamp;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp end_time = 4;
+   */
+  endTime?: Timestamp;
+
+  /**
+   * @generated from field: schedula.v1.BlackoutMode mode = 5;
+   */
+  mode: BlackoutMode;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp created_at = 6;
+   */
+  createdAt?: Timestamp;
+};
+
+/**
+ * Describes the message schedula.v1.Blackout.
+ * Use `create(BlackoutSchema)` to create a new message.
+ */
+export const BlackoutSchema: GenMessage<Blackout> = /*@__PURE__*/
+  messageDesc(file_proto_schedula_v1_admin, 5);
+
+/**
+ * @generated from message schedula.v1.CreateBlackoutRequest
+ */
+export type CreateBlackoutRequest = Message<"schedula.v1.CreateBlackoutRequest"> & {
+  /**
+   * @generated from field: string title = 1;
+   */
+  title: string;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp start_time = 2;
+   */
+  startTime?: Timestamp;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp end_time = 3;
+   */
+  endTime?: Timestamp;
+
+  /**
+   * @generated from field: schedula.v1.BlackoutMode mode = 4;
+   */
+  mode: BlackoutMode;
+};
+
+/**
+ * Describes the message schedula.v1.CreateBlackoutRequest.
+ * Use `create(CreateBlackoutRequestSchema)` to create a new message.
+ */
+export const CreateBlackoutRequestSchema: GenMessage<CreateBlackoutRequest> = /*@__PURE__*/
+  messageDesc(file_proto_schedula_v1_admin, 6);
+
+/**
+ * @generated from message schedula.v1.CreateBlackoutResponse
+ */
+export type CreateBlackoutResponse = Message<"schedula.v1.CreateBlackoutResponse"> & {
+  /**
+   * @generated from field: schedula.v1.Blackout blackout = 1;
+   */
+  blackout?: Blackout;
+};
+
+/**
+ * Describes the message schedula.v1.CreateBlackoutResponse.
+ * Use `create(CreateBlackoutResponseSchema)` to create a new message.
+ */
+export const CreateBlackoutResponseSchema: GenMessage<CreateBlackoutResponse> = /*@__PURE__*/
+  messageDesc(file_proto_schedula_v1_admin, 7);
+
+/**
+ * @generated from message schedula.v1.DeleteBlackoutRequest
+ */
+export type DeleteBlackoutRequest = Message<"schedula.v1.DeleteBlackoutRequest"> & {
+  /**
+   * @generated from field: string blackout_id = 1;
+   */
+  blackoutId: string;
+};
+
+/**
+ * Describes the message schedula.v1.DeleteBlackoutRequest.
+ * Use `create(DeleteBlackoutRequestSchema)` to create a new message.
+ */
+export const DeleteBlackoutRequestSchema: GenMessage<DeleteBlackoutRequest> = /*@__PURE__*/
+  messageDesc(file_proto_schedula_v1_admin, 8);
+
+/**
+ * @generated from message schedula.v1.DeleteBlackoutResponse
+ */
+export type DeleteBlackoutResponse = Message<"schedula.v1.DeleteBlackoutResponse"> & {
+};
+
+/**
+ * Describes the message schedula.v1.DeleteBlackoutResponse.
+ * Use `create(DeleteBlackoutResponseSchema)` to create a new message.
+ */
+export const DeleteBlackoutResponseSchema: GenMessage<DeleteBlackoutResponse> = /*@__PURE__*/
+  messageDesc(file_proto_schedula_v1_admin, 9);
+
+/**
+ * @generated from message schedula.v1.ListBlackoutsRequest
+ */
+export type ListBlackoutsRequest = Message<"schedula.v1.ListBlackoutsRequest"> & {
+  /**
+   * @generated from field: google.protobuf.Timestamp window_start = 1;
+   */
+  windowStart?: Timestamp;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp window_end = 2;
+   */
+  windowEnd?: Timestamp;
+};
+
+/**
+ * Describes the message schedula.v1.ListBlackoutsRequest.
+ * Use `create(ListBlackoutsRequestSchema)` to create a new message.
+ */
+export const ListBlackoutsRequestSchema: GenMessage<ListBlackoutsRequest> = /*@__PURE__*/
+  messageDesc(file_proto_schedula_v1_admin, 10);
+
+/**
+ * @generated from message schedula.v1.ListBlackoutsResponse
+ */
+export type ListBlackoutsResponse = Message<"schedula.v1.ListBlackoutsResponse"> & {
+  /**
+   * @generated from field: repeated schedula.v1.Blackout blackouts = 1;
+   */
+  blackouts: Blackout[];
+};
+
+/**
+ * Describes the message schedula.v1.ListBlackoutsResponse.
+ * Use `create(ListBlackoutsResponseSchema)` to create a new message.
+ */
+export const ListBlackoutsResponseSchema: GenMessage<ListBlackoutsResponse> = /*@__PURE__*/
+  messageDesc(file_proto_schedula_v1_admin, 11);
+
+/**
+ * @generated from message schedula.v1.MethodUsage
+ */
+export type MethodUsage = Message<"schedula.v1.MethodUsage"> & {
+  /**
+   * @generated from field: string method = 1;
+   */
+  method: string;
+
+  /**
+   * @generated from field: int64 requests = 2;
+   */
+  requests: bigint;
+
+  /**
+   * @generated from field: int64 errors = 3;
+   */
+  errors: bigint;
+};
+
+/**
+ * Describes the message schedula.v1.MethodUsage.
+ * Use `create(MethodUsageSchema)` to create a new message.
+ */
+export const MethodUsageSchema: GenMessage<MethodUsage> = /*@__PURE__*/
+  messageDesc(file_proto_schedula_v1_admin, 12);
+
+/**
+ * @generated from message schedula.v1.UserUsage
+ */
+export type UserUsage = Message<"schedula.v1.UserUsage"> & {
+  /**
+   * @generated from field: string user_id = 1;
+   */
+  userId: string;
+
+  /**
+   * @generated from field: int64 requests = 2;
+   */
+  requests: bigint;
+
+  /**
+   * @generated from field: int64 errors = 3;
+   */
+  errors: bigint;
+
+  /**
+   * @generated from field: repeated schedula.v1.MethodUsage methods = 4;
+   */
+  methods: MethodUsage[];
+};
+
+/**
+ * Describes the message schedula.v1.UserUsage.
+ * Use `create(UserUsageSchema)` to create a new message.
+ */
+export const UserUsageSchema: GenMessage<UserUsage> = /*@__PURE__*/
+  messageDesc(file_proto_schedula_v1_admin, 13);
+
+/**
+ * @generated from message schedula.v1.GetAPIUsageRequest
+ */
+export type GetAPIUsageRequest = Message<"schedula.v1.GetAPIUsageRequest"> & {
+  /**
+   * @generated from field: string user_id = 1;
+   */
+  userId: string;
+
+  /**
+   * @generated from field: uint32 limit = 2;
+   */
+  limit: number;
+};
+
+/**
+ * Describes the message schedula.v1.GetAPIUsageRequest.
+ * Use `create(GetAPIUsageRequestSchema)` to create a new message.
+ */
+export const GetAPIUsageRequestSchema: GenMessage<GetAPIUsageRequest> = /*@__PURE__*/
+  messageDesc(file_proto_schedula_v1_admin, 14);
+
+/**
+ * @generated from message schedula.v1.GetAPIUsageResponse
+ */
+export type GetAPIUsageResponse = Message<"schedula.v1.GetAPIUsageResponse"> & {
+  /**
+   * @generated from field: google.protobuf.Duration window = 1;
+   */
+  window?: Duration;
+
+  /**
+   * @generated from field: repeated schedula.v1.UserUsage users = 2;
+   */
+  users: UserUsage[];
+};
+
+/**
+ * Describes the message schedula.v1.GetAPIUsageResponse.
+ * Use `create(GetAPIUsageResponseSchema)` to create a new message.
+ */
+export const GetAPIUsageResponseSchema: GenMessage<GetAPIUsageResponse> = /*@__PURE__*/
+  messageDesc(file_proto_schedula_v1_admin, 15);
+
+/**
+ * @generated from message schedula.v1.MethodHealth
+ */
+export type MethodHealth = Message<"schedula.v1.MethodHealth"> & {
+  /**
+   * @generated from field: string method = 1;
+   */
+  method: string;
+
+  /**
+   * @generated from field: int64 requests = 2;
+   */
+  requests: bigint;
+
+  /**
+   * @generated from field: int64 server_errors = 3;
+   */
+  serverErrors: bigint;
+
+  /**
+   * @generated from field: double availability = 4;
+   */
+  availability: number;
+
+  /**
+   * @generated from field: int64 conflicts = 5;
+   */
+  conflicts: bigint;
+
+  /**
+   * @generated from field: double conflict_rate = 6;
+   */
+  conflictRate: number;
+
+  /**
+   * @generated from field: google.protobuf.Duration p99_latency = 7;
+   */
+  p99Latency?: Duration;
+};
+
+/**
+ * Describes the message schedula.v1.MethodHealth.
+ * Use `create(MethodHealthSchema)` to create a new message.
+ */
+export const MethodHealthSchema: GenMessage<MethodHealth> = /*@__PURE__*/
+  messageDesc(file_proto_schedula_v1_admin, 16);
+
+/**
+ * @generated from message schedula.v1.HealthWindow
+ */
+export type HealthWindow = Message<"schedula.v1.HealthWindow"> & {
+  /**
+   * @generated from field: google.protobuf.Duration window = 1;
+   */
+  window?: Duration;
+
+  /**
+   * @generated from field: schedula.v1.MethodHealth total = 2;
+   */
+  total?: MethodHealth;
+
+  /**
+   * @generated from field: repeated schedula.v1.MethodHealth methods = 3;
+   */
+  methods: MethodHealth[];
+};
+
+/**
+ * Describes the message schedula.v1.HealthWindow.
+ * Use `create(HealthWindowSchema)` to create a new message.
+ */
+export const HealthWindowSchema: GenMessage<HealthWindow> = /*@__PURE__*/
+  messageDesc(file_proto_schedula_v1_admin, 17);
+
+/**
+ * @generated from message schedula.v1.GetServiceHealthSummaryRequest
+ */
+export type GetServiceHealthSummaryRequest = Message<"schedula.v1.GetServiceHealthSummaryRequest"> & {
+  /**
+   * @generated from field: repeated google.protobuf.Duration windows = 1;
+   */
+  windows: Duration[];
+};
+
+/**
+ * Describes the message schedula.v1.GetServiceHealthSummaryRequest.
+ * Use `create(GetServiceHealthSummaryRequestSchema)` to create a new message.
+ */
+export const GetServiceHealthSummaryRequestSchema: GenMessage<GetServiceHealthSummaryRequest> = /*@__PURE__*/
+  messageDesc(file_proto_schedula_v1_admin, 18);
+
+/**
+ * @generated from message schedula.v1.GetServiceHealthSummaryResponse
+ */
+export type GetServiceHealthSummaryResponse = Message<"schedula.v1.GetServiceHealthSummaryResponse"> & {
+  /**
+   * @generated from field: repeated schedula.v1.HealthWindow windows = 1;
+   */
+  windows: HealthWindow[];
+};
+
+/**
+ * Describes the message schedula.v1.GetServiceHealthSummaryResponse.
+ * Use `create(GetServiceHealthSummaryResponseSchema)` to create a new message.
+ */
+export const GetServiceHealthSummaryResponseSchema: GenMessage<GetServiceHealthSummaryResponse> = /*@__PURE__*/
+  messageDesc(file_proto_schedula_v1_admin, 19);
+
+/**
+ * @generated from message schedula.v1.LogTarget
+ */
+export type LogTarget = Message<"schedula.v1.LogTarget"> & {
+  /**
+   * @generated from field: schedula.v1.LogTargetKind kind = 1;
+   */
+  kind: LogTargetKind;
+
+  /**
+   * @generated from field: string id = 2;
+   */
+  id: string;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp expires_at = 3;
+   */
+  expiresAt?: Timestamp;
+};
+
+/**
+ * Describes the message schedula.v1.LogTarget.
+ * Use `create(LogTargetSchema)` to create a new message.
+ */
+export const LogTargetSchema: GenMessage<LogTarget> = /*@__PURE__*/
+  messageDesc(file_proto_schedula_v1_admin, 20);
+
+/**
+ * @generated from message schedula.v1.SetLogTargetRequest
+ */
+export type SetLogTargetRequest = Message<"schedula.v1.SetLogTargetRequest"> & {
+  /**
+   * @generated from field: schedula.v1.LogTargetKind kind = 1;
+   */
+  kind: LogTargetKind;
+
+  /**
+   * @generated from field: string id = 2;
+   */
+  id: string;
+
+  /**
+   * @generated from field: google.protobuf.Duration ttl = 3;
+   */
+  ttl?: Duration;
+};
+
+/**
+ * Describes the message schedula.v1.SetLogTargetRequest.
+ * Use `create(SetLogTargetRequestSchema)` to create a new message.
+ */
+export const SetLogTargetRequestSchema: GenMessage<SetLogTargetRequest> = /*@__PURE__*/
+  messageDesc(file_proto_schedula_v1_admin, 21);
+
+/**
+ * @generated from message schedula.v1.SetLogTargetResponse
+ */
+export type SetLogTargetResponse = Message<"schedula.v1.SetLogTargetResponse"> & {
+  /**
+   * @generated from field: schedula.v1.LogTarget target = 1;
+   */
+  target?: LogTarget;
+};
+
+/**
+ * Describes the message schedula.v1.SetLogTargetResponse.
+ * Use `create(SetLogTargetResponseSchema)` to create a new message.
+ */
+export const SetLogTargetResponseSchema: GenMessage<SetLogTargetResponse> = /*@__PURE__*/
+  messageDesc(file_proto_schedula_v1_admin, 22);
+
+/**
+ * @generated from message schedula.v1.ClearLogTargetRequest
+ */
+export type ClearLogTargetRequest = Message<"schedula.v1.ClearLogTargetRequest"> & {
+  /**
+   * @generated from field: schedula.v1.LogTargetKind kind = 1;
+   */
+  kind: LogTargetKind;
+
+  /**
+   * @generated from field: string id = 2;
+   */
+  id: string;
+};
+
+/**
+ * Describes the message schedula.v1.ClearLogTargetRequest.
+ * Use `create(ClearLogTargetRequestSchema)` to create a new message.
+ */
+export const ClearLogTargetRequestSchema: GenMessage<ClearLogTargetRequest> = /*@__PURE__*/
+  messageDesc(file_proto_schedula_v1_admin, 23);
+
+/**
+ * @generated from message schedula.v1.ClearLogTargetResponse
+ */
+export type ClearLogTargetResponse = Message<"schedula.v1.ClearLogTargetResponse"> & {
+};
+
+/**
+ * Describes the message schedula.v1.ClearLogTargetResponse.
+ * Use `create(ClearLogTargetResponseSchema)` to create a new message.
+ */
+export const ClearLogTargetResponseSchema: GenMessage<ClearLogTargetResponse> = /*@__PURE__*/
+  messageDesc(file_proto_schedula_v1_admin, 24);
+
+/**
+ * @generated from message schedula.v1.ListLogTargetsRequest
+ */
+export type ListLogTargetsRequest = Message<"schedula.v1.ListLogTargetsRequest"> & {
+};
+
+/**
+ * Describes the message schedula.v1.ListLogTargetsRequest.
+ * Use `create(ListLogTargetsRequestSchema)` to create a new message.
+ */
+export const ListLogTargetsRequestSchema: GenMessage<ListLogTargetsRequest> = /*@__PURE__*/
+  messageDesc(file_proto_schedula_v1_admin, 25);
+
+/**
+ * @generated from message schedula.v1.ListLogTargetsResponse
+ */
+export type ListLogTargetsResponse = Message<"schedula.v1.ListLogTargetsResponse"> & {
+  /**
+   * @generated from field: repeated schedula.v1.LogTarget targets = 1;
+   */
+  targets: LogTarget[];
+};
+
+/**
+ * Describes the message schedula.v1.ListLogTargetsResponse.
+ * Use `create(ListLogTargetsResponseSchema)` to create a new message.
+ */
+export const ListLogTargetsResponseSchema: GenMessage<ListLogTargetsResponse> = /*@__PURE__*/
+  messageDesc(file_proto_schedula_v1_admin, 26);
+
+/**
+ * @generated from message schedula.v1.UpdateRequestPolicyRequest
+ */
+export type UpdateRequestPolicyRequest = Message<"schedula.v1.UpdateRequestPolicyRequest"> & {
+  /**
+   * @generated from field: string user_id = 1;
+   */
+  userId: string;
+
+  /**
+   * @generated from field: google.protobuf.Duration request_timeout = 2;
+   */
+  requestTimeout?: Duration;
+
+  /**
+   * @generated from field: uint32 limits_multiplier = 3;
+   */
+  limitsMultiplier: number;
+};
+
+/**
+ * Describes the message schedula.v1.UpdateRequestPolicyRequest.
+ * Use `create(UpdateRequestPolicyRequestSchema)` to create a new message.
+ */
+export const UpdateRequestPolicyRequestSchema: GenMessage<UpdateRequestPolicyRequest> = /*@__PURE__*/
+  messageDesc(file_proto_schedula_v1_admin, 27);
+
+/**
+ * @generated from message schedula.v1.UpdateRequestPolicyResponse
+ */
+export type UpdateRequestPolicyResponse = Message<"schedula.v1.UpdateRequestPolicyResponse"> & {
+  /**
+   * @generated from field: string user_id = 1;
+   */
+  userId: string;
+
+  /**
+   * @generated from field: google.protobuf.Duration request_timeout = 2;
+   */
+  requestTimeout?: Duration;
+
+  /**
+   * @generated from field: uint32 limits_multiplier = 3;
+   */
+  limitsMultiplier: number;
+
+  /**
+   * @generated from field: uint32 max_title_length = 4;
+   */
+  maxTitleLength: number;
+
+  /**
+   * @generated from field: uint32 max_notes_length = 5;
+   */
+  maxNotesLength: number;
+
+  /**
+   * @generated from field: uint32 max_metadata_entries = 6;
+   */
+  maxMetadataEntries: number;
+};
+
+/**
+ * Describes the message schedula.v1.UpdateRequestPolicyResponse.
+ * Use `create(UpdateRequestPolicyResponseSchema)` to create a new message.
+ */
+export const UpdateRequestPolicyResponseSchema: GenMessage<UpdateRequestPolicyResponse> = /*@__PURE__*/
+  messageDesc(file_proto_schedula_v1_admin, 28);
+
+/**
+ * @generated from message schedula.v1.ProvisionedUser
+ */
+export type ProvisionedUser = Message<"schedula.v1.ProvisionedUser"> & {
+  /**
+   * @generated from field: string user_id = 1;
+   */
+  userId: string;
+
+  /**
+   * @generated from field: string display_name = 2;
+   */
+  displayName: string;
+
+  /**
+   * @generated from field: string email = 3;
+   */
+  email: string;
+
+  /**
+   * @generated from field: string external_id = 4;
+   */
+  externalId: string;
+
+  /**
+   * @generated from field: bool active = 5;
+   */
+  active: boolean;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp deactivated_at = 6;
+   */
+  deactivatedAt?: Timestamp;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp created_at = 7;
+   */
+  createdAt?: Timestamp;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp updated_at = 8;
+   */
+  updatedAt?: Timestamp;
+};
+
+/**
+ * Describes the message schedula.v1.ProvisionedUser.
+ * Use `create(ProvisionedUserSchema)` to create a new message.
+ */
+export const ProvisionedUserSchema: GenMessage<ProvisionedUser> = /*@__PURE__*/
+  messageDesc(file_proto_schedula_v1_admin, 29);
+
+/**
+ * @generated from message schedula.v1.ProvisionUserRequest
+ */
+export type ProvisionUserRequest = Message<"schedula.v1.ProvisionUserRequest"> & {
+  /**
+   * @generated from field: string user_id = 1;
+   */
+  userId: string;
+
+  /**
+   * @generated from field: string display_name = 2;
+   */
+  displayName: string;
+
+  /**
+   * @generated from field: string email = 3;
+   */
+  email: string;
+
+  /**
+   * @generated from field: string external_id = 4;
+   */
+  externalId: string;
+
+  /**
+   * @generated from field: bool deactivated = 5;
+   */
+  deactivated: boolean;
+};
+
+/**
+ * Describes the message schedula.v1.ProvisionUserRequest.
+ * Use `create(ProvisionUserRequestSchema)` to create a new message.
+ */
+export const ProvisionUserRequestSchema: GenMessage<ProvisionUserRequest> = /*@__PURE__*/
+  messageDesc(file_proto_schedula_v1_admin, 30);
+
+/**
+ * @generated from message schedula.v1.ProvisionUserResponse
+ */
+export type ProvisionUserResponse = Message<"schedula.v1.ProvisionUserResponse"> & {
+  /**
+   * @generated from field: schedula.v1.ProvisionedUser user = 1;
+   */
+  user?: ProvisionedUser;
+};
+
+/**
+ * Describes the message schedula.v1.ProvisionUserResponse.
+ * Use `create(ProvisionUserResponseSchema)` to create a new message.
+ */
+export const ProvisionUserResponseSchema: GenMessage<ProvisionUserResponse> = /*@__PURE__*/
+  messageDesc(file_proto_schedula_v1_admin, 31);
+
+/**
+ * @generated from message schedula.v1.DeactivateUserRequest
+ */
+export type DeactivateUserRequest = Message<"schedula.v1.DeactivateUserRequest"> & {
+  /**
+   * @generated from field: string user_id = 1;
+   */
+  userId: string;
+};
+
+/**
+ * Describes the message schedula.v1.DeactivateUserRequest.
+ * Use `create(DeactivateUserRequestSchema)` to create a new message.
+ */
+export const DeactivateUserRequestSchema: GenMessage<DeactivateUserRequest> = /*@__PURE__*/
+  messageDesc(file_proto_schedula_v1_admin, 32);
+
+/**
+ * @generated from message schedula.v1.DeactivateUserResponse
+ */
+export type DeactivateUserResponse = Message<"schedula.v1.DeactivateUserResponse"> & {
+  /**
+   * @generated from field: schedula.v1.ProvisionedUser user = 1;
+   */
+  user?: ProvisionedUser;
+};
+
+/**
+ * Describes the message schedula.v1.DeactivateUserResponse.
+ * Use `create(DeactivateUserResponseSchema)` to create a new message.
+ */
+export const DeactivateUserResponseSchema: GenMessage<DeactivateUserResponse> = /*@__PURE__*/
+  messageDesc(file_proto_schedula_v1_admin, 33);
+
+/**
+ * @generated from message schedula.v1.ReactivateUserRequest
+ */
+export type ReactivateUserRequest = Message<"schedula.v1.ReactivateUserRequest"> & {
+  /**
+   * @generated from field: string user_id = 1;
+   */
+  userId: string;
+};
+
+/**
+ * Describes the message schedula.v1.ReactivateUserRequest.
+ * Use `create(ReactivateUserRequestSchema)` to create a new message.
+ */
+export const ReactivateUserRequestSchema: GenMessage<ReactivateUserRequest> = /*@__PURE__*/
+  messageDesc(file_proto_schedula_v1_admin, 34);
+
+/**
+ * @generated from message schedula.v1.ReactivateUserResponse
+ */
+export type ReactivateUserResponse = Message<"schedula.v1.ReactivateUserResponse"> & {
+  /**
+   * @generated from field: schedula.v1.ProvisionedUser user = 1;
+   */
+  user?: ProvisionedUser;
+};
+
+/**
+ * Describes the message schedula.v1.ReactivateUserResponse.
+ * Use `create(ReactivateUserResponseSchema)` to create a new message.
+ */
+export const ReactivateUserResponseSchema: GenMessage<ReactivateUserResponse> = /*@__PURE__*/
+  messageDesc(file_proto_schedula_v1_admin, 35);
+
+/**
+ * @generated from message schedula.v1.ListProvisionedUsersRequest
+ */
+export type ListProvisionedUsersRequest = Message<"schedula.v1.ListProvisionedUsersRequest"> & {
+};
+
+/**
+ * Describes the message schedula.v1.ListProvisionedUsersRequest.
+ * Use `create(ListProvisionedUsersRequestSchema)` to create a new message.
+ */
+export const ListProvisionedUsersRequestSchema: GenMessage<ListProvisionedUsersRequest> = /*@__PURE__*/
+  messageDesc(file_proto_schedula_v1_admin, 36);
+
+/**
+ * @generated from message schedula.v1.ListProvisionedUsersResponse
+ */
+export type ListProvisionedUsersResponse = Message<"schedula.v1.ListProvisionedUsersResponse"> & {
+  /**
+   * @generated from field: repeated schedula.v1.ProvisionedUser users = 1;
+   */
+  users: ProvisionedUser[];
+};
+
+/**
+ * Describes the message schedula.v1.ListProvisionedUsersResponse.
+ * Use `create(ListProvisionedUsersResponseSchema)` to create a new message.
+ */
+export const ListProvisionedUsersResponseSchema: GenMessage<ListProvisionedUsersResponse> = /*@__PURE__*/
+  messageDesc(file_proto_schedula_v1_admin, 37);
+
+/**
+ * @generated from message schedula.v1.UserGroup
+ */
+export type UserGroup = Message<"schedula.v1.UserGroup"> & {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id: string;
+
+  /**
+   * @generated from field: string name = 2;
+   */
+  name: string;
+
+  /**
+   * @generated from field: string external_id = 3;
+   */
+  externalId: string;
+
+  /**
+   * @generated from field: repeated string member_ids = 4;
+   */
+  memberIds: string[];
+
+  /**
+   * @generated from field: google.protobuf.Timestamp created_at = 5;
+   */
+  createdAt?: Timestamp;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp updated_at = 6;
+   */
+  updatedAt?: Timestamp;
+};
+
+/**
+ * Describes the message schedula.v1.UserGroup.
+ * Use `create(UserGroupSchema)` to create a new message.
+ */
+export const UserGroupSchema: GenMessage<UserGroup> = /*@__PURE__*/
+  messageDesc(file_proto_schedula_v1_admin, 38);
+
+/**
+ * @generated from message schedula.v1.CreateUserGroupRequest
+ */
+export type CreateUserGroupRequest = Message<"schedula.v1.CreateUserGroupRequest"> & {
+  /**
+   * @generated from field: string name = 1;
+   */
+  name: string;
+
+  /**
+   * @generated from field: string external_id = 2;
+   */
+  externalId: string;
+};
+
+/**
+ * Describes the message schedula.v1.CreateUserGroupRequest.
+ * Use `create(CreateUserGroupRequestSchema)` to create a new message.
+ */
+export const CreateUserGroupRequestSchema: GenMessage<CreateUserGroupRequest> = /*@__PURE__*/
+  messageDesc(file_proto_schedula_v1_admin, 39);
+
+/**
+ * @generated from message schedula.v1.CreateUserGroupResponse
+ */
+export type CreateUserGroupResponse = Message<"schedula.v1.CreateUserGroupResponse"> & {
+  /**
+   * @generated from field: schedula.v1.UserGroup group = 1;
+   */
+  group?: UserGroup;
+};
+
+/**
+ * Describes the message schedula.v1.CreateUserGroupResponse.
+ * Use `create(CreateUserGroupResponseSchema)` to create a new message.
+ */
+export const CreateUserGroupResponseSchema: GenMessage<CreateUserGroupResponse> = /*@__PURE__*/
+  messageDesc(file_proto_schedula_v1_admin, 40);
+
+/**
+ * @generated from message schedula.v1.SetUserGroupMembersRequest
+ */
+export type SetUserGroupMembersRequest = Message<"schedula.v1.SetUserGroupMembersRequest"> & {
+  /**
+   * @generated from field: string group_id = 1;
+   */
+  groupId: string;
+
+  /**
+   * @generated from field: repeated string member_ids = 2;
+   */
+  memberIds: string[];
+};
+
+/**
+ * Describes the message schedula.v1.SetUserGroupMembersRequest.
+ * Use `create(SetUserGroupMembersRequestSchema)` to create a new message.
+ */
+export const SetUserGroupMembersRequestSchema: GenMessage<SetUserGroupMembersRequest> = /*@__PURE__*/
+  messageDesc(file_proto_schedula_v1_admin, 41);
+
+/**
+ * @generated from message schedula.v1.SetUserGroupMembersResponse
+ */
+export type SetUserGroupMembersResponse = Message<"schedula.v1.SetUserGroupMembersResponse"> & {
+  /**
+   * @generated from field: schedula.v1.UserGroup group = 1;
+   */
+  group?: UserGroup;
+};
+
+/**
+ * Describes the message schedula.v1.SetUserGroupMembersResponse.
+ * Use `create(SetUserGroupMembersResponseSchema)` to create a new message.
+ */
+export const SetUserGroupMembersResponseSchema: GenMessage<SetUserGroupMembersResponse> = /*@__PURE__*/
+  messageDesc(file_proto_schedula_v1_admin, 42);
+
+/**
+ * @generated from message schedula.v1.ListUserGroupsRequest
+ */
+export type ListUserGroupsRequest = Message<"schedula.v1.ListUserGroupsRequest"> & {
+};
+
+/**
+ * Describes the message schedula.v1.ListUserGroupsRequest.
+ * Use `create(ListUserGroupsRequestSchema)` to create a new message.
+ */
+export const ListUserGroupsRequestSchema: GenMessage<ListUserGroupsRequest> = /*@__PURE__*/
+  messageDesc(file_proto_schedula_v1_admin, 43);
+
+/**
+ * @generated from message schedula.v1.ListUserGroupsResponse
+ */
+export type ListUserGroupsResponse = Message<"schedula.v1.ListUserGroupsResponse"> & {
+  /**
+   * @generated from field: repeated schedula.v1.UserGroup groups = 1;
+   */
+  groups: UserGroup[];
+};
+
+/**
+ * Describes the message schedula.v1.ListUserGroupsResponse.
+ * Use `create(ListUserGroupsResponseSchema)` to create a new message.
+ */
+export const ListUserGroupsResponseSchema: GenMessage<ListUserGroupsResponse> = /*@__PURE__*/
+  messageDesc(file_proto_schedula_v1_admin, 44);
+
+/**
+ * @generated from message schedula.v1.UpdatePastStartPolicyRequest
+ */
+export type UpdatePastStartPolicyRequest = Message<"schedula.v1.UpdatePastStartPolicyRequest"> & {
+  /**
+   * @generated from field: string user_id = 1;
+   */
+  userId: string;
+
+  /**
+   * @generated from field: schedula.v1.PastStartPolicy policy = 2;
+   */
+  policy: PastStartPolicy;
+};
+
+/**
+ * Describes the message schedula.v1.UpdatePastStartPolicyRequest.
+ * Use `create(UpdatePastStartPolicyRequestSchema)` to create a new message.
+ */
+export const UpdatePastStartPolicyRequestSchema: GenMessage<UpdatePastStartPolicyRequest> = /*@__PURE__*/
+  messageDesc(file_proto_schedula_v1_admin, 45);
+
+/**
+ * @generated from message schedula.v1.UpdatePastStartPolicyResponse
+ */
+export type UpdatePastStartPolicyResponse = Message<"schedula.v1.UpdatePastStartPolicyResponse"> & {
+  /**
+   * @generated from field: string user_id = 1;
+   */
+  userId: string;
+
+  /**
+   * @generated from field: schedula.v1.PastStartPolicy policy = 2;
+   */
+  policy: PastStartPolicy;
+
+  /**
+   * @generated from field: schedula.v1.PastStartPolicy effective_policy = 3;
+   */
+  effectivePolicy: PastStartPolicy;
+};
+
+/**
+ * Describes the message schedula.v1.UpdatePastStartPolicyResponse.
+ * Use `create(UpdatePastStartPolicyResponseSchema)` to create a new message.
+ */
+export const UpdatePastStartPolicyResponseSchema: GenMessage<UpdatePastStartPolicyResponse> = /*@__PURE__*/
+  messageDesc(file_proto_schedula_v1_admin, 46);
+
+/**
+ * @generated from message schedula.v1.UpdateRetentionPolicyRequest
+ */
+export type UpdateRetentionPolicyRequest = Message<"schedula.v1.UpdateRetentionPolicyRequest"> & {
+  /**
+   * @generated from field: string user_id = 1;
+   */
+  userId: string;
+
+  /**
+   * @generated from field: uint32 retention_days = 2;
+   */
+  retentionDays: number;
+
+  /**
+   * @generated from field: bool use_server_default = 3;
+   */
+  useServerDefault: boolean;
+};
+
+/**
+ * Describes the message schedula.v1.UpdateRetentionPolicyRequest.
+ * Use `create(UpdateRetentionPolicyRequestSchema)` to create a new message.
+ */
+export const UpdateRetentionPolicyRequestSchema: GenMessage<UpdateRetentionPolicyRequest> = /*@__PURE__*/
+  messageDesc(file_proto_schedula_v1_admin, 47);
+
+/**
+ * @generated from message schedula.v1.UpdateRetentionPolicyResponse
+ */
+export type UpdateRetentionPolicyResponse = Message<"schedula.v1.UpdateRetentionPolicyResponse"> & {
+  /**
+   * @generated from field: string user_id = 1;
+   */
+  userId: string;
+
+  /**
+   * @generated from field: uint32 retention_days = 2;
+   */
+  retentionDays: number;
+
+  /**
+   * @generated from field: bool uses_server_default = 3;
+   */
+  usesServerDefault: boolean;
+
+  /**
+   * @generated from field: uint32 effective_retention_days = 4;
+   */
+  effectiveRetentionDays: number;
+};
+
+/**
+ * Describes the message schedula.v1.UpdateRetentionPolicyResponse.
+ * Use `create(UpdateRetentionPolicyResponseSchema)` to create a new message.
+ */
+export const UpdateRetentionPolicyResponseSchema: GenMessage<UpdateRetentionPolicyResponse> = /*@__PURE__*/
+  messageDesc(file_proto_schedula_v1_admin, 48);
+
+/**
+ * @generated from message schedula.v1.PurgeExpiredAppointmentsRequest
+ */
+export type PurgeExpiredAppointmentsRequest = Message<"schedula.v1.PurgeExpiredAppointmentsRequest"> & {
+  /**
+   * @generated from field: bool dry_run = 1;
+   */
+  dryRun: boolean;
+};
+
+/**
+ * Describes the message schedula.v1.PurgeExpiredAppointmentsRequest.
+ * Use `create(PurgeExpiredAppointmentsRequestSchema)` to create a new message.
+ */
+export const PurgeExpiredAppointmentsRequestSchema: GenMessage<PurgeExpiredAppointmentsRequest> = /*@__PURE__*/
+  messageDesc(file_proto_schedula_v1_admin, 49);
+
+/**
+ * @generated from message schedula.v1.RetentionPurge
+ */
+export type RetentionPurge = Message<"schedula.v1.RetentionPurge"> & {
+  /**
+   * @generated from field: string user_id = 1;
+   */
+  userId: string;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp cutoff = 2;
+   */
+  cutoff?: Timestamp;
+
+  /**
+   * @generated from field: int64 count = 3;
+   */
+  count: bigint;
+};
+
+/**
+ * Describes the message schedula.v1.RetentionPurge.
+ * Use `create(RetentionPurgeSchema)` to create a new message.
+ */
+export const RetentionPurgeSchema: GenMessage<RetentionPurge> = /*@__PURE__*/
+  messageDesc(file_proto_schedula_v1_admin, 50);
+
+/**
+ * @generated from message schedula.v1.PurgeExpiredAppointmentsResponse
+ */
+export type PurgeExpiredAppointmentsResponse = Message<"schedula.v1.PurgeExpiredAppointmentsResponse"> & {
+  /**
+   * @generated from field: bool dry_run = 1;
+   */
+  dryRun: boolean;
+
+  /**
+   * @generated from field: repeated schedula.v1.RetentionPurge users = 2;
+   */
+  users: RetentionPurge[];
+
+  /**
+   * @generated from field: int64 total = 3;
+   */
+  total: bigint;
+};
+
+/**
+ * Describes the message schedula.v1.PurgeExpiredAppointmentsResponse.
+ * Use `create(PurgeExpiredAppointmentsResponseSchema)` to create a new message.
+ */
+export const PurgeExpiredAppointmentsResponseSchema: GenMessage<PurgeExpiredAppointmentsResponse> = /*@__PURE__*/
+  messageDesc(file_proto_schedula_v1_admin, 51);
+
+/**
+ * @generated from message schedula.v1.CreateBackdatedAppointmentRequest
+ */
+export type CreateBackdatedAppointmentRequest = Message<"schedula.v1.CreateBackdatedAppointmentRequest"> & {
+  /**
+   * @generated from field: string user_id = 1;
+   */
+  userId: string;
+
+  /**
+   * @generated from field: string title = 2;
+   */
+  title: string;
+
+  /**
+   * @generated from field: string notes = 3;
+   */
+  notes: string;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp start_time = 4;
+   */
+  startTime?: Timestamp;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp end_time = 5;
+   */
+  endTime?: Timestamp;
+
+  /**
+   * @generated from field: string time_zone = 6;
+   */
+  timeZone: string;
+
+  /**
+   * @generated from field: map<string, string> metadata = 7;
+   */
+  metadata: { [key: string]: string };
+
+  /**
+   * @generated from field: string reason = 8;
+   */
+  reason: string;
+};
+
+/**
+ * Describes the message schedula.v1.CreateBackdatedAppointmentRequest.
+ * Use `create(CreateBackdatedAppointmentRequestSchema)` to create a new message.
+ */
+export const CreateBackdatedAppointmentRequestSchema: GenMessage<CreateBackdatedAppointmentRequest> = /*@__PURE__*/
+  messageDesc(file_proto_schedula_v1_admin, 52);
+
+/**
+ * @generated from message schedula.v1.CreateBackdatedAppointmentResponse
+ */
+export type CreateBackdatedAppointmentResponse = Message<"schedula.v1.CreateBackdatedAppointmentResponse"> & {
+  /**
+   * @generated from field: string appointment_id = 1;
+   */
+  appointmentId: string;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp start_time = 2;
+   */
+  startTime?: Timestamp;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp end_time = 3;
+   */
+  endTime?: Timestamp;
+};
+
+/**
+ * Describes the message schedula.v1.CreateBackdatedAppointmentResponse.
+ * Use `create(CreateBackdatedAppointmentResponseSchema)` to create a new message.
+ */
+export const CreateBackdatedAppointmentResponseSchema: GenMessage<CreateBackdatedAppointmentResponse> = /*@__PURE__*/
+  messageDesc(file_proto_schedula_v1_admin, 53);
+
+/**
+ * @generated from message schedula.v1.SourceDefault
+ */
+export type SourceDefault = Message<"schedula.v1.SourceDefault"> & {
+  /**
+   * @generated from field: string source = 1;
+   */
+  source: string;
+
+  /**
+   * @generated from field: string title_prefix = 2;
+   */
+  titlePrefix: string;
+
+  /**
+   * @generated from field: string notes_footer = 3;
+   */
+  notesFooter: string;
+};
+
+/**
+ * Describes the message schedula.v1.SourceDefault.
+ * Use `create(SourceDefaultSchema)` to create a new message.
+ */
+export const SourceDefaultSchema: GenMessage<SourceDefault> = /*@__PURE__*/
+  messageDesc(file_proto_schedula_v1_admin, 54);
+
+/**
+ * @generated from message schedula.v1.UpdateSourceDefaultsRequest
+ */
+export type UpdateSourceDefaultsRequest = Message<"schedula.v1.UpdateSourceDefaultsRequest"> & {
+  /**
+   * @generated from field: string user_id = 1;
+   */
+  userId: string;
+
+  /**
+   * @generated from field: repeated schedula.v1.SourceDefault defaults = 2;
+   */
+  defaults: SourceDefault[];
+};
+
+/**
+ * Describes the message schedula.v1.UpdateSourceDefaultsRequest.
+ * Use `create(UpdateSourceDefaultsRequestSchema)` to create a new message.
+ */
+export const UpdateSourceDefaultsRequestSchema: GenMessage<UpdateSourceDefaultsRequest> = /*@__PURE__*/
+  messageDesc(file_proto_schedula_v1_admin, 55);
+
+/**
+ * @generated from message schedula.v1.UpdateSourceDefaultsResponse
+ */
+export type UpdateSourceDefaultsResponse = Message<"schedula.v1.UpdateSourceDefaultsResponse"> & {
+  /**
+   * @generated from field: string user_id = 1;
+   */
+  userId: string;
+
+  /**
+   * @generated from field: repeated schedula.v1.SourceDefault defaults = 2;
+   */
+  defaults: SourceDefault[];
+};
+
+/**
+ * Describes the message schedula.v1.UpdateSourceDefaultsResponse.
+ * Use `create(UpdateSourceDefaultsResponseSchema)` to create a new message.
+ */
+export const UpdateSourceDefaultsResponseSchema: GenMessage<UpdateSourceDefaultsResponse> = /*@__PURE__*/
+  messageDesc(file_proto_schedula_v1_admin, 56);
+
+/**
+ * @generated from enum schedula.v1.BlackoutMode
+ */
+export enum BlackoutMode {
+  /**
+   * @generated from enum value: BLACKOUT_MODE_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * @generated from enum value: BLACKOUT_MODE_BLOCK = 1;
+   */
+  BLOCK = 1,
+
+  /**
+   * @generated from enum value: BLACKOUT_MODE_WARN = 2;
+   */
+  WARN = 2,
+}
+
+/**
+ * Describes the enum schedula.v1.BlackoutMode.
+ */
+export const BlackoutModeSchema: GenEnum<BlackoutMode> = /*@__PURE__*/
+  enumDesc(file_proto_schedula_v1_admin, 0);
+
+/**
+ * @generated from enum schedula.v1.LogTargetKind
+ */
+export enum LogTargetKind {
+  /**
+   * @generated from enum value: LOG_TARGET_KIND_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * @generated from enum value: LOG_TARGET_KIND_USER = 1;
+   */
+  USER = 1,
+
+  /**
+   * @generated from enum value: LOG_TARGET_KIND_REQUEST = 2;
+   */
+  REQUEST = 2,
+}
+
+/**
+ * Describes the enum schedula.v1.LogTargetKind.
+ */
+export const LogTargetKindSchema: GenEnum<LogTargetKind> = /*@__PURE__*/
+  enumDesc(file_proto_schedula_v1_admin, 1);
+
+/**
+ * @generated from enum schedula.v1.PastStartPolicy
+ */
+export enum PastStartPolicy {
+  /**
+   * @generated from enum value: PAST_START_POLICY_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * @generated from enum value: PAST_START_POLICY_ALLOW = 1;
+   */
+  ALLOW = 1,
+
+  /**
+   * @generated from enum value: PAST_START_POLICY_WARN = 2;
+   */
+  WARN = 2,
+
+  /**
+   * @generated from enum value: PAST_START_POLICY_REJECT = 3;
+   */
+  REJECT = 3,
+}
+
+/**
+ * Describes the enum schedula.v1.PastStartPolicy.
+ */
+export const PastStartPolicySchema: GenEnum<PastStartPolicy> = /*@__PURE__*/
+  enumDesc(file_proto_schedula_v1_admin, 2);
+
+/**
+ * @generated from service schedula.v1.AdminService
+ */
+export const AdminService: GenService<{
+  /**
+   * @generated from rpc schedula.v1.AdminService.GetDatabaseDiagnostics
+   */
+  getDatabaseDiagnostics: {
+    methodKind: "unary";
+    input: typeof GetDatabaseDiagnosticsRequestSchema;
+    output: typeof GetDatabaseDiagnosticsResponseSchema;
+  },
+  /**
+   * @generated from rpc schedula.v1.AdminService.CreateBlackout
+   */
+  createBlackout: {
+    methodKind: "unary";
+    input: typeof CreateBlackoutRequestSchema;
+    output: typeof CreateBlackoutResponseSchema;
+  },
+  /**
+   * @generated from rpc schedula.v1.AdminService.DeleteBlackout
+   */
+  deleteBlackout: {
+    methodKind: "unary";
+    input: typeof DeleteBlackoutRequestSchema;
+    output: typeof DeleteBlackoutResponseSchema;
+  },
+  /**
+   * @generated from rpc schedula.v1.AdminService.ListBlackouts
+   */
+  listBlackouts: {
+    methodKind: "unary";
+    input: typeof ListBlackoutsRequestSchema;
+    output: typeof ListBlackoutsResponseSchema;
+  },
+  /**
+   * @generated from rpc schedula.v1.AdminService.GetAPIUsage
+   */
+  getAPIUsage: {
+    methodKind: "unary";
+    input: typeof GetAPIUsageRequestSchema;
+    output: typeof GetAPIUsageResponseSchema;
+  },
+  /**
+   * @generated from rpc schedula.v1.AdminService.UpdatePastStartPolicy
+   */
+  updatePastStartPolicy: {
+    methodKind: "unary";
+    input: typeof UpdatePastStartPolicyRequestSchema;
+    output: typeof UpdatePastStartPolicyResponseSchema;
+  },
+  /**
+   * @generated from rpc schedula.v1.AdminService.CreateBackdatedAppointment
+   */
+  createBackdatedAppointment: {
+    methodKind: "unary";
+    input: typeof CreateBackdatedAppointmentRequestSchema;
+    output: typeof CreateBackdatedAppointmentResponseSchema;
+  },
+  /**
+   * @generated from rpc schedula.v1.AdminService.UpdateRetentionPolicy
+   */
+  updateRetentionPolicy: {
+    methodKind: "unary";
+    input: typeof UpdateRetentionPolicyRequestSchema;
+    output: typeof UpdateRetentionPolicyResponseSchema;
+  },
+  /**
+   * @generated from rpc schedula.v1.AdminService.PurgeExpiredAppointments
+   */
+  purgeExpiredAppointments: {
+    methodKind: "unary";
+    input: typeof PurgeExpiredAppointmentsRequestSchema;
+    output: typeof PurgeExpiredAppointmentsResponseSchema;
+  },
+  /**
+   * @generated from rpc schedula.v1.AdminService.GetServiceHealthSummary
+   */
+  getServiceHealthSummary: {
+    methodKind: "unary";
+    input: typeof GetServiceHealthSummaryRequestSchema;
+    output: typeof GetServiceHealthSummaryResponseSchema;
+  },
+  /**
+   * @generated from rpc schedula.v1.AdminService.SetLogTarget
+   */
+  setLogTarget: {
+    methodKind: "unary";
+    input: typeof SetLogTargetRequestSchema;
+    output: typeof SetLogTargetResponseSchema;
+  },
+  /**
+   * @generated from rpc schedula.v1.AdminService.ClearLogTarget
+   */
+  clearLogTarget: {
+    methodKind: "unary";
+    input: typeof ClearLogTargetRequestSchema;
+    output: typeof ClearLogTargetResponseSchema;
+  },
+  /**
+   * @generated from rpc schedula.v1.AdminService.ListLogTargets
+   */
+  listLogTargets: {
+    methodKind: "unary";
+    input: typeof ListLogTargetsRequestSchema;
+    output: typeof ListLogTargetsResponseSchema;
+  },
+  /**
+   * @generated from rpc schedula.v1.AdminService.UpdateRequestPolicy
+   */
+  updateRequestPolicy: {
+    methodKind: "unary";
+    input: typeof UpdateRequestPolicyRequestSchema;
+    output: typeof UpdateRequestPolicyResponseSchema;
+  },
+  /**
+   * @generated from rpc schedula.v1.AdminService.ProvisionUser
+   */
+  provisionUser: {
+    methodKind: "unary";
+    input: typeof ProvisionUserRequestSchema;
+    output: typeof ProvisionUserResponseSchema;
+  },
+  /**
+   * @generated from rpc schedula.v1.AdminService.DeactivateUser
+   */
+  deactivateUser: {
+    methodKind: "unary";
+    input: typeof DeactivateUserRequestSchema;
+    output: typeof DeactivateUserResponseSchema;
+  },
+  /**
+   * @generated from rpc schedula.v1.AdminService.ReactivateUser
+   */
+  reactivateUser: {
+    methodKind: "unary";
+    input: typeof ReactivateUserRequestSchema;
+    output: typeof ReactivateUserResponseSchema;
+  },
+  /**
+   * @generated from rpc schedula.v1.AdminService.ListProvisionedUsers
+   */
+  listProvisionedUsers: {
+    methodKind: "unary";
+    input: typeof ListProvisionedUsersRequestSchema;
+    output: typeof ListProvisionedUsersResponseSchema;
+  },
+  /**
+   * @generated from rpc schedula.v1.AdminService.CreateUserGroup
+   */
+  createUserGroup: {
+    methodKind: "unary";
+    input: typeof CreateUserGroupRequestSchema;
+    output: typeof CreateUserGroupResponseSchema;
+  },
+  /**
+   * @generated from rpc schedula.v1.AdminService.SetUserGroupMembers
+   */
+  setUserGroupMembers: {
+    methodKind: "unary";
+    input: typeof SetUserGroupMembersRequestSchema;
+    output: typeof SetUserGroupMembersResponseSchema;
+  },
+  /**
+   * @generated from rpc schedula.v1.AdminService.ListUserGroups
+   */
+  listUserGroups: {
+    methodKind: "unary";
+    input: typeof ListUserGroupsRequestSchema;
+    output: typeof ListUserGroupsResponseSchema;
+  },
+  /**
+   * @generated from rpc schedula.v1.AdminService.UpdateSourceDefaults
+   */
+  updateSourceDefaults: {
+    methodKind: "unary";
+    input: typeof UpdateSourceDefaultsRequestSchema;
+    output: typeof UpdateSourceDefaultsResponseSchema;
+  },
+}> = /*@__PURE__*/
+  serviceDesc(file_proto_schedula_v1_admin, 0);
+
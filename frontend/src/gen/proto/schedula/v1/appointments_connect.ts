@@ -3,7 +3,7 @@
 /* eslint-disable */
 // @ts-nocheck
 
-import { CreateAppointmentRequest, CreateAppointmentResponse, CreateRecurringSeriesRequest, CreateRecurringSeriesResponse, DeleteAppointmentRequest, DeleteAppointmentResponse, ListAppointmentsRequest, ListAppointmentsResponse, ListOccurrencesRequest, ListOccurrencesResponse } from "./appointments_pb.js";
+import { AcceptProposalRequest, AcceptProposalResponse, AuditCalendarRequest, AuditCalendarResponse, BatchGetFreeBusyRequest, BatchGetFreeBusyResponse, CancelProgramRequest, CancelProgramResponse, ChangeSeriesTimeZoneRequest, ChangeSeriesTimeZoneResponse, CheckInRequest, CheckInResponse, CheckOutRequest, CheckOutResponse, ConfirmHoldRequest, ConfirmHoldResponse, CreateAppointmentRequest, CreateAppointmentResponse, CreateCalendarSnapshotRequest, CreateCalendarSnapshotResponse, CreateContactRequest, CreateContactResponse, CreateEmbedTokenRequest, CreateEmbedTokenResponse, CreateProgramRequest, CreateProgramResponse, CreateRecurringSeriesRequest, CreateRecurringSeriesResponse, CreateTimeOffRequest, CreateTimeOffResponse, DeclineProposalRequest, DeclineProposalResponse, DeleteAppointmentRequest, DeleteAppointmentResponse, DeleteContactRequest, DeleteContactResponse, DeleteTimeOffRequest, DeleteTimeOffResponse, ExportBillableHoursRequest, ExportBillableHoursResponse, ExportCalendarRequest, ExportCalendarResponse, GetAnalyticsRequest, GetAnalyticsResponse, GetAppointmentByExternalRefRequest, GetAppointmentByExternalRefResponse, GetAttendanceStatsRequest, GetAttendanceStatsResponse, GetContactRequest, GetContactResponse, GetDailyAgendaRequest, GetDailyAgendaResponse, GetDaySummariesRequest, GetDaySummariesResponse, GetLimitsRequest, GetLimitsResponse, GetProgramRequest, GetProgramResponse, GetRecurringSeriesRequest, GetRecurringSeriesResponse, GetSlotSettingsRequest, GetSlotSettingsResponse, GetTimeOffRequest, GetTimeOffResponse, GrantDelegationRequest, GrantDelegationResponse, ImportCalendarRequest, ImportCalendarResponse, LinkAppointmentsRequest, LinkAppointmentsResponse, ListAppointmentsRequest, ListAppointmentsResponse, ListCalendarSnapshotsRequest, ListCalendarSnapshotsResponse, ListChangesRequest, ListChangesResponse, ListContactsRequest, ListContactsResponse, ListDelegationsRequest, ListDelegationsResponse, ListOccurrencesRequest, ListOccurrencesResponse, ListProgramsRequest, ListProgramsResponse, ListProposalsRequest, ListProposalsResponse, ListRecurringSeriesRequest, ListRecurringSeriesResponse, ListRelatedRequest, ListRelatedResponse, ListTimeOffRequest, ListTimeOffResponse, MarkAttendanceRequest, MarkAttendanceResponse, MergeAppointmentsRequest, MergeAppointmentsResponse, ProposeAppointmentRequest, ProposeAppointmentResponse, ReconcileCalendarRequest, ReconcileCalendarResponse, ReleaseHoldRequest, ReleaseHoldResponse, RepairRecurringSeriesRequest, RepairRecurringSeriesResponse, ReserveSlotRequest, ReserveSlotResponse, RestoreCalendarSnapshotRequest, RestoreCalendarSnapshotResponse, RevokeDelegationRequest, RevokeDelegationResponse, SimulateScheduleRequest, SimulateScheduleResponse, SkipOccurrencesRequest, SkipOccurrencesResponse, SuggestEndTimeRequest, SuggestEndTimeResponse, SuggestMeetingTimesRequest, SuggestMeetingTimesResponse, UnlinkAppointmentsRequest, UnlinkAppointmentsResponse, UpdateContactRequest, UpdateContactResponse, UpdateDailyBreaksRequest, UpdateDailyBreaksResponse, UpdateSeriesEndRequest, UpdateSeriesEndResponse, UpdateSlotSettingsRequest, UpdateSlotSettingsResponse, UpdateTimeOffRequest, UpdateTimeOffResponse, WatchOccurrencesRequest, WatchOccurrencesResponse } from "./appointments_pb.js";
 import { MethodKind } from "@bufbuild/protobuf";
 
 /**
@@ -57,6 +57,555 @@ export const AppointmentsService = {
       O: ListOccurrencesResponse,
       kind: MethodKind.Unary,
     },
+    /**
+     * @generated from rpc schedula.v1.AppointmentsService.GetRecurringSeries
+     */
+    getRecurringSeries: {
+      name: "GetRecurringSeries",
+      I: GetRecurringSeriesRequest,
+      O: GetRecurringSeriesResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc schedula.v1.AppointmentsService.ListRecurringSeries
+     */
+    listRecurringSeries: {
+      name: "ListRecurringSeries",
+      I: ListRecurringSeriesRequest,
+      O: ListRecurringSeriesResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc schedula.v1.AppointmentsService.MarkAttendance
+     */
+    markAttendance: {
+      name: "MarkAttendance",
+      I: MarkAttendanceRequest,
+      O: MarkAttendanceResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc schedula.v1.AppointmentsService.GetAttendanceStats
+     */
+    getAttendanceStats: {
+      name: "GetAttendanceStats",
+      I: GetAttendanceStatsRequest,
+      O: GetAttendanceStatsResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc schedula.v1.AppointmentsService.GetLimits
+     */
+    getLimits: {
+      name: "GetLimits",
+      I: GetLimitsRequest,
+      O: GetLimitsResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc schedula.v1.AppointmentsService.GetAppointmentByExternalRef
+     */
+    getAppointmentByExternalRef: {
+      name: "GetAppointmentByExternalRef",
+      I: GetAppointmentByExternalRefRequest,
+      O: GetAppointmentByExternalRefResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc schedula.v1.AppointmentsService.GetAnalytics
+     */
+    getAnalytics: {
+      name: "GetAnalytics",
+      I: GetAnalyticsRequest,
+      O: GetAnalyticsResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc schedula.v1.AppointmentsService.SuggestEndTime
+     */
+    suggestEndTime: {
+      name: "SuggestEndTime",
+      I: SuggestEndTimeRequest,
+      O: SuggestEndTimeResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc schedula.v1.AppointmentsService.ReserveSlot
+     */
+    reserveSlot: {
+      name: "ReserveSlot",
+      I: ReserveSlotRequest,
+      O: ReserveSlotResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc schedula.v1.AppointmentsService.ConfirmHold
+     */
+    confirmHold: {
+      name: "ConfirmHold",
+      I: ConfirmHoldRequest,
+      O: ConfirmHoldResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc schedula.v1.AppointmentsService.ReleaseHold
+     */
+    releaseHold: {
+      name: "ReleaseHold",
+      I: ReleaseHoldRequest,
+      O: ReleaseHoldResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc schedula.v1.AppointmentsService.ProposeAppointment
+     */
+    proposeAppointment: {
+      name: "ProposeAppointment",
+      I: ProposeAppointmentRequest,
+      O: ProposeAppointmentResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc schedula.v1.AppointmentsService.ListProposals
+     */
+    listProposals: {
+      name: "ListProposals",
+      I: ListProposalsRequest,
+      O: ListProposalsResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc schedula.v1.AppointmentsService.AcceptProposal
+     */
+    acceptProposal: {
+      name: "AcceptProposal",
+      I: AcceptProposalRequest,
+      O: AcceptProposalResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc schedula.v1.AppointmentsService.DeclineProposal
+     */
+    declineProposal: {
+      name: "DeclineProposal",
+      I: DeclineProposalRequest,
+      O: DeclineProposalResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc schedula.v1.AppointmentsService.LinkAppointments
+     */
+    linkAppointments: {
+      name: "LinkAppointments",
+      I: LinkAppointmentsRequest,
+      O: LinkAppointmentsResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc schedula.v1.AppointmentsService.UnlinkAppointments
+     */
+    unlinkAppointments: {
+      name: "UnlinkAppointments",
+      I: UnlinkAppointmentsRequest,
+      O: UnlinkAppointmentsResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc schedula.v1.AppointmentsService.ListRelated
+     */
+    listRelated: {
+      name: "ListRelated",
+      I: ListRelatedRequest,
+      O: ListRelatedResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc schedula.v1.AppointmentsService.MergeAppointments
+     */
+    mergeAppointments: {
+      name: "MergeAppointments",
+      I: MergeAppointmentsRequest,
+      O: MergeAppointmentsResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc schedula.v1.AppointmentsService.BatchGetFreeBusy
+     */
+    batchGetFreeBusy: {
+      name: "BatchGetFreeBusy",
+      I: BatchGetFreeBusyRequest,
+      O: BatchGetFreeBusyResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc schedula.v1.AppointmentsService.SuggestMeetingTimes
+     */
+    suggestMeetingTimes: {
+      name: "SuggestMeetingTimes",
+      I: SuggestMeetingTimesRequest,
+      O: SuggestMeetingTimesResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc schedula.v1.AppointmentsService.RepairRecurringSeries
+     */
+    repairRecurringSeries: {
+      name: "RepairRecurringSeries",
+      I: RepairRecurringSeriesRequest,
+      O: RepairRecurringSeriesResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc schedula.v1.AppointmentsService.SkipOccurrences
+     */
+    skipOccurrences: {
+      name: "SkipOccurrences",
+      I: SkipOccurrencesRequest,
+      O: SkipOccurrencesResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc schedula.v1.AppointmentsService.UpdateSeriesEnd
+     */
+    updateSeriesEnd: {
+      name: "UpdateSeriesEnd",
+      I: UpdateSeriesEndRequest,
+      O: UpdateSeriesEndResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc schedula.v1.AppointmentsService.ChangeSeriesTimeZone
+     */
+    changeSeriesTimeZone: {
+      name: "ChangeSeriesTimeZone",
+      I: ChangeSeriesTimeZoneRequest,
+      O: ChangeSeriesTimeZoneResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc schedula.v1.AppointmentsService.AuditCalendar
+     */
+    auditCalendar: {
+      name: "AuditCalendar",
+      I: AuditCalendarRequest,
+      O: AuditCalendarResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc schedula.v1.AppointmentsService.GetDailyAgenda
+     */
+    getDailyAgenda: {
+      name: "GetDailyAgenda",
+      I: GetDailyAgendaRequest,
+      O: GetDailyAgendaResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc schedula.v1.AppointmentsService.GetDaySummaries
+     */
+    getDaySummaries: {
+      name: "GetDaySummaries",
+      I: GetDaySummariesRequest,
+      O: GetDaySummariesResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc schedula.v1.AppointmentsService.GrantDelegation
+     */
+    grantDelegation: {
+      name: "GrantDelegation",
+      I: GrantDelegationRequest,
+      O: GrantDelegationResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc schedula.v1.AppointmentsService.RevokeDelegation
+     */
+    revokeDelegation: {
+      name: "RevokeDelegation",
+      I: RevokeDelegationRequest,
+      O: RevokeDelegationResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc schedula.v1.AppointmentsService.ListDelegations
+     */
+    listDelegations: {
+      name: "ListDelegations",
+      I: ListDelegationsRequest,
+      O: ListDelegationsResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc schedula.v1.AppointmentsService.ExportCalendar
+     */
+    exportCalendar: {
+      name: "ExportCalendar",
+      I: ExportCalendarRequest,
+      O: ExportCalendarResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc schedula.v1.AppointmentsService.WatchOccurrences
+     */
+    watchOccurrences: {
+      name: "WatchOccurrences",
+      I: WatchOccurrencesRequest,
+      O: WatchOccurrencesResponse,
+      kind: MethodKind.ServerStreaming,
+    },
+    /**
+     * @generated from rpc schedula.v1.AppointmentsService.ListChanges
+     */
+    listChanges: {
+      name: "ListChanges",
+      I: ListChangesRequest,
+      O: ListChangesResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc schedula.v1.AppointmentsService.ImportCalendar
+     */
+    importCalendar: {
+      name: "ImportCalendar",
+      I: ImportCalendarRequest,
+      O: ImportCalendarResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc schedula.v1.AppointmentsService.ReconcileCalendar
+     */
+    reconcileCalendar: {
+      name: "ReconcileCalendar",
+      I: ReconcileCalendarRequest,
+      O: ReconcileCalendarResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc schedula.v1.AppointmentsService.CheckIn
+     */
+    checkIn: {
+      name: "CheckIn",
+      I: CheckInRequest,
+      O: CheckInResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc schedula.v1.AppointmentsService.CheckOut
+     */
+    checkOut: {
+      name: "CheckOut",
+      I: CheckOutRequest,
+      O: CheckOutResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc schedula.v1.AppointmentsService.ExportBillableHours
+     */
+    exportBillableHours: {
+      name: "ExportBillableHours",
+      I: ExportBillableHoursRequest,
+      O: ExportBillableHoursResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc schedula.v1.AppointmentsService.CreateContact
+     */
+    createContact: {
+      name: "CreateContact",
+      I: CreateContactRequest,
+      O: CreateContactResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc schedula.v1.AppointmentsService.GetContact
+     */
+    getContact: {
+      name: "GetContact",
+      I: GetContactRequest,
+      O: GetContactResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc schedula.v1.AppointmentsService.UpdateContact
+     */
+    updateContact: {
+      name: "UpdateContact",
+      I: UpdateContactRequest,
+      O: UpdateContactResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc schedula.v1.AppointmentsService.DeleteContact
+     */
+    deleteContact: {
+      name: "DeleteContact",
+      I: DeleteContactRequest,
+      O: DeleteContactResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc schedula.v1.AppointmentsService.ListContacts
+     */
+    listContacts: {
+      name: "ListContacts",
+      I: ListContactsRequest,
+      O: ListContactsResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc schedula.v1.AppointmentsService.CreateProgram
+     */
+    createProgram: {
+      name: "CreateProgram",
+      I: CreateProgramRequest,
+      O: CreateProgramResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc schedula.v1.AppointmentsService.GetProgram
+     */
+    getProgram: {
+      name: "GetProgram",
+      I: GetProgramRequest,
+      O: GetProgramResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc schedula.v1.AppointmentsService.ListPrograms
+     */
+    listPrograms: {
+      name: "ListPrograms",
+      I: ListProgramsRequest,
+      O: ListProgramsResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc schedula.v1.AppointmentsService.CancelProgram
+     */
+    cancelProgram: {
+      name: "CancelProgram",
+      I: CancelProgramRequest,
+      O: CancelProgramResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc schedula.v1.AppointmentsService.CreateEmbedToken
+     */
+    createEmbedToken: {
+      name: "CreateEmbedToken",
+      I: CreateEmbedTokenRequest,
+      O: CreateEmbedTokenResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc schedula.v1.AppointmentsService.GetSlotSettings
+     */
+    getSlotSettings: {
+      name: "GetSlotSettings",
+      I: GetSlotSettingsRequest,
+      O: GetSlotSettingsResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc schedula.v1.AppointmentsService.UpdateSlotSettings
+     */
+    updateSlotSettings: {
+      name: "UpdateSlotSettings",
+      I: UpdateSlotSettingsRequest,
+      O: UpdateSlotSettingsResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc schedula.v1.AppointmentsService.UpdateDailyBreaks
+     */
+    updateDailyBreaks: {
+      name: "UpdateDailyBreaks",
+      I: UpdateDailyBreaksRequest,
+      O: UpdateDailyBreaksResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc schedula.v1.AppointmentsService.CreateTimeOff
+     */
+    createTimeOff: {
+      name: "CreateTimeOff",
+      I: CreateTimeOffRequest,
+      O: CreateTimeOffResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc schedula.v1.AppointmentsService.GetTimeOff
+     */
+    getTimeOff: {
+      name: "GetTimeOff",
+      I: GetTimeOffRequest,
+      O: GetTimeOffResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc schedula.v1.AppointmentsService.UpdateTimeOff
+     */
+    updateTimeOff: {
+      name: "UpdateTimeOff",
+      I: UpdateTimeOffRequest,
+      O: UpdateTimeOffResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc schedula.v1.AppointmentsService.DeleteTimeOff
+     */
+    deleteTimeOff: {
+      name: "DeleteTimeOff",
+      I: DeleteTimeOffRequest,
+      O: DeleteTimeOffResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc schedula.v1.AppointmentsService.ListTimeOff
+     */
+    listTimeOff: {
+      name: "ListTimeOff",
+      I: ListTimeOffRequest,
+      O: ListTimeOffResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc schedula.v1.AppointmentsService.SimulateSchedule
+     */
+    simulateSchedule: {
+      name: "SimulateSchedule",
+      I: SimulateScheduleRequest,
+      O: SimulateScheduleResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc schedula.v1.AppointmentsService.CreateCalendarSnapshot
+     */
+    createCalendarSnapshot: {
+      name: "CreateCalendarSnapshot",
+      I: CreateCalendarSnapshotRequest,
+      O: CreateCalendarSnapshotResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc schedula.v1.AppointmentsService.ListCalendarSnapshots
+     */
+    listCalendarSnapshots: {
+      name: "ListCalendarSnapshots",
+      I: ListCalendarSnapshotsRequest,
+      O: ListCalendarSnapshotsResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc schedula.v1.AppointmentsService.RestoreCalendarSnapshot
+     */
+    restoreCalendarSnapshot: {
+      name: "RestoreCalendarSnapshot",
+      I: RestoreCalendarSnapshotRequest,
+      O: RestoreCalendarSnapshotResponse,
+      kind: MethodKind.Unary,
+    },
   }
 } as const;
 
@@ -4,15 +4,15 @@
 
 import type { GenEnum, GenFile, GenMessage, GenService } from "@bufbuild/protobuf/codegenv2";
 import { enumDesc, fileDesc, messageDesc, serviceDesc } from "@bufbuild/protobuf/codegenv2";
-import type { Timestamp } from "@bufbuild/protobuf/wkt";
-import { file_google_protobuf_timestamp } from "@bufbuild/protobuf/wkt";
+import type { Duration, Timestamp } from "@bufbuild/protobuf/wkt";
+import { file_google_protobuf_duration, file_google_protobuf_timestamp } from "@bufbuild/protobuf/wkt";
 import type { Message } from "@bufbuild/protobuf";
 
 /**
  * Describes the file proto/schedula/v1/appointments.proto.
  */
 export const file_proto_schedula_v1_appointments: GenFile = /*@__PURE__*/
-  fileDesc("CiRwcm90by9zY2hlZHVsYS92MS9hcHBvaW50bWVudHMucHJvdG8SC3NjaGVkdWxhLnYxIpkBChBXZWVrbHlSZWN1cnJlbmNlEhAKCGludGVydmFsGAEgASgNEiYKCHdlZWtkYXlzGAIgAygOMhQuc2NoZWR1bGEudjEuV2Vla2RheRIpCgV1bnRpbBgDIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASDQoFY291bnQYBCABKA0SEQoJdGltZV96b25lGAUgASgJIoYCCgtBcHBvaW50bWVudBIKCgJpZBgBIAEoCRIPCgd1c2VyX2lkGAIgASgJEg0KBXRpdGxlGAMgASgJEg0KBW5vdGVzGAQgASgJEi4KCnN0YXJ0X3RpbWUYBSABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEiwKCGVuZF90aW1lGAYgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIuCgpjcmVhdGVkX2F0GAcgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIuCgp1cGRhdGVkX2F0GAggASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcCKnAQoYQ3JlYXRlQXBwb2ludG1lbnRSZXF1ZXN0Eg8KB3VzZXJfaWQYASABKAkSDQoFdGl0bGUYAiABKAkSDQoFbm90ZXMYAyABKAkSLgoKc3RhcnRfdGltZRgEIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASLAoIZW5kX3RpbWUYBSABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wIkoKGUNyZWF0ZUFwcG9pbnRtZW50UmVzcG9uc2USLQoLYXBwb2ludG1lbnQYASABKAsyGC5zY2hlZHVsYS52MS5BcHBvaW50bWVudCKMAQoXTGlzdEFwcG9pbnRtZW50c1JlcXVlc3QSDwoHdXNlcl9pZBgBIAEoCRIwCgx3aW5kb3dfc3RhcnQYAiABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEi4KCndpbmRvd19lbmQYAyABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wIkoKGExpc3RBcHBvaW50bWVudHNSZXNwb25zZRIuCgxhcHBvaW50bWVudHMYASADKAsyGC5zY2hlZHVsYS52MS5BcHBvaW50bWVudCJDChhEZWxldGVBcHBvaW50bWVudFJlcXVlc3QSDwoHdXNlcl9pZBgBIAEoCRIWCg5hcHBvaW50bWVudF9pZBgCIAEoCSIbChlEZWxldGVBcHBvaW50bWVudFJlc3BvbnNlIrkCCg9SZWN1cnJpbmdTZXJpZXMSCgoCaWQYASABKAkSDwoHdXNlcl9pZBgCIAEoCRINCgV0aXRsZRgDIAEoCRINCgVub3RlcxgEIAEoCRIuCgpzdGFydF90aW1lGAUgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIsCghlbmRfdGltZRgGIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASLQoGd2Vla2x5GAcgASgLMh0uc2NoZWR1bGEudjEuV2Vla2x5UmVjdXJyZW5jZRIuCgpjcmVhdGVkX2F0GAggASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIuCgp1cGRhdGVkX2F0GAkgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcCLaAQocQ3JlYXRlUmVjdXJyaW5nU2VyaWVzUmVxdWVzdBIPCgd1c2VyX2lkGAEgASgJEg0KBXRpdGxlGAIgASgJEg0KBW5vdGVzGAMgASgJEi4KCnN0YXJ0X3RpbWUYBCABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEiwKCGVuZF90aW1lGAUgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBItCgZ3ZWVrbHkYBiABKAsyHS5zY2hlZHVsYS52MS5XZWVrbHlSZWN1cnJlbmNlIk0KHUNyZWF0ZVJlY3VycmluZ1Nlcmllc1Jlc3BvbnNlEiwKBnNlcmllcxgBIAEoCzIcLnNjaGVkdWxhLnYxLlJlY3VycmluZ1NlcmllcyLDAQoKT2NjdXJyZW5jZRIRCglzZXJpZXNfaWQYASABKAkSFQoNb2NjdXJyZW5jZV9pZBgCIAEoCRIPCgd1c2VyX2lkGAMgASgJEg0KBXRpdGxlGAQgASgJEg0KBW5vdGVzGAUgASgJEi4KCnN0YXJ0X3RpbWUYBiABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEiwKCGVuZF90aW1lGAcgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcCKLAQoWTGlzdE9jY3VycmVuY2VzUmVxdWVzdBIPCgd1c2VyX2lkGAEgASgJEjAKDHdpbmRvd19zdGFydBgCIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASLgoKd2luZG93X2VuZBgDIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXAiRwoXTGlzdE9jY3VycmVuY2VzUmVzcG9uc2USLAoLb2NjdXJyZW5jZXMYASADKAsyFy5zY2hlZHVsYS52MS5PY2N1cnJlbmNlKn4KB1dlZWtkYXkSFwoTV0VFS0RBWV9VTlNQRUNJRklFRBAAEgoKBk1PTkRBWRABEgsKB1RVRVNEQVkQAhINCglXRURORVNEQVkQAxIMCghUSFVSU0RBWRAEEgoKBkZSSURBWRAFEgwKCFNBVFVSREFZEAYSCgoGU1VOREFZEAcyjAQKE0FwcG9pbnRtZW50c1NlcnZpY2USYgoRQ3JlYXRlQXBwb2ludG1lbnQSJS5zY2hlZHVsYS52MS5DcmVhdGVBcHBvaW50bWVudFJlcXVlc3QaJi5zY2hlZHVsYS52MS5DcmVhdGVBcHBvaW50bWVudFJlc3BvbnNlEl8KEExpc3RBcHBvaW50bWVudHMSJC5zY2hlZHVsYS52MS5MaXN0QXBwb2ludG1lbnRzUmVxdWVzdBolLnNjaGVkdWxhLnYxLkxpc3RBcHBvaW50bWVudHNSZXNwb25zZRJiChFEZWxldGVBcHBvaW50bWVudBIlLnNjaGVkdWxhLnYxLkRlbGV0ZUFwcG9pbnRtZW50UmVxdWVzdBomLnNjaGVkdWxhLnYxLkRlbGV0ZUFwcG9pbnRtZW50UmVzcG9uc2USbgoVQ3JlYXRlUmVjdXJyaW5nU2VyaWVzEikuc2NoZWR1bGEudjEuQ3JlYXRlUmVjdXJyaW5nU2VyaWVzUmVxdWVzdBoqLnNjaGVkdWxhLnYxLkNyZWF0ZVJlY3VycmluZ1Nlcmllc1Jlc3BvbnNlElwKD0xpc3RPY2N1cnJlbmNlcxIjLnNjaGVkdWxhLnYxLkxpc3RPY2N1cnJlbmNlc1JlcXVlc3QaJC5zY2hlZHVsYS52MS5MaXN0T2NjdXJyZW5jZXNSZXNwb25zZUI8WjpzY2hlZHVsYS9iYWNrZW5kL2ludGVybmFsL2dlbi9wcm90by9zY2hlZHVsYS92MTtzY2hlZHVsZXYxYgZwcm90bzM", [file_google_protobuf_timestamp]);
+  fileDesc("CiRwcm90by9zY2hlZHVsYS92MS9hcHBvaW50bWVudHMucHJvdG8SC3NjaGVkdWxhLnYxIuYCChBXZWVrbHlSZWN1cnJlbmNlEhAKCGludGVydmFsGAEgASgNEiYKCHdlZWtkYXlzGAIgAygOMhQuc2NoZWR1bGEudjEuV2Vla2RheRIpCgV1bnRpbBgDIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASDQoFY291bnQYBCABKA0SEQoJdGltZV96b25lGAUgASgJEjEKDmRzdF9nYXBfcG9saWN5GAYgASgOMhkuc2NoZWR1bGEudjEuRHN0R2FwUG9saWN5Ej0KFGRzdF9hbWJpZ3VvdXNfcG9saWN5GAcgASgOMh8uc2NoZWR1bGEudjEuRHN0QW1iaWd1b3VzUG9saWN5EigKCndlZWtfc3RhcnQYCCABKA4yFC5zY2hlZHVsYS52MS5XZWVrZGF5Ei8KDXdlZWtkYXlfdGltZXMYCSADKAsyGC5zY2hlZHVsYS52MS5XZWVrZGF5VGltZSJKCgtXZWVrZGF5VGltZRIlCgd3ZWVrZGF5GAEgASgOMhQuc2NoZWR1bGEudjEuV2Vla2RheRIUCgxzdGFydF9taW51dGUYAiABKA0iKQoLRXh0ZXJuYWxSZWYSDgoGc3lzdGVtGAEgASgJEgoKAmlkGAIgASgJItwFCgtBcHBvaW50bWVudBIKCgJpZBgBIAEoCRIPCgd1c2VyX2lkGAIgASgJEg0KBXRpdGxlGAMgASgJEg0KBW5vdGVzGAQgASgJEi4KCnN0YXJ0X3RpbWUYBSABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEiwKCGVuZF90aW1lGAYgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIuCgpjcmVhdGVkX2F0GAcgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIuCgp1cGRhdGVkX2F0GAggASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBI4CghtZXRhZGF0YRgJIAMoCzImLnNjaGVkdWxhLnYxLkFwcG9pbnRtZW50Lk1ldGFkYXRhRW50cnkSLgoMZXh0ZXJuYWxfcmVmGAogASgLMhguc2NoZWR1bGEudjEuRXh0ZXJuYWxSZWYSEQoJdGltZV96b25lGAsgASgJEhgKEGxvY2FsX3N0YXJ0X3RpbWUYDCABKAkSFgoObG9jYWxfZW5kX3RpbWUYDSABKAkSEgoKY3JlYXRlZF9ieRgOIAEoCRIxCg1jaGVja2VkX2luX2F0GA8gASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIyCg5jaGVja2VkX291dF9hdBgQIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASEgoKY29udGFjdF9pZBgRIAEoCRIOCgZzb3VyY2UYEiABKAkSKgoEa2luZBgTIAEoDjIcLnNjaGVkdWxhLnYxLkFwcG9pbnRtZW50S2luZBIVCg1wcml2YXRlX25vdGVzGBQgASgJEhIKCnByb2dyYW1faWQYFSABKAkaLwoNTWV0YWRhdGFFbnRyeRILCgNrZXkYASABKAkSDQoFdmFsdWUYAiABKAk6AjgBIt8DChhDcmVhdGVBcHBvaW50bWVudFJlcXVlc3QSDwoHdXNlcl9pZBgBIAEoCRINCgV0aXRsZRgCIAEoCRINCgVub3RlcxgDIAEoCRIuCgpzdGFydF90aW1lGAQgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIsCghlbmRfdGltZRgFIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASRQoIbWV0YWRhdGEYBiADKAsyMy5zY2hlZHVsYS52MS5DcmVhdGVBcHBvaW50bWVudFJlcXVlc3QuTWV0YWRhdGFFbnRyeRIuCgxleHRlcm5hbF9yZWYYByABKAsyGC5zY2hlZHVsYS52MS5FeHRlcm5hbFJlZhIRCgl0aW1lX3pvbmUYCCABKAkSEAoIYWN0b3JfaWQYCSABKAkSEgoKY29udGFjdF9pZBgKIAEoCRIqCgRraW5kGAsgASgOMhwuc2NoZWR1bGEudjEuQXBwb2ludG1lbnRLaW5kEhUKDXByaXZhdGVfbm90ZXMYDCABKAkSEgoKcHJvZ3JhbV9pZBgNIAEoCRovCg1NZXRhZGF0YUVudHJ5EgsKA2tleRgBIAEoCRINCgV2YWx1ZRgCIAEoCToCOAEifgoPQmxhY2tvdXRXYXJuaW5nEg0KBXRpdGxlGAEgASgJEi4KCnN0YXJ0X3RpbWUYAiABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEiwKCGVuZF90aW1lGAMgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcCIoCgdXYXJuaW5nEgwKBGNvZGUYASABKAkSDwoHbWVzc2FnZRgCIAEoCSLHAQoZQ3JlYXRlQXBwb2ludG1lbnRSZXNwb25zZRItCgthcHBvaW50bWVudBgBIAEoCzIYLnNjaGVkdWxhLnYxLkFwcG9pbnRtZW50EjcKEWJsYWNrb3V0X3dhcm5pbmdzGAIgAygLMhwuc2NoZWR1bGEudjEuQmxhY2tvdXRXYXJuaW5nEhoKEnBhc3Rfc3RhcnRfd2FybmluZxgDIAEoCBImCgh3YXJuaW5ncxgEIAMoCzIULnNjaGVkdWxhLnYxLldhcm5pbmcimAMKF0xpc3RBcHBvaW50bWVudHNSZXF1ZXN0Eg8KB3VzZXJfaWQYASABKAkSMAoMd2luZG93X3N0YXJ0GAIgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIuCgp3aW5kb3dfZW5kGAMgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBJRCg9tZXRhZGF0YV9maWx0ZXIYBCADKAsyOC5zY2hlZHVsYS52MS5MaXN0QXBwb2ludG1lbnRzUmVxdWVzdC5NZXRhZGF0YUZpbHRlckVudHJ5EhcKD3NwbGl0X3RpbWVfem9uZRgFIAEoCRIbChNpbmNsdWRlX2xvY2FsX3RpbWVzGAYgASgIEhIKCnN0YXJ0X3N5bmMYByABKAgSEgoKc3luY190b2tlbhgIIAEoCRISCgpjb250YWN0X2lkGAkgASgJEg4KBnNvdXJjZRgKIAEoCRo1ChNNZXRhZGF0YUZpbHRlckVudHJ5EgsKA2tleRgBIAEoCRINCgV2YWx1ZRgCIAEoCToCOAEiigEKCkRheVNlZ21lbnQSCgoCaWQYASABKAkSEgoKbG9jYWxfZGF0ZRgCIAEoCRIuCgpzdGFydF90aW1lGAMgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIsCghlbmRfdGltZRgEIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXAi4AEKGExpc3RBcHBvaW50bWVudHNSZXNwb25zZRIuCgxhcHBvaW50bWVudHMYASADKAsyGC5zY2hlZHVsYS52MS5BcHBvaW50bWVudBItCgxkYXlfc2VnbWVudHMYAiADKAsyFy5zY2hlZHVsYS52MS5EYXlTZWdtZW50EhcKD25leHRfc3luY190b2tlbhgDIAEoCRIRCglmdWxsX3N5bmMYBCABKAgSHwoXZGVsZXRlZF9hcHBvaW50bWVudF9pZHMYBSADKAkSGAoQY2FsZW5kYXJfdmVyc2lvbhgGIAEoAyJlCiJHZXRBcHBvaW50bWVudEJ5RXh0ZXJuYWxSZWZSZXF1ZXN0Eg8KB3VzZXJfaWQYASABKAkSLgoMZXh0ZXJuYWxfcmVmGAIgASgLMhguc2NoZWR1bGEudjEuRXh0ZXJuYWxSZWYiVAojR2V0QXBwb2ludG1lbnRCeUV4dGVybmFsUmVmUmVzcG9uc2USLQoLYXBwb2ludG1lbnQYASABKAsyGC5zY2hlZHVsYS52MS5BcHBvaW50bWVudCJVChhEZWxldGVBcHBvaW50bWVudFJlcXVlc3QSDwoHdXNlcl9pZBgBIAEoCRIWCg5hcHBvaW50bWVudF9pZBgCIAEoCRIQCghhY3Rvcl9pZBgDIAEoCSIbChlEZWxldGVBcHBvaW50bWVudFJlc3BvbnNlItUECg9SZWN1cnJpbmdTZXJpZXMSCgoCaWQYASABKAkSDwoHdXNlcl9pZBgCIAEoCRINCgV0aXRsZRgDIAEoCRINCgVub3RlcxgEIAEoCRIuCgpzdGFydF90aW1lGAUgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIwCghlbmRfdGltZRgGIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXBCAhgBEi0KBndlZWtseRgHIAEoCzIdLnNjaGVkdWxhLnYxLldlZWtseVJlY3VycmVuY2USLgoKY3JlYXRlZF9hdBgIIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASLgoKdXBkYXRlZF9hdBgJIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASHQoVb2NjdXJyZW5jZXNfcmVtYWluaW5nGAogASgNEjMKD25leHRfb2NjdXJyZW5jZRgLIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASPAoIbWV0YWRhdGEYDCADKAsyKi5zY2hlZHVsYS52MS5SZWN1cnJpbmdTZXJpZXMuTWV0YWRhdGFFbnRyeRISCgpjcmVhdGVkX2J5GA0gASgJEhIKCnByb2dyYW1faWQYDiABKAkSKwoIZHVyYXRpb24YDyABKAsyGS5nb29nbGUucHJvdG9idWYuRHVyYXRpb24aLwoNTWV0YWRhdGFFbnRyeRILCgNrZXkYASABKAkSDQoFdmFsdWUYAiABKAk6AjgBIsUDChxDcmVhdGVSZWN1cnJpbmdTZXJpZXNSZXF1ZXN0Eg8KB3VzZXJfaWQYASABKAkSDQoFdGl0bGUYAiABKAkSDQoFbm90ZXMYAyABKAkSLgoKc3RhcnRfdGltZRgEIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASMAoIZW5kX3RpbWUYBSABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wQgIYARItCgZ3ZWVrbHkYBiABKAsyHS5zY2hlZHVsYS52MS5XZWVrbHlSZWN1cnJlbmNlEkkKCG1ldGFkYXRhGAcgAygLMjcuc2NoZWR1bGEudjEuQ3JlYXRlUmVjdXJyaW5nU2VyaWVzUmVxdWVzdC5NZXRhZGF0YUVudHJ5EhYKDnNraXBfY29uZmxpY3RzGAggASgIEhAKCGFjdG9yX2lkGAkgASgJEhIKCnByb2dyYW1faWQYCiABKAkSKwoIZHVyYXRpb24YCyABKAsyGS5nb29nbGUucHJvdG9idWYuRHVyYXRpb24aLwoNTWV0YWRhdGFFbnRyeRILCgNrZXkYASABKAkSDQoFdmFsdWUYAiABKAk6AjgBIucBCh1DcmVhdGVSZWN1cnJpbmdTZXJpZXNSZXNwb25zZRIsCgZzZXJpZXMYASABKAsyHC5zY2hlZHVsYS52MS5SZWN1cnJpbmdTZXJpZXMSNwoTc2tpcHBlZF9vY2N1cnJlbmNlcxgCIAMoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASNwoRYmxhY2tvdXRfd2FybmluZ3MYAyADKAsyHC5zY2hlZHVsYS52MS5CbGFja291dFdhcm5pbmcSJgoId2FybmluZ3MYBCADKAsyFC5zY2hlZHVsYS52MS5XYXJuaW5nIj8KGUdldFJlY3VycmluZ1Nlcmllc1JlcXVlc3QSDwoHdXNlcl9pZBgBIAEoCRIRCglzZXJpZXNfaWQYAiABKAkiSgoaR2V0UmVjdXJyaW5nU2VyaWVzUmVzcG9uc2USLAoGc2VyaWVzGAEgASgLMhwuc2NoZWR1bGEudjEuUmVjdXJyaW5nU2VyaWVzIlUKGkxpc3RSZWN1cnJpbmdTZXJpZXNSZXF1ZXN0Eg8KB3VzZXJfaWQYASABKAkSJgoId2Vla2RheXMYAiADKA4yFC5zY2hlZHVsYS52MS5XZWVrZGF5IksKG0xpc3RSZWN1cnJpbmdTZXJpZXNSZXNwb25zZRIsCgZzZXJpZXMYASADKAsyHC5zY2hlZHVsYS52MS5SZWN1cnJpbmdTZXJpZXMi8gIKCk9jY3VycmVuY2USEQoJc2VyaWVzX2lkGAEgASgJEhUKDW9jY3VycmVuY2VfaWQYAiABKAkSDwoHdXNlcl9pZBgDIAEoCRINCgV0aXRsZRgEIAEoCRINCgVub3RlcxgFIAEoCRIuCgpzdGFydF90aW1lGAYgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIsCghlbmRfdGltZRgHIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASNwoIbWV0YWRhdGEYCCADKAsyJS5zY2hlZHVsYS52MS5PY2N1cnJlbmNlLk1ldGFkYXRhRW50cnkSEQoJdGltZV96b25lGAkgASgJEhgKEGxvY2FsX3N0YXJ0X3RpbWUYCiABKAkSFgoObG9jYWxfZW5kX3RpbWUYCyABKAkaLwoNTWV0YWRhdGFFbnRyeRILCgNrZXkYASABKAkSDQoFdmFsdWUYAiABKAk6AjgBIpkCChZMaXN0T2NjdXJyZW5jZXNSZXF1ZXN0Eg8KB3VzZXJfaWQYASABKAkSMAoMd2luZG93X3N0YXJ0GAIgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIuCgp3aW5kb3dfZW5kGAMgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIXCg9zcGxpdF90aW1lX3pvbmUYBCABKAkSGwoTaW5jbHVkZV9sb2NhbF90aW1lcxgFIAEoCBISCgpzdGFydF9zeW5jGAYgASgIEhIKCnN5bmNfdG9rZW4YByABKAkSLgoLbWF4X2hvcml6b24YCCABKAsyGS5nb29nbGUucHJvdG9idWYuRHVyYXRpb24inwIKF0xpc3RPY2N1cnJlbmNlc1Jlc3BvbnNlEiwKC29jY3VycmVuY2VzGAEgAygLMhcuc2NoZWR1bGEudjEuT2NjdXJyZW5jZRItCgxkYXlfc2VnbWVudHMYAiADKAsyFy5zY2hlZHVsYS52MS5EYXlTZWdtZW50EhcKD25leHRfc3luY190b2tlbhgDIAEoCRIRCglmdWxsX3N5bmMYBCABKAgSGgoSY2hhbmdlZF9zZXJpZXNfaWRzGAUgAygJEhgKEGNhbGVuZGFyX3ZlcnNpb24YBiABKAMSEQoJdHJ1bmNhdGVkGAcgASgIEjIKDmV4cGFuZGVkX3VudGlsGAggASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcCLtAQoUT2NjdXJyZW5jZUF0dGVuZGFuY2USEQoJc2VyaWVzX2lkGAEgASgJEhUKDW9jY3VycmVuY2VfaWQYAiABKAkSFgoOcGFydGljaXBhbnRfaWQYAyABKAkSLQoGc3RhdHVzGAQgASgOMh0uc2NoZWR1bGEudjEuQXR0ZW5kYW5jZVN0YXR1cxI0ChBvY2N1cnJlbmNlX3N0YXJ0GAUgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIuCgp1cGRhdGVkX2F0GAYgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcCKZAQoVTWFya0F0dGVuZGFuY2VSZXF1ZXN0Eg8KB3VzZXJfaWQYASABKAkSEQoJc2VyaWVzX2lkGAIgASgJEhUKDW9jY3VycmVuY2VfaWQYAyABKAkSFgoOcGFydGljaXBhbnRfaWQYBCABKAkSLQoGc3RhdHVzGAUgASgOMh0uc2NoZWR1bGEudjEuQXR0ZW5kYW5jZVN0YXR1cyJPChZNYXJrQXR0ZW5kYW5jZVJlc3BvbnNlEjUKCmF0dGVuZGFuY2UYASABKAsyIS5zY2hlZHVsYS52MS5PY2N1cnJlbmNlQXR0ZW5kYW5jZSJWChpQYXJ0aWNpcGFudEF0dGVuZGFuY2VTdGF0cxIWCg5wYXJ0aWNpcGFudF9pZBgBIAEoCRIQCghhdHRlbmRlZBgCIAEoDRIOCgZtaXNzZWQYAyABKA0iPwoZR2V0QXR0ZW5kYW5jZVN0YXRzUmVxdWVzdBIPCgd1c2VyX2lkGAEgASgJEhEKCXNlcmllc19pZBgCIAEoCSJ4ChpHZXRBdHRlbmRhbmNlU3RhdHNSZXNwb25zZRI9CgxwYXJ0aWNpcGFudHMYASADKAsyJy5zY2hlZHVsYS52MS5QYXJ0aWNpcGFudEF0dGVuZGFuY2VTdGF0cxIbChNvY2N1cnJlbmNlc190cmFja2VkGAIgASgNIiMKEEdldExpbWl0c1JlcXVlc3QSDwoHdXNlcl9pZBgBIAEoCSLjAwoRR2V0TGltaXRzUmVzcG9uc2USOwoYbWF4X2FwcG9pbnRtZW50X2R1cmF0aW9uGAEgASgLMhkuZ29vZ2xlLnByb3RvYnVmLkR1cmF0aW9uEjYKE3JlY3VycmluZ19sb29rYWhlYWQYAiABKAsyGS5nb29nbGUucHJvdG9idWYuRHVyYXRpb24SGAoQbWF4X3RpdGxlX2xlbmd0aBgDIAEoDRIYChBtYXhfbm90ZXNfbGVuZ3RoGAQgASgNEhQKDG1heF93ZWVrZGF5cxgFIAEoDRIhChltYXhfcGFydGljaXBhbnRfaWRfbGVuZ3RoGAYgASgNEhkKEW1heF9tZXNzYWdlX2J5dGVzGAcgASgNEhwKFG1heF9tZXRhZGF0YV9lbnRyaWVzGAggASgNEh8KF21heF9tZXRhZGF0YV9rZXlfbGVuZ3RoGAkgASgNEiEKGW1heF9tZXRhZGF0YV92YWx1ZV9sZW5ndGgYCiABKA0SNAoPaW50ZXJ2YWxfYm91bmRzGAsgASgOMhsuc2NoZWR1bGEudjEuSW50ZXJ2YWxCb3VuZHMSGQoRbWF4X2FjdGl2ZV9zZXJpZXMYDCABKA0SHgoWbWF4X2Z1dHVyZV9vY2N1cnJlbmNlcxgNIAEoDSKIAQoTR2V0QW5hbHl0aWNzUmVxdWVzdBIPCgd1c2VyX2lkGAEgASgJEjAKDHdpbmRvd19zdGFydBgCIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASLgoKd2luZG93X2VuZBgDIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXAioQIKFEdldEFuYWx5dGljc1Jlc3BvbnNlEhkKEWFwcG9pbnRtZW50X2NvdW50GAEgASgNEjMKEGF2ZXJhZ2VfZHVyYXRpb24YAiABKAsyGS5nb29nbGUucHJvdG9idWYuRHVyYXRpb24SEAoIYXR0ZW5kZWQYAyABKA0SDgoGbWlzc2VkGAQgASgNEhQKDG5vX3Nob3dfcmF0ZRgFIAEoARIQCghzZXNzaW9ucxgGIAEoDRI3ChRwbGFubmVkX3Nlc3Npb25fdGltZRgHIAEoCzIZLmdvb2dsZS5wcm90b2J1Zi5EdXJhdGlvbhI2ChNhY3R1YWxfc2Vzc2lvbl90aW1lGAggASgLMhkuZ29vZ2xlLnByb3RvYnVmLkR1cmF0aW9uIr8BChVTdWdnZXN0RW5kVGltZVJlcXVlc3QSDwoHdXNlcl9pZBgBIAEoCRIuCgpzdGFydF90aW1lGAIgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIzChBkZXNpcmVkX2R1cmF0aW9uGAMgASgLMhkuZ29vZ2xlLnByb3RvYnVmLkR1cmF0aW9uEjAKDXdvcmtpbmdfaG91cnMYBCABKAsyGS5zY2hlZHVsYS52MS5Xb3JraW5nSG91cnMihgEKFlN1Z2dlc3RFbmRUaW1lUmVzcG9uc2USLAoIZW5kX3RpbWUYASABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEisKCGR1cmF0aW9uGAIgASgLMhkuZ29vZ2xlLnByb3RvYnVmLkR1cmF0aW9uEhEKCXNob3J0ZW5lZBgDIAEoCCK1AQoIU2xvdEhvbGQSCgoCaWQYASABKAkSDwoHdXNlcl9pZBgCIAEoCRIuCgpzdGFydF90aW1lGAMgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIsCghlbmRfdGltZRgEIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASLgoKZXhwaXJlc19hdBgFIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXAiqwEKElJlc2VydmVTbG90UmVxdWVzdBIPCgd1c2VyX2lkGAEgASgJEi4KCnN0YXJ0X3RpbWUYAiABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEiwKCGVuZF90aW1lGAMgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBImCgN0dGwYBCABKAsyGS5nb29nbGUucHJvdG9idWYuRHVyYXRpb24iOgoTUmVzZXJ2ZVNsb3RSZXNwb25zZRIjCgRob2xkGAEgASgLMhUuc2NoZWR1bGEudjEuU2xvdEhvbGQixgEKEkNvbmZpcm1Ib2xkUmVxdWVzdBIPCgd1c2VyX2lkGAEgASgJEg8KB2hvbGRfaWQYAiABKAkSDQoFdGl0bGUYAyABKAkSDQoFbm90ZXMYBCABKAkSPwoIbWV0YWRhdGEYBSADKAsyLS5zY2hlZHVsYS52MS5Db25maXJtSG9sZFJlcXVlc3QuTWV0YWRhdGFFbnRyeRovCg1NZXRhZGF0YUVudHJ5EgsKA2tleRgBIAEoCRINCgV2YWx1ZRgCIAEoCToCOAEibAoTQ29uZmlybUhvbGRSZXNwb25zZRItCgthcHBvaW50bWVudBgBIAEoCzIYLnNjaGVkdWxhLnYxLkFwcG9pbnRtZW50EiYKCHdhcm5pbmdzGAIgAygLMhQuc2NoZWR1bGEudjEuV2FybmluZyI2ChJSZWxlYXNlSG9sZFJlcXVlc3QSDwoHdXNlcl9pZBgBIAEoCRIPCgdob2xkX2lkGAIgASgJIhUKE1JlbGVhc2VIb2xkUmVzcG9uc2UiygMKE0FwcG9pbnRtZW50UHJvcG9zYWwSCgoCaWQYASABKAkSEwoLcHJvcG9zZXJfaWQYAiABKAkSFAoMcmVjaXBpZW50X2lkGAMgASgJEg0KBXRpdGxlGAQgASgJEg0KBW5vdGVzGAUgASgJEi4KCnN0YXJ0X3RpbWUYBiABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEiwKCGVuZF90aW1lGAcgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIrCgZzdGF0dXMYCCABKA4yGy5zY2hlZHVsYS52MS5Qcm9wb3NhbFN0YXR1cxIuCgpleHBpcmVzX2F0GAkgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIuCgpjcmVhdGVkX2F0GAogASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIwCgxyZXNwb25kZWRfYXQYCyABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEh8KF3Byb3Bvc2VyX2FwcG9pbnRtZW50X2lkGAwgASgJEiAKGHJlY2lwaWVudF9hcHBvaW50bWVudF9pZBgNIAEoCSLqAQoZUHJvcG9zZUFwcG9pbnRtZW50UmVxdWVzdBITCgtwcm9wb3Nlcl9pZBgBIAEoCRIUCgxyZWNpcGllbnRfaWQYAiABKAkSDQoFdGl0bGUYAyABKAkSDQoFbm90ZXMYBCABKAkSLgoKc3RhcnRfdGltZRgFIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASLAoIZW5kX3RpbWUYBiABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEiYKA3R0bBgHIAEoCzIZLmdvb2dsZS5wcm90b2J1Zi5EdXJhdGlvbiJQChpQcm9wb3NlQXBwb2ludG1lbnRSZXNwb25zZRIyCghwcm9wb3NhbBgBIAEoCzIgLnNjaGVkdWxhLnYxLkFwcG9pbnRtZW50UHJvcG9zYWwiJwoUTGlzdFByb3Bvc2Fsc1JlcXVlc3QSDwoHdXNlcl9pZBgBIAEoCSJMChVMaXN0UHJvcG9zYWxzUmVzcG9uc2USMwoJcHJvcG9zYWxzGAEgAygLMiAuc2NoZWR1bGEudjEuQXBwb2ludG1lbnRQcm9wb3NhbCI9ChVBY2NlcHRQcm9wb3NhbFJlcXVlc3QSDwoHdXNlcl9pZBgBIAEoCRITCgtwcm9wb3NhbF9pZBgCIAEoCSJMChZBY2NlcHRQcm9wb3NhbFJlc3BvbnNlEjIKCHByb3Bvc2FsGAEgASgLMiAuc2NoZWR1bGEudjEuQXBwb2ludG1lbnRQcm9wb3NhbCI+ChZEZWNsaW5lUHJvcG9zYWxSZXF1ZXN0Eg8KB3VzZXJfaWQYASABKAkSEwoLcHJvcG9zYWxfaWQYAiABKAkiTQoXRGVjbGluZVByb3Bvc2FsUmVzcG9uc2USMgoIcHJvcG9zYWwYASABKAsyIC5zY2hlZHVsYS52MS5BcHBvaW50bWVudFByb3Bvc2FsInkKD0FwcG9pbnRtZW50TGluaxIWCg5hcHBvaW50bWVudF9pZBgBIAEoCRIeChZyZWxhdGVkX2FwcG9pbnRtZW50X2lkGAIgASgJEi4KBGtpbmQYAyABKA4yIC5zY2hlZHVsYS52MS5BcHBvaW50bWVudExpbmtLaW5kIpIBChdMaW5rQXBwb2ludG1lbnRzUmVxdWVzdBIPCgd1c2VyX2lkGAEgASgJEhYKDmFwcG9pbnRtZW50X2lkGAIgASgJEh4KFnJlbGF0ZWRfYXBwb2ludG1lbnRfaWQYAyABKAkSLgoEa2luZBgEIAEoDjIgLnNjaGVkdWxhLnYxLkFwcG9pbnRtZW50TGlua0tpbmQiRgoYTGlua0FwcG9pbnRtZW50c1Jlc3BvbnNlEioKBGxpbmsYASABKAsyHC5zY2hlZHVsYS52MS5BcHBvaW50bWVudExpbmsilAEKGVVubGlua0FwcG9pbnRtZW50c1JlcXVlc3QSDwoHdXNlcl9pZBgBIAEoCRIWCg5hcHBvaW50bWVudF9pZBgCIAEoCRIeChZyZWxhdGVkX2FwcG9pbnRtZW50X2lkGAMgASgJEi4KBGtpbmQYBCABKA4yIC5zY2hlZHVsYS52MS5BcHBvaW50bWVudExpbmtLaW5kIhwKGlVubGlua0FwcG9pbnRtZW50c1Jlc3BvbnNlIm8KElJlbGF0ZWRBcHBvaW50bWVudBIqCgRsaW5rGAEgASgLMhwuc2NoZWR1bGEudjEuQXBwb2ludG1lbnRMaW5rEi0KC2FwcG9pbnRtZW50GAIgASgLMhguc2NoZWR1bGEudjEuQXBwb2ludG1lbnQiPQoSTGlzdFJlbGF0ZWRSZXF1ZXN0Eg8KB3VzZXJfaWQYASABKAkSFgoOYXBwb2ludG1lbnRfaWQYAiABKAkiRwoTTGlzdFJlbGF0ZWRSZXNwb25zZRIwCgdyZWxhdGVkGAEgAygLMh8uc2NoZWR1bGEudjEuUmVsYXRlZEFwcG9pbnRtZW50InAKGE1lcmdlQXBwb2ludG1lbnRzUmVxdWVzdBIPCgd1c2VyX2lkGAEgASgJEhIKCnByaW1hcnlfaWQYAiABKAkSFQoNZHVwbGljYXRlX2lkcxgDIAMoCRIYChBjb3Zlcl9kdXBsaWNhdGVzGAQgASgIIpEBChlNZXJnZUFwcG9pbnRtZW50c1Jlc3BvbnNlEi0KC2FwcG9pbnRtZW50GAEgASgLMhguc2NoZWR1bGEudjEuQXBwb2ludG1lbnQSEwoLZGVsZXRlZF9pZHMYAiADKAkSEwoLbGlua3NfbW92ZWQYAyABKA0SGwoTc3luY19tYXBwaW5nc19tb3ZlZBgEIAEoDSJsCgxCdXN5SW50ZXJ2YWwSLgoKc3RhcnRfdGltZRgBIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASLAoIZW5kX3RpbWUYAiABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wInMKDFVzZXJGcmVlQnVzeRIPCgd1c2VyX2lkGAEgASgJEicKBGJ1c3kYAiADKAsyGS5zY2hlZHVsYS52MS5CdXN5SW50ZXJ2YWwSEgoKZXJyb3JfY29kZRgDIAEoCRIVCg1lcnJvcl9tZXNzYWdlGAQgASgJIo0BChdCYXRjaEdldEZyZWVCdXN5UmVxdWVzdBIQCgh1c2VyX2lkcxgBIAMoCRIwCgx3aW5kb3dfc3RhcnQYAiABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEi4KCndpbmRvd19lbmQYAyABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wIkYKGEJhdGNoR2V0RnJlZUJ1c3lSZXNwb25zZRIqCgdyZXN1bHRzGAEgAygLMhkuc2NoZWR1bGEudjEuVXNlckZyZWVCdXN5ImkKCVRpbWVSYW5nZRIuCgpzdGFydF90aW1lGAEgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIsCghlbmRfdGltZRgCIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXAicwoMV29ya2luZ0hvdXJzEhEKCXRpbWVfem9uZRgBIAEoCRIUCgxzdGFydF9taW51dGUYAiABKA0SEgoKZW5kX21pbnV0ZRgDIAEoDRImCgh3ZWVrZGF5cxgEIAMoDjIULnNjaGVkdWxhLnYxLldlZWtkYXkifwoPTWVldGluZ0F0dGVuZGVlEg8KB3VzZXJfaWQYASABKAkSMAoNd29ya2luZ19ob3VycxgCIAEoCzIZLnNjaGVkdWxhLnYxLldvcmtpbmdIb3VycxIpCglwcmVmZXJyZWQYAyADKAsyFi5zY2hlZHVsYS52MS5UaW1lUmFuZ2UimgIKGlN1Z2dlc3RNZWV0aW5nVGltZXNSZXF1ZXN0Ei8KCWF0dGVuZGVlcxgBIAMoCzIcLnNjaGVkdWxhLnYxLk1lZXRpbmdBdHRlbmRlZRIrCghkdXJhdGlvbhgCIAEoCzIZLmdvb2dsZS5wcm90b2J1Zi5EdXJhdGlvbhIwCgx3aW5kb3dfc3RhcnQYAyABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEi4KCndpbmRvd19lbmQYBCABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEicKBHN0ZXAYBSABKAsyGS5nb29nbGUucHJvdG9idWYuRHVyYXRpb24SEwoLbWF4X3Jlc3VsdHMYBiABKA0inwEKEU1lZXRpbmdTdWdnZXN0aW9uEi4KCnN0YXJ0X3RpbWUYASABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEiwKCGVuZF90aW1lGAIgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBINCgVzY29yZRgDIAEoARIdChVvdXRzaWRlX3dvcmtpbmdfaG91cnMYBCADKAkiUgobU3VnZ2VzdE1lZXRpbmdUaW1lc1Jlc3BvbnNlEjMKC3N1Z2dlc3Rpb25zGAEgAygLMh4uc2NoZWR1bGEudjEuTWVldGluZ1N1Z2dlc3Rpb24iegoOU2ltdWxhdGVkU3RhZmYSDQoFbGFiZWwYASABKAkSMAoNd29ya2luZ19ob3VycxgCIAEoCzIZLnNjaGVkdWxhLnYxLldvcmtpbmdIb3VycxInCgZicmVha3MYAyADKAsyFy5zY2hlZHVsYS52MS5EYWlseUJyZWFrIo4BCg5Cb29raW5nUGF0dGVybhINCgVsYWJlbBgBIAEoCRIrCghkdXJhdGlvbhgCIAEoCzIZLmdvb2dsZS5wcm90b2J1Zi5EdXJhdGlvbhIYChBib29raW5nc19wZXJfZGF5GAMgASgNEiYKCHdlZWtkYXlzGAQgAygOMhQuc2NoZWR1bGEudjEuV2Vla2RheSKSAgoXU2ltdWxhdGVTY2hlZHVsZVJlcXVlc3QSKgoFc3RhZmYYASADKAsyGy5zY2hlZHVsYS52MS5TaW11bGF0ZWRTdGFmZhItCghwYXR0ZXJucxgCIAMoCzIbLnNjaGVkdWxhLnYxLkJvb2tpbmdQYXR0ZXJuEjAKDHdpbmRvd19zdGFydBgDIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASLgoKd2luZG93X2VuZBgEIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASEQoJdGltZV96b25lGAUgASgJEicKBHN0ZXAYBiABKAsyGS5nb29nbGUucHJvdG9idWYuRHVyYXRpb24iggEKE1NjaGVkdWxlVXRpbGl6YXRpb24SKwoIY2FwYWNpdHkYASABKAsyGS5nb29nbGUucHJvdG9idWYuRHVyYXRpb24SKQoGYm9va2VkGAIgASgLMhkuZ29vZ2xlLnByb3RvYnVmLkR1cmF0aW9uEhMKC3V0aWxpemF0aW9uGAMgASgBIngKDFNpbXVsYXRlZERheRIMCgRkYXRlGAEgASgJEjUKC3V0aWxpemF0aW9uGAIgASgLMiAuc2NoZWR1bGEudjEuU2NoZWR1bGVVdGlsaXphdGlvbhIRCglyZXF1ZXN0ZWQYAyABKA0SEAoIdW5ib29rZWQYBCABKA0icwoZU2ltdWxhdGVkU3RhZmZVdGlsaXphdGlvbhINCgVsYWJlbBgBIAEoCRI1Cgt1dGlsaXphdGlvbhgCIAEoCzIgLnNjaGVkdWxhLnYxLlNjaGVkdWxlVXRpbGl6YXRpb24SEAoIYm9va2luZ3MYAyABKA0iSwoVQm9va2luZ1BhdHRlcm5PdXRjb21lEg0KBWxhYmVsGAEgASgJEhEKCXJlcXVlc3RlZBgCIAEoDRIQCgh1bmJvb2tlZBgDIAEoDSKMAgoYU2ltdWxhdGVTY2hlZHVsZVJlc3BvbnNlEjUKC3V0aWxpemF0aW9uGAEgASgLMiAuc2NoZWR1bGEudjEuU2NoZWR1bGVVdGlsaXphdGlvbhIRCglyZXF1ZXN0ZWQYAiABKA0SEAoIdW5ib29rZWQYAyABKA0SJwoEZGF5cxgEIAMoCzIZLnNjaGVkdWxhLnYxLlNpbXVsYXRlZERheRI1CgVzdGFmZhgFIAMoCzImLnNjaGVkdWxhLnYxLlNpbXVsYXRlZFN0YWZmVXRpbGl6YXRpb24SNAoIcGF0dGVybnMYBiADKAsyIi5zY2hlZHVsYS52MS5Cb29raW5nUGF0dGVybk91dGNvbWUimwEKDVNlcmllc0ZpbmRpbmcSLAoEa2luZBgBIAEoDjIeLnNjaGVkdWxhLnYxLlNlcmllc0ZpbmRpbmdLaW5kEhQKDGV4Y2VwdGlvbl9pZBgCIAEoCRI0ChBvY2N1cnJlbmNlX3N0YXJ0GAMgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIQCghyZXBhaXJlZBgEIAEoCCJRChxSZXBhaXJSZWN1cnJpbmdTZXJpZXNSZXF1ZXN0Eg8KB3VzZXJfaWQYASABKAkSEQoJc2VyaWVzX2lkGAIgASgJEg0KBWFwcGx5GAMgASgIIl8KHVJlcGFpclJlY3VycmluZ1Nlcmllc1Jlc3BvbnNlEiwKCGZpbmRpbmdzGAEgAygLMhouc2NoZWR1bGEudjEuU2VyaWVzRmluZGluZxIQCghyZXBhaXJlZBgCIAEoDSLiAQoNQ2FsZW5kYXJFbnRyeRIWCg5hcHBvaW50bWVudF9pZBgBIAEoCRIRCglzZXJpZXNfaWQYAiABKAkSFQoNb2NjdXJyZW5jZV9pZBgDIAEoCRINCgV0aXRsZRgEIAEoCRIOCgZzb3VyY2UYBSABKAkSLgoKc3RhcnRfdGltZRgGIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASLAoIZW5kX3RpbWUYByABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEhIKCm92ZXJyaWRkZW4YCCABKAgi+AEKEENhbGVuZGFyQ29uZmxpY3QSKQoFZmlyc3QYASABKAsyGi5zY2hlZHVsYS52MS5DYWxlbmRhckVudHJ5EioKBnNlY29uZBgCIAEoCzIaLnNjaGVkdWxhLnYxLkNhbGVuZGFyRW50cnkSMQoNb3ZlcmxhcF9zdGFydBgDIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASLwoLb3ZlcmxhcF9lbmQYBCABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEikKBWNhdXNlGAUgASgOMhouc2NoZWR1bGEudjEuQ29uZmxpY3RDYXVzZSKJAQoUQXVkaXRDYWxlbmRhclJlcXVlc3QSDwoHdXNlcl9pZBgBIAEoCRIwCgx3aW5kb3dfc3RhcnQYAiABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEi4KCndpbmRvd19lbmQYAyABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wImIKFUF1ZGl0Q2FsZW5kYXJSZXNwb25zZRIwCgljb25mbGljdHMYASADKAsyHS5zY2hlZHVsYS52MS5DYWxlbmRhckNvbmZsaWN0EhcKD2VudHJpZXNfc2Nhbm5lZBgCIAEoDSJJChVHZXREYWlseUFnZW5kYVJlcXVlc3QSDwoHdXNlcl9pZBgBIAEoCRIMCgRkYXRlGAIgASgJEhEKCXRpbWVfem9uZRgDIAEoCSKhAQoKQWdlbmRhSXRlbRIpCgVlbnRyeRgBIAEoCzIaLnNjaGVkdWxhLnYxLkNhbGVuZGFyRW50cnkSEQoJbWlsZXN0b25lGAIgASgIEi0KCmdhcF9iZWZvcmUYAyABKAsyGS5nb29nbGUucHJvdG9idWYuRHVyYXRpb24SFAoMYmFja190b19iYWNrGAQgASgIEhAKCG92ZXJsYXBzGAUgASgIIo8BChZHZXREYWlseUFnZW5kYVJlc3BvbnNlEgwKBGRhdGUYASABKAkSEQoJdGltZV96b25lGAIgASgJEiYKBWl0ZW1zGAMgAygLMhcuc2NoZWR1bGEudjEuQWdlbmRhSXRlbRIsCglidXN5X3RpbWUYBCABKAsyGS5nb29nbGUucHJvdG9idWYuRHVyYXRpb24iTQoWR2V0RGF5U3VtbWFyaWVzUmVxdWVzdBIPCgd1c2VyX2lkGAEgASgJEhEKCWZyb21fZGF0ZRgCIAEoCRIPCgd0b19kYXRlGAMgASgJInMKCkRheVN1bW1hcnkSDAoEZGF0ZRgBIAEoCRIUCgxhcHBvaW50bWVudHMYAiABKA0SEwoLb2NjdXJyZW5jZXMYAyABKA0SLAoJYnVzeV90aW1lGAQgASgLMhkuZ29vZ2xlLnByb3RvYnVmLkR1cmF0aW9uIlMKF0dldERheVN1bW1hcmllc1Jlc3BvbnNlEhEKCXRpbWVfem9uZRgBIAEoCRIlCgRkYXlzGAIgAygLMhcuc2NoZWR1bGEudjEuRGF5U3VtbWFyeSJ2ChZVcGRhdGVTZXJpZXNFbmRSZXF1ZXN0Eg8KB3VzZXJfaWQYASABKAkSEQoJc2VyaWVzX2lkGAIgASgJEikKBXVudGlsGAMgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBINCgVjb3VudBgEIAEoDSJjChdVcGRhdGVTZXJpZXNFbmRSZXNwb25zZRIsCgZzZXJpZXMYASABKAsyHC5zY2hlZHVsYS52MS5SZWN1cnJpbmdTZXJpZXMSGgoScmVtb3ZlZF9leGNlcHRpb25zGAIgASgNIqsBChtDaGFuZ2VTZXJpZXNUaW1lWm9uZVJlcXVlc3QSDwoHdXNlcl9pZBgBIAEoCRISCgpzZXJpZXNfaWRzGAIgAygJEhEKCXRpbWVfem9uZRgDIAEoCRInCgRrZWVwGAQgASgOMhkuc2NoZWR1bGEudjEuVGltZVpvbmVLZWVwEg8KB3ByZXZpZXcYBSABKAgSGgoSY29uZmlybWF0aW9uX3Rva2VuGAYgASgJIsEBChxDaGFuZ2VTZXJpZXNUaW1lWm9uZVJlc3BvbnNlEiwKBnNlcmllcxgBIAMoCzIcLnNjaGVkdWxhLnYxLlJlY3VycmluZ1NlcmllcxIaChJyZW1vdmVkX2V4Y2VwdGlvbnMYAiABKA0SGgoSY29uZmlybWF0aW9uX3Rva2VuGAMgASgJEjsKF2NvbmZpcm1hdGlvbl9leHBpcmVzX2F0GAQgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcCLxAQoWU2tpcE9jY3VycmVuY2VzUmVxdWVzdBIPCgd1c2VyX2lkGAEgASgJEhEKCXNlcmllc19pZBgCIAEoCRIwCgx3aW5kb3dfc3RhcnQYAyABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEi4KCndpbmRvd19lbmQYBCABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEiYKCHdlZWtkYXlzGAUgAygOMhQuc2NoZWR1bGEudjEuV2Vla2RheRINCgVhcHBseRgGIAEoCBIaChJjb25maXJtYXRpb25fdG9rZW4YByABKAkiugEKF1NraXBPY2N1cnJlbmNlc1Jlc3BvbnNlEjUKEW9jY3VycmVuY2Vfc3RhcnRzGAEgAygLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIPCgdza2lwcGVkGAIgASgNEhoKEmNvbmZpcm1hdGlvbl90b2tlbhgDIAEoCRI7Chdjb25maXJtYXRpb25fZXhwaXJlc19hdBgEIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXAibAoPRGVsZWdhdGlvbkdyYW50EhQKDHByaW5jaXBhbF9pZBgBIAEoCRITCgtkZWxlZ2F0ZV9pZBgCIAEoCRIuCgpjcmVhdGVkX2F0GAMgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcCJDChZHcmFudERlbGVnYXRpb25SZXF1ZXN0EhQKDHByaW5jaXBhbF9pZBgBIAEoCRITCgtkZWxlZ2F0ZV9pZBgCIAEoCSJGChdHcmFudERlbGVnYXRpb25SZXNwb25zZRIrCgVncmFudBgBIAEoCzIcLnNjaGVkdWxhLnYxLkRlbGVnYXRpb25HcmFudCJEChdSZXZva2VEZWxlZ2F0aW9uUmVxdWVzdBIUCgxwcmluY2lwYWxfaWQYASABKAkSEwoLZGVsZWdhdGVfaWQYAiABKAkiGgoYUmV2b2tlRGVsZWdhdGlvblJlc3BvbnNlIi4KFkxpc3REZWxlZ2F0aW9uc1JlcXVlc3QSFAoMcHJpbmNpcGFsX2lkGAEgASgJIkcKF0xpc3REZWxlZ2F0aW9uc1Jlc3BvbnNlEiwKBmdyYW50cxgBIAMoCzIcLnNjaGVkdWxhLnYxLkRlbGVnYXRpb25HcmFudCKfAQoXV2F0Y2hPY2N1cnJlbmNlc1JlcXVlc3QSDwoHdXNlcl9pZBgBIAEoCRIRCglzZXJpZXNfaWQYAiABKAkSMAoMd2luZG93X3N0YXJ0GAMgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIuCgp3aW5kb3dfZW5kGAQgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcCJ2ChhXYXRjaE9jY3VycmVuY2VzUmVzcG9uc2USLAoGc2VyaWVzGAEgASgLMhwuc2NoZWR1bGEudjEuUmVjdXJyaW5nU2VyaWVzEiwKC29jY3VycmVuY2VzGAIgAygLMhcuc2NoZWR1bGEudjEuT2NjdXJyZW5jZSKmAQoOQ2FsZW5kYXJDaGFuZ2USLgoLZW50aXR5X3R5cGUYASABKA4yGS5zY2hlZHVsYS52MS5DaGFuZ2VFbnRpdHkSEQoJZW50aXR5X2lkGAIgASgJEiEKAm9wGAMgASgOMhUuc2NoZWR1bGEudjEuQ2hhbmdlT3ASLgoKY2hhbmdlZF9hdBgEIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXAidwoSTGlzdENoYW5nZXNSZXF1ZXN0Eg8KB3VzZXJfaWQYASABKAkSFAoMc2luY2VfY3Vyc29yGAIgASgJEhEKCXBhZ2Vfc2l6ZRgDIAEoBRInCgR3YWl0GAQgASgLMhkuZ29vZ2xlLnByb3RvYnVmLkR1cmF0aW9uImoKE0xpc3RDaGFuZ2VzUmVzcG9uc2USLAoHY2hhbmdlcxgBIAMoCzIbLnNjaGVkdWxhLnYxLkNhbGVuZGFyQ2hhbmdlEhMKC25leHRfY3Vyc29yGAIgASgJEhAKCGhhc19tb3JlGAMgASgIIigKFUV4cG9ydENhbGVuZGFyUmVxdWVzdBIPCgd1c2VyX2lkGAEgASgJIigKFkV4cG9ydENhbGVuZGFyUmVzcG9uc2USDgoGYnVuZGxlGAEgASgMIjgKFUltcG9ydENhbGVuZGFyUmVxdWVzdBIPCgd1c2VyX2lkGAEgASgJEg4KBmJ1bmRsZRgCIAEoDCKIAQoWSW1wb3J0Q2FsZW5kYXJSZXNwb25zZRIdChVhcHBvaW50bWVudHNfaW1wb3J0ZWQYASABKAUSFwoPc2VyaWVzX2ltcG9ydGVkGAIgASgFEhsKE2V4Y2VwdGlvbnNfaW1wb3J0ZWQYAyABKAUSGQoRY29udGFjdHNfaW1wb3J0ZWQYBCABKAUi+AEKEENhbGVuZGFyU25hcHNob3QSCgoCaWQYASABKAkSDwoHdXNlcl9pZBgCIAEoCRINCgVsYWJlbBgDIAEoCRIWCg5mb3JtYXRfdmVyc2lvbhgEIAEoBRIQCghjaGVja3N1bRgFIAEoCRISCgpzaXplX2J5dGVzGAYgASgFEhAKCGNvbnRhY3RzGAcgASgFEhQKDGFwcG9pbnRtZW50cxgIIAEoBRIOCgZzZXJpZXMYCSABKAUSEgoKZXhjZXB0aW9ucxgKIAEoBRIuCgpjcmVhdGVkX2F0GAsgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcCI/Ch1DcmVhdGVDYWxlbmRhclNuYXBzaG90UmVxdWVzdBIPCgd1c2VyX2lkGAEgASgJEg0KBWxhYmVsGAIgASgJIlEKHkNyZWF0ZUNhbGVuZGFyU25hcHNob3RSZXNwb25zZRIvCghzbmFwc2hvdBgBIAEoCzIdLnNjaGVkdWxhLnYxLkNhbGVuZGFyU25hcHNob3QiLwocTGlzdENhbGVuZGFyU25hcHNob3RzUmVxdWVzdBIPCgd1c2VyX2lkGAEgASgJIlEKHUxpc3RDYWxlbmRhclNuYXBzaG90c1Jlc3BvbnNlEjAKCXNuYXBzaG90cxgBIAMoCzIdLnNjaGVkdWxhLnYxLkNhbGVuZGFyU25hcHNob3QiRgoeUmVzdG9yZUNhbGVuZGFyU25hcHNob3RSZXF1ZXN0Eg8KB3VzZXJfaWQYASABKAkSEwoLc25hcHNob3RfaWQYAiABKAkijgIKH1Jlc3RvcmVDYWxlbmRhclNuYXBzaG90UmVzcG9uc2USLwoIc25hcHNob3QYASABKAsyHS5zY2hlZHVsYS52MS5DYWxlbmRhclNuYXBzaG90EhwKFGFwcG9pbnRtZW50c19jcmVhdGVkGAIgASgFEhwKFGFwcG9pbnRtZW50c191cGRhdGVkGAMgASgFEhwKFGFwcG9pbnRtZW50c19kZWxldGVkGAQgASgFEhYKDnNlcmllc19jcmVhdGVkGAUgASgFEhYKDnNlcmllc191cGRhdGVkGAYgASgFEhYKDnNlcmllc19kZWxldGVkGAcgASgFEhgKEGNvbnRhY3RzX2NoYW5nZWQYCCABKAUisgEKB0NvbnRhY3QSCgoCaWQYASABKAkSDwoHdXNlcl9pZBgCIAEoCRIMCgRuYW1lGAMgASgJEg0KBWVtYWlsGAQgASgJEg0KBXBob25lGAUgASgJEi4KCmNyZWF0ZWRfYXQYBiABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEi4KCnVwZGF0ZWRfYXQYByABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wIlMKFENyZWF0ZUNvbnRhY3RSZXF1ZXN0Eg8KB3VzZXJfaWQYASABKAkSDAoEbmFtZRgCIAEoCRINCgVlbWFpbBgDIAEoCRINCgVwaG9uZRgEIAEoCSI+ChVDcmVhdGVDb250YWN0UmVzcG9uc2USJQoHY29udGFjdBgBIAEoCzIULnNjaGVkdWxhLnYxLkNvbnRhY3QiOAoRR2V0Q29udGFjdFJlcXVlc3QSDwoHdXNlcl9pZBgBIAEoCRISCgpjb250YWN0X2lkGAIgASgJIjsKEkdldENvbnRhY3RSZXNwb25zZRIlCgdjb250YWN0GAEgASgLMhQuc2NoZWR1bGEudjEuQ29udGFjdCJnChRVcGRhdGVDb250YWN0UmVxdWVzdBIPCgd1c2VyX2lkGAEgASgJEhIKCmNvbnRhY3RfaWQYAiABKAkSDAoEbmFtZRgDIAEoCRINCgVlbWFpbBgEIAEoCRINCgVwaG9uZRgFIAEoCSI+ChVVcGRhdGVDb250YWN0UmVzcG9uc2USJQoHY29udGFjdBgBIAEoCzIULnNjaGVkdWxhLnYxLkNvbnRhY3QiOwoURGVsZXRlQ29udGFjdFJlcXVlc3QSDwoHdXNlcl9pZBgBIAEoCRISCgpjb250YWN0X2lkGAIgASgJIhcKFURlbGV0ZUNvbnRhY3RSZXNwb25zZSImChNMaXN0Q29udGFjdHNSZXF1ZXN0Eg8KB3VzZXJfaWQYASABKAkiPgoUTGlzdENvbnRhY3RzUmVzcG9uc2USJgoIY29udGFjdHMYASADKAsyFC5zY2hlZHVsYS52MS5Db250YWN0ItYBCgdQcm9ncmFtEgoKAmlkGAEgASgJEg8KB3VzZXJfaWQYAiABKAkSDQoFdGl0bGUYAyABKAkSDQoFbm90ZXMYBCABKAkSMAoMY2FuY2VsbGVkX2F0GAUgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIuCgpjcmVhdGVkX2F0GAYgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIuCgp1cGRhdGVkX2F0GAcgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcCKTAQoPUHJvZ3JhbVByb2dyZXNzEhYKDnRvdGFsX3Nlc3Npb25zGAEgASgNEhoKEmNvbXBsZXRlZF9zZXNzaW9ucxgCIAEoDRIaChJyZW1haW5pbmdfc2Vzc2lvbnMYAyABKA0SMAoMbmV4dF9zZXNzaW9uGAQgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcCJFChRDcmVhdGVQcm9ncmFtUmVxdWVzdBIPCgd1c2VyX2lkGAEgASgJEg0KBXRpdGxlGAIgASgJEg0KBW5vdGVzGAMgASgJIj4KFUNyZWF0ZVByb2dyYW1SZXNwb25zZRIlCgdwcm9ncmFtGAEgASgLMhQuc2NoZWR1bGEudjEuUHJvZ3JhbSI4ChFHZXRQcm9ncmFtUmVxdWVzdBIPCgd1c2VyX2lkGAEgASgJEhIKCnByb2dyYW1faWQYAiABKAkiyQEKEkdldFByb2dyYW1SZXNwb25zZRIlCgdwcm9ncmFtGAEgASgLMhQuc2NoZWR1bGEudjEuUHJvZ3JhbRIuCgxhcHBvaW50bWVudHMYAiADKAsyGC5zY2hlZHVsYS52MS5BcHBvaW50bWVudBIsCgZzZXJpZXMYAyADKAsyHC5zY2hlZHVsYS52MS5SZWN1cnJpbmdTZXJpZXMSLgoIcHJvZ3Jlc3MYBCABKAsyHC5zY2hlZHVsYS52MS5Qcm9ncmFtUHJvZ3Jlc3MiJgoTTGlzdFByb2dyYW1zUmVxdWVzdBIPCgd1c2VyX2lkGAEgASgJIj4KFExpc3RQcm9ncmFtc1Jlc3BvbnNlEiYKCHByb2dyYW1zGAEgAygLMhQuc2NoZWR1bGEudjEuUHJvZ3JhbSI7ChRDYW5jZWxQcm9ncmFtUmVxdWVzdBIPCgd1c2VyX2lkGAEgASgJEhIKCnByb2dyYW1faWQYAiABKAkiigEKFUNhbmNlbFByb2dyYW1SZXNwb25zZRIlCgdwcm9ncmFtGAEgASgLMhQuc2NoZWR1bGEudjEuUHJvZ3JhbRIcChRhcHBvaW50bWVudHNfZGVsZXRlZBgCIAEoBRIUCgxzZXJpZXNfZW5kZWQYAyABKAUSFgoOc2VyaWVzX2RlbGV0ZWQYBCABKAUiYQoOQ2hlY2tJblJlcXVlc3QSDwoHdXNlcl9pZBgBIAEoCRIWCg5hcHBvaW50bWVudF9pZBgCIAEoCRImCgJhdBgDIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXAiQAoPQ2hlY2tJblJlc3BvbnNlEi0KC2FwcG9pbnRtZW50GAEgASgLMhguc2NoZWR1bGEudjEuQXBwb2ludG1lbnQiYgoPQ2hlY2tPdXRSZXF1ZXN0Eg8KB3VzZXJfaWQYASABKAkSFgoOYXBwb2ludG1lbnRfaWQYAiABKAkSJgoCYXQYAyABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wIqoBChBDaGVja091dFJlc3BvbnNlEi0KC2FwcG9pbnRtZW50GAEgASgLMhguc2NoZWR1bGEudjEuQXBwb2ludG1lbnQSMwoQcGxhbm5lZF9kdXJhdGlvbhgCIAEoCzIZLmdvb2dsZS5wcm90b2J1Zi5EdXJhdGlvbhIyCg9hY3R1YWxfZHVyYXRpb24YAyABKAsyGS5nb29nbGUucHJvdG9idWYuRHVyYXRpb24ijQIKGkV4cG9ydEJpbGxhYmxlSG91cnNSZXF1ZXN0Eg8KB3VzZXJfaWQYASABKAkSMAoMd2luZG93X3N0YXJ0GAIgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIuCgp3aW5kb3dfZW5kGAMgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIPCgd0YWdfa2V5GAQgASgJEisKBnBlcmlvZBgFIAEoDjIbLnNjaGVkdWxhLnYxLkJpbGxhYmxlUGVyaW9kEhEKCXRpbWVfem9uZRgGIAEoCRIrCgZmb3JtYXQYByABKA4yGy5zY2hlZHVsYS52MS5CaWxsYWJsZUZvcm1hdCJBChtFeHBvcnRCaWxsYWJsZUhvdXJzUmVzcG9uc2USDAoEZGF0YRgBIAEoDBIUCgxjb250ZW50X3R5cGUYAiABKAkihQMKD09mZmxpbmVNdXRhdGlvbhInCgRraW5kGAEgASgOMhkuc2NoZWR1bGEudjEuTXV0YXRpb25LaW5kEhYKDmFwcG9pbnRtZW50X2lkGAIgASgJEg0KBXRpdGxlGAMgASgJEg0KBW5vdGVzGAQgASgJEi4KCnN0YXJ0X3RpbWUYBSABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEiwKCGVuZF90aW1lGAYgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBI8CghtZXRhZGF0YRgHIAMoCzIqLnNjaGVkdWxhLnYxLk9mZmxpbmVNdXRhdGlvbi5NZXRhZGF0YUVudHJ5EhEKCXRpbWVfem9uZRgIIAEoCRIzCg9iYXNlX3VwZGF0ZWRfYXQYCSABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wGi8KDU1ldGFkYXRhRW50cnkSCwoDa2V5GAEgASgJEg0KBXZhbHVlGAIgASgJOgI4ASKqAQoOTXV0YXRpb25SZXN1bHQSKwoGc3RhdHVzGAEgASgOMhsuc2NoZWR1bGEudjEuTXV0YXRpb25TdGF0dXMSLwoIY29uZmxpY3QYAiABKA4yHS5zY2hlZHVsYS52MS5NdXRhdGlvbkNvbmZsaWN0Eg8KB21lc3NhZ2UYAyABKAkSKQoHY3VycmVudBgEIAEoCzIYLnNjaGVkdWxhLnYxLkFwcG9pbnRtZW50IlwKGFJlY29uY2lsZUNhbGVuZGFyUmVxdWVzdBIPCgd1c2VyX2lkGAEgASgJEi8KCW11dGF0aW9ucxgCIAMoCzIcLnNjaGVkdWxhLnYxLk9mZmxpbmVNdXRhdGlvbiJJChlSZWNvbmNpbGVDYWxlbmRhclJlc3BvbnNlEiwKB3Jlc3VsdHMYASADKAsyGy5zY2hlZHVsYS52MS5NdXRhdGlvblJlc3VsdCK2AQoXQ3JlYXRlRW1iZWRUb2tlblJlcXVlc3QSDwoHdXNlcl9pZBgBIAEoCRIwCg1zbG90X2R1cmF0aW9uGAIgASgLMhkuZ29vZ2xlLnByb3RvYnVmLkR1cmF0aW9uEjAKDXdvcmtpbmdfaG91cnMYAyABKAsyGS5zY2hlZHVsYS52MS5Xb3JraW5nSG91cnMSJgoDdHRsGAQgASgLMhkuZ29vZ2xlLnByb3RvYnVmLkR1cmF0aW9uIlkKGENyZWF0ZUVtYmVkVG9rZW5SZXNwb25zZRINCgV0b2tlbhgBIAEoCRIuCgpleHBpcmVzX2F0GAIgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcCJFCgpEYWlseUJyZWFrEg0KBWxhYmVsGAEgASgJEhQKDHN0YXJ0X21pbnV0ZRgCIAEoDRISCgplbmRfbWludXRlGAMgASgNIqwBCgxTbG90U2V0dGluZ3MSDwoHdXNlcl9pZBgBIAEoCRIZChFhbGlnbm1lbnRfbWludXRlcxgCIAEoDRIRCgl0aW1lX3pvbmUYAyABKAkSLgoKdXBkYXRlZF9hdBgEIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASLQoMZGFpbHlfYnJlYWtzGAUgAygLMhcuc2NoZWR1bGEudjEuRGFpbHlCcmVhayIpChZHZXRTbG90U2V0dGluZ3NSZXF1ZXN0Eg8KB3VzZXJfaWQYASABKAkiRgoXR2V0U2xvdFNldHRpbmdzUmVzcG9uc2USKwoIc2V0dGluZ3MYASABKAsyGS5zY2hlZHVsYS52MS5TbG90U2V0dGluZ3MiWgoZVXBkYXRlU2xvdFNldHRpbmdzUmVxdWVzdBIPCgd1c2VyX2lkGAEgASgJEhkKEWFsaWdubWVudF9taW51dGVzGAIgASgNEhEKCXRpbWVfem9uZRgDIAEoCSJJChpVcGRhdGVTbG90U2V0dGluZ3NSZXNwb25zZRIrCghzZXR0aW5ncxgBIAEoCzIZLnNjaGVkdWxhLnYxLlNsb3RTZXR0aW5ncyJUChhVcGRhdGVEYWlseUJyZWFrc1JlcXVlc3QSDwoHdXNlcl9pZBgBIAEoCRInCgZicmVha3MYAiADKAsyFy5zY2hlZHVsYS52MS5EYWlseUJyZWFrIkgKGVVwZGF0ZURhaWx5QnJlYWtzUmVzcG9uc2USKwoIc2V0dGluZ3MYASABKAsyGS5zY2hlZHVsYS52MS5TbG90U2V0dGluZ3MiiwEKEVRpbWVPZmZSZWN1cnJlbmNlEhAKCGludGVydmFsGAEgASgNEiYKCHdlZWtkYXlzGAIgAygOMhQuc2NoZWR1bGEudjEuV2Vla2RheRIpCgV1bnRpbBgDIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASEQoJdGltZV96b25lGAQgASgJIqcCCgdUaW1lT2ZmEgoKAmlkGAEgASgJEg8KB3VzZXJfaWQYAiABKAkSDQoFdGl0bGUYAyABKAkSLgoKc3RhcnRfdGltZRgEIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASLAoIZW5kX3RpbWUYBSABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEjIKCnJlY3VycmVuY2UYBiABKAsyHi5zY2hlZHVsYS52MS5UaW1lT2ZmUmVjdXJyZW5jZRIuCgpjcmVhdGVkX2F0GAcgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIuCgp1cGRhdGVkX2F0GAggASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcCLIAQoUQ3JlYXRlVGltZU9mZlJlcXVlc3QSDwoHdXNlcl9pZBgBIAEoCRINCgV0aXRsZRgCIAEoCRIuCgpzdGFydF90aW1lGAMgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIsCghlbmRfdGltZRgEIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASMgoKcmVjdXJyZW5jZRgFIAEoCzIeLnNjaGVkdWxhLnYxLlRpbWVPZmZSZWN1cnJlbmNlIj8KFUNyZWF0ZVRpbWVPZmZSZXNwb25zZRImCgh0aW1lX29mZhgBIAEoCzIULnNjaGVkdWxhLnYxLlRpbWVPZmYiOQoRR2V0VGltZU9mZlJlcXVlc3QSDwoHdXNlcl9pZBgBIAEoCRITCgt0aW1lX29mZl9pZBgCIAEoCSI8ChJHZXRUaW1lT2ZmUmVzcG9uc2USJgoIdGltZV9vZmYYASABKAsyFC5zY2hlZHVsYS52MS5UaW1lT2ZmIt0BChRVcGRhdGVUaW1lT2ZmUmVxdWVzdBIPCgd1c2VyX2lkGAEgASgJEhMKC3RpbWVfb2ZmX2lkGAIgASgJEg0KBXRpdGxlGAMgASgJEi4KCnN0YXJ0X3RpbWUYBCABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEiwKCGVuZF90aW1lGAUgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIyCgpyZWN1cnJlbmNlGAYgASgLMh4uc2NoZWR1bGEudjEuVGltZU9mZlJlY3VycmVuY2UiPwoVVXBkYXRlVGltZU9mZlJlc3BvbnNlEiYKCHRpbWVfb2ZmGAEgASgLMhQuc2NoZWR1bGEudjEuVGltZU9mZiI8ChREZWxldGVUaW1lT2ZmUmVxdWVzdBIPCgd1c2VyX2lkGAEgASgJEhMKC3RpbWVfb2ZmX2lkGAIgASgJIhcKFURlbGV0ZVRpbWVPZmZSZXNwb25zZSIlChJMaXN0VGltZU9mZlJlcXVlc3QSDwoHdXNlcl9pZBgBIAEoCSI9ChNMaXN0VGltZU9mZlJlc3BvbnNlEiYKCHRpbWVfb2ZmGAEgAygLMhQuc2NoZWR1bGEudjEuVGltZU9mZip+CgdXZWVrZGF5EhcKE1dFRUtEQVlfVU5TUEVDSUZJRUQQABIKCgZNT05EQVkQARILCgdUVUVTREFZEAISDQoJV0VETkVTREFZEAMSDAoIVEhVUlNEQVkQBBIKCgZGUklEQVkQBRIMCghTQVRVUkRBWRAGEgoKBlNVTkRBWRAHKnMKEEF0dGVuZGFuY2VTdGF0dXMSIQodQVRURU5EQU5DRV9TVEFUVVNfVU5TUEVDSUZJRUQQABIeChpBVFRFTkRBTkNFX1NUQVRVU19BVFRFTkRFRBABEhwKGEFUVEVOREFOQ0VfU1RBVFVTX01JU1NFRBACKogBChNBcHBvaW50bWVudExpbmtLaW5kEiUKIUFQUE9JTlRNRU5UX0xJTktfS0lORF9VTlNQRUNJRklFRBAAEiYKIkFQUE9JTlRNRU5UX0xJTktfS0lORF9GT0xMT1dfVVBfT0YQARIiCh5BUFBPSU5UTUVOVF9MSU5LX0tJTkRfUFJFUF9GT1IQAippCgxEc3RHYXBQb2xpY3kSHgoaRFNUX0dBUF9QT0xJQ1lfVU5TUEVDSUZJRUQQABIgChxEU1RfR0FQX1BPTElDWV9TSElGVF9GT1JXQVJEEAESFwoTRFNUX0dBUF9QT0xJQ1lfU0tJUBACKnwKEkRzdEFtYmlndW91c1BvbGljeRIkCiBEU1RfQU1CSUdVT1VTX1BPTElDWV9VTlNQRUNJRklFRBAAEiAKHERTVF9BTUJJR1VPVVNfUE9MSUNZX0VBUkxJRVIQARIeChpEU1RfQU1CSUdVT1VTX1BPTElDWV9MQVRFUhACKm8KD0FwcG9pbnRtZW50S2luZBIgChxBUFBPSU5UTUVOVF9LSU5EX1VOU1BFQ0lGSUVEEAASGgoWQVBQT0lOVE1FTlRfS0lORF9FVkVOVBABEh4KGkFQUE9JTlRNRU5UX0tJTkRfTUlMRVNUT05FEAIqaAoMVGltZVpvbmVLZWVwEh4KGlRJTUVfWk9ORV9LRUVQX1VOU1BFQ0lGSUVEEAASHAoYVElNRV9aT05FX0tFRVBfV0FMTF9USU1FEAESGgoWVElNRV9aT05FX0tFRVBfSU5TVEFOVBACKmQKDkludGVydmFsQm91bmRzEh8KG0lOVEVSVkFMX0JPVU5EU19VTlNQRUNJRklFRBAAEjEKLUlOVEVSVkFMX0JPVU5EU19TVEFSVF9JTkNMVVNJVkVfRU5EX0VYQ0xVU0lWRRABKoUBCg1Db25mbGljdENhdXNlEh4KGkNPTkZMSUNUX0NBVVNFX1VOU1BFQ0lGSUVEEAASGgoWQ09ORkxJQ1RfQ0FVU0VfT1ZFUkxBUBABEhsKF0NPTkZMSUNUX0NBVVNFX0VYVEVSTkFMEAISGwoXQ09ORkxJQ1RfQ0FVU0VfT1ZFUlJJREUQAyqnAQoOUHJvcG9zYWxTdGF0dXMSHwobUFJPUE9TQUxfU1RBVFVTX1VOU1BFQ0lGSUVEEAASGwoXUFJPUE9TQUxfU1RBVFVTX1BFTkRJTkcQARIcChhQUk9QT1NBTF9TVEFUVVNfQUNDRVBURUQQAhIcChhQUk9QT1NBTF9TVEFUVVNfREVDTElORUQQAxIbChdQUk9QT1NBTF9TVEFUVVNfRVhQSVJFRBAEKpQCChFTZXJpZXNGaW5kaW5nS2luZBIjCh9TRVJJRVNfRklORElOR19LSU5EX1VOU1BFQ0lGSUVEEAASKQolU0VSSUVTX0ZJTkRJTkdfS0lORF9JTlZBTElEX1RJTUVfWk9ORRABEiQKIFNFUklFU19GSU5ESU5HX0tJTkRfSU5WQUxJRF9SVUxFEAISMAosU0VSSUVTX0ZJTkRJTkdfS0lORF9FWENFUFRJT05fT1VUU0lERV9TRVJJRVMQAxItCilTRVJJRVNfRklORElOR19LSU5EX0VYQ0VQVElPTl9PRkZfUEFUVEVSThAEEigKJFNFUklFU19GSU5ESU5HX0tJTkRfSU5WQUxJRF9PVkVSUklERRAFKpoBCgxDaGFuZ2VFbnRpdHkSHQoZQ0hBTkdFX0VOVElUWV9VTlNQRUNJRklFRBAAEh0KGUNIQU5HRV9FTlRJVFlfQVBQT0lOVE1FTlQQARIYChRDSEFOR0VfRU5USVRZX1NFUklFUxACEhYKEkNIQU5HRV9FTlRJVFlfSE9MRBADEhoKFkNIQU5HRV9FTlRJVFlfUFJPUE9TQUwQBCqBAQoIQ2hhbmdlT3ASGQoVQ0hBTkdFX09QX1VOU1BFQ0lGSUVEEAASFQoRQ0hBTkdFX09QX0NSRUFURUQQARIVChFDSEFOR0VfT1BfVVBEQVRFRBACEhUKEUNIQU5HRV9PUF9ERUxFVEVEEAMSFQoRQ0hBTkdFX09QX0VYUElSRUQQBCpmCg5CaWxsYWJsZVBlcmlvZBIfChtCSUxMQUJMRV9QRVJJT0RfVU5TUEVDSUZJRUQQABIYChRCSUxMQUJMRV9QRVJJT0RfV0VFSxABEhkKFUJJTExBQkxFX1BFUklPRF9NT05USBACKmQKDkJpbGxhYmxlRm9ybWF0Eh8KG0JJTExBQkxFX0ZPUk1BVF9VTlNQRUNJRklFRBAAEhcKE0JJTExBQkxFX0ZPUk1BVF9DU1YQARIYChRCSUxMQUJMRV9GT1JNQVRfSlNPThACKnsKDE11dGF0aW9uS2luZBIdChlNVVRBVElPTl9LSU5EX1VOU1BFQ0lGSUVEEAASGAoUTVVUQVRJT05fS0lORF9DUkVBVEUQARIYChRNVVRBVElPTl9LSU5EX1VQREFURRACEhgKFE1VVEFUSU9OX0tJTkRfREVMRVRFEAMqjQEKDk11dGF0aW9uU3RhdHVzEh8KG01VVEFUSU9OX1NUQVRVU19VTlNQRUNJRklFRBAAEhwKGE1VVEFUSU9OX1NUQVRVU19BQ0NFUFRFRBABEh4KGk1VVEFUSU9OX1NUQVRVU19DT05GTElDVEVEEAISHAoYTVVUQVRJT05fU1RBVFVTX1JFSkVDVEVEEAMqsAEKEE11dGF0aW9uQ29uZmxpY3QSIQodTVVUQVRJT05fQ09ORkxJQ1RfVU5TUEVDSUZJRUQQABIdChlNVVRBVElPTl9DT05GTElDVF9WRVJTSU9OEAESHQoZTVVUQVRJT05fQ09ORkxJQ1RfREVMRVRFRBACEhwKGE1VVEFUSU9OX0NPTkZMSUNUX0VYSVNUUxADEh0KGU1VVEFUSU9OX0NPTkZMSUNUX09WRVJMQVAQBDLRMAoTQXBwb2ludG1lbnRzU2VydmljZRJiChFDcmVhdGVBcHBvaW50bWVudBIlLnNjaGVkdWxhLnYxLkNyZWF0ZUFwcG9pbnRtZW50UmVxdWVzdBomLnNjaGVkdWxhLnYxLkNyZWF0ZUFwcG9pbnRtZW50UmVzcG9uc2USXwoQTGlzdEFwcG9pbnRtZW50cxIkLnNjaGVkdWxhLnYxLkxpc3RBcHBvaW50bWVudHNSZXF1ZXN0GiUuc2NoZWR1bGEudjEuTGlzdEFwcG9pbnRtZW50c1Jlc3BvbnNlEmIKEURlbGV0ZUFwcG9pbnRtZW50EiUuc2NoZWR1bGEudjEuRGVsZXRlQXBwb2ludG1lbnRSZXF1ZXN0GiYuc2NoZWR1bGEudjEuRGVsZXRlQXBwb2ludG1lbnRSZXNwb25zZRJuChVDcmVhdGVSZWN1cnJpbmdTZXJpZXMSKS5zY2hlZHVsYS52MS5DcmVhdGVSZWN1cnJpbmdTZXJpZXNSZXF1ZXN0Giouc2NoZWR1bGEudjEuQ3JlYXRlUmVjdXJyaW5nU2VyaWVzUmVzcG9uc2USXAoPTGlzdE9jY3VycmVuY2VzEiMuc2NoZWR1bGEudjEuTGlzdE9jY3VycmVuY2VzUmVxdWVzdBokLnNjaGVkdWxhLnYxLkxpc3RPY2N1cnJlbmNlc1Jlc3BvbnNlEmUKEkdldFJlY3VycmluZ1NlcmllcxImLnNjaGVkdWxhLnYxLkdldFJlY3VycmluZ1Nlcmllc1JlcXVlc3QaJy5zY2hlZHVsYS52MS5HZXRSZWN1cnJpbmdTZXJpZXNSZXNwb25zZRJoChNMaXN0UmVjdXJyaW5nU2VyaWVzEicuc2NoZWR1bGEudjEuTGlzdFJlY3VycmluZ1Nlcmllc1JlcXVlc3QaKC5zY2hlZHVsYS52MS5MaXN0UmVjdXJyaW5nU2VyaWVzUmVzcG9uc2USWQoOTWFya0F0dGVuZGFuY2USIi5zY2hlZHVsYS52MS5NYXJrQXR0ZW5kYW5jZVJlcXVlc3QaIy5zY2hlZHVsYS52MS5NYXJrQXR0ZW5kYW5jZVJlc3BvbnNlEmUKEkdldEF0dGVuZGFuY2VTdGF0cxImLnNjaGVkdWxhLnYxLkdldEF0dGVuZGFuY2VTdGF0c1JlcXVlc3QaJy5zY2hlZHVsYS52MS5HZXRBdHRlbmRhbmNlU3RhdHNSZXNwb25zZRJKCglHZXRMaW1pdHMSHS5zY2hlZHVsYS52MS5HZXRMaW1pdHNSZXF1ZXN0Gh4uc2NoZWR1bGEudjEuR2V0TGltaXRzUmVzcG9uc2USgAEKG0dldEFwcG9pbnRtZW50QnlFeHRlcm5hbFJlZhIvLnNjaGVkdWxhLnYxLkdldEFwcG9pbnRtZW50QnlFeHRlcm5hbFJlZlJlcXVlc3QaMC5zY2hlZHVsYS52MS5HZXRBcHBvaW50bWVudEJ5RXh0ZXJuYWxSZWZSZXNwb25zZRJTCgxHZXRBbmFseXRpY3MSIC5zY2hlZHVsYS52MS5HZXRBbmFseXRpY3NSZXF1ZXN0GiEuc2NoZWR1bGEudjEuR2V0QW5hbHl0aWNzUmVzcG9uc2USWQoOU3VnZ2VzdEVuZFRpbWUSIi5zY2hlZHVsYS52MS5TdWdnZXN0RW5kVGltZVJlcXVlc3QaIy5zY2hlZHVsYS52MS5TdWdnZXN0RW5kVGltZVJlc3BvbnNlElAKC1Jlc2VydmVTbG90Eh8uc2NoZWR1bGEudjEuUmVzZXJ2ZVNsb3RSZXF1ZXN0GiAuc2NoZWR1bGEudjEuUmVzZXJ2ZVNsb3RSZXNwb25zZRJQCgtDb25maXJtSG9sZBIfLnNjaGVkdWxhLnYxLkNvbmZpcm1Ib2xkUmVxdWVzdBogLnNjaGVkdWxhLnYxLkNvbmZpcm1Ib2xkUmVzcG9uc2USUAoLUmVsZWFzZUhvbGQSHy5zY2hlZHVsYS52MS5SZWxlYXNlSG9sZFJlcXVlc3QaIC5zY2hlZHVsYS52MS5SZWxlYXNlSG9sZFJlc3BvbnNlEmUKElByb3Bvc2VBcHBvaW50bWVudBImLnNjaGVkdWxhLnYxLlByb3Bvc2VBcHBvaW50bWVudFJlcXVlc3QaJy5zY2hlZHVsYS52MS5Qcm9wb3NlQXBwb2ludG1lbnRSZXNwb25zZRJWCg1MaXN0UHJvcG9zYWxzEiEuc2NoZWR1bGEudjEuTGlzdFByb3Bvc2Fsc1JlcXVlc3QaIi5zY2hlZHVsYS52MS5MaXN0UHJvcG9zYWxzUmVzcG9uc2USWQoOQWNjZXB0UHJvcG9zYWwSIi5zY2hlZHVsYS52MS5BY2NlcHRQcm9wb3NhbFJlcXVlc3QaIy5zY2hlZHVsYS52MS5BY2NlcHRQcm9wb3NhbFJlc3BvbnNlElwKD0RlY2xpbmVQcm9wb3NhbBIjLnNjaGVkdWxhLnYxLkRlY2xpbmVQcm9wb3NhbFJlcXVlc3QaJC5zY2hlZHVsYS52MS5EZWNsaW5lUHJvcG9zYWxSZXNwb25zZRJfChBMaW5rQXBwb2ludG1lbnRzEiQuc2NoZWR1bGEudjEuTGlua0FwcG9pbnRtZW50c1JlcXVlc3QaJS5zY2hlZHVsYS52MS5MaW5rQXBwb2ludG1lbnRzUmVzcG9uc2USZQoSVW5saW5rQXBwb2ludG1lbnRzEiYuc2NoZWR1bGEudjEuVW5saW5rQXBwb2ludG1lbnRzUmVxdWVzdBonLnNjaGVkdWxhLnYxLlVubGlua0FwcG9pbnRtZW50c1Jlc3BvbnNlElAKC0xpc3RSZWxhdGVkEh8uc2NoZWR1bGEudjEuTGlzdFJlbGF0ZWRSZXF1ZXN0GiAuc2NoZWR1bGEudjEuTGlzdFJlbGF0ZWRSZXNwb25zZRJiChFNZXJnZUFwcG9pbnRtZW50cxIlLnNjaGVkdWxhLnYxLk1lcmdlQXBwb2ludG1lbnRzUmVxdWVzdBomLnNjaGVkdWxhLnYxLk1lcmdlQXBwb2ludG1lbnRzUmVzcG9uc2USXwoQQmF0Y2hHZXRGcmVlQnVzeRIkLnNjaGVkdWxhLnYxLkJhdGNoR2V0RnJlZUJ1c3lSZXF1ZXN0GiUuc2NoZWR1bGEudjEuQmF0Y2hHZXRGcmVlQnVzeVJlc3BvbnNlEmgKE1N1Z2dlc3RNZWV0aW5nVGltZXMSJy5zY2hlZHVsYS52MS5TdWdnZXN0TWVldGluZ1RpbWVzUmVxdWVzdBooLnNjaGVkdWxhLnYxLlN1Z2dlc3RNZWV0aW5nVGltZXNSZXNwb25zZRJuChVSZXBhaXJSZWN1cnJpbmdTZXJpZXMSKS5zY2hlZHVsYS52MS5SZXBhaXJSZWN1cnJpbmdTZXJpZXNSZXF1ZXN0Giouc2NoZWR1bGEudjEuUmVwYWlyUmVjdXJyaW5nU2VyaWVzUmVzcG9uc2USXAoPU2tpcE9jY3VycmVuY2VzEiMuc2NoZWR1bGEudjEuU2tpcE9jY3VycmVuY2VzUmVxdWVzdBokLnNjaGVkdWxhLnYxLlNraXBPY2N1cnJlbmNlc1Jlc3BvbnNlElwKD1VwZGF0ZVNlcmllc0VuZBIjLnNjaGVkdWxhLnYxLlVwZGF0ZVNlcmllc0VuZFJlcXVlc3QaJC5zY2hlZHVsYS52MS5VcGRhdGVTZXJpZXNFbmRSZXNwb25zZRJrChRDaGFuZ2VTZXJpZXNUaW1lWm9uZRIoLnNjaGVkdWxhLnYxLkNoYW5nZVNlcmllc1RpbWVab25lUmVxdWVzdBopLnNjaGVkdWxhLnYxLkNoYW5nZVNlcmllc1RpbWVab25lUmVzcG9uc2USVgoNQXVkaXRDYWxlbmRhchIhLnNjaGVkdWxhLnYxLkF1ZGl0Q2FsZW5kYXJSZXF1ZXN0GiIuc2NoZWR1bGEudjEuQXVkaXRDYWxlbmRhclJlc3BvbnNlElkKDkdldERhaWx5QWdlbmRhEiIuc2NoZWR1bGEudjEuR2V0RGFpbHlBZ2VuZGFSZXF1ZXN0GiMuc2NoZWR1bGEudjEuR2V0RGFpbHlBZ2VuZGFSZXNwb25zZRJcCg9HZXREYXlTdW1tYXJpZXMSIy5zY2hlZHVsYS52MS5HZXREYXlTdW1tYXJpZXNSZXF1ZXN0GiQuc2NoZWR1bGEudjEuR2V0RGF5U3VtbWFyaWVzUmVzcG9uc2USXAoPR3JhbnREZWxlZ2F0aW9uEiMuc2NoZWR1bGEudjEuR3JhbnREZWxlZ2F0aW9uUmVxdWVzdBokLnNjaGVkdWxhLnYxLkdyYW50RGVsZWdhdGlvblJlc3BvbnNlEl8KEFJldm9rZURlbGVnYXRpb24SJC5zY2hlZHVsYS52MS5SZXZva2VEZWxlZ2F0aW9uUmVxdWVzdBolLnNjaGVkdWxhLnYxLlJldm9rZURlbGVnYXRpb25SZXNwb25zZRJcCg9MaXN0RGVsZWdhdGlvbnMSIy5zY2hlZHVsYS52MS5MaXN0RGVsZWdhdGlvbnNSZXF1ZXN0GiQuc2NoZWR1bGEudjEuTGlzdERlbGVnYXRpb25zUmVzcG9uc2USWQoORXhwb3J0Q2FsZW5kYXISIi5zY2hlZHVsYS52MS5FeHBvcnRDYWxlbmRhclJlcXVlc3QaIy5zY2hlZHVsYS52MS5FeHBvcnRDYWxlbmRhclJlc3BvbnNlEmEKEFdhdGNoT2NjdXJyZW5jZXMSJC5zY2hlZHVsYS52MS5XYXRjaE9jY3VycmVuY2VzUmVxdWVzdBolLnNjaGVkdWxhLnYxLldhdGNoT2NjdXJyZW5jZXNSZXNwb25zZTABElAKC0xpc3RDaGFuZ2VzEh8uc2NoZWR1bGEudjEuTGlzdENoYW5nZXNSZXF1ZXN0GiAuc2NoZWR1bGEudjEuTGlzdENoYW5nZXNSZXNwb25zZRJZCg5JbXBvcnRDYWxlbmRhchIiLnNjaGVkdWxhLnYxLkltcG9ydENhbGVuZGFyUmVxdWVzdBojLnNjaGVkdWxhLnYxLkltcG9ydENhbGVuZGFyUmVzcG9uc2USYgoRUmVjb25jaWxlQ2FsZW5kYXISJS5zY2hlZHVsYS52MS5SZWNvbmNpbGVDYWxlbmRhclJlcXVlc3QaJi5zY2hlZHVsYS52MS5SZWNvbmNpbGVDYWxlbmRhclJlc3BvbnNlEkQKB0NoZWNrSW4SGy5zY2hlZHVsYS52MS5DaGVja0luUmVxdWVzdBocLnNjaGVkdWxhLnYxLkNoZWNrSW5SZXNwb25zZRJHCghDaGVja091dBIcLnNjaGVkdWxhLnYxLkNoZWNrT3V0UmVxdWVzdBodLnNjaGVkdWxhLnYxLkNoZWNrT3V0UmVzcG9uc2USaAoTRXhwb3J0QmlsbGFibGVIb3VycxInLnNjaGVkdWxhLnYxLkV4cG9ydEJpbGxhYmxlSG91cnNSZXF1ZXN0Giguc2NoZWR1bGEudjEuRXhwb3J0QmlsbGFibGVIb3Vyc1Jlc3BvbnNlElYKDUNyZWF0ZUNvbnRhY3QSIS5zY2hlZHVsYS52MS5DcmVhdGVDb250YWN0UmVxdWVzdBoiLnNjaGVkdWxhLnYxLkNyZWF0ZUNvbnRhY3RSZXNwb25zZRJNCgpHZXRDb250YWN0Eh4uc2NoZWR1bGEudjEuR2V0Q29udGFjdFJlcXVlc3QaHy5zY2hlZHVsYS52MS5HZXRDb250YWN0UmVzcG9uc2USVgoNVXBkYXRlQ29udGFjdBIhLnNjaGVkdWxhLnYxLlVwZGF0ZUNvbnRhY3RSZXF1ZXN0GiIuc2NoZWR1bGEudjEuVXBkYXRlQ29udGFjdFJlc3BvbnNlElYKDURlbGV0ZUNvbnRhY3QSIS5zY2hlZHVsYS52MS5EZWxldGVDb250YWN0UmVxdWVzdBoiLnNjaGVkdWxhLnYxLkRlbGV0ZUNvbnRhY3RSZXNwb25zZRJTCgxMaXN0Q29udGFjdHMSIC5zY2hlZHVsYS52MS5MaXN0Q29udGFjdHNSZXF1ZXN0GiEuc2NoZWR1bGEudjEuTGlzdENvbnRhY3RzUmVzcG9uc2USVgoNQ3JlYXRlUHJvZ3JhbRIhLnNjaGVkdWxhLnYxLkNyZWF0ZVByb2dyYW1SZXF1ZXN0GiIuc2NoZWR1bGEudjEuQ3JlYXRlUHJvZ3JhbVJlc3BvbnNlEk0KCkdldFByb2dyYW0SHi5zY2hlZHVsYS52MS5HZXRQcm9ncmFtUmVxdWVzdBofLnNjaGVkdWxhLnYxLkdldFByb2dyYW1SZXNwb25zZRJTCgxMaXN0UHJvZ3JhbXMSIC5zY2hlZHVsYS52MS5MaXN0UHJvZ3JhbXNSZXF1ZXN0GiEuc2NoZWR1bGEudjEuTGlzdFByb2dyYW1zUmVzcG9uc2USVgoNQ2FuY2VsUHJvZ3JhbRIhLnNjaGVkdWxhLnYxLkNhbmNlbFByb2dyYW1SZXF1ZXN0GiIuc2NoZWR1bGEudjEuQ2FuY2VsUHJvZ3JhbVJlc3BvbnNlEl8KEENyZWF0ZUVtYmVkVG9rZW4SJC5zY2hlZHVsYS52MS5DcmVhdGVFbWJlZFRva2VuUmVxdWVzdBolLnNjaGVkdWxhLnYxLkNyZWF0ZUVtYmVkVG9rZW5SZXNwb25zZRJcCg9HZXRTbG90U2V0dGluZ3MSIy5zY2hlZHVsYS52MS5HZXRTbG90U2V0dGluZ3NSZXF1ZXN0GiQuc2NoZWR1bGEudjEuR2V0U2xvdFNldHRpbmdzUmVzcG9uc2USZQoSVXBkYXRlU2xvdFNldHRpbmdzEiYuc2NoZWR1bGEudjEuVXBkYXRlU2xvdFNldHRpbmdzUmVxdWVzdBonLnNjaGVkdWxhLnYxLlVwZGF0ZVNsb3RTZXR0aW5nc1Jlc3BvbnNlEmIKEVVwZGF0ZURhaWx5QnJlYWtzEiUuc2NoZWR1bGEudjEuVXBkYXRlRGFpbHlCcmVha3NSZXF1ZXN0GiYuc2NoZWR1bGEudjEuVXBkYXRlRGFpbHlCcmVha3NSZXNwb25zZRJWCg1DcmVhdGVUaW1lT2ZmEiEuc2NoZWR1bGEudjEuQ3JlYXRlVGltZU9mZlJlcXVlc3QaIi5zY2hlZHVsYS52MS5DcmVhdGVUaW1lT2ZmUmVzcG9uc2USTQoKR2V0VGltZU9mZhIeLnNjaGVkdWxhLnYxLkdldFRpbWVPZmZSZXF1ZXN0Gh8uc2NoZWR1bGEudjEuR2V0VGltZU9mZlJlc3BvbnNlElYKDVVwZGF0ZVRpbWVPZmYSIS5zY2hlZHVsYS52MS5VcGRhdGVUaW1lT2ZmUmVxdWVzdBoiLnNjaGVkdWxhLnYxLlVwZGF0ZVRpbWVPZmZSZXNwb25zZRJWCg1EZWxldGVUaW1lT2ZmEiEuc2NoZWR1bGEudjEuRGVsZXRlVGltZU9mZlJlcXVlc3QaIi5zY2hlZHVsYS52MS5EZWxldGVUaW1lT2ZmUmVzcG9uc2USUAoLTGlzdFRpbWVPZmYSHy5zY2hlZHVsYS52MS5MaXN0VGltZU9mZlJlcXVlc3QaIC5zY2hlZHVsYS52MS5MaXN0VGltZU9mZlJlc3BvbnNlEl8KEFNpbXVsYXRlU2NoZWR1bGUSJC5zY2hlZHVsYS52MS5TaW11bGF0ZVNjaGVkdWxlUmVxdWVzdBolLnNjaGVkdWxhLnYxLlNpbXVsYXRlU2NoZWR1bGVSZXNwb25zZRJxChZDcmVhdGVDYWxlbmRhclNuYXBzaG90Eiouc2NoZWR1bGEudjEuQ3JlYXRlQ2FsZW5kYXJTbmFwc2hvdFJlcXVlc3QaKy5zY2hlZHVsYS52MS5DcmVhdGVDYWxlbmRhclNuYXBzaG90UmVzcG9uc2USbgoVTGlzdENhbGVuZGFyU25hcHNob3RzEikuc2NoZWR1bGEudjEuTGlzdENhbGVuZGFyU25hcHNob3RzUmVxdWVzdBoqLnNjaGVkdWxhLnYxLkxpc3RDYWxlbmRhclNuYXBzaG90c1Jlc3BvbnNlEnQKF1Jlc3RvcmVDYWxlbmRhclNuYXBzaG90Eisuc2NoZWR1bGEudjEuUmVzdG9yZUNhbGVuZGFyU25hcHNob3RSZXF1ZXN0Giwuc2NoZWR1bGEudjEuUmVzdG9yZUNhbGVuZGFyU25hcHNob3RSZXNwb25zZUI8WjpzY2hlZHVsYS9iYWNrZW5kL2ludGVybmFsL2dlbi9wcm90by9zY2hlZHVsYS92MTtzY2hlZHVsZXYxYgZwcm90bzM", [file_google_protobuf_duration, file_google_protobuf_timestamp]);
 
 /**
  * @generated from message schedula.v1.WeeklyRecurrence
@@ -42,6 +42,26 @@ export type WeeklyRecurrence = Message<"schedula.v1.WeeklyRecurrence"> & {
    * @generated from field: string time_zone = 5;
    */
   timeZone: string;
+
+  /**
+   * @generated from field: schedula.v1.DstGapPolicy dst_gap_policy = 6;
+   */
+  dstGapPolicy: DstGapPolicy;
+
+  /**
+   * @generated from field: schedula.v1.DstAmbiguousPolicy dst_ambiguous_policy = 7;
+   */
+  dstAmbiguousPolicy: DstAmbiguousPolicy;
+
+  /**
+   * @generated from field: schedula.v1.Weekday week_start = 8;
+   */
+  weekStart: Weekday;
+
+  /**
+   * @generated from field: repeated schedula.v1.WeekdayTime weekday_times = 9;
+   */
+  weekdayTimes: WeekdayTime[];
 };
 
 /**
@@ -51,6 +71,50 @@ export type WeeklyRecurrence = Message<"schedula.v1.WeeklyRecurrence"> & {
 export const WeeklyRecurrenceSchema: GenMessage<WeeklyRecurrence> = /*@__PURE__*/
   messageDesc(file_proto_schedula_v1_appointments, 0);
 
+/**
+ * @generated from message schedula.v1.WeekdayTime
+ */
+export type WeekdayTime = Message<"schedula.v1.WeekdayTime"> & {
+  /**
+   * @generated from field: schedula.v1.Weekday weekday = 1;
+   */
+  weekday: Weekday;
+
+  /**
+   * @generated from field: uint32 start_minute = 2;
+   */
+  startMinute: number;
+};
+
+/**
+ * Describes the message schedula.v1.WeekdayTime.
+ * Use `create(WeekdayTimeSchema)` to create a new message.
+ */
+export const WeekdayTimeSchema: GenMessage<WeekdayTime> = /*@__PURE__*/
+  messageDesc(file_proto_schedula_v1_appointments, 1);
+
+/**
+ * @generated from message schedula.v1.ExternalRef
+ */
+export type ExternalRef = Message<"schedula.v1.ExternalRef"> & {
+  /**
+   * @generated from field: string system = 1;
+   */
+  system: string;
+
+  /**
+   * @generated from field: string id = 2;
+   */
+  id: string;
+};
+
+/**
+ * Describes the message schedula.v1.ExternalRef.
+ * Use `create(ExternalRefSchema)` to create a new message.
+ */
+export const ExternalRefSchema: GenMessage<ExternalRef> = /*@__PURE__*/
+  messageDesc(file_proto_schedula_v1_appointments, 2);
+
 /**
  * @generated from message schedula.v1.Appointment
  */
@@ -94,6 +158,71 @@ export type Appointment = Message<"schedula.v1.Appointment"> & {
    * @generated from field: google.protobuf.Timestamp updated_at = 8;
    */
   updatedAt?: Timestamp;
+
+  /**
+   * @generated from field: map<string, string> metadata = 9;
+   */
+  metadata: { [key: string]: string };
+
+  /**
+   * @generated from field: schedula.v1.ExternalRef external_ref = 10;
+   */
+  externalRef?: ExternalRef;
+
+  /**
+   * @generated from field: string time_zone = 11;
+   */
+  timeZone: string;
+
+  /**
+   * @generated from field: string local_start_time = 12;
+   */
+  localStartTime: string;
+
+  /**
+   * @generated from field: string local_end_time = 13;
+   */
+  localEndTime: string;
+
+  /**
+   * @generated from field: string created_by = 14;
+   */
+  createdBy: string;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp checked_in_at = 15;
+   */
+  checkedInAt?: Timestamp;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp checked_out_at = 16;
+   */
+  checkedOutAt?: Timestamp;
+
+  /**
+   * @generated from field: string contact_id = 17;
+   */
+  contactId: string;
+
+  /**
+   * @generated from field: string source = 18;
+   */
+  source: string;
+
+  /**
+   * @generated from field: schedula.v1.AppointmentKind kind = 19;
+   */
+  kind: AppointmentKind;
+
+  /**
+   * @generated from field: string private_notes = 20;
+   */
+  privateNotes: string;
+
+  /**
+   * @generated from field: string program_id = 21;
+   */
+  programId: string;
 };
 
 /**
@@ -101,7 +230,7 @@ export type Appointment = Message<"schedula.v1.Appointment"> & {
  * Use `create(AppointmentSchema)` to create a new message.
  */
 export const AppointmentSchema: GenMessage<Appointment> = /*@__PURE__*/
-  messageDesc(file_proto_schedula_v1_appointments, 1);
+  messageDesc(file_proto_schedula_v1_appointments, 3);
 
 /**
  * @generated from message schedula.v1.CreateAppointmentRequest
@@ -131,6 +260,46 @@ export type CreateAppointmentRequest = Message<"schedula.v1.CreateAppointmentReq
    * @generated from field: google.protobuf.Timestamp end_time = 5;
    */
   endTime?: Timestamp;
+
+  /**
+   * @generated from field: map<string, string> metadata = 6;
+   */
+  metadata: { [key: string]: string };
+
+  /**
+   * @generated from field: schedula.v1.ExternalRef external_ref = 7;
+   */
+  externalRef?: ExternalRef;
+
+  /**
+   * @generated from field: string time_zone = 8;
+   */
+  timeZone: string;
+
+  /**
+   * @generated from field: string actor_id = 9;
+   */
+  actorId: string;
+
+  /**
+   * @generated from field: string contact_id = 10;
+   */
+  contactId: string;
+
+  /**
+   * @generated from field: schedula.v1.AppointmentKind kind = 11;
+   */
+  kind: AppointmentKind;
+
+  /**
+   * @generated from field: string private_notes = 12;
+   */
+  privateNotes: string;
+
+  /**
+   * @generated from field: string program_id = 13;
+   */
+  programId: string;
 };
 
 /**
@@ -138,7 +307,56 @@ export type CreateAppointmentRequest = Message<"schedula.v1.CreateAppointmentReq
  * Use `create(CreateAppointmentRequestSchema)` to create a new message.
  */
 export const CreateAppointmentRequestSchema: GenMessage<CreateAppointmentRequest> = /*@__PURE__*/
-  messageDesc(file_proto_schedula_v1_appointments, 2);
+  messageDesc(file_proto_schedula_v1_appointments, 4);
+
+/**
+ * @generated from message schedula.v1.BlackoutWarning
+ */
+export type BlackoutWarning = Message<"schedula.v1.BlackoutWarning"> & {
+  /**
+   * @generated from field: string title = 1;
+   */
+  title: string;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp start_time = 2;
+   */
+  startTime?: Timestamp;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp end_time = 3;
+   */
+  endTime?: Timestamp;
+};
+
+/**
+ * Describes the message schedula.v1.BlackoutWarning.
+ * Use `create(BlackoutWarningSchema)` to create a new message.
+ */
+export const BlackoutWarningSchema: GenMessage<BlackoutWarning> = /*@__PURE__*/
+  messageDesc(file_proto_schedula_v1_appointments, 5);
+
+/**
+ * @generated from message schedula.v1.Warning
+ */
+export type Warning = Message<"schedula.v1.Warning"> & {
+  /**
+   * @generated from field: string code = 1;
+   */
+  code: string;
+
+  /**
+   * @generated from field: string message = 2;
+   */
+  message: string;
+};
+
+/**
+ * Describes the message schedula.v1.Warning.
+ * Use `create(WarningSchema)` to create a new message.
+ */
+export const WarningSchema: GenMessage<Warning> = /*@__PURE__*/
+  messageDesc(file_proto_schedula_v1_appointments, 6);
 
 /**
  * @generated from message schedula.v1.CreateAppointmentResponse
@@ -148,6 +366,21 @@ export type CreateAppointmentResponse = Message<"schedula.v1.CreateAppointmentRe
    * @generated from field: schedula.v1.Appointment appointment = 1;
    */
   appointment?: Appointment;
+
+  /**
+   * @generated from field: repeated schedula.v1.BlackoutWarning blackout_warnings = 2;
+   */
+  blackoutWarnings: BlackoutWarning[];
+
+  /**
+   * @generated from field: bool past_start_warning = 3;
+   */
+  pastStartWarning: boolean;
+
+  /**
+   * @generated from field: repeated schedula.v1.Warning warnings = 4;
+   */
+  warnings: Warning[];
 };
 
 /**
@@ -155,7 +388,7 @@ export type CreateAppointmentResponse = Message<"schedula.v1.CreateAppointmentRe
  * Use `create(CreateAppointmentResponseSchema)` to create a new message.
  */
 export const CreateAppointmentResponseSchema: GenMessage<CreateAppointmentResponse> = /*@__PURE__*/
-  messageDesc(file_proto_schedula_v1_appointments, 3);
+  messageDesc(file_proto_schedula_v1_appointments, 7);
 
 /**
  * @generated from message schedula.v1.ListAppointmentsRequest
@@ -175,6 +408,41 @@ export type ListAppointmentsRequest = Message<"schedula.v1.ListAppointmentsReque
    * @generated from field: google.protobuf.Timestamp window_end = 3;
    */
   windowEnd?: Timestamp;
+
+  /**
+   * @generated from field: map<string, string> metadata_filter = 4;
+   */
+  metadataFilter: { [key: string]: string };
+
+  /**
+   * @generated from field: string split_time_zone = 5;
+   */
+  splitTimeZone: string;
+
+  /**
+   * @generated from field: bool include_local_times = 6;
+   */
+  includeLocalTimes: boolean;
+
+  /**
+   * @generated from field: bool start_sync = 7;
+   */
+  startSync: boolean;
+
+  /**
+   * @generated from field: string sync_token = 8;
+   */
+  syncToken: string;
+
+  /**
+   * @generated from field: string contact_id = 9;
+   */
+  contactId: string;
+
+  /**
+   * @generated from field: string source = 10;
+   */
+  source: string;
 };
 
 /**
@@ -182,7 +450,39 @@ export type ListAppointmentsRequest = Message<"schedula.v1.ListAppointmentsReque
  * Use `create(ListAppointmentsRequestSchema)` to create a new message.
  */
 export const ListAppointmentsRequestSchema: GenMessage<ListAppointmentsRequest> = /*@__PURE__*/
-  messageDesc(file_proto_schedula_v1_appointments, 4);
+  messageDesc(file_proto_schedula_v1_appointments, 8);
+
+/**
+ * @generated from message schedula.v1.DaySegment
+ */
+export type DaySegment = Message<"schedula.v1.DaySegment"> & {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id: string;
+
+  /**
+   * @generated from field: string local_date = 2;
+   */
+  localDate: string;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp start_time = 3;
+   */
+  startTime?: Timestamp;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp end_time = 4;
+   */
+  endTime?: Timestamp;
+};
+
+/**
+ * Describes the message schedula.v1.DaySegment.
+ * Use `create(DaySegmentSchema)` to create a new message.
+ */
+export const DaySegmentSchema: GenMessage<DaySegment> = /*@__PURE__*/
+  messageDesc(file_proto_schedula_v1_appointments, 9);
 
 /**
  * @generated from message schedula.v1.ListAppointmentsResponse
@@ -192,6 +492,31 @@ export type ListAppointmentsResponse = Message<"schedula.v1.ListAppointmentsResp
    * @generated from field: repeated schedula.v1.Appointment appointments = 1;
    */
   appointments: Appointment[];
+
+  /**
+   * @generated from field: repeated schedula.v1.DaySegment day_segments = 2;
+   */
+  daySegments: DaySegment[];
+
+  /**
+   * @generated from field: string next_sync_token = 3;
+   */
+  nextSyncToken: string;
+
+  /**
+   * @generated from field: bool full_sync = 4;
+   */
+  fullSync: boolean;
+
+  /**
+   * @generated from field: repeated string deleted_appointment_ids = 5;
+   */
+  deletedAppointmentIds: string[];
+
+  /**
+   * @generated from field: int64 calendar_version = 6;
+   */
+  calendarVersion: bigint;
 };
 
 /**
@@ -199,7 +524,46 @@ export type ListAppointmentsResponse = Message<"schedula.v1.ListAppointmentsResp
  * Use `create(ListAppointmentsResponseSchema)` to create a new message.
  */
 export const ListAppointmentsResponseSchema: GenMessage<ListAppointmentsResponse> = /*@__PURE__*/
-  messageDesc(file_proto_schedula_v1_appointments, 5);
+  messageDesc(file_proto_schedula_v1_appointments, 10);
+
+/**
+ * @generated from message schedula.v1.GetAppointmentByExternalRefRequest
+ */
+export type GetAppointmentByExternalRefRequest = Message<"schedula.v1.GetAppointmentByExternalRefRequest"> & {
+  /**
+   * @generated from field: string user_id = 1;
+   */
+  userId: string;
+
+  /**
+   * @generated from field: schedula.v1.ExternalRef external_ref = 2;
+   */
+  externalRef?: ExternalRef;
+};
+
+/**
+ * Describes the message schedula.v1.GetAppointmentByExternalRefRequest.
+ * Use `create(GetAppointmentByExternalRefRequestSchema)` to create a new message.
+ */
+export const GetAppointmentByExternalRefRequestSchema: GenMessage<GetAppointmentByExternalRefRequest> = /*@__PURE__*/
+  messageDesc(file_proto_schedula_v1_appointments, 11);
+
+/**
+ * @generated from message schedula.v1.GetAppointmentByExternalRefResponse
+ */
+export type GetAppointmentByExternalRefResponse = Message<"schedula.v1.GetAppointmentByExternalRefResponse"> & {
+  /**
+   * @generated from field: schedula.v1.Appointment appointment = 1;
+   */
+  appointment?: Appointment;
+};
+
+/**
+ * Describes the message schedula.v1.GetAppointmentByExternalRefResponse.
+ * Use `create(GetAppointmentByExternalRefResponseSchema)` to create a new message.
+ */
+export const GetAppointmentByExternalRefResponseSchema: GenMessage<GetAppointmentByExternalRefResponse> = /*@__PURE__*/
+  messageDesc(file_proto_schedula_v1_appointments, 12);
 
 /**
  * @generated from message schedula.v1.DeleteAppointmentRequest
@@ -214,6 +578,11 @@ export type DeleteAppointmentRequest = Message<"schedula.v1.DeleteAppointmentReq
    * @generated from field: string appointment_id = 2;
    */
   appointmentId: string;
+
+  /**
+   * @generated from field: string actor_id = 3;
+   */
+  actorId: string;
 };
 
 /**
@@ -221,7 +590,7 @@ export type DeleteAppointmentRequest = Message<"schedula.v1.DeleteAppointmentReq
  * Use `create(DeleteAppointmentRequestSchema)` to create a new message.
  */
 export const DeleteAppointmentRequestSchema: GenMessage<DeleteAppointmentRequest> = /*@__PURE__*/
-  messageDesc(file_proto_schedula_v1_appointments, 6);
+  messageDesc(file_proto_schedula_v1_appointments, 13);
 
 /**
  * @generated from message schedula.v1.DeleteAppointmentResponse
@@ -234,7 +603,7 @@ export type DeleteAppointmentResponse = Message<"schedula.v1.DeleteAppointmentRe
  * Use `create(DeleteAppointmentResponseSchema)` to create a new message.
  */
 export const DeleteAppointmentResponseSchema: GenMessage<DeleteAppointmentResponse> = /*@__PURE__*/
-  messageDesc(file_proto_schedula_v1_appointments, 7);
+  messageDesc(file_proto_schedula_v1_appointments, 14);
 
 /**
  * @generated from message schedula.v1.RecurringSeries
@@ -284,14 +653,44 @@ export type RecurringSeries = Message<"schedula.v1.RecurringSeries"> & {
    * @generated from field: google.protobuf.Timestamp updated_at = 9;
    */
   updatedAt?: Timestamp;
-};
 
-/**
- * Describes the message schedula.v1.RecurringSeries.
- * Use `create(RecurringSeriesSchema)` to create a new message.
- */
-export const RecurringSeriesSchema: GenMessage<RecurringSeries> = /*@__PURE__*/
-  messageDesc(file_proto_schedula_v1_appointments, 8);
+  /**
+   * @generated from field: uint32 occurrences_remaining = 10;
+   */
+  occurrencesRemaining: number;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp next_occurrence = 11;
+   */
+  nextOccurrence?: Timestamp;
+
+  /**
+   * @generated from field: map<string, string> metadata = 12;
+   */
+  metadata: { [key: string]: string };
+
+  /**
+   * @generated from field: string created_by = 13;
+   */
+  createdBy: string;
+
+  /**
+   * @generated from field: string program_id = 14;
+   */
+  programId: string;
+
+  /**
+   * @generated from field: google.protobuf.Duration duration = 15;
+   */
+  duration?: Duration;
+};
+
+/**
+ * Describes the message schedula.v1.RecurringSeries.
+ * Use `create(RecurringSeriesSchema)` to create a new message.
+ */
+export const RecurringSeriesSchema: GenMessage<RecurringSeries> = /*@__PURE__*/
+  messageDesc(file_proto_schedula_v1_appointments, 15);
 
 /**
  * @generated from message schedula.v1.CreateRecurringSeriesRequest
@@ -326,6 +725,31 @@ export type CreateRecurringSeriesRequest = Message<"schedula.v1.CreateRecurringS
    * @generated from field: schedula.v1.WeeklyRecurrence weekly = 6;
    */
   weekly?: WeeklyRecurrence;
+
+  /**
+   * @generated from field: map<string, string> metadata = 7;
+   */
+  metadata: { [key: string]: string };
+
+  /**
+   * @generated from field: bool skip_conflicts = 8;
+   */
+  skipConflicts: boolean;
+
+  /**
+   * @generated from field: string actor_id = 9;
+   */
+  actorId: string;
+
+  /**
+   * @generated from field: string program_id = 10;
+   */
+  programId: string;
+
+  /**
+   * @generated from field: google.protobuf.Duration duration = 11;
+   */
+  duration?: Duration;
 };
 
 /**
@@ -333,7 +757,7 @@ export type CreateRecurringSeriesRequest = Message<"schedula.v1.CreateRecurringS
  * Use `create(CreateRecurringSeriesRequestSchema)` to create a new message.
  */
 export const CreateRecurringSeriesRequestSchema: GenMessage<CreateRecurringSeriesRequest> = /*@__PURE__*/
-  messageDesc(file_proto_schedula_v1_appointments, 9);
+  messageDesc(file_proto_schedula_v1_appointments, 16);
 
 /**
  * @generated from message schedula.v1.CreateRecurringSeriesResponse
@@ -343,6 +767,21 @@ export type CreateRecurringSeriesResponse = Message<"schedula.v1.CreateRecurring
    * @generated from field: schedula.v1.RecurringSeries series = 1;
    */
   series?: RecurringSeries;
+
+  /**
+   * @generated from field: repeated google.protobuf.Timestamp skipped_occurrences = 2;
+   */
+  skippedOccurrences: Timestamp[];
+
+  /**
+   * @generated from field: repeated schedula.v1.BlackoutWarning blackout_warnings = 3;
+   */
+  blackoutWarnings: BlackoutWarning[];
+
+  /**
+   * @generated from field: repeated schedula.v1.Warning warnings = 4;
+   */
+  warnings: Warning[];
 };
 
 /**
@@ -350,7 +789,85 @@ export type CreateRecurringSeriesResponse = Message<"schedula.v1.CreateRecurring
  * Use `create(CreateRecurringSeriesResponseSchema)` to create a new message.
  */
 export const CreateRecurringSeriesResponseSchema: GenMessage<CreateRecurringSeriesResponse> = /*@__PURE__*/
-  messageDesc(file_proto_schedula_v1_appointments, 10);
+  messageDesc(file_proto_schedula_v1_appointments, 17);
+
+/**
+ * @generated from message schedula.v1.GetRecurringSeriesRequest
+ */
+export type GetRecurringSeriesRequest = Message<"schedula.v1.GetRecurringSeriesRequest"> & {
+  /**
+   * @generated from field: string user_id = 1;
+   */
+  userId: string;
+
+  /**
+   * @generated from field: string series_id = 2;
+   */
+  seriesId: string;
+};
+
+/**
+ * Describes the message schedula.v1.GetRecurringSeriesRequest.
+ * Use `create(GetRecurringSeriesRequestSchema)` to create a new message.
+ */
+export const GetRecurringSeriesRequestSchema: GenMessage<GetRecurringSeriesRequest> = /*@__PURE__*/
+  messageDesc(file_proto_schedula_v1_appointments, 18);
+
+/**
+ * @generated from message schedula.v1.GetRecurringSeriesResponse
+ */
+export type GetRecurringSeriesResponse = Message<"schedula.v1.GetRecurringSeriesResponse"> & {
+  /**
+   * @generated from field: schedula.v1.RecurringSeries series = 1;
+   */
+  series?: RecurringSeries;
+};
+
+/**
+ * Describes the message schedula.v1.GetRecurringSeriesResponse.
+ * Use `create(GetRecurringSeriesResponseSchema)` to create a new message.
+ */
+export const GetRecurringSeriesResponseSchema: GenMessage<GetRecurringSeriesResponse> = /*@__PURE__*/
+  messageDesc(file_proto_schedula_v1_appointments, 19);
+
+/**
+ * @generated from message schedula.v1.ListRecurringSeriesRequest
+ */
+export type ListRecurringSeriesRequest = Message<"schedula.v1.ListRecurringSeriesRequest"> & {
+  /**
+   * @generated from field: string user_id = 1;
+   */
+  userId: string;
+
+  /**
+   * @generated from field: repeated schedula.v1.Weekday weekdays = 2;
+   */
+  weekdays: Weekday[];
+};
+
+/**
+ * Describes the message schedula.v1.ListRecurringSeriesRequest.
+ * Use `create(ListRecurringSeriesRequestSchema)` to create a new message.
+ */
+export const ListRecurringSeriesRequestSchema: GenMessage<ListRecurringSeriesRequest> = /*@__PURE__*/
+  messageDesc(file_proto_schedula_v1_appointments, 20);
+
+/**
+ * @generated from message schedula.v1.ListRecurringSeriesResponse
+ */
+export type ListRecurringSeriesResponse = Message<"schedula.v1.ListRecurringSeriesResponse"> & {
+  /**
+   * @generated from field: repeated schedula.v1.RecurringSeries series = 1;
+   */
+  series: RecurringSeries[];
+};
+
+/**
+ * Describes the message schedula.v1.ListRecurringSeriesResponse.
+ * Use `create(ListRecurringSeriesResponseSchema)` to create a new message.
+ */
+export const ListRecurringSeriesResponseSchema: GenMessage<ListRecurringSeriesResponse> = /*@__PURE__*/
+  messageDesc(file_proto_schedula_v1_appointments, 21);
 
 /**
  * @generated from message schedula.v1.Occurrence
@@ -390,6 +907,26 @@ export type Occurrence = Message<"schedula.v1.Occurrence"> & {
    * @generated from field: google.protobuf.Timestamp end_time = 7;
    */
   endTime?: Timestamp;
+
+  /**
+   * @generated from field: map<string, string> metadata = 8;
+   */
+  metadata: { [key: string]: string };
+
+  /**
+   * @generated from field: string time_zone = 9;
+   */
+  timeZone: string;
+
+  /**
+   * @generated from field: string local_start_time = 10;
+   */
+  localStartTime: string;
+
+  /**
+   * @generated from field: string local_end_time = 11;
+   */
+  localEndTime: string;
 };
 
 /**
@@ -397,7 +934,7 @@ export type Occurrence = Message<"schedula.v1.Occurrence"> & {
  * Use `create(OccurrenceSchema)` to create a new message.
  */
 export const OccurrenceSchema: GenMessage<Occurrence> = /*@__PURE__*/
-  messageDesc(file_proto_schedula_v1_appointments, 11);
+  messageDesc(file_proto_schedula_v1_appointments, 22);
 
 /**
  * @generated from message schedula.v1.ListOccurrencesRequest
@@ -417,6 +954,31 @@ export type ListOccurrencesRequest = Message<"schedula.v1.ListOccurrencesRequest
    * @generated from field: google.protobuf.Timestamp window_end = 3;
    */
   windowEnd?: Timestamp;
+
+  /**
+   * @generated from field: string split_time_zone = 4;
+   */
+  splitTimeZone: string;
+
+  /**
+   * @generated from field: bool include_local_times = 5;
+   */
+  includeLocalTimes: boolean;
+
+  /**
+   * @generated from field: bool start_sync = 6;
+   */
+  startSync: boolean;
+
+  /**
+   * @generated from field: string sync_token = 7;
+   */
+  syncToken: string;
+
+  /**
+   * @generated from field: google.protobuf.Duration max_horizon = 8;
+   */
+  maxHorizon?: Duration;
 };
 
 /**
@@ -424,7 +986,7 @@ export type ListOccurrencesRequest = Message<"schedula.v1.ListOccurrencesRequest
  * Use `create(ListOccurrencesRequestSchema)` to create a new message.
  */
 export const ListOccurrencesRequestSchema: GenMessage<ListOccurrencesRequest> = /*@__PURE__*/
-  messageDesc(file_proto_schedula_v1_appointments, 12);
+  messageDesc(file_proto_schedula_v1_appointments, 23);
 
 /**
  * @generated from message schedula.v1.ListOccurrencesResponse
@@ -434,6 +996,41 @@ export type ListOccurrencesResponse = Message<"schedula.v1.ListOccurrencesRespon
    * @generated from field: repeated schedula.v1.Occurrence occurrences = 1;
    */
   occurrences: Occurrence[];
+
+  /**
+   * @generated from field: repeated schedula.v1.DaySegment day_segments = 2;
+   */
+  daySegments: DaySegment[];
+
+  /**
+   * @generated from field: string next_sync_token = 3;
+   */
+  nextSyncToken: string;
+
+  /**
+   * @generated from field: bool full_sync = 4;
+   */
+  fullSync: boolean;
+
+  /**
+   * @generated from field: repeated string changed_series_ids = 5;
+   */
+  changedSeriesIds: string[];
+
+  /**
+   * @generated from field: int64 calendar_version = 6;
+   */
+  calendarVersion: bigint;
+
+  /**
+   * @generated from field: bool truncated = 7;
+   */
+  truncated: boolean;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp expanded_until = 8;
+   */
+  expandedUntil?: Timestamp;
 };
 
 /**
@@ -441,102 +1038,5244 @@ export type ListOccurrencesResponse = Message<"schedula.v1.ListOccurrencesRespon
  * Use `create(ListOccurrencesResponseSchema)` to create a new message.
  */
 export const ListOccurrencesResponseSchema: GenMessage<ListOccurrencesResponse> = /*@__PURE__*/
-  messageDesc(file_proto_schedula_v1_appointments, 13);
+  messageDesc(file_proto_schedula_v1_appointments, 24);
 
 /**
- * @generated from enum schedula.v1.Weekday
+ * @generated from message schedula.v1.OccurrenceAttendance
  */
-export enum Weekday {
+export type OccurrenceAttendance = Message<"schedula.v1.OccurrenceAttendance"> & {
   /**
-   * @generated from enum value: WEEKDAY_UNSPECIFIED = 0;
+   * @generated from field: string series_id = 1;
    */
-  WEEKDAY_UNSPECIFIED = 0,
+  seriesId: string;
 
   /**
-   * @generated from enum value: MONDAY = 1;
+   * @generated from field: string occurrence_id = 2;
    */
-  MONDAY = 1,
+  occurrenceId: string;
 
   /**
-   * @generated from enum value: TUESDAY = 2;
+   * @generated from field: string participant_id = 3;
    */
-  TUESDAY = 2,
+  participantId: string;
 
   /**
-   * @generated from enum value: WEDNESDAY = 3;
+   * @generated from field: schedula.v1.AttendanceStatus status = 4;
    */
-  WEDNESDAY = 3,
+  status: AttendanceStatus;
 
   /**
-   * @generated from enum value: THURSDAY = 4;
+   * @generated from field: google.protobuf.Timestamp occurrence_start = 5;
    */
-  THURSDAY = 4,
+  occurrenceStart?: Timestamp;
 
   /**
-   * @generated from enum value: FRIDAY = 5;
+   * @generated from field: google.protobuf.Timestamp updated_at = 6;
    */
-  FRIDAY = 5,
+  updatedAt?: Timestamp;
+};
+
+/**
+ * Describes the message schedula.v1.OccurrenceAttendance.
+ * Use `create(OccurrenceAttendanceSchema)` to create a new message.
+ */
+export const OccurrenceAttendanceSchema: GenMessage<OccurrenceAttendance> = /*@__PURE__*/
+  messageDesc(file_proto_schedula_v1_appointments, 25);
+
+/**
+ * @generated from message schedula.v1.MarkAttendanceRequest
+ */
+export type MarkAttendanceRequest = Message<"schedula.v1.MarkAttendanceRequest"> & {
+  /**
+   * @generated from field: string user_id = 1;
+   */
+  userId: string;
 
   /**
-   * @generated from enum value: SATURDAY = 6;
+   * @generated from field: string series_id = 2;
    */
-  SATURDAY = 6,
+  seriesId: string;
 
   /**
-   * @generated from enum value: SUNDAY = 7;
+   * @generated from field: string occurrence_id = 3;
    */
-  SUNDAY = 7,
-}
+  occurrenceId: string;
+
+  /**
+   * @generated from field: string participant_id = 4;
+   */
+  participantId: string;
+
+  /**
+   * @generated from field: schedula.v1.AttendanceStatus status = 5;
+   */
+  status: AttendanceStatus;
+};
 
 /**
- * Describes the enum schedula.v1.Weekday.
+ * Describes the message schedula.v1.MarkAttendanceRequest.
+ * Use `create(MarkAttendanceRequestSchema)` to create a new message.
  */
-export const WeekdaySchema: GenEnum<Weekday> = /*@__PURE__*/
-  enumDesc(file_proto_schedula_v1_appointments, 0);
+export const MarkAttendanceRequestSchema: GenMessage<MarkAttendanceRequest> = /*@__PURE__*/
+  messageDesc(file_proto_schedula_v1_appointments, 26);
 
 /**
- * @generated from service schedula.v1.AppointmentsService
+ * @generated from message schedula.v1.MarkAttendanceResponse
  */
-export const AppointmentsService: GenService<{
+export type MarkAttendanceResponse = Message<"schedula.v1.MarkAttendanceResponse"> & {
   /**
-   * @generated from rpc schedula.v1.AppointmentsService.CreateAppointment
+   * @generated from field: schedula.v1.OccurrenceAttendance attendance = 1;
    */
-  createAppointment: {
-    methodKind: "unary";
-    input: typeof CreateAppointmentRequestSchema;
-    output: typeof CreateAppointmentResponseSchema;
-  },
+  attendance?: OccurrenceAttendance;
+};
+
+/**
+ * Describes the message schedula.v1.MarkAttendanceResponse.
+ * Use `create(MarkAttendanceResponseSchema)` to create a new message.
+ */
+export const MarkAttendanceResponseSchema: GenMessage<MarkAttendanceResponse> = /*@__PURE__*/
+  messageDesc(file_proto_schedula_v1_appointments, 27);
+
+/**
+ * @generated from message schedula.v1.ParticipantAttendanceStats
+ */
+export type ParticipantAttendanceStats = Message<"schedula.v1.ParticipantAttendanceStats"> & {
   /**
-   * @generated from rpc schedula.v1.AppointmentsService.ListAppointments
+   * @generated from field: string participant_id = 1;
    */
-  listAppointments: {
-    methodKind: "unary";
-    input: typeof ListAppointmentsRequestSchema;
-    output: typeof ListAppointmentsResponseSchema;
-  },
+  participantId: string;
+
   /**
-   * @generated from rpc schedula.v1.AppointmentsService.DeleteAppointment
+   * @generated from field: uint32 attended = 2;
    */
-  deleteAppointment: {
-    methodKind: "unary";
-    input: typeof DeleteAppointmentRequestSchema;
-    output: typeof DeleteAppointmentResponseSchema;
-  },
+  attended: number;
+
   /**
-   * @generated from rpc schedula.v1.AppointmentsService.CreateRecurringSeries
+   * @generated from field: uint32 missed = 3;
    */
-  createRecurringSeries: {
-    methodKind: "unary";
-    input: typeof CreateRecurringSeriesRequestSchema;
-    output: typeof CreateRecurringSeriesResponseSchema;
-  },
+  missed: number;
+};
+
+/**
+ * Describes the message schedula.v1.ParticipantAttendanceStats.
+ * Use `create(ParticipantAttendanceStatsSchema)` to create a new message.
+ */
+export const ParticipantAttendanceStatsSchema: GenMessage<ParticipantAttendanceStats> = /*@__PURE__*/
+  messageDesc(file_proto_schedula_v1_appointments, 28);
+
+/**
+ * @generated from message schedula.v1.GetAttendanceStatsRequest
+ */
+export type GetAttendanceStatsRequest = Message<"schedula.v1.GetAttendanceStatsRequest"> & {
   /**
-   * @generated from rpc schedula.v1.AppointmentsService.ListOccurrences
+   * @generated from field: string user_id = 1;
    */
-  listOccurrences: {
-    methodKind: "unary";
-    input: typeof ListOccurrencesRequestSchema;
-    output: typeof ListOccurrencesResponseSchema;
+  userId: string;
+
+  /**
+   * @generated from field: string series_id = 2;
+   */
+  seriesId: string;
+};
+
+/**
+ * Describes the message schedula.v1.GetAttendanceStatsRequest.
+ * Use `create(GetAttendanceStatsRequestSchema)` to create a new message.
+ */
+export const GetAttendanceStatsRequestSchema: GenMessage<GetAttendanceStatsRequest> = /*@__PURE__*/
+  messageDesc(file_proto_schedula_v1_appointments, 29);
+
+/**
+ * @generated from message schedula.v1.GetAttendanceStatsResponse
+ */
+export type GetAttendanceStatsResponse = Message<"schedula.v1.GetAttendanceStatsResponse"> & {
+  /**
+   * @generated from field: repeated schedula.v1.ParticipantAttendanceStats participants = 1;
+   */
+  participants: ParticipantAttendanceStats[];
+
+  /**
+   * @generated from field: uint32 occurrences_tracked = 2;
+   */
+  occurrencesTracked: number;
+};
+
+/**
+ * Describes the message schedula.v1.GetAttendanceStatsResponse.
+ * Use `create(GetAttendanceStatsResponseSchema)` to create a new message.
+ */
+export const GetAttendanceStatsResponseSchema: GenMessage<GetAttendanceStatsResponse> = /*@__PURE__*/
+  messageDesc(file_proto_schedula_v1_appointments, 30);
+
+/**
+ * @generated from message schedula.v1.GetLimitsRequest
+ */
+export type GetLimitsRequest = Message<"schedula.v1.GetLimitsRequest"> & {
+  /**
+   * @generated from field: string user_id = 1;
+   */
+  userId: string;
+};
+
+/**
+ * Describes the message schedula.v1.GetLimitsRequest.
+ * Use `create(GetLimitsRequestSchema)` to create a new message.
+ */
+export const GetLimitsRequestSchema: GenMessage<GetLimitsRequest> = /*@__PURE__*/
+  messageDesc(file_proto_schedula_v1_appointments, 31);
+
+/**
+ * @generated from message schedula.v1.GetLimitsResponse
+ */
+export type GetLimitsResponse = Message<"schedula.v1.GetLimitsResponse"> & {
+  /**
+   * @generated from field: google.protobuf.Duration max_appointment_duration = 1;
+   */
+  maxAppointmentDuration?: Duration;
+
+  /**
+   * @generated from field: google.protobuf.Duration recurring_lookahead = 2;
+   */
+  recurringLookahead?: Duration;
+
+  /**
+   * @generated from field: uint32 max_title_length = 3;
+   */
+  maxTitleLength: number;
+
+  /**
+   * @generated from field: uint32 max_notes_length = 4;
+   */
+  maxNotesLength: number;
+
+  /**
+   * @generated from field: uint32 max_weekdays = 5;
+   */
+  maxWeekdays: number;
+
+  /**
+   * @generated from field: uint32 max_participant_id_length = 6;
+   */
+  maxParticipantIdLength: number;
+
+  /**
+   * @generated from field: uint32 max_message_bytes = 7;
+   */
+  maxMessageBytes: number;
+
+  /**
+   * @generated from field: uint32 max_metadata_entries = 8;
+   */
+  maxMetadataEntries: number;
+
+  /**
+   * @generated from field: uint32 max_metadata_key_length = 9;
+   */
+  maxMetadataKeyLength: number;
+
+  /**
+   * @generated from field: uint32 max_metadata_value_length = 10;
+   */
+  maxMetadataValueLength: number;
+
+  /**
+   * @generated from field: schedula.v1.IntervalBounds interval_bounds = 11;
+   */
+  intervalBounds: IntervalBounds;
+
+  /**
+   * @generated from field: uint32 max_active_series = 12;
+   */
+  maxActiveSeries: number;
+
+  /**
+   * @generated from field: uint32 max_future_occurrences = 13;
+   */
+  maxFutureOccurrences: number;
+};
+
+/**
+ * Describes the message schedula.v1.GetLimitsResponse.
+ * Use `create(GetLimitsResponseSchema)` to create a new message.
+ */
+export const GetLimitsResponseSchema: GenMessage<GetLimitsResponse> = /*@__PURE__*/
+  messageDesc(file_proto_schedula_v1_appointments, 32);
+
+/**
+ * @generated from message schedula.v1.GetAnalyticsRequest
+ */
+export type GetAnalyticsRequest = Message<"schedula.v1.GetAnalyticsRequest"> & {
+  /**
+   * @generated from field: string user_id = 1;
+   */
+  userId: string;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp window_start = 2;
+   */
+  windowStart?: Timestamp;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp window_end = 3;
+   */
+  windowEnd?: Timestamp;
+};
+
+/**
+ * Describes the message schedula.v1.GetAnalyticsRequest.
+ * Use `create(GetAnalyticsRequestSchema)` to create a new message.
+ */
+export const GetAnalyticsRequestSchema: GenMessage<GetAnalyticsRequest> = /*@__PURE__*/
+  messageDesc(file_proto_schedula_v1_appointments, 33);
+
+/**
+ * @generated from message schedula.v1.GetAnalyticsResponse
+ */
+export type GetAnalyticsResponse = Message<"schedula.v1.GetAnalyticsResponse"> & {
+  /**
+   * @generated from field: uint32 appointment_count = 1;
+   */
+  appointmentCount: number;
+
+  /**
+   * @generated from field: google.protobuf.Duration average_duration = 2;
+   */
+  averageDuration?: Duration;
+
+  /**
+   * @generated from field: uint32 attended = 3;
+   */
+  attended: number;
+
+  /**
+   * @generated from field: uint32 missed = 4;
+   */
+  missed: number;
+
+  /**
+   * @generated from field: double no_show_rate = 5;
+   */
+  noShowRate: number;
+
+  /**
+   * @generated from field: uint32 sessions = 6;
+   */
+  sessions: number;
+
+  /**
+   * @generated from field: google.protobuf.Duration planned_session_time = 7;
+   */
+  plannedSessionTime?: Duration;
+
+  /**
+   * @generated from field: google.protobuf.Duration actual_session_time = 8;
+   */
+  actualSessionTime?: Duration;
+};
+
+/**
+ * Describes the message schedula.v1.GetAnalyticsResponse.
+ * Use `create(GetAnalyticsResponseSchema)` to create a new message.
+ */
+export const GetAnalyticsResponseSchema: GenMessage<GetAnalyticsResponse> = /*@__PURE__*/
+  messageDesc(file_proto_schedula_v1_appointments, 34);
+
+/**
+ * @generated from message schedula.v1.SuggestEndTimeRequest
+ */
+export type SuggestEndTimeRequest = Message<"schedula.v1.SuggestEndTimeRequest"> & {
+  /**
+   * @generated from field: string user_id = 1;
+   */
+  userId: string;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp start_time = 2;
+   */
+  startTime?: Timestamp;
+
+  /**
+   * @generated from field: google.protobuf.Duration desired_duration = 3;
+   */
+  desiredDuration?: Duration;
+
+  /**
+   * @generated from field: schedula.v1.WorkingHours working_hours = 4;
+   */
+  workingHours?: WorkingHours;
+};
+
+/**
+ * Describes the message schedula.v1.SuggestEndTimeRequest.
+ * Use `create(SuggestEndTimeRequestSchema)` to create a new message.
+ */
+export const SuggestEndTimeRequestSchema: GenMessage<SuggestEndTimeRequest> = /*@__PURE__*/
+  messageDesc(file_proto_schedula_v1_appointments, 35);
+
+/**
+ * @generated from message schedula.v1.SuggestEndTimeResponse
+ */
+export type SuggestEndTimeResponse = Message<"schedula.v1.SuggestEndTimeResponse"> & {
+  /**
+   * @generated from field: google.protobuf.Timestamp end_time = 1;
+   */
+  endTime?: Timestamp;
+
+  /**
+   * @generated from field: google.protobuf.Duration duration = 2;
+   */
+  duration?: Duration;
+
+  /**
+   * @generated from field: bool shortened = 3;
+   */
+  shortened: boolean;
+};
+
+/**
+ * Describes the message schedula.v1.SuggestEndTimeResponse.
+ * Use `create(SuggestEndTimeResponseSchema)` to create a new message.
+ */
+export const SuggestEndTimeResponseSchema: GenMessage<SuggestEndTimeResponse> = /*@__PURE__*/
+  messageDesc(file_proto_schedula_v1_appointments, 36);
+
+/**
+ * @generated from message schedula.v1.SlotHold
+ */
+export type SlotHold = Message<"schedula.v1.SlotHold"> & {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id: string;
+
+  /**
+   * @generated from field: string user_id = 2;
+   */
+  userId: string;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp start_time = 3;
+   */
+  startTime?: Timestamp;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp end_time = 4;
+   */
+  endTime?: Timestamp;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp expires_at = 5;
+   */
+  expiresAt?: Timestamp;
+};
+
+/**
+ * Describes the message schedula.v1.SlotHold.
+ * Use `create(SlotHoldSchema)` to create a new message.
+ */
+export const SlotHoldSchema: GenMessage<SlotHold> = /*@__PURE__*/
+  messageDesc(file_proto_schedula_v1_appointments, 37);
+
+/**
+ * @generated from message schedula.v1.ReserveSlotRequest
+ */
+export type ReserveSlotRequest = Message<"schedula.v1.ReserveSlotRequest"> & {
+  /**
+   * @generated from field: string user_id = 1;
+   */
+  userId: string;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp start_time = 2;
+   */
+  startTime?: Timestamp;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp end_time = 3;
+   */
+  endTime?: Timestamp;
+
+  /**
+   * @generated from field: google.protobuf.Duration ttl = 4;
+   */
+  ttl?: Duration;
+};
+
+/**
+ * Describes the message schedula.v1.ReserveSlotRequest.
+ * Use `create(ReserveSlotRequestSchema)` to create a new message.
+ */
+export const ReserveSlotRequestSchema: GenMessage<ReserveSlotRequest> = /*@__PURE__*/
+  messageDesc(file_proto_schedula_v1_appointments, 38);
+
+/**
+ * @generated from message schedula.v1.ReserveSlotResponse
+ */
+export type ReserveSlotResponse = Message<"schedula.v1.ReserveSlotResponse"> & {
+  /**
+   * @generated from field: schedula.v1.SlotHold hold = 1;
+   */
+  hold?: SlotHold;
+};
+
+/**
+ * Describes the message schedula.v1.ReserveSlotResponse.
+ * Use `create(ReserveSlotResponseSchema)` to create a new message.
+ */
+export const ReserveSlotResponseSchema: GenMessage<ReserveSlotResponse> = /*@__PURE__*/
+  messageDesc(file_proto_schedula_v1_appointments, 39);
+
+/**
+ * @generated from message schedula.v1.ConfirmHoldRequest
+ */
+export type ConfirmHoldRequest = Message<"schedula.v1.ConfirmHoldRequest"> & {
+  /**
+   * @generated from field: string user_id = 1;
+   */
+  userId: string;
+
+  /**
+   * @generated from field: string hold_id = 2;
+   */
+  holdId: string;
+
+  /**
+   * @generated from field: string title = 3;
+   */
+  title: string;
+
+  /**
+   * @generated from field: string notes = 4;
+   */
+  notes: string;
+
+  /**
+   * @generated from field: map<string, string> metadata = 5;
+   */
+  metadata: { [key: string]: string };
+};
+
+/**
+ * Describes the message schedula.v1.ConfirmHoldRequest.
+ * Use `create(ConfirmHoldRequestSchema)` to create a new message.
+ */
+export const ConfirmHoldRequestSchema: GenMessage<ConfirmHoldRequest> = /*@__PURE__*/
+  messageDesc(file_proto_schedula_v1_appointments, 40);
+
+/**
+ * @generated from message schedula.v1.ConfirmHoldResponse
+ */
+export type ConfirmHoldResponse = Message<"schedula.v1.ConfirmHoldResponse"> & {
+  /**
+   * @generated from field: schedula.v1.Appointment appointment = 1;
+   */
+  appointment?: Appointment;
+
+  /**
+   * @generated from field: repeated schedula.v1.Warning warnings = 2;
+   */
+  warnings: Warning[];
+};
+
+/**
+ * Describes the message schedula.v1.ConfirmHoldResponse.
+ * Use `create(ConfirmHoldResponseSchema)` to create a new message.
+ */
+export const ConfirmHoldResponseSchema: GenMessage<ConfirmHoldResponse> = /*@__PURE__*/
+  messageDesc(file_proto_schedula_v1_appointments, 41);
+
+/**
+ * @generated from message schedula.v1.ReleaseHoldRequest
+ */
+export type ReleaseHoldRequest = Message<"schedula.v1.ReleaseHoldRequest"> & {
+  /**
+   * @generated from field: string user_id = 1;
+   */
+  userId: string;
+
+  /**
+   * @generated from field: string hold_id = 2;
+   */
+  holdId: string;
+};
+
+/**
+ * Describes the message schedula.v1.ReleaseHoldRequest.
+ * Use `create(ReleaseHoldRequestSchema)` to create a new message.
+ */
+export const ReleaseHoldRequestSchema: GenMessage<ReleaseHoldRequest> = /*@__PURE__*/
+  messageDesc(file_proto_schedula_v1_appointments, 42);
+
+/**
+ * @generated from message schedula.v1.ReleaseHoldResponse
+ */
+export type ReleaseHoldResponse = Message<"schedula.v1.ReleaseHoldResponse"> & {
+};
+
+/**
+ * Describes the message schedula.v1.ReleaseHoldResponse.
+ * Use `create(ReleaseHoldResponseSchema)` to create a new message.
+ */
+export const ReleaseHoldResponseSchema: GenMessage<ReleaseHoldResponse> = /*@__PURE__*/
+  messageDesc(file_proto_schedula_v1_appointments, 43);
+
+/**
+ * @generated from message schedula.v1.AppointmentProposal
+ */
+export type AppointmentProposal = Message<"schedula.v1.AppointmentProposal"> & {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id: string;
+
+  /**
+   * @generated from field: string proposer_id = 2;
+   */
+  proposerId: string;
+
+  /**
+   * @generated from field: string recipient_id = 3;
+   */
+  recipientId: string;
+
+  /**
+   * @generated from field: string title = 4;
+   */
+  title: string;
+
+  /**
+   * @generated from field: string notes = 5;
+   */
+  notes: string;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp start_time = 6;
+   */
+  startTime?: Timestamp;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp end_time = 7;
+   */
+  endTime?: Timestamp;
+
+  /**
+   * @generated from field: schedula.v1.ProposalStatus status = 8;
+   */
+  status: ProposalStatus;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp expires_at = 9;
+   */
+  expiresAt?: Timestamp;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp created_at = 10;
+   */
+  createdAt?: Timestamp;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp responded_at = 11;
+   */
+  respondedAt?: Timestamp;
+
+  /**
+   * @generated from field: string proposer_appointment_id = 12;
+   */
+  proposerAppointmentId: string;
+
+  /**
+   * @generated from field: string recipient_appointment_id = 13;
+   */
+  recipientAppointmentId: string;
+};
+
+/**
+ * Describes the message schedula.v1.AppointmentProposal.
+ * Use `create(AppointmentProposalSchema)` to create a new message.
+ */
+export const AppointmentProposalSchema: GenMessage<AppointmentProposal> = /*@__PURE__*/
+  messageDesc(file_proto_schedula_v1_appointments, 44);
+
+/**
+ * @generated from message schedula.v1.ProposeAppointmentRequest
+ */
+export type ProposeAppointmentRequest = Message<"schedula.v1.ProposeAppointmentRequest"> & {
+  /**
+   * @generated from field: string proposer_id = 1;
+   */
+  proposerId: string;
+
+  /**
+   * @generated from field: string recipient_id = 2;
+   */
+  recipientId: string;
+
+  /**
+   * @generated from field: string title = 3;
+   */
+  title: string;
+
+  /**
+   * @generated from field: string notes = 4;
+   */
+  notes: string;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp start_time = 5;
+   */
+  startTime?: Timestamp;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp end_time = 6;
+   */
+  endTime?: Timestamp;
+
+  /**
+   * @generated from field: google.protobuf.Duration ttl = 7;
+   */
+  ttl?: Duration;
+};
+
+/**
+ * Describes the message schedula.v1.ProposeAppointmentRequest.
+ * Use `create(ProposeAppointmentRequestSchema)` to create a new message.
+ */
+export const ProposeAppointmentRequestSchema: GenMessage<ProposeAppointmentRequest> = /*@__PURE__*/
+  messageDesc(file_proto_schedula_v1_appointments, 45);
+
+/**
+ * @generated from message schedula.v1.ProposeAppointmentResponse
+ */
+export type ProposeAppointmentResponse = Message<"schedula.v1.ProposeAppointmentResponse"> & {
+  /**
+   * @generated from field: schedula.v1.AppointmentProposal proposal = 1;
+   */
+  proposal?: AppointmentProposal;
+};
+
+/**
+ * Describes the message schedula.v1.ProposeAppointmentResponse.
+ * Use `create(ProposeAppointmentResponseSchema)` to create a new message.
+ */
+export const ProposeAppointmentResponseSchema: GenMessage<ProposeAppointmentResponse> = /*@__PURE__*/
+  messageDesc(file_proto_schedula_v1_appointments, 46);
+
+/**
+ * @generated from message schedula.v1.ListProposalsRequest
+ */
+export type ListProposalsRequest = Message<"schedula.v1.ListProposalsRequest"> & {
+  /**
+   * @generated from field: string user_id = 1;
+   */
+  userId: string;
+};
+
+/**
+ * Describes the message schedula.v1.ListProposalsRequest.
+ * Use `create(ListProposalsRequestSchema)` to create a new message.
+ */
+export const ListProposalsRequestSchema: GenMessage<ListProposalsRequest> = /*@__PURE__*/
+  messageDesc(file_proto_schedula_v1_appointments, 47);
+
+/**
+ * @generated from message schedula.v1.ListProposalsResponse
+ */
+export type ListProposalsResponse = Message<"schedula.v1.ListProposalsResponse"> & {
+  /**
+   * @generated from field: repeated schedula.v1.AppointmentProposal proposals = 1;
+   */
+  proposals: AppointmentProposal[];
+};
+
+/**
+ * Describes the message schedula.v1.ListProposalsResponse.
+ * Use `create(ListProposalsResponseSchema)` to create a new message.
+ */
+export const ListProposalsResponseSchema: GenMessage<ListProposalsResponse> = /*@__PURE__*/
+  messageDesc(file_proto_schedula_v1_appointments, 48);
+
+/**
+ * @generated from message schedula.v1.AcceptProposalRequest
+ */
+export type AcceptProposalRequest = Message<"schedula.v1.AcceptProposalRequest"> & {
+  /**
+   * @generated from field: string user_id = 1;
+   */
+  userId: string;
+
+  /**
+   * @generated from field: string proposal_id = 2;
+   */
+  proposalId: string;
+};
+
+/**
+ * Describes the message schedula.v1.AcceptProposalRequest.
+ * Use `create(AcceptProposalRequestSchema)` to create a new message.
+ */
+export const AcceptProposalRequestSchema: GenMessage<AcceptProposalRequest> = /*@__PURE__*/
+  messageDesc(file_proto_schedula_v1_appointments, 49);
+
+/**
+ * @generated from message schedula.v1.AcceptProposalResponse
+ */
+export type AcceptProposalResponse = Message<"schedula.v1.AcceptProposalResponse"> & {
+  /**
+   * @generated from field: schedula.v1.AppointmentProposal proposal = 1;
+   */
+  proposal?: AppointmentProposal;
+};
+
+/**
+ * Describes the message schedula.v1.AcceptProposalResponse.
+ * Use `create(AcceptProposalResponseSchema)` to create a new message.
+ */
+export const AcceptProposalResponseSchema: GenMessage<AcceptProposalResponse> = /*@__PURE__*/
+  messageDesc(file_proto_schedula_v1_appointments, 50);
+
+/**
+ * @generated from message schedula.v1.DeclineProposalRequest
+ */
+export type DeclineProposalRequest = Message<"schedula.v1.DeclineProposalRequest"> & {
+  /**
+   * @generated from field: string user_id = 1;
+   */
+  userId: string;
+
+  /**
+   * @generated from field: string proposal_id = 2;
+   */
+  proposalId: string;
+};
+
+/**
+ * Describes the message schedula.v1.DeclineProposalRequest.
+ * Use `create(DeclineProposalRequestSchema)` to create a new message.
+ */
+export const DeclineProposalRequestSchema: GenMessage<DeclineProposalRequest> = /*@__PURE__*/
+  messageDesc(file_proto_schedula_v1_appointments, 51);
+
+/**
+ * @generated from message schedula.v1.DeclineProposalResponse
+ */
+export type DeclineProposalResponse = Message<"schedula.v1.DeclineProposalResponse"> & {
+  /**
+   * @generated from field: schedula.v1.AppointmentProposal proposal = 1;
+   */
+  proposal?: AppointmentProposal;
+};
+
+/**
+ * Describes the message schedula.v1.DeclineProposalResponse.
+ * Use `create(DeclineProposalResponseSchema)` to create a new message.
+ */
+export const DeclineProposalResponseSchema: GenMessage<DeclineProposalResponse> = /*@__PURE__*/
+  messageDesc(file_proto_schedula_v1_appointments, 52);
+
+/**
+ * @generated from message schedula.v1.AppointmentLink
+ */
+export type AppointmentLink = Message<"schedula.v1.AppointmentLink"> & {
+  /**
+   * @generated from field: string appointment_id = 1;
+   */
+  appointmentId: string;
+
+  /**
+   * @generated from field: string related_appointment_id = 2;
+   */
+  relatedAppointmentId: string;
+
+  /**
+   * @generated from field: schedula.v1.AppointmentLinkKind kind = 3;
+   */
+  kind: AppointmentLinkKind;
+};
+
+/**
+ * Describes the message schedula.v1.AppointmentLink.
+ * Use `create(AppointmentLinkSchema)` to create a new message.
+ */
+export const AppointmentLinkSchema: GenMessage<AppointmentLink> = /*@__PURE__*/
+  messageDesc(file_proto_schedula_v1_appointments, 53);
+
+/**
+ * @generated from message schedula.v1.LinkAppointmentsRequest
+ */
+export type LinkAppointmentsRequest = Message<"schedula.v1.LinkAppointmentsRequest"> & {
+  /**
+   * @generated from field: string user_id = 1;
+   */
+  userId: string;
+
+  /**
+   * @generated from field: string appointment_id = 2;
+   */
+  appointmentId: string;
+
+  /**
+   * @generated from field: string related_appointment_id = 3;
+   */
+  relatedAppointmentId: string;
+
+  /**
+   * @generated from field: schedula.v1.AppointmentLinkKind kind = 4;
+   */
+  kind: AppointmentLinkKind;
+};
+
+/**
+ * Describes the message schedula.v1.LinkAppointmentsRequest.
+ * Use `create(LinkAppointmentsRequestSchema)` to create a new message.
+ */
+export const LinkAppointmentsRequestSchema: GenMessage<LinkAppointmentsRequest> = /*@__PURE__*/
+  messageDesc(file_proto_schedula_v1_appointments, 54);
+
+/**
+ * @generated from message schedula.v1.LinkAppointmentsResponse
+ */
+export type LinkAppointmentsResponse = Message<"schedula.v1.LinkAppointmentsResponse"> & {
+  /**
+   * @generated from field: schedula.v1.AppointmentLink link = 1;
+   */
+  link?: AppointmentLink;
+};
+
+/**
+ * Describes the message schedula.v1.LinkAppointmentsResponse.
+ * Use `create(LinkAppointmentsResponseSchema)` to create a new message.
+ */
+export const LinkAppointmentsResponseSchema: GenMessage<LinkAppointmentsResponse> = /*@__PURE__*/
+  messageDesc(file_proto_schedula_v1_appointments, 55);
+
+/**
+ * @generated from message schedula.v1.UnlinkAppointmentsRequest
+ */
+export type UnlinkAppointmentsRequest = Message<"schedula.v1.UnlinkAppointmentsRequest"> & {
+  /**
+   * @generated from field: string user_id = 1;
+   */
+  userId: string;
+
+  /**
+   * @generated from field: string appointment_id = 2;
+   */
+  appointmentId: string;
+
+  /**
+   * @generated from field: string related_appointment_id = 3;
+   */
+  relatedAppointmentId: string;
+
+  /**
+   * @generated from field: schedula.v1.AppointmentLinkKind kind = 4;
+   */
+  kind: AppointmentLinkKind;
+};
+
+/**
+ * Describes the message schedula.v1.UnlinkAppointmentsRequest.
+ * Use `create(UnlinkAppointmentsRequestSchema)` to create a new message.
+ */
+export const UnlinkAppointmentsRequestSchema: GenMessage<UnlinkAppointmentsRequest> = /*@__PURE__*/
+  messageDesc(file_proto_schedula_v1_appointments, 56);
+
+/**
+ * @generated from message schedula.v1.UnlinkAppointmentsResponse
+ */
+export type UnlinkAppointmentsResponse = Message<"schedula.v1.UnlinkAppointmentsResponse"> & {
+};
+
+/**
+ * Describes the message schedula.v1.UnlinkAppointmentsResponse.
+ * Use `create(UnlinkAppointmentsResponseSchema)` to create a new message.
+ */
+export const UnlinkAppointmentsResponseSchema: GenMessage<UnlinkAppointmentsResponse> = /*@__PURE__*/
+  messageDesc(file_proto_schedula_v1_appointments, 57);
+
+/**
+ * @generated from message schedula.v1.RelatedAppointment
+ */
+export type RelatedAppointment = Message<"schedula.v1.RelatedAppointment"> & {
+  /**
+   * @generated from field: schedula.v1.AppointmentLink link = 1;
+   */
+  link?: AppointmentLink;
+
+  /**
+   * @generated from field: schedula.v1.Appointment appointment = 2;
+   */
+  appointment?: Appointment;
+};
+
+/**
+ * Describes the message schedula.v1.RelatedAppointment.
+ * Use `create(RelatedAppointmentSchema)` to create a new message.
+ */
+export const RelatedAppointmentSchema: GenMessage<RelatedAppointment> = /*@__PURE__*/
+  messageDesc(file_proto_schedula_v1_appointments, 58);
+
+/**
+ * @generated from message schedula.v1.ListRelatedRequest
+ */
+export type ListRelatedRequest = Message<"schedula.v1.ListRelatedRequest"> & {
+  /**
+   * @generated from field: string user_id = 1;
+   */
+  userId: string;
+
+  /**
+   * @generated from field: string appointment_id = 2;
+   */
+  appointmentId: string;
+};
+
+/**
+ * Describes the message schedula.v1.ListRelatedRequest.
+ * Use `create(ListRelatedRequestSchema)` to create a new message.
+ */
+export const ListRelatedRequestSchema: GenMessage<ListRelatedRequest> = /*@__PURE__*/
+  messageDesc(file_proto_schedula_v1_appointments, 59);
+
+/**
+ * @generated from message schedula.v1.ListRelatedResponse
+ */
+export type ListRelatedResponse = Message<"schedula.v1.ListRelatedResponse"> & {
+  /**
+   * @generated from field: repeated schedula.v1.RelatedAppointment related = 1;
+   */
+  related: RelatedAppointment[];
+};
+
+/**
+ * Describes the message schedula.v1.ListRelatedResponse.
+ * Use `create(ListRelatedResponseSchema)` to create a new message.
+ */
+export const ListRelatedResponseSchema: GenMessage<ListRelatedResponse> = /*@__PURE__*/
+  messageDesc(file_proto_schedula_v1_appointments, 60);
+
+/**
+ * @generated from message schedula.v1.MergeAppointmentsRequest
+ */
+export type MergeAppointmentsRequest = Message<"schedula.v1.MergeAppointmentsRequest"> & {
+  /**
+   * @generated from field: string user_id = 1;
+   */
+  userId: string;
+
+  /**
+   * @generated from field: string primary_id = 2;
+   */
+  primaryId: string;
+
+  /**
+   * @generated from field: repeated string duplicate_ids = 3;
+   */
+  duplicateIds: string[];
+
+  /**
+   * @generated from field: bool cover_duplicates = 4;
+   */
+  coverDuplicates: boolean;
+};
+
+/**
+ * Describes the message schedula.v1.MergeAppointmentsRequest.
+ * Use `create(MergeAppointmentsRequestSchema)` to create a new message.
+ */
+export const MergeAppointmentsRequestSchema: GenMessage<MergeAppointmentsRequest> = /*@__PURE__*/
+  messageDesc(file_proto_schedula_v1_appointments, 61);
+
+/**
+ * @generated from message schedula.v1.MergeAppointmentsResponse
+ */
+export type MergeAppointmentsResponse = Message<"schedula.v1.MergeAppointmentsResponse"> & {
+  /**
+   * @generated from field: schedula.v1.Appointment appointment = 1;
+   */
+  appointment?: Appointment;
+
+  /**
+   * @generated from field: repeated string deleted_ids = 2;
+   */
+  deletedIds: string[];
+
+  /**
+   * @generated from field: uint32 links_moved = 3;
+   */
+  linksMoved: number;
+
+  /**
+   * @generated from field: uint32 sync_mappings_moved = 4;
+   */
+  syncMappingsMoved: number;
+};
+
+/**
+ * Describes the message schedula.v1.MergeAppointmentsResponse.
+ * Use `create(MergeAppointmentsResponseSchema)` to create a new message.
+ */
+export const MergeAppointmentsResponseSchema: GenMessage<MergeAppointmentsResponse> = /*@__PURE__*/
+  messageDesc(file_proto_schedula_v1_appointments, 62);
+
+/**
+ * @generated from message schedula.v1.BusyInterval
+ */
+export type BusyInterval = Message<"schedula.v1.BusyInterval"> & {
+  /**
+   * @generated from field: google.protobuf.Timestamp start_time = 1;
+   */
+  startTime?: Timestamp;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp end_time = 2;
+   */
+  endTime?: Timestamp;
+};
+
+/**
+ * Describes the message schedula.v1.BusyInterval.
+ * Use `create(BusyIntervalSchema)` to create a new message.
+ */
+export const BusyIntervalSchema: GenMessage<BusyInterval> = /*@__PURE__*/
+  messageDesc(file_proto_schedula_v1_appointments, 63);
+
+/**
+ * @generated from message schedula.v1.UserFreeBusy
+ */
+export type UserFreeBusy = Message<"schedula.v1.UserFreeBusy"> & {
+  /**
+   * @generated from field: string user_id = 1;
+   */
+  userId: string;
+
+  /**
+   * @generated from field: repeated schedula.v1.BusyInterval busy = 2;
+   */
+  busy: BusyInterval[];
+
+  /**
+   * @generated from field: string error_code = 3;
+   */
+  errorCode: string;
+
+  /**
+   * @generated from field: string error_message = 4;
+   */
+  errorMessage: string;
+};
+
+/**
+ * Describes the message schedula.v1.UserFreeBusy.
+ * Use `create(UserFreeBusySchema)` to create a new message.
+ */
+export const UserFreeBusySchema: GenMessage<UserFreeBusy> = /*@__PURE__*/
+  messageDesc(file_proto_schedula_v1_appointments, 64);
+
+/**
+ * @generated from message schedula.v1.BatchGetFreeBusyRequest
+ */
+export type BatchGetFreeBusyRequest = Message<"schedula.v1.BatchGetFreeBusyRequest"> & {
+  /**
+   * @generated from field: repeated string user_ids = 1;
+   */
+  userIds: string[];
+
+  /**
+   * @generated from field: google.protobuf.Timestamp window_start = 2;
+   */
+  windowStart?: Timestamp;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp window_end = 3;
+   */
+  windowEnd?: Timestamp;
+};
+
+/**
+ * Describes the message schedula.v1.BatchGetFreeBusyRequest.
+ * Use `create(BatchGetFreeBusyRequestSchema)` to create a new message.
+ */
+export const BatchGetFreeBusyRequestSchema: GenMessage<BatchGetFreeBusyRequest> = /*@__PURE__*/
+  messageDesc(file_proto_schedula_v1_appointments, 65);
+
+/**
+ * @generated from message schedula.v1.BatchGetFreeBusyResponse
+ */
+export type BatchGetFreeBusyResponse = Message<"schedula.v1.BatchGetFreeBusyResponse"> & {
+  /**
+   * @generated from field: repeated schedula.v1.UserFreeBusy results = 1;
+   */
+  results: UserFreeBusy[];
+};
+
+/**
+ * Describes the message schedula.v1.BatchGetFreeBusyResponse.
+ * Use `create(BatchGetFreeBusyResponseSchema)` to create a new message.
+ */
+export const BatchGetFreeBusyResponseSchema: GenMessage<BatchGetFreeBusyResponse> = /*@__PURE__*/
+  messageDesc(file_proto_schedula_v1_appointments, 66);
+
+/**
+ * @generated from message schedula.v1.TimeRange
+ */
+export type TimeRange = Message<"schedula.v1.TimeRange"> & {
+  /**
+   * @generated from field: google.protobuf.Timestamp start_time = 1;
+   */
+  startTime?: Timestamp;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp end_time = 2;
+   */
+  endTime?: Timestamp;
+};
+
+/**
+ * Describes the message schedula.v1.TimeRange.
+ * Use `create(TimeRangeSchema)` to create a new message.
+ */
+export const TimeRangeSchema: GenMessage<TimeRange> = /*@__PURE__*/
+  messageDesc(file_proto_schedula_v1_appointments, 67);
+
+/**
+ * @generated from message schedula.v1.WorkingHours
+ */
+export type WorkingHours = Message<"schedula.v1.WorkingHours"> & {
+  /**
+   * @generated from field: string time_zone = 1;
+   */
+  timeZone: string;
+
+  /**
+   * @generated from field: uint32 start_minute = 2;
+   */
+  startMinute: number;
+
+  /**
+   * @generated from field: uint32 end_minute = 3;
+   */
+  endMinute: number;
+
+  /**
+   * @generated from field: repeated schedula.v1.Weekday weekdays = 4;
+   */
+  weekdays: Weekday[];
+};
+
+/**
+ * Describes the message schedula.v1.WorkingHours.
+ * Use `create(WorkingHoursSchema)` to create a new message.
+ */
+export const WorkingHoursSchema: GenMessage<WorkingHours> = /*@__PURE__*/
+  messageDesc(file_proto_schedula_v1_appointments, 68);
+
+/**
+ * @generated from message schedula.v1.MeetingAttendee
+ */
+export type MeetingAttendee = Message<"schedula.v1.MeetingAttendee"> & {
+  /**
+   * @generated from field: string user_id = 1;
+   */
+  userId: string;
+
+  /**
+   * @generated from field: schedula.v1.WorkingHours working_hours = 2;
+   */
+  workingHours?: WorkingHours;
+
+  /**
+   * @generated from field: repeated schedula.v1.TimeRange preferred = 3;
+   */
+  preferred: TimeRange[];
+};
+
+/**
+ * Describes the message schedula.v1.MeetingAttendee.
+ * Use `create(MeetingAttendeeSchema)` to create a new message.
+ */
+export const MeetingAttendeeSchema: GenMessage<MeetingAttendee> = /*@__PURE__*/
+  messageDesc(file_proto_schedula_v1_appointments, 69);
+
+/**
+ * @generated from message schedula.v1.SuggestMeetingTimesRequest
+ */
+export type SuggestMeetingTimesRequest = Message<"schedula.v1.SuggestMeetingTimesRequest"> & {
+  /**
+   * @generated from field: repeated schedula.v1.MeetingAttendee attendees = 1;
+   */
+  attendees: MeetingAttendee[];
+
+  /**
+   * @generated from field: google.protobuf.Duration duration = 2;
+   */
+  duration?: Duration;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp window_start = 3;
+   */
+  windowStart?: Timestamp;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp window_end = 4;
+   */
+  windowEnd?: Timestamp;
+
+  /**
+   * @generated from field: google.protobuf.Duration step = 5;
+   */
+  step?: Duration;
+
+  /**
+   * @generated from field: uint32 max_results = 6;
+   */
+  maxResults: number;
+};
+
+/**
+ * Describes the message schedula.v1.SuggestMeetingTimesRequest.
+ * Use `create(SuggestMeetingTimesRequestSchema)` to create a new message.
+ */
+export const SuggestMeetingTimesRequestSchema: GenMessage<SuggestMeetingTimesRequest> = /*@__PURE__*/
+  messageDesc(file_proto_schedula_v1_appointments, 70);
+
+/**
+ * @generated from message schedula.v1.MeetingSuggestion
+ */
+export type MeetingSuggestion = Message<"schedula.v1.MeetingSuggestion"> & {
+  /**
+   * @generated from field: google.protobuf.Timestamp start_time = 1;
+   */
+  startTime?: Timestamp;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp end_time = 2;
+   */
+  endTime?: Timestamp;
+
+  /**
+   * @generated from field: double score = 3;
+   */
+  score: number;
+
+  /**
+   * @generated from field: repeated string outside_working_hours = 4;
+   */
+  outsideWorkingHours: string[];
+};
+
+/**
+ * Describes the message schedula.v1.MeetingSuggestion.
+ * Use `create(MeetingSuggestionSchema)` to create a new message.
+ */
+export const MeetingSuggestionSchema: GenMessage<MeetingSuggestion> = /*@__PURE__*/
+  messageDesc(file_proto_schedula_v1_appointments, 71);
+
+/**
+ * @generated from message schedula.v1.SuggestMeetingTimesResponse
+ */
+export type SuggestMeetingTimesResponse = Message<"schedula.v1.SuggestMeetingTimesResponse"> & {
+  /**
+   * @generated from field: repeated schedula.v1.MeetingSuggestion suggestions = 1;
+   */
+  suggestions: MeetingSuggestion[];
+};
+
+/**
+ * Describes the message schedula.v1.SuggestMeetingTimesResponse.
+ * Use `create(SuggestMeetingTimesResponseSchema)` to create a new message.
+ */
+export const SuggestMeetingTimesResponseSchema: GenMessage<SuggestMeetingTimesResponse> = /*@__PURE__*/
+  messageDesc(file_proto_schedula_v1_appointments, 72);
+
+/**
+ * @generated from message schedula.v1.SimulatedStaff
+ */
+export type SimulatedStaff = Message<"schedula.v1.SimulatedStaff"> & {
+  /**
+   * @generated from field: string label = 1;
+   */
+  label: string;
+
+  /**
+   * @generated from field: schedula.v1.WorkingHours working_hours = 2;
+   */
+  workingHours?: WorkingHours;
+
+  /**
+   * @generated from field: repeated schedula.v1.DailyBreak breaks = 3;
+   */
+  breaks: DailyBreak[];
+};
+
+/**
+ * Describes the message schedula.v1.SimulatedStaff.
+ * Use `create(SimulatedStaffSchema)` to create a new message.
+ */
+export const SimulatedStaffSchema: GenMessage<SimulatedStaff> = /*@__PURE__*/
+  messageDesc(file_proto_schedula_v1_appointments, 73);
+
+/**
+ * @generated from message schedula.v1.BookingPattern
+ */
+export type BookingPattern = Message<"schedula.v1.BookingPattern"> & {
+  /**
+   * @generated from field: string label = 1;
+   */
+  label: string;
+
+  /**
+   * @generated from field: google.protobuf.Duration duration = 2;
+   */
+  duration?: Duration;
+
+  /**
+   * @generated from field: uint32 bookings_per_day = 3;
+   */
+  bookingsPerDay: number;
+
+  /**
+   * @generated from field: repeated schedula.v1.Weekday weekdays = 4;
+   */
+  weekdays: Weekday[];
+};
+
+/**
+ * Describes the message schedula.v1.BookingPattern.
+ * Use `create(BookingPatternSchema)` to create a new message.
+ */
+export const BookingPatternSchema: GenMessage<BookingPattern> = /*@__PURE__*/
+  messageDesc(file_proto_schedula_v1_appointments, 74);
+
+/**
+ * @generated from message schedula.v1.SimulateScheduleRequest
+ */
+export type SimulateScheduleRequest = Message<"schedula.v1.SimulateScheduleRequest"> & {
+  /**
+   * @generated from field: repeated schedula.v1.SimulatedStaff staff = 1;
+   */
+  staff: SimulatedStaff[];
+
+  /**
+   * @generated from field: repeated schedula.v1.BookingPattern patterns = 2;
+   */
+  patterns: BookingPattern[];
+
+  /**
+   * @generated from field: google.protobuf.Timestamp window_start = 3;
+   */
+  windowStart?: Timestamp;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp window_end = 4;
+   */
+  windowEnd?: Timestamp;
+
+  /**
+   * @generated from field: string time_zone = 5;
+   */
+  timeZone: string;
+
+  /**
+   * @generated from field: google.protobuf.Duration step = 6;
+   */
+  step?: Duration;
+};
+
+/**
+ * Describes the message schedula.v1.SimulateScheduleRequest.
+ * Use `create(SimulateScheduleRequestSchema)` to create a new message.
+ */
+export const SimulateScheduleRequestSchema: GenMessage<SimulateScheduleRequest> = /*@__PURE__*/
+  messageDesc(file_proto_schedula_v1_appointments, 75);
+
+/**
+ * @generated from message schedula.v1.ScheduleUtilization
+ */
+export type ScheduleUtilization = Message<"schedula.v1.ScheduleUtilization"> & {
+  /**
+   * @generated from field: google.protobuf.Duration capacity = 1;
+   */
+  capacity?: Duration;
+
+  /**
+   * @generated from field: google.protobuf.Duration booked = 2;
+   */
+  booked?: Duration;
+
+  /**
+   * @generated from field: double utilization = 3;
+   */
+  utilization: number;
+};
+
+/**
+ * Describes the message schedula.v1.ScheduleUtilization.
+ * Use `create(ScheduleUtilizationSchema)` to create a new message.
+ */
+export const ScheduleUtilizationSchema: GenMessage<ScheduleUtilization> = /*@__PURE__*/
+  messageDesc(file_proto_schedula_v1_appointments, 76);
+
+/**
+ * @generated from message schedula.v1.SimulatedDay
+ */
+export type SimulatedDay = Message<"schedula.v1.SimulatedDay"> & {
+  /**
+   * @generated from field: string date = 1;
+   */
+  date: string;
+
+  /**
+   * @generated from field: schedula.v1.ScheduleUtilization utilization = 2;
+   */
+  utilization?: ScheduleUtilization;
+
+  /**
+   * @generated from field: uint32 requested = 3;
+   */
+  requested: number;
+
+  /**
+   * @generated from field: uint32 unbooked = 4;
+   */
+  unbooked: number;
+};
+
+/**
+ * Describes the message schedula.v1.SimulatedDay.
+ * Use `create(SimulatedDaySchema)` to create a new message.
+ */
+export const SimulatedDaySchema: GenMessage<SimulatedDay> = /*@__PURE__*/
+  messageDesc(file_proto_schedula_v1_appointments, 77);
+
+/**
+ * @generated from message schedula.v1.SimulatedStaffUtilization
+ */
+export type SimulatedStaffUtilization = Message<"schedula.v1.SimulatedStaffUtilization"> & {
+  /**
+   * @generated from field: string label = 1;
+   */
+  label: string;
+
+  /**
+   * @generated from field: schedula.v1.ScheduleUtilization utilization = 2;
+   */
+  utilization?: ScheduleUtilization;
+
+  /**
+   * @generated from field: uint32 bookings = 3;
+   */
+  bookings: number;
+};
+
+/**
+ * Describes the message schedula.v1.SimulatedStaffUtilization.
+ * Use `create(SimulatedStaffUtilizationSchema)` to create a new message.
+ */
+export const SimulatedStaffUtilizationSchema: GenMessage<SimulatedStaffUtilization> = /*@__PURE__*/
+  messageDesc(file_proto_schedula_v1_appointments, 78);
+
+/**
+ * @generated from message schedula.v1.BookingPatternOutcome
+ */
+export type BookingPatternOutcome = Message<"schedula.v1.BookingPatternOutcome"> & {
+  /**
+   * @generated from field: string label = 1;
+   */
+  label: string;
+
+  /**
+   * @generated from field: uint32 requested = 2;
+   */
+  requested: number;
+
+  /**
+   * @generated from field: uint32 unbooked = 3;
+   */
+  unbooked: number;
+};
+
+/**
+ * Describes the message schedula.v1.BookingPatternOutcome.
+ * Use `create(BookingPatternOutcomeSchema)` to create a new message.
+ */
+export const BookingPatternOutcomeSchema: GenMessage<BookingPatternOutcome> = /*@__PURE__*/
+  messageDesc(file_proto_schedula_v1_appointments, 79);
+
+/**
+ * @generated from message schedula.v1.SimulateScheduleResponse
+ */
+export type SimulateScheduleResponse = Message<"schedula.v1.SimulateScheduleResponse"> & {
+  /**
+   * @generated from field: schedula.v1.ScheduleUtilization utilization = 1;
+   */
+  utilization?: ScheduleUtilization;
+
+  /**
+   * @generated from field: uint32 requested = 2;
+   */
+  requested: number;
+
+  /**
+   * @generated from field: uint32 unbooked = 3;
+   */
+  unbooked: number;
+
+  /**
+   * @generated from field: repeated schedula.v1.SimulatedDay days = 4;
+   */
+  days: SimulatedDay[];
+
+  /**
+   * @generated from field: repeated schedula.v1.SimulatedStaffUtilization staff = 5;
+   */
+  staff: SimulatedStaffUtilization[];
+
+  /**
+   * @generated from field: repeated schedula.v1.BookingPatternOutcome patterns = 6;
+   */
+  patterns: BookingPatternOutcome[];
+};
+
+/**
+ * Describes the message schedula.v1.SimulateScheduleResponse.
+ * Use `create(SimulateScheduleResponseSchema)` to create a new message.
+ */
+export const SimulateScheduleResponseSchema: GenMessage<SimulateScheduleResponse> = /*@__PURE__*/
+  messageDesc(file_proto_schedula_v1_appointments, 80);
+
+/**
+ * @generated from message schedula.v1.SeriesFinding
+ */
+export type SeriesFinding = Message<"schedula.v1.SeriesFinding"> & {
+  /**
+   * @generated from field: schedula.v1.SeriesFindingKind kind = 1;
+   */
+  kind: SeriesFindingKind;
+
+  /**
+   * @generated from field: string exception_id = 2;
+   */
+  exceptionId: string;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp occurrence_start = 3;
+   */
+  occurrenceStart?: Timestamp;
+
+  /**
+   * @generated from field: bool repaired = 4;
+   */
+  repaired: boolean;
+};
+
+/**
+ * Describes the message schedula.v1.SeriesFinding.
+ * Use `create(SeriesFindingSchema)` to create a new message.
+ */
+export const SeriesFindingSchema: GenMessage<SeriesFinding> = /*@__PURE__*/
+  messageDesc(file_proto_schedula_v1_appointments, 81);
+
+/**
+ * @generated from message schedula.v1.RepairRecurringSeriesRequest
+ */
+export type RepairRecurringSeriesRequest = Message<"schedula.v1.RepairRecurringSeriesRequest"> & {
+  /**
+   * @generated from field: string user_id = 1;
+   */
+  userId: string;
+
+  /**
+   * @generated from field: string series_id = 2;
+   */
+  seriesId: string;
+
+  /**
+   * @generated from field: bool apply = 3;
+   */
+  apply: boolean;
+};
+
+/**
+ * Describes the message schedula.v1.RepairRecurringSeriesRequest.
+ * Use `create(RepairRecurringSeriesRequestSchema)` to create a new message.
+ */
+export const RepairRecurringSeriesRequestSchema: GenMessage<RepairRecurringSeriesRequest> = /*@__PURE__*/
+  messageDesc(file_proto_schedula_v1_appointments, 82);
+
+/**
+ * @generated from message schedula.v1.RepairRecurringSeriesResponse
+ */
+export type RepairRecurringSeriesResponse = Message<"schedula.v1.RepairRecurringSeriesResponse"> & {
+  /**
+   * @generated from field: repeated schedula.v1.SeriesFinding findings = 1;
+   */
+  findings: SeriesFinding[];
+
+  /**
+   * @generated from field: uint32 repaired = 2;
+   */
+  repaired: number;
+};
+
+/**
+ * Describes the message schedula.v1.RepairRecurringSeriesResponse.
+ * Use `create(RepairRecurringSeriesResponseSchema)` to create a new message.
+ */
+export const RepairRecurringSeriesResponseSchema: GenMessage<RepairRecurringSeriesResponse> = /*@__PURE__*/
+  messageDesc(file_proto_schedula_v1_appointments, 83);
+
+/**
+ * @generated from message schedula.v1.CalendarEntry
+ */
+export type CalendarEntry = Message<"schedula.v1.CalendarEntry"> & {
+  /**
+   * @generated from field: string appointment_id = 1;
+   */
+  appointmentId: string;
+
+  /**
+   * @generated from field: string series_id = 2;
+   */
+  seriesId: string;
+
+  /**
+   * @generated from field: string occurrence_id = 3;
+   */
+  occurrenceId: string;
+
+  /**
+   * @generated from field: string title = 4;
+   */
+  title: string;
+
+  /**
+   * @generated from field: string source = 5;
+   */
+  source: string;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp start_time = 6;
+   */
+  startTime?: Timestamp;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp end_time = 7;
+   */
+  endTime?: Timestamp;
+
+  /**
+   * @generated from field: bool overridden = 8;
+   */
+  overridden: boolean;
+};
+
+/**
+ * Describes the message schedula.v1.CalendarEntry.
+ * Use `create(CalendarEntrySchema)` to create a new message.
+ */
+export const CalendarEntrySchema: GenMessage<CalendarEntry> = /*@__PURE__*/
+  messageDesc(file_proto_schedula_v1_appointments, 84);
+
+/**
+ * @generated from message schedula.v1.CalendarConflict
+ */
+export type CalendarConflict = Message<"schedula.v1.CalendarConflict"> & {
+  /**
+   * @generated from field: schedula.v1.CalendarEntry first = 1;
+   */
+  first?: CalendarEntry;
+
+  /**
+   * @generated from field: schedula.v1.CalendarEntry second = 2;
+   */
+  second?: CalendarEntry;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp overlap_start = 3;
+   */
+  overlapStart?: Timestamp;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp overlap_end = 4;
+   */
+  overlapEnd?: Timestamp;
+
+  /**
+   * @generated from field: schedula.v1.ConflictCause cause = 5;
+   */
+  cause: ConflictCause;
+};
+
+/**
+ * Describes the message schedula.v1.CalendarConflict.
+ * Use `create(CalendarConflictSchema)` to create a new message.
+ */
+export const CalendarConflictSchema: GenMessage<CalendarConflict> = /*@__PURE__*/
+  messageDesc(file_proto_schedula_v1_appointments, 85);
+
+/**
+ * @generated from message schedula.v1.AuditCalendarRequest
+ */
+export type AuditCalendarRequest = Message<"schedula.v1.AuditCalendarRequest"> & {
+  /**
+   * @generated from field: string user_id = 1;
+   */
+  userId: string;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp window_start = 2;
+   */
+  windowStart?: Timestamp;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp window_end = 3;
+   */
+  windowEnd?: Timestamp;
+};
+
+/**
+ * Describes the message schedula.v1.AuditCalendarRequest.
+ * Use `create(AuditCalendarRequestSchema)` to create a new message.
+ */
+export const AuditCalendarRequestSchema: GenMessage<AuditCalendarRequest> = /*@__PURE__*/
+  messageDesc(file_proto_schedula_v1_appointments, 86);
+
+/**
+ * @generated from message schedula.v1.AuditCalendarResponse
+ */
+export type AuditCalendarResponse = Message<"schedula.v1.AuditCalendarResponse"> & {
+  /**
+   * @generated from field: repeated schedula.v1.CalendarConflict conflicts = 1;
+   */
+  conflicts: CalendarConflict[];
+
+  /**
+   * @generated from field: uint32 entries_scanned = 2;
+   */
+  entriesScanned: number;
+};
+
+/**
+ * Describes the message schedula.v1.AuditCalendarResponse.
+ * Use `create(AuditCalendarResponseSchema)` to create a new message.
+ */
+export const AuditCalendarResponseSchema: GenMessage<AuditCalendarResponse> = /*@__PURE__*/
+  messageDesc(file_proto_schedula_v1_appointments, 87);
+
+/**
+ * @generated from message schedula.v1.GetDailyAgendaRequest
+ */
+export type GetDailyAgendaRequest = Message<"schedula.v1.GetDailyAgendaRequest"> & {
+  /**
+   * @generated from field: string user_id = 1;
+   */
+  userId: string;
+
+  /**
+   * @generated from field: string date = 2;
+   */
+  date: string;
+
+  /**
+   * @generated from field: string time_zone = 3;
+   */
+  timeZone: string;
+};
+
+/**
+ * Describes the message schedula.v1.GetDailyAgendaRequest.
+ * Use `create(GetDailyAgendaRequestSchema)` to create a new message.
+ */
+export const GetDailyAgendaRequestSchema: GenMessage<GetDailyAgendaRequest> = /*@__PURE__*/
+  messageDesc(file_proto_schedula_v1_appointments, 88);
+
+/**
+ * @generated from message schedula.v1.AgendaItem
+ */
+export type AgendaItem = Message<"schedula.v1.AgendaItem"> & {
+  /**
+   * @generated from field: schedula.v1.CalendarEntry entry = 1;
+   */
+  entry?: CalendarEntry;
+
+  /**
+   * @generated from field: bool milestone = 2;
+   */
+  milestone: boolean;
+
+  /**
+   * @generated from field: google.protobuf.Duration gap_before = 3;
+   */
+  gapBefore?: Duration;
+
+  /**
+   * @generated from field: bool back_to_back = 4;
+   */
+  backToBack: boolean;
+
+  /**
+   * @generated from field: bool overlaps = 5;
+   */
+  overlaps: boolean;
+};
+
+/**
+ * Describes the message schedula.v1.AgendaItem.
+ * Use `create(AgendaItemSchema)` to create a new message.
+ */
+export const AgendaItemSchema: GenMessage<AgendaItem> = /*@__PURE__*/
+  messageDesc(file_proto_schedula_v1_appointments, 89);
+
+/**
+ * @generated from message schedula.v1.GetDailyAgendaResponse
+ */
+export type GetDailyAgendaResponse = Message<"schedula.v1.GetDailyAgendaResponse"> & {
+  /**
+   * @generated from field: string date = 1;
+   */
+  date: string;
+
+  /**
+   * @generated from field: string time_zone = 2;
+   */
+  timeZone: string;
+
+  /**
+   * @generated from field: repeated schedula.v1.AgendaItem items = 3;
+   */
+  items: AgendaItem[];
+
+  /**
+   * @generated from field: google.protobuf.Duration busy_time = 4;
+   */
+  busyTime?: Duration;
+};
+
+/**
+ * Describes the message schedula.v1.GetDailyAgendaResponse.
+ * Use `create(GetDailyAgendaResponseSchema)` to create a new message.
+ */
+export const GetDailyAgendaResponseSchema: GenMessage<GetDailyAgendaResponse> = /*@__PURE__*/
+  messageDesc(file_proto_schedula_v1_appointments, 90);
+
+/**
+ * @generated from message schedula.v1.GetDaySummariesRequest
+ */
+export type GetDaySummariesRequest = Message<"schedula.v1.GetDaySummariesRequest"> & {
+  /**
+   * @generated from field: string user_id = 1;
+   */
+  userId: string;
+
+  /**
+   * @generated from field: string from_date = 2;
+   */
+  fromDate: string;
+
+  /**
+   * @generated from field: string to_date = 3;
+   */
+  toDate: string;
+};
+
+/**
+ * Describes the message schedula.v1.GetDaySummariesRequest.
+ * Use `create(GetDaySummariesRequestSchema)` to create a new message.
+ */
+export const GetDaySummariesRequestSchema: GenMessage<GetDaySummariesRequest> = /*@__PURE__*/
+  messageDesc(file_proto_schedula_v1_appointments, 91);
+
+/**
+ * @generated from message schedula.v1.DaySummary
+ */
+export type DaySummary = Message<"schedula.v1.DaySummary"> & {
+  /**
+   * @generated from field: string date = 1;
+   */
+  date: string;
+
+  /**
+   * @generated from field: uint32 appointments = 2;
+   */
+  appointments: number;
+
+  /**
+   * @generated from field: uint32 occurrences = 3;
+   */
+  occurrences: number;
+
+  /**
+   * @generated from field: google.protobuf.Duration busy_time = 4;
+   */
+  busyTime?: Duration;
+};
+
+/**
+ * Describes the message schedula.v1.DaySummary.
+ * Use `create(DaySummarySchema)` to create a new message.
+ */
+export const DaySummarySchema: GenMessage<DaySummary> = /*@__PURE__*/
+  messageDesc(file_proto_schedula_v1_appointments, 92);
+
+/**
+ * @generated from message schedula.v1.GetDaySummariesResponse
+ */
+export type GetDaySummariesResponse = Message<"schedula.v1.GetDaySummariesResponse"> & {
+  /**
+   * @generated from field: string time_zone = 1;
+   */
+  timeZone: string;
+
+  /**
+   * @generated from field: repeated schedula.v1.DaySummary days = 2;
+   */
+  days: DaySummary[];
+};
+
+/**
+ * Describes the message schedula.v1.GetDaySummariesResponse.
+ * Use `create(GetDaySummariesResponseSchema)` to create a new message.
+ */
+export const GetDaySummariesResponseSchema: GenMessage<GetDaySummariesResponse> = /*@__PURE__*/
+  messageDesc(file_proto_schedula_v1_appointments, 93);
+
+/**
+ * @generated from message schedula.v1.UpdateSeriesEndRequest
+ */
+export type UpdateSeriesEndRequest = Message<"schedula.v1.UpdateSeriesEndRequest"> & {
+  /**
+   * @generated from field: string user_id = 1;
+   */
+  userId: string;
+
+  /**
+   * @generated from field: string series_id = 2;
+   */
+  seriesId: string;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp until = 3;
+   */
+  until?: Timestamp;
+
+  /**
+   * @generated from field: uint32 count = 4;
+   */
+  count: number;
+};
+
+/**
+ * Describes the message schedula.v1.UpdateSeriesEndRequest.
+ * Use `create(UpdateSeriesEndRequestSchema)` to create a new message.
+ */
+export const UpdateSeriesEndRequestSchema: GenMessage<UpdateSeriesEndRequest> = /*@__PURE__*/
+  messageDesc(file_proto_schedula_v1_appointments, 94);
+
+/**
+ * @generated from message schedula.v1.UpdateSeriesEndResponse
+ */
+export type UpdateSeriesEndResponse = Message<"schedula.v1.UpdateSeriesEndResponse"> & {
+  /**
+   * @generated from field: schedula.v1.RecurringSeries series = 1;
+   */
+  series?: RecurringSeries;
+
+  /**
+   * @generated from field: uint32 removed_exceptions = 2;
+   */
+  removedExceptions: number;
+};
+
+/**
+ * Describes the message schedula.v1.UpdateSeriesEndResponse.
+ * Use `create(UpdateSeriesEndResponseSchema)` to create a new message.
+ */
+export const UpdateSeriesEndResponseSchema: GenMessage<UpdateSeriesEndResponse> = /*@__PURE__*/
+  messageDesc(file_proto_schedula_v1_appointments, 95);
+
+/**
+ * @generated from message schedula.v1.ChangeSeriesTimeZoneRequest
+ */
+export type ChangeSeriesTimeZoneRequest = Message<"schedula.v1.ChangeSeriesTimeZoneRequest"> & {
+  /**
+   * @generated from field: string user_id = 1;
+   */
+  userId: string;
+
+  /**
+   * @generated from field: repeated string series_ids = 2;
+   */
+  seriesIds: string[];
+
+  /**
+   * @generated from field: string time_zone = 3;
+   */
+  timeZone: string;
+
+  /**
+   * @generated from field: schedula.v1.TimeZoneKeep keep = 4;
+   */
+  keep: TimeZoneKeep;
+
+  /**
+   * @generated from field: bool preview = 5;
+   */
+  preview: boolean;
+
+  /**
+   * @generated from field: string confirmation_token = 6;
+   */
+  confirmationToken: string;
+};
+
+/**
+ * Describes the message schedula.v1.ChangeSeriesTimeZoneRequest.
+ * Use `create(ChangeSeriesTimeZoneRequestSchema)` to create a new message.
+ */
+export const ChangeSeriesTimeZoneRequestSchema: GenMessage<ChangeSeriesTimeZoneRequest> = /*@__PURE__*/
+  messageDesc(file_proto_schedula_v1_appointments, 96);
+
+/**
+ * @generated from message schedula.v1.ChangeSeriesTimeZoneResponse
+ */
+export type ChangeSeriesTimeZoneResponse = Message<"schedula.v1.ChangeSeriesTimeZoneResponse"> & {
+  /**
+   * @generated from field: repeated schedula.v1.RecurringSeries series = 1;
+   */
+  series: RecurringSeries[];
+
+  /**
+   * @generated from field: uint32 removed_exceptions = 2;
+   */
+  removedExceptions: number;
+
+  /**
+   * @generated from field: string confirmation_token = 3;
+   */
+  confirmationToken: string;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp confirmation_expires_at = 4;
+   */
+  confirmationExpiresAt?: Timestamp;
+};
+
+/**
+ * Describes the message schedula.v1.ChangeSeriesTimeZoneResponse.
+ * Use `create(ChangeSeriesTimeZoneResponseSchema)` to create a new message.
+ */
+export const ChangeSeriesTimeZoneResponseSchema: GenMessage<ChangeSeriesTimeZoneResponse> = /*@__PURE__*/
+  messageDesc(file_proto_schedula_v1_appointments, 97);
+
+/**
+ * @generated from message schedula.v1.SkipOccurrencesRequest
+ */
+export type SkipOccurrencesRequest = Message<"schedula.v1.SkipOccurrencesRequest"> & {
+  /**
+   * @generated from field: string user_id = 1;
+   */
+  userId: string;
+
+  /**
+   * @generated from field: string series_id = 2;
+   */
+  seriesId: string;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp window_start = 3;
+   */
+  windowStart?: Timestamp;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp window_end = 4;
+   */
+  windowEnd?: Timestamp;
+
+  /**
+   * @generated from field: repeated schedula.v1.Weekday weekdays = 5;
+   */
+  weekdays: Weekday[];
+
+  /**
+   * @generated from field: bool apply = 6;
+   */
+  apply: boolean;
+
+  /**
+   * @generated from field: string confirmation_token = 7;
+   */
+  confirmationToken: string;
+};
+
+/**
+ * Describes the message schedula.v1.SkipOccurrencesRequest.
+ * Use `create(SkipOccurrencesRequestSchema)` to create a new message.
+ */
+export const SkipOccurrencesRequestSchema: GenMessage<SkipOccurrencesRequest> = /*@__PURE__*/
+  messageDesc(file_proto_schedula_v1_appointments, 98);
+
+/**
+ * @generated from message schedula.v1.SkipOccurrencesResponse
+ */
+export type SkipOccurrencesResponse = Message<"schedula.v1.SkipOccurrencesResponse"> & {
+  /**
+   * @generated from field: repeated google.protobuf.Timestamp occurrence_starts = 1;
+   */
+  occurrenceStarts: Timestamp[];
+
+  /**
+   * @generated from field: uint32 skipped = 2;
+   */
+  skipped: number;
+
+  /**
+   * @generated from field: string confirmation_token = 3;
+   */
+  confirmationToken: string;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp confirmation_expires_at = 4;
+   */
+  confirmationExpiresAt?: Timestamp;
+};
+
+/**
+ * Describes the message schedula.v1.SkipOccurrencesResponse.
+ * Use `create(SkipOccurrencesResponseSchema)` to create a new message.
+ */
+export const SkipOccurrencesResponseSchema: GenMessage<SkipOccurrencesResponse> = /*@__PURE__*/
+  messageDesc(file_proto_schedula_v1_appointments, 99);
+
+/**
+ * @generated from message schedula.v1.DelegationGrant
+ */
+export type DelegationGrant = Message<"schedula.v1.DelegationGrant"> & {
+  /**
+   * @generated from field: string principal_id = 1;
+   */
+  principalId: string;
+
+  /**
+   * @generated from field: string delegate_id = 2;
+   */
+  delegateId: string;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp created_at = 3;
+   */
+  createdAt?: Timestamp;
+};
+
+/**
+ * Describes the message schedula.v1.DelegationGrant.
+ * Use `create(DelegationGrantSchema)` to create a new message.
+ */
+export const DelegationGrantSchema: GenMessage<DelegationGrant> = /*@__PURE__*/
+  messageDesc(file_proto_schedula_v1_appointments, 100);
+
+/**
+ * @generated from message schedula.v1.GrantDelegationRequest
+ */
+export type GrantDelegationRequest = Message<"schedula.v1.GrantDelegationRequest"> & {
+  /**
+   * @generated from field: string principal_id = 1;
+   */
+  principalId: string;
+
+  /**
+   * @generated from field: string delegate_id = 2;
+   */
+  delegateId: string;
+};
+
+/**
+ * Describes the message schedula.v1.GrantDelegationRequest.
+ * Use `create(GrantDelegationRequestSchema)` to create a new message.
+ */
+export const GrantDelegationRequestSchema: GenMessage<GrantDelegationRequest> = /*@__PURE__*/
+  messageDesc(file_proto_schedula_v1_appointments, 101);
+
+/**
+ * @generated from message schedula.v1.GrantDelegationResponse
+ */
+export type GrantDelegationResponse = Message<"schedula.v1.GrantDelegationResponse"> & {
+  /**
+   * @generated from field: schedula.v1.DelegationGrant grant = 1;
+   */
+  grant?: DelegationGrant;
+};
+
+/**
+ * Describes the message schedula.v1.GrantDelegationResponse.
+ * Use `create(GrantDelegationResponseSchema)` to create a new message.
+ */
+export const GrantDelegationResponseSchema: GenMessage<GrantDelegationResponse> = /*@__PURE__*/
+  messageDesc(file_proto_schedula_v1_appointments, 102);
+
+/**
+ * @generated from message schedula.v1.RevokeDelegationRequest
+ */
+export type RevokeDelegationRequest = Message<"schedula.v1.RevokeDelegationRequest"> & {
+  /**
+   * @generated from field: string principal_id = 1;
+   */
+  principalId: string;
+
+  /**
+   * @generated from field: string delegate_id = 2;
+   */
+  delegateId: string;
+};
+
+/**
+ * Describes the message schedula.v1.RevokeDelegationRequest.
+ * Use `create(RevokeDelegationRequestSchema)` to create a new message.
+ */
+export const RevokeDelegationRequestSchema: GenMessage<RevokeDelegationRequest> = /*@__PURE__*/
+  messageDesc(file_proto_schedula_v1_appointments, 103);
+
+/**
+ * @generated from message schedula.v1.RevokeDelegationResponse
+ */
+export type RevokeDelegationResponse = Message<"schedula.v1.RevokeDelegationResponse"> & {
+};
+
+/**
+ * Describes the message schedula.v1.RevokeDelegationResponse.
+ * Use `create(RevokeDelegationResponseSchema)` to create a new message.
+ */
+export const RevokeDelegationResponseSchema: GenMessage<RevokeDelegationResponse> = /*@__PURE__*/
+  messageDesc(file_proto_schedula_v1_appointments, 104);
+
+/**
+ * @generated from message schedula.v1.ListDelegationsRequest
+ */
+export type ListDelegationsRequest = Message<"schedula.v1.ListDelegationsRequest"> & {
+  /**
+   * @generated from field: string principal_id = 1;
+   */
+  principalId: string;
+};
+
+/**
+ * Describes the message schedula.v1.ListDelegationsRequest.
+ * Use `create(ListDelegationsRequestSchema)` to create a new message.
+ */
+export const ListDelegationsRequestSchema: GenMessage<ListDelegationsRequest> = /*@__PURE__*/
+  messageDesc(file_proto_schedula_v1_appointments, 105);
+
+/**
+ * @generated from message schedula.v1.ListDelegationsResponse
+ */
+export type ListDelegationsResponse = Message<"schedula.v1.ListDelegationsResponse"> & {
+  /**
+   * @generated from field: repeated schedula.v1.DelegationGrant grants = 1;
+   */
+  grants: DelegationGrant[];
+};
+
+/**
+ * Describes the message schedula.v1.ListDelegationsResponse.
+ * Use `create(ListDelegationsResponseSchema)` to create a new message.
+ */
+export const ListDelegationsResponseSchema: GenMessage<ListDelegationsResponse> = /*@__PURE__*/
+  messageDesc(file_proto_schedula_v1_appointments, 106);
+
+/**
+ * @generated from message schedula.v1.WatchOccurrencesRequest
+ */
+export type WatchOccurrencesRequest = Message<"schedula.v1.WatchOccurrencesRequest"> & {
+  /**
+   * @generated from field: string user_id = 1;
+   */
+  userId: string;
+
+  /**
+   * @generated from field: string series_id = 2;
+   */
+  seriesId: string;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp window_start = 3;
+   */
+  windowStart?: Timestamp;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp window_end = 4;
+   */
+  windowEnd?: Timestamp;
+};
+
+/**
+ * Describes the message schedula.v1.WatchOccurrencesRequest.
+ * Use `create(WatchOccurrencesRequestSchema)` to create a new message.
+ */
+export const WatchOccurrencesRequestSchema: GenMessage<WatchOccurrencesRequest> = /*@__PURE__*/
+  messageDesc(file_proto_schedula_v1_appointments, 107);
+
+/**
+ * @generated from message schedula.v1.WatchOccurrencesResponse
+ */
+export type WatchOccurrencesResponse = Message<"schedula.v1.WatchOccurrencesResponse"> & {
+  /**
+   * @generated from field: schedula.v1.RecurringSeries series = 1;
+   */
+  series?: RecurringSeries;
+
+  /**
+   * @generated from field: repeated schedula.v1.Occurrence occurrences = 2;
+   */
+  occurrences: Occurrence[];
+};
+
+/**
+ * Describes the message schedula.v1.WatchOccurrencesResponse.
+ * Use `create(WatchOccurrencesResponseSchema)` to create a new message.
+ */
+export const WatchOccurrencesResponseSchema: GenMessage<WatchOccurrencesResponse> = /*@__PURE__*/
+  messageDesc(file_proto_schedula_v1_appointments, 108);
+
+/**
+ * @generated from message schedula.v1.CalendarChange
+ */
+export type CalendarChange = Message<"schedula.v1.CalendarChange"> & {
+  /**
+   * @generated from field: schedula.v1.ChangeEntity entity_type = 1;
+   */
+  entityType: ChangeEntity;
+
+  /**
+   * @generated from field: string entity_id = 2;
+   */
+  entityId: string;
+
+  /**
+   * @generated from field: schedula.v1.ChangeOp op = 3;
+   */
+  op: ChangeOp;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp changed_at = 4;
+   */
+  changedAt?: Timestamp;
+};
+
+/**
+ * Describes the message schedula.v1.CalendarChange.
+ * Use `create(CalendarChangeSchema)` to create a new message.
+ */
+export const CalendarChangeSchema: GenMessage<CalendarChange> = /*@__PURE__*/
+  messageDesc(file_proto_schedula_v1_appointments, 109);
+
+/**
+ * @generated from message schedula.v1.ListChangesRequest
+ */
+export type ListChangesRequest = Message<"schedula.v1.ListChangesRequest"> & {
+  /**
+   * @generated from field: string user_id = 1;
+   */
+  userId: string;
+
+  /**
+   * @generated from field: string since_cursor = 2;
+   */
+  sinceCursor: string;
+
+  /**
+   * @generated from field: int32 page_size = 3;
+   */
+  pageSize: number;
+
+  /**
+   * @generated from field: google.protobuf.Duration wait = 4;
+   */
+  wait?: Duration;
+};
+
+/**
+ * Describes the message schedula.v1.ListChangesRequest.
+ * Use `create(ListChangesRequestSchema)` to create a new message.
+ */
+export const ListChangesRequestSchema: GenMessage<ListChangesRequest> = /*@__PURE__*/
+  messageDesc(file_proto_schedula_v1_appointments, 110);
+
+/**
+ * @generated from message schedula.v1.ListChangesResponse
+ */
+export type ListChangesResponse = Message<"schedula.v1.ListChangesResponse"> & {
+  /**
+   * @generated from field: repeated schedula.v1.CalendarChange changes = 1;
+   */
+  changes: CalendarChange[];
+
+  /**
+   * @generated from field: string next_cursor = 2;
+   */
+  nextCursor: string;
+
+  /**
+   * @generated from field: bool has_more = 3;
+   */
+  hasMore: boolean;
+};
+
+/**
+ * Describes the message schedula.v1.ListChangesResponse.
+ * Use `create(ListChangesResponseSchema)` to create a new message.
+ */
+export const ListChangesResponseSchema: GenMessage<ListChangesResponse> = /*@__PURE__*/
+  messageDesc(file_proto_schedula_v1_appointments, 111);
+
+/**
+ * @generated from message schedula.v1.ExportCalendarRequest
+ */
+export type ExportCalendarRequest = Message<"schedula.v1.ExportCalendarRequest"> & {
+  /**
+   * @generated from field: string user_id = 1;
+   */
+  userId: string;
+};
+
+/**
+ * Describes the message schedula.v1.ExportCalendarRequest.
+ * Use `create(ExportCalendarRequestSchema)` to create a new message.
+ */
+export const ExportCalendarRequestSchema: GenMessage<ExportCalendarRequest> = /*@__PURE__*/
+  messageDesc(file_proto_schedula_v1_appointments, 112);
+
+/**
+ * @generated from message schedula.v1.ExportCalendarResponse
+ */
+export type ExportCalendarResponse = Message<"schedula.v1.ExportCalendarResponse"> & {
+  /**
+   * @generated from field: bytes bundle = 1;
+   */
+  bundle: Uint8Array;
+};
+
+/**
+ * Describes the message schedula.v1.ExportCalendarResponse.
+ * Use `create(ExportCalendarResponseSchema)` to create a new message.
+ */
+export const ExportCalendarResponseSchema: GenMessage<ExportCalendarResponse> = /*@__PURE__*/
+  messageDesc(file_proto_schedula_v1_appointments, 113);
+
+/**
+ * @generated from message schedula.v1.ImportCalendarRequest
+ */
+export type ImportCalendarRequest = Message<"schedula.v1.ImportCalendarRequest"> & {
+  /**
+   * @generated from field: string user_id = 1;
+   */
+  userId: string;
+
+  /**
+   * @generated from field: bytes bundle = 2;
+   */
+  bundle: Uint8Array;
+};
+
+/**
+ * Describes the message schedula.v1.ImportCalendarRequest.
+ * Use `create(ImportCalendarRequestSchema)` to create a new message.
+ */
+export const ImportCalendarRequestSchema: GenMessage<ImportCalendarRequest> = /*@__PURE__*/
+  messageDesc(file_proto_schedula_v1_appointments, 114);
+
+/**
+ * @generated from message schedula.v1.ImportCalendarResponse
+ */
+export type ImportCalendarResponse = Message<"schedula.v1.ImportCalendarResponse"> & {
+  /**
+   * @generated from field: int32 appointments_imported = 1;
+   */
+  appointmentsImported: number;
+
+  /**
+   * @generated from field: int32 series_imported = 2;
+   */
+  seriesImported: number;
+
+  /**
+   * @generated from field: int32 exceptions_imported = 3;
+   */
+  exceptionsImported: number;
+
+  /**
+   * @generated from field: int32 contacts_imported = 4;
+   */
+  contactsImported: number;
+};
+
+/**
+ * Describes the message schedula.v1.ImportCalendarResponse.
+ * Use `create(ImportCalendarResponseSchema)` to create a new message.
+ */
+export const ImportCalendarResponseSchema: GenMessage<ImportCalendarResponse> = /*@__PURE__*/
+  messageDesc(file_proto_schedula_v1_appointments, 115);
+
+/**
+ * @generated from message schedula.v1.CalendarSnapshot
+ */
+export type CalendarSnapshot = Message<"schedula.v1.CalendarSnapshot"> & {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id: string;
+
+  /**
+   * @generated from field: string user_id = 2;
+   */
+  userId: string;
+
+  /**
+   * @generated from field: string label = 3;
+   */
+  label: string;
+
+  /**
+   * @generated from field: int32 format_version = 4;
+   */
+  formatVersion: number;
+
+  /**
+   * @generated from field: string checksum = 5;
+   */
+  checksum: string;
+
+  /**
+   * @generated from field: int32 size_bytes = 6;
+   */
+  sizeBytes: number;
+
+  /**
+   * @generated from field: int32 contacts = 7;
+   */
+  contacts: number;
+
+  /**
+   * @generated from field: int32 appointments = 8;
+   */
+  appointments: number;
+
+  /**
+   * @generated from field: int32 series = 9;
+   */
+  series: number;
+
+  /**
+   * @generated from field: int32 exceptions = 10;
+   */
+  exceptions: number;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp created_at = 11;
+   */
+  createdAt?: Timestamp;
+};
+
+/**
+ * Describes the message schedula.v1.CalendarSnapshot.
+ * Use `create(CalendarSnapshotSchema)` to create a new message.
+ */
+export const CalendarSnapshotSchema: GenMessage<CalendarSnapshot> = /*@__PURE__*/
+  messageDesc(file_proto_schedula_v1_appointments, 116);
+
+/**
+ * @generated from message schedula.v1.CreateCalendarSnapshotRequest
+ */
+export type CreateCalendarSnapshotRequest = Message<"schedula.v1.CreateCalendarSnapshotRequest"> & {
+  /**
+   * @generated from field: string user_id = 1;
+   */
+  userId: string;
+
+  /**
+   * @generated from field: string label = 2;
+   */
+  label: string;
+};
+
+/**
+ * Describes the message schedula.v1.CreateCalendarSnapshotRequest.
+ * Use `create(CreateCalendarSnapshotRequestSchema)` to create a new message.
+ */
+export const CreateCalendarSnapshotRequestSchema: GenMessage<CreateCalendarSnapshotRequest> = /*@__PURE__*/
+  messageDesc(file_proto_schedula_v1_appointments, 117);
+
+/**
+ * @generated from message schedula.v1.CreateCalendarSnapshotResponse
+ */
+export type CreateCalendarSnapshotResponse = Message<"schedula.v1.CreateCalendarSnapshotResponse"> & {
+  /**
+   * @generated from field: schedula.v1.CalendarSnapshot snapshot = 1;
+   */
+  snapshot?: CalendarSnapshot;
+};
+
+/**
+ * Describes the message schedula.v1.CreateCalendarSnapshotResponse.
+ * Use `create(CreateCalendarSnapshotResponseSchema)` to create a new message.
+ */
+export const CreateCalendarSnapshotResponseSchema: GenMessage<CreateCalendarSnapshotResponse> = /*@__PURE__*/
+  messageDesc(file_proto_schedula_v1_appointments, 118);
+
+/**
+ * @generated from message schedula.v1.ListCalendarSnapshotsRequest
+ */
+export type ListCalendarSnapshotsRequest = Message<"schedula.v1.ListCalendarSnapshotsRequest"> & {
+  /**
+   * @generated from field: string user_id = 1;
+   */
+  userId: string;
+};
+
+/**
+ * Describes the message schedula.v1.ListCalendarSnapshotsRequest.
+ * Use `create(ListCalendarSnapshotsRequestSchema)` to create a new message.
+ */
+export const ListCalendarSnapshotsRequestSchema: GenMessage<ListCalendarSnapshotsRequest> = /*@__PURE__*/
+  messageDesc(file_proto_schedula_v1_appointments, 119);
+
+/**
+ * @generated from message schedula.v1.ListCalendarSnapshotsResponse
+ */
+export type ListCalendarSnapshotsResponse = Message<"schedula.v1.ListCalendarSnapshotsResponse"> & {
+  /**
+   * @generated from field: repeated schedula.v1.CalendarSnapshot snapshots = 1;
+   */
+  snapshots: CalendarSnapshot[];
+};
+
+/**
+ * Describes the message schedula.v1.ListCalendarSnapshotsResponse.
+ * Use `create(ListCalendarSnapshotsResponseSchema)` to create a new message.
+ */
+export const ListCalendarSnapshotsResponseSchema: GenMessage<ListCalendarSnapshotsResponse> = /*@__PURE__*/
+  messageDesc(file_proto_schedula_v1_appointments, 120);
+
+/**
+ * @generated from message schedula.v1.RestoreCalendarSnapshotRequest
+ */
+export type RestoreCalendarSnapshotRequest = Message<"schedula.v1.RestoreCalendarSnapshotRequest"> & {
+  /**
+   * @generated from field: string user_id = 1;
+   */
+  userId: string;
+
+  /**
+   * @generated from field: string snapshot_id = 2;
+   */
+  snapshotId: string;
+};
+
+/**
+ * Describes the message schedula.v1.RestoreCalendarSnapshotRequest.
+ * Use `create(RestoreCalendarSnapshotRequestSchema)` to create a new message.
+ */
+export const RestoreCalendarSnapshotRequestSchema: GenMessage<RestoreCalendarSnapshotRequest> = /*@__PURE__*/
+  messageDesc(file_proto_schedula_v1_appointments, 121);
+
+/**
+ * @generated from message schedula.v1.RestoreCalendarSnapshotResponse
+ */
+export type RestoreCalendarSnapshotResponse = Message<"schedula.v1.RestoreCalendarSnapshotResponse"> & {
+  /**
+   * @generated from field: schedula.v1.CalendarSnapshot snapshot = 1;
+   */
+  snapshot?: CalendarSnapshot;
+
+  /**
+   * @generated from field: int32 appointments_created = 2;
+   */
+  appointmentsCreated: number;
+
+  /**
+   * @generated from field: int32 appointments_updated = 3;
+   */
+  appointmentsUpdated: number;
+
+  /**
+   * @generated from field: int32 appointments_deleted = 4;
+   */
+  appointmentsDeleted: number;
+
+  /**
+   * @generated from field: int32 series_created = 5;
+   */
+  seriesCreated: number;
+
+  /**
+   * @generated from field: int32 series_updated = 6;
+   */
+  seriesUpdated: number;
+
+  /**
+   * @generated from field: int32 series_deleted = 7;
+   */
+  seriesDeleted: number;
+
+  /**
+   * @generated from field: int32 contacts_changed = 8;
+   */
+  contactsChanged: number;
+};
+
+/**
+ * Describes the message schedula.v1.RestoreCalendarSnapshotResponse.
+ * Use `create(RestoreCalendarSnapshotResponseSchema)` to create a new message.
+ */
+export const RestoreCalendarSnapshotResponseSchema: GenMessage<RestoreCalendarSnapshotResponse> = /*@__PURE__*/
+  messageDesc(file_proto_schedula_v1_appointments, 122);
+
+/**
+ * @generated from message schedula.v1.Contact
+ */
+export type Contact = Message<"schedula.v1.Contact"> & {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id: string;
+
+  /**
+   * @generated from field: string user_id = 2;
+   */
+  userId: string;
+
+  /**
+   * @generated from field: string name = 3;
+   */
+  name: string;
+
+  /**
+   * @generated from field: string email = 4;
+   */
+  email: string;
+
+  /**
+   * @generated from field: string phone = 5;
+   */
+  phone: string;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp created_at = 6;
+   */
+  createdAt?: Timestamp;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp updated_at = 7;
+   */
+  updatedAt?: Timestamp;
+};
+
+/**
+ * Describes the message schedula.v1.Contact.
+ * Use `create(ContactSchema)` to create a new message.
+ */
+export const ContactSchema: GenMessage<Contact> = /*@__PURE__*/
+  messageDesc(file_proto_schedula_v1_appointments, 123);
+
+/**
+ * @generated from message schedula.v1.CreateContactRequest
+ */
+export type CreateContactRequest = Message<"schedula.v1.CreateContactRequest"> & {
+  /**
+   * @generated from field: string user_id = 1;
+   */
+  userId: string;
+
+  /**
+   * @generated from field: string name = 2;
+   */
+  name: string;
+
+  /**
+   * @generated from field: string email = 3;
+   */
+  email: string;
+
+  /**
+   * @generated from field: string phone = 4;
+   */
+  phone: string;
+};
+
+/**
+ * Describes the message schedula.v1.CreateContactRequest.
+ * Use `create(CreateContactRequestSchema)` to create a new message.
+ */
+export const CreateContactRequestSchema: GenMessage<CreateContactRequest> = /*@__PURE__*/
+  messageDesc(file_proto_schedula_v1_appointments, 124);
+
+/**
+ * @generated from message schedula.v1.CreateContactResponse
+ */
+export type CreateContactResponse = Message<"schedula.v1.CreateContactResponse"> & {
+  /**
+   * @generated from field: schedula.v1.Contact contact = 1;
+   */
+  contact?: Contact;
+};
+
+/**
+ * Describes the message schedula.v1.CreateContactResponse.
+ * Use `create(CreateContactResponseSchema)` to create a new message.
+ */
+export const CreateContactResponseSchema: GenMessage<CreateContactResponse> = /*@__PURE__*/
+  messageDesc(file_proto_schedula_v1_appointments, 125);
+
+/**
+ * @generated from message schedula.v1.GetContactRequest
+ */
+export type GetContactRequest = Message<"schedula.v1.GetContactRequest"> & {
+  /**
+   * @generated from field: string user_id = 1;
+   */
+  userId: string;
+
+  /**
+   * @generated from field: string contact_id = 2;
+   */
+  contactId: string;
+};
+
+/**
+ * Describes the message schedula.v1.GetContactRequest.
+ * Use `create(GetContactRequestSchema)` to create a new message.
+ */
+export const GetContactRequestSchema: GenMessage<GetContactRequest> = /*@__PURE__*/
+  messageDesc(file_proto_schedula_v1_appointments, 126);
+
+/**
+ * @generated from message schedula.v1.GetContactResponse
+ */
+export type GetContactResponse = Message<"schedula.v1.GetContactResponse"> & {
+  /**
+   * @generated from field: schedula.v1.Contact contact = 1;
+   */
+  contact?: Contact;
+};
+
+/**
+ * Describes the message schedula.v1.GetContactResponse.
+ * Use `create(GetContactResponseSchema)` to create a new message.
+ */
+export const GetContactResponseSchema: GenMessage<GetContactResponse> = /*@__PURE__*/
+  messageDesc(file_proto_schedula_v1_appointments, 127);
+
+/**
+ * @generated from message schedula.v1.UpdateContactRequest
+ */
+export type UpdateContactRequest = Message<"schedula.v1.UpdateContactRequest"> & {
+  /**
+   * @generated from field: string user_id = 1;
+   */
+  userId: string;
+
+  /**
+   * @generated from field: string contact_id = 2;
+   */
+  contactId: string;
+
+  /**
+   * @generated from field: string name = 3;
+   */
+  name: string;
+
+  /**
+   * @generated from field: string email = 4;
+   */
+  email: string;
+
+  /**
+   * @generated from field: string phone = 5;
+   */
+  phone: string;
+};
+
+/**
+ * Describes the message schedula.v1.UpdateContactRequest.
+ * Use `create(UpdateContactRequestSchema)` to create a new message.
+ */
+export const UpdateContactRequestSchema: GenMessage<UpdateContactRequest> = /*@__PURE__*/
+  messageDesc(file_proto_schedula_v1_appointments, 128);
+
+/**
+ * @generated from message schedula.v1.UpdateContactResponse
+ */
+export type UpdateContactResponse = Message<"schedula.v1.UpdateContactResponse"> & {
+  /**
+   * @generated from field: schedula.v1.Contact contact = 1;
+   */
+  contact?: Contact;
+};
+
+/**
+ * Describes the message schedula.v1.UpdateContactResponse.
+ * Use `create(UpdateContactResponseSchema)` to create a new message.
+ */
+export const UpdateContactResponseSchema: GenMessage<UpdateContactResponse> = /*@__PURE__*/
+  messageDesc(file_proto_schedula_v1_appointments, 129);
+
+/**
+ * @generated from message schedula.v1.DeleteContactRequest
+ */
+export type DeleteContactRequest = Message<"schedula.v1.DeleteContactRequest"> & {
+  /**
+   * @generated from field: string user_id = 1;
+   */
+  userId: string;
+
+  /**
+   * @generated from field: string contact_id = 2;
+   */
+  contactId: string;
+};
+
+/**
+ * Describes the message schedula.v1.DeleteContactRequest.
+ * Use `create(DeleteContactRequestSchema)` to create a new message.
+ */
+export const DeleteContactRequestSchema: GenMessage<DeleteContactRequest> = /*@__PURE__*/
+  messageDesc(file_proto_schedula_v1_appointments, 130);
+
+/**
+ * @generated from message schedula.v1.DeleteContactResponse
+ */
+export type DeleteContactResponse = Message<"schedula.v1.DeleteContactResponse"> & {
+};
+
+/**
+ * Describes the message schedula.v1.DeleteContactResponse.
+ * Use `create(DeleteContactResponseSchema)` to create a new message.
+ */
+export const DeleteContactResponseSchema: GenMessage<DeleteContactResponse> = /*@__PURE__*/
+  messageDesc(file_proto_schedula_v1_appointments, 131);
+
+/**
+ * @generated from message schedula.v1.ListContactsRequest
+ */
+export type ListContactsRequest = Message<"schedula.v1.ListContactsRequest"> & {
+  /**
+   * @generated from field: string user_id = 1;
+   */
+  userId: string;
+};
+
+/**
+ * Describes the message schedula.v1.ListContactsRequest.
+ * Use `create(ListContactsRequestSchema)` to create a new message.
+ */
+export const ListContactsRequestSchema: GenMessage<ListContactsRequest> = /*@__PURE__*/
+  messageDesc(file_proto_schedula_v1_appointments, 132);
+
+/**
+ * @generated from message schedula.v1.ListContactsResponse
+ */
+export type ListContactsResponse = Message<"schedula.v1.ListContactsResponse"> & {
+  /**
+   * @generated from field: repeated schedula.v1.Contact contacts = 1;
+   */
+  contacts: Contact[];
+};
+
+/**
+ * Describes the message schedula.v1.ListContactsResponse.
+ * Use `create(ListContactsResponseSchema)` to create a new message.
+ */
+export const ListContactsResponseSchema: GenMessage<ListContactsResponse> = /*@__PURE__*/
+  messageDesc(file_proto_schedula_v1_appointments, 133);
+
+/**
+ * @generated from message schedula.v1.Program
+ */
+export type Program = Message<"schedula.v1.Program"> & {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id: string;
+
+  /**
+   * @generated from field: string user_id = 2;
+   */
+  userId: string;
+
+  /**
+   * @generated from field: string title = 3;
+   */
+  title: string;
+
+  /**
+   * @generated from field: string notes = 4;
+   */
+  notes: string;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp cancelled_at = 5;
+   */
+  cancelledAt?: Timestamp;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp created_at = 6;
+   */
+  createdAt?: Timestamp;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp updated_at = 7;
+   */
+  updatedAt?: Timestamp;
+};
+
+/**
+ * Describes the message schedula.v1.Program.
+ * Use `create(ProgramSchema)` to create a new message.
+ */
+export const ProgramSchema: GenMessage<Program> = /*@__PURE__*/
+  messageDesc(file_proto_schedula_v1_appointments, 134);
+
+/**
+ * @generated from message schedula.v1.ProgramProgress
+ */
+export type ProgramProgress = Message<"schedula.v1.ProgramProgress"> & {
+  /**
+   * @generated from field: uint32 total_sessions = 1;
+   */
+  totalSessions: number;
+
+  /**
+   * @generated from field: uint32 completed_sessions = 2;
+   */
+  completedSessions: number;
+
+  /**
+   * @generated from field: uint32 remaining_sessions = 3;
+   */
+  remainingSessions: number;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp next_session = 4;
+   */
+  nextSession?: Timestamp;
+};
+
+/**
+ * Describes the message schedula.v1.ProgramProgress.
+ * Use `create(ProgramProgressSchema)` to create a new message.
+ */
+export const ProgramProgressSchema: GenMessage<ProgramProgress> = /*@__PURE__*/
+  messageDesc(file_proto_schedula_v1_appointments, 135);
+
+/**
+ * @generated from message schedula.v1.CreateProgramRequest
+ */
+export type CreateProgramRequest = Message<"schedula.v1.CreateProgramRequest"> & {
+  /**
+   * @generated from field: string user_id = 1;
+   */
+  userId: string;
+
+  /**
+   * @generated from field: string title = 2;
+   */
+  title: string;
+
+  /**
+   * @generated from field: string notes = 3;
+   */
+  notes: string;
+};
+
+/**
+ * Describes the message schedula.v1.CreateProgramRequest.
+ * Use `create(CreateProgramRequestSchema)` to create a new message.
+ */
+export const CreateProgramRequestSchema: GenMessage<CreateProgramRequest> = /*@__PURE__*/
+  messageDesc(file_proto_schedula_v1_appointments, 136);
+
+/**
+ * @generated from message schedula.v1.CreateProgramResponse
+ */
+export type CreateProgramResponse = Message<"schedula.v1.CreateProgramResponse"> & {
+  /**
+   * @generated from field: schedula.v1.Program program = 1;
+   */
+  program?: Program;
+};
+
+/**
+ * Describes the message schedula.v1.CreateProgramResponse.
+ * Use `create(CreateProgramResponseSchema)` to create a new message.
+ */
+export const CreateProgramResponseSchema: GenMessage<CreateProgramResponse> = /*@__PURE__*/
+  messageDesc(file_proto_schedula_v1_appointments, 137);
+
+/**
+ * @generated from message schedula.v1.GetProgramRequest
+ */
+export type GetProgramRequest = Message<"schedula.v1.GetProgramRequest"> & {
+  /**
+   * @generated from field: string user_id = 1;
+   */
+  userId: string;
+
+  /**
+   * @generated from field: string program_id = 2;
+   */
+  programId: string;
+};
+
+/**
+ * Describes the message schedula.v1.GetProgramRequest.
+ * Use `create(GetProgramRequestSchema)` to create a new message.
+ */
+export const GetProgramRequestSchema: GenMessage<GetProgramRequest> = /*@__PURE__*/
+  messageDesc(file_proto_schedula_v1_appointments, 138);
+
+/**
+ * @generated from message schedula.v1.GetProgramResponse
+ */
+export type GetProgramResponse = Message<"schedula.v1.GetProgramResponse"> & {
+  /**
+   * @generated from field: schedula.v1.Program program = 1;
+   */
+  program?: Program;
+
+  /**
+   * @generated from field: repeated schedula.v1.Appointment appointments = 2;
+   */
+  appointments: Appointment[];
+
+  /**
+   * @generated from field: repeated schedula.v1.RecurringSeries series = 3;
+   */
+  series: RecurringSeries[];
+
+  /**
+   * @generated from field: schedula.v1.ProgramProgress progress = 4;
+   */
+  progress?: ProgramProgress;
+};
+
+/**
+ * Describes the message schedula.v1.GetProgramResponse.
+ * Use `create(GetProgramResponseSchema)` to create a new message.
+ */
+export const GetProgramResponseSchema: GenMessage<GetProgramResponse> = /*@__PURE__*/
+  messageDesc(file_proto_schedula_v1_appointments, 139);
+
+/**
+ * @generated from message schedula.v1.ListProgramsRequest
+ */
+export type ListProgramsRequest = Message<"schedula.v1.ListProgramsRequest"> & {
+  /**
+   * @generated from field: string user_id = 1;
+   */
+  userId: string;
+};
+
+/**
+ * Describes the message schedula.v1.ListProgramsRequest.
+ * Use `create(ListProgramsRequestSchema)` to create a new message.
+ */
+export const ListProgramsRequestSchema: GenMessage<ListProgramsRequest> = /*@__PURE__*/
+  messageDesc(file_proto_schedula_v1_appointments, 140);
+
+/**
+ * @generated from message schedula.v1.ListProgramsResponse
+ */
+export type ListProgramsResponse = Message<"schedula.v1.ListProgramsResponse"> & {
+  /**
+   * @generated from field: repeated schedula.v1.Program programs = 1;
+   */
+  programs: Program[];
+};
+
+/**
+ * Describes the message schedula.v1.ListProgramsResponse.
+ * Use `create(ListProgramsResponseSchema)` to create a new message.
+ */
+export const ListProgramsResponseSchema: GenMessage<ListProgramsResponse> = /*@__PURE__*/
+  messageDesc(file_proto_schedula_v1_appointments, 141);
+
+/**
+ * @generated from message schedula.v1.CancelProgramRequest
+ */
+export type CancelProgramRequest = Message<"schedula.v1.CancelProgramRequest"> & {
+  /**
+   * @generated from field: string user_id = 1;
+   */
+  userId: string;
+
+  /**
+   * @generated from field: string program_id = 2;
+   */
+  programId: string;
+};
+
+/**
+ * Describes the message schedula.v1.CancelProgramRequest.
+ * Use `create(CancelProgramRequestSchema)` to create a new message.
+ */
+export const CancelProgramRequestSchema: GenMessage<CancelProgramRequest> = /*@__PURE__*/
+  messageDesc(file_proto_schedula_v1_appointments, 142);
+
+/**
+ * @generated from message schedula.v1.CancelProgramResponse
+ */
+export type CancelProgramResponse = Message<"schedula.v1.CancelProgramResponse"> & {
+  /**
+   * @generated from field: schedula.v1.Program program = 1;
+   */
+  program?: Program;
+
+  /**
+   * @generated from field: int32 appointments_deleted = 2;
+   */
+  appointmentsDeleted: number;
+
+  /**
+   * @generated from field: int32 series_ended = 3;
+   */
+  seriesEnded: number;
+
+  /**
+   * @generated from field: int32 series_deleted = 4;
+   */
+  seriesDeleted: number;
+};
+
+/**
+ * Describes the message schedula.v1.CancelProgramResponse.
+ * Use `create(CancelProgramResponseSchema)` to create a new message.
+ */
+export const CancelProgramResponseSchema: GenMessage<CancelProgramResponse> = /*@__PURE__*/
+  messageDesc(file_proto_schedula_v1_appointments, 143);
+
+/**
+ * @generated from message schedula.v1.CheckInRequest
+ */
+export type CheckInRequest = Message<"schedula.v1.CheckInRequest"> & {
+  /**
+   * @generated from field: string user_id = 1;
+   */
+  userId: string;
+
+  /**
+   * @generated from field: string appointment_id = 2;
+   */
+  appointmentId: string;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp at = 3;
+   */
+  at?: Timestamp;
+};
+
+/**
+ * Describes the message schedula.v1.CheckInRequest.
+ * Use `create(CheckInRequestSchema)` to create a new message.
+ */
+export const CheckInRequestSchema: GenMessage<CheckInRequest> = /*@__PURE__*/
+  messageDesc(file_proto_schedula_v1_appointments, 144);
+
+/**
+ * @generated from message schedula.v1.CheckInResponse
+ */
+export type CheckInResponse = Message<"schedula.v1.CheckInResponse"> & {
+  /**
+   * @generated from field: schedula.v1.Appointment appointment = 1;
+   */
+  appointment?: Appointment;
+};
+
+/**
+ * Describes the message schedula.v1.CheckInResponse.
+ * Use `create(CheckInResponseSchema)` to create a new message.
+ */
+export const CheckInResponseSchema: GenMessage<CheckInResponse> = /*@__PURE__*/
+  messageDesc(file_proto_schedula_v1_appointments, 145);
+
+/**
+ * @generated from message schedula.v1.CheckOutRequest
+ */
+export type CheckOutRequest = Message<"schedula.v1.CheckOutRequest"> & {
+  /**
+   * @generated from field: string user_id = 1;
+   */
+  userId: string;
+
+  /**
+   * @generated from field: string appointment_id = 2;
+   */
+  appointmentId: string;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp at = 3;
+   */
+  at?: Timestamp;
+};
+
+/**
+ * Describes the message schedula.v1.CheckOutRequest.
+ * Use `create(CheckOutRequestSchema)` to create a new message.
+ */
+export const CheckOutRequestSchema: GenMessage<CheckOutRequest> = /*@__PURE__*/
+  messageDesc(file_proto_schedula_v1_appointments, 146);
+
+/**
+ * @generated from message schedula.v1.CheckOutResponse
+ */
+export type CheckOutResponse = Message<"schedula.v1.CheckOutResponse"> & {
+  /**
+   * @generated from field: schedula.v1.Appointment appointment = 1;
+   */
+  appointment?: Appointment;
+
+  /**
+   * @generated from field: google.protobuf.Duration planned_duration = 2;
+   */
+  plannedDuration?: Duration;
+
+  /**
+   * @generated from field: google.protobuf.Duration actual_duration = 3;
+   */
+  actualDuration?: Duration;
+};
+
+/**
+ * Describes the message schedula.v1.CheckOutResponse.
+ * Use `create(CheckOutResponseSchema)` to create a new message.
+ */
+export const CheckOutResponseSchema: GenMessage<CheckOutResponse> = /*@__PURE__*/
+  messageDesc(file_proto_schedula_v1_appointments, 147);
+
+/**
+ * @generated from message schedula.v1.ExportBillableHoursRequest
+ */
+export type ExportBillableHoursRequest = Message<"schedula.v1.ExportBillableHoursRequest"> & {
+  /**
+   * @generated from field: string user_id = 1;
+   */
+  userId: string;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp window_start = 2;
+   */
+  windowStart?: Timestamp;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp window_end = 3;
+   */
+  windowEnd?: Timestamp;
+
+  /**
+   * @generated from field: string tag_key = 4;
+   */
+  tagKey: string;
+
+  /**
+   * @generated from field: schedula.v1.BillablePeriod period = 5;
+   */
+  period: BillablePeriod;
+
+  /**
+   * @generated from field: string time_zone = 6;
+   */
+  timeZone: string;
+
+  /**
+   * @generated from field: schedula.v1.BillableFormat format = 7;
+   */
+  format: BillableFormat;
+};
+
+/**
+ * Describes the message schedula.v1.ExportBillableHoursRequest.
+ * Use `create(ExportBillableHoursRequestSchema)` to create a new message.
+ */
+export const ExportBillableHoursRequestSchema: GenMessage<ExportBillableHoursRequest> = /*@__PURE__*/
+  messageDesc(file_proto_schedula_v1_appointments, 148);
+
+/**
+ * @generated from message schedula.v1.ExportBillableHoursResponse
+ */
+export type ExportBillableHoursResponse = Message<"schedula.v1.ExportBillableHoursResponse"> & {
+  /**
+   * @generated from field: bytes data = 1;
+   */
+  data: Uint8Array;
+
+  /**
+   * @generated from field: string content_type = 2;
+   */
+  contentType: string;
+};
+
+/**
+ * Describes the message schedula.v1.ExportBillableHoursResponse.
+ * Use `create(ExportBillableHoursResponseSchema)` to create a new message.
+ */
+export const ExportBillableHoursResponseSchema: GenMessage<ExportBillableHoursResponse> = /*@__PURE__*/
+  messageDesc(file_proto_schedula_v1_appointments, 149);
+
+/**
+ * @generated from message schedula.v1.OfflineMutation
+ */
+export type OfflineMutation = Message<"schedula.v1.OfflineMutation"> & {
+  /**
+   * @generated from field: schedula.v1.MutationKind kind = 1;
+   */
+  kind: MutationKind;
+
+  /**
+   * @generated from field: string appointment_id = 2;
+   */
+  appointmentId: string;
+
+  /**
+   * @generated from field: string title = 3;
+   */
+  title: string;
+
+  /**
+   * @generated from field: string notes = 4;
+   */
+  notes: string;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp start_time = 5;
+   */
+  startTime?: Timestamp;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp end_time = 6;
+   */
+  endTime?: Timestamp;
+
+  /**
+   * @generated from field: map<string, string> metadata = 7;
+   */
+  metadata: { [key: string]: string };
+
+  /**
+   * @generated from field: string time_zone = 8;
+   */
+  timeZone: string;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp base_updated_at = 9;
+   */
+  baseUpdatedAt?: Timestamp;
+};
+
+/**
+ * Describes the message schedula.v1.OfflineMutation.
+ * Use `create(OfflineMutationSchema)` to create a new message.
+ */
+export const OfflineMutationSchema: GenMessage<OfflineMutation> = /*@__PURE__*/
+  messageDesc(file_proto_schedula_v1_appointments, 150);
+
+/**
+ * @generated from message schedula.v1.MutationResult
+ */
+export type MutationResult = Message<"schedula.v1.MutationResult"> & {
+  /**
+   * @generated from field: schedula.v1.MutationStatus status = 1;
+   */
+  status: MutationStatus;
+
+  /**
+   * @generated from field: schedula.v1.MutationConflict conflict = 2;
+   */
+  conflict: MutationConflict;
+
+  /**
+   * @generated from field: string message = 3;
+   */
+  message: string;
+
+  /**
+   * @generated from field: schedula.v1.Appointment current = 4;
+   */
+  current?: Appointment;
+};
+
+/**
+ * Describes the message schedula.v1.MutationResult.
+ * Use `create(MutationResultSchema)` to create a new message.
+ */
+export const MutationResultSchema: GenMessage<MutationResult> = /*@__PURE__*/
+  messageDesc(file_proto_schedula_v1_appointments, 151);
+
+/**
+ * @generated from message schedula.v1.ReconcileCalendarRequest
+ */
+export type ReconcileCalendarRequest = Message<"schedula.v1.ReconcileCalendarRequest"> & {
+  /**
+   * @generated from field: string user_id = 1;
+   */
+  userId: string;
+
+  /**
+   * @generated from field: repeated schedula.v1.OfflineMutation mutations = 2;
+   */
+  mutations: OfflineMutation[];
+};
+
+/**
+ * Describes the message schedula.v1.ReconcileCalendarRequest.
+ * Use `create(ReconcileCalendarRequestSchema)` to create a new message.
+ */
+export const ReconcileCalendarRequestSchema: GenMessage<ReconcileCalendarRequest> = /*@__PURE__*/
+  messageDesc(file_proto_schedula_v1_appointments, 152);
+
+/**
+ * @generated from message schedula.v1.ReconcileCalendarResponse
+ */
+export type ReconcileCalendarResponse = Message<"schedula.v1.ReconcileCalendarResponse"> & {
+  /**
+   * @generated from field: repeated schedula.v1.MutationResult results = 1;
+   */
+  results: MutationResult[];
+};
+
+/**
+ * Describes the message schedula.v1.ReconcileCalendarResponse.
+ * Use `create(ReconcileCalendarResponseSchema)` to create a new message.
+ */
+export const ReconcileCalendarResponseSchema: GenMessage<ReconcileCalendarResponse> = /*@__PURE__*/
+  messageDesc(file_proto_schedula_v1_appointments, 153);
+
+/**
+ * @generated from message schedula.v1.CreateEmbedTokenRequest
+ */
+export type CreateEmbedTokenRequest = Message<"schedula.v1.CreateEmbedTokenRequest"> & {
+  /**
+   * @generated from field: string user_id = 1;
+   */
+  userId: string;
+
+  /**
+   * @generated from field: google.protobuf.Duration slot_duration = 2;
+   */
+  slotDuration?: Duration;
+
+  /**
+   * @generated from field: schedula.v1.WorkingHours working_hours = 3;
+   */
+  workingHours?: WorkingHours;
+
+  /**
+   * @generated from field: google.protobuf.Duration ttl = 4;
+   */
+  ttl?: Duration;
+};
+
+/**
+ * Describes the message schedula.v1.CreateEmbedTokenRequest.
+ * Use `create(CreateEmbedTokenRequestSchema)` to create a new message.
+ */
+export const CreateEmbedTokenRequestSchema: GenMessage<CreateEmbedTokenRequest> = /*@__PURE__*/
+  messageDesc(file_proto_schedula_v1_appointments, 154);
+
+/**
+ * @generated from message schedula.v1.CreateEmbedTokenResponse
+ */
+export type CreateEmbedTokenResponse = Message<"schedula.v1.CreateEmbedTokenResponse"> & {
+  /**
+   * @generated from field: string token = 1;
+   */
+  token: string;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp expires_at = 2;
+   */
+  expiresAt?: Timestamp;
+};
+
+/**
+ * Describes the message schedula.v1.CreateEmbedTokenResponse.
+ * Use `create(CreateEmbedTokenResponseSchema)` to create a new message.
+ */
+export const CreateEmbedTokenResponseSchema: GenMessage<CreateEmbedTokenResponse> = /*@__PURE__*/
+  messageDesc(file_proto_schedula_v1_appointments, 155);
+
+/**
+ * @generated from message schedula.v1.DailyBreak
+ */
+export type DailyBreak = Message<"schedula.v1.DailyBreak"> & {
+  /**
+   * @generated from field: string label = 1;
+   */
+  label: string;
+
+  /**
+   * @generated from field: uint32 start_minute = 2;
+   */
+  startMinute: number;
+
+  /**
+   * @generated from field: uint32 end_minute = 3;
+   */
+  endMinute: number;
+};
+
+/**
+ * Describes the message schedula.v1.DailyBreak.
+ * Use `create(DailyBreakSchema)` to create a new message.
+ */
+export const DailyBreakSchema: GenMessage<DailyBreak> = /*@__PURE__*/
+  messageDesc(file_proto_schedula_v1_appointments, 156);
+
+/**
+ * @generated from message schedula.v1.SlotSettings
+ */
+export type SlotSettings = Message<"schedula.v1.SlotSettings"> & {
+  /**
+   * @generated from field: string user_id = 1;
+   */
+  userId: string;
+
+  /**
+   * @generated from field: uint32 alignment_minutes = 2;
+   */
+  alignmentMinutes: number;
+
+  /**
+   * @generated from field: string time_zone = 3;
+   */
+  timeZone: string;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp updated_at = 4;
+   */
+  updatedAt?: Timestamp;
+
+  /**
+   * @generated from field: repeated schedula.v1.DailyBreak daily_breaks = 5;
+   */
+  dailyBreaks: DailyBreak[];
+};
+
+/**
+ * Describes the message schedula.v1.SlotSettings.
+ * Use `create(SlotSettingsSchema)` to create a new message.
+ */
+export const SlotSettingsSchema: GenMessage<SlotSettings> = /*@__PURE__*/
+  messageDesc(file_proto_schedula_v1_appointments, 157);
+
+/**
+ * @generated from message schedula.v1.GetSlotSettingsRequest
+ */
+export type GetSlotSettingsRequest = Message<"schedula.v1.GetSlotSettingsRequest"> & {
+  /**
+   * @generated from field: string user_id = 1;
+   */
+  userId: string;
+};
+
+/**
+ * Describes the message schedula.v1.GetSlotSettingsRequest.
+ * Use `create(GetSlotSettingsRequestSchema)` to create a new message.
+ */
+export const GetSlotSettingsRequestSchema: GenMessage<GetSlotSettingsRequest> = /*@__PURE__*/
+  messageDesc(file_proto_schedula_v1_appointments, 158);
+
+/**
+ * @generated from message schedula.v1.GetSlotSettingsResponse
+ */
+export type GetSlotSettingsResponse = Message<"schedula.v1.GetSlotSettingsResponse"> & {
+  /**
+   * @generated from field: schedula.v1.SlotSettings settings = 1;
+   */
+  settings?: SlotSettings;
+};
+
+/**
+ * Describes the message schedula.v1.GetSlotSettingsResponse.
+ * Use `create(GetSlotSettingsResponseSchema)` to create a new message.
+ */
+export const GetSlotSettingsResponseSchema: GenMessage<GetSlotSettingsResponse> = /*@__PURE__*/
+  messageDesc(file_proto_schedula_v1_appointments, 159);
+
+/**
+ * @generated from message schedula.v1.UpdateSlotSettingsRequest
+ */
+export type UpdateSlotSettingsRequest = Message<"schedula.v1.UpdateSlotSettingsRequest"> & {
+  /**
+   * @generated from field: string user_id = 1;
+   */
+  userId: string;
+
+  /**
+   * @generated from field: uint32 alignment_minutes = 2;
+   */
+  alignmentMinutes: number;
+
+  /**
+   * @generated from field: string time_zone = 3;
+   */
+  timeZone: string;
+};
+
+/**
+ * Describes the message schedula.v1.UpdateSlotSettingsRequest.
+ * Use `create(UpdateSlotSettingsRequestSchema)` to create a new message.
+ */
+export const UpdateSlotSettingsRequestSchema: GenMessage<UpdateSlotSettingsRequest> = /*@__PURE__*/
+  messageDesc(file_proto_schedula_v1_appointments, 160);
+
+/**
+ * @generated from message schedula.v1.UpdateSlotSettingsResponse
+ */
+export type UpdateSlotSettingsResponse = Message<"schedula.v1.UpdateSlotSettingsResponse"> & {
+  /**
+   * @generated from field: schedula.v1.SlotSettings settings = 1;
+   */
+  settings?: SlotSettings;
+};
+
+/**
+ * Describes the message schedula.v1.UpdateSlotSettingsResponse.
+ * Use `create(UpdateSlotSettingsResponseSchema)` to create a new message.
+ */
+export const UpdateSlotSettingsResponseSchema: GenMessage<UpdateSlotSettingsResponse> = /*@__PURE__*/
+  messageDesc(file_proto_schedula_v1_appointments, 161);
+
+/**
+ * @generated from message schedula.v1.UpdateDailyBreaksRequest
+ */
+export type UpdateDailyBreaksRequest = Message<"schedula.v1.UpdateDailyBreaksRequest"> & {
+  /**
+   * @generated from field: string user_id = 1;
+   */
+  userId: string;
+
+  /**
+   * @generated from field: repeated schedula.v1.DailyBreak breaks = 2;
+   */
+  breaks: DailyBreak[];
+};
+
+/**
+ * Describes the message schedula.v1.UpdateDailyBreaksRequest.
+ * Use `create(UpdateDailyBreaksRequestSchema)` to create a new message.
+ */
+export const UpdateDailyBreaksRequestSchema: GenMessage<UpdateDailyBreaksRequest> = /*@__PURE__*/
+  messageDesc(file_proto_schedula_v1_appointments, 162);
+
+/**
+ * @generated from message schedula.v1.UpdateDailyBreaksResponse
+ */
+export type UpdateDailyBreaksResponse = Message<"schedula.v1.UpdateDailyBreaksResponse"> & {
+  /**
+   * @generated from field: schedula.v1.SlotSettings settings = 1;
+   */
+  settings?: SlotSettings;
+};
+
+/**
+ * Describes the message schedula.v1.UpdateDailyBreaksResponse.
+ * Use `create(UpdateDailyBreaksResponseSchema)` to create a new message.
+ */
+export const UpdateDailyBreaksResponseSchema: GenMessage<UpdateDailyBreaksResponse> = /*@__PURE__*/
+  messageDesc(file_proto_schedula_v1_appointments, 163);
+
+/**
+ * @generated from message schedula.v1.TimeOffRecurrence
+ */
+export type TimeOffRecurrence = Message<"schedula.v1.TimeOffRecurrence"> & {
+  /**
+   * @generated from field: uint32 interval = 1;
+   */
+  interval: number;
+
+  /**
+   * @generated from field: repeated schedula.v1.Weekday weekdays = 2;
+   */
+  weekdays: Weekday[];
+
+  /**
+   * @generated from field: google.protobuf.Timestamp until = 3;
+   */
+  until?: Timestamp;
+
+  /**
+   * @generated from field: string time_zone = 4;
+   */
+  timeZone: string;
+};
+
+/**
+ * Describes the message schedula.v1.TimeOffRecurrence.
+ * Use `create(TimeOffRecurrenceSchema)` to create a new message.
+ */
+export const TimeOffRecurrenceSchema: GenMessage<TimeOffRecurrence> = /*@__PURE__*/
+  messageDesc(file_proto_schedula_v1_appointments, 164);
+
+/**
+ * @generated from message schedula.v1.TimeOff
+ */
+export type TimeOff = Message<"schedula.v1.TimeOff"> & {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id: string;
+
+  /**
+   * @generated from field: string user_id = 2;
+   */
+  userId: string;
+
+  /**
+   * @generated from field: string title = 3;
+   */
+  title: string;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp start_time = 4;
+   */
+  startTime?: Timestamp;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp end_time = 5;
+   */
+  endTime?: Timestamp;
+
+  /**
+   * @generated from field: schedula.v1.TimeOffRecurrence recurrence = 6;
+   */
+  recurrence?: TimeOffRecurrence;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp created_at = 7;
+   */
+  createdAt?: Timestamp;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp updated_at = 8;
+   */
+  updatedAt?: Timestamp;
+};
+
+/**
+ * Describes the message schedula.v1.TimeOff.
+ * Use `create(TimeOffSchema)` to create a new message.
+ */
+export const TimeOffSchema: GenMessage<TimeOff> = /*@__PURE__*/
+  messageDesc(file_proto_schedula_v1_appointments, 165);
+
+/**
+ * @generated from message schedula.v1.CreateTimeOffRequest
+ */
+export type CreateTimeOffRequest = Message<"schedula.v1.CreateTimeOffRequest"> & {
+  /**
+   * @generated from field: string user_id = 1;
+   */
+  userId: string;
+
+  /**
+   * @generated from field: string title = 2;
+   */
+  title: string;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp start_time = 3;
+   */
+  startTime?: Timestamp;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp end_time = 4;
+   */
+  endTime?: Timestamp;
+
+  /**
+   * @generated from field: schedula.v1.TimeOffRecurrence recurrence = 5;
+   */
+  recurrence?: TimeOffRecurrence;
+};
+
+/**
+ * Describes the message schedula.v1.CreateTimeOffRequest.
+ * Use `create(CreateTimeOffRequestSchema)` to create a new message.
+ */
+export const CreateTimeOffRequestSchema: GenMessage<CreateTimeOffRequest> = /*@__PURE__*/
+  messageDesc(file_proto_schedula_v1_appointments, 166);
+
+/**
+ * @generated from message schedula.v1.CreateTimeOffResponse
+ */
+export type CreateTimeOffResponse = Message<"schedula.v1.CreateTimeOffResponse"> & {
+  /**
+   * @generated from field: schedula.v1.TimeOff time_off = 1;
+   */
+  timeOff?: TimeOff;
+};
+
+/**
+ * Describes the message schedula.v1.CreateTimeOffResponse.
+ * Use `create(CreateTimeOffResponseSchema)` to create a new message.
+ */
+export const CreateTimeOffResponseSchema: GenMessage<CreateTimeOffResponse> = /*@__PURE__*/
+  messageDesc(file_proto_schedula_v1_appointments, 167);
+
+/**
+ * @generated from message schedula.v1.GetTimeOffRequest
+ */
+export type GetTimeOffRequest = Message<"schedula.v1.GetTimeOffRequest"> & {
+  /**
+   * @generated from field: string user_id = 1;
+   */
+  userId: string;
+
+  /**
+   * @generated from field: string time_off_id = 2;
+   */
+  timeOffId: string;
+};
+
+/**
+ * Describes the message schedula.v1.GetTimeOffRequest.
+ * Use `create(GetTimeOffRequestSchema)` to create a new message.
+ */
+export const GetTimeOffRequestSchema: GenMessage<GetTimeOffRequest> = /*@__PURE__*/
+  messageDesc(file_proto_schedula_v1_appointments, 168);
+
+/**
+ * @generated from message schedula.v1.GetTimeOffResponse
+ */
+export type GetTimeOffResponse = Message<"schedula.v1.GetTimeOffResponse"> & {
+  /**
+   * @generated from field: schedula.v1.TimeOff time_off = 1;
+   */
+  timeOff?: TimeOff;
+};
+
+/**
+ * Describes the message schedula.v1.GetTimeOffResponse.
+ * Use `create(GetTimeOffResponseSchema)` to create a new message.
+ */
+export const GetTimeOffResponseSchema: GenMessage<GetTimeOffResponse> = /*@__PURE__*/
+  messageDesc(file_proto_schedula_v1_appointments, 169);
+
+/**
+ * @generated from message schedula.v1.UpdateTimeOffRequest
+ */
+export type UpdateTimeOffRequest = Message<"schedula.v1.UpdateTimeOffRequest"> & {
+  /**
+   * @generated from field: string user_id = 1;
+   */
+  userId: string;
+
+  /**
+   * @generated from field: string time_off_id = 2;
+   */
+  timeOffId: string;
+
+  /**
+   * @generated from field: string title = 3;
+   */
+  title: string;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp start_time = 4;
+   */
+  startTime?: Timestamp;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp end_time = 5;
+   */
+  endTime?: Timestamp;
+
+  /**
+   * @generated from field: schedula.v1.TimeOffRecurrence recurrence = 6;
+   */
+  recurrence?: TimeOffRecurrence;
+};
+
+/**
+ * Describes the message schedula.v1.UpdateTimeOffRequest.
+ * Use `create(UpdateTimeOffRequestSchema)` to create a new message.
+ */
+export const UpdateTimeOffRequestSchema: GenMessage<UpdateTimeOffRequest> = /*@__PURE__*/
+  messageDesc(file_proto_schedula_v1_appointments, 170);
+
+/**
+ * @generated from message schedula.v1.UpdateTimeOffResponse
+ */
+export type UpdateTimeOffResponse = Message<"schedula.v1.UpdateTimeOffResponse"> & {
+  /**
+   * @generated from field: schedula.v1.TimeOff time_off = 1;
+   */
+  timeOff?: TimeOff;
+};
+
+/**
+ * Describes the message schedula.v1.UpdateTimeOffResponse.
+ * Use `create(UpdateTimeOffResponseSchema)` to create a new message.
+ */
+export const UpdateTimeOffResponseSchema: GenMessage<UpdateTimeOffResponse> = /*@__PURE__*/
+  messageDesc(file_proto_schedula_v1_appointments, 171);
+
+/**
+ * @generated from message schedula.v1.DeleteTimeOffRequest
+ */
+export type DeleteTimeOffRequest = Message<"schedula.v1.DeleteTimeOffRequest"> & {
+  /**
+   * @generated from field: string user_id = 1;
+   */
+  userId: string;
+
+  /**
+   * @generated from field: string time_off_id = 2;
+   */
+  timeOffId: string;
+};
+
+/**
+ * Describes the message schedula.v1.DeleteTimeOffRequest.
+ * Use `create(DeleteTimeOffRequestSchema)` to create a new message.
+ */
+export const DeleteTimeOffRequestSchema: GenMessage<DeleteTimeOffRequest> = /*@__PURE__*/
+  messageDesc(file_proto_schedula_v1_appointments, 172);
+
+/**
+ * @generated from message schedula.v1.DeleteTimeOffResponse
+ */
+export type DeleteTimeOffResponse = Message<"schedula.v1.DeleteTimeOffResponse"> & {
+};
+
+/**
+ * Describes the message schedula.v1.DeleteTimeOffResponse.
+ * Use `create(DeleteTimeOffResponseSchema)` to create a new message.
+ */
+export const DeleteTimeOffResponseSchema: GenMessage<DeleteTimeOffResponse> = /*@__PURE__*/
+  messageDesc(file_proto_schedula_v1_appointments, 173);
+
+/**
+ * @generated from message schedula.v1.ListTimeOffRequest
+ */
+export type ListTimeOffRequest = Message<"schedula.v1.ListTimeOffRequest"> & {
+  /**
+   * @generated from field: string user_id = 1;
+   */
+  userId: string;
+};
+
+/**
+ * Describes the message schedula.v1.ListTimeOffRequest.
+ * Use `create(ListTimeOffRequestSchema)` to create a new message.
+ */
+export const ListTimeOffRequestSchema: GenMessage<ListTimeOffRequest> = /*@__PURE__*/
+  messageDesc(file_proto_schedula_v1_appointments, 174);
+
+/**
+ * @generated from message schedula.v1.ListTimeOffResponse
+ */
+export type ListTimeOffResponse = Message<"schedula.v1.ListTimeOffResponse"> & {
+  /**
+   * @generated from field: repeated schedula.v1.TimeOff time_off = 1;
+   */
+  timeOff: TimeOff[];
+};
+
+/**
+ * Describes the message schedula.v1.ListTimeOffResponse.
+ * Use `create(ListTimeOffResponseSchema)` to create a new message.
+ */
+export const ListTimeOffResponseSchema: GenMessage<ListTimeOffResponse> = /*@__PURE__*/
+  messageDesc(file_proto_schedula_v1_appointments, 175);
+
+/**
+ * @generated from enum schedula.v1.Weekday
+ */
+export enum Weekday {
+  /**
+   * @generated from enum value: WEEKDAY_UNSPECIFIED = 0;
+   */
+  WEEKDAY_UNSPECIFIED = 0,
+
+  /**
+   * @generated from enum value: MONDAY = 1;
+   */
+  MONDAY = 1,
+
+  /**
+   * @generated from enum value: TUESDAY = 2;
+   */
+  TUESDAY = 2,
+
+  /**
+   * @generated from enum value: WEDNESDAY = 3;
+   */
+  WEDNESDAY = 3,
+
+  /**
+   * @generated from enum value: THURSDAY = 4;
+   */
+  THURSDAY = 4,
+
+  /**
+   * @generated from enum value: FRIDAY = 5;
+   */
+  FRIDAY = 5,
+
+  /**
+   * @generated from enum value: SATURDAY = 6;
+   */
+  SATURDAY = 6,
+
+  /**
+   * @generated from enum value: SUNDAY = 7;
+   */
+  SUNDAY = 7,
+}
+
+/**
+ * Describes the enum schedula.v1.Weekday.
+ */
+export const WeekdaySchema: GenEnum<Weekday> = /*@__PURE__*/
+  enumDesc(file_proto_schedula_v1_appointments, 0);
+
+/**
+ * @generated from enum schedula.v1.AttendanceStatus
+ */
+export enum AttendanceStatus {
+  /**
+   * @generated from enum value: ATTENDANCE_STATUS_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * @generated from enum value: ATTENDANCE_STATUS_ATTENDED = 1;
+   */
+  ATTENDED = 1,
+
+  /**
+   * @generated from enum value: ATTENDANCE_STATUS_MISSED = 2;
+   */
+  MISSED = 2,
+}
+
+/**
+ * Describes the enum schedula.v1.AttendanceStatus.
+ */
+export const AttendanceStatusSchema: GenEnum<AttendanceStatus> = /*@__PURE__*/
+  enumDesc(file_proto_schedula_v1_appointments, 1);
+
+/**
+ * @generated from enum schedula.v1.AppointmentLinkKind
+ */
+export enum AppointmentLinkKind {
+  /**
+   * @generated from enum value: APPOINTMENT_LINK_KIND_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * @generated from enum value: APPOINTMENT_LINK_KIND_FOLLOW_UP_OF = 1;
+   */
+  FOLLOW_UP_OF = 1,
+
+  /**
+   * @generated from enum value: APPOINTMENT_LINK_KIND_PREP_FOR = 2;
+   */
+  PREP_FOR = 2,
+}
+
+/**
+ * Describes the enum schedula.v1.AppointmentLinkKind.
+ */
+export const AppointmentLinkKindSchema: GenEnum<AppointmentLinkKind> = /*@__PURE__*/
+  enumDesc(file_proto_schedula_v1_appointments, 2);
+
+/**
+ * @generated from enum schedula.v1.DstGapPolicy
+ */
+export enum DstGapPolicy {
+  /**
+   * @generated from enum value: DST_GAP_POLICY_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * @generated from enum value: DST_GAP_POLICY_SHIFT_FORWARD = 1;
+   */
+  SHIFT_FORWARD = 1,
+
+  /**
+   * @generated from enum value: DST_GAP_POLICY_SKIP = 2;
+   */
+  SKIP = 2,
+}
+
+/**
+ * Describes the enum schedula.v1.DstGapPolicy.
+ */
+export const DstGapPolicySchema: GenEnum<DstGapPolicy> = /*@__PURE__*/
+  enumDesc(file_proto_schedula_v1_appointments, 3);
+
+/**
+ * @generated from enum schedula.v1.DstAmbiguousPolicy
+ */
+export enum DstAmbiguousPolicy {
+  /**
+   * @generated from enum value: DST_AMBIGUOUS_POLICY_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * @generated from enum value: DST_AMBIGUOUS_POLICY_EARLIER = 1;
+   */
+  EARLIER = 1,
+
+  /**
+   * @generated from enum value: DST_AMBIGUOUS_POLICY_LATER = 2;
+   */
+  LATER = 2,
+}
+
+/**
+ * Describes the enum schedula.v1.DstAmbiguousPolicy.
+ */
+export const DstAmbiguousPolicySchema: GenEnum<DstAmbiguousPolicy> = /*@__PURE__*/
+  enumDesc(file_proto_schedula_v1_appointments, 4);
+
+/**
+ * @generated from enum schedula.v1.AppointmentKind
+ */
+export enum AppointmentKind {
+  /**
+   * @generated from enum value: APPOINTMENT_KIND_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * @generated from enum value: APPOINTMENT_KIND_EVENT = 1;
+   */
+  EVENT = 1,
+
+  /**
+   * @generated from enum value: APPOINTMENT_KIND_MILESTONE = 2;
+   */
+  MILESTONE = 2,
+}
+
+/**
+ * Describes the enum schedula.v1.AppointmentKind.
+ */
+export const AppointmentKindSchema: GenEnum<AppointmentKind> = /*@__PURE__*/
+  enumDesc(file_proto_schedula_v1_appointments, 5);
+
+/**
+ * @generated from enum schedula.v1.TimeZoneKeep
+ */
+export enum TimeZoneKeep {
+  /**
+   * @generated from enum value: TIME_ZONE_KEEP_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * @generated from enum value: TIME_ZONE_KEEP_WALL_TIME = 1;
+   */
+  WALL_TIME = 1,
+
+  /**
+   * @generated from enum value: TIME_ZONE_KEEP_INSTANT = 2;
+   */
+  INSTANT = 2,
+}
+
+/**
+ * Describes the enum schedula.v1.TimeZoneKeep.
+ */
+export const TimeZoneKeepSchema: GenEnum<TimeZoneKeep> = /*@__PURE__*/
+  enumDesc(file_proto_schedula_v1_appointments, 6);
+
+/**
+ * @generated from enum schedula.v1.IntervalBounds
+ */
+export enum IntervalBounds {
+  /**
+   * @generated from enum value: INTERVAL_BOUNDS_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * @generated from enum value: INTERVAL_BOUNDS_START_INCLUSIVE_END_EXCLUSIVE = 1;
+   */
+  START_INCLUSIVE_END_EXCLUSIVE = 1,
+}
+
+/**
+ * Describes the enum schedula.v1.IntervalBounds.
+ */
+export const IntervalBoundsSchema: GenEnum<IntervalBounds> = /*@__PURE__*/
+  enumDesc(file_proto_schedula_v1_appointments, 7);
+
+/**
+ * @generated from enum schedula.v1.ConflictCause
+ */
+export enum ConflictCause {
+  /**
+   * @generated from enum value: CONFLICT_CAUSE_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * @generated from enum value: CONFLICT_CAUSE_OVERLAP = 1;
+   */
+  OVERLAP = 1,
+
+  /**
+   * @generated from enum value: CONFLICT_CAUSE_EXTERNAL = 2;
+   */
+  EXTERNAL = 2,
+
+  /**
+   * @generated from enum value: CONFLICT_CAUSE_OVERRIDE = 3;
+   */
+  OVERRIDE = 3,
+}
+
+/**
+ * Describes the enum schedula.v1.ConflictCause.
+ */
+export const ConflictCauseSchema: GenEnum<ConflictCause> = /*@__PURE__*/
+  enumDesc(file_proto_schedula_v1_appointments, 8);
+
+/**
+ * @generated from enum schedula.v1.ProposalStatus
+ */
+export enum ProposalStatus {
+  /**
+   * @generated from enum value: PROPOSAL_STATUS_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * @generated from enum value: PROPOSAL_STATUS_PENDING = 1;
+   */
+  PENDING = 1,
+
+  /**
+   * @generated from enum value: PROPOSAL_STATUS_ACCEPTED = 2;
+   */
+  ACCEPTED = 2,
+
+  /**
+   * @generated from enum value: PROPOSAL_STATUS_DECLINED = 3;
+   */
+  DECLINED = 3,
+
+  /**
+   * @generated from enum value: PROPOSAL_STATUS_EXPIRED = 4;
+   */
+  EXPIRED = 4,
+}
+
+/**
+ * Describes the enum schedula.v1.ProposalStatus.
+ */
+export const ProposalStatusSchema: GenEnum<ProposalStatus> = /*@__PURE__*/
+  enumDesc(file_proto_schedula_v1_appointments, 9);
+
+/**
+ * @generated from enum schedula.v1.SeriesFindingKind
+ */
+export enum SeriesFindingKind {
+  /**
+   * @generated from enum value: SERIES_FINDING_KIND_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * @generated from enum value: SERIES_FINDING_KIND_INVALID_TIME_ZONE = 1;
+   */
+  INVALID_TIME_ZONE = 1,
+
+  /**
+   * @generated from enum value: SERIES_FINDING_KIND_INVALID_RULE = 2;
+   */
+  INVALID_RULE = 2,
+
+  /**
+   * @generated from enum value: SERIES_FINDING_KIND_EXCEPTION_OUTSIDE_SERIES = 3;
+   */
+  EXCEPTION_OUTSIDE_SERIES = 3,
+
+  /**
+   * @generated from enum value: SERIES_FINDING_KIND_EXCEPTION_OFF_PATTERN = 4;
+   */
+  EXCEPTION_OFF_PATTERN = 4,
+
+  /**
+   * @generated from enum value: SERIES_FINDING_KIND_INVALID_OVERRIDE = 5;
+   */
+  INVALID_OVERRIDE = 5,
+}
+
+/**
+ * Describes the enum schedula.v1.SeriesFindingKind.
+ */
+export const SeriesFindingKindSchema: GenEnum<SeriesFindingKind> = /*@__PURE__*/
+  enumDesc(file_proto_schedula_v1_appointments, 10);
+
+/**
+ * @generated from enum schedula.v1.ChangeEntity
+ */
+export enum ChangeEntity {
+  /**
+   * @generated from enum value: CHANGE_ENTITY_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * @generated from enum value: CHANGE_ENTITY_APPOINTMENT = 1;
+   */
+  APPOINTMENT = 1,
+
+  /**
+   * @generated from enum value: CHANGE_ENTITY_SERIES = 2;
+   */
+  SERIES = 2,
+
+  /**
+   * @generated from enum value: CHANGE_ENTITY_HOLD = 3;
+   */
+  HOLD = 3,
+
+  /**
+   * @generated from enum value: CHANGE_ENTITY_PROPOSAL = 4;
+   */
+  PROPOSAL = 4,
+}
+
+/**
+ * Describes the enum schedula.v1.ChangeEntity.
+ */
+export const ChangeEntitySchema: GenEnum<ChangeEntity> = /*@__PURE__*/
+  enumDesc(file_proto_schedula_v1_appointments, 11);
+
+/**
+ * @generated from enum schedula.v1.ChangeOp
+ */
+export enum ChangeOp {
+  /**
+   * @generated from enum value: CHANGE_OP_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * @generated from enum value: CHANGE_OP_CREATED = 1;
+   */
+  CREATED = 1,
+
+  /**
+   * @generated from enum value: CHANGE_OP_UPDATED = 2;
+   */
+  UPDATED = 2,
+
+  /**
+   * @generated from enum value: CHANGE_OP_DELETED = 3;
+   */
+  DELETED = 3,
+
+  /**
+   * @generated from enum value: CHANGE_OP_EXPIRED = 4;
+   */
+  EXPIRED = 4,
+}
+
+/**
+ * Describes the enum schedula.v1.ChangeOp.
+ */
+export const ChangeOpSchema: GenEnum<ChangeOp> = /*@__PURE__*/
+  enumDesc(file_proto_schedula_v1_appointments, 12);
+
+/**
+ * @generated from enum schedula.v1.BillablePeriod
+ */
+export enum BillablePeriod {
+  /**
+   * @generated from enum value: BILLABLE_PERIOD_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * @generated from enum value: BILLABLE_PERIOD_WEEK = 1;
+   */
+  WEEK = 1,
+
+  /**
+   * @generated from enum value: BILLABLE_PERIOD_MONTH = 2;
+   */
+  MONTH = 2,
+}
+
+/**
+ * Describes the enum schedula.v1.BillablePeriod.
+ */
+export const BillablePeriodSchema: GenEnum<BillablePeriod> = /*@__PURE__*/
+  enumDesc(file_proto_schedula_v1_appointments, 13);
+
+/**
+ * @generated from enum schedula.v1.BillableFormat
+ */
+export enum BillableFormat {
+  /**
+   * @generated from enum value: BILLABLE_FORMAT_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * @generated from enum value: BILLABLE_FORMAT_CSV = 1;
+   */
+  CSV = 1,
+
+  /**
+   * @generated from enum value: BILLABLE_FORMAT_JSON = 2;
+   */
+  JSON = 2,
+}
+
+/**
+ * Describes the enum schedula.v1.BillableFormat.
+ */
+export const BillableFormatSchema: GenEnum<BillableFormat> = /*@__PURE__*/
+  enumDesc(file_proto_schedula_v1_appointments, 14);
+
+/**
+ * @generated from enum schedula.v1.MutationKind
+ */
+export enum MutationKind {
+  /**
+   * @generated from enum value: MUTATION_KIND_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * @generated from enum value: MUTATION_KIND_CREATE = 1;
+   */
+  CREATE = 1,
+
+  /**
+   * @generated from enum value: MUTATION_KIND_UPDATE = 2;
+   */
+  UPDATE = 2,
+
+  /**
+   * @generated from enum value: MUTATION_KIND_DELETE = 3;
+   */
+  DELETE = 3,
+}
+
+/**
+ * Describes the enum schedula.v1.MutationKind.
+ */
+export const MutationKindSchema: GenEnum<MutationKind> = /*@__PURE__*/
+  enumDesc(file_proto_schedula_v1_appointments, 15);
+
+/**
+ * @generated from enum schedula.v1.MutationStatus
+ */
+export enum MutationStatus {
+  /**
+   * @generated from enum value: MUTATION_STATUS_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * @generated from enum value: MUTATION_STATUS_ACCEPTED = 1;
+   */
+  ACCEPTED = 1,
+
+  /**
+   * @generated from enum value: MUTATION_STATUS_CONFLICTED = 2;
+   */
+  CONFLICTED = 2,
+
+  /**
+   * @generated from enum value: MUTATION_STATUS_REJECTED = 3;
+   */
+  REJECTED = 3,
+}
+
+/**
+ * Describes the enum schedula.v1.MutationStatus.
+ */
+export const MutationStatusSchema: GenEnum<MutationStatus> = /*@__PURE__*/
+  enumDesc(file_proto_schedula_v1_appointments, 16);
+
+/**
+ * @generated from enum schedula.v1.MutationConflict
+ */
+export enum MutationConflict {
+  /**
+   * @generated from enum value: MUTATION_CONFLICT_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * @generated from enum value: MUTATION_CONFLICT_VERSION = 1;
+   */
+  VERSION = 1,
+
+  /**
+   * @generated from enum value: MUTATION_CONFLICT_DELETED = 2;
+   */
+  DELETED = 2,
+
+  /**
+   * @generated from enum value: MUTATION_CONFLICT_EXISTS = 3;
+   */
+  EXISTS = 3,
+
+  /**
+   * @generated from enum value: MUTATION_CONFLICT_OVERLAP = 4;
+   */
+  OVERLAP = 4,
+}
+
+/**
+ * Describes the enum schedula.v1.MutationConflict.
+ */
+export const MutationConflictSchema: GenEnum<MutationConflict> = /*@__PURE__*/
+  enumDesc(file_proto_schedula_v1_appointments, 17);
+
+/**
+ * @generated from service schedula.v1.AppointmentsService
+ */
+export const AppointmentsService: GenService<{
+  /**
+   * @generated from rpc schedula.v1.AppointmentsService.CreateAppointment
+   */
+  createAppointment: {
+    methodKind: "unary";
+    input: typeof CreateAppointmentRequestSchema;
+    output: typeof CreateAppointmentResponseSchema;
+  },
+  /**
+   * @generated from rpc schedula.v1.AppointmentsService.ListAppointments
+   */
+  listAppointments: {
+    methodKind: "unary";
+    input: typeof ListAppointmentsRequestSchema;
+    output: typeof ListAppointmentsResponseSchema;
+  },
+  /**
+   * @generated from rpc schedula.v1.AppointmentsService.DeleteAppointment
+   */
+  deleteAppointment: {
+    methodKind: "unary";
+    input: typeof DeleteAppointmentRequestSchema;
+    output: typeof DeleteAppointmentResponseSchema;
+  },
+  /**
+   * @generated from rpc schedula.v1.AppointmentsService.CreateRecurringSeries
+   */
+  createRecurringSeries: {
+    methodKind: "unary";
+    input: typeof CreateRecurringSeriesRequestSchema;
+    output: typeof CreateRecurringSeriesResponseSchema;
+  },
+  /**
+   * @generated from rpc schedula.v1.AppointmentsService.ListOccurrences
+   */
+  listOccurrences: {
+    methodKind: "unary";
+    input: typeof ListOccurrencesRequestSchema;
+    output: typeof ListOccurrencesResponseSchema;
+  },
+  /**
+   * @generated from rpc schedula.v1.AppointmentsService.GetRecurringSeries
+   */
+  getRecurringSeries: {
+    methodKind: "unary";
+    input: typeof GetRecurringSeriesRequestSchema;
+    output: typeof GetRecurringSeriesResponseSchema;
+  },
+  /**
+   * @generated from rpc schedula.v1.AppointmentsService.ListRecurringSeries
+   */
+  listRecurringSeries: {
+    methodKind: "unary";
+    input: typeof ListRecurringSeriesRequestSchema;
+    output: typeof ListRecurringSeriesResponseSchema;
+  },
+  /**
+   * @generated from rpc schedula.v1.AppointmentsService.MarkAttendance
+   */
+  markAttendance: {
+    methodKind: "unary";
+    input: typeof MarkAttendanceRequestSchema;
+    output: typeof MarkAttendanceResponseSchema;
+  },
+  /**
+   * @generated from rpc schedula.v1.AppointmentsService.GetAttendanceStats
+   */
+  getAttendanceStats: {
+    methodKind: "unary";
+    input: typeof GetAttendanceStatsRequestSchema;
+    output: typeof GetAttendanceStatsResponseSchema;
+  },
+  /**
+   * @generated from rpc schedula.v1.AppointmentsService.GetLimits
+   */
+  getLimits: {
+    methodKind: "unary";
+    input: typeof GetLimitsRequestSchema;
+    output: typeof GetLimitsResponseSchema;
+  },
+  /**
+   * @generated from rpc schedula.v1.AppointmentsService.GetAppointmentByExternalRef
+   */
+  getAppointmentByExternalRef: {
+    methodKind: "unary";
+    input: typeof GetAppointmentByExternalRefRequestSchema;
+    output: typeof GetAppointmentByExternalRefResponseSchema;
+  },
+  /**
+   * @generated from rpc schedula.v1.AppointmentsService.GetAnalytics
+   */
+  getAnalytics: {
+    methodKind: "unary";
+    input: typeof GetAnalyticsRequestSchema;
+    output: typeof GetAnalyticsResponseSchema;
+  },
+  /**
+   * @generated from rpc schedula.v1.AppointmentsService.SuggestEndTime
+   */
+  suggestEndTime: {
+    methodKind: "unary";
+    input: typeof SuggestEndTimeRequestSchema;
+    output: typeof SuggestEndTimeResponseSchema;
+  },
+  /**
+   * @generated from rpc schedula.v1.AppointmentsService.ReserveSlot
+   */
+  reserveSlot: {
+    methodKind: "unary";
+    input: typeof ReserveSlotRequestSchema;
+    output: typeof ReserveSlotResponseSchema;
+  },
+  /**
+   * @generated from rpc schedula.v1.AppointmentsService.ConfirmHold
+   */
+  confirmHold: {
+    methodKind: "unary";
+    input: typeof ConfirmHoldRequestSchema;
+    output: typeof ConfirmHoldResponseSchema;
+  },
+  /**
+   * @generated from rpc schedula.v1.AppointmentsService.ReleaseHold
+   */
+  releaseHold: {
+    methodKind: "unary";
+    input: typeof ReleaseHoldRequestSchema;
+    output: typeof ReleaseHoldResponseSchema;
+  },
+  /**
+   * @generated from rpc schedula.v1.AppointmentsService.ProposeAppointment
+   */
+  proposeAppointment: {
+    methodKind: "unary";
+    input: typeof ProposeAppointmentRequestSchema;
+    output: typeof ProposeAppointmentResponseSchema;
+  },
+  /**
+   * @generated from rpc schedula.v1.AppointmentsService.ListProposals
+   */
+  listProposals: {
+    methodKind: "unary";
+    input: typeof ListProposalsRequestSchema;
+    output: typeof ListProposalsResponseSchema;
+  },
+  /**
+   * @generated from rpc schedula.v1.AppointmentsService.AcceptProposal
+   */
+  acceptProposal: {
+    methodKind: "unary";
+    input: typeof AcceptProposalRequestSchema;
+    output: typeof AcceptProposalResponseSchema;
+  },
+  /**
+   * @generated from rpc schedula.v1.AppointmentsService.DeclineProposal
+   */
+  declineProposal: {
+    methodKind: "unary";
+    input: typeof DeclineProposalRequestSchema;
+    output: typeof DeclineProposalResponseSchema;
+  },
+  /**
+   * @generated from rpc schedula.v1.AppointmentsService.LinkAppointments
+   */
+  linkAppointments: {
+    methodKind: "unary";
+    input: typeof LinkAppointmentsRequestSchema;
+    output: typeof LinkAppointmentsResponseSchema;
+  },
+  /**
+   * @generated from rpc schedula.v1.AppointmentsService.UnlinkAppointments
+   */
+  unlinkAppointments: {
+    methodKind: "unary";
+    input: typeof UnlinkAppointmentsRequestSchema;
+    output: typeof UnlinkAppointmentsResponseSchema;
+  },
+  /**
+   * @generated from rpc schedula.v1.AppointmentsService.ListRelated
+   */
+  listRelated: {
+    methodKind: "unary";
+    input: typeof ListRelatedRequestSchema;
+    output: typeof ListRelatedResponseSchema;
+  },
+  /**
+   * @generated from rpc schedula.v1.AppointmentsService.MergeAppointments
+   */
+  mergeAppointments: {
+    methodKind: "unary";
+    input: typeof MergeAppointmentsRequestSchema;
+    output: typeof MergeAppointmentsResponseSchema;
+  },
+  /**
+   * @generated from rpc schedula.v1.AppointmentsService.BatchGetFreeBusy
+   */
+  batchGetFreeBusy: {
+    methodKind: "unary";
+    input: typeof BatchGetFreeBusyRequestSchema;
+    output: typeof BatchGetFreeBusyResponseSchema;
+  },
+  /**
+   * @generated from rpc schedula.v1.AppointmentsService.SuggestMeetingTimes
+   */
+  suggestMeetingTimes: {
+    methodKind: "unary";
+    input: typeof SuggestMeetingTimesRequestSchema;
+    output: typeof SuggestMeetingTimesResponseSchema;
+  },
+  /**
+   * @generated from rpc schedula.v1.AppointmentsService.RepairRecurringSeries
+   */
+  repairRecurringSeries: {
+    methodKind: "unary";
+    input: typeof RepairRecurringSeriesRequestSchema;
+    output: typeof RepairRecurringSeriesResponseSchema;
+  },
+  /**
+   * @generated from rpc schedula.v1.AppointmentsService.SkipOccurrences
+   */
+  skipOccurrences: {
+    methodKind: "unary";
+    input: typeof SkipOccurrencesRequestSchema;
+    output: typeof SkipOccurrencesResponseSchema;
+  },
+  /**
+   * @generated from rpc schedula.v1.AppointmentsService.UpdateSeriesEnd
+   */
+  updateSeriesEnd: {
+    methodKind: "unary";
+    input: typeof UpdateSeriesEndRequestSchema;
+    output: typeof UpdateSeriesEndResponseSchema;
+  },
+  /**
+   * @generated from rpc schedula.v1.AppointmentsService.ChangeSeriesTimeZone
+   */
+  changeSeriesTimeZone: {
+    methodKind: "unary";
+    input: typeof ChangeSeriesTimeZoneRequestSchema;
+    output: typeof ChangeSeriesTimeZoneResponseSchema;
+  },
+  /**
+   * @generated from rpc schedula.v1.AppointmentsService.AuditCalendar
+   */
+  auditCalendar: {
+    methodKind: "unary";
+    input: typeof AuditCalendarRequestSchema;
+    output: typeof AuditCalendarResponseSchema;
+  },
+  /**
+   * @generated from rpc schedula.v1.AppointmentsService.GetDailyAgenda
+   */
+  getDailyAgenda: {
+    methodKind: "unary";
+    input: typeof GetDailyAgendaRequestSchema;
+    output: typeof GetDailyAgendaResponseSchema;
+  },
+  /**
+   * @generated from rpc schedula.v1.AppointmentsService.GetDaySummaries
+   */
+  getDaySummaries: {
+    methodKind: "unary";
+    input: typeof GetDaySummariesRequestSchema;
+    output: typeof GetDaySummariesResponseSchema;
+  },
+  /**
+   * @generated from rpc schedula.v1.AppointmentsService.GrantDelegation
+   */
+  grantDelegation: {
+    methodKind: "unary";
+    input: typeof GrantDelegationRequestSchema;
+    output: typeof GrantDelegationResponseSchema;
+  },
+  /**
+   * @generated from rpc schedula.v1.AppointmentsService.RevokeDelegation
+   */
+  revokeDelegation: {
+    methodKind: "unary";
+    input: typeof RevokeDelegationRequestSchema;
+    output: typeof RevokeDelegationResponseSchema;
+  },
+  /**
+   * @generated from rpc schedula.v1.AppointmentsService.ListDelegations
+   */
+  listDelegations: {
+    methodKind: "unary";
+    input: typeof ListDelegationsRequestSchema;
+    output: typeof ListDelegationsResponseSchema;
+  },
+  /**
+   * @generated from rpc schedula.v1.AppointmentsService.ExportCalendar
+   */
+  exportCalendar: {
+    methodKind: "unary";
+    input: typeof ExportCalendarRequestSchema;
+    output: typeof ExportCalendarResponseSchema;
+  },
+  /**
+   * @generated from rpc schedula.v1.AppointmentsService.WatchOccurrences
+   */
+  watchOccurrences: {
+    methodKind: "server_streaming";
+    input: typeof WatchOccurrencesRequestSchema;
+    output: typeof WatchOccurrencesResponseSchema;
+  },
+  /**
+   * @generated from rpc schedula.v1.AppointmentsService.ListChanges
+   */
+  listChanges: {
+    methodKind: "unary";
+    input: typeof ListChangesRequestSchema;
+    output: typeof ListChangesResponseSchema;
+  },
+  /**
+   * @generated from rpc schedula.v1.AppointmentsService.ImportCalendar
+   */
+  importCalendar: {
+    methodKind: "unary";
+    input: typeof ImportCalendarRequestSchema;
+    output: typeof ImportCalendarResponseSchema;
+  },
+  /**
+   * @generated from rpc schedula.v1.AppointmentsService.ReconcileCalendar
+   */
+  reconcileCalendar: {
+    methodKind: "unary";
+    input: typeof ReconcileCalendarRequestSchema;
+    output: typeof ReconcileCalendarResponseSchema;
+  },
+  /**
+   * @generated from rpc schedula.v1.AppointmentsService.CheckIn
+   */
+  checkIn: {
+    methodKind: "unary";
+    input: typeof CheckInRequestSchema;
+    output: typeof CheckInResponseSchema;
+  },
+  /**
+   * @generated from rpc schedula.v1.AppointmentsService.CheckOut
+   */
+  checkOut: {
+    methodKind: "unary";
+    input: typeof CheckOutRequestSchema;
+    output: typeof CheckOutResponseSchema;
+  },
+  /**
+   * @generated from rpc schedula.v1.AppointmentsService.ExportBillableHours
+   */
+  exportBillableHours: {
+    methodKind: "unary";
+    input: typeof ExportBillableHoursRequestSchema;
+    output: typeof ExportBillableHoursResponseSchema;
+  },
+  /**
+   * @generated from rpc schedula.v1.AppointmentsService.CreateContact
+   */
+  createContact: {
+    methodKind: "unary";
+    input: typeof CreateContactRequestSchema;
+    output: typeof CreateContactResponseSchema;
+  },
+  /**
+   * @generated from rpc schedula.v1.AppointmentsService.GetContact
+   */
+  getContact: {
+    methodKind: "unary";
+    input: typeof GetContactRequestSchema;
+    output: typeof GetContactResponseSchema;
+  },
+  /**
+   * @generated from rpc schedula.v1.AppointmentsService.UpdateContact
+   */
+  updateContact: {
+    methodKind: "unary";
+    input: typeof UpdateContactRequestSchema;
+    output: typeof UpdateContactResponseSchema;
+  },
+  /**
+   * @generated from rpc schedula.v1.AppointmentsService.DeleteContact
+   */
+  deleteContact: {
+    methodKind: "unary";
+    input: typeof DeleteContactRequestSchema;
+    output: typeof DeleteContactResponseSchema;
+  },
+  /**
+   * @generated from rpc schedula.v1.AppointmentsService.ListContacts
+   */
+  listContacts: {
+    methodKind: "unary";
+    input: typeof ListContactsRequestSchema;
+    output: typeof ListContactsResponseSchema;
+  },
+  /**
+   * @generated from rpc schedula.v1.AppointmentsService.CreateProgram
+   */
+  createProgram: {
+    methodKind: "unary";
+    input: typeof CreateProgramRequestSchema;
+    output: typeof CreateProgramResponseSchema;
+  },
+  /**
+   * @generated from rpc schedula.v1.AppointmentsService.GetProgram
+   */
+  getProgram: {
+    methodKind: "unary";
+    input: typeof GetProgramRequestSchema;
+    output: typeof GetProgramResponseSchema;
+  },
+  /**
+   * @generated from rpc schedula.v1.AppointmentsService.ListPrograms
+   */
+  listPrograms: {
+    methodKind: "unary";
+    input: typeof ListProgramsRequestSchema;
+    output: typeof ListProgramsResponseSchema;
+  },
+  /**
+   * @generated from rpc schedula.v1.AppointmentsService.CancelProgram
+   */
+  cancelProgram: {
+    methodKind: "unary";
+    input: typeof CancelProgramRequestSchema;
+    output: typeof CancelProgramResponseSchema;
+  },
+  /**
+   * @generated from rpc schedula.v1.AppointmentsService.CreateEmbedToken
+   */
+  createEmbedToken: {
+    methodKind: "unary";
+    input: typeof CreateEmbedTokenRequestSchema;
+    output: typeof CreateEmbedTokenResponseSchema;
+  },
+  /**
+   * @generated from rpc schedula.v1.AppointmentsService.GetSlotSettings
+   */
+  getSlotSettings: {
+    methodKind: "unary";
+    input: typeof GetSlotSettingsRequestSchema;
+    output: typeof GetSlotSettingsResponseSchema;
+  },
+  /**
+   * @generated from rpc schedula.v1.AppointmentsService.UpdateSlotSettings
+   */
+  updateSlotSettings: {
+    methodKind: "unary";
+    input: typeof UpdateSlotSettingsRequestSchema;
+    output: typeof UpdateSlotSettingsResponseSchema;
+  },
+  /**
+   * @generated from rpc schedula.v1.AppointmentsService.UpdateDailyBreaks
+   */
+  updateDailyBreaks: {
+    methodKind: "unary";
+    input: typeof UpdateDailyBreaksRequestSchema;
+    output: typeof UpdateDailyBreaksResponseSchema;
+  },
+  /**
+   * @generated from rpc schedula.v1.AppointmentsService.CreateTimeOff
+   */
+  createTimeOff: {
+    methodKind: "unary";
+    input: typeof CreateTimeOffRequestSchema;
+    output: typeof CreateTimeOffResponseSchema;
+  },
+  /**
+   * @generated from rpc schedula.v1.AppointmentsService.GetTimeOff
+   */
+  getTimeOff: {
+    methodKind: "unary";
+    input: typeof GetTimeOffRequestSchema;
+    output: typeof GetTimeOffResponseSchema;
+  },
+  /**
+   * @generated from rpc schedula.v1.AppointmentsService.UpdateTimeOff
+   */
+  updateTimeOff: {
+    methodKind: "unary";
+    input: typeof UpdateTimeOffRequestSchema;
+    output: typeof UpdateTimeOffResponseSchema;
+  },
+  /**
+   * @generated from rpc schedula.v1.AppointmentsService.DeleteTimeOff
+   */
+  deleteTimeOff: {
+    methodKind: "unary";
+    input: typeof DeleteTimeOffRequestSchema;
+    output: typeof DeleteTimeOffResponseSchema;
+  },
+  /**
+   * @generated from rpc schedula.v1.AppointmentsService.ListTimeOff
+   */
+  listTimeOff: {
+    methodKind: "unary";
+    input: typeof ListTimeOffRequestSchema;
+    output: typeof ListTimeOffResponseSchema;
+  },
+  /**
+   * @generated from rpc schedula.v1.AppointmentsService.SimulateSchedule
+   */
+  simulateSchedule: {
+    methodKind: "unary";
+    input: typeof SimulateScheduleRequestSchema;
+    output: typeof SimulateScheduleResponseSchema;
+  },
+  /**
+   * @generated from rpc schedula.v1.AppointmentsService.CreateCalendarSnapshot
+   */
+  createCalendarSnapshot: {
+    methodKind: "unary";
+    input: typeof CreateCalendarSnapshotRequestSchema;
+    output: typeof CreateCalendarSnapshotResponseSchema;
+  },
+  /**
+   * @generated from rpc schedula.v1.AppointmentsService.ListCalendarSnapshots
+   */
+  listCalendarSnapshots: {
+    methodKind: "unary";
+    input: typeof ListCalendarSnapshotsRequestSchema;
+    output: typeof ListCalendarSnapshotsResponseSchema;
+  },
+  /**
+   * @generated from rpc schedula.v1.AppointmentsService.RestoreCalendarSnapshot
+   */
+  restoreCalendarSnapshot: {
+    methodKind: "unary";
+    input: typeof RestoreCalendarSnapshotRequestSchema;
+    output: typeof RestoreCalendarSnapshotResponseSchema;
   },
 }> = /*@__PURE__*/
   serviceDesc(file_proto_schedula_v1_appointments, 0);
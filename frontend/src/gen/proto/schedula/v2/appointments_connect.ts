@@ -0,0 +1,35 @@
+// @generated by protoc-gen-connect-es v1.6.1 with parameter "target=ts,import_extension=.js"
+// @generated from file proto/schedula/v2/appointments.proto (package schedula.v2, syntax proto3)
+/* eslint-disable */
+// @ts-nocheck
+
+import { DeleteAppointmentRequest, DeleteAppointmentResponse, ListAppointmentsRequest, ListAppointmentsResponse } from "./appointments_pb.js";
+import { MethodKind } from "@bufbuild/protobuf";
+
+/**
+ * @generated from service schedula.v2.AppointmentsService
+ */
+export const AppointmentsService = {
+  typeName: "schedula.v2.AppointmentsService",
+  methods: {
+    /**
+     * @generated from rpc schedula.v2.AppointmentsService.ListAppointments
+     */
+    listAppointments: {
+      name: "ListAppointments",
+      I: ListAppointmentsRequest,
+      O: ListAppointmentsResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc schedula.v2.AppointmentsService.DeleteAppointment
+     */
+    deleteAppointment: {
+      name: "DeleteAppointment",
+      I: DeleteAppointmentRequest,
+      O: DeleteAppointmentResponse,
+      kind: MethodKind.Unary,
+    },
+  }
+} as const;
+
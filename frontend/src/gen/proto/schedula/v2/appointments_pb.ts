@@ -0,0 +1,303 @@
+// @generated by protoc-gen-es v2.11.0 with parameter "target=ts,import_extension=.js"
+// @generated from file proto/schedula/v2/appointments.proto (package schedula.v2, syntax proto3)
+/* eslint-disable */
+
+import type { GenEnum, GenFile, GenMessage, GenService } from "@bufbuild/protobuf/codegenv2";
+import { enumDesc, fileDesc, messageDesc, serviceDesc } from "@bufbuild/protobuf/codegenv2";
+import type { Timestamp } from "@bufbuild/protobuf/wkt";
+import { file_google_protobuf_timestamp } from "@bufbuild/protobuf/wkt";
+import type { Message } from "@bufbuild/protobuf";
+
+/**
+ * Describes the file proto/schedula/v2/appointments.proto.
+ */
+export const file_proto_schedula_v2_appointments: GenFile = /*@__PURE__*/
+  fileDesc("CiRwcm90by9zY2hlZHVsYS92Mi9hcHBvaW50bWVudHMucHJvdG8SC3NjaGVkdWxhLnYyIikKC0V4dGVybmFsUmVmEg4KBnN5c3RlbRgBIAEoCRIKCgJpZBgCIAEoCSKsBQoLQXBwb2ludG1lbnQSCgoCaWQYASABKAkSDwoHdXNlcl9pZBgCIAEoCRINCgV0aXRsZRgDIAEoCRINCgVub3RlcxgEIAEoCRIuCgpzdGFydF90aW1lGAUgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIsCghlbmRfdGltZRgGIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASLwoLY3JlYXRlX3RpbWUYByABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEi8KC3VwZGF0ZV90aW1lGAggASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBI4CghtZXRhZGF0YRgJIAMoCzImLnNjaGVkdWxhLnYyLkFwcG9pbnRtZW50Lk1ldGFkYXRhRW50cnkSLgoMZXh0ZXJuYWxfcmVmGAogASgLMhguc2NoZWR1bGEudjIuRXh0ZXJuYWxSZWYSEQoJdGltZV96b25lGAsgASgJEhIKCmNyZWF0ZWRfYnkYDCABKAkSMQoNY2hlY2tfaW5fdGltZRgNIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASMgoOY2hlY2tfb3V0X3RpbWUYDiABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEhIKCmNvbnRhY3RfaWQYDyABKAkSDgoGc291cmNlGBAgASgJEioKBGtpbmQYESABKA4yHC5zY2hlZHVsYS52Mi5BcHBvaW50bWVudEtpbmQSFQoNcHJpdmF0ZV9ub3RlcxgSIAEoCRISCgpwcm9ncmFtX2lkGBMgASgJGi8KDU1ldGFkYXRhRW50cnkSCwoDa2V5GAEgASgJEg0KBXZhbHVlGAIgASgJOgI4ASLXAQoXTGlzdEFwcG9pbnRtZW50c1JlcXVlc3QSDwoHdXNlcl9pZBgBIAEoCRIwCgx3aW5kb3dfc3RhcnQYAiABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEi4KCndpbmRvd19lbmQYAyABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEhEKCXBhZ2Vfc2l6ZRgEIAEoBRISCgpwYWdlX3Rva2VuGAUgASgJEhIKCmNvbnRhY3RfaWQYBiABKAkSDgoGc291cmNlGAcgASgJImMKGExpc3RBcHBvaW50bWVudHNSZXNwb25zZRIuCgxhcHBvaW50bWVudHMYASADKAsyGC5zY2hlZHVsYS52Mi5BcHBvaW50bWVudBIXCg9uZXh0X3BhZ2VfdG9rZW4YAiABKAkiVQoYRGVsZXRlQXBwb2ludG1lbnRSZXF1ZXN0Eg8KB3VzZXJfaWQYASABKAkSFgoOYXBwb2ludG1lbnRfaWQYAiABKAkSEAoIYWN0b3JfaWQYAyABKAkiGwoZRGVsZXRlQXBwb2ludG1lbnRSZXNwb25zZSpvCg9BcHBvaW50bWVudEtpbmQSIAocQVBQT0lOVE1FTlRfS0lORF9VTlNQRUNJRklFRBAAEhoKFkFQUE9JTlRNRU5UX0tJTkRfRVZFTlQQARIeChpBUFBPSU5UTUVOVF9LSU5EX01JTEVTVE9ORRACMtoBChNBcHBvaW50bWVudHNTZXJ2aWNlEl8KEExpc3RBcHBvaW50bWVudHMSJC5zY2hlZHVsYS52Mi5MaXN0QXBwb2ludG1lbnRzUmVxdWVzdBolLnNjaGVkdWxhLnYyLkxpc3RBcHBvaW50bWVudHNSZXNwb25zZRJiChFEZWxldGVBcHBvaW50bWVudBIlLnNjaGVkdWxhLnYyLkRlbGV0ZUFwcG9pbnRtZW50UmVxdWVzdBomLnNjaGVkdWxhLnYyLkRlbGV0ZUFwcG9pbnRtZW50UmVzcG9uc2VCPFo6c2NoZWR1bGEvYmFja2VuZC9pbnRlcm5hbC9nZW4vcHJvdG8vc2NoZWR1bGEvdjI7c2NoZWR1bGV2MmIGcHJvdG8z", [file_google_protobuf_timestamp]);
+
+/**
+ * @generated from message schedula.v2.ExternalRef
+ */
+export type ExternalRef = Message<"schedula.v2.ExternalRef"> & {
+  /**
+   * @generated from field: string system = 1;
+   */
+  system: string;
+
+  /**
+   * @generated from field: string id = 2;
+   */
+  id: string;
+};
+
+/**
+ * Describes the message schedula.v2.ExternalRef.
+ * Use `create(ExternalRefSchema)` to create a new message.
+ */
+export const ExternalRefSchema: GenMessage<ExternalRef> = /*@__PURE__*/
+  messageDesc(file_proto_schedula_v2_appointments, 0);
+
+/**
+ * @generated from message schedula.v2.Appointment
+ */
+export type Appointment = Message<"schedula.v2.Appointment"> & {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id: string;
+
+  /**
+   * @generated from field: string user_id = 2;
+   */
+  userId: string;
+
+  /**
+   * @generated from field: string title = 3;
+   */
+  title: string;
+
+  /**
+   * @generated from field: string notes = 4;
+   */
+  notes: string;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp start_time = 5;
+   */
+  startTime?: Timestamp;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp end_time = 6;
+   */
+  endTime?: Timestamp;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp create_time = 7;
+   */
+  createTime?: Timestamp;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp update_time = 8;
+   */
+  updateTime?: Timestamp;
+
+  /**
+   * @generated from field: map<string, string> metadata = 9;
+   */
+  metadata: { [key: string]: string };
+
+  /**
+   * @generated from field: schedula.v2.ExternalRef external_ref = 10;
+   */
+  externalRef?: ExternalRef;
+
+  /**
+   * @generated from field: string time_zone = 11;
+   */
+  timeZone: string;
+
+  /**
+   * @generated from field: string created_by = 12;
+   */
+  createdBy: string;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp check_in_time = 13;
+   */
+  checkInTime?: Timestamp;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp check_out_time = 14;
+   */
+  checkOutTime?: Timestamp;
+
+  /**
+   * @generated from field: string contact_id = 15;
+   */
+  contactId: string;
+
+  /**
+   * @generated from field: string source = 16;
+   */
+  source: string;
+
+  /**
+   * @generated from field: schedula.v2.AppointmentKind kind = 17;
+   */
+  kind: AppointmentKind;
+
+  /**
+   * @generated from field: string private_notes = 18;
+   */
+  privateNotes: string;
+
+  /**
+   * @generated from field: string program_id = 19;
+   */
+  programId: string;
+};
+
+/**
+ * Describes the message schedula.v2.Appointment.
+ * Use `create(AppointmentSchema)` to create a new message.
+ */
+export const AppointmentSchema: GenMessage<Appointment> = /*@__PURE__*/
+  messageDesc(file_proto_schedula_v2_appointments, 1);
+
+/**
+ * @generated from message schedula.v2.ListAppointmentsRequest
+ */
+export type ListAppointmentsRequest = Message<"schedula.v2.ListAppointmentsRequest"> & {
+  /**
+   * @generated from field: string user_id = 1;
+   */
+  userId: string;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp window_start = 2;
+   */
+  windowStart?: Timestamp;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp window_end = 3;
+   */
+  windowEnd?: Timestamp;
+
+  /**
+   * @generated from field: int32 page_size = 4;
+   */
+  pageSize: number;
+
+  /**
+   * @generated from field: string page_token = 5;
+   */
+  pageToken: string;
+
+  /**
+   * @generated from field: string contact_id = 6;
+   */
+  contactId: string;
+
+  /**
+   * @generated from field: string source = 7;
+   */
+  source: string;
+};
+
+/**
+ * Describes the message schedula.v2.ListAppointmentsRequest.
+ * Use `create(ListAppointmentsRequestSchema)` to create a new message.
+ */
+export const ListAppointmentsRequestSchema: GenMessage<ListAppointmentsRequest> = /*@__PURE__*/
+  messageDesc(file_proto_schedula_v2_appointments, 2);
+
+/**
+ * @generated from message schedula.v2.ListAppointmentsResponse
+ */
+export type ListAppointmentsResponse = Message<"schedula.v2.ListAppointmentsResponse"> & {
+  /**
+   * @generated from field: repeated schedula.v2.Appointment appointments = 1;
+   */
+  appointments: Appointment[];
+
+  /**
+   * @generated from field: string next_page_token = 2;
+   */
+  nextPageToken: string;
+};
+
+/**
+ * Describes the message schedula.v2.ListAppointmentsResponse.
+ * Use `create(ListAppointmentsResponseSchema)` to create a new message.
+ */
+export const ListAppointmentsResponseSchema: GenMessage<ListAppointmentsResponse> = /*@__PURE__*/
+  messageDesc(file_proto_schedula_v2_appointments, 3);
+
+/**
+ * @generated from message schedula.v2.DeleteAppointmentRequest
+ */
+export type DeleteAppointmentRequest = Message<"schedula.v2.DeleteAppointmentRequest"> & {
+  /**
+   * @generated from field: string user_id = 1;
+   */
+  userId: string;
+
+  /**
+   * @generated from field: string appointment_id = 2;
+   */
+  appointmentId: string;
+
+  /**
+   * @generated from field: string actor_id = 3;
+   */
+  actorId: string;
+};
+
+/**
+ * Describes the message schedula.v2.DeleteAppointmentRequest.
+ * Use `create(DeleteAppointmentRequestSchema)` to create a new message.
+ */
+export const DeleteAppointmentRequestSchema: GenMessage<DeleteAppointmentRequest> = /*@__PURE__*/
+  messageDesc(file_proto_schedula_v2_appointments, 4);
+
+/**
+ * @generated from message schedula.v2.DeleteAppointmentResponse
+ */
+export type DeleteAppointmentResponse = Message<"schedula.v2.DeleteAppointmentResponse"> & {
+};
+
+/**
+ * Describes the message schedula.v2.DeleteAppointmentResponse.
+ * Use `create(DeleteAppointmentResponseSchema)` to create a new message.
+ */
+export const DeleteAppointmentResponseSchema: GenMessage<DeleteAppointmentResponse> = /*@__PURE__*/
+  messageDesc(file_proto_schedula_v2_appointments, 5);
+
+/**
+ * @generated from enum schedula.v2.AppointmentKind
+ */
+export enum AppointmentKind {
+  /**
+   * @generated from enum value: APPOINTMENT_KIND_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * @generated from enum value: APPOINTMENT_KIND_EVENT = 1;
+   */
+  EVENT = 1,
+
+  /**
+   * @generated from enum value: APPOINTMENT_KIND_MILESTONE = 2;
+   */
+  MILESTONE = 2,
+}
+
+/**
+ * Describes the enum schedula.v2.AppointmentKind.
+ */
+export const AppointmentKindSchema: GenEnum<AppointmentKind> = /*@__PURE__*/
+  enumDesc(file_proto_schedula_v2_appointments, 0);
+
+/**
+ * @generated from service schedula.v2.AppointmentsService
+ */
+export const AppointmentsService: GenService<{
+  /**
+   * @generated from rpc schedula.v2.AppointmentsService.ListAppointments
+   */
+  listAppointments: {
+    methodKind: "unary";
+    input: typeof ListAppointmentsRequestSchema;
+    output: typeof ListAppointmentsResponseSchema;
+  },
+  /**
+   * @generated from rpc schedula.v2.AppointmentsService.DeleteAppointment
+   */
+  deleteAppointment: {
+    methodKind: "unary";
+    input: typeof DeleteAppointmentRequestSchema;
+    output: typeof DeleteAppointmentResponseSchema;
+  },
+}> = /*@__PURE__*/
+  serviceDesc(file_proto_schedula_v2_appointments, 0);
+